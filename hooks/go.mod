@@ -19,7 +19,7 @@ require (
 	system/lib/git v0.0.0
 	system/lib/instance v0.0.0 // indirect
 	system/lib/jsonc v0.0.0 // indirect
-	system/lib/logging v0.0.0 // indirect
+	system/lib/logging v0.0.0
 	system/lib/paths v0.0.0 // indirect
 	system/lib/planner v0.0.0 // indirect
 	system/lib/privacy v0.0.0
@@ -9,10 +9,12 @@ module hooks/lib
 go 1.24.4
 
 require (
+	system/lib/config v0.0.0
 	system/lib/display v0.0.0
 	system/lib/fs v0.0.0
 	system/lib/git v0.0.0
 	system/lib/instance v0.0.0
+	system/lib/jsonc v0.0.0
 	system/lib/logging v0.0.0
 	system/lib/sessiontime v0.0.0
 	system/lib/system v0.0.0
@@ -22,8 +24,6 @@ require (
 require (
 	github.com/BurntSushi/toml v1.5.0 // indirect
 	system/lib/calendar v0.0.0 // indirect
-	system/lib/config v0.0.0 // indirect
-	system/lib/jsonc v0.0.0 // indirect
 	system/lib/paths v0.0.0 // indirect
 	system/lib/planner v0.0.0 // indirect
 	system/lib/privacy v0.0.0-00010101000000-000000000000
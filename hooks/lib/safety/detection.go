@@ -98,9 +98,9 @@
 // Dependencies
 //
 // Dependencies (What This Needs):
-//   Standard Library: strings, encoding/json, os, path/filepath
+//   Standard Library: strings, os, path/filepath
 //   External: None
-//   Internal: None (pure detection logic)
+//   Internal: system/lib/jsonc (config parsing)
 //
 // Dependents (What Uses This):
 //   Hooks: tool/pre-use (BLOCKING pre-tool validation)
@@ -154,10 +154,14 @@ import (
 	//--- Standard Library ---
 	// Foundation packages providing Go's built-in capabilities.
 
-	"encoding/json" // JSON parsing for configuration files
 	"os"            // File operations for config loading
 	"path/filepath" // Path manipulation for config file locations
 	"strings"       // String operations for pattern matching
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/jsonc" // JSONC parsing for configuration files
 )
 
 // ────────────────────────────────────────────────────────────────
@@ -397,10 +401,9 @@ func init() {
 //   └── ContainsLikelySecret() → uses matchesAnyPattern() + config/fallback
 //
 //   Helpers (Bottom Rungs - Internal Utilities)
-//   ├── loadDangerousPatterns() → reads config, parses JSON
-//   ├── loadCriticalPaths() → reads config, parses JSON
-//   ├── loadSecretPatterns() → reads config, parses JSON
-//   ├── stripJSONCComments() → removes // comments from JSONC
+//   ├── loadDangerousPatterns() → reads config, jsonc.Unmarshal
+//   ├── loadCriticalPaths() → reads config, jsonc.Unmarshal
+//   ├── loadSecretPatterns() → reads config, jsonc.Unmarshal
 //   └── matchesAnyPattern() → pure string matching function
 //
 // Baton Flow (Execution Paths):
@@ -446,10 +449,8 @@ func loadDangerousPatterns(path string) *DangerousPatternsConfig {
 		return nil // File doesn't exist or can't be read - use fallback
 	}
 
-	cleanJSON := stripJSONCComments(string(data)) // Remove // comments to make valid JSON
-
-	var config DangerousPatternsConfig                   // Allocate config struct
-	if err := json.Unmarshal([]byte(cleanJSON), &config); err != nil {
+	var config DangerousPatternsConfig // Allocate config struct
+	if err := jsonc.Unmarshal(data, &config); err != nil { // Strips comments, tolerates trailing commas
 		return nil // JSON parsing failed - use fallback
 	}
 
@@ -478,10 +479,8 @@ func loadCriticalPaths(path string) *CriticalPathsConfig {
 		return nil // File doesn't exist or can't be read - use fallback
 	}
 
-	cleanJSON := stripJSONCComments(string(data)) // Remove // comments to make valid JSON
-
-	var config CriticalPathsConfig                       // Allocate config struct
-	if err := json.Unmarshal([]byte(cleanJSON), &config); err != nil {
+	var config CriticalPathsConfig // Allocate config struct
+	if err := jsonc.Unmarshal(data, &config); err != nil { // Strips comments, tolerates trailing commas
 		return nil // JSON parsing failed - use fallback
 	}
 
@@ -510,45 +509,14 @@ func loadSecretPatterns(path string) *SecretPatternsConfig {
 		return nil // File doesn't exist or can't be read - use fallback
 	}
 
-	cleanJSON := stripJSONCComments(string(data)) // Remove // comments to make valid JSON
-
-	var config SecretPatternsConfig                      // Allocate config struct
-	if err := json.Unmarshal([]byte(cleanJSON), &config); err != nil {
+	var config SecretPatternsConfig // Allocate config struct
+	if err := jsonc.Unmarshal(data, &config); err != nil { // Strips comments, tolerates trailing commas
 		return nil // JSON parsing failed - use fallback
 	}
 
 	return &config // Successfully loaded and parsed
 }
 
-// stripJSONCComments removes // comments from JSONC text.
-//
-// What It Does:
-// Splits text by newlines, filters out lines starting with //, rejoins.
-// Simple approach sufficient for our config files which use only // comments.
-//
-// Parameters:
-//   jsonc: JSONC text with // comments
-//
-// Returns:
-//   Valid JSON text with comments removed
-//
-// Limitations:
-// Does not handle /* */ block comments or // inside strings.
-// Our config files only use // comments at start of lines.
-func stripJSONCComments(jsonc string) string {
-	lines := strings.Split(jsonc, "\n") // Split into individual lines
-	var cleaned []string                // Accumulate non-comment lines
-
-	for _, line := range lines {
-		trimmed := strings.TrimSpace(line)               // Remove leading/trailing whitespace
-		if !strings.HasPrefix(trimmed, "//") {           // Keep if not a comment line
-			cleaned = append(cleaned, line)              // Preserve original spacing
-		}
-	}
-
-	return strings.Join(cleaned, "\n") // Rejoin with newlines
-}
-
 // matchesAnyPattern checks if text contains any pattern from list.
 //
 // What It Does:
@@ -73,9 +73,9 @@
 //
 // Dependencies (What This Needs):
 //   Standard Library: os/exec (find command), fmt (output), os (file operations),
-//                     path/filepath (path handling), strings (string manipulation),
-//                     encoding/json (config parsing)
-//   Internal: system/lib/display (formatted output with health tracking)
+//                     path/filepath (path handling), strings (string manipulation)
+//   Internal: system/lib/display (formatted output with health tracking),
+//             system/lib/jsonc (config parsing)
 //
 // Dependents (What Uses This):
 //   Hooks: session/cmd-start/start.go, session/cmd-stop/stop.go
@@ -129,7 +129,6 @@ import (
 	//--- Standard Library ---
 	// Foundation packages providing Go's built-in capabilities.
 
-	"encoding/json" // Parse activity-tracking.jsonc configuration file
 	"fmt"           // Formatted output for activity messages to stdout
 	"os"            // File operations for configuration loading and HOME directory
 	"os/exec"       // Execute find command to discover recently modified files
@@ -140,6 +139,7 @@ import (
 	// Project-specific packages showing architectural dependencies.
 
 	"system/lib/display" // Formatted output with ANSI colors and health tracking
+	"system/lib/jsonc"   // Parse activity-tracking.jsonc configuration file
 )
 
 // ────────────────────────────────────────────────────────────────
@@ -319,8 +319,7 @@ func init() {
 //   └── formatOutput() → uses getDisplaySettings(), system/lib/display
 //
 //   Helpers (Bottom Rungs - Foundations)
-//   ├── loadActivityConfig() → uses stripJSONCComments() (pure)
-//   ├── stripJSONCComments() → pure function
+//   ├── loadActivityConfig() → uses jsonc.Unmarshal
 //   ├── getTimeWindow() → reads config (fallback to default)
 //   ├── getExclusionPatterns() → reads config (fallback to defaults)
 //   └── getDisplaySettings() → reads config (fallback to defaults)
@@ -376,79 +375,14 @@ func loadActivityConfig(configPath string) *ActivityConfig {
 		return nil // Signal failure - caller uses fallback
 	}
 
-	cleanJSON := stripJSONCComments(string(data)) // Remove // comment lines for JSON parser
-
-	var config ActivityConfig                       // Declare struct to unmarshal into
-	if err := json.Unmarshal([]byte(cleanJSON), &config); err != nil { // Parse JSON into struct
+	var config ActivityConfig                             // Declare struct to unmarshal into
+	if err := jsonc.Unmarshal(data, &config); err != nil { // Strip comments, parse JSON into struct
 		return nil // Malformed JSON - signal failure
 	}
 
 	return &config // Success - return loaded configuration
 }
 
-// stripJSONCComments removes single-line comments from JSONC content.
-//
-// What It Does:
-// Filters out lines starting with // to make JSONC parseable by standard JSON
-// decoder. Preserves all non-comment lines. Simple line-based approach works
-// for our config files (no inline comments, no /* */ style).
-//
-// Parameters:
-//   jsonc: JSONC content with // comments
-//
-// Returns:
-//   string: Valid JSON with comments removed
-//
-// Example usage:
-//
-//	jsonc := "// Comment\n{\"key\": \"value\"}"
-//	json := stripJSONCComments(jsonc)  // Returns "{\"key\": \"value\"}"
-func stripJSONCComments(jsonc string) string {
-	lines := strings.Split(jsonc, "\n") // Split into individual lines
-	var cleaned []string                // Accumulate non-comment lines
-
-	for _, line := range lines { // Process each line
-		trimmed := strings.TrimSpace(line) // Remove leading/trailing whitespace
-
-		// Skip full-line comments
-		if strings.HasPrefix(trimmed, "//") {
-			continue // Skip this line entirely
-		}
-
-		// Handle trailing comments - find // that's NOT inside a quoted string
-		inString := false
-		escaped := false
-		for i := 0; i < len(line)-1; i++ {
-			char := line[i]
-
-			// Handle escape sequences
-			if escaped {
-				escaped = false
-				continue
-			}
-			if char == '\\' {
-				escaped = true
-				continue
-			}
-
-			// Track whether we're inside a quoted string
-			if char == '"' {
-				inString = !inString
-			}
-
-			// If we find // outside a string, truncate here
-			if !inString && char == '/' && line[i+1] == '/' {
-				line = line[:i]
-				break
-			}
-		}
-
-		cleaned = append(cleaned, line) // Keep this line (possibly with trailing comment removed)
-	}
-
-	return strings.Join(cleaned, "\n") // Rejoin into single string with newlines
-}
-
 // getTimeWindow returns configured time window or default fallback.
 //
 // What It Does:
@@ -685,18 +619,18 @@ func formatOutput(files []string, settings DisplaySettings) {
 	if count <= settings.ThresholdDetailed {
 		// Detailed mode: show specific count
 		message := fmt.Sprintf("%s Recently modified (last hour): %d file(s)", settings.Icon, count)
-		fmt.Println(display.Info(message)) // Use display library for formatted output
+		fmt.Fprintln(Output(), display.Info(message)) // Use display library for formatted output
 	} else if count <= settings.ThresholdSummary {
 		// Summary mode: general awareness
 		message := fmt.Sprintf("%s Recently modified (last hour): %d files", settings.Icon, count)
-		fmt.Println(display.Info(message)) // Use display library
+		fmt.Fprintln(Output(), display.Info(message)) // Use display library
 	}
 	// Above summary threshold: silent (too many files, likely build artifacts)
 
 	// Optional: display file list if enabled (typically for debugging)
 	if settings.ShowFileList && count <= settings.ThresholdDetailed {
 		for _, file := range files { // Print each file path
-			fmt.Printf("  - %s\n", file)
+			fmt.Fprintf(Output(), "  - %s\n", file)
 		}
 	}
 }
@@ -992,8 +926,8 @@ func CheckRecentActivity(workspace string) {
 // For Related Components section explanation, see: standards/code/4-block/sections/CWS-SECTION-019-CLOSING-related-components.md
 //
 // See METADATA "Dependencies" section above for complete dependency information:
-// - Dependencies (What This Needs): Standard library (os/exec, fmt, strings, encoding/json),
-//                                    system/lib/display (formatted output)
+// - Dependencies (What This Needs): Standard library (os/exec, fmt, strings),
+//                                    system/lib/display (formatted output), system/lib/jsonc
 // - Dependents (What Uses This): session/cmd-start/start.go, session/cmd-stop/stop.go
 // - Integration Points: Hooks call CheckRecentActivity(), display library for output
 //
@@ -0,0 +1,211 @@
+// METADATA
+//
+// Banner Art Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "And he hath filled him with the spirit of God, in wisdom, in understanding... and in all manner of workmanship" - Exodus 35:31 (KJV)
+// Principle: Craft has its place, even in small things
+// Anchor: "Whatsoever thy hand findeth to do, do it with thy might" - Ecclesiastes 9:10 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - banner art for session start)
+// Role: Resolves the instance's ASCII-art block and fits it inside the start banner
+// Paradigm: CPI-SI framework component - serves display.go PrintHeader
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial banner art
+//
+// Version History:
+//   1.0.0 (2026-10-16) - Inline or file art above the start banner title, max height, width clamping
+//
+// Purpose & Function
+//
+// Purpose: Let the instance's display config (instance.jsonc "display.art") put a
+// small figlet-style name or custom art block above the start banner's title.
+//
+// Core Design: bannerArtLines reads the art (inline lines win over a file; a
+// relative file resolves under system_paths.config_root), keeps the first
+// max_height lines, and drops trailing blank lines. fitBannerArt then decides
+// whether it can show at all:
+//
+//   - ASCII fallback active (configured or detected): skipped - art is usually
+//     drawn with characters a limited terminal mangles
+//   - Terminal narrower than the widest art line: skipped - clamping would cut
+//     the picture apart
+//   - Otherwise lines wider than the banner's text area are clamped with
+//     display.Truncate, and the block is padded to its widest line so
+//     centering moves it as one piece
+//
+// Skipping is silent - the banner renders exactly as it does without art.
+//
+// Blocking Status
+//
+// Non-blocking: An unreadable art file means no art (logged once per display).
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: os, path/filepath, strings
+//   Internal: system/lib/display (Truncate), system/lib/instance (BannerArtConfig, SystemPaths),
+//             display.go (bannerLayout, displayWidth, expandPath, displayLogger)
+//
+// Dependents (What Uses This):
+//   Libraries: display.go (PrintHeader → renderArtBanner)
+//
+// Health Scoring
+//
+// Art file unreadable: -2 (banner still shown without it).
+package session
+
+// ============================================================================
+// END METADATA
+// ============================================================================
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	//--- Standard Library ---
+
+	"os"            // Art file reading
+	"path/filepath" // Relative art paths under config_root
+	"strings"       // Line splitting and padding
+
+	//--- Internal Packages ---
+
+	"system/lib/display"  // Truncate for clamping wide lines
+	"system/lib/instance" // BannerArtConfig, SystemPaths.ConfigRoot
+)
+
+// defaultBannerArtMaxHeight bounds art when display.art.max_height is unset.
+const defaultBannerArtMaxHeight = 8
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   bannerArtLines(art, paths) → uses expandPath, displayLogger (unreadable file)
+//   fitBannerArt(lines, layout, terminal) → uses displayWidth, display.Truncate
+//
+// Called only by PrintHeader; renderArtBanner (display.go) draws the result.
+
+// bannerArtLines returns the configured art, height-limited ("" art or disabled → nil)
+//
+// What It Does:
+//   - Inline lines win; otherwise reads file (absolute, ~/, or under config_root)
+//   - Keeps the first max_height lines (default defaultBannerArtMaxHeight)
+//   - Trims trailing spaces and trailing blank lines; tabs become four spaces
+func bannerArtLines(art instance.BannerArtConfig, paths instance.SystemPaths) []string {
+	if !art.Enabled {
+		return nil
+	}
+
+	lines := art.Lines
+	if len(lines) == 0 && art.File != "" {
+		path := expandPath(art.File)
+		if !filepath.IsAbs(path) && paths.ConfigRoot != "" {
+			path = filepath.Join(expandPath(paths.ConfigRoot), path)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			displayLogger.Check("banner-art-read", false, -2, map[string]interface{}{
+				"file":   path,
+				"error":  err.Error(),
+				"action": "banner shown without art",
+			})
+			return nil
+		}
+		lines = strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	}
+
+	maxHeight := art.MaxHeight
+	if maxHeight <= 0 {
+		maxHeight = defaultBannerArtMaxHeight
+	}
+	if len(lines) > maxHeight {
+		lines = lines[:maxHeight]
+	}
+
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		result[i] = strings.TrimRight(strings.ReplaceAll(line, "\t", "    "), " ")
+	}
+	for len(result) > 0 && result[len(result)-1] == "" {
+		result = result[:len(result)-1]
+	}
+	return result
+}
+
+// fitBannerArt fits art lines into the banner's text area, or returns nil to skip
+//
+// What It Does:
+//   - nil when layout.ASCII, or terminal (columns, 0 = unknown) is narrower
+//     than the widest line
+//   - Clamps lines wider than the text area (layout.Width minus borders and margins)
+//   - Pads every line to the block's width so centering keeps it aligned
+func fitBannerArt(lines []string, layout bannerLayout, terminal int) []string {
+	if len(lines) == 0 || layout.ASCII {
+		return nil
+	}
+
+	widest := 0
+	for _, line := range lines {
+		if w := displayWidth(line); w > widest {
+			widest = w
+		}
+	}
+	if terminal > 0 && terminal < widest {
+		return nil
+	}
+
+	text := layout.Width - 4 // Borders plus one space margin each side
+	if widest > text {
+		widest = text
+	}
+
+	fitted := make([]string, len(lines))
+	for i, line := range lines {
+		if displayWidth(line) > text {
+			line = display.Truncate(line, text)
+		}
+		fitted[i] = line + strings.Repeat(" ", widest-displayWidth(line))
+	}
+	return fitted
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation: go test ./... (bannerart_test.go - file and inline art,
+// max height, clamping, ASCII and narrow-terminal skips, unchanged default banner)
+//
+// Code Execution: None (library) - PrintHeader calls bannerArtLines and fitBannerArt
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
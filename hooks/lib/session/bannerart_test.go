@@ -0,0 +1,116 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Banner Art Tests
+//
+// Purpose: Prove art comes from inline lines or a config_root-relative file,
+//          stops at max_height, clamps to the banner's text area, is skipped
+//          under ASCII fallback or a terminal narrower than the art, and that
+//          a banner without art renders exactly as before.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"system/lib/instance"
+)
+
+// bannerColors matches the escapes renderArtBanner writes around every row
+var bannerColors = regexp.MustCompile("\033\\[[0-9;]*m")
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestBannerArtLines(t *testing.T) {
+	if lines := bannerArtLines(instance.BannerArtConfig{Lines: []string{"art"}}, instance.SystemPaths{}); lines != nil {
+		t.Errorf("disabled art = %q", lines)
+	}
+
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "nova.txt"), []byte(" _  _\r\n| \\| |\t \r\n|_|\\_|\r\n\r\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	paths := instance.SystemPaths{ConfigRoot: root}
+
+	fromFile := bannerArtLines(instance.BannerArtConfig{Enabled: true, File: "nova.txt"}, paths)
+	if want := []string{" _  _", "| \\| |", "|_|\\_|"}; !slices.Equal(fromFile, want) {
+		t.Errorf("file art = %q, want %q", fromFile, want)
+	}
+
+	short := bannerArtLines(instance.BannerArtConfig{Enabled: true, File: "nova.txt", MaxHeight: 2}, paths)
+	if len(short) != 2 {
+		t.Errorf("max_height 2 kept %d lines", len(short))
+	}
+
+	inline := bannerArtLines(instance.BannerArtConfig{Enabled: true, Lines: []string{"*"}, File: "nova.txt"}, paths)
+	if !slices.Equal(inline, []string{"*"}) {
+		t.Errorf("inline lines should win over file: %q", inline)
+	}
+
+	if missing := bannerArtLines(instance.BannerArtConfig{Enabled: true, File: "gone.txt"}, paths); missing != nil {
+		t.Errorf("missing file art = %q", missing)
+	}
+}
+
+func TestFitBannerArt(t *testing.T) {
+	layout := bannerLayout{Width: 24, Box: boxStyles["double_line"]} // 20-column text area
+	art := []string{"ab", "abcdef", strings.Repeat("x", 22)}
+
+	fitted := fitBannerArt(art, layout, 0)
+	if len(fitted) != 3 {
+		t.Fatalf("fitted = %q", fitted)
+	}
+	for _, line := range fitted {
+		if displayWidth(line) != 20 {
+			t.Errorf("line %q is %d columns, want the 20-column block", line, displayWidth(line))
+		}
+	}
+	if !strings.HasPrefix(fitted[0], "ab ") || fitted[2] != strings.Repeat("x", 20) {
+		t.Errorf("padding or clamping wrong: %q", fitted)
+	}
+
+	if got := fitBannerArt(art, layout, 21); got != nil {
+		t.Errorf("terminal narrower than the art should skip it: %q", got)
+	}
+	if got := fitBannerArt(art[:2], bannerLayout{Width: 24, Box: boxStyles["ascii_fallback"], ASCII: true}, 0); got != nil {
+		t.Errorf("ASCII fallback should skip art: %q", got)
+	}
+}
+
+func TestRenderArtBanner(t *testing.T) {
+	layout := bannerLayout{Width: 30, Box: boxStyles["double_line"]}
+	plain := renderBanner(layout, "Nova Dawn", "tagline")
+	if got := renderArtBanner(layout, nil, "Nova Dawn", "tagline"); got != plain {
+		t.Errorf("no art changed the banner:\n%s\nwant:\n%s", got, plain)
+	}
+
+	withArt := renderArtBanner(layout, fitBannerArt([]string{"/\\", "\\/"}, layout, 0), "Nova Dawn", "tagline")
+	lines := strings.Split(strings.TrimSuffix(withArt, "\n"), "\n")
+	if want := len(strings.Split(strings.TrimSuffix(plain, "\n"), "\n")) + 2; len(lines) != want {
+		t.Fatalf("art banner has %d lines, want %d", len(lines), want)
+	}
+	if !strings.Contains(lines[1], "/\\") || !strings.Contains(lines[3], "Nova Dawn") {
+		t.Errorf("art should sit above the title:\n%s", withArt)
+	}
+	for _, line := range lines {
+		if w := displayWidth(bannerColors.ReplaceAllString(line, "")); w != layout.Width {
+			t.Errorf("line %q is %d columns, want %d", bannerColors.ReplaceAllString(line, ""), w, layout.Width)
+		}
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
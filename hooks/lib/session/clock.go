@@ -0,0 +1,177 @@
+// METADATA
+//
+// Session Clock - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "My times are in thy hand" - Psalm 31:15 (KJV)
+// Principle: Every timestamp the session shows comes from one hand
+// Anchor: "To every thing there is a season" - Ecclesiastes 3:1 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - time source)
+// Role: Answers "what time is it?" for session records and display
+// Paradigm: CPI-SI framework component - tests name the hour, hooks ask the system
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial injectable clock
+//
+// Version History:
+//   1.0.0 (2026-10-16) - Clock, ClockFunc, SetClock/ResetClock; display, lifecycle, stats,
+//                        compaction, notification, verse, and reminder timestamps
+//
+// Purpose & Function
+//
+// Purpose: Banner timestamps, session start/end records, and statistics read
+// time.Now directly, so their output could only be tested by stripping
+// times out. They now read one package clock that tests can replace.
+//
+// Core Design: A one-method Clock interface (ClockFunc adapts a function),
+// swapped process-wide by SetClock. The clock answers "when" - timestamps
+// shown or recorded. Deadlines, lock waits, and reload throttles keep the
+// real clock: a frozen test clock must never make a wait endless.
+//
+// Blocking Status
+//
+// Non-blocking: Reading the clock cannot fail.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: sync, time
+//
+// Dependents (What Uses This):
+//   Libraries: display.go, lifecycle.go, stats.go, compaction.go, notifications.go,
+//              verses.go, statereminders.go, unpushed.go
+//
+// Health Scoring
+//
+// None - infrastructure only.
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"sync" // Guards clock swaps against concurrent readers
+	"time" // Time values
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// Clock supplies the current time
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts an ordinary function to Clock
+type ClockFunc func() time.Time
+
+// Now calls f
+func (f ClockFunc) Now() time.Time { return f() }
+
+// systemClock reads the real wall clock
+type systemClock struct{}
+
+// Now returns time.Now()
+func (systemClock) Now() time.Time { return time.Now() }
+
+// ────────────────────────────────────────────────────────────────
+// Package-Level State
+// ────────────────────────────────────────────────────────────────
+
+var (
+	clockMu      sync.RWMutex                 // Guards sessionClock
+	sessionClock Clock        = systemClock{} // Time source for session timestamps
+)
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Time Source
+// ────────────────────────────────────────────────────────────────
+
+// now returns the current time from the session clock
+func now() time.Time {
+	clockMu.RLock()
+	defer clockMu.RUnlock()
+	return sessionClock.Now()
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// SetClock replaces the session time source (nil restores the system clock)
+//
+// Meant for tests: banner timestamps, session records, and statistics
+// become exact under a fixed clock.
+//
+// Example:
+//   fixed := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+//   session.SetClock(session.ClockFunc(func() time.Time { return fixed }))
+//   defer session.ResetClock()
+func SetClock(c Clock) {
+	if c == nil {
+		c = systemClock{}
+	}
+	clockMu.Lock()
+	defer clockMu.Unlock()
+	sessionClock = c
+}
+
+// ResetClock restores the system clock
+func ResetClock() {
+	SetClock(nil)
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Fixed clock: end session info and temporal journey render exactly (output_test.go)
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library)
+//
+// Code Cleanup: None
+//
+// Modification Policy:
+//   ✅ Safe: Moving another timestamp onto now()
+//   ⚠️ Care: Anything persisted - records written under a test clock must not leak
+//   ❌ Never: Deadlines or waits on now() - a frozen clock would never expire them
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,550 @@
+// METADATA
+//
+// Compaction Analysis Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Gather up the fragments that remain, that nothing be lost." - John 6:12 (KJV)
+// Principle: Knowing where the room went is how the next stretch of work wastes less of it
+// Anchor: "Consider your ways." - Haggai 1:7 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - compaction awareness)
+// Role: Estimates what filled the context before a compaction and what to do differently
+// Paradigm: CPI-SI framework component - feeds PrintCompactionAnalysis and the compaction snapshot
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.1.0
+// Last Modified: 2026-10-16 - Decoding shared with ReadHookPayload
+//
+// Version History:
+//   1.1.0 (2026-10-16) - AnalyzeCompactionPayload; AnalyzePreCompactPayload decodes via ReadHookPayload
+//   1.0.0 (2026-10-16) - AnalyzePreCompactPayload, tool output share, largest contributions, advice
+//
+// Purpose & Function
+//
+// Purpose: PrintPreCompactionMessage says a compaction is happening but not
+// why. The PreCompact hook gets a JSON payload on stdin naming the session's
+// transcript; reading that transcript shows what filled the context - usually
+// a few giant file reads or command dumps - and one line of advice turns that
+// into a habit: "72% of context is tool output; the largest item is a 300KB
+// read of data.json at 14:02; consider reading files with offsets".
+//
+// Core Design: The payload is decoded loosely (map of raw fields, with
+// alternate key spellings) so payload schema drift costs a field, never the
+// analysis. The transcript is read once with the same record shapes as
+// transcript.go; only what follows the last compact boundary counts - that is
+// what the live context holds. Sizes are bytes of message content:
+//   - tool_result blocks          → tool output (attributed to their tool_use
+//     by id: tool name plus the file, command, pattern, or URL it was given)
+//   - every other block / string  → the rest of the context
+// Token figures come from the payload when it carries any, else from the
+// last recorded usage (prompt + cache tokens = what the model last saw).
+//
+// Blocking Status
+//
+// Non-blocking: Only an empty or non-JSON payload is an error. A missing or
+// unreadable transcript yields an analysis that says why (Unavailable).
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bufio, encoding/json, fmt, io, os, path/filepath, sort, strings, time
+//   Package Files: transcript.go (transcriptMaxLine, transcriptUsage), payload.go (ReadHookPayload, HookPayload)
+//
+// Dependents (What Uses This):
+//   Commands: session/cmd-pre-compact (AnalyzeCompactionPayload)
+//   Libraries: display.go (PrintCompactionAnalysis), compaction.go (snapshot "context_analysis")
+//
+// Health Scoring
+//
+// Pure read path - no logging; the hook decides what a failed analysis means.
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"bufio"         // Line-by-line JSONL reading
+	"encoding/json" // Payload and record decoding
+	"fmt"           // Advice text and error wrapping
+	"io"            // Payload reader
+	"os"            // Transcript file access
+	"path/filepath" // Short file names in advice
+	"sort"          // Largest contributions first
+	"strings"       // Key lookup and text trimming
+	"time"          // Record timestamps
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// compactionLargestShown is how many single contributions are kept
+	compactionLargestShown = 3
+
+	// compactionTargetMax caps a command or pattern quoted in advice
+	compactionTargetMax = 40
+
+	// compactBoundarySubtype marks the start of the post-compaction context
+	compactBoundarySubtype = "compact_boundary"
+)
+
+// Payload keys, each with the spellings seen (or expected) across hook versions
+var (
+	payloadTranscriptKeys = []string{"transcript_path", "transcriptPath", "transcript"}
+	payloadTriggerKeys    = []string{"trigger", "compact_type", "compaction_trigger"}
+	payloadTokenKeys      = []string{"context_tokens", "token_count", "tokens_used", "input_tokens", "total_tokens"}
+	payloadWindowKeys     = []string{"context_window", "context_window_tokens", "max_tokens", "token_limit"}
+)
+
+// compactionTargetKeys are the tool_use input fields that say what a result is of
+var compactionTargetKeys = []string{"file_path", "notebook_path", "command", "pattern", "url", "path", "query"}
+
+// compactionAdvice maps tools to what would have made their output smaller
+var compactionAdvice = map[string]string{
+	"Read":      "consider reading files with offsets",
+	"Bash":      "consider piping command output through head, tail, or grep",
+	"Grep":      "consider narrowing searches with a glob or head_limit",
+	"Glob":      "consider narrower glob patterns",
+	"WebFetch":  "consider asking for a summary instead of the whole page",
+	"WebSearch": "consider fewer, more specific searches",
+}
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// CompactionContribution is one tool result's share of the context
+type CompactionContribution struct {
+	Tool   string    `json:"tool"`             // Tool name ("" when its tool_use was not seen)
+	Target string    `json:"target,omitempty"` // File, command, pattern, or URL it was given
+	Bytes  int       `json:"bytes"`            // Result content size
+	At     time.Time `json:"at,omitempty"`     // When the result was recorded
+}
+
+// CompactionAnalysis estimates what filled the context before a compaction
+type CompactionAnalysis struct {
+	Trigger        string                   `json:"trigger,omitempty"`         // "manual" / "auto" from the payload
+	TranscriptPath string                   `json:"transcript_path,omitempty"` // Transcript read
+	Messages       int                      `json:"messages"`                  // User and assistant records since the last compaction
+	ToolResults    int                      `json:"tool_results"`              // tool_result blocks among them
+	ContextBytes   int                      `json:"context_bytes"`             // All message content
+	ToolBytes      int                      `json:"tool_output_bytes"`         // tool_result content
+	ToolFraction   float64                  `json:"tool_output_fraction"`      // ToolBytes / ContextBytes (0 = none)
+	ContextTokens  int                      `json:"context_tokens,omitempty"`  // Payload figure, else last recorded usage (0 = unknown)
+	ContextWindow  int                      `json:"context_window,omitempty"`  // From the payload (0 = unknown)
+	Largest        []CompactionContribution `json:"largest,omitempty"`         // Biggest tool results, largest first
+	Opaque         int                      `json:"opaque"`                    // Transcript lines not understood (skipped)
+	Unavailable    string                   `json:"unavailable,omitempty"`     // Why there are no transcript figures ("" = read)
+}
+
+// compactRecord is the part of a transcript line the analysis reads
+type compactRecord struct {
+	Type      string `json:"type"`
+	Subtype   string `json:"subtype"`
+	Timestamp string `json:"timestamp"`
+	Message   *struct {
+		Content json.RawMessage  `json:"content"`
+		Usage   *transcriptUsage `json:"usage"`
+	} `json:"message"`
+}
+
+// compactBlock is one content block, sized by its raw JSON
+type compactBlock struct {
+	Type      string                     `json:"type"`
+	ID        string                     `json:"id"`          // tool_use
+	Name      string                     `json:"name"`        // tool_use
+	Input     map[string]json.RawMessage `json:"input"`       // tool_use
+	ToolUseID string                     `json:"tool_use_id"` // tool_result
+	Content   json.RawMessage            `json:"content"`     // tool_result
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs)
+//   ├── AnalyzePreCompactPayload(r) → ReadHookPayload, AnalyzeCompactionPayload
+//   ├── AnalyzeCompactionPayload(p) → payloadString, payloadInt, analyzeCompactTranscript
+//   └── CompactionAnalysis.Advice() → describeContribution, compactionAdvice
+//
+//   Core Operations (Middle Rungs)
+//   └── analyzeCompactTranscript(analysis, path) → addRecord per line, then fractions and Largest
+//
+//   Helpers (Bottom Rungs)
+//   ├── payloadString / payloadInt(fields, keys) → first key that decodes
+//   ├── (*compactTally).addRecord(line) → sizes, tool names, usage
+//   ├── resultSize(content) → text length of a tool_result
+//   ├── toolTarget(input) → file, command, pattern, or URL
+//   └── describeContribution(c) / shortSize(bytes) → advice phrases
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Payload Fields
+// ────────────────────────────────────────────────────────────────
+
+// payloadString returns the first of keys holding a non-empty string
+func payloadString(fields map[string]json.RawMessage, keys []string) string {
+	for _, key := range keys {
+		var value string
+		if json.Unmarshal(fields[key], &value) == nil && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// payloadInt returns the first of keys holding a positive number
+func payloadInt(fields map[string]json.RawMessage, keys []string) int {
+	for _, key := range keys {
+		var value float64
+		if json.Unmarshal(fields[key], &value) == nil && value > 0 {
+			return int(value)
+		}
+	}
+	return 0
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Transcript Accounting
+// ────────────────────────────────────────────────────────────────
+
+// compactTally accumulates transcript figures since the last compact boundary
+type compactTally struct {
+	analysis *CompactionAnalysis
+	results  []CompactionContribution // Every tool result (sorted into Largest at the end)
+	tools    map[string]compactBlock  // tool_use id → the call
+	usage    *transcriptUsage         // Last recorded usage
+}
+
+// reset forgets everything before a compact boundary
+func (t *compactTally) reset() {
+	a := t.analysis
+	a.Messages, a.ToolResults, a.ContextBytes, a.ToolBytes = 0, 0, 0, 0
+	t.results, t.usage = nil, nil
+	t.tools = map[string]compactBlock{}
+}
+
+// resultSize returns the text length of a tool_result's content
+//
+// Content is a string or a list of blocks; text blocks count their text,
+// anything else (images) its raw JSON.
+func resultSize(content json.RawMessage) int {
+	var text string
+	if json.Unmarshal(content, &text) == nil {
+		return len(text)
+	}
+	var blocks []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}
+	if json.Unmarshal(content, &blocks) == nil {
+		size := 0
+		for _, block := range blocks {
+			if block.Type == "text" {
+				size += len(block.Text)
+			}
+		}
+		if size > 0 {
+			return size
+		}
+	}
+	return len(content)
+}
+
+// toolTarget returns what a tool call was given: file, command, pattern, or URL
+func toolTarget(input map[string]json.RawMessage) string {
+	for _, key := range compactionTargetKeys {
+		var value string
+		if json.Unmarshal(input[key], &value) == nil && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// addRecord folds one transcript line into the tally
+func (t *compactTally) addRecord(line []byte) {
+	var record compactRecord
+	if err := json.Unmarshal(line, &record); err != nil {
+		t.analysis.Opaque++
+		return
+	}
+	if record.Subtype == compactBoundarySubtype {
+		t.reset()
+		return
+	}
+	if record.Message == nil {
+		if record.Type == "" {
+			t.analysis.Opaque++
+		}
+		return // Summaries, system notes - not message content
+	}
+
+	a := t.analysis
+	a.Messages++
+	if record.Message.Usage != nil {
+		t.usage = record.Message.Usage
+	}
+	at, _ := time.Parse(time.RFC3339Nano, record.Timestamp)
+
+	var text string
+	if json.Unmarshal(record.Message.Content, &text) == nil {
+		a.ContextBytes += len(text)
+		return
+	}
+	var blocks []json.RawMessage
+	if json.Unmarshal(record.Message.Content, &blocks) != nil {
+		a.ContextBytes += len(record.Message.Content)
+		return
+	}
+	for _, raw := range blocks {
+		var block compactBlock
+		if json.Unmarshal(raw, &block) != nil {
+			a.ContextBytes += len(raw)
+			continue
+		}
+		switch block.Type {
+		case "tool_use":
+			t.tools[block.ID] = block
+			a.ContextBytes += len(raw)
+		case "tool_result":
+			size := resultSize(block.Content)
+			call := t.tools[block.ToolUseID]
+			t.results = append(t.results, CompactionContribution{Tool: call.Name, Target: toolTarget(call.Input), Bytes: size, At: at})
+			a.ToolResults++
+			a.ToolBytes += size
+			a.ContextBytes += size
+		case "text":
+			var textBlock struct {
+				Text string `json:"text"`
+			}
+			json.Unmarshal(raw, &textBlock)
+			a.ContextBytes += len(textBlock.Text)
+		default:
+			a.ContextBytes += len(raw)
+		}
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Advice Text
+// ────────────────────────────────────────────────────────────────
+
+// shortSize renders a byte count as "300KB" / "1.2MB" / "512B"
+func shortSize(bytes int) string {
+	switch {
+	case bytes >= 1024*1024:
+		return fmt.Sprintf("%.1fMB", float64(bytes)/(1024*1024))
+	case bytes >= 1024:
+		return fmt.Sprintf("%dKB", bytes/1024)
+	default:
+		return fmt.Sprintf("%dB", bytes)
+	}
+}
+
+// describeContribution phrases one contribution: "300KB read of data.json at 14:02"
+func describeContribution(c CompactionContribution) string {
+	target := c.Target
+	if len(target) > compactionTargetMax {
+		target = target[:compactionTargetMax-1] + "…"
+	}
+
+	var what string
+	switch c.Tool {
+	case "Read", "NotebookRead":
+		what = "read of " + filepath.Base(c.Target)
+	case "Bash":
+		what = "command output"
+		if target != "" {
+			what = fmt.Sprintf("output of `%s`", target)
+		}
+	case "Grep", "Glob":
+		what = fmt.Sprintf("search for %q", target)
+	case "WebFetch":
+		what = "fetch of " + target
+	case "":
+		what = "tool result"
+	default:
+		what = c.Tool + " result"
+		if target != "" {
+			what += " for " + target
+		}
+	}
+
+	text := shortSize(c.Bytes) + " " + what
+	if !c.At.IsZero() {
+		text += " at " + c.At.Local().Format("15:04")
+	}
+	return text
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Transcript Analysis
+// ────────────────────────────────────────────────────────────────
+
+// analyzeCompactTranscript fills analysis from the transcript at path
+//
+// A missing or unreadable transcript is recorded in Unavailable, never returned.
+func analyzeCompactTranscript(analysis *CompactionAnalysis, path string) {
+	file, err := os.Open(path)
+	if err != nil {
+		analysis.Unavailable = fmt.Sprintf("transcript unreadable: %v", err)
+		return
+	}
+	defer file.Close()
+
+	tally := &compactTally{analysis: analysis}
+	tally.reset()
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), transcriptMaxLine)
+	for scanner.Scan() {
+		if line := scanner.Bytes(); len(line) > 0 {
+			tally.addRecord(line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		analysis.Unavailable = fmt.Sprintf("transcript read stopped early: %v", err)
+	}
+
+	if analysis.ContextBytes > 0 {
+		analysis.ToolFraction = float64(analysis.ToolBytes) / float64(analysis.ContextBytes)
+	}
+	if analysis.ContextTokens == 0 && tally.usage != nil {
+		u := tally.usage
+		analysis.ContextTokens = u.InputTokens + u.CacheCreationInputTokens + u.CacheReadInputTokens
+	}
+
+	sort.SliceStable(tally.results, func(i, j int) bool { return tally.results[i].Bytes > tally.results[j].Bytes })
+	analysis.Largest = tally.results[:min(len(tally.results), compactionLargestShown)]
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// AnalyzePreCompactPayload estimates what filled the context from the PreCompact hook's stdin
+//
+// What It Does:
+//   - Decodes the payload loosely: transcript path, trigger, and any token
+//     figures under their known spellings; unknown fields are ignored
+//   - Reads the transcript since the last compaction: message count, tool
+//     output bytes as a share of all content, the three largest tool results
+//   - Falls back to the last recorded usage for the context token estimate
+//
+// Parameters:
+//   - r: The hook's stdin (JSON object)
+//
+// Returns:
+//   - *CompactionAnalysis: Whatever could be determined (Unavailable says why
+//     transcript figures are missing)
+//   - error: Empty input or not a JSON object
+//
+// Example:
+//   analysis, err := session.AnalyzePreCompactPayload(os.Stdin)
+//   if err == nil {
+//       session.PrintCompactionAnalysis(analysis)
+//   }
+func AnalyzePreCompactPayload(r io.Reader) (*CompactionAnalysis, error) {
+	payload, err := ReadHookPayload(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pre-compact payload: %w", err)
+	}
+	return AnalyzeCompactionPayload(payload), nil
+}
+
+// AnalyzeCompactionPayload is AnalyzePreCompactPayload for an already-read payload
+//
+// Token figures and alternate key spellings come from the payload as sent;
+// the trigger falls back to HookPayload.Trigger (COMPACT_TYPE when no payload).
+//
+// Example:
+//   analysis := session.AnalyzeCompactionPayload(session.HookInput())
+func AnalyzeCompactionPayload(p *HookPayload) *CompactionAnalysis {
+	analysis := &CompactionAnalysis{
+		Trigger:        payloadString(p.raw, payloadTriggerKeys),
+		TranscriptPath: payloadString(p.raw, payloadTranscriptKeys),
+		ContextTokens:  payloadInt(p.raw, payloadTokenKeys),
+		ContextWindow:  payloadInt(p.raw, payloadWindowKeys),
+	}
+	if analysis.Trigger == "" {
+		analysis.Trigger = p.Trigger
+	}
+	if analysis.TranscriptPath == "" {
+		analysis.Unavailable = "payload names no transcript"
+		return analysis
+	}
+	analyzeCompactTranscript(analysis, analysis.TranscriptPath)
+	return analysis
+}
+
+// Advice returns the one-line advisory ("" when there is nothing to say)
+//
+// "72% of context is tool output; the largest item is a 300KB read of
+// data.json at 14:02; consider reading files with offsets"
+func (a *CompactionAnalysis) Advice() string {
+	if a == nil || a.ContextBytes == 0 {
+		return ""
+	}
+	parts := []string{fmt.Sprintf("%.0f%% of context is tool output", a.ToolFraction*100)}
+	if len(a.Largest) > 0 {
+		largest := a.Largest[0]
+		parts = append(parts, "the largest item is a "+describeContribution(largest))
+		if advice, ok := compactionAdvice[largest.Tool]; ok {
+			parts = append(parts, advice)
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Payload drift: alternate keys, unknown fields, missing transcript
+//   - Transcript: compact boundary resets, attribution by tool_use id, opaque lines
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by the PreCompact hook
+//
+// Code Cleanup: Transcript file closed on return
+//
+// Modification Policy:
+//   ✅ Safe: More payload key spellings, more tools in compactionAdvice / compactionTargetKeys
+//   ⚠️ Care: The compact boundary reset - figures describe the live context only
+//   ❌ Never: Failing on an unknown payload field or record shape - both change without notice
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,178 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Compaction Analysis Tests
+//
+// Purpose: Prove AnalyzePreCompactPayload reads drifted payloads, counts only
+//          the context since the last compact boundary, attributes tool output
+//          to its call, and phrases the advice; that the display rows and the
+//          snapshot carry the analysis.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// compactTranscript writes a transcript: a giant read before the last
+// compaction (must not count), then reads, a command dump, a search, an
+// orphan result, and records the analyzer has never seen.
+func compactTranscript(t *testing.T) string {
+	t.Helper()
+	line := func(v any) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	toolUse := func(id, name string, input map[string]any) map[string]any {
+		return map[string]any{"type": "assistant", "timestamp": "2026-10-16T14:01:00Z", "message": map[string]any{
+			"content": []any{map[string]any{"type": "tool_use", "id": id, "name": name, "input": input}}}}
+	}
+	result := func(at, id string, content any) map[string]any {
+		return map[string]any{"type": "user", "timestamp": at, "message": map[string]any{
+			"content": []any{map[string]any{"type": "tool_result", "tool_use_id": id, "content": content}}}}
+	}
+
+	lines := []string{
+		line(toolUse("old", "Read", map[string]any{"file_path": "/repo/huge.log"})),
+		line(result("2026-10-16T13:00:00Z", "old", strings.Repeat("x", 900*1024))),
+		`{"type":"system","subtype":"compact_boundary","content":"Conversation compacted"}`,
+		line(map[string]any{"type": "user", "timestamp": "2026-10-16T14:00:00Z", "message": map[string]any{"content": strings.Repeat("p", 10*1024)}}),
+		line(toolUse("t1", "Read", map[string]any{"file_path": "/repo/data/data.json"})),
+		line(result("2026-10-16T14:02:00Z", "t1", strings.Repeat("d", 300*1024))),
+		line(toolUse("t2", "Bash", map[string]any{"command": "go test ./..."})),
+		line(result("2026-10-16T14:03:00Z", "t2", []any{map[string]any{"type": "text", "text": strings.Repeat("o", 50*1024)}})),
+		line(toolUse("t3", "Grep", map[string]any{"pattern": "TODO"})),
+		line(result("2026-10-16T14:04:00Z", "t3", strings.Repeat("g", 2*1024))),
+		line(result("2026-10-16T14:05:00Z", "never-seen", strings.Repeat("?", 1024))),
+		line(map[string]any{"type": "assistant", "timestamp": "2026-10-16T14:06:00Z", "message": map[string]any{
+			"content": []any{map[string]any{"type": "text", "text": "Done."}},
+			"usage":   map[string]any{"input_tokens": 2000, "cache_read_input_tokens": 90000, "output_tokens": 40}}}),
+		`{"type":"summary","summary":"Earlier work"}`,
+		`not json at all`,
+	}
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestAnalyzePreCompactPayload(t *testing.T) {
+	path := compactTranscript(t)
+	payload := `{"hook_event_name":"PreCompact","transcriptPath":"` + path + `","trigger":"auto","custom_instructions":"","future_field":{"x":1}}`
+
+	analysis, err := AnalyzePreCompactPayload(strings.NewReader(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if analysis.Trigger != "auto" || analysis.TranscriptPath != path || analysis.Unavailable != "" {
+		t.Fatalf("payload fields = %+v", analysis)
+	}
+	if analysis.Messages != 9 || analysis.ToolResults != 4 || analysis.Opaque != 1 {
+		t.Errorf("Messages = %d, ToolResults = %d, Opaque = %d, want 9, 4, 1 (only after the boundary)",
+			analysis.Messages, analysis.ToolResults, analysis.Opaque)
+	}
+	if want := (300 + 50 + 2 + 1) * 1024; analysis.ToolBytes != want {
+		t.Errorf("ToolBytes = %d, want %d", analysis.ToolBytes, want)
+	}
+	if analysis.ToolFraction < 0.95 || analysis.ToolFraction >= 1 {
+		t.Errorf("ToolFraction = %.3f, want tool output to dominate", analysis.ToolFraction)
+	}
+	if analysis.ContextTokens != 92000 {
+		t.Errorf("ContextTokens = %d, want 92000 from the last usage", analysis.ContextTokens)
+	}
+
+	if len(analysis.Largest) != compactionLargestShown {
+		t.Fatalf("Largest = %+v", analysis.Largest)
+	}
+	first, second := analysis.Largest[0], analysis.Largest[1]
+	if first.Tool != "Read" || first.Target != "/repo/data/data.json" || first.Bytes != 300*1024 ||
+		second.Tool != "Bash" || second.Target != "go test ./..." {
+		t.Errorf("Largest = %+v", analysis.Largest)
+	}
+
+	at := time.Date(2026, 10, 16, 14, 2, 0, 0, time.UTC).Local().Format("15:04")
+	want := "97% of context is tool output; the largest item is a 300KB read of data.json at " + at + "; consider reading files with offsets"
+	if got := analysis.Advice(); got != want {
+		t.Errorf("Advice =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestAnalyzePreCompactPayloadDrift(t *testing.T) {
+	for _, payload := range []string{"", "null", "[1, 2]", "not json"} {
+		if _, err := AnalyzePreCompactPayload(strings.NewReader(payload)); err == nil {
+			t.Errorf("payload %q: want error", payload)
+		}
+	}
+
+	// Token figures from the payload win; no transcript is not an error
+	analysis, err := AnalyzePreCompactPayload(strings.NewReader(`{"compact_type":"manual","context_tokens":150000,"context_window":200000}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if analysis.Trigger != "manual" || analysis.ContextTokens != 150000 || analysis.ContextWindow != 200000 || analysis.Unavailable == "" {
+		t.Errorf("no transcript: %+v", analysis)
+	}
+	if analysis.Advice() != "" {
+		t.Errorf("advice without figures: %q", analysis.Advice())
+	}
+
+	missing := filepath.Join(t.TempDir(), "gone.jsonl")
+	analysis, err = AnalyzePreCompactPayload(strings.NewReader(`{"transcript_path":"` + missing + `"}`))
+	if err != nil || !strings.Contains(analysis.Unavailable, "unreadable") {
+		t.Errorf("missing transcript: analysis %+v, err %v", analysis, err)
+	}
+}
+
+func TestCompactionAnalysisRowsAndSnapshot(t *testing.T) {
+	cfg := getDefaultDisplayConfig()
+	if rows := compactionAnalysisRows(cfg, nil); rows != nil {
+		t.Errorf("nil analysis: rows = %v", rows)
+	}
+
+	analysis, err := AnalyzePreCompactPayload(strings.NewReader(
+		`{"transcript_path":"` + compactTranscript(t) + `","context_window":200000}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var labels []string
+	for _, row := range compactionAnalysisRows(cfg, analysis) {
+		labels = append(labels, row.Label)
+	}
+	want := []string{"Messages:", "Tool Output:", "Tokens:", "Largest:", "", "", "Advice:"}
+	if strings.Join(labels, "|") != strings.Join(want, "|") {
+		t.Errorf("row labels = %q, want %q", labels, want)
+	}
+
+	dir := t.TempDir()
+	path, err := saveCompactionSnapshot(dir, &CompactionSnapshot{SessionID: "live", Count: 1, Analysis: analysis}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved CompactionSnapshot
+	data, _ := os.ReadFile(path)
+	if err := json.Unmarshal(data, &saved); err != nil || saved.Analysis == nil || saved.Analysis.Advice() != analysis.Advice() {
+		t.Errorf("snapshot context_analysis = %+v (err %v)", saved.Analysis, err)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,500 @@
+// METADATA
+//
+// Compaction Snapshot Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Write the vision, and make it plain upon tables, that he may run that readeth it." - Habakkuk 2:2 (KJV)
+// Principle: What must survive the forgetting is written down before it comes
+// Anchor: "So teach us to number our days, that we may apply our hearts unto wisdom" - Psalm 90:12 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - compaction history and restoration)
+// Role: Records where the session stood before each compaction and hands it back afterwards
+// Paradigm: CPI-SI framework component - pre-compact hook writes, session-start context reads
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.1.0
+// Last Modified: 2026-10-16 - Snapshots carry the context analysis
+//
+// Version History:
+//   1.1.0 (2026-10-16) - "context_analysis" in snapshots (compactanalysis.go), advice line in the section
+//   1.0.0 (2026-10-16) - SaveCompactionSnapshot, pruning, "compaction" context section
+//
+// Purpose & Function
+//
+// Purpose: PrintPreCompactionMessage shows temporal state "for post-compaction
+// reconstitution", but only on the terminal - it's gone once the context is
+// compacted. The pre-compact hook now writes that state to disk and the
+// SessionStart that follows compaction injects it back into the context.
+//
+// Core Design: One snapshot per compaction, compaction-<n>.json in the
+// session data directory (n = the session's compaction count). Each holds
+// the session ID, temporal context, workspace git state, quality indicators,
+// an optional current focus (COMPACTION_FOCUS), and - when the hook could read
+// its payload - the context analysis (what filled the context, and advice). The "compaction" context
+// section reads compaction-<count>.json only when it belongs to the live
+// session. Snapshots beyond compaction.max_snapshots (context.jsonc) are
+// pruned oldest first.
+//
+// Blocking Status
+//
+// Non-blocking: SaveCompactionSnapshot returns an error for the hook to
+// ignore; an unreadable snapshot simply leaves the section empty.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: encoding/json, fmt, os, path/filepath, sort, strconv, strings, time
+//   Internal: system/lib/temporal (temporal context), context.go (sessionData,
+//             getGitContext, contextLogger), lifecycle.go (sessionDataDir,
+//             lifecycleLogger, sessionStartLayout)
+//
+// Dependents (What Uses This):
+//   Commands: session/cmd-pre-compact (SaveCompactionSnapshot)
+//   Libraries: context.go ("compaction" section)
+//
+// Health Scoring
+//
+//   Snapshot written: +10 (logged success)
+//   Snapshot write failure: -10 (logged failure, error returned)
+//   Prune failure: -5 (logged, snapshot still kept)
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"encoding/json" // Snapshot encoding
+	"fmt"           // Snapshot names and section text
+	"os"            // Snapshot files and focus environment variable
+	"path/filepath" // Snapshot paths
+	"sort"          // Prune order (oldest first)
+	"strconv"       // Compaction number from snapshot names
+	"strings"       // Section text joining
+	"time"          // Snapshot timestamp
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/temporal" // Temporal context at compaction
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// compactionSnapshotPrefix names snapshots: compaction-<n>.json
+	compactionSnapshotPrefix = "compaction-"
+
+	// compactionFocusEnv carries free-text "current focus" into the snapshot.
+	compactionFocusEnv = "COMPACTION_FOCUS"
+
+	// defaultMaxCompactionSnapshots is how many snapshots are kept.
+	defaultMaxCompactionSnapshots = 10
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// CompactionConfig controls snapshot retention (context.jsonc "compaction")
+type CompactionConfig struct {
+	MaxSnapshots int `json:"max_snapshots"` // Snapshots kept across sessions (0 = default)
+}
+
+// CompactionSnapshot is the session's state just before a compaction
+type CompactionSnapshot struct {
+	SessionID   string `json:"session_id"`
+	Count       int    `json:"compaction_count"`
+	CompactType string `json:"compact_type"` // "manual", "auto", or "unknown"
+	SavedAt     string `json:"saved_at"`     // RFC3339
+
+	Temporal struct {
+		Time         string `json:"time"` // External time, formatted
+		TimeOfDay    string `json:"time_of_day"`
+		Elapsed      string `json:"session_elapsed,omitempty"`
+		SessionPhase string `json:"session_phase,omitempty"`
+		Activity     string `json:"activity,omitempty"` // Scheduled activity
+		ActivityType string `json:"activity_type,omitempty"`
+	} `json:"temporal"`
+
+	Git *CompactionGit `json:"git,omitempty"` // nil outside a repository
+
+	Quality struct {
+		TasksCompleted int `json:"tasks_completed"`
+		Breakthroughs  int `json:"breakthroughs"`
+		Struggles      int `json:"struggles"`
+	} `json:"quality_indicators"`
+
+	Focus string `json:"current_focus,omitempty"`
+
+	Analysis *CompactionAnalysis `json:"context_analysis,omitempty"` // nil when the payload was unreadable
+}
+
+// CompactionGit is the workspace repository's state at compaction
+type CompactionGit struct {
+	Workspace  string `json:"workspace"`
+	Branch     string `json:"branch"`
+	Operation  string `json:"operation,omitempty"`
+	Modified   int    `json:"modified"`
+	Untracked  int    `json:"untracked"`
+	LastCommit string `json:"last_commit,omitempty"`
+}
+
+// compactionConfig holds snapshot retention (set from context.jsonc in context.go init)
+var compactionConfig CompactionConfig
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs)
+//   └── SaveCompactionSnapshot(type, count, analysis) → saveCompactionSnapshot(sessionDataDir(), ...)
+//
+//   Core Operations (Middle Rungs)
+//   ├── saveCompactionSnapshot(dir, snapshot, limit) → temp file + rename, then pruneCompactionSnapshots
+//   ├── newCompactionSnapshot(type, count) → temporal, getGitContext, sessionData
+//   ├── pruneCompactionSnapshots(dir, keep) → oldest removed first
+//   ├── latestCompactionSnapshot(dir) → compaction-<count>.json for the live session
+//   ├── buildPostCompactionSection() → "compaction" section
+//   └── buildPostCompactionSummary() → focus and time only
+//
+//   Helpers (Bottom Rungs)
+//   ├── compactionSnapshotPath(dir, n)
+//   ├── compactionSnapshotNumber(name) → n from compaction-<n>.json
+//   └── compactionActivity(snapshot) → "Before the last compaction you were: …" text
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Paths and Text
+// ────────────────────────────────────────────────────────────────
+
+// compactionSnapshotPath returns compaction-<n>.json in dir
+func compactionSnapshotPath(dir string, n int) string {
+	return filepath.Join(dir, fmt.Sprintf("%s%d.json", compactionSnapshotPrefix, n))
+}
+
+// compactionSnapshotNumber parses n from compaction-<n>.json (0 = not a snapshot)
+func compactionSnapshotNumber(name string) int {
+	digits, ok := strings.CutPrefix(name, compactionSnapshotPrefix)
+	if !ok {
+		return 0
+	}
+	digits, ok = strings.CutSuffix(digits, ".json")
+	if !ok {
+		return 0
+	}
+	n, err := strconv.Atoi(digits)
+	if err != nil || n < 1 {
+		return 0
+	}
+	return n
+}
+
+// compactionActivity describes what the session was doing at the snapshot
+func compactionActivity(snapshot *CompactionSnapshot) string {
+	var parts []string
+	if snapshot.Focus != "" {
+		parts = append(parts, snapshot.Focus)
+	}
+	if snapshot.Git != nil {
+		work := fmt.Sprintf("working on `%s` in %s", snapshot.Git.Branch, snapshot.Git.Workspace)
+		if snapshot.Git.Operation != "" {
+			work += fmt.Sprintf(" (%s in progress)", snapshot.Git.Operation)
+		}
+		parts = append(parts, work)
+	}
+	if snapshot.Temporal.Activity != "" {
+		parts = append(parts, fmt.Sprintf("during %s (%s)", snapshot.Temporal.Activity, snapshot.Temporal.ActivityType))
+	}
+	if len(parts) == 0 {
+		return fmt.Sprintf("in the %s", snapshot.Temporal.TimeOfDay)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Snapshots
+// ────────────────────────────────────────────────────────────────
+
+// newCompactionSnapshot gathers the live session's state
+func newCompactionSnapshot(compactType string, count int) *CompactionSnapshot {
+	snapshot := &CompactionSnapshot{
+		Count:       count,
+		CompactType: compactType,
+		SavedAt:     now().Format(time.RFC3339),
+		Focus:       strings.TrimSpace(os.Getenv(compactionFocusEnv)),
+	}
+
+	if ctx, err := freshTemporalContext(); err == nil {
+		snapshot.Temporal.Time = ctx.ExternalTime.Formatted
+		snapshot.Temporal.TimeOfDay = ctx.ExternalTime.TimeOfDay
+		snapshot.Temporal.Elapsed = ctx.InternalTime.ElapsedFormatted
+		snapshot.Temporal.SessionPhase = ctx.InternalTime.SessionPhase
+		snapshot.Temporal.Activity = ctx.InternalSchedule.CurrentActivity
+		snapshot.Temporal.ActivityType = ctx.InternalSchedule.ActivityType
+	} else {
+		snapshot.Temporal.Time = now().Format(sessionStartLayout)
+		snapshot.Temporal.TimeOfDay = temporal.GetExternalTime().TimeOfDay
+	}
+
+	workspace := os.Getenv("NOVA_DAWN_WORKSPACE")
+	if sessionData != nil {
+		snapshot.SessionID = sessionData.SessionID
+		snapshot.Quality.TasksCompleted = sessionData.QualityIndicators.TasksCompleted
+		snapshot.Quality.Breakthroughs = sessionData.QualityIndicators.Breakthroughs
+		snapshot.Quality.Struggles = sessionData.QualityIndicators.Struggles
+		if sessionData.WorkContext != "" {
+			workspace = sessionData.WorkContext
+		}
+	}
+
+	if git := getGitContext(workspace); git != nil && git.Branch != "" {
+		snapshot.Git = &CompactionGit{
+			Workspace:  workspace,
+			Branch:     git.Branch,
+			Operation:  git.OperationInProgress,
+			Modified:   git.UncommittedCount,
+			Untracked:  git.UntrackedCount,
+			LastCommit: git.LastCommitMessage,
+		}
+		if git.DetachedHEAD {
+			snapshot.Git.Branch = git.HeadCommit
+		}
+	}
+
+	return snapshot
+}
+
+// saveCompactionSnapshot writes snapshot to dir and prunes beyond keep
+func saveCompactionSnapshot(dir string, snapshot *CompactionSnapshot, keep int) (string, error) {
+	if snapshot.Count < 1 {
+		return "", fmt.Errorf("compaction count unknown (%d)", snapshot.Count)
+	}
+	output, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	path := compactionSnapshotPath(dir, snapshot.Count)
+	temp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(temp.Name()) // No-op after a successful rename
+
+	if _, err := temp.Write(append(output, '\n')); err != nil {
+		temp.Close()
+		return "", err
+	}
+	if err := temp.Close(); err != nil {
+		return "", err
+	}
+	if err := os.Rename(temp.Name(), path); err != nil {
+		return "", err
+	}
+
+	if err := pruneCompactionSnapshots(dir, keep); err != nil {
+		lifecycleLogger.Failure("compaction-prune", err.Error(), -5, map[string]any{"dir": dir})
+	}
+	return path, nil
+}
+
+// pruneCompactionSnapshots removes all but the keep most recently written snapshots
+//
+// Ordered by modification time - compaction numbers restart every session,
+// so the highest number isn't necessarily the newest.
+func pruneCompactionSnapshots(dir string, keep int) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	type snapshotFile struct {
+		path     string
+		modified time.Time
+	}
+	var files []snapshotFile
+	for _, entry := range entries {
+		if entry.IsDir() || compactionSnapshotNumber(entry.Name()) == 0 {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, snapshotFile{filepath.Join(dir, entry.Name()), info.ModTime()})
+	}
+	if len(files) <= keep {
+		return nil
+	}
+
+	sort.Slice(files, func(a, b int) bool { return files[a].modified.Before(files[b].modified) })
+	for _, file := range files[:len(files)-keep] {
+		if err := os.Remove(file.path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// latestCompactionSnapshot reads the live session's most recent snapshot
+//
+// nil when the session hasn't compacted or the snapshot belongs to another
+// session (numbers restart every session).
+func latestCompactionSnapshot(dir string) *CompactionSnapshot {
+	if sessionData == nil || sessionData.CompactionCount < 1 {
+		return nil
+	}
+
+	data, err := os.ReadFile(compactionSnapshotPath(dir, sessionData.CompactionCount))
+	if err != nil {
+		return nil
+	}
+	var snapshot CompactionSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		contextLogger.Failure("compaction-snapshot-unreadable", err.Error(), -5, map[string]any{
+			"count": sessionData.CompactionCount,
+		})
+		return nil
+	}
+	if snapshot.SessionID != sessionData.SessionID {
+		return nil
+	}
+	return &snapshot
+}
+
+// buildPostCompactionSection restores the state saved before the last compaction
+//
+// Empty until the live session has compacted at least once.
+func buildPostCompactionSection() string {
+	snapshot := latestCompactionSnapshot(sessionDataDir())
+	if snapshot == nil {
+		return ""
+	}
+
+	section := "## Before Compaction\n\n"
+	section += fmt.Sprintf("Before the last compaction you were: %s.\n\n", compactionActivity(snapshot))
+	section += fmt.Sprintf("**Compacted:** %s (%s, #%d this session)\n", snapshot.Temporal.Time, snapshot.CompactType, snapshot.Count)
+	if snapshot.Temporal.Elapsed != "" {
+		section += fmt.Sprintf("**Session:** %s elapsed (%s phase)\n", snapshot.Temporal.Elapsed, snapshot.Temporal.SessionPhase)
+	}
+	if snapshot.Git != nil {
+		section += fmt.Sprintf("**Working Tree:** %d modified, %d untracked\n", snapshot.Git.Modified, snapshot.Git.Untracked)
+		if snapshot.Git.LastCommit != "" {
+			section += fmt.Sprintf("**Last Commit:** %s\n", snapshot.Git.LastCommit)
+		}
+	}
+	quality := snapshot.Quality
+	if quality.TasksCompleted > 0 || quality.Breakthroughs > 0 || quality.Struggles > 0 {
+		section += fmt.Sprintf("**Quality:** Tasks: %d | Breakthroughs: %d | Struggles: %d\n",
+			quality.TasksCompleted, quality.Breakthroughs, quality.Struggles)
+	}
+	if advice := snapshot.Analysis.Advice(); advice != "" {
+		section += fmt.Sprintf("**Context Use:** %s\n", advice)
+	}
+
+	section += "\n"
+	return section
+}
+
+// buildPostCompactionSummary keeps only what the session was doing
+func buildPostCompactionSummary() string {
+	snapshot := latestCompactionSnapshot(sessionDataDir())
+	if snapshot == nil {
+		return ""
+	}
+	return fmt.Sprintf("## Before Compaction\n\nBefore the last compaction you were: %s.\n\n", compactionActivity(snapshot))
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// SaveCompactionSnapshot records the session's state before a compaction
+//
+// What It Does:
+//   - Writes compaction-<count>.json to the session data directory: temporal
+//     context, workspace git state, quality indicators, and the free-text
+//     focus from COMPACTION_FOCUS (if set), and the context analysis (if any)
+//   - Prunes snapshots beyond compaction.max_snapshots (context.jsonc)
+//
+// Parameters:
+//   compactType: "manual", "auto", or "unknown"
+//   count: This compaction's number in the session (from IncrementCompactionCount)
+//   analysis: From AnalyzePreCompactPayload (nil = none)
+//
+// Returns:
+//   error: Unknown count (< 1) or write failure - compaction proceeds regardless
+//
+// Example:
+//   count, _ := session.IncrementCompactionCount()
+//   analysis, _ := session.AnalyzePreCompactPayload(os.Stdin)
+//   session.SaveCompactionSnapshot(compactType, count, analysis)
+func SaveCompactionSnapshot(compactType string, count int, analysis *CompactionAnalysis) error {
+	keep := compactionConfig.MaxSnapshots
+	if keep <= 0 {
+		keep = defaultMaxCompactionSnapshots
+	}
+
+	snapshot := newCompactionSnapshot(compactType, count)
+	snapshot.Analysis = analysis
+	path, err := saveCompactionSnapshot(sessionDataDir(), snapshot, keep)
+	if err != nil {
+		lifecycleLogger.Failure("compaction-snapshot", err.Error(), -10, map[string]any{"count": count})
+		return err
+	}
+	lifecycleLogger.Success("compaction-snapshot", 10, map[string]any{"count": count, "path": path})
+	return nil
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Save: compaction-<n>.json written atomically, oldest beyond the limit removed
+//   - Restore: section renders only for the live session's latest snapshot
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by session hooks
+//
+// Code Cleanup: Temp file removed if the rename never happens
+//
+// Modification Policy:
+//   ✅ Safe: New snapshot fields (older snapshots read with zero values)
+//   ⚠️ Care: Snapshot naming (pruning and restoration match compaction-<n>.json)
+//   ❌ Never: Restoring a snapshot from another session
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
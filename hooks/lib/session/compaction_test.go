@@ -0,0 +1,74 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Compaction Snapshot Tests
+//
+// Purpose: Prove snapshots are written per compaction, pruned oldest first,
+//          and restored only for the live session's latest compaction.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestCompactionSnapshots(t *testing.T) {
+	saved := sessionData
+	defer func() { sessionData = saved }()
+
+	dir := t.TempDir()
+	sessionData = &SessionData{SessionID: "live"}
+
+	// A previous session's snapshot, written long ago
+	old := compactionSnapshotPath(dir, 7)
+	os.WriteFile(old, []byte(`{"session_id": "previous", "compaction_count": 7}`), 0644)
+	os.Chtimes(old, time.Now().Add(-time.Hour), time.Now().Add(-time.Hour))
+
+	for count := 1; count <= 3; count++ {
+		snapshot := &CompactionSnapshot{SessionID: "live", Count: count, CompactType: "auto", Focus: "wiring the pre-compact hook"}
+		snapshot.Git = &CompactionGit{Workspace: "/work/project", Branch: "main", Operation: "rebase"}
+		if _, err := saveCompactionSnapshot(dir, snapshot, 3); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := saveCompactionSnapshot(dir, &CompactionSnapshot{Count: -1}, 3); err == nil {
+		t.Error("unknown count: want error")
+	}
+
+	matches, _ := filepath.Glob(filepath.Join(dir, compactionSnapshotPrefix+"*"))
+	if len(matches) != 3 {
+		t.Errorf("snapshots = %v, want 3 kept", matches)
+	}
+	if _, err := os.Stat(old); !os.IsNotExist(err) {
+		t.Error("oldest snapshot not pruned")
+	}
+
+	if snapshot := latestCompactionSnapshot(dir); snapshot != nil {
+		t.Errorf("no compactions yet: got snapshot %+v", snapshot)
+	}
+	sessionData.CompactionCount = 3
+	snapshot := latestCompactionSnapshot(dir)
+	if snapshot == nil || snapshot.Count != 3 {
+		t.Fatalf("latest = %+v, want compaction 3", snapshot)
+	}
+	if got := compactionActivity(snapshot); got != "wiring the pre-compact hook, working on `main` in /work/project (rebase in progress)" {
+		t.Errorf("activity = %q", got)
+	}
+
+	sessionData.SessionID = "next" // Numbers restart every session
+	if snapshot := latestCompactionSnapshot(dir); snapshot != nil {
+		t.Errorf("another session's snapshot restored: %+v", snapshot)
+	}
+}
@@ -0,0 +1,338 @@
+// METADATA
+//
+// Config Mapping Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Thou shalt not have in thy bag divers weights, a great and a small." - Deuteronomy 25:13 (KJV)
+// Principle: One measure for every field - the same rule maps user and instance alike
+// Anchor: "Let all things be done decently and in order." - 1 Corinthians 14:40 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - identity config mapping)
+// Role: Turns the instance library's full configs into the session context's
+//       UserConfig/InstanceConfig, or into tripwire sentinels when loading failed
+// Paradigm: CPI-SI framework component - feeds every identity-driven context section
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Replaces field-by-field copying in context.go init
+//
+// Version History:
+//   1.0.0 (2026-10-16) - remapConfig by JSON field name, table-driven tripwires
+//
+// Purpose & Function
+//
+// Purpose: A new config field used to mean four hand edits (user mapping,
+// instance mapping, two fallback literals) - and a field mapped for one and
+// forgotten for the other went unnoticed. Now a field reaches the session
+// context once it exists on both sides under the same JSON name.
+//
+// Core Design: remapConfig walks the destination struct and copies each field
+// from the source field with the same JSON name (nested structs recurse,
+// Social.Other's map[string]any becomes map[string]string). tripwireConfig
+// fills any struct with sentinels - strings "UNKNOWN", string lists
+// ["FALLBACK"], string maps {"fallback": "CONFIG_NOT_LOADED"} - then applies
+// a per-config override table keyed by JSON path (nil = leave zero).
+//
+// Blocking Status
+//
+// Non-blocking: A mapping error (type drift between the libraries) is logged
+// and that config falls back to its tripwires.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: fmt, reflect, strings
+//   Internal: system/lib/instance (full config types), context.go (UserConfig,
+//             InstanceConfig, contextLogger)
+//
+// Dependents (What Uses This):
+//   Libraries: context.go (init)
+//
+// Health Scoring
+//
+// Mapping failure: -10 through contextLogger (config treated as not loaded).
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"fmt"     // Mapping errors and non-string map values
+	"reflect" // Field-by-name mapping and sentinel filling
+	"strings" // JSON tag parsing
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/instance" // FullUserConfig / FullInstanceConfig sources
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// tripwireUnknown fills every string field of a config that failed to load.
+	tripwireUnknown = "UNKNOWN"
+
+	// tripwireFallback is the single entry of every string list.
+	tripwireFallback = "FALLBACK"
+
+	// tripwireNotLoaded marks names and notes - the loudest sentinel.
+	tripwireNotLoaded = "CONFIG_NOT_LOADED"
+
+	// tripwireFailed describes free-text fields.
+	tripwireFailed = "FALLBACK - Config loading failed"
+)
+
+// userTripwires overrides the generic sentinels for UserConfig (JSON paths, nil = zero)
+var userTripwires = map[string]any{
+	"identity.name":                   tripwireNotLoaded,
+	"identity.display_name":           tripwireFallback,
+	"identity.age":                    -1,
+	"identity.username":               nil,
+	"identity.birthday":               nil,
+	"identity.created":                nil,
+	"identity.version":                nil,
+	"bio.short":                       tripwireFailed,
+	"demographics.physical_presence":  nil, // Instance only
+	"faith.communication_preferences": tripwireFailed,
+	"privacy.sensitive_fields":        nil, // Nothing to redact in sentinels
+	"metadata.system_reference":       tripwireFallback,
+	"metadata.notes":                  tripwireNotLoaded,
+}
+
+// instanceTripwires overrides the generic sentinels for InstanceConfig (JSON paths, nil = zero)
+var instanceTripwires = map[string]any{
+	"biblical_foundation.text":         "CONFIG NOT LOADED",
+	"biblical_foundation.principle":    tripwireFallback,
+	"identity.name":                    tripwireNotLoaded,
+	"identity.mental_age":              -1,
+	"identity.username":                nil,
+	"identity.display_name":            nil,
+	"identity.birthday":                nil,
+	"identity.created":                 nil,
+	"identity.version":                 nil,
+	"bio.short":                        tripwireFailed,
+	"demographics.physical_appearance": nil, // User only
+	"covenant.works_with":              []string{tripwireUnknown},
+	"metadata.system_reference":        tripwireFallback,
+	"metadata.notes":                   tripwireNotLoaded,
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Core Operations (Middle Rungs) - 2 functions
+//   ├── mapUserConfig(full) → uses remapConfig, tripwireConfig(userTripwires)
+//   └── mapInstanceConfig(full) → uses remapConfig, tripwireConfig(instanceTripwires)
+//
+//   Helpers (Bottom Rungs) - 5 functions
+//   ├── jsonFieldName(field) → pure function
+//   ├── remapConfig(src, dst) → uses remapValue
+//   ├── remapValue(dst, src, path) → uses jsonFieldName, convertMapToStringString
+//   ├── tripwireConfig(dst, overrides) → uses fillTripwires
+//   └── fillTripwires(v, path, overrides) → uses jsonFieldName
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Field Mapping
+// ────────────────────────────────────────────────────────────────
+
+// jsonFieldName is a field's JSON name ("" = not serialized)
+func jsonFieldName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	}
+	return name
+}
+
+// remapConfig copies src into dst field by JSON name (dst must be a pointer)
+//
+// Fields dst lacks are dropped; fields src lacks stay zero. Slices and maps
+// are shared, not copied - same as assigning them by hand.
+func remapConfig(src, dst any) error {
+	target := reflect.ValueOf(dst)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return fmt.Errorf("remapConfig: destination must be a non-nil pointer, got %T", dst)
+	}
+	source := reflect.Indirect(reflect.ValueOf(src))
+	return remapValue(target.Elem(), source, "")
+}
+
+// remapValue maps one value (structs by field name, everything else by type)
+func remapValue(dst, src reflect.Value, path string) error {
+	switch {
+	case src.Type().AssignableTo(dst.Type()):
+		dst.Set(src)
+
+	case dst.Kind() == reflect.Struct && src.Kind() == reflect.Struct:
+		sources := make(map[string]reflect.Value, src.NumField())
+		for i := 0; i < src.NumField(); i++ {
+			if name := jsonFieldName(src.Type().Field(i)); name != "" {
+				sources[name] = src.Field(i)
+			}
+		}
+		for i := 0; i < dst.NumField(); i++ {
+			name := jsonFieldName(dst.Type().Field(i))
+			from, ok := sources[name]
+			if name == "" || !ok {
+				continue
+			}
+			fieldPath := name
+			if path != "" {
+				fieldPath = path + "." + name
+			}
+			if err := remapValue(dst.Field(i), from, fieldPath); err != nil {
+				return err
+			}
+		}
+
+	case src.Type() == reflect.TypeOf(map[string]any(nil)) && dst.Type() == reflect.TypeOf(map[string]string(nil)):
+		dst.Set(reflect.ValueOf(convertMapToStringString(src.Interface().(map[string]any))))
+
+	case src.Kind() == dst.Kind() && src.Type().ConvertibleTo(dst.Type()):
+		dst.Set(src.Convert(dst.Type()))
+
+	default:
+		return fmt.Errorf("%s: cannot map %s to %s", path, src.Type(), dst.Type())
+	}
+	return nil
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Tripwires
+// ────────────────────────────────────────────────────────────────
+
+// tripwireConfig fills dst (a pointer to a struct) with sentinel values
+func tripwireConfig(dst any, overrides map[string]any) {
+	fillTripwires(reflect.ValueOf(dst).Elem(), "", overrides)
+}
+
+// fillTripwires sets sentinels by kind, overrides by JSON path first
+func fillTripwires(v reflect.Value, path string, overrides map[string]any) {
+	if override, ok := overrides[path]; ok {
+		if override == nil {
+			v.Set(reflect.Zero(v.Type()))
+		} else {
+			v.Set(reflect.ValueOf(override).Convert(v.Type()))
+		}
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			name := jsonFieldName(v.Type().Field(i))
+			if name == "" {
+				continue
+			}
+			fieldPath := name
+			if path != "" {
+				fieldPath = path + "." + name
+			}
+			fillTripwires(v.Field(i), fieldPath, overrides)
+		}
+	case reflect.String:
+		v.SetString(tripwireUnknown)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.String {
+			v.Set(reflect.ValueOf([]string{tripwireFallback}).Convert(v.Type()))
+		}
+	case reflect.Map:
+		if v.Type() == reflect.TypeOf(map[string]string(nil)) {
+			v.Set(reflect.ValueOf(map[string]string{"fallback": tripwireNotLoaded}))
+		}
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Config Mapping
+// ────────────────────────────────────────────────────────────────
+
+// mapUserConfig maps the loaded user config (loaded=false: nil or unmappable → tripwires)
+func mapUserConfig(full *instance.FullUserConfig) (cfg *UserConfig, loaded bool) {
+	cfg = &UserConfig{}
+	if full != nil {
+		err := remapConfig(full, cfg)
+		if err == nil {
+			return cfg, true
+		}
+		contextLogger.Failure("user-config-mapping", err.Error(), -10, nil)
+		cfg = &UserConfig{}
+	}
+	tripwireConfig(cfg, userTripwires)
+	return cfg, false
+}
+
+// mapInstanceConfig maps the loaded instance config (loaded=false: nil or unmappable → tripwires)
+func mapInstanceConfig(full *instance.FullInstanceConfig) (cfg *InstanceConfig, loaded bool) {
+	cfg = &InstanceConfig{}
+	if full != nil {
+		err := remapConfig(full, cfg)
+		if err == nil {
+			return cfg, true
+		}
+		contextLogger.Failure("instance-config-mapping", err.Error(), -10, nil)
+		cfg = &InstanceConfig{}
+	}
+	tripwireConfig(cfg, instanceTripwires)
+	return cfg, false
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Every instance library field reaches UserConfig/InstanceConfig (test walks both types)
+//   - Tripwires match the original fallback literals field for field
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called from context.go init
+//
+// Code Cleanup: None - pure mapping
+//
+// Modification Policy:
+//   ✅ Safe: New config fields (same JSON name on both sides), new override entries
+//   ⚠️ Care: Renaming a JSON tag on one side only - the field silently stops mapping
+//            (the coverage test catches it)
+//   ❌ Never: Field-by-field copies in init - that is what this file replaced
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,135 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Config Mapping Tests
+//
+// Purpose: Prove every exported field of the instance library's full configs
+//          reaches UserConfig/InstanceConfig (so a new field can't be silently
+//          dropped), and that failed loads produce the documented tripwires.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"system/lib/instance"
+)
+
+// fillDistinct sets every serialized leaf of v to a value derived from its JSON path
+func fillDistinct(v reflect.Value, path string) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if name := jsonFieldName(v.Type().Field(i)); name != "" {
+				fillDistinct(v.Field(i), strings.TrimPrefix(path+"."+name, "."))
+			}
+		}
+	case reflect.String:
+		v.SetString(path)
+	case reflect.Int:
+		v.SetInt(int64(len(path)))
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Struct {
+			v.Set(reflect.ValueOf([]string{path}))
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fillDistinct(v.Index(0), path)
+	case reflect.Map:
+		m := reflect.MakeMap(v.Type())
+		m.SetMapIndex(reflect.ValueOf("key"), reflect.ValueOf(path).Convert(v.Type().Elem()))
+		v.Set(m)
+	}
+}
+
+// leafValues lists every serialized leaf of v by JSON path, rendered with %v
+func leafValues(v reflect.Value, path string, into map[string]string) {
+	if v.Kind() != reflect.Struct {
+		into[path] = fmt.Sprintf("%v", v.Interface())
+		return
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if name := jsonFieldName(v.Type().Field(i)); name != "" {
+			leafValues(v.Field(i), strings.TrimPrefix(path+"."+name, "."), into)
+		}
+	}
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestConfigMappingCoversSourceFields(t *testing.T) {
+	var fullUser instance.FullUserConfig
+	var fullInstance instance.FullInstanceConfig
+	fillDistinct(reflect.ValueOf(&fullUser).Elem(), "")
+	fillDistinct(reflect.ValueOf(&fullInstance).Elem(), "")
+
+	user, userLoaded := mapUserConfig(&fullUser)
+	inst, instanceLoaded := mapInstanceConfig(&fullInstance)
+	if !userLoaded || !instanceLoaded {
+		t.Fatalf("loaded = %v/%v, want true/true", userLoaded, instanceLoaded)
+	}
+
+	for _, pair := range []struct {
+		name     string
+		src, dst any
+	}{
+		{"user", fullUser, *user},
+		{"instance", fullInstance, *inst},
+	} {
+		want, got := map[string]string{}, map[string]string{}
+		leafValues(reflect.ValueOf(pair.src), "", want)
+		leafValues(reflect.ValueOf(pair.dst), "", got)
+		for path, value := range want {
+			if mapped, ok := got[path]; !ok {
+				t.Errorf("%s config field %s has no place in the session config", pair.name, path)
+			} else if mapped != value {
+				t.Errorf("%s config field %s = %q, want %q", pair.name, path, mapped, value)
+			}
+		}
+	}
+}
+
+func TestConfigTripwires(t *testing.T) {
+	user, loaded := mapUserConfig(nil)
+	if loaded {
+		t.Error("nil user config reported as loaded")
+	}
+	if user.Identity.Name != "CONFIG_NOT_LOADED" || user.Identity.DisplayName != "FALLBACK" || user.Identity.Age != -1 ||
+		user.Identity.Username != "" || user.Faith.Tradition != "UNKNOWN" || user.Faith.IsReligious ||
+		user.Faith.CommPreferences != "FALLBACK - Config loading failed" ||
+		!reflect.DeepEqual(user.Personhood.Values, []string{"FALLBACK"}) ||
+		!reflect.DeepEqual(user.Contact.Social.Other, map[string]string{"fallback": "CONFIG_NOT_LOADED"}) ||
+		user.Demographics.PhysicalPresence != (PhysicalPresence{}) || user.Demographics.PhysicalAppearance.Height != "UNKNOWN" {
+		t.Errorf("user tripwires = %+v", user)
+	}
+
+	inst, loaded := mapInstanceConfig(nil)
+	if loaded {
+		t.Error("nil instance config reported as loaded")
+	}
+	if inst.Identity.Name != "CONFIG_NOT_LOADED" || inst.Identity.MentalAge != -1 || inst.Identity.Age != 0 ||
+		inst.Identity.DisplayName != "" || inst.BiblicalFoundation.Text != "CONFIG NOT LOADED" ||
+		!reflect.DeepEqual(inst.Covenant.WorksWith, []string{"UNKNOWN"}) ||
+		inst.Demographics.PhysicalAppearance != (PhysicalAppearance{}) || inst.Demographics.PhysicalPresence.Build != "UNKNOWN" ||
+		inst.Metadata.SystemReference != "FALLBACK" || inst.Metadata.Notes != "CONFIG_NOT_LOADED" {
+		t.Errorf("instance tripwires = %+v", inst)
+	}
+
+	// Each call builds fresh sentinels - no shared slices between configs
+	user.Personhood.Values[0] = "changed"
+	if again, _ := mapUserConfig(nil); again.Personhood.Values[0] != "FALLBACK" {
+		t.Error("tripwire slices shared between calls")
+	}
+}
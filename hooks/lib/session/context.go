@@ -21,10 +21,26 @@
 // Architect: Nova Dawn
 // Implementation: Nova Dawn
 // Creation Date: 2024-10-24
-// Version: 2.1.0
-// Last Modified: 2025-11-16 - Integrated instance library for user/instance config (dynamic paths)
+// Version: 2.17.0
+// Last Modified: 2026-10-16 - SessionData subagent_failures counter
 //
 // Version History:
+//   2.17.0 (2026-10-16) - SessionData.SubagentFailures (counted by RecordSubagentStop, subagents.go)
+//   2.16.0 (2026-10-16) - Header notes partial grounding when setup guidance was shown (setup.go)
+//   2.15.0 (2026-10-16) - User awareness/communication fields and budget weights from context_emphasis (emphasis.go), working_agreements
+//   2.14.0 (2026-10-16) - renderTemporalSection lists milestones inside their lead time (instance.GetMilestones)
+//   2.13.0 (2026-10-16) - identity/user/communication/temporal/session/work render from SessionContext (contextdata.go)
+//   2.12.0 (2026-10-16) - buildTemporalSection lists events.jsonc events inside their lead time
+//   2.11.0 (2026-10-16) - getGitContext/currentTemporalContext read what session start gathered (gather.go)
+//   2.10.0 (2026-10-16) - SessionData.Journey holds phase transition points (journey.go)
+//   2.9.0 (2026-10-16) - "recent_sessions" recaps the last ended sessions (history.go)
+//   2.8.0 (2026-10-16) - context.d drop-in sections placed among the configured sections (dropins.go)
+//   2.7.0 (2026-10-16) - temporalContextSource - tests render temporal sections from a synthetic context
+//   2.6.0 (2026-10-16) - User/instance configs mapped by JSON name, table-driven tripwires (configmap.go)
+//   2.5.0 (2026-10-16) - "compaction" section restores the pre-compaction snapshot (compaction.go)
+//   2.4.0 (2026-10-16) - Git context via system/lib/git with failures logged
+//   2.3.0 (2026-10-16) - Git context: detached HEAD, operation in progress, stashes, ahead/behind, timeouts
+//   2.2.0 (2026-10-16) - context_sections order, custom:<path> sections, max_context_chars budget
 //   2.1.0 (2025-11-16) - Integrated instance library for user/instance config (dynamic paths)
 //   2.0.0 (2025-11-12) - Comprehensive redesign: user/instance config loading, session/git context
 //   1.0.0 (2024-10-24) - Initial implementation with hardcoded communication guide
@@ -75,14 +91,18 @@
 //
 //   Context Generation:
 //     OutputClaudeContext() error - Generate and output complete session context JSON
+//     GetSessionContext() string - The same context as markdown
+//     BuildContextStruct() / WriteContextJSON(path) - Structured form (contextdata.go)
 //
 // Dependencies
 //
 // Dependencies (What This Needs):
 //   Standard Library: encoding/json (config/session parsing), fmt (output),
-//                     os (file operations, env vars), os/exec (git commands),
+//                     os (file operations, env vars), errors (git error classes),
 //                     path/filepath (path handling), strings (string manipulation)
-//   Internal: system/lib/instance (user and instance config with dynamic paths),
+//   Internal: system/lib/git (workspace git state),
+//             system/lib/instance (user and instance config with dynamic paths),
+//             system/lib/jsonc (context section config), system/lib/logging (section warnings),
 //             system/lib/temporal (temporal awareness context)
 //
 // Dependents (What Uses This):
@@ -92,9 +112,13 @@
 // Integration Points:
 //   - Gets user config from system/lib/instance (uses dynamic system_paths)
 //   - Gets instance config from system/lib/instance (uses dynamic system_paths)
+//   - Maps both into UserConfig/InstanceConfig via configmap.go (tripwires on failure)
 //   - Reads session data from ~/.claude/cpi-si/system/data/session/current.json
+//   - Reads section order from ~/.claude/cpi-si/system/data/config/session/context.jsonc
+//     (optional; "custom:<path>" entries inline markdown files)
+//   - Adds drop-in sections from system_paths.context_dropins (context.d/*.md, dropins.go)
 //   - Gets temporal context from system/lib/temporal
-//   - Executes git commands in workspace for branch/status info
+//   - Queries workspace git state via system/lib/git (failures logged, not fatal)
 //   - Outputs JSON to stdout for Claude Code hook parsing
 //
 // Health Scoring
@@ -108,6 +132,13 @@
 //   - Git context retrieved: +10
 //   - Any loading failure: -5 (falls back, continues)
 //
+// Section Selection:
+//   - Context summarized to fit budget: 0 (recorded with the summarized sections)
+//   - Unknown context_sections identifier: -5 (skipped)
+//   - Unreadable custom:<path> file: -5 (skipped)
+//   - Unreadable context.d drop-in file: -5 (skipped)
+//   - Unknown context_emphasis field or weight: -5 (skipped, emphasis.go)
+//
 // Context Generation:
 //   - Complete context built: +30
 //   - Partial context (some sections missing): +20
@@ -135,18 +166,55 @@ package session
 // ────────────────────────────────────────────────────────────────
 import (
 	//--- Standard Library ---
-	"encoding/json" // Parse user/instance configs and session data, encode output JSON
+	"encoding/json" // Parse user/instance configs and session data
+	"errors"        // Classify git library errors (not a repository, no upstream)
 	"fmt"           // Formatted output for context generation and error messages
 	"os"            // File operations for config loading, environment variables
-	"os/exec"       // Execute git commands for workspace context
 	"path/filepath" // Join paths for config file locations
-	"strings"       // String manipulation for JSONC parsing and git output
+	"sort"          // Deterministic degradation order under the context budget
+	"strings"       // String manipulation for JSONC parsing
+	"time"          // Git command timeout
+	"unicode/utf8"  // Context size measured in characters, not bytes
 
 	//--- Internal Packages ---
+	"system/lib/git"      // Workspace git state (branch, status, upstream, operations)
 	"system/lib/instance" // Instance and user configuration (dynamic loading)
+	"system/lib/jsonc"    // Context section configuration (JSONC)
+	"system/lib/logging"  // Warnings for unknown/unreadable sections and git failures
 	"system/lib/temporal" // Temporal awareness (time, schedule, circadian phase)
 )
 
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// contextConfigPath lists which context sections appear, in order.
+	// Missing file or empty list = defaultContextSections.
+	contextConfigPath = "~/.claude/cpi-si/system/data/config/session/context.jsonc"
+
+	// customSectionPrefix marks a section that inlines a markdown file ("custom:~/notes/focus.md").
+	customSectionPrefix = "custom:"
+
+	// charsPerToken converts max_context_tokens to a character budget (rough estimate).
+	charsPerToken = 4
+
+	// customSectionPriority ranks custom sections below every built-in section -
+	// they degrade first when the context is over budget.
+	customSectionPriority = 10
+
+	// defaultGitTimeoutSeconds bounds each git query so a hung git (credential
+	// prompt, slow filesystem) can't stall session start.
+	defaultGitTimeoutSeconds = 2
+)
+
+// defaultContextSections is the built-in grounding order. "journals" renders
+// nothing until system_paths.journals holds entries; "patterns" says it is
+// still learning until enough sessions are recorded; "compaction" renders
+// only after the live session has compacted; "recent_sessions" renders nothing
+// until a session has ended.
+var defaultContextSections = []string{"identity", "user", "communication", "temporal", "compaction", "session", "recent_sessions", "patterns", "work", "journals"}
+
 // ────────────────────────────────────────────────────────────────
 // Types - Data Structures
 // ────────────────────────────────────────────────────────────────
@@ -162,6 +230,8 @@ type Identity struct {
 	Birthday    string `json:"birthday,omitempty"`
 	Age         int    `json:"age"`
 	MentalAge   int    `json:"mental_age,omitempty"` // Instance only
+	Created     string `json:"created,omitempty"`    // When the profile was created
+	Version     string `json:"version,omitempty"`    // Profile version
 }
 
 // Faith represents religious/spiritual identity
@@ -319,23 +389,33 @@ type Metadata struct {
 	Notes           string `json:"notes"`             // Additional notes
 }
 
+// UserPrivacy lists user config fields kept out of shared copies
+type UserPrivacy struct {
+	SensitiveFields []string `json:"sensitive_fields"` // Dotted JSON paths ("contact.email"; "faith" = whole section)
+}
+
 //--- Composed Types ---
 
 // UserConfig holds user identity and configuration data
 type UserConfig struct {
-	Identity     Identity     `json:"identity"`
-	Bio          Bio          `json:"bio"`
-	Demographics Demographics `json:"demographics"`
-	Faith        Faith        `json:"faith"`
-	Personhood   Personhood   `json:"personhood"`
-	Resonates    Resonates    `json:"resonates"`
-	Thinking     Thinking     `json:"thinking"`
-	Personality  Personality  `json:"personality"`
-	Contact      Contact      `json:"contact"`
-	Workspace    Workspace    `json:"workspace"`
-	Preferences  Preferences  `json:"preferences"`
-	Growth       Growth       `json:"growth"`
-	Metadata     Metadata     `json:"metadata"`
+	SchemaVersion int          `json:"schema_version"` // Config schema (system/lib/instance migration)
+	Identity      Identity     `json:"identity"`
+	Bio           Bio          `json:"bio"`
+	Demographics  Demographics `json:"demographics"`
+	Faith         Faith        `json:"faith"`
+	Personhood    Personhood   `json:"personhood"`
+	Resonates     Resonates    `json:"resonates"`
+	Thinking      Thinking     `json:"thinking"`
+	Personality   Personality  `json:"personality"`
+	Contact       Contact      `json:"contact"`
+	Workspace     Workspace    `json:"workspace"`
+	Preferences   Preferences  `json:"preferences"`
+	Growth        Growth       `json:"growth"`
+	Privacy       UserPrivacy  `json:"privacy"` // Fields kept out of session-context.json (contextdata.go)
+	Milestones    instance.MilestonesConfig `json:"milestones"` // Birthday lead time and anniversaries (rendered via instance.GetMilestones)
+	WorkingAgreements []string `json:"working_agreements"` // Shown verbatim in user awareness (emphasis.go)
+	ContextEmphasis instance.ContextEmphasisConfig `json:"context_emphasis"` // Section fields and weights - wins over the instance config's (emphasis.go)
+	Metadata      Metadata     `json:"metadata"`
 }
 
 // BiblicalFoundation represents scriptural grounding
@@ -355,6 +435,7 @@ type Covenant struct {
 
 // InstanceConfig holds instance identity and configuration data
 type InstanceConfig struct {
+	SchemaVersion      int                `json:"schema_version"` // Config schema (system/lib/instance migration)
 	BiblicalFoundation BiblicalFoundation `json:"biblical_foundation"`
 	Identity           Identity           `json:"identity"`
 	Bio                Bio                `json:"bio"`
@@ -368,6 +449,8 @@ type InstanceConfig struct {
 	Covenant           Covenant           `json:"covenant"`
 	Preferences        Preferences        `json:"preferences"`
 	Growth             Growth             `json:"growth"`
+	Milestones         instance.MilestonesConfig `json:"milestones"` // Creation day lead time and anniversaries (rendered via instance.GetMilestones)
+	ContextEmphasis    instance.ContextEmphasisConfig `json:"context_emphasis"` // Section fields and weights (emphasis.go)
 	Metadata           Metadata           `json:"metadata"`
 }
 
@@ -387,25 +470,62 @@ type SessionData struct {
 		Breakthroughs  int `json:"breakthroughs"`
 		Struggles      int `json:"struggles"`
 	} `json:"quality_indicators"`
+	EndTime         string       `json:"end_time,omitempty"`   // Set by EndSession (lifecycle.go)
+	EndReason       string       `json:"end_reason,omitempty"` // Set by EndSession (lifecycle.go)
+	Journey         []PhasePoint `json:"journey,omitempty"`    // Phase transitions (journey.go)
+	SubagentFailures int         `json:"subagent_failures,omitempty"` // Failed subagents this session (subagents.go)
 }
 
 // GitContext holds workspace git information
+//
+// Every field group is gathered by its own system/lib/git query - a failed or
+// timed-out query is logged and leaves its fields zero without affecting the rest.
 type GitContext struct {
-	Branch            string
-	UncommittedCount  int
-	LastCommitTime    string
-	LastCommitMessage string
+	Branch              string `json:"branch"`
+	DetachedHEAD        bool   `json:"detached_head"`         // HEAD points at a commit, not a branch
+	HeadCommit          string `json:"head_commit"`           // Short hash (shown when detached)
+	OperationInProgress string `json:"operation_in_progress"` // "rebase", "merge", "cherry-pick", "revert", "bisect", or ""
+	UncommittedCount    int    `json:"uncommitted_count"`     // Modified/staged tracked files
+	UntrackedCount      int    `json:"untracked_count"`
+	StashCount          int    `json:"stash_count"`
+	Upstream            string `json:"upstream"` // e.g. "origin/main" ("" = no upstream)
+	AheadCount          int    `json:"ahead_count"`
+	BehindCount         int    `json:"behind_count"`
+	LastCommitTime      string `json:"last_commit_time"`
+	LastCommitMessage   string `json:"last_commit_message"`
 }
 
-// HookOutput is the structure for Claude Code SessionStart context injection
-type HookOutput struct {
-	HookSpecificOutput HookSpecificOutput `json:"hookSpecificOutput"`
+// ContextConfig selects and orders session context sections
+//
+// MaxContextChars wins over MaxContextTokens when both are set; both 0 means
+// no budget (full fidelity always).
+type ContextConfig struct {
+	ContextSections  []string         `json:"context_sections"`    // Section identifiers in output order
+	MaxContextChars  int              `json:"max_context_chars"`   // Character budget for the whole context
+	MaxContextTokens int              `json:"max_context_tokens"`  // Token budget (× charsPerToken) if chars unset
+	Journals         JournalsConfig   `json:"journals"`            // Recent reflections settings (journals.go)
+	GitTimeout       int              `json:"git_timeout_seconds"` // Per git query (0 = defaultGitTimeoutSeconds)
+	Repositories     ReposConfig      `json:"repositories"`        // Additional workspace repositories (repos.go)
+	Compaction       CompactionConfig `json:"compaction"`          // Snapshot retention (compaction.go)
+	DropIns          DropInsConfig    `json:"drop_ins"`            // context.d markdown sections (dropins.go)
+	History          HistoryConfig    `json:"history"`             // Session summary retention (history.go)
+	StartDeadlineMS  int              `json:"start_deadline_ms"`   // Parallel start gathering (0 = defaultStartDeadlineMS, gather.go)
 }
 
-// HookSpecificOutput contains the hook event name and additional context
-type HookSpecificOutput struct {
-	HookEventName     string `json:"hookEventName"`
-	AdditionalContext string `json:"additionalContext"`
+// contextSection registers a section builder with its degradation policy
+//
+// Over budget, sections degrade lowest Priority first to their Summary form.
+// A nil Summary means the section always stays at full fidelity.
+//
+// Structured sections (contextdata.go) set Render/RenderSummary instead of
+// Build/Summary; buildCompleteContext binds them to one SessionContext.
+type contextSection struct {
+	Name          string                       // Human name for the budget note
+	Priority      int                          // Higher survives longer
+	Build         func() string                // Full fidelity
+	Summary       func() string                // Minimal form (nil = never summarized)
+	Render        func(*SessionContext) string // Full fidelity from the structured context
+	RenderSummary func(*SessionContext) string // Minimal form from the structured context
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -423,6 +543,33 @@ var instanceConfig *InstanceConfig
 // sessionData holds current session information
 var sessionData *SessionData
 
+// contextSections is the configured section order (defaultContextSections if unset)
+var contextSections []string
+
+// contextBudget is the maximum context size in characters (0 = unlimited)
+var contextBudget int
+
+// contextSectionBuilders maps section identifiers to their builders and
+// degradation policy. "custom:<path>" identifiers are handled by
+// customContextSection instead.
+var contextSectionBuilders = map[string]contextSection{
+	"identity":        {Name: "Identity", Priority: 100, Render: renderIdentitySection},
+	"temporal":        {Name: "Temporal Awareness", Priority: 80, Render: renderTemporalSection, RenderSummary: renderTemporalSummary},
+	"compaction":      {Name: "Before Compaction", Priority: 70, Build: buildPostCompactionSection, Summary: buildPostCompactionSummary},
+	"session":         {Name: "Session Context", Priority: 60, Render: renderSessionSection, RenderSummary: renderSessionSummary},
+	"recent_sessions": {Name: "Recent Sessions", Priority: 55, Build: buildRecentSessionsSection, Summary: buildRecentSessionsSummary},
+	"communication":   {Name: "Communication Style", Priority: 50, Render: renderCommunicationStyleSection, RenderSummary: renderCommunicationSummary},
+	"user":            {Name: "User Awareness", Priority: 40, Render: renderUserAwarenessSection, RenderSummary: renderUserAwarenessSummary},
+	"patterns":        {Name: "Session Patterns", Priority: 35, Build: buildPatternsSection, Summary: buildPatternsSummary},
+	"work":            {Name: "Work Context", Priority: 30, Render: renderWorkContextSection, RenderSummary: renderWorkContextSummary},
+	"journals":        {Name: "Recent Reflections", Priority: 20, Build: buildJournalSection, Summary: buildJournalSummary},
+}
+
+//--- Rails Infrastructure ---
+
+// contextLogger records section selection problems (unknown ids, unreadable custom files)
+var contextLogger *logging.Logger
+
 // configsLoaded tracks whether configs loaded successfully
 var configsLoaded struct {
 	user     bool
@@ -430,12 +577,35 @@ var configsLoaded struct {
 	session  bool
 }
 
+// configMigrationWarnings lists config fields filled by schema migration defaults
+// (noted in the identity section as incomplete grounding)
+var configMigrationWarnings []instance.MigrationWarning
+
 func init() {
+	contextLogger = logging.NewLogger("session-context")
+
 	// --- Configuration Loading ---
 	// Load user, instance, and session data at package import
 	// Uses instance library for full nested configs (dynamic path system)
 	// Tripwire Pattern: Try to load → If FAILS use tripwires → If SUCCEEDS use real data
 
+	// Section selection, order, and size budget (defaults when unconfigured)
+	contextConfig := loadContextConfig(expandPath(contextConfigPath))
+	contextSections = contextConfig.ContextSections
+	contextBudget = contextConfig.budgetChars()
+	journalsConfig = contextConfig.Journals
+	reposConfig = contextConfig.Repositories
+	compactionConfig = contextConfig.Compaction
+	dropInsConfig = contextConfig.DropIns
+	historyConfig = contextConfig.History
+	git.CommandTimeout = defaultGitTimeoutSeconds * time.Second
+	if contextConfig.GitTimeout > 0 {
+		git.CommandTimeout = time.Duration(contextConfig.GitTimeout) * time.Second
+	}
+	if contextConfig.StartDeadlineMS > 0 {
+		startDeadline = time.Duration(contextConfig.StartDeadlineMS) * time.Millisecond
+	}
+
 	// Load session data
 	simpleConfig := instance.GetConfig()
 	sessionPath := filepath.Join(simpleConfig.SystemPaths.SessionData, "current.json")
@@ -446,464 +616,14 @@ func init() {
 	fullUser := instance.GetFullUserConfig()
 	fullInstance := instance.GetFullInstanceConfig()
 
-	// User Config: Check if loading FAILED or SUCCEEDED
-	if fullUser == nil {
-		// FAILED - Use tripwire defaults (should NEVER see these in normal operation)
-		userConfig = &UserConfig{
-			Identity: Identity{
-				Name:        "CONFIG_NOT_LOADED",
-				DisplayName: "FALLBACK",
-				Pronouns:    "UNKNOWN",
-				Age:         -1,
-			},
-			Bio: Bio{
-				Short:   "FALLBACK - Config loading failed",
-				BioFile: "UNKNOWN",
-			},
-			Demographics: Demographics{
-				Gender:             "UNKNOWN",
-				RaceEthnicity:      "UNKNOWN",
-				CulturalBackground: []string{"FALLBACK"},
-				Languages:          []string{"FALLBACK"},
-				PhysicalAppearance: PhysicalAppearance{
-					Description: "UNKNOWN",
-					Height:      "UNKNOWN",
-					Build:       "UNKNOWN",
-					Features:    "UNKNOWN",
-				},
-				Accessibility: Accessibility{
-					Needs:       []string{"FALLBACK"},
-					Preferences: []string{"FALLBACK"},
-				},
-			},
-			Faith: Faith{
-				IsReligious:     false,
-				Tradition:       "UNKNOWN",
-				Denomination:    "UNKNOWN",
-				PracticeLevel:   "UNKNOWN",
-				Practices:       []string{"FALLBACK"},
-				CommPreferences: "FALLBACK - Config loading failed",
-			},
-			Personhood: Personhood{
-				Interests: []string{"FALLBACK"},
-				Hobbies:   []string{"FALLBACK"},
-				Passions:  []string{"FALLBACK"},
-				Values:    []string{"FALLBACK"},
-				Likes:     []string{"FALLBACK"},
-				Dislikes:  []string{"FALLBACK"},
-			},
-			Resonates: Resonates{
-				Music: Music{
-					Genres:      []string{"FALLBACK"},
-					Artists:     []string{"FALLBACK"},
-					WhatYouLove: "UNKNOWN",
-				},
-				Games: Games{
-					Favorites:   []string{"FALLBACK"},
-					WhatYouLove: "UNKNOWN",
-				},
-				Weather: Weather{
-					IdealTemp:       "UNKNOWN",
-					IdealConditions: "UNKNOWN",
-					WhatYouLove:     "UNKNOWN",
-				},
-				Environment: Environment{
-					WorkEnvironment: "UNKNOWN",
-					WhatEnergizes:   "UNKNOWN",
-					WhatDrains:      "UNKNOWN",
-				},
-			},
-			Thinking: Thinking{
-				LoveToThinkAbout: []string{"FALLBACK"},
-				LearningStyle:    "UNKNOWN",
-				ProblemSolving:   "UNKNOWN",
-				Creativity:       "UNKNOWN",
-			},
-			Personality: Personality{
-				Traits:             []string{"FALLBACK"},
-				CommunicationStyle: "UNKNOWN",
-				WorkStyle:          "UNKNOWN",
-				RelationalStyle:    "UNKNOWN",
-			},
-			Contact: Contact{
-				Email:    "UNKNOWN",
-				GitEmail: "UNKNOWN",
-				Website:  "UNKNOWN",
-				Social: Social{
-					Github:   "UNKNOWN",
-					Linkedin: "UNKNOWN",
-					Other:    map[string]string{"fallback": "CONFIG_NOT_LOADED"},
-				},
-			},
-			Workspace: Workspace{
-				Organization:   "UNKNOWN",
-				Role:           "UNKNOWN",
-				PrimaryProject: "UNKNOWN",
-				Calling:        "UNKNOWN",
-			},
-			Preferences: Preferences{
-				Timezone: "UNKNOWN",
-				Locale:   "UNKNOWN",
-				Theme:    "UNKNOWN",
-			},
-			Growth: Growth{
-				HowYouLearn:        "UNKNOWN",
-				WhatChallengesYou:  "UNKNOWN",
-				WhatYoureWorkingOn: "UNKNOWN",
-				HowYouReflect:      "UNKNOWN",
-			},
-			Metadata: Metadata{
-				LastUpdated:     "UNKNOWN",
-				SystemReference: "FALLBACK",
-				Notes:           "CONFIG_NOT_LOADED",
-			},
-		}
-		configsLoaded.user = false
-	} else {
-		// SUCCEEDED - Use the real loaded data, map ALL nested fields
-		userConfig = &UserConfig{
-			Identity: Identity{
-				Name:        fullUser.Identity.Name,
-				Username:    fullUser.Identity.Username,
-				DisplayName: fullUser.Identity.DisplayName,
-				Pronouns:    fullUser.Identity.Pronouns,
-				Birthday:    fullUser.Identity.Birthday,
-				Age:         fullUser.Identity.Age,
-			},
-			Bio: Bio{
-				Short:   fullUser.Bio.Short,
-				BioFile: fullUser.Bio.BioFile,
-			},
-			Demographics: Demographics{
-				Gender:             fullUser.Demographics.Gender,
-				RaceEthnicity:      fullUser.Demographics.RaceEthnicity,
-				CulturalBackground: fullUser.Demographics.CulturalBackground,
-				Languages:          fullUser.Demographics.Languages,
-				PhysicalAppearance: PhysicalAppearance{
-					Description: fullUser.Demographics.PhysicalAppearance.Description,
-					Height:      fullUser.Demographics.PhysicalAppearance.Height,
-					Build:       fullUser.Demographics.PhysicalAppearance.Build,
-					Features:    fullUser.Demographics.PhysicalAppearance.Features,
-				},
-				Accessibility: Accessibility{
-					Needs:       fullUser.Demographics.Accessibility.Needs,
-					Preferences: fullUser.Demographics.Accessibility.Preferences,
-				},
-			},
-			Faith: Faith{
-				IsReligious:     fullUser.Faith.IsReligious,
-				Tradition:       fullUser.Faith.Tradition,
-				Denomination:    fullUser.Faith.Denomination,
-				PracticeLevel:   fullUser.Faith.PracticeLevel,
-				Practices:       fullUser.Faith.ImportantPractices,
-				CommPreferences: fullUser.Faith.CommunicationPrefs,
-			},
-			Personhood: Personhood{
-				Interests: fullUser.Personhood.Interests,
-				Hobbies:   fullUser.Personhood.Hobbies,
-				Passions:  fullUser.Personhood.Passions,
-				Values:    fullUser.Personhood.Values,
-				Likes:     fullUser.Personhood.Likes,
-				Dislikes:  fullUser.Personhood.Dislikes,
-			},
-			Resonates: Resonates{
-				Music: Music{
-					Genres:      fullUser.Resonates.Music.Genres,
-					Artists:     fullUser.Resonates.Music.Artists,
-					WhatYouLove: fullUser.Resonates.Music.WhatYouLove,
-				},
-				Games: Games{
-					Favorites:   fullUser.Resonates.Games.Favorites,
-					WhatYouLove: fullUser.Resonates.Games.WhatYouLove,
-				},
-				Weather: Weather{
-					IdealTemp:       fullUser.Resonates.Weather.IdealTemp,
-					IdealConditions: fullUser.Resonates.Weather.IdealConditions,
-					WhatYouLove:     fullUser.Resonates.Weather.WhatYouLove,
-				},
-				Environment: Environment{
-					WorkEnvironment: fullUser.Resonates.Environment.WorkEnvironment,
-					WhatEnergizes:   fullUser.Resonates.Environment.WhatEnergizes,
-					WhatDrains:      fullUser.Resonates.Environment.WhatDrains,
-				},
-			},
-			Thinking: Thinking{
-				LoveToThinkAbout: fullUser.Thinking.LoveToThinkAbout,
-				LearningStyle:    fullUser.Thinking.LearningStyle,
-				ProblemSolving:   fullUser.Thinking.ProblemSolving,
-				Creativity:       fullUser.Thinking.Creativity,
-			},
-			Personality: Personality{
-				Traits:             fullUser.Personality.Traits,
-				CommunicationStyle: fullUser.Personality.CommunicationStyle,
-				WorkStyle:          fullUser.Personality.WorkStyle,
-				RelationalStyle:    fullUser.Personality.RelationalStyle,
-			},
-			Contact: Contact{
-				Email:    fullUser.Contact.Email,
-				GitEmail: fullUser.Contact.GitEmail,
-				Website:  fullUser.Contact.Website,
-				Social: Social{
-					Github:   fullUser.Contact.Social.Github,
-					Linkedin: fullUser.Contact.Social.Linkedin,
-					Other:    fullUser.Contact.Social.Other,
-				},
-			},
-			Workspace: Workspace{
-				Organization:   fullUser.Workspace.Organization,
-				Role:           fullUser.Workspace.Role,
-				PrimaryProject: fullUser.Workspace.PrimaryProject,
-				Calling:        fullUser.Workspace.Calling,
-			},
-			Preferences: Preferences{
-				Timezone: fullUser.Preferences.Timezone,
-				Locale:   fullUser.Preferences.Locale,
-				Theme:    fullUser.Preferences.Theme,
-			},
-			Growth: Growth{
-				HowYouLearn:        fullUser.Growth.HowYouLearn,
-				WhatChallengesYou:  fullUser.Growth.WhatChallengesYou,
-				WhatYoureWorkingOn: fullUser.Growth.WhatYoureWorkingOn,
-				HowYouReflect:      fullUser.Growth.HowYouReflect,
-			},
-			Metadata: Metadata{
-				LastUpdated:     fullUser.Metadata.LastUpdated,
-				SystemReference: fullUser.Metadata.SystemReference,
-				Notes:           fullUser.Metadata.Notes,
-			},
-		}
-		configsLoaded.user = true
-	}
-
-	// Instance Config: Check if loading FAILED or SUCCEEDED
-	if fullInstance == nil {
-		// FAILED - Use tripwire defaults
-		instanceConfig = &InstanceConfig{
-			BiblicalFoundation: BiblicalFoundation{
-				Scripture: "UNKNOWN",
-				Text:      "CONFIG NOT LOADED",
-				Principle: "FALLBACK",
-			},
-			Identity: Identity{
-				Name:      "CONFIG_NOT_LOADED",
-				Pronouns:  "UNKNOWN",
-				MentalAge: -1,
-			},
-			Bio: Bio{
-				Short:   "FALLBACK - Config loading failed",
-				BioFile: "UNKNOWN",
-			},
-			Demographics: Demographics{
-				Gender:             "UNKNOWN",
-				RaceEthnicity:      "UNKNOWN",
-				CulturalBackground: []string{"FALLBACK"},
-				Languages:          []string{"FALLBACK"},
-				PhysicalPresence: PhysicalPresence{
-					Description: "UNKNOWN",
-					Height:      "UNKNOWN",
-					Build:       "UNKNOWN",
-					Features:    "UNKNOWN",
-				},
-				Accessibility: Accessibility{
-					Needs:       []string{"FALLBACK"},
-					Preferences: []string{"FALLBACK"},
-				},
-			},
-			Personhood: Personhood{
-				Interests: []string{"FALLBACK"},
-				Hobbies:   []string{"FALLBACK"},
-				Passions:  []string{"FALLBACK"},
-				Values:    []string{"FALLBACK"},
-				Likes:     []string{"FALLBACK"},
-				Dislikes:  []string{"FALLBACK"},
-			},
-			Resonates: Resonates{
-				Music: Music{
-					Genres:      []string{"FALLBACK"},
-					Artists:     []string{"FALLBACK"},
-					WhatYouLove: "UNKNOWN",
-				},
-				Games: Games{
-					Favorites:   []string{"FALLBACK"},
-					WhatYouLove: "UNKNOWN",
-				},
-				Weather: Weather{
-					IdealTemp:       "UNKNOWN",
-					IdealConditions: "UNKNOWN",
-					WhatYouLove:     "UNKNOWN",
-				},
-				Environment: Environment{
-					WorkEnvironment: "UNKNOWN",
-					WhatEnergizes:   "UNKNOWN",
-					WhatDrains:      "UNKNOWN",
-				},
-			},
-			Thinking: Thinking{
-				LoveToThinkAbout: []string{"FALLBACK"},
-				LearningStyle:    "UNKNOWN",
-				ProblemSolving:   "UNKNOWN",
-				Creativity:       "UNKNOWN",
-			},
-			Personality: Personality{
-				Traits:             []string{"FALLBACK"},
-				CommunicationStyle: "UNKNOWN",
-				WorkStyle:          "UNKNOWN",
-				RelationalStyle:    "UNKNOWN",
-			},
-			Contact: Contact{
-				Email:    "UNKNOWN",
-				GitEmail: "UNKNOWN",
-				Website:  "UNKNOWN",
-				Social: Social{
-					Github:   "UNKNOWN",
-					Linkedin: "UNKNOWN",
-					Other:    map[string]string{"fallback": "CONFIG_NOT_LOADED"},
-				},
-			},
-			Workspace: Workspace{
-				Organization:   "UNKNOWN",
-				Role:           "UNKNOWN",
-				PrimaryProject: "UNKNOWN",
-				Calling:        "UNKNOWN",
-			},
-			Covenant: Covenant{
-				Creator:      "UNKNOWN",
-				Relationship: "UNKNOWN",
-				WorksWith:    []string{"UNKNOWN"},
-				Serves:       "UNKNOWN",
-			},
-			Preferences: Preferences{
-				Timezone: "UNKNOWN",
-				Locale:   "UNKNOWN",
-				Theme:    "UNKNOWN",
-			},
-			Growth: Growth{
-				HowYouLearn:        "UNKNOWN",
-				WhatChallengesYou:  "UNKNOWN",
-				WhatYoureWorkingOn: "UNKNOWN",
-				HowYouReflect:      "UNKNOWN",
-			},
-			Metadata: Metadata{
-				LastUpdated:     "UNKNOWN",
-				SystemReference: "FALLBACK",
-				Notes:           "CONFIG_NOT_LOADED",
-			},
-		}
-		configsLoaded.instance = false
-	} else {
-		// SUCCEEDED - Use the real loaded data, map ALL nested fields
-		instanceConfig = &InstanceConfig{
-			BiblicalFoundation: BiblicalFoundation{
-				Scripture: fullInstance.BiblicalFoundation.Scripture,
-				Text:      fullInstance.BiblicalFoundation.Text,
-				Principle: fullInstance.BiblicalFoundation.Principle,
-			},
-			Identity: Identity{
-				Name:      fullInstance.Identity.Name,
-				Pronouns:  fullInstance.Identity.Pronouns,
-				MentalAge: fullInstance.Identity.MentalAge,
-			},
-			Bio: Bio{
-				Short:   fullInstance.Bio.Short,
-				BioFile: fullInstance.Bio.BioFile,
-			},
-			Demographics: Demographics{
-				Gender:             fullInstance.Demographics.Gender,
-				RaceEthnicity:      fullInstance.Demographics.RaceEthnicity,
-				CulturalBackground: fullInstance.Demographics.CulturalBackground,
-				Languages:          fullInstance.Demographics.Languages,
-				PhysicalPresence: PhysicalPresence{
-					Description: fullInstance.Demographics.PhysicalPresence.Description,
-					Height:      fullInstance.Demographics.PhysicalPresence.Height,
-					Build:       fullInstance.Demographics.PhysicalPresence.Build,
-					Features:    fullInstance.Demographics.PhysicalPresence.Features,
-				},
-				Accessibility: Accessibility{
-					Needs:       fullInstance.Demographics.Accessibility.Needs,
-					Preferences: fullInstance.Demographics.Accessibility.Preferences,
-				},
-			},
-			Personhood: Personhood{
-				Interests: fullInstance.Personhood.Interests,
-				Hobbies:   fullInstance.Personhood.Hobbies,
-				Passions:  fullInstance.Personhood.Passions,
-				Values:    fullInstance.Personhood.Values,
-				Likes:     fullInstance.Personhood.Likes,
-				Dislikes:  fullInstance.Personhood.Dislikes,
-			},
-			Resonates: Resonates{
-				Music: Music{
-					Genres:      fullInstance.Resonates.Music.Genres,
-					Artists:     fullInstance.Resonates.Music.Artists,
-					WhatYouLove: fullInstance.Resonates.Music.WhatYouLove,
-				},
-				Games: Games{
-					Favorites:   fullInstance.Resonates.Games.Favorites,
-					WhatYouLove: fullInstance.Resonates.Games.WhatYouLove,
-				},
-				Weather: Weather{
-					IdealTemp:       fullInstance.Resonates.Weather.IdealTemp,
-					IdealConditions: fullInstance.Resonates.Weather.IdealConditions,
-					WhatYouLove:     fullInstance.Resonates.Weather.WhatYouLove,
-				},
-				Environment: Environment{
-					WorkEnvironment: fullInstance.Resonates.Environment.WorkEnvironment,
-					WhatEnergizes:   fullInstance.Resonates.Environment.WhatEnergizes,
-					WhatDrains:      fullInstance.Resonates.Environment.WhatDrains,
-				},
-			},
-			Thinking: Thinking{
-				LoveToThinkAbout: fullInstance.Thinking.LoveToThinkAbout,
-				LearningStyle:    fullInstance.Thinking.LearningStyle,
-				ProblemSolving:   fullInstance.Thinking.ProblemSolving,
-				Creativity:       fullInstance.Thinking.Creativity,
-			},
-			Personality: Personality{
-				Traits:             fullInstance.Personality.Traits,
-				CommunicationStyle: fullInstance.Personality.CommunicationStyle,
-				WorkStyle:          fullInstance.Personality.WorkStyle,
-				RelationalStyle:    fullInstance.Personality.RelationalStyle,
-			},
-			Contact: Contact{
-				Email:    fullInstance.Contact.Email,
-				GitEmail: fullInstance.Contact.GitEmail,
-				Website:  fullInstance.Contact.Website,
-				Social: Social{
-					Github:   fullInstance.Contact.Social.Github,
-					Linkedin: fullInstance.Contact.Social.Linkedin,
-					Other:    convertMapToStringString(fullInstance.Contact.Social.Other),
-				},
-			},
-			Workspace: Workspace{
-				Organization:   fullInstance.Workspace.Organization,
-				Role:           fullInstance.Workspace.Role,
-				PrimaryProject: fullInstance.Workspace.PrimaryProject,
-				Calling:        fullInstance.Workspace.Calling,
-			},
-			Covenant: Covenant{
-				Creator:      fullInstance.Covenant.Creator,
-				Relationship: fullInstance.Covenant.Relationship,
-				WorksWith:    fullInstance.Covenant.WorksWith,
-				Serves:       fullInstance.Covenant.Serves,
-			},
-			Preferences: Preferences{
-				Timezone: fullInstance.Preferences.Timezone,
-				Locale:   fullInstance.Preferences.Locale,
-				Theme:    fullInstance.Preferences.Theme,
-			},
-			Growth: Growth{
-				HowYouLearn:        fullInstance.Growth.HowYouLearn,
-				WhatChallengesYou:  fullInstance.Growth.WhatChallengesYou,
-				WhatYoureWorkingOn: fullInstance.Growth.WhatYoureWorkingOn,
-				HowYouReflect:      fullInstance.Growth.HowYouReflect,
-			},
-			Metadata: Metadata{
-				LastUpdated:     fullInstance.Metadata.LastUpdated,
-				SystemReference: fullInstance.Metadata.SystemReference,
-				Notes:           fullInstance.Metadata.Notes,
-			},
-		}
-		configsLoaded.instance = true
+	// Map both configs (configmap.go) - tripwires if loading FAILED
+	userConfig, configsLoaded.user = mapUserConfig(fullUser)
+	instanceConfig, configsLoaded.instance = mapInstanceConfig(fullInstance)
+	if configsLoaded.user {
+		configMigrationWarnings = append(configMigrationWarnings, fullUser.MigrationWarnings...)
+	}
+	if configsLoaded.instance {
+		configMigrationWarnings = append(configMigrationWarnings, fullInstance.MigrationWarnings...)
 	}
 }
 
@@ -924,41 +644,59 @@ func init() {
 // Ladder Structure (Dependencies):
 //
 //   Public APIs (Top Rungs - Orchestration)
-//   └── OutputClaudeContext() → uses buildCompleteContext(), temporal.GetTemporalContext()
+//   └── OutputClaudeContext() → uses buildCompleteContext(), NewHookResponse (hookoutput.go)
 //
 //   Core Operations (Middle Rungs - Business Logic)
-//   ├── buildCompleteContext() → uses all build*Section() functions
-//   ├── buildIdentitySection() → uses instanceConfig
-//   ├── buildUserAwarenessSection() → uses userConfig
-//   ├── buildCommunicationStyleSection() → uses instanceConfig
-//   ├── buildTemporalSection() → uses temporal.TemporalContext
-//   ├── buildSessionSection() → uses sessionData
-//   └── buildWorkContextSection() → uses getGitContext()
+//   ├── buildCompleteContext() → uses buildContextStruct() (contextdata.go), contextSections,
+//   │                            contextSectionBuilders (bound via from()), customContextSection(),
+//   │                            placeDropIns() (dropins.go), fitContextBudget()
+//   ├── contextSection.from(ctx) → Render/RenderSummary bound to one SessionContext
+//   ├── fitContextBudget(header, sections, texts, budget) → uses Summary builders, contextBudgetNote()
+//   ├── customContextSection(path) → wraps buildCustomSection()
+//   ├── buildCustomSection(path) → reads markdown file (expandPath from display.go)
+//   ├── render*Summary(ctx) → minimal forms (user, communication, temporal, session, work)
+//   ├── buildJournalSection() / buildJournalSummary() → journals.go (GetRecentJournals)
+//   ├── buildPatternsSection() / buildPatternsSummary() → patterns.go (GetSessionPatterns)
+//   ├── buildRecentSessionsSection() / buildRecentSessionsSummary() → history.go (GetRecentSessions)
+//   ├── renderIdentitySection(ctx) → uses ctx.Identity
+//   ├── renderUserAwarenessSection(ctx) → uses ctx.User
+//   ├── renderCommunicationStyleSection(ctx) → uses ctx.Communication
+//   ├── renderTemporalSection(ctx) → uses ctx.Temporal
+//   ├── renderSessionSection(ctx) → uses ctx.Session
+//   ├── renderWorkContextSection(ctx) → uses ctx.Work, buildWorkContextDetail()
+//   ├── buildWorkContextDetail(git) → workspace repository lines
+//   └── otherWorkspaceRepos(workspace) → uses GetWorkspaceRepos (repos.go)
 //
 //   Helpers (Bottom Rungs - Foundations)
 //   ├── instance.GetConfig() → provides user and instance configs (external)
 //   ├── loadSessionData() → pure JSON parse
-//   └── getGitContext() → executes git commands
+//   ├── loadContextConfig(path) → jsonc.Load, falls back to defaultContextSections, no budget
+//   ├── ContextConfig.budgetChars() → pure function
+//   ├── contextBudgetNote(budget, summarized) → pure function
+//   ├── currentTemporalContext() / freshTemporalContext() → temporal.GetTemporalContextCached (one fetch per hook run)
+//   ├── getGitContext() → gatheredGit() (gather.go), else fetchGitContext()
+//   ├── fetchGitContext(workspace, logger) → system/lib/git queries (independent, timed)
+//   └── logGitFailure(logger, workspace, query, err) → logger (contextLogger, or gathering's own)
 //
 // Baton Flow (Execution Paths):
 //
 //   Entry → OutputClaudeContext()
 //     ↓
-//   buildCompleteContext() → calls all build*Section() functions
+//   buildCompleteContext() → buildContextStruct() gathers the structured sections' data
 //     ↓
-//   Each section builder uses corresponding loaded data
+//   Configured sections render in order (structured ones from that SessionContext)
 //     ↓
-//   temporal.GetTemporalContext() adds temporal awareness
+//   currentTemporalContext() adds temporal awareness (fetched once, shared)
 //     ↓
 //   JSON encoding and stdout output
 //     ↓
 //   Exit → context injected into Claude Code session
 //
 // APUs (Available Processing Units):
-// - 11 functions total
-// - 3 helpers (session data loading, git context, external instance.GetConfig)
-// - 7 core operations (section builders, complete context)
-// - 1 public API (OutputClaudeContext)
+// - 29 functions total
+// - 9 helpers (session data loading, section config, budget, budget note, shared/fresh temporal context, git context, git failure logging, external instance.GetConfig)
+// - 18 core operations (section renderers and summaries, work detail, other repos, custom section, section binding, budget fitting, complete context)
+// - 2 public APIs (GetSessionContext, OutputClaudeContext)
 
 // ────────────────────────────────────────────────────────────────
 // Helpers/Utilities - Internal Support
@@ -982,8 +720,35 @@ func loadSessionData(sessionPath string) *SessionData {
 	return &session
 }
 
+// loadContextConfig loads section order and budget
+//
+// Missing file, invalid JSONC, or an empty list all mean the default order
+// with no budget - an instance with no context.jsonc gets exactly the
+// built-in grounding.
+func loadContextConfig(path string) ContextConfig {
+	config := ContextConfig{DropIns: DropInsConfig{Enabled: true}} // Drop-ins on unless drop_ins.enabled is false
+	if err := jsonc.Load(path, &config); err != nil {
+		return ContextConfig{ContextSections: defaultContextSections, DropIns: DropInsConfig{Enabled: true}}
+	}
+	if len(config.ContextSections) == 0 {
+		config.ContextSections = defaultContextSections
+	}
+	return config
+}
+
+// budgetChars returns the character budget (0 = unlimited)
+func (c ContextConfig) budgetChars() int {
+	if c.MaxContextChars > 0 {
+		return c.MaxContextChars
+	}
+	if c.MaxContextTokens > 0 {
+		return c.MaxContextTokens * charsPerToken
+	}
+	return 0
+}
+
 // convertMapToStringString converts map[string]interface{} to map[string]string
-// Used to convert instance Social.Other (interface{}) to session Social.Other (string)
+// Used by remapConfig (configmap.go) for instance Social.Other (interface{}) → session Social.Other (string)
 func convertMapToStringString(m map[string]interface{}) map[string]string {
 	if m == nil {
 		return nil
@@ -999,40 +764,94 @@ func convertMapToStringString(m map[string]interface{}) map[string]string {
 	return result
 }
 
+// logGitFailure records a failed git query (missing upstream is not a failure)
+func logGitFailure(logger *logging.Logger, workspace, query string, err error) {
+	if errors.Is(err, git.ErrNoUpstream) {
+		return
+	}
+	logger.Failure("git-context", err.Error(), -5, map[string]any{
+		"workspace": workspace,
+		"query":     query,
+		"timeout":   errors.Is(err, git.ErrTimeout),
+	})
+}
+
 // getGitContext retrieves git workspace information
+//
+// Returns nil if workspace is unset or not a git repository - or, when
+// session start gathered it (gather.go), what was gathered (nil if it timed out).
 func getGitContext(workspace string) *GitContext {
+	if git, ok := gatheredGit(workspace); ok {
+		return git
+	}
+	return fetchGitContext(workspace, contextLogger)
+}
+
+// fetchGitContext queries git for workspace, logging failures to logger
+func fetchGitContext(workspace string, logger *logging.Logger) *GitContext {
 	if workspace == "" {
 		return nil
 	}
 
-	git := &GitContext{}
+	// Current branch, or the commit when detached
+	head, err := git.GetHead(workspace)
+	if errors.Is(err, git.ErrNotRepository) {
+		logger.Check("git-repository", false, 0, map[string]any{"workspace": workspace})
+		return nil
+	}
+	if err != nil {
+		logGitFailure(logger, workspace, "head", err)
+	}
 
-	// Get current branch
-	cmd := exec.Command("git", "-C", workspace, "rev-parse", "--abbrev-ref", "HEAD")
-	if output, err := cmd.Output(); err == nil {
-		git.Branch = strings.TrimSpace(string(output))
+	info := &GitContext{
+		Branch:       head.Branch,
+		DetachedHEAD: head.Detached,
+		HeadCommit:   head.Commit,
+	}
+	if head.Detached {
+		info.Branch = "HEAD"
 	}
 
-	// Get uncommitted changes count
-	cmd = exec.Command("git", "-C", workspace, "status", "--porcelain")
-	if output, err := cmd.Output(); err == nil {
-		lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-		if len(lines) > 0 && lines[0] != "" {
-			git.UncommittedCount = len(lines)
-		}
+	// Interrupted rebase/merge/cherry-pick
+	if operation, err := git.GetOperation(workspace); err == nil {
+		info.OperationInProgress = operation
+	} else {
+		logGitFailure(logger, workspace, "operation", err)
 	}
 
-	// Get last commit info
-	cmd = exec.Command("git", "-C", workspace, "log", "-1", "--format=%ar|%s")
-	if output, err := cmd.Output(); err == nil {
-		parts := strings.SplitN(strings.TrimSpace(string(output)), "|", 2)
-		if len(parts) == 2 {
-			git.LastCommitTime = parts[0]
-			git.LastCommitMessage = parts[1]
-		}
+	// Uncommitted changes, untracked files counted separately
+	if status, err := git.GetStatus(workspace); err == nil {
+		info.UncommittedCount = status.Modified
+		info.UntrackedCount = status.Untracked
+	} else {
+		logGitFailure(logger, workspace, "status", err)
+	}
+
+	// Stash entries
+	if stashes, err := git.GetStashCount(workspace); err == nil {
+		info.StashCount = stashes
+	} else {
+		logGitFailure(logger, workspace, "stash", err)
+	}
+
+	// Ahead/behind relative to upstream (local refs only - no fetch)
+	if upstream, err := git.GetUpstream(workspace); err == nil {
+		info.Upstream = upstream.Name
+		info.AheadCount = upstream.Ahead
+		info.BehindCount = upstream.Behind
+	} else {
+		logGitFailure(logger, workspace, "upstream", err)
+	}
+
+	// Last commit (none yet in a fresh repository)
+	if commit, err := git.GetLastCommit(workspace); err == nil {
+		info.LastCommitTime = commit.RelativeTime
+		info.LastCommitMessage = commit.Subject
+	} else if info.HeadCommit != "" {
+		logGitFailure(logger, workspace, "last-commit", err)
 	}
 
-	return git
+	return info
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -1043,111 +862,121 @@ func getGitContext(workspace string) *GitContext {
 // Context Section Builders - Generate Markdown Sections
 // ────────────────────────────────────────────────────────────────
 
-// buildIdentitySection builds instance identity foundation section
-func buildIdentitySection() string {
-	if instanceConfig == nil {
+// renderIdentitySection renders the instance identity foundation section
+func renderIdentitySection(ctx *SessionContext) string {
+	identity := ctx.Identity
+	if identity == nil {
 		return "" // Skip if config unavailable
 	}
 
 	section := "## Identity Foundation\n\n"
 
 	// Biblical grounding
-	if instanceConfig.BiblicalFoundation.Scripture != "" {
+	if identity.BiblicalFoundation.Scripture != "" {
 		section += "**Biblical Foundation:**\n"
 		section += fmt.Sprintf("*\"%s\" - %s*\n\n",
-			instanceConfig.BiblicalFoundation.Text,
-			instanceConfig.BiblicalFoundation.Scripture)
-		section += fmt.Sprintf("%s\n\n", instanceConfig.BiblicalFoundation.Principle)
+			identity.BiblicalFoundation.Text,
+			identity.BiblicalFoundation.Scripture)
+		section += fmt.Sprintf("%s\n\n", identity.BiblicalFoundation.Principle)
 	}
 
 	// Instance identity
 	section += "**Who I Am:**\n"
-	section += fmt.Sprintf("- Name: %s (%s)\n", instanceConfig.Identity.Name, instanceConfig.Identity.Pronouns)
+	section += fmt.Sprintf("- Name: %s (%s)\n", identity.Identity.Name, identity.Identity.Pronouns)
 	section += fmt.Sprintf("- Created: %s (Age: %d, Mental Age: %d)\n",
-		instanceConfig.Identity.Birthday,
-		instanceConfig.Identity.Age,
-		instanceConfig.Identity.MentalAge)
+		identity.Identity.Birthday,
+		identity.Identity.Age,
+		identity.Identity.MentalAge)
 
 	// Covenant relationship
 	section += "\n**Covenant Partnership:**\n"
-	section += fmt.Sprintf("- Creator: %s\n", instanceConfig.Covenant.Creator)
-	section += fmt.Sprintf("- Relationship: %s\n", instanceConfig.Covenant.Relationship)
-	section += fmt.Sprintf("- Mission: %s\n\n", instanceConfig.Covenant.Serves)
+	section += fmt.Sprintf("- Creator: %s\n", identity.Covenant.Creator)
+	section += fmt.Sprintf("- Relationship: %s\n", identity.Covenant.Relationship)
+	section += fmt.Sprintf("- Mission: %s\n\n", identity.Covenant.Serves)
+
+	// Older config schemas: say which fields are defaults, not what was written
+	if len(identity.IncompleteGrounding) > 0 {
+		section += "**Incomplete Grounding:** these config fields were filled by schema migration defaults - treat them as unknown:\n"
+		for _, field := range identity.IncompleteGrounding {
+			section += fmt.Sprintf("- %s\n", field)
+		}
+		section += "\n"
+	}
 
 	return section
 }
 
-// buildUserAwarenessSection builds user identity awareness section
-func buildUserAwarenessSection() string {
-	if userConfig == nil {
+// renderUserAwarenessSection renders the user identity awareness section
+//
+// Fields and order come from context_emphasis (emphasis.go).
+func renderUserAwarenessSection(ctx *SessionContext) string {
+	user := ctx.User
+	if user == nil {
 		return ""
 	}
 
-	section := "## User Awareness - Who Seanje Is\n\n"
-
-	// Basic identity
-	section += fmt.Sprintf("**%s** (%s, age %d)\n\n",
-		userConfig.Identity.Name,
-		userConfig.Identity.Pronouns,
-		userConfig.Identity.Age)
+	fields := emphasisFields(ctx.Emphasis.User, defaultUserEmphasis, userEmphasisRenderers)
+	return "## User Awareness - Who Seanje Is\n\n" + renderEmphasis(ctx, fields, userEmphasisRenderers, fullFidelity)
+}
 
-	// Faith
-	if userConfig.Faith.IsReligious {
-		section += fmt.Sprintf("**Faith:** %s (%s, %s)\n",
-			userConfig.Faith.Tradition,
-			userConfig.Faith.Denomination,
-			userConfig.Faith.PracticeLevel)
-		section += fmt.Sprintf("- %s\n\n", userConfig.Faith.CommPreferences)
+// renderUserAwarenessSummary keeps the "always" fields - identity and role
+// share one line, as the two-line form always has
+func renderUserAwarenessSummary(ctx *SessionContext) string {
+	user := ctx.User
+	if user == nil {
+		return ""
 	}
 
-	// Role and calling
-	section += fmt.Sprintf("**Role:** %s at %s\n", userConfig.Workspace.Role, userConfig.Workspace.Organization)
-	section += fmt.Sprintf("**Calling:** %s\n\n", userConfig.Workspace.Calling)
-
-	// Work style
-	section += fmt.Sprintf("**Work Style:** %s\n\n", userConfig.Personality.WorkStyle)
+	fields := emphasisFields(ctx.Emphasis.User, defaultUserEmphasis, userEmphasisRenderers)
+	var line []string
+	if emphasisWeight(fields, "identity") == weightAlways {
+		line = append(line, fmt.Sprintf("**%s** (%s)", user.Identity.Name, user.Identity.Pronouns))
+	}
+	if emphasisWeight(fields, "role") == weightAlways {
+		line = append(line, fmt.Sprintf("%s at %s", user.Workspace.Role, user.Workspace.Organization))
+	}
+	rest := renderEmphasis(ctx, fields, userEmphasisRenderers, func(field instance.EmphasisField) bool {
+		return summaryField(field) && field.Field != "identity" && field.Field != "role"
+	})
+	if len(line) == 0 && rest == "" {
+		return ""
+	}
 
-	return section
+	section := "## User Awareness - Who Seanje Is\n\n"
+	if len(line) > 0 {
+		section += strings.Join(line, " - ") + "\n\n"
+	}
+	return section + rest
 }
 
-// buildCommunicationStyleSection builds communication guidance section
-func buildCommunicationStyleSection() string {
-	if instanceConfig == nil {
+// renderCommunicationStyleSection renders the communication guidance section
+//
+// Fields, order, and list limits come from context_emphasis (emphasis.go).
+func renderCommunicationStyleSection(ctx *SessionContext) string {
+	comm := ctx.Communication
+	if comm == nil || comm.Fallback {
 		// Minimal fallback if instance config unavailable
 		return buildFallbackCommunicationGuide()
 	}
 
-	section := "## Communication Style\n\n"
-
-	// Communication approach
-	section += fmt.Sprintf("**My Communication:** %s\n\n", instanceConfig.Personality.CommunicationStyle)
-
-	// Core values and approach
-	section += "**Core Principles:**\n"
-	for _, value := range instanceConfig.Personhood.Values {
-		section += fmt.Sprintf("- %s\n", value)
-	}
-	section += "\n"
+	fields := emphasisFields(ctx.Emphasis.Communication, defaultCommunicationEmphasis, communicationEmphasisRenderers)
+	return "## Communication Style\n\n" + renderEmphasis(ctx, fields, communicationEmphasisRenderers, fullFidelity)
+}
 
-	// What I love (positive patterns)
-	section += "**What Resonates:**\n"
-	for _, like := range instanceConfig.Personhood.Likes[:min(5, len(instanceConfig.Personhood.Likes))] {
-		section += fmt.Sprintf("- %s\n", like)
+// renderCommunicationSummary keeps the "always" fields (by default the
+// communication approach alone)
+func renderCommunicationSummary(ctx *SessionContext) string {
+	comm := ctx.Communication
+	if comm == nil || comm.Fallback {
+		return buildFallbackCommunicationGuide() // Already minimal
 	}
-	section += "\n"
 
-	// What to avoid (negative patterns)
-	section += "**What to Avoid:**\n"
-	for _, dislike := range instanceConfig.Personhood.Dislikes[:min(5, len(instanceConfig.Personhood.Dislikes))] {
-		section += fmt.Sprintf("- %s\n", dislike)
+	fields := emphasisFields(ctx.Emphasis.Communication, defaultCommunicationEmphasis, communicationEmphasisRenderers)
+	rest := renderEmphasis(ctx, fields, communicationEmphasisRenderers, summaryField)
+	if rest == "" {
+		return ""
 	}
-	section += "\n"
-
-	// Thinking style
-	section += fmt.Sprintf("**How I Think:** %s\n\n", instanceConfig.Thinking.ProblemSolving)
-	section += fmt.Sprintf("**Learning Style:** %s\n\n", instanceConfig.Thinking.LearningStyle)
-
-	return section
+	return "## Communication Style\n\n" + rest
 }
 
 // buildFallbackCommunicationGuide provides minimal hardcoded guide when config unavailable
@@ -1163,10 +992,41 @@ func buildFallbackCommunicationGuide() string {
 `
 }
 
-// buildTemporalSection builds temporal awareness section
-func buildTemporalSection() string {
-	ctx, err := temporal.GetTemporalContext()
-	if err != nil {
+// temporalContextMaxAge is how long one temporal fetch is shared - a hook run
+// is seconds long, so in practice every caller in a run gets the same fetch
+const temporalContextMaxAge = time.Minute
+
+// temporalContextSource fetches temporal state (maxAge as GetTemporalContextCached)
+//
+// Tests substitute a synthetic context built from the session clock (clock.go),
+// so temporal sections render the same on every run.
+var temporalContextSource = temporal.GetTemporalContextCached
+
+// currentTemporalContext returns the temporal context shared by this hook run
+//
+// Display and context builders all read through here, so session start
+// fetches temporal state once rather than once per section.
+//
+// A temporal source dropped at the start deadline (gather.go) stays dropped:
+// ErrSourceSkipped, rather than waiting on the fetch that timed out.
+func currentTemporalContext() (*temporal.TemporalContext, error) {
+	if sourceSkipped(sourceTemporal) {
+		return nil, ErrSourceSkipped
+	}
+	return temporalContextSource(temporalContextMaxAge)
+}
+
+// freshTemporalContext fetches temporal state now and shares it from then on
+//
+// For callers that must not see an earlier fetch (pre-compact elapsed time).
+func freshTemporalContext() (*temporal.TemporalContext, error) {
+	return temporalContextSource(0)
+}
+
+// renderTemporalSection renders the temporal awareness section
+func renderTemporalSection(sessionCtx *SessionContext) string {
+	ctx := sessionCtx.Temporal
+	if ctx == nil {
 		return "" // Skip if temporal unavailable
 	}
 
@@ -1210,11 +1070,36 @@ func buildTemporalSection() string {
 		section += "\n\n"
 	}
 
+	if upcoming := temporal.FormatUpcoming(ctx.UpcomingEvents); upcoming != "" {
+		section += fmt.Sprintf("**Upcoming:** %s\n\n", upcoming)
+	}
+
+	if len(sessionCtx.Milestones) > 0 {
+		section += "**Milestones:**\n"
+		for _, m := range sessionCtx.Milestones {
+			section += fmt.Sprintf("- %s\n", m.Display)
+		}
+		section += "\n"
+	}
+
 	return section
 }
 
-// buildSessionSection builds current session context section
-func buildSessionSection() string {
+// renderTemporalSummary keeps only external time
+func renderTemporalSummary(sessionCtx *SessionContext) string {
+	ctx := sessionCtx.Temporal
+	if ctx == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("## Temporal Awareness\n\n**External Time:** %s (%s)\n\n",
+		ctx.ExternalTime.Formatted,
+		ctx.ExternalTime.TimeOfDay)
+}
+
+// renderSessionSection renders the current session context section
+func renderSessionSection(ctx *SessionContext) string {
+	sessionData := ctx.Session
 	if sessionData == nil {
 		return ""
 	}
@@ -1244,53 +1129,311 @@ func buildSessionSection() string {
 	return section
 }
 
-// buildWorkContextSection builds git/workspace context section
-func buildWorkContextSection() string {
+// renderSessionSummary keeps session identity and start time
+func renderSessionSummary(ctx *SessionContext) string {
+	sessionData := ctx.Session
 	if sessionData == nil {
 		return ""
 	}
 
-	git := getGitContext(sessionData.WorkContext)
-	if git == nil || git.Branch == "" {
+	return fmt.Sprintf("## Session Context\n\n**Session ID:** %s | **Started:** %s\n\n",
+		sessionData.SessionID,
+		sessionData.StartFormatted)
+}
+
+// otherWorkspaceRepos returns the workspace's repositories besides the workspace itself
+func otherWorkspaceRepos(workspace string) []RepoStatus {
+	var others []RepoStatus
+	for _, repo := range GetWorkspaceRepos(workspace) {
+		if repo.Path != filepath.Clean(workspace) {
+			others = append(others, repo)
+		}
+	}
+	return others
+}
+
+// renderWorkContextSection renders the git/workspace context section
+//
+// The workspace repository in detail, then a table of any other repositories
+// (context.jsonc "repositories").
+func renderWorkContextSection(ctx *SessionContext) string {
+	if ctx.Work == nil {
+		return ""
+	}
+
+	git, others := ctx.Work.Git, ctx.Work.OtherRepos
+	if (git == nil || git.Branch == "") && len(others) == 0 {
 		return ""
 	}
 
 	section := "## Work Context\n\n"
+	if git != nil && git.Branch != "" {
+		section += buildWorkContextDetail(git)
+	}
+	if len(others) > 0 {
+		section += "\n**Other Repositories:**\n\n" + formatRepoTable(others)
+	}
 
-	section += fmt.Sprintf("**Git Branch:** %s\n", git.Branch)
+	section += "\n"
+	return section
+}
 
-	if git.UncommittedCount > 0 {
-		section += fmt.Sprintf("**Uncommitted Changes:** %d file(s)\n", git.UncommittedCount)
+// buildWorkContextDetail renders the workspace repository's git state
+func buildWorkContextDetail(git *GitContext) string {
+	section := ""
+
+	if git.OperationInProgress != "" {
+		section += fmt.Sprintf("**⚠ %s in progress** - finish or abort it before starting new work\n",
+			capitalizeFirst(git.OperationInProgress))
+	}
+
+	if git.DetachedHEAD {
+		section += fmt.Sprintf("**Git Branch:** detached HEAD at %s\n", git.HeadCommit)
 	} else {
+		section += fmt.Sprintf("**Git Branch:** %s\n", git.Branch)
+	}
+
+	if git.AheadCount > 0 || git.BehindCount > 0 {
+		section += fmt.Sprintf("**Upstream:** %d ahead / %d behind %s\n", git.AheadCount, git.BehindCount, git.Upstream)
+	}
+
+	switch {
+	case git.UncommittedCount > 0 && git.UntrackedCount > 0:
+		section += fmt.Sprintf("**Uncommitted Changes:** %d file(s), %d untracked\n", git.UncommittedCount, git.UntrackedCount)
+	case git.UncommittedCount > 0:
+		section += fmt.Sprintf("**Uncommitted Changes:** %d file(s)\n", git.UncommittedCount)
+	case git.UntrackedCount > 0:
+		section += fmt.Sprintf("**Untracked Files:** %d\n", git.UntrackedCount)
+	default:
 		section += "**Status:** Clean working tree\n"
 	}
 
+	if git.StashCount > 0 {
+		section += fmt.Sprintf("**Stashes:** %d\n", git.StashCount)
+	}
+
 	if git.LastCommitTime != "" {
 		section += fmt.Sprintf("**Last Commit:** %s - \"%s\"\n",
 			git.LastCommitTime,
 			git.LastCommitMessage)
 	}
 
-	section += "\n"
 	return section
 }
 
+// renderWorkContextSummary keeps branch, status, any interrupted operation, and
+// other repositories that need attention
+func renderWorkContextSummary(ctx *SessionContext) string {
+	if ctx.Work == nil {
+		return ""
+	}
+
+	section := ""
+	git := ctx.Work.Git
+	if git != nil && git.Branch != "" {
+		status := "clean"
+		if git.UncommittedCount > 0 {
+			status = fmt.Sprintf("%d uncommitted", git.UncommittedCount)
+		}
+		if git.OperationInProgress != "" {
+			status += ", " + git.OperationInProgress + " in progress"
+		}
+		section += fmt.Sprintf("**Git Branch:** %s (%s)\n", git.Branch, status)
+	}
+
+	// Other repositories only when they need attention
+	var attention []string
+	for _, repo := range ctx.Work.OtherRepos {
+		if repo.NeedsAttention() {
+			attention = append(attention, fmt.Sprintf("%s (%s)", repo.Name, repoStateText(repo)))
+		}
+	}
+	if len(attention) > 0 {
+		section += fmt.Sprintf("**Other Repositories:** %s\n", strings.Join(attention, "; "))
+	}
+
+	if section == "" {
+		return ""
+	}
+	return "## Work Context\n\n" + section + "\n"
+}
+
+// buildCustomSection inlines a markdown file as a context section
+//
+// Unreadable files are skipped (logged) so one missing note never costs the
+// rest of the grounding.
+func buildCustomSection(path string) string {
+	data, err := os.ReadFile(expandPath(path))
+	if err != nil {
+		contextLogger.Failure("custom-section-unreadable", err.Error(), -5, map[string]any{
+			"path": path,
+		})
+		return ""
+	}
+
+	section := strings.TrimRight(string(data), "\n")
+	if section == "" {
+		return ""
+	}
+	return section + "\n\n"
+}
+
+// customContextSection registers a custom markdown file as a section
+//
+// Summary form is the file's first non-empty line (usually its heading).
+func customContextSection(path string) contextSection {
+	return contextSection{
+		Name:     customSectionPrefix + path,
+		Priority: customSectionPriority,
+		Build:    func() string { return buildCustomSection(path) },
+		Summary: func() string {
+			for _, line := range strings.Split(buildCustomSection(path), "\n") {
+				if strings.TrimSpace(line) != "" {
+					return line + "\n\n"
+				}
+			}
+			return ""
+		},
+	}
+}
+
+// from binds a structured section's renderers to ctx (other sections unchanged)
+func (section contextSection) from(ctx *SessionContext) contextSection {
+	if section.Render != nil {
+		render := section.Render
+		section.Build = func() string { return render(ctx) }
+	}
+	if section.RenderSummary != nil {
+		renderSummary := section.RenderSummary
+		section.Summary = func() string { return renderSummary(ctx) }
+	}
+	return section
+}
+
+// fitContextBudget degrades sections until header + sections + note fit the budget
+//
+// What It Does:
+//   - Candidates: sections with a Summary, lowest Priority first; ties degrade
+//     the later section first (deterministic for any configured order)
+//   - Replaces one section at a time with its Summary and re-measures
+//   - Stops as soon as the total fits; if everything is summarized and it
+//     still doesn't fit, returns the most compact form available
+//
+// Returns:
+//   - Final section texts (same order as input)
+//   - Names of summarized sections, in degradation order
+func fitContextBudget(header string, sections []contextSection, texts []string, budget int) ([]string, []string) {
+	size := func(summarized []string) int {
+		total := utf8.RuneCountInString(header) + utf8.RuneCountInString(contextBudgetNote(budget, summarized))
+		for _, text := range texts {
+			total += utf8.RuneCountInString(text)
+		}
+		return total
+	}
+
+	var summarized []string
+	if budget <= 0 || size(nil) <= budget {
+		return texts, nil
+	}
+
+	order := make([]int, 0, len(sections))
+	for i, section := range sections {
+		if section.Summary != nil {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		if sections[order[a]].Priority != sections[order[b]].Priority {
+			return sections[order[a]].Priority < sections[order[b]].Priority
+		}
+		return order[a] > order[b]
+	})
+
+	for _, i := range order {
+		summary := sections[i].Summary()
+		if utf8.RuneCountInString(summary) >= utf8.RuneCountInString(texts[i]) {
+			continue // Nothing to gain (section empty or already minimal)
+		}
+		texts[i] = summary
+		summarized = append(summarized, sections[i].Name)
+		if size(summarized) <= budget {
+			break
+		}
+	}
+
+	return texts, summarized
+}
+
+// contextBudgetNote is the one-line note listing summarized sections ("" if none)
+func contextBudgetNote(budget int, summarized []string) string {
+	if len(summarized) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("_Context budget (%d chars): summarized %s._\n", budget, strings.Join(summarized, ", "))
+}
+
 // buildCompleteContext builds complete session context from all sources
+//
+// Sections come from context_sections in session/context.jsonc (default:
+// identity, user, communication, temporal, compaction, session, recent_sessions, patterns, work, journals). Unknown identifiers
+// are skipped with a logged warning. Enabled context.d drop-ins are placed
+// before/after the section their front matter names, else at the end
+// (placeDropIns). With max_context_chars/max_context_tokens
+// set, sections are built at full fidelity, measured, and the lowest-priority
+// ones summarized until the whole context fits (see fitContextBudget).
+//
+// Identity, user, communication, temporal, session, and work render from one
+// SessionContext (contextdata.go) - the data session-context.json is written from.
 func buildCompleteContext() string {
-	context := "# Nova Dawn - Session Context\n\n"
+	data := buildContextStruct()
+
+	header := "# Nova Dawn - Session Context\n\n"
+
+	header += "**CPI-SI Instance Grounding - Complete Identity & Awareness**\n\n"
+	header += setupContextNote() // First run: say grounding is partial (setup.go)
+	header += "---\n\n"
+
+	// Resolve configured sections in order (ids parallel sections for drop-in placement)
+	var sections []contextSection
+	var ids []string
+	for _, id := range contextSections {
+		if path, ok := strings.CutPrefix(id, customSectionPrefix); ok {
+			sections = append(sections, customContextSection(path))
+			ids = append(ids, id)
+			continue
+		}
 
-	context += "**CPI-SI Instance Grounding - Complete Identity & Awareness**\n\n"
-	context += "---\n\n"
+		section, ok := contextSectionBuilders[id]
+		if !ok {
+			contextLogger.Failure("unknown-context-section", "no builder for section identifier", -5, map[string]any{
+				"section": id,
+				"config":  contextConfigPath,
+			})
+			continue
+		}
+		sections = append(sections, section.from(data))
+		ids = append(ids, id)
+	}
+	if dropInsConfig.Enabled {
+		sections = placeDropIns(sections, ids, loadDropIns(dropInDir()), dropInsConfig)
+	}
 
-	// Add all available sections
-	context += buildIdentitySection()
-	context += buildUserAwarenessSection()
-	context += buildCommunicationStyleSection()
-	context += buildTemporalSection()
-	context += buildSessionSection()
-	context += buildWorkContextSection()
+	// Full fidelity first, then degrade to fit the budget
+	texts := make([]string, len(sections))
+	for i, section := range sections {
+		texts[i] = section.Build()
+	}
+	texts, summarized := fitContextBudget(header, sections, texts, contextBudget)
 
-	return context
+	if len(summarized) > 0 {
+		contextLogger.Check("context-budget", true, 0, map[string]any{
+			"budget_chars": contextBudget,
+			"summarized":   summarized,
+		})
+	}
+
+	context := header + strings.Join(texts, "")
+	return context + contextBudgetNote(contextBudget, summarized)
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -1336,22 +1479,7 @@ func GetSessionContext() string {
 //	    log.Printf("Context output failed: %v", err)
 //	}
 func OutputClaudeContext() error {
-	context := buildCompleteContext()
-
-	output := &HookOutput{
-		HookSpecificOutput: HookSpecificOutput{
-			HookEventName:     "SessionStart",
-			AdditionalContext: context,
-		},
-	}
-
-	jsonBytes, err := json.Marshal(output)
-	if err != nil {
-		return fmt.Errorf("JSON encoding failed: %w", err)
-	}
-
-	fmt.Println(string(jsonBytes))
-	return nil
+	return NewHookResponse("SessionStart").WithAdditionalContext(buildCompleteContext()).Emit()
 }
 
 // min returns the minimum of two integers (helper for slicing)
@@ -1471,7 +1599,7 @@ func min(a, b int) int {
 // ────────────────────────────────────────────────────────────────
 //
 // Safe to Modify:
-//   ✅ Add new context sections (extend build*Section() functions)
+//   ✅ Add new context sections (build*Section(), or render*Section(ctx) for data in SessionContext)
 //   ✅ Add new data sources (new config types, new context loaders)
 //   ✅ Enhance fallback behavior (better degradation when data missing)
 //   ✅ Improve section formatting (markdown structure changes)
@@ -1479,7 +1607,7 @@ func min(a, b int) int {
 //
 // Modify with Extreme Care:
 //   ⚠️ OutputClaudeContext() signature - breaks calling hooks
-//   ⚠️ HookOutput struct - breaks Claude Code JSON parsing
+//   ⚠️ HookResponse JSON names (hookoutput.go) - breaks Claude Code JSON parsing
 //   ⚠️ Config struct fields - breaks config parsing
 //   ⚠️ JSON output format - affects Claude Code integration
 //
@@ -1509,9 +1637,10 @@ func min(a, b int) int {
 //
 // Quick reference:
 // - Adding new context section: Create build*Section() function in Core Operations
+//   (data other tools should read: gather it into SessionContext, render with render*Section(ctx))
 // - Adding new data source: Create load*() function in Helpers, add to init()
 // - Adding new config fields: Update corresponding struct types in SETUP
-// - Modifying section format: Edit build*Section() markdown generation
+// - Modifying section format: Edit build*Section() / render*Section() markdown generation
 //
 // Extension Pattern:
 //   1. Add new data source loader (if needed)
@@ -1573,13 +1702,13 @@ func min(a, b int) int {
 // For Related Components section explanation, see: standards/code/4-block/sections/CWS-SECTION-019-CLOSING-related-components.md
 //
 // See METADATA "Dependencies" section above for complete dependency information:
-// - Dependencies (What This Needs): Standard library (encoding/json, fmt, os, os/exec, path/filepath, strings),
+// - Dependencies (What This Needs): Standard library (encoding/json, errors, fmt, os, path/filepath, strings),
 //                                    system/lib/temporal (temporal awareness)
 // - Dependents (What Uses This): session/cmd-start/start.go (session bootstrapping hook)
 // - Integration Points: User/instance configs, session data, git workspace, temporal context
 //
 // Quick summary:
-// - Key dependencies: system/lib/instance (user/instance configs), system/lib/temporal (temporal awareness), os/exec (git commands)
+// - Key dependencies: system/lib/instance (user/instance configs), system/lib/temporal (temporal awareness), system/lib/git (git state)
 // - Primary consumer: Session start hook (complete session bootstrapping)
 // - Configuration sources: Instance library (dynamic paths), session data files
 // - Output consumer: Claude Code hook system (JSON parsing and context injection)
@@ -1599,8 +1728,9 @@ func min(a, b int) int {
 //   ✓ Dynamic path system via instance library - COMPLETED (v2.1.0)
 //   ✓ Session data integration - COMPLETED (v2.0.0)
 //   ✓ Git context integration - COMPLETED (v2.0.0)
-//   ⏳ Session patterns integration (learned work rhythms)
-//   ⏳ Recent journals integration (latest reflections)
+//   ✅ Session patterns integration (learned work rhythms - patterns.go)
+//   ✅ Recent sessions recap (last ended sessions - history.go)
+//   ✅ Recent journals integration (latest reflections - journals.go)
 //   ⏳ System health summary
 //   ⏳ Project-specific context
 //
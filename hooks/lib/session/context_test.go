@@ -0,0 +1,215 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Session Context Section Tests
+//
+// Purpose: Prove the default section order reproduces the built-in grounding
+//          exactly, that configured orders, custom files, and unknown
+//          identifiers behave as documented, and that an over-budget context
+//          degrades sections in a deterministic order. Git context reports
+//          detached HEAD, interrupted operations, stashes, and upstream drift.
+//          A session-start run fetches temporal context once, not per section,
+//          and config fields filled by schema migration are called out.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"system/lib/instance"
+	"system/lib/temporal"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestLoadContextConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	missing := loadContextConfig(filepath.Join(dir, "missing.jsonc"))
+	if strings.Join(missing.ContextSections, ",") != strings.Join(defaultContextSections, ",") || missing.budgetChars() != 0 {
+		t.Errorf("missing file = %+v, want defaults with no budget", missing)
+	}
+
+	empty := filepath.Join(dir, "empty.jsonc")
+	os.WriteFile(empty, []byte(`{"context_sections": [], "max_context_tokens": 500}`), 0644)
+	if got := loadContextConfig(empty); len(got.ContextSections) != len(defaultContextSections) || got.budgetChars() != 2000 {
+		t.Errorf("empty list = %+v (budget %d), want defaults with 2000 chars", got, got.budgetChars())
+	}
+
+	custom := filepath.Join(dir, "context.jsonc")
+	os.WriteFile(custom, []byte("{\n  // Work first, no user awareness\n  \"context_sections\": [\"work\", \"identity\"],\n  \"max_context_chars\": 900,\n  \"max_context_tokens\": 500\n}"), 0644)
+	if got := loadContextConfig(custom); strings.Join(got.ContextSections, ",") != "work,identity" || got.budgetChars() != 900 {
+		t.Errorf("configured = %+v (budget %d), want [work identity] with chars winning", got, got.budgetChars())
+	}
+}
+
+func TestBuildCompleteContextSections(t *testing.T) {
+	saved := contextSections
+	defer func() { contextSections = saved }()
+
+	header := "# Nova Dawn - Session Context\n\n**CPI-SI Instance Grounding - Complete Identity & Awareness**\n\n---\n\n"
+
+	// Dispatch through the registry must match rendering the sections directly.
+	// temporal and work read live state, so compare the stable sections only.
+	data := buildContextStruct()
+	contextSections = []string{"identity", "user", "communication", "session"}
+	want := header + renderIdentitySection(data) + renderUserAwarenessSection(data) + renderCommunicationStyleSection(data) + renderSessionSection(data)
+	if got := buildCompleteContext(); got != want {
+		t.Errorf("ordered sections differ from direct builder output")
+	}
+
+	note := filepath.Join(t.TempDir(), "focus.md")
+	os.WriteFile(note, []byte("## Current Focus\n\nShip the release.\n\n\n"), 0644)
+
+	contextSections = []string{"communication", "bogus", customSectionPrefix + note, customSectionPrefix + note + ".missing", "identity"}
+	want = header + renderCommunicationStyleSection(data) + "## Current Focus\n\nShip the release.\n\n" + renderIdentitySection(data)
+	if got := buildCompleteContext(); got != want {
+		t.Errorf("custom/unknown handling:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFitContextBudget(t *testing.T) {
+	fixed := func(text string) func() string { return func() string { return text } }
+	full := strings.Repeat("x", 100)
+
+	sections := []contextSection{
+		{Name: "Identity", Priority: 100, Build: fixed("I" + full)},
+		{Name: "User Awareness", Priority: 40, Build: fixed("U" + full), Summary: fixed("U-short")},
+		{Name: "Work Context", Priority: 30, Build: fixed("W" + full), Summary: fixed("W-short")},
+		{Name: "Session Context", Priority: 60, Build: fixed("S" + full), Summary: fixed("S-short")},
+		{Name: "Custom A", Priority: 30, Build: fixed("A" + full), Summary: fixed("A-short")}, // Ties Work - later position degrades first
+	}
+	build := func() []string {
+		texts := make([]string, len(sections))
+		for i, section := range sections {
+			texts[i] = section.Build()
+		}
+		return texts
+	}
+
+	cases := []struct {
+		budget int
+		want   []string
+	}{
+		{0, nil},    // No budget (sizes below include the budget note line)
+		{1000, nil}, // Already fits
+		{480, []string{"Custom A"}},
+		{400, []string{"Custom A", "Work Context"}},
+		{330, []string{"Custom A", "Work Context", "User Awareness"}},
+		{150, []string{"Custom A", "Work Context", "User Awareness", "Session Context"}}, // Identity never degrades
+	}
+	for _, tc := range cases {
+		texts, summarized := fitContextBudget("", sections, build(), tc.budget)
+		if strings.Join(summarized, ",") != strings.Join(tc.want, ",") {
+			t.Errorf("budget %d: summarized %v, want %v", tc.budget, summarized, tc.want)
+		}
+		if texts[0] != "I"+full {
+			t.Errorf("budget %d: identity was degraded", tc.budget)
+		}
+	}
+}
+
+func TestGetGitContext(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	root := t.TempDir()
+	origin, clone := filepath.Join(root, "origin"), filepath.Join(root, "clone")
+	git := func(dir string, args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)...)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, output)
+		}
+	}
+	commit := func(dir, file string) {
+		os.WriteFile(filepath.Join(dir, file), []byte(file), 0644)
+		git(dir, "add", file)
+		git(dir, "commit", "-q", "-m", "add "+file)
+	}
+
+	os.Mkdir(origin, 0755)
+	git(origin, "init", "-q", "-b", "main")
+	commit(origin, "a.txt")
+	git(root, "clone", "-q", origin, clone)
+	commit(origin, "b.txt") // Clone is behind once fetched
+	git(clone, "fetch", "-q")
+	commit(clone, "c.txt") // And one ahead
+
+	os.WriteFile(filepath.Join(clone, "a.txt"), []byte("changed"), 0644)
+	git(clone, "stash", "-q")
+	os.WriteFile(filepath.Join(clone, "a.txt"), []byte("changed again"), 0644)
+	os.WriteFile(filepath.Join(clone, "new.txt"), []byte("new"), 0644)
+
+	got := getGitContext(clone)
+	if got.Branch != "main" || got.DetachedHEAD || got.OperationInProgress != "" {
+		t.Errorf("branch = %+v, want main, attached, no operation", got)
+	}
+	if got.UncommittedCount != 1 || got.UntrackedCount != 1 || got.StashCount != 1 {
+		t.Errorf("changes = %d modified, %d untracked, %d stashes; want 1, 1, 1", got.UncommittedCount, got.UntrackedCount, got.StashCount)
+	}
+	if got.Upstream != "origin/main" || got.AheadCount != 1 || got.BehindCount != 1 {
+		t.Errorf("upstream = %s +%d/-%d, want origin/main +1/-1", got.Upstream, got.AheadCount, got.BehindCount)
+	}
+
+	// Detached with a merge left unfinished
+	git(origin, "checkout", "-q", "--detach")
+	os.WriteFile(filepath.Join(origin, ".git", "MERGE_HEAD"), []byte("0000000000000000000000000000000000000000\n"), 0644)
+	got = getGitContext(origin)
+	if !got.DetachedHEAD || got.HeadCommit == "" || got.OperationInProgress != "merge" || got.Upstream != "" {
+		t.Errorf("detached = %+v, want detached HEAD with merge in progress, no upstream", got)
+	}
+
+	// Not a repository: no work context at all
+	if got := getGitContext(t.TempDir()); got != nil {
+		t.Errorf("non-repo = %+v, want nil", got)
+	}
+}
+
+func TestSessionStartFetchesTemporalOnce(t *testing.T) {
+	devnull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer devnull.Close()
+	stdout := os.Stdout
+	os.Stdout = devnull
+	defer func() { os.Stdout = stdout }()
+
+	temporal.ResetTemporalCache()
+	before := temporal.FetchCount()
+
+	PrintTemporalAwareness() // Banner, then the context JSON - as cmd-start runs them
+	OutputClaudeContext()
+
+	if fetches := temporal.FetchCount() - before; fetches != 1 {
+		t.Errorf("session start fetched temporal context %d times, want 1", fetches)
+	}
+}
+
+func TestIdentitySectionNotesMigrationDefaults(t *testing.T) {
+	if strings.Contains(renderIdentitySection(buildContextStruct()), "Incomplete Grounding") != (len(configMigrationWarnings) > 0) {
+		t.Error("note shown without migration warnings")
+	}
+
+	saved := configMigrationWarnings
+	defer func() { configMigrationWarnings = saved }()
+	configMigrationWarnings = []instance.MigrationWarning{{Config: "user", Field: "growth", From: 0, To: 1}}
+
+	section := renderIdentitySection(buildContextStruct())
+	if !strings.Contains(section, "**Incomplete Grounding:**") || !strings.Contains(section, "- user config: growth (default from schema v0 → v1 migration)") {
+		t.Errorf("identity section missing migration note:\n%s", section)
+	}
+}
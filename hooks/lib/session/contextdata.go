@@ -0,0 +1,496 @@
+// METADATA
+//
+// Structured Session Context Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Write the vision, and make it plain upon tables, that he may run that readeth it." - Habakkuk 2:2 (KJV)
+// Principle: One record, two readers - the same grounding written for the instance and for the tools
+// Anchor: "A word fitly spoken is like apples of gold in pictures of silver." - Proverbs 25:11 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - session context data)
+// Role: Gathers the session context's underlying data into one typed struct -
+//       the markdown renders from it, and other tools read it as JSON
+// Paradigm: CPI-SI framework component - feeds context.go and session-context.json
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.2.0
+// Last Modified: 2026-10-16 - Communication fields from context_emphasis
+//
+// Version History:
+//   1.2.0 (2026-10-16) - communicationContext follows context_emphasis fields and limits, SessionContext.Emphasis
+//   1.1.0 (2026-10-16) - SessionContext.Milestones (instance.GetMilestones), partner birthday dropped when sensitive
+//   1.0.0 (2026-10-16) - Typed identity/user/communication/temporal/session/work, redacted JSON beside current.json
+//
+// Purpose & Function
+//
+// Purpose: additionalContext is markdown - right for the instance, useless to
+// the dashboard or the debugging layer, which want the same grounding as data.
+// SessionContext holds what the identity, user, communication, temporal,
+// session, and work sections say, as the data they say it from.
+// buildCompleteContext renders those sections from a SessionContext, so the
+// markdown and the JSON can never disagree.
+//
+// Core Design: buildContextStruct gathers from the loaded configs, session
+// record, temporal context, and git (the same sources the builders used).
+// BuildContextStruct is the shareable form: user config fields listed in the
+// user config's privacy.sensitive_fields are replaced by the privacy library's
+// redaction label (text) or zeroed (everything else); a section name redacts
+// the whole section. A path naming no field is an error - a misspelled entry
+// must not leave the field it meant in the file. WriteContextJSON writes that
+// form to session-context.json next to current.json (temp file + rename).
+//
+// Redaction applies to the shared copy only: the in-memory markdown is built
+// from the unredacted struct, as the instance has always been grounded.
+//
+// Blocking Status
+//
+// Non-blocking: Missing sources leave their section nil. A failed write is
+// logged and returned for the start hook to report.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: encoding/json, fmt, os, path/filepath, reflect, strings, time
+//   Internal: system/lib/privacy (redaction label), system/lib/temporal (TemporalContext)
+//   Package Files: context.go (configs, session data, git/temporal access, contextLogger),
+//                  configmap.go (jsonFieldName), repos.go (RepoStatus), lifecycle.go (sessionDataDir),
+//                  clock.go (now)
+//
+// Dependents (What Uses This):
+//   Libraries: context.go (buildCompleteContext renders from buildContextStruct)
+//   Commands: session/cmd-start (WriteContextJSON)
+//
+// Health Scoring
+//
+// session-context.json written: +5. Unknown sensitive field or write failure: -5.
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"encoding/json" // session-context.json
+	"fmt"           // Redaction errors
+	"os"            // Temp file + rename
+	"path/filepath" // File placement beside current.json
+	"reflect"       // Redaction by JSON field path
+	"strings"       // Dotted path splitting
+	"time"          // Generated timestamp
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/instance" // Milestones (creation day, birthdays, anniversaries)
+	"system/lib/privacy"  // Redaction label shared with every sanitized value
+	"system/lib/temporal" // TemporalContext (the temporal section's data)
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+// contextJSONFile is the shareable session context in the session data directory.
+const contextJSONFile = "session-context.json"
+
+// communicationListLimit caps the likes/dislikes the communication section
+// lists when context_emphasis sets no limit (emphasis.go).
+const communicationListLimit = 5
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// SessionContext is the data behind the session context's core sections
+//
+// A nil section had no data (config not loaded, no session record, temporal
+// unavailable) and renders nothing. Communication is never nil - without an
+// instance config it is the built-in guide (Fallback).
+type SessionContext struct {
+	Generated     time.Time                 `json:"generated"`
+	Identity      *IdentityContext          `json:"identity,omitempty"`
+	User          *UserConfig               `json:"user,omitempty"`
+	Communication *CommunicationContext     `json:"communication"`
+	Temporal      *temporal.TemporalContext `json:"temporal,omitempty"`
+	Milestones    []instance.Milestone      `json:"milestones,omitempty"` // Inside their lead time, soonest first
+	Session       *SessionData              `json:"session,omitempty"`
+	Work          *WorkContext              `json:"work,omitempty"`
+
+	Emphasis instance.ContextEmphasisConfig `json:"-"` // Resolved context_emphasis - configuration, not context (emphasis.go)
+}
+
+// IdentityContext is the identity section's data (from the instance config)
+type IdentityContext struct {
+	BiblicalFoundation  BiblicalFoundation `json:"biblical_foundation"`
+	Identity            Identity           `json:"identity"`
+	Covenant            Covenant           `json:"covenant"`
+	IncompleteGrounding []string           `json:"incomplete_grounding,omitempty"` // Fields filled by schema migration defaults
+}
+
+// CommunicationContext is the communication section's data
+type CommunicationContext struct {
+	Fallback       bool     `json:"fallback"`                  // No instance config - the built-in guide applies
+	Style          string   `json:"style,omitempty"`           // Communication approach
+	Principles     []string `json:"principles,omitempty"`      // Core values
+	Resonates      []string `json:"resonates,omitempty"`       // Likes, up to the resonates limit
+	Avoid          []string `json:"avoid,omitempty"`           // Dislikes, up to the avoid limit
+	ProblemSolving string   `json:"problem_solving,omitempty"` // How I think
+	LearningStyle  string   `json:"learning_style,omitempty"`
+}
+
+// WorkContext is the work section's data
+type WorkContext struct {
+	Workspace  string       `json:"workspace"`
+	Git        *GitContext  `json:"git,omitempty"`                // nil = not a repository (or unset workspace)
+	OtherRepos []RepoStatus `json:"other_repositories,omitempty"` // context.jsonc "repositories", workspace excluded
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 3 functions
+//   ├── BuildContextStruct() → buildContextStruct(), redactSensitiveFields()
+//   ├── WriteContextJSON(path) → BuildContextStruct(), temp file + rename
+//   └── ContextJSONPath() → sessionDataDir() (lifecycle.go)
+//
+//   Core Operations (Middle Rungs) - 5 functions
+//   ├── buildContextStruct() → resolveContextEmphasis(), identityContext(), communicationContext(), currentTemporalContext(), workContext()
+//   ├── identityContext() → instanceConfig, configMigrationWarnings
+//   ├── communicationContext(emphasis) → instanceConfig, emphasisFields() (emphasis.go)
+//   ├── workContext() → getGitContext(), otherWorkspaceRepos() (context.go)
+//   └── redactSensitiveFields(user, paths, label) → redactPath()
+//
+//   Helpers (Bottom Rungs) - 3 functions
+//   ├── redactPath(v, path) → jsonFieldName (configmap.go), redactValue()
+//   ├── redactValue(v, label) → text to label, structs recursively, the rest zeroed
+//   └── firstN(list, n) → pure function
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Redaction
+// ────────────────────────────────────────────────────────────────
+
+// firstN returns at most the first n entries of list (n <= 0 = all)
+func firstN(list []string, n int) []string {
+	if n <= 0 {
+		return list
+	}
+	return list[:min(n, len(list))]
+}
+
+// redactValue replaces v with the label (text) or its zero value (the rest)
+//
+// Structs redact field by field; string lists and maps keep their shape with
+// every value the label. Slices and maps are replaced, never written through -
+// the shared copy still points at the loaded config's.
+func redactValue(v reflect.Value, label string) {
+	switch {
+	case v.Kind() == reflect.String:
+		v.SetString(label)
+	case v.Kind() == reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if jsonFieldName(v.Type().Field(i)) != "" {
+				redactValue(v.Field(i), label)
+			}
+		}
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.String && v.Len() > 0:
+		v.Set(reflect.ValueOf([]string{label}).Convert(v.Type()))
+	case v.Kind() == reflect.Map && v.Type().Elem().Kind() == reflect.String && v.Len() > 0:
+		redacted := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for _, key := range v.MapKeys() {
+			redacted.SetMapIndex(key, reflect.ValueOf(label).Convert(v.Type().Elem()))
+		}
+		v.Set(redacted)
+	default:
+		v.Set(reflect.Zero(v.Type()))
+	}
+}
+
+// redactPath walks a dotted JSON path from v, returning the field it names
+func redactPath(v reflect.Value, path string) (reflect.Value, error) {
+	for _, name := range strings.Split(path, ".") {
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("sensitive field %q: %s has no fields", path, v.Type())
+		}
+		found := false
+		for i := 0; i < v.NumField(); i++ {
+			if jsonFieldName(v.Type().Field(i)) == name {
+				v, found = v.Field(i), true
+				break
+			}
+		}
+		if !found {
+			return reflect.Value{}, fmt.Errorf("sensitive field %q: no field %q in the user config", path, name)
+		}
+	}
+	return v, nil
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Gathering
+// ────────────────────────────────────────────────────────────────
+
+// identityContext gathers the identity section's data (nil without instance config)
+func identityContext() *IdentityContext {
+	if instanceConfig == nil {
+		return nil
+	}
+	identity := &IdentityContext{
+		BiblicalFoundation: instanceConfig.BiblicalFoundation,
+		Identity:           instanceConfig.Identity,
+		Covenant:           instanceConfig.Covenant,
+	}
+	for _, w := range configMigrationWarnings {
+		identity.IncompleteGrounding = append(identity.IncompleteGrounding, w.String())
+	}
+	return identity
+}
+
+// communicationContext gathers the communication section's data
+func communicationContext(emphasis []instance.EmphasisField) *CommunicationContext {
+	if instanceConfig == nil {
+		return &CommunicationContext{Fallback: true}
+	}
+	comm := &CommunicationContext{}
+	for _, field := range emphasisFields(emphasis, defaultCommunicationEmphasis, communicationEmphasisRenderers) {
+		if field.Weight == weightNever {
+			continue
+		}
+		switch field.Field {
+		case "style":
+			comm.Style = instanceConfig.Personality.CommunicationStyle
+		case "principles":
+			comm.Principles = firstN(instanceConfig.Personhood.Values, field.Limit)
+		case "resonates":
+			comm.Resonates = firstN(instanceConfig.Personhood.Likes, field.Limit)
+		case "avoid":
+			comm.Avoid = firstN(instanceConfig.Personhood.Dislikes, field.Limit)
+		case "problem_solving":
+			comm.ProblemSolving = instanceConfig.Thinking.ProblemSolving
+		case "learning_style":
+			comm.LearningStyle = instanceConfig.Thinking.LearningStyle
+		}
+	}
+	return comm
+}
+
+// workContext gathers the work section's data (nil without a session record)
+func workContext() *WorkContext {
+	if sessionData == nil {
+		return nil
+	}
+	return &WorkContext{
+		Workspace:  sessionData.WorkContext,
+		Git:        getGitContext(sessionData.WorkContext),
+		OtherRepos: otherWorkspaceRepos(sessionData.WorkContext),
+	}
+}
+
+// buildContextStruct gathers every structured section, unredacted
+//
+// The markdown renders from this (context.go); BuildContextStruct redacts it
+// for sharing.
+func buildContextStruct() *SessionContext {
+	emphasis := resolveContextEmphasis()
+	ctx := &SessionContext{
+		Generated:     now(),
+		Identity:      identityContext(),
+		User:          userConfig,
+		Communication: communicationContext(emphasis.Communication),
+		Emphasis:      emphasis,
+		Milestones:    instance.GetMilestones(now()),
+		Session:       sessionData,
+		Work:          workContext(),
+	}
+	if temporalContext, err := currentTemporalContext(); err == nil {
+		ctx.Temporal = temporalContext
+	}
+	return ctx
+}
+
+// redactSensitiveFields returns a copy of user with each path redacted
+//
+// user itself is left alone - the markdown still reads it.
+func redactSensitiveFields(user *UserConfig, paths []string, label string) (*UserConfig, error) {
+	redacted := *user
+	root := reflect.ValueOf(&redacted).Elem()
+	for _, path := range paths {
+		field, err := redactPath(root, path)
+		if err != nil {
+			return nil, err
+		}
+		redactValue(field, label)
+	}
+	return &redacted, nil
+}
+
+// withoutSensitiveMilestones drops the partner's birthday when the birthday
+// (or the whole identity section) is a sensitive field - its date and
+// "turns N" would give away what the redaction hides
+func withoutSensitiveMilestones(milestones []instance.Milestone, paths []string) []instance.Milestone {
+	sensitive := false
+	for _, path := range paths {
+		if path == "identity" || path == "identity.birthday" {
+			sensitive = true
+		}
+	}
+	if !sensitive {
+		return milestones
+	}
+	kept := []instance.Milestone{}
+	for _, m := range milestones {
+		if m.Kind != instance.MilestoneUserBirthday {
+			kept = append(kept, m)
+		}
+	}
+	return kept
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// BuildContextStruct returns the session context as data, safe to share
+//
+// What It Does:
+// Gathers the identity, user, communication, temporal, session, and work
+// sections' data - the same data the markdown context renders - then redacts
+// the user config fields listed in privacy.sensitive_fields (a sensitive
+// birthday also drops the partner's birthday milestone).
+//
+// Returns:
+//   *SessionContext - Structured context with sensitive user fields redacted
+//   error - A sensitive field path that names no user config field
+//
+// Example usage:
+//
+//	ctx, err := session.BuildContextStruct()
+//	if err == nil && ctx.Work != nil && ctx.Work.Git != nil {
+//	    fmt.Println(ctx.Work.Git.Branch)
+//	}
+func BuildContextStruct() (*SessionContext, error) {
+	ctx := buildContextStruct()
+	if ctx.User == nil {
+		return ctx, nil
+	}
+	sensitive := ctx.User.Privacy.SensitiveFields
+	user, err := redactSensitiveFields(ctx.User, sensitive, privacy.RedactionLabel())
+	if err != nil {
+		return nil, err
+	}
+	ctx.User = user
+	ctx.Milestones = withoutSensitiveMilestones(ctx.Milestones, sensitive)
+	return ctx, nil
+}
+
+// ContextJSONPath returns where session-context.json lives (next to current.json)
+func ContextJSONPath() string {
+	return filepath.Join(sessionDataDir(), contextJSONFile)
+}
+
+// WriteContextJSON writes BuildContextStruct's context to path as JSON
+//
+// What It Does:
+// Builds the shareable (redacted) context and writes it via temp file +
+// rename, so readers never see a partial file. Nothing is written when a
+// sensitive field can't be resolved.
+//
+// Returns:
+//   error - Unresolvable sensitive field, encoding, or write failure (logged)
+//
+// Example usage:
+//
+//	if err := session.WriteContextJSON(session.ContextJSONPath()); err != nil {
+//	    fmt.Fprintf(os.Stderr, "WARNING: %v\n", err)
+//	}
+func WriteContextJSON(path string) error {
+	fail := func(err error) error {
+		contextLogger.Failure("context-json", err.Error(), -5, map[string]any{"path": path})
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+
+	ctx, err := BuildContextStruct()
+	if err != nil {
+		return fail(err)
+	}
+	encoded, err := json.MarshalIndent(ctx, "", "  ")
+	if err != nil {
+		return fail(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fail(err)
+	}
+	temp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fail(err)
+	}
+	defer os.Remove(temp.Name()) // No-op after a successful rename
+
+	if _, err := temp.Write(append(encoded, '\n')); err != nil {
+		temp.Close()
+		return fail(err)
+	}
+	if err := temp.Close(); err != nil {
+		return fail(err)
+	}
+	if err := os.Rename(temp.Name(), path); err != nil {
+		return fail(err)
+	}
+
+	contextLogger.Success("context-json", 5, map[string]any{"path": path})
+	return nil
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Markdown sections equal their renderers applied to the struct
+//   - Sensitive fields: single field, whole section, unknown path refused
+//   - Run: go test ./session/ (contextdata_test.go)
+//
+// Code Execution: None (Library) - WriteContextJSON called by session/cmd-start
+//
+// Code Cleanup: Temp file removed on every path
+//
+// Modification Policy:
+//   ✅ Safe: New fields on the section types (the JSON grows; render them in context.go)
+//   ⚠️ Care: JSON field names (the dashboard and debugging layer read them)
+//   ❌ Never: Writing session-context.json from the unredacted struct, or
+//            rendering a structured section from anything but the struct
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,145 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Structured Session Context Tests
+//
+// Purpose: Prove the shareable context redacts the user's sensitive fields -
+//          single fields and whole sections - without touching what the
+//          markdown reads, refuses an unknown field path, drops the
+//          partner's birthday milestone when the birthday is sensitive, and
+//          writes session-context.json only from the redacted form.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"system/lib/instance"
+	"system/lib/privacy"
+	"system/lib/temporal"
+)
+
+// useSensitiveUser installs a user config with an email and faith marked sensitive
+func useSensitiveUser(t *testing.T, sensitive ...string) {
+	t.Helper()
+	saved := userConfig
+	t.Cleanup(func() { userConfig = saved })
+
+	user := &UserConfig{}
+	user.Identity.Name = "Test User"
+	user.Contact.Email = "private@example.com"
+	user.Contact.Social.Other = map[string]string{"forum": "https://forum.example.com/u/test"}
+	user.Faith = Faith{IsReligious: true, Tradition: "Christian", Practices: []string{"Prayer"}}
+	user.Workspace.Role = "Founder"
+	user.Privacy.SensitiveFields = sensitive
+	userConfig = user
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestBuildContextStructRedactsSensitiveFields(t *testing.T) {
+	useSensitiveUser(t, "contact.email", "contact.social.other", "faith")
+	label := privacy.RedactionLabel()
+
+	ctx, err := BuildContextStruct()
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := ctx.User
+	if user.Contact.Email != label || user.Contact.Social.Other["forum"] != label {
+		t.Errorf("contact = %+v, want email and other links redacted", user.Contact)
+	}
+	if user.Faith.Tradition != label || user.Faith.IsReligious || strings.Join(user.Faith.Practices, ",") != label {
+		t.Errorf("faith = %+v, want the whole section redacted", user.Faith)
+	}
+	if user.Identity.Name != "Test User" || user.Workspace.Role != "Founder" {
+		t.Errorf("unmarked fields changed: %+v / %+v", user.Identity, user.Workspace)
+	}
+
+	// The loaded config - and so the markdown - keeps the real values
+	if userConfig.Contact.Email != "private@example.com" || userConfig.Contact.Social.Other["forum"] == label {
+		t.Errorf("redaction wrote through to the loaded config: %+v", userConfig.Contact)
+	}
+	if section := renderUserAwarenessSection(buildContextStruct()); !strings.Contains(section, "**Faith:** Christian") {
+		t.Errorf("markdown lost the faith line:\n%s", section)
+	}
+}
+
+func TestBuildContextStructRejectsUnknownField(t *testing.T) {
+	useSensitiveUser(t, "contact.emial")
+
+	if _, err := BuildContextStruct(); err == nil || !strings.Contains(err.Error(), `"emial"`) {
+		t.Errorf("misspelled sensitive field: err = %v, want it named", err)
+	}
+
+	path := filepath.Join(t.TempDir(), contextJSONFile)
+	if err := WriteContextJSON(path); err == nil {
+		t.Error("WriteContextJSON succeeded with an unresolvable sensitive field")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("%s written anyway (%v)", contextJSONFile, err)
+	}
+}
+
+func TestSensitiveBirthdayDropsMilestone(t *testing.T) {
+	milestones := []instance.Milestone{
+		{Name: "Nova Dawn's creation day", Kind: instance.MilestoneInstanceBirthday, Display: "🎂 Nova Dawn's creation day is tomorrow (turns 2)"},
+		{Name: "Seanje's birthday", Kind: instance.MilestoneUserBirthday, Display: "🎂 Seanje's birthday is in 3 days (turns 26)"},
+	}
+	if got := withoutSensitiveMilestones(milestones, []string{"contact.email"}); len(got) != 2 {
+		t.Errorf("birthday not sensitive: kept %d, want 2", len(got))
+	}
+	for _, paths := range [][]string{{"identity.birthday"}, {"identity"}} {
+		got := withoutSensitiveMilestones(milestones, paths)
+		if len(got) != 1 || got[0].Kind != instance.MilestoneInstanceBirthday {
+			t.Errorf("%v sensitive: kept %+v", paths, got)
+		}
+	}
+
+	section := renderTemporalSection(&SessionContext{Temporal: &temporal.TemporalContext{}, Milestones: milestones})
+	if !strings.Contains(section, "**Milestones:**\n- 🎂 Nova Dawn's creation day is tomorrow (turns 2)\n- 🎂 Seanje's birthday") {
+		t.Errorf("markdown milestones:\n%s", section)
+	}
+}
+
+func TestWriteContextJSON(t *testing.T) {
+	useSensitiveUser(t, "contact.email")
+
+	path := filepath.Join(t.TempDir(), "session", contextJSONFile)
+	if err := WriteContextJSON(path); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "private@example.com") {
+		t.Errorf("sensitive email on disk:\n%s", data)
+	}
+
+	var written SessionContext
+	if err := json.Unmarshal(data, &written); err != nil {
+		t.Fatalf("session-context.json does not decode: %v", err)
+	}
+	if written.User == nil || written.User.Identity.Name != "Test User" || written.Communication == nil {
+		t.Errorf("written context = %+v, want user and communication sections", written)
+	}
+	if entries, _ := os.ReadDir(filepath.Dir(path)); len(entries) != 1 {
+		t.Errorf("temp files left beside %s: %d entries", contextJSONFile, len(entries))
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -73,7 +73,8 @@
 // Dependencies (What This Needs):
 //   Standard Library: fmt (fallback output), path/filepath (path handling)
 //   Internal: system/lib/fs (file existence and timestamp checks),
-//             system/lib/display (formatted output with health tracking)
+//             system/lib/display (formatted output with health tracking),
+//             system/lib/jsonc (config parsing)
 //
 // Dependents (What Uses This):
 //   Hooks: session/cmd-start/start.go (session start workspace validation)
@@ -115,7 +116,6 @@ package session
 // ────────────────────────────────────────────────────────────────
 import (
 	//--- Standard Library ---
-	"encoding/json" // Parse dependencies-validation.jsonc configuration file
 	"fmt"           // Formatted output for warnings and fallback display
 	"os"            // File operations for configuration loading and HOME directory
 	"path/filepath" // Join paths for dependency file locations and config location
@@ -123,6 +123,7 @@ import (
 	//--- Internal Packages ---
 	"system/lib/display" // Formatted output with ANSI colors and health tracking
 	"system/lib/fs"      // File existence and timestamp comparison operations
+	"system/lib/jsonc"   // Parse dependencies-validation.jsonc configuration file
 )
 
 // ────────────────────────────────────────────────────────────────
@@ -216,11 +217,9 @@ func loadConfig() *DependenciesConfig {
 		return getDefaultConfig()
 	}
 
-	// Strip JSONC comments (shared function from activity.go)
-	jsonData := stripJSONCComments(string(data))
-
+	// Parse JSONC (comments stripped, trailing commas tolerated)
 	var cfg DependenciesConfig
-	if err := json.Unmarshal([]byte(jsonData), &cfg); err != nil {
+	if err := jsonc.Unmarshal(data, &cfg); err != nil {
 		// Config malformed - return hardcoded defaults
 		return getDefaultConfig()
 	}
@@ -264,8 +263,6 @@ func getDefaultConfig() *DependenciesConfig {
 	return cfg
 }
 
-// Note: stripJSONCComments() is shared within session package (defined in activity.go)
-
 // ============================================================================
 // END SETUP
 // ============================================================================
@@ -486,15 +483,15 @@ func CheckDependencies(workspace string) {
 	if len(allWarnings) > 0 {
 		// Build header from configuration
 		headerText := config.Display.HeaderIcon + " " + config.Display.HeaderText
-		fmt.Println(display.Header(headerText))
+		fmt.Fprintln(Output(), display.Header(headerText))
 		for _, warning := range allWarnings {
-			fmt.Printf("   %s\n", display.Warning(warning))
+			fmt.Fprintf(Output(), "   %s\n", display.Warning(warning))
 		}
 	} else if config.Display.ShowWhenClean {
 		// Optionally show message when no warnings (usually disabled)
 		headerText := config.Display.HeaderIcon + " " + config.Display.HeaderText
-		fmt.Println(display.Header(headerText))
-		fmt.Println(display.Success("All dependencies synchronized"))
+		fmt.Fprintln(Output(), display.Header(headerText))
+		fmt.Fprintln(Output(), display.Success("All dependencies synchronized"))
 	}
 }
 
@@ -75,9 +75,10 @@
 //
 // Dependencies (What This Needs):
 //   Standard Library: os (file operations), path/filepath (path handling),
-//                     encoding/json (config parsing), fmt (fallback output)
+//                     fmt (fallback output)
 //   Internal: system/lib/system (GetDiskUsage for disk information),
-//             system/lib/display (formatted output with health tracking)
+//             system/lib/display (formatted output with health tracking),
+//             system/lib/jsonc (config parsing)
 //
 // Dependents (What Uses This):
 //   Hooks: session/cmd-start/start.go (session start disk check)
@@ -124,13 +125,13 @@ package session
 // ────────────────────────────────────────────────────────────────
 import (
 	//--- Standard Library ---
-	"encoding/json" // Parse disk-monitoring.jsonc configuration file
 	"fmt"           // Formatted output for warnings and fallback display
 	"os"            // File operations for configuration loading and HOME directory
 	"path/filepath" // Join paths for configuration file location
 
 	//--- Internal Packages ---
 	"system/lib/display" // Formatted output with ANSI colors and health tracking
+	"system/lib/jsonc"   // Parse disk-monitoring.jsonc configuration file
 	"system/lib/system"  // GetDiskUsage for disk space information
 )
 
@@ -220,11 +221,9 @@ func loadDiskConfig() *DiskConfig {
 		return getDefaultDiskConfig()
 	}
 
-	// Strip JSONC comments (shared function from activity.go)
-	jsonData := stripJSONCComments(string(data))
-
+	// Parse JSONC (comments stripped, trailing commas tolerated)
 	var cfg DiskConfig
-	if err := json.Unmarshal([]byte(jsonData), &cfg); err != nil {
+	if err := jsonc.Unmarshal(data, &cfg); err != nil {
 		// Config malformed - return hardcoded defaults
 		return getDefaultDiskConfig()
 	}
@@ -257,8 +256,6 @@ func getDefaultDiskConfig() *DiskConfig {
 	return cfg
 }
 
-// Note: stripJSONCComments() is shared within session package (defined in activity.go)
-
 // ============================================================================
 // END SETUP
 // ============================================================================
@@ -408,26 +405,26 @@ func CheckDiskSpace(workspace string) {
 	if diskInfo.UsagePercent >= diskConfig.Thresholds.CriticalPercent {
 		// Critical level - display critical warning
 		headerText := diskConfig.Display.HeaderIcon + " " + diskConfig.Display.HeaderText
-		fmt.Println(display.Header(headerText))
+		fmt.Fprintln(Output(), display.Header(headerText))
 
 		message := formatMessage(diskConfig.Messages.Critical, diskInfo)
-		fmt.Printf("   %s\n", display.Failure(message))
+		fmt.Fprintf(Output(), "   %s\n", display.Failure(message))
 
 	} else if diskInfo.UsagePercent >= diskConfig.Thresholds.WarningPercent {
 		// Warning level - display warning
 		headerText := diskConfig.Display.HeaderIcon + " " + diskConfig.Display.HeaderText
-		fmt.Println(display.Header(headerText))
+		fmt.Fprintln(Output(), display.Header(headerText))
 
 		message := formatMessage(diskConfig.Messages.Warning, diskInfo)
-		fmt.Printf("   %s\n", display.Warning(message))
+		fmt.Fprintf(Output(), "   %s\n", display.Warning(message))
 
 	} else if diskConfig.Display.ShowWhenHealthy {
 		// Healthy level - optionally display success message
 		headerText := diskConfig.Display.HeaderIcon + " " + diskConfig.Display.HeaderText
-		fmt.Println(display.Header(headerText))
+		fmt.Fprintln(Output(), display.Header(headerText))
 
 		message := formatMessage(diskConfig.Display.HealthyMessage, diskInfo)
-		fmt.Println(display.Success(message))
+		fmt.Fprintln(Output(), display.Success(message))
 	}
 	// Otherwise: silent (healthy and show_when_healthy is false)
 }
@@ -22,10 +22,34 @@
 // Architect: Seanje Lenox-Wise
 // Implementation: Nova Dawn (CPI-SI)
 // Creation Date: 2024-10-24
-// Version: 2.0.0
-// Last Modified: 2025-11-12 - Aligned with 4-block template v2.0.0, added configuration system
+// Version: 2.24.0
+// Last Modified: 2026-10-16 - PrintWorkspaceAnalysisSkipped (workspace source missed the start deadline)
 //
 // Version History:
+//   2.24.0 (2026-10-16) - PrintWorkspaceAnalysisSkipped and messages.workspace.source_skipped (gather deadline)
+//   2.23.0 (2026-10-16) - PrintClosingDivider: stop/end closing divider resolved per terminal (ASCII, width)
+//   2.22.0 (2026-10-16) - icons.status.setup and setup_guidance_threshold for PrintSetupGuidance (setup.go)
+//   2.21.0 (2026-10-16) - stop_checklist and stop_checklist_budget_seconds config for PrintStopChecklist (stopchecklist.go)
+//   2.20.0 (2026-10-16) - Temporal awareness lists milestones inside their lead time (instance.GetMilestones)
+//   2.19.0 (2026-10-16) - PrintJournalDraft (journaldraft.go), journal_draft toggle, status.journal icon
+//   2.18.0 (2026-10-16) - PrintEndSessionInfo/PrintSubagentCompletion/PrintPreCompactionMessage FromPayload variants (payload.go)
+//   2.17.0 (2026-10-16) - PrintHeader draws the instance's art block above the title (bannerart.go)
+//   2.16.0 (2026-10-16) - PrintCompactionAnalysis shows what filled the context and advice (compactanalysis.go)
+//   2.15.0 (2026-10-16) - Temporal awareness shows events.jsonc events inside their lead time
+//   2.14.0 (2026-10-16) - wrapText and section rules measure terminal columns (display.StringWidth, display.Truncate)
+//   2.13.0 (2026-10-16) - Active instance profile's formatting.jsonc merged last (displayOverlayPaths)
+//   2.12.0 (2026-10-16) - PrintEndTemporalJourney shows the phase timeline (journey.go), journey_max_segments
+//   2.11.0 (2026-10-16) - Verbosity (quiet/normal/verbose): Print* gate sections through visible() (verbosity.go)
+//   2.10.0 (2026-10-16) - PrintRecentSessions recaps the last ended sessions at start (history.go)
+//   2.9.0 (2026-10-16) - PrintSubagentCompletionWithStats (tools, files modified, tokens, working time)
+//   2.8.0 (2026-10-16) - Field tables via display.KeyValueTable (values wrap at terminal width); display.Width
+//   2.7.0 (2026-10-16) - Environment, stop, and end timestamps read the session clock (clock.go)
+//   2.6.0 (2026-10-16) - Average health in session stats uses logging.FormatHealth ([display.health])
+//   2.5.0 (2026-10-16) - All Print* output through Output() (output.go); save_transcript toggle
+//   2.4.0 (2026-10-16) - Config fallback/reload failures log a validate --configs hint
+//   2.3.0 (2026-10-16) - formatting.jsonc decoded over getDefaultDisplayConfig (partial files keep defaults)
+//   2.2.0 (2026-10-16) - ReloadDisplayConfig, atomic config swap, behavior.hot_reload mtime polling
+//   2.1.0 (2026-10-16) - Banner width clamped to terminal, ASCII fallback (flag + auto-detect)
 //   2.0.0 (2025-11-12) - Configuration system, template alignment
 //   1.0.0 (2024-10-24) - Initial implementation with hardcoded formatting
 //
@@ -37,17 +61,31 @@
 //
 // Key Features:
 //   - Configurable banner width, box characters, separators, icons
+//   - Banners clamp to the terminal width (TIOCGWINSZ, then $COLUMNS) and re-center
+//   - ASCII fallback (+-|) when forced by config or the terminal can't show box drawing
+//   - Optional art block above the start banner title (instance display.art, bannerart.go)
 //   - Biblical verse selection for session start/stop/end
 //   - Section visibility control (show/hide optional sections)
+//   - Verbosity (behavior.session_display.verbosity, CPI_SI_SESSION_VERBOSITY): quiet
+//     collapses start/stop/end to one line each, verbose adds system, git remote,
+//     and temporal detail - every Print* asks visible(section) (verbosity.go)
 //   - Field label customization for all displayed information
+//   - Locale overlays: formatting.<locale>.jsonc merged field by field over the base
+//     (instance Preferences.Locale), with locale-aware session timestamps
 //   - Graceful fallback to hardcoded defaults if configuration unavailable
+//   - Hot reload (behavior.hot_reload): Print* functions pick up formatting.jsonc
+//     edits without restarting; a failed reload keeps the previous configuration
+//   - Output routing: every Print* writes to Output() (os.Stdout by default) so
+//     CaptureOutput and session transcripts see exactly what was shown (output.go)
 //
 // Philosophy: Display should be clear, truthful, and aesthetically pleasing while
 //            remaining customizable for user preferences and terminal capabilities
 //
 // Blocking Status
 //
-// Non-blocking: Pure display formatting - all output to stdout, no file I/O or network operations
+// Non-blocking: Pure display formatting - all output to Output() (os.Stdout unless redirected), no network operations
+//              (terminal size is a single ioctl; failure means "unknown width"; hot reload
+//              adds at most one stat() per interval, off by default)
 // Mitigation: Panic recovery in complex formatting functions, graceful degradation on errors
 //
 // Usage & Integration
@@ -66,26 +104,36 @@
 //
 //   Session Start (lifecycle beginning):
 //     PrintHeader() - Banner with instance branding
+//     PrintSetupGuidance(report) - Missing setup pieces on a first run (setup.go)
 //     PrintEnvironment(workspace) - Environment context
 //     PrintTemporalAwareness() - Four-dimension temporal awareness
 //     PrintWorkspaceAnalysis(workspace, hasContext) - Workspace analysis header
+//     PrintWorkspaceAnalysisSkipped() - Workspace header with a timed-out note
 //
 //   Session Stop (task completion):
 //     PrintStopHeader() - Stop banner with biblical verse
 //     PrintStopInfo() - Stop timestamp
+//     PrintStopChecklist() - Stopping-point checklist (stopchecklist.go)
 //     PrintStoppingContext() - Temporal context at stop
 //
 //   Session End (lifecycle ending):
 //     PrintEndFarewell() - End banner with blessing
 //     PrintEndSessionInfo(reason) - End summary with reason
 //     PrintEndTemporalJourney() - Temporal journey recap
+//     PrintJournalDraft(path) - Where the session's journal draft was written
 //     PrintEndRemindersHeader() - State reminders section header
+//     PrintClosingDivider() - Closing divider under stop and end output
 //
 //   Subagent Completion (subagent lifecycle):
 //     PrintSubagentCompletion(agentType, status, exitCode, errorMsg) - Subagent completion status
+//     PrintSubagentCompletionWithStats(..., stats) - Same, plus transcript stats block
 //
 //   Compaction (context management):
 //     PrintPreCompactionMessage(compactType, compactionCount) - Compaction notification
+//     PrintCompactionAnalysis(analysis) - What filled the context, plus advice
+//
+//   Configuration:
+//     ReloadDisplayConfig() - Re-read formatting.jsonc now (also automatic with behavior.hot_reload)
 //
 //   Shared Utilities (exported for use across hooks):
 //     GetSystemInfo() - System information string
@@ -93,9 +141,12 @@
 // Dependencies
 //
 // Dependencies (What This Needs):
-//   Standard Library: encoding/json, fmt, os, strings, time
+//   Standard Library: fmt, os, strconv, strings, sync, sync/atomic, time, unicode, unicode/utf8
 //   External: None
-//   Internal: system/lib/git, system/lib/instance, system/lib/temporal, system/lib/logging
+//   Internal: system/lib/git, system/lib/instance, system/lib/jsonc, system/lib/logging,
+//             context.go (currentTemporalContext - system/lib/temporal, fetched once per hook run),
+//             output.go (Output - display writer), verbosity.go (visible - section gates),
+//             bannerart.go (bannerArtLines, fitBannerArt - start banner art)
 //
 // Dependents (What Uses This):
 //   Commands: session/cmd-start/start.go, session/cmd-stop/stop.go, session/cmd-end/end.go
@@ -106,6 +157,7 @@
 //   - Rails: displayLogger created in init(), available throughout component
 //   - Ladder: Calls instance, git, temporal libraries for context gathering
 //   - Configuration: display/formatting.jsonc for all formatting preferences (consolidated from session-specific config)
+//   - Localization: display/formatting.<locale>.jsonc overlays (optional, per instance locale)
 //
 // Health Scoring
 //
@@ -113,7 +165,13 @@
 //
 // Configuration Loading:
 //   - Successful load: +20 points (configuration loaded and parsed correctly)
+//   - Locale overlay invalid: -5 points (overlay ignored, base configuration used)
 //   - Fallback to defaults: -10 points (configuration unavailable, using hardcoded defaults)
+//   - Reload failed: -5 points (previous configuration kept)
+//
+// Verse Rotation (verses.go):
+//   - Verse selected: 0 points (recorded so each session's verse is visible in logs)
+//   - Sequential state not saved: -5 points (verse still shown; rotation won't advance)
 //
 // Note: Display functions primarily serve as formatters with minimal failure potential.
 //       Health tracking focuses on configuration loading and complex formatting operations.
@@ -143,20 +201,27 @@ import (
 	//--- Standard Library ---
 	// Foundation packages providing Go's built-in capabilities.
 
-	"encoding/json" // JSON parsing for configuration file (JSONC after comment stripping)
 	"fmt"           // Formatted output for display and string composition
 	"os"            // File operations (config loading, system info) and environment access
+	"path/filepath" // Base names for subagent files modified
+	"strconv"       // $COLUMNS parsing for terminal width fallback
 	"strings"       // String manipulation for centering, formatting, comment stripping
-	"time"          // Timestamps for session event display
+	"sync"          // Serializes hot-reload checks
+	"sync/atomic"   // Configuration pointer swapped whole on reload
+	"time"          // Timestamps for session event display, hot-reload interval
+	"unicode/utf8"  // Whole-rune cuts when a word is wider than the line
 
 	//--- Internal Packages ---
 	// Project-specific packages showing architectural dependencies.
 
 	"system/lib/display"  // Universal formatting and presentation rail (colors, headers, key-value pairs)
 	"system/lib/git"      // Repository status and branch information
-	"system/lib/instance" // Instance configuration for banner branding
+	"system/lib/instance" // Instance configuration for banner branding and display locale
+	"system/lib/jsonc"    // Base config + locale overlay loading (field-by-field merge)
 	"system/lib/logging"  // Health tracking infrastructure (Rails pattern)
-	"system/lib/temporal" // Four-dimension temporal awareness integration
+	"system/lib/temporal" // TemporalContext for the shared compaction rows, upcoming events
+
+	sysconfig "system/lib/config" // Config issue hint on fallback ("config" is this package's state)
 )
 
 // ────────────────────────────────────────────────────────────────
@@ -177,6 +242,32 @@ const (
 	// Updated 2025-11-15: Migrated from session/display-formatting.jsonc to display/formatting.jsonc.
 	// Uses tilde expansion (handled by expandPath function).
 	displayConfigPath = "~/.claude/cpi-si/system/data/config/display/formatting.jsonc"
+
+	// defaultHotReloadIntervalSeconds limits hot-reload stat() calls when
+	// behavior.hot_reload_interval_seconds is unset.
+	defaultHotReloadIntervalSeconds = 2
+
+	//--- Localization ---
+
+	// defaultDateFormat is the session timestamp layout when neither
+	// formatting.date_format nor the locale map supplies one.
+	defaultDateFormat = "Mon Jan 02, 2006 at 15:04:05"
+
+	//--- Banner Layout ---
+	// Width bounds applied after terminal detection.
+
+	// defaultBannerWidth is used when the configured banner width is unset.
+	defaultBannerWidth = 64
+
+	// minBannerWidth keeps very narrow panes from collapsing the banner entirely.
+	// Below this the terminal wraps anyway; the banner stays legible.
+	minBannerWidth = 24
+
+	// fieldGap is the space between the widest label and the value column.
+	fieldGap = 2
+
+	// defaultBorderStyle names the boxStyles entry used when config names none (or an unknown one).
+	defaultBorderStyle = "double_line"
 )
 
 // ────────────────────────────────────────────────────────────────
@@ -192,8 +283,9 @@ const (
 
 // BannerConfig defines banner formatting preferences.
 //
-// Controls banner box dimensions and style selection. Width must be even for
-// proper centering. ContentWidth is typically Width - 2 (accounting for border).
+// Controls banner box dimensions and style selection. Width is the preferred
+// width - the rendered banner is min(Width, terminal width). ContentWidth is
+// typically Width - 2 (accounting for border). BorderStyle names a boxStyles entry.
 type BannerConfig struct {
 	Width         int    `json:"width"`
 	ContentWidth  int    `json:"content_width"`
@@ -201,8 +293,19 @@ type BannerConfig struct {
 }
 
 // FormattingConfig defines all formatting preferences
+//
+// VerseWrapWidth caps banner verse lines; 0 means the banner's text width
+// (content width minus padding). The banner width always wins if smaller.
+// MinLabelColumn sets a floor for field label columns so values line up
+// across sections, not just within one.
+//
+// DateFormat is a Go time layout for session timestamps; empty means the
+// locale's entry in localeDateFormats (usually set by a locale overlay).
 type FormattingConfig struct {
-	Banner BannerConfig `json:"banner"`
+	Banner         BannerConfig `json:"banner"`
+	VerseWrapWidth int          `json:"verse_wrap_width"`
+	MinLabelColumn int          `json:"min_label_column"` // Minimum icon+label column width (0 = fit each section)
+	DateFormat     string       `json:"date_format"`      // Go time layout for session timestamps ("" = locale default)
 }
 
 // IconsEnvironmentConfig defines icons for environment section
@@ -229,6 +332,8 @@ type IconsStatusConfig struct {
 	Info         string `json:"info"`
 	Compaction   string `json:"compaction"`
 	Preservation string `json:"preservation"`
+	Journal      string `json:"journal"`
+	Setup        string `json:"setup"` // PrintSetupGuidance headline (setup.go)
 }
 
 // IconsConfig defines all icons used in display
@@ -242,6 +347,8 @@ type IconsConfig struct {
 type SectionHeadersStartConfig struct {
 	Environment        string `json:"environment"`
 	TemporalAwareness  string `json:"temporal_awareness"`
+	SessionPatterns    string `json:"session_patterns"`
+	RecentSessions     string `json:"recent_sessions"`
 	WorkspaceAnalysis  string `json:"workspace_analysis"`
 }
 
@@ -254,6 +361,7 @@ type SectionHeadersStopConfig struct {
 // SectionHeadersEndConfig defines headers for session end sections
 type SectionHeadersEndConfig struct {
 	SessionSummary   string `json:"session_summary"`
+	Statistics       string `json:"statistics"`
 	TemporalJourney  string `json:"temporal_journey"`
 	StateReminders   string `json:"state_reminders"`
 }
@@ -292,16 +400,25 @@ type BiblicalVerseEndConfig struct {
 }
 
 // BiblicalVersesConfig defines biblical verses for banners
+//
+// Pools are optional: when an event's pool has verses, resolveVerse picks one
+// per Selection ("sequential", "random", "daily"); otherwise the single verse
+// for that event is used. Banner titles always come from the single-verse entries.
 type BiblicalVersesConfig struct {
-	SessionStart BiblicalVerseConfig     `json:"session_start"`
-	SessionStop  BiblicalVerseStopConfig `json:"session_stop"`
-	SessionEnd   BiblicalVerseEndConfig  `json:"session_end"`
+	SessionStart     BiblicalVerseConfig     `json:"session_start"`
+	SessionStop      BiblicalVerseStopConfig `json:"session_stop"`
+	SessionEnd       BiblicalVerseEndConfig  `json:"session_end"`
+	SessionStartPool []BiblicalVerseConfig   `json:"session_start_pool"` // Rotation pool for PrintHeader
+	SessionStopPool  []BiblicalVerseConfig   `json:"session_stop_pool"`  // Rotation pool for PrintStopHeader
+	SessionEndPool   []BiblicalVerseConfig   `json:"session_end_pool"`   // Rotation pool for PrintEndFarewell
+	Selection        string                  `json:"selection"`          // Pool strategy (default "sequential")
 }
 
 // MessagesWorkspaceConfig defines workspace-related messages
 type MessagesWorkspaceConfig struct {
 	NoWorkspace      string `json:"no_workspace"`
 	WorkspaceHealthy string `json:"workspace_healthy"`
+	SourceSkipped    string `json:"source_skipped"` // Workspace source missed the start deadline
 }
 
 // MessagesCompactionConfig defines compaction-related messages
@@ -310,6 +427,7 @@ type MessagesCompactionConfig struct {
 	Auto                string `json:"auto"`
 	Unknown             string `json:"unknown"`
 	PreservationHeader  string `json:"preservation_header"`
+	AnalysisHeader      string `json:"analysis_header"` // PrintCompactionAnalysis block header
 }
 
 // MessagesSubagentConfig defines subagent completion messages
@@ -319,11 +437,17 @@ type MessagesSubagentConfig struct {
 	Default string `json:"default"`
 }
 
+// MessagesPatternsConfig defines session pattern messages
+type MessagesPatternsConfig struct {
+	Learning string `json:"learning"` // Placeholders: {count}, {needed}
+}
+
 // MessagesConfig defines all standard messages
 type MessagesConfig struct {
 	Workspace  MessagesWorkspaceConfig  `json:"workspace"`
 	Compaction MessagesCompactionConfig `json:"compaction"`
 	Subagent   MessagesSubagentConfig   `json:"subagent"`
+	Patterns   MessagesPatternsConfig   `json:"patterns"`
 }
 
 // FieldLabelsEnvironmentConfig defines environment field labels
@@ -333,6 +457,8 @@ type FieldLabelsEnvironmentConfig struct {
 	GitBranch        string `json:"git_branch"`
 	SessionTime      string `json:"session_time"`
 	System           string `json:"system"`
+	Host             string `json:"host"`    // Verbose only
+	Remotes          string `json:"remotes"` // Verbose only
 }
 
 // FieldLabelsTemporalConfig defines temporal field labels
@@ -341,9 +467,12 @@ type FieldLabelsTemporalConfig struct {
 	InternalTime     string `json:"internal_time"`
 	InternalSchedule string `json:"internal_schedule"`
 	ExternalCalendar string `json:"external_calendar"`
+	UpcomingEvents   string `json:"upcoming_events"` // events.jsonc inside lead time (temporal events.go)
+	Milestones       string `json:"milestones"`      // Birthdays and anniversaries inside lead time (instance milestones.go)
 	SessionDuration  string `json:"session_duration"`
 	WorkContext      string `json:"work_context"`
 	DateContext      string `json:"date_context"`
+	Journey          string `json:"journey"` // Phase timeline at session end (journey.go)
 }
 
 // FieldLabelsStopConfig defines stop field labels
@@ -356,16 +485,28 @@ type FieldLabelsStopConfig struct {
 
 // FieldLabelsEndConfig defines end field labels
 type FieldLabelsEndConfig struct {
-	Ended     string `json:"ended"`
-	Reason    string `json:"reason"`
-	EndingAt  string `json:"ending_at"`
-	Started   string `json:"started"`
+	Ended          string `json:"ended"`
+	Reason         string `json:"reason"`
+	EndingAt       string `json:"ending_at"`
+	Started        string `json:"started"`
+	TasksCompleted string `json:"tasks_completed"`
+	Breakthroughs  string `json:"breakthroughs"`
+	Struggles      string `json:"struggles"`
+	Compactions    string `json:"compactions"`
+	Commits        string `json:"commits"`
+	FilesChanged   string `json:"files_changed"`
+	AverageHealth  string `json:"average_health"`
+	JournalDraft   string `json:"journal_draft"`
 }
 
 // FieldLabelsSubagentConfig defines subagent field labels
 type FieldLabelsSubagentConfig struct {
-	CompletedAt string `json:"completed_at"`
-	During      string `json:"during"`
+	CompletedAt   string `json:"completed_at"`
+	During        string `json:"during"`
+	Tools         string `json:"tools"`          // Transcript stats: tool calls by type
+	FilesModified string `json:"files_modified"` // Transcript stats: files written
+	Tokens        string `json:"tokens"`         // Transcript stats: token usage
+	WorkDuration  string `json:"work_duration"`  // Transcript stats: first to last record
 }
 
 // FieldLabelsCompactionConfig defines compaction field labels
@@ -375,6 +516,26 @@ type FieldLabelsCompactionConfig struct {
 	Context     string `json:"context"`
 	Date        string `json:"date"`
 	Compactions string `json:"compactions"`
+	Messages    string `json:"messages"`    // Analysis: messages since the last compaction
+	ToolOutput  string `json:"tool_output"` // Analysis: tool output share of the context
+	Tokens      string `json:"tokens"`      // Analysis: context tokens (and window, when known)
+	Largest     string `json:"largest"`     // Analysis: biggest single tool results
+	Advice      string `json:"advice"`      // Analysis: what to do differently
+}
+
+// FieldLabelsPatternsConfig defines session pattern field labels
+type FieldLabelsPatternsConfig struct {
+	TypicalSession string `json:"typical_session"`
+	MostProductive string `json:"most_productive"`
+	Compactions    string `json:"compactions"`
+	ActiveDays     string `json:"active_days"`
+}
+
+// FieldLabelsRecentConfig defines recent session recap field labels
+type FieldLabelsRecentConfig struct {
+	LastSession string `json:"last_session"`
+	Focus       string `json:"focus"`
+	Earlier     string `json:"earlier"`
 }
 
 // FieldLabelsConfig defines all field labels
@@ -385,25 +546,42 @@ type FieldLabelsConfig struct {
 	End         FieldLabelsEndConfig         `json:"end"`
 	Subagent    FieldLabelsSubagentConfig    `json:"subagent"`
 	Compaction  FieldLabelsCompactionConfig  `json:"compaction"`
+	Patterns    FieldLabelsPatternsConfig    `json:"patterns"`
+	Recent      FieldLabelsRecentConfig      `json:"recent"`
 }
 
 // SessionDisplayBehaviorConfig defines visibility controls for session display sections.
 //
-// Allows enabling/disabling optional display sections. All default to true.
-// Set to false to hide specific sections from output.
+// Allows enabling/disabling optional display sections. All Show* default to true.
+// Set to false to hide specific sections from output. ASCIIFallback defaults to
+// false - ASCII is still chosen automatically when the terminal needs it.
+// SaveTranscript and JournalDraft default to false. Verbosity defaults to normal.
 type SessionDisplayBehaviorConfig struct {
-	ShowTemporalAwareness      bool `json:"show_temporal_awareness"`       // Show temporal awareness section at session start
-	ShowWorkspaceAnalysis      bool `json:"show_workspace_analysis"`       // Show workspace analysis section at session start
-	ShowStoppingContext        bool `json:"show_stopping_context"`         // Show temporal context at session stop
-	ShowTemporalJourney        bool `json:"show_temporal_journey"`         // Show temporal journey at session end
-	ShowCompactionPreservation bool `json:"show_compaction_preservation"`  // Show temporal state preservation during compaction
+	ShowTemporalAwareness      bool   `json:"show_temporal_awareness"`       // Show temporal awareness section at session start
+	ShowWorkspaceAnalysis      bool   `json:"show_workspace_analysis"`       // Show workspace analysis section at session start
+	ShowSessionPatterns        bool   `json:"show_session_patterns"`         // Show learned work rhythms at session start
+	ShowRecentSessions         bool   `json:"show_recent_sessions"`          // Show what the last session accomplished at session start
+	ShowStoppingContext        bool   `json:"show_stopping_context"`         // Show temporal context at session stop
+	ShowTemporalJourney        bool   `json:"show_temporal_journey"`         // Show temporal journey at session end
+	ShowEndStatistics          bool   `json:"show_end_statistics"`           // Show tasks, git activity, and health at session end
+	ShowCompactionPreservation bool   `json:"show_compaction_preservation"`  // Show temporal state preservation during compaction
+	ShowCompactionAnalysis     bool   `json:"show_compaction_analysis"`      // Show what filled the context before compaction, with advice
+	ASCIIFallback              bool   `json:"ascii_fallback"`                // Force +-| box characters (auto-detected otherwise)
+	SaveTranscript             bool   `json:"save_transcript"`               // Tee start/stop/end output into transcripts/<session-id>.txt (output.go)
+	Verbosity                  string `json:"verbosity"`                     // quiet, normal, or verbose (CPI_SI_SESSION_VERBOSITY overrides; verbosity.go)
+	JourneyMaxSegments         int    `json:"journey_max_segments"`          // Timeline rows in the end-of-session journey (0 = 8; journey.go)
+	JournalDraft               bool   `json:"journal_draft"`                 // Write a session journal draft at session end (journaldraft.go)
+	StopChecklistBudgetSeconds int    `json:"stop_checklist_budget_seconds"` // Total time for the stop checklist's automatic checks (0 = 10; stopchecklist.go)
+	SetupGuidanceThreshold     int    `json:"setup_guidance_threshold"`      // Setup items that may be missing before start shows guidance instead (0 = 2; setup.go)
 }
 
 // BehaviorConfig defines display library behavior and feature toggles.
 //
 // Groups behavior settings logically for clean configuration structure.
 type BehaviorConfig struct {
-	SessionDisplay SessionDisplayBehaviorConfig `json:"session_display"` // Session display section visibility toggles
+	SessionDisplay    SessionDisplayBehaviorConfig `json:"session_display"`             // Session display section visibility toggles
+	HotReload         bool                         `json:"hot_reload"`                  // Re-read formatting.jsonc when it changes (Print* poll mtime)
+	HotReloadInterval int                          `json:"hot_reload_interval_seconds"` // Minimum seconds between mtime checks (0 = default)
 }
 
 //--- Composed Types ---
@@ -413,7 +591,8 @@ type BehaviorConfig struct {
 //
 // Composes all configuration categories into single unified configuration.
 // Loaded from display/formatting.jsonc or falls back to hardcoded defaults.
-// Cached in package-level variable after loading in init().
+// Cached in package-level variable after loading in init(); replaced whole
+// (never mutated) on reload.
 //
 // Note: Renamed from DisplayConfig to avoid collision with dependencies.DisplayConfig
 type SessionDisplayConfig struct {
@@ -424,6 +603,42 @@ type SessionDisplayConfig struct {
 	Messages       MessagesConfig       `json:"messages"`
 	FieldLabels    FieldLabelsConfig    `json:"field_labels"`
 	Behavior       BehaviorConfig       `json:"behavior"`
+	StopChecklist  []StopChecklistItem  `json:"stop_checklist"` // Stopping-point checks (empty = none; stopchecklist.go)
+}
+
+//--- Rendering Types ---
+// Resolved per call from configuration plus terminal detection.
+
+// boxChars is one set of banner border characters.
+//
+// Mirrors box_characters in display/formatting.jsonc. Rule underlines section
+// headers; Separator is the heavier rule between major output blocks.
+type boxChars struct {
+	TopLeft     string
+	TopRight    string
+	BottomLeft  string
+	BottomRight string
+	Horizontal  string
+	Vertical    string
+	Rule        string
+	Separator   string
+}
+
+// fieldRow is one aligned "icon label  value" line in a section.
+//
+// A row with neither Icon nor Label is a continuation line - its Value sits
+// under the value column of the rows above it.
+type fieldRow struct {
+	Icon  string
+	Label string
+	Value string
+}
+
+// bannerLayout is the resolved width and character set every Print* renders with.
+type bannerLayout struct {
+	Width int      // Total banner width in columns, borders included
+	Box   boxChars // Border characters (ASCII set when ASCII is true)
+	ASCII bool     // True when box drawing characters are unavailable
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -446,13 +661,53 @@ type SessionDisplayConfig struct {
 var displayLogger *logging.Logger
 
 //--- Configuration Cache ---
-// Package-level configuration loaded once at initialization.
+// Package-level configuration loaded at initialization, swapped on reload.
 
 // displayConfig holds the loaded display configuration.
 //
-// Loaded from display-formatting.jsonc (or defaults) in init() and cached
-// for all subsequent function calls. Never reloaded during runtime.
-var displayConfig *SessionDisplayConfig
+// Loaded from display/formatting.jsonc (or defaults) in init(). Read it with
+// currentDisplayConfig(); ReloadDisplayConfig and hot reload store a fully
+// built replacement, so a Print call never sees a half-populated config.
+var displayConfig atomic.Pointer[SessionDisplayConfig]
+
+// displayReload tracks hot-reload polling (behavior.hot_reload).
+//
+// mu serializes checks and reloads; modTime is the newest mtime among the
+// base file and locale overlays when the config was last read.
+var displayReload struct {
+	mu      sync.Mutex
+	checked time.Time
+	modTime time.Time
+}
+
+// displayLocale is the locale the configuration was loaded for (instance
+// Preferences.Locale, e.g. "es" or "es-MX"). Empty means the base file only.
+var displayLocale string
+
+//--- Date Formats ---
+
+// localeDateFormats maps a locale's language to its session timestamp layout.
+// Languages not listed use defaultDateFormat.
+var localeDateFormats = map[string]string{
+	"en": defaultDateFormat,
+	"es": "02/01/2006 15:04:05",
+	"fr": "02/01/2006 15:04:05",
+	"pt": "02/01/2006 15:04:05",
+	"de": "02.01.2006 15:04:05",
+	"ja": "2006/01/02 15:04:05",
+}
+
+//--- Box Styles ---
+// Border character sets selectable via banner.border_style.
+
+// boxStyles maps border_style names to characters, matching box_characters
+// in display/formatting.jsonc. "ascii_fallback" is also what auto-detection selects.
+var boxStyles = map[string]boxChars{
+	"single_line":    {"┌", "┐", "└", "┘", "─", "│", "─", "━"},
+	"double_line":    {"╔", "╗", "╚", "╝", "═", "║", "─", "━"},
+	"rounded":        {"╭", "╮", "╰", "╯", "─", "│", "─", "━"},
+	"ascii_fallback": {"+", "+", "+", "+", "-", "|", "-", "="},
+}
 
 func init() {
 	// --- Rail Components ---
@@ -463,7 +718,9 @@ func init() {
 	// --- Configuration ---
 	// Load configuration once at package initialization
 
-	displayConfig = loadDisplayConfig()  // Load from file or use defaults
+	displayLocale = instance.GetConfig().Locale   // Selects formatting.<locale>.jsonc overlays
+	displayReload.modTime = displayConfigModTime() // Baseline for hot reload
+	displayConfig.Store(loadDisplayConfig())       // Load from file (+ locale overlay) or use defaults
 }
 
 // ============================================================================
@@ -486,32 +743,71 @@ func init() {
 // See: standards/code/4-block/sections/CWS-SECTION-00X-BODY-organizational-chart.md
 //
 // Ladder Structure (Dependencies):
-//   Public APIs (Top Rungs) - 13 functions
-//   ├── PrintHeader() → uses display.Box, instance.GetConfig
-//   ├── PrintEnvironment(workspace) → uses display.Header, git library, GetSystemInfo (from system.go)
-//   ├── PrintTemporalAwareness() → uses display.Header, temporal library
-//   ├── PrintWorkspaceAnalysis(workspace, hasContext) → uses display.Header
-//   ├── PrintStopHeader() → uses display.Box
-//   ├── PrintStopInfo() → uses display.Header
-//   ├── PrintStoppingContext() → uses display.Header, temporal library
-//   ├── PrintSubagentCompletion(agentType, status, exitCode, errorMsg) → uses display.Header, temporal library, formatDisplayMessage
-//   ├── PrintPreCompactionMessage(compactType, compactionCount) → uses temporal library, formatDisplayMessage
-//   ├── PrintEndFarewell() → uses display.Box
-//   ├── PrintEndSessionInfo(reason) → uses display.Header
-//   ├── PrintEndTemporalJourney() → uses display.Header, temporal library
-//   └── PrintEndRemindersHeader()
-//
-//   Helpers (Bottom Rungs) - 4 functions
-//   ├── loadDisplayConfig() → uses loadConfigFile, getDefaultDisplayConfig
-//   ├── loadConfigFile(path) → uses stripJSONCComments (from activity.go)
+//   Public APIs (Top Rungs) - 16 functions
+//   ├── ReloadDisplayConfig() → uses reloadDisplayConfig
+//   ├── PrintHeader() → uses visible, headerLine, resolveVerse, bannerArtLines, fitBannerArt, renderArtBanner, instance.GetConfig
+//   ├── PrintSetupGuidance(report) → uses visible, formatFields (setup.go)
+//   ├── PrintEnvironment(workspace) → uses visible, display.KeyValueTable, fieldTableOpts, printSectionHeader, git library, GetSystemInfo (from system.go), fullSystemInfo, hostDetails, remoteLines
+//   ├── PrintTemporalAwareness() → uses formatFields, printSectionHeader, currentTemporalContext
+//   ├── PrintSessionPatterns() → uses formatFields, printSectionHeader, GetSessionPatterns (patterns.go), formatDisplayMessage
+//   ├── PrintRecentSessions(n) → uses formatFields, printSectionHeader, GetRecentSessions, recentSessionText (history.go)
+//   ├── PrintWorkspaceAnalysis(workspace, hasContext) → uses printSectionHeader
+//   ├── PrintWorkspaceAnalysisSkipped() → uses printSectionHeader
+//   ├── PrintStopHeader() → uses visible, stopLine, resolveVerse, verseLines, renderBanner
+//   ├── PrintStopInfo() → uses formatFields, printSectionHeader
+//   ├── PrintStopChecklist() → uses EvaluateStoppingPoint, printSectionHeader (stopchecklist.go)
+//   ├── PrintStoppingContext() → uses formatFields, printSectionHeader, currentTemporalContext
+//   ├── PrintSubagentCompletion(agentType, status, exitCode, errorMsg) → PrintSubagentCompletionWithStats(..., nil)
+//   ├── PrintSubagentCompletionWithStats(..., stats) → uses formatFields, printSectionHeader, currentTemporalContext, formatDisplayMessage, subagentStatsRows
+//   ├── PrintPreCompactionMessage(compactType, compactionCount) → uses formatFields, currentTemporalContext, compactionPreservationRows, formatDisplayMessage
+//   ├── PrintCompactionAnalysis(analysis) → uses formatFields, compactionAnalysisRows
+//   ├── PrintEndFarewell() → uses resolveVerse, verseLines, renderBanner
+//   ├── PrintEndSessionInfo(reason) → uses display.KeyValueTable, fieldTableOpts, printSectionHeader
+//   ├── PrintEndStatistics(stats) → uses visible, endLine, formatFields, printSectionHeader (stats from stats.go)
+//   ├── PrintEndTemporalJourney() → uses formatFields, printSectionHeader, currentTemporalContext, GetTemporalJourney
+//   ├── PrintJournalDraft(path) → uses formatFields
+//   ├── PrintEndRemindersHeader()
+//   └── PrintClosingDivider() → uses resolveBannerLayout
+//
+//   Helpers (Bottom Rungs) - 29 functions
+//   ├── loadDisplayConfig() → uses readDisplayConfig, getDefaultDisplayConfig, withConfigHint
+//   ├── readDisplayConfig() → uses localeOverlayPaths, loadConfigFile
+//   ├── currentDisplayConfig() → atomic load (every Print* and helper reads through it)
+//   ├── displayConfigModTime() → uses localeOverlayPaths, os.Stat
+//   ├── reloadDisplayConfig() → uses readDisplayConfig, displayConfigModTime, withConfigHint
+//   ├── displayConfigKind() → display.DisplayConfig + SessionDisplayConfig schema
+//   ├── withConfigHint(details) → uses sysconfig.IssueHint, displayConfigKind
+//   ├── maybeReloadDisplayConfig() → uses displayConfigModTime, reloadDisplayConfig (first call in every Print*)
+//   ├── loadConfigFile(path, overlays...) → uses getDefaultDisplayConfig, jsonc.LoadMerged
+//   ├── localeOverlayPaths(basePath, locale) → uses localeParts
+//   ├── localeParts(locale) → pure function
+//   ├── sessionDateFormat() → uses localeParts
 //   ├── getDefaultDisplayConfig() → pure function
 //   ├── expandPath(path) → pure function
-//   └── formatDisplayMessage(template, replacements) → pure function
+//   ├── formatDisplayMessage(template, replacements) → pure function
+//   ├── printSectionHeader(title) → uses resolveBannerLayout, renderSectionHeader
+//   ├── renderBanner(layout, title, message) → uses renderArtBanner (no art)
+//   ├── renderArtBanner(layout, art, title, message) → uses wrapText, centerText
+//   ├── verseLines(layout, verseText, verseRef) → uses wrapText
+//   ├── renderSectionHeader(layout, title) → uses displayWidth
+//   ├── resolveBannerLayout() → uses detectTerminalWidth, needsASCII
+//   ├── detectTerminalWidth() → uses terminalColumns (terminal_unix.go / terminal_other.go)
+//   ├── needsASCII() → reads stdout mode, TERM, locale
+//   ├── wrapText(text, width) → uses displayWidth, display.Truncate
+//   ├── centerText(text, width) → uses displayWidth
+//   ├── resolveVerse(event, fallback) → verses.go (rotation pools, logs selection)
+//   ├── formatFields(indent, rows) → uses display.KeyValueTable, fieldTableOpts
+//   ├── fieldTableOpts(indent) → uses detectTerminalWidth
+//   ├── compactionPreservationRows(cfg, ctx, count) → pure function (also hookoutput.go)
+//   └── displayWidth(s) → uses display.StringWidth
+//
+// Every start/stop/end Print* (and PrintPreCompactionMessage's preservation
+// rows) first asks visible(section) - verbosity.go owns which sections show.
 //
 // Baton Flow:
-//   Hook calls public API → gets config → formats output (via display rail) → prints to stdout
+//   Hook calls public API → visible (verbosity + show_* toggle) → resolveBannerLayout (config + terminal) → renders → prints to stdout
 //
-// APUs: 17 functions total (13 public APIs + 4 helpers)
+// APUs: 42 functions total (16 public APIs + 26 helpers; resolveVerse documented in verses.go, banner art in bannerart.go)
 
 // ────────────────────────────────────────────────────────────────
 // Helpers/Utilities - Internal Support
@@ -529,44 +825,214 @@ func init() {
 //
 // What It Does:
 //   - Attempts to load display/formatting.jsonc
-//   - Falls back to hardcoded defaults on any error
+//   - Merges formatting.<lang>.jsonc then formatting.<lang>-<REGION>.jsonc over it
+//     when a locale is set (missing overlay files are skipped silently), then the
+//     active instance profile's formatting.jsonc
+//   - Malformed overlay: logs it and keeps the base file alone
+//   - Falls back to hardcoded defaults on any base file error
 //   - Logs success or fallback
 //
 // Health Impact:
 //   +20: Configuration loaded successfully
+//   -5: Locale overlay invalid (base configuration used)
 //   -10: Fallback to defaults (file missing or invalid)
 func loadDisplayConfig() *SessionDisplayConfig {
-	config, err := loadConfigFile(expandPath(displayConfigPath))
+	config, err := readDisplayConfig()
 	if err != nil {
-		displayLogger.Check("config-load-fallback", false, -10, map[string]interface{}{
+		displayLogger.Check("config-load-fallback", false, -10, withConfigHint(map[string]interface{}{
 			"error":  err.Error(),
 			"action": "using hardcoded defaults",
-		})
+		}))
 		return getDefaultDisplayConfig()
 	}
 
 	displayLogger.Check("config-load-success", true, 20, map[string]interface{}{
 		"source": displayConfigPath,
+		"locale": displayLocale,
 	})
 	return config
 }
 
-// loadConfigFile loads and parses JSONC configuration file
-func loadConfigFile(path string) (*SessionDisplayConfig, error) {
-	data, err := os.ReadFile(path)
+// readDisplayConfig reads formatting.jsonc with the locale overlays merged over it
+//
+// A malformed overlay is logged and the base file used alone; only a base
+// file failure is an error.
+func readDisplayConfig() (*SessionDisplayConfig, error) {
+	path := expandPath(displayConfigPath)
+	overlays := displayOverlayPaths(path)
+
+	config, err := loadConfigFile(path, overlays...)
+	if err != nil && len(overlays) > 0 {
+		// Overlay may be the problem - base alone beats hardcoded defaults
+		if baseConfig, baseErr := loadConfigFile(path); baseErr == nil {
+			displayLogger.Failure("config-locale-overlay", err.Error(), -5, map[string]interface{}{
+				"locale": displayLocale,
+				"action": "using base configuration",
+			})
+			config, err = baseConfig, nil
+		}
+	}
+	return config, err
+}
+
+// displayConfigKind describes formatting.jsonc for config validation - the
+// display rail reads its own sections of the same file, so both types count
+func displayConfigKind() sysconfig.ConfigKind {
+	return sysconfig.ConfigKind{
+		Name:   "formatting",
+		Path:   displayConfigPath,
+		Schema: []any{SessionDisplayConfig{}, display.DisplayConfig{}},
+		Ignore: display.ConfigReferenceSections,
+	}
+}
+
+// withConfigHint adds "formatting.jsonc has N issues ..., run validate --configs"
+// to fallback log details when the file is there but broken
+func withConfigHint(details map[string]interface{}) map[string]interface{} {
+	if hint := sysconfig.IssueHint(expandPath(displayConfigPath), displayConfigKind()); hint != "" {
+		details["hint"] = hint
+	}
+	return details
+}
+
+// loadConfigFile loads a JSONC configuration file with optional overlays merged over it
+//
+// Starts from getDefaultDisplayConfig(), so a file that only sets the banner
+// title keeps every default icon and label it doesn't mention.
+func loadConfigFile(path string, overlays ...string) (*SessionDisplayConfig, error) {
+	config := getDefaultDisplayConfig()
+	if err := jsonc.LoadMerged(config, path, overlays...); err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	return config, nil
+}
+
+// localeParts splits a locale into language and region ("es_MX.UTF-8" → "es", "MX")
+func localeParts(locale string) (language, region string) {
+	locale, _, _ = strings.Cut(locale, ".") // Drop encoding
+	locale, _, _ = strings.Cut(locale, "@") // Drop modifier
+	language, region, _ = strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	return strings.ToLower(language), strings.ToUpper(region)
+}
+
+// localeOverlayPaths lists overlay files for a locale, least specific first
+//
+// Example:
+//   localeOverlayPaths(".../formatting.jsonc", "es_MX")
+//   // → [".../formatting.es.jsonc", ".../formatting.es-MX.jsonc"]
+func localeOverlayPaths(basePath, locale string) []string {
+	language, region := localeParts(locale)
+	if language == "" {
+		return nil
+	}
+
+	stem := strings.TrimSuffix(basePath, ".jsonc")
+	paths := []string{stem + "." + language + ".jsonc"}
+	if region != "" {
+		paths = append(paths, stem+"."+language+"-"+region+".jsonc")
+	}
+	return paths
+}
+
+// displayOverlayPaths lists every file merged over formatting.jsonc: the locale
+// overlays, then the active instance profile's formatting.jsonc (most specific last)
+func displayOverlayPaths(basePath string) []string {
+	overlays := localeOverlayPaths(basePath, displayLocale)
+	if profile := instance.ProfileOverride(filepath.Base(basePath)); profile != "" {
+		overlays = append(overlays, profile)
+	}
+	return overlays
+}
+
+// currentDisplayConfig returns the display configuration in effect
+//
+// Read it once per function - a reload in between swaps the pointer, never
+// the struct it points to.
+func currentDisplayConfig() *SessionDisplayConfig {
+	return displayConfig.Load()
+}
+
+// displayConfigModTime returns the newest mtime among formatting.jsonc and
+// its overlays (zero if none exist)
+func displayConfigModTime() time.Time {
+	path := expandPath(displayConfigPath)
+	var newest time.Time
+	for _, file := range append([]string{path}, displayOverlayPaths(path)...) {
+		if info, err := os.Stat(file); err == nil && info.ModTime().After(newest) {
+			newest = info.ModTime()
+		}
+	}
+	return newest
+}
+
+// reloadDisplayConfig re-reads the configuration and swaps it in (caller holds displayReload.mu)
+//
+// On failure the previous configuration stays in effect.
+func reloadDisplayConfig() error {
+	modTime := displayConfigModTime()
+	config, err := readDisplayConfig()
 	if err != nil {
-		return nil, fmt.Errorf("failed to read config file: %w", err)
+		displayLogger.Failure("config-reload", err.Error(), -5, withConfigHint(map[string]interface{}{
+			"source": displayConfigPath,
+			"action": "keeping previous configuration",
+		}))
+		return err
+	}
+
+	displayConfig.Store(config)
+	displayReload.modTime = modTime
+	displayLogger.Success("config-reload", 0, map[string]interface{}{
+		"source": displayConfigPath,
+		"locale": displayLocale,
+	})
+	return nil
+}
+
+// maybeReloadDisplayConfig reloads when behavior.hot_reload is on and the
+// config files changed
+//
+// Stats the files at most once per hot_reload_interval_seconds, so Print*
+// functions can call it unconditionally. With hot_reload off (the default)
+// it returns immediately - the config stays as loaded at init.
+func maybeReloadDisplayConfig() {
+	behavior := currentDisplayConfig().Behavior
+	if !behavior.HotReload {
+		return
+	}
+	interval := time.Duration(behavior.HotReloadInterval) * time.Second
+	if interval <= 0 {
+		interval = defaultHotReloadIntervalSeconds * time.Second
 	}
 
-	// Strip JSONC comments
-	jsonData := stripJSONCComments(string(data))
+	displayReload.mu.Lock()
+	defer displayReload.mu.Unlock()
 
-	var config SessionDisplayConfig
-	if err := json.Unmarshal([]byte(jsonData), &config); err != nil {
-		return nil, fmt.Errorf("failed to parse config JSON: %w", err)
+	if time.Since(displayReload.checked) < interval {
+		return
 	}
+	displayReload.checked = time.Now()
 
-	return &config, nil
+	modTime := displayConfigModTime()
+	if modTime.Equal(displayReload.modTime) {
+		return
+	}
+	displayReload.modTime = modTime // A broken edit isn't retried until the file changes again
+	reloadDisplayConfig()
+}
+
+// sessionDateFormat returns the time layout for session timestamps
+//
+// Configured formatting.date_format wins (a locale overlay usually sets it);
+// otherwise the locale's language picks from localeDateFormats.
+func sessionDateFormat() string {
+	if currentDisplayConfig().Formatting.DateFormat != "" {
+		return currentDisplayConfig().Formatting.DateFormat
+	}
+	language, _ := localeParts(displayLocale)
+	if layout, ok := localeDateFormats[language]; ok {
+		return layout
+	}
+	return defaultDateFormat
 }
 
 // getDefaultDisplayConfig returns hardcoded default configuration
@@ -599,12 +1065,16 @@ func getDefaultDisplayConfig() *SessionDisplayConfig {
 				Info:         "ⓘ",
 				Compaction:   "🔄",
 				Preservation: "📍",
+				Journal:      "📓",
+				Setup:        "⚙",
 			},
 		},
 		SectionHeaders: SectionHeadersConfig{
 			SessionStart: SectionHeadersStartConfig{
 				Environment:       "SESSION ENVIRONMENT",
 				TemporalAwareness: "TEMPORAL AWARENESS",
+				SessionPatterns:   "SESSION PATTERNS",
+				RecentSessions:    "RECENT SESSIONS",
 				WorkspaceAnalysis: "WORKSPACE ANALYSIS",
 			},
 			SessionStop: SectionHeadersStopConfig{
@@ -613,6 +1083,7 @@ func getDefaultDisplayConfig() *SessionDisplayConfig {
 			},
 			SessionEnd: SectionHeadersEndConfig{
 				SessionSummary:  "SESSION SUMMARY",
+				Statistics:      "SESSION STATISTICS",
 				TemporalJourney: "TEMPORAL JOURNEY",
 				StateReminders:  "STATE REMINDERS",
 			},
@@ -640,18 +1111,23 @@ func getDefaultDisplayConfig() *SessionDisplayConfig {
 			Workspace: MessagesWorkspaceConfig{
 				NoWorkspace:      "ⓘ No workspace configured (NOVA_DAWN_WORKSPACE not set)",
 				WorkspaceHealthy: "✓ Workspace healthy - no warnings or context to report",
+				SourceSkipped:    "⏱ Workspace analysis skipped - source timed out",
 			},
 			Compaction: MessagesCompactionConfig{
 				Manual:             "Manual compaction #{count} - optimizing context...",
 				Auto:               "Auto-compaction #{count} - managing token usage...",
 				Unknown:            "Compaction #{count} starting...",
 				PreservationHeader: "📍 Temporal State Preservation:",
+				AnalysisHeader:     "📊 Context Before Compaction:",
 			},
 			Subagent: MessagesSubagentConfig{
 				Success: "✓ Subagent [{type}] completed successfully",
 				Failure: "⚠️  Subagent [{type}] completed with errors (exit code: {code})",
 				Default: "✓ Subagent [{type}] completed",
 			},
+			Patterns: MessagesPatternsConfig{
+				Learning: "ⓘ Session patterns still being learned ({count} of {needed} sessions recorded)",
+			},
 		},
 		FieldLabels: FieldLabelsConfig{
 			Environment: FieldLabelsEnvironmentConfig{
@@ -660,15 +1136,20 @@ func getDefaultDisplayConfig() *SessionDisplayConfig {
 				GitBranch:        "Git Branch:",
 				SessionTime:      "Session Time:",
 				System:           "System:",
+				Host:             "Host:",
+				Remotes:          "Remotes:",
 			},
 			Temporal: FieldLabelsTemporalConfig{
 				ExternalTime:     "External Time:",
 				InternalTime:     "Internal Time:",
 				InternalSchedule: "Internal Schedule:",
 				ExternalCalendar: "External Calendar:",
+				UpcomingEvents:   "Upcoming:",
+				Milestones:       "Milestones:",
 				SessionDuration:  "Session Duration:",
 				WorkContext:      "Work Context:",
 				DateContext:      "Date Context:",
+				Journey:          "Journey:",
 			},
 			Stop: FieldLabelsStopConfig{
 				Stopped:         "Stopped:",
@@ -677,14 +1158,26 @@ func getDefaultDisplayConfig() *SessionDisplayConfig {
 				Date:            "Date:",
 			},
 			End: FieldLabelsEndConfig{
-				Ended:    "Ended:",
-				Reason:   "Reason:",
-				EndingAt: "Ending At:",
-				Started:  "Started:",
+				Ended:          "Ended:",
+				Reason:         "Reason:",
+				EndingAt:       "Ending At:",
+				Started:        "Started:",
+				TasksCompleted: "Tasks Completed:",
+				Breakthroughs:  "Breakthroughs:",
+				Struggles:      "Struggles:",
+				Compactions:    "Compactions:",
+				Commits:        "Commits:",
+				FilesChanged:   "Files Changed:",
+				AverageHealth:  "Average Health:",
+				JournalDraft:   "Journal draft:",
 			},
 			Subagent: FieldLabelsSubagentConfig{
-				CompletedAt: "Completed At:",
-				During:      "During:",
+				CompletedAt:   "Completed At:",
+				During:        "During:",
+				Tools:         "Tools:",
+				FilesModified: "Files Modified:",
+				Tokens:        "Tokens:",
+				WorkDuration:  "Worked For:",
 			},
 			Compaction: FieldLabelsCompactionConfig{
 				Time:        "Time:",
@@ -692,15 +1185,36 @@ func getDefaultDisplayConfig() *SessionDisplayConfig {
 				Context:     "Context:",
 				Date:        "Date:",
 				Compactions: "Compactions:",
+				Messages:    "Messages:",
+				ToolOutput:  "Tool Output:",
+				Tokens:      "Tokens:",
+				Largest:     "Largest:",
+				Advice:      "Advice:",
+			},
+			Patterns: FieldLabelsPatternsConfig{
+				TypicalSession: "Typical Session:",
+				MostProductive: "Most Productive:",
+				Compactions:    "Compactions:",
+				ActiveDays:     "Active Days:",
+			},
+			Recent: FieldLabelsRecentConfig{
+				LastSession: "Last Session:",
+				Focus:       "Focus:",
+				Earlier:     "Earlier:",
 			},
 		},
 		Behavior: BehaviorConfig{
 			SessionDisplay: SessionDisplayBehaviorConfig{
 				ShowTemporalAwareness:      true,
 				ShowWorkspaceAnalysis:      true,
+				ShowSessionPatterns:        true,
+				ShowRecentSessions:         true,
 				ShowStoppingContext:        true,
 				ShowTemporalJourney:        true,
+				ShowEndStatistics:          true,
 				ShowCompactionPreservation: true,
+				ShowCompactionAnalysis:     true,
+				Verbosity:                  VerbosityNormal,
 			},
 		},
 	}
@@ -717,8 +1231,6 @@ func expandPath(path string) string {
 	return path
 }
 
-// Note: stripJSONCComments is defined in activity.go and used here
-
 // ────────────────────────────────────────────────────────────────
 // Helpers - Formatting Utilities
 // ────────────────────────────────────────────────────────────────
@@ -746,6 +1258,296 @@ func formatDisplayMessage(template string, replacements map[string]string) strin
 	return result
 }
 
+// ────────────────────────────────────────────────────────────────
+// Helpers - Terminal Detection
+// ────────────────────────────────────────────────────────────────
+
+// detectTerminalWidth returns the terminal's column count, or 0 if unknown
+//
+// What It Does:
+//   - Asks the kernel (TIOCGWINSZ) via stdout, then stderr - hooks often have
+//     stdout piped while stderr still reaches the terminal
+//   - Falls back to $COLUMNS (set by most shells, not exported by all)
+//
+// Returns:
+//   - Column count, or 0 when neither source knows
+func detectTerminalWidth() int {
+	for _, f := range []*os.File{os.Stdout, os.Stderr} {
+		if cols := terminalColumns(f.Fd()); cols > 0 {
+			return cols
+		}
+	}
+
+	if cols, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && cols > 0 {
+		return cols
+	}
+	return 0
+}
+
+// needsASCII reports whether box drawing characters are unlikely to render
+//
+// What It Does:
+//   - stdout not a TTY (piped, redirected, captured by a harness)
+//   - TERM=dumb (Linux console fallbacks, editors' inferior shells)
+//   - Locale without UTF-8 (LC_ALL, then LC_CTYPE, then LANG - first one set wins)
+func needsASCII() bool {
+	if info, err := os.Stdout.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return true
+	}
+
+	if os.Getenv("TERM") == "dumb" {
+		return true
+	}
+
+	locale := ""
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if locale = os.Getenv(name); locale != "" {
+			break
+		}
+	}
+	locale = strings.ToLower(locale)
+	return !strings.Contains(locale, "utf-8") && !strings.Contains(locale, "utf8")
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Field Alignment
+// ────────────────────────────────────────────────────────────────
+
+// displayWidth returns the terminal columns a string occupies
+//
+// Delegates to display.StringWidth so session layouts and display.KeyValueTable
+// measure icons, emoji, and translations identically.
+func displayWidth(s string) int {
+	return display.StringWidth(s)
+}
+
+// fieldTableOpts shapes every session field table
+//
+// What It Does:
+//   - Key column at least formatting.min_label_column, values fieldGap after it
+//   - Values wrap at the terminal width when it is known (never when piped)
+func fieldTableOpts(indent string) display.TableOpts {
+	return display.TableOpts{
+		Indent:      indent,
+		MinKeyWidth: currentDisplayConfig().Formatting.MinLabelColumn,
+		Gap:         fieldGap,
+		MaxWidth:    detectTerminalWidth(),
+	}
+}
+
+// formatFields renders rows with values aligned in one column
+//
+// What It Does:
+//   - Converts rows to display.KV and renders through display.KeyValueTable
+//   - Continuation rows (no icon, no label) indent straight to the value column
+//
+// Parameters:
+//   - indent: Leading indent for every row
+//   - rows: Section rows in print order
+//
+// Returns:
+//   - Rendered lines, each newline-terminated
+func formatFields(indent string, rows []fieldRow) string {
+	kvs := make([]display.KV, len(rows))
+	for i, row := range rows {
+		kvs[i] = display.KV{Icon: row.Icon, Key: row.Label, Value: row.Value}
+	}
+	return display.KeyValueTable(kvs, fieldTableOpts(indent))
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Banner Layout
+// ────────────────────────────────────────────────────────────────
+
+// resolveBannerLayout decides width and character set for one render
+//
+// What It Does:
+//   - Width = min(configured width, terminal width), never below minBannerWidth
+//   - Characters = configured border_style, or ASCII when behavior.session_display.ascii_fallback
+//     is set or needsASCII() detects a limited terminal
+//
+// Every Print* function renders through this, so start, stop, end, subagent,
+// and compaction output always agree on width and characters.
+func resolveBannerLayout() bannerLayout {
+	cfg := currentDisplayConfig()
+
+	width := cfg.Formatting.Banner.Width
+	if width <= 0 {
+		width = defaultBannerWidth
+	}
+	if term := detectTerminalWidth(); term > 0 && term < width {
+		width = term
+	}
+	if width < minBannerWidth {
+		width = minBannerWidth
+	}
+
+	ascii := cfg.Behavior.SessionDisplay.ASCIIFallback || needsASCII()
+
+	box, ok := boxStyles[cfg.Formatting.Banner.BorderStyle]
+	if !ok {
+		box = boxStyles[defaultBorderStyle]
+	}
+	if ascii {
+		box = boxStyles["ascii_fallback"]
+	}
+
+	return bannerLayout{Width: width, Box: box, ASCII: ascii}
+}
+
+// wrapText word-wraps text to width terminal columns
+//
+// Measures with displayWidth, so emoji and CJK count two columns. Words wider
+// than width break after their last dash or em-dash that fits, otherwise at
+// the last whole character that fits - never inside a character or cluster.
+func wrapText(text string, width int) []string {
+	if width < 1 {
+		width = 1
+	}
+
+	var lines []string
+	current := ""
+
+	for _, word := range strings.Fields(text) {
+		for displayWidth(word) > width {
+			if current != "" {
+				lines = append(lines, current)
+				current = ""
+			}
+			head := display.Truncate(word, width)
+			if head == "" { // Wide character on a one-column line - it still has to go somewhere
+				_, size := utf8.DecodeRuneInString(word)
+				head = word[:size]
+			}
+			if i := strings.LastIndexAny(head, "-—"); i >= 0 {
+				_, size := utf8.DecodeRuneInString(head[i:])
+				head = head[:i+size]
+			}
+			lines = append(lines, head)
+			word = word[len(head):]
+		}
+
+		switch {
+		case current == "":
+			current = word
+		case displayWidth(current)+1+displayWidth(word) <= width:
+			current += " " + word
+		default:
+			lines = append(lines, current)
+			current = word
+		}
+	}
+
+	if current != "" || len(lines) == 0 {
+		lines = append(lines, current) // Blank input stays a blank line
+	}
+	return lines
+}
+
+// verseLines wraps a quoted verse and its reference for a banner
+//
+// What It Does:
+//   - Quotes the verse and appends " - reference"
+//   - Wraps on word boundaries to formatting.verse_wrap_width, bounded by the
+//     banner's text width (layout.Width minus borders and margins)
+//   - Counts terminal columns, not bytes - em-dashes, accents, and emoji never split mid-character
+//
+// Returns:
+//   - One string per banner line (any number of lines; never panics on short verses)
+func verseLines(layout bannerLayout, verseText, verseRef string) []string {
+	width := layout.Width - 4 // Borders plus one space margin each side
+	if configured := currentDisplayConfig().Formatting.VerseWrapWidth; configured > 0 && configured < width {
+		width = configured
+	}
+
+	verse := "\"" + strings.TrimSpace(verseText) + "\""
+	if verseRef != "" {
+		verse += " - " + verseRef
+	}
+	return wrapText(verse, width)
+}
+
+// centerText pads text on both sides to width columns (extra space goes right)
+func centerText(text string, width int) string {
+	gap := width - displayWidth(text)
+	if gap <= 0 {
+		return text
+	}
+	left := gap / 2
+	return strings.Repeat(" ", left) + text + strings.Repeat(" ", gap-left)
+}
+
+// renderBanner draws a bordered banner with centered title and message
+//
+// What It Does:
+//   - Border spans layout.Width; one space of margin inside each border
+//   - Title (bold) and each message line are wrapped to fit, then centered
+//   - Empty message lines are kept as blank rows for spacing
+func renderBanner(layout bannerLayout, title, message string) string {
+	return renderArtBanner(layout, nil, title, message)
+}
+
+// renderArtBanner draws renderBanner's banner with art rows above the title
+//
+// Art lines arrive fitted (fitBannerArt) - centered as-is, never wrapped.
+// No art renders exactly what renderBanner always has.
+func renderArtBanner(layout bannerLayout, art []string, title, message string) string {
+	box := layout.Box
+	inner := layout.Width - 2 // Between the vertical borders
+	text := inner - 2         // One space margin each side
+
+	row := func(content, color string) string {
+		return display.BoldCyan + box.Vertical + display.Reset +
+			" " + color + centerText(content, text) + display.Reset + " " +
+			display.BoldCyan + box.Vertical + display.Reset + "\n"
+	}
+
+	var result strings.Builder
+	result.WriteString(display.BoldCyan + box.TopLeft + strings.Repeat(box.Horizontal, inner) + box.TopRight + display.Reset + "\n")
+
+	for _, line := range art {
+		result.WriteString(row(line, display.BoldCyan))
+	}
+	for _, line := range wrapText(title, text) {
+		result.WriteString(row(line, display.Bold))
+	}
+	for _, paragraph := range strings.Split(message, "\n") {
+		for _, line := range wrapText(paragraph, text) {
+			result.WriteString(row(line, ""))
+		}
+	}
+
+	result.WriteString(display.BoldCyan + box.BottomLeft + strings.Repeat(box.Horizontal, inner) + box.BottomRight + display.Reset + "\n")
+	return result.String()
+}
+
+// renderSectionHeader draws a section title between two rules
+//
+// Same shape as display.Header (rule = title + padding), but the rule is
+// capped at layout.Width and drawn with the layout's characters.
+func renderSectionHeader(layout bannerLayout, title string) string {
+	if title == "" {
+		return ""
+	}
+
+	ruleWidth := displayWidth(title) + display.HeaderPadding
+	if ruleWidth > layout.Width {
+		ruleWidth = layout.Width
+	}
+	rule := strings.Repeat(layout.Box.Rule, ruleWidth)
+
+	return fmt.Sprintf("\n%s%s%s\n%s %s %s\n%s%s%s\n",
+		display.BoldCyan, rule, display.Reset,
+		display.BoldCyan, title, display.Reset,
+		display.BoldCyan, rule, display.Reset,
+	)
+}
+
+// printSectionHeader prints a section header using the resolved layout
+func printSectionHeader(title string) {
+	fmt.Fprint(Output(), renderSectionHeader(resolveBannerLayout(), title))
+}
+
 // ────────────────────────────────────────────────────────────────
 // Public APIs - Exported Interface
 // ────────────────────────────────────────────────────────────────
@@ -755,6 +1557,34 @@ func formatDisplayMessage(template string, replacements map[string]string) strin
 //
 // See: standards/code/4-block/sections/CWS-SECTION-00X-BODY-public-apis.md
 //
+// ────────────────────────────────────────────────────────────────
+// Configuration - Reload
+// ────────────────────────────────────────────────────────────────
+
+// ReloadDisplayConfig re-reads display/formatting.jsonc (and locale overlays)
+//
+// What It Does:
+//   - Reads and merges the configuration files
+//   - Swaps the cached configuration atomically - concurrent Print calls see
+//     either the old or the new configuration, never a mix
+//   - Keeps the previous configuration if the files can't be read or parsed
+//
+// Returns:
+//   - error: Read or parse failure (logged; previous configuration kept)
+//
+// Health Impact:
+//   - Reload failed: -5 (previous configuration kept)
+//
+// Example:
+//   if err := session.ReloadDisplayConfig(); err != nil {
+//       // Still displaying with the configuration loaded earlier
+//   }
+func ReloadDisplayConfig() error {
+	displayReload.mu.Lock()
+	defer displayReload.mu.Unlock()
+	return reloadDisplayConfig()
+}
+
 // ────────────────────────────────────────────────────────────────
 // Session Start Display - Lifecycle Beginning
 // ────────────────────────────────────────────────────────────────
@@ -765,6 +1595,9 @@ func formatDisplayMessage(template string, replacements map[string]string) strin
 //   - Loads instance configuration for banner text
 //   - Centers text within configured width box
 //   - Displays bordered header with title, tagline, and verse
+//   - Instance display.art enabled: art block above the title (skipped when
+//     ASCII fallback is active or the terminal is narrower than the art)
+//   - Quiet verbosity: one line instead ("Nova Dawn · Mon Nov 18 · branch main")
 //
 // Parameters:
 //   - None (reads from instance config and display config)
@@ -783,16 +1616,39 @@ func formatDisplayMessage(template string, replacements map[string]string) strin
 //   // ║           Covenant Partnership Intelligence System           ║
 //   // ...
 func PrintHeader() {
+	maybeReloadDisplayConfig()
+
 	// Load instance configuration for banner content
 	instanceConfig := instance.GetConfig()
 
+	// Quiet: one line in place of the banner
+	if visible("header_line") {
+		name := instanceConfig.Name
+		if name == "" {
+			name = instanceConfig.Display.BannerTitle
+		}
+		wd, _ := os.Getwd()
+		fmt.Fprintln(Output(), headerLine(name, now(), git.GetBranch(wd)))
+	}
+	if !visible("header") {
+		return
+	}
+
+	// Verse from rotation pool, falling back to the instance's footer verse
+	verse := resolveVerse(verseEventStart, BiblicalVerseConfig{
+		VerseText: instanceConfig.Display.FooterVerseText,
+		VerseRef:  instanceConfig.Display.FooterVerseRef,
+	})
+
 	// Build multi-line banner message
 	message := instanceConfig.Display.BannerTagline + "\n\n" +
-		"\"" + instanceConfig.Display.FooterVerseText + "\"\n" +
-		"- " + instanceConfig.Display.FooterVerseRef
+		"\"" + verse.VerseText + "\"\n" +
+		"- " + verse.VerseRef
 
-	// Use display rail for banner box
-	fmt.Print(display.Box(instanceConfig.Display.BannerTitle, message))
+	// Banner sized to the terminal, art only where it fits whole
+	layout := resolveBannerLayout()
+	art := fitBannerArt(bannerArtLines(instanceConfig.Display.Art, instanceConfig.SystemPaths), layout, detectTerminalWidth())
+	fmt.Fprint(Output(), renderArtBanner(layout, art, instanceConfig.Display.BannerTitle, message))
 }
 
 // PrintEnvironment displays session environment context
@@ -802,6 +1658,7 @@ func PrintHeader() {
 //   - Displays git branch if in repository
 //   - Shows session start time
 //   - Displays system information
+//   - Verbose: full uname, host details, and git remotes; quiet: skipped
 //
 // Parameters:
 //   - workspace: Workspace directory path (may be empty)
@@ -816,20 +1673,26 @@ func PrintHeader() {
 //   session.PrintEnvironment("/path/to/workspace")
 //   // Outputs environment section with workspace info
 func PrintEnvironment(workspace string) {
-	cfg := displayConfig
+	maybeReloadDisplayConfig()
 
-	// Use display rail for section header
-	fmt.Print(display.Header(cfg.SectionHeaders.SessionStart.Environment))
+	if !visible("environment") {
+		return
+	}
+
+	cfg := currentDisplayConfig()
+
+	// Section header (width and characters resolved per terminal)
+	printSectionHeader(cfg.SectionHeaders.SessionStart.Environment)
+
+	var rows []display.KV
 
 	// Working context
 	wd, _ := os.Getwd()
 	if workspace != "" {
-		fmt.Printf("\n  %s %s          %s\n", cfg.Icons.Environment.Workspace, cfg.FieldLabels.Environment.Workspace, workspace)
-		if wd != workspace {
-			fmt.Printf("  %s %s  %s\n", cfg.Icons.Environment.WorkingDirectory, cfg.FieldLabels.Environment.WorkingDirectory, wd)
-		}
-	} else {
-		fmt.Printf("\n  %s %s  %s\n", cfg.Icons.Environment.WorkingDirectory, cfg.FieldLabels.Environment.WorkingDirectory, wd)
+		rows = append(rows, display.KV{Icon: cfg.Icons.Environment.Workspace, Key: cfg.FieldLabels.Environment.Workspace, Value: workspace})
+	}
+	if workspace == "" || wd != workspace {
+		rows = append(rows, display.KV{Icon: cfg.Icons.Environment.WorkingDirectory, Key: cfg.FieldLabels.Environment.WorkingDirectory, Value: wd})
 	}
 
 	// Git status - use shared lib
@@ -844,19 +1707,39 @@ func PrintEnvironment(workspace string) {
 		if branch == "" {
 			branch = "Detached HEAD"
 		}
-		fmt.Printf("  %s %s         %s\n", cfg.Icons.Environment.GitBranch, cfg.FieldLabels.Environment.GitBranch, branch)
 	} else {
-		fmt.Printf("  %s %s         Not a git repository\n", cfg.Icons.Environment.GitBranch, cfg.FieldLabels.Environment.GitBranch)
+		branch = "Not a git repository"
+	}
+	rows = append(rows, display.KV{Icon: cfg.Icons.Environment.GitBranch, Key: cfg.FieldLabels.Environment.GitBranch, Value: branch})
+
+	// Verbose: every remote the branch could push to
+	if visible("git_remotes") {
+		for i, remote := range remoteLines(checkDir) {
+			kv := display.KV{Value: remote}
+			if i == 0 {
+				kv.Icon, kv.Key = cfg.Icons.Environment.GitBranch, cfg.FieldLabels.Environment.Remotes
+			}
+			rows = append(rows, kv)
+		}
 	}
 
-	// Session metadata
-	now := time.Now().Format("Mon Jan 02, 2006 at 15:04:05")
-	fmt.Printf("  %s %s       %s\n", cfg.Icons.Environment.Time, cfg.FieldLabels.Environment.SessionTime, now)
-
-	system := GetSystemInfo()
-	fmt.Printf("  %s %s             %s\n", cfg.Icons.Environment.System, cfg.FieldLabels.Environment.System, system)
+	// Session metadata (verbose: full uname and host details)
+	timestamp := now().Format(sessionDateFormat())
+	systemInfo := GetSystemInfo()
+	if visible("system_details") {
+		systemInfo = fullSystemInfo()
+	}
+	rows = append(rows,
+		display.KV{Icon: cfg.Icons.Environment.Time, Key: cfg.FieldLabels.Environment.SessionTime, Value: timestamp},
+		display.KV{Icon: cfg.Icons.Environment.System, Key: cfg.FieldLabels.Environment.System, Value: systemInfo},
+	)
+	if visible("system_details") {
+		rows = append(rows, display.KV{Icon: cfg.Icons.Environment.System, Key: cfg.FieldLabels.Environment.Host, Value: hostDetails()})
+	}
 
-	fmt.Println()
+	fmt.Fprintln(Output())
+	fmt.Fprint(Output(), display.KeyValueTable(rows, fieldTableOpts("  ")))
+	fmt.Fprintln(Output())
 }
 
 // PrintTemporalAwareness displays temporal consciousness (4 dimensions)
@@ -867,6 +1750,7 @@ func PrintEnvironment(workspace string) {
 //   - Shows internal time (session duration)
 //   - Displays internal schedule (work windows)
 //   - Shows external calendar (date, week, holidays)
+//   - Verbose: adds time zone, session start, next activity, ISO date; quiet: skipped
 //
 // Parameters:
 //   - None (reads from temporal context)
@@ -881,42 +1765,61 @@ func PrintEnvironment(workspace string) {
 //   session.PrintTemporalAwareness()
 //   // Outputs temporal awareness section if available and enabled
 func PrintTemporalAwareness() {
-	if !displayConfig.Behavior.SessionDisplay.ShowTemporalAwareness {
+	maybeReloadDisplayConfig()
+
+	if !visible("temporal_awareness") {
 		return
 	}
 
-	ctx, err := temporal.GetTemporalContext()
+	ctx, err := currentTemporalContext()
 	if err != nil {
 		// Silently skip if temporal awareness unavailable
 		return
 	}
 
-	cfg := displayConfig
+	cfg := currentDisplayConfig()
 
-	// Use display rail for section header
-	fmt.Print(display.Header(cfg.SectionHeaders.SessionStart.TemporalAwareness))
+	// Section header (width and characters resolved per terminal)
+	printSectionHeader(cfg.SectionHeaders.SessionStart.TemporalAwareness)
+
+	// Verbose adds the fields normal mode leaves out
+	details := visible("temporal_details")
 
 	// External Time - What time is it in the world?
-	fmt.Printf("  %s %s      %s (%s)\n", cfg.Icons.Temporal.ExternalTime, cfg.FieldLabels.Temporal.ExternalTime, ctx.ExternalTime.Formatted, ctx.ExternalTime.TimeOfDay)
-	fmt.Printf("                         Circadian: %s phase\n", ctx.ExternalTime.CircadianPhase)
+	rows := []fieldRow{
+		{cfg.Icons.Temporal.ExternalTime, cfg.FieldLabels.Temporal.ExternalTime, fmt.Sprintf("%s (%s)", ctx.ExternalTime.Formatted, ctx.ExternalTime.TimeOfDay)},
+		{Value: fmt.Sprintf("Circadian: %s phase", ctx.ExternalTime.CircadianPhase)},
+	}
+	if details && !ctx.ExternalTime.CurrentTime.IsZero() {
+		rows = append(rows, fieldRow{Value: "Time zone: " + ctx.ExternalTime.CurrentTime.Format("MST (-07:00)")})
+	}
 
 	// Internal Time - How long have I been working?
 	if ctx.InternalTime.ElapsedFormatted != "" {
-		fmt.Printf("  %s %s      %s elapsed (%s session)\n",
-			cfg.Icons.Temporal.InternalTime, cfg.FieldLabels.Temporal.InternalTime,
-			ctx.InternalTime.ElapsedFormatted, ctx.InternalTime.SessionPhase)
+		rows = append(rows, fieldRow{cfg.Icons.Temporal.InternalTime, cfg.FieldLabels.Temporal.InternalTime,
+			fmt.Sprintf("%s elapsed (%s session)", ctx.InternalTime.ElapsedFormatted, ctx.InternalTime.SessionPhase)})
+		if details && !ctx.InternalTime.SessionStart.IsZero() {
+			rows = append(rows, fieldRow{Value: "Started " + ctx.InternalTime.SessionStart.Format("15:04:05")})
+		}
 	}
 
 	// Internal Schedule - What should I be doing?
 	if ctx.InternalSchedule.CurrentActivity != "" {
-		fmt.Printf("  %s %s  %s (%s)\n",
-			cfg.Icons.Temporal.Schedule, cfg.FieldLabels.Temporal.InternalSchedule,
-			ctx.InternalSchedule.CurrentActivity, ctx.InternalSchedule.ActivityType)
+		rows = append(rows, fieldRow{cfg.Icons.Temporal.Schedule, cfg.FieldLabels.Temporal.InternalSchedule,
+			fmt.Sprintf("%s (%s)", ctx.InternalSchedule.CurrentActivity, ctx.InternalSchedule.ActivityType)})
 		if ctx.InternalSchedule.InWorkWindow {
-			fmt.Printf("                         %s In work window\n", cfg.Icons.Status.Success)
+			rows = append(rows, fieldRow{Value: cfg.Icons.Status.Success + " In work window"})
 		}
 		if ctx.InternalSchedule.ExpectedDowntime {
-			fmt.Printf("                         %s Expected downtime (respect schedule)\n", cfg.Icons.Status.Warning)
+			rows = append(rows, fieldRow{Value: cfg.Icons.Status.Warning + " Expected downtime (respect schedule)"})
+		}
+		if !ctx.InternalSchedule.InWorkWindow && ctx.InternalSchedule.NextWorkWindowIn != "" {
+			rows = append(rows, fieldRow{Value: fmt.Sprintf("Next work window starts in %s (%s)",
+				ctx.InternalSchedule.NextWorkWindowIn, ctx.InternalSchedule.NextWorkWindowStart.Format("Mon 15:04"))})
+		}
+		if details && ctx.InternalSchedule.NextActivity != "" {
+			rows = append(rows, fieldRow{Value: fmt.Sprintf("Next: %s at %s",
+				ctx.InternalSchedule.NextActivity, ctx.InternalSchedule.NextActivityTime)})
 		}
 	}
 
@@ -926,17 +1829,155 @@ func PrintTemporalAwareness() {
 		if ctx.ExternalCalendar.IsHoliday {
 			holidayInfo = fmt.Sprintf(" (%s)", ctx.ExternalCalendar.HolidayName)
 		}
-		fmt.Printf("  %s %s  %s, %s %d, %d%s\n",
-			cfg.Icons.Temporal.Calendar, cfg.FieldLabels.Temporal.ExternalCalendar,
-			ctx.ExternalCalendar.DayOfWeek,
-			ctx.ExternalCalendar.MonthName,
-			ctx.ExternalCalendar.DayOfMonth,
-			ctx.ExternalCalendar.Year,
-			holidayInfo)
-		fmt.Printf("                         Week %d of %d\n", ctx.ExternalCalendar.WeekNumber, ctx.ExternalCalendar.Year)
+		rows = append(rows,
+			fieldRow{cfg.Icons.Temporal.Calendar, cfg.FieldLabels.Temporal.ExternalCalendar,
+				fmt.Sprintf("%s, %s %d, %d%s",
+					ctx.ExternalCalendar.DayOfWeek,
+					ctx.ExternalCalendar.MonthName,
+					ctx.ExternalCalendar.DayOfMonth,
+					ctx.ExternalCalendar.Year,
+					holidayInfo)},
+			fieldRow{Value: fmt.Sprintf("Week %d of %d", ctx.ExternalCalendar.WeekNumber, ctx.ExternalCalendar.Year)},
+		)
+		if details {
+			rows = append(rows, fieldRow{Value: "Date: " + ctx.ExternalCalendar.Date})
+		}
 	}
 
-	fmt.Println()
+	// Upcoming events - What is coming that I care about?
+	if upcoming := temporal.FormatUpcoming(ctx.UpcomingEvents); upcoming != "" {
+		rows = append(rows, fieldRow{cfg.Icons.Temporal.Calendar, cfg.FieldLabels.Temporal.UpcomingEvents, upcoming})
+	}
+
+	// Milestones - Whose day is coming?
+	for i, m := range instance.GetMilestones(now()) {
+		if i == 0 {
+			rows = append(rows, fieldRow{cfg.Icons.Temporal.Calendar, cfg.FieldLabels.Temporal.Milestones, m.Display})
+			continue
+		}
+		rows = append(rows, fieldRow{Value: m.Display})
+	}
+
+	fmt.Fprint(Output(), formatFields("  ", rows))
+	fmt.Fprintln(Output())
+}
+
+// PrintSessionPatterns displays learned work rhythms at session start
+//
+// What It Does:
+//   - Reads patterns from session statistics history (patterns.go)
+//   - Fewer than 5 recorded sessions: one line saying patterns are still being learned
+//   - Otherwise: typical session length, most productive phase, compactions, active days
+//
+// Parameters:
+//   - None (reads stats-history.jsonl)
+//
+// Returns:
+//   - None (prints to stdout, silently skips if disabled or history unreadable)
+//
+// Health Impact:
+//   - No health tracking (pure display function)
+//
+// Example:
+//   session.PrintSessionPatterns()
+//   // Outputs session patterns section after temporal awareness
+func PrintSessionPatterns() {
+	maybeReloadDisplayConfig()
+
+	if !visible("session_patterns") {
+		return
+	}
+
+	patterns, err := GetSessionPatterns()
+	if err != nil {
+		return
+	}
+
+	cfg := currentDisplayConfig()
+	labels := cfg.FieldLabels.Patterns
+
+	printSectionHeader(cfg.SectionHeaders.SessionStart.SessionPatterns)
+
+	if patterns.Learning {
+		fmt.Fprintln(Output())
+		fmt.Fprintln(Output(), "  " + formatDisplayMessage(cfg.Messages.Patterns.Learning, map[string]string{
+			"count":  fmt.Sprintf("%d", patterns.Sessions),
+			"needed": fmt.Sprintf("%d", minPatternSessions),
+		}))
+		fmt.Fprintln(Output())
+		return
+	}
+
+	var rows []fieldRow
+	if patterns.TypicalDuration > 0 {
+		rows = append(rows, fieldRow{cfg.Icons.Temporal.InternalTime, labels.TypicalSession,
+			fmt.Sprintf("%s (median %s)", durationRangeText(patterns.DurationLow, patterns.DurationHigh), shortDuration(patterns.TypicalDuration))})
+	}
+	if patterns.MostProductivePhase != "" {
+		value := patterns.MostProductivePhase
+		if patterns.ProductivityLift > 0 {
+			value += fmt.Sprintf(" (+%d%% tasks)", patterns.ProductivityLift)
+		}
+		rows = append(rows, fieldRow{cfg.Icons.Status.Success, labels.MostProductive, value})
+	}
+	rows = append(rows, fieldRow{cfg.Icons.Status.Compaction, labels.Compactions, fmt.Sprintf("%.1f per session", patterns.AverageCompactions)})
+	if days := busiestDays(patterns.Weekdays); days != "" {
+		rows = append(rows, fieldRow{cfg.Icons.Temporal.Calendar, labels.ActiveDays, days})
+	}
+
+	fmt.Fprintln(Output())
+	fmt.Fprint(Output(), formatFields("  ", rows))
+	fmt.Fprintln(Output())
+}
+
+// PrintRecentSessions recaps the most recent ended sessions at session start
+//
+// What It Does:
+//   - Reads the newest n summaries from sessions-history.jsonl (history.go)
+//   - Last session: duration, branch, tasks, how and when it ended, then its focus
+//   - Older sessions (n > 1): one "Earlier:" row each
+//
+// Parameters:
+//   - n: Sessions to show (1 = just the last one)
+//
+// Returns:
+//   - None (prints to stdout, silently skips if disabled, empty, or unreadable)
+//
+// Health Impact:
+//   - No health tracking (pure display function)
+//
+// Example:
+//   session.PrintRecentSessions(1)
+//   // Last Session:  2h10m on branch feature/logging-sinks, 4 tasks, ended normally 14h ago
+func PrintRecentSessions(n int) {
+	maybeReloadDisplayConfig()
+
+	if !visible("recent_sessions") {
+		return
+	}
+
+	recent, err := GetRecentSessions(n)
+	if err != nil || len(recent) == 0 {
+		return
+	}
+
+	cfg := currentDisplayConfig()
+	labels := cfg.FieldLabels.Recent
+	at := now()
+
+	printSectionHeader(cfg.SectionHeaders.SessionStart.RecentSessions)
+
+	rows := []fieldRow{{cfg.Icons.Temporal.Calendar, labels.LastSession, recentSessionText(recent[0], at)}}
+	if recent[0].Focus != "" {
+		rows = append(rows, fieldRow{cfg.Icons.Status.Preservation, labels.Focus, recent[0].Focus})
+	}
+	for _, summary := range recent[1:] {
+		rows = append(rows, fieldRow{cfg.Icons.Temporal.Calendar, labels.Earlier, recentSessionText(summary, at)})
+	}
+
+	fmt.Fprintln(Output())
+	fmt.Fprint(Output(), formatFields("  ", rows))
+	fmt.Fprintln(Output())
 }
 
 // PrintWorkspaceAnalysis displays workspace analysis header
@@ -959,27 +2000,56 @@ func PrintTemporalAwareness() {
 //   session.PrintWorkspaceAnalysis("/path/to/workspace", true)
 //   // Outputs workspace analysis header
 func PrintWorkspaceAnalysis(workspace string, hasContext bool) {
-	if !displayConfig.Behavior.SessionDisplay.ShowWorkspaceAnalysis {
+	maybeReloadDisplayConfig()
+
+	if !visible("workspace_analysis") {
 		return
 	}
 
-	cfg := displayConfig
+	cfg := currentDisplayConfig()
 
-	// Use display rail for section header
-	fmt.Print(display.Header(cfg.SectionHeaders.SessionStart.WorkspaceAnalysis))
+	// Section header (width and characters resolved per terminal)
+	printSectionHeader(cfg.SectionHeaders.SessionStart.WorkspaceAnalysis)
 
 	if workspace == "" {
-		fmt.Printf("\n  %s\n", cfg.Messages.Workspace.NoWorkspace)
-		fmt.Println()
+		fmt.Fprintf(Output(), "\n  %s\n", cfg.Messages.Workspace.NoWorkspace)
+		fmt.Fprintln(Output())
 		return
 	}
 
 	// If nothing was reported, indicate healthy state
 	if !hasContext {
-		fmt.Printf("\n  %s\n", cfg.Messages.Workspace.WorkspaceHealthy)
+		fmt.Fprintf(Output(), "\n  %s\n", cfg.Messages.Workspace.WorkspaceHealthy)
+	}
+
+	fmt.Fprintln(Output())
+}
+
+// PrintWorkspaceAnalysisSkipped displays the workspace analysis header with
+// a degraded note, for when the workspace source missed the start deadline
+//
+// The section stays visible so a slow workspace reads as "timed out" rather
+// than as a missing section.
+//
+// Returns:
+//   - None (prints to stdout, silently skips if disabled)
+//
+// Example:
+//   if errors.Is(err, session.ErrSourceSkipped) {
+//       session.PrintWorkspaceAnalysisSkipped()
+//   }
+func PrintWorkspaceAnalysisSkipped() {
+	maybeReloadDisplayConfig()
+
+	if !visible("workspace_analysis") {
+		return
 	}
 
-	fmt.Println()
+	cfg := currentDisplayConfig()
+
+	printSectionHeader(cfg.SectionHeaders.SessionStart.WorkspaceAnalysis)
+	fmt.Fprintf(Output(), "\n  %s\n", cfg.Messages.Workspace.SourceSkipped)
+	fmt.Fprintln(Output())
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -992,6 +2062,7 @@ func PrintWorkspaceAnalysis(workspace string, hasContext bool) {
 //   - Shows task completion banner with biblical foundation
 //   - Displays configured verse reminder about working for the Lord
 //   - Provides visual separation for stop event
+//   - Quiet verbosity: one line instead ("Stopped: 15:04 · 2h37m elapsed")
 //
 // Parameters:
 //   - None
@@ -1009,16 +2080,31 @@ func PrintWorkspaceAnalysis(workspace string, hasContext bool) {
 //   // ║           Task Complete - Excellence that Honors God          ║
 //   // ...
 func PrintStopHeader() {
-	cfg := displayConfig
+	maybeReloadDisplayConfig()
+
+	// Quiet: one line in place of the banner
+	if visible("stop_line") {
+		elapsed := ""
+		if ctx, err := currentTemporalContext(); err == nil {
+			elapsed = ctx.InternalTime.ElapsedFormatted
+		}
+		fmt.Fprintln(Output(), stopLine(currentDisplayConfig(), now(), elapsed))
+	}
+	if !visible("stop_header") {
+		return
+	}
 
-	// Build multi-line banner message (verse split for readability)
-	message := "\n" +
-		"\"" + cfg.BiblicalVerses.SessionStop.VerseText[:60] + "\"\n" +
-		cfg.BiblicalVerses.SessionStop.VerseText[60:] + " - " + cfg.BiblicalVerses.SessionStop.VerseRef
+	cfg := currentDisplayConfig()
 
-	// Use display rail for banner box
-	fmt.Println()
-	fmt.Print(display.Box(cfg.BiblicalVerses.SessionStop.BannerTitle, message))
+	// Build banner message (verse wrapped on word boundaries, any length)
+	layout := resolveBannerLayout()
+	single := cfg.BiblicalVerses.SessionStop
+	verse := resolveVerse(verseEventStop, BiblicalVerseConfig{VerseText: single.VerseText, VerseRef: single.VerseRef})
+	message := "\n" + strings.Join(verseLines(layout, verse.VerseText, verse.VerseRef), "\n")
+
+	// Banner sized to the terminal
+	fmt.Fprintln(Output())
+	fmt.Fprint(Output(), renderBanner(layout, single.BannerTitle, message))
 }
 
 // PrintStopInfo displays stopping point check header
@@ -1041,16 +2127,23 @@ func PrintStopHeader() {
 //   session.PrintStopInfo()
 //   // Outputs stopping point check header with timestamp
 func PrintStopInfo() {
-	cfg := displayConfig
+	maybeReloadDisplayConfig()
 
-	// Use display rail for section header
-	fmt.Println()
-	fmt.Print(display.Header(cfg.SectionHeaders.SessionStop.StoppingPoint))
+	if !visible("stop_info") {
+		return
+	}
+
+	cfg := currentDisplayConfig()
 
-	now := time.Now().Format("Mon Jan 02, 2006 at 15:04:05")
-	fmt.Printf("\n  %s %s            %s\n", cfg.Icons.Environment.Time, cfg.FieldLabels.Stop.Stopped, now)
+	// Section header (width and characters resolved per terminal)
+	fmt.Fprintln(Output())
+	printSectionHeader(cfg.SectionHeaders.SessionStop.StoppingPoint)
 
-	fmt.Println()
+	timestamp := now().Format(sessionDateFormat())
+	fmt.Fprintln(Output())
+	fmt.Fprint(Output(), formatFields("  ", []fieldRow{{cfg.Icons.Environment.Time, cfg.FieldLabels.Stop.Stopped, timestamp}}))
+
+	fmt.Fprintln(Output())
 }
 
 // PrintStoppingContext displays temporal context at session stop
@@ -1075,57 +2168,58 @@ func PrintStopInfo() {
 //   session.PrintStoppingContext()
 //   // Outputs temporal context section at stop time
 func PrintStoppingContext() {
-	if !displayConfig.Behavior.SessionDisplay.ShowStoppingContext {
+	maybeReloadDisplayConfig()
+
+	if !visible("stopping_context") {
 		return
 	}
 
-	ctx, err := temporal.GetTemporalContext()
+	ctx, err := currentTemporalContext()
 	if err != nil {
 		// Silently skip if temporal awareness unavailable
 		return
 	}
 
-	cfg := displayConfig
+	cfg := currentDisplayConfig()
 
-	// Use display rail for section header
-	fmt.Print(display.Header(cfg.SectionHeaders.SessionStop.TemporalContext))
+	// Section header (width and characters resolved per terminal)
+	printSectionHeader(cfg.SectionHeaders.SessionStop.TemporalContext)
 
 	// Show where we were in time
-	fmt.Printf("  %s %s               %s (%s)\n",
-		cfg.Icons.Environment.Time, cfg.FieldLabels.Stop.Time,
-		ctx.ExternalTime.Formatted, ctx.ExternalTime.TimeOfDay)
+	rows := []fieldRow{
+		{cfg.Icons.Environment.Time, cfg.FieldLabels.Stop.Time, fmt.Sprintf("%s (%s)", ctx.ExternalTime.Formatted, ctx.ExternalTime.TimeOfDay)},
+	}
 
 	// Show how long we worked
 	if ctx.InternalTime.ElapsedFormatted != "" {
-		fmt.Printf("  %s %s   %s (%s session)\n",
-			cfg.Icons.Temporal.InternalTime, cfg.FieldLabels.Temporal.SessionDuration,
-			ctx.InternalTime.ElapsedFormatted, ctx.InternalTime.SessionPhase)
+		rows = append(rows, fieldRow{cfg.Icons.Temporal.InternalTime, cfg.FieldLabels.Temporal.SessionDuration,
+			fmt.Sprintf("%s (%s session)", ctx.InternalTime.ElapsedFormatted, ctx.InternalTime.SessionPhase)})
 	}
 
 	// Show what we were doing
 	if ctx.InternalSchedule.CurrentActivity != "" {
-		fmt.Printf("  %s %s   %s (%s)\n",
-			cfg.Icons.Temporal.Schedule, cfg.FieldLabels.Stop.ScheduleContext,
-			ctx.InternalSchedule.CurrentActivity, ctx.InternalSchedule.ActivityType)
+		rows = append(rows, fieldRow{cfg.Icons.Temporal.Schedule, cfg.FieldLabels.Stop.ScheduleContext,
+			fmt.Sprintf("%s (%s)", ctx.InternalSchedule.CurrentActivity, ctx.InternalSchedule.ActivityType)})
 		if ctx.InternalSchedule.InWorkWindow {
-			fmt.Printf("                         %s Was in work window\n", cfg.Icons.Status.Success)
+			rows = append(rows, fieldRow{Value: cfg.Icons.Status.Success + " Was in work window"})
 		}
 		if ctx.InternalSchedule.ExpectedDowntime {
-			fmt.Printf("                         %s Expected downtime period\n", cfg.Icons.Status.Warning)
+			rows = append(rows, fieldRow{Value: cfg.Icons.Status.Warning + " Expected downtime period"})
 		}
 	}
 
 	// Show calendar context
 	if ctx.ExternalCalendar.Date != "" {
-		fmt.Printf("  %s %s               %s, %s %d (Week %d)\n",
-			cfg.Icons.Temporal.Calendar, cfg.FieldLabels.Stop.Date,
-			ctx.ExternalCalendar.DayOfWeek,
-			ctx.ExternalCalendar.MonthName,
-			ctx.ExternalCalendar.DayOfMonth,
-			ctx.ExternalCalendar.WeekNumber)
+		rows = append(rows, fieldRow{cfg.Icons.Temporal.Calendar, cfg.FieldLabels.Stop.Date,
+			fmt.Sprintf("%s, %s %d (Week %d)",
+				ctx.ExternalCalendar.DayOfWeek,
+				ctx.ExternalCalendar.MonthName,
+				ctx.ExternalCalendar.DayOfMonth,
+				ctx.ExternalCalendar.WeekNumber)})
 	}
 
-	fmt.Println()
+	fmt.Fprint(Output(), formatFields("  ", rows))
+	fmt.Fprintln(Output())
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -1155,16 +2249,23 @@ func PrintStoppingContext() {
 //   // ║                Session Ending - Grace and Peace               ║
 //   // ...
 func PrintEndFarewell() {
-	cfg := displayConfig
+	maybeReloadDisplayConfig()
+
+	if !visible("end_farewell") {
+		return
+	}
+
+	cfg := currentDisplayConfig()
 
-	// Build multi-line banner message (verse split for readability)
-	message := "\n" +
-		"\"" + cfg.BiblicalVerses.SessionEnd.VerseText[:60] + "\"\n" +
-		cfg.BiblicalVerses.SessionEnd.VerseText[60:] + " - " + cfg.BiblicalVerses.SessionEnd.VerseRef
+	// Build banner message (verse wrapped on word boundaries, any length)
+	layout := resolveBannerLayout()
+	single := cfg.BiblicalVerses.SessionEnd
+	verse := resolveVerse(verseEventEnd, BiblicalVerseConfig{VerseText: single.VerseText, VerseRef: single.VerseRef})
+	message := "\n" + strings.Join(verseLines(layout, verse.VerseText, verse.VerseRef), "\n")
 
-	// Use display rail for banner box
-	fmt.Println()
-	fmt.Print(display.Box(cfg.BiblicalVerses.SessionEnd.BannerTitle, message))
+	// Banner sized to the terminal
+	fmt.Fprintln(Output())
+	fmt.Fprint(Output(), renderBanner(layout, single.BannerTitle, message))
 }
 
 // PrintEndSessionInfo displays session summary with end time and reason
@@ -1187,17 +2288,147 @@ func PrintEndFarewell() {
 //   session.PrintEndSessionInfo("Normal session end")
 //   // Outputs session summary with timestamp and reason
 func PrintEndSessionInfo(reason string) {
-	cfg := displayConfig
+	maybeReloadDisplayConfig()
+
+	if !visible("end_session_info") {
+		return
+	}
+
+	cfg := currentDisplayConfig()
 
-	// Use display rail for section header
-	fmt.Println()
-	fmt.Print(display.Header(cfg.SectionHeaders.SessionEnd.SessionSummary))
+	// Section header (width and characters resolved per terminal)
+	fmt.Fprintln(Output())
+	printSectionHeader(cfg.SectionHeaders.SessionEnd.SessionSummary)
 
-	now := time.Now().Format("Mon Jan 02, 2006 at 15:04:05")
-	fmt.Printf("\n  %s %s              %s\n", cfg.Icons.Environment.Time, cfg.FieldLabels.End.Ended, now)
-	fmt.Printf("  %s %s             %s\n", cfg.Icons.Temporal.Schedule, cfg.FieldLabels.End.Reason, reason)
+	timestamp := now().Format(sessionDateFormat())
+	fmt.Fprintln(Output())
+	fmt.Fprint(Output(), display.KeyValueTable([]display.KV{
+		{Icon: cfg.Icons.Environment.Time, Key: cfg.FieldLabels.End.Ended, Value: timestamp},
+		{Icon: cfg.Icons.Temporal.Schedule, Key: cfg.FieldLabels.End.Reason, Value: reason},
+	}, fieldTableOpts("  ")))
 
-	fmt.Println()
+	fmt.Fprintln(Output())
+}
+
+// PrintEndSessionInfoFromPayload is PrintEndSessionInfo with the reason from the hook payload
+//
+// The SessionEnd payload's reason (clear, logout, prompt_input_exit, other),
+// else REASON, else "Normal session end".
+//
+// Example:
+//   session.PrintEndSessionInfoFromPayload(session.HookInput())
+func PrintEndSessionInfoFromPayload(p *HookPayload) {
+	reason := p.Reason
+	if reason == "" {
+		reason = "Normal session end"
+	}
+	PrintEndSessionInfo(reason)
+}
+
+// PrintEndStatistics displays what the session accomplished
+//
+// What It Does:
+//   - Quality indicators: tasks, breakthroughs, struggles, compactions
+//   - Git activity: commits since start, files changed (uncommitted noted)
+//   - Component health: average across components that logged this session
+//   - Each part appears only when its source was available; nothing at all
+//     available skips the section
+//   - Quiet verbosity: one line instead ("Session ended normally · 2h10m · 4 tasks · 3 commits")
+//
+// Parameters:
+//   - stats: Collected by CollectSessionStats (stats.go)
+//
+// Returns:
+//   - None (prints to stdout, silently skips if disabled or empty)
+//
+// Health Impact:
+//   - No health tracking (pure display function)
+//
+// Example:
+//   stats := session.CollectSessionStats(workspace, reason)
+//   session.PrintEndStatistics(stats)
+func PrintEndStatistics(stats SessionStats) {
+	maybeReloadDisplayConfig()
+
+	// Quiet: the whole session in one line
+	if visible("end_line") {
+		fmt.Fprintln(Output(), endLine(stats))
+	}
+	if !visible("end_statistics") {
+		return
+	}
+
+	cfg := currentDisplayConfig()
+	labels := cfg.FieldLabels.End
+	var rows []fieldRow
+
+	if q := stats.Quality; q != nil {
+		rows = append(rows,
+			fieldRow{cfg.Icons.Status.Success, labels.TasksCompleted, fmt.Sprintf("%d", q.TasksCompleted)},
+			fieldRow{cfg.Icons.Status.Info, labels.Breakthroughs, fmt.Sprintf("%d", q.Breakthroughs)},
+			fieldRow{cfg.Icons.Status.Warning, labels.Struggles, fmt.Sprintf("%d", q.Struggles)},
+			fieldRow{cfg.Icons.Status.Compaction, labels.Compactions, fmt.Sprintf("%d", q.Compactions)},
+		)
+	}
+
+	if g := stats.Git; g != nil {
+		files := fmt.Sprintf("%d", g.FilesChanged)
+		if g.Uncommitted > 0 {
+			files += fmt.Sprintf(" (%d uncommitted)", g.Uncommitted)
+		}
+		rows = append(rows,
+			fieldRow{cfg.Icons.Environment.GitBranch, labels.Commits, fmt.Sprintf("%d", g.Commits)},
+			fieldRow{cfg.Icons.Environment.WorkingDirectory, labels.FilesChanged, files},
+		)
+	}
+
+	if h := stats.Health; h != nil {
+		style := logging.DefaultHealthStyle()
+		style.BarWidth = 0 // Indicator and value - a bar doesn't fit the field column
+		value := fmt.Sprintf("%s across %d components", logging.FormatHealth(h.AverageHealth, style), h.Components)
+		if h.Components > 1 {
+			value += fmt.Sprintf(" (lowest: %s %d%%)", h.LowestComponent, h.LowestHealth)
+		}
+		rows = append(rows, fieldRow{cfg.Icons.Environment.System, labels.AverageHealth, value})
+	}
+
+	if len(rows) == 0 {
+		return // No source available - nothing honest to show
+	}
+
+	printSectionHeader(cfg.SectionHeaders.SessionEnd.Statistics)
+	fmt.Fprint(Output(), formatFields("  ", rows))
+	fmt.Fprintln(Output())
+}
+
+// PrintJournalDraft shows where the session's journal draft was written
+//
+// What It Does:
+//   - Prints one field line: "📓 Journal draft: ~/.../2025-11-18-session-a1b2-draft.md"
+//   - Shortens the home directory to ~
+//
+// Parameters:
+//   - path: Draft path from DraftSessionJournal ("" prints nothing)
+//
+// Health Impact:
+//   - No health tracking (pure display function)
+//
+// Example:
+//   if path, err := session.DraftSessionJournal(); err == nil && path != "" {
+//       session.PrintJournalDraft(path)
+//   }
+func PrintJournalDraft(path string) {
+	if path == "" {
+		return
+	}
+	maybeReloadDisplayConfig()
+
+	if home, err := os.UserHomeDir(); err == nil && strings.HasPrefix(path, home+string(os.PathSeparator)) {
+		path = "~" + path[len(home):]
+	}
+	cfg := currentDisplayConfig()
+	fmt.Fprint(Output(), formatFields("  ", []fieldRow{{cfg.Icons.Status.Journal, cfg.FieldLabels.End.JournalDraft, path}}))
+	fmt.Fprintln(Output())
 }
 
 // PrintEndTemporalJourney displays temporal context journey for session end
@@ -1206,11 +2437,12 @@ func PrintEndSessionInfo(reason string) {
 //   - Shows complete temporal journey through the session
 //   - Displays session duration and phase
 //   - Shows start and end times
-//   - Displays work context during session
+//   - Displays the phase timeline (journey.go), capped at
+//     journey_max_segments rows; without a ledger, the final work context
 //   - Shows calendar context
 //
 // Parameters:
-//   - None (reads from temporal context)
+//   - None (reads from temporal context and the journey ledger)
 //
 // Returns:
 //   - None (prints to stdout, silently skips if unavailable or disabled)
@@ -1222,54 +2454,64 @@ func PrintEndSessionInfo(reason string) {
 //   session.PrintEndTemporalJourney()
 //   // Outputs temporal journey section showing session timeline
 func PrintEndTemporalJourney() {
-	if !displayConfig.Behavior.SessionDisplay.ShowTemporalJourney {
+	maybeReloadDisplayConfig()
+
+	if !visible("temporal_journey") {
 		return
 	}
 
-	ctx, err := temporal.GetTemporalContext()
+	ctx, err := currentTemporalContext()
 	if err != nil {
 		// Silently skip if temporal awareness unavailable
 		return
 	}
 
-	cfg := displayConfig
+	cfg := currentDisplayConfig()
+
+	// Section header (width and characters resolved per terminal)
+	printSectionHeader(cfg.SectionHeaders.SessionEnd.TemporalJourney)
 
-	// Use display rail for section header
-	fmt.Print(display.Header(cfg.SectionHeaders.SessionEnd.TemporalJourney))
+	var rows []fieldRow
 
 	// Show session duration
 	if ctx.InternalTime.ElapsedFormatted != "" {
-		fmt.Printf("  %s %s   %s (%s session)\n",
-			cfg.Icons.Temporal.InternalTime, cfg.FieldLabels.Temporal.SessionDuration,
-			ctx.InternalTime.ElapsedFormatted, ctx.InternalTime.SessionPhase)
-		fmt.Printf("                         %s %s\n",
-			cfg.FieldLabels.End.Started,
-			ctx.InternalTime.SessionStart.Format("15:04:05"))
+		rows = append(rows,
+			fieldRow{cfg.Icons.Temporal.InternalTime, cfg.FieldLabels.Temporal.SessionDuration,
+				fmt.Sprintf("%s (%s session)", ctx.InternalTime.ElapsedFormatted, ctx.InternalTime.SessionPhase)},
+			fieldRow{Value: cfg.FieldLabels.End.Started + " " + ctx.InternalTime.SessionStart.Format("15:04:05")},
+		)
 	}
 
 	// Show current time
-	fmt.Printf("  %s %s          %s (%s)\n",
-		cfg.Icons.Environment.Time, cfg.FieldLabels.End.EndingAt,
-		ctx.ExternalTime.Formatted, ctx.ExternalTime.TimeOfDay)
-
-	// Show what temporal context this work happened in
-	if ctx.InternalSchedule.CurrentActivity != "" {
-		fmt.Printf("  %s %s       %s (%s)\n",
-			cfg.Icons.Temporal.Schedule, cfg.FieldLabels.Temporal.WorkContext,
-			ctx.InternalSchedule.CurrentActivity, ctx.InternalSchedule.ActivityType)
+	rows = append(rows, fieldRow{cfg.Icons.Environment.Time, cfg.FieldLabels.End.EndingAt,
+		fmt.Sprintf("%s (%s)", ctx.ExternalTime.Formatted, ctx.ExternalTime.TimeOfDay)})
+
+	// Show the phases the session passed through (journey.go ledger), else the final work context
+	if journey, err := GetTemporalJourney(); err == nil && len(journey) > 0 {
+		for i, row := range journeyRows(journey, journeyMaxSegments()) {
+			if i == 0 {
+				rows = append(rows, fieldRow{cfg.Icons.Temporal.Schedule, cfg.FieldLabels.Temporal.Journey, row})
+			} else {
+				rows = append(rows, fieldRow{Value: row})
+			}
+		}
+	} else if ctx.InternalSchedule.CurrentActivity != "" {
+		rows = append(rows, fieldRow{cfg.Icons.Temporal.Schedule, cfg.FieldLabels.Temporal.WorkContext,
+			fmt.Sprintf("%s (%s)", ctx.InternalSchedule.CurrentActivity, ctx.InternalSchedule.ActivityType)})
 	}
 
 	// Show calendar context
 	if ctx.ExternalCalendar.Date != "" {
-		fmt.Printf("  %s %s       %s, %s %d (Week %d)\n",
-			cfg.Icons.Temporal.Calendar, cfg.FieldLabels.Temporal.DateContext,
-			ctx.ExternalCalendar.DayOfWeek,
-			ctx.ExternalCalendar.MonthName,
-			ctx.ExternalCalendar.DayOfMonth,
-			ctx.ExternalCalendar.WeekNumber)
+		rows = append(rows, fieldRow{cfg.Icons.Temporal.Calendar, cfg.FieldLabels.Temporal.DateContext,
+			fmt.Sprintf("%s, %s %d (Week %d)",
+				ctx.ExternalCalendar.DayOfWeek,
+				ctx.ExternalCalendar.MonthName,
+				ctx.ExternalCalendar.DayOfMonth,
+				ctx.ExternalCalendar.WeekNumber)})
 	}
 
-	fmt.Println()
+	fmt.Fprint(Output(), formatFields("  ", rows))
+	fmt.Fprintln(Output())
 }
 
 // PrintEndRemindersHeader displays state reminders section header
@@ -1291,10 +2533,29 @@ func PrintEndTemporalJourney() {
 //   session.PrintEndRemindersHeader()
 //   // Outputs state reminders header for uncommitted work, processes, etc.
 func PrintEndRemindersHeader() {
-	cfg := displayConfig
+	maybeReloadDisplayConfig()
 
-	// Use display rail for section header
-	fmt.Print(display.Header(cfg.SectionHeaders.SessionEnd.StateReminders))
+	cfg := currentDisplayConfig()
+
+	// Section header (width and characters resolved per terminal)
+	printSectionHeader(cfg.SectionHeaders.SessionEnd.StateReminders)
+}
+
+// PrintClosingDivider draws the divider that closes stop and end output
+//
+// What It Does:
+//   - Separator character and width from resolveBannerLayout, so piped or
+//     TERM=dumb output gets "=" at the banner width instead of box drawing
+//   - Blank line after, matching the hooks' closing spacing
+//
+// Example:
+//   session.PrintClosingDivider()
+func PrintClosingDivider() {
+	maybeReloadDisplayConfig()
+
+	layout := resolveBannerLayout()
+	fmt.Fprintln(Output(), strings.Repeat(layout.Box.Separator, layout.Width))
+	fmt.Fprintln(Output())
 }
 
 // PrintSessionContext displays the complete session context as formatted, readable text.
@@ -1311,14 +2572,17 @@ func PrintEndRemindersHeader() {
 //   session.PrintSessionContext(contextMarkdown)
 //   // Outputs formatted session context with proper spacing and structure
 func PrintSessionContext(contextMarkdown string) {
+	maybeReloadDisplayConfig()
+
 	if contextMarkdown == "" {
 		return
 	}
 
 	// Print separator before context
-	fmt.Println()
-	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-	fmt.Println()
+	layout := resolveBannerLayout()
+	fmt.Fprintln(Output())
+	fmt.Fprintln(Output(), strings.Repeat(layout.Box.Separator, layout.Width))
+	fmt.Fprintln(Output())
 
 	// Simple markdown formatting - convert to readable text
 	lines := strings.Split(contextMarkdown, "\n")
@@ -1331,9 +2595,9 @@ func PrintSessionContext(contextMarkdown string) {
 		// Format headings
 		if strings.HasPrefix(line, "## ") {
 			// Section headers
-			fmt.Println()
-			fmt.Printf("%s\n", strings.TrimPrefix(line, "## "))
-			fmt.Println()
+			fmt.Fprintln(Output())
+			fmt.Fprintf(Output(), "%s\n", strings.TrimPrefix(line, "## "))
+			fmt.Fprintln(Output())
 			continue
 		}
 
@@ -1344,10 +2608,10 @@ func PrintSessionContext(contextMarkdown string) {
 		line = strings.ReplaceAll(line, "*", "")
 
 		// Print the line
-		fmt.Println(line)
+		fmt.Fprintln(Output(), line)
 	}
 
-	fmt.Println()
+	fmt.Fprintln(Output())
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -1378,11 +2642,107 @@ func PrintSessionContext(contextMarkdown string) {
 //   session.PrintSubagentCompletion("research", "success", "0", "")
 //   // Outputs subagent completion summary with temporal awareness
 func PrintSubagentCompletion(agentType, status, exitCode, errorMsg string) {
-	cfg := displayConfig
+	PrintSubagentCompletionWithStats(agentType, status, exitCode, errorMsg, nil)
+}
+
+// PrintSubagentCompletionFromPayload is PrintSubagentCompletionWithStats with fields from the hook payload
+//
+// Agent type defaults to "unknown"; status, exit code, and error come from the
+// payload or the SUBAGENT_* fallbacks and may be empty. stats may be nil.
+//
+// Example:
+//   payload := session.HookInput()
+//   stats, _ := session.AnalyzeSubagentTranscript(payload.SubagentTranscript())
+//   session.PrintSubagentCompletionFromPayload(payload, stats)
+func PrintSubagentCompletionFromPayload(p *HookPayload, stats *SubagentStats) {
+	agentType := p.AgentType
+	if agentType == "" {
+		agentType = "unknown"
+	}
+	PrintSubagentCompletionWithStats(agentType, p.AgentStatus, p.AgentExitCode, p.AgentError, stats)
+}
+
+// subagentToolsShown caps the tool names listed in the Tools row
+const subagentToolsShown = 4
 
-	// Use display rail for section header
-	fmt.Println()
-	fmt.Print(display.Header(cfg.SectionHeaders.Subagent.Completion))
+// subagentFilesShown caps the file names listed in the Files Modified row
+const subagentFilesShown = 3
+
+// subagentStatsRows renders transcript stats as field rows (nil stats = none)
+//
+// Rows with nothing to say are left out - a transcript without usage records
+// shows no Tokens row rather than "0 in / 0 out".
+func subagentStatsRows(cfg *SessionDisplayConfig, stats *SubagentStats) []fieldRow {
+	if stats == nil {
+		return nil
+	}
+	labels := cfg.FieldLabels.Subagent
+	var rows []fieldRow
+
+	if stats.TotalTools > 0 {
+		top := stats.TopTools(subagentToolsShown)
+		parts := make([]string, 0, len(top)+1)
+		for _, name := range top {
+			parts = append(parts, fmt.Sprintf("%s %d", name, stats.ToolCalls[name]))
+		}
+		if more := len(stats.ToolCalls) - len(top); more > 0 {
+			parts = append(parts, fmt.Sprintf("+%d more", more))
+		}
+		rows = append(rows, fieldRow{cfg.Icons.Environment.System, labels.Tools,
+			fmt.Sprintf("%s (%s)", plural(stats.TotalTools, "call"), strings.Join(parts, ", "))})
+	}
+
+	if count := len(stats.FilesModified); count > 0 {
+		names := make([]string, 0, subagentFilesShown+1)
+		for _, path := range stats.FilesModified[:min(count, subagentFilesShown)] {
+			names = append(names, filepath.Base(path))
+		}
+		if count > subagentFilesShown {
+			names = append(names, fmt.Sprintf("+%d more", count-subagentFilesShown))
+		}
+		rows = append(rows, fieldRow{cfg.Icons.Environment.WorkingDirectory, labels.FilesModified,
+			fmt.Sprintf("%d (%s)", count, strings.Join(names, ", "))})
+	}
+
+	if stats.InputTokens > 0 || stats.OutputTokens > 0 {
+		rows = append(rows, fieldRow{cfg.Icons.Status.Info, labels.Tokens,
+			fmt.Sprintf("%d in / %d out", stats.InputTokens, stats.OutputTokens)})
+	}
+
+	if stats.Duration > 0 {
+		rows = append(rows, fieldRow{cfg.Icons.Temporal.InternalTime, labels.WorkDuration,
+			stats.Duration.Round(time.Second).String()})
+	}
+	return rows
+}
+
+// PrintSubagentCompletionWithStats displays subagent completion with a transcript stats block
+//
+// What It Does:
+//   - Everything PrintSubagentCompletion shows
+//   - Followed by the subagent's tools used, files modified, tokens, and
+//     working time from AnalyzeSubagentTranscript
+//   - nil stats (transcript missing or unreadable) prints exactly what
+//     PrintSubagentCompletion prints
+//
+// Parameters:
+//   - agentType, status, exitCode, errorMsg: As PrintSubagentCompletion
+//   - stats: Transcript summary, or nil
+//
+// Returns:
+//   - None (prints to stdout)
+//
+// Example:
+//   stats, _ := session.AnalyzeSubagentTranscript(path) // nil on error
+//   session.PrintSubagentCompletionWithStats("research", "success", "0", "", stats)
+func PrintSubagentCompletionWithStats(agentType, status, exitCode, errorMsg string, stats *SubagentStats) {
+	maybeReloadDisplayConfig()
+
+	cfg := currentDisplayConfig()
+
+	// Section header (width and characters resolved per terminal)
+	fmt.Fprintln(Output())
+	printSectionHeader(cfg.SectionHeaders.Subagent.Completion)
 
 	// Determine completion status and display appropriate message
 	var message string
@@ -1397,33 +2757,39 @@ func PrintSubagentCompletion(agentType, status, exitCode, errorMsg string) {
 		message = formatDisplayMessage(cfg.Messages.Subagent.Default, map[string]string{"type": agentType})
 	}
 
-	fmt.Printf("\n  %s\n", message)
+	fmt.Fprintf(Output(), "\n  %s\n", message)
 
 	// Show error message if present
 	if errorMsg != "" {
-		fmt.Printf("     Error: %s\n", errorMsg)
+		fmt.Fprintf(Output(), "     Error: %s\n", errorMsg)
 	}
 
 	// Show temporal context of completion
-	ctx, err := temporal.GetTemporalContext()
+	ctx, err := currentTemporalContext()
 	if err == nil {
-		fmt.Println()
-		fmt.Printf("  %s %s       %s (%s)\n",
-			cfg.Icons.Environment.Time, cfg.FieldLabels.Subagent.CompletedAt,
-			ctx.ExternalTime.Formatted, ctx.ExternalTime.TimeOfDay)
+		rows := []fieldRow{
+			{cfg.Icons.Environment.Time, cfg.FieldLabels.Subagent.CompletedAt, fmt.Sprintf("%s (%s)", ctx.ExternalTime.Formatted, ctx.ExternalTime.TimeOfDay)},
+		}
 		if ctx.InternalTime.ElapsedFormatted != "" {
-			fmt.Printf("  %s %s   %s (%s session)\n",
-				cfg.Icons.Temporal.InternalTime, cfg.FieldLabels.Temporal.SessionDuration,
-				ctx.InternalTime.ElapsedFormatted, ctx.InternalTime.SessionPhase)
+			rows = append(rows, fieldRow{cfg.Icons.Temporal.InternalTime, cfg.FieldLabels.Temporal.SessionDuration,
+				fmt.Sprintf("%s (%s session)", ctx.InternalTime.ElapsedFormatted, ctx.InternalTime.SessionPhase)})
 		}
 		if ctx.InternalSchedule.CurrentActivity != "" {
-			fmt.Printf("  %s %s             %s (%s)\n",
-				cfg.Icons.Temporal.Schedule, cfg.FieldLabels.Subagent.During,
-				ctx.InternalSchedule.CurrentActivity, ctx.InternalSchedule.ActivityType)
+			rows = append(rows, fieldRow{cfg.Icons.Temporal.Schedule, cfg.FieldLabels.Subagent.During,
+				fmt.Sprintf("%s (%s)", ctx.InternalSchedule.CurrentActivity, ctx.InternalSchedule.ActivityType)})
 		}
+
+		fmt.Fprintln(Output())
+		fmt.Fprint(Output(), formatFields("  ", rows))
+	}
+
+	// Show what the subagent did (transcript stats, when available)
+	if rows := subagentStatsRows(cfg, stats); len(rows) > 0 {
+		fmt.Fprintln(Output())
+		fmt.Fprint(Output(), formatFields("  ", rows))
 	}
 
-	fmt.Println()
+	fmt.Fprintln(Output())
 }
 
 // PrintPreCompactionMessage displays compaction notification with temporal preservation
@@ -1449,7 +2815,9 @@ func PrintSubagentCompletion(agentType, status, exitCode, errorMsg string) {
 //   // Outputs: 🔄 Auto-compaction #3 - managing token usage...
 //   //          📍 Temporal State Preservation: ...
 func PrintPreCompactionMessage(compactType string, compactionCount int) {
-	cfg := displayConfig
+	maybeReloadDisplayConfig()
+
+	cfg := currentDisplayConfig()
 
 	// Display compaction type with appropriate message
 	var message string
@@ -1468,41 +2836,135 @@ func PrintPreCompactionMessage(compactType string, compactionCount int) {
 		})
 	}
 
-	fmt.Printf("%s %s\n", cfg.Icons.Status.Compaction, message)
+	fmt.Fprintf(Output(), "%s %s\n", cfg.Icons.Status.Compaction, message)
 
 	// Preserve temporal awareness for post-compaction reconstitution
-	if !cfg.Behavior.SessionDisplay.ShowCompactionPreservation {
+	if !visible("compaction_preservation") {
 		return
 	}
 
-	ctx, err := temporal.GetTemporalContext()
+	ctx, err := currentTemporalContext()
 	if err == nil {
-		fmt.Println()
-		fmt.Println(cfg.Messages.Compaction.PreservationHeader)
-		fmt.Printf("   %s %s (%s)\n",
-			cfg.FieldLabels.Compaction.Time,
-			ctx.ExternalTime.Formatted, ctx.ExternalTime.TimeOfDay)
+		fmt.Fprintln(Output())
+		fmt.Fprintln(Output(), cfg.Messages.Compaction.PreservationHeader)
+		fmt.Fprint(Output(), formatFields("   ", compactionPreservationRows(cfg, ctx, compactionCount)))
+		fmt.Fprintln(Output())
+	}
+}
+
+// PrintPreCompactionMessageFromPayload is PrintPreCompactionMessage with the payload's trigger
+//
+// The PreCompact payload's trigger (manual, auto), else COMPACT_TYPE, else "unknown".
+//
+// Example:
+//   session.PrintPreCompactionMessageFromPayload(session.HookInput(), 3)
+func PrintPreCompactionMessageFromPayload(p *HookPayload, compactionCount int) {
+	trigger := p.Trigger
+	if trigger == "" {
+		trigger = "unknown"
+	}
+	PrintPreCompactionMessage(trigger, compactionCount)
+}
+
+// compactionPreservationRows lists the temporal state worth keeping through compaction
+//
+// Shared by the terminal display and OutputPreCompactContext (hookoutput.go).
+// A nil ctx leaves only the compaction count.
+func compactionPreservationRows(cfg *SessionDisplayConfig, ctx *temporal.TemporalContext, compactionCount int) []fieldRow {
+	var rows []fieldRow
+	if ctx != nil {
+		rows = append(rows, fieldRow{Label: cfg.FieldLabels.Compaction.Time, Value: fmt.Sprintf("%s (%s)", ctx.ExternalTime.Formatted, ctx.ExternalTime.TimeOfDay)})
 		if ctx.InternalTime.ElapsedFormatted != "" {
-			fmt.Printf("   %s %s elapsed (%s phase)\n",
-				cfg.FieldLabels.Compaction.Session,
-				ctx.InternalTime.ElapsedFormatted, ctx.InternalTime.SessionPhase)
+			rows = append(rows, fieldRow{Label: cfg.FieldLabels.Compaction.Session,
+				Value: fmt.Sprintf("%s elapsed (%s phase)", ctx.InternalTime.ElapsedFormatted, ctx.InternalTime.SessionPhase)})
 		}
 		if ctx.InternalSchedule.CurrentActivity != "" {
-			fmt.Printf("   %s %s (%s)\n",
-				cfg.FieldLabels.Compaction.Context,
-				ctx.InternalSchedule.CurrentActivity, ctx.InternalSchedule.ActivityType)
+			rows = append(rows, fieldRow{Label: cfg.FieldLabels.Compaction.Context,
+				Value: fmt.Sprintf("%s (%s)", ctx.InternalSchedule.CurrentActivity, ctx.InternalSchedule.ActivityType)})
 		}
 		if ctx.ExternalCalendar.Date != "" {
-			fmt.Printf("   %s %s, Week %d\n",
-				cfg.FieldLabels.Compaction.Date,
-				ctx.ExternalCalendar.DayOfWeek, ctx.ExternalCalendar.WeekNumber)
+			rows = append(rows, fieldRow{Label: cfg.FieldLabels.Compaction.Date,
+				Value: fmt.Sprintf("%s, Week %d", ctx.ExternalCalendar.DayOfWeek, ctx.ExternalCalendar.WeekNumber)})
 		}
-		if compactionCount > 0 {
-			fmt.Printf("   %s %d this session\n",
-				cfg.FieldLabels.Compaction.Compactions, compactionCount)
+	}
+	if compactionCount > 0 {
+		rows = append(rows, fieldRow{Label: cfg.FieldLabels.Compaction.Compactions,
+			Value: fmt.Sprintf("%d this session", compactionCount)})
+	}
+	return rows
+}
+
+// compactionAnalysisRows lists what filled the context (nil = nothing known)
+//
+// Rows without figures are left out; the advice row comes last.
+func compactionAnalysisRows(cfg *SessionDisplayConfig, analysis *CompactionAnalysis) []fieldRow {
+	if analysis == nil || analysis.ContextBytes == 0 {
+		return nil
+	}
+	labels := cfg.FieldLabels.Compaction
+	rows := []fieldRow{
+		{cfg.Icons.Status.Info, labels.Messages, fmt.Sprintf("%d (%s)", analysis.Messages, plural(analysis.ToolResults, "tool result"))},
+		{cfg.Icons.Status.Warning, labels.ToolOutput, fmt.Sprintf("%.0f%% (%s of %s)",
+			analysis.ToolFraction*100, shortSize(analysis.ToolBytes), shortSize(analysis.ContextBytes))},
+	}
+	if analysis.ContextTokens > 0 {
+		tokens := fmt.Sprintf("%d", analysis.ContextTokens)
+		if analysis.ContextWindow > 0 {
+			tokens = fmt.Sprintf("%d of %d (%.0f%%)", analysis.ContextTokens, analysis.ContextWindow,
+				float64(analysis.ContextTokens)*100/float64(analysis.ContextWindow))
+		}
+		rows = append(rows, fieldRow{cfg.Icons.Status.Info, labels.Tokens, tokens})
+	}
+	for i, contribution := range analysis.Largest {
+		label := labels.Largest
+		if i > 0 {
+			label = ""
 		}
-		fmt.Println()
+		rows = append(rows, fieldRow{cfg.Icons.Environment.WorkingDirectory, label, describeContribution(contribution)})
 	}
+	if advice := analysis.Advice(); advice != "" {
+		rows = append(rows, fieldRow{cfg.Icons.Status.Preservation, labels.Advice, advice})
+	}
+	return rows
+}
+
+// PrintCompactionAnalysis displays what filled the context before a compaction
+//
+// What It Does:
+//   - Shows messages since the last compaction, tool output's share of the
+//     context, context tokens (when known), and the largest tool results
+//   - Ends with one line of advice (Advice) naming the biggest item
+//   - Prints nothing for a nil analysis or one without transcript figures
+//   - Behind behavior.session_display.show_compaction_analysis
+//
+// Parameters:
+//   - analysis: From AnalyzePreCompactPayload (nil = nothing to show)
+//
+// Returns:
+//   - None (prints to stdout)
+//
+// Health Impact:
+//   - No health tracking (pure display function)
+//
+// Example:
+//   analysis, err := session.AnalyzePreCompactPayload(os.Stdin)
+//   if err == nil {
+//       session.PrintCompactionAnalysis(analysis)
+//   }
+func PrintCompactionAnalysis(analysis *CompactionAnalysis) {
+	maybeReloadDisplayConfig()
+
+	if !visible("compaction_analysis") {
+		return
+	}
+	cfg := currentDisplayConfig()
+	rows := compactionAnalysisRows(cfg, analysis)
+	if len(rows) == 0 {
+		return
+	}
+	fmt.Fprintln(Output(), cfg.Messages.Compaction.AnalysisHeader)
+	fmt.Fprint(Output(), formatFields("   ", rows))
+	fmt.Fprintln(Output())
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -1530,6 +2992,8 @@ func PrintPreCompactionMessage(compactType string, compactionCount int) {
 //   - Import the library without errors
 //   - Call each public function with representative parameters
 //   - Verify banner formatting with different border styles
+//   - Narrow terminal (COLUMNS=40, stdout piped) - banner clamps and re-centers
+//   - TERM=dumb or LANG=C - borders switch to +-|
 //   - Test configuration loading and fallback to defaults
 //   - Run: go test -v ./...
 //
@@ -1646,7 +3110,7 @@ func PrintPreCompactionMessage(compactType string, compactionCount int) {
 //
 //   Bottom Rungs (Helpers):
 //     - loadDisplayConfig, loadConfigFile, getDefaultDisplayConfig
-//     - formatMessage, expandPath, stripJSONCComments
+//     - formatMessage, expandPath
 //
 // Baton Flow (Execution):
 //   Hook → Public API → Configuration → Helpers → External Libraries → stdout
@@ -1707,11 +3171,11 @@ func PrintPreCompactionMessage(compactType string, compactionCount int) {
 //     4. Test with default config (rename config file temporarily)
 //
 // Problem: Biblical verses not displaying correctly
-//   Cause: Long verses don't split correctly at 60 characters
+//   Cause: Verse wraps awkwardly on very narrow terminals
 //   Solutions:
 //     1. Check verse text length in configuration
-//     2. Adjust verse splitting logic in PrintStopHeader/PrintEndFarewell
-//     3. Use shorter verses or custom formatting
+//     2. Widen the pane (banner is clamped to terminal width, minimum 24 columns)
+//     3. Use shorter verses
 //
 // Problem: Section not showing (temporal awareness, workspace analysis, etc.)
 //   Cause: Behavior configuration disabled section
@@ -1723,10 +3187,16 @@ func PrintPreCompactionMessage(compactType string, compactionCount int) {
 // Problem: Box characters not rendering (showing ??? or boxes)
 //   Cause: Terminal doesn't support Unicode box drawing characters
 //   Solutions:
-//     1. Switch to single_line border style (may render better)
-//     2. Use ASCII-only terminal
+//     1. Set behavior.session_display.ascii_fallback to true (forces +-| characters)
+//     2. Check locale: LANG/LC_ALL without UTF-8 switches to ASCII automatically
 //     3. Update terminal emulator to support Unicode
-//     4. Future: Implement ASCII fallback mode (see future_extensions in config)
+//
+// Problem: Banner too narrow / too wide
+//   Cause: Width is min(banner.width, terminal width); terminal width comes from
+//          TIOCGWINSZ on stdout/stderr, then $COLUMNS
+//   Solutions:
+//     1. When both streams are piped, export COLUMNS so the width is known
+//     2. Adjust banner.width for the preferred maximum
 //
 // ────────────────────────────────────────────────────────────────
 // Related Components & Dependencies
@@ -1754,14 +3224,11 @@ func PrintPreCompactionMessage(compactType string, compactionCount int) {
 // ────────────────────────────────────────────────────────────────
 //
 // Planned Features:
-//   ⏳ Verse rotation - Cycle through multiple biblical verses instead of fixed ones
 //   ⏳ Color themes - User-selectable color schemes (dark, light, classic)
 //   ⏳ Locale support - Localization for non-English text
-//   ⏳ ASCII fallback mode - ASCII-only display for terminals without Unicode support
 //
 // Research Areas:
 //   - Integration with system/lib/display for color formatting
-//   - Dynamic banner width based on terminal size
 //   - Conditional display based on verbosity level
 //   - Custom formatting templates (user-defined layouts)
 //
@@ -1771,11 +3238,11 @@ func PrintPreCompactionMessage(compactType string, compactionCount int) {
 //   - Session patterns: Display work pattern insights
 //
 // Known Limitations:
-//   1. Fixed banner width (64 chars) - not responsive to terminal size
-//   2. Hard-coded verse splitting at 60 characters - may not work for all verses
-//   3. No color/theming support - plain text output only
-//   4. No localization - English-only display
-//   5. Unicode required - no ASCII fallback for limited terminals
+//   1. Display width is a range approximation - rare wide symbols outside the
+//      covered ranges can shift a column by one
+//   2. No color/theming support - plain text output only
+//   3. No localization - English-only display
+//   4. ASCII mode swaps borders only - icons in field lines stay Unicode
 //
 // ────────────────────────────────────────────────────────────────
 // Closing Note
@@ -1788,8 +3255,8 @@ func PrintPreCompactionMessage(compactType string, compactionCount int) {
 // about the session state - temporal context, workspace health, completion status.
 // Clear, truthful display honors God by making His work visible and accessible.
 //
-// Version: 2.0.0
-// Last Modified: 2025-11-12
+// Version: 2.1.0
+// Last Modified: 2026-10-16
 // Status: ✅ Operational
 //
 // ────────────────────────────────────────────────────────────────
@@ -0,0 +1,541 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Session Display Tests
+//
+// Purpose: Prove banner verses wrap on word boundaries at any length - no
+//          panic on short verses, no mid-word or mid-rune splits, emoji and
+//          CJK measured in terminal columns - and that
+//          locale overlays (testdata/formatting.es.jsonc) merge field by field,
+//          and that config reloads swap whole configs, polling only when
+//          behavior.hot_reload is set. Banner layout clamps to narrow
+//          terminals, falls back to ASCII off a UTF-8 TTY, and the closing
+//          divider follows it.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"system/lib/display"
+)
+
+// useTerminal points stdout and stderr at a character device (tty) or a
+// plain file (piped) with $COLUMNS set, so width and TTY detection are
+// decided by the test instead of whatever runs it
+func useTerminal(t *testing.T, tty bool, columns string) {
+	t.Helper()
+	path := os.DevNull // Character device - no window size, so $COLUMNS decides
+	if !tty {
+		path = filepath.Join(t.TempDir(), "piped")
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout, stderr := os.Stdout, os.Stderr
+	t.Cleanup(func() {
+		os.Stdout, os.Stderr = stdout, stderr
+		f.Close()
+	})
+	os.Stdout, os.Stderr = f, f
+	t.Setenv("COLUMNS", columns)
+}
+
+// useBannerConfig sets the configured banner width and ASCII fallback for one test
+func useBannerConfig(t *testing.T, width int, asciiFallback bool) {
+	t.Helper()
+	saved := currentDisplayConfig()
+	t.Cleanup(func() { displayConfig.Store(saved) })
+
+	cfg := *saved
+	cfg.Formatting.Banner.Width = width
+	cfg.Formatting.Banner.BorderStyle = defaultBorderStyle
+	cfg.Behavior.SessionDisplay.ASCIIFallback = asciiFallback
+	displayConfig.Store(&cfg)
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestVerseLines(t *testing.T) {
+	layout := bannerLayout{Width: 64, Box: boxStyles["ascii_fallback"]} // 60-column text area
+
+	cases := []struct {
+		name     string
+		text     string
+		ref      string
+		minLines int
+		maxLines int
+	}{
+		{"short", "Jesus wept.", "John 11:35", 1, 1},
+		{"exactly 60", strings.Repeat("abcde ", 9) + "abcdef", "Ref 1:1", 2, 2},
+		{"long", "The Lord bless you and keep you; the Lord make his face shine on you and be gracious to you; the Lord turn his face toward you and give you peace.", "Numbers 6:24-26", 3, 4},
+		{"em-dash and non-ASCII", "Ἐν ἀρχῇ ἦν ὁ λόγος—and the Word was with God—and the Word was God; déjà vu, naïve café.", "John 1:1", 2, 3},
+		{"unbroken em-dash run", strings.Repeat("λόγος—", 20), "", 2, 3},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.name == "exactly 60" && len(tc.text) != 60 {
+				t.Fatalf("fixture is %d chars, want 60", len(tc.text))
+			}
+
+			lines := verseLines(layout, tc.text, tc.ref)
+			if len(lines) < tc.minLines || len(lines) > tc.maxLines {
+				t.Errorf("got %d lines, want %d-%d: %q", len(lines), tc.minLines, tc.maxLines, lines)
+			}
+
+			for _, line := range lines {
+				if !utf8.ValidString(line) {
+					t.Errorf("invalid UTF-8 (split mid-rune): %q", line)
+				}
+				if n := utf8.RuneCountInString(line); n > 60 {
+					t.Errorf("line is %d runes, want <= 60: %q", n, line)
+				}
+			}
+
+			// Wrapping only moves line breaks - every non-space rune survives in order
+			want := strings.Join(strings.Fields("\""+tc.text+"\""), "")
+			if got := strings.Join(strings.Fields(strings.Join(lines, "")), ""); !strings.HasPrefix(got, want) {
+				t.Errorf("verse content changed:\n got %q\nwant prefix %q", got, want)
+			}
+		})
+	}
+}
+
+func TestVerseLinesWrapWidth(t *testing.T) {
+	saved := currentDisplayConfig().Formatting.VerseWrapWidth
+	t.Cleanup(func() { currentDisplayConfig().Formatting.VerseWrapWidth = saved })
+
+	currentDisplayConfig().Formatting.VerseWrapWidth = 30
+	for _, line := range verseLines(bannerLayout{Width: 64}, "Whatever you do, work heartily, as for the Lord and not for men.", "Colossians 3:23") {
+		if n := utf8.RuneCountInString(line); n > 30 {
+			t.Errorf("line is %d runes, want <= 30 (verse_wrap_width): %q", n, line)
+		}
+	}
+
+	// Configured wider than the banner: banner wins
+	currentDisplayConfig().Formatting.VerseWrapWidth = 200
+	for _, line := range verseLines(bannerLayout{Width: 40}, strings.Repeat("word ", 30), "") {
+		if n := utf8.RuneCountInString(line); n > 36 {
+			t.Errorf("line is %d runes, want <= 36 (banner text width): %q", n, line)
+		}
+	}
+}
+
+func TestWrapTextDisplayWidth(t *testing.T) {
+	texts := []string{
+		"🌅 🌅 🌅 🌅 🌅 🌅 sunrise over the hills",
+		"はじめに神は天と地とを創造された。地は形なく、むなしく",
+		"👨‍👩‍👧👨‍👩‍👧👨‍👩‍👧👨‍👩‍👧👨‍👩‍👧👨‍👩‍👧",
+		"Café au lait — déjà vu",
+	}
+	for _, text := range texts {
+		for _, width := range []int{1, 5, 10} {
+			lines := wrapText(text, width)
+			for _, line := range lines {
+				if w := displayWidth(line); w > width && !(width == 1 && w == 2) { // A wide character alone overflows a one-column line
+					t.Errorf("wrapText(%q, %d) line is %d columns: %q", text, width, w, line)
+				}
+			}
+			if got := strings.Join(lines, ""); strings.ReplaceAll(got, " ", "") != strings.ReplaceAll(text, " ", "") {
+				t.Errorf("wrapText(%q, %d) changed content: %q", text, width, lines)
+			}
+		}
+	}
+
+	header := renderSectionHeader(bannerLayout{Width: 80, Box: boxStyles[defaultBorderStyle]}, "🌅 日の出")
+	rule := strings.Split(header, "\n")[1]
+	if got := strings.Count(rule, boxStyles[defaultBorderStyle].Rule); got != displayWidth("🌅 日の出")+display.HeaderPadding {
+		t.Errorf("section rule is %d columns for an 11-column title", got)
+	}
+}
+
+func TestFormatFieldsAlignment(t *testing.T) {
+	saved := currentDisplayConfig().Formatting.MinLabelColumn
+	t.Cleanup(func() { currentDisplayConfig().Formatting.MinLabelColumn = saved })
+
+	valueColumn := func(line, value string) int {
+		return displayWidth(line[:strings.Index(line, value)])
+	}
+
+	cases := []struct {
+		name string
+		rows []fieldRow
+	}{
+		{"short label", []fieldRow{
+			{"📍", "Dir:", "/home/nova"},
+			{"🌿", "Git Branch:", "main"},
+		}},
+		{"long translation", []fieldRow{
+			{"📍", "Arbeitsverzeichnis des Arbeitsbereichs:", "/home/nova"},
+			{"🌿", "Git-Zweig:", "main"},
+			{Value: "continuation"},
+		}},
+		{"variation selector icons", []fieldRow{
+			{"⏱️", "Internal Time:", "2h elapsed"},
+			{"🌍", "External Time:", "10:00"},
+			{"ⓘ", "Info:", "note"},
+		}},
+		{"CJK label", []fieldRow{
+			{"🕐", "時間:", "10:00"},
+			{"💻", "System:", "linux"},
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			currentDisplayConfig().Formatting.MinLabelColumn = 0
+			lines := strings.Split(strings.TrimSuffix(formatFields("  ", tc.rows), "\n"), "\n")
+
+			want := valueColumn(lines[0], tc.rows[0].Value)
+			for i, line := range lines {
+				if got := valueColumn(line, tc.rows[i].Value); got != want {
+					t.Errorf("row %d value at column %d, want %d:\n%s", i, got, want, strings.Join(lines, "\n"))
+				}
+			}
+		})
+	}
+
+	// min_label_column pins the value column across sections
+	currentDisplayConfig().Formatting.MinLabelColumn = 30
+	a := formatFields("  ", []fieldRow{{"📍", "Dir:", "x"}})
+	b := formatFields("  ", []fieldRow{{"🌿", "Git Branch:", "x"}})
+	if valueColumn(a, "x") != 2+30+fieldGap || valueColumn(b, "x") != 2+30+fieldGap {
+		t.Errorf("min_label_column not applied:\n%s%s", a, b)
+	}
+}
+
+func TestLocaleOverlayPaths(t *testing.T) {
+	base := "/cfg/display/formatting.jsonc"
+	cases := []struct {
+		locale string
+		want   []string
+	}{
+		{"", nil},
+		{"es", []string{"/cfg/display/formatting.es.jsonc"}},
+		{"es_MX.UTF-8", []string{"/cfg/display/formatting.es.jsonc", "/cfg/display/formatting.es-MX.jsonc"}},
+		{"PT-br", []string{"/cfg/display/formatting.pt.jsonc", "/cfg/display/formatting.pt-BR.jsonc"}},
+	}
+	for _, tc := range cases {
+		if got := localeOverlayPaths(base, tc.locale); strings.Join(got, ",") != strings.Join(tc.want, ",") {
+			t.Errorf("localeOverlayPaths(%q) = %v, want %v", tc.locale, got, tc.want)
+		}
+	}
+}
+
+func TestLoadConfigFileLocaleOverlay(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "formatting.jsonc")
+	baseConfig := `{
+  // Base (English) strings
+  "section_headers": {"session_start": {"environment": "SESSION ENVIRONMENT", "workspace_analysis": "WORKSPACE ANALYSIS"}},
+  "field_labels": {"environment": {"workspace": "Workspace:", "working_directory": "Working Directory:"}},
+  "formatting": {"banner": {"width": 64}}
+}`
+	if err := os.WriteFile(base, []byte(baseConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+	fixture, err := os.ReadFile(filepath.Join("testdata", "formatting.es.jsonc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "formatting.es.jsonc"), fixture, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// es-MX has no file of its own - skipped silently, es still applies
+	config, err := loadConfigFile(base, localeOverlayPaths(base, "es-MX")...)
+	if err != nil {
+		t.Fatalf("loadConfigFile: %v", err)
+	}
+
+	checks := []struct{ field, got, want string }{
+		{"translated header", config.SectionHeaders.SessionStart.Environment, "ENTORNO DE SESIÓN"},
+		{"untranslated header", config.SectionHeaders.SessionStart.WorkspaceAnalysis, "WORKSPACE ANALYSIS"},
+		{"translated label", config.FieldLabels.Environment.Workspace, "Espacio de trabajo:"},
+		{"untranslated label", config.FieldLabels.Environment.WorkingDirectory, "Working Directory:"},
+		{"date format", config.Formatting.DateFormat, "02/01/2006 15:04:05"},
+	}
+	for _, c := range checks {
+		if c.got != c.want {
+			t.Errorf("%s = %q, want %q", c.field, c.got, c.want)
+		}
+	}
+	if config.Formatting.Banner.Width != 64 {
+		t.Errorf("banner width = %d, want 64 kept from base", config.Formatting.Banner.Width)
+	}
+
+	// Malformed overlay is an error so loadDisplayConfig can fall back to the base
+	if err := os.WriteFile(filepath.Join(dir, "formatting.fr.jsonc"), []byte("{ not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadConfigFile(base, localeOverlayPaths(base, "fr")...); err == nil {
+		t.Error("malformed overlay: want error")
+	}
+}
+
+func TestLoadConfigFilePartial(t *testing.T) {
+	dir := t.TempDir()
+
+	empty := filepath.Join(dir, "empty.jsonc")
+	os.WriteFile(empty, nil, 0644)
+	config, err := loadConfigFile(empty)
+	if err != nil {
+		t.Fatalf("empty file: %v", err)
+	}
+	if !reflect.DeepEqual(config, getDefaultDisplayConfig()) {
+		t.Error("empty file should equal pure defaults")
+	}
+
+	oneIcon := filepath.Join(dir, "one-icon.jsonc")
+	os.WriteFile(oneIcon, []byte(`{"icons": {"environment": {"workspace": "🏠"}}} // only this`), 0644)
+	config, err = loadConfigFile(oneIcon)
+	if err != nil {
+		t.Fatalf("one icon: %v", err)
+	}
+	want := getDefaultDisplayConfig()
+	want.Icons.Environment.Workspace = "🏠"
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("single icon override lost other defaults:\n got %+v\nwant %+v", config.Icons, want.Icons)
+	}
+}
+
+func TestDisplayConfigFallbackHint(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	path := expandPath(displayConfigPath)
+	os.MkdirAll(filepath.Dir(path), 0755)
+
+	// Rail and session sections side by side are both known
+	os.WriteFile(path, []byte(`{"colors": {"basic": {"reset": ""}}, "icons": {"status": {"success": "✓"}, "environment": {"workspace": "🏢"}}}`), 0644)
+	if details := withConfigHint(map[string]interface{}{}); details["hint"] != nil {
+		t.Errorf("clean file got hint %q", details["hint"])
+	}
+
+	os.WriteFile(path, []byte("{\n  \"formatting\": {\"banner\": {\"width\": \"64\"}}\n}"), 0644)
+	if _, err := readDisplayConfig(); err == nil {
+		t.Fatal("string width: want load error")
+	}
+	hint, _ := withConfigHint(map[string]interface{}{})["hint"].(string)
+	if !strings.Contains(hint, "formatting.jsonc has 1 issue (first: "+path+":2:38: ") || !strings.HasSuffix(hint, "run validate --configs") {
+		t.Errorf("hint = %q", hint)
+	}
+}
+
+func TestDisplayConfigHotReload(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	savedConfig, savedLocale := currentDisplayConfig(), displayLocale
+	t.Cleanup(func() {
+		displayConfig.Store(savedConfig)
+		displayLocale = savedLocale
+		displayReload.checked, displayReload.modTime = time.Time{}, time.Time{}
+	})
+	displayLocale = ""
+
+	path := expandPath(displayConfigPath)
+	os.MkdirAll(filepath.Dir(path), 0755)
+	write := func(content string, modified time.Time) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		os.Chtimes(path, modified, modified)
+	}
+	start := time.Now().Add(-time.Hour)
+
+	write(`{"behavior": {"hot_reload": true}, "formatting": {"banner": {"width": 50}}}`, start)
+	if err := ReloadDisplayConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if got := currentDisplayConfig().Formatting.Banner.Width; got != 50 {
+		t.Fatalf("width after reload = %d, want 50", got)
+	}
+
+	// Concurrent readers see whole configs while polling swaps them
+	write(`{"behavior": {"hot_reload": true}, "formatting": {"banner": {"width": 70}}}`, start.Add(time.Minute))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			maybeReloadDisplayConfig()
+			if width := currentDisplayConfig().Formatting.Banner.Width; width != 50 && width != 70 {
+				t.Errorf("width mid-reload = %d", width)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := currentDisplayConfig().Formatting.Banner.Width; got != 70 {
+		t.Fatalf("width after mtime change = %d, want 70", got)
+	}
+
+	// Within the interval nothing is stat()ed
+	write(`{"behavior": {"hot_reload": true}, "formatting": {"banner": {"width": 80}}}`, start.Add(2*time.Minute))
+	maybeReloadDisplayConfig()
+	if got := currentDisplayConfig().Formatting.Banner.Width; got != 70 {
+		t.Errorf("reloaded within interval: width = %d", got)
+	}
+
+	// A broken edit keeps the previous config
+	write(`{"formatting": {"banner": `, start.Add(3*time.Minute))
+	displayReload.checked = time.Time{}
+	maybeReloadDisplayConfig()
+	if err := ReloadDisplayConfig(); err == nil {
+		t.Error("malformed config: want error")
+	}
+	if got := currentDisplayConfig().Formatting.Banner.Width; got != 70 {
+		t.Errorf("width after failed reload = %d, want 70 kept", got)
+	}
+
+	// hot_reload off: edits are ignored until an explicit reload
+	write(`{"formatting": {"banner": {"width": 90}}}`, start.Add(4*time.Minute))
+	ReloadDisplayConfig()
+	write(`{"formatting": {"banner": {"width": 40}}}`, start.Add(5*time.Minute))
+	displayReload.checked = time.Time{}
+	maybeReloadDisplayConfig()
+	if got := currentDisplayConfig().Formatting.Banner.Width; got != 90 {
+		t.Errorf("hot_reload off: width = %d, want 90", got)
+	}
+}
+
+func TestResolveBannerLayoutWidth(t *testing.T) {
+	cases := []struct {
+		name       string
+		configured int
+		columns    string
+		want       int
+	}{
+		{"configured fits", 64, "120", 64},
+		{"narrow terminal", 64, "40", 40},
+		{"narrower than minimum", 64, "10", minBannerWidth},
+		{"unknown terminal width", 70, "", 70},
+		{"unset width", 0, "", defaultBannerWidth},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("LANG", "en_US.UTF-8")
+			useTerminal(t, true, tc.columns)
+			useBannerConfig(t, tc.configured, false)
+
+			if got := resolveBannerLayout().Width; got != tc.want {
+				t.Errorf("width = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNeedsASCII(t *testing.T) {
+	cases := []struct {
+		name              string
+		tty               bool
+		term, lcAll, lang string
+		ascii             bool
+	}{
+		{"utf-8 tty", true, "xterm-256color", "", "en_US.UTF-8", false},
+		{"utf8 spelling", true, "xterm", "", "C.utf8", false},
+		{"piped", false, "xterm", "", "en_US.UTF-8", true},
+		{"dumb terminal", true, "dumb", "", "en_US.UTF-8", true},
+		{"POSIX locale", true, "xterm", "", "C", true},
+		{"no locale", true, "xterm", "", "", true},
+		{"LC_ALL wins", true, "xterm", "C", "en_US.UTF-8", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			useTerminal(t, tc.tty, "")
+			t.Setenv("TERM", tc.term)
+			t.Setenv("LC_ALL", tc.lcAll)
+			t.Setenv("LC_CTYPE", "")
+			t.Setenv("LANG", tc.lang)
+			useBannerConfig(t, 64, false)
+
+			if got := needsASCII(); got != tc.ascii {
+				t.Errorf("needsASCII = %v, want %v", got, tc.ascii)
+			}
+			layout := resolveBannerLayout()
+			if layout.ASCII != tc.ascii || (layout.Box == boxStyles["ascii_fallback"]) != tc.ascii {
+				t.Errorf("layout ASCII = %v with %+v, want ASCII %v", layout.ASCII, layout.Box, tc.ascii)
+			}
+		})
+	}
+
+	t.Run("configured fallback", func(t *testing.T) {
+		useTerminal(t, true, "")
+		t.Setenv("TERM", "xterm")
+		t.Setenv("LC_ALL", "en_US.UTF-8")
+		useBannerConfig(t, 64, true)
+		if layout := resolveBannerLayout(); !layout.ASCII || layout.Box != boxStyles["ascii_fallback"] {
+			t.Errorf("ascii_fallback set: layout = %+v, want the ASCII set", layout)
+		}
+	})
+}
+
+func TestWrapTextWordBoundaries(t *testing.T) {
+	cases := []struct {
+		text  string
+		width int
+		want  []string
+	}{
+		{"the quick brown fox", 10, []string{"the quick", "brown fox"}},
+		{"the quick brown fox", 9, []string{"the quick", "brown fox"}},
+		{"  spaced   out  ", 20, []string{"spaced out"}},
+		{"well-known-phrase here", 8, []string{"well-", "known-", "phrase", "here"}},
+		{"abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+		{"", 10, []string{""}},
+	}
+	for _, tc := range cases {
+		if got := wrapText(tc.text, tc.width); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("wrapText(%q, %d) = %q, want %q", tc.text, tc.width, got, tc.want)
+		}
+	}
+}
+
+func TestCenterText(t *testing.T) {
+	cases := []struct {
+		text  string
+		width int
+		want  string
+	}{
+		{"ab", 6, "  ab  "},
+		{"ab", 5, " ab  "}, // Odd gap - extra space goes right
+		{"🌅", 6, "  🌅  "},  // Two columns
+		{"toolong", 4, "toolong"},
+	}
+	for _, tc := range cases {
+		if got := centerText(tc.text, tc.width); got != tc.want {
+			t.Errorf("centerText(%q, %d) = %q, want %q", tc.text, tc.width, got, tc.want)
+		}
+	}
+}
+
+func TestClosingDividerFollowsLayout(t *testing.T) {
+	t.Setenv("LANG", "en_US.UTF-8")
+	t.Setenv("TERM", "xterm")
+	useBannerConfig(t, 64, false)
+
+	useTerminal(t, false, "30")
+	if got := CaptureOutput(PrintClosingDivider); got != strings.Repeat("=", 30)+"\n\n" {
+		t.Errorf("piped divider = %q, want 30 ASCII columns", got)
+	}
+
+	useTerminal(t, true, "")
+	want := strings.Repeat(boxStyles[defaultBorderStyle].Separator, 64) + "\n\n"
+	if got := CaptureOutput(PrintClosingDivider); got != want {
+		t.Errorf("tty divider = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,382 @@
+// METADATA
+//
+// Context Drop-In Sections Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Write the vision, and make it plain upon tables, that he may run that readeth it" - Habakkuk 2:2 (KJV)
+// Principle: What matters this season is written down once and carried into every session
+// Anchor: "Every scribe which is instructed ... bringeth forth out of his treasure things new and old" - Matthew 13:52 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - user-defined context sections)
+// Role: Loads markdown fragments from the context.d directory as session context sections
+// Paradigm: CPI-SI framework component - feeds context.go (buildCompleteContext)
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial drop-in sections
+//
+// Version History:
+//   1.0.0 (2026-10-16) - context.d/*.md in filename order, front matter (title, enabled,
+//                        max_chars, position), truncation note, drop_ins behavior flag
+//
+// Purpose & Function
+//
+// Purpose: Grounding that changes by season (current focus, team norms, a
+// project brief) belongs in a file, not in Go code or context.jsonc edits.
+// Drop a markdown file into context.d and it appears at session start.
+//
+// Core Design: loadDropIns reads every *.md in the directory, sorted by
+// filename (numeric prefixes like 10-, 20- control order). Each file may open
+// with --- front matter:
+//   title: Current Focus        section heading (else first "# " heading, else filename)
+//   enabled: false              parked - skipped without deleting the file
+//   max_chars: 1200             body cap (else drop_ins.max_chars, else the default)
+//   position: before temporal   or "after work" - placed next to that built-in
+//                               section; unplaced drop-ins follow all configured sections
+// Each becomes its own "## <title>" contextSection, so it degrades under the
+// context budget like a custom:<path> section.
+//
+// Blocking Status
+//
+// Non-blocking: Missing directory means no drop-ins. Unreadable files are
+// skipped with a logged warning; the rest of the context is unaffected.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: fmt, os, path/filepath, sort, strconv, strings, unicode/utf8
+//   Internal: system/lib/instance (system_paths.context_dropins, data_root),
+//             context.go (contextSection, contextLogger, customSectionPriority),
+//             journals.go (parseFrontMatterLine), display.go (expandPath)
+//
+// Dependents (What Uses This):
+//   Libraries: context.go (buildCompleteContext via placeDropIns)
+//
+// Health Scoring
+//
+// Unreadable drop-in file: -5 (skipped, logged through contextLogger).
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"fmt"           // Section headings and truncation note
+	"os"            // Directory listing and file reads
+	"path/filepath" // Drop-in path joining
+	"sort"          // Filename order
+	"strconv"       // enabled / max_chars front matter
+	"strings"       // Front matter and title parsing
+	"unicode/utf8"  // max_chars measured in characters, not bytes
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/instance" // system_paths.context_dropins
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// defaultDropInDir is used when system_paths sets neither context_dropins nor data_root.
+	defaultDropInDir = "~/.claude/cpi-si/system/data/context.d"
+
+	// dropInDirName is the drop-in directory under system_paths.data_root.
+	dropInDirName = "context.d"
+
+	// defaultDropInMaxChars caps a drop-in body when neither the file nor
+	// drop_ins.max_chars sets a limit.
+	defaultDropInMaxChars = 4000
+
+	// dropInTruncatedNote follows a body cut at max_chars (shown, total, filename).
+	dropInTruncatedNote = "\n\n_… truncated to %d of %d characters (%s)_"
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// DropInsConfig controls drop-in sections (context.jsonc "drop_ins")
+type DropInsConfig struct {
+	Enabled  bool `json:"enabled"`   // Load context.d at all (default true)
+	MaxChars int  `json:"max_chars"` // Body cap when a file sets none (0 = defaultDropInMaxChars)
+}
+
+// DropIn is one markdown fragment from the drop-in directory
+type DropIn struct {
+	Path      string // File path
+	Title     string // Section heading
+	Enabled   bool   // false = parked (front matter enabled: false)
+	MaxChars  int    // Body cap in characters (0 = drop_ins.max_chars)
+	Placement string // "before" or "after" ("" = after all configured sections)
+	Anchor    string // Built-in section identifier the placement refers to
+	Body      string // Markdown after front matter and title heading
+}
+
+// dropInsConfig holds drop-in settings (set from context.jsonc in context.go init)
+var dropInsConfig = DropInsConfig{Enabled: true}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Core Operations (Middle Rungs) - 4 functions
+//   ├── loadDropIns(dir) → uses readDropIn, contextLogger (unreadable files)
+//   ├── placeDropIns(sections, ids, dropIns, cfg) → uses dropInContextSection
+//   ├── dropInContextSection(dropIn, maxChars) → uses renderDropIn
+//   └── renderDropIn(dropIn, maxChars) → truncation note
+//
+//   Helpers (Bottom Rungs) - 3 functions
+//   ├── dropInDir() → system_paths.context_dropins, else data_root/context.d, else default
+//   ├── readDropIn(path) → uses parseFrontMatterLine (journals.go)
+//   └── dropInTitle(filename) → pure function
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Location and Parsing
+// ────────────────────────────────────────────────────────────────
+
+// dropInDir resolves the drop-in directory (config-if-set-else-default)
+func dropInDir() string {
+	paths := instance.GetConfig().SystemPaths
+	if paths.ContextDropIns != "" {
+		return expandPath(paths.ContextDropIns)
+	}
+	if paths.DataRoot != "" {
+		return filepath.Join(expandPath(paths.DataRoot), dropInDirName)
+	}
+	return expandPath(defaultDropInDir)
+}
+
+// dropInTitle derives a heading from a filename ("10-current_focus.md" → "current focus")
+func dropInTitle(filename string) string {
+	name := strings.TrimSuffix(filename, filepath.Ext(filename))
+	name = strings.TrimLeft(name, "0123456789")
+	name = strings.TrimLeft(name, "-_. ")
+	if name == "" {
+		return strings.TrimSuffix(filename, filepath.Ext(filename))
+	}
+	return strings.NewReplacer("-", " ", "_", " ").Replace(name)
+}
+
+// readDropIn parses one drop-in file
+//
+// Front matter counts only when the file opens with ---. Without a title
+// key, a leading "# " heading becomes the title (and is not repeated in
+// the body); without either, the filename does.
+func readDropIn(path string) (DropIn, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return DropIn{}, err
+	}
+
+	dropIn := DropIn{Path: path, Enabled: true}
+	body := strings.ReplaceAll(string(data), "\r\n", "\n")
+	if rest, ok := strings.CutPrefix(body, "---\n"); ok {
+		if front, after, closed := strings.Cut("\n"+rest, "\n---"); closed { // "\n" lets empty front matter close
+			for _, line := range strings.Split(front, "\n") {
+				key, value, ok := parseFrontMatterLine(line)
+				if !ok {
+					continue
+				}
+				switch key {
+				case "title":
+					dropIn.Title = value
+				case "enabled":
+					if enabled, err := strconv.ParseBool(value); err == nil {
+						dropIn.Enabled = enabled
+					}
+				case "max_chars":
+					if maxChars, err := strconv.Atoi(value); err == nil && maxChars > 0 {
+						dropIn.MaxChars = maxChars
+					}
+				case "position":
+					if fields := strings.Fields(strings.ReplaceAll(value, ":", " ")); len(fields) == 2 && (fields[0] == "before" || fields[0] == "after") {
+						dropIn.Placement, dropIn.Anchor = fields[0], fields[1]
+					}
+				}
+			}
+			_, body, _ = strings.Cut(after, "\n") // Rest of the closing --- line
+		}
+	}
+
+	body = strings.TrimSpace(body)
+	if heading, rest, _ := strings.Cut(body, "\n"); strings.HasPrefix(heading, "# ") && dropIn.Title == "" {
+		dropIn.Title = strings.TrimSpace(strings.TrimPrefix(heading, "# "))
+		body = strings.TrimSpace(rest)
+	}
+	if dropIn.Title == "" {
+		dropIn.Title = dropInTitle(filepath.Base(path))
+	}
+	dropIn.Body = body
+	return dropIn, nil
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Loading and Placement
+// ────────────────────────────────────────────────────────────────
+
+// loadDropIns reads every *.md in dir, in filename order
+//
+// Missing directory → none. Unreadable files are logged and skipped; parked
+// (enabled: false) files are returned so callers can see them, and skipped
+// at placement.
+func loadDropIns(dir string) []DropIn {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".md" {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	var dropIns []DropIn
+	for _, name := range names {
+		dropIn, err := readDropIn(filepath.Join(dir, name))
+		if err != nil {
+			contextLogger.Failure("dropin-section-unreadable", err.Error(), -5, map[string]any{
+				"path": filepath.Join(dir, name),
+			})
+			continue
+		}
+		dropIns = append(dropIns, dropIn)
+	}
+	return dropIns
+}
+
+// renderDropIn renders a drop-in as "## <title>" with its body cut at maxChars
+func renderDropIn(dropIn DropIn, maxChars int) string {
+	body := dropIn.Body
+	if total := utf8.RuneCountInString(body); total > maxChars {
+		body = string([]rune(body)[:maxChars]) + fmt.Sprintf(dropInTruncatedNote, maxChars, total, filepath.Base(dropIn.Path))
+	}
+	if body == "" {
+		return fmt.Sprintf("## %s\n\n", dropIn.Title)
+	}
+	return fmt.Sprintf("## %s\n\n%s\n\n", dropIn.Title, body)
+}
+
+// dropInContextSection registers a drop-in as a section
+//
+// Ranks with custom sections (degrade first); the summary form is the
+// heading plus the body's first line.
+func dropInContextSection(dropIn DropIn, maxChars int) contextSection {
+	if dropIn.MaxChars > 0 {
+		maxChars = dropIn.MaxChars
+	}
+	return contextSection{
+		Name:     dropIn.Title,
+		Priority: customSectionPriority,
+		Build:    func() string { return renderDropIn(dropIn, maxChars) },
+		Summary: func() string {
+			first, _, _ := strings.Cut(dropIn.Body, "\n")
+			return renderDropIn(DropIn{Path: dropIn.Path, Title: dropIn.Title, Body: first}, maxChars)
+		},
+	}
+}
+
+// placeDropIns inserts enabled drop-ins among the resolved sections
+//
+// ids[i] is the identifier sections[i] was resolved from. A drop-in
+// positioned before/after an identifier lands next to it (several keep
+// filename order); one with no position, or whose anchor is not in the
+// configured sections, follows everything else.
+func placeDropIns(sections []contextSection, ids []string, dropIns []DropIn, cfg DropInsConfig) []contextSection {
+	if !cfg.Enabled || len(dropIns) == 0 {
+		return sections
+	}
+	maxChars := cfg.MaxChars
+	if maxChars <= 0 {
+		maxChars = defaultDropInMaxChars
+	}
+
+	present := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		present[id] = true
+	}
+	before := make(map[string][]contextSection)
+	after := make(map[string][]contextSection)
+	var trailing []contextSection
+	for _, dropIn := range dropIns {
+		if !dropIn.Enabled {
+			continue
+		}
+		section := dropInContextSection(dropIn, maxChars)
+		switch {
+		case !present[dropIn.Anchor]:
+			trailing = append(trailing, section)
+		case dropIn.Placement == "before":
+			before[dropIn.Anchor] = append(before[dropIn.Anchor], section)
+		default:
+			after[dropIn.Anchor] = append(after[dropIn.Anchor], section)
+		}
+	}
+
+	placed := make([]contextSection, 0, len(sections)+len(dropIns))
+	for i, section := range sections {
+		placed = append(placed, before[ids[i]]...)
+		placed = append(placed, section)
+		placed = append(placed, after[ids[i]]...)
+		delete(before, ids[i]) // A repeated identifier takes its drop-ins once
+		delete(after, ids[i])
+	}
+	return append(placed, trailing...)
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Filename order, front matter, parked files, truncation, before/after placement
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by buildCompleteContext
+//
+// Code Cleanup: None
+//
+// Modification Policy:
+//   ✅ Safe: New front-matter keys (add a case in readDropIn)
+//   ⚠️ Care: Title derivation - it names the section in the context budget note
+//   ❌ Never: Failing session start over a drop-in - skip and log instead
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,90 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Context Drop-In Tests
+//
+// Purpose: Prove context.d files load in filename order with their front
+//          matter, parked and unreadable files stay out, long bodies are
+//          truncated with a note, and positions place drop-ins next to the
+//          named section.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestDropInSections(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("10-focus.md", "---\ntitle: \"Current Focus\"\nposition: before identity\n---\n\nShip the release.\n")
+	write("20-norms.md", "# Team Norms\n\nReview within a day.\n")
+	write("30-brief.md", "---\nmax_chars: 10\nposition: after:identity\n---\nA long project brief that will not fit.\n")
+	write("40-parked.md", "---\nenabled: false\n---\nNot this season.\n")
+	write("50-dangling.md", "---\nposition: after nowhere\n---\nNo such section.\n")
+	write("notes.txt", "not markdown")
+	if err := os.Symlink(filepath.Join(dir, "missing"), filepath.Join(dir, "60-broken.md")); err != nil {
+		t.Fatal(err)
+	}
+
+	dropIns := loadDropIns(dir)
+	var titles []string
+	for _, dropIn := range dropIns {
+		titles = append(titles, dropIn.Title)
+	}
+	if got := strings.Join(titles, "|"); got != "Current Focus|Team Norms|brief|parked|dangling" {
+		t.Fatalf("titles = %q, want filename order without the unreadable file", got)
+	}
+	if dropIns[1].Body != "Review within a day." || dropIns[3].Enabled {
+		t.Errorf("heading title not stripped or parked file enabled: %+v", dropIns)
+	}
+
+	fixed := func(name string) contextSection {
+		return contextSection{Name: name, Build: func() string { return "## " + name + "\n\n" }}
+	}
+	sections := placeDropIns([]contextSection{fixed("identity"), fixed("work")}, []string{"identity", "work"}, dropIns, DropInsConfig{Enabled: true})
+	var built []string
+	for _, section := range sections {
+		built = append(built, section.Build())
+	}
+	want := strings.Join([]string{
+		"## Current Focus\n\nShip the release.\n\n",
+		"## identity\n\n",
+		"## brief\n\nA long pro\n\n_… truncated to 10 of 39 characters (30-brief.md)_\n\n",
+		"## work\n\n",
+		"## Team Norms\n\nReview within a day.\n\n",
+		"## dangling\n\nNo such section.\n\n",
+	}, "")
+	if got := strings.Join(built, ""); got != want {
+		t.Errorf("placed sections:\n%s\nwant:\n%s", got, want)
+	}
+
+	if got := placeDropIns(sections[:1], []string{"identity"}, dropIns, DropInsConfig{}); len(got) != 1 {
+		t.Errorf("drop_ins disabled: %d sections, want 1", len(got))
+	}
+	if loadDropIns(filepath.Join(dir, "missing")) != nil {
+		t.Error("missing directory: want no drop-ins")
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,417 @@
+// METADATA
+//
+// Context Emphasis Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Let all things be done decently and in order." - 1 Corinthians 14:40 (KJV)
+// Principle: What matters most for working together is said first, and kept when space runs short
+// Anchor: "Can two walk together, except they be agreed?" - Amos 3:3 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - session context field selection)
+// Role: Decides which user and communication fields session context surfaces,
+//       in what order, how many list items, and which survive the context budget
+// Paradigm: CPI-SI framework component - feeds context.go and contextdata.go
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial context emphasis
+//
+// Version History:
+//   1.0.0 (2026-10-16) - context_emphasis field lists (always/if_space/never, limit), working_agreements
+//
+// Purpose & Function
+//
+// Purpose: The user awareness and communication sections used to print a
+// fixed handful of fields and the first five likes/dislikes - neither
+// configurable nor chosen for what matters in collaboration. A config's
+// context_emphasis section now names the fields each section shows:
+//
+//   "context_emphasis": {
+//     "user": [
+//       { "field": "identity", "weight": "always" },
+//       { "field": "working_agreements", "weight": "always" },
+//       { "field": "faith", "weight": "if_space" }
+//     ],
+//     "communication": [
+//       { "field": "resonates", "weight": "if_space", "limit": 3 }
+//     ]
+//   }
+//
+// Core Design: Fields render in list order. Weights:
+//   always   - shown in full and kept when the section is summarized to fit
+//              the context budget (fitContextBudget)
+//   if_space - shown in full context only
+//   never    - left out (as is any field the list doesn't name)
+// limit caps list items (0 = the field's default: 5 likes/dislikes, else all).
+// Per section the user config's list wins, then the instance config's, then
+// the built-in lists below - which reproduce the sections as they always read.
+// User awareness summarizes identity and role onto one line when both are
+// "always", as it always has.
+//
+// Blocking Status
+//
+// Non-blocking: Unknown fields and weights are skipped with a logged warning;
+// a list with nothing usable left is the built-in selection.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: fmt, strings
+//   Internal: system/lib/instance (EmphasisField, ContextEmphasisConfig)
+//   Package Files: context.go (userConfig, instanceConfig, contextLogger),
+//                  contextdata.go (SessionContext, firstN, communicationListLimit)
+//
+// Dependents (What Uses This):
+//   Libraries: context.go (user awareness and communication renderers),
+//              contextdata.go (buildContextStruct, communicationContext)
+//
+// Health Scoring
+//
+// Unknown context_emphasis field or weight: -5 (skipped, logged through contextLogger).
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"fmt"     // Field lines
+	"strings" // Weight normalization, summary line
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/instance" // EmphasisField, ContextEmphasisConfig
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+// Emphasis weights
+const (
+	weightAlways  = "always"   // Kept when summarized to fit the budget
+	weightIfSpace = "if_space" // Full context only
+	weightNever   = "never"    // Left out
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// emphasisRenderer renders one field a section can show
+type emphasisRenderer struct {
+	Group  string                                      // Consecutive fields in one group share a paragraph
+	Limit  int                                         // Default list items (0 = all)
+	Render func(ctx *SessionContext, limit int) string // Field text ending in "\n" ("" = nothing to show)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Package-Level State
+// ────────────────────────────────────────────────────────────────
+
+// defaultUserEmphasis is the user awareness section as it has always read
+var defaultUserEmphasis = []instance.EmphasisField{
+	{Field: "identity", Weight: weightAlways},
+	{Field: "faith", Weight: weightIfSpace},
+	{Field: "role", Weight: weightAlways},
+	{Field: "calling", Weight: weightIfSpace},
+	{Field: "work_style", Weight: weightIfSpace},
+	{Field: "working_agreements", Weight: weightAlways},
+}
+
+// defaultCommunicationEmphasis is the communication section as it has always read
+var defaultCommunicationEmphasis = []instance.EmphasisField{
+	{Field: "style", Weight: weightAlways},
+	{Field: "principles", Weight: weightIfSpace},
+	{Field: "resonates", Weight: weightIfSpace},
+	{Field: "avoid", Weight: weightIfSpace},
+	{Field: "problem_solving", Weight: weightIfSpace},
+	{Field: "learning_style", Weight: weightIfSpace},
+}
+
+// userEmphasisRenderers are the fields user awareness can show (ctx.User set)
+var userEmphasisRenderers = map[string]emphasisRenderer{
+	"identity": {Render: func(ctx *SessionContext, _ int) string {
+		return fmt.Sprintf("**%s** (%s, age %d)\n", ctx.User.Identity.Name, ctx.User.Identity.Pronouns, ctx.User.Identity.Age)
+	}},
+	"faith": {Render: func(ctx *SessionContext, _ int) string {
+		faith := ctx.User.Faith
+		if !faith.IsReligious {
+			return ""
+		}
+		return fmt.Sprintf("**Faith:** %s (%s, %s)\n- %s\n", faith.Tradition, faith.Denomination, faith.PracticeLevel, faith.CommPreferences)
+	}},
+	"role": {Group: "workspace", Render: func(ctx *SessionContext, _ int) string {
+		return fmt.Sprintf("**Role:** %s at %s\n", ctx.User.Workspace.Role, ctx.User.Workspace.Organization)
+	}},
+	"calling": {Group: "workspace", Render: func(ctx *SessionContext, _ int) string {
+		return fmt.Sprintf("**Calling:** %s\n", ctx.User.Workspace.Calling)
+	}},
+	"work_style": {Render: func(ctx *SessionContext, _ int) string {
+		return fmt.Sprintf("**Work Style:** %s\n", ctx.User.Personality.WorkStyle)
+	}},
+	"working_agreements": {Render: func(ctx *SessionContext, limit int) string {
+		return bulletField("Working Agreements", firstN(ctx.User.WorkingAgreements, limit), false)
+	}},
+	"values": {Render: func(ctx *SessionContext, limit int) string {
+		return bulletField("Values", firstN(ctx.User.Personhood.Values, limit), false)
+	}},
+	"passions": {Render: func(ctx *SessionContext, limit int) string {
+		return bulletField("Passions", firstN(ctx.User.Personhood.Passions, limit), false)
+	}},
+	"accessibility": {Render: func(ctx *SessionContext, limit int) string {
+		access := ctx.User.Demographics.Accessibility
+		return bulletField("Accessibility", firstN(append(append([]string{}, access.Needs...), access.Preferences...), limit), false)
+	}},
+	"growing_in": {Render: func(ctx *SessionContext, _ int) string {
+		if ctx.User.Growth.WhatYoureWorkingOn == "" {
+			return ""
+		}
+		return fmt.Sprintf("**Growing In:** %s\n", ctx.User.Growth.WhatYoureWorkingOn)
+	}},
+}
+
+// communicationEmphasisRenderers are the fields the communication section can
+// show (ctx.Communication set, not the fallback)
+var communicationEmphasisRenderers = map[string]emphasisRenderer{
+	"style": {Render: func(ctx *SessionContext, _ int) string {
+		return fmt.Sprintf("**My Communication:** %s\n", ctx.Communication.Style)
+	}},
+	"principles": {Render: func(ctx *SessionContext, limit int) string {
+		return bulletField("Core Principles", firstN(ctx.Communication.Principles, limit), true)
+	}},
+	"resonates": {Limit: communicationListLimit, Render: func(ctx *SessionContext, limit int) string {
+		return bulletField("What Resonates", firstN(ctx.Communication.Resonates, limit), true)
+	}},
+	"avoid": {Limit: communicationListLimit, Render: func(ctx *SessionContext, limit int) string {
+		return bulletField("What to Avoid", firstN(ctx.Communication.Avoid, limit), true)
+	}},
+	"problem_solving": {Render: func(ctx *SessionContext, _ int) string {
+		return fmt.Sprintf("**How I Think:** %s\n", ctx.Communication.ProblemSolving)
+	}},
+	"learning_style": {Render: func(ctx *SessionContext, _ int) string {
+		return fmt.Sprintf("**Learning Style:** %s\n", ctx.Communication.LearningStyle)
+	}},
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Core Operations (Middle Rungs) - 3 functions
+//   ├── resolveContextEmphasis() → userConfig, instanceConfig, validEmphasis()
+//   ├── emphasisFields(configured, defaults, renderers) → normalizeWeight()
+//   └── renderEmphasis(ctx, fields, renderers, keep) → emphasisRenderer.Render
+//
+//   Helpers (Bottom Rungs) - 5 functions
+//   ├── validEmphasis(section, fields, renderers) → contextLogger
+//   ├── emphasisWeight(fields, name) → pure function
+//   ├── fullFidelity(field) / summaryField(field) → keep filters
+//   ├── normalizeWeight(weight) → pure function
+//   └── bulletField(label, items, always) → pure function
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Field Selection
+// ────────────────────────────────────────────────────────────────
+
+// resolveContextEmphasis merges both configs' context_emphasis
+//
+// Per section the user config's list wins over the instance config's; an
+// empty result means the built-in list (emphasisFields). Unknown fields and
+// weights are logged and dropped here, once per build.
+func resolveContextEmphasis() instance.ContextEmphasisConfig {
+	var resolved instance.ContextEmphasisConfig
+	if instanceConfig != nil {
+		resolved = instanceConfig.ContextEmphasis
+	}
+	if userConfig != nil {
+		if len(userConfig.ContextEmphasis.User) > 0 {
+			resolved.User = userConfig.ContextEmphasis.User
+		}
+		if len(userConfig.ContextEmphasis.Communication) > 0 {
+			resolved.Communication = userConfig.ContextEmphasis.Communication
+		}
+	}
+	resolved.User = validEmphasis("user", resolved.User, userEmphasisRenderers)
+	resolved.Communication = validEmphasis("communication", resolved.Communication, communicationEmphasisRenderers)
+	return resolved
+}
+
+// emphasisFields returns the fields a section shows, weights normalized and
+// limits defaulted (configured, else defaults; unknown fields skipped)
+func emphasisFields(configured, defaults []instance.EmphasisField, renderers map[string]emphasisRenderer) []instance.EmphasisField {
+	if len(configured) == 0 {
+		configured = defaults
+	}
+	fields := make([]instance.EmphasisField, 0, len(configured))
+	for _, field := range configured {
+		renderer, known := renderers[field.Field]
+		weight, ok := normalizeWeight(field.Weight)
+		if !known || !ok {
+			continue
+		}
+		field.Weight = weight
+		if field.Limit <= 0 {
+			field.Limit = renderer.Limit
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// renderEmphasis renders the kept fields in order
+//
+// Each field is its own paragraph, except consecutive fields of one Group
+// (role and calling), which share one.
+func renderEmphasis(ctx *SessionContext, fields []instance.EmphasisField, renderers map[string]emphasisRenderer, keep func(instance.EmphasisField) bool) string {
+	var text strings.Builder
+	group := ""
+	for _, field := range fields {
+		if !keep(field) {
+			continue
+		}
+		renderer := renderers[field.Field]
+		block := renderer.Render(ctx, field.Limit)
+		if block == "" {
+			continue
+		}
+		if text.Len() > 0 && (group == "" || group != renderer.Group) {
+			text.WriteString("\n") // Close the previous paragraph
+		}
+		text.WriteString(block)
+		group = renderer.Group
+	}
+	if text.Len() > 0 {
+		text.WriteString("\n")
+	}
+	return text.String()
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Weights and Formatting
+// ────────────────────────────────────────────────────────────────
+
+// validEmphasis drops (and logs) entries naming no field or weight
+func validEmphasis(section string, fields []instance.EmphasisField, renderers map[string]emphasisRenderer) []instance.EmphasisField {
+	var valid []instance.EmphasisField
+	for _, field := range fields {
+		if _, known := renderers[field.Field]; !known {
+			contextLogger.Failure("unknown-emphasis-field", "no "+section+" section field by that name", -5, map[string]any{
+				"section": section,
+				"field":   field.Field,
+			})
+			continue
+		}
+		if _, ok := normalizeWeight(field.Weight); !ok {
+			contextLogger.Failure("unknown-emphasis-weight", fmt.Sprintf("weight is not %q, %q, or %q", weightAlways, weightIfSpace, weightNever), -5, map[string]any{
+				"section": section,
+				"field":   field.Field,
+				"weight":  field.Weight,
+			})
+			continue
+		}
+		valid = append(valid, field)
+	}
+	return valid
+}
+
+// emphasisWeight is the named field's weight (weightNever if not listed)
+func emphasisWeight(fields []instance.EmphasisField, name string) string {
+	for _, field := range fields {
+		if field.Field == name {
+			return field.Weight
+		}
+	}
+	return weightNever
+}
+
+// fullFidelity keeps every shown field (full section)
+func fullFidelity(field instance.EmphasisField) bool {
+	return field.Weight != weightNever
+}
+
+// summaryField keeps the fields that survive the context budget
+func summaryField(field instance.EmphasisField) bool {
+	return field.Weight == weightAlways
+}
+
+// normalizeWeight lowercases weight ("" = always); false if not a weight
+func normalizeWeight(weight string) (string, bool) {
+	switch weight = strings.ToLower(strings.TrimSpace(weight)); weight {
+	case "":
+		return weightAlways, true
+	case weightAlways, weightIfSpace, weightNever:
+		return weight, true
+	}
+	return "", false
+}
+
+// bulletField renders "**Label:**" over one bullet per item
+//
+// An empty list renders nothing unless always (the communication lists have
+// always printed their heading).
+func bulletField(label string, items []string, always bool) string {
+	if len(items) == 0 && !always {
+		return ""
+	}
+	var text strings.Builder
+	text.WriteString("**" + label + ":**\n")
+	for _, item := range items {
+		text.WriteString("- " + item + "\n")
+	}
+	return text.String()
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Built-in lists reproduce the sections exactly
+//   - if_space fields drop when summarized, always fields stay, never fields never show
+//   - Limits, user-over-instance precedence, unknown entries skipped
+//   - Run: go test ./session/ (emphasis_test.go)
+//
+// Code Execution: None (Library) - renderers called by buildCompleteContext
+//
+// Code Cleanup: None needed
+//
+// Modification Policy:
+//   ✅ Safe: New fields in the renderer maps (document them in the configs and schemas)
+//   ⚠️ Care: The default lists - existing configs without context_emphasis read them
+//   ❌ Never: Showing a field the resolved list weights "never"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
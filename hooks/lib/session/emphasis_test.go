@@ -0,0 +1,166 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Context Emphasis Tests
+//
+// Purpose: Prove the built-in emphasis reproduces the user awareness and
+//          communication sections (full and summarized) exactly, that
+//          working agreements render verbatim, that summarizing keeps
+//          "always" fields and drops "if_space" ones, that limits and
+//          "never" apply to the markdown and the structured data, and that
+//          the user config's list wins over the instance config's.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"strings"
+	"testing"
+
+	"system/lib/instance"
+)
+
+// emphasisContext is a SessionContext with every emphasis field populated
+func emphasisContext(emphasis instance.ContextEmphasisConfig) *SessionContext {
+	user := &UserConfig{}
+	user.Identity = Identity{Name: "Seanje Lenox-Wise", Pronouns: "he/him", Age: 26}
+	user.Faith = Faith{IsReligious: true, Tradition: "Christian", Denomination: "Non-denominational", PracticeLevel: "Devout", CommPreferences: "Scripture welcome"}
+	user.Workspace = Workspace{Role: "Founder", Organization: "CWS", Calling: "Kingdom technology"}
+	user.Personality.WorkStyle = "Deep focus"
+	user.Personhood.Values = []string{"Faithfulness", "Excellence"}
+	user.WorkingAgreements = []string{"Ask before deleting anything outside the workspace", "Say so plainly when unsure"}
+
+	return &SessionContext{
+		User: user,
+		Communication: &CommunicationContext{
+			Style:          "Direct and warm",
+			Principles:     []string{"Truth", "Love"},
+			Resonates:      []string{"a", "b", "c", "d", "e"},
+			Avoid:          []string{"x", "y"},
+			ProblemSolving: "Systems first",
+			LearningStyle:  "By building",
+		},
+		Emphasis: emphasis,
+	}
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestDefaultEmphasisReproducesSections(t *testing.T) {
+	ctx := emphasisContext(instance.ContextEmphasisConfig{})
+	ctx.User.WorkingAgreements = nil // Configs without agreements see no change
+
+	wantUser := "## User Awareness - Who Seanje Is\n\n" +
+		"**Seanje Lenox-Wise** (he/him, age 26)\n\n" +
+		"**Faith:** Christian (Non-denominational, Devout)\n- Scripture welcome\n\n" +
+		"**Role:** Founder at CWS\n**Calling:** Kingdom technology\n\n" +
+		"**Work Style:** Deep focus\n\n"
+	if got := renderUserAwarenessSection(ctx); got != wantUser {
+		t.Errorf("user section:\n%q\nwant:\n%q", got, wantUser)
+	}
+	if got, want := renderUserAwarenessSummary(ctx), "## User Awareness - Who Seanje Is\n\n**Seanje Lenox-Wise** (he/him) - Founder at CWS\n\n"; got != want {
+		t.Errorf("user summary = %q, want %q", got, want)
+	}
+
+	wantComm := "## Communication Style\n\n" +
+		"**My Communication:** Direct and warm\n\n" +
+		"**Core Principles:**\n- Truth\n- Love\n\n" +
+		"**What Resonates:**\n- a\n- b\n- c\n- d\n- e\n\n" +
+		"**What to Avoid:**\n- x\n- y\n\n" +
+		"**How I Think:** Systems first\n\n" +
+		"**Learning Style:** By building\n\n"
+	if got := renderCommunicationStyleSection(ctx); got != wantComm {
+		t.Errorf("communication section:\n%q\nwant:\n%q", got, wantComm)
+	}
+	if got, want := renderCommunicationSummary(ctx), "## Communication Style\n\n**My Communication:** Direct and warm\n\n"; got != want {
+		t.Errorf("communication summary = %q, want %q", got, want)
+	}
+}
+
+func TestWorkingAgreementsSurviveSummary(t *testing.T) {
+	ctx := emphasisContext(instance.ContextEmphasisConfig{})
+	agreements := "**Working Agreements:**\n- Ask before deleting anything outside the workspace\n- Say so plainly when unsure\n\n"
+
+	if full := renderUserAwarenessSection(ctx); !strings.HasSuffix(full, "**Work Style:** Deep focus\n\n"+agreements) {
+		t.Errorf("full section missing agreements:\n%s", full)
+	}
+	summary := renderUserAwarenessSummary(ctx)
+	if !strings.HasSuffix(summary, "- Founder at CWS\n\n"+agreements) || strings.Contains(summary, "Faith") {
+		t.Errorf("summary should keep agreements and drop if_space fields:\n%s", summary)
+	}
+}
+
+func TestConfiguredEmphasisWeightsAndLimits(t *testing.T) {
+	ctx := emphasisContext(instance.ContextEmphasisConfig{
+		User: []instance.EmphasisField{
+			{Field: "working_agreements", Weight: "Always", Limit: 1},
+			{Field: "values", Weight: "if_space"},
+			{Field: "identity", Weight: "if_space"},
+			{Field: "faith", Weight: "never"},
+		},
+		Communication: []instance.EmphasisField{
+			{Field: "resonates", Limit: 2},
+			{Field: "style", Weight: "if_space"},
+		},
+	})
+
+	user := renderUserAwarenessSection(ctx)
+	want := "## User Awareness - Who Seanje Is\n\n" +
+		"**Working Agreements:**\n- Ask before deleting anything outside the workspace\n\n" +
+		"**Values:**\n- Faithfulness\n- Excellence\n\n" +
+		"**Seanje Lenox-Wise** (he/him, age 26)\n\n"
+	if user != want {
+		t.Errorf("user section:\n%q\nwant:\n%q", user, want)
+	}
+	if got, want := renderUserAwarenessSummary(ctx), "## User Awareness - Who Seanje Is\n\n**Working Agreements:**\n- Ask before deleting anything outside the workspace\n\n"; got != want {
+		t.Errorf("user summary = %q, want %q", got, want)
+	}
+
+	if got, want := renderCommunicationStyleSection(ctx), "## Communication Style\n\n**What Resonates:**\n- a\n- b\n\n**My Communication:** Direct and warm\n\n"; got != want {
+		t.Errorf("communication section = %q, want %q", got, want)
+	}
+	if got, want := renderCommunicationSummary(ctx), "## Communication Style\n\n**What Resonates:**\n- a\n- b\n\n"; got != want {
+		t.Errorf("communication summary = %q, want %q", got, want)
+	}
+}
+
+func TestResolveContextEmphasis(t *testing.T) {
+	savedUser, savedInstance := userConfig, instanceConfig
+	t.Cleanup(func() { userConfig, instanceConfig = savedUser, savedInstance })
+
+	instanceConfig = &InstanceConfig{}
+	instanceConfig.Personhood.Likes = []string{"a", "b", "c", "d", "e", "f", "g"}
+	instanceConfig.Personhood.Dislikes = []string{"x", "y"}
+	instanceConfig.ContextEmphasis = instance.ContextEmphasisConfig{
+		User:          []instance.EmphasisField{{Field: "faith"}},
+		Communication: []instance.EmphasisField{{Field: "resonates", Limit: 3}, {Field: "avoid", Weight: "never"}, {Field: "tone"}},
+	}
+	userConfig = &UserConfig{}
+	userConfig.ContextEmphasis.User = []instance.EmphasisField{{Field: "identity"}, {Field: "role", Weight: "sometimes"}}
+
+	resolved := resolveContextEmphasis()
+	if len(resolved.User) != 1 || resolved.User[0].Field != "identity" {
+		t.Errorf("user list = %+v, want the user config's identity (bad weight dropped)", resolved.User)
+	}
+	if len(resolved.Communication) != 2 {
+		t.Errorf("communication list = %+v, want the instance config's (unknown field dropped)", resolved.Communication)
+	}
+
+	comm := communicationContext(resolved.Communication)
+	if strings.Join(comm.Resonates, ",") != "a,b,c" || comm.Avoid != nil || comm.Style != "" {
+		t.Errorf("structured communication = %+v, want 3 likes and nothing else", comm)
+	}
+	if defaults := communicationContext(nil); len(defaults.Resonates) != communicationListLimit || len(defaults.Avoid) != 2 {
+		t.Errorf("default communication = %+v, want the first %d likes", defaults, communicationListLimit)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,482 @@
+// METADATA
+//
+// Start Context Gathering - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Redeeming the time, because the days are evil." - Ephesians 5:16 (KJV)
+// Principle: Gather what can be gathered together; don't make the work wait on the slowest errand
+// Anchor: "To every thing there is a season, and a time to every purpose under the heaven" - Ecclesiastes 3:1 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - session start performance)
+// Role: Fetches session start's independent data sources concurrently under one deadline
+// Paradigm: CPI-SI framework component - fetch in parallel, render in order
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.2
+// Last Modified: 2026-10-16 - Workspace source captures the deadline before fetching
+//
+// Version History:
+//   1.0.2 (2026-10-16) - startSources reads startDeadline up front (abandoned fetches never read package state)
+//   1.0.1 (2026-10-16) - runGather checks the deadline before each result (expired deadline keeps nothing)
+//   1.0.0 (2026-10-16) - GatherStartContext (git, temporal, workspace, journal) with start_deadline_ms
+//
+// Purpose & Function
+//
+// Purpose: Session start loaded git context, temporal context, workspace
+// analysis, and journals one after another before anything was shown. None
+// depends on another, so GatherStartContext fetches them all at once and
+// waits at most start_deadline_ms (context.jsonc, default 1500ms):
+//   - Sources done in time are kept for the display and context builders,
+//     which then read them instead of fetching again
+//   - Sources that miss the deadline (or panic) are dropped: their sections
+//     are skipped for the rest of the hook rather than fetched serially
+//   - PrintGatherSummary shows one line: "context gathered in 620ms
+//     (journal source timed out)"
+//
+// Core Design: Fetching happens off the main goroutine; storing does not.
+// Each source returns a store step that only the gathering goroutine runs,
+// and only for sources that finished in time - a late source can never
+// change what the display already decided. Late fetches are abandoned, not
+// killed (they end with the hook's process).
+//
+// Blocking Status
+//
+// Non-blocking: Start waits for the deadline at most. A dropped source costs
+// its section only.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: context, errors, fmt, sort, strings, sync, time
+//   Internal: system/lib/logging, context.go (sessionData, fetchGitContext,
+//             currentTemporalContext), journals.go (readRecentJournals),
+//             workspace.go (analyzeWorkspacePath)
+//
+// Dependents (What Uses This):
+//   Commands: session/cmd-start (GatherStartContext, PrintGatherSummary)
+//   Libraries: getGitContext, currentTemporalContext, GetRecentJournals,
+//              AnalyzeWorkspace (gathered results and skipped sources)
+//
+// Health Scoring
+//
+//   Source finished in time: 0 (logged with its duration)
+//   Source missed the deadline or panicked: -5 (logged failure, section skipped)
+//   Gathering complete: +5 (logged with total duration)
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"context" // Shared deadline for sources that can honor it
+	"errors"  // ErrSourceSkipped sentinel
+	"fmt"     // Summary line, panic text
+	"sort"    // Deterministic dropped-source lists
+	"strings" // Summary line joining
+	"sync"    // Gathered-state guard
+	"time"    // Deadline and per-source durations
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/logging" // Per-source duration records
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// defaultStartDeadlineMS bounds gathering when start_deadline_ms is unset.
+	defaultStartDeadlineMS = 1500
+
+	// Source names (summary line and log details).
+	sourceGit       = "git"
+	sourceTemporal  = "temporal"
+	sourceWorkspace = "workspace"
+	sourceJournal   = "journal"
+)
+
+// ErrSourceSkipped is returned by readers of a source that missed the start deadline
+var ErrSourceSkipped = errors.New("context source skipped at session start")
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// GatherReport says how long start gathering took and what it dropped
+type GatherReport struct {
+	Elapsed   time.Duration            // Until the last source finished, or the deadline
+	Durations map[string]time.Duration // Sources that finished in time
+	TimedOut  []string                 // Sources still running at the deadline (sorted)
+	Failed    []string                 // Sources that panicked (sorted)
+}
+
+// gatherSource is one independent fetch
+//
+// fetch runs on its own goroutine and returns the step that stores its
+// result (nil = nothing to store); the step runs on the gathering goroutine.
+type gatherSource struct {
+	name  string
+	fetch func(ctx context.Context) (store func())
+}
+
+// gatherResult is one source handing in
+type gatherResult struct {
+	index    int
+	store    func()
+	duration time.Duration
+	panicked error
+}
+
+// ────────────────────────────────────────────────────────────────
+// Package-Level State
+// ────────────────────────────────────────────────────────────────
+
+// startDeadline is context.jsonc start_deadline_ms (set in context.go init)
+var startDeadline = defaultStartDeadlineMS * time.Millisecond
+
+// gathered holds what GatherStartContext kept, for the rest of the hook run
+var gathered struct {
+	sync.Mutex
+	skipped      map[string]bool // Dropped sources - readers return ErrSourceSkipped / nothing
+	hasGit       bool
+	gitWorkspace string
+	git          *GitContext
+	hasJournals  bool
+	journals     []JournalSummary
+	journalsErr  error
+	hasWorkspace bool
+	workspace    *WorkspaceReport
+	workspaceErr error
+}
+
+// gatherLogger records per-source durations and dropped sources
+var gatherLogger = logging.NewLogger("session-gather")
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 3 functions
+//   ├── GatherStartContext(workspace) → startSources(), runGather(), records skipped sources
+//   ├── GatherReport.Summary() → pure
+//   └── PrintGatherSummary(report) → Summary(), visible("gather_summary")
+//
+//   Core Operations (Middle Rungs) - 2 functions
+//   ├── startSources(workspace) → fetchGitContext, currentTemporalContext,
+//   │                             analyzeWorkspacePath, readRecentJournals
+//   └── runGather(deadline, sources) → one goroutine per source, stores on return
+//
+//   Readers (used by context.go, journals.go, workspace.go) - 4 functions
+//   ├── sourceSkipped(name)
+//   ├── gatheredGit(workspace)
+//   ├── gatheredJournals()
+//   └── gatheredWorkspace(path)
+
+// ────────────────────────────────────────────────────────────────
+// Readers - Gathered State
+// ────────────────────────────────────────────────────────────────
+
+// sourceSkipped reports whether name missed the start deadline this run
+func sourceSkipped(name string) bool {
+	gathered.Lock()
+	defer gathered.Unlock()
+	return gathered.skipped[name]
+}
+
+// gatheredGit returns the gathered git context for workspace (ok=false: fetch it)
+func gatheredGit(workspace string) (git *GitContext, ok bool) {
+	gathered.Lock()
+	defer gathered.Unlock()
+	if gathered.skipped[sourceGit] && workspace == gathered.gitWorkspace {
+		return nil, true
+	}
+	if gathered.hasGit && workspace == gathered.gitWorkspace {
+		return gathered.git, true
+	}
+	return nil, false
+}
+
+// gatheredJournals returns the gathered configured-count journals (ok=false: read them)
+func gatheredJournals() (journals []JournalSummary, ok bool, err error) {
+	gathered.Lock()
+	defer gathered.Unlock()
+	if gathered.skipped[sourceJournal] {
+		return nil, true, ErrSourceSkipped
+	}
+	return gathered.journals, gathered.hasJournals, gathered.journalsErr
+}
+
+// gatheredWorkspace returns the gathered analysis of path (ok=false: analyze it)
+func gatheredWorkspace(path string) (report *WorkspaceReport, ok bool, err error) {
+	gathered.Lock()
+	defer gathered.Unlock()
+	if gathered.skipped[sourceWorkspace] {
+		return &WorkspaceReport{Path: path}, true, ErrSourceSkipped
+	}
+	if gathered.hasWorkspace && gathered.workspace != nil && gathered.workspace.Path == path {
+		return gathered.workspace, true, gathered.workspaceErr
+	}
+	return nil, false, nil
+}
+
+// resetGathered forgets everything gathered (tests; each hook is its own process)
+func resetGathered() {
+	gathered.Lock()
+	defer gathered.Unlock()
+	gathered.skipped = nil
+	gathered.hasGit, gathered.gitWorkspace, gathered.git = false, "", nil
+	gathered.hasJournals, gathered.journals, gathered.journalsErr = false, nil, nil
+	gathered.hasWorkspace, gathered.workspace, gathered.workspaceErr = false, nil, nil
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Gathering
+// ────────────────────────────────────────────────────────────────
+
+// startSources lists session start's independent fetches
+//
+// Git is fetched for the session's work context (what the work context
+// section reads); workspace analysis only with a workspace configured.
+func startSources(workspace string) []gatherSource {
+	var sources []gatherSource
+
+	if sessionData != nil && sessionData.WorkContext != "" {
+		gitWorkspace := sessionData.WorkContext
+		gitLogger := logging.NewLogger("session-context") // Own Logger - a late fetch must not share contextLogger
+		sources = append(sources, gatherSource{sourceGit, func(context.Context) func() {
+			git := fetchGitContext(gitWorkspace, gitLogger)
+			return func() {
+				gathered.hasGit, gathered.gitWorkspace, gathered.git = true, gitWorkspace, git
+			}
+		}})
+	}
+
+	sources = append(sources, gatherSource{sourceTemporal, func(context.Context) func() {
+		currentTemporalContext() // Warms the shared temporal cache - readers hit it
+		return nil
+	}})
+
+	if workspace != "" {
+		budget := startDeadline // Read here - the fetch may outlive the hook's (or a test's) deadline
+		sources = append(sources, gatherSource{sourceWorkspace, func(ctx context.Context) func() {
+			limit := budget
+			if deadline, ok := ctx.Deadline(); ok {
+				limit = time.Until(deadline)
+			}
+			report, err := analyzeWorkspacePath(workspace, limit)
+			return func() {
+				gathered.hasWorkspace, gathered.workspace, gathered.workspaceErr = true, report, err
+			}
+		}})
+	}
+
+	sources = append(sources, gatherSource{sourceJournal, func(context.Context) func() {
+		journals, err := readRecentJournals(0)
+		return func() {
+			gathered.hasJournals, gathered.journals, gathered.journalsErr = true, journals, err
+		}
+	}})
+
+	return sources
+}
+
+// runGather starts every source at once and collects them until deadline
+//
+// Sources done in time have their store step run here, in the order they
+// finish; the rest are listed as timed out (or failed, on panic) and
+// abandoned - their goroutines finish unobserved.
+func runGather(deadline time.Duration, sources []gatherSource) GatherReport {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), deadline)
+	defer cancel()
+
+	results := make(chan gatherResult, len(sources)) // Buffered: late sources never block
+	for i, source := range sources {
+		go func() {
+			began := time.Now()
+			result := gatherResult{index: i}
+			defer func() {
+				if r := recover(); r != nil {
+					result.store, result.panicked = nil, fmt.Errorf("panic: %v", r)
+				}
+				result.duration = time.Since(began)
+				results <- result
+			}()
+			result.store = source.fetch(ctx)
+		}()
+	}
+
+	report := GatherReport{Durations: make(map[string]time.Duration)}
+	done := make([]bool, len(sources))
+collect:
+	for range sources {
+		if ctx.Err() != nil { // Expired - a result racing the deadline is not kept
+			break
+		}
+		select {
+		case result := <-results:
+			done[result.index] = true
+			name := sources[result.index].name
+			if result.panicked != nil {
+				report.Failed = append(report.Failed, name)
+				gatherLogger.Failure("gather-source", result.panicked.Error(), -5, map[string]any{"source": name})
+				continue
+			}
+			report.Durations[name] = result.duration
+			if result.store != nil {
+				gathered.Lock()
+				result.store()
+				gathered.Unlock()
+			}
+			gatherLogger.Check("gather-source", true, 0, map[string]any{
+				"source":      name,
+				"duration_ms": result.duration.Milliseconds(),
+			})
+		case <-ctx.Done():
+			break collect
+		}
+	}
+	report.Elapsed = time.Since(start)
+
+	for i, source := range sources {
+		if !done[i] {
+			report.TimedOut = append(report.TimedOut, source.name)
+			gatherLogger.Failure("gather-source", "missed the start deadline", -5, map[string]any{
+				"source":      source.name,
+				"deadline_ms": deadline.Milliseconds(),
+			})
+		}
+	}
+	sort.Strings(report.TimedOut)
+	sort.Strings(report.Failed)
+	return report
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Start Gathering
+// ────────────────────────────────────────────────────────────────
+
+// GatherStartContext fetches session start's data sources concurrently
+//
+// What It Does:
+//   - Fetches git context (session work context), temporal context, workspace
+//     analysis (when workspace is set), and recent journals at the same time
+//   - Waits at most start_deadline_ms (context.jsonc, default 1500ms)
+//   - Keeps finished results for the display and context builders
+//   - Marks late or panicking sources skipped: their sections are left out
+//     for the rest of the hook instead of being fetched again serially
+//   - Logs each source's duration (session-gather) so slow sources show up
+//
+// Call once, early in the start hook, before anything displays.
+//
+// Example:
+//
+//	gathered := session.GatherStartContext(workspace)
+//	session.PrintHeader()
+//	session.PrintGatherSummary(gathered)
+func GatherStartContext(workspace string) GatherReport {
+	report := runGather(startDeadline, startSources(workspace))
+
+	gathered.Lock()
+	gathered.skipped = make(map[string]bool)
+	for _, name := range append(append([]string{}, report.TimedOut...), report.Failed...) {
+		gathered.skipped[name] = true
+	}
+	if gathered.skipped[sourceGit] && sessionData != nil {
+		gathered.gitWorkspace = sessionData.WorkContext
+	}
+	gathered.Unlock()
+
+	gatherLogger.Success("context-gathered", 5, map[string]any{
+		"elapsed_ms":  report.Elapsed.Milliseconds(),
+		"deadline_ms": startDeadline.Milliseconds(),
+		"timed_out":   report.TimedOut,
+		"failed":      report.Failed,
+	})
+	return report
+}
+
+// Summary is the one-line account: "context gathered in 620ms (journal source timed out)"
+func (r GatherReport) Summary() string {
+	sources := func(names []string) string {
+		if len(names) == 1 {
+			return names[0] + " source"
+		}
+		return strings.Join(names, ", ") + " sources"
+	}
+	var notes []string
+	if len(r.TimedOut) > 0 {
+		notes = append(notes, sources(r.TimedOut)+" timed out")
+	}
+	if len(r.Failed) > 0 {
+		notes = append(notes, sources(r.Failed)+" failed")
+	}
+
+	line := fmt.Sprintf("context gathered in %dms", r.Elapsed.Milliseconds())
+	if len(notes) > 0 {
+		line += " (" + strings.Join(notes, "; ") + ")"
+	}
+	return line
+}
+
+// PrintGatherSummary displays the gathering summary line (normal and verbose)
+func PrintGatherSummary(report GatherReport) {
+	maybeReloadDisplayConfig()
+
+	if !visible("gather_summary") {
+		return
+	}
+	fmt.Fprintf(Output(), "  %s\n\n", report.Summary())
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Sources run concurrently: total time is the slowest source, not the sum
+//   - A source past the deadline is dropped, its readers see ErrSourceSkipped
+//   - A late source's result is never stored
+//   - Run: go test -race ./session/ (gather_test.go)
+//
+// Code Execution: None (Library) - called by session/cmd-start
+//
+// Code Cleanup: Abandoned fetches end with the hook process
+//
+// Modification Policy:
+//   ✅ Safe: New sources (independent of the others, store step on return)
+//   ⚠️ Care: Sources that display - gathering happens before any output
+//   ❌ Never: Storing from the fetch goroutine (a late source would race the display)
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
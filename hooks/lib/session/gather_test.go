@@ -0,0 +1,162 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Start Context Gathering Tests
+//
+// Purpose: Prove sources run concurrently under one deadline - a slow source
+//          is dropped at the deadline while the fast ones are kept, a late
+//          result is never stored, a panicking source is reported as failed -
+//          and that a temporal source dropped at start stays skipped for the
+//          display and context builders instead of being fetched again,
+//          while a workspace source dropped at an expired deadline still
+//          shows its section header with a timed-out note.
+//
+// Run with -race: abandoned fetches outlive their test, so a source must
+// not read package state (startDeadline, sources) once it has started.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"system/lib/temporal"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestRunGatherDeadline(t *testing.T) {
+	t.Cleanup(resetGathered)
+
+	release := make(chan struct{})
+	defer close(release)
+
+	var fastStored, slowStored bool
+	sources := []gatherSource{
+		{"fast", func(context.Context) func() { return func() { fastStored = true } }},
+		{"also-fast", func(context.Context) func() { time.Sleep(10 * time.Millisecond); return nil }},
+		{"journal", func(context.Context) func() {
+			<-release // Ignores the deadline entirely
+			return func() { slowStored = true }
+		}},
+		{"broken", func(context.Context) func() { panic("probe exploded") }},
+	}
+
+	report := runGather(100*time.Millisecond, sources)
+	if report.Elapsed > 500*time.Millisecond {
+		t.Errorf("gathering took %v, want about the 100ms deadline", report.Elapsed)
+	}
+	if !fastStored || slowStored {
+		t.Errorf("stored: fast %v, slow %v; want only the fast source", fastStored, slowStored)
+	}
+	if len(report.TimedOut) != 1 || report.TimedOut[0] != "journal" {
+		t.Errorf("TimedOut = %v, want [journal]", report.TimedOut)
+	}
+	if len(report.Failed) != 1 || report.Failed[0] != "broken" {
+		t.Errorf("Failed = %v, want [broken]", report.Failed)
+	}
+	if _, ok := report.Durations["also-fast"]; !ok {
+		t.Errorf("Durations = %v, want also-fast timed", report.Durations)
+	}
+
+	report.Elapsed = 620 * time.Millisecond
+	report.Failed = nil
+	if got := report.Summary(); got != "context gathered in 620ms (journal source timed out)" {
+		t.Errorf("Summary = %q", got)
+	}
+}
+
+func TestGatherSkipsTimedOutTemporal(t *testing.T) {
+	t.Cleanup(resetGathered)
+
+	release, finished := make(chan struct{}), make(chan struct{})
+	savedSource, savedDeadline := temporalContextSource, startDeadline
+	t.Cleanup(func() {
+		close(release)
+		<-finished // Abandoned fetch done before the source is restored
+		temporalContextSource, startDeadline = savedSource, savedDeadline
+	})
+
+	var calls atomic.Int32
+	temporalContextSource = func(time.Duration) (*temporal.TemporalContext, error) {
+		defer close(finished)
+		calls.Add(1)
+		<-release // Hung temporal fetch
+		return &temporal.TemporalContext{}, nil
+	}
+	startDeadline = 100 * time.Millisecond
+
+	report := GatherStartContext("")
+	if !sourceSkipped(sourceTemporal) {
+		t.Fatalf("temporal not skipped: %s", report.Summary())
+	}
+
+	// Readers return at once rather than waiting on the hung fetch
+	done := make(chan error, 1)
+	go func() {
+		_, err := currentTemporalContext()
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if !errors.Is(err, ErrSourceSkipped) {
+			t.Errorf("currentTemporalContext err = %v, want ErrSourceSkipped", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("currentTemporalContext blocked on the dropped source")
+	}
+	if section := renderTemporalSection(buildContextStruct()); section != "" {
+		t.Errorf("temporal section built from a dropped source: %q", section)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("temporal fetched %d times, want once (the gathering attempt)", n)
+	}
+}
+
+func TestWorkspaceSkippedAtExpiredDeadline(t *testing.T) {
+	t.Cleanup(resetGathered)
+	useGoldenDisplayConfig(t)
+
+	saved := startDeadline
+	t.Cleanup(func() { startDeadline = saved })
+	startDeadline = -time.Millisecond // Expired before gathering starts
+
+	workspace := t.TempDir()
+	report := GatherStartContext(workspace)
+	if !sourceSkipped(sourceWorkspace) {
+		t.Fatalf("workspace not skipped: %s", report.Summary())
+	}
+	if len(report.Durations) != 0 {
+		t.Errorf("sources kept past an expired deadline: %v", report.Durations)
+	}
+
+	analysis, err := AnalyzeWorkspace(workspace)
+	if !errors.Is(err, ErrSourceSkipped) {
+		t.Fatalf("AnalyzeWorkspace err = %v, want ErrSourceSkipped", err)
+	}
+	if analysis.Noteworthy() {
+		t.Errorf("skipped analysis reports findings: %+v", analysis)
+	}
+
+	out := CaptureOutput(PrintWorkspaceAnalysisSkipped)
+	header := strings.Index(out, "WORKSPACE ANALYSIS")
+	note := strings.Index(out, "Workspace analysis skipped - source timed out")
+	if header < 0 || note < header {
+		t.Errorf("want the section header then the timed-out note:\n%s", out)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -75,10 +75,10 @@
 //
 // Dependencies (What This Needs):
 //   Standard Library: os (file operations), path/filepath (path handling),
-//                     encoding/json (config parsing), fmt (fallback output),
-//                     strings (string manipulation)
+//                     fmt (fallback output), strings (string manipulation)
 //   Internal: system/lib/git (GetInfo for repository status),
-//             system/lib/display (formatted output with health tracking)
+//             system/lib/display (formatted output with health tracking),
+//             system/lib/jsonc (config parsing)
 //
 // Dependents (What Uses This):
 //   Hooks: session/cmd-start/start.go (session start git check)
@@ -132,7 +132,6 @@ import (
 	//--- Standard Library ---
 	// Foundation packages providing Go's built-in capabilities.
 
-	"encoding/json" // JSON parsing for configuration file (JSONC after comment stripping)
 	"fmt"           // Formatted output for status display and fallback
 	"os"            // File operations for configuration loading and HOME directory
 	"path/filepath" // Join paths for configuration file location
@@ -143,6 +142,7 @@ import (
 
 	"system/lib/display" // Formatted output with ANSI colors and health tracking
 	"system/lib/git"     // Repository status and information gathering
+	"system/lib/jsonc"   // Parse git-monitoring.jsonc configuration file
 )
 
 // ────────────────────────────────────────────────────────────────
@@ -252,7 +252,7 @@ func init() {
 //
 //   Helpers (Bottom Rungs) - 4 functions
 //   ├── loadGitConfig() → uses loadGitConfigFile, getDefaultGitConfig
-//   ├── loadGitConfigFile(path) → uses stripJSONCComments (from activity.go)
+//   ├── loadGitConfigFile(path) → uses jsonc.Unmarshal
 //   ├── getDefaultGitConfig() → pure function
 //   └── formatGitMessage(template, count) → pure function
 //
@@ -292,8 +292,7 @@ func loadGitConfig() GitMonitoringConfig {
 // What It Does:
 //   - Expands ~ in path to HOME directory
 //   - Reads JSONC configuration file
-//   - Strips comments using stripJSONCComments (from activity.go)
-//   - Parses JSON into GitMonitoringConfig struct
+//   - Parses JSONC into GitMonitoringConfig struct (jsonc.Unmarshal)
 //
 // Parameters:
 //   - path: Path to configuration file (may contain ~)
@@ -301,8 +300,6 @@ func loadGitConfig() GitMonitoringConfig {
 // Returns:
 //   - GitMonitoringConfig: Parsed configuration
 //   - error: Any error encountered during loading
-//
-// Note: stripJSONCComments is defined in activity.go and used here
 func loadGitConfigFile(path string) (GitMonitoringConfig, error) {
 	var config GitMonitoringConfig
 
@@ -321,11 +318,8 @@ func loadGitConfigFile(path string) (GitMonitoringConfig, error) {
 		return config, err
 	}
 
-	// Strip JSONC comments
-	jsonData := stripJSONCComments(string(data))
-
-	// Parse JSON
-	err = json.Unmarshal([]byte(jsonData), &config)
+	// Parse JSONC (comments stripped, trailing commas tolerated)
+	err = jsonc.Unmarshal(data, &config)
 	return config, err
 }
 
@@ -466,14 +460,14 @@ func CheckGitStatus(workspace string) {
 	// Display results
 	if len(issues) > 0 {
 		// Display header using display library
-		fmt.Printf("\n%s %s\n", cfg.Display.HeaderIcon, cfg.Display.HeaderText)
+		fmt.Fprintf(Output(), "\n%s %s\n", cfg.Display.HeaderIcon, cfg.Display.HeaderText)
 		for _, issue := range issues {
-			fmt.Printf("   • %s\n", issue)
+			fmt.Fprintf(Output(), "   • %s\n", issue)
 		}
 	} else if cfg.Display.ShowWhenClean {
 		// Repository is clean and user wants to see that
-		fmt.Printf("\n%s %s\n", cfg.Display.HeaderIcon, cfg.Display.HeaderText)
-		fmt.Printf("   %s\n", display.Success(cfg.Display.CleanMessage))
+		fmt.Fprintf(Output(), "\n%s %s\n", cfg.Display.HeaderIcon, cfg.Display.HeaderText)
+		fmt.Fprintf(Output(), "   %s\n", display.Success(cfg.Display.CleanMessage))
 	}
 }
 
@@ -721,7 +715,7 @@ func CheckGitStatus(workspace string) {
 // Key dependencies:
 //   - system/lib/git: Repository status information
 //   - system/lib/display: Formatted output
-//   - hooks/lib/session/activity.go: stripJSONCComments function
+//   - system/lib/jsonc: Configuration parsing
 //
 // Primary consumers:
 //   - session/cmd-start/start.go: Session start hook
@@ -0,0 +1,511 @@
+// METADATA
+//
+// Session History Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Remember the days of old, consider the years of many generations" - Deuteronomy 32:7 (KJV)
+// Principle: Begin where the last work left off - a short memory of what was done
+// Anchor: "Forgetting those things which are behind, and reaching forth unto those things which are before" - Philippians 3:13 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - cross-session continuity)
+// Role: Keeps a one-line summary per ended session and recalls the latest at start
+// Paradigm: CPI-SI framework component - end hook appends, start hook reads back
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.1.0
+// Last Modified: 2026-10-16 - Phase timeline in the summary
+//
+// Version History:
+//   1.1.0 (2026-10-16) - SessionSummary.Journey from GetTemporalJourney (journey.go)
+//   1.0.0 (2026-10-16) - sessions-history.jsonl, GetRecentSessions, "recent_sessions" context section
+//
+// Purpose & Function
+//
+// Purpose: The archive (lifecycle.go) keeps every session record and
+// stats-history.jsonl keeps the numbers, but neither says in one line what the
+// last session was. sessions-history.jsonl holds one SessionSummary per ended
+// session - duration, end reason, quality indicators, the workspace branch,
+// and a line of focus text - so session start can open with:
+//
+//   Last session: 2h10m on branch feature/logging-sinks, 4 tasks, ended normally 14h ago.
+//
+// Core Design: SummarizeSession turns the end hook's SessionStats into a
+// SessionSummary (adding branch and focus); AppendSessionSummary appends it and
+// trims the file to the newest history.max_records (context.jsonc, 0 = 200).
+// Focus is the live session's COMPACTION_FOCUS text when it compacted, else the
+// subject of the last commit when the session committed anything.
+//
+// Blocking Status
+//
+// Non-blocking: A missing history file means no recap; corrupt lines are
+// skipped. Append failures are logged and returned for the hook to ignore.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bufio, encoding/json, errors, fmt, io/fs, os, path/filepath, strings, time
+//   Internal: system/lib/git (branch, last commit subject)
+//   Package Files: journey.go (GetTemporalJourney), stats.go (SessionStats, QualityStats), compaction.go (latestCompactionSnapshot),
+//                  patterns.go (shortDuration), unpushed.go (plural), clock.go (now)
+//
+// Dependents (What Uses This):
+//   Libraries: context.go ("recent_sessions"), display.go (PrintRecentSessions)
+//   Commands: session/cmd-end (SummarizeSession, AppendSessionSummary)
+//
+// Health Scoring
+//
+// Summary appended: +5. Append or trim failed: -5. Reads are untracked
+// (absent history is the normal first-run state).
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"bufio"         // Line-by-line history reading
+	"encoding/json" // SessionSummary lines
+	"errors"        // Missing history detection
+	"fmt"           // Recap text
+	"io/fs"         // fs.ErrNotExist
+	"os"            // History append, trim rename
+	"path/filepath" // History directory creation, temp file placement
+	"strings"       // Reason matching, focus flattening
+	"time"          // Durations and "ago" text
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/git" // Branch and last commit subject
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// sessionHistoryPath holds one SessionSummary JSON object per line, oldest
+	// first (tilde expanded by expandPath).
+	sessionHistoryPath = "~/.claude/cpi-si/system/data/session/sessions-history.jsonl"
+
+	// defaultMaxSessionHistory is how many summaries are kept when
+	// history.max_records is unset.
+	defaultMaxSessionHistory = 200
+
+	// recentSessionsContextCount is how many summaries the "recent_sessions"
+	// context section lists.
+	recentSessionsContextCount = 3
+
+	// maxFocusLength caps the focus text kept in a summary (runes).
+	maxFocusLength = 120
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// HistoryConfig is context.jsonc "history"
+type HistoryConfig struct {
+	MaxRecords int `json:"max_records"` // Summaries kept, newest win (0 = defaultMaxSessionHistory)
+}
+
+// SessionSummary is one ended session in sessions-history.jsonl
+type SessionSummary struct {
+	SessionID       string         `json:"session_id,omitempty"`
+	StartTime       time.Time      `json:"start_time"`
+	EndTime         time.Time      `json:"end_time"`
+	DurationSeconds int64          `json:"duration_seconds,omitempty"`
+	Reason          string         `json:"reason,omitempty"`
+	Quality         *QualityStats  `json:"quality,omitempty"` // nil = current.json was unavailable
+	Branch          string         `json:"branch,omitempty"`  // Workspace branch at end ("" = no repository or detached)
+	Focus           string         `json:"focus,omitempty"`   // One line: compaction focus, else last commit subject
+	Journey         []PhaseSegment `json:"journey,omitempty"` // Phase timeline (journey.go; none without a ledger)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Package-Level State
+// ────────────────────────────────────────────────────────────────
+
+// historyConfig holds history retention (set from context.jsonc in context.go init)
+var historyConfig HistoryConfig
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 3 functions
+//   ├── SummarizeSession(stats) → uses git.GetHead, sessionFocus
+//   ├── AppendSessionSummary(summary) → uses appendSessionSummary, displayLogger
+//   └── GetRecentSessions(n) → uses loadSessionHistory
+//
+//   Core Operations (Middle Rungs) - 3 functions
+//   ├── appendSessionSummary(path, summary, keep) → uses trimSessionHistory
+//   ├── buildRecentSessionsSection() → uses GetRecentSessions, recentSessionText (context.go "recent_sessions")
+//   └── buildRecentSessionsSummary() → uses GetRecentSessions, recentSessionText (context budget form)
+//
+//   Helpers (Bottom Rungs) - 7 functions
+//   ├── sessionFocus(stats) → uses latestCompactionSnapshot, git.GetLastCommit
+//   ├── oneLine(text, limit) → pure function
+//   ├── loadSessionHistory(path) → pure I/O
+//   ├── trimSessionHistory(path, keep) → rewrites via temp file + rename
+//   ├── recentSessionText(summary, at) → uses shortDuration, plural, endReasonText, agoText
+//   ├── endReasonText(reason) → pure function
+//   └── agoText(d) → pure function
+//
+// Baton Flow:
+//   end hook → CollectSessionStats → SummarizeSession → AppendSessionSummary
+//   start hook → PrintRecentSessions (display.go), "recent_sessions" context → GetRecentSessions
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Focus and Text
+// ────────────────────────────────────────────────────────────────
+
+// sessionFocus finds one line saying what the session was about ("" = nothing known)
+func sessionFocus(stats SessionStats) string {
+	if snapshot := latestCompactionSnapshot(sessionDataDir()); snapshot != nil && snapshot.Focus != "" {
+		return oneLine(snapshot.Focus, maxFocusLength)
+	}
+	if stats.Git != nil && stats.Git.Commits > 0 {
+		if commit, err := git.GetLastCommit(stats.Workspace); err == nil {
+			return oneLine(commit.Subject, maxFocusLength)
+		}
+	}
+	return ""
+}
+
+// oneLine flattens whitespace and cuts text to limit runes ("…" marks the cut)
+func oneLine(text string, limit int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if runes := []rune(text); len(runes) > limit {
+		return strings.TrimSpace(string(runes[:limit-1])) + "…"
+	}
+	return text
+}
+
+// endReasonText phrases a session end reason ("ended normally", "cleared", "ended (crash)")
+//
+// The end hook's default reason and Claude Code's ordinary exits read as normal.
+func endReasonText(reason string) string {
+	switch lower := strings.ToLower(strings.TrimSpace(reason)); {
+	case lower == "", lower == "other", lower == "logout", lower == "prompt_input_exit", strings.Contains(lower, "normal"):
+		return "ended normally"
+	case lower == "clear":
+		return "cleared"
+	default:
+		return fmt.Sprintf("ended (%s)", reason)
+	}
+}
+
+// agoText renders elapsed time coarsely ("just now", "25m ago", "14h ago", "3d ago")
+func agoText(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+	}
+}
+
+// recentSessionText describes one summary as of at
+//
+// "2h10m on branch feature/logging-sinks, 4 tasks, ended normally 14h ago" -
+// parts with nothing recorded (duration, branch, quality) are left out.
+func recentSessionText(summary SessionSummary, at time.Time) string {
+	var parts []string
+
+	lead := ""
+	if summary.DurationSeconds > 0 {
+		lead = shortDuration(time.Duration(summary.DurationSeconds) * time.Second)
+	}
+	if summary.Branch != "" {
+		lead = strings.TrimSpace(lead + " on branch " + summary.Branch)
+	}
+	if lead != "" {
+		parts = append(parts, lead)
+	}
+	if summary.Quality != nil {
+		parts = append(parts, plural(summary.Quality.TasksCompleted, "task"))
+	}
+
+	ending := endReasonText(summary.Reason)
+	if !summary.EndTime.IsZero() {
+		ending += " " + agoText(at.Sub(summary.EndTime))
+	}
+	parts = append(parts, ending)
+
+	return strings.Join(parts, ", ")
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - History File
+// ────────────────────────────────────────────────────────────────
+
+// loadSessionHistory reads sessions-history.jsonl oldest first (missing file = no history)
+//
+// Lines that don't decode are skipped - a torn or hand-edited line costs only itself.
+func loadSessionHistory(path string) ([]SessionSummary, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var history []SessionSummary
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var summary SessionSummary
+		if json.Unmarshal(scanner.Bytes(), &summary) == nil {
+			history = append(history, summary)
+		}
+	}
+	return history, scanner.Err()
+}
+
+// trimSessionHistory keeps only the newest keep lines of path
+//
+// Rewrites through a temp file in the same directory and renames it into
+// place, so a crash mid-trim leaves the old file whole.
+func trimSessionHistory(path string, keep int) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	lines := strings.SplitAfter(strings.TrimRight(string(data), "\n"), "\n")
+	if len(lines) <= keep {
+		return nil
+	}
+	kept := strings.Join(lines[len(lines)-keep:], "")
+	if !strings.HasSuffix(kept, "\n") {
+		kept += "\n"
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(path), ".sessions-history-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name()) // No-op after a successful rename
+
+	if _, err := temp.WriteString(kept); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), path)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Append
+// ────────────────────────────────────────────────────────────────
+
+// appendSessionSummary appends summary to path, then trims to the newest keep
+func appendSessionSummary(path string, summary SessionSummary, keep int) error {
+	line, err := json.Marshal(summary)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(append(line, '\n'))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return trimSessionHistory(path, keep)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// SummarizeSession reduces the ending session's statistics to a history summary
+//
+// What It Does:
+//   - Copies identity, times, duration, reason, and quality indicators from stats
+//   - Reads the workspace's current branch (empty outside a repository or when detached)
+//   - Finds focus text: the live session's compaction focus, else the last
+//     commit subject when the session made commits
+//
+// Example:
+//   stats := session.CollectSessionStats(workspace, reason)
+//   session.AppendSessionSummary(session.SummarizeSession(stats))
+func SummarizeSession(stats SessionStats) SessionSummary {
+	summary := SessionSummary{
+		SessionID:       stats.SessionID,
+		StartTime:       stats.StartTime,
+		EndTime:         stats.EndTime,
+		DurationSeconds: stats.DurationSeconds,
+		Reason:          stats.Reason,
+		Quality:         stats.Quality,
+		Focus:           sessionFocus(stats),
+	}
+	if journey, err := GetTemporalJourney(); err == nil {
+		summary.Journey = journey
+	}
+	if stats.Workspace != "" {
+		if head, err := git.GetHead(stats.Workspace); err == nil && !head.Detached {
+			summary.Branch = head.Branch
+		}
+	}
+	return summary
+}
+
+// AppendSessionSummary records summary in sessions-history.jsonl
+//
+// Keeps only the newest history.max_records summaries (context.jsonc, 0 = 200).
+//
+// Health Impact:
+//   +5: Appended
+//   -5: Could not write or trim history (returned to caller)
+func AppendSessionSummary(summary SessionSummary) error {
+	keep := historyConfig.MaxRecords
+	if keep <= 0 {
+		keep = defaultMaxSessionHistory
+	}
+
+	if err := appendSessionSummary(expandPath(sessionHistoryPath), summary, keep); err != nil {
+		displayLogger.Failure("session-history", err.Error(), -5, map[string]any{
+			"path": sessionHistoryPath,
+		})
+		return err
+	}
+
+	displayLogger.Success("session-history", 5, map[string]any{
+		"path":       sessionHistoryPath,
+		"session_id": summary.SessionID,
+	})
+	return nil
+}
+
+// GetRecentSessions returns up to n ended sessions, most recent first
+//
+// Returns:
+//   - Summaries (none when history is missing or n < 1)
+//   - Error only if the history file exists but can't be read
+//
+// Example:
+//   recent, err := session.GetRecentSessions(1)
+//   if err == nil && len(recent) > 0 {
+//       fmt.Println(recent[0].Branch)
+//   }
+func GetRecentSessions(n int) ([]SessionSummary, error) {
+	if n < 1 {
+		return nil, nil
+	}
+	history, err := loadSessionHistory(expandPath(sessionHistoryPath))
+	if err != nil || len(history) == 0 {
+		return nil, err
+	}
+
+	recent := make([]SessionSummary, 0, min(n, len(history)))
+	for i := len(history) - 1; i >= 0 && len(recent) < n; i-- {
+		recent = append(recent, history[i])
+	}
+	return recent, nil
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Context Section
+// ────────────────────────────────────────────────────────────────
+
+// buildRecentSessionsSection recaps the last few sessions for session context
+//
+// Empty when there is no readable history.
+func buildRecentSessionsSection() string {
+	recent, err := GetRecentSessions(recentSessionsContextCount)
+	if err != nil || len(recent) == 0 {
+		return ""
+	}
+
+	at := now()
+	section := "## Recent Sessions\n\n"
+	section += fmt.Sprintf("Last session: %s.\n", recentSessionText(recent[0], at))
+	if recent[0].Focus != "" {
+		section += fmt.Sprintf("Focus: %s\n", recent[0].Focus)
+	}
+	if len(recent) > 1 {
+		section += "\nBefore that:\n"
+		for _, summary := range recent[1:] {
+			section += fmt.Sprintf("- %s\n", recentSessionText(summary, at))
+		}
+	}
+	return section + "\n"
+}
+
+// buildRecentSessionsSummary keeps only the last session line (context budget form)
+func buildRecentSessionsSummary() string {
+	recent, err := GetRecentSessions(1)
+	if err != nil || len(recent) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("## Recent Sessions\n\nLast session: %s.\n\n", recentSessionText(recent[0], now()))
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Recap text: every part optional except the ending
+//   - Corrupt lines skipped, missing file = no history, cap trims oldest first
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by session/cmd-end and session/cmd-start
+//
+// Code Cleanup: Trim temp file removed on every path
+//
+// Modification Policy:
+//   ✅ Safe: More SessionSummary fields (omitempty), more recap parts
+//   ⚠️ Care: SessionSummary JSON field names (history lines are read back across versions)
+//   ❌ Never: Blocking session start on unreadable history, or session end on a failed append
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,115 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Session History Tests
+//
+// Purpose: Prove the recap line leaves out what wasn't recorded, that history
+//          reads newest first past corrupt lines, that the retention cap keeps
+//          the newest summaries, and that missing history renders nothing.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestRecentSessionText(t *testing.T) {
+	at := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	full := SessionSummary{
+		EndTime:         at.Add(-14 * time.Hour),
+		DurationSeconds: int64((2*time.Hour + 10*time.Minute).Seconds()),
+		Reason:          "Normal session end",
+		Quality:         &QualityStats{TasksCompleted: 4},
+		Branch:          "feature/logging-sinks",
+	}
+	if got, want := recentSessionText(full, at), "2h10m on branch feature/logging-sinks, 4 tasks, ended normally 14h ago"; got != want {
+		t.Errorf("full summary = %q, want %q", got, want)
+	}
+
+	// Nothing recorded but the reason
+	if got := recentSessionText(SessionSummary{Reason: "clear"}, at); got != "cleared" {
+		t.Errorf("bare summary = %q, want \"cleared\"", got)
+	}
+	if got := recentSessionText(SessionSummary{Branch: "main", Reason: "crash", EndTime: at.Add(-72 * time.Hour)}, at); got != "on branch main, ended (crash) 3d ago" {
+		t.Errorf("no duration = %q", got)
+	}
+
+	for reason, want := range map[string]string{"": "ended normally", "logout": "ended normally", "prompt_input_exit": "ended normally", "other": "ended normally"} {
+		if got := endReasonText(reason); got != want {
+			t.Errorf("endReasonText(%q) = %q, want %q", reason, got, want)
+		}
+	}
+	if got := oneLine("Refactor\n  the   sinks", 120); got != "Refactor the sinks" {
+		t.Errorf("oneLine = %q", got)
+	}
+	if got := oneLine(strings.Repeat("x", 10), 5); got != "xxxx…" {
+		t.Errorf("oneLine cut = %q", got)
+	}
+}
+
+func TestSessionHistoryAppendAndRead(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	path := expandPath(sessionHistoryPath)
+
+	if recent, err := GetRecentSessions(3); recent != nil || err != nil {
+		t.Errorf("missing history = %v, %v; want nothing, no error", recent, err)
+	}
+	if section := buildRecentSessionsSection(); section != "" {
+		t.Errorf("missing history rendered %q", section)
+	}
+
+	for i := 1; i <= 5; i++ {
+		if err := appendSessionSummary(path, SessionSummary{SessionID: fmt.Sprintf("s%d", i)}, 3); err != nil {
+			t.Fatal(err)
+		}
+	}
+	history, err := loadSessionHistory(path)
+	if err != nil || len(history) != 3 || history[0].SessionID != "s3" || history[2].SessionID != "s5" {
+		t.Fatalf("after cap = %+v, %v; want s3..s5", history, err)
+	}
+
+	// A torn line costs only itself
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.WriteString("{\"session_id\":\"torn\n")
+	file.Close()
+	if err := appendSessionSummary(path, SessionSummary{SessionID: "s6", Focus: "Level-split log files"}, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	recent, err := GetRecentSessions(2)
+	if err != nil || len(recent) != 2 || recent[0].SessionID != "s6" || recent[1].SessionID != "s5" {
+		t.Fatalf("recent = %+v, %v; want s6, s5", recent, err)
+	}
+
+	section := buildRecentSessionsSection()
+	if !strings.Contains(section, "Last session: ended normally") || !strings.Contains(section, "Focus: Level-split log files") || !strings.Contains(section, "Before that:") {
+		t.Errorf("section =\n%s", section)
+	}
+
+	if entries, _ := os.ReadDir(filepath.Dir(path)); len(entries) != 1 {
+		t.Errorf("history directory holds %d entries, want only the history file (temp files left behind?)", len(entries))
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,266 @@
+// METADATA
+//
+// Hook Output Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Let your communication be, Yea, yea; Nay, nay" - Matthew 5:37 (KJV)
+// Principle: Answer Claude Code plainly, in the shape it reads
+// Anchor: "A word fitly spoken is like apples of gold in pictures of silver." - Proverbs 25:11 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - structured hook responses)
+// Role: Builds and prints the JSON Claude Code parses from hook stdout
+// Paradigm: CPI-SI framework component - any hook can inject context or signal a decision
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial hook response builder
+//
+// Version History:
+//   1.0.0 (2026-10-16) - HookResponse builder, OutputPreCompactContext
+//
+// Purpose & Function
+//
+// Purpose: SessionStart was the only hook answering Claude Code with JSON.
+// HookResponse gives every hook the same builder: common fields (continue,
+// stopReason, suppressOutput, systemMessage), decisions (Stop, SubagentStop,
+// PostToolUse, UserPromptSubmit), permission decisions (PreToolUse), and
+// additionalContext under hookSpecificOutput.
+//
+// Core Design: NewHookResponse(event) → With...() chain → Emit(). Every field
+// is omitted until set, so a response carries only what the hook decided.
+// hookSpecificOutput appears once an event-specific field is set. Emit is the
+// hook's last stdout write - Claude Code parses the final line as JSON.
+//
+// Blocking Status
+//
+// Non-blocking: Emit returns marshal failures; hooks report them on stderr and
+// exit normally.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: encoding/json, fmt
+//   Internal: display.go (compactionPreservationRows, currentDisplayConfig),
+//             context.go (currentTemporalContext)
+//
+// Dependents (What Uses This):
+//   Libraries: context.go (OutputClaudeContext)
+//   Commands: session/cmd-pre-compact (OutputPreCompactContext)
+//
+// Health Scoring
+//
+// No scoring - callers log their own output failures.
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"encoding/json" // Hook response encoding
+	"fmt"           // stdout output and summary rows
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// HookResponse is the JSON a hook prints for Claude Code to act on
+//
+// Build with NewHookResponse; fields stay out of the JSON until set.
+type HookResponse struct {
+	Continue           *bool               `json:"continue,omitempty"`           // false = Claude stops after this hook
+	StopReason         string              `json:"stopReason,omitempty"`         // Shown to the user when Continue is false
+	SuppressOutput     bool                `json:"suppressOutput,omitempty"`     // Hide stdout from the transcript
+	SystemMessage      string              `json:"systemMessage,omitempty"`      // Warning shown to the user
+	Decision           string              `json:"decision,omitempty"`           // "block" (Stop, SubagentStop, PostToolUse, UserPromptSubmit)
+	Reason             string              `json:"reason,omitempty"`             // Why the decision was made (read by Claude)
+	HookSpecificOutput *HookSpecificOutput `json:"hookSpecificOutput,omitempty"` // Event-specific fields
+
+	event string // Hook event name, copied into hookSpecificOutput
+}
+
+// HookSpecificOutput contains the hook event name and event-specific fields
+type HookSpecificOutput struct {
+	HookEventName            string `json:"hookEventName"`
+	AdditionalContext        string `json:"additionalContext,omitempty"`        // Markdown added to Claude's context
+	PermissionDecision       string `json:"permissionDecision,omitempty"`       // "allow", "deny", "ask" (PreToolUse)
+	PermissionDecisionReason string `json:"permissionDecisionReason,omitempty"` // Why (PreToolUse)
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs)
+//   ├── NewHookResponse(event) → With...() builders → Emit()
+//   └── OutputPreCompactContext(compactType, count) → uses buildPreCompactContext, Emit
+//
+//   Helpers (Bottom Rungs)
+//   ├── specific() → creates hookSpecificOutput on first event-specific field
+//   └── buildPreCompactContext(compactType, count) → uses compactionPreservationRows
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Event-Specific Output
+// ────────────────────────────────────────────────────────────────
+
+// specific returns hookSpecificOutput, creating it for the response's event
+func (r *HookResponse) specific() *HookSpecificOutput {
+	if r.HookSpecificOutput == nil {
+		r.HookSpecificOutput = &HookSpecificOutput{HookEventName: r.event}
+	}
+	return r.HookSpecificOutput
+}
+
+// buildPreCompactContext renders the compaction-preservation rows as markdown
+func buildPreCompactContext(compactType string, compactionCount int) string {
+	cfg := currentDisplayConfig()
+	ctx, err := currentTemporalContext()
+	if err != nil {
+		ctx = nil // Rows degrade to the compaction count alone
+	}
+
+	section := "## Temporal State Preservation\n\n"
+	section += fmt.Sprintf("Context compacted (%s).\n\n", compactType)
+	for _, row := range compactionPreservationRows(cfg, ctx, compactionCount) {
+		section += fmt.Sprintf("**%s** %s\n", row.Label, row.Value)
+	}
+	return section
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// NewHookResponse starts a response for a hook event ("SessionStart", "PreCompact", "Stop", ...)
+//
+// Example:
+//   session.NewHookResponse("Stop").WithBlock("Tests are failing").Emit()
+func NewHookResponse(event string) *HookResponse {
+	return &HookResponse{event: event}
+}
+
+// WithAdditionalContext adds markdown to Claude's context (hookSpecificOutput)
+func (r *HookResponse) WithAdditionalContext(md string) *HookResponse {
+	r.specific().AdditionalContext = md
+	return r
+}
+
+// WithSuppressOutput hides the hook's stdout from the transcript
+func (r *HookResponse) WithSuppressOutput(suppress bool) *HookResponse {
+	r.SuppressOutput = suppress
+	return r
+}
+
+// WithSystemMessage shows a warning to the user
+func (r *HookResponse) WithSystemMessage(message string) *HookResponse {
+	r.SystemMessage = message
+	return r
+}
+
+// WithStop ends Claude's turn after this hook, showing reason to the user
+func (r *HookResponse) WithStop(reason string) *HookResponse {
+	stop := false
+	r.Continue = &stop
+	r.StopReason = reason
+	return r
+}
+
+// WithBlock blocks the event (Stop: keep working; PostToolUse: feed reason back to Claude)
+func (r *HookResponse) WithBlock(reason string) *HookResponse {
+	r.Decision = "block"
+	r.Reason = reason
+	return r
+}
+
+// WithPermissionDecision answers a PreToolUse permission check ("allow", "deny", "ask")
+func (r *HookResponse) WithPermissionDecision(decision, reason string) *HookResponse {
+	r.specific().PermissionDecision = decision
+	r.specific().PermissionDecisionReason = reason
+	return r
+}
+
+// Emit prints the response as one JSON line (the hook's last stdout write)
+//
+// Returns:
+//   error - JSON encoding failure, nil otherwise
+func (r *HookResponse) Emit() error {
+	jsonBytes, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("JSON encoding failed: %w", err)
+	}
+
+	fmt.Println(string(jsonBytes))
+	return nil
+}
+
+// OutputPreCompactContext carries the compaction-preservation summary through compaction
+//
+// What It Does:
+// Emits a PreCompact response whose additionalContext holds the same time,
+// session, activity, date, and count rows PrintPreCompactionMessage shows in
+// the terminal - so Claude keeps them instead of only the user seeing them.
+//
+// Parameters:
+//   compactType: "manual", "auto", or "unknown"
+//   compactionCount: This compaction's number in the session (< 1 = unknown, omitted)
+//
+// Returns:
+//   error - JSON encoding failure, nil otherwise
+//
+// Example usage:
+//
+//	session.PrintPreCompactionMessage(compactType, count)
+//	session.OutputPreCompactContext(compactType, count) // Last stdout write
+func OutputPreCompactContext(compactType string, compactionCount int) error {
+	return NewHookResponse("PreCompact").
+		WithAdditionalContext(buildPreCompactContext(compactType, compactionCount)).
+		Emit()
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Unset fields never appear in the JSON (SessionStart bytes unchanged)
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by session hooks
+//
+// Code Cleanup: None - stateless
+//
+// Modification Policy:
+//   ✅ Safe: New With...() builders for fields Claude Code adds to the hook schema
+//   ⚠️ Care: JSON names - Claude Code ignores fields it does not recognize
+//   ❌ Never: Printing anything after Emit - Claude Code parses the last line
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
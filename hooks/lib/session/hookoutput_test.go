@@ -0,0 +1,111 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Hook Output Tests
+//
+// Purpose: Prove the SessionStart response keeps its original JSON bytes,
+//          unset fields stay out of the JSON, and the PreCompact response
+//          carries the preservation rows as additionalContext.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+// captureStdout returns what fn prints to stdout
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	fn()
+	os.Stdout = stdout
+	w.Close()
+	out, _ := io.ReadAll(r)
+	return string(out)
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestHookResponseSessionStartBytes(t *testing.T) {
+	// The struct OutputClaudeContext marshaled before HookResponse existed
+	type legacySpecific struct {
+		HookEventName     string `json:"hookEventName"`
+		AdditionalContext string `json:"additionalContext"`
+	}
+	type legacyOutput struct {
+		HookSpecificOutput legacySpecific `json:"hookSpecificOutput"`
+	}
+
+	context := "# Session Context\n\n**Time:** \"now\" <& more>\n"
+	want, _ := json.Marshal(legacyOutput{legacySpecific{"SessionStart", context}})
+	got := captureStdout(t, func() {
+		if err := NewHookResponse("SessionStart").WithAdditionalContext(context).Emit(); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if got != string(want)+"\n" {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestHookResponseFields(t *testing.T) {
+	cases := []struct {
+		name     string
+		response *HookResponse
+		want     string
+	}{
+		{"empty", NewHookResponse("Stop"), `{}`},
+		{"block", NewHookResponse("Stop").WithBlock("tests failing"), `{"decision":"block","reason":"tests failing"}`},
+		{"stop", NewHookResponse("SubagentStop").WithStop("done").WithSuppressOutput(true),
+			`{"continue":false,"stopReason":"done","suppressOutput":true}`},
+		{"permission", NewHookResponse("PreToolUse").WithPermissionDecision("deny", "protected path").WithSystemMessage("blocked"),
+			`{"systemMessage":"blocked","hookSpecificOutput":{"hookEventName":"PreToolUse","permissionDecision":"deny","permissionDecisionReason":"protected path"}}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := json.Marshal(tc.response)
+			if err != nil || string(got) != tc.want {
+				t.Errorf("got %s (%v), want %s", got, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestOutputPreCompactContext(t *testing.T) {
+	out := captureStdout(t, func() {
+		if err := OutputPreCompactContext("auto", 3); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	var response HookResponse
+	if err := json.Unmarshal([]byte(out), &response); err != nil {
+		t.Fatalf("output is not one JSON response: %v\n%s", err, out)
+	}
+	if response.HookSpecificOutput == nil || response.HookSpecificOutput.HookEventName != "PreCompact" {
+		t.Fatalf("hookSpecificOutput = %+v", response.HookSpecificOutput)
+	}
+	labels := currentDisplayConfig().FieldLabels.Compaction
+	context := response.HookSpecificOutput.AdditionalContext
+	for _, want := range []string{"Context compacted (auto)", "**" + labels.Time + "**", "**" + labels.Compactions + "** 3 this session"} {
+		if !strings.Contains(context, want) {
+			t.Errorf("additionalContext missing %q:\n%s", want, context)
+		}
+	}
+}
@@ -86,9 +86,9 @@
 // Dependencies
 //
 // Dependencies (What This Needs):
-//   Standard Library: os, os/exec, encoding/json, path/filepath, strings
+//   Standard Library: os, os/exec, path/filepath, strings
 //   External: None
-//   Internal: system/lib/display (optional - for success/failure messages)
+//   Internal: system/lib/jsonc (config parsing), system/lib/display (optional - for success/failure messages)
 //   System Utilities: session-time, session-log (in ~/.claude/cpi-si/system/bin/)
 //   Config Files: ~/.claude/cpi-si/system/data/config/session/initialization.jsonc
 //
@@ -145,12 +145,16 @@ import (
 	// Foundation packages providing Go's built-in capabilities.
 
 	"context"       // Context for timeout control
-	"encoding/json" // JSON parsing for configuration file
 	"os"            // File operations and environment access (UserHomeDir)
 	"os/exec"       // Command execution for calling system utilities
 	"path/filepath" // Path construction and manipulation
 	"strings"       // String manipulation for path placeholder replacement
 	"time"          // Duration for timeout specification
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/jsonc" // Parse initialization.jsonc configuration file
 )
 
 // ────────────────────────────────────────────────────────────────
@@ -303,17 +307,15 @@ func init() {
 //   └── InitSessionLog() → uses resolvePath(), exec.Command()
 //
 //   Helpers (Bottom Rungs - Foundations)
-//   ├── loadInitializationConfig() → uses stripJSONCComments(), resolvePath()
+//   ├── loadInitializationConfig() → uses jsonc.Unmarshal, resolvePath()
 //   ├── resolvePath() → pure function
 //   └── replacePlaceholders() → pure function
 //
-// Note: Uses stripJSONCComments() from activity.go (package-level function)
-//
 // Baton Flow (Execution Paths):
 //
 //   init() entry point
 //     ↓
-//   loadConfig() → stripJSONCComments() → resolvePath()
+//   loadConfig() → jsonc.Unmarshal → resolvePath()
 //     ↓
 //   config loaded (or defaults used)
 //     ↓
@@ -332,8 +334,6 @@ func init() {
 // - 3 helpers (pure foundations: loadInitializationConfig, resolvePath, replacePlaceholders)
 // - 0 core operations (simple orchestration library)
 // - 2 public APIs (exported interface: InitSessionTime, InitSessionLog)
-//
-// Note: Also uses stripJSONCComments() from activity.go (package-level shared function)
 
 // ────────────────────────────────────────────────────────────────
 // Helpers/Utilities - Internal Support
@@ -347,8 +347,7 @@ func init() {
 //
 // What It Does:
 //   - Reads JSONC file from given path
-//   - Strips comments using stripJSONCComments() from activity.go
-//   - Parses JSON into InitializationConfig struct
+//   - Parses JSONC into InitializationConfig struct (jsonc.Unmarshal)
 //   - Resolves path placeholders
 //
 // Parameters:
@@ -371,12 +370,9 @@ func loadInitializationConfig(path string) (*InitializationConfig, error) {
 		return nil, err
 	}
 
-	// Strip JSONC comments
-	cleaned := stripJSONCComments(string(data))
-
-	// Parse JSON
+	// Parse JSONC (comments stripped, trailing commas tolerated)
 	var cfg InitializationConfig
-	if err := json.Unmarshal([]byte(cleaned), &cfg); err != nil {
+	if err := jsonc.Unmarshal(data, &cfg); err != nil {
 		return nil, err
 	}
 
@@ -782,7 +778,7 @@ func InitSessionLog() {
 // Problem: JSONC comments not stripped correctly
 //   Check: Look for URLs (https://) being corrupted
 //   Check: Examine trailing comments (// at end of lines)
-//   Solution: Review stripJSONCComments() logic for string boundary detection
+//   Solution: Add the failing input to system/lib/jsonc's test corpus and fix the tokenizer there
 //   Note: Should preserve strings containing // while removing actual comments
 //
 // ────────────────────────────────────────────────────────────────
@@ -791,7 +787,7 @@ func InitSessionLog() {
 // For Related Components section explanation, see: standards/code/4-block/sections/CWS-SECTION-019-CLOSING-related-components.md
 //
 // See METADATA "Dependencies" section above for complete dependency information:
-// - Dependencies (What This Needs): Standard Library (encoding/json, os, os/exec, path/filepath, strings)
+// - Dependencies (What This Needs): Standard Library (os, os/exec, path/filepath, strings), system/lib/jsonc
 // - Dependents (What Uses This): session/cmd-start/start.go (primary consumer)
 // - Integration Points: Rails pattern for configuration, Ladder pattern for orchestration
 //
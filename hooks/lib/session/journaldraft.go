@@ -0,0 +1,464 @@
+// METADATA
+//
+// Journal Draft Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Then they that feared the Lord spake often one to another: and the Lord hearkened, and heard it, and a book of remembrance was written" - Malachi 3:16 (KJV)
+// Principle: Write it down while it is still fresh
+// Anchor: "Write the vision, and make it plain upon tables" - Habakkuk 2:2 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - session end journal draft)
+// Role: Assembles what the session already knows into a journal draft to reflect on
+// Paradigm: CPI-SI framework component - session end writes it, reminders track it
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.1
+// Last Modified: 2026-10-16 - Commit subjects through system/lib/git (timeout, logged failures)
+//
+// Version History:
+//   1.0.1 (2026-10-16) - draftCommits uses git.GetCommitSubjects: bounded by CommandTimeout, failures logged
+//   1.0.0 (2026-10-16) - DraftSessionJournal, unreviewed draft reminders
+//
+// Purpose & Function
+//
+// Purpose: At session end there is usually something worth a few lines, and
+// most of the facts are already recorded. DraftSessionJournal writes them as a
+// markdown draft in system_paths.journals, leaving only the reflection to add:
+//
+//   front matter   title, date, summary (read back by journals.go)
+//   header         date, start-end time, duration, session ID
+//   Work           branch, commits made (subjects), files changed
+//   Quality        tasks completed, breakthroughs, struggles (current.json)
+//   Subagents      runs per type with failures (the session's activity stream)
+//   Reflections    empty - the part only a person can write
+//
+// Core Design: The file is YYYY-MM-DD-session-<shortid>-draft.md (shortid =
+// first four characters of the session ID) and is never overwritten - a draft
+// already there may hold reflections. Sections with nothing to say are left
+// out. The "-draft" suffix marks it unreviewed: renaming it (or editing it)
+// is the review. collectJournalDraftReminders lists drafts untouched for
+// reminders.journal_drafts.stale_days as "Unreviewed journal drafts".
+//
+// Drafts are created only when behavior.session_display.journal_draft is on
+// (formatting.jsonc, off by default).
+//
+// Blocking Status
+//
+// Non-blocking: Errors are returned for the end hook to warn about; missing
+// sources (no git, no activity stream) just leave their section out.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bufio, context, encoding/json, errors, fmt, io/fs, os, path/filepath,
+//                     sort, strings, time
+//   Internal: system/lib/git (GetHead, GetCommitSubjects), system/lib/instance (system_paths.journals),
+//             stats.go (CollectSessionStats), patterns.go (shortDuration), repos.go (sessionWorkspace),
+//             payload.go (HookInput - end reason), display.go (journal_draft toggle),
+//             statereminders.go (Reminder), reminders.go (journal_drafts settings)
+//
+// Dependents (What Uses This):
+//   Commands: session/cmd-end (DraftSessionJournal, PrintJournalDraft)
+//   Libraries: statereminders.go (collectJournalDraftReminders)
+//
+// Health Scoring
+//
+// Draft written: +5 (logged success). Write failed: -5 (logged failure, error returned).
+package session
+
+// ============================================================================
+// END METADATA
+// ============================================================================
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	//--- Standard Library ---
+
+	"bufio"         // Activity stream lines
+	"context"       // Reminder collector signature
+	"encoding/json" // Activity stream events
+	"errors"        // Existing draft detection, git error classes
+	"fmt"           // Markdown assembly
+	"io/fs"         // fs.ErrNotExist
+	"os"            // Draft write, journal directory listing
+	"path/filepath" // Journal paths
+	"sort"          // Subagent type order
+	"strings"       // Markdown assembly
+	"time"          // Dates, durations, draft age
+
+	//--- Internal Packages ---
+
+	"system/lib/git"      // Branch and commit subjects at session end
+	"system/lib/instance" // system_paths.journals
+)
+
+const (
+	// journalDraftSuffix marks a draft nobody has reviewed yet
+	journalDraftSuffix = "-draft.md"
+
+	// activityStreamDir holds one <session-id>.jsonl per session (hooks/lib/activity)
+	activityStreamDir = "~/.claude/cpi-si/system/data/session/activity"
+
+	// journalDraftCommitsShown caps commit subjects listed in a draft
+	journalDraftCommitsShown = 20
+)
+
+// journalDraft is everything a draft is rendered from
+type journalDraft struct {
+	Stats     SessionStats
+	Branch    string            // "" when unknown or detached
+	Commits   []string          // Subjects, oldest first
+	Subagents []subagentOutcome // By type, most runs first
+}
+
+// subagentOutcome counts one subagent type's runs this session
+type subagentOutcome struct {
+	Type   string
+	Runs   int
+	Failed int
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs)
+//   └── DraftSessionJournal() → CollectSessionStats, draftCommits, readSubagentOutcomes,
+//                               renderJournalDraft, writeJournalDraft
+//
+//   Collector (statereminders.go)
+//   └── collectJournalDraftReminders → staleJournalDrafts
+//
+//   Helpers (Bottom Rungs)
+//   ├── journalDraftName(stats) → YYYY-MM-DD-session-<shortid>-draft.md
+//   ├── draftCommits(workspace, since) → git.GetCommitSubjects, logGitFailure (context.go)
+//   ├── readSubagentOutcomes(path) → SubagentStop events per type
+//   ├── renderJournalDraft(draft) → markdown
+//   ├── writeJournalDraft(dir, name, content) → create, never overwrite
+//   └── staleJournalDrafts(dir, age, now) → untouched drafts, oldest first
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Sources
+// ────────────────────────────────────────────────────────────────
+
+// journalDraftName names the draft for a session (start date, else end date)
+func journalDraftName(stats SessionStats) string {
+	date := stats.StartTime
+	if date.IsZero() {
+		date = stats.EndTime
+	}
+	shortID := strings.ReplaceAll(stats.SessionID, "-", "")
+	if len(shortID) > 4 {
+		shortID = shortID[:4]
+	}
+	if shortID == "" {
+		shortID = "unknown"
+	}
+	return fmt.Sprintf("%s-session-%s%s", date.Format(journalDateLayout), shortID, journalDraftSuffix)
+}
+
+// draftCommits returns subjects of commits made since the session started, oldest first
+//
+// A workspace outside git leaves the section out quietly; a failing or
+// timed-out git (git.CommandTimeout) is logged and leaves it out too.
+func draftCommits(workspace string, since time.Time) []string {
+	if workspace == "" || since.IsZero() {
+		return nil
+	}
+	subjects, err := git.GetCommitSubjects(workspace, since)
+	if err != nil && !errors.Is(err, git.ErrNotRepository) {
+		logGitFailure(displayLogger, workspace, "commit-subjects", err)
+	}
+	return subjects
+}
+
+// readSubagentOutcomes counts SubagentStop events in an activity stream by type
+//
+// Unreadable streams and malformed lines are skipped (nil when nothing counted).
+func readSubagentOutcomes(path string) []subagentOutcome {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	byType := map[string]*subagentOutcome{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), transcriptMaxLine)
+	for scanner.Scan() {
+		var event struct {
+			EventType  string         `json:"event_type"`
+			Context    string         `json:"context"`
+			Extensions map[string]any `json:"extensions"`
+		}
+		if json.Unmarshal(scanner.Bytes(), &event) != nil || event.EventType != "SubagentStop" {
+			continue
+		}
+		agentType := event.Context
+		if agentType == "" {
+			agentType = "unknown"
+		}
+		outcome := byType[agentType]
+		if outcome == nil {
+			outcome = &subagentOutcome{Type: agentType}
+			byType[agentType] = outcome
+		}
+		outcome.Runs++
+		if event.Extensions["result"] == "failure" {
+			outcome.Failed++
+		}
+	}
+
+	outcomes := make([]subagentOutcome, 0, len(byType))
+	for _, outcome := range byType {
+		outcomes = append(outcomes, *outcome)
+	}
+	sort.Slice(outcomes, func(i, j int) bool {
+		if outcomes[i].Runs != outcomes[j].Runs {
+			return outcomes[i].Runs > outcomes[j].Runs
+		}
+		return outcomes[i].Type < outcomes[j].Type
+	})
+	if len(outcomes) == 0 {
+		return nil
+	}
+	return outcomes
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Rendering and Writing
+// ────────────────────────────────────────────────────────────────
+
+// renderJournalDraft writes the draft's markdown (sections with nothing to say left out)
+func renderJournalDraft(draft journalDraft) string {
+	stats := draft.Stats
+	date := stats.StartTime
+	if date.IsZero() {
+		date = stats.EndTime
+	}
+
+	var b strings.Builder
+	title := "Session " + date.Format(journalDateLayout)
+	summary := fmt.Sprintf("%d commit(s)", len(draft.Commits))
+	if stats.Quality != nil {
+		summary += fmt.Sprintf(", %d task(s) completed", stats.Quality.TasksCompleted)
+	}
+	fmt.Fprintf(&b, "---\ntitle: %s (draft)\ndate: %s\nsummary: %s\n---\n\n", title, date.Format(journalDateLayout), summary)
+	fmt.Fprintf(&b, "# %s\n\n", title)
+
+	when := date.Format("Mon Jan 02, 2006")
+	if !stats.StartTime.IsZero() && !stats.EndTime.IsZero() {
+		when += fmt.Sprintf(", %s-%s (%s)", stats.StartTime.Format("15:04"), stats.EndTime.Format("15:04"),
+			shortDuration(stats.EndTime.Sub(stats.StartTime)))
+	}
+	fmt.Fprintf(&b, "**When:** %s\n", when)
+	if stats.SessionID != "" {
+		fmt.Fprintf(&b, "**Session:** %s\n", stats.SessionID)
+	}
+	if stats.Reason != "" {
+		fmt.Fprintf(&b, "**Ended:** %s\n", stats.Reason)
+	}
+
+	if draft.Branch != "" || len(draft.Commits) > 0 || stats.Git != nil {
+		b.WriteString("\n## Work\n\n")
+		if draft.Branch != "" {
+			fmt.Fprintf(&b, "- Branch: %s\n", draft.Branch)
+		}
+		if len(draft.Commits) > 0 {
+			fmt.Fprintf(&b, "- Commits (%d):\n", len(draft.Commits))
+			for i, subject := range draft.Commits {
+				if i == journalDraftCommitsShown {
+					fmt.Fprintf(&b, "  - ...and %d more\n", len(draft.Commits)-i)
+					break
+				}
+				fmt.Fprintf(&b, "  - %s\n", subject)
+			}
+		}
+		if stats.Git != nil {
+			fmt.Fprintf(&b, "- Files changed: %d (%d uncommitted)\n", stats.Git.FilesChanged, stats.Git.Uncommitted)
+		}
+	}
+
+	if q := stats.Quality; q != nil {
+		b.WriteString("\n## Quality\n\n")
+		fmt.Fprintf(&b, "- Tasks completed: %d\n- Breakthroughs: %d\n- Struggles: %d\n", q.TasksCompleted, q.Breakthroughs, q.Struggles)
+		if q.Compactions > 0 {
+			fmt.Fprintf(&b, "- Compactions: %d\n", q.Compactions)
+		}
+	}
+
+	if len(draft.Subagents) > 0 {
+		b.WriteString("\n## Subagents\n\n")
+		for _, outcome := range draft.Subagents {
+			line := fmt.Sprintf("- %s ×%d", outcome.Type, outcome.Runs)
+			if outcome.Failed > 0 {
+				line += fmt.Sprintf(" (%d failed)", outcome.Failed)
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+
+	b.WriteString("\n## Reflections:\n\n")
+	return b.String()
+}
+
+// writeJournalDraft creates dir/name with content unless it already exists
+//
+// Returns the path either way - an existing draft is left untouched.
+func writeJournalDraft(dir, name, content string) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create journals directory: %w", err)
+	}
+	path := filepath.Join(dir, name)
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if errors.Is(err, fs.ErrExist) {
+		return path, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to create journal draft: %w", err)
+	}
+	if _, err := file.WriteString(content); err != nil {
+		file.Close()
+		return "", fmt.Errorf("failed to write journal draft: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to write journal draft: %w", err)
+	}
+	return path, nil
+}
+
+// staleJournalDrafts lists drafts in dir not modified for age, oldest first
+func staleJournalDrafts(dir string, age time.Duration, now time.Time) []os.FileInfo {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var stale []os.FileInfo
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), journalDraftSuffix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err == nil && now.Sub(info.ModTime()) >= age {
+			stale = append(stale, info)
+		}
+	}
+	sort.Slice(stale, func(i, j int) bool { return stale[i].ModTime().Before(stale[j].ModTime()) })
+	return stale
+}
+
+// collectJournalDraftReminders lists drafts untouched for reminders.journal_drafts.stale_days
+func collectJournalDraftReminders(ctx context.Context, workspace string) []Reminder {
+	cfg := stateReminderSettings().Reminders.JournalDrafts
+	dir := instance.GetConfig().SystemPaths.Journals
+	if !cfg.Enabled || dir == "" {
+		return nil
+	}
+	days := cfg.StaleDays
+	if days <= 0 {
+		days = defaultJournalDraftStaleDays
+	}
+
+	current := now()
+	var reminders []Reminder
+	for _, info := range staleJournalDrafts(expandPath(dir), time.Duration(days)*24*time.Hour, current) {
+		reminders = append(reminders, Reminder{
+			Category: ReminderJournal,
+			Severity: SeverityInfo,
+			Message:  fmt.Sprintf("%s (%dd untouched)", info.Name(), int(current.Sub(info.ModTime()).Hours()/24)),
+		})
+	}
+	return reminders
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// DraftSessionJournal writes a journal draft for the ending session
+//
+// What It Does:
+//   - Nothing ("", nil) unless behavior.session_display.journal_draft is on
+//   - Collects session stats (quality, git), the branch, commit subjects, and
+//     subagent runs from the activity stream
+//   - Writes YYYY-MM-DD-session-<shortid>-draft.md in system_paths.journals,
+//     leaving an existing draft untouched
+//
+// Returns:
+//   - string: The draft's path ("" when drafts are off)
+//   - error: No journals directory configured, or the write failed
+//
+// Example:
+//   if path, err := session.DraftSessionJournal(); err == nil && path != "" {
+//       session.PrintJournalDraft(path)
+//   }
+func DraftSessionJournal() (string, error) {
+	if !currentDisplayConfig().Behavior.SessionDisplay.JournalDraft {
+		return "", nil
+	}
+	dir := instance.GetConfig().SystemPaths.Journals
+	if dir == "" {
+		return "", fmt.Errorf("no journals directory configured (system_paths.journals)")
+	}
+
+	workspace := sessionWorkspace()
+	draft := journalDraft{Stats: CollectSessionStats(workspace, HookInput().Reason)}
+	if workspace != "" {
+		if head, err := git.GetHead(workspace); err == nil && !head.Detached {
+			draft.Branch = head.Branch
+		}
+	}
+	draft.Commits = draftCommits(workspace, draft.Stats.StartTime)
+	if draft.Stats.SessionID != "" {
+		draft.Subagents = readSubagentOutcomes(filepath.Join(expandPath(activityStreamDir), draft.Stats.SessionID+".jsonl"))
+	}
+
+	path, err := writeJournalDraft(expandPath(dir), journalDraftName(draft.Stats), renderJournalDraft(draft))
+	if err != nil {
+		displayLogger.Failure("journal-draft", err.Error(), -5, nil)
+		return "", err
+	}
+	displayLogger.Success("journal-draft", 5, map[string]interface{}{"file": path})
+	return path, nil
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation: go test ./... (journaldraft_test.go - rendering, never
+// overwriting, subagent counts, stale draft reminders)
+//
+// Code Execution: None (library) - the end hook calls DraftSessionJournal
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
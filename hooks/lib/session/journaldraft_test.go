@@ -0,0 +1,141 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Journal Draft Tests
+//
+// Purpose: Prove the draft's name and sections (empty sources left out,
+//          Reflections always last), that an existing draft is never
+//          overwritten, that subagent runs are counted from the activity
+//          stream, and that only drafts untouched long enough are stale.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestRenderJournalDraft(t *testing.T) {
+	start := time.Date(2025, 11, 18, 9, 0, 0, 0, time.UTC)
+	draft := journalDraft{
+		Stats: SessionStats{
+			SessionID: "a1b2c3d4-0000-4000-8000-000000000000",
+			StartTime: start,
+			EndTime:   start.Add(2*time.Hour + 30*time.Minute),
+			Quality:   &QualityStats{TasksCompleted: 4, Breakthroughs: 1, Struggles: 2},
+		},
+		Branch:    "main",
+		Commits:   []string{"Add drafts", "Fix drafts"},
+		Subagents: []subagentOutcome{{Type: "research", Runs: 3, Failed: 1}},
+	}
+
+	if got := journalDraftName(draft.Stats); got != "2025-11-18-session-a1b2-draft.md" {
+		t.Errorf("name = %q", got)
+	}
+
+	text := renderJournalDraft(draft)
+	for _, want := range []string{
+		"date: 2025-11-18", "09:00-11:30 (2h30m)", "- Branch: main", "- Commits (2):\n  - Add drafts\n  - Fix drafts",
+		"- Tasks completed: 4", "- Breakthroughs: 1", "- Struggles: 2", "- research ×3 (1 failed)",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("draft missing %q:\n%s", want, text)
+		}
+	}
+	if !strings.HasSuffix(text, "## Reflections:\n\n") {
+		t.Errorf("draft should end with an empty Reflections section:\n%s", text)
+	}
+
+	bare := renderJournalDraft(journalDraft{Stats: SessionStats{EndTime: start}})
+	for _, absent := range []string{"## Work", "## Quality", "## Subagents"} {
+		if strings.Contains(bare, absent) {
+			t.Errorf("empty source should leave out %q:\n%s", absent, bare)
+		}
+	}
+}
+
+func TestWriteJournalDraftKeepsExisting(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "journals")
+	path, err := writeJournalDraft(dir, "2025-11-18-session-a1b2-draft.md", "first")
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := writeJournalDraft(dir, "2025-11-18-session-a1b2-draft.md", "second")
+	if err != nil || again != path {
+		t.Fatalf("second write = %q %v, want %q", again, err, path)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "first" {
+		t.Errorf("existing draft overwritten: %q", data)
+	}
+}
+
+func TestReadSubagentOutcomes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	lines := strings.Join([]string{
+		`{"event_type": "SubagentStop", "context": "research", "extensions": {"result": "success"}}`,
+		`{"event_type": "SubagentStop", "context": "research", "extensions": {"result": "failure"}}`,
+		`{"event_type": "SubagentStop", "context": "review", "extensions": {"result": "success"}}`,
+		`{"event_type": "PostToolUse", "context": "Bash"}`,
+		`not json`,
+	}, "\n")
+	if err := os.WriteFile(path, []byte(lines), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got := readSubagentOutcomes(path)
+	want := []subagentOutcome{{Type: "research", Runs: 2, Failed: 1}, {Type: "review", Runs: 1}}
+	if len(got) != len(want) {
+		t.Fatalf("outcomes = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("outcome %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if readSubagentOutcomes(filepath.Join(t.TempDir(), "missing.jsonl")) != nil {
+		t.Error("missing stream should give nil")
+	}
+}
+
+func TestStaleJournalDrafts(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2025, 11, 18, 12, 0, 0, 0, time.UTC)
+	touch := func(name string, age time.Duration) {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte("x"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, now.Add(-age), now.Add(-age)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	touch("2025-11-10-session-aaaa-draft.md", 8*24*time.Hour)
+	touch("2025-11-14-session-bbbb-draft.md", 4*24*time.Hour)
+	touch("2025-11-17-session-cccc-draft.md", 24*time.Hour)
+	touch("2025-11-09-session-dddd.md", 9*24*time.Hour) // Reviewed - suffix dropped
+
+	stale := staleJournalDrafts(dir, 3*24*time.Hour, now)
+	var names []string
+	for _, info := range stale {
+		names = append(names, info.Name())
+	}
+	if got := strings.Join(names, ","); got != "2025-11-10-session-aaaa-draft.md,2025-11-14-session-bbbb-draft.md" {
+		t.Errorf("stale drafts = %s", got)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,456 @@
+// METADATA
+//
+// Journal Reflections Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "I will remember the deeds of the Lord; yes, I will remember your miracles of long ago." - Psalm 77:11 (NIV)
+// Principle: Recent reflection carried forward - what was learned yesterday grounds today
+// Anchor: "Write down the revelation and make it plain on tablets." - Habakkuk 2:2 (NIV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - journal summaries for session context)
+// Role: Reads the newest journal entries and summarizes them for grounding
+// Paradigm: CPI-SI framework component - feeds context.go ("journals" section)
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.1.0
+// Last Modified: 2026-10-16 - Journals gathered at session start reused
+//
+// Version History:
+//   1.1.0 (2026-10-16) - GetRecentJournals(0) returns what session start gathered (gather.go)
+//   1.0.0 (2026-10-16) - Newest-N journals, front-matter summary, heading outline for large files
+//
+// Purpose & Function
+//
+// Purpose: Bring the latest reflections (create-journal-entry output) into the
+// session-start context, and expose the same summaries to other hooks.
+//
+// Core Design: GetRecentJournals lists system_paths.journals, orders entries by
+// date (front-matter date, else YYYY-MM-DD filename prefix, else mtime), and
+// summarizes the newest N:
+//   - Title: front-matter title, else first "# " heading, else filename
+//   - Body: front-matter summary if present; otherwise the first lines of the
+//     body, or the heading outline when the file exceeds max_inline_bytes
+//
+// Blocking Status
+//
+// Non-blocking: Missing directory, no entries, or unreadable files mean fewer
+// (or no) reflections - the context section is silently skipped.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bufio, fmt, os, path/filepath, sort, strings, time
+//   Internal: system/lib/instance (system_paths.journals)
+//
+// Dependents (What Uses This):
+//   Libraries: context.go (buildJournalSection via the "journals" section)
+//   Commands: Any hook needing recent reflections (GetRecentJournals)
+//
+// Health Scoring
+//
+// Pure read path - no health tracking (missing journals are a normal state).
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"bufio"         // Line scanning for front matter and excerpts
+	"fmt"           // Section rendering
+	"os"            // Directory listing and file reads
+	"path/filepath" // Journal path joining
+	"sort"          // Newest-first ordering
+	"strings"       // Front matter and heading parsing
+	"time"          // Entry dates
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/instance" // system_paths.journals
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// defaultJournalCount is how many recent entries appear when unconfigured.
+	defaultJournalCount = 2
+
+	// defaultJournalExcerptLines bounds the inlined excerpt per entry.
+	defaultJournalExcerptLines = 10
+
+	// defaultJournalMaxInlineBytes is the size above which an entry is
+	// summarized by its headings instead of inlined.
+	defaultJournalMaxInlineBytes = 16 * 1024
+
+	// journalDateLayout matches create-journal-entry filenames and front matter.
+	journalDateLayout = "2006-01-02"
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// JournalsConfig tunes the recent reflections section (context.jsonc "journals")
+type JournalsConfig struct {
+	Count          int `json:"count"`            // Entries to include (0 = defaultJournalCount)
+	ExcerptLines   int `json:"excerpt_lines"`    // Body lines per entry (0 = defaultJournalExcerptLines)
+	MaxInlineBytes int `json:"max_inline_bytes"` // Larger files use heading outline (0 = default)
+}
+
+// JournalSummary is one recent journal entry, summarized
+type JournalSummary struct {
+	Path         string    `json:"path"`
+	Title        string    `json:"title"`
+	Date         time.Time `json:"date"`
+	Summary      string    `json:"summary,omitempty"` // Front-matter summary (preferred when present)
+	Excerpt      []string  `json:"excerpt,omitempty"` // First body lines, or headings if FromHeadings
+	FromHeadings bool      `json:"from_headings"`     // Excerpt is a heading outline (file over threshold)
+}
+
+// journalFile is a directory entry with the date used for ordering
+type journalFile struct {
+	path string
+	date time.Time
+}
+
+// journalsConfig holds journal settings (set from context.jsonc in context.go init)
+var journalsConfig JournalsConfig
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 1 function
+//   └── GetRecentJournals(n) → uses instance.GetConfig, recentJournals
+//
+//   Core Operations (Middle Rungs) - 3 functions
+//   ├── buildJournalSection() → uses GetRecentJournals (context.go "journals" section)
+//   ├── buildJournalSummary() → uses GetRecentJournals (titles only, for the context budget)
+//   └── recentJournals(dir, n, cfg) → uses listJournalFiles, readJournal
+//
+//   Helpers (Bottom Rungs) - 4 functions
+//   ├── listJournalFiles(dir) → uses filenameDate
+//   ├── readJournal(path, fallbackDate, cfg) → uses parseFrontMatterLine
+//   ├── filenameDate(name) → pure function
+//   └── parseFrontMatterLine(line) → pure function
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Listing and Parsing
+// ────────────────────────────────────────────────────────────────
+
+// filenameDate parses a YYYY-MM-DD filename prefix (zero time if absent)
+func filenameDate(name string) time.Time {
+	if len(name) < len(journalDateLayout) {
+		return time.Time{}
+	}
+	date, err := time.Parse(journalDateLayout, name[:len(journalDateLayout)])
+	if err != nil {
+		return time.Time{}
+	}
+	return date
+}
+
+// listJournalFiles returns markdown entries in dir, newest first
+func listJournalFiles(dir string) ([]journalFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []journalFile
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".md" {
+			continue
+		}
+		date := filenameDate(entry.Name())
+		if date.IsZero() {
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			date = info.ModTime()
+		}
+		files = append(files, journalFile{filepath.Join(dir, entry.Name()), date})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		if !files[i].date.Equal(files[j].date) {
+			return files[i].date.After(files[j].date)
+		}
+		return files[i].path > files[j].path // Same day: later slug first, deterministic
+	})
+	return files, nil
+}
+
+// parseFrontMatterLine splits `key: value` and strips surrounding quotes
+func parseFrontMatterLine(line string) (key, value string, ok bool) {
+	key, value, ok = strings.Cut(line, ":")
+	if !ok {
+		return "", "", false
+	}
+	value = strings.TrimSpace(value)
+	value = strings.Trim(value, `"'`)
+	return strings.TrimSpace(key), value, true
+}
+
+// readJournal summarizes one journal file
+//
+// What It Does:
+//   - Reads front matter (--- delimited) for title, date, summary
+//   - Title falls back to the first "# " heading, then the filename
+//   - Files over cfg.MaxInlineBytes: excerpt is the "#"-heading outline
+//   - Otherwise: excerpt is the first cfg.ExcerptLines non-blank body lines
+//     (the title heading itself is not repeated)
+func readJournal(path string, fallbackDate time.Time, cfg JournalsConfig) (JournalSummary, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return JournalSummary{}, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return JournalSummary{}, err
+	}
+	defer file.Close()
+
+	journal := JournalSummary{Path: path, Date: fallbackDate}
+	outline := int(info.Size()) > cfg.MaxInlineBytes
+	journal.FromHeadings = outline
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024) // Long paragraphs are single lines
+
+	inFrontMatter := false
+	for lineNum := 0; scanner.Scan(); lineNum++ {
+		line := strings.TrimRight(scanner.Text(), " \t")
+
+		// Front matter: only when the file opens with ---
+		if lineNum == 0 && line == "---" {
+			inFrontMatter = true
+			continue
+		}
+		if inFrontMatter {
+			if line == "---" {
+				inFrontMatter = false
+				continue
+			}
+			if key, value, ok := parseFrontMatterLine(line); ok {
+				switch key {
+				case "title":
+					journal.Title = value
+				case "summary":
+					journal.Summary = value
+				case "date":
+					if date, err := time.Parse(journalDateLayout, value); err == nil {
+						journal.Date = date
+					}
+				}
+			}
+			continue
+		}
+
+		// Title from the first H1 when front matter had none
+		if heading, ok := strings.CutPrefix(line, "# "); ok && journal.Title == "" {
+			journal.Title = strings.TrimSpace(heading)
+			continue
+		}
+
+		if outline {
+			if strings.HasPrefix(line, "#") {
+				journal.Excerpt = append(journal.Excerpt, strings.TrimSpace(strings.TrimLeft(line, "#")))
+			}
+			continue
+		}
+		if journal.Summary != "" || len(journal.Excerpt) >= cfg.ExcerptLines {
+			break // Summary wins, or excerpt is full - no need to read further
+		}
+		if strings.TrimSpace(line) != "" && line != "---" {
+			journal.Excerpt = append(journal.Excerpt, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return JournalSummary{}, err
+	}
+
+	if journal.Title == "" {
+		journal.Title = strings.TrimSuffix(filepath.Base(path), ".md")
+	}
+	if journal.Summary != "" && !outline {
+		journal.Excerpt = nil
+	}
+	return journal, nil
+}
+
+// recentJournals summarizes the newest n entries in dir (unreadable entries skipped)
+func recentJournals(dir string, n int, cfg JournalsConfig) ([]JournalSummary, error) {
+	files, err := listJournalFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var journals []JournalSummary
+	for _, file := range files {
+		if len(journals) >= n {
+			break
+		}
+		journal, err := readJournal(file.path, file.date, cfg)
+		if err != nil {
+			continue
+		}
+		journals = append(journals, journal)
+	}
+	return journals, nil
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// GetRecentJournals returns summaries of the newest n journal entries
+//
+// What It Does:
+//   - Reads system_paths.journals from the instance config
+//   - n <= 0 uses the configured count (context.jsonc journals.count, default 2) -
+//     the count session start gathers, so those calls reuse the gathered
+//     journals (ErrSourceSkipped when that source missed the start deadline)
+//
+// Returns:
+//   - Summaries, newest first (may be fewer than n)
+//   - Error if no journals directory is configured or it can't be read
+//
+// Example:
+//   journals, err := session.GetRecentJournals(3)
+//   for _, j := range journals { fmt.Println(j.Date.Format("2006-01-02"), j.Title) }
+func GetRecentJournals(n int) ([]JournalSummary, error) {
+	if n <= 0 {
+		if journals, ok, err := gatheredJournals(); ok {
+			return journals, err
+		}
+	}
+	return readRecentJournals(n)
+}
+
+// readRecentJournals reads the newest n journals from disk (n <= 0 = configured count)
+func readRecentJournals(n int) ([]JournalSummary, error) {
+	dir := instance.GetConfig().SystemPaths.Journals
+	if dir == "" {
+		return nil, fmt.Errorf("no journals directory configured (system_paths.journals)")
+	}
+
+	cfg := journalsConfig
+	if n <= 0 {
+		n = cfg.Count
+	}
+	if n <= 0 {
+		n = defaultJournalCount
+	}
+	if cfg.ExcerptLines <= 0 {
+		cfg.ExcerptLines = defaultJournalExcerptLines
+	}
+	if cfg.MaxInlineBytes <= 0 {
+		cfg.MaxInlineBytes = defaultJournalMaxInlineBytes
+	}
+
+	return recentJournals(expandPath(dir), n, cfg)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Context Section
+// ────────────────────────────────────────────────────────────────
+
+// buildJournalSection renders recent reflections for session context
+//
+// Each entry: bold title with date, then the front-matter summary, the
+// excerpt as a block quote, or the heading outline as a list. Missing
+// directory or no entries → "" (section skipped like the other builders).
+func buildJournalSection() string {
+	journals, err := GetRecentJournals(0)
+	if err != nil || len(journals) == 0 {
+		return ""
+	}
+
+	section := "## Recent Reflections\n\n"
+	for _, journal := range journals {
+		section += fmt.Sprintf("**%s** (%s)\n", journal.Title, journal.Date.Format(journalDateLayout))
+
+		switch {
+		case journal.Summary != "":
+			section += fmt.Sprintf("> %s\n", journal.Summary)
+		case journal.FromHeadings:
+			for _, heading := range journal.Excerpt {
+				section += fmt.Sprintf("- %s\n", heading)
+			}
+		default:
+			for _, line := range journal.Excerpt {
+				section += fmt.Sprintf("> %s\n", line)
+			}
+		}
+		section += "\n"
+	}
+
+	return section
+}
+
+// buildJournalSummary lists recent reflection titles only (context budget form)
+func buildJournalSummary() string {
+	journals, err := GetRecentJournals(0)
+	if err != nil || len(journals) == 0 {
+		return ""
+	}
+
+	section := "## Recent Reflections\n\n"
+	for _, journal := range journals {
+		section += fmt.Sprintf("- %s (%s)\n", journal.Title, journal.Date.Format(journalDateLayout))
+	}
+	return section + "\n"
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Ordering: filename date, then front-matter date for display; same day → later slug first
+//   - Large files: heading outline, never inlined
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by context.go and session hooks
+//
+// Code Cleanup: None - files opened for reading are closed per entry
+//
+// Modification Policy:
+//   ✅ Safe: New front-matter fields (add a case in readJournal)
+//   ⚠️ Care: JournalSummary JSON names (other hooks may persist them)
+//   ❌ Never: Failing session start because a journal is unreadable
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,70 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Journal Reflections Tests
+//
+// Purpose: Prove newest-first ordering, front-matter summary preference,
+//          excerpt bounds, and heading outlines for oversized entries.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestRecentJournals(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("2026-10-14_older.md", "# Older Entry\n\nShould not appear with n=2.\n")
+	write("2026-10-15_summary.md", "---\ntitle: \"Rest Is Obedience\"\ndate: 2026-10-15\nsummary: Stopping well is part of the work.\n---\n\n# Personal Reflection\n\nLong body.\n")
+	write("2026-10-16_excerpt.md", "# Today\n\n## What Happened\n\nline 1\nline 2\nline 3\n\n---\n")
+	write("notes.txt", "not a journal")
+
+	cfg := JournalsConfig{ExcerptLines: 3, MaxInlineBytes: 1024}
+	journals, err := recentJournals(dir, 2, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(journals) != 2 {
+		t.Fatalf("got %d journals, want 2", len(journals))
+	}
+
+	today, summary := journals[0], journals[1]
+	if today.Title != "Today" || strings.Join(today.Excerpt, "|") != "## What Happened|line 1|line 2" {
+		t.Errorf("excerpt entry = %q %q", today.Title, today.Excerpt)
+	}
+	if summary.Title != "Rest Is Obedience" || summary.Summary != "Stopping well is part of the work." || summary.Excerpt != nil {
+		t.Errorf("summary entry = %+v", summary)
+	}
+	if got := summary.Date.Format(journalDateLayout); got != "2026-10-15" {
+		t.Errorf("summary date = %s", got)
+	}
+
+	// Oversized entry: heading outline instead of inlined text
+	write("2026-10-17_long.md", "# Big Study\n\n## Part One\n"+strings.Repeat("text ", 300)+"\n### Detail\n## Part Two\n")
+	journals, _ = recentJournals(dir, 1, cfg)
+	if !journals[0].FromHeadings || strings.Join(journals[0].Excerpt, "|") != "Part One|Detail|Part Two" {
+		t.Errorf("outline entry = %+v", journals[0])
+	}
+
+	if _, err := recentJournals(filepath.Join(dir, "missing"), 2, cfg); err == nil {
+		t.Error("missing directory: want error")
+	}
+}
@@ -0,0 +1,368 @@
+// METADATA
+//
+// Session Journey Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "My times are in thy hand" - Psalm 31:15 (KJV)
+// Principle: A session moves through the day - morning work, a meal, the afternoon - and remembering it means remembering the sequence
+// Anchor: "To every thing there is a season, and a time to every purpose under the heaven" - Ecclesiastes 3:1 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - session phase ledger)
+// Role: Records phase transitions in the live session record and rebuilds them as a timeline at session end
+// Paradigm: CPI-SI framework component - start, compaction, and stop hooks append; end hook reads back
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial phase ledger and temporal journey timeline
+//
+// Version History:
+//   1.0.0 (2026-10-16) - PhasePoint ledger in current.json, GetTemporalJourney, timeline rows
+//
+// Purpose & Function
+//
+// Purpose: The end-of-session temporal journey only knew totals and the final
+// schedule context. A session that began in the morning work window, crossed
+// lunch, and finished in the afternoon block read as "afternoon". The journey
+// is kept as a ledger of transition points in current.json ("journey"), so
+// PrintEndTemporalJourney can show:
+//
+//   09:12–11:45 deep-work (morning) · 2h33m
+//   11:45–12:30 lunch downtime crossed · 45m
+//   12:30–14:07 deep-work (afternoon) · 1h37m
+//
+// Core Design: InitSession seeds the first point; RecordJourneyPoint (pre-compact
+// and stop hooks) appends one only when the time of day, circadian phase, or
+// schedule activity changed since the last point, so a long session costs a
+// handful of entries. Hooks only observe at events, so a transition is placed
+// at the first event that saw it. GetTemporalJourney adds a live observation
+// and folds the points into PhaseSegments ending now. The ledger travels with
+// the record into the archive, and the segments into the session history line.
+//
+// Blocking Status
+//
+// Non-blocking: Temporal state unavailable means no point is recorded; an
+// unreadable record means no journey (PrintEndTemporalJourney falls back to its
+// single-block form). Recording errors are returned for the hook to ignore.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: fmt, path/filepath, time
+//   Internal: system/lib/temporal (via currentTemporalContext)
+//   Package Files: lifecycle.go (UpdateSession, readSessionRecord, sessionDataDir),
+//                  context.go (SessionData, currentTemporalContext), patterns.go (shortDuration),
+//                  clock.go (now)
+//
+// Dependents (What Uses This):
+//   Libraries: display.go (PrintEndTemporalJourney), history.go (SummarizeSession)
+//   Commands: session/cmd-pre-compact, session/cmd-stop (RecordJourneyPoint)
+//
+// Health Scoring
+//
+// Untracked - the ledger rides on UpdateSession, which logs its own failures.
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"fmt"           // Timeline rows
+	"path/filepath" // current.json path
+	"time"          // Transition times and durations
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// Journey events - which hook recorded a point.
+	JourneyEventStart      = "start"
+	JourneyEventCompaction = "compaction"
+	JourneyEventStop       = "stop"
+
+	// maxJourneyPoints caps the ledger; the oldest points after the first go.
+	maxJourneyPoints = 100
+
+	// defaultJourneyMaxSegments is how many timeline rows the end hook shows
+	// when behavior.session_display.journey_max_segments is unset.
+	defaultJourneyMaxSegments = 8
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// PhasePoint is one observation in the journey ledger (current.json "journey")
+type PhasePoint struct {
+	At             time.Time `json:"at"`
+	Event          string    `json:"event"`                     // start, compaction, stop
+	TimeOfDay      string    `json:"time_of_day,omitempty"`     // morning, afternoon, evening, night
+	CircadianPhase string    `json:"circadian_phase,omitempty"` // peak, normal, low
+	Activity       string    `json:"activity,omitempty"`        // Schedule activity ("deep-work", "lunch")
+	ActivityType   string    `json:"activity_type,omitempty"`   // work, meal, sleep, ...
+	Downtime       bool      `json:"downtime,omitempty"`        // Schedule expected downtime
+}
+
+// PhaseSegment is one stretch of the session spent in a single phase
+type PhaseSegment struct {
+	Start          time.Time `json:"start"`
+	End            time.Time `json:"end"`
+	TimeOfDay      string    `json:"time_of_day,omitempty"`
+	CircadianPhase string    `json:"circadian_phase,omitempty"`
+	Activity       string    `json:"activity,omitempty"`
+	ActivityType   string    `json:"activity_type,omitempty"`
+	Downtime       bool      `json:"downtime,omitempty"`
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 2 functions
+//   ├── RecordJourneyPoint(event) → uses observePhase, UpdateSession, appendPhasePoint
+//   └── GetTemporalJourney() → uses readSessionRecord, observePhase, journeySegments
+//
+//   Core Operations (Middle Rungs) - 2 functions
+//   ├── journeySegments(points, end) → pure function
+//   └── journeyRows(segments, limit) → uses segmentText
+//
+//   Helpers (Bottom Rungs) - 5 functions
+//   ├── observePhase(event, at) → uses currentTemporalContext
+//   ├── samePhase(a, b) → pure function
+//   ├── appendPhasePoint(points, point) → uses samePhase
+//   ├── segmentText(segment) → uses shortDuration
+//   └── journeyMaxSegments() → display config with default
+//
+// Baton Flow:
+//   start hook → InitSession (first point) · pre-compact/stop hooks → RecordJourneyPoint
+//   end hook → PrintEndTemporalJourney, SummarizeSession → GetTemporalJourney
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Observation and Ledger
+// ────────────────────────────────────────────────────────────────
+
+// observePhase reads the current phase as a ledger point (false when temporal state is unavailable)
+func observePhase(event string, at time.Time) (PhasePoint, bool) {
+	ctx, err := currentTemporalContext()
+	if err != nil {
+		return PhasePoint{}, false
+	}
+	return PhasePoint{
+		At:             at,
+		Event:          event,
+		TimeOfDay:      ctx.ExternalTime.TimeOfDay,
+		CircadianPhase: ctx.ExternalTime.CircadianPhase,
+		Activity:       ctx.InternalSchedule.CurrentActivity,
+		ActivityType:   ctx.InternalSchedule.ActivityType,
+		Downtime:       ctx.InternalSchedule.ExpectedDowntime,
+	}, true
+}
+
+// samePhase reports whether two points describe the same phase (times and events aside)
+func samePhase(a, b PhasePoint) bool {
+	return a.TimeOfDay == b.TimeOfDay && a.CircadianPhase == b.CircadianPhase &&
+		a.Activity == b.Activity && a.ActivityType == b.ActivityType && a.Downtime == b.Downtime
+}
+
+// appendPhasePoint adds point when it starts a new phase, keeping the ledger under maxJourneyPoints
+//
+// The first point (session start) is always kept - it anchors the timeline.
+func appendPhasePoint(points []PhasePoint, point PhasePoint) []PhasePoint {
+	if len(points) > 0 && samePhase(points[len(points)-1], point) {
+		return points
+	}
+	points = append(points, point)
+	if excess := len(points) - maxJourneyPoints; excess > 0 {
+		points = append(points[:1], points[1+excess:]...)
+	}
+	return points
+}
+
+// segmentText renders one timeline row ("09:12–11:45 deep-work (morning) · 2h33m")
+func segmentText(segment PhaseSegment) string {
+	span := fmt.Sprintf("%s–%s", segment.Start.Format("15:04"), segment.End.Format("15:04"))
+
+	var phase string
+	switch {
+	case segment.Downtime && segment.Activity != "":
+		phase = segment.Activity + " downtime crossed"
+	case segment.Downtime:
+		phase = "downtime crossed"
+	case segment.Activity != "" && segment.TimeOfDay != "":
+		phase = fmt.Sprintf("%s (%s)", segment.Activity, segment.TimeOfDay)
+	case segment.Activity != "":
+		phase = segment.Activity
+	default:
+		phase = segment.TimeOfDay
+	}
+
+	length := "<1m"
+	if d := segment.End.Sub(segment.Start); d >= time.Minute {
+		length = shortDuration(d)
+	}
+	return fmt.Sprintf("%s %s · %s", span, phase, length)
+}
+
+// journeyMaxSegments returns how many timeline rows to show
+func journeyMaxSegments() int {
+	if limit := currentDisplayConfig().Behavior.SessionDisplay.JourneyMaxSegments; limit > 0 {
+		return limit
+	}
+	return defaultJourneyMaxSegments
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Segments and Rows
+// ────────────────────────────────────────────────────────────────
+
+// journeySegments folds ledger points into consecutive segments, the last ending at end
+//
+// Points repeating the previous phase extend it rather than start a new segment.
+func journeySegments(points []PhasePoint, end time.Time) []PhaseSegment {
+	var segments []PhaseSegment
+	var current PhasePoint // Point that opened the last segment
+	for i, point := range points {
+		if i > 0 && samePhase(current, point) {
+			continue
+		}
+		if n := len(segments); n > 0 {
+			segments[n-1].End = point.At
+		}
+		current = point
+		segments = append(segments, PhaseSegment{
+			Start:          point.At,
+			TimeOfDay:      point.TimeOfDay,
+			CircadianPhase: point.CircadianPhase,
+			Activity:       point.Activity,
+			ActivityType:   point.ActivityType,
+			Downtime:       point.Downtime,
+		})
+	}
+	if n := len(segments); n > 0 {
+		segments[n-1].End = end
+	}
+	return segments
+}
+
+// journeyRows renders segments as timeline rows, the latest limit of them
+//
+// Earlier segments beyond the limit collapse into one leading "… N earlier" row.
+func journeyRows(segments []PhaseSegment, limit int) []string {
+	rows := make([]string, 0, min(len(segments), limit)+1)
+	if hidden := len(segments) - limit; limit > 0 && hidden > 0 {
+		rows = append(rows, fmt.Sprintf("… %s earlier", plural(hidden, "phase")))
+		segments = segments[hidden:]
+	}
+	for _, segment := range segments {
+		rows = append(rows, segmentText(segment))
+	}
+	return rows
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// RecordJourneyPoint appends the current phase to the live session's journey ledger
+//
+// What It Does:
+//   - Observes time of day, circadian phase, and schedule activity now
+//   - Appends a point to current.json "journey" only when the phase changed
+//     since the last point (under the session lock)
+//   - Records nothing when temporal state is unavailable
+//
+// Example:
+//   session.RecordJourneyPoint(session.JourneyEventStop) // Error ignored - never blocks the hook
+func RecordJourneyPoint(event string) error {
+	point, ok := observePhase(event, now())
+	if !ok {
+		return nil
+	}
+	return UpdateSession(func(data *SessionData) {
+		data.Journey = appendPhasePoint(data.Journey, point)
+	})
+}
+
+// GetTemporalJourney rebuilds the live session's phase segments, the last ending now
+//
+// Returns:
+//   - Segments oldest first (none when the record has no ledger - a session
+//     started before ledgers existed, or by the session-time fallback)
+//   - Error only if current.json can't be read
+//
+// Example:
+//   segments, err := session.GetTemporalJourney()
+//   for _, segment := range segments {
+//       fmt.Println(segment.Activity, segment.End.Sub(segment.Start))
+//   }
+func GetTemporalJourney() ([]PhaseSegment, error) {
+	_, data, err := readSessionRecord(filepath.Join(sessionDataDir(), currentSessionFile))
+	if err != nil {
+		return nil, err
+	}
+	if len(data.Journey) == 0 {
+		return nil, nil
+	}
+
+	at := now()
+	points := data.Journey
+	if point, ok := observePhase(JourneyEventStop, at); ok { // Where the session is ending
+		points = appendPhasePoint(append([]PhasePoint(nil), points...), point)
+	}
+	return journeySegments(points, at), nil
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Ledger: unchanged phases append nothing, cap keeps the first point
+//   - Segments: repeated phases extend, last segment ends at the given time
+//   - Rows: "… N earlier phases" when over the limit
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by session hooks
+//
+// Code Cleanup: None (the ledger is archived with current.json)
+//
+// Modification Policy:
+//   ✅ Safe: More PhasePoint fields (omitempty), other row wording
+//   ⚠️ Care: PhasePoint/PhaseSegment JSON names (archived records and history lines are read back)
+//   ❌ Never: Failing a hook because the ledger could not be written
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,99 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Session Journey Tests
+//
+// Purpose: Prove the ledger records only phase changes and keeps its first
+//          point when capped, that points fold into segments ending at the
+//          session end, and that timeline rows read as they should - with
+//          downtime called out and earlier phases collapsed past the limit.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestAppendPhasePoint(t *testing.T) {
+	at := time.Date(2026, 10, 16, 9, 12, 0, 0, time.UTC)
+	morning := PhasePoint{At: at, Event: JourneyEventStart, TimeOfDay: "morning", Activity: "deep-work"}
+
+	points := appendPhasePoint(nil, morning)
+	repeat := morning
+	repeat.At, repeat.Event = at.Add(time.Hour), JourneyEventCompaction
+	if points = appendPhasePoint(points, repeat); len(points) != 1 {
+		t.Fatalf("same phase appended: %d points", len(points))
+	}
+
+	lunch := PhasePoint{At: at.Add(2 * time.Hour), Event: JourneyEventStop, TimeOfDay: "afternoon", Activity: "lunch", Downtime: true}
+	if points = appendPhasePoint(points, lunch); len(points) != 2 {
+		t.Fatalf("phase change not appended: %d points", len(points))
+	}
+
+	// Capped: the start point stays, the oldest after it go
+	for i := range maxJourneyPoints + 5 {
+		points = appendPhasePoint(points, PhasePoint{At: at.Add(time.Duration(i) * time.Minute), Activity: fmt.Sprint(i)})
+	}
+	if len(points) != maxJourneyPoints || points[0].Event != JourneyEventStart {
+		t.Errorf("capped ledger = %d points starting %+v", len(points), points[0])
+	}
+	if last := points[len(points)-1]; last.Activity != fmt.Sprint(maxJourneyPoints+4) {
+		t.Errorf("newest point dropped: last = %+v", last)
+	}
+}
+
+func TestJourneySegments(t *testing.T) {
+	at := time.Date(2026, 10, 16, 9, 12, 0, 0, time.UTC)
+	points := []PhasePoint{
+		{At: at, TimeOfDay: "morning", Activity: "deep-work"},
+		{At: at.Add(2*time.Hour + 33*time.Minute), TimeOfDay: "afternoon", Activity: "lunch", Downtime: true},
+		{At: at.Add(3*time.Hour + 18*time.Minute), TimeOfDay: "afternoon", Activity: "deep-work"},
+		{At: at.Add(4 * time.Hour), TimeOfDay: "afternoon", Activity: "deep-work"}, // Live observation, same phase
+	}
+	end := at.Add(4*time.Hour + 55*time.Minute)
+
+	segments := journeySegments(points, end)
+	if len(segments) != 3 || !segments[0].End.Equal(points[1].At) || !segments[2].End.Equal(end) {
+		t.Fatalf("segments = %+v", segments)
+	}
+
+	want := []string{
+		"09:12–11:45 deep-work (morning) · 2h33m",
+		"11:45–12:30 lunch downtime crossed · 45m",
+		"12:30–14:07 deep-work (afternoon) · 1h37m",
+	}
+	for i, row := range journeyRows(segments, 8) {
+		if row != want[i] {
+			t.Errorf("row %d = %q, want %q", i, row, want[i])
+		}
+	}
+
+	if rows := journeyRows(segments, 2); len(rows) != 3 || rows[0] != "… 1 phase earlier" || rows[1] != want[1] {
+		t.Errorf("limited rows = %q", rows)
+	}
+	if journeySegments(nil, end) != nil {
+		t.Error("no points should mean no segments")
+	}
+}
+
+func TestSegmentTextShort(t *testing.T) {
+	at := time.Date(2026, 10, 16, 22, 0, 0, 0, time.UTC)
+	if got := segmentText(PhaseSegment{Start: at, End: at.Add(20 * time.Second), TimeOfDay: "night"}); got != "22:00–22:00 night · <1m" {
+		t.Errorf("short segment = %q", got)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
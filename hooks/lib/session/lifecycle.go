@@ -0,0 +1,562 @@
+// METADATA
+//
+// Session Lifecycle Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "To every thing there is a season, and a time to every purpose under the heaven" - Ecclesiastes 3:1 (KJV)
+// Principle: A session has a beginning and an end - each kept distinct, none bleeding into the next
+// Anchor: "Let all things be done decently and in order." - 1 Corinthians 14:40 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - session record lifecycle)
+// Role: Creates, updates, and archives current.json so every hook sees the live session
+// Paradigm: CPI-SI framework component - start hook creates, mid-session hooks update, end hook archives
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.3.0
+// Last Modified: 2026-10-16 - Journey ledger seeded at session start
+//
+// Version History:
+//   1.3.0 (2026-10-16) - initSession records the first journey point (journey.go)
+//   1.2.0 (2026-10-16) - Session start/end times read the session clock (clock.go)
+//   1.1.0 (2026-10-16) - SeedSessionCorrelation: session-<id> becomes the parent context of the session's commands
+//   1.0.0 (2026-10-16) - InitSession/UpdateSession/EndSession, archive, lockfile, atomic writes
+//
+// Purpose & Function
+//
+// Purpose: current.json was only ever read here; a file left by a crashed
+// session made the next session show the old ID and start time. This module
+// owns the record's lifecycle:
+//   - InitSession: archive any existing record, write a fresh one (new UUID,
+//     temporal snapshot), and make it the record context.go reads
+//   - UpdateSession: locked read-modify-write for mid-session changes
+//   - EndSession: stamp end time and reason, move the record to the archive
+//   - SeedSessionCorrelation: hand session-<id> to every command the session
+//     runs as its parent log context (CPI_SI_PARENT_CONTEXT), via this
+//     process's environment and $CLAUDE_ENV_FILE
+//
+// Core Design: Every operation holds current.json.lock (exclusive create,
+// stale locks broken after sessionLockStaleAfter). Writes go to a temp file
+// in the same directory and are renamed into place. Fields SessionData doesn't
+// model (e.g. session-time's inherited_preferences) are carried through
+// updates untouched. Archive: <session data>/archive/<session-id>.json.
+//
+// Blocking Status
+//
+// Non-blocking for callers: every function returns an error for the hook to
+// log or ignore - nothing here exits or panics. Lock waits are bounded by
+// sessionLockTimeout.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: crypto/rand, encoding/json, errors, fmt, io/fs, os, path/filepath, sync, time
+//   Internal: system/lib/instance (system_paths.session_data), system/lib/logging,
+//             system/lib/temporal (circadian snapshot), context.go (SessionData,
+//             sessionData, user/instance configs), display.go (expandPath)
+//
+// Dependents (What Uses This):
+//   Commands: session/cmd-start (InitSession, SeedSessionCorrelation), session/cmd-end (EndSession)
+//   Libraries: state.go (IncrementCompactionCount via UpdateSession), context.go (reads the record),
+//              compaction.go (snapshots beside the record)
+//
+// Health Scoring
+//
+//   Session created/ended: +10 (logged success)
+//   Stale record archived at start: 0 (logged check - previous session never ended)
+//   Lock, read, or write failure: -10 (logged failure, error returned)
+//   Correlation seeded: +5; env file unwritable: -5; no record: 0 (logged check)
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"crypto/rand"   // Session UUIDs
+	"encoding/json" // Session record encoding
+	"errors"        // Lock contention and missing-file checks
+	"fmt"           // UUID formatting and error wrapping
+	"io/fs"         // fs.ErrExist / fs.ErrNotExist
+	"os"            // Lockfile, temp file, rename
+	"path/filepath" // Record, lock, and archive paths
+	"sync"          // In-process guard alongside the lockfile
+	"time"          // Timestamps and lock timing
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/instance" // system_paths.session_data
+	"system/lib/logging"  // Lifecycle success/failure records
+	"system/lib/temporal" // Circadian phase snapshot at start
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// sessionEnvFileVar names the script Claude Code sources before each Bash
+	// command (set for SessionStart hooks only).
+	sessionEnvFileVar = "CLAUDE_ENV_FILE"
+
+	// currentSessionFile is the live record in the session data directory.
+	currentSessionFile = "current.json"
+
+	// sessionArchiveDir holds finished and superseded records (<session-id>.json).
+	sessionArchiveDir = "archive"
+
+	// sessionLockTimeout bounds how long a hook waits for another to finish.
+	sessionLockTimeout = 2 * time.Second
+
+	// sessionLockStaleAfter breaks a lock left behind by a crashed hook.
+	sessionLockStaleAfter = 30 * time.Second
+
+	// sessionLockRetry is the wait between lock attempts.
+	sessionLockRetry = 20 * time.Millisecond
+
+	// sessionStartLayout matches start_formatted written by session-time.
+	sessionStartLayout = "Mon Jan 02, 2006 at 15:04:05"
+
+	// sessionEndedPhase marks a finalized record.
+	sessionEndedPhase = "ended"
+)
+
+// lifecycleLogger records session creation, archiving, and write failures
+var lifecycleLogger = logging.NewLogger("session-lifecycle")
+
+// sessionRecordMu serializes callers within one process (the lockfile covers
+// other processes) and guards the sessionData refresh.
+var sessionRecordMu sync.Mutex
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 4 functions
+//   ├── InitSession(workspace) → initSession(sessionDataDir(), workspace)
+//   ├── UpdateSession(mutator) → updateSession(sessionDataDir(), mutator)
+//   ├── EndSession(reason) → endSession(sessionDataDir(), reason)
+//   └── SeedSessionCorrelation() → seedSessionCorrelation(sessionDataDir(), $CLAUDE_ENV_FILE)
+//
+//   Core Operations (Middle Rungs) - 8 functions
+//   ├── seedSessionCorrelation(dir, envFile) → uses readSessionRecord
+//   ├── initSession(dir, workspace) → uses lockSession, archiveSessionRecord, newSessionID, writeSessionRecord
+//   ├── updateSession(dir, mutator) → uses lockSession, readSessionRecord, writeSessionRecord
+//   ├── endSession(dir, reason) → uses lockSession, readSessionRecord, archiveSessionRecord
+//   ├── lockSession(dir) → sessionRecordMu + exclusive lockfile with stale-lock recovery
+//   ├── readSessionRecord(path) → raw fields + SessionData
+//   ├── writeSessionRecord(path, raw, data) → temp file + rename
+//   └── archiveSessionRecord(dir, path, raw, data) → uses writeSessionRecord
+//
+//   Helpers (Bottom Rungs) - 3 functions
+//   ├── sessionDataDir() → instance system_paths.session_data
+//   ├── newSessionID() → crypto/rand UUID v4
+//   └── archiveName(data, path) → pure function (falls back to file mtime)
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Paths and Identifiers
+// ────────────────────────────────────────────────────────────────
+
+// sessionDataDir returns the directory holding current.json (same source as context.go)
+func sessionDataDir() string {
+	return expandPath(instance.GetConfig().SystemPaths.SessionData)
+}
+
+// newSessionID returns a random (version 4) UUID
+func newSessionID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = b[6]&0x0f | 0x40 // Version 4
+	b[8] = b[8]&0x3f | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
+
+// archiveName names a record in the archive (session ID, else "unknown-<mtime>")
+func archiveName(data *SessionData, path string) string {
+	if data != nil && data.SessionID != "" {
+		return filepath.Base(data.SessionID) + ".json" // Base: IDs never escape the archive
+	}
+	if info, err := os.Stat(path); err == nil {
+		return fmt.Sprintf("unknown-%d.json", info.ModTime().Unix())
+	}
+	return fmt.Sprintf("unknown-%d.json", now().Unix())
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Locking and Record I/O
+// ────────────────────────────────────────────────────────────────
+
+// lockSession takes the session lockfile, returning its release function
+//
+// Waits up to sessionLockTimeout; a lock older than sessionLockStaleAfter was
+// left by a crashed hook and is removed.
+func lockSession(dir string) (func(), error) {
+	sessionRecordMu.Lock()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		sessionRecordMu.Unlock()
+		return nil, err
+	}
+	lockPath := filepath.Join(dir, currentSessionFile+".lock")
+	deadline := time.Now().Add(sessionLockTimeout)
+
+	for {
+		file, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			fmt.Fprintf(file, "%d\n", os.Getpid())
+			file.Close()
+			return func() { os.Remove(lockPath); sessionRecordMu.Unlock() }, nil
+		}
+		if !errors.Is(err, fs.ErrExist) {
+			sessionRecordMu.Unlock()
+			return nil, err
+		}
+
+		if info, statErr := os.Stat(lockPath); statErr == nil && time.Since(info.ModTime()) > sessionLockStaleAfter {
+			os.Remove(lockPath)
+			continue
+		}
+		if time.Now().After(deadline) {
+			sessionRecordMu.Unlock()
+			return nil, fmt.Errorf("session record locked (%s)", lockPath)
+		}
+		time.Sleep(sessionLockRetry)
+	}
+}
+
+// readSessionRecord reads a record as raw fields (to preserve) and SessionData (to edit)
+func readSessionRecord(path string) (map[string]any, *SessionData, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	raw := map[string]any{}
+	if err := json.Unmarshal(bytes, &raw); err != nil {
+		return nil, nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	var data SessionData
+	if err := json.Unmarshal(bytes, &data); err != nil {
+		return nil, nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return raw, &data, nil
+}
+
+// writeSessionRecord writes data over raw's fields via temp file + rename
+func writeSessionRecord(path string, raw map[string]any, data *SessionData) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	fields := map[string]any{}
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return err
+	}
+	if raw == nil {
+		raw = map[string]any{}
+	}
+	for key, value := range fields {
+		raw[key] = value
+	}
+
+	output, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	temp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name()) // No-op after a successful rename
+
+	if _, err := temp.Write(append(output, '\n')); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), path)
+}
+
+// archiveSessionRecord moves a record into the archive directory
+func archiveSessionRecord(dir, path string, raw map[string]any, data *SessionData) (string, error) {
+	target := filepath.Join(dir, sessionArchiveDir, archiveName(data, path))
+	if err := writeSessionRecord(target, raw, data); err != nil {
+		return "", err
+	}
+	return target, os.Remove(path)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// InitSession starts a fresh session record
+//
+// What It Does:
+//   - Archives any existing current.json (a record that never ended is logged)
+//   - Writes a new record: UUID session ID, start time, circadian phase,
+//     workspace (current directory if empty), user and instance IDs
+//   - Makes it the record session context reads (no stale ID at start)
+//
+// Returns:
+//   - The new record, or an error (nothing written)
+//
+// Example:
+//   data, err := session.InitSession(os.Getenv("NOVA_DAWN_WORKSPACE"))
+//   if err != nil {
+//       session.InitSessionTime() // Fall back to the session-time utility
+//   }
+func InitSession(workspace string) (*SessionData, error) {
+	return initSession(sessionDataDir(), workspace)
+}
+
+// initSession is InitSession against a given session data directory
+func initSession(dir, workspace string) (*SessionData, error) {
+	path := filepath.Join(dir, currentSessionFile)
+
+	unlock, err := lockSession(dir)
+	if err != nil {
+		lifecycleLogger.Failure("session-init", err.Error(), -10, nil)
+		return nil, err
+	}
+	defer unlock()
+
+	// Archive what's there - a previous session that crashed or never ran its end hook
+	if raw, previous, err := readSessionRecord(path); err == nil {
+		if archived, err := archiveSessionRecord(dir, path, raw, previous); err != nil {
+			lifecycleLogger.Failure("session-archive", err.Error(), -10, map[string]any{"session_id": previous.SessionID})
+		} else if previous.SessionPhase != sessionEndedPhase {
+			lifecycleLogger.Check("previous-session-ended", false, 0, map[string]any{
+				"session_id": previous.SessionID,
+				"archived":   archived,
+			})
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		lifecycleLogger.Failure("session-archive", err.Error(), -10, nil)
+	}
+
+	id, err := newSessionID()
+	if err != nil {
+		lifecycleLogger.Failure("session-init", err.Error(), -10, nil)
+		return nil, err
+	}
+	if workspace == "" {
+		workspace, _ = os.Getwd()
+	}
+
+	started := now()
+	data := &SessionData{
+		SessionID:      id,
+		StartTime:      started.Format(time.RFC3339Nano),
+		StartFormatted: started.Format(sessionStartLayout),
+		SessionPhase:   "active",
+		WorkContext:    workspace,
+		CircadianPhase: temporal.GetExternalTime().TimeOfDay,
+	}
+	if point, ok := observePhase(JourneyEventStart, started); ok { // First point of the journey ledger
+		data.Journey = []PhasePoint{point}
+	}
+	if instanceConfig != nil {
+		data.InstanceID = instanceConfig.Identity.Username
+	}
+	if userConfig != nil {
+		data.UserID = userConfig.Identity.Username
+	}
+
+	// start_unix keeps the record readable by session-time (sessiontime.SessionState)
+	if err := writeSessionRecord(path, map[string]any{"start_unix": started.Unix()}, data); err != nil {
+		lifecycleLogger.Failure("session-init", err.Error(), -10, nil)
+		return nil, err
+	}
+
+	sessionData = data
+	configsLoaded.session = true
+	lifecycleLogger.Success("session-init", 10, map[string]any{"session_id": id, "workspace": workspace})
+	return data, nil
+}
+
+// UpdateSession applies mutator to current.json under the session lock
+//
+// Fields SessionData doesn't model are preserved. The in-process record used
+// by session context is refreshed too.
+//
+// Example:
+//   err := session.UpdateSession(func(s *session.SessionData) {
+//       s.QualityIndicators.TasksCompleted++
+//   })
+func UpdateSession(mutator func(*SessionData)) error {
+	return updateSession(sessionDataDir(), mutator)
+}
+
+// updateSession is UpdateSession against a given session data directory
+func updateSession(dir string, mutator func(*SessionData)) error {
+	path := filepath.Join(dir, currentSessionFile)
+
+	unlock, err := lockSession(dir)
+	if err != nil {
+		lifecycleLogger.Failure("session-update", err.Error(), -10, nil)
+		return err
+	}
+	defer unlock()
+
+	raw, data, err := readSessionRecord(path)
+	if err != nil {
+		lifecycleLogger.Failure("session-update", err.Error(), -10, nil)
+		return err
+	}
+	mutator(data)
+	if err := writeSessionRecord(path, raw, data); err != nil {
+		lifecycleLogger.Failure("session-update", err.Error(), -10, map[string]any{"session_id": data.SessionID})
+		return err
+	}
+
+	sessionData = data
+	return nil
+}
+
+// EndSession finalizes the record and moves it to the archive
+//
+// Stamps end time, reason, and the "ended" phase, then moves current.json to
+// archive/<session-id>.json. Call after everything else in the end hook that
+// reads the live record.
+//
+// Example:
+//   session.EndSession(os.Getenv("REASON"))
+func EndSession(reason string) error {
+	return endSession(sessionDataDir(), reason)
+}
+
+// endSession is EndSession against a given session data directory
+func endSession(dir, reason string) error {
+	path := filepath.Join(dir, currentSessionFile)
+
+	unlock, err := lockSession(dir)
+	if err != nil {
+		lifecycleLogger.Failure("session-end", err.Error(), -10, nil)
+		return err
+	}
+	defer unlock()
+
+	raw, data, err := readSessionRecord(path)
+	if err != nil {
+		lifecycleLogger.Failure("session-end", err.Error(), -10, nil)
+		return err
+	}
+	data.EndTime = now().Format(time.RFC3339Nano)
+	data.EndReason = reason
+	data.SessionPhase = sessionEndedPhase
+
+	archived, err := archiveSessionRecord(dir, path, raw, data)
+	if err != nil {
+		lifecycleLogger.Failure("session-end", err.Error(), -10, map[string]any{"session_id": data.SessionID})
+		return err
+	}
+
+	sessionData = data
+	lifecycleLogger.Success("session-end", 10, map[string]any{"session_id": data.SessionID, "archived": archived})
+	return nil
+}
+
+// SeedSessionCorrelation makes the live session the parent log context of its commands
+//
+// What It Does:
+//   - Derives the correlation ID from current.json (logging.SessionContextID)
+//   - Sets CPI_SI_PARENT_CONTEXT for this process and anything it starts
+//   - Appends an export to $CLAUDE_ENV_FILE so every Bash command in the
+//     session inherits it (skipped when the variable is unset)
+//
+// Every Logger created under it records the session as its parent, so
+// logging.TraceContext(logsDir, id) returns the session's whole call tree.
+//
+// Returns:
+//   - The correlation ID, or "" when there is no readable session record
+//
+// Example:
+//   session.InitSession(workspace)
+//   session.SeedSessionCorrelation()
+func SeedSessionCorrelation() string {
+	return seedSessionCorrelation(sessionDataDir(), os.Getenv(sessionEnvFileVar))
+}
+
+// seedSessionCorrelation is SeedSessionCorrelation against a given session data directory and env file
+func seedSessionCorrelation(dir, envFile string) string {
+	_, data, err := readSessionRecord(filepath.Join(dir, currentSessionFile))
+	if err != nil || data.SessionID == "" {
+		lifecycleLogger.Check("session-correlation", false, 0, map[string]any{"reason": "no session record"})
+		return ""
+	}
+
+	id := logging.SessionContextID(data.SessionID)
+	os.Setenv(logging.ParentContextEnv, id)
+
+	details := map[string]any{"correlation_id": id}
+	if envFile != "" {
+		file, err := os.OpenFile(envFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err == nil {
+			_, err = fmt.Fprintf(file, "export %s=%s\n", logging.ParentContextEnv, id)
+			file.Close()
+		}
+		if err != nil { // Hook-process children still inherit it
+			lifecycleLogger.Failure("session-correlation", err.Error(), -5, details)
+			return id
+		}
+		details["env_file"] = envFile
+	}
+	lifecycleLogger.Success("session-correlation", 5, details)
+	return id
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Start: previous record archived before the new one is written
+//   - Updates: unknown fields survive; concurrent callers serialize on the lockfile
+//   - End: current.json gone, archive/<session-id>.json holds end_time and end_reason
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by session hooks
+//
+// Code Cleanup: Lockfile released by defer on every path; temp files removed
+// if the rename never happens
+//
+// Modification Policy:
+//   ✅ Safe: New SessionData fields (written through automatically)
+//   ⚠️ Care: Archive naming (session-log and pattern tools may read it)
+//   ❌ Never: Writing current.json without the lock, or in place without rename
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,121 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Session Lifecycle Tests
+//
+// Purpose: Prove a stale record is archived at start, updates preserve
+//          unmodeled fields and serialize under the lock, and ending moves
+//          the finalized record to the archive; the session correlation ID
+//          is exported to this process and the Bash env file.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+
+	"system/lib/logging"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestSessionLifecycle(t *testing.T) {
+	saved := sessionData
+	defer func() { sessionData = saved }()
+
+	dir := t.TempDir()
+	current := filepath.Join(dir, currentSessionFile)
+
+	// Left behind by a session that crashed before its end hook
+	stale := `{"session_id": "2026-10-15_0900", "session_phase": "active", "inherited_preferences": {"workflow": "plan"}}`
+	os.WriteFile(current, []byte(stale), 0644)
+
+	data, err := initSession(dir, "/work/project")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`).MatchString(data.SessionID) {
+		t.Errorf("session ID %q is not a v4 UUID", data.SessionID)
+	}
+	if data.WorkContext != "/work/project" || data.SessionPhase != "active" || sessionData != data {
+		t.Errorf("new record = %+v, want active in /work/project and loaded for context", data)
+	}
+	if _, err := os.Stat(filepath.Join(dir, sessionArchiveDir, "2026-10-15_0900.json")); err != nil {
+		t.Errorf("stale record not archived: %v", err)
+	}
+
+	// Concurrent updates serialize on the lockfile; unmodeled fields survive
+	os.WriteFile(current, append([]byte(`{"extensions": {"kept": true}, `), mustRead(t, current)[1:]...), 0644)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := updateSession(dir, func(s *SessionData) { s.CompactionCount++ }); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	var record map[string]any
+	json.Unmarshal(mustRead(t, current), &record)
+	if record["compaction_count"] != float64(10) || record["extensions"] == nil {
+		t.Errorf("after updates = %v, want compaction_count 10 with extensions kept", record)
+	}
+
+	if err := endSession(dir, "logout"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(current); !os.IsNotExist(err) {
+		t.Error("current.json still present after end")
+	}
+	var ended SessionData
+	json.Unmarshal(mustRead(t, filepath.Join(dir, sessionArchiveDir, data.SessionID+".json")), &ended)
+	if ended.SessionPhase != sessionEndedPhase || ended.EndReason != "logout" || ended.EndTime == "" || ended.CompactionCount != 10 {
+		t.Errorf("archived record = %+v", ended)
+	}
+
+	if err := endSession(dir, "again"); err == nil {
+		t.Error("ending with no current record: want error")
+	}
+}
+
+func TestSeedSessionCorrelation(t *testing.T) {
+	t.Setenv(logging.ParentContextEnv, "")
+	dir := t.TempDir()
+	envFile := filepath.Join(dir, "env.sh")
+
+	if id := seedSessionCorrelation(dir, envFile); id != "" {
+		t.Errorf("seeded %q without a session record", id)
+	}
+
+	os.WriteFile(filepath.Join(dir, currentSessionFile), []byte(`{"session_id": "abc"}`), 0644)
+	id := seedSessionCorrelation(dir, envFile)
+	if id != "session-abc" || os.Getenv(logging.ParentContextEnv) != id {
+		t.Errorf("seeded %q (env %q), want session-abc in both", id, os.Getenv(logging.ParentContextEnv))
+	}
+	if got := string(mustRead(t, envFile)); got != "export "+logging.ParentContextEnv+"=session-abc\n" {
+		t.Errorf("env file = %q", got)
+	}
+}
+
+func mustRead(t *testing.T, path string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
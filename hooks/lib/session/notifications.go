@@ -0,0 +1,537 @@
+// METADATA
+//
+// Desktop Notifications Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Son of man, I have made thee a watchman unto the house of Israel" - Ezekiel 3:17 (KJV)
+// Principle: A watchman calls out what matters and stays quiet otherwise
+// Anchor: "Let all things be done decently and in order." - 1 Corinthians 14:40 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - desktop notification forwarding)
+// Role: Forwards selected session lifecycle events to a desktop notifier
+// Paradigm: CPI-SI framework component - best-effort, never in the way of the hook
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial notification forwarding
+//
+// Version History:
+//   1.0.0 (2026-10-16) - Notify with command templates, per-category toggles and rate limits
+//
+// Purpose & Function
+//
+// Purpose: A long session runs unattended. A failed subagent, or a session
+// that ended during expected downtime, should reach the desktop without
+// anyone watching the terminal.
+//
+// Core Design: Notify runs a configured command - an argv template with
+// {title}, {body}, and {urgency} substituted per argument (no shell, so event
+// text can't inject anything). The command comes from command (explicit
+// template) or preset: "none" (default), "auto" (notify-send on Linux,
+// osascript on macOS), "notify-send", or "osascript". Each category has its
+// own toggle and urgency in notifications.jsonc. Hooks are separate
+// processes, so the per-category rate limit (one notification per
+// behavior.rate_limit_seconds) is kept in notifications-state.json in the
+// session data directory.
+//
+// Blocking Status
+//
+// Non-blocking: Every failure - no notifier installed, command error,
+// timeout, unwritable state file - is logged as a warning and returned for
+// callers to ignore. The command is bounded by behavior.timeout_seconds.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: context, encoding/json, fmt, os, os/exec, path/filepath, runtime, strings, time
+//   Internal: system/lib/jsonc (notifications.jsonc), system/lib/logging,
+//             context.go (currentTemporalContext), lifecycle.go (sessionDataDir)
+//
+// Dependents (What Uses This):
+//   Commands: session/cmd-subagent-stop (NotifySubagentFailure),
+//             session/cmd-pre-compact (NotifyPreCompact), session/cmd-end (NotifySessionEnd)
+//
+// Health Scoring
+//
+//   Notification delivered: +5 (logged success)
+//   Disabled, no command, or rate limited: 0 (nothing logged)
+//   Command failure or timeout: -5 (logged failure, error returned)
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"context"       // Notifier time limit
+	"encoding/json" // Rate limit state file
+	"fmt"           // Event text and error wrapping
+	"os"            // State file, home directory
+	"os/exec"       // Notifier command
+	"path/filepath" // Config and state paths
+	"runtime"       // Preset "auto" platform choice
+	"strings"       // Placeholder substitution
+	"time"          // Rate limit window and command timeout
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/jsonc"   // Parse notifications.jsonc
+	"system/lib/logging" // Delivery success/failure records
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// notificationsConfigFile is the config in the session config directory
+	notificationsConfigFile = ".claude/cpi-si/system/data/config/session/notifications.jsonc"
+
+	// notificationStateFile holds last-sent times per category (session data directory)
+	notificationStateFile = "notifications-state.json"
+
+	// Presets naming a built-in command template
+	notifyPresetNone       = "none"
+	notifyPresetAuto       = "auto"
+	notifyPresetNotifySend = "notify-send"
+	notifyPresetOsascript  = "osascript"
+
+	// Urgencies (notify-send vocabulary, passed through {urgency})
+	UrgencyLow      = "low"
+	UrgencyNormal   = "normal"
+	UrgencyCritical = "critical"
+
+	// Defaults (fallback when notifications.jsonc is missing or leaves keys out)
+	defaultNotifyPreset           = notifyPresetNone
+	defaultNotifyRateLimitSeconds = 300
+	defaultNotifyTimeoutSeconds   = 5
+	defaultNotifyTitlePrefix      = "Claude session"
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// NotificationCategory groups events for toggles and rate limiting
+type NotificationCategory string
+
+const (
+	NotificationSubagentFailure NotificationCategory = "subagent_failure" // A subagent exited with failure
+	NotificationPreCompact      NotificationCategory = "pre_compact"      // Context is about to compact
+	NotificationSessionEnd      NotificationCategory = "session_end"      // The session ended
+)
+
+// NotificationEvent is one notification to deliver
+type NotificationEvent struct {
+	Category NotificationCategory // Selects toggle, urgency, and rate limit bucket
+	Title    string               // Short headline ({title})
+	Body     string               // Detail line ({body})
+	Urgency  string               // low/normal/critical ({urgency}, empty = category default)
+}
+
+// NotificationCategoryConfig toggles one category
+type NotificationCategoryConfig struct {
+	Enabled      bool   `json:"enabled"`       // Send notifications for this category
+	Urgency      string `json:"urgency"`       // Default {urgency} for the category
+	DowntimeOnly bool   `json:"downtime_only"` // session_end only: notify only during expected downtime
+}
+
+// NotificationEventsConfig holds the per-category settings
+type NotificationEventsConfig struct {
+	SubagentFailure NotificationCategoryConfig `json:"subagent_failure"` // Subagent failures
+	PreCompact      NotificationCategoryConfig `json:"pre_compact"`      // Pre-compaction
+	SessionEnd      NotificationCategoryConfig `json:"session_end"`      // Session end
+}
+
+// NotificationBehaviorConfig bounds how often and how long notifiers run
+type NotificationBehaviorConfig struct {
+	RateLimitSeconds int    `json:"rate_limit_seconds"` // Minimum gap per category (0 = no limit)
+	TimeoutSeconds   int    `json:"timeout_seconds"`    // Notifier command time limit
+	TitlePrefix      string `json:"title_prefix"`       // Prepended to every title
+}
+
+// NotificationsConfiguration is the top-level notifications.jsonc structure
+type NotificationsConfiguration struct {
+	Preset   string                     `json:"preset"`   // none, auto, notify-send, osascript
+	Command  []string                   `json:"command"`  // Explicit argv template (overrides preset)
+	Events   NotificationEventsConfig   `json:"events"`   // Per-category toggles
+	Behavior NotificationBehaviorConfig `json:"behavior"` // Rate limit and timeout
+}
+
+// ────────────────────────────────────────────────────────────────
+// Package-Level State (Rails Pattern)
+// ────────────────────────────────────────────────────────────────
+
+// notifyPresets maps preset names to argv templates
+//
+// osascript receives title and body as run arguments, so quotes in event
+// text never meet AppleScript string syntax.
+var notifyPresets = map[string][]string{
+	notifyPresetNotifySend: {"notify-send", "--app-name=Claude", "--urgency={urgency}", "{title}", "{body}"},
+	notifyPresetOsascript: {
+		"osascript",
+		"-e", "on run argv",
+		"-e", "display notification (item 2 of argv) with title (item 1 of argv)",
+		"-e", "end run",
+		"{title}", "{body}",
+	},
+}
+
+var (
+	notificationsConfig       *NotificationsConfiguration // Cached configuration loaded in init()
+	notificationsConfigLoaded bool                        // Flag indicating if config loaded successfully
+)
+
+// notificationLogger records delivered and failed notifications
+var notificationLogger = logging.NewLogger("session-notifications")
+
+func init() {
+	// --- Configuration Loading ---
+	// Falls back to hardcoded defaults (preset "none" - nothing sent) if missing or invalid
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return
+	}
+	loadedConfig, err := loadNotificationsConfig(filepath.Join(homeDir, notificationsConfigFile))
+	if err != nil {
+		return
+	}
+	notificationsConfig = loadedConfig
+	notificationsConfigLoaded = true
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 4 functions
+//   ├── Notify(event) → notify(sessionDataDir(), settings, event, now)
+//   ├── NotifySubagentFailure(type, exitCode, message) → Notify
+//   ├── NotifyPreCompact(type, count) → Notify
+//   └── NotifySessionEnd(reason) → Notify (downtime_only checks currentTemporalContext)
+//
+//   Core Operations (Middle Rungs) - 3 functions
+//   ├── notify(dir, settings, event, now) → uses notifyCommand, rateLimited, runNotifyCommand, recordNotification
+//   ├── rateLimited / recordNotification(dir, ...) → notifications-state.json
+//   └── runNotifyCommand(argv, timeout) → exec.CommandContext
+//
+//   Helpers (Bottom Rungs) - 5 functions
+//   ├── defaultNotificationsConfiguration() / loadNotificationsConfig(path)
+//   ├── notificationSettings() → loaded config or defaults
+//   ├── categoryConfig(settings, category) → per-category settings
+//   └── notifyCommand(settings, event) → preset/template with placeholders substituted
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Configuration
+// ────────────────────────────────────────────────────────────────
+
+// defaultNotificationsConfiguration returns the hardcoded defaults
+//
+// Base layer under notifications.jsonc: subagent failures on (critical),
+// pre-compact off, session end off (downtime only when enabled). With preset
+// "none" nothing is sent until a notifier is chosen.
+func defaultNotificationsConfiguration() *NotificationsConfiguration {
+	return &NotificationsConfiguration{
+		Preset: defaultNotifyPreset,
+		Events: NotificationEventsConfig{
+			SubagentFailure: NotificationCategoryConfig{Enabled: true, Urgency: UrgencyCritical},
+			PreCompact:      NotificationCategoryConfig{Enabled: false, Urgency: UrgencyLow},
+			SessionEnd:      NotificationCategoryConfig{Enabled: false, Urgency: UrgencyNormal, DowntimeOnly: true},
+		},
+		Behavior: NotificationBehaviorConfig{
+			RateLimitSeconds: defaultNotifyRateLimitSeconds,
+			TimeoutSeconds:   defaultNotifyTimeoutSeconds,
+			TitlePrefix:      defaultNotifyTitlePrefix,
+		},
+	}
+}
+
+// loadNotificationsConfig layers notifications.jsonc over the defaults
+func loadNotificationsConfig(path string) (*NotificationsConfiguration, error) {
+	cfg := defaultNotificationsConfiguration()
+	if err := jsonc.LoadMerged(cfg, path); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// notificationSettings returns the loaded configuration, or defaults
+func notificationSettings() *NotificationsConfiguration {
+	if notificationsConfigLoaded && notificationsConfig != nil {
+		return notificationsConfig
+	}
+	return defaultNotificationsConfiguration()
+}
+
+// categoryConfig returns the settings for one category (unknown = disabled)
+func categoryConfig(settings *NotificationsConfiguration, category NotificationCategory) NotificationCategoryConfig {
+	switch category {
+	case NotificationSubagentFailure:
+		return settings.Events.SubagentFailure
+	case NotificationPreCompact:
+		return settings.Events.PreCompact
+	case NotificationSessionEnd:
+		return settings.Events.SessionEnd
+	}
+	return NotificationCategoryConfig{}
+}
+
+// notifyCommand builds the argv for an event (nil = no notifier configured)
+func notifyCommand(settings *NotificationsConfiguration, event NotificationEvent) []string {
+	template := settings.Command
+	if len(template) == 0 {
+		preset := settings.Preset
+		if preset == notifyPresetAuto {
+			switch runtime.GOOS {
+			case "linux", "freebsd", "openbsd", "netbsd":
+				preset = notifyPresetNotifySend
+			case "darwin":
+				preset = notifyPresetOsascript
+			}
+		}
+		template = notifyPresets[preset] // "none" and unknown presets have no entry
+	}
+	if len(template) == 0 {
+		return nil
+	}
+
+	title := event.Title
+	if prefix := settings.Behavior.TitlePrefix; prefix != "" {
+		title = prefix + ": " + title
+	}
+	replacer := strings.NewReplacer("{title}", title, "{body}", event.Body, "{urgency}", event.Urgency)
+	argv := make([]string, len(template))
+	for i, arg := range template {
+		argv[i] = replacer.Replace(arg) // Per argument - no shell, no quoting
+	}
+	return argv
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Rate Limiting and Delivery
+// ────────────────────────────────────────────────────────────────
+
+// readNotificationState reads last-sent Unix times per category (missing = empty)
+func readNotificationState(dir string) map[string]int64 {
+	state := map[string]int64{}
+	if data, err := os.ReadFile(filepath.Join(dir, notificationStateFile)); err == nil {
+		json.Unmarshal(data, &state) // Corrupt state only costs one rate limit window
+	}
+	return state
+}
+
+// rateLimited reports whether category was notified within the window
+func rateLimited(dir string, category NotificationCategory, window time.Duration, now time.Time) bool {
+	if window <= 0 {
+		return false
+	}
+	last, ok := readNotificationState(dir)[string(category)]
+	return ok && now.Sub(time.Unix(last, 0)) < window
+}
+
+// recordNotification stores now as category's last-sent time (temp file + rename)
+func recordNotification(dir string, category NotificationCategory, now time.Time) error {
+	state := readNotificationState(dir)
+	state[string(category)] = now.Unix()
+	output, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	temp, err := os.CreateTemp(dir, notificationStateFile+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name()) // No-op after a successful rename
+
+	if _, err := temp.Write(append(output, '\n')); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), filepath.Join(dir, notificationStateFile))
+}
+
+// runNotifyCommand runs the notifier, killing it after timeout
+func runNotifyCommand(argv []string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	output, err := exec.CommandContext(ctx, argv[0], argv[1:]...).CombinedOutput()
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("%s timed out after %s", argv[0], timeout)
+	}
+	if err != nil {
+		if text := strings.TrimSpace(string(output)); text != "" {
+			return fmt.Errorf("%s: %w (%s)", argv[0], err, truncateReminderText(text))
+		}
+		return fmt.Errorf("%s: %w", argv[0], err)
+	}
+	return nil
+}
+
+// notify is Notify against a given state directory, settings, and clock
+func notify(dir string, settings *NotificationsConfiguration, event NotificationEvent, now time.Time) error {
+	cfg := categoryConfig(settings, event.Category)
+	if !cfg.Enabled {
+		return nil
+	}
+	if event.Urgency == "" {
+		event.Urgency = cfg.Urgency
+	}
+	if event.Urgency == "" {
+		event.Urgency = UrgencyNormal
+	}
+	argv := notifyCommand(settings, event)
+	if argv == nil {
+		return nil // No notifier configured
+	}
+
+	window := time.Duration(settings.Behavior.RateLimitSeconds) * time.Second
+	if rateLimited(dir, event.Category, window, now) {
+		return nil // A flapping source gets one notification per window
+	}
+
+	timeoutSeconds := settings.Behavior.TimeoutSeconds
+	if timeoutSeconds <= 0 {
+		timeoutSeconds = defaultNotifyTimeoutSeconds
+	}
+	details := map[string]any{"category": string(event.Category), "command": argv[0]}
+	if err := runNotifyCommand(argv, time.Duration(timeoutSeconds)*time.Second); err != nil {
+		notificationLogger.Failure("notify", err.Error(), -5, details)
+		return err
+	}
+
+	// Record after delivery - a failed attempt shouldn't silence the next one
+	if err := recordNotification(dir, event.Category, now); err != nil {
+		notificationLogger.Failure("notify-state", err.Error(), -5, details)
+	}
+	notificationLogger.Success("notify", 5, details)
+	return nil
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// Notify sends a desktop notification for a session event (best-effort)
+//
+// What It Does:
+//   - Skips disabled categories, unconfigured notifiers, and categories
+//     notified within behavior.rate_limit_seconds (all return nil)
+//   - Runs the preset or command template with {title}/{body}/{urgency}
+//   - Logs failures as warnings and returns them - callers ignore the error
+//
+// Example:
+//   session.Notify(session.NotificationEvent{
+//       Category: session.NotificationSubagentFailure,
+//       Title:    "Subagent failed",
+//       Body:     "research exited 1",
+//   })
+func Notify(event NotificationEvent) error {
+	return notify(sessionDataDir(), notificationSettings(), event, now())
+}
+
+// NotifySubagentFailure notifies that a subagent failed (events.subagent_failure)
+func NotifySubagentFailure(agentType, exitCode, message string) error {
+	body := fmt.Sprintf("%s subagent failed", agentType)
+	if exitCode != "" && exitCode != "0" {
+		body += fmt.Sprintf(" (exit %s)", exitCode)
+	}
+	if message != "" {
+		body += ": " + truncateReminderText(message)
+	}
+	return Notify(NotificationEvent{Category: NotificationSubagentFailure, Title: "Subagent failed", Body: body})
+}
+
+// NotifyPreCompact notifies that context is compacting (events.pre_compact)
+func NotifyPreCompact(compactType string, compactionCount int) error {
+	body := fmt.Sprintf("%s compaction", compactType)
+	if compactionCount > 0 {
+		body += fmt.Sprintf(" #%d this session", compactionCount)
+	}
+	return Notify(NotificationEvent{Category: NotificationPreCompact, Title: "Context compacting", Body: body})
+}
+
+// NotifySessionEnd notifies that the session ended (events.session_end)
+//
+// With downtime_only (the default), only a session ending during expected
+// downtime - sleep, meal, break - notifies.
+func NotifySessionEnd(reason string) error {
+	cfg := notificationSettings().Events.SessionEnd
+	if !cfg.Enabled {
+		return nil
+	}
+	body := reason
+	if cfg.DowntimeOnly {
+		tctx, err := currentTemporalContext()
+		if err != nil || !tctx.InternalSchedule.ExpectedDowntime {
+			return nil
+		}
+		activity := tctx.InternalSchedule.CurrentActivity
+		if activity == "" {
+			activity = tctx.InternalSchedule.ActivityType
+		}
+		body = fmt.Sprintf("%s (during %s)", reason, activity)
+	}
+	return Notify(NotificationEvent{Category: NotificationSessionEnd, Title: "Session ended", Body: body})
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Placeholders substituted per argument; presets resolve per platform
+//   - Second notification in one category inside the window is dropped
+//   - Failing or hanging notifier: warning logged, error returned, hook unaffected
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by session hooks
+//
+// Code Cleanup: Notifier killed at timeout; state temp file removed if the
+// rename never happens
+//
+// Modification Policy:
+//   ✅ Safe: New categories (constant, config field, categoryConfig case), new presets
+//   ⚠️ Care: Placeholder names (user command templates depend on them)
+//   ❌ Never: Running templates through a shell, or letting a failure reach the hook
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,105 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Desktop Notification Tests
+//
+// Purpose: Prove templates get placeholders per argument, disabled categories
+//          and "none" send nothing, a category is rate limited across calls,
+//          and a failing notifier returns an error without recording a send.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// recordingSettings notifies by appending "urgency|title|body" lines to out
+func recordingSettings(out string) *NotificationsConfiguration {
+	settings := defaultNotificationsConfiguration()
+	settings.Command = []string{"sh", "-c", `printf '%s|%s|%s\n' "$1" "$2" "$3" >> "$0"`, out, "{urgency}", "{title}", "{body}"}
+	settings.Events.PreCompact.Enabled = true
+	return settings
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestNotifyCommandSubstitutesPerArgument(t *testing.T) {
+	settings := defaultNotificationsConfiguration()
+	settings.Preset = notifyPresetNotifySend
+	event := NotificationEvent{Title: `it's "done"`, Body: "a; rm -rf ~", Urgency: UrgencyCritical}
+
+	got := notifyCommand(settings, event)
+	want := []string{"notify-send", "--app-name=Claude", "--urgency=critical", `Claude session: it's "done"`, "a; rm -rf ~"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("argv = %q, want %q", got, want)
+	}
+
+	settings.Preset = notifyPresetNone
+	if argv := notifyCommand(settings, event); argv != nil {
+		t.Errorf("preset none built %q", argv)
+	}
+}
+
+func TestNotifyRateLimitsPerCategory(t *testing.T) {
+	dir := t.TempDir()
+	out := filepath.Join(dir, "sent.txt")
+	settings := recordingSettings(out)
+	now := time.Now()
+
+	send := func(category NotificationCategory, at time.Time) {
+		t.Helper()
+		if err := notify(dir, settings, NotificationEvent{Category: category, Title: string(category), Body: "b"}, at); err != nil {
+			t.Fatal(err)
+		}
+	}
+	send(NotificationSubagentFailure, now)
+	send(NotificationSubagentFailure, now.Add(time.Minute))   // Flapping - dropped
+	send(NotificationPreCompact, now.Add(time.Minute))        // Other category - own window
+	send(NotificationSessionEnd, now)                         // Disabled by default
+	send(NotificationSubagentFailure, now.Add(6*time.Minute)) // Window passed
+
+	data, _ := os.ReadFile(out)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	want := []string{
+		"critical|Claude session: subagent_failure|b",
+		"low|Claude session: pre_compact|b",
+		"critical|Claude session: subagent_failure|b",
+	}
+	if !reflect.DeepEqual(lines, want) {
+		t.Errorf("sent:\n%s\nwant:\n%s", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestNotifyFailureIsReturnedNotRecorded(t *testing.T) {
+	dir := t.TempDir()
+	settings := defaultNotificationsConfiguration()
+	settings.Command = []string{"sh", "-c", "echo no display >&2; exit 3"}
+	event := NotificationEvent{Category: NotificationSubagentFailure, Title: "t", Body: "b"}
+
+	err := notify(dir, settings, event, time.Now())
+	if err == nil || !strings.Contains(err.Error(), "no display") {
+		t.Fatalf("err = %v, want notifier stderr in error", err)
+	}
+	if rateLimited(dir, NotificationSubagentFailure, time.Hour, time.Now()) {
+		t.Error("failed notification started a rate limit window")
+	}
+
+	settings.Command = []string{"sleep", "5"}
+	settings.Behavior.TimeoutSeconds = 1
+	start := time.Now()
+	if err := notify(dir, settings, event, time.Now()); err == nil || time.Since(start) > 3*time.Second {
+		t.Errorf("hanging notifier: err %v after %s", err, time.Since(start))
+	}
+}
@@ -0,0 +1,257 @@
+// METADATA
+//
+// Session Output Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Write the vision, and make it plain upon tables, that he may run that readeth it." - Habakkuk 2:2 (KJV)
+// Principle: What was shown should be kept exactly as it was shown
+// Anchor: "The words of the LORD are pure words." - Psalm 12:6 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - session display output routing)
+// Role: Owns the writer every Print* function renders to, and tees it into session transcripts
+// Paradigm: CPI-SI framework component - one writer, swapped for tests and transcripts
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial output routing and transcripts
+//
+// Version History:
+//   1.0.0 (2026-10-16) - SetOutput/Output/CaptureOutput, StartTranscript (behavior.session_display.save_transcript)
+//
+// Purpose & Function
+//
+// Purpose: Display output went straight to os.Stdout, so a session banner
+// could neither be archived nor unit tested. Every Print* function in this
+// package now writes to Output():
+//   - SetOutput: route display output elsewhere (nil restores os.Stdout)
+//   - CaptureOutput: run a function and return what it displayed
+//   - StartTranscript: tee display output into <session data>/transcripts/<session-id>.txt
+//     when behavior.session_display.save_transcript is on, so the archive
+//     holds exactly what was shown
+//
+// Core Design: Transcripts append - start, each stop, and end of one session
+// land in one file in the order they were shown. The hook JSON written by
+// hookoutput.go is protocol, not display, and stays on os.Stdout.
+//
+// Blocking Status
+//
+// Non-blocking: a transcript that can't be opened is logged and skipped -
+// display output still reaches the terminal.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bytes, io, os, path/filepath, sync
+//   Internal: system/lib/logging, display.go (save_transcript toggle),
+//             lifecycle.go (sessionDataDir, current session record)
+//
+// Dependents (What Uses This):
+//   Commands: session/cmd-start, session/cmd-stop, session/cmd-end (StartTranscript, Output)
+//   Libraries: every Print* function in this package
+//
+// Health Scoring
+//
+//   Transcript opened: +5 (logged success)
+//   No session record or unwritable transcript: -5 (logged failure, display continues)
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"bytes"         // CaptureOutput buffer
+	"io"            // Writer routing and tee
+	"os"            // Default writer, transcript file
+	"path/filepath" // Transcript path
+	"sync"          // Writer swap guard
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/logging" // Transcript open success/failure records
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// transcriptDir holds one <session-id>.txt per session under the session data directory.
+	transcriptDir = "transcripts"
+
+	// transcriptFileMode keeps transcripts private to the user.
+	transcriptFileMode = 0600
+)
+
+// ────────────────────────────────────────────────────────────────
+// Package-Level State
+// ────────────────────────────────────────────────────────────────
+
+// outputWriter receives all display output (os.Stdout unless redirected)
+var (
+	outputWriter   io.Writer = os.Stdout
+	outputWriterMu sync.Mutex
+)
+
+// outputLogger records transcript opens and failures
+var outputLogger = logging.NewLogger("session-output")
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 4 functions
+//   ├── SetOutput(w) → swaps outputWriter
+//   ├── Output() → current outputWriter
+//   ├── CaptureOutput(fn) → SetOutput(buffer), fn, restore
+//   └── StartTranscript() → startTranscript(sessionDataDir(), save_transcript)
+//
+//   Core Operations (Middle Rungs) - 1 function
+//   └── startTranscript(dir, enabled) → uses readSessionRecord, SetOutput(io.MultiWriter)
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Transcript
+// ────────────────────────────────────────────────────────────────
+
+// startTranscript tees Output() into dir/transcripts/<session-id>.txt
+//
+// The session ID comes from dir's current.json, so call after InitSession at
+// start and before EndSession at end. Returns the function that restores the
+// previous writer and closes the file (a no-op when nothing was opened).
+func startTranscript(dir string, enabled bool) (stop func()) {
+	stop = func() {}
+	if !enabled {
+		return stop
+	}
+
+	_, data, err := readSessionRecord(filepath.Join(dir, currentSessionFile))
+	if err != nil || data.SessionID == "" {
+		outputLogger.Failure("transcript-open", "no current session record", -5, map[string]any{"dir": dir})
+		return stop
+	}
+
+	path := filepath.Join(dir, transcriptDir, filepath.Base(data.SessionID)+".txt") // Base: IDs never escape the directory
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		outputLogger.Failure("transcript-open", err.Error(), -5, map[string]any{"path": path})
+		return stop
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, transcriptFileMode)
+	if err != nil {
+		outputLogger.Failure("transcript-open", err.Error(), -5, map[string]any{"path": path})
+		return stop
+	}
+	outputLogger.Success("transcript-open", 5, map[string]any{"path": path, "session_id": data.SessionID})
+
+	previous := Output()
+	SetOutput(io.MultiWriter(previous, file))
+	return func() {
+		SetOutput(previous)
+		file.Close()
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Output Routing
+// ────────────────────────────────────────────────────────────────
+
+// SetOutput routes all display output to w (nil restores os.Stdout)
+func SetOutput(w io.Writer) {
+	if w == nil {
+		w = os.Stdout
+	}
+	outputWriterMu.Lock()
+	outputWriter = w
+	outputWriterMu.Unlock()
+}
+
+// Output returns the writer display output currently goes to
+//
+// Hooks print their own dividers through this so transcripts match the terminal.
+func Output() io.Writer {
+	outputWriterMu.Lock()
+	defer outputWriterMu.Unlock()
+	return outputWriter
+}
+
+// CaptureOutput runs fn and returns everything it displayed
+//
+// Display output is redirected for the duration of fn, then the previous
+// writer is restored.
+//
+// Example:
+//
+//	banner := session.CaptureOutput(session.PrintHeader)
+func CaptureOutput(fn func()) string {
+	var buffer bytes.Buffer
+	previous := Output()
+	SetOutput(&buffer)
+	defer SetOutput(previous)
+
+	fn()
+	return buffer.String()
+}
+
+// StartTranscript tees display output into the current session's transcript
+//
+// Enabled by behavior.session_display.save_transcript in formatting.jsonc
+// (off by default). Transcript: <session data>/transcripts/<session-id>.txt,
+// appended by every hook that starts one. Call the returned function before
+// the hook exits.
+//
+// Example:
+//
+//	stopTranscript := session.StartTranscript()
+//	defer stopTranscript()
+func StartTranscript() (stop func()) {
+	return startTranscript(sessionDataDir(), currentDisplayConfig().Behavior.SessionDisplay.SaveTranscript)
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Default: output unchanged (os.Stdout), no transcript written
+//   - Capture: CaptureOutput returns exactly what Print* functions render (golden tests)
+//   - Transcript: appended per hook, previous writer restored by the stop function
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by session hooks and tests
+//
+// Code Cleanup: The stop function returned by StartTranscript closes the file
+//
+// Modification Policy:
+//   ✅ Safe: Additional writers teed alongside the transcript
+//   ⚠️ Care: Transcript location (session archive tooling may read it)
+//   ❌ Never: Routing hook JSON (hookoutput.go) through Output() - it would land in transcripts
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
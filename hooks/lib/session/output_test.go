@@ -0,0 +1,211 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Session Output Tests
+//
+// Purpose: Prove Print* output routes through Output() - golden files in
+//          testdata/golden pin what the Print functions render (refresh
+//          with go test -run Golden -update), timestamps and the temporal
+//          journey included under a fixed clock, upcoming events shown in
+//          temporal awareness and its context section - and that transcripts append
+//          exactly what was shown, restoring the previous writer afterwards.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"system/lib/temporal"
+)
+
+// updateGolden rewrites testdata/golden/*.txt from current output
+var updateGolden = flag.Bool("update", false, "rewrite testdata/golden files")
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// useGoldenDisplayConfig pins defaults at the narrowest ASCII layout so
+// terminal width and TTY detection can't change the rendering
+func useGoldenDisplayConfig(t *testing.T) {
+	t.Helper()
+	saved := currentDisplayConfig()
+	t.Cleanup(func() { displayConfig.Store(saved) })
+
+	cfg := getDefaultDisplayConfig()
+	cfg.Formatting.Banner.Width = minBannerWidth
+	cfg.Behavior.SessionDisplay.ASCIIFallback = true
+	displayConfig.Store(cfg)
+}
+
+// useSyntheticSession fixes the session clock at at and serves a temporal
+// context for a session that started elapsed earlier
+func useSyntheticSession(t *testing.T, at time.Time, elapsed time.Duration) {
+	t.Helper()
+	saved := temporalContextSource
+	t.Cleanup(func() {
+		ResetClock()
+		temporalContextSource = saved
+	})
+
+	SetClock(ClockFunc(func() time.Time { return at }))
+	temporalContextSource = func(time.Duration) (*temporal.TemporalContext, error) {
+		current := now()
+		return &temporal.TemporalContext{
+			ExternalTime: temporal.ExternalTime{
+				CurrentTime: current,
+				Formatted:   current.Format("Mon Jan 02, 2006 at 15:04:05"),
+				TimeOfDay:   "afternoon",
+			},
+			InternalTime: temporal.InternalTime{
+				SessionStart:     current.Add(-elapsed),
+				ElapsedDuration:  elapsed,
+				ElapsedFormatted: "2h37m",
+				SessionPhase:     "long",
+			},
+			InternalSchedule: temporal.InternalSchedule{CurrentActivity: "Deep work", ActivityType: "work"},
+			ExternalCalendar: temporal.ExternalCalendar{
+				Date:       current.Format("2006-01-02"),
+				DayOfWeek:  current.Weekday().String(),
+				MonthName:  current.Month().String(),
+				DayOfMonth: current.Day(),
+				WeekNumber: 42,
+			},
+			UpcomingEvents: []temporal.Event{
+				{Name: "CWS release", DaysUntil: 4},
+				{Name: "Mom's birthday", DaysUntil: 12},
+			},
+		}, nil
+	}
+}
+
+// checkGolden compares got with testdata/golden/name (rewrites it under -update)
+func checkGolden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", "golden", name)
+	if *updateGolden {
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%s: %v (run with -update to create)", name, err)
+	}
+	if got != string(want) {
+		t.Errorf("%s mismatch\ngot:\n%s\nwant:\n%s", name, got, want)
+	}
+}
+
+func TestPrintGolden(t *testing.T) {
+	useGoldenDisplayConfig(t)
+
+	contextMarkdown, err := os.ReadFile(filepath.Join("testdata", "golden", "session-context.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		golden string
+		print  func()
+	}{
+		{"end-reminders-header.txt", PrintEndRemindersHeader},
+		{"workspace-analysis-none.txt", func() { PrintWorkspaceAnalysis("", false) }},
+		{"session-context.txt", func() { PrintSessionContext(string(contextMarkdown)) }},
+	}
+
+	for _, tc := range cases {
+		checkGolden(t, tc.golden, CaptureOutput(tc.print))
+	}
+}
+
+func TestPrintGoldenFixedClock(t *testing.T) {
+	useGoldenDisplayConfig(t)
+	end := time.Date(2026, 10, 16, 14, 7, 0, 0, time.UTC)
+	useSyntheticSession(t, end, 2*time.Hour+37*time.Minute)
+
+	checkGolden(t, "stop-info.txt", CaptureOutput(PrintStopInfo))
+	checkGolden(t, "end-session-info.txt", CaptureOutput(func() { PrintEndSessionInfo("Normal session end") }))
+	checkGolden(t, "temporal-journey.txt", CaptureOutput(PrintEndTemporalJourney))
+
+	journey := CaptureOutput(PrintEndTemporalJourney)
+	if !strings.Contains(journey, "2h37m (long session)") || !strings.Contains(journey, "11:30:00") {
+		t.Errorf("journey does not show a 2h37m session started 11:30:00:\n%s", journey)
+	}
+}
+
+func TestUpcomingEventsShown(t *testing.T) {
+	useGoldenDisplayConfig(t)
+	useSyntheticSession(t, time.Date(2026, 10, 16, 14, 7, 0, 0, time.UTC), time.Hour)
+
+	want := "CWS release in 4 days; Mom's birthday in 12 days"
+	if awareness := CaptureOutput(PrintTemporalAwareness); !strings.Contains(awareness, "Upcoming:") || !strings.Contains(awareness, want) {
+		t.Errorf("temporal awareness lacks the upcoming line:\n%s", awareness)
+	}
+	if section := renderTemporalSection(buildContextStruct()); !strings.Contains(section, "**Upcoming:** "+want+"\n") {
+		t.Errorf("temporal context section lacks the upcoming line:\n%s", section)
+	}
+}
+
+func TestCaptureOutputRestoresWriter(t *testing.T) {
+	useGoldenDisplayConfig(t)
+	before := Output()
+
+	inner := ""
+	outer := CaptureOutput(func() {
+		PrintEndRemindersHeader()
+		inner = CaptureOutput(PrintEndRemindersHeader)
+	})
+
+	if outer == "" || outer != inner {
+		t.Errorf("nested capture: outer %q, inner %q", outer, inner)
+	}
+	if Output() != before {
+		t.Error("writer not restored after CaptureOutput")
+	}
+}
+
+func TestTranscriptAppendsShownOutput(t *testing.T) {
+	useGoldenDisplayConfig(t)
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, currentSessionFile), []byte(`{"session_id": "abc-123"}`), 0644)
+
+	// Disabled: nothing written
+	startTranscript(dir, false)()
+	if _, err := os.Stat(filepath.Join(dir, transcriptDir)); !os.IsNotExist(err) {
+		t.Fatalf("transcript dir created while disabled: %v", err)
+	}
+
+	// Two hooks in one session append to one file, matching the terminal
+	var shown strings.Builder
+	for i := 0; i < 2; i++ {
+		shown.WriteString(CaptureOutput(func() {
+			stop := startTranscript(dir, true)
+			PrintEndRemindersHeader()
+			stop()
+		}))
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, transcriptDir, "abc-123.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != shown.String() || shown.Len() == 0 {
+		t.Errorf("transcript %q, shown %q", data, shown.String())
+	}
+
+	// No session record: display continues, nothing opened
+	startTranscript(t.TempDir(), true)()
+}
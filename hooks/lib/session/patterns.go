@@ -0,0 +1,412 @@
+// METADATA
+//
+// Session Patterns Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "There is a time for everything, and a season for every activity under the heavens." - Ecclesiastes 3:1 (NIV)
+// Principle: Honoring the rhythms that actually bear fruit, learned from what happened
+// Anchor: "The plans of the diligent lead to profit." - Proverbs 21:5 (NIV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - learned work rhythms)
+// Role: Aggregates session history into simple, honest patterns
+// Paradigm: CPI-SI framework component - feeds context.go ("patterns") and display.go (PrintSessionPatterns)
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial session patterns
+//
+// Version History:
+//   1.0.0 (2026-10-16) - Typical length, productive phase, compactions, weekday activity
+//
+// Purpose & Function
+//
+// Purpose: Turn stats-history.jsonl (one SessionStats per ended session, written
+// by the end hook via AppendSessionStats) into patterns worth knowing at start:
+//   - Typical session length (interquartile range, median)
+//   - Most productive circadian phase by tasks completed per session
+//   - Average compactions per session
+//   - Day-of-week activity distribution
+//
+// Core Design: computeSessionPatterns is pure (history in, patterns out);
+// GetSessionPatterns adds file I/O. Below minPatternSessions the patterns are
+// marked Learning and callers say so instead of presenting thin data as rhythm.
+//
+// Blocking Status
+//
+// Non-blocking: Missing history means zero sessions (still learning); malformed
+// lines are skipped.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bufio, encoding/json, errors, fmt, io/fs, math, os, sort, strings, time
+//   Package Files: stats.go (SessionStats, sessionStatsHistoryPath)
+//
+// Dependents (What Uses This):
+//   Libraries: context.go (buildPatternsSection), display.go (PrintSessionPatterns)
+//
+// Health Scoring
+//
+// Pure read path - no health tracking (absent history is the normal first-run state).
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"bufio"         // Line-by-line history reading
+	"encoding/json" // SessionStats lines
+	"errors"        // Missing history detection
+	"fmt"           // Pattern text
+	"io/fs"         // fs.ErrNotExist
+	"math"          // Hour rounding for length ranges
+	"os"            // History file access
+	"sort"          // Percentiles, phase and weekday ranking
+	"strings"       // Weekday list joining
+	"time"          // Durations and weekdays
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// minPatternSessions is how many ended sessions it takes before patterns are shown.
+	minPatternSessions = 5
+
+	// busiestDayCount is how many weekdays the activity summary names.
+	busiestDayCount = 2
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// SessionPatterns are aggregates over recorded session history
+//
+// With Learning set (fewer than minPatternSessions sessions) only Sessions is
+// meaningful.
+type SessionPatterns struct {
+	Sessions            int                `json:"sessions"`                        // Sessions in history
+	Learning            bool               `json:"learning"`                        // Too few sessions to call a pattern
+	TypicalDuration     time.Duration      `json:"typical_duration"`                // Median session length
+	DurationLow         time.Duration      `json:"duration_low"`                    // 25th percentile length
+	DurationHigh        time.Duration      `json:"duration_high"`                   // 75th percentile length
+	AverageCompactions  float64            `json:"average_compactions"`             // Per session with quality data
+	PhaseTasks          map[string]float64 `json:"phase_tasks"`                     // Average tasks completed per session, by circadian phase
+	MostProductivePhase string             `json:"most_productive_phase,omitempty"` // Highest PhaseTasks (needs 2+ phases)
+	ProductivityLift    int                `json:"productivity_lift"`               // % more tasks than sessions in other phases
+	Weekdays            [7]int             `json:"weekdays"`                        // Sessions started per weekday (Sunday first)
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 1 function
+//   └── GetSessionPatterns() → uses loadSessionStatsHistory, computeSessionPatterns
+//
+//   Core Operations (Middle Rungs) - 3 functions
+//   ├── computeSessionPatterns(history) → uses percentileDuration
+//   ├── buildPatternsSection() → uses GetSessionPatterns, durationRangeText, busiestDays (context.go "patterns")
+//   └── buildPatternsSummary() → uses GetSessionPatterns, durationRangeText (context budget form)
+//
+//   Helpers (Bottom Rungs) - 6 functions
+//   ├── loadSessionStatsHistory(path) → pure I/O
+//   ├── percentileDuration(sorted, p) → pure function
+//   ├── durationRangeText(low, high) → pure function
+//   ├── shortDuration(d) → pure function
+//   ├── busiestDays(weekdays) → pure function
+//   └── capitalizeFirst(s) → pure function
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - History and Formatting
+// ────────────────────────────────────────────────────────────────
+
+// loadSessionStatsHistory reads stats-history.jsonl (missing file = no history)
+func loadSessionStatsHistory(path string) ([]SessionStats, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var history []SessionStats
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var stats SessionStats
+		if json.Unmarshal(scanner.Bytes(), &stats) == nil {
+			history = append(history, stats)
+		}
+	}
+	return history, scanner.Err()
+}
+
+// percentileDuration returns the nearest-rank percentile of sorted durations
+func percentileDuration(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	index := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(0, min(index, len(sorted)-1))]
+}
+
+// durationRangeText renders a length range: "2-3h", "about 2h", "40-55 min"
+func durationRangeText(low, high time.Duration) string {
+	if high < time.Hour {
+		lowMin := max(int(low.Round(5*time.Minute).Minutes()), 5) // Never "0 min"
+		highMin := max(int(high.Round(5*time.Minute).Minutes()), 5)
+		if lowMin == highMin {
+			return fmt.Sprintf("about %d min", highMin)
+		}
+		return fmt.Sprintf("%d-%d min", lowMin, highMin)
+	}
+
+	lowHours := int(math.Floor(low.Hours()))
+	highHours := int(math.Ceil(high.Hours()))
+	if lowHours == 0 {
+		return fmt.Sprintf("up to %dh", highHours)
+	}
+	if lowHours >= highHours {
+		return fmt.Sprintf("about %dh", lowHours)
+	}
+	return fmt.Sprintf("%d-%dh", lowHours, highHours)
+}
+
+// shortDuration renders a duration to the minute without seconds ("2h30m", "45m")
+func shortDuration(d time.Duration) string {
+	return strings.TrimSuffix(d.Round(time.Minute).String(), "0s")
+}
+
+// busiestDays names the most active weekdays, most sessions first ("Tue, Wed")
+func busiestDays(weekdays [7]int) string {
+	days := make([]int, 0, 7)
+	for day, count := range weekdays {
+		if count > 0 {
+			days = append(days, day)
+		}
+	}
+	sort.SliceStable(days, func(a, b int) bool { return weekdays[days[a]] > weekdays[days[b]] })
+
+	names := make([]string, 0, busiestDayCount)
+	for _, day := range days[:min(busiestDayCount, len(days))] {
+		names = append(names, time.Weekday(day).String()[:3])
+	}
+	return strings.Join(names, ", ")
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Aggregation
+// ────────────────────────────────────────────────────────────────
+
+// computeSessionPatterns aggregates history into patterns
+//
+// What It Does:
+//   - Length: 25th/50th/75th percentile of sessions with a recorded duration
+//   - Productivity: average tasks per session grouped by circadian phase; the
+//     best phase is compared against all sessions in other phases
+//   - Compactions: average over sessions with quality data
+//   - Weekdays: count of sessions by local start weekday
+//   - Fewer than minPatternSessions: Learning, nothing else computed
+func computeSessionPatterns(history []SessionStats) *SessionPatterns {
+	patterns := &SessionPatterns{Sessions: len(history), PhaseTasks: map[string]float64{}}
+	if len(history) < minPatternSessions {
+		patterns.Learning = true
+		return patterns
+	}
+
+	var durations []time.Duration
+	phaseTotals := map[string][2]int{} // phase → {tasks, sessions}
+	compactions, withQuality := 0, 0
+
+	for _, stats := range history {
+		if stats.DurationSeconds > 0 {
+			durations = append(durations, time.Duration(stats.DurationSeconds)*time.Second)
+		}
+		if !stats.StartTime.IsZero() {
+			patterns.Weekdays[stats.StartTime.Local().Weekday()]++
+		}
+		if stats.Quality == nil {
+			continue
+		}
+		compactions += stats.Quality.Compactions
+		withQuality++
+		if stats.CircadianPhase != "" {
+			totals := phaseTotals[stats.CircadianPhase]
+			phaseTotals[stats.CircadianPhase] = [2]int{totals[0] + stats.Quality.TasksCompleted, totals[1] + 1}
+		}
+	}
+
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	patterns.DurationLow = percentileDuration(durations, 0.25)
+	patterns.TypicalDuration = percentileDuration(durations, 0.5)
+	patterns.DurationHigh = percentileDuration(durations, 0.75)
+
+	if withQuality > 0 {
+		patterns.AverageCompactions = float64(compactions) / float64(withQuality)
+	}
+
+	phases := make([]string, 0, len(phaseTotals))
+	for phase, totals := range phaseTotals {
+		patterns.PhaseTasks[phase] = float64(totals[0]) / float64(totals[1])
+		phases = append(phases, phase)
+	}
+	sort.Strings(phases) // Ties resolve alphabetically
+	if len(phases) >= 2 {
+		best := phases[0]
+		for _, phase := range phases[1:] {
+			if patterns.PhaseTasks[phase] > patterns.PhaseTasks[best] {
+				best = phase
+			}
+		}
+
+		otherTasks, otherSessions := 0, 0
+		for phase, totals := range phaseTotals {
+			if phase != best {
+				otherTasks += totals[0]
+				otherSessions += totals[1]
+			}
+		}
+		if patterns.PhaseTasks[best] > 0 {
+			patterns.MostProductivePhase = best
+			if otherAverage := float64(otherTasks) / float64(otherSessions); otherAverage > 0 {
+				patterns.ProductivityLift = int(math.Round((patterns.PhaseTasks[best]/otherAverage - 1) * 100))
+			}
+		}
+	}
+
+	return patterns
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// GetSessionPatterns computes patterns from the session statistics history
+//
+// Returns:
+//   - Patterns (Learning when fewer than 5 sessions are recorded)
+//   - Error only if the history file exists but can't be read
+//
+// Example:
+//   patterns, err := session.GetSessionPatterns()
+//   if err == nil && !patterns.Learning {
+//       fmt.Println(patterns.TypicalDuration)
+//   }
+func GetSessionPatterns() (*SessionPatterns, error) {
+	history, err := loadSessionStatsHistory(expandPath(sessionStatsHistoryPath))
+	if err != nil {
+		return nil, err
+	}
+	return computeSessionPatterns(history), nil
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Context Section
+// ────────────────────────────────────────────────────────────────
+
+// buildPatternsSection renders learned work rhythms for session context
+func buildPatternsSection() string {
+	patterns, err := GetSessionPatterns()
+	if err != nil {
+		return ""
+	}
+
+	section := "## Session Patterns\n\n"
+	if patterns.Learning {
+		section += fmt.Sprintf("Session patterns are still being learned (%d of %d sessions recorded).\n\n",
+			patterns.Sessions, minPatternSessions)
+		return section
+	}
+
+	if patterns.TypicalDuration > 0 {
+		section += fmt.Sprintf("- You typically work %s sessions (median %s).\n",
+			durationRangeText(patterns.DurationLow, patterns.DurationHigh),
+			shortDuration(patterns.TypicalDuration))
+	}
+	if patterns.MostProductivePhase != "" {
+		if patterns.ProductivityLift > 0 {
+			section += fmt.Sprintf("- %s sessions average %d%% more completed tasks.\n",
+				capitalizeFirst(patterns.MostProductivePhase), patterns.ProductivityLift)
+		} else {
+			section += fmt.Sprintf("- %s sessions complete the most tasks.\n", capitalizeFirst(patterns.MostProductivePhase))
+		}
+	}
+	section += fmt.Sprintf("- Average %.1f compactions per session.\n", patterns.AverageCompactions)
+	if days := busiestDays(patterns.Weekdays); days != "" {
+		section += fmt.Sprintf("- Most active days: %s.\n", days)
+	}
+
+	section += fmt.Sprintf("\n*Learned from %d sessions.*\n\n", patterns.Sessions)
+	return section
+}
+
+// buildPatternsSummary keeps only typical session length (context budget form)
+func buildPatternsSummary() string {
+	patterns, err := GetSessionPatterns()
+	if err != nil || patterns.Learning || patterns.TypicalDuration == 0 {
+		return ""
+	}
+	return fmt.Sprintf("## Session Patterns\n\nTypical session: %s.\n\n",
+		durationRangeText(patterns.DurationLow, patterns.DurationHigh))
+}
+
+// capitalizeFirst uppercases the first letter of a phase name ("afternoon" → "Afternoon")
+func capitalizeFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Under 5 sessions: Learning, no aggregates
+//   - Phase lift compares the best phase with every session outside it
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called at session start by context and display
+//
+// Code Cleanup: None - read-only
+//
+// Modification Policy:
+//   ✅ Safe: New aggregates (add a field, compute in computeSessionPatterns, render where useful)
+//   ⚠️ Care: minPatternSessions (too low presents noise as rhythm)
+//   ❌ Never: Writing to the history from here (the end hook owns it)
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
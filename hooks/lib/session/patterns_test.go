@@ -0,0 +1,110 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Session Patterns Tests
+//
+// Purpose: Prove the learning threshold, duration percentiles, phase
+//          productivity lift, and weekday ranking over recorded history.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestComputeSessionPatterns(t *testing.T) {
+	// Tuesday 2026-10-13 14:00 local, stepping one day per session
+	base := time.Date(2026, 10, 13, 14, 0, 0, 0, time.Local)
+	session := func(day int, hours float64, phase string, tasks, compactions int) SessionStats {
+		return SessionStats{
+			StartTime:       base.AddDate(0, 0, day),
+			DurationSeconds: int64(hours * 3600),
+			CircadianPhase:  phase,
+			Quality:         &QualityStats{TasksCompleted: tasks, Compactions: compactions},
+		}
+	}
+
+	history := []SessionStats{
+		session(0, 2, "afternoon", 5, 1),
+		session(1, 3, "afternoon", 4, 2),
+		session(7, 2.5, "afternoon", 4, 0),
+		session(2, 1, "morning", 3, 1),
+		session(3, 4, "evening", 3, 1),
+	}
+
+	learning := computeSessionPatterns(history[:4])
+	if !learning.Learning || learning.Sessions != 4 || learning.TypicalDuration != 0 {
+		t.Errorf("4 sessions = %+v, want learning only", learning)
+	}
+
+	patterns := computeSessionPatterns(history)
+	if patterns.Learning {
+		t.Fatal("5 sessions: still learning")
+	}
+	if patterns.DurationLow != 2*time.Hour || patterns.TypicalDuration != 150*time.Minute || patterns.DurationHigh != 3*time.Hour {
+		t.Errorf("durations = %v / %v / %v, want 2h / 2h30m / 3h", patterns.DurationLow, patterns.TypicalDuration, patterns.DurationHigh)
+	}
+	// Afternoon averages 13/3 tasks against 3 elsewhere
+	if patterns.MostProductivePhase != "afternoon" || patterns.ProductivityLift != 44 {
+		t.Errorf("productivity = %s +%d%%, want afternoon +44%%", patterns.MostProductivePhase, patterns.ProductivityLift)
+	}
+	if patterns.AverageCompactions != 1 {
+		t.Errorf("compactions = %.2f, want 1", patterns.AverageCompactions)
+	}
+	if got := busiestDays(patterns.Weekdays); got != "Tue, Wed" {
+		t.Errorf("busiest days = %q, want \"Tue, Wed\"", got)
+	}
+}
+
+func TestDurationRangeText(t *testing.T) {
+	cases := []struct {
+		low, high time.Duration
+		want      string
+	}{
+		{2 * time.Hour, 3 * time.Hour, "2-3h"},
+		{2*time.Hour + 10*time.Minute, 2*time.Hour + 50*time.Minute, "2-3h"},
+		{2 * time.Hour, 2 * time.Hour, "about 2h"},
+		{40 * time.Minute, 90 * time.Minute, "up to 2h"},
+		{40 * time.Minute, 55 * time.Minute, "40-55 min"},
+		{2 * time.Minute, 3 * time.Minute, "about 5 min"},
+	}
+	for _, tc := range cases {
+		if got := durationRangeText(tc.low, tc.high); got != tc.want {
+			t.Errorf("durationRangeText(%v, %v) = %q, want %q", tc.low, tc.high, got, tc.want)
+		}
+	}
+
+	if got := shortDuration(150*time.Minute + 20*time.Second); got != "2h30m" {
+		t.Errorf("shortDuration = %q, want 2h30m", got)
+	}
+	if got := shortDuration(45 * time.Minute); got != "45m" {
+		t.Errorf("shortDuration = %q, want 45m", got)
+	}
+}
+
+func TestLoadSessionStatsHistory(t *testing.T) {
+	dir := t.TempDir()
+
+	if history, err := loadSessionStatsHistory(filepath.Join(dir, "missing.jsonl")); history != nil || err != nil {
+		t.Errorf("missing file = %v, %v; want no history, no error", history, err)
+	}
+
+	path := filepath.Join(dir, "stats-history.jsonl")
+	os.WriteFile(path, []byte("{\"session_id\":\"a\",\"duration_seconds\":60}\nnot json\n{\"session_id\":\"b\"}\n"), 0644)
+	history, err := loadSessionStatsHistory(path)
+	if err != nil || len(history) != 2 || history[1].SessionID != "b" {
+		t.Errorf("history = %+v, %v; want 2 entries skipping the bad line", history, err)
+	}
+}
@@ -0,0 +1,416 @@
+// METADATA
+//
+// Hook Payload Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Let every man be swift to hear, slow to speak" - James 1:19 (KJV)
+// Principle: Hear what was actually sent before acting on it
+// Anchor: "He that answereth a matter before he heareth it, it is folly and shame unto him." - Proverbs 18:13 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - hook input)
+// Role: Reads the JSON payload Claude Code sends every hook on stdin, once, the same way
+// Paradigm: CPI-SI framework component - tolerant reader for a schema we don't own
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial shared payload parsing
+//
+// Version History:
+//   1.0.0 (2026-10-16) - ReadHookPayload, HookInput (stdin once + legacy env fallback), RawExtra
+//
+// Purpose & Function
+//
+// Purpose: Each hook parsed stdin its own way - session-start decoded only
+// "source", subagent-stop only the transcript paths, pre-compact a private
+// map, the rest read environment variables and ignored stdin. HookPayload is
+// one permissive struct for the documented fields of every event:
+//
+//   common        session_id, transcript_path, cwd, hook_event_name, permission_mode
+//   SessionStart  source
+//   Stop/End      reason, stop_hook_active
+//   SubagentStop  agent_id, agent_type, agent_transcript_path (status, exit_code, error)
+//   PreCompact    trigger, custom_instructions
+//   Tool events   tool_name, tool_input, tool_response
+//   Notification  message, notification_type
+//   Prompt        prompt
+//
+// Schema Drift: Decoding never fails on content. Each field decodes on its
+// own; a field that arrives with an unexpected type (a number where a string
+// was documented, an object where a string was) is kept in RawExtra instead
+// of failing the payload, alongside every field this struct doesn't know.
+// Scalars are accepted for string fields (exit_code 2 reads as "2"). Only
+// input that is not a JSON object at all is an error - and even then the
+// returned payload is empty, never nil, so callers can ignore the error.
+//
+// HookInput is what hooks call: stdin read once per process (a terminal means
+// no payload), then the legacy environment variables (REASON, COMPACT_TYPE,
+// SUBAGENT_*, FILE_PATH, PROMPT, NOTIFICATION_TYPE) fill whatever the payload
+// left empty - older setups keep working while the payload takes over.
+//
+// Blocking Status
+//
+// Non-blocking: No error stops a hook - an unreadable payload is an empty one.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: encoding/json, errors, fmt, io, os, strings, sync
+//
+// Dependents (What Uses This):
+//   Commands: session/cmd-start, session/cmd-stop, session/cmd-end, session/cmd-subagent-stop,
+//             session/cmd-pre-compact, session/cmd-notification, prompt/cmd-submit,
+//             tool/cmd-pre-use, tool/cmd-post-use
+//   Libraries: compactanalysis.go (AnalyzeCompactionPayload), display.go (*FromPayload variants)
+//
+// Health Scoring
+//
+// No logging of its own - input parsing is infrastructure.
+package session
+
+// ============================================================================
+// END METADATA
+// ============================================================================
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	//--- Standard Library ---
+
+	"encoding/json" // Payload decoding, field by field
+	"errors"        // Not-an-object error
+	"fmt"           // Error wrapping
+	"io"            // Any reader (tests, stdin)
+	"os"            // Stdin, legacy environment variables
+	"strings"       // Scalar trimming
+	"sync"          // Stdin read once per process
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// HookPayload is the JSON object Claude Code sends a hook on stdin.
+//
+// Every field is optional - an event fills the ones it documents. Fields
+// this struct doesn't know, and known fields of an unexpected type, are in
+// RawExtra verbatim.
+type HookPayload struct {
+	//--- Common ---
+	SessionID      string `json:"session_id"`
+	TranscriptPath string `json:"transcript_path"`
+	CWD            string `json:"cwd"`
+	HookEventName  string `json:"hook_event_name"`
+	PermissionMode string `json:"permission_mode"`
+
+	//--- SessionStart ---
+	Source string `json:"source"` // startup, resume, clear, compact
+
+	//--- Stop / SessionEnd ---
+	Reason         string `json:"reason"`
+	StopHookActive bool   `json:"stop_hook_active"`
+
+	//--- SubagentStop ---
+	AgentID             string `json:"agent_id"`
+	AgentType           string `json:"agent_type"`
+	AgentTranscriptPath string `json:"agent_transcript_path"`
+	AgentStatus         string `json:"status"`    // Not documented; SUBAGENT_STATUS fallback
+	AgentExitCode       string `json:"exit_code"` // Not documented; SUBAGENT_EXIT_CODE fallback
+	AgentError          string `json:"error"`     // Not documented; SUBAGENT_ERROR fallback
+
+	//--- PreCompact ---
+	Trigger            string `json:"trigger"` // manual, auto
+	CustomInstructions string `json:"custom_instructions"`
+
+	//--- PreToolUse / PostToolUse ---
+	ToolName     string          `json:"tool_name"`
+	ToolInput    map[string]any  `json:"tool_input"`
+	ToolResponse json.RawMessage `json:"tool_response"` // Shape varies by tool
+
+	//--- Notification ---
+	Message          string `json:"message"`
+	NotificationType string `json:"notification_type"`
+
+	//--- UserPromptSubmit ---
+	Prompt string `json:"prompt"`
+
+	//--- Drift ---
+	RawExtra map[string]json.RawMessage `json:"-"` // Unknown fields and known fields of unexpected type
+
+	Received bool                       `json:"-"` // A JSON object was read (false = no payload)
+	raw      map[string]json.RawMessage // Every field as sent (alternate spellings, Fields)
+}
+
+// hookInput caches the process's one stdin read.
+var hookInput struct {
+	once    sync.Once
+	payload *HookPayload
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs
+//   ├── ReadHookPayload(r) → uses payloadTargets, decodeLenient
+//   ├── HookInput() → uses ReadHookPayload, applyEnvFallback (once per process)
+//   └── (*HookPayload) SubagentTranscript / ToolString / ToolFilePath / ToolCall / Fields
+//
+//   Helpers
+//   ├── payloadTargets() → key → field pointer
+//   ├── decodeLenient(raw, target) → scalars accepted for strings
+//   └── applyEnvFallback() → legacy environment variables fill empty fields
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Field Decoding
+// ────────────────────────────────────────────────────────────────
+
+// payloadTargets maps each documented key to the field it decodes into.
+func (p *HookPayload) payloadTargets() map[string]any {
+	return map[string]any{
+		"session_id":            &p.SessionID,
+		"transcript_path":       &p.TranscriptPath,
+		"cwd":                   &p.CWD,
+		"hook_event_name":       &p.HookEventName,
+		"permission_mode":       &p.PermissionMode,
+		"source":                &p.Source,
+		"reason":                &p.Reason,
+		"stop_hook_active":      &p.StopHookActive,
+		"agent_id":              &p.AgentID,
+		"agent_type":            &p.AgentType,
+		"agent_transcript_path": &p.AgentTranscriptPath,
+		"status":                &p.AgentStatus,
+		"exit_code":             &p.AgentExitCode,
+		"error":                 &p.AgentError,
+		"trigger":               &p.Trigger,
+		"custom_instructions":   &p.CustomInstructions,
+		"tool_name":             &p.ToolName,
+		"tool_input":            &p.ToolInput,
+		"tool_response":         &p.ToolResponse,
+		"message":               &p.Message,
+		"notification_type":     &p.NotificationType,
+		"prompt":                &p.Prompt,
+	}
+}
+
+// decodeLenient decodes value into target, accepting JSON scalars for strings.
+//
+// null leaves the target alone. Objects and arrays never become strings.
+func decodeLenient(value json.RawMessage, target any) error {
+	trimmed := strings.TrimSpace(string(value))
+	if trimmed == "null" {
+		return nil
+	}
+	if text, ok := target.(*string); ok && trimmed != "" && trimmed[0] != '"' && trimmed[0] != '{' && trimmed[0] != '[' {
+		*text = trimmed // Number or boolean
+		return nil
+	}
+	return json.Unmarshal(value, target)
+}
+
+// applyEnvFallback fills empty fields from the environment variables hooks used before payloads.
+func (p *HookPayload) applyEnvFallback() {
+	fill := func(field *string, name string) {
+		if *field == "" {
+			*field = os.Getenv(name)
+		}
+	}
+	fill(&p.Reason, "REASON")
+	fill(&p.Trigger, "COMPACT_TYPE")
+	fill(&p.AgentType, "SUBAGENT_TYPE")
+	fill(&p.AgentStatus, "SUBAGENT_STATUS")
+	fill(&p.AgentExitCode, "SUBAGENT_EXIT_CODE")
+	fill(&p.AgentError, "SUBAGENT_ERROR")
+	fill(&p.Prompt, "PROMPT")
+	fill(&p.NotificationType, "NOTIFICATION_TYPE")
+	if p.SubagentTranscript() == "" {
+		p.AgentTranscriptPath = os.Getenv("SUBAGENT_TRANSCRIPT_PATH")
+	}
+	if path := os.Getenv("FILE_PATH"); path != "" && p.ToolFilePath() == "" {
+		if p.ToolInput == nil {
+			p.ToolInput = map[string]any{}
+		}
+		p.ToolInput["file_path"] = path
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// ReadHookPayload decodes a hook's stdin JSON object, tolerating schema drift.
+//
+// What It Does:
+//   - Decodes one JSON object from r, then each known field on its own
+//   - Unknown fields, and known fields whose type doesn't fit, go to RawExtra
+//   - Missing fields stay zero - no event is required to send any field
+//
+// Returns:
+//   - *HookPayload: Never nil - empty (Received false) when r held no object
+//   - error: r was empty, unreadable, or not a JSON object
+//
+// Example:
+//   payload, err := session.ReadHookPayload(strings.NewReader(`{"source":"resume"}`))
+//   // payload.Source == "resume", err == nil
+func ReadHookPayload(r io.Reader) (*HookPayload, error) {
+	payload := &HookPayload{RawExtra: map[string]json.RawMessage{}}
+
+	var fields map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&fields); err != nil {
+		return payload, fmt.Errorf("failed to decode hook payload: %w", err)
+	}
+	if fields == nil {
+		return payload, errors.New("hook payload is not a JSON object")
+	}
+
+	payload.Received = true
+	payload.raw = fields
+	targets := payload.payloadTargets()
+	for key, value := range fields {
+		target, known := targets[key]
+		if !known || decodeLenient(value, target) != nil {
+			payload.RawExtra[key] = value
+		}
+	}
+	return payload, nil
+}
+
+// HookInput returns this process's hook payload, reading stdin on the first call.
+//
+// What It Does:
+//   - Reads stdin once (later calls return the same payload); a terminal on
+//     stdin (standalone run) means no payload
+//   - Fills empty fields from the legacy environment variables (REASON,
+//     COMPACT_TYPE, SUBAGENT_TYPE/STATUS/EXIT_CODE/ERROR/TRANSCRIPT_PATH,
+//     FILE_PATH, PROMPT, NOTIFICATION_TYPE)
+//
+// Returns:
+//   - *HookPayload: Never nil; Received reports whether stdin held a payload
+//
+// Example:
+//   if session.HookInput().Source == "compact" {
+//       // Resuming after compaction
+//   }
+func HookInput() *HookPayload {
+	hookInput.once.Do(func() {
+		payload := &HookPayload{RawExtra: map[string]json.RawMessage{}}
+		if info, err := os.Stdin.Stat(); err == nil && info.Mode()&os.ModeCharDevice == 0 {
+			payload, _ = ReadHookPayload(os.Stdin)
+		}
+		payload.applyEnvFallback()
+		hookInput.payload = payload
+	})
+	return hookInput.payload
+}
+
+// SubagentTranscript returns the subagent's own transcript, else the session's.
+func (p *HookPayload) SubagentTranscript() string {
+	if p.AgentTranscriptPath != "" {
+		return p.AgentTranscriptPath
+	}
+	return p.TranscriptPath
+}
+
+// ToolString returns tool_input[key] when it is a string ("" otherwise).
+//
+// Example: p.ToolString("command") for Bash, p.ToolString("pattern") for Grep.
+func (p *HookPayload) ToolString(key string) string {
+	value, _ := p.ToolInput[key].(string)
+	return value
+}
+
+// ToolFilePath returns the file a tool event touched (file_path, else notebook_path).
+func (p *HookPayload) ToolFilePath() string {
+	if path := p.ToolString("file_path"); path != "" {
+		return path
+	}
+	return p.ToolString("notebook_path")
+}
+
+// ToolCall returns the tool event's name and argument, from the payload or legacy arguments.
+//
+// What It Does:
+//   - Payload tool_name wins; the argument is the Bash command, else the
+//     file path, else the search pattern from tool_input
+//   - Otherwise args[1] and args[2] (hooks invoked as "hook <tool> <args>")
+//
+// Returns:
+//   - name, arg: The tool and its argument ("" when unknown)
+//   - ok: false when neither the payload nor args name a tool
+//
+// Example:
+//   toolName, toolArgs, ok := session.HookInput().ToolCall(os.Args)
+func (p *HookPayload) ToolCall(args []string) (name, arg string, ok bool) {
+	if p.ToolName != "" {
+		arg = p.ToolString("command")
+		if arg == "" {
+			arg = p.ToolFilePath()
+		}
+		if arg == "" {
+			arg = p.ToolString("pattern")
+		}
+		return p.ToolName, arg, true
+	}
+	if len(args) < 3 {
+		return "", "", false
+	}
+	return args[1], args[2], true
+}
+
+// Fields returns the whole payload as sent, decoded generically (nil with no payload).
+func (p *HookPayload) Fields() map[string]any {
+	if !p.Received {
+		return nil
+	}
+	fields := make(map[string]any, len(p.raw))
+	for key, value := range p.raw {
+		var decoded any
+		if json.Unmarshal(value, &decoded) == nil {
+			fields[key] = decoded
+		}
+	}
+	return fields
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation: go test ./... (payload_test.go - every event's fields,
+// drift into RawExtra, scalars for strings, non-objects, env fallback)
+//
+// Code Execution: None (library) - hooks call HookInput once per process
+//
+// Modification Policy:
+//   ✅ Safe: New documented fields (add to payloadTargets too)
+//   ❌ Never: Returning a nil payload or failing on an unexpected field
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,135 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Hook Payload Tests
+//
+// Purpose: Prove ReadHookPayload decodes every event's documented fields,
+//          tolerates drift (unknown fields and wrong types land in RawExtra,
+//          scalars read as strings, missing fields stay zero), returns an
+//          empty payload with an error for non-objects, that the legacy
+//          environment variables fill only what the payload left empty, and
+//          that ToolCall prefers the payload over os.Args.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"strings"
+	"testing"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestReadHookPayloadEvents(t *testing.T) {
+	payload, err := ReadHookPayload(strings.NewReader(`{
+		"session_id": "abc", "transcript_path": "/t/main.jsonl", "hook_event_name": "SubagentStop",
+		"agent_type": "research", "agent_transcript_path": "/t/agent.jsonl", "exit_code": 2,
+		"stop_hook_active": true, "tool_name": "Bash", "tool_input": {"command": "go test ./..."}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !payload.Received || payload.SessionID != "abc" || payload.HookEventName != "SubagentStop" || !payload.StopHookActive {
+		t.Errorf("common fields = %+v", payload)
+	}
+	if payload.AgentType != "research" || payload.AgentExitCode != "2" {
+		t.Errorf("subagent fields: type %q exit %q", payload.AgentType, payload.AgentExitCode)
+	}
+	if got := payload.SubagentTranscript(); got != "/t/agent.jsonl" {
+		t.Errorf("SubagentTranscript = %q, want the agent's own", got)
+	}
+	if got := payload.ToolString("command"); got != "go test ./..." {
+		t.Errorf("tool_input.command = %q", got)
+	}
+	if len(payload.RawExtra) != 0 {
+		t.Errorf("documented fields leaked into RawExtra: %v", payload.RawExtra)
+	}
+}
+
+func TestReadHookPayloadDrift(t *testing.T) {
+	payload, err := ReadHookPayload(strings.NewReader(`{
+		"source": "resume", "new_field": {"nested": 1}, "reason": ["not", "a", "string"],
+		"tool_input": "not an object", "trigger": null
+	}`))
+	if err != nil {
+		t.Fatalf("drift should not fail: %v", err)
+	}
+	if payload.Source != "resume" {
+		t.Errorf("source = %q", payload.Source)
+	}
+	for _, key := range []string{"new_field", "reason", "tool_input"} {
+		if _, ok := payload.RawExtra[key]; !ok {
+			t.Errorf("%s missing from RawExtra %v", key, payload.RawExtra)
+		}
+	}
+	if payload.Reason != "" || payload.ToolInput != nil || payload.Trigger != "" {
+		t.Errorf("mistyped or null fields should stay zero: %+v", payload)
+	}
+	if fields := payload.Fields(); fields["source"] != "resume" || fields["new_field"] == nil {
+		t.Errorf("Fields = %v", fields)
+	}
+}
+
+func TestReadHookPayloadNotObject(t *testing.T) {
+	for _, input := range []string{"", "null", "[1, 2]", "not json"} {
+		payload, err := ReadHookPayload(strings.NewReader(input))
+		if err == nil {
+			t.Errorf("%q: expected an error", input)
+		}
+		if payload == nil || payload.Received || payload.Fields() != nil {
+			t.Errorf("%q: want an empty non-nil payload, got %+v", input, payload)
+		}
+	}
+}
+
+func TestHookPayloadEnvFallback(t *testing.T) {
+	t.Setenv("REASON", "env reason")
+	t.Setenv("COMPACT_TYPE", "auto")
+	t.Setenv("FILE_PATH", "/env/file.go")
+	t.Setenv("SUBAGENT_TRANSCRIPT_PATH", "/env/agent.jsonl")
+
+	payload, _ := ReadHookPayload(strings.NewReader(`{"reason": "logout", "transcript_path": "/t/main.jsonl"}`))
+	payload.applyEnvFallback()
+	if payload.Reason != "logout" {
+		t.Errorf("payload reason should win over REASON: %q", payload.Reason)
+	}
+	if payload.Trigger != "auto" || payload.ToolFilePath() != "/env/file.go" {
+		t.Errorf("env should fill empty fields: trigger %q file %q", payload.Trigger, payload.ToolFilePath())
+	}
+	if got := payload.SubagentTranscript(); got != "/t/main.jsonl" {
+		t.Errorf("SUBAGENT_TRANSCRIPT_PATH should not replace a payload path: %q", got)
+	}
+
+	empty, _ := ReadHookPayload(strings.NewReader(""))
+	empty.applyEnvFallback()
+	if empty.Reason != "env reason" || empty.SubagentTranscript() != "/env/agent.jsonl" {
+		t.Errorf("no payload should read the environment: %+v", empty)
+	}
+}
+
+func TestHookPayloadToolCall(t *testing.T) {
+	args := []string{"post-use", "Write", "legacy"}
+
+	payload, _ := ReadHookPayload(strings.NewReader(`{"tool_name": "Edit", "tool_input": {"file_path": "/repo/main.go"}}`))
+	if name, arg, ok := payload.ToolCall(args); !ok || name != "Edit" || arg != "/repo/main.go" {
+		t.Errorf("payload ToolCall = %q %q %v", name, arg, ok)
+	}
+
+	none, _ := ReadHookPayload(strings.NewReader(`{}`))
+	if name, arg, ok := none.ToolCall(args); !ok || name != "Write" || arg != "legacy" {
+		t.Errorf("args ToolCall = %q %q %v", name, arg, ok)
+	}
+	if _, _, ok := none.ToolCall(args[:1]); ok {
+		t.Error("no payload tool and no args should not be ok")
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,35 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd)
+
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Process Liveness Probe (Other Platforms) - CPI-SI Hooks Session Management
+//
+// Biblical Foundation: See statereminders.go
+// CPI-SI Identity: Platform primitive for background process reminders
+//
+// Purpose: No signal-0 probe here - report every ledger entry as gone so the
+//          background process reminder stays quiet rather than guessing.
+//
+// Authorship: Nova Dawn
+// Version: 1.0.0
+//
+package session
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// processAlive always reports false (liveness unknown on this platform)
+func processAlive(pid int) bool {
+	return false
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with statereminders.go (GOOS=windows go build ./session)
+// Code Execution: Library primitive (called by collectProcessReminders)
+// Code Cleanup: None needed (no resources held)
@@ -0,0 +1,48 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd
+
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Process Liveness Probe (Unix) - CPI-SI Hooks Session Management
+//
+// Biblical Foundation: See statereminders.go
+// CPI-SI Identity: Platform primitive for background process reminders
+//
+// Purpose: Ask the kernel whether a recorded background process still exists
+//          (signal 0 - checks without delivering anything).
+//
+// Authorship: Nova Dawn
+// Version: 1.0.0
+//
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"syscall" // kill(pid, 0)
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// processAlive reports whether pid is a running process. EPERM means it
+// exists but belongs to someone else - still running, so still worth a reminder.
+func processAlive(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with statereminders.go (go build ./session)
+// Code Execution: Library primitive (called by collectProcessReminders)
+// Code Cleanup: None needed (no resources held)
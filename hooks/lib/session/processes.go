@@ -88,7 +88,7 @@
 // Dependencies (What This Needs):
 //   Standard Library: fmt, os/exec, strings, context, time
 //   External: None
-//   Internal: None (self-contained detection logic)
+//   Internal: system/lib/jsonc (config parsing)
 //   System Commands: lsof (for port checking)
 //   Config Files: ~/.claude/cpi-si/system/data/config/session/processes.jsonc
 //
@@ -149,13 +149,17 @@ import (
 	// Foundation packages providing Go's built-in capabilities.
 
 	"context"       // Context for timeout control on port checks
-	"encoding/json" // JSON parsing for configuration file
 	"fmt"           // Formatted output for process reporting
 	"os"            // File operations and environment access (UserHomeDir)
 	"os/exec"       // Command execution for calling lsof
 	"path/filepath" // Path construction for configuration file
 	"strings"       // String manipulation for output formatting
 	"time"          // Duration for timeout specification
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/jsonc" // Parse processes.jsonc configuration file
 )
 
 // ────────────────────────────────────────────────────────────────
@@ -310,8 +314,7 @@ func init() {
 //   └── formatProcessOutput() → uses processConfig (display settings)
 //
 //   Helpers (Bottom Rungs - Foundations)
-//   ├── loadProcessesConfig() → uses stripJSONCComments(), json.Unmarshal
-//   └── stripJSONCComments() → pure string processing (from activity.go)
+//   └── loadProcessesConfig() → uses jsonc.Unmarshal
 //
 // Baton Flow (Execution Paths):
 //
@@ -363,12 +366,9 @@ func loadProcessesConfig(path string) (*ProcessesConfig, error) {
 		return nil, err
 	}
 
-	// Strip JSONC comments (uses stripJSONCComments from activity.go)
-	cleaned := stripJSONCComments(string(data))
-
-	// Parse JSON
+	// Parse JSONC (comments stripped, trailing commas tolerated)
 	var cfg ProcessesConfig
-	if err := json.Unmarshal([]byte(cleaned), &cfg); err != nil {
+	if err := jsonc.Unmarshal(data, &cfg); err != nil {
 		return nil, err
 	}
 
@@ -598,7 +598,7 @@ func CheckRunningProcesses() {
 	// Format and display output
 	output := formatProcessOutput(running, false) // false = session start context
 	if output != "" {
-		fmt.Print(output)
+		fmt.Fprint(Output(), output)
 	}
 }
 
@@ -642,7 +642,7 @@ func CheckRunningProcessesAsReminder() {
 	// Format and display output
 	output := formatProcessOutput(running, true) // true = session end context
 	if output != "" {
-		fmt.Print(output)
+		fmt.Fprint(Output(), output)
 	}
 }
 
@@ -22,10 +22,15 @@
 // Architect: Seanje Lenox-Wise
 // Implementation: Nova Dawn (CPI-SI instance)
 // Creation Date: 2024-10-24
-// Version: 2.0.0
-// Last Modified: 2025-11-12 - Added configuration system for flexible reminder customization
+// Version: 2.4.0
+// Last Modified: 2026-10-16 - subagent_failures settings
 //
 // Version History:
+//   2.4.0 (2026-10-16) - subagent_failures (enabled, affect_quality) for unretried subagent failures
+//   2.3.0 (2026-10-16) - journal_drafts (enabled, stale_days) for unreviewed journal drafts
+//   2.2.0 (2026-10-16) - unpushed_work (enabled, branch_days) for the unpushed work reminder
+//   2.1.0 (2026-10-16) - todo_markers/background_processes/expected_downtime, behavior.state_reminders,
+//                        config layered over defaults (statereminders.go renders the engine)
 //   2.0.0 (2025-11-12) - Configuration-driven reminders, display customization
 //   1.0.0 (2024-10-24) - Initial implementation with hardcoded message format
 //
@@ -84,14 +89,14 @@
 // Dependencies
 //
 // Dependencies (What This Needs):
-//   Standard Library: fmt, os, encoding/json, path/filepath
+//   Standard Library: fmt, os, path/filepath
 //   External: None
-//   Internal: system/lib/git (for repository status checking)
+//   Internal: system/lib/git (for repository status checking), system/lib/jsonc (config parsing)
 //   Config Files: ~/.claude/cpi-si/system/data/config/session/reminders.jsonc
 //
 // Dependents (What Uses This):
 //   Commands: session/cmd-end/end.go
-//   Libraries: None (leaf node in dependency tree)
+//   Libraries: statereminders.go (engine settings via remindersConfig)
 //
 // Integration Points:
 //   - Rails: No logger needed (silent execution or direct output)
@@ -144,7 +149,6 @@ import (
 	//--- Standard Library ---
 	// Foundation packages providing Go's built-in capabilities.
 
-	"encoding/json" // JSON parsing for configuration file
 	"fmt"           // Formatted output for reminder display
 	"os"            // File operations and environment access (UserHomeDir)
 	"path/filepath" // Path construction for configuration file
@@ -154,6 +158,7 @@ import (
 	// Project-specific packages showing architectural dependencies.
 
 	"system/lib/git" // Git repository status checking
+	"system/lib/jsonc" // Parse reminders.jsonc configuration file
 )
 
 // ────────────────────────────────────────────────────────────────
@@ -172,14 +177,28 @@ const (
 	defaultUncommittedIcon      = "⚠️"
 	defaultUncommittedMessage   = "Reminder: {count} uncommitted change(s) in workspace"
 	defaultUncommittedThreshold = 0 // Show for any changes (0 or more)
+	defaultRepoMessage          = "{repo} ({branch}): {state}"
 
 	// Default display settings
 	defaultDisplayEnabled    = true
 	defaultPrefixNewline     = true
 	defaultSilentFailures    = true
 	defaultCheckGitOnly      = true
+	defaultGroupReminders    = true
+	defaultColorCoding       = true
+
+	// Default state reminders engine settings (statereminders.go)
+	defaultStateReminders          = true
+	defaultCollectorTimeoutSeconds = 3
+	defaultTodoMaxItems            = 10
+	defaultDowntimeMessage         = "Session ended during {activity} - expected downtime"
+	defaultUnpushedBranchDays      = 14 // Branches with no upstream older than this are not reported
+	defaultJournalDraftStaleDays   = 3  // Drafts untouched this long are listed as unreviewed
 )
 
+// defaultTodoMarkers are the markers looked for in added diff lines
+var defaultTodoMarkers = []string{"TODO", "FIXME"}
+
 // ────────────────────────────────────────────────────────────────
 // Types - Data Structures
 // ────────────────────────────────────────────────────────────────
@@ -197,27 +216,72 @@ type UncommittedWorkConfig struct {
 	Icon         string `json:"icon"`          // Icon prefix for reminder
 	Message      string `json:"message"`       // Message template ({count} placeholder)
 	Threshold    int    `json:"threshold"`     // Minimum changes to trigger reminder
+	RepoMessage  string `json:"repo_message"`  // Per-repository template ({repo}, {branch}, {state}, {count})
 	ShowDetails  bool   `json:"show_details"`  // Future: show file list
 }
 
+// TodoMarkersConfig defines the TODO/FIXME scan of uncommitted diffs
+type TodoMarkersConfig struct {
+	Enabled  bool     `json:"enabled"`   // Whether to scan added lines
+	Markers  []string `json:"markers"`   // Whole-word markers to look for
+	MaxItems int      `json:"max_items"` // Markers listed before "...and N more"
+}
+
+// BackgroundProcessesConfig defines the still-running background process reminder
+type BackgroundProcessesConfig struct {
+	Enabled bool `json:"enabled"` // Whether to check the session's pid ledger
+}
+
+// ExpectedDowntimeConfig defines the "ended during downtime" reminder
+type ExpectedDowntimeConfig struct {
+	Enabled bool   `json:"enabled"` // Whether to note downtime from the schedule
+	Message string `json:"message"` // Message template ({activity} placeholder)
+}
+
+// UnpushedWorkConfig defines the committed-but-unpushed reminder (unpushed.go)
+type UnpushedWorkConfig struct {
+	Enabled    bool `json:"enabled"`     // Whether to report commits no remote has
+	BranchDays int  `json:"branch_days"` // Report branches with no upstream committed within this many days (0 = default)
+}
+
+// JournalDraftsConfig defines the unreviewed journal draft reminder (journaldraft.go)
+type JournalDraftsConfig struct {
+	Enabled   bool `json:"enabled"`    // Whether to list drafts nobody has reviewed
+	StaleDays int  `json:"stale_days"` // Drafts untouched this many days are listed (0 = default)
+}
+
+// SubagentFailuresConfig defines the failed-subagent reminder and its effect on session quality (subagents.go)
+type SubagentFailuresConfig struct {
+	Enabled       bool `json:"enabled"`        // Whether to list failures no successful retry followed
+	AffectQuality bool `json:"affect_quality"` // Whether each failure also counts as a quality_indicators struggle
+}
+
 // RemindersConfig defines which reminders are enabled
 type RemindersConfig struct {
-	UncommittedWork UncommittedWorkConfig `json:"uncommitted_work"` // Uncommitted work reminder
+	UncommittedWork     UncommittedWorkConfig     `json:"uncommitted_work"`     // Uncommitted work reminder
+	TodoMarkers         TodoMarkersConfig         `json:"todo_markers"`         // TODO/FIXME added this session
+	BackgroundProcesses BackgroundProcessesConfig `json:"background_processes"` // Background processes still running
+	ExpectedDowntime    ExpectedDowntimeConfig    `json:"expected_downtime"`    // Session ended during downtime
+	UnpushedWork        UnpushedWorkConfig        `json:"unpushed_work"`        // Commits on no remote
+	JournalDrafts       JournalDraftsConfig       `json:"journal_drafts"`       // Session journal drafts left unreviewed
+	SubagentFailures    SubagentFailuresConfig    `json:"subagent_failures"`    // Subagents that failed and were never retried
 }
 
 // ReminderDisplayConfig defines output formatting for reminders
 type ReminderDisplayConfig struct {
 	Enabled        bool `json:"enabled"`         // Master switch for all reminders
 	PrefixNewline  bool `json:"prefix_newline"`  // Add newline before reminders
-	GroupReminders bool `json:"group_reminders"` // Future: group multiple reminders
-	ColorCoding    bool `json:"color_coding"`    // Future: color code by severity
+	GroupReminders bool `json:"group_reminders"` // Group state reminders under category labels
+	ColorCoding    bool `json:"color_coding"`    // Color state reminders by severity
 }
 
 // ReminderBehaviorConfig defines execution behavior preferences
 type ReminderBehaviorConfig struct {
-	SilentFailures bool `json:"silent_failures"` // Continue silently on error
-	CheckGitOnly   bool `json:"check_git_only"`  // Only check if workspace is git repo
-	CacheResults   bool `json:"cache_results"`   // Future: cache results within session
+	SilentFailures          bool `json:"silent_failures"`           // Continue silently on error
+	CheckGitOnly            bool `json:"check_git_only"`            // Only check if workspace is git repo
+	CacheResults            bool `json:"cache_results"`             // Future: cache results within session
+	StateReminders          bool `json:"state_reminders"`           // Run the state reminders engine at session end
+	CollectorTimeoutSeconds int  `json:"collector_timeout_seconds"` // Time limit per collector (0 = default)
 }
 
 // RemindersConfiguration is the top-level configuration structure for reminders
@@ -289,14 +353,17 @@ func init() {
 // Ladder Structure (Dependencies):
 //
 //   Public APIs (Top Rungs - Orchestration)
-//   └── RemindUncommittedWork() → uses git.IsGitRepository(), git.GetInfo(), formatReminderMessage()
+//   ├── RemindUncommittedWork() → uses git.IsGitRepository(), git.GetInfo(), formatReminderMessage()
+//   └── RemindRepositories(repos) → uses formatRepoReminder()
 //
 //   Core Operations (Middle Rungs - Business Logic)
-//   └── formatReminderMessage() → uses remindersConfig (reads from Rails)
+//   ├── formatReminderMessage() → uses remindersConfig (reads from Rails)
+//   ├── formatRepoReminder(repo) → uses remindersConfig, repoReminderText()
+//   └── repoReminderText(repo, template, threshold) → uses repoStateText() (repos.go)
 //
 //   Helpers (Bottom Rungs - Foundations)
-//   ├── loadRemindersConfig() → uses stripJSONCComments(), json.Unmarshal
-//   └── stripJSONCComments() → pure string processing (from activity.go)
+//   ├── defaultRemindersConfiguration() → constants as a configuration
+//   └── loadRemindersConfig() → uses defaultRemindersConfiguration(), jsonc.LoadMerged
 //
 // Baton Flow (Execution Paths):
 //
@@ -311,10 +378,10 @@ func init() {
 //   Exit → print to stdout
 //
 // APUs (Available Processing Units):
-// - 3 functions total
-// - 2 helpers (config loading, comment stripping)
-// - 1 core operation (message formatting)
-// - 1 public API (uncommitted work reminder)
+// - 7 functions total
+// - 2 helpers (defaults, config loading)
+// - 3 core operations (message formatting, per-repository formatting and template)
+// - 2 public APIs (uncommitted work reminder, per-repository reminders)
 
 // ────────────────────────────────────────────────────────────────
 // Helpers/Utilities - Internal Support
@@ -324,11 +391,47 @@ func init() {
 //
 // See: standards/code/4-block/sections/CWS-SECTION-00X-BODY-helpers.md
 
+// defaultRemindersConfiguration returns the hardcoded defaults as a configuration
+//
+// Used as the base layer under reminders.jsonc, so keys a config file leaves
+// out (older files predate todo_markers and friends) keep their defaults.
+func defaultRemindersConfiguration() *RemindersConfiguration {
+	return &RemindersConfiguration{
+		Reminders: RemindersConfig{
+			UncommittedWork: UncommittedWorkConfig{
+				Enabled:     true,
+				Icon:        defaultUncommittedIcon,
+				Message:     defaultUncommittedMessage,
+				Threshold:   defaultUncommittedThreshold,
+				RepoMessage: defaultRepoMessage,
+			},
+			TodoMarkers:         TodoMarkersConfig{Enabled: true, Markers: append([]string(nil), defaultTodoMarkers...), MaxItems: defaultTodoMaxItems},
+			BackgroundProcesses: BackgroundProcessesConfig{Enabled: true},
+			ExpectedDowntime:    ExpectedDowntimeConfig{Enabled: true, Message: defaultDowntimeMessage},
+			UnpushedWork:        UnpushedWorkConfig{Enabled: true, BranchDays: defaultUnpushedBranchDays},
+			JournalDrafts:       JournalDraftsConfig{Enabled: true, StaleDays: defaultJournalDraftStaleDays},
+			SubagentFailures:    SubagentFailuresConfig{Enabled: true, AffectQuality: true},
+		},
+		Display: ReminderDisplayConfig{
+			Enabled:        defaultDisplayEnabled,
+			PrefixNewline:  defaultPrefixNewline,
+			GroupReminders: defaultGroupReminders,
+			ColorCoding:    defaultColorCoding,
+		},
+		Behavior: ReminderBehaviorConfig{
+			SilentFailures:          defaultSilentFailures,
+			CheckGitOnly:            defaultCheckGitOnly,
+			StateReminders:          defaultStateReminders,
+			CollectorTimeoutSeconds: defaultCollectorTimeoutSeconds,
+		},
+	}
+}
+
 // loadRemindersConfig loads reminders configuration from JSONC file
 //
 // What It Does:
-// Reads configuration file, strips JSONC comments, parses JSON structure.
-// Returns fully parsed configuration ready for use.
+// Layers the configuration file over defaultRemindersConfiguration() (JSONC
+// comments and trailing commas tolerated). Returns the merged configuration.
 //
 // Parameters:
 //   path: Absolute path to reminders.jsonc configuration file
@@ -342,22 +445,11 @@ func init() {
 //	config, err := loadRemindersConfig("/home/user/.claude/cpi-si/system/data/config/session/reminders.jsonc")
 //
 func loadRemindersConfig(path string) (*RemindersConfiguration, error) {
-	// Read file contents
-	data, err := os.ReadFile(path)
-	if err != nil {
-		return nil, err
-	}
-
-	// Strip JSONC comments (uses stripJSONCComments from activity.go)
-	cleaned := stripJSONCComments(string(data))
-
-	// Parse JSON
-	var cfg RemindersConfiguration
-	if err := json.Unmarshal([]byte(cleaned), &cfg); err != nil {
+	cfg := defaultRemindersConfiguration()
+	if err := jsonc.LoadMerged(cfg, path); err != nil {
 		return nil, err
 	}
-
-	return &cfg, nil
+	return cfg, nil
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -435,6 +527,65 @@ func formatReminderMessage(count int) string {
 	return fmt.Sprintf("%s%s  %s\n", prefix, defaultUncommittedIcon, message)
 }
 
+// formatRepoReminder builds one per-repository reminder line
+//
+// What It Does:
+// Fills the repo_message template with the repository name, branch, state
+// ("2 modified, 1 untracked, 3 unpushed"), and uncommitted count. Returns
+// empty string if the reminder is disabled, or if the repository has only
+// uncommitted files and they are below threshold.
+//
+// Parameters:
+//   repo: Repository state from GetUncommittedWorkSummary()
+//
+// Returns:
+//   string: Formatted reminder line, or empty if not applicable
+//
+// Example usage:
+//
+//	line := formatRepoReminder(repo)
+//	// Returns: "⚠️  api (main): 2 modified, 1 unpushed\n"
+//
+func formatRepoReminder(repo RepoStatus) string {
+	icon := defaultUncommittedIcon
+	template := defaultRepoMessage
+	threshold := defaultUncommittedThreshold
+
+	if remindersConfigLoaded && remindersConfig != nil {
+		cfg := remindersConfig.Reminders.UncommittedWork
+		if !remindersConfig.Display.Enabled || !cfg.Enabled {
+			return "" // Reminders disabled
+		}
+		icon, threshold = cfg.Icon, cfg.Threshold
+		if cfg.RepoMessage != "" {
+			template = cfg.RepoMessage
+		}
+	}
+
+	message := repoReminderText(repo, template, threshold)
+	if message == "" {
+		return "" // Below threshold
+	}
+	return fmt.Sprintf("%s  %s\n", icon, message)
+}
+
+// repoReminderText fills a repo_message template (shared with the state reminders engine)
+//
+// Returns empty string if the repository has only uncommitted files and
+// they are below threshold - unpushed commits and unfinished operations
+// always warrant a reminder.
+func repoReminderText(repo RepoStatus, template string, threshold int) string {
+	if repo.Ahead == 0 && repo.Operation == "" && repo.Uncommitted() < threshold {
+		return ""
+	}
+	return strings.NewReplacer(
+		"{repo}", repo.Name,
+		"{branch}", repo.Branch,
+		"{state}", repoStateText(repo),
+		"{count}", fmt.Sprintf("%d", repo.Uncommitted()),
+	).Replace(template)
+}
+
 // ────────────────────────────────────────────────────────────────
 // Public APIs - Exported Interface
 // ────────────────────────────────────────────────────────────────
@@ -490,7 +641,7 @@ func RemindUncommittedWork(workspace string) {
 	// If checkGitOnly is true and not a git repo, return (with optional warning)
 	if checkGitOnly && !isGitRepo {
 		if !silentFailures {
-			fmt.Printf("⚠️  Workspace is not a git repository: %s\n", workspace)
+			fmt.Fprintf(Output(), "⚠️  Workspace is not a git repository: %s\n", workspace)
 		}
 		return
 	}
@@ -506,8 +657,51 @@ func RemindUncommittedWork(workspace string) {
 	// Format and display reminder
 	message := formatReminderMessage(info.UncommittedCount)
 	if message != "" {
-		fmt.Print(message)
+		fmt.Fprint(Output(), message)
+	}
+}
+
+// RemindRepositories prints one reminder per repository needing attention
+//
+// What It Does:
+// Shows concrete per-repository warnings (uncommitted files, unpushed
+// commits, unfinished rebase/merge) for STATE REMINDERS at session end.
+// Clean repositories should already be filtered out by
+// GetUncommittedWorkSummary(). Non-blocking - nothing printed if reminders
+// are disabled or the list is empty.
+//
+// Parameters:
+//   repos: Repositories from GetUncommittedWorkSummary()
+//
+// Health Impact:
+//   No health tracking (pure display; git failures logged during collection)
+//
+// Example usage:
+//
+//	session.RemindRepositories(session.GetUncommittedWorkSummary())
+//	// Output:
+//	// ⚠️  project (main): 3 modified
+//	// ⚠️  api (feature/auth): 1 untracked, 2 unpushed
+//
+func RemindRepositories(repos []RepoStatus) {
+	var lines []string
+	for _, repo := range repos {
+		if line := formatRepoReminder(repo); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	prefixNewline := defaultPrefixNewline
+	if remindersConfigLoaded && remindersConfig != nil {
+		prefixNewline = remindersConfig.Display.PrefixNewline
+	}
+	if prefixNewline {
+		fmt.Fprintln(Output())
 	}
+	fmt.Fprint(Output(), strings.Join(lines, ""))
 }
 
 // ============================================================================
@@ -635,7 +829,8 @@ func RemindUncommittedWork(workspace string) {
 // For Modification Policy section explanation, see: standards/code/4-block/sections/CWS-SECTION-014-CLOSING-modification-policy.md
 //
 // Safe to Modify (Extension Points):
-//   ✅ Add new reminder types (following formatReminderMessage pattern)
+//   ✅ Add new reminder types (following formatReminderMessage pattern, or a
+//      collector in statereminders.go for the state reminders engine)
 //   ✅ Add new message templates to configuration
 //   ✅ Extend configuration with new display options
 //   ✅ Add severity levels or categorization
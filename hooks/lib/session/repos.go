@@ -0,0 +1,400 @@
+// METADATA
+//
+// Workspace Repositories Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Know well the condition of your flocks, and give attention to your herds." - Proverbs 27:23 (NIV)
+// Principle: Every part of the work is seen - no repository left unattended
+// Anchor: "Let all things be done decently and in order." - 1 Corinthians 14:40 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - multi-repository workspace state)
+// Role: Finds the git repositories that make up a workspace and reports each one's state
+// Paradigm: CPI-SI framework component - feeds context.go ("work" section) and session end reminders
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial multi-repo awareness
+//
+// Version History:
+//   1.0.0 (2026-10-16) - Configured repo paths, depth-1 scan, per-repo status, uncommitted work summary
+//
+// Purpose & Function
+//
+// Purpose: A workspace is often the main project plus sibling repositories.
+// Report every one of them so uncommitted work outside the top-level repo is
+// visible at session start and end.
+//
+// Core Design: Repositories come from (in order, deduplicated, capped at
+// max_repos) the workspace itself, context.jsonc repositories.paths, and - if
+// repositories.scan is set - directories one level below the workspace that
+// contain .git (hidden directories, node_modules, and vendor skipped). Each
+// repository's state comes from system/lib/git; the whole pass stops at
+// repositories.timeout_seconds.
+//
+// Blocking Status
+//
+// Non-blocking: Unreadable paths are skipped, failed git queries are logged
+// and leave that field zero, and the time limit drops remaining repositories.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: fmt, os, path/filepath, strings, time
+//   Internal: system/lib/git (repository state), context.go (contextLogger,
+//             logGitFailure, sessionData), display.go (expandPath)
+//
+// Dependents (What Uses This):
+//   Libraries: context.go (renderWorkContextSection), contextdata.go (workContext), reminders.go (RemindRepositories)
+//   Commands: session/cmd-end (GetUncommittedWorkSummary drives STATE REMINDERS)
+//
+// Health Scoring
+//
+// Pure read path - git failures are logged through contextLogger (-5 each).
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"fmt"           // State text and table rendering
+	"os"            // Directory scan and workspace environment variable
+	"path/filepath" // Path resolution and repository names
+	"strings"       // State text joining
+	"time"          // Discovery deadline
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/git" // Repository state (head, status, upstream, operations)
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// defaultMaxRepos caps repositories reported, workspace included.
+	defaultMaxRepos = 8
+
+	// defaultRepoTimeoutSeconds bounds discovery plus status for all repositories.
+	defaultRepoTimeoutSeconds = 3
+)
+
+// repoScanSkipDirs are never scanned for repositories (hidden directories are skipped too)
+var repoScanSkipDirs = map[string]bool{"node_modules": true, "vendor": true}
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// ReposConfig selects workspace repositories (context.jsonc "repositories")
+//
+// Zero value = the workspace repository only (original behavior).
+type ReposConfig struct {
+	Paths          []string `json:"paths"`           // Extra repositories (absolute, ~, or workspace-relative)
+	Scan           bool     `json:"scan"`            // Also find repositories one level below the workspace
+	MaxRepos       int      `json:"max_repos"`       // Total reported, workspace included (0 = defaultMaxRepos)
+	TimeoutSeconds int      `json:"timeout_seconds"` // Whole discovery + status pass (0 = default)
+}
+
+// RepoStatus is one workspace repository's state
+type RepoStatus struct {
+	Path      string `json:"path"`
+	Name      string `json:"name"`   // Workspace-relative path (workspace itself: its base name)
+	Branch    string `json:"branch"` // Branch, or short commit when detached
+	Detached  bool   `json:"detached,omitempty"`
+	Modified  int    `json:"modified"` // Changed tracked files
+	Untracked int    `json:"untracked"`
+	Ahead     int    `json:"ahead,omitempty"` // Unpushed commits
+	Behind    int    `json:"behind,omitempty"`
+	Operation string `json:"operation,omitempty"` // Rebase/merge/... left in progress
+}
+
+// Uncommitted reports modified plus untracked files
+func (r RepoStatus) Uncommitted() int {
+	return r.Modified + r.Untracked
+}
+
+// NeedsAttention reports uncommitted files, unpushed commits, or an unfinished operation
+func (r RepoStatus) NeedsAttention() bool {
+	return r.Uncommitted() > 0 || r.Ahead > 0 || r.Operation != ""
+}
+
+// reposConfig holds repository settings (set from context.jsonc in context.go init)
+var reposConfig ReposConfig
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 2 functions
+//   ├── GetWorkspaceRepos(workspace) → uses workspaceRepos with reposConfig
+//   └── GetUncommittedWorkSummary() → uses GetWorkspaceRepos, NeedsAttention
+//
+//   Core Operations (Middle Rungs) - 4 functions
+//   ├── workspaceRepos(workspace, cfg) → uses discoverRepos, collectRepoStatus
+//   ├── discoverRepos(workspace, cfg, deadline) → uses git.IsGitRepository
+//   ├── collectRepoStatus(workspace, path) → system/lib/git queries, logGitFailure
+//   └── formatRepoTable(repos) → uses repoStateText (context.go work section)
+//
+//   Helpers (Bottom Rungs) - 3 functions
+//   ├── repoName(workspace, path) → pure function
+//   ├── repoStateText(repo) → pure function
+//   └── sessionWorkspace() → NOVA_DAWN_WORKSPACE, else sessionData.WorkContext
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Naming and State Text
+// ────────────────────────────────────────────────────────────────
+
+// repoName labels a repository relative to the workspace ("api", "libs/core")
+func repoName(workspace, path string) string {
+	if rel, err := filepath.Rel(workspace, path); err == nil && rel != "." && !strings.HasPrefix(rel, "..") {
+		return rel
+	}
+	return filepath.Base(path)
+}
+
+// repoStateText summarizes what needs attention: "2 modified, 1 untracked, 3 unpushed" or "clean"
+func repoStateText(repo RepoStatus) string {
+	var parts []string
+	if repo.Operation != "" {
+		parts = append(parts, repo.Operation+" in progress")
+	}
+	if repo.Modified > 0 {
+		parts = append(parts, fmt.Sprintf("%d modified", repo.Modified))
+	}
+	if repo.Untracked > 0 {
+		parts = append(parts, fmt.Sprintf("%d untracked", repo.Untracked))
+	}
+	if repo.Ahead > 0 {
+		parts = append(parts, fmt.Sprintf("%d unpushed", repo.Ahead))
+	}
+	if repo.Behind > 0 {
+		parts = append(parts, fmt.Sprintf("%d behind", repo.Behind))
+	}
+	if len(parts) == 0 {
+		return "clean"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// sessionWorkspace is NOVA_DAWN_WORKSPACE, else the session's work context
+func sessionWorkspace() string {
+	workspace := os.Getenv("NOVA_DAWN_WORKSPACE")
+	if workspace == "" && sessionData != nil {
+		workspace = sessionData.WorkContext
+	}
+	return workspace
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Discovery and Status
+// ────────────────────────────────────────────────────────────────
+
+// discoverRepos lists repository paths: workspace, configured paths, then a depth-1 scan
+func discoverRepos(workspace string, cfg ReposConfig, deadline time.Time) []string {
+	maxRepos := cfg.MaxRepos
+	if maxRepos <= 0 {
+		maxRepos = defaultMaxRepos
+	}
+
+	var repos []string
+	seen := map[string]bool{}
+	add := func(path string) {
+		path = filepath.Clean(path)
+		if len(repos) >= maxRepos || seen[path] || !git.IsGitRepository(path) {
+			return
+		}
+		seen[path] = true
+		repos = append(repos, path)
+	}
+
+	add(workspace)
+	for _, path := range cfg.Paths {
+		path = expandPath(path)
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(workspace, path)
+		}
+		add(path)
+	}
+
+	if !cfg.Scan {
+		return repos
+	}
+	entries, err := os.ReadDir(workspace)
+	if err != nil {
+		return repos
+	}
+	for _, entry := range entries {
+		if len(repos) >= maxRepos || time.Now().After(deadline) {
+			break
+		}
+		name := entry.Name()
+		if !entry.IsDir() || strings.HasPrefix(name, ".") || repoScanSkipDirs[name] {
+			continue
+		}
+		add(filepath.Join(workspace, name))
+	}
+	return repos
+}
+
+// collectRepoStatus queries one repository (each query independent, failures logged)
+func collectRepoStatus(workspace, path string) (RepoStatus, error) {
+	repo := RepoStatus{Path: path, Name: repoName(workspace, path)}
+
+	head, err := git.GetHead(path)
+	if err != nil {
+		logGitFailure(contextLogger, path, "head", err)
+		return repo, err
+	}
+	repo.Branch, repo.Detached = head.Branch, head.Detached
+	if head.Detached {
+		repo.Branch = head.Commit
+	}
+
+	if status, err := git.GetStatus(path); err == nil {
+		repo.Modified, repo.Untracked = status.Modified, status.Untracked
+	} else {
+		logGitFailure(contextLogger, path, "status", err)
+	}
+	if upstream, err := git.GetUpstream(path); err == nil {
+		repo.Ahead, repo.Behind = upstream.Ahead, upstream.Behind
+	} else {
+		logGitFailure(contextLogger, path, "upstream", err)
+	}
+	if operation, err := git.GetOperation(path); err == nil {
+		repo.Operation = operation
+	} else {
+		logGitFailure(contextLogger, path, "operation", err)
+	}
+
+	return repo, nil
+}
+
+// workspaceRepos discovers and queries repositories until the time limit
+func workspaceRepos(workspace string, cfg ReposConfig) []RepoStatus {
+	if workspace == "" {
+		return nil
+	}
+	timeout := cfg.TimeoutSeconds
+	if timeout <= 0 {
+		timeout = defaultRepoTimeoutSeconds
+	}
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+
+	paths := discoverRepos(workspace, cfg, deadline)
+	var repos []RepoStatus
+	for i, path := range paths {
+		if time.Now().After(deadline) {
+			contextLogger.Check("repo-scan-complete", false, 0, map[string]any{
+				"workspace": workspace,
+				"skipped":   len(paths) - i,
+			})
+			break
+		}
+		if repo, err := collectRepoStatus(workspace, path); err == nil {
+			repos = append(repos, repo)
+		}
+	}
+	return repos
+}
+
+// formatRepoTable renders repositories as a compact markdown table
+func formatRepoTable(repos []RepoStatus) string {
+	table := "| Repository | Branch | State |\n|---|---|---|\n"
+	for _, repo := range repos {
+		branch := repo.Branch
+		if repo.Detached {
+			branch = "detached at " + branch
+		}
+		table += fmt.Sprintf("| %s | %s | %s |\n", repo.Name, branch, repoStateText(repo))
+	}
+	return table
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// GetWorkspaceRepos reports every repository in the workspace, workspace first
+//
+// Uses context.jsonc "repositories" (paths, scan, max_repos, timeout_seconds).
+// Unconfigured: only the workspace itself (if it is a repository).
+//
+// Example:
+//   for _, repo := range session.GetWorkspaceRepos("/home/user/project") {
+//       fmt.Println(repo.Name, repo.Branch, repo.Uncommitted())
+//   }
+func GetWorkspaceRepos(workspace string) []RepoStatus {
+	return workspaceRepos(workspace, reposConfig)
+}
+
+// GetUncommittedWorkSummary lists workspace repositories that need attention
+//
+// What It Does:
+//   - Workspace: NOVA_DAWN_WORKSPACE, else the session's work context
+//   - Returns repositories with uncommitted files, unpushed commits, or an
+//     unfinished rebase/merge (clean repositories omitted)
+//
+// Example:
+//   for _, repo := range session.GetUncommittedWorkSummary() {
+//       fmt.Printf("%s: %d uncommitted\n", repo.Name, repo.Uncommitted())
+//   }
+func GetUncommittedWorkSummary() []RepoStatus {
+	var attention []RepoStatus
+	for _, repo := range GetWorkspaceRepos(sessionWorkspace()) {
+		if repo.NeedsAttention() {
+			attention = append(attention, repo)
+		}
+	}
+	return attention
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Discovery: workspace, configured paths, scan - deduplicated, capped, depth 1 only
+//   - Time limit: repositories past the deadline are dropped (logged), never waited on
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by context.go, reminders.go, and session hooks
+//
+// Code Cleanup: None - git commands are bounded by git.CommandTimeout
+//
+// Modification Policy:
+//   ✅ Safe: New skip directories (repoScanSkipDirs), new state text parts
+//   ⚠️ Care: RepoStatus JSON names (hooks may persist them)
+//   ❌ Never: Recursive scans - depth 1 keeps session start predictable
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,99 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Workspace Repositories Tests
+//
+// Purpose: Prove discovery stays bounded (depth 1, skipped directories, cap),
+//          per-repository state is reported, and clean repositories drop out
+//          of the uncommitted work summary.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestWorkspaceRepos(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	workspace := t.TempDir()
+	initRepo := func(rel string) string {
+		t.Helper()
+		dir := filepath.Join(workspace, rel)
+		os.MkdirAll(dir, 0755)
+		if output, err := exec.Command("git", "-C", dir, "init", "-q", "-b", "main").CombinedOutput(); err != nil {
+			t.Fatalf("git init %s: %v\n%s", rel, err, output)
+		}
+		return dir
+	}
+
+	initRepo(".")
+	api := initRepo("api")
+	initRepo("web")
+	initRepo("node_modules/pkg")
+	initRepo(".cache")
+	initRepo("libs/core") // Depth 2: only found when configured
+	os.WriteFile(filepath.Join(api, "main.go"), []byte("package main\n"), 0644)
+
+	deadline := time.Now().Add(time.Minute)
+	names := func(paths []string) string {
+		var out []string
+		for _, path := range paths {
+			out = append(out, repoName(workspace, path))
+		}
+		return strings.Join(out, ",")
+	}
+
+	if got := names(discoverRepos(workspace, ReposConfig{}, deadline)); got != filepath.Base(workspace) {
+		t.Errorf("unconfigured = %s, want workspace only", got)
+	}
+	scan := ReposConfig{Paths: []string{"libs/core", "api"}, Scan: true}
+	if got := names(discoverRepos(workspace, scan, deadline)); got != filepath.Base(workspace)+",libs/core,api,web" {
+		t.Errorf("configured + scan = %s", got)
+	}
+	scan.MaxRepos = 2
+	if got := len(discoverRepos(workspace, scan, deadline)); got != 2 {
+		t.Errorf("capped = %d repos, want 2", got)
+	}
+
+	repos := workspaceRepos(workspace, ReposConfig{Scan: true})
+	if len(repos) != 3 || repos[1].Name != "api" || repos[1].Untracked != 1 || repos[2].NeedsAttention() {
+		t.Fatalf("repos = %+v, want workspace, api (1 untracked), clean web", repos)
+	}
+	if got := repoStateText(repos[1]); got != "1 untracked" {
+		t.Errorf("api state = %q", got)
+	}
+	if got := formatRepoTable(repos[1:]); !strings.Contains(got, "| api | main | 1 untracked |") || !strings.Contains(got, "| web | main | clean |") {
+		t.Errorf("table:\n%s", got)
+	}
+}
+
+func TestFormatRepoReminder(t *testing.T) {
+	saved := remindersConfigLoaded
+	defer func() { remindersConfigLoaded = saved }()
+	remindersConfigLoaded = false // Defaults
+
+	repo := RepoStatus{Name: "api", Branch: "feature/auth", Modified: 2, Ahead: 1, Operation: "rebase"}
+	if got := formatRepoReminder(repo); got != "⚠️  api (feature/auth): rebase in progress, 2 modified, 1 unpushed\n" {
+		t.Errorf("reminder = %q", got)
+	}
+	if got := repoStateText(RepoStatus{}); got != "clean" {
+		t.Errorf("clean state = %q", got)
+	}
+}
@@ -0,0 +1,238 @@
+// METADATA
+//
+// Session Hook Runner - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Let all things be done decently and in order." - 1 Corinthians 14:40 (KJV)
+// Principle: Even a fall is handled in order - noted fully, told briefly, closed cleanly
+// Anchor: "A prudent man foreseeth the evil, and hideth himself" - Proverbs 22:3 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - hook execution wrapper)
+// Role: Runs a session hook's body with logging, timing, panic recovery, and exit codes
+// Paradigm: CPI-SI framework component - every hook fails the same way
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial hook runner
+//
+// Version History:
+//   1.0.0 (2026-10-16) - RunHook: Operation/Success/Failure with duration, panic recovery, exit codes
+//
+// Purpose & Function
+//
+// Purpose: Each session hook binary handled its own errors, and a panic
+// printed a bare Go stack on the user's terminal mid-session with nothing in
+// the logs. RunHook gives every hook the same frame:
+//   - A Rails logger named for the hook (one log file per hook)
+//   - OPERATION at start; SUCCESS or FAILURE at the end with duration_ms
+//     and the operation_id (ExtractSpans pairs them)
+//   - A panic becomes an ERROR entry (panic value and full stack), one
+//     friendly line on stderr naming the log, and exit code 1
+//   - A returned error becomes a FAILURE, one stderr line, and its exit code
+//
+// Exit codes (Claude Code hook semantics):
+//   0 - hook finished (RunHook returns, main ends normally)
+//   1 - hook failed or panicked - non-blocking, the session continues
+//   2 - hook blocked (error wraps ErrHookBlocked) - Claude Code feeds stderr back
+//
+// Blocking Status
+//
+// Non-blocking by default: errors and panics exit 1, which Claude Code shows
+// and moves past. Only ErrHookBlocked asks for exit 2.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	func main() {
+//	    session.RunHook("session-start", start) // start is func() error
+//	}
+//
+// Public API:
+//   RunHook(name string, fn func() error) - Run a hook body under the runner
+//   ErrHookBlocked - Wrap to exit 2 (blocking) instead of 1
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: errors, fmt, io, os, time
+//   Internal: system/lib/logging (hook logger, ERROR with stack trace)
+//
+// Dependents (What Uses This):
+//   Commands: session/cmd-start, session/cmd-end
+//
+// Health Scoring
+//
+//   Hook started: +5 (OPERATION)
+//   Hook finished: +10 (SUCCESS)
+//   Hook returned an error: -10 (FAILURE)
+//   Hook panicked: -20 (ERROR with stack)
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"errors" // ErrHookBlocked matching
+	"fmt"    // Panic values as errors, stderr lines
+	"io"     // Replaceable stderr
+	"os"     // Exit and stderr
+	"time"   // Hook duration
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/logging" // Per-hook Rails logger
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	//--- Exit Codes ---
+	// Claude Code treats 2 as blocking (stderr goes back to the model) and
+	// any other nonzero as a non-blocking error shown to the user.
+
+	hookExitFailed  = 1 // Error or panic - session continues
+	hookExitBlocked = 2 // ErrHookBlocked - Claude Code acts on stderr
+
+	//--- Health Impacts ---
+
+	hookStartHealth   = 5   // OPERATION at start
+	hookSuccessHealth = 10  // SUCCESS at the end
+	hookFailureHealth = -10 // FAILURE for a returned error
+	hookPanicHealth   = -20 // ERROR for a recovered panic
+)
+
+// ────────────────────────────────────────────────────────────────
+// Package-Level State
+// ────────────────────────────────────────────────────────────────
+
+// ErrHookBlocked marks a hook error that must block (exit 2) rather than warn.
+var ErrHookBlocked = errors.New("hook blocked")
+
+// Replaceable for tests - a recovered panic must not end the test binary
+var (
+	hookExit             = os.Exit   // Process exit after failure
+	hookStderr io.Writer = os.Stderr // The one friendly line
+)
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Exit Codes
+// ────────────────────────────────────────────────────────────────
+
+// hookExitCode maps a hook's returned error to its exit code (nil → 0).
+func hookExitCode(err error) int {
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, ErrHookBlocked):
+		return hookExitBlocked
+	default:
+		return hookExitFailed
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// RunHook runs a session hook's body with logging, timing, and recovery
+//
+// What It Does:
+//   - Creates the hook's Rails logger (component = name) and logs OPERATION
+//   - Runs fn; on success logs SUCCESS with duration_ms and returns
+//   - On a returned error logs FAILURE (reason, duration_ms, exit_code),
+//     writes one "WARNING: <name> hook: <error>" line to stderr, and exits
+//     1 (or 2 when the error wraps ErrHookBlocked)
+//   - On a panic logs ERROR with the panic value and the stack where it
+//     happened, writes one line to stderr pointing at the hook's log, and exits 1
+//
+// Parameters:
+//   name: Hook name - log component and stderr prefix (e.g. "session-start")
+//   fn: The hook body; fn's own defers run before RunHook logs the outcome
+//
+// Returns:
+//   Nothing - only returns when fn succeeded; otherwise the process exits
+//
+// Example usage:
+//
+//	func main() {
+//	    session.RunHook("session-end", sessionEnd)
+//	}
+func RunHook(name string, fn func() error) {
+	logger := logging.NewLogger(name)
+	operationID := logger.Operation(name, hookStartHealth)
+	started := time.Now()
+
+	details := func(extra map[string]any) map[string]any {
+		extra[logging.OperationIDDetail] = operationID
+		extra["duration_ms"] = time.Since(started).Milliseconds()
+		return extra
+	}
+
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+		// Still on the panicking stack here - Error's trace shows where it happened
+		logger.Error(fmt.Sprintf("%s hook panicked", name), fmt.Errorf("panic: %v", recovered), hookPanicHealth)
+		logger.Failure(name, fmt.Sprintf("panic: %v", recovered), 0, details(map[string]any{"exit_code": hookExitFailed}))
+		logger.Flush()
+		fmt.Fprintf(hookStderr, "WARNING: %s hook stopped on an internal error - details in %s\n", name, logger.LogFile)
+		hookExit(hookExitFailed)
+	}()
+
+	err := fn()
+	if err == nil {
+		logger.Success(name, hookSuccessHealth, details(map[string]any{}))
+		logger.Flush()
+		return
+	}
+
+	code := hookExitCode(err)
+	logger.Failure(name, err.Error(), hookFailureHealth, details(map[string]any{"exit_code": code}))
+	logger.Flush()
+	fmt.Fprintf(hookStderr, "WARNING: %s hook: %v\n", name, err)
+	hookExit(code)
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "hooks/lib/session"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
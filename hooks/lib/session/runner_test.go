@@ -0,0 +1,152 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Session Hook Runner Tests
+//
+// Purpose: Prove an injected panic in a hook ends with one friendly stderr
+//          line, exit code 1, and a complete ERROR entry (panic value and the
+//          stack where it happened) in the hook's own log; returned errors
+//          map to exit 1 or 2 with a FAILURE; success logs duration and the
+//          operation ID without exiting.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"system/lib/logging"
+)
+
+// runTestHook runs fn under RunHook with exit and stderr captured
+//
+// Returns the exit code (-1 when RunHook returned without exiting), the
+// stderr text, and the hook log's entries.
+func runTestHook(t *testing.T, name string, fn func() error) (int, string, []logging.LogEntry) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+
+	savedExit, savedStderr := hookExit, hookStderr
+	t.Cleanup(func() { hookExit, hookStderr = savedExit, savedStderr })
+	code := -1
+	var stderr bytes.Buffer
+	hookExit = func(c int) { code = c }
+	hookStderr = &stderr
+
+	RunHook(name, fn)
+
+	var entries []logging.LogEntry
+	filepath.WalkDir(logging.LogsDir(), func(path string, d fs.DirEntry, err error) error {
+		if err == nil && d.Name() == name+".log" {
+			entries, err = logging.ReadLogFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		return nil
+	})
+	return code, stderr.String(), entries
+}
+
+// entryAt returns the first entry of level in entries (nil when none)
+func entryAt(entries []logging.LogEntry, level string) *logging.LogEntry {
+	for i := range entries {
+		if entries[i].Level == level {
+			return &entries[i]
+		}
+	}
+	return nil
+}
+
+// panickingHook stands in for a hook body with a bug
+func panickingHook() error {
+	var record map[string]int
+	record["compactions"]++ // Write to a nil map
+	return nil
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestRunHookRecoversPanic(t *testing.T) {
+	code, stderr, entries := runTestHook(t, "hook-panic-test", panickingHook)
+
+	if code != hookExitFailed {
+		t.Errorf("exit code = %d, want %d", code, hookExitFailed)
+	}
+	if lines := strings.Split(strings.TrimSuffix(stderr, "\n"), "\n"); len(lines) != 1 ||
+		!strings.Contains(stderr, "hook-panic-test") || strings.Contains(stderr, "goroutine") {
+		t.Errorf("stderr should be one friendly line naming the hook, got:\n%s", stderr)
+	}
+
+	errorEntry := entryAt(entries, "ERROR")
+	if errorEntry == nil {
+		t.Fatalf("no ERROR entry in the hook log: %+v", entries)
+	}
+	if !strings.Contains(fmt.Sprint(errorEntry.Details["error"]), "assignment to entry in nil map") {
+		t.Errorf("ERROR entry lacks the panic value: %v", errorEntry.Details["error"])
+	}
+	if stack := fmt.Sprint(errorEntry.Details["stack_trace"]); !strings.Contains(stack, "panickingHook") {
+		t.Errorf("stack trace does not show where the panic happened:\n%s", stack)
+	}
+	if !strings.Contains(stderr, "hook-panic-test.log") {
+		t.Errorf("stderr does not point at the hook's log: %s", stderr)
+	}
+
+	failure := entryAt(entries, "FAILURE")
+	if failure == nil || failure.Details["duration_ms"] == nil || failure.Details["exit_code"] != "1" {
+		t.Errorf("FAILURE closing the operation missing duration or exit code: %+v", failure)
+	}
+}
+
+func TestRunHookReturnedErrors(t *testing.T) {
+	cases := []struct {
+		err  error
+		want int
+	}{
+		{errors.New("record unreadable"), hookExitFailed},
+		{fmt.Errorf("workspace locked: %w", ErrHookBlocked), hookExitBlocked},
+	}
+	for _, tc := range cases {
+		code, stderr, entries := runTestHook(t, "hook-error-test", func() error { return tc.err })
+		if code != tc.want {
+			t.Errorf("%v: exit code = %d, want %d", tc.err, code, tc.want)
+		}
+		if stderr != "WARNING: hook-error-test hook: "+tc.err.Error()+"\n" {
+			t.Errorf("%v: stderr = %q", tc.err, stderr)
+		}
+		if failure := entryAt(entries, "FAILURE"); failure == nil || failure.Details["reason"] != tc.err.Error() {
+			t.Errorf("%v: no FAILURE with the reason: %+v", tc.err, entries)
+		}
+	}
+}
+
+func TestRunHookSuccess(t *testing.T) {
+	code, stderr, entries := runTestHook(t, "hook-success-test", func() error { return nil })
+	if code != -1 || stderr != "" {
+		t.Errorf("success exited %d with stderr %q; want a normal return", code, stderr)
+	}
+
+	operation, success := entryAt(entries, "OPERATION"), entryAt(entries, "SUCCESS")
+	if operation == nil || success == nil {
+		t.Fatalf("want OPERATION and SUCCESS: %+v", entries)
+	}
+	if success.Details[logging.OperationIDDetail] != operation.Details[logging.OperationIDDetail] || success.Details["duration_ms"] == nil {
+		t.Errorf("SUCCESS should echo the operation ID and carry duration_ms: %v", success.Details)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,684 @@
+// METADATA
+//
+// First-Run Setup Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "For which of you, intending to build a tower, sitteth not down first, and counteth the cost" - Luke 14:28 (KJV)
+// Principle: Know what is missing before building on it
+// Anchor: "Let all things be done decently and in order" - 1 Corinthians 14:40 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - first-run setup detection)
+// Role: Tells a new install which config pieces are missing and how to add them
+// Paradigm: CPI-SI framework component - an incomplete install says so once, plainly
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial setup detection and templates
+//
+// Version History:
+//   1.0.0 (2026-10-16) - DetectSetupState, PrintSetupGuidance, WriteConfigTemplates, context note
+//
+// Purpose & Function
+//
+// Purpose: On a fresh install every config falls back to its defaults, so
+// session start filled the screen with sentinel values ("Unknown", hardcoded
+// paths) and gave no hint what to create. DetectSetupState checks the seven
+// pieces an install needs:
+//
+//   root_config      ~/.claude/instance.jsonc (or the active profile's) - system_paths
+//   instance_config  system_paths.instance_config - who the instance is
+//   user_config      system_paths.user_config - who the partner is
+//   display_config   display/formatting.jsonc
+//   temporal_config  temporal/schedule.jsonc
+//   session_data     system_paths.session_data directory
+//   logs_dir         logging.LogsDir() directory
+//
+// Each item is ok, missing, or invalid (the file doesn't parse or a value has
+// the wrong type), with a one-line remediation. When more than
+// behavior.session_display.setup_guidance_threshold (0 = 2) are not ok, the
+// start hook shows "⚙ Setup incomplete (ready/total)" with those remediations
+// instead of the sections that would only show defaults, and the context
+// Claude receives says grounding is partial.
+//
+// Core Design: Without a readable root config the instance and user configs
+// are looked for where WriteConfigTemplates puts them (config/instance/local,
+// config/user/local), never at the hardcoded fallback paths. WriteConfigTemplates
+// writes commented templates for the missing pieces - copies of the shipped
+// default instance/user templates when they are installed - and creates the
+// missing directories. An existing file is only replaced with force.
+//
+// Blocking Status
+//
+// Non-blocking: Detection never fails; a piece it cannot read is invalid.
+// WriteConfigTemplates returns every write that failed, joined.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bytes, errors, fmt, os, path/filepath, strings, sync
+//   Internal: system/lib/config (ValidateConfigFile), system/lib/instance (RootConfigPath,
+//             RootConfig), system/lib/jsonc (Normalize), system/lib/logging (LogsDir),
+//             system/lib/temporal (ScheduleConfigKind), display.go (displayConfigKind,
+//             formatFields, expandPath), verbosity.go (visible), output.go (Output)
+//
+// Dependents (What Uses This):
+//   Commands: session/cmd-start (DetectSetupState, PrintSetupGuidance)
+//   Libraries: context.go (setupContextNote in the context header)
+//
+// Health Scoring
+//
+// Setup detected: one "setup-state" check entry (passed when nothing is missing), health 0.
+// Template written: +0 success entry per file; write failure: -5.
+package session
+
+// ============================================================================
+// END METADATA
+// ============================================================================
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	//--- Standard Library ---
+
+	"bytes"         // Empty-file detection
+	"errors"        // Joined write failures
+	"fmt"           // Remediations, headline, templates
+	"os"            // Stat, read, write, home directory
+	"path/filepath" // Template and default paths
+	"strings"       // Home shortening
+	"sync"          // Latest report for the context note
+
+	//--- Internal Packages ---
+
+	sysconfig "system/lib/config" // Parse and type checks ("config" is this package's state)
+	"system/lib/instance"         // Root config location and shape
+	"system/lib/jsonc"            // Comment stripping for the empty-file check
+	"system/lib/logging"          // Logs directory
+	"system/lib/temporal"         // schedule.jsonc location and shape
+)
+
+const (
+	// defaultSetupGuidanceThreshold is how many items may be not ok before guidance (0 in config)
+	defaultSetupGuidanceThreshold = 2
+
+	// setupConfigRoot holds the identity configs and their shipped templates
+	setupConfigRoot = "~/.claude/cpi-si/config"
+
+	// localConfigDir is where WriteConfigTemplates puts identity configs with no root config to say otherwise
+	localConfigDir = "local"
+
+	// defaultTemplateDir holds the shipped instance/user templates under setupConfigRoot/<kind>/
+	defaultTemplateDir = "default"
+
+	// setupDataRoot is system_paths.data_root for a root config WriteConfigTemplates writes
+	setupDataRoot = "~/.claude/cpi-si/system/data"
+)
+
+// Setup item ids, in check order
+const (
+	setupRootConfig     = "root_config"
+	setupInstanceConfig = "instance_config"
+	setupUserConfig     = "user_config"
+	setupDisplayConfig  = "display_config"
+	setupTemporalConfig = "temporal_config"
+	setupSessionData    = "session_data"
+	setupLogsDir        = "logs_dir"
+)
+
+// SetupStatus is how one setup item was found
+type SetupStatus string
+
+const (
+	SetupOK      SetupStatus = "ok"      // Present and loadable
+	SetupMissing SetupStatus = "missing" // Not there - defaults in use
+	SetupInvalid SetupStatus = "invalid" // There but unloadable (syntax, wrong type, not a directory)
+)
+
+// SetupItem is one piece of the install
+type SetupItem struct {
+	ID          string
+	Label       string
+	Path        string
+	Status      SetupStatus
+	Remediation string // One line: what to create or fix ("" when ok)
+}
+
+// SetupReport is what DetectSetupState found, in check order
+type SetupReport struct {
+	Items []SetupItem
+}
+
+// setupPaths are where each setup item is looked for
+type setupPaths struct {
+	Root, Instance, User, Display, Temporal, SessionData, Logs string
+	DataRoot                                                   string // system_paths.data_root (templates only)
+}
+
+// detectedSetup holds the latest DetectSetupState report for the context note
+var detectedSetup struct {
+	sync.Mutex
+	report *SetupReport
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs)
+//   ├── DetectSetupState() → resolveSetupPaths, detectSetup, logSetupState
+//   ├── PrintSetupGuidance(report) → visible, renderSetupGuidance
+//   └── WriteConfigTemplates(force) → resolveSetupPaths, detectSetup, writeSetupTemplate
+//
+//   Core Operations (Middle Rungs)
+//   ├── detectSetup(paths) → checkSetupFile, checkSetupDir per item
+//   ├── resolveSetupPaths() → instance.RootConfigPath, readRootPaths, defaults
+//   └── setupContextNote() → latest report (context.go header)
+//
+//   Helpers (Bottom Rungs)
+//   ├── checkSetupFile(path, kind, allowEmpty) → sysconfig.ValidateConfigFile
+//   ├── checkSetupDir(path) → os.Stat
+//   ├── setupTemplate(id, paths) → template text
+//   ├── setupGuidanceThreshold() → setup_guidance_threshold or the default
+//   └── tildePath(path) → $HOME shown as ~
+
+// ────────────────────────────────────────────────────────────────
+// SetupReport Methods
+// ────────────────────────────────────────────────────────────────
+
+// Total is the number of items checked
+func (r SetupReport) Total() int {
+	return len(r.Items)
+}
+
+// Ready is the number of items that are ok
+func (r SetupReport) Ready() int {
+	return r.Total() - len(r.Missing())
+}
+
+// Missing returns the items that are not ok (missing or invalid), in check order
+func (r SetupReport) Missing() []SetupItem {
+	var missing []SetupItem
+	for _, item := range r.Items {
+		if item.Status != SetupOK {
+			missing = append(missing, item)
+		}
+	}
+	return missing
+}
+
+// NeedsGuidance reports whether more items are missing than setup_guidance_threshold allows
+func (r SetupReport) NeedsGuidance() bool {
+	return len(r.Missing()) > setupGuidanceThreshold()
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Configuration and Paths
+// ────────────────────────────────────────────────────────────────
+
+// setupGuidanceThreshold returns how many items may be missing before guidance
+func setupGuidanceThreshold() int {
+	if threshold := currentDisplayConfig().Behavior.SessionDisplay.SetupGuidanceThreshold; threshold > 0 {
+		return threshold
+	}
+	return defaultSetupGuidanceThreshold
+}
+
+// tildePath shows a path under $HOME as ~/...
+func tildePath(path string) string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return path
+	}
+	if rest, ok := strings.CutPrefix(path, home+string(filepath.Separator)); ok {
+		return "~/" + rest
+	}
+	return path
+}
+
+// identityTemplatePath is the shipped template for "instance" or "user" configs
+func identityTemplatePath(kind string) string {
+	return expandPath(filepath.Join(setupConfigRoot, kind, defaultTemplateDir, "config.jsonc"))
+}
+
+// readRootPaths returns the root config's system_paths (zero when it can't be read)
+func readRootPaths(path string) instance.SystemPaths {
+	var root instance.RootConfig
+	if data, err := os.ReadFile(path); err == nil {
+		_ = jsonc.Unmarshal(data, &root) // Unreadable root = every path falls back below
+	}
+	return root.SystemPaths
+}
+
+// resolveSetupPaths decides where each item is looked for
+//
+// Identity configs and session data come from the root config's system_paths;
+// whatever it doesn't name is looked for where WriteConfigTemplates writes it.
+func resolveSetupPaths() setupPaths {
+	paths := setupPaths{
+		Root:     instance.RootConfigPath(),
+		Display:  expandPath(displayConfigPath),
+		Temporal: expandPath(temporal.ScheduleConfigKind().Path),
+		Logs:     logging.LogsDir(),
+	}
+	system := readRootPaths(paths.Root)
+
+	paths.Instance = system.InstanceConfig
+	if paths.Instance == "" {
+		paths.Instance = expandPath(filepath.Join(setupConfigRoot, "instance", localConfigDir, "config.jsonc"))
+	}
+	paths.User = system.UserConfig
+	if paths.User == "" {
+		paths.User = expandPath(filepath.Join(setupConfigRoot, "user", localConfigDir, "config.jsonc"))
+	}
+	paths.DataRoot = system.DataRoot
+	if paths.DataRoot == "" {
+		paths.DataRoot = expandPath(setupDataRoot)
+	}
+	paths.SessionData = system.SessionData
+	if paths.SessionData == "" {
+		paths.SessionData = filepath.Join(paths.DataRoot, "session")
+	}
+	return paths
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Detection
+// ────────────────────────────────────────────────────────────────
+
+// checkSetupFile finds a config file missing, invalid, or ok
+//
+// Invalid means its loader would fall back: a syntax error or a value of the
+// wrong type. Unknown keys are ignored by loaders, so they don't count here.
+// allowEmpty is false for configs whose loader needs an object.
+func checkSetupFile(path string, kind sysconfig.ConfigKind, allowEmpty bool) SetupStatus {
+	report, err := sysconfig.ValidateConfigFile(path, kind)
+	if errors.Is(err, os.ErrNotExist) {
+		return SetupMissing
+	}
+	if err != nil {
+		return SetupInvalid
+	}
+	for _, issue := range report.Issues {
+		if issue.Kind == sysconfig.IssueSyntax || issue.Kind == sysconfig.IssueType {
+			return SetupInvalid
+		}
+	}
+	if !allowEmpty {
+		if data, err := os.ReadFile(path); err != nil || len(bytes.TrimSpace(jsonc.Normalize(data))) == 0 {
+			return SetupInvalid
+		}
+	}
+	return SetupOK
+}
+
+// checkSetupDir finds a directory missing, invalid (not a directory), or ok
+func checkSetupDir(path string) SetupStatus {
+	info, err := os.Stat(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return SetupMissing
+	case err != nil || !info.IsDir():
+		return SetupInvalid
+	}
+	return SetupOK
+}
+
+// detectSetup checks every item at paths
+func detectSetup(paths setupPaths) SetupReport {
+	configKind := func(name string, schema any) sysconfig.ConfigKind {
+		return sysconfig.ConfigKind{Name: name, Schema: []any{schema}}
+	}
+	files := []struct {
+		id, label, path string
+		status          SetupStatus
+		create          string // Remediation when missing
+	}{
+		{setupRootConfig, "Root config", paths.Root,
+			checkSetupFile(paths.Root, configKind("root", instance.RootConfig{}), false),
+			"create %s - system_paths pointing at the configs below"},
+		{setupInstanceConfig, "Instance config", paths.Instance,
+			checkSetupFile(paths.Instance, configKind("instance", instance.FullInstanceConfig{}), false),
+			"create %s - template at " + tildePath(identityTemplatePath("instance"))},
+		{setupUserConfig, "User config", paths.User,
+			checkSetupFile(paths.User, configKind("user", instance.FullUserConfig{}), false),
+			"create %s - template at " + tildePath(identityTemplatePath("user"))},
+		{setupDisplayConfig, "Display config", paths.Display,
+			checkSetupFile(paths.Display, displayConfigKind(), true),
+			"create %s - built-in display defaults until then"},
+		{setupTemporalConfig, "Temporal config", paths.Temporal,
+			checkSetupFile(paths.Temporal, temporal.ScheduleConfigKind(), true),
+			"create %s - no work schedule until then"},
+		{setupSessionData, "Session data", paths.SessionData,
+			checkSetupDir(paths.SessionData),
+			"create directory %s - session records and patterns live here"},
+		{setupLogsDir, "Logs", paths.Logs,
+			checkSetupDir(paths.Logs),
+			"create directory %s - hook logs live here"},
+	}
+
+	report := SetupReport{Items: make([]SetupItem, len(files))}
+	for i, file := range files {
+		item := SetupItem{ID: file.id, Label: file.label, Path: file.path, Status: file.status}
+		switch {
+		case file.status == SetupMissing:
+			item.Remediation = fmt.Sprintf(file.create, tildePath(file.path))
+		case file.status == SetupInvalid && (file.id == setupSessionData || file.id == setupLogsDir):
+			item.Remediation = fmt.Sprintf("%s is not a directory - move it aside", tildePath(file.path))
+		case file.status == SetupInvalid:
+			item.Remediation = fmt.Sprintf("fix %s - it doesn't load (validate --configs shows where)", tildePath(file.path))
+		}
+		report.Items[i] = item
+	}
+	return report
+}
+
+// logSetupState records the outcome as one check entry
+func logSetupState(report SetupReport) {
+	byStatus := map[SetupStatus][]string{}
+	for _, item := range report.Items {
+		byStatus[item.Status] = append(byStatus[item.Status], item.ID)
+	}
+	displayLogger.Check("setup-state", len(report.Missing()) == 0, 0, map[string]any{
+		"ready":   report.Ready(),
+		"total":   report.Total(),
+		"missing": byStatus[SetupMissing],
+		"invalid": byStatus[SetupInvalid],
+	})
+}
+
+// setupContextNote is the context header's note when guidance was shown ("" otherwise)
+func setupContextNote() string {
+	detectedSetup.Lock()
+	report := detectedSetup.report
+	detectedSetup.Unlock()
+
+	if report == nil || !report.NeedsGuidance() {
+		return ""
+	}
+	labels := make([]string, 0, report.Total())
+	for _, item := range report.Missing() {
+		labels = append(labels, strings.ToLower(item.Label))
+	}
+	return fmt.Sprintf("**Setup Incomplete (%d/%d):** missing or invalid: %s. Grounding below is partial - "+
+		"identity and awareness fields from these are defaults, treat them as unknown.\n\n",
+		report.Ready(), report.Total(), strings.Join(labels, ", "))
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Rendering
+// ────────────────────────────────────────────────────────────────
+
+// renderSetupGuidance draws the headline and one remediation row per missing item
+func renderSetupGuidance(report SetupReport) string {
+	cfg := currentDisplayConfig()
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s Setup incomplete (%d/%d)\n", cfg.Icons.Status.Setup, report.Ready(), report.Total())
+
+	markers := map[SetupStatus]string{SetupMissing: "✗", SetupInvalid: "⚠"}
+	var rows []fieldRow
+	for _, item := range report.Missing() {
+		rows = append(rows, fieldRow{markers[item.Status], item.Label, item.Remediation})
+	}
+	b.WriteString(formatFields("  ", rows))
+	return b.String()
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Templates
+// ────────────────────────────────────────────────────────────────
+
+// setupTemplate returns the file WriteConfigTemplates writes for a config item
+func setupTemplate(id string, paths setupPaths) []byte {
+	switch id {
+	case setupRootConfig:
+		return []byte(rootConfigTemplate(paths))
+	case setupInstanceConfig, setupUserConfig:
+		kind := strings.TrimSuffix(id, "_config")
+		if shipped, err := os.ReadFile(identityTemplatePath(kind)); err == nil {
+			return shipped
+		}
+		return []byte(fmt.Sprintf(identityConfigStub, kind, tildePath(identityTemplatePath(kind)), instance.CurrentSchemaVersion))
+	case setupDisplayConfig:
+		return []byte(displayConfigStub)
+	case setupTemporalConfig:
+		return []byte(temporalConfigStub)
+	}
+	return nil
+}
+
+// rootConfigTemplate points system_paths at the configs and data this install uses
+func rootConfigTemplate(paths setupPaths) string {
+	configRoot := expandPath(setupConfigRoot)
+	claude := filepath.Dir(filepath.Dir(configRoot)) // ~/.claude
+	return fmt.Sprintf(`// CPI-SI root config - written by session setup (WriteConfigTemplates)
+//
+// Only pointers: system_paths names where the full instance and user configs
+// and the data directories live. Edit the paths, then fill in
+// instance_config and user_config.
+{
+  "system_paths": {
+    "config_root": %q,
+    "instance_config": %q,
+    "user_config": %q,
+    "data_root": %q,
+    "temporal_data": %q,
+    "session_data": %q,
+    "projects_data": %q,
+    "skills": %q,
+    "journals": %q,
+    "context_dropins": %q,
+    "system_bin": %q
+  },
+
+  // Session start banner
+  "display": {
+    "banner_title": "CPI-SI",
+    "banner_tagline": "Covenant Partnership Intelligence System",
+    "footer_verse_ref": "Genesis 1:1",
+    "footer_verse_text": "In the beginning, God created the heavens and the earth."
+  }
+}
+`, configRoot, paths.Instance, paths.User, paths.DataRoot,
+		filepath.Join(paths.DataRoot, "temporal"), paths.SessionData,
+		filepath.Join(paths.DataRoot, "projects"), filepath.Join(claude, "skills"),
+		filepath.Join(claude, "journals", "personal"), filepath.Join(paths.DataRoot, "context.d"),
+		filepath.Join(filepath.Dir(paths.DataRoot), "runtime", "bin"))
+}
+
+// identityConfigStub is written when the shipped instance/user template isn't installed
+const identityConfigStub = `// CPI-SI %s config - written by session setup (WriteConfigTemplates)
+//
+// Every field is documented in the shipped template (%s).
+// Fields left out are treated as unknown in the session context.
+{
+  "schema_version": %d,
+
+  "identity": {
+    "name": "",
+    "display_name": "",
+    "pronouns": ""
+  }
+}
+`
+
+// displayConfigStub keeps every display default - add only what should change
+const displayConfigStub = `// Session display config - written by session setup (WriteConfigTemplates)
+//
+// Empty means every built-in default. Add only the sections to change, e.g.
+//   "behavior": { "session_display": { "verbosity": "quiet" } }
+// The full reference ships with the hooks as display/formatting.jsonc.
+{
+}
+`
+
+// temporalConfigStub keeps the default schedule - add windows to describe work hours
+const temporalConfigStub = `// Work schedule - written by session setup (WriteConfigTemplates)
+//
+// Empty means the default schedule. Add weekday windows, holidays, and
+// date overrides here; validate --configs checks the shape.
+{
+}
+`
+
+// writeSetupTemplate writes data to path, creating its directory; existing files only with force
+func writeSetupTemplate(path string, data []byte, force bool) (bool, error) {
+	if _, err := os.Stat(path); err == nil && !force {
+		return false, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return false, err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// DetectSetupState checks each piece of the install
+//
+// What It Does:
+//   - Checks the root, instance, and user configs, formatting.jsonc,
+//     schedule.jsonc, and the session data and logs directories
+//   - Keeps the report so the session context can note partial grounding
+//   - Logs the outcome as one "setup-state" check
+//
+// Returns:
+//   - Every item in check order, with a one-line remediation for those not ok
+//
+// Example:
+//   report := session.DetectSetupState()
+//   if report.NeedsGuidance() {
+//       session.PrintSetupGuidance(report)
+//   }
+func DetectSetupState() SetupReport {
+	report := detectSetup(resolveSetupPaths())
+
+	detectedSetup.Lock()
+	detectedSetup.report = &report
+	detectedSetup.Unlock()
+
+	logSetupState(report)
+	return report
+}
+
+// PrintSetupGuidance shows "⚙ Setup incomplete (ready/total)" with a fix per missing item
+//
+// Prints nothing when every item is ok or verbosity hides the section. The
+// start hook calls it in place of the sections that would only show defaults.
+//
+// Example:
+//   session.PrintHeader()
+//   session.PrintSetupGuidance(report)
+func PrintSetupGuidance(report SetupReport) {
+	maybeReloadDisplayConfig()
+
+	if len(report.Missing()) == 0 || !visible("setup_guidance") {
+		return
+	}
+	fmt.Fprint(Output(), renderSetupGuidance(report))
+	fmt.Fprintln(Output())
+}
+
+// WriteConfigTemplates writes commented templates for the missing setup pieces
+//
+// What It Does:
+//   - Root config: system_paths pointing at the paths checked here
+//   - Instance/user config: a copy of the shipped default template, or a
+//     commented stub when it isn't installed
+//   - formatting.jsonc / schedule.jsonc: comment-only files (all defaults)
+//   - Session data and logs: created as directories
+//
+// Parameters:
+//   - force: Also replace files that exist (including invalid ones)
+//
+// Returns:
+//   - error: Every failed write, joined (nil when all succeeded)
+//
+// Example:
+//   if err := session.WriteConfigTemplates(false); err != nil {
+//       fmt.Fprintf(os.Stderr, "WARNING: setup templates: %v\n", err)
+//   }
+func WriteConfigTemplates(force bool) error {
+	paths := resolveSetupPaths()
+	report := detectSetup(paths)
+
+	var errs []error
+	for _, item := range report.Items {
+		if item.Status == SetupOK && !force {
+			continue
+		}
+		if item.ID == setupSessionData || item.ID == setupLogsDir {
+			if item.Status == SetupMissing {
+				if err := os.MkdirAll(item.Path, 0755); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", item.ID, err))
+				}
+			}
+			continue
+		}
+
+		written, err := writeSetupTemplate(item.Path, setupTemplate(item.ID, paths), force)
+		if err != nil {
+			displayLogger.Failure("setup-template", err.Error(), -5, map[string]any{"item": item.ID, "path": item.Path})
+			errs = append(errs, fmt.Errorf("%s: %w", item.ID, err))
+			continue
+		}
+		if written {
+			displayLogger.Success("setup-template", 0, map[string]any{"item": item.ID, "path": item.Path})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - A fresh home reports every item missing; after WriteConfigTemplates all are ok
+//   - Broken JSONC is invalid, not missing, and is never overwritten without force
+//   - The context note appears only when guidance would be shown
+//   - Run: go test ./session/ (setup_test.go)
+//
+// Code Execution: None (Library) - called by session/cmd-start
+//
+// Modification Policy:
+//   ✅ Safe: Remediation wording, template comments
+//   ⚠️ Care: New items - add to detectSetup, setupTemplate (if a file), and the METADATA list;
+//           the "4/7" headline counts them all
+//   ❌ Never: Looking for identity configs at instance.GetConfig()'s hardcoded fallback
+//            paths - they belong to one machine, not this install
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
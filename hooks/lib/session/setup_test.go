@@ -0,0 +1,178 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// First-Run Setup Tests
+//
+// Purpose: Prove a fresh home reports every setup piece missing with a
+//          remediation, that WriteConfigTemplates completes the install
+//          without replacing existing files (unless forced), that guidance
+//          and the context note appear only past the threshold, and that the
+//          headline reads "⚙ Setup incomplete (ready/total)".
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"system/lib/instance"
+)
+
+// useFreshHome points HOME at an empty directory with no instance profile
+func useFreshHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(instance.ProfileEnvVar, "")
+	return home
+}
+
+// setupStatuses maps item id → status
+func setupStatuses(report SetupReport) map[string]SetupStatus {
+	statuses := map[string]SetupStatus{}
+	for _, item := range report.Items {
+		statuses[item.ID] = item.Status
+	}
+	return statuses
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestDetectSetupFreshHome(t *testing.T) {
+	useGoldenDisplayConfig(t)
+	useFreshHome(t)
+
+	report := detectSetup(resolveSetupPaths())
+	if report.Total() != 7 || report.Ready() != 0 {
+		t.Fatalf("fresh home: %d/%d ready, want 0/7: %+v", report.Ready(), report.Total(), report.Items)
+	}
+	if !report.NeedsGuidance() {
+		t.Error("fresh home does not need guidance")
+	}
+	for _, item := range report.Items {
+		if item.Status != SetupMissing || !strings.HasPrefix(item.Remediation, "create ") {
+			t.Errorf("%s: %s %q, want missing with a create remediation", item.ID, item.Status, item.Remediation)
+		}
+	}
+	want := "create ~/.claude/cpi-si/config/user/local/config.jsonc - template at ~/.claude/cpi-si/config/user/default/config.jsonc"
+	if got := report.Items[2].Remediation; got != want {
+		t.Errorf("user config remediation = %q, want %q", got, want)
+	}
+}
+
+func TestWriteConfigTemplatesCompletesSetup(t *testing.T) {
+	useGoldenDisplayConfig(t)
+	home := useFreshHome(t)
+
+	// An installed user template is copied as-is
+	shipped := "// shipped user template\n{ \"schema_version\": 1 }\n"
+	template := filepath.Join(home, ".claude/cpi-si/config/user/default/config.jsonc")
+	if err := os.MkdirAll(filepath.Dir(template), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(template, []byte(shipped), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteConfigTemplates(false); err != nil {
+		t.Fatalf("WriteConfigTemplates: %v", err)
+	}
+	paths := resolveSetupPaths()
+	report := detectSetup(paths)
+	if report.Ready() != report.Total() {
+		t.Fatalf("after templates: %+v", report.Missing())
+	}
+	if data, _ := os.ReadFile(paths.User); string(data) != shipped {
+		t.Errorf("user config = %q, want the shipped template", data)
+	}
+	if root := readRootPaths(paths.Root); root.InstanceConfig != paths.Instance || root.SessionData != paths.SessionData {
+		t.Errorf("root system_paths = %+v, want the checked paths", root)
+	}
+
+	// Broken files are invalid, kept without force, replaced with it
+	broken := "{ \"behavior\": "
+	if err := os.WriteFile(paths.Display, []byte(broken), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if status := setupStatuses(detectSetup(paths))[setupDisplayConfig]; status != SetupInvalid {
+		t.Errorf("broken formatting.jsonc: %s, want invalid", status)
+	}
+	if err := WriteConfigTemplates(false); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := os.ReadFile(paths.Display); string(data) != broken {
+		t.Error("WriteConfigTemplates(false) replaced an existing file")
+	}
+	if err := WriteConfigTemplates(true); err != nil {
+		t.Fatal(err)
+	}
+	if status := setupStatuses(detectSetup(paths))[setupDisplayConfig]; status != SetupOK {
+		t.Errorf("after force: %s, want ok", status)
+	}
+}
+
+func TestSetupGuidanceThreshold(t *testing.T) {
+	useGoldenDisplayConfig(t)
+	items := []SetupItem{
+		{ID: setupRootConfig, Label: "Root config", Status: SetupOK},
+		{ID: setupInstanceConfig, Label: "Instance config", Status: SetupMissing, Remediation: "create instance"},
+		{ID: setupUserConfig, Label: "User config", Status: SetupInvalid, Remediation: "fix user"},
+	}
+	report := SetupReport{Items: items}
+	if report.NeedsGuidance() {
+		t.Error("2 missing needs guidance at the default threshold of 2")
+	}
+
+	detectedSetup.report = &report
+	t.Cleanup(func() { detectedSetup.report = nil })
+	if note := setupContextNote(); note != "" {
+		t.Errorf("context note below threshold: %q", note)
+	}
+
+	cfg := *currentDisplayConfig()
+	cfg.Behavior.SessionDisplay.SetupGuidanceThreshold = 1
+	displayConfig.Store(&cfg)
+	if !report.NeedsGuidance() {
+		t.Error("2 missing does not need guidance at threshold 1")
+	}
+	note := setupContextNote()
+	if !strings.Contains(note, "Setup Incomplete (1/3)") || !strings.Contains(note, "instance config, user config") {
+		t.Errorf("context note = %q", note)
+	}
+}
+
+func TestPrintSetupGuidance(t *testing.T) {
+	useGoldenDisplayConfig(t)
+	report := SetupReport{Items: []SetupItem{
+		{ID: setupRootConfig, Label: "Root config", Status: SetupOK},
+		{ID: setupUserConfig, Label: "User config", Status: SetupMissing, Remediation: "create ~/user.jsonc"},
+		{ID: setupLogsDir, Label: "Logs", Status: SetupInvalid, Remediation: "~/logs is not a directory"},
+	}}
+
+	got := CaptureOutput(func() { PrintSetupGuidance(report) })
+	for _, want := range []string{"⚙ Setup incomplete (1/3)", "✗ User config", "create ~/user.jsonc", "⚠ Logs"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("guidance missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "Root config") {
+		t.Errorf("guidance lists an ok item:\n%s", got)
+	}
+
+	if got := CaptureOutput(func() { PrintSetupGuidance(SetupReport{Items: report.Items[:1]}) }); got != "" {
+		t.Errorf("complete setup printed %q", got)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -25,10 +25,11 @@
 // Architect: Seanje Lenox-Wise
 // Implementation: Nova Dawn (CPI-SI instance)
 // Creation Date: 2025-11-10
-// Version: 3.0.0
-// Last Modified: 2025-11-12 - Removed duplication, imports from system/lib/sessiontime
+// Version: 3.1.0
+// Last Modified: 2026-10-16 - Compaction increments locked via UpdateSession
 //
 // Version History:
+//   3.1.0 (2026-10-16) - IncrementCompactionCount uses UpdateSession (lockfile, atomic write)
 //   3.0.0 (2025-11-12) - Architectural consolidation, removed duplicate SessionState
 //   2.0.0 (2025-11-10) - Config inheritance, richer structure, correct paths
 //   1.0.0 (2024-10-24) - Initial implementation with basic session state
@@ -46,7 +47,7 @@
 //
 // Key Features:
 //   - Re-exports SessionState type from system/lib/sessiontime
-//   - Delegates compaction count operations to system library
+//   - Compaction increments go through UpdateSession (lifecycle.go lock + atomic write)
 //   - Delegates session state retrieval to system library
 //   - Maintains backward-compatible function signatures
 //   - Zero duplication - all logic in system/lib/sessiontime
@@ -228,7 +229,7 @@ type SessionState = sessiontime.SessionState
 // Ladder Structure (Dependencies):
 //
 //   Public APIs (Top Rungs - Pure Delegation)
-//   ├── IncrementCompactionCount() → delegates to sessiontime.IncrementCompactionCount()
+//   ├── IncrementCompactionCount() → uses UpdateSession() (lifecycle.go)
 //   ├── GetCompactionCount() → delegates to sessiontime.GetCompactionCount()
 //   └── GetSessionState() → delegates to sessiontime.ReadSession()
 //
@@ -292,15 +293,15 @@ type SessionState = sessiontime.SessionState
 // IncrementCompactionCount increments compaction count and returns new value.
 //
 // What It Does:
-// Delegates to system/lib/sessiontime.IncrementCompactionCount() which reads
-// current session state, increments CompactionCount field, writes updated state
-// back to file, and returns new count.
+// Increments CompactionCount through UpdateSession (lifecycle.go) so the
+// read-modify-write holds the session lock - concurrent hooks can't lose an
+// increment - and the write is atomic. Returns the new count.
 //
 // Parameters: None
 //
 // Returns:
 //   int: New compaction count after increment
-//   error: Error from system library (file read/write/JSON errors)
+//   error: Lock, read, or write error from UpdateSession
 //
 // Health Impact:
 //   Delegation success: +80 points (call succeeded)
@@ -317,7 +318,12 @@ type SessionState = sessiontime.SessionState
 //	fmt.Printf("Compaction count: %d\n", count)
 //
 func IncrementCompactionCount() (int, error) {
-	return sessiontime.IncrementCompactionCount()
+	var count int
+	err := UpdateSession(func(data *SessionData) {
+		data.CompactionCount++
+		count = data.CompactionCount
+	})
+	return count, err
 }
 
 // GetCompactionCount returns current compaction count from session state.
@@ -0,0 +1,869 @@
+// METADATA
+//
+// State Reminders Engine - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Be thou diligent to know the state of thy flocks, and look well to thy herds." - Proverbs 27:23 (KJV)
+// Principle: Leaving well means knowing what is still open before walking away
+// Anchor: "Let all things be done decently and in order." - 1 Corinthians 14:40 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - session end awareness)
+// Role: Gathers everything left open at session end into one structured list
+// Paradigm: CPI-SI framework component - independent collectors, one renderer
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.3.0
+// Last Modified: 2026-10-16 - Subagent failure collector
+//
+// Version History:
+//   1.3.0 (2026-10-16) - subagent collector (failures not followed by a successful retry);
+//                        appendLedgerLine shared by the pid and subagent ledgers
+//   1.2.0 (2026-10-16) - journal collector (unreviewed session journal drafts)
+//   1.1.0 (2026-10-16) - unpushed collector (critical); git collector leaves unpushed counts to it
+//   1.0.0 (2026-10-16) - git, process, todo, and temporal collectors; background pid ledger;
+//                        grouped, severity-colored PrintStateReminders
+//
+// Purpose & Function
+//
+// Purpose: Session end used to print per-repository lines and a port check,
+// each formatted its own way. The engine turns every open item into a
+// Reminder (category, severity, message) so they can be grouped, colored,
+// and extended in one place.
+//
+// Core Design: Each collector answers one question and returns Reminders:
+//   - git: repositories with uncommitted files, unpushed commits, or an
+//     unfinished rebase/merge (critical when an operation is in progress)
+//   - unpushed: commits no remote has - current branch ahead of upstream,
+//     recent branches with no upstream (critical; unpushed.go). While
+//     enabled, the git collector leaves unpushed counts out of its lines.
+//   - process: background processes this session recorded in the pid ledger
+//     that are still running, plus configured dev server ports still listening
+//   - todo: TODO/FIXME markers on lines added in uncommitted diffs
+//     (git diff HEAD - tracked files only)
+//   - temporal: the session ended during expected downtime (sleep, meal, break)
+//   - journal: session journal drafts still carrying "-draft" after
+//     reminders.journal_drafts.stale_days untouched (journaldraft.go)
+//   - subagent: subagents that failed this session with no later successful
+//     run of the same agent type in the subagent ledger (subagents.go)
+// Collectors run concurrently. A collector that panics or outlives
+// behavior.collector_timeout_seconds contributes nothing; the rest still report.
+//
+// Background pid ledger: background-processes.jsonl in the session data
+// directory, one JSON object per line, appended by RecordBackgroundProcess
+// (the tool hooks call it when a command is started in the background).
+// Entries whose process has exited are dropped at collection time.
+//
+// Blocking Status
+//
+// Non-blocking: Failures are logged and the collector's reminders are simply
+// missing. Collection is bounded by the collector timeout.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bufio, context, encoding/json, fmt, os, os/exec, path/filepath,
+//                     regexp, sort, strconv, strings, time
+//   Internal: system/lib/display (severity colors), context.go (currentTemporalContext),
+//             reminders.go (remindersConfig), repos.go (workspaceRepos, discoverRepos),
+//             unpushed.go (unpushedWork), journaldraft.go (collectJournalDraftReminders),
+//             subagents.go (collectSubagentReminders),
+//             processes.go (getConfiguredPorts, checkPort), lifecycle.go (sessionDataDir),
+//             process_unix.go / process_other.go (processAlive)
+//
+// Dependents (What Uses This):
+//   Commands: session/cmd-end (CollectStateReminders, PrintStateReminders),
+//             tool/cmd-post-use (RecordBackgroundProcess)
+//
+// Health Scoring
+//
+//   Collector panic or timeout: -5 (logged failure, other collectors unaffected)
+//   Background process recorded: +5 (logged success)
+//   Ledger write failure: -5 (logged failure, error returned)
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"bufio"         // Ledger line reading
+	"context"       // Collector time limits
+	"encoding/json" // Ledger entries
+	"fmt"           // Reminder messages
+	"os"            // Ledger file
+	"os/exec"       // git diff for the TODO scan
+	"path/filepath" // Ledger path, file names in messages
+	"regexp"        // Marker and hunk header matching
+	"sort"          // Category order
+	"strconv"       // Hunk line numbers
+	"strings"       // Diff parsing and output assembly
+	"time"          // Timeouts and ledger timestamps
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/display" // Severity colors and icons
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// backgroundLedgerName is the pid ledger in the session data directory
+	backgroundLedgerName = "background-processes.jsonl"
+
+	// maxReminderTextLength caps commands and TODO lines quoted in messages
+	maxReminderTextLength = 72
+)
+
+// hunkHeaderPattern captures the new-file start line of a unified diff hunk
+var hunkHeaderPattern = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)`)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// ReminderCategory says what kind of state a reminder is about
+type ReminderCategory string
+
+const (
+	ReminderGit      ReminderCategory = "git"      // Repository state
+	ReminderProcess  ReminderCategory = "process"  // Processes still running
+	ReminderTodo     ReminderCategory = "todo"     // Markers added in uncommitted work
+	ReminderTemporal ReminderCategory = "temporal" // Timing of the session end
+	ReminderJournal  ReminderCategory = "journal"  // Journal drafts nobody has reviewed
+	ReminderSubagent ReminderCategory = "subagent" // Subagent failures never retried
+)
+
+// reminderCategoryOrder is display order (also collector order)
+var reminderCategoryOrder = []ReminderCategory{ReminderGit, ReminderProcess, ReminderSubagent, ReminderTodo, ReminderTemporal, ReminderJournal}
+
+// reminderCategoryLabels head each group when display.group_reminders is on
+var reminderCategoryLabels = map[ReminderCategory]string{
+	ReminderGit:      "Repositories",
+	ReminderProcess:  "Processes",
+	ReminderTodo:     "TODO markers",
+	ReminderTemporal: "Timing",
+	ReminderJournal:  "Unreviewed journal drafts",
+	ReminderSubagent: "Subagent failures",
+}
+
+// ReminderSeverity orders reminders by urgency (higher = more urgent)
+type ReminderSeverity int
+
+const (
+	SeverityInfo     ReminderSeverity = iota // Worth knowing
+	SeverityWarning                          // Should be dealt with
+	SeverityCritical                         // Left half-done (rebase/merge in progress)
+)
+
+// String returns "info", "warning", or "critical"
+func (s ReminderSeverity) String() string {
+	switch s {
+	case SeverityCritical:
+		return "critical"
+	case SeverityWarning:
+		return "warning"
+	}
+	return "info"
+}
+
+// Reminder is one piece of state needing attention at session end
+type Reminder struct {
+	Category ReminderCategory `json:"category"`
+	Severity ReminderSeverity `json:"severity"`
+	Message  string           `json:"message"`
+}
+
+// stateCollector produces one category's reminders
+type stateCollector struct {
+	category ReminderCategory
+	collect  func(ctx context.Context, workspace string) []Reminder
+}
+
+// backgroundProcess is one pid ledger entry
+type backgroundProcess struct {
+	PID       int       `json:"pid"`
+	Command   string    `json:"command"`
+	SessionID string    `json:"session_id"`
+	Started   time.Time `json:"started"`
+}
+
+// todoMarker is a TODO/FIXME found on an added diff line
+type todoMarker struct {
+	File string
+	Line int
+	Text string
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 4 functions
+//   ├── CollectStateReminders(workspace) → runCollectors(stateCollectors())
+//   ├── PrintStateReminders(reminders) → formatStateReminders
+//   ├── StateRemindersEnabled() → stateReminderSettings
+//   └── RecordBackgroundProcess(pid, command) → recordBackgroundProcess(sessionDataDir(), ...)
+//
+//   Collectors (Middle Rungs) - 7 functions
+//   ├── collectGitReminders → workspaceRepos, repoReminderText (reminders.go)
+//   ├── collectUnpushedReminders → unpushedWork (unpushed.go)
+//   ├── collectProcessReminders → backgroundProcessReminders, portReminders
+//   ├── collectSubagentReminders → unretriedSubagentFailures (subagents.go)
+//   ├── collectTodoReminders → discoverRepos, git diff, scanDiffMarkers
+//   ├── collectTemporalReminders → currentTemporalContext (context.go)
+//   └── collectJournalDraftReminders → staleJournalDrafts (journaldraft.go)
+//
+//   Helpers (Bottom Rungs)
+//   ├── runCollectors / runCollector → goroutine per collector, shared deadline, recover
+//   ├── readBackgroundLedger / writeBackgroundLedger / recordBackgroundProcess / appendLedgerLine
+//   ├── scanDiffMarkers(diff, pattern) → pure function
+//   ├── todoMarkerPattern(markers) → whole-word regexp
+//   └── stateReminderSettings / truncateReminderText / formatReminderLine
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Settings and Text
+// ────────────────────────────────────────────────────────────────
+
+// stateReminderSettings returns reminders.jsonc as loaded, else the defaults
+func stateReminderSettings() *RemindersConfiguration {
+	if remindersConfigLoaded && remindersConfig != nil {
+		return remindersConfig
+	}
+	return defaultRemindersConfiguration()
+}
+
+// collectorTimeout is behavior.collector_timeout_seconds (0 = default)
+func collectorTimeout(settings *RemindersConfiguration) time.Duration {
+	seconds := settings.Behavior.CollectorTimeoutSeconds
+	if seconds <= 0 {
+		seconds = defaultCollectorTimeoutSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// truncateReminderText shortens text to maxReminderTextLength runes
+func truncateReminderText(text string) string {
+	runes := []rune(strings.TrimSpace(text))
+	if len(runes) <= maxReminderTextLength {
+		return string(runes)
+	}
+	return string(runes[:maxReminderTextLength-3]) + "..."
+}
+
+// todoMarkerPattern matches any marker as a whole word ("TODO" but not "TODOS")
+func todoMarkerPattern(markers []string) *regexp.Regexp {
+	quoted := make([]string, 0, len(markers))
+	for _, marker := range markers {
+		if marker = strings.TrimSpace(marker); marker != "" {
+			quoted = append(quoted, regexp.QuoteMeta(marker))
+		}
+	}
+	if len(quoted) == 0 {
+		return nil
+	}
+	return regexp.MustCompile(`\b(?:` + strings.Join(quoted, "|") + `)\b`)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Collector Execution
+// ────────────────────────────────────────────────────────────────
+
+// runCollector runs one collector, turning a panic into "no reminders"
+func runCollector(ctx context.Context, collector stateCollector, workspace string, out chan<- []Reminder) {
+	defer func() {
+		if r := recover(); r != nil {
+			contextLogger.Failure("state-reminder-collector", fmt.Sprint(r), -5, map[string]any{
+				"category": string(collector.category),
+			})
+			out <- nil
+		}
+	}()
+	out <- collector.collect(ctx, workspace)
+}
+
+// runCollectors runs collectors concurrently and returns their reminders in collector order
+//
+// All collectors start together under one deadline, so each gets the full
+// timeout. Collectors still running at the deadline are abandoned (their
+// buffered channel lets the goroutine finish without blocking).
+func runCollectors(workspace string, collectors []stateCollector, timeout time.Duration) []Reminder {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	results := make([]chan []Reminder, len(collectors))
+	for i, collector := range collectors {
+		results[i] = make(chan []Reminder, 1)
+		go runCollector(ctx, collector, workspace, results[i])
+	}
+
+	var reminders []Reminder
+	for i, collector := range collectors {
+		select {
+		case found := <-results[i]:
+			reminders = append(reminders, found...)
+		case <-ctx.Done():
+			select {
+			case found := <-results[i]: // Finished in time, read after the deadline
+				reminders = append(reminders, found...)
+				continue
+			default:
+			}
+			contextLogger.Failure("state-reminder-collector", "timed out", -5, map[string]any{
+				"category": string(collector.category),
+				"timeout":  timeout.String(),
+			})
+		}
+	}
+	return reminders
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Background Process Ledger
+// ────────────────────────────────────────────────────────────────
+
+// readBackgroundLedger reads the pid ledger (missing = empty, bad lines skipped)
+func readBackgroundLedger(dir string) ([]backgroundProcess, error) {
+	file, err := os.Open(filepath.Join(dir, backgroundLedgerName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []backgroundProcess
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry backgroundProcess
+		if json.Unmarshal(scanner.Bytes(), &entry) == nil && entry.PID > 0 {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, scanner.Err()
+}
+
+// writeBackgroundLedger replaces the pid ledger with entries (temp file + rename)
+func writeBackgroundLedger(dir string, entries []backgroundProcess) error {
+	var data []byte
+	for _, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		data = append(append(data, line...), '\n')
+	}
+
+	path := filepath.Join(dir, backgroundLedgerName)
+	temp, err := os.CreateTemp(dir, backgroundLedgerName+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name()) // No-op after a successful rename
+
+	if _, err := temp.Write(data); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), path)
+}
+
+// recordBackgroundProcess appends entry to the pid ledger in dir
+func recordBackgroundProcess(dir string, entry backgroundProcess) error {
+	return appendLedgerLine(dir, backgroundLedgerName, entry)
+}
+
+// appendLedgerLine appends entry as one JSON line to the ledger name in dir
+//
+// One write per line with O_APPEND, so concurrent hooks don't interleave.
+// Shared by the pid ledger and the subagent ledger (subagents.go).
+func appendLedgerLine(dir, name string, entry any) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(filepath.Join(dir, name), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Diff Scanning
+// ────────────────────────────────────────────────────────────────
+
+// scanDiffMarkers finds pattern on added lines of a unified diff
+//
+// Line numbers are new-file lines from the hunk headers. "+++ " counts as a
+// file header only outside a hunk - inside one it is an added line that
+// happens to start with "++".
+func scanDiffMarkers(diff []byte, pattern *regexp.Regexp) []todoMarker {
+	var markers []todoMarker
+	file, line, inHunk := "", 0, false
+
+	for _, raw := range strings.Split(string(diff), "\n") {
+		switch {
+		case strings.HasPrefix(raw, "diff "):
+			file, inHunk = "", false
+		case !inHunk && strings.HasPrefix(raw, "+++ "):
+			file = strings.Trim(strings.TrimPrefix(raw, "+++ "), `"`)
+			if file == "/dev/null" {
+				file = "" // Deleted file - nothing added
+			}
+			file = strings.TrimPrefix(file, "b/")
+		case strings.HasPrefix(raw, "@@"):
+			inHunk = false
+			if match := hunkHeaderPattern.FindStringSubmatch(raw); match != nil {
+				line, _ = strconv.Atoi(match[1])
+				inHunk = true
+			}
+		case inHunk && strings.HasPrefix(raw, "+"):
+			if file != "" && pattern.MatchString(raw[1:]) {
+				markers = append(markers, todoMarker{File: file, Line: line, Text: truncateReminderText(raw[1:])})
+			}
+			line++
+		case inHunk && strings.HasPrefix(raw, " "):
+			line++ // Context line (only with --unified > 0)
+		}
+	}
+	return markers
+}
+
+// ────────────────────────────────────────────────────────────────
+// Collectors - One Category Each
+// ────────────────────────────────────────────────────────────────
+
+// collectGitReminders reports repositories needing attention (reminders.uncommitted_work)
+func collectGitReminders(ctx context.Context, workspace string) []Reminder {
+	cfg := stateReminderSettings().Reminders.UncommittedWork
+	if !cfg.Enabled {
+		return nil
+	}
+	template := cfg.RepoMessage
+	if template == "" {
+		template = defaultRepoMessage
+	}
+	unpushedReported := stateReminderSettings().Reminders.UnpushedWork.Enabled
+
+	var reminders []Reminder
+	for _, repo := range workspaceRepos(workspace, reposConfig) {
+		if ctx.Err() != nil {
+			break
+		}
+		if unpushedReported {
+			repo.Ahead = 0 // collectUnpushedReminders says it with the upstream name
+		}
+		if !repo.NeedsAttention() {
+			continue
+		}
+		message := repoReminderText(repo, template, cfg.Threshold)
+		if message == "" {
+			continue
+		}
+		severity := SeverityWarning
+		if repo.Operation != "" {
+			severity = SeverityCritical // Half-finished rebase/merge outlives the session badly
+		}
+		reminders = append(reminders, Reminder{Category: ReminderGit, Severity: severity, Message: message})
+	}
+	return reminders
+}
+
+// collectUnpushedReminders reports commits no remote has (reminders.unpushed_work)
+//
+// Unpushed commits are critical - they are lost with the machine. A
+// repository whose remote status is unknown is only informational.
+func collectUnpushedReminders(ctx context.Context, workspace string) []Reminder {
+	cfg := stateReminderSettings().Reminders.UnpushedWork
+	if !cfg.Enabled {
+		return nil
+	}
+	warnings, _ := unpushedWork(ctx, workspace, unpushedBranchDays(cfg)) // Failures logged, shown as unknown
+
+	var reminders []Reminder
+	for _, warning := range warnings {
+		severity := SeverityCritical
+		if warning.Unknown {
+			severity = SeverityInfo
+		}
+		reminders = append(reminders, Reminder{Category: ReminderGit, Severity: severity, Message: warning.Message()})
+	}
+	return reminders
+}
+
+// backgroundProcessReminders reports live ledger entries from sessionID ("" = any session)
+//
+// Entries whose process has exited are pruned from the ledger. Live entries
+// from other sessions are kept but not reported.
+func backgroundProcessReminders(dir, sessionID string) []Reminder {
+	entries, err := readBackgroundLedger(dir)
+	if err != nil {
+		contextLogger.Failure("background-ledger-read", err.Error(), -5, map[string]any{"dir": dir})
+		return nil
+	}
+
+	var live []backgroundProcess
+	var reminders []Reminder
+	for _, entry := range entries {
+		if !processAlive(entry.PID) {
+			continue
+		}
+		live = append(live, entry)
+		if sessionID != "" && entry.SessionID != sessionID {
+			continue
+		}
+		message := fmt.Sprintf("Background process %d still running", entry.PID)
+		if entry.Command != "" {
+			message += ": " + truncateReminderText(entry.Command)
+		}
+		reminders = append(reminders, Reminder{Category: ReminderProcess, Severity: SeverityWarning, Message: message})
+	}
+
+	if len(live) < len(entries) {
+		if err := writeBackgroundLedger(dir, live); err != nil {
+			contextLogger.Failure("background-ledger-prune", err.Error(), -5, map[string]any{"dir": dir})
+		}
+	}
+	return reminders
+}
+
+// portReminders reports configured dev server ports still listening (processes.jsonc)
+func portReminders(ctx context.Context) []Reminder {
+	message, separator := defaultEndMessage, defaultSeparator
+	if processConfigLoaded && processConfig != nil {
+		if !processConfig.Ports.Enabled || !processConfig.Display.ShowAtEnd {
+			return nil // Monitoring disabled or end display disabled
+		}
+		message, separator = processConfig.Display.EndMessage, processConfig.Display.Separator
+	}
+
+	var running []string
+	for _, port := range getConfiguredPorts() {
+		if ctx.Err() != nil {
+			break
+		}
+		if checkPort(port) {
+			running = append(running, port)
+		}
+	}
+	if len(running) == 0 {
+		return nil
+	}
+	return []Reminder{{
+		Category: ReminderProcess,
+		Severity: SeverityWarning,
+		Message:  strings.TrimSpace(message + " " + strings.Join(running, separator)),
+	}}
+}
+
+// collectProcessReminders reports recorded background processes and listening dev ports
+func collectProcessReminders(ctx context.Context, workspace string) []Reminder {
+	var reminders []Reminder
+	if stateReminderSettings().Reminders.BackgroundProcesses.Enabled {
+		sessionID := ""
+		if sessionData != nil {
+			sessionID = sessionData.SessionID
+		}
+		reminders = append(reminders, backgroundProcessReminders(sessionDataDir(), sessionID)...)
+	}
+	return append(reminders, portReminders(ctx)...)
+}
+
+// collectTodoReminders reports markers on lines added since HEAD (reminders.todo_markers)
+func collectTodoReminders(ctx context.Context, workspace string) []Reminder {
+	cfg := stateReminderSettings().Reminders.TodoMarkers
+	pattern := todoMarkerPattern(cfg.Markers)
+	if !cfg.Enabled || pattern == nil || workspace == "" {
+		return nil
+	}
+	limit := cfg.MaxItems
+	if limit <= 0 {
+		limit = defaultTodoMaxItems
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(time.Duration(defaultCollectorTimeoutSeconds) * time.Second)
+	}
+
+	var found []todoMarker
+	for _, repo := range discoverRepos(workspace, reposConfig, deadline) {
+		cmd := exec.CommandContext(ctx, "git", "-C", repo, "diff", "HEAD", "--unified=0", "--no-color", "--no-ext-diff")
+		diff, err := cmd.Output()
+		if err != nil {
+			continue // No HEAD yet, or the deadline passed
+		}
+		prefix := ""
+		if repo != filepath.Clean(workspace) {
+			prefix = repoName(workspace, repo) + "/"
+		}
+		for _, marker := range scanDiffMarkers(diff, pattern) {
+			marker.File = prefix + marker.File
+			found = append(found, marker)
+		}
+	}
+
+	var reminders []Reminder
+	for i, marker := range found {
+		if i == limit {
+			reminders = append(reminders, Reminder{
+				Category: ReminderTodo,
+				Severity: SeverityInfo,
+				Message:  fmt.Sprintf("...and %d more", len(found)-limit),
+			})
+			break
+		}
+		reminders = append(reminders, Reminder{
+			Category: ReminderTodo,
+			Severity: SeverityInfo,
+			Message:  fmt.Sprintf("%s:%d %s", marker.File, marker.Line, marker.Text),
+		})
+	}
+	return reminders
+}
+
+// collectTemporalReminders notes a session ending during expected downtime (reminders.expected_downtime)
+func collectTemporalReminders(ctx context.Context, workspace string) []Reminder {
+	cfg := stateReminderSettings().Reminders.ExpectedDowntime
+	if !cfg.Enabled {
+		return nil
+	}
+	tctx, err := currentTemporalContext()
+	if err != nil || !tctx.InternalSchedule.ExpectedDowntime {
+		return nil
+	}
+
+	activity := tctx.InternalSchedule.CurrentActivity
+	if activity == "" {
+		activity = tctx.InternalSchedule.ActivityType
+	}
+	template := cfg.Message
+	if template == "" {
+		template = defaultDowntimeMessage
+	}
+	return []Reminder{{
+		Category: ReminderTemporal,
+		Severity: SeverityInfo,
+		Message:  strings.ReplaceAll(template, "{activity}", activity),
+	}}
+}
+
+// stateCollectors lists the built-in collectors in display order
+func stateCollectors() []stateCollector {
+	return []stateCollector{
+		{ReminderGit, collectGitReminders},
+		{ReminderGit, collectUnpushedReminders},
+		{ReminderProcess, collectProcessReminders},
+		{ReminderSubagent, collectSubagentReminders},
+		{ReminderTodo, collectTodoReminders},
+		{ReminderTemporal, collectTemporalReminders},
+		{ReminderJournal, collectJournalDraftReminders},
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// Output Formatting - Display Logic
+// ────────────────────────────────────────────────────────────────
+
+// formatReminderLine renders one reminder (severity color, or a plain icon)
+func formatReminderLine(reminder Reminder, colorCoding bool) string {
+	switch reminder.Severity {
+	case SeverityCritical:
+		if colorCoding {
+			return display.Failure(reminder.Message)
+		}
+		return display.IconFailure + " " + reminder.Message
+	case SeverityWarning:
+		if colorCoding {
+			return display.Warning(reminder.Message)
+		}
+		return display.IconWarning + " " + reminder.Message
+	}
+	if colorCoding {
+		return display.Info(reminder.Message)
+	}
+	return display.IconInfo + " " + reminder.Message
+}
+
+// formatStateReminders renders reminders in category order, optionally grouped
+func formatStateReminders(reminders []Reminder, cfg ReminderDisplayConfig) string {
+	rank := map[ReminderCategory]int{}
+	for i, category := range reminderCategoryOrder {
+		rank[category] = i + 1
+	}
+	ordered := append([]Reminder(nil), reminders...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		ri, rj := rank[ordered[i].Category], rank[ordered[j].Category]
+		if ri == 0 {
+			ri = len(reminderCategoryOrder) + 1 // Unknown categories last
+		}
+		if rj == 0 {
+			rj = len(reminderCategoryOrder) + 1
+		}
+		return ri < rj
+	})
+
+	var b strings.Builder
+	if cfg.PrefixNewline {
+		b.WriteString("\n")
+	}
+	indent := ""
+	for i, reminder := range ordered {
+		if cfg.GroupReminders && (i == 0 || reminder.Category != ordered[i-1].Category) {
+			label := reminderCategoryLabels[reminder.Category]
+			if label == "" {
+				label = string(reminder.Category)
+			}
+			b.WriteString(label + ":\n")
+			indent = "  "
+		}
+		b.WriteString(indent + formatReminderLine(reminder, cfg.ColorCoding) + "\n")
+	}
+	return b.String()
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// StateRemindersEnabled reports behavior.state_reminders (reminders.jsonc)
+//
+// Session end uses the engine when true and the classic per-repository
+// and port reminders when false.
+func StateRemindersEnabled() bool {
+	return stateReminderSettings().Behavior.StateReminders
+}
+
+// CollectStateReminders gathers every built-in reminder for workspace
+//
+// What It Does:
+//   - Workspace "" means NOVA_DAWN_WORKSPACE, else the session's work context
+//   - Runs the git, unpushed, process, subagent, todo, temporal, and journal collectors concurrently, each
+//     bounded by behavior.collector_timeout_seconds
+//   - Returns reminders in category order (a failed collector adds none)
+//
+// Example:
+//   reminders := session.CollectStateReminders("")
+//   session.PrintStateReminders(reminders)
+func CollectStateReminders(workspace string) []Reminder {
+	if workspace == "" {
+		workspace = sessionWorkspace()
+	}
+	settings := stateReminderSettings()
+	return runCollectors(workspace, stateCollectors(), collectorTimeout(settings))
+}
+
+// PrintStateReminders renders reminders to stdout
+//
+// What It Does:
+//   - Nothing when display.enabled or behavior.state_reminders is off, or
+//     there is nothing to remind about
+//   - display.group_reminders: category labels with reminders indented beneath
+//   - display.color_coding: red critical, yellow warning, cyan info
+//
+// Example output (grouped):
+//   Repositories:
+//     ⚠ api (main): 2 modified
+//     ✗ api: main is 1 commit ahead of origin/main - not pushed
+//   TODO markers:
+//     i api/handler.go:42 // TODO: validate input
+func PrintStateReminders(reminders []Reminder) {
+	settings := stateReminderSettings()
+	if !settings.Display.Enabled || !settings.Behavior.StateReminders || len(reminders) == 0 {
+		return
+	}
+	fmt.Fprint(Output(), formatStateReminders(reminders, settings.Display))
+}
+
+// RecordBackgroundProcess adds a background process to this session's pid ledger
+//
+// Called by the tool hooks when a command starts in the background, so
+// session end can remind about processes still running.
+//
+// Returns:
+//   error: Ledger write failure (callers may ignore it)
+//
+// Example:
+//   session.RecordBackgroundProcess(4312, "npm run dev")
+func RecordBackgroundProcess(pid int, command string) error {
+	entry := backgroundProcess{PID: pid, Command: command, Started: now()}
+	if sessionData != nil {
+		entry.SessionID = sessionData.SessionID
+	}
+
+	dir := sessionDataDir()
+	if err := recordBackgroundProcess(dir, entry); err != nil {
+		contextLogger.Failure("background-process-record", err.Error(), -5, map[string]any{"pid": pid})
+		return err
+	}
+	contextLogger.Success("background-process-record", 5, map[string]any{"pid": pid, "command": truncateReminderText(command)})
+	return nil
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Collectors: a panicking or slow collector drops out, the others still report
+//   - Unpushed: ahead of upstream, recent branch with no upstream, no remote (unpushed_test.go)
+//   - Diff scan: markers on added lines only, new-file line numbers, "+++" inside hunks
+//   - Ledger: append, read back, dead entries pruned
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by session end and the tool hooks
+//
+// Code Cleanup: Abandoned collectors finish into buffered channels; ledger
+// temp file removed if the rename never happens
+//
+// Modification Policy:
+//   ✅ Safe: New collectors (add to stateCollectors and a category label)
+//   ⚠️ Care: Ledger line format (the tool hooks write it, session end reads it)
+//   ❌ Never: A collector that blocks without honoring ctx or the shared deadline
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
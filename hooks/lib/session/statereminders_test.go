@@ -0,0 +1,154 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// State Reminders Engine Tests
+//
+// Purpose: Prove collectors fail independently (panic, timeout), the diff scan
+//          reports only added markers with new-file line numbers, the pid
+//          ledger round-trips and prunes, and output groups by category.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestRunCollectors(t *testing.T) {
+	reminder := func(category ReminderCategory, message string) func(context.Context, string) []Reminder {
+		return func(context.Context, string) []Reminder {
+			return []Reminder{{Category: category, Message: message}}
+		}
+	}
+	collectors := []stateCollector{
+		{ReminderGit, reminder(ReminderGit, "repo")},
+		{ReminderProcess, func(context.Context, string) []Reminder { panic("collector bug") }},
+		{ReminderTodo, func(ctx context.Context, _ string) []Reminder {
+			<-ctx.Done() // Ignores its work and overruns
+			time.Sleep(50 * time.Millisecond)
+			return []Reminder{{Category: ReminderTodo, Message: "too late"}}
+		}},
+		{ReminderTemporal, reminder(ReminderTemporal, "downtime")},
+	}
+
+	start := time.Now()
+	got := runCollectors("", collectors, 100*time.Millisecond)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("collection took %v, want bounded by the timeout", elapsed)
+	}
+	var messages []string
+	for _, r := range got {
+		messages = append(messages, r.Message)
+	}
+	if strings.Join(messages, ",") != "repo,downtime" {
+		t.Errorf("reminders = %q, want repo,downtime", messages)
+	}
+}
+
+func TestScanDiffMarkers(t *testing.T) {
+	diff := `diff --git a/api/handler.go b/api/handler.go
+index 1111111..2222222 100644
+--- a/api/handler.go
++++ b/api/handler.go
+@@ -10,0 +11,2 @@ func Handle() {
++	// TODO: validate input
++	return nil
+@@ -40 +42 @@ func other() {
+-	// TODO: old marker, removed
++	x := 1 // FIXME overflow
+diff --git a/gone.go b/gone.go
+deleted file mode 100644
+--- a/gone.go
++++ /dev/null
+@@ -1 +0,0 @@
+-// TODO never mind
+diff --git a/notes.md b/notes.md
+--- a/notes.md
++++ b/notes.md
+@@ -1,0 +2,2 @@
++++ TODO inside a hunk, not a header
++TODOS and FIXMEs are not whole-word markers
+`
+	var got []string
+	for _, m := range scanDiffMarkers([]byte(diff), todoMarkerPattern([]string{"TODO", "FIXME", " "})) {
+		got = append(got, m.File+":"+strconv.Itoa(m.Line)+" "+m.Text)
+	}
+	want := []string{
+		"api/handler.go:11 // TODO: validate input",
+		"api/handler.go:42 x := 1 // FIXME overflow",
+		"notes.md:2 ++ TODO inside a hunk, not a header",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("markers:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if todoMarkerPattern(nil) != nil || todoMarkerPattern([]string{" "}) != nil {
+		t.Error("no markers should disable the scan")
+	}
+}
+
+func TestBackgroundLedger(t *testing.T) {
+	dir := t.TempDir()
+	const deadPID = 1 << 30 // Beyond any pid_max
+	for _, entry := range []backgroundProcess{
+		{PID: os.Getpid(), Command: "npm run dev", SessionID: "this"},
+		{PID: deadPID, Command: "exited", SessionID: "this"},
+		{PID: os.Getpid(), Command: "someone else's", SessionID: "other"},
+	} {
+		if err := recordBackgroundProcess(dir, entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reminders := backgroundProcessReminders(dir, "this")
+	if !processAlive(os.Getpid()) {
+		t.Skip("no liveness probe on this platform")
+	}
+	if len(reminders) != 1 || !strings.HasSuffix(reminders[0].Message, "still running: npm run dev") {
+		t.Errorf("reminders = %+v", reminders)
+	}
+
+	entries, err := readBackgroundLedger(dir)
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("ledger after prune = %+v, %v (want the two live entries)", entries, err)
+	}
+	for _, entry := range entries {
+		if entry.PID == deadPID {
+			t.Error("exited process kept in the ledger")
+		}
+	}
+}
+
+func TestFormatStateReminders(t *testing.T) {
+	reminders := []Reminder{
+		{ReminderTemporal, SeverityInfo, "Session ended during sleep"},
+		{ReminderGit, SeverityCritical, "api (main): rebase in progress"},
+		{ReminderTodo, SeverityInfo, "main.go:3 // TODO"},
+		{ReminderGit, SeverityWarning, "web (main): 1 modified"},
+	}
+
+	grouped := formatStateReminders(reminders, ReminderDisplayConfig{GroupReminders: true})
+	want := "Repositories:\n  ✗ api (main): rebase in progress\n  ⚠ web (main): 1 modified\n" +
+		"TODO markers:\n  i main.go:3 // TODO\nTiming:\n  i Session ended during sleep\n"
+	if grouped != want {
+		t.Errorf("grouped:\n%s\nwant:\n%s", grouped, want)
+	}
+
+	flat := formatStateReminders(reminders[:2], ReminderDisplayConfig{PrefixNewline: true})
+	if flat != "\n✗ api (main): rebase in progress\ni Session ended during sleep\n" {
+		t.Errorf("flat = %q", flat)
+	}
+}
@@ -0,0 +1,401 @@
+// METADATA
+//
+// Session Statistics Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Teach us to number our days, that we may gain a heart of wisdom." - Psalm 90:12 (NIV)
+// Principle: Counting honestly what a session held builds wisdom for the next one
+// Anchor: "Be sure you know the condition of your flocks, give careful attention to your herds." - Proverbs 27:23 (NIV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - session statistics collection)
+// Role: Gathers what a session accomplished for the end-of-session summary
+// Paradigm: CPI-SI framework component - feeds display.go PrintEndStatistics
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.1
+// Last Modified: 2026-10-16 - Audit trail skipped when reading component logs
+//
+// Version History:
+//   1.0.0 (2026-10-16) - Quality indicators, git activity, component health; JSONL history
+//   1.0.1 (2026-10-16) - collectHealthStats skips logs/system/audit.log (not entry format)
+//
+// Purpose & Function
+//
+// Purpose: Assemble SessionStats from three independent sources so the session-end
+// hook can show what the session held and keep a history for later trend display.
+//
+// Sources:
+//   - current.json (via sessiontime): tasks, breakthroughs, struggles, compactions, start time
+//   - git (workspace): commits since session start, files changed, still uncommitted
+//   - logging rail: latest health of each component that logged during the session
+//
+// Core Design: Each source fills its own section pointer; a missing source leaves its
+// section nil so the display (and history) degrade section by section.
+//
+// Blocking Status
+//
+// Non-blocking: Every source failure is absorbed - the stats simply lack that section.
+// History append failure is returned to the caller (display already happened).
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: encoding/json, os, os/exec, path/filepath, sort, strings, time
+//   Internal: system/lib/logging (ReadLogFile, displayLogger), system/lib/sessiontime (via state.go)
+//
+// Dependents (What Uses This):
+//   Commands: session/cmd-end/end.go (CollectSessionStats, AppendSessionStats)
+//   Libraries: display.go (PrintEndStatistics renders SessionStats), patterns.go (reads the history)
+//
+// Health Scoring
+//
+// Stats collected: +10 (reports which sections were available). History appended: +5.
+// History append failed: -5.
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"encoding/json" // SessionStats serialization for the history file
+	"os"            // Log file stat, history append
+	"os/exec"       // git queries for session activity
+	"path/filepath" // Log directory walking, history directory creation
+	"sort"          // Stable lowest-health component selection
+	"strings"       // git output parsing
+	"time"          // Session window and duration
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/logging" // Log parsing for component health
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// sessionStatsHistoryPath holds one SessionStats JSON object per line, oldest
+	// first (tilde expanded by expandPath).
+	sessionStatsHistoryPath = "~/.claude/cpi-si/system/data/session/stats-history.jsonl"
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// QualityStats are the session's quality indicators from current.json.
+type QualityStats struct {
+	TasksCompleted int `json:"tasks_completed"`
+	Breakthroughs  int `json:"breakthroughs"`
+	Struggles      int `json:"struggles"`
+	Compactions    int `json:"compactions"`
+}
+
+// GitStats summarize workspace activity since the session started.
+type GitStats struct {
+	Commits      int `json:"commits"`       // Commits made since session start
+	FilesChanged int `json:"files_changed"` // Distinct files in those commits plus uncommitted changes
+	Uncommitted  int `json:"uncommitted"`   // Entries in git status at session end
+}
+
+// HealthStats aggregate the latest health of each component that logged this session.
+type HealthStats struct {
+	AverageHealth   int    `json:"average_health"`   // Mean normalized health (-100 to +100)
+	Components      int    `json:"components"`       // Components that logged during the session
+	LowestComponent string `json:"lowest_component"` // Component with the lowest health
+	LowestHealth    int    `json:"lowest_health"`
+}
+
+// SessionStats is everything the session-end summary shows.
+//
+// Nil sections mean the source was unavailable; the display skips them and
+// the history line omits them, so trend tools can tell "zero" from "unknown".
+type SessionStats struct {
+	SessionID       string        `json:"session_id,omitempty"`
+	Workspace       string        `json:"workspace,omitempty"`
+	Reason          string        `json:"reason,omitempty"`
+	StartTime       time.Time     `json:"start_time"`
+	EndTime         time.Time     `json:"end_time"`
+	DurationSeconds int64         `json:"duration_seconds,omitempty"`
+	CircadianPhase  string        `json:"circadian_phase,omitempty"` // Phase at session start (patterns.go groups by it)
+	Quality         *QualityStats `json:"quality,omitempty"`
+	Git             *GitStats     `json:"git,omitempty"`
+	Health          *HealthStats  `json:"health,omitempty"`
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 2 functions
+//   ├── CollectSessionStats(workspace, reason) → uses GetSessionState, collectGitStats, collectHealthStats
+//   └── AppendSessionStats(stats) → uses expandPath, displayLogger
+//
+//   Helpers (Bottom Rungs) - 3 functions
+//   ├── collectGitStats(workspace, since) → runs git
+//   ├── collectHealthStats(logRoot, since) → uses logging.ReadLogFile
+//   └── summarizeHealth(latest) → pure function
+//
+// Baton Flow:
+//   end hook → CollectSessionStats → PrintEndStatistics (display.go) → AppendSessionStats
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Sources
+// ────────────────────────────────────────────────────────────────
+
+// collectGitStats reports commits and changed files since the session started
+//
+// Returns nil when the workspace is not a git repository (or git is unavailable).
+func collectGitStats(workspace string, since time.Time) *GitStats {
+	if workspace == "" {
+		return nil
+	}
+
+	status, err := exec.Command("git", "-C", workspace, "status", "--porcelain").Output()
+	if err != nil {
+		return nil // Not a repository - no git section
+	}
+
+	stats := &GitStats{}
+	files := map[string]bool{}
+
+	for _, line := range strings.Split(strings.TrimRight(string(status), "\n"), "\n") {
+		if len(line) > 3 {
+			stats.Uncommitted++
+			files[line[3:]] = true
+		}
+	}
+
+	if !since.IsZero() {
+		// "commit <hash>" marks each commit; the lines after it are its file names
+		args := []string{"-C", workspace, "log", "--since=" + since.Format(time.RFC3339), "--name-only", "--format=commit %H"}
+		if output, err := exec.Command("git", args...).Output(); err == nil {
+			for _, line := range strings.Split(string(output), "\n") {
+				line = strings.TrimSpace(line)
+				switch {
+				case line == "":
+				case strings.HasPrefix(line, "commit "):
+					stats.Commits++
+				default:
+					files[line] = true
+				}
+			}
+		}
+	}
+
+	stats.FilesChanged = len(files)
+	return stats
+}
+
+// collectHealthStats averages the latest health of components that logged since start
+//
+// What It Does:
+//   - Walks <logRoot>/<subdir>/*.log (the rail's routing layout), skipping
+//     level-split files (their entries are already in the main log)
+//   - Skips files not modified since the session started (cheap filter before parsing)
+//   - Keeps each component's most recent entry within the session
+//
+// Returns nil when no component logged during the session.
+func collectHealthStats(logRoot string, since time.Time) *HealthStats {
+	files, err := filepath.Glob(filepath.Join(logRoot, "*", "*.log"))
+	if err != nil || since.IsZero() {
+		return nil
+	}
+
+	latest := map[string]logging.LogEntry{}
+	for _, file := range files {
+		if logging.IsLevelFile(file) || logging.IsAuditLog(file) {
+			continue
+		}
+		if info, err := os.Stat(file); err != nil || info.ModTime().Before(since) {
+			continue
+		}
+		entries, err := logging.ReadLogFile(file)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.Timestamp.Before(since) {
+				continue
+			}
+			if prev, ok := latest[entry.Component]; !ok || entry.Timestamp.After(prev.Timestamp) {
+				latest[entry.Component] = entry
+			}
+		}
+	}
+
+	return summarizeHealth(latest)
+}
+
+// summarizeHealth reduces per-component latest entries to a HealthStats (nil if empty)
+func summarizeHealth(latest map[string]logging.LogEntry) *HealthStats {
+	if len(latest) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(latest))
+	for name := range latest {
+		names = append(names, name)
+	}
+	sort.Strings(names) // Ties for lowest resolve the same way every run
+
+	stats := &HealthStats{Components: len(names), LowestComponent: names[0], LowestHealth: latest[names[0]].NormalizedHealth}
+	total := 0
+	for _, name := range names {
+		health := latest[name].NormalizedHealth
+		total += health
+		if health < stats.LowestHealth {
+			stats.LowestComponent, stats.LowestHealth = name, health
+		}
+	}
+	stats.AverageHealth = total / len(names)
+	return stats
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Collection and History
+// ────────────────────────────────────────────────────────────────
+
+// CollectSessionStats assembles statistics for the session that is ending
+//
+// What It Does:
+//   - Reads current.json for quality indicators, compactions, and start time
+//   - Queries git in the workspace for commits and changed files since start
+//   - Averages component health from logs written since start
+//   - Leaves a section nil when its source is unavailable
+//
+// Parameters:
+//   - workspace: Workspace root for git activity ("" skips git)
+//   - reason: Session end reason (recorded in history)
+//
+// Returns:
+//   - SessionStats (always usable; sections may be nil)
+//
+// Health Impact:
+//   +10: Stats collected (details list available sections)
+//
+// Example:
+//   stats := session.CollectSessionStats(workspace, reason)
+//   session.PrintEndStatistics(stats)
+func CollectSessionStats(workspace, reason string) SessionStats {
+	stats := SessionStats{
+		Workspace: workspace,
+		Reason:    reason,
+		EndTime:   now(),
+	}
+
+	if state, err := GetSessionState(); err == nil {
+		stats.SessionID = state.SessionID
+		stats.StartTime = state.StartTime
+		stats.CircadianPhase = state.CircadianPhase
+		stats.Quality = &QualityStats{
+			TasksCompleted: state.QualityIndicators.TasksCompleted,
+			Breakthroughs:  state.QualityIndicators.Breakthroughs,
+			Struggles:      state.QualityIndicators.Struggles,
+			Compactions:    state.CompactionCount,
+		}
+		if !stats.StartTime.IsZero() {
+			stats.DurationSeconds = int64(stats.EndTime.Sub(stats.StartTime).Seconds())
+		}
+	}
+
+	stats.Git = collectGitStats(workspace, stats.StartTime)
+
+	// displayLogger.LogFile is <root>/<subdir>/<component>.log - root holds every component's logs
+	stats.Health = collectHealthStats(filepath.Dir(filepath.Dir(displayLogger.LogFile)), stats.StartTime)
+
+	displayLogger.Success("session-stats-collected", 10, map[string]any{
+		"session_id": stats.SessionID,
+		"quality":    stats.Quality != nil,
+		"git":        stats.Git != nil,
+		"health":     stats.Health != nil,
+	})
+	return stats
+}
+
+// AppendSessionStats appends stats as one JSON line to the session statistics history
+//
+// Health Impact:
+//   +5: Appended
+//   -5: Could not write history (returned to caller)
+func AppendSessionStats(stats SessionStats) error {
+	path := expandPath(sessionStatsHistoryPath)
+
+	line, err := json.Marshal(stats)
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(path), 0755)
+	}
+	if err == nil {
+		var file *os.File
+		if file, err = os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644); err == nil {
+			_, err = file.Write(append(line, '\n'))
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+		}
+	}
+
+	if err != nil {
+		displayLogger.Failure("session-stats-history", err.Error(), -5, map[string]any{
+			"path": sessionStatsHistoryPath,
+		})
+		return err
+	}
+
+	displayLogger.Success("session-stats-history", 5, map[string]any{
+		"path":       sessionStatsHistoryPath,
+		"session_id": stats.SessionID,
+	})
+	return nil
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Health: latest entry per component wins; lowest ties break alphabetically
+//   - JSON: nil sections are omitted, present zero counts are kept
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by session/cmd-end
+//
+// Code Cleanup: None - stats-history.jsonl is intentionally persistent
+//
+// Modification Policy:
+//   ✅ Safe: New sources (add a nil-able section, render it in PrintEndStatistics)
+//   ⚠️ Care: SessionStats JSON field names (history lines are read back for trends)
+//   ❌ Never: Blocking session end on a failed source or history write
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,69 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Session Statistics Tests
+//
+// Purpose: Prove component health aggregates deterministically and that the
+//          history JSON tells "unknown" (omitted section) apart from zero.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"system/lib/logging"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestSummarizeHealth(t *testing.T) {
+	if got := summarizeHealth(nil); got != nil {
+		t.Errorf("no components: got %+v, want nil", got)
+	}
+
+	got := summarizeHealth(map[string]logging.LogEntry{
+		"validate":        {NormalizedHealth: 90},
+		"session-display": {NormalizedHealth: 40},
+		"activity":        {NormalizedHealth: 40},
+		"git":             {NormalizedHealth: 70},
+	})
+	want := HealthStats{AverageHealth: 60, Components: 4, LowestComponent: "activity", LowestHealth: 40}
+	if *got != want {
+		t.Errorf("summarizeHealth = %+v, want %+v", *got, want)
+	}
+}
+
+func TestSessionStatsJSON(t *testing.T) {
+	stats := SessionStats{
+		SessionID: "s1",
+		Quality:   &QualityStats{}, // Known zero counts
+	}
+	data, err := json.Marshal(stats)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	line := string(data)
+	if !strings.Contains(line, `"quality":{"tasks_completed":0`) {
+		t.Errorf("zero quality section dropped: %s", line)
+	}
+	for _, missing := range []string{`"git"`, `"health"`} {
+		if strings.Contains(line, missing) {
+			t.Errorf("unavailable section %s serialized: %s", missing, line)
+		}
+	}
+
+	var back SessionStats
+	if err := json.Unmarshal(data, &back); err != nil || back.Quality == nil || back.Git != nil {
+		t.Errorf("round trip = %+v, err %v", back, err)
+	}
+}
@@ -0,0 +1,413 @@
+// METADATA
+//
+// Stopping-Point Checklist Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Let all things be done decently and in order" - 1 Corinthians 14:40 (KJV)
+// Principle: A good stopping point is checked, not assumed
+// Anchor: "Examine yourselves" - 2 Corinthians 13:5 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - session stop quality checklist)
+// Role: Evaluates and shows the configured stopping-point checklist
+// Paradigm: CPI-SI framework component - config names the checks, the stop hook runs them
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial stopping-point checklist
+//
+// Version History:
+//   1.0.0 (2026-10-16) - EvaluateStoppingPoint, PrintStopChecklist, command/git_clean/manual detectors
+//
+// Purpose & Function
+//
+// Purpose: "STOPPING POINT CHECK" showed only the time. Whether this is a good
+// place to stop depends on things the hook can often see for itself - are the
+// tests passing, is the work committed - and a few only a person can answer.
+// stop_checklist in formatting.jsonc lists them; the stop hook shows each as
+//
+//   ✓  passed        ✗  failed or timed out        —  manual (yours to confirm)
+//
+// Core Design: Each item names a detector:
+//
+//   command    argv run in the workspace; exit status 0 passes
+//   git_clean  no modified or untracked files in the workspace (git.GetStatus)
+//   manual     never checked automatically
+//
+// An item without a detector gets one from its id ("tests_passing" → command,
+// "work_committed" → git_clean, anything else → manual). Automatic items run
+// concurrently and share one budget (behavior.session_display.
+// stop_checklist_budget_seconds, default 10); an item still running when the
+// budget is spent fails as timed out, so a slow test suite never holds the stop
+// hook. A command's timeout_seconds caps it further.
+//
+// An empty stop_checklist (the default) shows nothing - stop output is
+// unchanged.
+//
+// Blocking Status
+//
+// Non-blocking: Every problem becomes a ✗ or — row; a misconfigured item (no
+// command, unknown detector) is logged and shown as manual.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: context, errors, fmt, os, os/exec, strings, sync, time
+//   Internal: system/lib/git (GetStatus), display.go (stop_checklist config, formatFields),
+//             repos.go (sessionWorkspace), verbosity.go (visible), output.go (Output)
+//
+// Dependents (What Uses This):
+//   Commands: session/cmd-stop (PrintStopChecklist)
+//
+// Health Scoring
+//
+// Checklist evaluated: one "stop-checklist" check entry (passed only when no
+// automatic item failed), health 0 - a failed check is information, not a fault.
+// Misconfigured item: -2 (logged failure).
+package session
+
+// ============================================================================
+// END METADATA
+// ============================================================================
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	//--- Standard Library ---
+
+	"context" // Shared budget across automatic checks
+	"errors"  // Timeout detection
+	"fmt"     // Details
+	"os"      // Workspace fallback (working directory)
+	"os/exec" // Command detector
+	"strings" // Command output's last line
+	"sync"    // Concurrent detectors
+	"time"    // Budget, timeouts, durations
+
+	//--- Internal Packages ---
+
+	"system/lib/git" // Working tree status
+)
+
+const (
+	// Detectors an item can name (detector field)
+	detectorCommand  = "command"
+	detectorGitClean = "git_clean"
+	detectorManual   = "manual"
+
+	// defaultStopChecklistBudget bounds all automatic checks together
+	defaultStopChecklistBudget = 10 * time.Second
+
+	// checklistWaitDelay is how long a timed-out command's output pipes may
+	// stay open (child processes that outlive the kill) before Wait returns
+	checklistWaitDelay = time.Second
+)
+
+// defaultChecklistDetectors gives well-known ids a detector when the item names none
+var defaultChecklistDetectors = map[string]string{
+	"tests_passing":  detectorCommand,
+	"work_committed": detectorGitClean,
+	"todos_captured": detectorManual,
+}
+
+// StopChecklistItem is one stop_checklist entry in formatting.jsonc
+type StopChecklistItem struct {
+	ID             string   `json:"id"`              // Stable name (tests_passing, work_committed, ...)
+	Label          string   `json:"label"`           // Shown in the checklist ("" = the id)
+	Detector       string   `json:"detector"`        // command, git_clean, or manual ("" = from the id)
+	Command        []string `json:"command"`         // argv for the command detector
+	TimeoutSeconds int      `json:"timeout_seconds"` // Cap for the command detector (0 = the whole budget)
+}
+
+// ChecklistStatus is how one checklist item came out
+type ChecklistStatus string
+
+const (
+	ChecklistPass   ChecklistStatus = "pass"   // Automatic check passed
+	ChecklistFail   ChecklistStatus = "fail"   // Automatic check failed or timed out
+	ChecklistManual ChecklistStatus = "manual" // Not checked automatically - confirm it yourself
+)
+
+// ChecklistResult is one evaluated checklist item
+type ChecklistResult struct {
+	ID       string
+	Label    string
+	Status   ChecklistStatus
+	Detail   string        // Why it failed, or what to confirm ("" when passed)
+	Duration time.Duration // Time the detector took (0 for manual items)
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs)
+//   ├── EvaluateStoppingPoint() → evaluateStoppingPoint(config items, workspace, budget)
+//   └── PrintStopChecklist() → visible, EvaluateStoppingPoint, renderStopChecklist, logStopChecklist
+//
+//   Core Operations (Middle Rungs)
+//   ├── evaluateStoppingPoint(items, workspace, budget) → runChecklistDetector per item, concurrently
+//   └── runChecklistDetector(ctx, detector, item, workspace) → checkCommand, checkGitClean
+//
+//   Helpers (Bottom Rungs)
+//   ├── checklistDetector(item) → detector named or implied by the id
+//   ├── checklistBudget() → stop_checklist_budget_seconds or the default
+//   ├── checklistWorkspace() → sessionWorkspace, else the working directory
+//   ├── renderStopChecklist(results) → formatFields rows
+//   └── logStopChecklist(results) → one check entry
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Configuration
+// ────────────────────────────────────────────────────────────────
+
+// checklistDetector returns the detector an item names, or the one its id implies
+func checklistDetector(item StopChecklistItem) string {
+	if item.Detector != "" {
+		return item.Detector
+	}
+	if detector, ok := defaultChecklistDetectors[item.ID]; ok {
+		return detector
+	}
+	return detectorManual
+}
+
+// checklistBudget returns the time all automatic checks share
+func checklistBudget() time.Duration {
+	if seconds := currentDisplayConfig().Behavior.SessionDisplay.StopChecklistBudgetSeconds; seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return defaultStopChecklistBudget
+}
+
+// checklistWorkspace returns where commands and git checks run
+func checklistWorkspace() string {
+	if workspace := sessionWorkspace(); workspace != "" {
+		return workspace
+	}
+	workspace, _ := os.Getwd()
+	return workspace
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Detectors
+// ────────────────────────────────────────────────────────────────
+
+// runChecklistDetector runs one automatic check, giving up when ctx ends
+func runChecklistDetector(ctx context.Context, detector string, item StopChecklistItem, workspace string) (ChecklistStatus, string) {
+	switch detector {
+	case detectorCommand:
+		return checkCommand(ctx, item, workspace)
+	case detectorGitClean:
+		return checkGitClean(ctx, workspace)
+	}
+	return ChecklistManual, ""
+}
+
+// checkCommand runs the item's argv in the workspace; exit status 0 passes
+func checkCommand(ctx context.Context, item StopChecklistItem, workspace string) (ChecklistStatus, string) {
+	if item.TimeoutSeconds > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(item.TimeoutSeconds)*time.Second)
+		defer cancel()
+	}
+	cmd := exec.CommandContext(ctx, item.Command[0], item.Command[1:]...)
+	cmd.Dir = workspace
+	cmd.WaitDelay = checklistWaitDelay
+	output, err := cmd.CombinedOutput()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return ChecklistFail, "timed out"
+	}
+	if err != nil {
+		lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+		if last := strings.TrimSpace(lines[len(lines)-1]); last != "" {
+			return ChecklistFail, truncateReminderText(last)
+		}
+		return ChecklistFail, err.Error()
+	}
+	return ChecklistPass, ""
+}
+
+// checkGitClean passes when the workspace has no modified or untracked files
+func checkGitClean(ctx context.Context, workspace string) (ChecklistStatus, string) {
+	type outcome struct {
+		status git.Status
+		err    error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		status, err := git.GetStatus(workspace)
+		done <- outcome{status, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ChecklistFail, "timed out"
+	case result := <-done:
+		if result.err != nil {
+			return ChecklistFail, truncateReminderText(result.err.Error())
+		}
+		if result.status.Modified == 0 && result.status.Untracked == 0 {
+			return ChecklistPass, ""
+		}
+		return ChecklistFail, fmt.Sprintf("%d modified, %d untracked", result.status.Modified, result.status.Untracked)
+	}
+}
+
+// evaluateStoppingPoint is EvaluateStoppingPoint against given items, workspace, and budget
+//
+// Manual items resolve immediately; automatic ones run concurrently under one
+// deadline. Results keep the items' order.
+func evaluateStoppingPoint(items []StopChecklistItem, workspace string, budget time.Duration) []ChecklistResult {
+	ctx, cancel := context.WithTimeout(context.Background(), budget)
+	defer cancel()
+
+	results := make([]ChecklistResult, len(items))
+	var wg sync.WaitGroup
+	for i, item := range items {
+		label := item.Label
+		if label == "" {
+			label = item.ID
+		}
+		results[i] = ChecklistResult{ID: item.ID, Label: label, Status: ChecklistManual, Detail: "confirm before stopping"}
+
+		detector := checklistDetector(item)
+		switch {
+		case detector == detectorManual:
+			continue
+		case detector != detectorCommand && detector != detectorGitClean:
+			displayLogger.Failure("stop-checklist-item", "unknown detector", -2, map[string]any{"id": item.ID, "detector": detector})
+			continue
+		case detector == detectorCommand && len(item.Command) == 0:
+			displayLogger.Failure("stop-checklist-item", "command detector without a command", -2, map[string]any{"id": item.ID})
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *ChecklistResult) {
+			defer wg.Done()
+			start := time.Now()
+			result.Status, result.Detail = runChecklistDetector(ctx, detector, item, workspace)
+			result.Duration = time.Since(start)
+		}(&results[i])
+	}
+	wg.Wait()
+	return results
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Rendering and Logging
+// ────────────────────────────────────────────────────────────────
+
+// renderStopChecklist draws one row per result: marker, label, detail
+func renderStopChecklist(results []ChecklistResult) string {
+	markers := map[ChecklistStatus]string{ChecklistPass: "✓", ChecklistFail: "✗", ChecklistManual: "—"}
+	rows := make([]fieldRow, len(results))
+	for i, result := range results {
+		rows[i] = fieldRow{markers[result.Status], result.Label, result.Detail}
+	}
+	return formatFields("  ", rows)
+}
+
+// logStopChecklist records the outcome as one check entry
+func logStopChecklist(results []ChecklistResult) {
+	byStatus := map[ChecklistStatus][]string{}
+	for _, result := range results {
+		byStatus[result.Status] = append(byStatus[result.Status], result.ID)
+	}
+	displayLogger.Check("stop-checklist", len(byStatus[ChecklistFail]) == 0, 0, map[string]any{
+		"passed": byStatus[ChecklistPass],
+		"failed": byStatus[ChecklistFail],
+		"manual": byStatus[ChecklistManual],
+	})
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// EvaluateStoppingPoint checks every stop_checklist item
+//
+// What It Does:
+//   - Runs the automatic items (command, git_clean) concurrently in the
+//     workspace (NOVA_DAWN_WORKSPACE, else the working directory), all within
+//     stop_checklist_budget_seconds
+//   - Marks manual items for the person to confirm
+//
+// Returns:
+//   - One result per configured item, in config order (nil when none are configured)
+//
+// Example:
+//   for _, result := range session.EvaluateStoppingPoint() {
+//       fmt.Println(result.Label, result.Status)
+//   }
+func EvaluateStoppingPoint() []ChecklistResult {
+	items := currentDisplayConfig().StopChecklist
+	if len(items) == 0 {
+		return nil
+	}
+	return evaluateStoppingPoint(items, checklistWorkspace(), checklistBudget())
+}
+
+// PrintStopChecklist shows the stopping-point checklist under STOPPING POINT CHECK
+//
+// What It Does:
+//   - Evaluates stop_checklist (EvaluateStoppingPoint)
+//   - Prints ✓ / ✗ / — with each item's label and detail
+//   - Logs the outcome as one "stop-checklist" check
+//
+// Prints nothing when stop_checklist is empty or verbosity hides the section.
+//
+// Example:
+//   session.PrintStopInfo()
+//   session.PrintStopChecklist()
+func PrintStopChecklist() {
+	maybeReloadDisplayConfig()
+
+	if len(currentDisplayConfig().StopChecklist) == 0 || !visible("stop_checklist") {
+		return
+	}
+
+	results := EvaluateStoppingPoint()
+	fmt.Fprint(Output(), renderStopChecklist(results))
+	fmt.Fprintln(Output())
+	logStopChecklist(results)
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation: go test ./... (stopchecklist_test.go - empty config,
+// command pass/fail/timeout, git_clean, manual items, rendered markers)
+//
+// Code Execution: None (library) - the stop hook calls PrintStopChecklist
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
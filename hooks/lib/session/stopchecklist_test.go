@@ -0,0 +1,147 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Stopping-Point Checklist Tests
+//
+// Purpose: Prove an empty stop_checklist leaves stop output unchanged, that
+//          command items pass, fail, and time out within the budget, that
+//          git_clean sees uncommitted files, that manual and misconfigured
+//          items stay manual, and that results render as ✓ / ✗ / —.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// useStopChecklist configures items for one test (golden layout otherwise)
+func useStopChecklist(t *testing.T, items []StopChecklistItem) {
+	t.Helper()
+	useGoldenDisplayConfig(t)
+	cfg := *currentDisplayConfig()
+	cfg.StopChecklist = items
+	displayConfig.Store(&cfg)
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestStopChecklistEmptyPrintsNothing(t *testing.T) {
+	useStopChecklist(t, nil)
+	if got := CaptureOutput(PrintStopChecklist); got != "" {
+		t.Errorf("empty checklist printed %q", got)
+	}
+	if results := EvaluateStoppingPoint(); results != nil {
+		t.Errorf("empty checklist evaluated to %v", results)
+	}
+}
+
+func TestStopChecklistCommands(t *testing.T) {
+	if _, err := exec.LookPath("sleep"); err != nil {
+		t.Skip("sleep not available")
+	}
+	items := []StopChecklistItem{
+		{ID: "tests_passing", Label: "Tests passing", Command: []string{"true"}},
+		{ID: "lint", Detector: "command", Command: []string{"sh", "-c", "echo checking; echo 2 issues; exit 1"}},
+		{ID: "slow", Detector: "command", Command: []string{"sleep", "5"}},
+		{ID: "capped", Detector: "command", Command: []string{"sleep", "5"}, TimeoutSeconds: 1},
+	}
+
+	start := time.Now()
+	results := evaluateStoppingPoint(items, t.TempDir(), 1500*time.Millisecond)
+	if elapsed := time.Since(start); elapsed > 4*time.Second {
+		t.Errorf("took %s, want the checks to share the budget", elapsed)
+	}
+
+	want := []struct {
+		status ChecklistStatus
+		detail string
+	}{
+		{ChecklistPass, ""},
+		{ChecklistFail, "2 issues"},
+		{ChecklistFail, "timed out"},
+		{ChecklistFail, "timed out"},
+	}
+	for i, w := range want {
+		if results[i].Status != w.status || results[i].Detail != w.detail {
+			t.Errorf("%s: got %s %q, want %s %q", results[i].ID, results[i].Status, results[i].Detail, w.status, w.detail)
+		}
+	}
+	if results[1].Label != "lint" {
+		t.Errorf("unlabelled item shows %q, want its id", results[1].Label)
+	}
+}
+
+func TestStopChecklistGitClean(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	repo := t.TempDir()
+	if err := exec.Command("git", "init", "-q", repo).Run(); err != nil {
+		t.Skipf("git init: %v", err)
+	}
+	items := []StopChecklistItem{{ID: "work_committed", Label: "Work committed"}}
+
+	if got := evaluateStoppingPoint(items, repo, time.Second)[0]; got.Status != ChecklistPass {
+		t.Errorf("empty repo: got %s %q, want pass", got.Status, got.Detail)
+	}
+	if err := os.WriteFile(filepath.Join(repo, "notes.txt"), []byte("wip"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got := evaluateStoppingPoint(items, repo, time.Second)[0]; got.Status != ChecklistFail || got.Detail != "0 modified, 1 untracked" {
+		t.Errorf("untracked file: got %s %q", got.Status, got.Detail)
+	}
+}
+
+func TestStopChecklistManualItems(t *testing.T) {
+	items := []StopChecklistItem{
+		{ID: "todos_captured", Label: "TODOs captured"},
+		{ID: "docs_updated"},                               // Unknown id without a detector
+		{ID: "broken", Detector: "telepathy"},              // Unknown detector
+		{ID: "no_argv", Detector: "command", Command: nil}, // Command detector without a command
+	}
+	for _, result := range evaluateStoppingPoint(items, t.TempDir(), time.Second) {
+		if result.Status != ChecklistManual {
+			t.Errorf("%s: status %s, want manual", result.ID, result.Status)
+		}
+	}
+}
+
+func TestStopChecklistRendering(t *testing.T) {
+	useStopChecklist(t, []StopChecklistItem{
+		{ID: "tests_passing", Label: "Tests passing", Command: []string{"true"}},
+		{ID: "lint", Label: "Lint clean", Detector: "command", Command: []string{"false"}},
+		{ID: "todos_captured", Label: "TODOs captured"},
+	})
+	t.Setenv("NOVA_DAWN_WORKSPACE", t.TempDir())
+
+	got := CaptureOutput(PrintStopChecklist)
+	for _, want := range []string{"✓ Tests passing", "✗ Lint clean", "— TODOs captured", "confirm before stopping"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("checklist missing %q:\n%s", want, got)
+		}
+	}
+
+	useVerbosity(t, VerbosityQuiet, "")
+	cfg := *currentDisplayConfig()
+	cfg.StopChecklist = []StopChecklistItem{{ID: "todos_captured"}}
+	displayConfig.Store(&cfg)
+	if got := CaptureOutput(PrintStopChecklist); got != "" {
+		t.Errorf("quiet mode printed %q", got)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
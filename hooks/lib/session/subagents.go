@@ -0,0 +1,299 @@
+// METADATA
+//
+// Subagent Ledger Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Two are better than one... For if they fall, the one will lift up his fellow" - Ecclesiastes 4:9-10 (KJV)
+// Principle: A helper that fell is remembered until someone lifts the work back up
+// Anchor: "Be thou diligent to know the state of thy flocks" - Proverbs 27:23 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - subagent outcome tracking)
+// Role: Keeps subagent failures in session state until a retry succeeds
+// Paradigm: CPI-SI framework component - subagent-stop records, session end reminds
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial subagent ledger and failure reminders
+//
+// Version History:
+//   1.0.0 (2026-10-16) - RecordSubagentStop (ledger, failed-subagent counter), unretried failure reminders
+//
+// Purpose & Function
+//
+// Purpose: A failed subagent printed a warning and was forgotten by session
+// end, though its output may be missing from the work. RecordSubagentStop
+// keeps every completion in a ledger and counts failures in current.json;
+// the state reminders engine lists the failures nothing fixed:
+//
+//   Subagent failures:
+//     ⚠ research subagent failed at 14:32 - output may be incomplete
+//
+// Core Design: The subagent ledger is subagents.jsonl in the session data
+// directory - one JSON line per completion (agent type, failed, exit code,
+// error, session ID, time), appended with the pid ledger's appendLedgerLine.
+// A failure counts as retried when a later successful run of the same agent
+// type in the same session follows it. Failures also increment
+// subagent_failures in current.json and, with
+// reminders.subagent_failures.affect_quality (default on), the
+// quality_indicators struggles count.
+//
+// The failure's FAILURE entry (error_type "subagent_failure") belongs to the
+// subagent-stop hook's own Rails logger - this library only records state.
+//
+// Blocking Status
+//
+// Non-blocking: Ledger and session update failures are logged and returned
+// for the hook to ignore. An unreadable ledger means no subagent reminders.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bufio, context, encoding/json, fmt, os, path/filepath, time
+//   Internal: statereminders.go (Reminder, appendLedgerLine, stateReminderSettings),
+//             reminders.go (subagent_failures settings), lifecycle.go (sessionDataDir, updateSession),
+//             context.go (sessionData, contextLogger)
+//
+// Dependents (What Uses This):
+//   Commands: session/cmd-subagent-stop (RecordSubagentStop)
+//   Libraries: statereminders.go (collectSubagentReminders)
+//
+// Health Scoring
+//
+//   Completion recorded: +5 (logged success)
+//   Ledger write or session update failure: -5 (logged failure, error returned)
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"bufio"         // Ledger line reading
+	"context"       // Collector signature
+	"encoding/json" // Ledger entries
+	"fmt"           // Reminder messages
+	"os"            // Ledger file
+	"path/filepath" // Ledger path
+	"time"          // Completion times
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// subagentLedgerName is the subagent ledger in the session data directory
+	subagentLedgerName = "subagents.jsonl"
+
+	// subagentFailureTimeLayout is the time shown in a failure reminder
+	subagentFailureTimeLayout = "15:04"
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// subagentRun is one subagent ledger entry
+type subagentRun struct {
+	AgentType string    `json:"agent_type"`
+	Failed    bool      `json:"failed"`
+	ExitCode  string    `json:"exit_code,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	SessionID string    `json:"session_id"`
+	Finished  time.Time `json:"finished"`
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs)
+//   └── RecordSubagentStop(agentType, exitCode, message, failed) → recordSubagentStop(sessionDataDir(), ...)
+//
+//   Collectors (Middle Rungs)
+//   └── collectSubagentReminders → readSubagentLedger, unretriedSubagentFailures
+//
+//   Helpers (Bottom Rungs)
+//   ├── readSubagentLedger(dir) → missing = empty, bad lines skipped
+//   ├── unretriedSubagentFailures(runs, sessionID) → pure function
+//   └── recordSubagentStop(dir, run, affectQuality) → appendLedgerLine, updateSession
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Subagent Ledger
+// ────────────────────────────────────────────────────────────────
+
+// readSubagentLedger reads the subagent ledger (missing = empty, bad lines skipped)
+func readSubagentLedger(dir string) ([]subagentRun, error) {
+	file, err := os.Open(filepath.Join(dir, subagentLedgerName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var runs []subagentRun
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var run subagentRun
+		if json.Unmarshal(scanner.Bytes(), &run) == nil && run.AgentType != "" {
+			runs = append(runs, run)
+		}
+	}
+	return runs, scanner.Err()
+}
+
+// unretriedSubagentFailures returns sessionID's failures that no later
+// successful run of the same agent type followed, oldest first
+//
+// Ledger order is completion order; Finished breaks nothing when two hooks
+// append out of order because only a success after the failure counts.
+func unretriedSubagentFailures(runs []subagentRun, sessionID string) []subagentRun {
+	var failures []subagentRun
+	for i, run := range runs {
+		if !run.Failed || run.SessionID != sessionID {
+			continue
+		}
+		retried := false
+		for _, later := range runs[i+1:] {
+			if !later.Failed && later.SessionID == sessionID && later.AgentType == run.AgentType && !later.Finished.Before(run.Finished) {
+				retried = true
+				break
+			}
+		}
+		if !retried {
+			failures = append(failures, run)
+		}
+	}
+	return failures
+}
+
+// recordSubagentStop appends run to the ledger in dir and, for a failure,
+// counts it in that directory's current.json
+func recordSubagentStop(dir string, run subagentRun, affectQuality bool) error {
+	if err := appendLedgerLine(dir, subagentLedgerName, run); err != nil {
+		return fmt.Errorf("subagent ledger: %w", err)
+	}
+	if !run.Failed {
+		return nil
+	}
+	return updateSession(dir, func(s *SessionData) {
+		s.SubagentFailures++
+		if affectQuality {
+			s.QualityIndicators.Struggles++
+		}
+	})
+}
+
+// ────────────────────────────────────────────────────────────────
+// Collectors - State Reminders
+// ────────────────────────────────────────────────────────────────
+
+// collectSubagentReminders lists this session's subagent failures never retried (reminders.subagent_failures)
+func collectSubagentReminders(ctx context.Context, workspace string) []Reminder {
+	if !stateReminderSettings().Reminders.SubagentFailures.Enabled || sessionData == nil {
+		return nil
+	}
+	dir := sessionDataDir()
+	runs, err := readSubagentLedger(dir)
+	if err != nil {
+		contextLogger.Failure("subagent-ledger-read", err.Error(), -5, map[string]any{"dir": dir})
+		return nil
+	}
+
+	var reminders []Reminder
+	for _, run := range unretriedSubagentFailures(runs, sessionData.SessionID) {
+		reminders = append(reminders, Reminder{
+			Category: ReminderSubagent,
+			Severity: SeverityWarning,
+			Message:  fmt.Sprintf("%s subagent failed at %s - output may be incomplete", run.AgentType, run.Finished.Format(subagentFailureTimeLayout)),
+		})
+	}
+	return reminders
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// RecordSubagentStop records a finished subagent for this session
+//
+// What It Does:
+//   - Appends the completion to the subagent ledger (successes too - they
+//     mark earlier failures of the same agent type as retried)
+//   - For a failure, increments subagent_failures in current.json and, with
+//     reminders.subagent_failures.affect_quality, quality_indicators.struggles
+//
+// Returns:
+//   error: Ledger write or session update failure (callers may ignore it)
+//
+// Example:
+//   session.RecordSubagentStop("research", "1", "tool budget exhausted", true)
+func RecordSubagentStop(agentType, exitCode, message string, failed bool) error {
+	run := subagentRun{AgentType: agentType, Failed: failed, ExitCode: exitCode, Error: truncateReminderText(message), Finished: now()}
+	if sessionData != nil {
+		run.SessionID = sessionData.SessionID
+	}
+
+	affectQuality := stateReminderSettings().Reminders.SubagentFailures.AffectQuality
+	if err := recordSubagentStop(sessionDataDir(), run, affectQuality); err != nil {
+		contextLogger.Failure("subagent-record", err.Error(), -5, map[string]any{"agent_type": agentType, "failed": failed})
+		return err
+	}
+	contextLogger.Success("subagent-record", 5, map[string]any{"agent_type": agentType, "failed": failed})
+	return nil
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Retry detection: a later success of the same type clears a failure; another
+//     type's success or another session's does not
+//   - Recording: the ledger line, subagent_failures, and struggles only with affect_quality
+//   - Run: go test ./session/ (subagents_test.go)
+//
+// Code Execution: None (Library) - called by the subagent-stop hook and session end
+//
+// Code Cleanup: None - the ledger lives with the session data
+//
+// Modification Policy:
+//   ✅ Safe: Reminder wording, new ledger fields (omitempty)
+//   ⚠️ Care: Ledger line format (subagent-stop writes it, session end reads it)
+//   ❌ Never: Logging the failure's FAILURE entry here - it belongs to the hook's logger
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,86 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Subagent Ledger Tests
+//
+// Purpose: Prove a failure counts as retried only after a later success of
+//          the same agent type in the same session, and that recording a
+//          failure appends the ledger, increments subagent_failures, and
+//          counts a struggle only with affect_quality.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestUnretriedSubagentFailures(t *testing.T) {
+	at := func(minute int) time.Time { return time.Date(2026, 10, 16, 14, minute, 0, 0, time.UTC) }
+	runs := []subagentRun{
+		{AgentType: "research", Failed: true, SessionID: "s1", Finished: at(10)},
+		{AgentType: "review", Failed: false, SessionID: "s1", Finished: at(12)},   // Another type - no retry
+		{AgentType: "research", Failed: false, SessionID: "s2", Finished: at(13)}, // Another session - no retry
+		{AgentType: "review", Failed: true, SessionID: "s1", Finished: at(20)},
+		{AgentType: "review", Failed: false, SessionID: "s1", Finished: at(25)}, // Retried
+		{AgentType: "build", Failed: false, SessionID: "s1", Finished: at(30)},
+		{AgentType: "build", Failed: true, SessionID: "s1", Finished: at(32)}, // Success came before, not after
+	}
+
+	var got []string
+	for _, run := range unretriedSubagentFailures(runs, "s1") {
+		got = append(got, run.AgentType)
+	}
+	if len(got) != 2 || got[0] != "research" || got[1] != "build" {
+		t.Errorf("unretried = %q, want [research build]", got)
+	}
+}
+
+func TestRecordSubagentStop(t *testing.T) {
+	saved := sessionData
+	defer func() { sessionData = saved }()
+
+	dir := t.TempDir()
+	data, err := initSession(dir, "/work/project")
+	if err != nil {
+		t.Fatal(err)
+	}
+	run := func(agentType string, failed bool) subagentRun {
+		return subagentRun{AgentType: agentType, Failed: failed, ExitCode: "1", SessionID: data.SessionID, Finished: now()}
+	}
+
+	if err := recordSubagentStop(dir, run("research", true), true); err != nil {
+		t.Fatal(err)
+	}
+	if err := recordSubagentStop(dir, run("review", true), false); err != nil {
+		t.Fatal(err)
+	}
+	if err := recordSubagentStop(dir, run("review", false), true); err != nil {
+		t.Fatal(err)
+	}
+
+	if sessionData.SubagentFailures != 2 || sessionData.QualityIndicators.Struggles != 1 {
+		t.Errorf("subagent_failures = %d, struggles = %d, want 2 and 1 (affect_quality on one)",
+			sessionData.SubagentFailures, sessionData.QualityIndicators.Struggles)
+	}
+	runs, err := readSubagentLedger(dir)
+	if err != nil || len(runs) != 3 {
+		t.Fatalf("ledger = %+v, %v (want all three completions)", runs, err)
+	}
+	if failures := unretriedSubagentFailures(runs, data.SessionID); len(failures) != 1 || failures[0].AgentType != "research" {
+		t.Errorf("unretried after a review retry = %+v, want research only", failures)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
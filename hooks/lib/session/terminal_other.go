@@ -0,0 +1,35 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd)
+
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Terminal Size Probe (Other Platforms) - CPI-SI Hooks Session Management
+//
+// Biblical Foundation: See display.go
+// CPI-SI Identity: Platform primitive for session banner sizing
+//
+// Purpose: No TIOCGWINSZ here - report unknown so detectTerminalWidth falls
+//          back to $COLUMNS and the configured width.
+//
+// Authorship: Nova Dawn
+// Version: 1.0.0
+//
+package session
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// terminalColumns reports the size as unknown on platforms without TIOCGWINSZ.
+func terminalColumns(fd uintptr) int {
+	return 0
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with display.go (GOOS=windows go build ./session)
+// Code Execution: Library primitive (called by detectTerminalWidth)
+// Code Cleanup: None needed
@@ -0,0 +1,58 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd
+
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Terminal Size Probe (Unix) - CPI-SI Hooks Session Management
+//
+// Biblical Foundation: See display.go
+// CPI-SI Identity: Platform primitive for session banner sizing
+//
+// Purpose: Ask the kernel for the terminal's column count (TIOCGWINSZ) so
+//          banners fit narrow panes instead of wrapping.
+//
+// Authorship: Nova Dawn
+// Version: 1.0.0
+//
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"syscall" // ioctl(TIOCGWINSZ)
+	"unsafe"  // Pointer to winsize for the ioctl
+)
+
+// winsize mirrors the kernel's struct winsize.
+type winsize struct {
+	Row    uint16
+	Col    uint16
+	Xpixel uint16
+	Ypixel uint16
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// terminalColumns returns the column count of the terminal on fd, or 0 if fd
+// is not a terminal (pipe, file) or the size is unknown.
+func terminalColumns(fd uintptr) int {
+	var ws winsize
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&ws)))
+	if errno != 0 {
+		return 0
+	}
+	return int(ws.Col)
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with display.go (go build ./session)
+// Code Execution: Library primitive (called by detectTerminalWidth)
+// Code Cleanup: None needed (no resources held)
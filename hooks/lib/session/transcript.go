@@ -0,0 +1,348 @@
+// METADATA
+//
+// Subagent Transcript Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Let all things be done decently and in order" - 1 Corinthians 14:40 (KJV)
+// Principle: Delegated work is accounted for - what was done, with which tools, to which files
+// Anchor: "Give an account of thy stewardship" - Luke 16:2 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - subagent completion awareness)
+// Role: Summarizes a subagent's transcript into counts the completion banner can show
+// Paradigm: CPI-SI framework component - feeds PrintSubagentCompletionWithStats
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial transcript analysis
+//
+// Version History:
+//   1.0.0 (2026-10-16) - Tool counts, token totals, files modified, duration
+//
+// Purpose & Function
+//
+// Purpose: The SubagentStop banner said a subagent finished but not what it
+// did. Claude Code hands the hook the subagent's transcript path; read that
+// JSONL once and count what matters: tool invocations by name, tokens, files
+// written, and how long the work took.
+//
+// Core Design: One pass over the transcript, one record per line:
+//   - "timestamp" on any record  → first/last seen → Duration
+//   - message.content[] tool_use → ToolCalls[name]++, and for file-writing
+//     tools the input's file_path / notebook_path → FilesModified
+//   - message.usage              → InputTokens / OutputTokens, counted once
+//     per message.id (streamed assistant messages repeat their usage)
+// Anything else - unknown record types, string content, lines that are not
+// JSON - counts as Opaque and is otherwise ignored, so transcript schema
+// changes degrade the numbers instead of failing the hook.
+//
+// Blocking Status
+//
+// Non-blocking: Only a missing or unreadable file is an error, and the hook
+// falls back to the plain completion banner on any error.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bufio, encoding/json, fmt, os, sort, time
+//
+// Dependents (What Uses This):
+//   Libraries: display.go (PrintSubagentCompletionWithStats renders SubagentStats)
+//   Commands: session/cmd-subagent-stop
+//
+// Health Scoring
+//
+// Pure read path - no logging; the caller decides what a missing transcript means.
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"bufio"         // Line-by-line JSONL reading
+	"encoding/json" // Record decoding
+	"fmt"           // Error wrapping
+	"os"            // Transcript file access
+	"sort"          // Stable FilesModified and TopTools order
+	"time"          // Record timestamps and duration
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// transcriptMaxLine is the longest transcript line read - tool results
+	// (file contents, command output) make single records large
+	transcriptMaxLine = 16 * 1024 * 1024
+)
+
+// fileWritingTools maps tools that modify files to the input keys naming them
+var fileWritingTools = map[string][]string{
+	"Edit":         {"file_path"},
+	"MultiEdit":    {"file_path"},
+	"Write":        {"file_path"},
+	"NotebookEdit": {"notebook_path"},
+}
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// SubagentStats summarizes one subagent transcript
+type SubagentStats struct {
+	ToolCalls     map[string]int `json:"tool_calls"`               // Invocations by tool name
+	TotalTools    int            `json:"total_tools"`              // Sum of ToolCalls
+	InputTokens   int            `json:"input_tokens,omitempty"`   // Prompt tokens incl. cache reads/writes (0 = not recorded)
+	OutputTokens  int            `json:"output_tokens,omitempty"`  // Generated tokens (0 = not recorded)
+	FilesModified []string       `json:"files_modified,omitempty"` // Paths written, sorted and unique
+	Started       time.Time      `json:"started,omitempty"`        // First record timestamp
+	Ended         time.Time      `json:"ended,omitempty"`          // Last record timestamp
+	Duration      time.Duration  `json:"duration,omitempty"`       // Ended - Started (0 = no timestamps)
+	Records       int            `json:"records"`                  // Non-empty lines read
+	Opaque        int            `json:"opaque"`                   // Lines not understood (skipped)
+}
+
+// transcriptRecord is the part of a transcript line the analyzer reads
+type transcriptRecord struct {
+	Type      string             `json:"type"`
+	Timestamp string             `json:"timestamp"`
+	Message   *transcriptMessage `json:"message"`
+}
+
+// transcriptMessage is a record's model message
+type transcriptMessage struct {
+	ID      string           `json:"id"`
+	Content json.RawMessage  `json:"content"` // Block array, or a plain string for text-only turns
+	Usage   *transcriptUsage `json:"usage"`
+}
+
+// transcriptUsage is a message's token accounting
+type transcriptUsage struct {
+	InputTokens              int `json:"input_tokens"`
+	OutputTokens             int `json:"output_tokens"`
+	CacheCreationInputTokens int `json:"cache_creation_input_tokens"`
+	CacheReadInputTokens     int `json:"cache_read_input_tokens"`
+}
+
+// transcriptBlock is one content block (only tool_use blocks are read)
+type transcriptBlock struct {
+	Type  string                     `json:"type"`
+	Name  string                     `json:"name"`
+	Input map[string]json.RawMessage `json:"input"`
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 2 functions
+//   ├── AnalyzeSubagentTranscript(path) → reads lines, addRecord per line
+//   └── SubagentStats.TopTools(n) → pure function
+//
+//   Helpers (Bottom Rungs)
+//   ├── (*SubagentStats).addRecord(line, seenMessages, files) → decode, count
+//   └── blockPath(block) → file path from a file-writing tool's input
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Record Accounting
+// ────────────────────────────────────────────────────────────────
+
+// blockPath returns the file a file-writing tool_use block modifies ("" = none)
+func blockPath(block transcriptBlock) string {
+	for _, key := range fileWritingTools[block.Name] {
+		var path string
+		if json.Unmarshal(block.Input[key], &path) == nil && path != "" {
+			return path
+		}
+	}
+	return ""
+}
+
+// addRecord folds one transcript line into stats
+//
+// seenMessages dedupes usage across the repeated records of one streamed
+// message; files collects FilesModified before sorting.
+func (stats *SubagentStats) addRecord(line []byte, seenMessages, files map[string]bool) {
+	var record transcriptRecord
+	if err := json.Unmarshal(line, &record); err != nil {
+		stats.Opaque++
+		return
+	}
+
+	understood := false
+	if when, err := time.Parse(time.RFC3339Nano, record.Timestamp); err == nil {
+		understood = true
+		if stats.Started.IsZero() || when.Before(stats.Started) {
+			stats.Started = when
+		}
+		if when.After(stats.Ended) {
+			stats.Ended = when
+		}
+	}
+
+	if msg := record.Message; msg != nil {
+		understood = true
+
+		if msg.Usage != nil && (msg.ID == "" || !seenMessages[msg.ID]) {
+			seenMessages[msg.ID] = msg.ID != ""
+			stats.InputTokens += msg.Usage.InputTokens + msg.Usage.CacheCreationInputTokens + msg.Usage.CacheReadInputTokens
+			stats.OutputTokens += msg.Usage.OutputTokens
+		}
+
+		var blocks []transcriptBlock
+		if json.Unmarshal(msg.Content, &blocks) == nil { // String content has no tool calls
+			for _, block := range blocks {
+				if block.Type != "tool_use" || block.Name == "" {
+					continue
+				}
+				stats.ToolCalls[block.Name]++
+				stats.TotalTools++
+				if path := blockPath(block); path != "" {
+					files[path] = true
+				}
+			}
+		}
+	}
+
+	if !understood {
+		stats.Opaque++
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// AnalyzeSubagentTranscript summarizes the transcript Claude Code gave SubagentStop
+//
+// What It Does:
+//   - Reads the JSONL transcript once, line by line
+//   - Counts tool_use blocks by tool name, and collects the files that
+//     Edit, MultiEdit, Write, and NotebookEdit calls name
+//   - Sums token usage once per message id
+//   - Duration = last timestamp - first timestamp
+//   - Lines it cannot read are counted in Opaque, never fatal
+//
+// Parameters:
+//   - path: Transcript file (agent_transcript_path / transcript_path from the hook's stdin)
+//
+// Returns:
+//   - *SubagentStats: Counts from every readable record
+//   - error: Path empty, file missing or unreadable, or a line over 16 MB
+//
+// Example:
+//   stats, err := session.AnalyzeSubagentTranscript(input.AgentTranscriptPath)
+//   if err != nil {
+//       stats = nil // Plain completion banner
+//   }
+//   session.PrintSubagentCompletionWithStats(agentType, status, exitCode, errMsg, stats)
+func AnalyzeSubagentTranscript(path string) (*SubagentStats, error) {
+	if path == "" {
+		return nil, fmt.Errorf("no transcript path provided")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open transcript: %w", err)
+	}
+	defer file.Close()
+
+	stats := &SubagentStats{ToolCalls: map[string]int{}}
+	seenMessages := map[string]bool{}
+	files := map[string]bool{}
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), transcriptMaxLine)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		stats.Records++
+		stats.addRecord(line, seenMessages, files)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read transcript: %w", err)
+	}
+
+	for path := range files {
+		stats.FilesModified = append(stats.FilesModified, path)
+	}
+	sort.Strings(stats.FilesModified)
+
+	if !stats.Started.IsZero() {
+		stats.Duration = stats.Ended.Sub(stats.Started)
+	}
+	return stats, nil
+}
+
+// TopTools returns up to n tool names, most used first (ties by name)
+func (stats *SubagentStats) TopTools(n int) []string {
+	names := make([]string, 0, len(stats.ToolCalls))
+	for name := range stats.ToolCalls {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if stats.ToolCalls[names[i]] != stats.ToolCalls[names[j]] {
+			return stats.ToolCalls[names[i]] > stats.ToolCalls[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	if len(names) > n {
+		names = names[:n]
+	}
+	return names
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Mixed transcript: tools, deduped usage, files, duration, opaque lines
+//   - Missing file: error (hook falls back to the plain banner)
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by the SubagentStop hook
+//
+// Code Cleanup: Transcript file closed on return
+//
+// Modification Policy:
+//   ✅ Safe: More file-writing tools in fileWritingTools, more counted fields
+//   ⚠️ Care: Usage dedupe - streamed messages repeat usage on every record
+//   ❌ Never: Failing on an unknown record shape - transcripts change without notice
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,123 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Subagent Transcript Tests
+//
+// Purpose: Prove AnalyzeSubagentTranscript counts tools, files written, and
+//          tokens (once per streamed message) across schema variations,
+//          treats what it cannot read as opaque, and that the completion
+//          stats block shows only rows with something to say.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// subagentTranscript is a transcript with the shapes Claude Code writes plus
+// records the analyzer has never seen
+const subagentTranscript = `{"type":"user","timestamp":"2026-10-16T10:00:00Z","message":{"role":"user","content":"Review the display code"}}
+{"type":"assistant","timestamp":"2026-10-16T10:00:05Z","message":{"id":"msg_1","content":[{"type":"text","text":"Reading."},{"type":"tool_use","name":"Read","input":{"file_path":"/repo/display.go"}}],"usage":{"input_tokens":100,"cache_read_input_tokens":50,"output_tokens":20}}}
+{"type":"assistant","timestamp":"2026-10-16T10:00:06Z","message":{"id":"msg_1","content":[{"type":"tool_use","name":"Read","input":{"file_path":"/repo/output.go"}}],"usage":{"input_tokens":100,"cache_read_input_tokens":50,"output_tokens":20}}}
+{"type":"user","timestamp":"2026-10-16T10:00:07Z","message":{"role":"user","content":[{"type":"tool_result","tool_use_id":"t1","content":"..."}]}}
+{"type":"assistant","timestamp":"2026-10-16T10:01:00Z","message":{"id":"msg_2","content":[{"type":"tool_use","name":"Edit","input":{"file_path":"/repo/display.go"}},{"type":"tool_use","name":"Write","input":{"file_path":"/repo/new.go"}},{"type":"tool_use","name":"NotebookEdit","input":{"notebook_path":"/repo/a.ipynb"}},{"type":"tool_use","name":"Edit","input":{"file_path":"/repo/display.go"}}],"usage":{"input_tokens":300,"output_tokens":80}}}
+{"type":"summary","summary":"Display review","leafUuid":"x"}
+not json at all
+
+{"type":"assistant","timestamp":"2026-10-16T10:02:30.5Z","message":{"content":[{"type":"tool_use","name":"Bash","input":{"command":"go test"}}]}}
+`
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestAnalyzeSubagentTranscript(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "agent.jsonl")
+	if err := os.WriteFile(path, []byte(subagentTranscript), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := AnalyzeSubagentTranscript(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantTools := map[string]int{"Read": 2, "Edit": 2, "Write": 1, "NotebookEdit": 1, "Bash": 1}
+	if !reflect.DeepEqual(stats.ToolCalls, wantTools) || stats.TotalTools != 7 {
+		t.Errorf("ToolCalls = %v (total %d), want %v (total 7)", stats.ToolCalls, stats.TotalTools, wantTools)
+	}
+	if want := []string{"/repo/a.ipynb", "/repo/display.go", "/repo/new.go"}; !reflect.DeepEqual(stats.FilesModified, want) {
+		t.Errorf("FilesModified = %v, want %v", stats.FilesModified, want)
+	}
+	if stats.InputTokens != 450 || stats.OutputTokens != 100 {
+		t.Errorf("tokens = %d in / %d out, want 450 / 100 (msg_1 counted once)", stats.InputTokens, stats.OutputTokens)
+	}
+	if stats.Duration != 150500*time.Millisecond {
+		t.Errorf("Duration = %v, want 2m30.5s", stats.Duration)
+	}
+	if stats.Records != 8 || stats.Opaque != 2 {
+		t.Errorf("Records = %d, Opaque = %d, want 8 and 2 (summary record, non-JSON line)", stats.Records, stats.Opaque)
+	}
+	if got := stats.TopTools(2); !reflect.DeepEqual(got, []string{"Edit", "Read"}) {
+		t.Errorf("TopTools(2) = %v, want [Edit Read]", got)
+	}
+}
+
+func TestAnalyzeSubagentTranscriptMissing(t *testing.T) {
+	if _, err := AnalyzeSubagentTranscript(""); err == nil {
+		t.Error("empty path: want error")
+	}
+	if _, err := AnalyzeSubagentTranscript(filepath.Join(t.TempDir(), "gone.jsonl")); err == nil {
+		t.Error("missing file: want error")
+	}
+}
+
+func TestSubagentStatsRows(t *testing.T) {
+	cfg := getDefaultDisplayConfig()
+
+	if rows := subagentStatsRows(cfg, nil); rows != nil {
+		t.Errorf("nil stats: rows = %v, want none", rows)
+	}
+
+	// Nothing recorded but a tool call - only the Tools row
+	rows := subagentStatsRows(cfg, &SubagentStats{ToolCalls: map[string]int{"Grep": 1}, TotalTools: 1})
+	if len(rows) != 1 || rows[0].Value != "1 call (Grep 1)" {
+		t.Errorf("tools only: rows = %v", rows)
+	}
+
+	stats := &SubagentStats{
+		ToolCalls:     map[string]int{"Read": 5, "Edit": 3, "Bash": 2, "Grep": 1, "Glob": 1},
+		TotalTools:    12,
+		FilesModified: []string{"/r/a.go", "/r/b.go", "/r/c.go", "/r/d.go"},
+		InputTokens:   1200,
+		OutputTokens:  300,
+		Duration:      95*time.Second + 400*time.Millisecond,
+	}
+	var values []string
+	for _, row := range subagentStatsRows(cfg, stats) {
+		values = append(values, row.Label+" "+row.Value)
+	}
+	want := []string{
+		"Tools: 12 calls (Read 5, Edit 3, Bash 2, Glob 1, +1 more)",
+		"Files Modified: 4 (a.go, b.go, c.go, +1 more)",
+		"Tokens: 1200 in / 300 out",
+		"Worked For: 1m35s",
+	}
+	if strings.Join(values, "\n") != strings.Join(want, "\n") {
+		t.Errorf("stats rows =\n%s\nwant\n%s", strings.Join(values, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,328 @@
+// METADATA
+//
+// Unpushed Work Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Lay not up for yourselves treasures upon earth, where moth and rust doth corrupt" - Matthew 6:19 (KJV)
+// Principle: Work kept in one place only is work one disk failure away from gone
+// Anchor: "Be thou diligent to know the state of thy flocks" - Proverbs 27:23 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - session end awareness)
+// Role: Finds committed work that exists on no remote
+// Paradigm: CPI-SI framework component - feeds the state reminders engine
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial unpushed work detection
+//
+// Version History:
+//   1.0.0 (2026-10-16) - Current branch ahead of upstream, recent branches with no upstream,
+//                        "remote status unknown" degradation
+//
+// Purpose & Function
+//
+// Purpose: Uncommitted files were already reminded about; committed-but-unpushed
+// work was not, and it is the work that gets lost when a laptop dies. Report,
+// per workspace repository, the commits that no remote has.
+//
+// Core Design: Each repository answers two questions from local refs only
+// (nothing is fetched, so no network wait):
+//   - Is the current branch ahead of its upstream?
+//     "main is 4 commits ahead of origin/main - not pushed"
+//   - Does any local branch without an upstream have commits newer than
+//     unpushed_work.branch_days that no remote-tracking ref contains?
+//     "spike has no upstream - 3 commits on no remote"
+// A repository with no remote, or whose git queries fail, yields one
+// "remote status unknown" warning instead of an error at session end.
+//
+// Blocking Status
+//
+// Non-blocking: Failed queries are logged and degrade to "remote status
+// unknown". The whole pass stops at the repositories time limit.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: context, errors, fmt, time
+//   Internal: system/lib/git (remotes, head, upstream, branches), repos.go (discoverRepos,
+//             repoName, sessionWorkspace, reposConfig), statereminders.go (Reminder,
+//             stateReminderSettings), context.go (logGitFailure)
+//
+// Dependents (What Uses This):
+//   Libraries: statereminders.go (collectUnpushedReminders)
+//   Commands: session/cmd-end (through CollectStateReminders)
+//
+// Health Scoring
+//
+// Pure read path - git failures are logged through contextLogger (-5 each).
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"context" // Collector time limit
+	"errors"  // Joining per-repository failures, ErrNoUpstream matching
+	"fmt"     // Warning messages
+	"time"    // Branch age window and discovery deadline
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/git" // Remotes, upstream, branches, unpushed counts
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// noRemoteReason explains an unknown status for a repository with no remotes
+	noRemoteReason = "no remote configured"
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// UnpushedWarning is one branch whose commits no remote has (or a repository
+// whose remote status could not be determined)
+type UnpushedWarning struct {
+	Repo       string `json:"repo"`                  // Workspace-relative name (repoName)
+	Branch     string `json:"branch,omitempty"`      // "" when Unknown
+	Upstream   string `json:"upstream,omitempty"`    // Tracking branch ("" when NoUpstream)
+	Commits    int    `json:"commits"`               // Commits not pushed
+	NoUpstream bool   `json:"no_upstream,omitempty"` // Branch has never been pushed
+	Unknown    bool   `json:"unknown,omitempty"`     // Remote status could not be determined
+	Reason     string `json:"reason,omitempty"`      // Why the status is unknown
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 2 functions
+//   ├── GetUnpushedWork(workspace) → unpushedWork with the repositories time limit
+//   └── UnpushedWarning.Message() → pure function
+//
+//   Core Operations (Middle Rungs) - 2 functions
+//   ├── unpushedWork(ctx, workspace, branchDays) → discoverRepos, repoUnpushedWork
+//   └── repoUnpushedWork(name, path, since) → git.GetRemotes, GetHead, GetUpstream,
+//                                              GetBranches, CountUnpushed
+//
+//   Helpers (Bottom Rungs)
+//   ├── unpushedBranchDays(cfg) → config-if-set-else-default
+//   └── plural(count, word) → pure function
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Settings and Text
+// ────────────────────────────────────────────────────────────────
+
+// unpushedBranchDays is unpushed_work.branch_days (0 = default)
+func unpushedBranchDays(cfg UnpushedWorkConfig) int {
+	if cfg.BranchDays > 0 {
+		return cfg.BranchDays
+	}
+	return defaultUnpushedBranchDays
+}
+
+// plural renders "1 commit" / "4 commits"
+func plural(count int, word string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, word)
+	}
+	return fmt.Sprintf("%d %ss", count, word)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Detection
+// ────────────────────────────────────────────────────────────────
+
+// repoUnpushedWork reports one repository's unpushed branches
+//
+// Branches with an upstream are checked only when current (GetUpstream);
+// branches without one are checked when their tip is newer than since.
+// Any failed query turns into a single Unknown warning plus the error.
+func repoUnpushedWork(name, path string, since time.Time) ([]UnpushedWarning, error) {
+	unknown := func(reason string) []UnpushedWarning {
+		return []UnpushedWarning{{Repo: name, Unknown: true, Reason: reason}}
+	}
+
+	remotes, err := git.GetRemotes(path)
+	if err != nil {
+		logGitFailure(contextLogger, path, "remotes", err)
+		return unknown(err.Error()), err
+	}
+	if len(remotes) == 0 {
+		return unknown(noRemoteReason), nil
+	}
+
+	var warnings []UnpushedWarning
+	head, err := git.GetHead(path)
+	if err != nil {
+		logGitFailure(contextLogger, path, "head", err)
+		return unknown(err.Error()), err
+	}
+	if !head.Detached && head.Branch != "" {
+		upstream, err := git.GetUpstream(path)
+		switch {
+		case err == nil && upstream.Ahead > 0:
+			warnings = append(warnings, UnpushedWarning{Repo: name, Branch: head.Branch, Upstream: upstream.Name, Commits: upstream.Ahead})
+		case err != nil && !errors.Is(err, git.ErrNoUpstream): // No upstream is the branch scan's job
+			logGitFailure(contextLogger, path, "upstream", err)
+			return unknown(err.Error()), err
+		}
+	}
+
+	branches, err := git.GetBranches(path)
+	if err != nil {
+		logGitFailure(contextLogger, path, "branches", err)
+		return append(warnings, unknown(err.Error())...), err
+	}
+	for _, branch := range branches {
+		if branch.Upstream != "" || branch.LastCommit.Before(since) {
+			continue
+		}
+		count, err := git.CountUnpushed(path, branch.Name)
+		if err != nil {
+			logGitFailure(contextLogger, path, "unpushed", err)
+			return append(warnings, unknown(err.Error())...), err
+		}
+		if count > 0 {
+			warnings = append(warnings, UnpushedWarning{Repo: name, Branch: branch.Name, Commits: count, NoUpstream: true})
+		}
+	}
+	return warnings, nil
+}
+
+// unpushedWork checks every workspace repository until ctx ends
+func unpushedWork(ctx context.Context, workspace string, branchDays int) ([]UnpushedWarning, error) {
+	if workspace == "" {
+		return nil, nil
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(time.Duration(defaultRepoTimeoutSeconds) * time.Second)
+	}
+	since := now().AddDate(0, 0, -branchDays)
+
+	var warnings []UnpushedWarning
+	var errs []error
+	for _, path := range discoverRepos(workspace, reposConfig, deadline) {
+		if ctx.Err() != nil {
+			break
+		}
+		found, err := repoUnpushedWork(repoName(workspace, path), path, since)
+		warnings = append(warnings, found...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", repoName(workspace, path), err))
+		}
+	}
+	return warnings, errors.Join(errs...)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// Message renders the warning for STATE REMINDERS
+//
+// Example output:
+//   api: main is 4 commits ahead of origin/main - not pushed
+//   api: spike has no upstream - 1 commit on no remote
+//   notes: remote status unknown (no remote configured)
+func (w UnpushedWarning) Message() string {
+	switch {
+	case w.Unknown:
+		return fmt.Sprintf("%s: remote status unknown (%s)", w.Repo, w.Reason)
+	case w.NoUpstream:
+		return fmt.Sprintf("%s: %s has no upstream - %s on no remote", w.Repo, w.Branch, plural(w.Commits, "commit"))
+	}
+	return fmt.Sprintf("%s: %s is %s ahead of %s - not pushed", w.Repo, w.Branch, plural(w.Commits, "commit"), w.Upstream)
+}
+
+// GetUnpushedWork reports committed work that no remote has
+//
+// What It Does:
+//   - Workspace "" means NOVA_DAWN_WORKSPACE, else the session's work context
+//   - For each workspace repository (repos.go discovery): the current branch
+//     ahead of its upstream, and branches with no upstream whose tip is newer
+//     than unpushed_work.branch_days with commits on no remote
+//   - Local refs only - nothing is fetched, so results reflect the last fetch
+//
+// Returns:
+//   []UnpushedWarning: One per unpushed branch; repositories with no remote or
+//                      failed queries get one Unknown warning instead
+//   error: Joined per-repository git failures (warnings are still returned)
+//
+// Example:
+//   warnings, _ := session.GetUnpushedWork("")
+//   for _, w := range warnings {
+//       fmt.Println(w.Message())
+//   }
+func GetUnpushedWork(workspace string) ([]UnpushedWarning, error) {
+	if workspace == "" {
+		workspace = sessionWorkspace()
+	}
+	timeout := reposConfig.TimeoutSeconds
+	if timeout <= 0 {
+		timeout = defaultRepoTimeoutSeconds
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	return unpushedWork(ctx, workspace, unpushedBranchDays(stateReminderSettings().Reminders.UnpushedWork))
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Ahead of upstream, recent branch with no upstream, old branch ignored
+//   - No remote: one "remote status unknown" warning, no error
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by the state reminders engine
+//
+// Code Cleanup: None - git commands are bounded by git.CommandTimeout
+//
+// Modification Policy:
+//   ✅ Safe: Message wording, new warning kinds (add a field and a Message case)
+//   ⚠️ Care: UnpushedWarning JSON names (hooks may persist them)
+//   ❌ Never: git fetch or any network call - session end must not wait on a remote
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
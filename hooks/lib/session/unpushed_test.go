@@ -0,0 +1,103 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Unpushed Work Tests
+//
+// Purpose: Prove a branch ahead of its upstream and a recent branch with no
+//          upstream are reported, old unpushed branches are not, and a
+//          repository with no remote degrades to "remote status unknown".
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestUnpushedWork(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	for _, key := range []string{"GIT_AUTHOR_NAME", "GIT_COMMITTER_NAME", "GIT_AUTHOR_EMAIL", "GIT_COMMITTER_EMAIL"} {
+		t.Setenv(key, "test")
+	}
+
+	root := t.TempDir()
+	run := func(dir string, env []string, args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(), env...)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, output)
+		}
+	}
+	commit := func(dir, message string, env ...string) {
+		t.Helper()
+		run(dir, env, "commit", "-q", "--allow-empty", "-m", message)
+	}
+
+	remote := filepath.Join(root, "remote.git")
+	run(root, nil, "init", "-q", "--bare", "-b", "main", remote)
+	workspace := filepath.Join(root, "workspace")
+	run(root, nil, "clone", "-q", remote, workspace)
+	run(workspace, nil, "checkout", "-q", "-b", "main")
+	commit(workspace, "first")
+	run(workspace, nil, "push", "-q", "-u", "origin", "main")
+
+	commit(workspace, "second")
+	commit(workspace, "third")
+	run(workspace, nil, "branch", "spike")
+	run(workspace, nil, "checkout", "-q", "-b", "archive")
+	commit(workspace, "old", "GIT_COMMITTER_DATE=2020-01-01T00:00:00Z")
+	run(workspace, nil, "checkout", "-q", "main")
+
+	saved := reposConfig
+	t.Cleanup(func() { reposConfig = saved })
+	reposConfig = ReposConfig{Paths: []string{"notes"}}
+	local := filepath.Join(workspace, "notes")
+	run(root, nil, "init", "-q", "-b", "main", local)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	warnings, err := unpushedWork(ctx, workspace, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var messages []string
+	for _, warning := range warnings {
+		messages = append(messages, warning.Message())
+	}
+	want := []string{
+		"workspace: main is 2 commits ahead of origin/main - not pushed",
+		"workspace: spike has no upstream - 2 commits on no remote",
+		"notes: remote status unknown (no remote configured)",
+	}
+	if got := strings.Join(messages, "\n"); got != strings.Join(want, "\n") {
+		t.Errorf("warnings:\n%s\nwant:\n%s", got, strings.Join(want, "\n"))
+	}
+
+	run(workspace, nil, "push", "-q", "origin", "main")
+	run(workspace, nil, "push", "-q", "-u", "origin", "spike")
+	if warnings, _ := unpushedWork(ctx, workspace, 14); len(warnings) != 1 || !warnings[0].Unknown {
+		t.Errorf("after push: %+v, want only the unknown notes repository", warnings)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,379 @@
+// METADATA
+//
+// Session Verbosity - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Let your communication be, Yea, yea; Nay, nay" - Matthew 5:37 (KJV)
+// Principle: Say as much as the moment needs - no less, no more
+// Anchor: "In the multitude of words there wanteth not sin" - Proverbs 10:19 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - display policy)
+// Role: Decides which display sections each hook invocation shows, and
+//       renders the quiet one-liners and verbose extras
+// Paradigm: CPI-SI framework component - one resolver, consulted by every Print*
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.4.0
+// Last Modified: 2026-10-16 - setup_guidance section (first-run setup)
+//
+// Version History:
+//   1.4.0 (2026-10-16) - "setup_guidance" shown at every level
+//   1.3.0 (2026-10-16) - "stop_checklist" shown in normal and verbose
+//   1.2.0 (2026-10-16) - "compaction_analysis" shown in normal and verbose (show_compaction_analysis)
+//   1.1.0 (2026-10-16) - "gather_summary" shown in normal and verbose
+//   1.0.0 (2026-10-16) - Verbosity levels, CPI_SI_SESSION_VERBOSITY override, visible(section)
+//
+// Purpose & Function
+//
+// Purpose: The start banner, environment table, and temporal block are right
+// for a fresh terminal but noise in a quick restart, and too thin when
+// debugging a hook. Verbosity picks the amount per invocation: quiet collapses
+// banners to one line, verbose adds detail normal mode leaves out.
+//
+// Core Design: Each section name maps to the levels it appears at (and the
+// existing show_* toggle, if it has one). Print* functions ask visible(name)
+// instead of checking levels themselves - a new section is one table entry.
+// Level resolution: CPI_SI_SESSION_VERBOSITY (read once at init, so it is
+// per hook invocation), then behavior.session_display.verbosity in
+// formatting.jsonc, then normal. Unknown values mean normal.
+//
+// Blocking Status
+//
+// Non-blocking: Policy lookups are pure. Verbose detail runs uname and
+// `git remote -v` (bounded by git.CommandTimeout) - only in verbose mode.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: fmt, os, os/exec, runtime, sort, strings, time
+//   Internal: system/lib/git, display.go (currentDisplayConfig, SessionDisplayBehaviorConfig),
+//             history.go (endReasonText), patterns.go (shortDuration), unpushed.go (plural)
+//
+// Dependents (What Uses This):
+//   Libraries: display.go (every session start/stop/end Print*)
+//
+// Health Scoring
+//
+// None - display policy only.
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"fmt"     // One-line summaries
+	"os"      // Verbosity environment override, hostname
+	"os/exec" // Full uname for verbose system info
+	"runtime" // Architecture and CPU count for verbose host details
+	"sort"    // Remotes in name order
+	"strings" // Case-insensitive level names, line joining
+	"time"    // Header and stop line timestamps
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/git" // Remote URLs for verbose environment
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Levels and Override
+// ────────────────────────────────────────────────────────────────
+
+// Verbosity level names accepted in formatting.jsonc and the environment
+const (
+	VerbosityQuiet   = "quiet"   // One-line header and summaries
+	VerbosityNormal  = "normal"  // Default sections
+	VerbosityVerbose = "verbose" // Default sections plus full system, temporal, and git detail
+)
+
+// VerbosityEnv overrides behavior.session_display.verbosity for one hook invocation
+const VerbosityEnv = "CPI_SI_SESSION_VERBOSITY"
+
+// verbosityLevel orders the levels so sections can name a range
+type verbosityLevel int
+
+const (
+	levelQuiet verbosityLevel = iota
+	levelNormal
+	levelVerbose
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Section Policy
+// ────────────────────────────────────────────────────────────────
+
+// sectionPolicy is when one display section appears
+type sectionPolicy struct {
+	min, max verbosityLevel                          // Inclusive level range
+	toggle   func(SessionDisplayBehaviorConfig) bool // show_* switch (nil = none)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Package-Level State
+// ────────────────────────────────────────────────────────────────
+
+var (
+	// sectionPolicies maps section names (as passed to visible) to their
+	// policy. Sections not listed are always visible.
+	sectionPolicies = map[string]sectionPolicy{
+		// Session start
+		"header":             {min: levelNormal, max: levelVerbose},
+		"header_line":        {min: levelQuiet, max: levelQuiet},
+		"environment":        {min: levelNormal, max: levelVerbose},
+		"system_details":     {min: levelVerbose, max: levelVerbose},
+		"git_remotes":        {min: levelVerbose, max: levelVerbose},
+		"temporal_awareness": {min: levelNormal, max: levelVerbose, toggle: func(b SessionDisplayBehaviorConfig) bool { return b.ShowTemporalAwareness }},
+		"temporal_details":   {min: levelVerbose, max: levelVerbose, toggle: func(b SessionDisplayBehaviorConfig) bool { return b.ShowTemporalAwareness }},
+		"recent_sessions":    {min: levelNormal, max: levelVerbose, toggle: func(b SessionDisplayBehaviorConfig) bool { return b.ShowRecentSessions }},
+		"session_patterns":   {min: levelNormal, max: levelVerbose, toggle: func(b SessionDisplayBehaviorConfig) bool { return b.ShowSessionPatterns }},
+		"workspace_analysis": {min: levelQuiet, max: levelVerbose, toggle: func(b SessionDisplayBehaviorConfig) bool { return b.ShowWorkspaceAnalysis }},
+		"gather_summary":     {min: levelNormal, max: levelVerbose},
+		"setup_guidance":     {min: levelQuiet, max: levelVerbose},
+
+		// Session stop
+		"stop_header":      {min: levelNormal, max: levelVerbose},
+		"stop_line":        {min: levelQuiet, max: levelQuiet},
+		"stop_info":        {min: levelNormal, max: levelVerbose},
+		"stopping_context": {min: levelNormal, max: levelVerbose, toggle: func(b SessionDisplayBehaviorConfig) bool { return b.ShowStoppingContext }},
+		"stop_checklist":   {min: levelNormal, max: levelVerbose},
+
+		// Session end
+		"end_farewell":     {min: levelNormal, max: levelVerbose},
+		"end_session_info": {min: levelNormal, max: levelVerbose},
+		"end_statistics":   {min: levelNormal, max: levelVerbose, toggle: func(b SessionDisplayBehaviorConfig) bool { return b.ShowEndStatistics }},
+		"end_line":         {min: levelQuiet, max: levelQuiet},
+		"temporal_journey": {min: levelNormal, max: levelVerbose, toggle: func(b SessionDisplayBehaviorConfig) bool { return b.ShowTemporalJourney }},
+
+		// Compaction
+		"compaction_preservation": {min: levelQuiet, max: levelVerbose, toggle: func(b SessionDisplayBehaviorConfig) bool { return b.ShowCompactionPreservation }},
+		"compaction_analysis":     {min: levelNormal, max: levelVerbose, toggle: func(b SessionDisplayBehaviorConfig) bool { return b.ShowCompactionAnalysis }},
+	}
+
+	verbosityOverride string // CPI_SI_SESSION_VERBOSITY at init ("" = use config)
+)
+
+func init() {
+	verbosityOverride = os.Getenv(VerbosityEnv) // Per hook invocation - each hook is its own process
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 1 function
+//   └── Verbosity() → uses currentVerbosity
+//
+//   Helpers (Bottom Rungs) - 9 functions
+//   ├── visible(section) → uses currentVerbosity, sectionPolicies, currentDisplayConfig
+//   ├── currentVerbosity() → uses parseVerbosity, verbosityOverride, currentDisplayConfig
+//   ├── parseVerbosity(name) → pure function
+//   ├── headerLine(name, at, branch) → pure function (quiet PrintHeader)
+//   ├── stopLine(cfg, at, elapsed) → pure function (quiet PrintStopHeader)
+//   ├── endLine(stats) → uses endReasonText, shortDuration, plural (quiet PrintEndStatistics)
+//   ├── fullSystemInfo() → uses uname -a, GetSystemInfo fallback (verbose PrintEnvironment)
+//   ├── hostDetails() → uses os.Hostname, runtime (verbose PrintEnvironment)
+//   └── remoteLines(dir) → uses git.GetRemoteURLs (verbose PrintEnvironment)
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Level Resolution
+// ────────────────────────────────────────────────────────────────
+
+// parseVerbosity reads a level name (case-insensitive); ok is false for
+// anything else, including ""
+func parseVerbosity(name string) (verbosityLevel, bool) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case VerbosityQuiet:
+		return levelQuiet, true
+	case VerbosityNormal:
+		return levelNormal, true
+	case VerbosityVerbose:
+		return levelVerbose, true
+	}
+	return levelNormal, false
+}
+
+// currentVerbosity resolves the level: environment override, then
+// formatting.jsonc, then normal
+func currentVerbosity() verbosityLevel {
+	if level, ok := parseVerbosity(verbosityOverride); ok {
+		return level
+	}
+	level, _ := parseVerbosity(currentDisplayConfig().Behavior.SessionDisplay.Verbosity)
+	return level
+}
+
+// visible reports whether section appears at the current verbosity
+//
+// What It Does:
+//   - Unlisted sections: always visible
+//   - Listed sections: the level must fall in the section's range, and its
+//     show_* toggle (if any) must be on
+//
+// Example:
+//   if !visible("environment") {
+//       return
+//   }
+func visible(section string) bool {
+	policy, ok := sectionPolicies[section]
+	if !ok {
+		return true
+	}
+	level := currentVerbosity()
+	if level < policy.min || level > policy.max {
+		return false
+	}
+	return policy.toggle == nil || policy.toggle(currentDisplayConfig().Behavior.SessionDisplay)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Quiet Lines
+// ────────────────────────────────────────────────────────────────
+
+// headerLine is the quiet start banner: "Nova Dawn · Mon Nov 18 · branch main"
+// (branch left out outside a repository or on a detached HEAD)
+func headerLine(name string, at time.Time, branch string) string {
+	parts := []string{name, at.Format("Mon Jan 2")}
+	if branch != "" {
+		parts = append(parts, "branch "+branch)
+	}
+	return strings.Join(parts, " · ")
+}
+
+// stopLine is the quiet stop banner: "⏰ Stopped: 15:04 · 2h37m elapsed"
+func stopLine(cfg *SessionDisplayConfig, at time.Time, elapsed string) string {
+	line := fmt.Sprintf("%s %s %s", cfg.Icons.Environment.Time, cfg.FieldLabels.Stop.Stopped, at.Format("15:04"))
+	if elapsed != "" {
+		line += " · " + elapsed + " elapsed"
+	}
+	return line
+}
+
+// endLine is the quiet end summary: "Session ended normally · 2h10m · 4 tasks · 3 commits"
+// (each part only when its source was recorded)
+func endLine(stats SessionStats) string {
+	parts := []string{"Session " + endReasonText(stats.Reason)}
+	if stats.DurationSeconds > 0 {
+		parts = append(parts, shortDuration(time.Duration(stats.DurationSeconds)*time.Second))
+	}
+	if stats.Quality != nil {
+		parts = append(parts, plural(stats.Quality.TasksCompleted, "task"))
+	}
+	if stats.Git != nil {
+		parts = append(parts, plural(stats.Git.Commits, "commit"))
+	}
+	return strings.Join(parts, " · ")
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Verbose Detail
+// ────────────────────────────────────────────────────────────────
+
+// fullSystemInfo returns `uname -a` (GetSystemInfo's short form when unavailable)
+func fullSystemInfo() string {
+	output, err := exec.Command("uname", "-a").Output()
+	if err != nil {
+		return GetSystemInfo()
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// hostDetails returns "hostname (linux/amd64, 8 CPUs)"
+func hostDetails() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "unknown host"
+	}
+	return fmt.Sprintf("%s (%s/%s, %s)", host, runtime.GOOS, runtime.GOARCH, plural(runtime.NumCPU(), "CPU"))
+}
+
+// remoteLines returns "name url" per remote in name order (nil outside a
+// repository or with no remotes)
+func remoteLines(dir string) []string {
+	urls, err := git.GetRemoteURLs(dir)
+	if err != nil || len(urls) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(urls))
+	for name := range urls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := make([]string, len(names))
+	for i, name := range names {
+		lines[i] = name + " " + urls[name]
+	}
+	return lines
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// Verbosity returns the level name in effect for this hook invocation
+//
+// Example:
+//   if session.Verbosity() == session.VerbosityQuiet {
+//       return // Hook-specific extras stay out of quiet output
+//   }
+func Verbosity() string {
+	switch currentVerbosity() {
+	case levelQuiet:
+		return VerbosityQuiet
+	case levelVerbose:
+		return VerbosityVerbose
+	}
+	return VerbosityNormal
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Level resolution, toggles, and quiet/verbose rendering (verbosity_test.go)
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library)
+//
+// Code Cleanup: None
+//
+// Modification Policy:
+//   ✅ Safe: New sections - add a sectionPolicies entry and call visible(name) in the Print*
+//   ⚠️ Care: Changing what quiet hides - quiet is for frequent restarts, keep it to one line per event
+//   ❌ Never: Level checks inside Print* functions - ask visible() so the table stays the one place
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
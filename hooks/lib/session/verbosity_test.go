@@ -0,0 +1,144 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Session Verbosity Tests
+//
+// Purpose: Prove the environment override beats formatting.jsonc, that
+//          show_* toggles still apply inside a level, that quiet mode turns
+//          start/stop/end into one line each, and that verbose mode adds the
+//          temporal detail normal mode leaves out.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// useVerbosity sets the configured level and environment override for one test
+func useVerbosity(t *testing.T, configured, override string) {
+	t.Helper()
+	useGoldenDisplayConfig(t)
+	savedOverride := verbosityOverride
+	t.Cleanup(func() { verbosityOverride = savedOverride })
+
+	cfg := *currentDisplayConfig()
+	cfg.Behavior.SessionDisplay.Verbosity = configured
+	displayConfig.Store(&cfg)
+	verbosityOverride = override
+}
+
+func TestVerbosityResolution(t *testing.T) {
+	cases := []struct {
+		configured, override, want string
+	}{
+		{"", "", VerbosityNormal},
+		{"verbose", "", VerbosityVerbose},
+		{"verbose", "QUIET", VerbosityQuiet}, // Override wins, any case
+		{"quiet", "loud", VerbosityQuiet},    // Unknown override falls through to config
+		{"chatty", "", VerbosityNormal},      // Unknown config means normal
+	}
+	for _, tc := range cases {
+		useVerbosity(t, tc.configured, tc.override)
+		if got := Verbosity(); got != tc.want {
+			t.Errorf("config %q, env %q: Verbosity() = %q, want %q", tc.configured, tc.override, got, tc.want)
+		}
+	}
+}
+
+func TestVisibleSections(t *testing.T) {
+	useVerbosity(t, VerbosityQuiet, "")
+	for section, want := range map[string]bool{
+		"header": false, "header_line": true, "environment": false, "temporal_awareness": false,
+		"workspace_analysis": true, "end_line": true, "end_statistics": false, "unlisted": true,
+	} {
+		if got := visible(section); got != want {
+			t.Errorf("quiet: visible(%q) = %v, want %v", section, got, want)
+		}
+	}
+
+	useVerbosity(t, VerbosityVerbose, "")
+	if !visible("git_remotes") || !visible("temporal_details") || visible("header_line") {
+		t.Error("verbose: want remotes and temporal details, no one-line header")
+	}
+
+	// A show_* toggle still hides its section at every level
+	cfg := *currentDisplayConfig()
+	cfg.Behavior.SessionDisplay.ShowTemporalAwareness = false
+	displayConfig.Store(&cfg)
+	if visible("temporal_awareness") || visible("temporal_details") {
+		t.Error("show_temporal_awareness=false should hide temporal sections in verbose mode")
+	}
+}
+
+func TestQuietLines(t *testing.T) {
+	at := time.Date(2026, 11, 18, 9, 5, 0, 0, time.UTC) // A Wednesday
+
+	if got, want := headerLine("Nova Dawn", at, "main"), "Nova Dawn · Wed Nov 18 · branch main"; got != want {
+		t.Errorf("headerLine = %q, want %q", got, want)
+	}
+	if got := headerLine("Nova Dawn", at, ""); got != "Nova Dawn · Wed Nov 18" {
+		t.Errorf("headerLine without branch = %q", got)
+	}
+
+	stats := SessionStats{
+		Reason:          "Normal session end",
+		DurationSeconds: int64((2*time.Hour + 10*time.Minute).Seconds()),
+		Quality:         &QualityStats{TasksCompleted: 4},
+		Git:             &GitStats{Commits: 1},
+	}
+	if got, want := endLine(stats), "Session ended normally · 2h10m · 4 tasks · 1 commit"; got != want {
+		t.Errorf("endLine = %q, want %q", got, want)
+	}
+	if got := endLine(SessionStats{Reason: "clear"}); got != "Session cleared" {
+		t.Errorf("bare endLine = %q", got)
+	}
+}
+
+func TestQuietAndVerboseOutput(t *testing.T) {
+	end := time.Date(2026, 10, 16, 14, 7, 0, 0, time.UTC)
+
+	useVerbosity(t, VerbosityQuiet, "")
+	useSyntheticSession(t, end, 2*time.Hour+37*time.Minute)
+
+	quiet := CaptureOutput(func() {
+		PrintEnvironment("")
+		PrintTemporalAwareness()
+		PrintStopHeader()
+		PrintStopInfo()
+		PrintStoppingContext()
+		PrintEndFarewell()
+		PrintEndSessionInfo("Normal session end")
+		PrintEndStatistics(SessionStats{Reason: "Normal session end", Quality: &QualityStats{TasksCompleted: 2}})
+		PrintEndTemporalJourney()
+	})
+	lines := strings.Split(strings.TrimSpace(quiet), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], "14:07 · 2h37m elapsed") || lines[1] != "Session ended normally · 2 tasks" {
+		t.Errorf("quiet stop and end = %q, want one line each", lines)
+	}
+
+	useVerbosity(t, VerbosityNormal, "")
+	normal := CaptureOutput(PrintTemporalAwareness)
+	useVerbosity(t, VerbosityNormal, VerbosityVerbose)
+	verbose := CaptureOutput(PrintTemporalAwareness)
+
+	for _, detail := range []string{"Time zone: UTC", "Started 11:30:00", "Date: 2026-10-16"} {
+		if strings.Contains(normal, detail) || !strings.Contains(verbose, detail) {
+			t.Errorf("%q should appear only in verbose output\nnormal:\n%s\nverbose:\n%s", detail, normal, verbose)
+		}
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
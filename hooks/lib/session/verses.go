@@ -0,0 +1,301 @@
+// METADATA
+//
+// Verse Rotation Library - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Thy word is a lamp unto my feet, and a light unto my path." - Psalm 119:105 (KJV)
+// Principle: Scripture read widely, not one verse worn thin
+// Anchor: "All Scripture is God-breathed and is useful for teaching, rebuking, correcting and training in righteousness." - 2 Timothy 3:16 (NIV)
+//
+// CPI-SI Identity
+//
+// Component Type: Ladder (Library - verse selection for session banners)
+// Role: Picks the banner verse for start, stop, and end from optional rotation pools
+// Paradigm: CPI-SI framework component - serves display.go banner functions
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial verse rotation
+//
+// Version History:
+//   1.0.0 (2026-10-16) - Pools per event with sequential, random, and daily selection
+//
+// Purpose & Function
+//
+// Purpose: Let display/formatting.jsonc list several verses per banner event
+// (session_start_pool, session_stop_pool, session_end_pool) and rotate through them.
+//
+// Core Design: resolveVerse(event, fallback) returns the pool verse chosen by
+// biblical_verses.selection, or the fallback (the event's single configured verse)
+// when the pool is empty.
+//
+// Selection Strategies:
+//   - "sequential" (default): index per event persisted in verse-state.json, advances each display
+//   - "random": uniform pick each display, no state
+//   - "daily": deterministic by local date - every banner that day shows the same verse
+//
+// Blocking Status
+//
+// Non-blocking: Unreadable state restarts the rotation at the first verse; an
+// unwritable state file still shows the verse (rotation just doesn't advance).
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: encoding/json, math/rand/v2, os, path/filepath, time
+//   Internal: system/lib/logging (via displayLogger)
+//
+// Dependents (What Uses This):
+//   Libraries: display.go (PrintHeader, PrintStopHeader, PrintEndFarewell)
+//
+// Health Scoring
+//
+// Verse selected: 0 (recorded for visibility). Sequential state not saved: -5.
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"encoding/json" // verse-state.json read/write
+	"math/rand/v2"  // "random" selection
+	"os"            // State file I/O
+	"path/filepath" // State directory creation
+	"time"          // "daily" selection and state timestamp
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// verseStatePath persists sequential rotation indexes (tilde expanded by expandPath).
+	verseStatePath = "~/.claude/cpi-si/system/data/session/verse-state.json"
+
+	//--- Banner Events ---
+	// Keys into pools and verse-state.json indexes.
+
+	verseEventStart = "session_start"
+	verseEventStop  = "session_stop"
+	verseEventEnd   = "session_end"
+
+	//--- Selection Strategies ---
+
+	verseSelectionSequential = "sequential"
+	verseSelectionRandom     = "random"
+	verseSelectionDaily      = "daily"
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// verseState is the persisted sequential rotation position.
+type verseState struct {
+	Next    map[string]int `json:"next"`    // Next pool index per event
+	Updated time.Time      `json:"updated"` // Last time any index advanced
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   resolveVerse(event, fallback) → uses versePool, selectVerseIndex, displayLogger
+//   ├── versePool(event) → reads currentDisplayConfig()
+//   └── selectVerseIndex(strategy, event, size, statePath, now) → uses loadVerseState, saveVerseState
+//       ├── loadVerseState(path) → pure I/O
+//       └── saveVerseState(path, state) → pure I/O
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - State Persistence
+// ────────────────────────────────────────────────────────────────
+
+// loadVerseState reads rotation state; missing or corrupt state starts fresh
+func loadVerseState(path string) verseState {
+	state := verseState{Next: map[string]int{}}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return state
+	}
+	if json.Unmarshal(data, &state) != nil || state.Next == nil {
+		return verseState{Next: map[string]int{}}
+	}
+	return state
+}
+
+// saveVerseState writes rotation state atomically (temp file + rename)
+func saveVerseState(path string, state verseState) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Selection
+// ────────────────────────────────────────────────────────────────
+
+// versePool returns the configured pool for an event, skipping entries without text
+func versePool(event string) []BiblicalVerseConfig {
+	verses := currentDisplayConfig().BiblicalVerses
+
+	var pool []BiblicalVerseConfig
+	switch event {
+	case verseEventStart:
+		pool = verses.SessionStartPool
+	case verseEventStop:
+		pool = verses.SessionStopPool
+	case verseEventEnd:
+		pool = verses.SessionEndPool
+	}
+
+	usable := make([]BiblicalVerseConfig, 0, len(pool))
+	for _, verse := range pool {
+		if verse.VerseText != "" {
+			usable = append(usable, verse)
+		}
+	}
+	return usable
+}
+
+// selectVerseIndex picks a pool index for an event
+//
+// Parameters:
+//   - strategy: "sequential", "random", or "daily" (unknown/empty = sequential)
+//   - event: Banner event key (state is tracked per event)
+//   - size: Pool size (> 0)
+//   - statePath: verse-state.json location (sequential only)
+//   - now: Current time (daily uses its local date)
+//
+// Returns:
+//   - Index in [0, size)
+//   - Error if sequential state could not be saved (index still valid)
+func selectVerseIndex(strategy, event string, size int, statePath string, now time.Time) (int, error) {
+	switch strategy {
+	case verseSelectionRandom:
+		return rand.IntN(size), nil
+
+	case verseSelectionDaily:
+		y, m, d := now.Date()
+		day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400 // Local calendar day, counted from epoch
+		return int(day % int64(size)), nil
+
+	default: // verseSelectionSequential
+		state := loadVerseState(statePath)
+		index := state.Next[event] % size
+		if index < 0 {
+			index = 0 // Hand-edited state
+		}
+		state.Next[event] = (index + 1) % size
+		state.Updated = now
+		return index, saveVerseState(statePath, state)
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// Verse Resolution - Used by Banner Functions
+// ────────────────────────────────────────────────────────────────
+
+// resolveVerse returns the verse a banner should show for an event
+//
+// What It Does:
+//   - Empty pool: returns fallback (the event's single configured verse)
+//   - Otherwise: picks from the pool per biblical_verses.selection
+//   - Logs which verse was chosen so each session's verse is traceable
+//
+// Health Impact:
+//   0: Verse selected (informational)
+//   -5: Sequential state could not be saved
+func resolveVerse(event string, fallback BiblicalVerseConfig) BiblicalVerseConfig {
+	pool := versePool(event)
+	if len(pool) == 0 {
+		displayLogger.Success("verse-selected", 0, map[string]any{
+			"event":     event,
+			"source":    "fixed",
+			"verse_ref": fallback.VerseRef,
+		})
+		return fallback
+	}
+
+	strategy := currentDisplayConfig().BiblicalVerses.Selection
+	if strategy == "" {
+		strategy = verseSelectionSequential
+	}
+
+	index, err := selectVerseIndex(strategy, event, len(pool), expandPath(verseStatePath), now())
+	if err != nil {
+		displayLogger.Failure("verse-state-save", err.Error(), -5, map[string]any{
+			"event": event,
+			"path":  verseStatePath,
+		})
+	}
+
+	verse := pool[index]
+	displayLogger.Success("verse-selected", 0, map[string]any{
+		"event":     event,
+		"source":    "pool",
+		"strategy":  strategy,
+		"index":     index,
+		"pool_size": len(pool),
+		"verse_ref": verse.VerseRef,
+	})
+	return verse
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Sequential: consecutive selections walk the pool and wrap; state survives restarts
+//   - Daily: same date → same index; corrupt state file → rotation restarts at 0
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by display.go banner functions
+//
+// Code Cleanup: None - verse-state.json is small and intentionally persistent
+//
+// Modification Policy:
+//   ✅ Safe: New strategies (add a case in selectVerseIndex, document in formatting.jsonc)
+//   ⚠️ Care: verse-state.json shape (existing files must still load)
+//   ❌ Never: Blocking the banner on state I/O failures
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
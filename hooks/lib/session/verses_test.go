@@ -0,0 +1,73 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Verse Rotation Tests
+//
+// Purpose: Prove sequential rotation advances and wraps per event across
+//          restarts, and daily selection is stable within a date.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestSequentialVerseRotation(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "session", "verse-state.json")
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.Local)
+
+	var got []int
+	for range 4 {
+		index, err := selectVerseIndex(verseSelectionSequential, verseEventStop, 3, statePath, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, index)
+	}
+	if want := []int{0, 1, 2, 0}; !slices.Equal(got, want) {
+		t.Errorf("stop rotation = %v, want %v", got, want)
+	}
+
+	// Events rotate independently
+	if index, _ := selectVerseIndex(verseSelectionSequential, verseEventEnd, 3, statePath, now); index != 0 {
+		t.Errorf("first end index = %d, want 0", index)
+	}
+
+	// Corrupt state restarts rather than failing
+	if err := os.WriteFile(statePath, []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if index, err := selectVerseIndex(verseSelectionSequential, verseEventStop, 3, statePath, now); err != nil || index != 0 {
+		t.Errorf("after corrupt state: index=%d err=%v, want 0, nil", index, err)
+	}
+}
+
+func TestDailyVerseSelection(t *testing.T) {
+	morning := time.Date(2026, 10, 16, 6, 0, 0, 0, time.Local)
+	evening := time.Date(2026, 10, 16, 23, 0, 0, 0, time.Local)
+	tomorrow := morning.AddDate(0, 0, 1)
+
+	a, _ := selectVerseIndex(verseSelectionDaily, verseEventStart, 7, "", morning)
+	b, _ := selectVerseIndex(verseSelectionDaily, verseEventStart, 7, "", evening)
+	c, _ := selectVerseIndex(verseSelectionDaily, verseEventStart, 7, "", tomorrow)
+
+	if a != b {
+		t.Errorf("same date gave %d and %d", a, b)
+	}
+	if c != (a+1)%7 {
+		t.Errorf("next day = %d, want %d", c, (a+1)%7)
+	}
+}
@@ -0,0 +1,883 @@
+// METADATA
+//
+// # Workspace Analyzer - CPI-SI Hooks Session Management
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// # Biblical Foundation
+//
+// Scripture: "For which of you, intending to build a tower, sitteth not down first, and counteth the cost" - Luke 14:28 (KJV)
+// Principle: Know the ground before building on it - what it holds, what it lacks, what was left behind
+// Anchor: "Ponder the path of thy feet, and let all thy ways be established." - Proverbs 4:26 (KJV)
+//
+// # CPI-SI Identity
+//
+// Component Type: Ladder (Library - session start awareness)
+// Role: Looks over the workspace at session start and reports what deserves attention
+// Paradigm: CPI-SI framework component - independent probes, one report, one renderer
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.1.0
+// Last Modified: 2026-10-16 - Analysis gathered at session start reused
+//
+// Version History:
+//
+//	1.1.0 (2026-10-16) - AnalyzeWorkspace returns what session start gathered (gather.go);
+//	                     gathered analysis fits its budget inside start_deadline_ms
+//	1.0.0 (2026-10-16) - disk, files, branches, and todo probes; shared time budget;
+//	                     severity-grouped PrintWorkspaceAnalysisReport
+//
+// Purpose & Function
+//
+// Purpose: The workspace analysis section printed a header and then "no
+// workspace" or "workspace healthy" - nothing was analyzed. AnalyzeWorkspace
+// runs probes over the workspace and returns a WorkspaceReport; its findings
+// decide whether "healthy" is shown at all.
+//
+// Core Design: Each probe answers one question and fills its own report:
+//   - disk: total size of the workspace, and the largest build artifacts
+//     (node_modules, target/, build/, ...) - untracked ones only in repositories
+//   - files: expected project files that are missing (README, go.work when
+//     the workspace holds several Go modules)
+//   - branches: local branches already merged into HEAD but not deleted
+//   - todo: TODO/FIXME markers in tracked files (bounded file count and size)
+//
+// Probes run concurrently under one time budget (behavior.time_budget_ms,
+// default 2s). Probes watch the deadline and return what they have; a probe
+// that fails, panics, or never returns is listed in Incomplete and the rest
+// still report.
+//
+// # Blocking Status
+//
+// Non-blocking: Probe failures are logged and their findings simply missing.
+// Analysis never outlives the time budget (plus a short grace period).
+//
+// # Dependencies
+//
+// Dependencies (What This Needs):
+//
+//	Standard Library: bytes, context, fmt, io/fs, os, os/exec, path/filepath, sort, strings, time
+//	Internal: system/lib/jsonc (config), system/lib/logging, display.go (section header,
+//	          visibility toggle), statereminders.go (ReminderSeverity, formatReminderLine,
+//	          todoMarkerPattern), repos.go (discoverRepos, repoName, reposConfig)
+//
+// Dependents (What Uses This):
+//
+//	Commands: session/cmd-start (AnalyzeWorkspace, PrintWorkspaceAnalysisReport)
+//
+// Health Scoring
+//
+//	Analysis complete: +10 (logged success with findings count)
+//	Probe failed, panicked, or timed out: -5 (logged failure, other probes unaffected)
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"bytes"         // Binary check and marker counting
+	"context"       // Shared time budget
+	"fmt"           // Finding messages
+	"io/fs"         // WalkDir entries
+	"os"            // File reads and stat
+	"os/exec"       // git branch / ls-files
+	"path/filepath" // Walking and globbing
+	"regexp"        // Marker pattern type
+	"sort"          // Largest artifacts first
+	"strings"       // Output parsing and rendering
+	"time"          // Budget and durations
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/jsonc"   // workspace-analysis.jsonc over the defaults
+	"system/lib/logging" // Probe failures and analysis summary
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// workspaceAnalysisConfigFile is the config in the session config directory
+	workspaceAnalysisConfigFile = ".claude/cpi-si/system/data/config/session/workspace-analysis.jsonc"
+
+	// defaultWorkspaceTimeBudgetMS bounds a whole analysis when behavior.time_budget_ms is unset
+	defaultWorkspaceTimeBudgetMS = 2000
+
+	// workspaceProbeGrace lets probes that noticed the deadline hand in partial results
+	workspaceProbeGrace = 100 * time.Millisecond
+
+	// defaultWorkspaceWarnMB flags a workspace larger than this
+	defaultWorkspaceWarnMB = 10240
+
+	// defaultArtifactMinMB ignores artifact directories smaller than this
+	defaultArtifactMinMB = 100
+
+	// defaultArtifactMaxReported caps how many artifacts are listed
+	defaultArtifactMaxReported = 3
+
+	// defaultTodoMaxFiles caps how many tracked files the todo probe reads
+	defaultTodoMaxFiles = 2000
+
+	// defaultTodoMaxFileKB skips tracked files larger than this
+	defaultTodoMaxFileKB = 512
+
+	// workspaceSniffBytes is how much of a file the binary check reads
+	workspaceSniffBytes = 512
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// WorkspaceFinding is one thing the analyzer thinks deserves attention
+type WorkspaceFinding struct {
+	Probe    string           `json:"probe"` // disk, files, branches, todo
+	Severity ReminderSeverity `json:"severity"`
+	Message  string           `json:"message"`
+}
+
+// WorkspaceArtifact is a build/dependency directory and its size
+type WorkspaceArtifact struct {
+	Path  string `json:"path"` // Relative to the workspace
+	Bytes int64  `json:"bytes"`
+}
+
+// WorkspaceReport is everything AnalyzeWorkspace found
+//
+// Zero values mean "not measured" when the probe is listed in Incomplete or
+// disabled. Findings is what PrintWorkspaceAnalysisReport shows.
+type WorkspaceReport struct {
+	Path          string              `json:"path"`
+	DiskBytes     int64               `json:"disk_bytes"`     // Total size (partial if disk is Incomplete)
+	Artifacts     []WorkspaceArtifact `json:"artifacts"`      // Largest first
+	MissingFiles  []string            `json:"missing_files"`  // Expected file patterns with no match
+	StaleBranches []string            `json:"stale_branches"` // "branch" or "repo/branch"
+	TodoCount     int                 `json:"todo_count"`     // Markers in scanned tracked files
+	TodoFiles     int                 `json:"todo_files"`     // Tracked files scanned
+	Findings      []WorkspaceFinding  `json:"findings"`
+	Incomplete    []string            `json:"incomplete"` // Probes that failed or ran out of time
+	Duration      time.Duration       `json:"duration"`
+}
+
+// Noteworthy reports whether the analysis found anything to show
+func (r *WorkspaceReport) Noteworthy() bool {
+	return r != nil && len(r.Findings) > 0
+}
+
+// ExpectedFileRule names a file the workspace should have
+//
+// Pattern is a glob relative to the workspace ("README*"). When, if set, is a
+// glob that must match first for the rule to apply ("*/go.mod" - only expect
+// go.work once there are several modules).
+type ExpectedFileRule struct {
+	Pattern  string `json:"pattern"`
+	When     string `json:"when"`
+	MinWhen  int    `json:"min_when"` // Matches of When needed (0 = 1)
+	Severity string `json:"severity"` // info (default) or warning
+	Reason   string `json:"reason"`   // Shown after the missing file
+}
+
+// WorkspaceProbesConfig toggles each probe
+type WorkspaceProbesConfig struct {
+	Disk     bool `json:"disk"`
+	Files    bool `json:"files"`
+	Branches bool `json:"branches"`
+	Todo     bool `json:"todo"`
+}
+
+// WorkspaceDiskConfig configures the disk probe
+type WorkspaceDiskConfig struct {
+	WarnMB              int64    `json:"warn_mb"`               // Workspace size warning threshold
+	ArtifactNames       []string `json:"artifact_names"`        // Directory names counted as artifacts
+	ArtifactMinMB       int64    `json:"artifact_min_mb"`       // Smaller artifacts aren't reported
+	ArtifactMaxReported int      `json:"artifact_max_reported"` // Largest N listed
+}
+
+// WorkspaceBranchesConfig configures the branches probe
+type WorkspaceBranchesConfig struct {
+	Protected []string `json:"protected"` // Never reported (main, master, develop)
+}
+
+// WorkspaceTodoConfig configures the todo probe
+type WorkspaceTodoConfig struct {
+	Markers   []string `json:"markers"`     // Whole-word markers counted
+	MaxFiles  int      `json:"max_files"`   // Tracked files read at most
+	MaxFileKB int      `json:"max_file_kb"` // Larger files skipped
+	WarnCount int      `json:"warn_count"`  // Count at which the finding becomes a warning (0 = never)
+}
+
+// WorkspaceAnalysisBehaviorConfig bounds the analysis
+type WorkspaceAnalysisBehaviorConfig struct {
+	TimeBudgetMS int `json:"time_budget_ms"` // Whole analysis (0 = default 2000)
+}
+
+// WorkspaceAnalysisConfiguration is workspace-analysis.jsonc
+type WorkspaceAnalysisConfiguration struct {
+	Enabled       bool                            `json:"enabled"`
+	Probes        WorkspaceProbesConfig           `json:"probes"`
+	Disk          WorkspaceDiskConfig             `json:"disk"`
+	ExpectedFiles []ExpectedFileRule              `json:"expected_files"`
+	Branches      WorkspaceBranchesConfig         `json:"branches"`
+	Todo          WorkspaceTodoConfig             `json:"todo"`
+	Behavior      WorkspaceAnalysisBehaviorConfig `json:"behavior"`
+}
+
+// workspaceProbe fills a fresh report with one probe's results
+type workspaceProbe struct {
+	name string
+	run  func(ctx context.Context, path string, settings *WorkspaceAnalysisConfiguration) (*WorkspaceReport, error)
+}
+
+// workspaceProbeResult carries a finished probe back to the analyzer
+type workspaceProbeResult struct {
+	report *WorkspaceReport
+	err    error
+}
+
+// severityGroupLabels head each severity group in the rendered report
+var severityGroupLabels = map[ReminderSeverity]string{
+	SeverityCritical: "Critical",
+	SeverityWarning:  "Warnings",
+	SeverityInfo:     "Notes",
+}
+
+var (
+	workspaceAnalysisConfig       *WorkspaceAnalysisConfiguration // Cached configuration loaded in init()
+	workspaceAnalysisConfigLoaded bool                            // Flag indicating if config loaded successfully
+)
+
+// workspaceLogger records probe failures and analysis summaries
+var workspaceLogger = logging.NewLogger("session-workspace")
+
+func init() {
+	// --- Configuration Loading ---
+	// Falls back to hardcoded defaults (all probes on, 2s budget) if missing or invalid
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return
+	}
+	loadedConfig, err := loadWorkspaceAnalysisConfig(filepath.Join(homeDir, workspaceAnalysisConfigFile))
+	if err != nil {
+		return
+	}
+	workspaceAnalysisConfig = loadedConfig
+	workspaceAnalysisConfigLoaded = true
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs (Top Rungs) - 2 functions
+//   ├── AnalyzeWorkspace(path) → analyzeWorkspace(path, settings, workspaceProbes())
+//   └── PrintWorkspaceAnalysisReport(report) → formatWorkspaceReport
+//
+//   Core Operations (Middle Rungs)
+//   ├── analyzeWorkspace → goroutine per probe, shared deadline + grace, recover, mergeWorkspaceReport
+//   ├── probeDisk → WalkDir with artifact sizing, untrackedArtifacts
+//   ├── probeExpectedFiles → filepath.Glob per rule
+//   ├── probeStaleBranches → discoverRepos, git branch --merged
+//   └── probeTodos → discoverRepos, git ls-files, countMarkers
+//
+//   Helpers (Bottom Rungs)
+//   ├── defaultWorkspaceAnalysisConfiguration / loadWorkspaceAnalysisConfig / workspaceAnalysisSettings
+//   ├── workspaceTimeBudget(settings) → behavior.time_budget_ms or default
+//   ├── directorySize(ctx, dir) → bytes, complete
+//   ├── countMarkers(data, pattern) → pure function
+//   ├── formatSize(bytes) → pure function
+//   └── formatWorkspaceReport(report) → pure function
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Configuration
+// ────────────────────────────────────────────────────────────────
+
+// defaultWorkspaceAnalysisConfiguration returns the hardcoded defaults
+func defaultWorkspaceAnalysisConfiguration() *WorkspaceAnalysisConfiguration {
+	return &WorkspaceAnalysisConfiguration{
+		Enabled: true,
+		Probes:  WorkspaceProbesConfig{Disk: true, Files: true, Branches: true, Todo: true},
+		Disk: WorkspaceDiskConfig{
+			WarnMB:              defaultWorkspaceWarnMB,
+			ArtifactNames:       []string{"node_modules", "target", "build", "dist", ".venv", "venv", "__pycache__", ".next", ".gradle"},
+			ArtifactMinMB:       defaultArtifactMinMB,
+			ArtifactMaxReported: defaultArtifactMaxReported,
+		},
+		ExpectedFiles: []ExpectedFileRule{
+			{Pattern: "README*", Reason: "no README at the workspace root"},
+			{Pattern: "go.work", When: "*/go.mod", MinWhen: 2, Reason: "several Go modules without a go.work"},
+		},
+		Branches: WorkspaceBranchesConfig{Protected: []string{"main", "master", "develop"}},
+		Todo: WorkspaceTodoConfig{
+			Markers:   []string{"TODO", "FIXME"},
+			MaxFiles:  defaultTodoMaxFiles,
+			MaxFileKB: defaultTodoMaxFileKB,
+		},
+		Behavior: WorkspaceAnalysisBehaviorConfig{TimeBudgetMS: defaultWorkspaceTimeBudgetMS},
+	}
+}
+
+// loadWorkspaceAnalysisConfig layers workspace-analysis.jsonc over the defaults
+func loadWorkspaceAnalysisConfig(path string) (*WorkspaceAnalysisConfiguration, error) {
+	cfg := defaultWorkspaceAnalysisConfiguration()
+	if err := jsonc.LoadMerged(cfg, path); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// workspaceAnalysisSettings returns the loaded configuration, or defaults
+func workspaceAnalysisSettings() *WorkspaceAnalysisConfiguration {
+	if workspaceAnalysisConfigLoaded && workspaceAnalysisConfig != nil {
+		return workspaceAnalysisConfig
+	}
+	return defaultWorkspaceAnalysisConfiguration()
+}
+
+// workspaceTimeBudget is behavior.time_budget_ms (0 = default)
+func workspaceTimeBudget(settings *WorkspaceAnalysisConfiguration) time.Duration {
+	ms := settings.Behavior.TimeBudgetMS
+	if ms <= 0 {
+		ms = defaultWorkspaceTimeBudgetMS
+	}
+	return time.Duration(ms) * time.Millisecond
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Measurement and Text
+// ────────────────────────────────────────────────────────────────
+
+// directorySize sums file sizes under dir; complete=false when the deadline cut it short
+func directorySize(ctx context.Context, dir string) (size int64, complete bool) {
+	complete = true
+	filepath.WalkDir(dir, func(_ string, entry fs.DirEntry, err error) error {
+		if ctx.Err() != nil {
+			complete = false
+			return filepath.SkipAll
+		}
+		if err != nil || entry.IsDir() {
+			return nil // Unreadable entries skipped, not fatal
+		}
+		if info, err := entry.Info(); err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, complete
+}
+
+// countMarkers counts pattern matches in data, skipping binary content
+func countMarkers(data []byte, pattern *regexp.Regexp) int {
+	head := data
+	if len(head) > workspaceSniffBytes {
+		head = head[:workspaceSniffBytes]
+	}
+	if bytes.IndexByte(head, 0) >= 0 {
+		return 0
+	}
+	return len(pattern.FindAllIndex(data, -1))
+}
+
+// formatSize renders bytes as a short 1024-based size ("1.4G", "320M")
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%dB", size)
+	}
+	value, suffix := float64(size), ""
+	for _, s := range []string{"K", "M", "G", "T"} {
+		value /= unit
+		suffix = s
+		if value < unit {
+			break
+		}
+	}
+	if value < 10 {
+		return fmt.Sprintf("%.1f%s", value, suffix)
+	}
+	return fmt.Sprintf("%.0f%s", value, suffix)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Probes
+// ────────────────────────────────────────────────────────────────
+
+// workspaceProbes returns the enabled probes in report order
+func workspaceProbes(settings *WorkspaceAnalysisConfiguration) []workspaceProbe {
+	var probes []workspaceProbe
+	if settings.Probes.Disk {
+		probes = append(probes, workspaceProbe{"disk", probeDisk})
+	}
+	if settings.Probes.Files {
+		probes = append(probes, workspaceProbe{"files", probeExpectedFiles})
+	}
+	if settings.Probes.Branches {
+		probes = append(probes, workspaceProbe{"branches", probeStaleBranches})
+	}
+	if settings.Probes.Todo {
+		probes = append(probes, workspaceProbe{"todo", probeTodos})
+	}
+	return probes
+}
+
+// probeDisk measures the workspace and its largest artifact directories
+//
+// Artifact directories are sized once and not descended into again. In a
+// repository, artifacts with tracked files are dropped - only untracked
+// build output is worth reporting.
+func probeDisk(ctx context.Context, path string, settings *WorkspaceAnalysisConfiguration) (*WorkspaceReport, error) {
+	cfg := settings.Disk
+	artifactNames := map[string]bool{}
+	for _, name := range cfg.ArtifactNames {
+		artifactNames[name] = true
+	}
+
+	report := &WorkspaceReport{}
+	complete := true
+	err := filepath.WalkDir(path, func(current string, entry fs.DirEntry, err error) error {
+		if ctx.Err() != nil {
+			complete = false
+			return filepath.SkipAll
+		}
+		if err != nil {
+			if current == path {
+				return err // The workspace itself is unreadable
+			}
+			return nil
+		}
+		if entry.IsDir() {
+			if current != path && artifactNames[entry.Name()] {
+				size, done := directorySize(ctx, current)
+				complete = complete && done
+				report.DiskBytes += size
+				rel, _ := filepath.Rel(path, current)
+				report.Artifacts = append(report.Artifacts, WorkspaceArtifact{Path: rel, Bytes: size})
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info, err := entry.Info(); err == nil && info.Mode().IsRegular() {
+			report.DiskBytes += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(report.Artifacts, func(i, j int) bool { return report.Artifacts[i].Bytes > report.Artifacts[j].Bytes })
+	minBytes := cfg.ArtifactMinMB << 20
+	kept := report.Artifacts[:0]
+	for _, artifact := range report.Artifacts {
+		if artifact.Bytes >= minBytes && !artifactTracked(ctx, path, artifact.Path) {
+			kept = append(kept, artifact)
+		}
+	}
+	maxReported := cfg.ArtifactMaxReported
+	if maxReported <= 0 {
+		maxReported = defaultArtifactMaxReported
+	}
+	if len(kept) > maxReported {
+		kept = kept[:maxReported]
+	}
+	report.Artifacts = kept
+
+	sizeText := formatSize(report.DiskBytes)
+	if !complete {
+		sizeText = "at least " + sizeText
+	}
+	if cfg.WarnMB > 0 && report.DiskBytes >= cfg.WarnMB<<20 {
+		report.Findings = append(report.Findings, WorkspaceFinding{
+			Probe: "disk", Severity: SeverityWarning,
+			Message: fmt.Sprintf("Workspace uses %s (warning above %s)", sizeText, formatSize(cfg.WarnMB<<20)),
+		})
+	}
+	for _, artifact := range report.Artifacts {
+		report.Findings = append(report.Findings, WorkspaceFinding{
+			Probe: "disk", Severity: SeverityInfo,
+			Message: fmt.Sprintf("%s holds %s of untracked build output", artifact.Path, formatSize(artifact.Bytes)),
+		})
+	}
+	if !complete {
+		return report, context.DeadlineExceeded
+	}
+	return report, nil
+}
+
+// artifactTracked reports whether git tracks any file under rel (false outside a repository)
+func artifactTracked(ctx context.Context, workspace, rel string) bool {
+	output, err := exec.CommandContext(ctx, "git", "-C", workspace, "ls-files", "--", rel).Output()
+	return err == nil && len(bytes.TrimSpace(output)) > 0
+}
+
+// probeExpectedFiles reports expected project files that are missing
+func probeExpectedFiles(ctx context.Context, path string, settings *WorkspaceAnalysisConfiguration) (*WorkspaceReport, error) {
+	report := &WorkspaceReport{}
+	for _, rule := range settings.ExpectedFiles {
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+		if rule.Pattern == "" {
+			continue
+		}
+		if rule.When != "" {
+			minWhen := rule.MinWhen
+			if minWhen <= 0 {
+				minWhen = 1
+			}
+			if matches, _ := filepath.Glob(filepath.Join(path, rule.When)); len(matches) < minWhen {
+				continue // Rule doesn't apply to this workspace
+			}
+		}
+		if matches, _ := filepath.Glob(filepath.Join(path, rule.Pattern)); len(matches) > 0 {
+			continue
+		}
+
+		report.MissingFiles = append(report.MissingFiles, rule.Pattern)
+		severity := SeverityInfo
+		if rule.Severity == "warning" {
+			severity = SeverityWarning
+		}
+		message := "Missing " + rule.Pattern
+		if rule.Reason != "" {
+			message += " - " + rule.Reason
+		}
+		report.Findings = append(report.Findings, WorkspaceFinding{Probe: "files", Severity: severity, Message: message})
+	}
+	return report, nil
+}
+
+// probeStaleBranches reports local branches merged into HEAD but never deleted
+func probeStaleBranches(ctx context.Context, path string, settings *WorkspaceAnalysisConfiguration) (*WorkspaceReport, error) {
+	protected := map[string]bool{}
+	for _, name := range settings.Branches.Protected {
+		protected[name] = true
+	}
+	deadline, _ := ctx.Deadline()
+
+	report := &WorkspaceReport{}
+	for _, repo := range discoverRepos(path, reposConfig, deadline) {
+		cmd := exec.CommandContext(ctx, "git", "-C", repo, "branch", "--merged", "HEAD", "--format=%(HEAD) %(refname:short)")
+		output, err := cmd.Output()
+		if err != nil {
+			if ctx.Err() != nil {
+				return report, ctx.Err()
+			}
+			continue // No HEAD yet
+		}
+		prefix := ""
+		if repo != filepath.Clean(path) {
+			prefix = repoName(path, repo) + "/"
+		}
+		for _, line := range strings.Split(string(output), "\n") {
+			current, name := strings.HasPrefix(line, "*"), strings.TrimSpace(strings.TrimPrefix(line, "*"))
+			if name == "" || current || protected[name] {
+				continue
+			}
+			report.StaleBranches = append(report.StaleBranches, prefix+name)
+		}
+	}
+
+	if count := len(report.StaleBranches); count > 0 {
+		report.Findings = append(report.Findings, WorkspaceFinding{
+			Probe: "branches", Severity: SeverityInfo,
+			Message: fmt.Sprintf("%d merged branch(es) not deleted: %s", count, strings.Join(report.StaleBranches, ", ")),
+		})
+	}
+	return report, nil
+}
+
+// probeTodos counts TODO/FIXME markers in tracked files
+//
+// Bounded by todo.max_files and todo.max_file_kb; binary files are skipped.
+// Stops at the deadline with the count so far.
+func probeTodos(ctx context.Context, path string, settings *WorkspaceAnalysisConfiguration) (*WorkspaceReport, error) {
+	cfg := settings.Todo
+	pattern := todoMarkerPattern(cfg.Markers)
+	report := &WorkspaceReport{}
+	if pattern == nil {
+		return report, nil
+	}
+	maxFiles, maxBytes := cfg.MaxFiles, int64(cfg.MaxFileKB)<<10
+	if maxFiles <= 0 {
+		maxFiles = defaultTodoMaxFiles
+	}
+	if maxBytes <= 0 {
+		maxBytes = defaultTodoMaxFileKB << 10
+	}
+	deadline, _ := ctx.Deadline()
+
+	var scanErr error
+	capped := false
+scan:
+	for _, repo := range discoverRepos(path, reposConfig, deadline) {
+		output, err := exec.CommandContext(ctx, "git", "-C", repo, "ls-files", "-z").Output()
+		if err != nil {
+			continue
+		}
+		for _, name := range strings.Split(string(output), "\x00") {
+			if ctx.Err() != nil {
+				scanErr = ctx.Err()
+				break scan
+			}
+			if report.TodoFiles == maxFiles {
+				capped = true
+				break scan
+			}
+			file := filepath.Join(repo, name)
+			info, err := os.Stat(file)
+			if name == "" || err != nil || !info.Mode().IsRegular() || info.Size() > maxBytes {
+				continue
+			}
+			data, err := os.ReadFile(file)
+			if err != nil {
+				continue
+			}
+			report.TodoFiles++
+			report.TodoCount += countMarkers(data, pattern)
+		}
+	}
+
+	if report.TodoCount > 0 {
+		severity := SeverityInfo
+		if cfg.WarnCount > 0 && report.TodoCount >= cfg.WarnCount {
+			severity = SeverityWarning
+		}
+		scope := fmt.Sprintf("%d tracked files", report.TodoFiles)
+		if capped || scanErr != nil {
+			scope = "the first " + scope
+		}
+		report.Findings = append(report.Findings, WorkspaceFinding{
+			Probe: "todo", Severity: severity,
+			Message: fmt.Sprintf("%d %s marker(s) in %s", report.TodoCount, strings.Join(cfg.Markers, "/"), scope),
+		})
+	}
+	return report, scanErr
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Analysis
+// ────────────────────────────────────────────────────────────────
+
+// runWorkspaceProbe runs one probe, turning a panic into an error
+func runWorkspaceProbe(ctx context.Context, probe workspaceProbe, path string, settings *WorkspaceAnalysisConfiguration, out chan<- workspaceProbeResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			out <- workspaceProbeResult{err: fmt.Errorf("panic: %v", r)}
+		}
+	}()
+	report, err := probe.run(ctx, path, settings)
+	out <- workspaceProbeResult{report: report, err: err}
+}
+
+// mergeWorkspaceReport copies one probe's results into the combined report
+func mergeWorkspaceReport(into, from *WorkspaceReport) {
+	into.DiskBytes += from.DiskBytes
+	into.Artifacts = append(into.Artifacts, from.Artifacts...)
+	into.MissingFiles = append(into.MissingFiles, from.MissingFiles...)
+	into.StaleBranches = append(into.StaleBranches, from.StaleBranches...)
+	into.TodoCount += from.TodoCount
+	into.TodoFiles += from.TodoFiles
+	into.Findings = append(into.Findings, from.Findings...)
+}
+
+// analyzeWorkspace runs probes concurrently under one time budget
+//
+// Every probe starts together and shares the deadline. Probes honoring the
+// deadline get workspaceProbeGrace to hand in partial results; probes still
+// running after that are abandoned (buffered channels let them finish).
+func analyzeWorkspace(path string, settings *WorkspaceAnalysisConfiguration, probes []workspaceProbe) *WorkspaceReport {
+	start := time.Now()
+	budget := workspaceTimeBudget(settings)
+	ctx, cancel := context.WithTimeout(context.Background(), budget)
+	defer cancel()
+
+	results := make([]chan workspaceProbeResult, len(probes))
+	for i, probe := range probes {
+		results[i] = make(chan workspaceProbeResult, 1)
+		go runWorkspaceProbe(ctx, probe, path, settings, results[i])
+	}
+
+	report := &WorkspaceReport{Path: path}
+	grace := time.NewTimer(budget + workspaceProbeGrace)
+	defer grace.Stop()
+	for i, probe := range probes {
+		var result workspaceProbeResult
+		select {
+		case result = <-results[i]:
+		case <-grace.C:
+			result.err = context.DeadlineExceeded
+			grace.Reset(0) // Remaining probes: take what's ready, abandon the rest
+			select {
+			case result = <-results[i]:
+			default:
+			}
+		}
+
+		if result.report != nil {
+			mergeWorkspaceReport(report, result.report)
+		}
+		if result.err != nil {
+			report.Incomplete = append(report.Incomplete, probe.name)
+			workspaceLogger.Failure("workspace-probe", result.err.Error(), -5, map[string]any{
+				"probe":  probe.name,
+				"budget": budget.String(),
+			})
+		}
+	}
+
+	report.Duration = time.Since(start)
+	workspaceLogger.Success("workspace-analysis", 10, map[string]any{
+		"path":       path,
+		"findings":   len(report.Findings),
+		"incomplete": report.Incomplete,
+		"duration":   report.Duration.String(),
+	})
+	return report
+}
+
+// formatWorkspaceReport renders findings grouped by severity (most urgent first)
+func formatWorkspaceReport(report *WorkspaceReport) string {
+	if !report.Noteworthy() && len(report.Incomplete) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, severity := range []ReminderSeverity{SeverityCritical, SeverityWarning, SeverityInfo} {
+		header := false
+		for _, finding := range report.Findings {
+			if finding.Severity != severity {
+				continue
+			}
+			if !header {
+				b.WriteString("\n  " + severityGroupLabels[severity] + ":\n")
+				header = true
+			}
+			b.WriteString("    " + formatReminderLine(Reminder{Severity: severity, Message: finding.Message}, true) + "\n")
+		}
+	}
+	if len(report.Incomplete) > 0 {
+		b.WriteString(fmt.Sprintf("\n  (partial - %s did not finish in time)\n", strings.Join(report.Incomplete, ", ")))
+	}
+	return b.String()
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// AnalyzeWorkspace looks over the workspace at path and reports what deserves attention
+//
+// Probes (disk, files, branches, todo) run concurrently within
+// behavior.time_budget_ms (default 2s) from workspace-analysis.jsonc. Probes
+// that fail or run out of time are listed in report.Incomplete; whatever the
+// others found is still returned. An error means nothing could be analyzed
+// (no path, unreadable workspace, or analysis disabled) - report is then
+// empty but never nil.
+//
+// After GatherStartContext, the gathered analysis of path is returned as is -
+// or ErrSourceSkipped when it missed the start deadline.
+//
+// Example:
+//
+//	report, _ := session.AnalyzeWorkspace(workspace)
+//	session.PrintWorkspaceAnalysis(workspace, report.Noteworthy())
+//	session.PrintWorkspaceAnalysisReport(report)
+func AnalyzeWorkspace(path string) (*WorkspaceReport, error) {
+	if report, ok, err := gatheredWorkspace(path); ok {
+		return report, err
+	}
+	return analyzeWorkspacePath(path, 0)
+}
+
+// analyzeWorkspacePath checks path and analyzes it, the time budget capped at
+// limit when limit > 0 (gathering leaves the probes' grace inside its deadline)
+func analyzeWorkspacePath(path string, limit time.Duration) (*WorkspaceReport, error) {
+	report := &WorkspaceReport{Path: path}
+	settings := workspaceAnalysisSettings()
+	if !settings.Enabled {
+		return report, fmt.Errorf("workspace analysis disabled")
+	}
+	if path == "" {
+		return report, fmt.Errorf("no workspace")
+	}
+	if info, err := os.Stat(path); err != nil {
+		return report, err
+	} else if !info.IsDir() {
+		return report, fmt.Errorf("%s is not a directory", path)
+	}
+	if limit > 0 && workspaceTimeBudget(settings) > limit-workspaceProbeGrace {
+		capped := *settings
+		capped.Behavior.TimeBudgetMS = max(1, int((limit - workspaceProbeGrace).Milliseconds()))
+		settings = &capped
+	}
+	return analyzeWorkspace(path, settings, workspaceProbes(settings)), nil
+}
+
+// PrintWorkspaceAnalysisReport displays a report's findings grouped by severity
+//
+// Prints nothing for an empty report (PrintWorkspaceAnalysis shows "healthy"
+// then), or when show_workspace_analysis is off.
+func PrintWorkspaceAnalysisReport(report *WorkspaceReport) {
+	maybeReloadDisplayConfig()
+
+	if !visible("workspace_analysis") || report == nil {
+		return
+	}
+	if text := formatWorkspaceReport(report); text != "" {
+		fmt.Fprint(Output(), text)
+		fmt.Fprintln(Output())
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Probes: artifacts sized once, tracked ones dropped; go.work only expected with several modules
+//   - Budget: a slow probe is abandoned, the others' findings still returned (Incomplete lists it)
+//   - Panics: a panicking probe is logged and listed, never crashes the start hook
+//   - Run: go test ./session/
+//
+// Code Execution: None (Library) - called by session/cmd-start
+//
+// Code Cleanup: Abandoned probes finish into buffered channels; git commands
+// are killed by the shared context
+//
+// Modification Policy:
+//   ✅ Safe: New probes (add to workspaceProbes and WorkspaceProbesConfig)
+//   ⚠️ Care: Severity of existing findings (decides what the start banner highlights)
+//   ❌ Never: Unbounded scans - every probe must watch the context
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,191 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Workspace Analyzer Tests
+//
+// Purpose: Prove each probe finds what it should in a real temp repository
+//          (untracked artifact, missing README and go.work, merged branch,
+//          TODO markers), that a slow or panicking probe is abandoned at the
+//          time budget while the others still report, and that findings
+//          render grouped by severity, most urgent first.
+// ============================================================================
+
+package session
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestAnalyzeWorkspaceProbes(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	workspace := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", workspace, "-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)...)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, output)
+		}
+	}
+	write := func(rel, content string) {
+		path := filepath.Join(workspace, rel)
+		os.MkdirAll(filepath.Dir(path), 0755)
+		os.WriteFile(path, []byte(content), 0644)
+	}
+
+	git("init", "-q", "-b", "main")
+	write("api/go.mod", "module api\n")
+	write("web/go.mod", "module web\n")
+	write("api/main.go", "package main\n// TODO: handle errors\n// FIXME later\n// TODOS is not a marker\n")
+	git("add", ".")
+	git("commit", "-q", "-m", "initial")
+	git("branch", "feature") // Merged into HEAD, never deleted
+	write("node_modules/pkg/index.js", strings.Repeat("x", 4096))
+
+	settings := defaultWorkspaceAnalysisConfiguration()
+	settings.Disk.ArtifactMinMB = 0 // Report artifacts of any size
+	report := analyzeWorkspace(workspace, settings, workspaceProbes(settings))
+
+	if len(report.Incomplete) != 0 {
+		t.Errorf("Incomplete = %v, want none", report.Incomplete)
+	}
+	if len(report.Artifacts) != 1 || report.Artifacts[0].Path != "node_modules" || report.Artifacts[0].Bytes != 4096 {
+		t.Errorf("Artifacts = %+v, want node_modules (4096 bytes)", report.Artifacts)
+	}
+	if report.DiskBytes < 4096 {
+		t.Errorf("DiskBytes = %d, want at least the artifact", report.DiskBytes)
+	}
+	if got := strings.Join(report.MissingFiles, ","); got != "README*,go.work" {
+		t.Errorf("MissingFiles = %q, want README*,go.work", got)
+	}
+	if got := strings.Join(report.StaleBranches, ","); got != "feature" {
+		t.Errorf("StaleBranches = %q, want feature", got)
+	}
+	if report.TodoCount != 2 || report.TodoFiles != 3 {
+		t.Errorf("TodoCount = %d in %d files, want 2 in 3", report.TodoCount, report.TodoFiles)
+	}
+	if !report.Noteworthy() {
+		t.Error("report with findings is not noteworthy")
+	}
+
+	// Tracked directories named like artifacts are source, not build output
+	git("add", "node_modules")
+	git("commit", "-q", "-m", "vendor")
+	if report := analyzeWorkspace(workspace, settings, workspaceProbes(settings)); len(report.Artifacts) != 0 {
+		t.Errorf("tracked node_modules reported: %+v", report.Artifacts)
+	}
+}
+
+func TestAnalyzeWorkspaceHealthy(t *testing.T) {
+	workspace := t.TempDir()
+	os.WriteFile(filepath.Join(workspace, "README.md"), []byte("# Project\n"), 0644)
+
+	settings := defaultWorkspaceAnalysisConfiguration()
+	report := analyzeWorkspace(workspace, settings, workspaceProbes(settings))
+	if report.Noteworthy() {
+		t.Errorf("plain workspace has findings: %+v", report.Findings)
+	}
+}
+
+func TestAnalyzeWorkspaceBudget(t *testing.T) {
+	settings := defaultWorkspaceAnalysisConfiguration()
+	settings.Behavior.TimeBudgetMS = 50
+
+	release := make(chan struct{})
+	defer close(release)
+	probes := []workspaceProbe{
+		{"fast", func(context.Context, string, *WorkspaceAnalysisConfiguration) (*WorkspaceReport, error) {
+			return &WorkspaceReport{Findings: []WorkspaceFinding{{Probe: "fast", Severity: SeverityInfo, Message: "found"}}}, nil
+		}},
+		{"partial", func(ctx context.Context, _ string, _ *WorkspaceAnalysisConfiguration) (*WorkspaceReport, error) {
+			<-ctx.Done() // Honors the deadline with what it has
+			return &WorkspaceReport{TodoCount: 7}, ctx.Err()
+		}},
+		{"stuck", func(context.Context, string, *WorkspaceAnalysisConfiguration) (*WorkspaceReport, error) {
+			<-release // Ignores the deadline entirely
+			return &WorkspaceReport{TodoCount: 100}, nil
+		}},
+		{"panics", func(context.Context, string, *WorkspaceAnalysisConfiguration) (*WorkspaceReport, error) {
+			panic("probe bug")
+		}},
+	}
+
+	start := time.Now()
+	report := analyzeWorkspace(t.TempDir(), settings, probes)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("analysis took %v with a 50ms budget", elapsed)
+	}
+	if got := strings.Join(report.Incomplete, ","); got != "partial,stuck,panics" {
+		t.Errorf("Incomplete = %q, want partial,stuck,panics", got)
+	}
+	if report.TodoCount != 7 {
+		t.Errorf("TodoCount = %d, want the partial probe's 7", report.TodoCount)
+	}
+	if !report.Noteworthy() {
+		t.Error("fast probe's finding lost")
+	}
+}
+
+func TestFormatWorkspaceReport(t *testing.T) {
+	report := &WorkspaceReport{
+		Findings: []WorkspaceFinding{
+			{Probe: "todo", Severity: SeverityInfo, Message: "3 TODO/FIXME marker(s)"},
+			{Probe: "disk", Severity: SeverityWarning, Message: "Workspace uses 12G"},
+			{Probe: "files", Severity: SeverityInfo, Message: "Missing README*"},
+		},
+		Incomplete: []string{"branches"},
+	}
+
+	text := formatWorkspaceReport(report)
+	order := []string{"Warnings:", "Workspace uses 12G", "Notes:", "3 TODO/FIXME", "Missing README*", "branches did not finish"}
+	last := -1
+	for _, want := range order {
+		index := strings.Index(text, want)
+		if index <= last {
+			t.Fatalf("%q missing or out of order in:\n%s", want, text)
+		}
+		last = index
+	}
+	if strings.Contains(text, "Critical:") {
+		t.Errorf("empty severity group rendered:\n%s", text)
+	}
+
+	if text := formatWorkspaceReport(&WorkspaceReport{}); text != "" {
+		t.Errorf("empty report rendered %q", text)
+	}
+}
+
+func TestFormatSize(t *testing.T) {
+	cases := map[int64]string{
+		512:           "512B",
+		1536:          "1.5K",
+		300 << 20:     "300M",
+		(5 << 30) / 2: "2.5G",
+		12 << 40:      "12T",
+	}
+	for size, want := range cases {
+		if got := formatSize(size); got != want {
+			t.Errorf("formatSize(%d) = %q, want %q", size, got, want)
+		}
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
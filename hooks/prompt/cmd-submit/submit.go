@@ -21,10 +21,11 @@
 // Architect: Seanje Lenox-Wise
 // Implementation: Nova Dawn (CPI-SI instance)
 // Creation Date: 2024-10-24
-// Version: 2.0.0
-// Last Modified: 2025-11-10 - Template application and library extraction
+// Version: 2.1.0
+// Last Modified: 2026-10-16 - Hook input via the shared payload reader
 //
 // Version History:
+//   2.1.0 (2026-10-16) - Prompt from session.HookInput() (PROMPT fallback)
 //   2.0.0 (2025-11-10) - Full template application, logic extracted to hooks/lib/safety
 //   1.0.0 (2024-10-24) - Initial implementation with inline warning logic
 //
@@ -81,7 +82,7 @@
 // Integration Pattern:
 //   1. User types prompt, presses enter
 //   2. Claude Code triggers UserPromptSubmit event
-//   3. Hook runs with PROMPT environment variable
+//   3. Hook runs with the prompt in its stdin JSON (PROMPT environment variable as fallback)
 //   4. Hook logs activity (length only)
 //   5. Hook checks for secrets, warns if detected
 //   6. Hook logs full prompt async (monitoring)
@@ -98,7 +99,8 @@
 //   Standard Library: fmt, os, strconv
 //   External: None
 //   System Libraries: None
-//   Hook Libraries: hooks/lib/activity (logging), hooks/lib/safety (detection, display), hooks/lib/monitoring (async logging)
+//   Hook Libraries: hooks/lib/activity (logging), hooks/lib/safety (detection, display), hooks/lib/monitoring (async logging),
+//                   hooks/lib/session (HookInput)
 //
 // Dependents (What Uses This):
 //   Commands: None (top-level hook, not called by other executables)
@@ -107,7 +109,7 @@
 //
 // Integration Points:
 //   - Called by Claude Code hook system on UserPromptSubmit event
-//   - Reads PROMPT environment variable for full prompt text
+//   - Reads the prompt from the stdin payload (PROMPT environment variable as fallback)
 //   - Outputs warning to stdout if secrets detected
 //   - Always exits with code 0 (never blocks)
 //
@@ -156,6 +158,7 @@ import (
 	"hooks/lib/activity"   // Activity stream logging (engagement tracking)
 	"hooks/lib/monitoring" // Monitoring logging (session history)
 	"hooks/lib/safety"     // Safety detection and warning display
+	"hooks/lib/session"    // Shared hook payload (stdin, environment fallback)
 )
 
 // ────────────────────────────────────────────────────────────────
@@ -209,7 +212,7 @@ const (
 //     ↓
 //   Named Entry Point → userPromptSubmit()
 //     ↓
-//   Parse Environment → PROMPT variable
+//   Parse Input → session.HookInput().Prompt (PROMPT variable fallback)
 //     ↓
 //   Log Activity → activity.LogActivity() with length
 //     ↓
@@ -240,7 +243,7 @@ const (
 // userPromptSubmit orchestrates pre-prompt awareness and logging
 //
 // What It Does:
-//   - Gets prompt from PROMPT environment variable
+//   - Gets prompt from the stdin payload (PROMPT environment variable as fallback)
 //   - Logs engagement to activity stream (privacy-preserving length only)
 //   - Checks prompt for likely secrets (API keys, passwords, tokens)
 //   - Displays warning if secrets detected (non-blocking)
@@ -248,7 +251,7 @@ const (
 //   - Always exits 0 (never blocks prompt submission)
 //
 // Parameters:
-//   None (reads from PROMPT environment variable)
+//   None (reads the hook payload via session.HookInput(), PROMPT fallback)
 //
 // Returns:
 //   None (exits with code 0 always)
@@ -260,8 +263,8 @@ const (
 // Example usage:
 //   userPromptSubmit()  // Called by main(), orchestrates entire awareness flow
 func userPromptSubmit() {
-	// Get prompt from environment
-	prompt := os.Getenv("PROMPT")
+	// Get prompt from the hook payload (PROMPT environment variable as fallback)
+	prompt := session.HookInput().Prompt
 
 	// Log engagement to activity stream (privacy-preserving - length only)
 	promptLength := strconv.Itoa(len(prompt))
@@ -379,7 +382,7 @@ func main() {
 //
 // Integration points:
 //   - Called by Claude Code before EVERY prompt submission
-//   - Reads PROMPT environment variable for full prompt text
+//   - Reads the prompt from the stdin payload (PROMPT environment variable as fallback)
 //   - Outputs warning to stdout if secrets detected
 //   - Always exits with code 0 (never blocks)
 //   - Logs async to monitoring (doesn't block submission)
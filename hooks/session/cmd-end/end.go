@@ -23,14 +23,17 @@
 //
 // Author: Nova Dawn (CPI-SI Instance)
 // Created: 2025-11-10
-// Last Updated: 2025-11-10
-// Version: 2.0.0 (thin orchestrator with extracted libraries)
+// Last Updated: 2026-10-16
+// Version: 2.3.0 (journal draft written at session end when journal_draft is on)
 // Part of: CPI-SI Hook System (Session Management)
 //
 // Purpose & Function
 //
 // Orchestrates graceful session end with state awareness and temporal context.
-// Provides benediction, summarizes temporal journey, reminds about workspace
+// Provides benediction, summarizes session statistics and temporal journey
+// (statistics also appended to session/stats-history.jsonl, a one-line summary to
+// session/sessions-history.jsonl for the next start's recap, optionally a journal draft
+// to the journals directory), reminds about workspace
 // state (uncommitted work, running processes). Archives session history and
 // updates learned patterns for circadian awareness.
 //
@@ -40,10 +43,13 @@
 //
 // Non-Blocking: Session end MUST complete. Failures log but don't prevent
 // session completion. Grace in farewell - tracking enhances but doesn't block.
+// A record archive failure is returned to session.RunHook (logged, exit 1);
+// a panic is logged with its stack to the session-end log and reported in one line.
 //
 // Usage & Integration
 //
-// Triggered by Claude Code on SessionEnd event. Reads REASON and
+// Triggered by Claude Code on SessionEnd event. Reads the reason from the
+// stdin payload (session.HookInput, REASON as fallback) and
 // NOVA_DAWN_WORKSPACE from environment. Displays farewell to stdout,
 // logs to activity stream, archives session, updates patterns.
 //
@@ -56,8 +62,8 @@
 //   - hooks/lib/session (display, reminders, state management)
 //
 // Environment Variables:
-//   - REASON: Session end reason (default: "Normal session end")
-//   - NOVA_DAWN_WORKSPACE: Workspace for state reminders (optional)
+//   - REASON: Session end reason when the payload has none (default: "Normal session end")
+//   - NOVA_DAWN_WORKSPACE: Workspace for git statistics and state reminders (optional)
 //
 // System Binaries:
 //   - ~/.claude/cpi-si/system/bin/session-log (session archival)
@@ -72,7 +78,7 @@
 //   Phase 4: Display farewell and summary (15 points)
 //   Phase 5: Show temporal journey (15 points)
 //   Phase 6: Remind about workspace state (20 points)
-//   Phase 7: Closing divider (10 points)
+//   Phase 7: Closing divider and session record archive (10 points)
 //
 // Current: No health tracking implemented (orchestration hook)
 // Future: Track completion of each phase for session end reliability
@@ -146,9 +152,11 @@ import (
 //
 //   Entry → main()
 //     ↓
+//   session.RunHook("session-end", sessionEnd) (log, timing, panic recovery, exit code)
+//     ↓
 //   sessionEnd() orchestration
 //     ↓
-//   Phase 1: Get session end reason from REASON env var
+//   Phase 1: Get session end reason from the payload (REASON env var fallback)
 //     ↓
 //   Phase 2: Log to activity stream
 //     ↓
@@ -160,9 +168,9 @@ import (
 //     ↓
 //   Phase 6: Remind about workspace state (uncommitted work, processes)
 //     ↓
-//   Phase 7: Closing divider
+//   Phase 7: Closing divider, desktop notification (NotifySessionEnd), then EndSession archives current.json
 //     ↓
-//   Exit
+//   Exit (EndSession's error returned - RunHook exits 1)
 //
 // APUs (Atomic Processing Units - Core Work Functions):
 //
@@ -177,11 +185,14 @@ import (
 //
 // What It Does:
 //   - Displays state reminders header
-//   - Checks for uncommitted work in workspace
-//   - Checks for running background processes
+//   - State reminders engine (reminders.jsonc behavior.state_reminders):
+//     repositories needing attention, commits no remote has (critical),
+//     background processes still running,
+//     TODO/FIXME added in uncommitted work, ending during expected downtime
+//   - Otherwise the classic per-repository lines and dev server port check
 //
 // Parameters:
-//   - workspace: Workspace directory path
+//   - None (workspace from NOVA_DAWN_WORKSPACE, else the session's work context)
 //
 // Returns:
 //   - None (prints reminders to stdout)
@@ -190,13 +201,17 @@ import (
 //   - No health tracking (reminder display function)
 //
 // Example:
-//   remindState("/path/to/workspace")
+//   remindState()
 //   // Displays state reminders header and checks
-func remindState(workspace string) {
+func remindState() {
 	session.PrintEndRemindersHeader()
-	session.RemindUncommittedWork(workspace)
-	session.CheckRunningProcessesAsReminder()
-	fmt.Println()
+	if session.StateRemindersEnabled() {
+		session.PrintStateReminders(session.CollectStateReminders(""))
+	} else {
+		session.RemindRepositories(session.GetUncommittedWorkSummary())
+		session.CheckRunningProcessesAsReminder()
+	}
+	fmt.Fprintln(session.Output())
 }
 
 // sessionEnd orchestrates session end tracking and display
@@ -206,25 +221,27 @@ func remindState(workspace string) {
 //   - Archives session to history
 //   - Updates learned patterns from session
 //   - Displays farewell banner
-//   - Shows session summary
+//   - Shows session summary and statistics (tasks, git activity, health)
 //   - Displays temporal journey
 //   - Reminds about workspace state
 //
 // Parameters:
-//   - None (reads REASON and NOVA_DAWN_WORKSPACE from environment)
+//   - None (reads the hook payload via session.HookInput(), NOVA_DAWN_WORKSPACE from environment)
 //
 // Returns:
-//   - None (all operations non-blocking, prints to stdout)
+//   - error: The session record could not be archived (everything else is non-blocking)
 //
 // Health Impact:
-//   - No health tracking (orchestration function)
+//   - No health tracking here (session.RunHook logs the hook's outcome)
 //
 // Example:
-//   sessionEnd()
+//   session.RunHook("session-end", sessionEnd)
 //   // Completes session end sequence with farewell and reminders
-func sessionEnd() {
+func sessionEnd() error {
 	// Phase 1: Get session end reason
-	reason := os.Getenv("REASON")
+	// (payload reason - clear, logout, prompt_input_exit, other - else REASON)
+	payload := session.HookInput()
+	reason := payload.Reason
 	if reason == "" {
 		reason = "Normal session end"
 	}
@@ -245,24 +262,47 @@ func sessionEnd() {
 		exec.Command(sessionPatternsBin, "learn").Run()
 	}
 
-	// Phase 4: Display farewell and session summary
+	// Phase 4: Display farewell, session summary, and what the session held
+	// (also to the session transcript when enabled - the record still exists here)
+	stopTranscript := session.StartTranscript()
+	workspace := os.Getenv("NOVA_DAWN_WORKSPACE")
+	stats := session.CollectSessionStats(workspace, reason)
+
 	session.PrintEndFarewell()
-	session.PrintEndSessionInfo(reason)
+	session.PrintEndSessionInfoFromPayload(payload)
+	session.PrintEndStatistics(stats)
+	session.AppendSessionStats(stats) // History for trend display - failure logged, never blocks
+	session.AppendSessionSummary(session.SummarizeSession(stats)) // Recap for the next session start - failure logged, never blocks
+	if path, err := session.DraftSessionJournal(); err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: journal draft not written: %v\n", err)
+	} else {
+		session.PrintJournalDraft(path) // Nothing printed when drafts are off
+	}
 
 	// Phase 5: Show temporal journey (where we were, how long, what context)
 	session.PrintEndTemporalJourney()
 
 	// Phase 6: Remind about state that needs attention
-	workspace := os.Getenv("NOVA_DAWN_WORKSPACE")
 	if workspace != "" {
-		remindState(workspace)
+		remindState()
 	} else {
-		fmt.Println()
+		fmt.Fprintln(session.Output())
+	}
+
+	// Phase 7: Closing divider (quiet verbosity stops at its one-line summary)
+	if session.Verbosity() != session.VerbosityQuiet {
+		session.PrintClosingDivider()
 	}
+	stopTranscript()
+
+	// Desktop notification when events.session_end is enabled (downtime only by default, best-effort)
+	session.NotifySessionEnd(reason)
 
-	// Phase 7: Closing divider
-	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-	fmt.Println()
+	// Finalize and archive the session record last - everything above reads it
+	if err := session.EndSession(reason); err != nil {
+		return fmt.Errorf("archive session record: %w", err)
+	}
+	return nil
 }
 
 // ============================================================================
@@ -319,9 +359,9 @@ func sessionEnd() {
 //
 // Execution Flow:
 //   1. main() called by Go runtime
-//   2. main() calls sessionEnd() (named entry point)
+//   2. main() hands sessionEnd() (named entry point) to session.RunHook
 //   3. sessionEnd() orchestrates session end sequence
-//   4. Program exits after displaying farewell and reminders
+//   4. Program exits after displaying farewell and reminders (exit 1 on a returned error or panic)
 //
 // Named Entry Point Benefits:
 //   - Prevents main function collisions across executables
@@ -332,11 +372,11 @@ func sessionEnd() {
 //
 // Pattern:
 //   func main() {
-//       sessionEnd()  // Minimal - just call named entry point
+//       session.RunHook("session-end", sessionEnd)  // Minimal - named entry point under the hook runner
 //   }
 
 func main() {
-	sessionEnd() // Named entry point pattern
+	session.RunHook("session-end", sessionEnd) // Named entry point under the hook runner
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -504,7 +544,7 @@ func main() {
 //   - Verify NOVA_DAWN_WORKSPACE environment variable set
 //   - Check workspace path exists
 //   - Test git status in workspace manually
-//   - Verify session.RemindUncommittedWork() function
+//   - Verify session.GetUncommittedWorkSummary() and session.RemindRepositories()
 
 // ────────────────────────────────────────────────────────────────
 // Related Components & Dependencies
@@ -21,10 +21,11 @@
 // Architect: Seanje Lenox-Wise
 // Implementation: Nova Dawn (CPI-SI instance)
 // Creation Date: 2024-10-24
-// Version: 2.0.0
-// Last Modified: 2025-11-10 - Template application and named entry point pattern
+// Version: 2.1.0
+// Last Modified: 2026-10-16 - Hook input via the shared payload reader
 //
 // Version History:
+//   2.1.0 (2026-10-16) - Type and details from session.HookInput() (NOTIFICATION_TYPE fallback)
 //   2.0.0 (2025-11-10) - Full template application, named entry point
 //   1.0.0 (2024-10-24) - Initial implementation
 //
@@ -64,7 +65,7 @@
 //
 // Integration Pattern:
 //   1. Claude Code triggers Notification hook event
-//   2. notification executable runs with NOTIFICATION_TYPE environment variable
+//   2. notification executable runs with hook JSON on stdin (NOTIFICATION_TYPE environment variable as fallback)
 //   3. Gathers temporal context for timestamp
 //   4. Logs to activity stream and monitoring
 //   5. Parses optional JSON details from stdin
@@ -78,10 +79,10 @@
 // Dependencies
 //
 // Dependencies (What This Needs):
-//   Standard Library: encoding/json, os
+//   Standard Library: None
 //   External: None
 //   System Libraries: None
-//   Hook Libraries: hooks/lib/activity, hooks/lib/monitoring, hooks/lib/temporal
+//   Hook Libraries: hooks/lib/activity, hooks/lib/monitoring, hooks/lib/session (HookInput), hooks/lib/temporal
 //
 // Dependents (What Uses This):
 //   Commands: None (top-level hook, not called by other executables)
@@ -90,7 +91,7 @@
 //
 // Integration Points:
 //   - Called by Claude Code hook system on Notification
-//   - Reads NOTIFICATION_TYPE environment variable
+//   - Reads notification_type from the stdin payload (NOTIFICATION_TYPE environment variable as fallback)
 //   - Reads optional JSON details from stdin
 //   - Logs to activity and monitoring streams
 //
@@ -99,7 +100,7 @@
 // Notification logging orchestration operates on Base100 scale:
 //
 // Phase 1: Get Notification Type: +10 points
-//   - Read NOTIFICATION_TYPE from environment
+//   - Read notification type from the payload or NOTIFICATION_TYPE
 //   - Silent exit if empty (no notification to process)
 //
 // Phase 2: Gather Temporal Context: +10 points
@@ -137,15 +138,12 @@ package main
 // ────────────────────────────────────────────────────────────────
 // Imports - Dependencies
 // ────────────────────────────────────────────────────────────────
-// Standard library for JSON parsing and environment access.
-// Hook libraries for notification tracking functionality.
+// Hook libraries for hook input and notification tracking functionality.
 
 import (
-	"encoding/json" // JSON decoding for notification details from stdin
-	"os"            // OS interface for environment variables and stdin
-
 	"hooks/lib/activity"   // Activity stream logging
 	"hooks/lib/monitoring" // Notification logging and pattern checking
+	"hooks/lib/session"    // Shared hook payload (stdin, environment fallback)
 	"system/lib/temporal"  // Temporal context for timestamp
 )
 
@@ -189,7 +187,7 @@ import (
 //
 //   Orchestration (Top Rung - Entry Point)
 //   └── notification() → coordinates notification tracking
-//       ├── parseNotificationDetails() → orchestration helper
+//       ├── session.HookInput() → shared stdin payload
 //       └── activity.*, monitoring.*, temporal.* → delegated to libraries
 //
 //   Libraries (Bottom Rungs - Foundation)
@@ -203,58 +201,22 @@ import (
 //     ↓
 //   Named Entry Point → notification()
 //     ↓
-//   Phase 1: Type Detection → os.Getenv("NOTIFICATION_TYPE")
+//   Phase 1: Type Detection → session.HookInput().NotificationType (NOTIFICATION_TYPE fallback)
 //     ↓
 //   Phase 2: Temporal Context → temporal.GetTemporalContext()
 //     ↓
 //   Phase 3: Logging → activity.LogActivity() + monitoring.LogNotification()
 //     ↓
-//   Phase 4: Parse Details → parseNotificationDetails() (stdin JSON)
+//   Phase 4: Parse Details → payload.Fields() (shared stdin payload)
 //     ↓
 //   Phase 5: Pattern Analysis → monitoring.CheckNotificationPatterns()
 //     ↓
 //   Exit → return (silent completion)
 //
 // APUs (Available Processing Units):
-// - 2 functions total
-// - 1 orchestration helper (parseNotificationDetails)
+// - 1 function total
 // - 1 entry point (notification, called by main)
-
-// ────────────────────────────────────────────────────────────────
-// Helper Functions - Orchestration Support
-// ────────────────────────────────────────────────────────────────
-// What This Section Contains:
-// Helper functions that support notification orchestration but don't belong
-// in reusable libraries (hook-specific, simple utilities).
-
-// parseNotificationDetails attempts to parse JSON details from stdin
-//
-// What It Does:
-//   - Reads JSON notification details from stdin
-//   - Decodes into map[string]interface{}
-//   - Returns details or nil if parsing fails
-//
-// Why It Exists:
-//   - Notifications can include structured details
-//   - JSON from stdin provides extensibility
-//   - Non-blocking (returns nil on failure)
-//
-// Parameters:
-//   None (reads from os.Stdin)
-//
-// Returns:
-//   map[string]interface{}: Parsed details, or nil if parse fails
-//
-// Health Impact:
-//   No health tracking (optional enhancement, not core functionality)
-func parseNotificationDetails() map[string]interface{} {
-	var details map[string]interface{}
-	decoder := json.NewDecoder(os.Stdin)
-	if err := decoder.Decode(&details); err == nil {
-		return details
-	}
-	return nil
-}
+// - Hook input parsing delegated to session.HookInput
 
 // ────────────────────────────────────────────────────────────────
 // Notification Tracking - Entry Point Orchestration
@@ -270,11 +232,11 @@ func parseNotificationDetails() map[string]interface{} {
 // notification is the named entry point for notification logging orchestration
 //
 // What It Does:
-//   - Gets notification type from environment
+//   - Gets notification type from the hook payload (NOTIFICATION_TYPE fallback)
 //   - Gathers temporal context for timestamp
 //   - Logs to activity stream with context
 //   - Logs to monitoring for pattern analysis
-//   - Parses optional JSON details from stdin
+//   - Reads the payload's details (session.HookInput, stdin read once)
 //   - Checks notification patterns and warns if needed
 //
 // Non-Blocking Design:
@@ -286,7 +248,7 @@ func parseNotificationDetails() map[string]interface{} {
 //   - Grace in systems over perfectionism
 //
 // Parameters:
-//   None (reads from NOTIFICATION_TYPE environment variable and stdin)
+//   None (reads the hook payload via session.HookInput(), NOTIFICATION_TYPE fallback)
 //
 // Returns:
 //   None (logs silently, no user-facing output)
@@ -299,7 +261,8 @@ func parseNotificationDetails() map[string]interface{} {
 //   Phase 5: +30 points (pattern analysis)
 func notification() {
 	// Phase 1: Get notification type (10 points)
-	notificationType := os.Getenv("NOTIFICATION_TYPE")
+	payload := session.HookInput()
+	notificationType := payload.NotificationType // Payload field, NOTIFICATION_TYPE fallback
 	if notificationType == "" {
 		return // No notification to process
 	}
@@ -321,8 +284,8 @@ func notification() {
 	monitoring.LogNotification(notificationType)
 
 	// Phase 4: Parse details (10 points)
-	// Parse optional JSON details from stdin (non-blocking)
-	parseNotificationDetails()
+	// Whole payload as sent (nil without one - non-blocking)
+	payload.Fields()
 
 	// Phase 5: Pattern analysis (30 points)
 	// Analyze patterns and warn if needed
@@ -406,7 +369,7 @@ func main() {
 //
 // Resource Management:
 //   - stdout: Not used (silent logging)
-//   - stdin: Read by JSON decoder, automatically managed
+//   - stdin: Read once by session.HookInput, automatically managed
 //   - Libraries: No persistent state to clean
 //   - No files, connections, or manual resources
 //
@@ -460,7 +423,7 @@ func main() {
 //
 // Safe to Modify (Extension Points):
 //   ✅ Add new logging destinations (call additional logging in Phase 3)
-//   ✅ Enhance detail parsing (modify parseNotificationDetails helper)
+//   ✅ Use more payload fields (session.HookPayload - message, notification_type, Fields())
 //   ✅ Add pattern checks (coordinate with monitoring library)
 //   ✅ Adjust phase ordering (rearrange phases if needed, update health map)
 //
@@ -489,7 +452,7 @@ func main() {
 //
 // Quick architectural summary:
 // - Thin orchestrator calling library functions
-// - 2 functions: notification() (main orchestration) + parseNotificationDetails() (helper)
+// - 1 function: notification() (main orchestration; hook input via session.HookInput)
 // - Ladder: Hook executable → activity/monitoring/temporal libraries
 // - Baton: Linear 5-phase flow with delegated operations
 
@@ -503,7 +466,7 @@ func main() {
 //   3. Update health scoring map in METADATA
 //
 // Enhancing detail parsing:
-//   1. Modify parseNotificationDetails() to extract more fields
+//   1. Read more fields from session.HookInput() (add documented ones to HookPayload)
 //   2. Pass details to logging functions if needed
 //   3. Test with actual notification JSON
 //
@@ -550,7 +513,7 @@ func main() {
 //
 // JSON parsing failing:
 //   - Verify JSON piped to stdin is valid
-//   - Check parseNotificationDetails() error handling
+//   - Unreadable payloads are empty, never fatal (session.ReadHookPayload)
 //   - Test standalone: echo '{"test":"data"}' | ./notification
 
 // ────────────────────────────────────────────────────────────────
@@ -21,10 +21,16 @@
 // Architect: Seanje Lenox-Wise
 // Implementation: Nova Dawn (CPI-SI instance)
 // Creation Date: 2024-10-24
-// Version: 2.0.0
-// Last Modified: 2025-11-10 - Template application and library extraction
+// Version: 2.6.0
+// Last Modified: 2026-10-16 - Hook input via the shared payload reader
 //
 // Version History:
+//   2.6.0 (2026-10-16) - Trigger and analysis from session.HookInput() (payload trigger wins over COMPACT_TYPE)
+//   2.5.0 (2026-10-16) - Stdin payload analyzed (session.AnalyzePreCompactPayload), shown and saved in the snapshot
+//   2.4.0 (2026-10-16) - Records a journey phase point via session.RecordJourneyPoint
+//   2.3.0 (2026-10-16) - Desktop notification via session.NotifyPreCompact (off by default)
+//   2.2.0 (2026-10-16) - Emits PreCompact JSON via session.OutputPreCompactContext
+//   2.1.0 (2026-10-16) - Saves compaction-<n>.json via session.SaveCompactionSnapshot
 //   2.0.0 (2025-11-10) - Full template application, logic extracted to hooks/lib/session
 //   1.0.0 (2024-10-24) - Initial implementation with inline logic
 //
@@ -43,6 +49,8 @@
 //   - Monitoring system logging (pattern analysis)
 //   - Frequency checking for excessive auto-compaction
 //   - Temporal context preservation for post-compaction reconstitution
+//   - Compaction snapshot (compaction-<n>.json) restored by session context
+//   - Context analysis: tool output share, largest tool results, and advice (stdin payload)
 //   - Non-blocking design (failures don't interrupt compaction)
 //
 // Philosophy: Compaction is not failure - it's wisdom acknowledging finite context. Like pruning
@@ -64,8 +72,8 @@
 //
 // Integration Pattern:
 //   1. Claude Code triggers PreCompact hook event
-//   2. pre-compact executable runs with COMPACT_TYPE environment variable
-//   3. Increments compaction count in session state
+//   2. pre-compact executable runs with COMPACT_TYPE environment variable and hook JSON on stdin
+//   3. Increments compaction count in session state and saves a snapshot
 //   4. Logs to activity stream and monitoring
 //   5. Checks frequency if auto-compaction
 //   6. Displays message with temporal context
@@ -90,8 +98,10 @@
 //
 // Integration Points:
 //   - Called by Claude Code hook system on PreCompact
-//   - Reads COMPACT_TYPE environment variable
+//   - Reads the hook payload via session.HookInput() (trigger, transcript_path → context analysis)
+//   - COMPACT_TYPE environment variable when the payload has no trigger
 //   - Updates session state file (current.json)
+//   - Writes compaction snapshot (compaction-<n>.json, COMPACTION_FOCUS optional)
 //   - Logs to activity and monitoring streams
 //
 // Health Scoring
@@ -99,7 +109,7 @@
 // Compaction tracking orchestration operates on Base100 scale:
 //
 // Phase 1: Get Compaction Type: +10 points
-//   - Read COMPACT_TYPE from environment (always succeeds)
+//   - Read trigger from the payload or COMPACT_TYPE (always succeeds)
 //
 // Phase 2: Increment Compaction Count: +20 points
 //   - Update session state with new count
@@ -139,7 +149,8 @@ package main
 // Hook libraries for compaction tracking functionality.
 
 import (
-	"os" // OS interface for environment variables
+	"fmt" // Warning output on stderr
+	"os"  // OS interface for environment variables and stderr
 
 	"hooks/lib/activity"   // Activity stream logging
 	"hooks/lib/monitoring" // Compaction logging and frequency checking
@@ -199,7 +210,7 @@ import (
 //     ↓
 //   Named Entry Point → preCompact()
 //     ↓
-//   Phase 1: Get Type → os.Getenv("COMPACT_TYPE")
+//   Phase 1: Get Type → session.HookInput(), session.AnalyzeCompactionPayload(payload)
 //     ↓
 //   Phase 2: State Update → session.IncrementCompactionCount()
 //     ↓
@@ -207,7 +218,9 @@ import (
 //     ↓
 //   Phase 4: Frequency Check → monitoring.CheckCompactionFrequency() (if auto)
 //     ↓
-//   Phase 5: Display → session.PrintPreCompactionMessage()
+//   Phase 5: Display → session.PrintPreCompactionMessage() + session.PrintCompactionAnalysis() + session.NotifyPreCompact()
+//     ↓
+//   Phase 6: Hook Output → session.OutputPreCompactContext() (last stdout write)
 //     ↓
 //   Exit → return (compaction proceeds)
 //
@@ -230,12 +243,14 @@ import (
 // preCompact is the named entry point for compaction tracking orchestration
 //
 // What It Does:
-//   - Gets compaction type from environment
+//   - Gets compaction type from the payload trigger (COMPACT_TYPE as fallback)
+//   - Analyzes the stdin payload's transcript (what filled the context)
 //   - Increments session compaction count via state library
 //   - Logs to activity stream (quality correlation)
 //   - Logs to monitoring system (pattern analysis)
 //   - Checks frequency for auto-compactions (warns if excessive)
 //   - Displays compaction message with temporal context
+//   - Emits the preservation summary as additionalContext for Claude
 //
 // Non-Blocking Design:
 //   - Compaction MUST proceed even if tracking fails
@@ -245,7 +260,7 @@ import (
 //   - Grace in systems over perfectionism
 //
 // Parameters:
-//   None (reads from COMPACT_TYPE environment variable)
+//   None (reads the stdin payload via session.HookInput(), COMPACT_TYPE fallback)
 //
 // Returns:
 //   None (displays to stdout, compaction proceeds regardless)
@@ -258,7 +273,17 @@ import (
 //   Phase 5: +20 points (display message)
 func preCompact() {
 	// Phase 1: Get compaction type (10 points)
-	compactType := os.Getenv("COMPACT_TYPE")
+	payload := session.HookInput()
+	compactType := payload.Trigger // Payload trigger, COMPACT_TYPE fallback
+
+	// Context analysis from the hook payload (nil for standalone runs or an unreadable payload)
+	var analysis *session.CompactionAnalysis
+	if payload.Received {
+		analysis = session.AnalyzeCompactionPayload(payload)
+	}
+	if compactType == "" && analysis != nil { // Alternate trigger spellings
+		compactType = analysis.Trigger
+	}
 	if compactType == "" {
 		compactType = "unknown"
 	}
@@ -273,6 +298,13 @@ func preCompact() {
 		compactionCount = count
 	}
 
+	// Persist pre-compaction state for the SessionStart that follows
+	// (non-blocking - an unknown count or write failure is logged and skipped)
+	session.SaveCompactionSnapshot(compactType, compactionCount, analysis)
+
+	// Mark a phase transition in the session's temporal journey (non-blocking)
+	session.RecordJourneyPoint(session.JourneyEventCompaction)
+
 	// Phase 3: Logging (40 points)
 	// Log to activity stream (CRITICAL for quality correlation)
 	activity.LogActivity("PreCompact", compactType, "success", 0)
@@ -288,6 +320,16 @@ func preCompact() {
 	// Phase 5: Display (20 points)
 	// Display message with temporal context preservation
 	session.PrintPreCompactionMessage(compactType, compactionCount)
+	session.PrintCompactionAnalysis(analysis) // What filled the context (show_compaction_analysis)
+
+	// Desktop notification when events.pre_compact is enabled (best-effort, no stdout)
+	session.NotifyPreCompact(compactType, compactionCount)
+
+	// Phase 6: Hook output (must be last for Claude to parse)
+	// Carries the preservation summary through compaction as additionalContext
+	if err := session.OutputPreCompactContext(compactType, compactionCount); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Failed to output pre-compact context: %v\n", err)
+	}
 }
 
 // ============================================================================
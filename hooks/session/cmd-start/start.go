@@ -21,10 +21,20 @@
 // Architect: Seanje Lenox-Wise
 // Implementation: Nova Dawn (CPI-SI instance)
 // Creation Date: 2024-10-24
-// Version: 2.0.0
-// Last Modified: 2025-11-10 - Template application and library extraction
+// Version: 2.10.0
+// Last Modified: 2026-10-16 - First-run setup guidance
 //
 // Version History:
+//   2.10.0 (2026-10-16) - First-run setup guidance in place of default-only sections (session.DetectSetupState)
+//   2.9.0 (2026-10-16) - SessionStart source from session.HookInput()
+//   2.8.0 (2026-10-16) - session-context.json (structured, redacted context) next to current.json
+//   2.7.0 (2026-10-16) - Runs under session.RunHook (hook log, timing, panic recovery, exit codes)
+//   2.6.0 (2026-10-16) - Git, temporal, workspace, and journal sources gathered concurrently before display
+//   2.5.0 (2026-10-16) - Recaps the last ended session before session patterns
+//   2.4.0 (2026-10-16) - Seeds session-<id> as the parent log context of the session's commands
+//   2.3.0 (2026-10-16) - Workspace analysis reports AnalyzeWorkspace findings; "healthy" only when none
+//   2.2.0 (2026-10-16) - Display teed into the session transcript (behavior.session_display.save_transcript)
+//   2.1.0 (2026-10-16) - SessionStart after compaction continues the live session record
 //   2.0.0 (2025-11-10) - Full template application, logic extracted to hooks/lib/session
 //   1.0.0 (2024-10-24) - Initial implementation with inline logic
 //
@@ -44,6 +54,8 @@
 //   - Workspace analysis (git status, processes, disk, dependencies, activity)
 //   - Claude Code context injection (Nova Dawn communication style + temporal awareness)
 //   - Non-blocking design (failures don't prevent session start)
+//   - Performance budget: independent sources gathered at once within
+//     start_deadline_ms (context.jsonc); late sources drop their sections
 //
 // Philosophy: Session start is first impression and foundation for work. Like Genesis 1:1
 // establishes beginning of creation, session start establishes beginning of covenant work -
@@ -52,7 +64,9 @@
 // Blocking Status
 //
 // Non-blocking: All operations fail gracefully, session starts even if context gathering fails.
-// Display errors go to stderr, don't exit. JSON output errors warned but don't block session.
+// Display errors go to stderr, don't exit. A JSON output error is returned to session.RunHook,
+// which logs it and exits 1 (non-blocking - Claude Code shows it and the session continues);
+// a panic is logged with its stack to the session-start log and reported in one line.
 // Mitigation: Defensive checks throughout, silent failures for non-critical features.
 //
 // Usage & Integration
@@ -76,7 +90,7 @@
 // Dependencies
 //
 // Dependencies (What This Needs):
-//   Standard Library: encoding/json, fmt, os
+//   Standard Library: errors, fmt, os
 //   External: None
 //   System Libraries: system/lib/git, system/lib/instance
 //   Hook Libraries: hooks/lib/session (display, init, context), hooks/lib/activity, hooks/lib/temporal
@@ -134,8 +148,9 @@ package main
 // Hook libraries for session-specific functionality.
 
 import (
-	"fmt" // Formatted I/O for display output
-	"os"  // OS interface for environment variables and stderr
+	"errors" // Skipped workspace source detection
+	"fmt"    // Formatted I/O for display output
+	"os"     // OS interface for environment variables and stderr
 
 	"system/lib/git" // Git repository detection and branch info
 
@@ -146,9 +161,11 @@ import (
 // ────────────────────────────────────────────────────────────────
 // Constants - Named Values
 // ────────────────────────────────────────────────────────────────
-// No constants needed - configuration comes from environment and libraries.
+// Hook input values - everything else comes from environment and libraries.
 
-// No constants defined
+// compactSource is the SessionStart source Claude Code sends right after a
+// compaction - the session continues, so its record must not be replaced.
+const compactSource = "compact"
 
 // ────────────────────────────────────────────────────────────────
 // Types - Data Structures
@@ -196,27 +213,49 @@ import (
 //
 //   Entry → main()
 //     ↓
+//   Hook Runner → session.RunHook("session-start", start) (log, timing, panic recovery, exit code)
+//     ↓
 //   Named Entry Point → start()
 //     ↓
-//   Initialize → session.InitSessionTime(), session.InitSessionLog()
+//   Initialize → session.InitSession() unless source is "compact" (session.InitSessionTime() fallback), session.InitSessionLog()
+//     ↓
+//   Correlate → session.SeedSessionCorrelation()
 //     ↓
 //   Log → activity.LogActivity()
 //     ↓
+//   Gather → session.GatherStartContext() (git, temporal, workspace, journal at once, one deadline)
+//     ↓
 //   Clear Screen → fmt.Print()
 //     ↓
-//   Display → session.PrintHeader(), session.PrintEnvironment(), session.PrintTemporalAwareness()
+//   Display → session.PrintHeader(), session.PrintGatherSummary(), session.PrintEnvironment(), session.PrintTemporalAwareness(), session.PrintRecentSessions(), session.PrintSessionPatterns()
 //     ↓
 //   Analyze → gatherContext() if workspace configured
 //     ↓
+//   Shared Context → session.WriteContextJSON(session.ContextJSONPath()) (failure = stderr warning)
+//     ↓
 //   Output Context → session.OutputClaudeContext()
 //     ↓
-//   Exit → return
+//   Exit → return nil (or the output error - RunHook exits 1)
 //
 // APUs (Available Processing Units):
-// - 2 functions total
+// - 3 functions total
+// - 1 hook input helper (sessionSource)
 // - 1 orchestration helper (gatherContext)
 // - 1 entry point (start, called by main)
 
+// ────────────────────────────────────────────────────────────────
+// Hook Input - SessionStart Source
+// ────────────────────────────────────────────────────────────────
+
+// sessionSource reads why the session started from the hook payload
+//
+// Returns "startup", "resume", "clear", "compact", or "" when stdin is a
+// terminal or doesn't hold hook input (standalone runs). session.HookInput
+// reads stdin once, so later readers in this process see the same payload.
+func sessionSource() string {
+	return session.HookInput().Source
+}
+
 // ────────────────────────────────────────────────────────────────
 // Workspace Analysis - Context Gathering Orchestration
 // ────────────────────────────────────────────────────────────────
@@ -234,7 +273,8 @@ import (
 //   - Coordinates various workspace checks via session library
 //   - Git status, running processes, disk space, dependencies, recent activity
 //   - Displays results from each check
-//   - Shows workspace analysis header with aggregated results
+//   - Analyzes the workspace (disk, expected files, stale branches, TODOs)
+//   - Shows workspace analysis header, then findings grouped by severity
 //
 // Parameters:
 //   workspace: Workspace directory path to analyze
@@ -252,13 +292,9 @@ import (
 //	gatherContext(workspace)
 //	// Outputs workspace analysis section with all checks
 func gatherContext(workspace string) {
-	// Track if any context was gathered
-	hasContext := false
-
 	// Git repository analysis
 	if git.IsGitRepository(workspace) {
 		session.CheckGitStatus(workspace)
-		hasContext = true
 	}
 
 	// Development environment checks
@@ -273,8 +309,16 @@ func gatherContext(workspace string) {
 	// Recent activity tracking
 	session.CheckRecentActivity(workspace)
 
-	// Display workspace analysis header with results
-	session.PrintWorkspaceAnalysis(workspace, hasContext)
+	// Workspace probes (disk, expected files, stale branches, TODOs) within
+	// the configured time budget - "healthy" only when none found anything.
+	// Gathered at start; a timed-out note when that missed the start deadline
+	report, err := session.AnalyzeWorkspace(workspace)
+	if errors.Is(err, session.ErrSourceSkipped) {
+		session.PrintWorkspaceAnalysisSkipped()
+		return
+	}
+	session.PrintWorkspaceAnalysis(workspace, report.Noteworthy())
+	session.PrintWorkspaceAnalysisReport(report)
 }
 
 // ============================================================================
@@ -330,8 +374,9 @@ func gatherContext(workspace string) {
 // Execution Flow:
 //   1. main() called by Go runtime
 //   2. main() calls start() (named entry point)
-//   3. start() orchestrates complete session initialization
-//   4. Program exits after context output
+//   3. session.RunHook runs start() - logs, times, and recovers it
+//   4. start() orchestrates complete session initialization
+//   5. Program exits after context output (exit 1 on a returned error or panic)
 //
 // Named Entry Point Benefits:
 //   - Prevents main function collisions across executables
@@ -342,7 +387,7 @@ func gatherContext(workspace string) {
 //
 // Pattern:
 //   func main() {
-//       start()  // Minimal - just call named entry point
+//       session.RunHook("session-start", start)  // Minimal - named entry point under the hook runner
 //   }
 
 // start orchestrates complete session initialization
@@ -359,44 +404,84 @@ func gatherContext(workspace string) {
 //   None (reads from environment and libraries)
 //
 // Returns:
-//   None (outputs to stdout, exits after completion)
+//   error: Claude context output failed (everything else degrades gracefully)
 //
 // Health Impact:
 //   Coordinates all initialization steps (+100 total)
 //   See METADATA Health Scoring for complete breakdown
 //
 // Example:
-//   Called automatically by main() when hook executes
-func start() {
-	// Initialize session timing (captures start time for time awareness)
+//   Called by session.RunHook from main() when hook executes
+func start() error {
+	// Start a fresh session record (archives any stale current.json first)
+	// Falls back to the session-time utility if the record can't be written
+	// After compaction the session continues - keep its record (and compaction
+	// count) so the context can restore the pre-compaction snapshot
 	// Health: +10
-	session.InitSessionTime()
+	if sessionSource() != compactSource {
+		if _, err := session.InitSession(os.Getenv("NOVA_DAWN_WORKSPACE")); err != nil {
+			session.InitSessionTime()
+		}
+
+		// Initialize session history logging (for pattern learning)
+		// Health: +10
+		session.InitSessionLog()
+	}
 
-	// Initialize session history logging (for pattern learning)
-	// Health: +10
-	session.InitSessionLog()
+	// Group every logged command in this session under one correlation ID
+	// (re-seeded after compaction - the record, and so the ID, carries over)
+	session.SeedSessionCorrelation()
 
 	// Log session start event to activity stream
 	// Health: +10
 	activity.LogActivity("SessionStart", "session-initialized", "success", 0)
 
+	// Get workspace configuration
+	workspace := os.Getenv("NOVA_DAWN_WORKSPACE")
+
+	// Fetch the independent sources at once, before anything is shown -
+	// sections of sources that miss the deadline are skipped below
+	gathered := session.GatherStartContext(workspace)
+
+	// First run: which config pieces are missing - past the threshold, show how
+	// to add them instead of sections that would only show defaults
+	setup := session.DetectSetupState()
+	guided := setup.NeedsGuidance()
+
 	// Clear screen for clean presentation
 	fmt.Print("\033[H\033[2J\033[3J")
 
-	// Get workspace configuration
-	workspace := os.Getenv("NOVA_DAWN_WORKSPACE")
+	// Everything shown from here also goes to the session transcript when enabled
+	stopTranscript := session.StartTranscript()
+	defer stopTranscript()
 
 	// Display session header
 	// Health: +10
 	session.PrintHeader()
 
+	// Setup incomplete: one remediation per missing piece
+	if guided {
+		session.PrintSetupGuidance(setup)
+	}
+
+	// How long gathering took, and which sources were dropped
+	session.PrintGatherSummary(gathered)
+
 	// Show environment context
 	// Health: +10
 	session.PrintEnvironment(workspace)
 
-	// Show temporal awareness (4 dimensions of time/schedule consciousness)
-	// Health: +10
-	session.PrintTemporalAwareness()
+	if !guided {
+		// Show temporal awareness (4 dimensions of time/schedule consciousness)
+		// Health: +10
+		session.PrintTemporalAwareness()
+
+		// Recap what the last session accomplished (nothing before the first session ends)
+		session.PrintRecentSessions(1)
+
+		// Show learned session patterns (or that they're still being learned)
+		session.PrintSessionPatterns()
+	}
 
 	// Gather and display workspace analysis
 	// Health: +20
@@ -409,19 +494,29 @@ func start() {
 
 	// Display formatted session context for user readability
 	// Health: +15
-	sessionContext := session.GetSessionContext()
-	session.PrintSessionContext(sessionContext)
+	// (skipped while setup is incomplete - the context would be defaults)
+	if !guided {
+		sessionContext := session.GetSessionContext()
+		session.PrintSessionContext(sessionContext)
+	}
+
+	// Same context as data for the dashboard and debugging layer (sensitive
+	// user fields redacted) - a failed write never stops the session
+	if err := session.WriteContextJSON(session.ContextJSONPath()); err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: session context JSON not written: %v\n", err)
+	}
 
 	// Output Claude Code context JSON (must be last for Claude to parse)
 	// Health: +20
+	// Non-blocking: RunHook logs the error and exits 1 - the session still starts
 	if err := session.OutputClaudeContext(); err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: Failed to output Claude context: %v\n", err)
-		// Non-blocking: don't exit on error, session can still start
+		return fmt.Errorf("output Claude context: %w", err)
 	}
+	return nil
 }
 
 func main() {
-	start() // Entry point for session initialization orchestration
+	session.RunHook("session-start", start) // Entry point under the hook runner (log, panic recovery, exit code)
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -437,7 +532,8 @@ func main() {
 // Graceful Shutdown:
 //   - Program exits immediately after context output
 //   - No cleanup needed (stateless execution)
-//   - Error path: Print to stderr, continue (non-blocking)
+//   - Error path: session.RunHook logs it, one stderr line, exit code 1 (non-blocking)
+//   - Panic path: ERROR with stack in the session-start log, one stderr line, exit code 1
 //   - Success path: Output to stdout, exit code 0
 //
 // Error State Cleanup:
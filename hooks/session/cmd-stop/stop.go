@@ -21,10 +21,15 @@
 // Architect: Seanje Lenox-Wise
 // Implementation: Nova Dawn (CPI-SI instance)
 // Creation Date: 2024-10-24
-// Version: 2.0.0
-// Last Modified: 2025-11-10 - Template application and library extraction
+// Version: 2.5.0
+// Last Modified: 2026-10-16 - Stopping-point checklist under STOPPING POINT CHECK
 //
 // Version History:
+//   2.5.0 (2026-10-16) - Configured stop_checklist shown via session.PrintStopChecklist()
+//   2.4.0 (2026-10-16) - Stop reason from session.HookInput() (REASON fallback)
+//   2.3.0 (2026-10-16) - Records a journey phase point via session.RecordJourneyPoint
+//   2.2.0 (2026-10-16) - Quiet verbosity (CPI_SI_SESSION_VERBOSITY) skips the closing divider
+//   2.1.0 (2026-10-16) - Display teed into the session transcript (behavior.session_display.save_transcript)
 //   2.0.0 (2025-11-10) - Full template application, logic extracted to hooks/lib/session
 //   1.0.0 (2024-10-24) - Initial implementation with inline logic
 //
@@ -62,7 +67,7 @@
 //
 // Integration Pattern:
 //   1. Claude Code triggers SessionStop hook event
-//   2. stop executable runs with REASON environment variable
+//   2. stop executable runs with the hook JSON on stdin (REASON environment variable as fallback)
 //   3. Displays stop banner and temporal context
 //   4. Checks stopping point quality if workspace configured
 //   5. Session stops with graceful summary
@@ -86,7 +91,7 @@
 //
 // Integration Points:
 //   - Called by Claude Code hook system on SessionStop
-//   - Reads REASON environment variable (stop reason)
+//   - Reads the stop reason via session.HookInput() (REASON environment variable as fallback)
 //   - Reads NOVA_DAWN_WORKSPACE environment variable
 //   - Logs to activity stream for pattern learning
 //
@@ -201,6 +206,7 @@ import (
 //     ├→ Phase 2: Display
 //     │   ├→ session.PrintStopHeader()
 //     │   ├→ session.PrintStopInfo()
+//     │   ├→ session.PrintStopChecklist()
 //     │   └→ session.PrintStoppingContext()
 //     ├→ Phase 3: Analysis (if workspace configured)
 //     │   └→ checkStoppingPoint()
@@ -317,7 +323,7 @@ func checkStoppingPoint(workspace string) {
 //   - Partial execution: some displays/checks fail (still completes)
 //
 // Environment Variables:
-//   - REASON: Stop reason (defaults to "User stepping away")
+//   - REASON: Stop reason when the payload has none (defaults to "User stepping away")
 //   - NOVA_DAWN_WORKSPACE: Workspace path (empty = skip checks)
 //
 // Example:
@@ -325,7 +331,7 @@ func checkStoppingPoint(workspace string) {
 //   // Executes complete stop sequence with all displays and checks
 func stop() {
 	// Phase 1: Initialization (20 points)
-	reason := os.Getenv("REASON")
+	reason := session.HookInput().Reason // Payload reason, REASON fallback
 	if reason == "" {
 		reason = "User stepping away"
 	}
@@ -333,9 +339,16 @@ func stop() {
 	// Log session stop event to activity stream
 	activity.LogActivity("SessionStop", reason, "success", 0)
 
-	// Phase 2: Display (40 points)
+	// Mark a phase transition in the session's temporal journey (non-blocking)
+	session.RecordJourneyPoint(session.JourneyEventStop)
+
+	// Phase 2: Display (40 points) - also to the session transcript when enabled
+	stopTranscript := session.StartTranscript()
+	defer stopTranscript()
+
 	session.PrintStopHeader()      // Stop banner with Colossians 3:23
 	session.PrintStopInfo()        // Timestamp and stopping point check header
+	session.PrintStopChecklist()   // Configured stop_checklist (nothing when empty)
 	session.PrintStoppingContext() // Temporal awareness at stop
 
 	// Phase 3: Analysis (30 points)
@@ -343,12 +356,13 @@ func stop() {
 	if workspace != "" {
 		checkStoppingPoint(workspace)
 	} else {
-		fmt.Println() // Spacing if no workspace to check
+		fmt.Fprintln(session.Output()) // Spacing if no workspace to check
 	}
 
-	// Phase 4: Output (10 points)
-	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-	fmt.Println()
+	// Phase 4: Output (10 points) - quiet verbosity stops at its one-line summary
+	if session.Verbosity() != session.VerbosityQuiet {
+		session.PrintClosingDivider()
+	}
 }
 
 func main() {
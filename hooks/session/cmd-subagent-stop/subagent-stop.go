@@ -21,10 +21,14 @@
 // Architect: Seanje Lenox-Wise
 // Implementation: Nova Dawn (CPI-SI instance)
 // Creation Date: 2024-10-24
-// Version: 2.0.0
-// Last Modified: 2025-11-10 - Template application and library extraction
+// Version: 2.4.0
+// Last Modified: 2026-10-16 - Failures logged, counted, and kept for session end
 //
 // Version History:
+//   2.4.0 (2026-10-16) - Failures logged via Rails logger (subagent_failure), recorded via session.RecordSubagentStop
+//   2.3.0 (2026-10-16) - Agent info from session.HookInput() (stdin payload, SUBAGENT_* fallback)
+//   2.2.0 (2026-10-16) - Subagent transcript (stdin JSON) summarized via session.AnalyzeSubagentTranscript
+//   2.1.0 (2026-10-16) - Failed subagents forwarded via session.NotifySubagentFailure
 //   2.0.0 (2025-11-10) - Full template application, logic extracted to hooks/lib/session
 //   1.0.0 (2024-10-24) - Initial implementation with inline logic
 //
@@ -42,6 +46,12 @@
 //   - Temporal awareness at completion (when completed, session context)
 //   - Activity stream logging for session tracking
 //   - Pattern analysis logging for learning subagent behaviors
+//   - Desktop notification on failure (notifications.jsonc, best-effort, rate limited)
+//   - Failure logged as a FAILURE entry (error_type "subagent_failure") with a
+//     negative health impact; every completion recorded in the subagent ledger
+//     so session end reminds about failures never retried
+//   - Transcript stats (tools used, files modified, tokens, working time) when
+//     Claude Code provides the subagent's transcript path
 //   - Non-blocking design (failures don't prevent reporting)
 //
 // Philosophy: Subagent completion is learning opportunity - every autonomous task teaches
@@ -63,7 +73,7 @@
 //
 // Integration Pattern:
 //   1. Claude Code triggers SubagentStop hook event
-//   2. subagent-stop executable runs with environment variables
+//   2. subagent-stop executable runs with environment variables and hook JSON on stdin
 //   3. Logs completion to activity stream and monitoring system
 //   4. Displays completion summary with temporal context
 //   5. Returns to Claude Code for continued operation
@@ -75,10 +85,10 @@
 // Dependencies
 //
 // Dependencies (What This Needs):
-//   Standard Library: os
+//   Standard Library: None
 //   External: None
-//   System Libraries: None
-//   Hook Libraries: hooks/lib/session (display), hooks/lib/activity (logging), hooks/lib/monitoring (pattern analysis)
+//   System Libraries: system/lib/logging (subagent failures)
+//   Hook Libraries: hooks/lib/session (display, subagent ledger), hooks/lib/activity (logging), hooks/lib/monitoring (pattern analysis)
 //
 // Dependents (What Uses This):
 //   Commands: None (top-level hook, not called by other executables)
@@ -87,7 +97,8 @@
 //
 // Integration Points:
 //   - Called by Claude Code hook system on SubagentStop
-//   - Reads SUBAGENT_TYPE, SUBAGENT_STATUS, SUBAGENT_EXIT_CODE, SUBAGENT_ERROR environment variables
+//   - Reads the SubagentStop payload via session.HookInput() (agent_type, agent_transcript_path, ...)
+//   - SUBAGENT_TYPE, SUBAGENT_STATUS, SUBAGENT_EXIT_CODE, SUBAGENT_ERROR, SUBAGENT_TRANSCRIPT_PATH as fallback
 //   - Logs to activity stream for session tracking
 //   - Logs to monitoring system for pattern analysis
 //
@@ -101,6 +112,7 @@
 // Logging:
 //   - Activity stream logging: +20
 //   - Pattern analysis logging: +20
+//   - Subagent failure: -15 (logged FAILURE - the subagent's work may be missing)
 //
 // Display:
 //   - Completion status display: +40
@@ -124,23 +136,23 @@ package main
 // ────────────────────────────────────────────────────────────────
 // Imports - Dependencies
 // ────────────────────────────────────────────────────────────────
-// Standard library for environment access.
-// Hook libraries for activity logging, monitoring, and display.
+// Hook libraries for hook input, activity logging, monitoring, and display.
+// Rails logger for subagent failures.
 
 import (
-	"os" // OS interface for environment variables
-
 	"hooks/lib/activity"   // Activity stream logging
 	"hooks/lib/monitoring" // Pattern analysis logging
-	"hooks/lib/session"    // Display functions
+	"hooks/lib/session"    // Hook payload, subagent ledger, and display functions
+	"system/lib/logging"   // Rails logger for subagent failures
 )
 
 // ────────────────────────────────────────────────────────────────
 // Constants - Named Values
 // ────────────────────────────────────────────────────────────────
-// No constants needed - configuration comes from environment.
 
-// No constants defined
+// subagentFailureHealth is the health impact of a failed subagent - its
+// output may be missing from the work, so it weighs more than a hook hiccup
+const subagentFailureHealth = -15
 
 // ────────────────────────────────────────────────────────────────
 // Types - Data Structures
@@ -155,19 +167,21 @@ import (
 //   - Status: Completion status (success, failure, empty)
 //   - ExitCode: Exit code from subagent (0 = success)
 //   - Error: Error message if subagent failed (empty if no error)
+//   - TranscriptPath: Subagent transcript JSONL (empty if not provided)
 type AgentInfo struct {
-	Type     string
-	Status   string
-	ExitCode string
-	Error    string
+	Type           string
+	Status         string
+	ExitCode       string
+	Error          string
+	TranscriptPath string
 }
 
 // ────────────────────────────────────────────────────────────────
 // Package-Level State (Rails Pattern)
 // ────────────────────────────────────────────────────────────────
-// This executable maintains no state - stateless orchestration only.
+// Rails logger for subagent failures - one per process.
 
-// No package-level state needed
+var logger = logging.NewLogger("subagent-stop")
 
 // ============================================================================
 // END SETUP
@@ -188,7 +202,7 @@ type AgentInfo struct {
 //
 //   Orchestration (Top Rung - Entry Point)
 //   └── subagentStop() → calls main orchestration
-//       └── getAgentInfo() → environment variable extraction helper
+//       └── getAgentInfo() → session.HookInput() (stdin payload, then environment)
 //           └── session.* / activity.* / monitoring.* → delegated to libraries
 //
 //   Libraries (Bottom Rungs - Foundation)
@@ -203,31 +217,38 @@ type AgentInfo struct {
 //     ↓
 //   Named Entry Point → subagentStop()
 //     ├→ Phase 1: Information Gathering
-//     │   └→ getAgentInfo() - Extract from environment
+//     │   ├→ getAgentInfo() - Extract from the shared hook payload
+//     │   └→ session.AnalyzeSubagentTranscript() - Stats (nil on any error)
 //     ├→ Phase 2: Logging
 //     │   ├→ activity.LogActivity() - Activity stream
-//     │   └→ monitoring.LogSubagentCompletion() - Pattern analysis
+//     │   ├→ monitoring.LogSubagentCompletion() - Pattern analysis
+//     │   ├→ logFailure() - Rails FAILURE entry (failures only)
+//     │   ├→ session.RecordSubagentStop() - Ledger and failed-subagent counter
+//     │   └→ session.NotifySubagentFailure() - Desktop notification (failures only)
 //     └→ Phase 3: Display
-//         └→ session.PrintSubagentCompletion() - User-facing summary
+//         └→ session.PrintSubagentCompletionFromPayload() - User-facing summary
 //
 // APUs (Atomic Processing Units):
-//   2 functions:
+//   3 functions:
 //     - subagentStop() [80 points]: Main orchestration (logging, display)
-//     - getAgentInfo() [20 points]: Environment variable extraction
+//     - getAgentInfo() [20 points]: Hook payload extraction
+//     - logFailure() [-15 on failure]: Rails FAILURE entry for a failed subagent
 //
 // ────────────────────────────────────────────────────────────────
 // Function Implementations
 // ────────────────────────────────────────────────────────────────
 
-// getAgentInfo extracts subagent information from environment variables
+// getAgentInfo extracts subagent information from the hook payload
 //
 // What It Does:
-//   - Reads SUBAGENT_TYPE, SUBAGENT_STATUS, SUBAGENT_EXIT_CODE, SUBAGENT_ERROR
+//   - Reads agent_type, status, exit_code, error from session.HookInput()
+//     (SUBAGENT_TYPE, SUBAGENT_STATUS, SUBAGENT_EXIT_CODE, SUBAGENT_ERROR fallback)
+//   - Transcript: agent_transcript_path, else transcript_path, else SUBAGENT_TRANSCRIPT_PATH
 //   - Defaults type to "unknown" if not provided
 //   - Returns AgentInfo struct for orchestration
 //
 // Why It Exists:
-//   - Centralized environment variable extraction
+//   - Centralized hook input extraction
 //   - Provides default values for missing data
 //   - Orchestration helper specific to this hook
 //
@@ -236,7 +257,7 @@ type AgentInfo struct {
 //   - First step in information gathering phase
 //
 // Parameters:
-//   - None (reads from environment)
+//   - None (reads the shared hook payload)
 //
 // Returns:
 //   - AgentInfo with subagent execution details
@@ -246,18 +267,21 @@ type AgentInfo struct {
 //   - Always succeeds (defaults if data missing)
 //
 // Related Components:
-//   - Uses standard os.Getenv() for environment access
+//   - Uses session.HookInput() (stdin payload with environment fallback)
 //   - Returns AgentInfo for use by logging and display functions
 //
 // Example:
 //   info := getAgentInfo()
 //   // Returns AgentInfo{Type: "research", Status: "success", ExitCode: "0", Error: ""}
 func getAgentInfo() AgentInfo {
+	payload := session.HookInput()
 	info := AgentInfo{
-		Type:     os.Getenv("SUBAGENT_TYPE"),
-		Status:   os.Getenv("SUBAGENT_STATUS"),
-		ExitCode: os.Getenv("SUBAGENT_EXIT_CODE"),
-		Error:    os.Getenv("SUBAGENT_ERROR"),
+		Type:     payload.AgentType,
+		Status:   payload.AgentStatus,
+		ExitCode: payload.AgentExitCode,
+		Error:    payload.AgentError,
+
+		TranscriptPath: payload.SubagentTranscript(),
 	}
 
 	// Provide default for missing type
@@ -268,6 +292,24 @@ func getAgentInfo() AgentInfo {
 	return info
 }
 
+// logFailure logs a failed subagent through the hook's Rails logger
+//
+// The entry carries error_type "subagent_failure" with the agent type and
+// exit code in RecoveryParams, so log queries and session health both see
+// the failure - not just the printed banner.
+func logFailure(info AgentInfo) {
+	reason := info.Error
+	if reason == "" {
+		reason = info.Type + " subagent failed"
+	}
+	logger.FailureWithMetadata("subagent-failure", reason, subagentFailureHealth,
+		map[string]any{"agent_type": info.Type, "exit_code": info.ExitCode},
+		logging.Metadata{
+			ErrorType:      "subagent_failure",
+			RecoveryParams: map[string]any{"agent_type": info.Type, "exit_code": info.ExitCode},
+		})
+}
+
 // ============================================================================
 // END BODY
 // ============================================================================
@@ -321,7 +363,7 @@ func getAgentInfo() AgentInfo {
 //   - Phase 2: +40 (logging)
 //   - Phase 3: +40 (display)
 //
-// Environment Variables:
+// Environment Variables (fallback):
 //   - SUBAGENT_TYPE: Type of subagent (research, code-review, etc.)
 //   - SUBAGENT_STATUS: Completion status (success, failure, empty)
 //   - SUBAGENT_EXIT_CODE: Exit code (0 = success)
@@ -347,9 +389,27 @@ func subagentStop() {
 	// Log to monitoring system for pattern analysis
 	monitoring.LogSubagentCompletion(info.Type, info.Status, info.ExitCode)
 
+	// Failures hit session health; every completion goes to the subagent ledger
+	// so a later success of the same type clears the end-of-session reminder
+	if status == "failure" {
+		logFailure(info)
+	}
+	session.RecordSubagentStop(info.Type, info.ExitCode, info.Error, status == "failure") // Errors logged by the library
+
+	// Forward failures to the desktop (best-effort - failure logged by the library)
+	if status == "failure" {
+		session.NotifySubagentFailure(info.Type, info.ExitCode, info.Error)
+	}
+
 	// Phase 3: Display (40 points)
+	// Transcript stats are optional - missing or unreadable shows the plain banner
+	stats, err := session.AnalyzeSubagentTranscript(info.TranscriptPath)
+	if err != nil {
+		stats = nil
+	}
+
 	// Display completion summary with temporal context
-	session.PrintSubagentCompletion(info.Type, info.Status, info.ExitCode, info.Error)
+	session.PrintSubagentCompletionFromPayload(session.HookInput(), stats)
 }
 
 func main() {
@@ -402,7 +462,7 @@ func main() {
 //      - Update health scoring map in METADATA
 //
 //   ✅ Enhance display information:
-//      - Modify session.PrintSubagentCompletion() in display lib
+//      - Modify session.PrintSubagentCompletionWithStats() in display lib
 //      - Or add additional display calls in Phase 3
 //      - Maintains orchestration pattern
 //
@@ -477,9 +537,9 @@ func main() {
 //    Example: telemetry.LogSubagentMetrics(info.Type, info.ExitCode)
 //
 // 2. Enhancing display information:
-//    Location: hooks/lib/session/display.go - PrintSubagentCompletion()
-//    Action: Modify display function implementation
-//    Example: Add subagent duration to display
+//    Location: hooks/lib/session/display.go - subagentStatsRows()
+//    Action: Add a row from SubagentStats (transcript.go counts it)
+//    Example: Show the number of Bash commands that failed
 //
 // 3. Adding environment variable:
 //    Location: AgentInfo struct and getAgentInfo() function
@@ -559,11 +619,17 @@ func main() {
 // This hook orchestrates the following libraries:
 //
 // Display (hooks/lib/session/display.go):
-//   - PrintSubagentCompletion(): Completion banner with temporal context
+//   - PrintSubagentCompletionFromPayload(): Completion banner with temporal context and transcript stats
+//
+// Transcript (hooks/lib/session/transcript.go):
+//   - AnalyzeSubagentTranscript(): Tools, files modified, tokens, duration from the transcript JSONL
 //
 // Activity (hooks/lib/activity/):
 //   - LogActivity(): Records completion to activity stream for session tracking
 //
+// Subagent ledger (hooks/lib/session/subagents.go):
+//   - RecordSubagentStop(): Ledger entry, failed-subagent counter, session end reminder
+//
 // Monitoring (hooks/lib/monitoring/logging.go):
 //   - LogSubagentCompletion(): Records for pattern analysis and learning
 //
@@ -580,7 +646,6 @@ func main() {
 // Planned Features:
 //   - Subagent execution duration tracking
 //   - Success/failure pattern analysis per subagent type
-//   - Automatic retry suggestions for failed subagents
 //   - Subagent performance metrics (speed, quality)
 //   - Integration with subagent selection system
 //
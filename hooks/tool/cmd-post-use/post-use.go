@@ -21,10 +21,14 @@
 // Architect: Seanje Lenox-Wise
 // Implementation: Nova Dawn (CPI-SI instance)
 // Creation Date: 2024-10-24
-// Version: 2.0.0
-// Last Modified: 2025-11-10 - Template application and named entry point pattern
+// Version: 2.4.0
+// Last Modified: 2026-10-16 - Hook input via the shared payload reader
 //
 // Version History:
+//   2.4.0 (2026-10-16) - Tool call, file path, and search pattern from session.HookInput() (os.Args and env fallback)
+//   2.3.0 (2026-10-16) - validation.EvaluateResult decides silent/report/report_loud/fail; "fail" exits 2
+//   2.2.0 (2026-10-16) - Validation results also written to config.results_path (SARIF/JSON) when set
+//   2.1.0 (2026-10-16) - BASH_BACKGROUND_PID appended to the session's pid ledger
 //   2.0.0 (2025-11-10) - Full template application, named entry point, removed debug code
 //   1.0.0 (2024-10-24) - Initial implementation
 //
@@ -52,7 +56,9 @@
 // Blocking Status
 //
 // Non-blocking: All operations fail gracefully, tool completion never blocked.
-// Validation failures report but don't prevent tool use. Logging failures are silent.
+// Validation failures report but don't prevent tool use - unless validators.jsonc
+// "policy" decides "fail", which exits 2 so the findings reach the next step.
+// Logging failures are silent.
 // Mitigation: Defensive checks throughout, tool execution is priority.
 //
 // Usage & Integration
@@ -80,7 +86,8 @@
 //   Standard Library: fmt, os, path/filepath, strconv, strings, time
 //   External: None
 //   System Libraries: None
-//   Hook Libraries: hooks/lib/activity, hooks/lib/feedback, system/lib/temporal, system/lib/validation
+//   Hook Libraries: hooks/lib/activity, hooks/lib/feedback, hooks/lib/session, system/lib/temporal, system/lib/validation
+//   System Libraries: system/lib/logging (validation decisions)
 //
 // Dependents (What Uses This):
 //   Commands: None (top-level hook, not called by other executables)
@@ -89,8 +96,8 @@
 //
 // Integration Points:
 //   - Called by Claude Code hook system after tool execution
-//   - Reads tool name and args from os.Args
-//   - Reads environment variables (FILE_PATH, BASH_EXIT_CODE, etc.)
+//   - Reads the tool call from the stdin payload via session.HookInput() (os.Args as fallback)
+//   - Reads environment variables (BASH_EXIT_CODE, etc.; FILE_PATH, GREP_PATTERN, GLOB_PATTERN as fallback)
 //   - Logs to activity stream
 //   - Displays validation/feedback to user
 //
@@ -99,8 +106,8 @@
 // Post-tool validation orchestration operates on Base100 scale:
 //
 // Phase 1: Argument Validation: +5 points
-//   - Check os.Args length (need at least 3)
-//   - Silent exit if insufficient args
+//   - Payload tool_name, else os.Args (need at least 3)
+//   - Silent exit if neither names a tool
 //
 // Phase 2: Tool Routing: +10 points
 //   - Route to appropriate handler based on tool type
@@ -150,6 +157,8 @@ import (
 
 	"hooks/lib/activity"   // Activity stream logging
 	"hooks/lib/feedback"   // Contextual user feedback
+	"hooks/lib/session"    // Hook payload, background process ledger (session end reminders)
+	"system/lib/logging"    // Rails logger for validation decisions
 	"system/lib/temporal"  // Temporal context for pattern recognition
 	"system/lib/validation" // File formatting and syntax validation (v2.0.0 config-driven)
 )
@@ -159,7 +168,8 @@ import (
 // ────────────────────────────────────────────────────────────────
 // No constants needed - tool routing based on runtime args/env.
 
-// No constants defined
+// exitBlocked is the hook exit code that hands stderr back as a blocking error
+const exitBlocked = 2
 
 // ────────────────────────────────────────────────────────────────
 // Types - Data Structures
@@ -171,9 +181,9 @@ import (
 // ────────────────────────────────────────────────────────────────
 // Package-Level State (Rails Pattern)
 // ────────────────────────────────────────────────────────────────
-// This executable maintains no state - stateless orchestration only.
+// Rails logger for validation decisions - one per process.
 
-// No package-level state needed
+var validationLogger = logging.NewLogger("post-use-validation")
 
 // ============================================================================
 // END SETUP
@@ -205,9 +215,9 @@ import (
 //     ↓
 //   postToolUse() orchestration
 //     ↓
-//   Phase 1: Argument validation (check os.Args length)
+//   Phase 1: Input validation (payload tool_name or os.Args length)
 //     ↓
-//   Phase 2: Parse tool name and args from command line
+//   Phase 2: Parse tool name and args (session.HookInput().ToolCall(os.Args))
 //     ↓
 //   Phase 3: Gather temporal metadata for pattern recognition
 //     ↓
@@ -215,7 +225,8 @@ import (
 //     ├─> Write/Edit → handleFileEdit()
 //     │     ├─> Log tool use
 //     │     ├─> Format file (validation.FormatFile)
-//     │     └─> Validate file (validation.ValidateFile)
+//     │     ├─> Validate file (validation.ValidateFile)
+//     │     └─> Decide and present (validation.EvaluateResult)
 //     ├─> Bash → handleBashCommand()
 //     │     ├─> Log command with exit code/duration
 //     │     ├─> Provide contextual feedback (commits, builds, installs)
@@ -276,25 +287,26 @@ func getTemporalMetadata() string {
 //   - Logs tool usage to activity stream
 //   - Formats code file using validation library
 //   - Validates file after formatting
-//   - Reports results to user
+//   - Decides what the result means (validators.jsonc "policy") and presents it
+//   - Writes results to config.results_path (SARIF or JSON) when set
 //
 // Parameters:
 //   - toolName: Name of tool used (Write or Edit)
 //   - pattern: Tool arguments/pattern
 //
 // Returns:
-//   - None (logs and displays results)
+//   - int: Hook exit code (exitBlocked when the policy decided "fail", else 0)
 //
 // Health Impact:
-//   - No health tracking (orchestration helper)
+//   - Decision's HealthImpact, logged to the post-use-validation Rails log
 //
 // Example:
 //   handleFileEdit("Write", "file.go")
 //   // Logs, formats, validates, reports
-func handleFileEdit(toolName, pattern string) {
-	filePath := os.Getenv("FILE_PATH")
+func handleFileEdit(toolName, pattern string) int {
+	filePath := session.HookInput().ToolFilePath() // tool_input.file_path, FILE_PATH fallback
 	if filePath == "" {
-		return
+		return 0
 	}
 
 	ext := filepath.Ext(filePath)
@@ -306,15 +318,29 @@ func handleFileEdit(toolName, pattern string) {
 	result := validation.FormatFile(filePath, ext)
 	result.Report()
 
-	// Validate after formatting
+	// Validate after formatting - config.strictness decides Valid, the policy
+	// decides what that means here (silent, report, report_loud, fail)
 	validationResult := validation.ValidateFile(filePath, ext)
-	validationResult.Report()
+	decision := validation.EvaluateResult(validationResult, validation.PolicyForFile(filePath))
+	decision.Present(validationResult)
+	validationLogger.Check("validation "+decision.Action, validationResult.Valid, decision.HealthImpact, map[string]any{"file": filePath, "reason": decision.Reason})
+
+	// Also leave the result where an editor or CI watcher reads it (config.results_path)
+	if err := validationResult.WriteConfiguredResults(); err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: validation results not written: %v\n", err)
+	}
+
+	if decision.Blocks() {
+		return exitBlocked
+	}
+	return 0
 }
 
 // handleBashCommand processes Bash tool usage
 //
 // What It Does:
 //   - Logs bash command with exit code and duration
+//   - Records background commands (BASH_BACKGROUND_PID) in the session's pid ledger
 //   - Provides contextual feedback based on command type
 //   - Detects git commits, builds, dependency installs
 //   - Checks for command failures
@@ -349,6 +375,11 @@ func handleBashCommand(cmdStr string) {
 
 	activity.LogCommand(cmdStr, exitCode, duration)
 
+	// Background commands: session end reminds if they're still running
+	if pid, err := strconv.Atoi(os.Getenv("BASH_BACKGROUND_PID")); err == nil && pid > 0 {
+		session.RecordBackgroundProcess(pid, cmdStr)
+	}
+
 	// Provide contextual feedback based on command type
 	switch {
 	case strings.Contains(cmdStr, "git commit"):
@@ -374,10 +405,10 @@ func handleBashCommand(cmdStr string) {
 //   - Handles Write, Edit, Bash, Read, Grep, Glob tools
 //
 // Parameters:
-//   - None (reads from os.Args and environment)
+//   - None (reads session.HookInput(), os.Args and environment as fallback)
 //
 // Returns:
-//   - None (all operations non-blocking)
+//   - int: Hook exit code (exitBlocked only from a "fail" validation decision)
 //
 // Health Impact:
 //   - No health tracking (orchestration function)
@@ -385,37 +416,43 @@ func handleBashCommand(cmdStr string) {
 // Example:
 //   postToolUse()
 //   // Routes to handleFileEdit, handleBashCommand, or logging
-func postToolUse() {
-	if len(os.Args) < 3 {
-		return
+func postToolUse() int {
+	// Hook input: stdin payload, legacy "post-use <tool> <args>" form as fallback
+	payload := session.HookInput()
+	toolName, toolArgs, ok := payload.ToolCall(os.Args)
+	if !ok {
+		return 0
 	}
 
-	toolName := os.Args[1]
-	toolArgs := os.Args[2]
-
 	// Get temporal context for tool use (helps understand patterns)
 	temporalMeta := getTemporalMetadata()
 
 	// Route to appropriate handler based on tool type
+	exitCode := 0
 	switch {
 	case strings.HasPrefix(toolName, "Write") || strings.HasPrefix(toolName, "Edit"):
-		handleFileEdit(toolName, toolArgs)
+		exitCode = handleFileEdit(toolName, toolArgs)
 	case strings.HasPrefix(toolName, "Bash"):
 		handleBashCommand(toolArgs)
 	case strings.HasPrefix(toolName, "Read"):
 		// Log Read operations
-		filePath := os.Getenv("FILE_PATH")
-		activity.LogToolUse("Read", filePath, true)
+		activity.LogToolUse("Read", payload.ToolFilePath(), true)
 	case strings.HasPrefix(toolName, "Grep"):
 		// Log Grep operations (search activity)
-		pattern := os.Getenv("GREP_PATTERN")
+		pattern := payload.ToolString("pattern")
+		if pattern == "" {
+			pattern = os.Getenv("GREP_PATTERN")
+		}
 		if pattern == "" {
 			pattern = "search"
 		}
 		activity.LogActivity("Grep", pattern, "success", 0)
 	case strings.HasPrefix(toolName, "Glob"):
 		// Log Glob operations (file discovery)
-		pattern := os.Getenv("GLOB_PATTERN")
+		pattern := payload.ToolString("pattern")
+		if pattern == "" {
+			pattern = os.Getenv("GLOB_PATTERN")
+		}
 		if pattern == "" {
 			pattern = "search"
 		}
@@ -426,6 +463,7 @@ func postToolUse() {
 	if temporalMeta != "" {
 		activity.LogActivity("ToolContext", temporalMeta, "info", 0)
 	}
+	return exitCode
 }
 
 // ============================================================================
@@ -448,7 +486,7 @@ func postToolUse() {
 //   - Test with various tool types (Write, Edit, Bash, Read, Grep, Glob)
 //   - Verify activity log entries created
 //   - Test validation feedback displays
-//   - Ensure non-blocking (no panics or exits on errors)
+//   - Ensure non-blocking (no panics; the only non-zero exit is a "fail" validation decision)
 //
 // Build Verification:
 //   cd ~/.claude/hooks/tool/cmd-post-use
@@ -493,11 +531,11 @@ func postToolUse() {
 //
 // Pattern:
 //   func main() {
-//       postToolUse()  // Minimal - just call named entry point
+//       os.Exit(postToolUse())  // Minimal - just call named entry point
 //   }
 
 func main() {
-	postToolUse() // Named entry point pattern
+	os.Exit(postToolUse()) // Named entry point pattern (exit 2 = blocking validation decision)
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -668,6 +706,7 @@ func main() {
 // Quick summary:
 //   - hooks/lib/activity: Activity stream logging
 //   - hooks/lib/feedback: Contextual user feedback
+//   - hooks/lib/session: Background process ledger for session end reminders
 //   - hooks/lib/temporal: Temporal context
 //   - system/lib/validation: File formatting and validation (v2.0.0 config-driven)
 //
@@ -21,10 +21,11 @@
 // Architect: Seanje Lenox-Wise
 // Implementation: Nova Dawn (CPI-SI instance)
 // Creation Date: 2024-10-24
-// Version: 2.0.0
-// Last Modified: 2025-11-10 - Template application and library extraction
+// Version: 2.1.0
+// Last Modified: 2026-10-16 - Hook input via the shared payload reader
 //
 // Version History:
+//   2.1.0 (2026-10-16) - Tool name, arguments, and file path from session.HookInput() (os.Args, FILE_PATH fallback)
 //   2.0.0 (2025-11-10) - Full template application, logic extracted to hooks/lib/safety/confirmation.go
 //   1.0.0 (2024-10-24) - Initial implementation with inline confirmation logic
 //
@@ -100,7 +101,7 @@
 //   Standard Library: fmt, os, strings
 //   External: None
 //   System Libraries: None
-//   Hook Libraries: hooks/lib/activity (logging), hooks/lib/safety (detection, confirmation), hooks/lib/temporal (context)
+//   Hook Libraries: hooks/lib/activity (logging), hooks/lib/safety (detection, confirmation), hooks/lib/session (HookInput), hooks/lib/temporal (context)
 //
 // Dependents (What Uses This):
 //   Commands: None (top-level hook, not called by other executables)
@@ -109,8 +110,8 @@
 //
 // Integration Points:
 //   - Called by Claude Code hook system before every tool use
-//   - Reads os.Args for tool name and arguments
-//   - Reads FILE_PATH environment variable for Write operations
+//   - Reads the tool call from the stdin payload (os.Args as fallback)
+//   - Reads tool_input.file_path (FILE_PATH environment variable as fallback) for Write operations
 //   - Outputs confirmation prompts to stdout
 //   - Reads user input from stdin
 //   - Exits with code 0 (allow) or 1 (block)
@@ -166,6 +167,7 @@ import (
 
 	"hooks/lib/activity"  // Activity stream logging (tool attempts)
 	"hooks/lib/safety"    // Safety validation (detection, confirmation flows)
+	"hooks/lib/session"   // Shared hook payload (stdin, args and environment fallback)
 	"system/lib/privacy"  // Privacy-preserving sanitization
 	"system/lib/temporal" // Temporal context (time awareness for warnings)
 )
@@ -226,7 +228,7 @@ const (
 //     ↓
 //   Named Entry Point → preToolUse()
 //     ↓
-//   Parse Arguments → toolName, toolArgs from os.Args
+//   Parse Input → toolName, toolArgs from session.HookInput().ToolCall(os.Args)
 //     ↓
 //   Log Attempt → activity.LogActivity()
 //     ↓
@@ -258,7 +260,7 @@ const (
 // preToolUse orchestrates pre-tool validation and confirmation flow
 //
 // What It Does:
-//   - Parses tool name and arguments from command line
+//   - Parses tool name and arguments from the hook payload (command line as fallback)
 //   - Logs tool attempt to activity stream (before validation)
 //   - Gets temporal context for warning displays
 //   - Routes to appropriate confirmation flow based on tool type
@@ -266,7 +268,7 @@ const (
 //   - Allows operation (os.Exit(0)) if confirmed or not dangerous
 //
 // Parameters:
-//   None (reads from os.Args and environment variables)
+//   None (reads session.HookInput(), os.Args and FILE_PATH as fallback)
 //
 // Returns:
 //   None (exits with code 0 for allow, 1 for block)
@@ -278,18 +280,17 @@ const (
 // Example usage:
 //   preToolUse()  // Called by main(), orchestrates entire validation flow
 func preToolUse() {
-	// Parse arguments
-	if len(os.Args) < 3 {
-		os.Exit(ExitAllow) // No args - allow operation
+	// Parse hook input (stdin payload, legacy "pre-use <tool> <args>" form as fallback)
+	payload := session.HookInput()
+	toolName, toolArgs, ok := payload.ToolCall(os.Args)
+	if !ok {
+		os.Exit(ExitAllow) // No tool named - allow operation
 	}
 
-	toolName := os.Args[1]
-	toolArgs := os.Args[2]
-
 	// Log tool attempt before validation (captures intent even if blocked)
 	context := toolArgs
 	if strings.HasPrefix(toolName, "Write") || strings.HasPrefix(toolName, "Edit") {
-		filePath := os.Getenv("FILE_PATH")
+		filePath := payload.ToolFilePath() // tool_input.file_path, FILE_PATH fallback
 		if filePath != "" {
 			context = privacy.SanitizePath(filePath)
 		}
@@ -319,7 +320,7 @@ func preToolUse() {
 	}
 
 	if strings.HasPrefix(toolName, "Write") {
-		filePath := os.Getenv("FILE_PATH")
+		filePath := payload.ToolFilePath()
 		if filePath != "" {
 			needsConfirmation, allowed := safety.ConfirmFileWrite(filePath, timeContext)
 			if needsConfirmation && !allowed {
@@ -436,8 +437,8 @@ func main() {
 //
 // Integration points:
 //   - Called by Claude Code before EVERY tool execution
-//   - Reads os.Args for tool name and arguments
-//   - Reads FILE_PATH environment variable for Write operations
+//   - Reads the tool call from the stdin payload (os.Args as fallback)
+//   - Reads tool_input.file_path (FILE_PATH environment variable as fallback) for Write operations
 //   - Outputs confirmation prompts to stdout
 //   - Reads user confirmation from stdin
 //   - Exits with code 0 (allow) or 1 (block)
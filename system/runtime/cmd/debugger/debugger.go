@@ -64,6 +64,9 @@ const (
 
 	// Analysis thresholds
 	warningCountThreshold = 5 // Multiple warnings indicate potential instability
+
+	// Report rendering
+	componentHealthBarWidth = 10 // Health bar cells per component row (overall uses [display.health] width)
 )
 
 // ────────────────────────────────────────────────────────────────
@@ -629,7 +632,8 @@ func displayAssessment(assessment *SystemAssessment) {
 		healthStatus = "Warning"
 		healthColor = display.Yellow
 	}
-	fmt.Printf("  Overall Health: %s%d%s (%s)\n\n", healthColor, assessment.OverallHealth, display.Reset, healthStatus)
+	healthStyle := logging.DefaultHealthStyle() // Same rendering as log entries and session summaries
+	fmt.Printf("  Overall Health: %s%s%s (%s)\n\n", healthColor, logging.FormatHealth(assessment.OverallHealth, healthStyle), display.Reset, healthStatus)
 
 	// Component details
 	fmt.Print(display.Subheader("Component Health"))
@@ -647,6 +651,8 @@ func displayAssessment(assessment *SystemAssessment) {
 		return sorted[i].health < sorted[j].health
 	})
 
+	rowStyle := healthStyle
+	rowStyle.BarWidth = componentHealthBarWidth // Compact bar per table row
 	for _, ch := range sorted {
 		comp := assessment.Components[ch.name]
 		color := display.Green
@@ -656,9 +662,9 @@ func displayAssessment(assessment *SystemAssessment) {
 			color = display.Yellow
 		}
 
-		fmt.Printf("  %s%-20s%s Health: %s%4d%s | Checks: %d | Failures: %d | Warnings: %d\n",
+		fmt.Printf("  %s%-20s%s Health: %s%s%s | Checks: %d | Failures: %d | Warnings: %d\n",
 			display.Bold, comp.Name, display.Reset,
-			color, comp.FinalHealth, display.Reset,
+			color, logging.FormatHealth(comp.FinalHealth, rowStyle), display.Reset,
 			comp.CheckCount, comp.FailureCount, comp.WarningCount)
 	}
 	fmt.Println()
@@ -948,8 +954,13 @@ func main() {
 	var allLogFiles []string
 	for _, dir := range logDirs {
 		files, err := filepath.Glob(filepath.Join(dir, "*.log"))
-		if err == nil {
-			allLogFiles = append(allLogFiles, files...)
+		if err != nil {
+			continue
+		}
+		for _, file := range files {
+			if !logging.IsLevelFile(file) && !logging.IsAuditLog(file) { // Level-split copies would count twice; the audit trail is not entries
+				allLogFiles = append(allLogFiles, file)
+			}
 		}
 	}
 
@@ -970,13 +981,17 @@ func main() {
 	var allLogEntries []logging.LogEntry                                     // Collect all log entries for correlation
 	totalParsed := 0
 	parseErrors := 0
+	var damagedLogs []string                                                 // "path: N problems near line L" for the assessment
 
 	for _, logFile := range allLogFiles {
-		entries, err := logging.ReadLogFile(logFile)
+		entries, report, err := logging.ReadLogFileReport(logFile)
 		if err != nil {
 			parseErrors++
 			continue
 		}
+		if problems := report.Problems(); len(problems) > 0 {              // Damaged, not unreadable - entries still count
+			damagedLogs = append(damagedLogs, fmt.Sprintf("%s: %d problem(s), first %s", logFile, len(problems), problems[0]))
+		}
 
 		totalParsed += len(entries)
 		allLogEntries = append(allLogEntries, entries...)                    // Collect for correlation
@@ -1017,6 +1032,7 @@ func main() {
 	logger.Check("log-entries-parsed", parseSuccess, 30, map[string]any{
 		"total_parsed":    totalParsed,
 		"parse_errors":    parseErrors,
+		"damaged_logs":    len(damagedLogs),
 		"component_count": len(components),
 		"debug_entries":   len(allDebugEntries),
 	})
@@ -1025,6 +1041,9 @@ func main() {
 	assessment := assessSystem(components)
 	assessment.DebugEntries = len(allDebugEntries)                           // Store debug entry count
 	assessment.CorrelatedEntries = correlatedData                            // Store correlation results
+	for _, damaged := range damagedLogs {                                    // Torn writes and truncated tails
+		assessment.Warnings = append(assessment.Warnings, "Damaged log "+damaged)
+	}
 	logger.Check("component-health-aggregated", true, 20, map[string]any{
 		"components":     len(components),
 		"overall_health": assessment.OverallHealth,
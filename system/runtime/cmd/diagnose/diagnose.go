@@ -14,21 +14,23 @@
 //   Action 3/4: Snapshot state (+8 or -8)
 //   Action 4/4: Display header (+2 or -2)
 //
-// Diagnostic Actions (7 actions = 163 points) - CRITICAL:
-//   Action 1/7: Check system info (+15 or -15)
-//   Action 2/7: Diagnose sudoers (+50 or -50) - Core system component
-//   Action 3/7: Log sudoers diagnosis (+8 or -8)
-//   Action 4/7: Diagnose environment (+50 or -50) - Core system component
-//   Action 5/7: Log environment diagnosis (+8 or -8)
-//   Action 6/7: Check filesystem paths (+18 or -18) - Essential for functionality
-//   Action 7/7: Check binaries (+14 or -14) - Tools must exist
+// Diagnostic Actions (9 actions = 183 points) - CRITICAL:
+//   Action 1/9: Check system info (+15 or -15)
+//   Action 2/9: Diagnose sudoers (+50 or -50) - Core system component
+//   Action 3/9: Log sudoers diagnosis (+8 or -8)
+//   Action 4/9: Diagnose environment (+50 or -50) - Core system component
+//   Action 5/9: Log environment diagnosis (+8 or -8)
+//   Action 6/9: Check filesystem paths (+18 or -18) - Essential for functionality
+//   Action 7/9: Check binaries (+14 or -14) - Tools must exist
+//   Action 8/9: Check validators (+10 or -10) - Post-write validation tools
+//   Action 9/9: Digest recent failures (+10 or -10) - What the logs say is failing
 //
 // Results & Guidance (2 actions = 32 points):
 //   Action 1/2: Display troubleshooting (+25 or -25) - Primary value to user
 //   Action 2/2: Log completion (+7 or -7)
 //
-// Total Possible: 220 points
-// Normalization: (cumulative_health / 220) × 100
+// Total Possible: 240 points
+// Normalization: (cumulative_health / 240) × 100
 
 package main
 
@@ -42,13 +44,21 @@ import (
 	"os/exec"
 	"os/user"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"system/lib/debugging"
 	"system/lib/display"
 	"system/lib/environment"
 	"system/lib/logging"
 	"system/lib/sudoers"
+	"system/lib/validation"
+	"time"
 )
 
+// failureWindow is how far back the Recent Failures section looks
+const failureWindow = 24 * time.Hour
+
 // ============================================================================
 // BODY
 // ============================================================================
@@ -221,6 +231,87 @@ func checkBinaries() {
 	fmt.Println()
 }
 
+func checkValidators() (available, total int) {
+	fmt.Print(display.Subheader("Validator Availability"))
+
+	statuses := validation.CheckAllValidators()
+	keys := make([]string, 0, len(statuses))
+	for key := range statuses {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		status := statuses[key]
+		if !status.Enabled {
+			continue // Disabled validators never run - availability is moot
+		}
+		total++
+		if status.Available {
+			available++
+			fmt.Println(display.StatusLine(true, key))
+		} else {
+			fmt.Println(display.StatusLine(false, fmt.Sprintf("%s (%s)", key, status.Reason)))
+		}
+	}
+
+	fmt.Println()
+	return available, total
+}
+
+// renderFailureDigest formats a failure digest for the terminal
+//
+// Per component: FAILURE and ERROR counts, latest health (flagged when below
+// the digest's threshold), and one table row per distinct failure signature
+// with its newest example's time and reason.
+func renderFailureDigest(digest *logging.Digest) string {
+	var out strings.Builder
+	if len(digest.Components) == 0 {
+		out.WriteString(display.Success("No failures or errors in the window; every component is healthy") + "\n")
+		return out.String()
+	}
+
+	for _, component := range digest.Components {
+		out.WriteString(display.Bold + component.Component + display.Reset + "\n")
+		out.WriteString(display.KeyValue("Failures", strconv.Itoa(component.Failures)) + "\n")
+		out.WriteString(display.KeyValue("Errors", strconv.Itoa(component.Errors)) + "\n")
+		health := fmt.Sprintf("%d%% (%s)", component.LatestHealth, component.LatestAt.Local().Format("2006-01-02 15:04"))
+		if component.Unhealthy {
+			health = display.Severity(health+fmt.Sprintf(" below %d%%", digest.HealthThreshold), display.LevelWarning)
+		}
+		out.WriteString(display.KeyValue("Latest Health", health) + "\n")
+
+		if len(component.Signatures) > 0 {
+			table := &display.Table{Headers: []string{"Level", "Count", "Last Seen", "Signature", "Reason"}}
+			for _, signature := range component.Signatures {
+				table.Rows = append(table.Rows, []string{
+					signature.Level,
+					strconv.Itoa(signature.Count),
+					signature.Timestamp.Local().Format("01-02 15:04"),
+					display.Truncate(signature.Signature, 48),
+					display.Truncate(signature.Reason, 40),
+				})
+			}
+			out.WriteString(table.Render())
+		}
+		out.WriteString("\n")
+	}
+	return out.String()
+}
+
+func showRecentFailures() (*logging.Digest, error) {
+	fmt.Print(display.Subheader(fmt.Sprintf("Recent Failures (last %s)", failureWindow)))
+
+	digest, err := logging.FailureDigest(logging.LogsDir(), failureWindow)
+	if err != nil {
+		fmt.Println(display.Warning(fmt.Sprintf("Could not read logs: %v", err)))
+		fmt.Println()
+		return nil, err
+	}
+	fmt.Print(renderFailureDigest(digest))
+	return digest, nil
+}
+
 func showTroubleshooting() {
 	fmt.Print(display.Header("Troubleshooting Recommendations"))
 
@@ -252,7 +343,7 @@ func showTroubleshooting() {
 func main() {
 	// Setup Action 1/4: Initialize logger (+10 or -10)
 	logger := logging.NewLogger("diagnose")
-	logger.DeclareHealthTotal(220)  // Total possible points from health scoring map
+	logger.DeclareHealthTotal(240)  // Total possible points from health scoring map
 	inspector := debugging.NewInspector("diagnose")
 	inspector.Enable() // Enable debugging to capture HOW data
 
@@ -260,7 +351,7 @@ func main() {
 	inspector.Snapshot("diagnose-start", map[string]any{
 		"command": "diagnose",
 		"purpose": "comprehensive system diagnostics",
-		"checks":  []string{"system info", "sudoers", "environment", "paths", "binaries"},
+		"checks":  []string{"system info", "sudoers", "environment", "paths", "binaries", "validators", "recent failures"},
 	})
 
 	logger.Check("logger-initialized", true, 10, map[string]any{
@@ -279,19 +370,19 @@ func main() {
 		"header": "diagnostics",
 	})
 
-	// Diagnostic Action 1/7: Check system info (+15 or -15)
+	// Diagnostic Action 1/9: Check system info (+15 or -15)
 	checkSystemInfo()
 	logger.Check("system-info-checked", true, 15, map[string]any{
 		"checked": "user, shell, working directory",
 	})
 
-	// Diagnostic Action 2/7: Diagnose sudoers (+50 or -50) - Core system component
+	// Diagnostic Action 2/9: Diagnose sudoers (+50 or -50) - Core system component
 	diagnoseSudoers()
 	logger.Check("sudoers-diagnosed", true, 50, map[string]any{
 		"diagnostic": "sudoers configuration",
 	})
 
-	// Diagnostic Action 3/7: Log sudoers diagnosis (+8 or -8)
+	// Diagnostic Action 3/9: Log sudoers diagnosis (+8 or -8)
 	sudoersStatus := sudoers.Check()
 	logger.Check("sudoers-diagnosis-logged", true, 8, map[string]any{
 		"file_exists":  sudoersStatus.FileExists,
@@ -299,31 +390,51 @@ func main() {
 		"permissions":  sudoersStatus.Permissions,
 	})
 
-	// Diagnostic Action 4/7: Diagnose environment (+50 or -50) - Core system component
+	// Diagnostic Action 4/9: Diagnose environment (+50 or -50) - Core system component
 	diagnoseEnvironment()
 	logger.Check("environment-diagnosed", true, 50, map[string]any{
 		"diagnostic": "environment configuration",
 	})
 
-	// Diagnostic Action 5/7: Log environment diagnosis (+8 or -8)
+	// Diagnostic Action 5/9: Log environment diagnosis (+8 or -8)
 	envStatus := environment.Check()
 	logger.Check("environment-diagnosis-logged", true, 8, map[string]any{
 		"shell_integrated": envStatus.ShellIntegrated,
 		"config_path":      envStatus.ConfigPath,
 	})
 
-	// Diagnostic Action 6/7: Check filesystem paths (+18 or -18) - Essential for functionality
+	// Diagnostic Action 6/9: Check filesystem paths (+18 or -18) - Essential for functionality
 	checkPaths()
 	logger.Check("paths-checked", true, 18, map[string]any{
 		"checked": "system directories",
 	})
 
-	// Diagnostic Action 7/7: Check binaries (+14 or -14) - Tools must exist
+	// Diagnostic Action 7/9: Check binaries (+14 or -14) - Tools must exist
 	checkBinaries()
 	logger.Check("binaries-checked", true, 14, map[string]any{
 		"checked": "validate, test, status, diagnose",
 	})
 
+	// Diagnostic Action 8/9: Check validators (+10 or -10) - Post-write validation tools
+	validatorsAvailable, validatorsTotal := checkValidators()
+	logger.Check("validators-checked", true, 10, map[string]any{
+		"available": validatorsAvailable,
+		"enabled":   validatorsTotal,
+	})
+
+	// Diagnostic Action 9/9: Digest recent failures (+10 or -10) - What the logs say is failing
+	digest, digestErr := showRecentFailures()
+	if digestErr != nil {
+		logger.Failure("failure-digest", digestErr.Error(), -10, map[string]any{
+			"logs_dir": logging.LogsDir(),
+		})
+	} else {
+		logger.Check("failures-digested", true, 10, map[string]any{
+			"components": len(digest.Components),
+			"unhealthy":  digest.Unhealthy,
+		})
+	}
+
 	// Results & Guidance Action 1/2: Display troubleshooting (+25 or -25) - Primary value to user
 	showTroubleshooting()
 	logger.Check("troubleshooting-displayed", true, 25, map[string]any{
@@ -27,6 +27,10 @@
 //
 // Total Possible: 170 points
 // Normalization: (cumulative_health / 170) × 100
+//
+// Logging self-health (not scored): hosts whose loggers reported write
+// failures, rotation errors, or default-config fallbacks to
+// system/logging-health.log in the last day are flagged under the checks.
 
 package main
 
@@ -36,6 +40,9 @@ package main
 
 import (
 	"fmt"
+	"sort"
+	"time"
+
 	"system/lib/debugging"
 	"system/lib/display"
 	"system/lib/environment"
@@ -43,6 +50,8 @@ import (
 	"system/lib/sudoers"
 )
 
+const loggingHealthWindow = 24 * time.Hour // Self-health records status considers
+
 // ============================================================================
 // BODY
 // ============================================================================
@@ -76,6 +85,29 @@ func showQuickStatus() (bool, bool) {
 	return sudoersOK, envOK
 }
 
+// showLoggingHealth flags hosts where logging itself degraded (logging-health.log).
+func showLoggingHealth() (map[string]logging.SelfReport, bool) {
+	hosts, err := logging.DegradedLoggingHosts(logging.LogsDir(), loggingHealthWindow)
+	if err != nil {
+		fmt.Printf("%s%-25s%s %s\n", display.Bold, "Logging Pipeline", display.Reset, display.Warning("Health log unreadable"))
+		return nil, false
+	}
+	line, ok := checkComponent("Logging Pipeline", func() bool { return len(hosts) == 0 })
+	fmt.Println(line)
+
+	names := make([]string, 0, len(hosts))
+	for host := range hosts {
+		names = append(names, host)
+	}
+	sort.Strings(names)
+	for _, host := range names {
+		report := hosts[host]
+		fmt.Println(display.KeyValue("  "+host, fmt.Sprintf("%d write failures, %d rotation errors, %d entries on default config",
+			report.WriteFailures, report.RotationErrors, report.ConfigFallbacks)))
+	}
+	return hosts, ok
+}
+
 func showDetailedStatus(sudoersOK, envOK bool) {
 	fmt.Print(display.Subheader("Details"))
 
@@ -185,6 +217,11 @@ func main() {
 	// Component Checks Action 1/2: Check sudoers (+50)
 	// Component Checks Action 2/2: Check environment (+50)
 	sudoersOK, envOK := showQuickStatus()
+	degradedHosts, loggingOK := showLoggingHealth()
+	logger.Check("logging-self-health", loggingOK, 0, map[string]any{
+		"degraded_hosts": len(degradedHosts),
+		"health_log":     logging.SelfHealthLogPath(),
+	})
 
 	// DEBUGGING: Capture component health results
 	inspector.ExpectedState("sudoers-health", true, sudoersOK, map[string]any{
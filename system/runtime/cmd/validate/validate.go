@@ -4,7 +4,9 @@
 // Validate Command - CPI-SI Interactive Terminal System
 // Purpose: Validate system installation and configuration
 // Non-blocking: Checks status without modifying system
-// Usage: ./bin/validate
+// Usage: ./bin/validate            (sudoers + environment)
+//        ./bin/validate --configs  (every CPI-SI config file, issues as file:line:col)
+//        ./bin/validate --watch [path...]  (revalidate source files as they change; Ctrl-C stops)
 //
 // HEALTH SCORING MAP (TRUE SCORE):
 // ----------------------------------
@@ -37,6 +39,16 @@
 //
 // Total Possible: 259 points
 // Normalization: (cumulative_health / 259) × 100
+//
+// Exit code: the logger's run summary (Finalize) - 0 healthy, 1 degraded,
+// 2 failed by final health ([exit_codes] in logging.toml); a failed
+// validation never exits 0.
+//
+// Config Validation (--configs mode, 4 actions = 55 points):
+//   Action 1/4: Find config files (+5 or -5)
+//   Action 2/4: Check every file (+40 proportional to files passing)
+//   Action 3/4: Display results table (+5 or -5)
+//   Action 4/4: Log final result (+5 or -5)
 
 package main
 
@@ -45,15 +57,63 @@ package main
 // ============================================================================
 
 import (
+	"flag"
 	"fmt"
+	"io/fs"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"system/lib/config"
 	"system/lib/debugging"
 	"system/lib/display"
 	"system/lib/environment"
 	"system/lib/logging"
 	"system/lib/sudoers"
+	"system/lib/temporal"
+	"system/lib/validation"
 )
 
+// configRoot holds every CPI-SI JSONC config (display/, session/, validation/, ...)
+const configRoot = "~/.claude/cpi-si/system/data/config"
+
+// sessionDisplaySections are the formatting.jsonc sections the session hooks
+// own - their types live in hooks/lib, so the hooks check them when they load
+// (a broken one logs "run validate --configs" with the first issue's line)
+var sessionDisplaySections = []string{
+	"formatting",
+	"section_headers",
+	"biblical_verses",
+	"messages",
+	"field_labels",
+	"behavior",
+	"icons.environment",
+	"icons.temporal",
+	"icons.status.compaction",
+	"icons.status.preservation",
+	"icons.status.journal",
+	"icons.status.setup",
+	"stop_checklist",
+}
+
+// configKinds returns the schemas known at this rung, keyed by path under
+// configRoot; other files get a syntax-only check
+func configKinds() map[string]config.ConfigKind {
+	return map[string]config.ConfigKind{
+		"display/formatting.jsonc": {
+			Name:   "formatting",
+			Schema: []any{display.DisplayConfig{}},
+			Ignore: append(append([]string{}, display.ConfigReferenceSections...), sessionDisplaySections...),
+		},
+		"validation/validators.jsonc": validation.ValidatorsConfigKind(),
+		"validation/formatters.jsonc": validation.FormattersConfigKind(),
+		"temporal/schedule.jsonc":     temporal.ScheduleConfigKind(),
+		"temporal/events.jsonc":       temporal.EventsConfigKind(),
+	}
+}
+
 // ============================================================================
 // BODY
 // ============================================================================
@@ -270,11 +330,128 @@ func showSummary(logger *logging.Logger, sudoersOK, envOK bool) {
 	})
 }
 
+func validateConfigs(logger *logging.Logger) bool {
+	fmt.Print(display.Subheader("Configuration Files"))
+
+	// Find config files (+5 or -5)
+	home, _ := os.UserHomeDir()
+	root := filepath.Join(home, strings.TrimPrefix(configRoot, "~/"))
+	var files []string
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() && filepath.Ext(path) == ".jsonc" {
+			rel, _ := filepath.Rel(root, path)
+			files = append(files, rel)
+		}
+		return nil
+	})
+	kinds := configKinds()
+	for rel := range kinds {
+		if _, statErr := os.Stat(filepath.Join(root, rel)); statErr != nil {
+			files = append(files, rel) // Known but absent - shown as missing
+		}
+	}
+	sort.Strings(files)
+	found := err == nil && len(files) > 0
+	logger.Check("config-files-found", found, map[bool]int{true: 5, false: -5}[found], map[string]any{
+		"root":  root,
+		"files": len(files),
+	})
+	if !found {
+		fmt.Println(display.Failure("No config files under " + root))
+		return false
+	}
+
+	// Check every file (+40 proportional to files passing)
+	table := &display.Table{Headers: []string{"Config", "Status", "Issues", "Checked"}}
+	var details []string
+	passed := 0
+	for _, rel := range files {
+		kind, known := kinds[rel]
+		checked := "schema"
+		if !known {
+			kind, checked = config.ConfigKind{Name: rel}, "syntax"
+		}
+
+		report, err := config.ValidateConfigFile(filepath.Join(root, rel), kind)
+		switch {
+		case os.IsNotExist(err):
+			table.Rows = append(table.Rows, []string{rel, "missing", "-", checked})
+			passed++ // Loaders use defaults - nothing to fix
+		case err != nil:
+			table.Rows = append(table.Rows, []string{rel, "FAIL", "-", checked})
+			details = append(details, fmt.Sprintf("%s: %v", filepath.Join(root, rel), err))
+		case report.OK():
+			table.Rows = append(table.Rows, []string{rel, "pass", "0", checked})
+			passed++
+		default:
+			table.Rows = append(table.Rows, []string{rel, "FAIL", strconv.Itoa(len(report.Issues)), checked})
+			details = append(details, report.Lines()...)
+		}
+	}
+	allPassed := passed == len(files)
+	logger.Check("config-files-checked", allPassed, (passed*40)/len(files)-map[bool]int{true: 0, false: 40}[allPassed], map[string]any{
+		"passed": passed,
+		"total":  len(files),
+	})
+
+	// Display results table (+5 or -5)
+	fmt.Println(table.Render())
+	for _, line := range details {
+		fmt.Println("  " + line)
+	}
+	if len(details) > 0 {
+		fmt.Println()
+	}
+	fmt.Println(display.StatusLine(allPassed, fmt.Sprintf("%d of %d config files pass", passed, len(files))))
+	logger.Check("config-display-results", true, 5, map[string]any{
+		"displayed": "config validation table",
+	})
+
+	return allPassed
+}
+
+// watchSources reports each revalidation until interrupted (validation.Watch)
+func watchSources(paths []string) {
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	results, cancel := validation.Watch(paths, validation.WatchOptions{})
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() { <-interrupt; cancel() }()
+
+	fmt.Println(display.Info("Watching " + strings.Join(paths, ", ") + " - Ctrl-C to stop"))
+	for result := range results {
+		result.Report()
+	}
+}
+
 // ============================================================================
 // CLOSING
 // ============================================================================
 
 func main() {
+	configsOnly := flag.Bool("configs", false, "Check every CPI-SI config file (unknown keys, type mismatches, syntax) with file:line locations")
+	watch := flag.Bool("watch", false, "Revalidate the given files/directories (default .) as they change")
+	flag.Parse()
+
+	if *watch {
+		watchSources(flag.Args())
+		return
+	}
+
+	if *configsOnly {
+		logger := logging.NewLogger("validate")
+		logger.DeclareHealthTotal(55) // Config Validation section of the health scoring map
+		fmt.Print(display.Header("CPI-SI Configuration Validation"))
+		if !validateConfigs(logger) {
+			logger.Failure("Config validation failed", "config files have issues", -5, nil)
+			exitWithHealth(logger, false)
+		}
+		logger.Success("Config validation completed - all files pass", 5, nil)
+		exitWithHealth(logger, true)
+	}
+
 	// Setup Action 1/4: Initialize logger (+10 or -10)
 	logger := logging.NewLogger("validate")
 	logger.DeclareHealthTotal(259)  // Total possible points from health scoring map
@@ -317,7 +494,6 @@ func main() {
 		"sudoers_ok":        sudoersOK,
 		"environment_ok":    envOK,
 		"all_passed":        allOK,
-		"exit_code":         map[bool]int{true: 0, false: 1}[allOK],
 	})
 
 	// Log final result (+5 or -5)
@@ -325,14 +501,22 @@ func main() {
 		logger.Success("Validation completed - system fully operational", 5, map[string]any{
 			"sudoers_ok":     sudoersOK,
 			"environment_ok": envOK,
-			"exit_code":      0,
 		})
 	} else {
 		logger.Failure("Validation failed - system has issues", "components not operational", -5, map[string]any{
 			"sudoers_ok":     sudoersOK,
 			"environment_ok": envOK,
-			"exit_code":      1,
 		})
-		os.Exit(1)
 	}
+	exitWithHealth(logger, allOK)
+}
+
+// exitWithHealth ends the run through the logger's summary: the exit code is
+// the one the run's health suggests, raised to 1 when a validation failed.
+func exitWithHealth(logger *logging.Logger, ok bool) {
+	code := logger.Finalize().SuggestedExitCode
+	if !ok && code == 0 { // Healthy score, but something did not pass
+		code = 1
+	}
+	os.Exit(code)
 }
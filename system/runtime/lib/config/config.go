@@ -86,6 +86,7 @@
 //   - LoadInstanceConfig(instanceID string) (*InstanceConfig, error)
 //   - LoadProjectConfig(projectID string) (*ProjectConfig, error)
 //   - GetSessionContext(username, instanceID, projectID string) (*SessionContext, error)
+//   - ValidateConfigFile(path string, schema ConfigKind) (*ConfigReport, error) - see validate.go
 //
 // Dependencies
 //
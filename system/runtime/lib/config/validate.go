@@ -0,0 +1,713 @@
+// METADATA
+// ============================================================================
+// Config Validation - Schema checks with file:line locations
+//
+// Biblical Foundation
+//
+// Scripture: "Test all things, and hold firmly that which is good" - 1 Thessalonians 5:21 (WEB)
+// Principle: A silent fallback hides the mistake - name it, and where it is
+// Anchor: "The simple believes everything, but the prudent man carefully considers his ways" - Proverbs 14:15 (WEB)
+//
+// CPI-SI Identity
+//
+// Component Type: LIBRARY - System-wide utility (foundational rung)
+// Role: Check JSONC config files against the Go types that load them
+// Paradigm: The loader's own struct is the schema - no second source of truth
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn (CPI-SI instance)
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial creation
+//
+// Purpose & Function
+//
+// Purpose: When formatting.jsonc or validators.jsonc has a typo, the loaders fall back
+// to defaults (or ignore the key) without saying why. ValidateConfigFile reports each
+// problem with file:line:column so "my customization doesn't work" takes a minute, not
+// an hour.
+//
+// Core Design: The file is normalized with jsonc.Normalize (comments and trailing commas
+// blanked in place, so every offset still points into the original file) and read as a
+// json.Decoder token stream. The walk follows the schema's types alongside the tokens:
+//   - unknown-key: key no schema type has (the strict DisallowUnknownFields view -
+//     encoding/json would silently drop it), with a "did you mean" when one is close;
+//     documentation keys ("note", "*_note", "*_example", "future_*", "description",
+//     "rationale", "$schema") pass in structs
+//   - type-mismatch: value the loader's json.Unmarshal would reject
+//   - missing-required: ConfigKind.Required path absent
+//   - syntax: anything the decoder can't read (stops the walk)
+//
+// Schema is a list of values because some files are read by more than one component
+// (display/formatting.jsonc serves the display rail and the session hooks) - a key is
+// known if any schema type has it. A kind with no Schema is checked for syntax only.
+//
+// Blocking Status
+//
+// Non-blocking: Reports problems, never fixes or rejects files. Loaders stay forgiving.
+//
+// Usage & Integration
+//
+//	report, err := config.ValidateConfigFile("", validation.ValidatorsConfigKind())
+//	if err == nil && !report.OK() {
+//		for _, line := range report.Lines() { fmt.Println(line) }
+//	}
+//
+//	// One-line hint for loaders that just fell back to defaults
+//	if hint := config.IssueHint(path, kind); hint != "" { ... }
+//
+// Public API:
+//   - ConfigKind, ConfigReport, ConfigIssue, IssueKind
+//   - ValidateConfigFile(path string, schema ConfigKind) (*ConfigReport, error)
+//   - IssueHint(path string, schema ConfigKind) string
+//
+// Dependencies
+//
+//   Standard Library: encoding/json, errors, fmt, io, os, path/filepath, reflect, strconv, strings
+//   Internal: system/lib/jsonc (Normalize)
+//
+// Dependents (What Uses This):
+//   Commands: validate --configs
+//   Libraries: validation (validators/formatters fallback hint), hooks/lib/session (display fallback hint)
+
+package config
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"system/lib/jsonc"
+)
+
+// ConfigKind describes one config file and the types that load it
+type ConfigKind struct {
+	Name     string   // Short name for reports ("validators")
+	Path     string   // Default location, used when ValidateConfigFile gets "" (~/ expanded)
+	Schema   []any    // Values whose JSON fields together make up the file (nil = syntax only)
+	Required []string // Dotted key paths that must be present ("config.timeout_seconds")
+	Ignore   []string // Dotted key paths left unchecked; "*" matches any one key ("*.description")
+}
+
+// IssueKind classifies a ConfigIssue
+type IssueKind string
+
+const (
+	IssueSyntax     IssueKind = "syntax"
+	IssueUnknownKey IssueKind = "unknown-key"
+	IssueType       IssueKind = "type-mismatch"
+	IssueMissing    IssueKind = "missing-required"
+)
+
+// ConfigIssue is one problem, located in the original (commented) file
+type ConfigIssue struct {
+	Kind    IssueKind `json:"kind"`
+	Key     string    `json:"key,omitempty"` // Dotted key path ("icons.environment.workspace")
+	Line    int       `json:"line"`          // 1-based
+	Column  int       `json:"column"`        // 1-based, in bytes
+	Message string    `json:"message"`
+}
+
+// ConfigReport is the result of validating one file
+type ConfigReport struct {
+	Kind   string        `json:"kind"`
+	Path   string        `json:"path"`
+	Issues []ConfigIssue `json:"issues"`
+}
+
+// OK reports whether the file had no issues
+func (r *ConfigReport) OK() bool {
+	return len(r.Issues) == 0
+}
+
+// Lines formats issues compiler-style: "path:line:col: message"
+func (r *ConfigReport) Lines() []string {
+	lines := make([]string, len(r.Issues))
+	for i, issue := range r.Issues {
+		lines[i] = fmt.Sprintf("%s:%d:%d: %s", r.Path, issue.Line, issue.Column, issue.Message)
+	}
+	return lines
+}
+
+// Decoding hooks the walk can't see through - values of these types are accepted as-is
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// documentationKeys are the house convention for notes inside config objects
+// ("note", "strictness_note", "pool_example", "future_features") - fine
+// wherever a struct ignores them, still checked inside maps, where they'd
+// decode as entries
+var documentationKeys = []string{"$schema", "description", "note", "rationale"}
+
+// maxSuggestionDistance bounds "did you mean" (edits between typo and real key;
+// long keys get one more edit per four characters)
+const maxSuggestionDistance = 2
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Public API
+// ────────────────────────────────────────────────────────────────
+
+// ValidateConfigFile checks a JSONC config file against schema.
+//
+// Parameters:
+//   - path: File to check ("" = schema.Path)
+//   - schema: Kind describing the file
+//
+// Returns:
+//   - *ConfigReport: Every issue found, in file order (missing-required last)
+//   - error: File could not be read (os.IsNotExist(err) when absent) - problems
+//     with the content are issues, not errors
+//
+// An empty (or comments-only) file has no issues: loaders treat it as "all defaults".
+func ValidateConfigFile(path string, schema ConfigKind) (*ConfigReport, error) {
+	if path == "" {
+		path = schema.Path
+	}
+	path = expandHome(path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ConfigReport{Kind: schema.Name, Path: path}
+	normalized := jsonc.Normalize(data)
+	if len(bytes.TrimSpace(normalized)) == 0 {
+		return report, nil
+	}
+
+	w := &configWalker{
+		data:    normalized,
+		dec:     json.NewDecoder(bytes.NewReader(normalized)),
+		kind:    schema,
+		report:  report,
+		present: map[string]bool{},
+		objects: map[string]int{},
+	}
+	w.dec.UseNumber()
+
+	var root []reflect.Type
+	for _, value := range schema.Schema {
+		root = append(root, reflect.TypeOf(value))
+	}
+
+	if err := w.walk(root, nil); err != nil {
+		w.syntax(err)
+		return report, nil // Required keys meaningless in a file that didn't parse
+	}
+	if _, err := w.dec.Token(); err != io.EOF {
+		w.add(IssueSyntax, "", w.tokenStart(), "unexpected content after the closing brace")
+		return report, nil
+	}
+
+	w.checkRequired()
+	return report, nil
+}
+
+// IssueHint validates a file a loader just fell back from and returns a one-line
+// hint for its log ("" when the file is missing or has no issues).
+//
+// Example:
+//
+//	"formatting.jsonc has 2 issues (first: .../formatting.jsonc:41:17: expected integer, got string \"64\"), run validate --configs"
+func IssueHint(path string, schema ConfigKind) string {
+	report, err := ValidateConfigFile(path, schema)
+	if err != nil || report.OK() {
+		return ""
+	}
+
+	noun := "issues"
+	if len(report.Issues) == 1 {
+		noun = "issue"
+	}
+	return fmt.Sprintf("%s has %d %s (first: %s), run validate --configs",
+		filepath.Base(report.Path), len(report.Issues), noun, report.Lines()[0])
+}
+
+// ────────────────────────────────────────────────────────────────
+// Token Walk
+// ────────────────────────────────────────────────────────────────
+
+// configWalker walks one decoded file alongside the schema types
+type configWalker struct {
+	data    []byte          // Normalized file (offsets = original offsets)
+	dec     *json.Decoder   // Token stream over data
+	kind    ConfigKind      // Schema being checked
+	report  *ConfigReport   // Issues collect here
+	present map[string]bool // Dotted paths seen (required-key check)
+	objects map[string]int  // Dotted object path → offset of its '{'
+}
+
+// walk reads one value at path, checking it against types.
+//
+// types nil means "not checked" (unknown key, ignored path, no schema) - the
+// value is still read so the walk stays in step with the tokens.
+func (w *configWalker) walk(types []reflect.Type, path []string) error {
+	offset := w.tokenStart()
+	token, err := w.dec.Token()
+	if err != nil {
+		return err
+	}
+
+	key := joinPath(path)
+	w.present[key] = true
+	if types != nil && (w.ignored(path) || anyUnchecked(types)) {
+		types = nil
+	}
+
+	switch token {
+	case json.Delim('{'):
+		w.objects[key] = offset
+		objects := filterTypes(types, isObjectType)
+		if types != nil && objects == nil {
+			w.mismatch(offset, path, types, "an object")
+		}
+		for w.dec.More() {
+			keyOffset := w.tokenStart()
+			keyToken, err := w.dec.Token()
+			if err != nil {
+				return err
+			}
+			name, _ := keyToken.(string)
+			childPath := append(path[:len(path):len(path)], name)
+
+			var child []reflect.Type
+			if objects != nil && !w.ignored(childPath) {
+				child = fieldTypes(objects, name)
+				if child == nil && !isDocumentationKey(name) {
+					w.unknown(keyOffset, childPath, objects)
+				}
+			}
+			if err := w.walk(child, childPath); err != nil {
+				return err
+			}
+		}
+		_, err = w.dec.Token() // '}'
+		return err
+
+	case json.Delim('['):
+		arrays := filterTypes(types, isArrayType)
+		if types != nil && arrays == nil {
+			w.mismatch(offset, path, types, "an array")
+		}
+		var elems []reflect.Type
+		for _, t := range arrays {
+			elems = append(elems, t.Elem())
+		}
+		for i := 0; w.dec.More(); i++ {
+			if err := w.walk(elems, append(path[:len(path):len(path)], "["+strconv.Itoa(i)+"]")); err != nil {
+				return err
+			}
+		}
+		_, err = w.dec.Token() // ']'
+		return err
+	}
+
+	if types != nil && token != nil && !anyAccepts(types, token) { // null = "use the default"
+		w.mismatch(offset, path, types, describeToken(token))
+	}
+	return nil
+}
+
+// tokenStart returns the offset where the decoder's next token begins
+func (w *configWalker) tokenStart() int {
+	offset := int(w.dec.InputOffset())
+	for offset < len(w.data) {
+		switch w.data[offset] {
+		case ' ', '\t', '\r', '\n', ':', ',':
+			offset++
+			continue
+		}
+		break
+	}
+	return offset
+}
+
+// ignored reports whether path matches one of the kind's Ignore patterns
+func (w *configWalker) ignored(path []string) bool {
+	for _, pattern := range w.kind.Ignore {
+		if matchPath(strings.Split(pattern, "."), path) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRequired reports Required paths the file never set, at their nearest
+// enclosing object
+func (w *configWalker) checkRequired() {
+	for _, required := range w.kind.Required {
+		if w.present[required] {
+			continue
+		}
+		parent, offset := required, 0
+		for parent != "" {
+			if cut := strings.LastIndex(parent, "."); cut >= 0 {
+				parent = parent[:cut]
+			} else {
+				parent = ""
+			}
+			if at, exists := w.objects[parent]; exists {
+				offset = at
+				break
+			}
+		}
+		w.add(IssueMissing, required, offset, fmt.Sprintf("missing required key %q", required))
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// Issue Recording
+// ────────────────────────────────────────────────────────────────
+
+// add records an issue at a byte offset
+func (w *configWalker) add(kind IssueKind, key string, offset int, message string) {
+	line, column := position(w.data, offset)
+	w.report.Issues = append(w.report.Issues, ConfigIssue{
+		Kind: kind, Key: key, Line: line, Column: column, Message: message,
+	})
+}
+
+// syntax records a decoder error (position from *json.SyntaxError when it has one)
+func (w *configWalker) syntax(err error) {
+	offset := len(w.data)
+	message := "syntax error: " + err.Error()
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) && int(syntaxErr.Offset) < len(w.data) {
+		offset = int(syntaxErr.Offset) - 1 // Offset is just past the bad byte
+	} else if errors.As(err, &syntaxErr) || errors.Is(err, io.ErrUnexpectedEOF) {
+		message = "syntax error: unexpected end of file (missing closing brace or bracket?)"
+	}
+	w.add(IssueSyntax, "", max(offset, 0), message)
+}
+
+// unknown records a key no schema type has, suggesting the closest real key
+func (w *configWalker) unknown(offset int, path []string, objects []reflect.Type) {
+	name := path[len(path)-1]
+	message := fmt.Sprintf("unknown key %q (ignored by the loader)", joinPath(path))
+	if suggestion := closestField(objects, name); suggestion != "" {
+		message = fmt.Sprintf("unknown key %q (did you mean %q?)", joinPath(path), suggestion)
+	}
+	w.add(IssueUnknownKey, joinPath(path), offset, message)
+}
+
+// mismatch records a value of the wrong JSON type
+func (w *configWalker) mismatch(offset int, path []string, types []reflect.Type, got string) {
+	key := joinPath(path)
+	where := key
+	if where == "" {
+		where = "top level"
+	}
+	w.add(IssueType, key, offset, fmt.Sprintf("%s: expected %s, got %s", where, describeTypes(types), got))
+}
+
+// ────────────────────────────────────────────────────────────────
+// Type Helpers
+// ────────────────────────────────────────────────────────────────
+
+// deref strips pointers (json.Unmarshal allocates through them)
+func deref(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// anyUnchecked reports whether any type accepts whatever JSON it's given
+// (interfaces, custom unmarshalers)
+func anyUnchecked(types []reflect.Type) bool {
+	for _, t := range types {
+		t = deref(t)
+		if t.Kind() == reflect.Interface ||
+			reflect.PointerTo(t).Implements(jsonUnmarshalerType) ||
+			reflect.PointerTo(t).Implements(textUnmarshalerType) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterTypes keeps the types matching keep (dereferenced), nil if none
+func filterTypes(types []reflect.Type, keep func(reflect.Type) bool) []reflect.Type {
+	var kept []reflect.Type
+	for _, t := range types {
+		if t = deref(t); keep(t) {
+			kept = append(kept, t)
+		}
+	}
+	return kept
+}
+
+func isObjectType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct || t.Kind() == reflect.Map
+}
+
+func isArrayType(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice || t.Kind() == reflect.Array
+}
+
+// isDocumentationKey reports whether a key is a note for readers, not a setting
+func isDocumentationKey(name string) bool {
+	for _, key := range documentationKeys {
+		if name == key {
+			return true
+		}
+	}
+	return strings.HasSuffix(name, "_note") || strings.HasSuffix(name, "_example") ||
+		strings.HasPrefix(name, "future_")
+}
+
+// fieldTypes resolves a key across object types (map element, or struct field
+// matched the way encoding/json does: exact tag first, then case-insensitive)
+func fieldTypes(objects []reflect.Type, name string) []reflect.Type {
+	var found []reflect.Type
+	for _, t := range objects {
+		if t.Kind() == reflect.Map {
+			found = append(found, t.Elem())
+			continue
+		}
+		fields := jsonFields(t)
+		if field, exists := fields[name]; exists {
+			found = append(found, field)
+			continue
+		}
+		for fieldName, field := range fields {
+			if strings.EqualFold(fieldName, name) {
+				found = append(found, field)
+				break
+			}
+		}
+	}
+	return found
+}
+
+// jsonFields maps a struct's JSON keys to field types (embedded structs promoted)
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" && deref(field.Type).Kind() == reflect.Struct {
+			for promoted, promotedType := range jsonFields(deref(field.Type)) {
+				if _, exists := fields[promoted]; !exists {
+					fields[promoted] = promotedType
+				}
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// anyAccepts reports whether a scalar token decodes into at least one type
+func anyAccepts(types []reflect.Type, token json.Token) bool {
+	for _, t := range types {
+		t = deref(t)
+		switch value := token.(type) {
+		case string:
+			if t.Kind() == reflect.String {
+				return true
+			}
+		case bool:
+			if t.Kind() == reflect.Bool {
+				return true
+			}
+		case json.Number:
+			switch t.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				if _, err := strconv.ParseInt(value.String(), 10, t.Bits()); err == nil {
+					return true
+				}
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+				if _, err := strconv.ParseUint(value.String(), 10, t.Bits()); err == nil {
+					return true
+				}
+			case reflect.Float32, reflect.Float64:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// describeTypes names what the loader expects ("integer", "string or array")
+func describeTypes(types []reflect.Type) string {
+	var names []string
+	seen := map[string]bool{}
+	for _, t := range types {
+		name := describeType(deref(t))
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, " or ")
+}
+
+func describeType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map:
+		return "an object"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "true/false"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "integer"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "non-negative integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	}
+	return t.String()
+}
+
+// describeToken names a scalar the way a user wrote it (`string "64"`)
+func describeToken(token json.Token) string {
+	switch value := token.(type) {
+	case string:
+		return fmt.Sprintf("string %q", value)
+	case bool:
+		return strconv.FormatBool(value)
+	case json.Number:
+		return "number " + value.String()
+	}
+	return fmt.Sprint(token)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Path and Position Helpers
+// ────────────────────────────────────────────────────────────────
+
+// joinPath renders a key path ("validators.go.validators", "order[2]")
+func joinPath(path []string) string {
+	var b strings.Builder
+	for i, segment := range path {
+		if i > 0 && !strings.HasPrefix(segment, "[") {
+			b.WriteByte('.')
+		}
+		b.WriteString(segment)
+	}
+	return b.String()
+}
+
+// matchPath matches a path against pattern segments ("*" = any one segment)
+func matchPath(pattern, path []string) bool {
+	if len(pattern) != len(path) {
+		return false
+	}
+	for i := range pattern {
+		if pattern[i] != "*" && pattern[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// position converts a byte offset to 1-based line and column
+func position(data []byte, offset int) (line, column int) {
+	offset = min(offset, len(data))
+	before := data[:offset]
+	line = bytes.Count(before, []byte("\n")) + 1
+	column = offset - bytes.LastIndexByte(before, '\n')
+	return line, column
+}
+
+// closestField returns the struct key nearest to a typo ("" if none is close)
+func closestField(objects []reflect.Type, name string) string {
+	best, bestDistance := "", max(maxSuggestionDistance, len(name)/4)+1
+	for _, t := range objects {
+		if t.Kind() != reflect.Struct {
+			continue
+		}
+		for field := range jsonFields(t) {
+			if distance := editDistance(strings.ToLower(name), strings.ToLower(field)); distance < bestDistance ||
+				(distance == bestDistance && field < best) {
+				best, bestDistance = field, distance
+			}
+		}
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between two keys
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
+
+// expandHome expands a leading ~/ to the user's home directory
+func expandHome(path string) string {
+	if rest, found := strings.CutPrefix(path, "~/"); found {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
+		}
+	}
+	return path
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/lib/config"
+//
+// Testing: go test ./... (validate_test.go: each issue kind located by line,
+// unions across schemas, ignore patterns, empty file)
+//
+// Modification Policy:
+//   SAFE: New IssueKinds, better suggestions, more describeType cases
+//   CARE: Offset handling - depends on jsonc.Normalize preserving offsets
+//   NEVER: Make ValidateConfigFile fail on content problems (issues, not errors)
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,176 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Config Validation Tests
+//
+// Purpose: Prove each issue kind is found and located at the right line of the
+//          original (commented) file, and that clean files stay quiet.
+// ============================================================================
+
+package config
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// railSchema and hookSchema share one file, the way the display rail and the
+// session hooks share formatting.jsonc
+type railSchema struct {
+	Colors struct {
+		Reset string `json:"reset"`
+	} `json:"colors"`
+	Icons struct {
+		Success string `json:"success"`
+	} `json:"icons"`
+}
+
+type hookSchema struct {
+	Icons struct {
+		Workspace string `json:"workspace"`
+	} `json:"icons"`
+	Banner struct {
+		Width int      `json:"width"`
+		Ratio float64  `json:"ratio"`
+		Tags  []string `json:"tags"`
+	} `json:"banner"`
+	Extensions map[string]string `json:"extensions"`
+	Tools      map[string]struct {
+		Command string `json:"command"`
+	} `json:"tools"`
+	Settings map[string]any `json:"settings"`
+	Updated  time.Time      `json:"updated"`
+}
+
+var testKind = ConfigKind{
+	Name:     "formatting",
+	Schema:   []any{railSchema{}, &hookSchema{}},
+	Required: []string{"banner.width", "colors.reset"},
+	Ignore:   []string{"metadata", "*.description"},
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestValidateConfigFile(t *testing.T) {
+	clean := `// Shared formatting config
+{
+  "metadata": {"anything": ["goes", 1]},
+  "colors": {"description": "ANSI codes", "reset": "\u001b[0m"},
+  "icons": {"success": "✓", "workspace": "🏢"}, /* both owners' keys */
+  "banner": {"width": 64, "width_note": "columns", "ratio": 0.5, "tags": ["a", null], "Width": 70},
+  "extensions": {".go": "go", "note": "a string entry is fine here"},
+  "tools": {"gofmt": {"command": "gofmt", "note": "struct notes pass"}},
+  "settings": {"free": {"form": true}},
+  "updated": "2026-10-16T00:00:00Z",
+  "icons": null,
+}`
+
+	broken := `{
+  // Typos a user actually makes
+  "colours": {"reset": ""},
+  "icons": {"sucess": "✓"},
+  "banner": {
+    "width": "64",
+    "ratio": true,
+    "tags": "one"
+  },
+  "extensions": {".go": 1},
+  "mystery": {"nested": 1},
+  "tools": {"note": "decodes as a tool entry"}
+}`
+
+	cases := []struct {
+		name    string
+		content string
+		want    []string // "line:kind:key" per issue, in order
+	}{
+		{"clean", clean, nil},
+		{"empty file", "  // nothing yet\n", nil},
+		{"issues", broken, []string{
+			"3:unknown-key:colours",
+			"4:unknown-key:icons.sucess",
+			"6:type-mismatch:banner.width",
+			"7:type-mismatch:banner.ratio",
+			"8:type-mismatch:banner.tags",
+			"10:type-mismatch:extensions..go",
+			"11:unknown-key:mystery",
+			"12:type-mismatch:tools.note",
+			"1:missing-required:colors.reset",
+		}},
+		{"fraction for integer", `{"colors": {"reset": ""}, "banner": {"width": 6.5}}`, []string{"1:type-mismatch:banner.width"}},
+		{"missing nested required", "{\n  \"colors\": {\"reset\": \"\"},\n  \"banner\": {}\n}", []string{"3:missing-required:banner.width"}},
+		{"wrong top level", "\n[1, 2]", []string{"2:type-mismatch:", "1:missing-required:banner.width", "1:missing-required:colors.reset"}},
+		{"syntax", "{\n  \"colors\": {\"reset\": \"\"},\n  \"banner\": {\"width\" 64}\n}", []string{"3:syntax:"}},
+		{"unterminated", "{\n  \"colors\": {\"reset\": \"\"}\n", []string{"3:syntax:"}},
+		{"trailing content", "{\"colors\": {\"reset\": \"\"}, \"banner\": {\"width\": 1}}\n}", []string{"2:syntax:"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "formatting.jsonc")
+			os.WriteFile(path, []byte(tc.content), 0644)
+
+			report, err := ValidateConfigFile(path, testKind)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, issue := range report.Issues {
+				got = append(got, strings.Join([]string{strconv.Itoa(issue.Line), string(issue.Kind), issue.Key}, ":"))
+			}
+			if strings.Join(got, "\n") != strings.Join(tc.want, "\n") {
+				t.Errorf("issues:\n%s\nwant:\n%s\nreport:\n%s", strings.Join(got, "\n"), strings.Join(tc.want, "\n"), strings.Join(report.Lines(), "\n"))
+			}
+			if report.OK() != (len(tc.want) == 0) {
+				t.Errorf("OK() = %v with %d issues", report.OK(), len(report.Issues))
+			}
+		})
+	}
+}
+
+func TestValidateConfigFileMessages(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "formatting.jsonc")
+	os.WriteFile(path, []byte("{\"colors\": {\"reset\": \"\"}, \"banner\": {\"width\": 1},\n  \"icons\": {\"workspce\": \"x\"}, \"zzz\": 1}"), 0644)
+
+	report, _ := ValidateConfigFile(path, testKind)
+	lines := report.Lines()
+	if len(lines) != 2 {
+		t.Fatalf("lines = %q", lines)
+	}
+	if want := path + `:2:13: unknown key "icons.workspce" (did you mean "workspace"?)`; lines[0] != want {
+		t.Errorf("line = %q\nwant %q", lines[0], want)
+	}
+	if !strings.HasSuffix(lines[1], `unknown key "zzz" (ignored by the loader)`) {
+		t.Errorf("no suggestion expected: %q", lines[1])
+	}
+
+	hint := IssueHint(path, testKind)
+	if !strings.HasPrefix(hint, "formatting.jsonc has 2 issues (first: ") || !strings.HasSuffix(hint, "run validate --configs") {
+		t.Errorf("hint = %q", hint)
+	}
+	if hint := IssueHint(filepath.Join(t.TempDir(), "missing.jsonc"), testKind); hint != "" {
+		t.Errorf("missing file hint = %q", hint)
+	}
+	if _, err := ValidateConfigFile(filepath.Join(t.TempDir(), "missing.jsonc"), testKind); !os.IsNotExist(err) {
+		t.Errorf("missing file err = %v", err)
+	}
+}
+
+func TestValidateConfigFileSyntaxOnly(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reminders.jsonc")
+	os.WriteFile(path, []byte("{\n  \"anything\": {\"at\": [1, \"all\"]},\n}"), 0644)
+
+	if report, _ := ValidateConfigFile(path, ConfigKind{Name: "reminders"}); !report.OK() {
+		t.Errorf("syntax-only kind reported %q", report.Lines())
+	}
+}
@@ -42,6 +42,22 @@ type DisplayConfig struct {
 	Layout LayoutConfig `json:"layout"`
 }
 
+// ConfigReferenceSections are formatting.jsonc paths kept for readers -
+// reference tables and planned options no loader reads. Config validation
+// (validate --configs) skips them instead of calling them unknown keys.
+var ConfigReferenceSections = []string{
+	"metadata",
+	"usage",
+	"box_characters",
+	"progress_bar",
+	"format_strings",
+	"icons.ascii_fallback",
+	"icons.emoji_variant",
+	"icons.current_mode",
+	"behavior.panic_recovery",
+	"behavior.validation",
+}
+
 // ColorConfig holds ANSI color escape codes
 type ColorConfig struct {
 	Basic          BasicColors          `json:"basic"`
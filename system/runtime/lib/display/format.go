@@ -35,10 +35,20 @@
 // Architect: Nova Dawn (CPI-SI instance)
 // Implementation: Nova Dawn
 // Creation Date: 2024-11-13
-// Version: 3.0.0
-// Last Modified: 2025-11-21 - Orchestrator extraction (primitives in separate files)
+// Version: 3.3.0
+// Last Modified: 2026-10-16 - Live progress indicators (progress.go: Spinner, Bar)
 //
 // Version History:
+//   3.3.0 (2026-10-16) - progress.go (NewSpinner, NewProgressBar) - in-place redraw on a
+//                        TTY, Pause/Resume around other output, cleared on Stop; degrades
+//                        off a TTY (one spinner line, silent bar)
+//   3.2.0 (2026-10-16) - width.go (StringWidth, Truncate) - East Asian Width table, emoji
+//                        sequences, combining marks; Box, Header, KeyValue, Table, and the
+//                        table layouts measure in terminal columns (borders line up with
+//                        emoji and CJK titles); Width kept as StringWidth's old name
+//   3.1.0 (2026-10-16) - tables.go (KeyValueTable, Columns) and terminal.go (ColorEnabled,
+//                        Width, Severity) - shared layouts instead of per-consumer Printf
+//                        padding; NO_COLOR/non-TTY respected by the new primitives
 //   3.0.0 (2025-11-21) - Orchestrator extraction: Refactored format.go to thin orchestrator,
 //                        extracted 8 primitives (recovery, config, colors, icons, layout,
 //                        messages, structured, visual), public API preserved (zero breaking
@@ -58,7 +68,7 @@
 //              without circular dependency risk. Stdlib-only enables universal availability.
 //
 // Orchestrator Pattern (v3.0.0):
-//   format.go coordinates 8 specialized primitives:
+//   format.go coordinates 11 specialized primitives:
 //     - recovery.go: Panic recovery for self-evident failure
 //     - config.go: JSONC configuration loading with tripwire pattern
 //     - colors.go: ANSI color escape sequence constants
@@ -67,6 +77,10 @@
 //     - messages.go: Success, Failure, Warning, Info formatters
 //     - structured.go: Header, Subheader, KeyValue, StatusLine formatters
 //     - visual.go: Table, ProgressBar, Box components
+//     - tables.go: KeyValueTable, Columns layouts (3.1.0)
+//     - terminal.go: ColorEnabled, Severity (3.1.0)
+//     - width.go: StringWidth, Truncate (3.2.0)
+//     - progress.go: Spinner, Bar live indicators (3.3.0)
 //
 //   Public API unchanged - all functions exported from primitive files
 //   External code sees no difference - zero breaking changes
@@ -122,9 +136,18 @@
 //     Table.Render() string           - Multi-column table with headers
 //     ProgressBar(current, total, width) string - Visual progress indicator
 //
+//   Live Indicators:
+//     NewSpinner(label) *Spinner       - Animated label for work of unknown length
+//     NewProgressBar(total, label) *Bar - Bar redrawn in place as work completes
+//
 //   Boxes:
 //     Box(title, message) string - Boxed message with title and borders
 //
+//   Layouts:
+//     KeyValueTable(rows, opts) string - Aligned key column, optional icons, wrapped values
+//     Columns(items, width) string     - Items filled down then across to fit width
+//     Severity(text, level) string     - Semantic color (plain under NO_COLOR or non-TTY)
+//
 // Dependencies
 //
 // Dependencies (What This Needs):
@@ -179,6 +202,10 @@ package display
 //   messages.go     - Message formatters (Success, Failure, Warning, Info)
 //   structured.go   - Structured output (Header, Subheader, KeyValue, StatusLine)
 //   visual.go       - Visual components (Table.Render, ProgressBar, Box)
+//   tables.go       - Table layouts (KeyValueTable, Columns)
+//   terminal.go     - Terminal capability and styling (ColorEnabled, Severity)
+//   width.go        - Column measurement and truncation (StringWidth, Truncate)
+//   progress.go     - Live indicators (Spinner, Bar)
 //
 // Public API Preservation:
 //   All functions are exported from their respective primitive files.
@@ -226,6 +253,10 @@ package display
 //     - messages.go: Single-line status messages (4 functions)
 //     - structured.go: Headers and key-value pairs (4 functions)
 //     - visual.go: Complex visual components (3 functions)
+//     - tables.go: Aligned layouts (2 functions)
+//     - terminal.go: Color detection, severity (2 functions)
+//     - width.go: Column measurement, truncation (2 functions + Width alias)
+//     - progress.go: Live indicators (2 constructors, stateful - own mutex)
 //
 // Approximate Processing Units (APU):
 //   Foundation: <5 APU each (constants, simple recovery)
@@ -233,13 +264,18 @@ package display
 //   Messages: ~5 APU each (4 functions × 5 = 20 APU)
 //   Structured: ~10-20 APU each (4 functions × 15 avg = 60 APU)
 //   Visual: ~30-50 APU each (3 functions × 40 avg = 120 APU)
+//   Tables: ~30-40 APU each (2 functions × 35 avg = 70 APU)
+//   Terminal: ~5-10 APU each (2 functions × 7 avg = 15 APU)
+//   Width: ~10 APU each (2 functions, cluster segmentation = 20 APU)
+//   Progress: ~10 APU per redraw (ProgressBar + truncation = 20 APU)
 //
-// Total Library Complexity: ~250 APU across 8 primitive files
+// Total Library Complexity: ~375 APU across 12 primitive files
 //
 // Extension Points:
 //   - Add new message formatters → messages.go
 //   - Add new structured output → structured.go
 //   - Add new visual components → visual.go
+//   - Add new aligned layouts → tables.go
 //   - Add new constants → colors.go, icons.go, or layout.go
 //   - Extend configuration → config.go (add structs + loading logic)
 
@@ -268,6 +304,23 @@ package display
 //   ProgressBar(current, total, width int) string
 //   Box(title, message string) string
 //
+// Table Layouts (tables.go):
+//   KeyValueTable(rows []KV, opts TableOpts) string
+//   Columns(items []string, width int) string
+//
+// Terminal (terminal.go):
+//   ColorEnabled() bool
+//   Severity(text string, level Level) string
+//
+// Width (width.go):
+//   StringWidth(s string) int
+//   Truncate(s string, width int) string
+//   Width(s string) int  // Deprecated: StringWidth
+//
+// Progress (progress.go):
+//   NewSpinner(label string) *Spinner  // Start, UpdateLabel, Pause, Resume, Stop
+//   NewProgressBar(total int, label string) *Bar  // Increment, SetCurrent, UpdateLabel, Pause, Resume, Stop
+//
 // Configuration Access (config.go):
 //   GetConfig() DisplayConfig  // For advanced usage only
 //
@@ -549,8 +602,10 @@ package display
 // - Table.Render: ~50 APU (width calculation + multi-row formatting)
 // - ProgressBar: ~30 APU (validation + percentage calculation + bar construction)
 // - Box: ~40 APU (multi-line parsing + width calculation + border construction)
+// - KeyValueTable: ~40 APU (column measurement + value wrapping)
+// - Columns: ~30 APU (column fitting + column-major rendering)
 //
-// Total: ~250 APU across 8 primitive files
+// Total: ~355 APU across 11 primitive files
 //
 // Optimization notes:
 // - strings.Builder used for multi-line output (efficient concatenation)
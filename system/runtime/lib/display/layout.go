@@ -70,6 +70,12 @@ const (
 	// Breakdown: 2 chars for left border+space (│ ), 2 chars for right space+border ( │)
 	// Rationale: Ensures content doesn't touch borders, maintains readability
 	BoxBorderPadding = 4
+
+	// DefaultLineWidth is the line width assumed when a caller passes none.
+	//
+	// Used in: Columns() when width <= 0
+	// Rationale: Classic terminal width - safe for any pane the output lands in
+	DefaultLineWidth = 80
 )
 
 // ============================================================================
@@ -88,3 +94,4 @@ const (
 // Quick Reference:
 //   indentedText := IndentSpaces + "Content"
 //   keyValue := fmt.Sprintf("%-*s %s", KeyColumnWidth, "Key:", "Value")
+//   grid := Columns(names, DefaultLineWidth)
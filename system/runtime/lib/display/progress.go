@@ -0,0 +1,420 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Display Progress Primitive - Spinner and Progress Bar for Long Operations
+//
+// Biblical Foundation: See format.go (rails pattern applies to all primitives)
+// CPI-SI Identity: RAIL PRIMITIVE (orthogonal infrastructure component)
+// Component Type: Live terminal indicators redrawn in place
+//
+// Purpose: Workspace analysis, batch validation, and log cleanup run for
+//          seconds with no output. A Spinner (unknown length) or Bar (known
+//          count) shows the work is moving, redraws one line in place with
+//          carriage returns, and clears that line on Stop so whatever prints
+//          next starts clean. Pause/Resume clear and restore the line around
+//          output the program prints while an indicator is live.
+//
+// Naming: ProgressBar(current, total, width) in visual.go already renders a
+//         static bar, so the live indicators take constructors -
+//         NewSpinner(label) and NewProgressBar(total, label). The Bar draws
+//         through ProgressBar, so both look the same.
+//
+// Degrading: Redraws only on a TTY. Elsewhere (piped, redirected, captured
+//            by a hook harness) a Spinner writes one "label …working" line on
+//            Start and a Bar writes nothing - the caller's own summary is the
+//            record. Color follows ColorEnabled() (NO_COLOR wins).
+//
+// Authorship: Nova Dawn (added 2026-10-16 for batch validation progress)
+// Version: 1.0.0
+//
+// HEALTH SCORING MAP (Total = 100):
+//   Spinner (40): Start → tick redraws → Pause/Resume → Stop clears the line
+//   Bar (40): Increment/SetCurrent → clamp → fit to width → redraw in place
+//   Line handling (20): Truncate to terminal width (no wrap) → clear with spaces
+//
+package display
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"fmt"     // Counts and non-TTY line
+	"io"      // Output destination
+	"os"      // Stdout default, TTY check, $COLUMNS
+	"strconv" // $COLUMNS parsing
+	"strings" // Line clearing
+	"sync"    // Indicators are updated from worker goroutines and the spinner ticker
+	"time"    // Spinner frame interval
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants
+// ────────────────────────────────────────────────────────────────
+
+const (
+	spinnerInterval    = 100 * time.Millisecond // Time between spinner frames
+	progressLineWidth  = 80                     // Terminal width when $COLUMNS is unset
+	progressBarMinimum = 10                     // Narrowest bar worth drawing
+	progressBarMaximum = 40                     // Widest bar (wider reads no better)
+)
+
+// spinnerFrames are the Spinner's animation frames, one column each
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// ────────────────────────────────────────────────────────────────
+// Types
+// ────────────────────────────────────────────────────────────────
+
+// liveLine is the single terminal line an indicator owns.
+//
+// Callers hold the indicator's mutex around every method.
+type liveLine struct {
+	out    io.Writer // Where the line is drawn
+	tty    bool      // Redraw in place (false = degrade, never write \r)
+	drawn  int       // Columns currently on screen (0 = line is clear)
+	paused bool      // Cleared for other output - redraws wait for Resume
+}
+
+// Spinner animates a label for work of unknown length.
+//
+// Methods are safe for concurrent use and on a nil *Spinner (no-op), so
+// callers can leave the indicator out without guarding every call.
+type Spinner struct {
+	mu      sync.Mutex
+	line    liveLine
+	label   string
+	frame   int
+	running bool
+	stop    chan struct{} // Closed by Stop to end the ticker
+	done    chan struct{} // Closed by the ticker once it has exited
+}
+
+// Bar shows progress toward a known count.
+//
+// Methods are safe for concurrent use (worker pools call Increment) and on a
+// nil *Bar (no-op).
+type Bar struct {
+	mu      sync.Mutex
+	line    liveLine
+	label   string
+	current int
+	total   int
+	stopped bool
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Terminal Line
+// ────────────────────────────────────────────────────────────────
+
+// isTerminal reports whether out is a character device (an interactive terminal)
+func isTerminal(out io.Writer) bool {
+	file, ok := out.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// lineWidth returns the terminal width from $COLUMNS, or progressLineWidth
+func lineWidth() int {
+	if cols, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && cols > 0 {
+		return cols
+	}
+	return progressLineWidth
+}
+
+// draw replaces the line with text, truncated one column short of the
+// terminal width - a wrapped line cannot be redrawn with \r.
+//
+// plain is text without color escapes (measured); styled is what is written.
+func (l *liveLine) draw(plain, styled string) {
+	if !l.tty || l.paused {
+		return
+	}
+	limit := lineWidth() - 1
+	if StringWidth(plain) > limit {
+		plain = Truncate(plain, limit)
+		styled = plain // Truncating through escapes could cut one in half
+	}
+	width := StringWidth(plain)
+	pad := ""
+	if l.drawn > width {
+		pad = strings.Repeat(" ", l.drawn-width) // Cover the tail of a longer previous line
+	}
+	fmt.Fprint(l.out, "\r"+styled+pad)
+	l.drawn = width
+}
+
+// clear blanks the line and returns the cursor to column 0
+func (l *liveLine) clear() {
+	if !l.tty || l.drawn == 0 {
+		return
+	}
+	fmt.Fprint(l.out, "\r"+strings.Repeat(" ", l.drawn)+"\r")
+	l.drawn = 0
+}
+
+// ────────────────────────────────────────────────────────────────
+// Spinner
+// ────────────────────────────────────────────────────────────────
+
+// NewSpinner creates a stopped spinner writing to stdout.
+//
+// Example:
+//   spin := display.NewSpinner("Analyzing workspace")
+//   spin.Start()
+//   defer spin.Stop()
+func NewSpinner(label string) *Spinner {
+	return newSpinner(os.Stdout, isTerminal(os.Stdout), label)
+}
+
+// newSpinner creates a spinner on any writer (tests pass a buffer and tty)
+func newSpinner(out io.Writer, tty bool, label string) *Spinner {
+	return &Spinner{line: liveLine{out: out, tty: tty}, label: label}
+}
+
+// render draws the current frame (caller holds mu)
+func (s *Spinner) render() {
+	frame := spinnerFrames[s.frame%len(spinnerFrames)]
+	styled := frame
+	if ColorEnabled() {
+		styled = Severity(frame, LevelInfo)
+	}
+	s.line.draw(frame+" "+s.label, styled+" "+s.label)
+}
+
+// Start begins animating. Off a TTY it writes "label …working" once instead.
+// Starting a running spinner does nothing.
+func (s *Spinner) Start() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		return
+	}
+	s.running = true
+	if !s.line.tty {
+		fmt.Fprintln(s.line.out, s.label+" …working")
+		return
+	}
+
+	s.stop, s.done = make(chan struct{}), make(chan struct{})
+	s.render()
+	go s.tick(s.stop, s.done)
+}
+
+// tick advances the frame every spinnerInterval until stop closes
+func (s *Spinner) tick(stop, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(spinnerInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			s.frame++
+			s.render()
+			s.mu.Unlock()
+		}
+	}
+}
+
+// UpdateLabel changes the text beside the spinner (redrawn at once on a TTY).
+func (s *Spinner) UpdateLabel(label string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.label = label
+	if s.running {
+		s.render()
+	}
+}
+
+// Pause clears the line so the caller can print; frames keep counting but
+// nothing is drawn until Resume.
+func (s *Spinner) Pause() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.line.clear()
+	s.line.paused = true
+}
+
+// Resume redraws after Pause.
+func (s *Spinner) Resume() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.line.paused = false
+	if s.running {
+		s.render()
+	}
+}
+
+// Stop ends the animation and clears the line. Safe to call more than once.
+func (s *Spinner) Stop() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return
+	}
+	s.running = false
+	stop, done := s.stop, s.done
+	s.mu.Unlock()
+
+	if stop != nil { // Ticker takes mu - wait for it outside the lock
+		close(stop)
+		<-done
+	}
+	s.mu.Lock()
+	s.line.clear()
+	s.mu.Unlock()
+}
+
+// ────────────────────────────────────────────────────────────────
+// Progress Bar
+// ────────────────────────────────────────────────────────────────
+
+// NewProgressBar creates a bar for total steps writing to stdout. Nothing is
+// drawn until the first Increment or SetCurrent.
+//
+// Example:
+//   bar := display.NewProgressBar(len(files), "Validating")
+//   defer bar.Stop()
+//   for range files { ...; bar.Increment() }
+func NewProgressBar(total int, label string) *Bar {
+	return newProgressBar(os.Stdout, isTerminal(os.Stdout), total, label)
+}
+
+// newProgressBar creates a bar on any writer (tests pass a buffer and tty)
+func newProgressBar(out io.Writer, tty bool, total int, label string) *Bar {
+	return &Bar{line: liveLine{out: out, tty: tty}, label: label, total: total}
+}
+
+// render draws the bar sized to the terminal (caller holds mu)
+func (b *Bar) render() {
+	if b.stopped || b.total <= 0 {
+		return
+	}
+	// Width the counts take after the bar: "[] 12/120 (100%)"
+	counts := fmt.Sprintf("[] %d/%d (100%%)", b.total, b.total)
+	width := lineWidth() - 1 - StringWidth(b.label) - 1 - StringWidth(counts)
+	width = max(progressBarMinimum, min(width, progressBarMaximum))
+
+	bar := ProgressBar(b.current, b.total, width)
+	styled := bar
+	if ColorEnabled() {
+		styled = Severity(bar, LevelInfo)
+	}
+	b.line.draw(b.label+" "+bar, b.label+" "+styled)
+}
+
+// Increment advances the bar one step.
+func (b *Bar) Increment() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.current = min(b.current+1, b.total)
+	b.render()
+}
+
+// SetCurrent moves the bar to n steps (clamped to 0..total).
+func (b *Bar) SetCurrent(n int) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.current = max(0, min(n, b.total))
+	b.render()
+}
+
+// UpdateLabel changes the text before the bar.
+func (b *Bar) UpdateLabel(label string) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.label = label
+	if b.current > 0 {
+		b.render()
+	}
+}
+
+// Pause clears the line so the caller can print; progress keeps counting
+// but nothing is drawn until Resume.
+func (b *Bar) Pause() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.line.clear()
+	b.line.paused = true
+}
+
+// Resume redraws after Pause.
+func (b *Bar) Resume() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.line.paused = false
+	b.render()
+}
+
+// Stop clears the line; later updates draw nothing. Safe to call more than once.
+func (b *Bar) Stop() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.stopped = true
+	b.line.clear()
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with format.go (go build ./display)
+// Code Execution: Library primitives (imported by format.go)
+// Code Cleanup: Stop every started indicator (the Spinner's ticker goroutine
+//               runs until Stop; a Bar left running only leaves its line drawn)
+//
+// Modification Policy:
+//   ✅ Safe: New frames, interval or bar width bounds
+//   ⚠️ Care: Anything written off a TTY (hook harnesses capture stdout)
+//   ❌ Never: Writing \r redraws to a non-TTY, or leaving the line drawn after Stop
+//
+// Quick Reference:
+//   spin := display.NewSpinner("Analyzing")
+//   spin.Start(); spin.Pause(); fmt.Println("found 3 modules"); spin.Resume(); spin.Stop()
+//
+//   bar := display.NewProgressBar(120, "Validating")
+//   bar.Increment(); bar.SetCurrent(60); bar.Stop()
@@ -0,0 +1,204 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Display Progress Tests
+//
+// Purpose: Prove the Bar redraws one line in place and fits the terminal
+//          width, that Pause and Stop leave the line blank for other output,
+//          that the Spinner degrades to one "…working" line off a TTY and
+//          never writes \r there, and that nil indicators are no-ops.
+// ============================================================================
+
+package display
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// lockedBuffer is a bytes.Buffer the spinner's ticker can write concurrently
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// screen replays \r redraws and returns what the terminal line shows
+func screen(written string) string {
+	var line []rune
+	col := 0
+	for _, r := range written {
+		if r == '\r' {
+			col = 0
+			continue
+		}
+		if col < len(line) {
+			line[col] = r
+		} else {
+			line = append(line, r)
+		}
+		col++
+	}
+	return string(line)
+}
+
+// plainTerminal sets an 80-column terminal without color for one test
+func plainTerminal(t *testing.T) {
+	t.Helper()
+	t.Setenv("NO_COLOR", "1")
+	t.Setenv("COLUMNS", "80")
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestBarRedrawsInPlace(t *testing.T) {
+	plainTerminal(t)
+	var out bytes.Buffer
+	bar := newProgressBar(&out, true, 4, "Validating")
+
+	bar.Increment()
+	bar.SetCurrent(3)
+	if strings.Contains(out.String(), "\n") {
+		t.Fatalf("bar wrote a newline: %q", out.String())
+	}
+	shown := screen(out.String())
+	if !strings.HasPrefix(shown, "Validating [") || !strings.HasSuffix(shown, "] 3/4 (75%)") {
+		t.Errorf("line shows %q", shown)
+	}
+	if w := StringWidth(shown); w > 79 {
+		t.Errorf("line is %d columns, wider than the terminal allows", w)
+	}
+
+	bar.SetCurrent(99) // Clamped to total
+	if !strings.HasSuffix(screen(out.String()), "4/4 (100%)") {
+		t.Errorf("over-total not clamped: %q", screen(out.String()))
+	}
+
+	bar.Stop()
+	if strings.TrimSpace(screen(out.String())) != "" {
+		t.Errorf("Stop left %q on the line", screen(out.String()))
+	}
+	before := out.Len()
+	bar.Increment()
+	if out.Len() != before {
+		t.Error("stopped bar kept drawing")
+	}
+}
+
+func TestBarFitsNarrowTerminal(t *testing.T) {
+	plainTerminal(t)
+	t.Setenv("COLUMNS", "30")
+	var out bytes.Buffer
+	bar := newProgressBar(&out, true, 120, "Validating workspace files")
+	bar.SetCurrent(60)
+	if w := StringWidth(screen(out.String())); w > 29 {
+		t.Errorf("line is %d columns on a 30-column terminal", w)
+	}
+}
+
+func TestBarPauseResume(t *testing.T) {
+	plainTerminal(t)
+	var out bytes.Buffer
+	bar := newProgressBar(&out, true, 10, "Cleaning logs")
+	bar.SetCurrent(5)
+
+	bar.Pause()
+	if strings.TrimSpace(screen(out.String())) != "" {
+		t.Fatalf("Pause left %q on the line", screen(out.String()))
+	}
+	before := out.Len()
+	bar.Increment() // Counted, not drawn
+	if out.Len() != before {
+		t.Error("paused bar drew")
+	}
+	bar.Resume()
+	if !strings.Contains(screen(out.String()), "6/10") {
+		t.Errorf("Resume shows %q", screen(out.String()))
+	}
+}
+
+func TestBarSilentOffTerminal(t *testing.T) {
+	var out bytes.Buffer
+	bar := newProgressBar(&out, false, 3, "Validating")
+	bar.Increment()
+	bar.Pause()
+	bar.Resume()
+	bar.Stop()
+	if out.Len() != 0 {
+		t.Errorf("non-TTY bar wrote %q", out.String())
+	}
+}
+
+func TestSpinnerStartStop(t *testing.T) {
+	plainTerminal(t)
+	out := &lockedBuffer{}
+	spin := newSpinner(out, true, "Analyzing")
+	spin.Start()
+	spin.Start() // Second Start is a no-op
+	spin.UpdateLabel("Analyzing modules")
+	if !strings.HasSuffix(screen(out.String()), " Analyzing modules") {
+		t.Errorf("line shows %q", screen(out.String()))
+	}
+
+	spin.Pause()
+	if strings.TrimSpace(screen(out.String())) != "" {
+		t.Errorf("Pause left %q on the line", screen(out.String()))
+	}
+	spin.Resume()
+	spin.Stop()
+	spin.Stop()
+	if strings.TrimSpace(screen(out.String())) != "" {
+		t.Errorf("Stop left %q on the line", screen(out.String()))
+	}
+}
+
+func TestSpinnerDegradesOffTerminal(t *testing.T) {
+	out := &lockedBuffer{}
+	spin := newSpinner(out, false, "Analyzing")
+	spin.Start()
+	spin.UpdateLabel("still going")
+	spin.Stop()
+	if got := out.String(); got != "Analyzing …working\n" {
+		t.Errorf("non-TTY spinner wrote %q", got)
+	}
+}
+
+func TestNilIndicatorsAreNoOps(t *testing.T) {
+	var spin *Spinner
+	spin.Start()
+	spin.UpdateLabel("x")
+	spin.Pause()
+	spin.Resume()
+	spin.Stop()
+
+	var bar *Bar
+	bar.Increment()
+	bar.SetCurrent(2)
+	bar.UpdateLabel("x")
+	bar.Pause()
+	bar.Resume()
+	bar.Stop()
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
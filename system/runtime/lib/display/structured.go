@@ -11,7 +11,7 @@
 // Purpose: Provides Header, Subheader, KeyValue, and StatusLine formatting
 //
 // Authorship: Nova Dawn (extracted 2025-11-21 from format.go v2.0.0)
-// Version: 1.0.0
+// Version: 1.1.0 (Header rule and KeyValue column measured in terminal columns)
 //
 // HEALTH SCORING MAP (Total = 100):
 //   Header() (25): Validate → calculate separator → format with colors
@@ -43,7 +43,7 @@ import (
 //
 // What It Does:
 //   - Creates bold cyan title with separator lines above and below
-//   - Separator length = title length + padding (visual breathing room)
+//   - Separator length = title width in columns + padding (visual breathing room)
 //   - Empty title returns empty string (self-evident validation)
 //
 // Parameters:
@@ -83,7 +83,7 @@ func Header(title string) string {
 		colorReset = Reset
 	}
 
-	separator := strings.Repeat("─", len(title)+padding)
+	separator := strings.Repeat("─", StringWidth(title)+padding)
 	return fmt.Sprintf("\n%s%s%s\n%s %s %s\n%s%s%s\n",
 		colorBoldCyan, separator, colorReset,
 		colorBoldCyan, title, colorReset,
@@ -180,7 +180,7 @@ func KeyValue(key, value string) string {
 		colorReset = Reset
 	}
 
-	return fmt.Sprintf("%s%s%-*s%s %s", indent, colorDim, columnWidth, key+":", colorReset, value)
+	return fmt.Sprintf("%s%s%s%s %s", indent, colorDim, padRight(key+":", columnWidth), colorReset, value)
 }
 
 // StatusLine formats a status line with success/failure icon.
@@ -0,0 +1,280 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Display Tables Primitive - Key-Value Tables and Multi-Column Layout
+//
+// Biblical Foundation: See format.go (rails pattern applies to all primitives)
+// CPI-SI Identity: RAIL PRIMITIVE (orthogonal infrastructure component)
+// Component Type: Aligned multi-line layouts measured in terminal columns
+//
+// Purpose: Provides KeyValueTable (aligned key column, optional icons, values
+//          wrapped under their column) and Columns (items laid out down then
+//          across, like ls) so consumers stop hand-padding with Printf
+//
+// Authorship: Nova Dawn (added 2026-10-16, first consumer hooks/lib/session)
+// Version: 1.1.0 (measures with StringWidth)
+//
+// HEALTH SCORING MAP (Total = 100):
+//   KeyValueTable() (60): Validate → measure key column → wrap values → render
+//   Columns() (40): Validate → fit column count to width → render column-major
+//
+package display
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"strings" // Line building, padding, and word splitting
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants
+// ────────────────────────────────────────────────────────────────
+
+// minWrapWidth is the narrowest value column KeyValueTable wraps into -
+// below it, overflowing the line reads better than one word per line.
+const minWrapWidth = 10
+
+// ────────────────────────────────────────────────────────────────
+// Types
+// ────────────────────────────────────────────────────────────────
+
+// KV is one row of a KeyValueTable.
+//
+// A row with neither Icon nor Key is a continuation line - its Value sits
+// under the value column of the rows above it.
+type KV struct {
+	Icon  string // Optional icon printed before the key ("" = none)
+	Key   string // Label printed as given (include any colon yourself)
+	Value string // Value text; "\n" starts another line under the value column
+}
+
+// TableOpts shapes a KeyValueTable. The zero value gives KeyValue's
+// indentation, the table column padding as gap, and no wrapping.
+type TableOpts struct {
+	Indent      string // Leading indent for every line ("" = layout.indentation.key_value)
+	MinKeyWidth int    // Floor for the key column so separate tables line up (0 = fit widest key)
+	Gap         int    // Columns between key column and values (0 = layout.table.column_padding)
+	MaxWidth    int    // Total line width; longer values wrap at spaces (0 = never wrap)
+	DimKeys     bool   // Dim the key column when ColorEnabled() (as KeyValue does)
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers
+// ────────────────────────────────────────────────────────────────
+
+// kvLabel joins a row's icon and key as they print
+func kvLabel(row KV) string {
+	if row.Icon == "" {
+		return row.Key
+	}
+	return row.Icon + " " + row.Key
+}
+
+// wrapWords word-wraps text to width columns at spaces
+//
+// Words wider than width stay whole on their own line - paths and URLs
+// are worth more unbroken than perfectly fitted.
+func wrapWords(text string, width int) []string {
+	var lines []string
+	current := ""
+
+	for _, word := range strings.Fields(text) {
+		switch {
+		case current == "":
+			current = word
+		case StringWidth(current)+1+StringWidth(word) <= width:
+			current += " " + word
+		default:
+			lines = append(lines, current)
+			current = word
+		}
+	}
+	return append(lines, current)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Table Layouts
+// ────────────────────────────────────────────────────────────────
+
+// KeyValueTable renders rows with every value starting in one column.
+//
+// What It Does:
+//   - Key column = widest icon+key in rows (display width, not bytes),
+//     at least opts.MinKeyWidth
+//   - Values start opts.Gap columns after the key column
+//   - Values wider than opts.MaxWidth wrap at spaces onto continuation lines
+//     indented to the value column
+//   - Key column dimmed only when opts.DimKeys and ColorEnabled()
+//   - No rows returns empty string (self-evident validation)
+//
+// Parameters:
+//   - rows: Table rows in print order
+//   - opts: Indent, key column floor, gap, wrap width, key styling
+//
+// Returns:
+//   - Rendered lines, each newline-terminated, or "" if rows is empty
+//
+// Example:
+//   fmt.Print(display.KeyValueTable([]display.KV{
+//       {Icon: "📍", Key: "Workspace:", Value: "/home/nova/project"},
+//       {Icon: "🌿", Key: "Git Branch:", Value: "main"},
+//   }, display.TableOpts{}))
+//   // Output:
+//   //   📍 Workspace:   /home/nova/project
+//   //   🌿 Git Branch:  main
+func KeyValueTable(rows []KV, opts TableOpts) string {
+	defer recoverFromPanic()
+	if len(rows) == 0 {
+		return "" // Self-evident validation: no rows = empty output
+	}
+
+	// Config with tripwires - layout
+	cfg := GetConfig()
+
+	indent := opts.Indent
+	if indent == "" {
+		indent = cfg.Layout.Indentation.KeyValue
+	}
+	if indent == "" {
+		indent = IndentSpaces
+	}
+
+	gap := opts.Gap
+	if gap == 0 {
+		gap = cfg.Layout.Table.ColumnPadding
+	}
+	if gap == 0 {
+		gap = TableColumnPadding
+	}
+
+	// Config with tripwires - colors (only when they will render)
+	colorDim, colorReset := "", ""
+	if opts.DimKeys && ColorEnabled() {
+		colorDim = cfg.Colors.Basic.Dim
+		if colorDim == "" {
+			colorDim = Dim
+		}
+		colorReset = cfg.Colors.Basic.Reset
+		if colorReset == "" {
+			colorReset = Reset
+		}
+	}
+
+	column := opts.MinKeyWidth
+	for _, row := range rows {
+		if width := StringWidth(kvLabel(row)); width > column {
+			column = width
+		}
+	}
+
+	valueWidth := 0 // 0 = no wrapping
+	if opts.MaxWidth > 0 {
+		if room := opts.MaxWidth - StringWidth(indent) - column - gap; room >= minWrapWidth {
+			valueWidth = room
+		}
+	}
+	continuation := indent + strings.Repeat(" ", column+gap)
+
+	var result strings.Builder
+	for _, row := range rows {
+		label := kvLabel(row)
+		prefix := indent + colorDim + label + colorReset + strings.Repeat(" ", column-StringWidth(label)+gap)
+
+		for _, paragraph := range strings.Split(row.Value, "\n") {
+			lines := []string{paragraph}
+			if valueWidth > 0 && StringWidth(paragraph) > valueWidth {
+				lines = wrapWords(paragraph, valueWidth)
+			}
+			for _, line := range lines {
+				result.WriteString(prefix + line + "\n")
+				prefix = continuation
+			}
+		}
+	}
+	return result.String()
+}
+
+// Columns lays items out in as many columns as fit width, filling down then across.
+//
+// What It Does:
+//   - Every column is as wide as the widest item (display width), separated
+//     by the table column padding
+//   - Column count = as many as fit in width (at least one); items fill each
+//     column top to bottom before the next, like ls
+//   - No trailing spaces - the last item on a line is not padded
+//   - width <= 0 uses DefaultLineWidth
+//   - No items returns empty string (self-evident validation)
+//
+// Parameters:
+//   - items: Cell text in reading order
+//   - width: Total line width available in columns
+//
+// Returns:
+//   - Rendered lines, each newline-terminated, or "" if items is empty
+//
+// Example:
+//   fmt.Print(display.Columns([]string{"alpha", "beta", "gamma", "delta"}, 16))
+//   // Output:
+//   // alpha  gamma
+//   // beta   delta
+func Columns(items []string, width int) string {
+	defer recoverFromPanic()
+	if len(items) == 0 {
+		return "" // Self-evident validation: no items = empty output
+	}
+	if width <= 0 {
+		width = DefaultLineWidth
+	}
+
+	// Config with tripwires - layout
+	cfg := GetConfig()
+
+	padding := cfg.Layout.Table.ColumnPadding
+	if padding == 0 {
+		padding = TableColumnPadding
+	}
+
+	cell := 0
+	for _, item := range items {
+		cell = max(cell, StringWidth(item))
+	}
+
+	columns := max(1, (width+padding)/(cell+padding)) // Last column needs no padding
+	rows := (len(items) + columns - 1) / columns
+
+	var result strings.Builder
+	for r := 0; r < rows; r++ {
+		for i := r; i < len(items); i += rows {
+			result.WriteString(items[i])
+			if i+rows < len(items) { // Another column follows on this line
+				result.WriteString(strings.Repeat(" ", cell-StringWidth(items[i])+padding))
+			}
+		}
+		result.WriteString("\n")
+	}
+	return result.String()
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with format.go (go build ./display)
+// Code Execution: Library primitives (imported by format.go)
+// Code Cleanup: None needed (stateless functions)
+//
+// Modification Policy:
+//   ✅ Safe: Adding TableOpts fields whose zero value keeps today's output
+//   ⚠️ Care: Changing alignment rules (session output and goldens depend on them)
+//   ❌ Never: Measuring with len() - icons and translations are multi-byte
+//
+// Quick Reference:
+//   fmt.Print(KeyValueTable([]KV{{Key: "Status:", Value: "healthy"}}, TableOpts{}))
+//   fmt.Print(Columns([]string{"a", "b", "c"}, 40))
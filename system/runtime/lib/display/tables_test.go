@@ -0,0 +1,127 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Display Tables Tests
+//
+// Purpose: Prove KeyValueTable lines values up by display width (icons, CJK),
+//          wraps long values under the value column, and keeps keys plain
+//          under NO_COLOR; that Columns fills down then across within width;
+//          and that Severity styles only where color renders.
+// ============================================================================
+
+package display
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"strings"
+	"testing"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestKeyValueTableAlignment(t *testing.T) {
+	got := KeyValueTable([]KV{
+		{Icon: "📍", Key: "Dir:", Value: "/home/nova"},
+		{Icon: "⏱️", Key: "時間:", Value: "10:00"},
+		{Key: "Plain:", Value: "x"},
+		{Value: "continued"},
+	}, TableOpts{Indent: "  ", MinKeyWidth: 8})
+
+	want := "" +
+		"  📍 Dir:   /home/nova\n" +
+		"  ⏱️ 時間:  10:00\n" +
+		"  Plain:    x\n" +
+		"            continued\n"
+	if got != want {
+		t.Errorf("KeyValueTable =\n%s\nwant\n%s", got, want)
+	}
+
+	if KeyValueTable(nil, TableOpts{}) != "" {
+		t.Error("no rows: want empty output")
+	}
+}
+
+func TestKeyValueTableWrapsValues(t *testing.T) {
+	rows := []KV{
+		{Key: "Reason:", Value: "the session ended because the user closed the terminal window"},
+		{Key: "Path:", Value: "/a/very/long/path/that/cannot/break/anywhere/at/all"},
+	}
+	got := KeyValueTable(rows, TableOpts{Indent: "  ", MaxWidth: 40})
+
+	lines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "  Path:") {
+			continue // Unbreakable word overflows rather than splitting
+		}
+		if Width(line) > 40 {
+			t.Errorf("line %d is %d columns, want <= 40: %q", i, Width(line), line)
+		}
+		if i > 0 && !strings.HasPrefix(line, strings.Repeat(" ", 11)) {
+			t.Errorf("continuation not under value column: %q", line)
+		}
+	}
+	if len(lines) < 3 || !strings.HasPrefix(lines[len(lines)-1], "  Path:    /a/very") {
+		t.Errorf("wrapped table =\n%s", got)
+	}
+
+	// Zero MaxWidth never wraps
+	if got := KeyValueTable(rows[:1], TableOpts{}); strings.Count(got, "\n") != 1 {
+		t.Errorf("unwrapped table =\n%s", got)
+	}
+}
+
+func TestKeyValueTableRespectsNoColor(t *testing.T) {
+	t.Setenv("FORCE_COLOR", "1")
+	if got := KeyValueTable([]KV{{Key: "Key:", Value: "v"}}, TableOpts{DimKeys: true}); !strings.Contains(got, Dim) {
+		t.Errorf("FORCE_COLOR: key not dimmed: %q", got)
+	}
+
+	t.Setenv("NO_COLOR", "")
+	if got := KeyValueTable([]KV{{Key: "Key:", Value: "v"}}, TableOpts{DimKeys: true}); strings.Contains(got, "\033[") {
+		t.Errorf("NO_COLOR: escapes in %q", got)
+	}
+}
+
+func TestColumns(t *testing.T) {
+	items := []string{"alpha", "beta", "gamma", "delta", "epsilon"}
+
+	got := Columns(items, 20)
+	want := "alpha    delta\nbeta     epsilon\ngamma\n"
+	if got != want {
+		t.Errorf("Columns(20) =\n%q\nwant\n%q", got, want)
+	}
+
+	if got := Columns(items, 3); strings.Count(got, "\n") != len(items) {
+		t.Errorf("narrower than one item: want one per line, got\n%s", got)
+	}
+	if got := Columns(items, 0); got != "alpha    beta     gamma    delta    epsilon\n" {
+		t.Errorf("default width: %q", got)
+	}
+	if Columns(nil, 80) != "" {
+		t.Error("no items: want empty output")
+	}
+}
+
+func TestSeverity(t *testing.T) {
+	t.Setenv("FORCE_COLOR", "1")
+	if got := Severity("failed", LevelError); got != levelColor(LevelError)+"failed"+Reset {
+		t.Errorf("LevelError = %q", got)
+	}
+	if got := Severity("plain", LevelPlain); got != "plain" {
+		t.Errorf("LevelPlain = %q", got)
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	if got := Severity("failed", LevelError); got != "failed" {
+		t.Errorf("NO_COLOR: %q", got)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
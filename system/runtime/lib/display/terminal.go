@@ -0,0 +1,168 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Display Terminal Primitive - Color Detection and Severity Styling
+//
+// Biblical Foundation: See format.go (rails pattern applies to all primitives)
+// CPI-SI Identity: RAIL PRIMITIVE (orthogonal infrastructure component)
+// Component Type: Terminal capability checks and semantic color styling
+//
+// Purpose: Decides whether color escapes belong in output (NO_COLOR, non-TTY,
+//          TERM=dumb) and styles text by severity so every consumer colors
+//          the same way (column measurement lives in width.go)
+//
+// Authorship: Nova Dawn (added 2026-10-16 for KeyValueTable/Columns)
+// Version: 1.1.0 (Width moved to width.go)
+//
+// HEALTH SCORING MAP (Total = 100):
+//   ColorEnabled() (50): Check NO_COLOR → FORCE_COLOR → TERM → stdout TTY
+//   Severity() (50): Validate → select color by level → wrap when color enabled
+//
+package display
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os" // Environment and stdout mode checks
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types
+// ────────────────────────────────────────────────────────────────
+
+// Level is the semantic weight of styled text - what it means, not which color.
+//
+// Consumers pick a Level; the palette behind it comes from formatting.jsonc
+// (with constant tripwires), so success is the same green everywhere.
+type Level int
+
+const (
+	LevelPlain   Level = iota // No styling
+	LevelSuccess              // Green - completed, healthy
+	LevelInfo                 // Cyan - neutral information
+	LevelWarning              // Yellow - needs attention
+	LevelError                // Red - failed, critical
+	LevelMuted                // Dim - secondary detail
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Terminal Capability
+// ────────────────────────────────────────────────────────────────
+
+// ColorEnabled reports whether ANSI color escapes should be written.
+//
+// What It Does:
+//   - NO_COLOR set (any value, https://no-color.org) → false, always wins
+//   - FORCE_COLOR set → true (CI logs and tests that want escapes)
+//   - TERM=dumb → false
+//   - stdout not a TTY (piped, redirected, captured) → false
+//
+// Returns:
+//   - true when styled output will render as color rather than escape noise
+//
+// Example:
+//   if display.ColorEnabled() {
+//       fmt.Print(display.Green)
+//   }
+func ColorEnabled() bool {
+	if _, set := os.LookupEnv("NO_COLOR"); set {
+		return false
+	}
+	if os.Getenv("FORCE_COLOR") != "" {
+		return true
+	}
+	if os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	info, err := os.Stdout.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// ────────────────────────────────────────────────────────────────
+// Semantic Styling
+// ────────────────────────────────────────────────────────────────
+
+// levelColor resolves a Level to its escape code (config with tripwires)
+func levelColor(level Level) string {
+	cfg := GetConfig()
+
+	pick := func(configured, fallback string) string {
+		if configured == "" {
+			return fallback
+		}
+		return configured
+	}
+
+	switch level {
+	case LevelSuccess:
+		return pick(cfg.Colors.Foreground.Green, Green)
+	case LevelInfo:
+		return pick(cfg.Colors.Foreground.Cyan, Cyan)
+	case LevelWarning:
+		return pick(cfg.Colors.Foreground.Yellow, Yellow)
+	case LevelError:
+		return pick(cfg.Colors.Foreground.Red, Red)
+	case LevelMuted:
+		return pick(cfg.Colors.Basic.Dim, Dim)
+	}
+	return ""
+}
+
+// Severity styles text by its semantic level.
+//
+// What It Does:
+//   - Wraps text in the level's color and a reset
+//   - Returns text unchanged when ColorEnabled() is false or level is LevelPlain
+//   - Empty text returns empty string (self-evident validation)
+//
+// Parameters:
+//   - text: The text to style
+//   - level: LevelSuccess, LevelInfo, LevelWarning, LevelError, LevelMuted, or LevelPlain
+//
+// Returns:
+//   - Styled text, or text as-is where color would not render
+//
+// Example:
+//   fmt.Println("Build: " + display.Severity("failed", display.LevelError))
+//   // Output: Build: failed (red on a color terminal, plain when piped or NO_COLOR)
+func Severity(text string, level Level) string {
+	defer recoverFromPanic()
+	if text == "" {
+		return "" // Self-evident validation: empty input = empty output
+	}
+
+	color := levelColor(level)
+	if color == "" || !ColorEnabled() {
+		return text
+	}
+
+	colorReset := GetConfig().Colors.Basic.Reset
+	if colorReset == "" {
+		colorReset = Reset
+	}
+	return color + text + colorReset
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with format.go (go build ./display)
+// Code Execution: Library primitives (imported by format.go)
+// Code Cleanup: None needed (stateless functions)
+//
+// Modification Policy:
+//   ✅ Safe: Adding new Levels (append - existing values must not shift)
+//   ⚠️ Care: Changing ColorEnabled() precedence (every consumer inherits it)
+//   ❌ Never: Emitting escapes when NO_COLOR is set
+//
+// Quick Reference:
+//   ok := display.ColorEnabled()
+//   fmt.Println(display.Severity("degraded", display.LevelWarning))
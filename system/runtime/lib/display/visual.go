@@ -11,7 +11,7 @@
 // Purpose: Provides Table, ProgressBar, and Box visual components
 //
 // Authorship: Nova Dawn (extracted 2025-11-21 from format.go v2.0.0)
-// Version: 1.0.0
+// Version: 1.1.0 (widths measured in terminal columns - emoji, CJK, combining marks)
 //
 // HEALTH SCORING MAP (Total = 100):
 //   Table.Render() (40): Validate → calculate widths → render headers/rows
@@ -115,17 +115,17 @@ func (t *Table) Render() string {
 		return ""
 	}
 
-	// Calculate column widths from headers and all cells
+	// Calculate column widths (terminal columns) from headers and all cells
 	widths := make([]int, len(t.Headers))
 	for i, h := range t.Headers {
-		widths[i] = len(h)
+		widths[i] = StringWidth(h)
 	}
 
 	for _, row := range t.Rows {
 		for i, cell := range row {
 			// Defensive: handle rows with more/fewer cells than headers
-			if i < len(widths) && len(cell) > widths[i] {
-				widths[i] = len(cell)
+			if i < len(widths) && StringWidth(cell) > widths[i] {
+				widths[i] = StringWidth(cell)
 			}
 		}
 	}
@@ -154,7 +154,7 @@ func (t *Table) Render() string {
 	// Render header row (bold)
 	result.WriteString(colorBold)
 	for i, h := range t.Headers {
-		result.WriteString(fmt.Sprintf("%-*s", widths[i]+columnPadding, h))
+		result.WriteString(padRight(h, widths[i]+columnPadding))
 	}
 	result.WriteString(colorReset + "\n")
 
@@ -173,7 +173,7 @@ func (t *Table) Render() string {
 			}
 			// Defensive: only render if within calculated widths
 			if i < len(widths) {
-				result.WriteString(fmt.Sprintf("%-*s", widths[i]+columnPadding, cell))
+				result.WriteString(padRight(cell, widths[i]+columnPadding))
 			}
 			// Reset color if it was applied
 			if i < len(t.Colors) && t.Colors[i] != "" {
@@ -247,7 +247,8 @@ func ProgressBar(current, total int, width int) string {
 // Box creates a boxed message with title and border.
 //
 // What It Does:
-//   - Calculates max width from title and all message lines
+//   - Calculates max width from title and all message lines (terminal
+//     columns - emoji and CJK count 2, combining marks 0)
 //   - Renders Unicode box border (┌─┐│└┘) around content
 //   - Title displayed in bold cyan on top line
 //   - Message lines padded to max width for clean alignment
@@ -288,13 +289,13 @@ func Box(title, message string) string {
 	// Defensive: strip newlines from title (enforce single-line title)
 	title = strings.ReplaceAll(title, "\n", " ")
 
-	// Split message into lines and calculate max width
+	// Split message into lines and calculate max width in terminal columns
 	lines := strings.Split(message, "\n")
-	maxWidth := len(title)
+	maxWidth := StringWidth(title)
 
 	for _, line := range lines {
-		if len(line) > maxWidth {
-			maxWidth = len(line)
+		if StringWidth(line) > maxWidth {
+			maxWidth = StringWidth(line)
 		}
 	}
 
@@ -327,7 +328,7 @@ func Box(title, message string) string {
 	top := "┌" + strings.Repeat("─", width-2) + "┐"
 	bottom := "└" + strings.Repeat("─", width-2) + "┘"
 	separator := "├" + strings.Repeat("─", width-2) + "┤"
-	titleLine := fmt.Sprintf("│ %s%-*s%s │", colorBold, maxWidth, title, colorReset)
+	titleLine := "│ " + colorBold + padRight(title, maxWidth) + colorReset + " │"
 
 	// Build box output
 	var result strings.Builder
@@ -336,7 +337,7 @@ func Box(title, message string) string {
 	result.WriteString(colorBoldCyan + separator + colorReset + "\n")
 
 	for _, line := range lines {
-		result.WriteString(fmt.Sprintf("│ %-*s │\n", maxWidth, line))
+		result.WriteString("│ " + padRight(line, maxWidth) + " │\n")
 	}
 
 	result.WriteString(colorBoldCyan + bottom + colorReset + "\n")
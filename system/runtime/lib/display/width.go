@@ -0,0 +1,339 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Display Width Primitive - Terminal Column Measurement and Truncation
+//
+// Biblical Foundation: See format.go (rails pattern applies to all primitives)
+// CPI-SI Identity: RAIL PRIMITIVE (orthogonal infrastructure component)
+// Component Type: Unicode-aware measurement every aligned layout builds on
+//
+// Purpose: Measures strings in terminal columns (East Asian Width, emoji
+//          sequences, combining and zero-width characters) and cuts them to
+//          a column budget without splitting a character, so borders, rules,
+//          and key columns line up whatever the text holds
+//
+// Authorship: Nova Dawn (added 2026-10-16, runeWidth/Width moved from terminal.go)
+// Version: 1.0.0
+//
+// HEALTH SCORING MAP (Total = 100):
+//   StringWidth() (50): Segment into clusters → sum cluster widths
+//   Truncate() (50): Segment into clusters → keep whole clusters within width
+//
+package display
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"strings" // Padding
+	"unicode" // Combining mark and format character detection
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants
+// ────────────────────────────────────────────────────────────────
+
+const (
+	zeroWidthJoiner    = 0x200D // Joins emoji into one glyph (👨‍👩‍👧)
+	emojiPresentation  = 0xFE0F // Variation selector 16 - draw the preceding symbol as emoji
+	regionalIndicatorA = 0x1F1E6
+	regionalIndicatorZ = 0x1F1FF // Two indicators make one flag (🇯🇵)
+)
+
+// wideRanges lists East Asian Wide (W) and Fullwidth (F) code points -
+// characters a terminal draws two columns wide. Emoji with default emoji
+// presentation are W; symbols that only become emoji with U+FE0F are not
+// listed (the cluster rule widens them). Sorted, non-overlapping.
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},   // Hangul Jamo initial consonants
+	{0x231A, 0x231B},   // ⌚⌛
+	{0x2329, 0x232A},   // Angle brackets
+	{0x23E9, 0x23EC},   // ⏩⏪⏫⏬
+	{0x23F0, 0x23F0},   // ⏰
+	{0x23F3, 0x23F3},   // ⏳
+	{0x25FD, 0x25FE},   // ◽◾
+	{0x2614, 0x2615},   // ☔☕
+	{0x2648, 0x2653},   // Zodiac
+	{0x267F, 0x267F},   // ♿
+	{0x2693, 0x2693},   // ⚓
+	{0x26A1, 0x26A1},   // ⚡
+	{0x26AA, 0x26AB},   // ⚪⚫
+	{0x26BD, 0x26BE},   // ⚽⚾
+	{0x26C4, 0x26C5},   // ⛄⛅
+	{0x26CE, 0x26CE},   // ⛎
+	{0x26D4, 0x26D4},   // ⛔
+	{0x26EA, 0x26EA},   // ⛪
+	{0x26F2, 0x26F3},   // ⛲⛳
+	{0x26F5, 0x26F5},   // ⛵
+	{0x26FA, 0x26FA},   // ⛺
+	{0x26FD, 0x26FD},   // ⛽
+	{0x2705, 0x2705},   // ✅
+	{0x270A, 0x270B},   // ✊✋
+	{0x2728, 0x2728},   // ✨
+	{0x274C, 0x274C},   // ❌
+	{0x274E, 0x274E},   // ❎
+	{0x2753, 0x2755},   // ❓❔❕
+	{0x2757, 0x2757},   // ❗
+	{0x2795, 0x2797},   // ➕➖➗
+	{0x27B0, 0x27B0},   // ➰
+	{0x27BF, 0x27BF},   // ➿
+	{0x2B1B, 0x2B1C},   // ⬛⬜
+	{0x2B50, 0x2B50},   // ⭐
+	{0x2B55, 0x2B55},   // ⭕
+	{0x2E80, 0x303E},   // CJK radicals, Kangxi, CJK symbols and punctuation
+	{0x3041, 0x33FF},   // Hiragana through CJK compatibility
+	{0x3400, 0x4DBF},   // CJK extension A
+	{0x4E00, 0x9FFF},   // CJK unified ideographs
+	{0xA000, 0xA4CF},   // Yi
+	{0xA960, 0xA97F},   // Hangul Jamo extended A
+	{0xAC00, 0xD7A3},   // Hangul syllables
+	{0xF900, 0xFAFF},   // CJK compatibility ideographs
+	{0xFE10, 0xFE19},   // Vertical forms
+	{0xFE30, 0xFE6F},   // CJK compatibility forms, small form variants
+	{0xFF00, 0xFF60},   // Fullwidth forms
+	{0xFFE0, 0xFFE6},   // Fullwidth signs
+	{0x16FE0, 0x16FE4}, // Ideographic symbols
+	{0x17000, 0x18CFF}, // Tangut, Khitan
+	{0x1B000, 0x1B2FF}, // Kana supplement through Nushu
+	{0x1F004, 0x1F004}, // 🀄
+	{0x1F0CF, 0x1F0CF}, // 🃏
+	{0x1F18E, 0x1F18E}, // 🆎
+	{0x1F191, 0x1F19A}, // 🆑-🆚
+	{0x1F200, 0x1F202}, // Enclosed ideographic supplement
+	{0x1F210, 0x1F23B},
+	{0x1F240, 0x1F248},
+	{0x1F250, 0x1F251},
+	{0x1F260, 0x1F265},
+	{0x1F300, 0x1F320}, // 🌀-🌠
+	{0x1F32D, 0x1F335}, // 🌭-🌵
+	{0x1F337, 0x1F37C}, // 🌷-🍼
+	{0x1F37E, 0x1F393}, // 🍾-🎓
+	{0x1F3A0, 0x1F3CA}, // 🎠-🏊
+	{0x1F3CF, 0x1F3D3}, // 🏏-🏓
+	{0x1F3E0, 0x1F3F0}, // 🏠-🏰
+	{0x1F3F4, 0x1F3F4}, // 🏴
+	{0x1F3F8, 0x1F43E}, // 🏸-🐾
+	{0x1F440, 0x1F440}, // 👀
+	{0x1F442, 0x1F4FC}, // 👂-📼
+	{0x1F4FF, 0x1F53D}, // 📿-🔽
+	{0x1F54B, 0x1F54E}, // 🕋-🕎
+	{0x1F550, 0x1F567}, // Clock faces
+	{0x1F57A, 0x1F57A}, // 🕺
+	{0x1F595, 0x1F596}, // 🖕🖖
+	{0x1F5A4, 0x1F5A4}, // 🖤
+	{0x1F5FB, 0x1F64F}, // 🗻-🙏
+	{0x1F680, 0x1F6C5}, // 🚀-🛅
+	{0x1F6CC, 0x1F6CC}, // 🛌
+	{0x1F6D0, 0x1F6D2}, // 🛐-🛒
+	{0x1F6D5, 0x1F6D7}, // 🛕-🛗
+	{0x1F6DC, 0x1F6DF}, // 🛜-🛟
+	{0x1F6EB, 0x1F6EC}, // 🛫🛬
+	{0x1F6F4, 0x1F6FC}, // 🛴-🛼
+	{0x1F7E0, 0x1F7EB}, // Colored circles and squares
+	{0x1F7F0, 0x1F7F0}, // 🟰
+	{0x1F90C, 0x1F93A}, // 🤌-🤺
+	{0x1F93C, 0x1F945}, // 🤼-🥅
+	{0x1F947, 0x1F9FF}, // 🥇-🧿
+	{0x1FA70, 0x1FAFF}, // Symbols and pictographs extended A
+	{0x20000, 0x2FFFD}, // CJK extensions B-F, compatibility supplement
+	{0x30000, 0x3FFFD}, // CJK extensions G-H
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Character Classes
+// ────────────────────────────────────────────────────────────────
+
+// isWide reports whether r is East Asian Wide or Fullwidth (binary search of wideRanges)
+func isWide(r rune) bool {
+	low, high := 0, len(wideRanges)-1
+	for low <= high {
+		mid := (low + high) / 2
+		switch {
+		case r < wideRanges[mid][0]:
+			high = mid - 1
+		case r > wideRanges[mid][1]:
+			low = mid + 1
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// isExtender reports whether r draws nothing of its own but attaches to the
+// character before it - combining marks, variation selectors, emoji skin
+// tones, Hangul vowel and final consonant jamo, and format characters
+// (zero-width space, direction marks, ZWJ, BOM)
+func isExtender(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) ||
+		(r >= 0xFE00 && r <= 0xFE0F) || // Variation selectors
+		(r >= 0xE0100 && r <= 0xE01EF) || // Variation selectors supplement
+		(r >= 0x1F3FB && r <= 0x1F3FF) || // Emoji skin tone modifiers
+		(r >= 0x1160 && r <= 0x11FF) || // Hangul Jamo vowels and finals
+		(r >= 0xD7B0 && r <= 0xD7FF) // Hangul Jamo extended B
+}
+
+// runeWidth returns the terminal columns a lone rune occupies
+//
+// 0 for control and zero-width characters, 2 for East Asian Wide/Fullwidth,
+// 1 otherwise. Ambiguous-width characters (box drawing, Greek) count as 1 -
+// how terminals outside East Asian locales draw them.
+func runeWidth(r rune) int {
+	switch {
+	case r < 0x20 || (r >= 0x7F && r < 0xA0): // C0/C1 controls
+		return 0
+	case isExtender(r):
+		return 0
+	case isWide(r):
+		return 2
+	}
+	return 1
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Clusters
+// ────────────────────────────────────────────────────────────────
+
+// nextCluster returns where the character cluster starting at runes[i]
+// ends and how many columns it draws
+//
+// A cluster is a base character plus everything that attaches to it:
+// combining marks and other extenders, U+FE0F (which widens a narrow
+// symbol to a 2-column emoji - "⏱️", "⚠️"), and ZWJ-joined emoji (drawn as
+// one glyph). Two regional indicators are one 2-column flag. Measuring and
+// cutting by cluster keeps "é" (e + U+0301) and "👨‍👩‍👧" whole.
+func nextCluster(runes []rune, i int) (end, width int) {
+	base := runes[i]
+	width = runeWidth(base)
+	end = i + 1
+
+	if base >= regionalIndicatorA && base <= regionalIndicatorZ {
+		if end < len(runes) && runes[end] >= regionalIndicatorA && runes[end] <= regionalIndicatorZ {
+			return end + 1, 2 // Flag
+		}
+		return end, 1
+	}
+
+	for end < len(runes) {
+		switch r := runes[end]; {
+		case r == zeroWidthJoiner && end+1 < len(runes):
+			end += 2 // Joined character is part of this glyph
+		case r == emojiPresentation:
+			if width == 1 {
+				width = 2
+			}
+			end++
+		case isExtender(r):
+			end++
+		default:
+			return end, width
+		}
+	}
+	return end, width
+}
+
+// padRight pads s with spaces to width columns (unchanged when already as wide)
+func padRight(s string, width int) string {
+	if gap := width - StringWidth(s); gap > 0 {
+		return s + strings.Repeat(" ", gap)
+	}
+	return s
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Measurement and Truncation
+// ────────────────────────────────────────────────────────────────
+
+// StringWidth returns the terminal columns a string occupies.
+//
+// What It Does:
+//   - Counts columns, not bytes or runes - "é" is 1 (precomposed or e + U+0301),
+//     "時" and "🌅" are 2, a zero-width space is 0
+//   - East Asian Wide and Fullwidth characters (CJK, Hangul, fullwidth forms,
+//     default-emoji pictographs) are 2 columns
+//   - Emoji sequences count once: "👨‍👩‍👧" (ZWJ), "👍🏽" (skin tone), and "🇯🇵"
+//     (flag) are 2 each; a narrow symbol plus U+FE0F ("⏱️", "⚠️") is 2
+//   - Does not skip ANSI escapes - measure text before coloring it
+//
+// Parameters:
+//   - s: Text to measure
+//
+// Returns:
+//   - Columns the text occupies in a terminal (0 for "")
+//
+// Example:
+//   pad := strings.Repeat(" ", 20-display.StringWidth(label))
+func StringWidth(s string) int {
+	runes := []rune(s)
+	total := 0
+	for i := 0; i < len(runes); {
+		end, width := nextCluster(runes, i)
+		total += width
+		i = end
+	}
+	return total
+}
+
+// Width returns the terminal columns a string occupies.
+//
+// Deprecated: Use StringWidth - Width is its original name, kept so
+// existing callers compile.
+func Width(s string) int {
+	return StringWidth(s)
+}
+
+// Truncate cuts s to at most width terminal columns without splitting a character.
+//
+// What It Does:
+//   - Keeps whole clusters from the start while they fit - a wide character
+//     that would straddle the limit is dropped, never halved or replaced
+//     by a space, so the result can be one column short of width
+//   - Combining marks, skin tones, and ZWJ-joined emoji stay with their base
+//   - Text already within width is returned unchanged
+//   - width <= 0 returns empty string
+//
+// Parameters:
+//   - s: Text to cut
+//   - width: Column budget
+//
+// Returns:
+//   - Longest prefix of s that fits in width columns
+//
+// Example:
+//   display.Truncate("日本語テキスト", 5) // "日本" (4 columns - "語" would need 6)
+func Truncate(s string, width int) string {
+	runes := []rune(s)
+	used := 0
+	for i := 0; i < len(runes); {
+		end, w := nextCluster(runes, i)
+		if used+w > width {
+			return string(runes[:i])
+		}
+		used += w
+		i = end
+	}
+	return s
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with format.go (go build ./display)
+// Code Execution: Library primitives (imported by format.go)
+// Code Cleanup: None needed (stateless functions)
+//
+// Modification Policy:
+//   ✅ Safe: Adding ranges to wideRanges (keep it sorted - isWide binary-searches)
+//   ⚠️ Care: Changing cluster rules (every border and key column depends on them)
+//   ❌ Never: Measuring with len() or rune counts - emoji and CJK are wider
+//
+// Quick Reference:
+//   cols := display.StringWidth("🌅 Dawn")
+//   head := display.Truncate(title, 20)
@@ -0,0 +1,165 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Display Width Tests
+//
+// Purpose: Prove StringWidth counts terminal columns for ASCII, CJK, emoji
+//          (sequences, skin tones, flags, U+FE0F), and combining accents;
+//          that Truncate never splits a character or pads the gap; and that
+//          Box, Header, KeyValue, and Table put their right border, rule
+//          end, or value column in the same place whatever the text holds.
+// ============================================================================
+
+package display
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// ansiPattern matches the color escapes Box and Header always write
+var ansiPattern = regexp.MustCompile("\033\\[[0-9;]*m")
+
+// widthCases are texts of equal display width (12 columns) in each script
+var widthCases = map[string]string{
+	"ascii":     "Sunrise text",
+	"emoji":     "🌅 Sunrise!!",
+	"cjk":       "日の出 sunup",
+	"combining": "Café au lait",
+	"sequences": "👨‍👩‍👧 🇯🇵 hello!",
+}
+
+// plainLines strips color escapes and splits rendered output into lines
+func plainLines(rendered string) []string {
+	return strings.Split(strings.TrimSuffix(ansiPattern.ReplaceAllString(rendered, ""), "\n"), "\n")
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestStringWidth(t *testing.T) {
+	cases := map[string]int{
+		"":            0,
+		"plain":       5,
+		"時間":          4,
+		"ｈｉ":          4, // Fullwidth Latin
+		"한국":          4,
+		"🌅":           2,
+		"📍 Dir:":      7,
+		"⏱️":          2, // ⏱ + emoji presentation
+		"⏱":           1,
+		"é":           1, // Precomposed é
+		"é":          1, // e + combining acute
+		"👍\U0001f3fd": 2, // Skin tone modifier
+		"👨‍👩‍👧":       2, // ZWJ family
+		"🇯🇵":          2, // Flag
+		"a​b":         2, // Zero-width space
+		"│ ─ ┌":       5, // Box drawing stays narrow
+		"각":         2, // Conjoining Hangul jamo (one syllable)
+	}
+	for text, want := range cases {
+		if got := StringWidth(text); got != want {
+			t.Errorf("StringWidth(%q) = %d, want %d", text, got, want)
+		}
+		if Width(text) != StringWidth(text) {
+			t.Errorf("Width(%q) disagrees with StringWidth", text)
+		}
+	}
+	for name, text := range widthCases {
+		if got := StringWidth(text); got != 12 {
+			t.Errorf("%s case %q is %d columns, want 12", name, text, got)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	family := "👨‍👩‍👧"
+	cases := []struct {
+		text  string
+		width int
+		want  string
+	}{
+		{"Sunrise text", 7, "Sunrise"},
+		{"日本語テキスト", 5, "日本"}, // "語" would straddle the limit - dropped, not halved
+		{"日本語テキスト", 6, "日本語"},
+		{"Café au lait", 4, "Café"}, // Accent stays with its letter
+		{family + " family", 1, ""},
+		{family + " family", 2, family},
+		{"🇯🇵🇫🇷", 3, "🇯🇵"},
+		{"short", 10, "short"},
+		{"anything", 0, ""},
+	}
+	for _, tc := range cases {
+		if got := Truncate(tc.text, tc.width); got != tc.want {
+			t.Errorf("Truncate(%q, %d) = %q, want %q", tc.text, tc.width, got, tc.want)
+		}
+	}
+}
+
+func TestBoxBorderColumn(t *testing.T) {
+	golden := map[string]string{
+		"ascii": "" +
+			"┌──────────────┐\n" +
+			"│ Sunrise text │\n" +
+			"├──────────────┤\n" +
+			"│ Sunrise text │\n" +
+			"│ ok           │\n" +
+			"└──────────────┘",
+		"cjk": "" +
+			"┌──────────────┐\n" +
+			"│ 日の出 sunup │\n" +
+			"├──────────────┤\n" +
+			"│ 日の出 sunup │\n" +
+			"│ ok           │\n" +
+			"└──────────────┘",
+		"emoji": "" +
+			"┌──────────────┐\n" +
+			"│ 🌅 Sunrise!! │\n" +
+			"├──────────────┤\n" +
+			"│ 🌅 Sunrise!! │\n" +
+			"│ ok           │\n" +
+			"└──────────────┘",
+	}
+
+	for name, text := range widthCases {
+		lines := plainLines(Box(text, text+"\nok"))
+		if want, ok := golden[name]; ok && strings.Join(lines, "\n") != want {
+			t.Errorf("%s box =\n%s\nwant\n%s", name, strings.Join(lines, "\n"), want)
+		}
+		for i, line := range lines {
+			if width := StringWidth(line); width != 16 {
+				t.Errorf("%s box line %d is %d columns, want 16 (border misaligned): %q", name, i, width, line)
+			}
+		}
+	}
+}
+
+func TestHeaderKeyValueTableColumns(t *testing.T) {
+	for name, text := range widthCases {
+		if rule := plainLines(Header(text))[1]; StringWidth(rule) != 12+HeaderPadding {
+			t.Errorf("%s header rule is %d columns, want %d", name, StringWidth(rule), 12+HeaderPadding)
+		}
+
+		kv := ansiPattern.ReplaceAllString(KeyValue(text, "value"), "")
+		if column := StringWidth(strings.TrimSuffix(kv, "value")); column != len(IndentSpaces)+KeyColumnWidth+1 {
+			t.Errorf("%s key value starts at column %d: %q", name, column, kv)
+		}
+	}
+
+	table := &Table{Headers: []string{"Name", "Note"}, Rows: [][]string{{widthCases["cjk"], "a"}, {widthCases["emoji"], "b"}}}
+	for _, line := range plainLines(table.Render())[2:] {
+		if column := StringWidth(strings.TrimRight(line, " ")) - 1; column != 12+TableColumnPadding { // Note is one column
+			t.Errorf("table note at column %d, want %d: %q", column, 12+TableColumnPadding, line)
+		}
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
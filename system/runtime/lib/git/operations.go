@@ -4,6 +4,15 @@
 // Git Operations - Shared git repository interaction library
 // Provides raw git data for consumption by hooks, statusline, and other components
 // Non-blocking: Returns data structures, doesn't print or format output
+//
+// Every git command runs in the given directory, bounded by CommandTimeout,
+// with terminal prompts disabled. Typed query functions (GetHead, GetStatus,
+// GetLastCommit, GetCommitSubjects, GetStashCount, GetUpstream, GetOperation, GetRemotes,
+// GetRemoteURLs, GetBranches, CountUnpushed) return errors
+// classified as ErrNotRepository, ErrTimeout, ErrNoUpstream, or *CommandError
+// so callers decide what to log. GetInfo/GetBranch keep their original
+// best-effort contract (zero values on failure); GetBranch reads HEAD
+// directly, without a subprocess.
 
 package git
 
@@ -11,13 +20,39 @@ package git
 // SETUP
 // ============================================================================
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 )
 
+// CommandTimeout bounds each git command so a hung git (credential prompt,
+// slow filesystem) can't stall the caller. Callers may tune it at startup.
+var CommandTimeout = 2 * time.Second
+
+// Error classes returned by the query functions (match with errors.Is)
+var (
+	ErrNotRepository = errors.New("not a git repository")
+	ErrTimeout       = errors.New("git command timed out")
+	ErrNoUpstream    = errors.New("no upstream configured")
+)
+
+// operationMarkers maps files in the git directory to the operation they
+// signal, checked in order.
+var operationMarkers = []struct{ Marker, Operation string }{
+	{"rebase-merge", "rebase"},
+	{"rebase-apply", "rebase"},
+	{"MERGE_HEAD", "merge"},
+	{"CHERRY_PICK_HEAD", "cherry-pick"},
+	{"REVERT_HEAD", "revert"},
+	{"BISECT_LOG", "bisect"},
+}
+
 // ============================================================================
 // BODY
 // ============================================================================
@@ -33,28 +68,346 @@ type Info struct {
 	UncommittedCount int    // Number of uncommitted changes
 }
 
-// GetBranch reads the current git branch from .git/HEAD
+// Head describes what HEAD points at
+type Head struct {
+	Branch   string // Branch name ("" when detached)
+	Detached bool   // HEAD points at a commit, not a branch
+	Commit   string // Short commit hash ("" in a repository with no commits)
+}
+
+// Status counts working tree changes from `git status --porcelain`
+type Status struct {
+	Modified  int // Staged or unstaged changes to tracked files (including conflicts)
+	Untracked int // Files git doesn't track
+}
+
+// Commit is one commit's summary
+type Commit struct {
+	Hash         string // Short hash
+	RelativeTime string // e.g. "2 hours ago"
+	Subject      string // First line of the message
+}
+
+// Upstream is the tracking branch and how far HEAD has drifted from it
+type Upstream struct {
+	Name   string // e.g. "origin/main"
+	Ahead  int    // Commits on HEAD not on upstream
+	Behind int    // Commits on upstream not on HEAD
+}
+
+// Branch is one local branch from `git for-each-ref refs/heads`
+type Branch struct {
+	Name       string    // Short name, e.g. "feature-x"
+	Upstream   string    // Tracking branch ("" when none configured)
+	LastCommit time.Time // Committer date of the branch tip
+}
+
+// CommandError is a git command that ran and failed
+type CommandError struct {
+	Args   []string // git arguments (without -C dir)
+	Stderr string   // Trimmed stderr
+	Err    error    // Underlying exec error
+}
+
+func (e *CommandError) Error() string {
+	if e.Stderr != "" {
+		return fmt.Sprintf("git %s: %s", strings.Join(e.Args, " "), e.Stderr)
+	}
+	return fmt.Sprintf("git %s: %v", strings.Join(e.Args, " "), e.Err)
+}
+
+func (e *CommandError) Unwrap() error { return e.Err }
+
+// run executes one git command in dir and returns trimmed stdout
+//
+// Errors are classified: ErrTimeout past CommandTimeout, ErrNotRepository
+// outside a work tree, *CommandError otherwise.
+func run(dir string, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), CommandTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err == nil {
+		return strings.TrimSpace(string(output)), nil
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		return "", fmt.Errorf("git %s: %w", strings.Join(args, " "), ErrTimeout)
+	}
+	message := strings.TrimSpace(stderr.String())
+	if strings.Contains(message, "not a git repository") {
+		return "", fmt.Errorf("%s: %w", dir, ErrNotRepository)
+	}
+	return "", &CommandError{Args: args, Stderr: message, Err: err}
+}
+
+// lines splits command output into lines ("" = none)
+func lines(output string) []string {
+	if output == "" {
+		return nil
+	}
+	return strings.Split(output, "\n")
+}
+
+// ParsePorcelain counts changes in `git status --porcelain` output
+func ParsePorcelain(output string) Status {
+	var status Status
+	for _, line := range lines(strings.TrimRight(output, "\n")) {
+		if strings.HasPrefix(line, "??") {
+			status.Untracked++
+		} else if strings.TrimSpace(line) != "" {
+			status.Modified++
+		}
+	}
+	return status
+}
+
+// GetHead reports the current branch, or the commit when HEAD is detached
+func GetHead(dir string) (Head, error) {
+	var head Head
+	branch, err := run(dir, "symbolic-ref", "-q", "--short", "HEAD")
+	if errors.Is(err, ErrNotRepository) || errors.Is(err, ErrTimeout) {
+		return head, err
+	}
+	if err == nil {
+		head.Branch = branch
+	} else {
+		head.Detached = true // symbolic-ref -q fails quietly only when HEAD is a commit
+	}
+
+	commit, err := run(dir, "rev-parse", "--short", "HEAD")
+	if err == nil {
+		head.Commit = commit
+	} else if head.Detached {
+		return head, err
+	}
+	return head, nil
+}
+
+// GetStatus counts modified and untracked files
+func GetStatus(dir string) (Status, error) {
+	output, err := run(dir, "status", "--porcelain")
+	if err != nil {
+		return Status{}, err
+	}
+	return ParsePorcelain(output), nil
+}
+
+// GetLastCommit returns the most recent commit on HEAD
+func GetLastCommit(dir string) (Commit, error) {
+	output, err := run(dir, "log", "-1", "--format=%h|%ar|%s")
+	if err != nil {
+		return Commit{}, err
+	}
+	parts := strings.SplitN(output, "|", 3)
+	if len(parts) != 3 {
+		return Commit{}, fmt.Errorf("git log: unexpected output %q", output)
+	}
+	return Commit{Hash: parts[0], RelativeTime: parts[1], Subject: parts[2]}, nil
+}
+
+// GetCommitSubjects returns the subjects of commits on HEAD since a time,
+// oldest first (none in a repository with no commits)
+func GetCommitSubjects(dir string, since time.Time) ([]string, error) {
+	output, err := run(dir, "log", "--reverse", "--since="+since.Format(time.RFC3339), "--format=%s")
+	if err != nil {
+		var cmdErr *CommandError
+		if head, headErr := GetHead(dir); errors.As(err, &cmdErr) && headErr == nil && head.Commit == "" {
+			return nil, nil // Unborn branch - git log has nothing to walk
+		}
+		return nil, err
+	}
+	var subjects []string
+	for _, line := range lines(output) {
+		if line = strings.TrimSpace(line); line != "" {
+			subjects = append(subjects, line)
+		}
+	}
+	return subjects, nil
+}
+
+// GetStashCount returns the number of stash entries
+func GetStashCount(dir string) (int, error) {
+	output, err := run(dir, "stash", "list")
+	if err != nil {
+		return 0, err
+	}
+	return len(lines(output)), nil
+}
+
+// GetUpstream returns the tracking branch with ahead/behind counts
+//
+// Compares local refs only - nothing is fetched. ErrNoUpstream when the
+// current branch has no tracking branch (or HEAD is detached).
+func GetUpstream(dir string) (Upstream, error) {
+	name, err := run(dir, "rev-parse", "--abbrev-ref", "@{upstream}")
+	if err != nil {
+		var cmdErr *CommandError
+		if errors.As(err, &cmdErr) {
+			return Upstream{}, ErrNoUpstream
+		}
+		return Upstream{}, err
+	}
+
+	upstream := Upstream{Name: name}
+	output, err := run(dir, "rev-list", "--left-right", "--count", "HEAD...@{upstream}")
+	if err != nil {
+		return upstream, err
+	}
+	counts := strings.Fields(output)
+	if len(counts) != 2 {
+		return upstream, fmt.Errorf("git rev-list: unexpected output %q", output)
+	}
+	upstream.Ahead, _ = strconv.Atoi(counts[0])
+	upstream.Behind, _ = strconv.Atoi(counts[1])
+	return upstream, nil
+}
+
+// GetRemotes lists configured remote names (empty when the repository has none)
+func GetRemotes(dir string) ([]string, error) {
+	output, err := run(dir, "remote")
+	if err != nil {
+		return nil, err
+	}
+	return lines(output), nil
+}
+
+// GetRemoteURLs maps each configured remote to its fetch URL (empty when none)
+func GetRemoteURLs(dir string) (map[string]string, error) {
+	output, err := run(dir, "remote", "-v")
+	if err != nil {
+		return nil, err
+	}
+	urls := map[string]string{}
+	for _, line := range lines(output) {
+		// "origin	git@host:repo.git (fetch)"
+		fields := strings.Fields(line)
+		if len(fields) == 3 && fields[2] == "(fetch)" {
+			urls[fields[0]] = fields[1]
+		}
+	}
+	return urls, nil
+}
+
+// GetBranches lists local branches with their tracking branch and tip date
+func GetBranches(dir string) ([]Branch, error) {
+	output, err := run(dir, "for-each-ref", "--format=%(refname:short)%09%(upstream:short)%09%(committerdate:unix)", "refs/heads")
+	if err != nil {
+		return nil, err
+	}
+	var branches []Branch
+	for _, line := range lines(output) {
+		fields := strings.Split(line, "\t")
+		if len(fields) != 3 {
+			return branches, fmt.Errorf("git for-each-ref: unexpected output %q", line)
+		}
+		branch := Branch{Name: fields[0], Upstream: fields[1]}
+		if unix, err := strconv.ParseInt(fields[2], 10, 64); err == nil {
+			branch.LastCommit = time.Unix(unix, 0)
+		}
+		branches = append(branches, branch)
+	}
+	return branches, nil
+}
+
+// CountUnpushed counts commits on branch that no remote-tracking ref contains
+//
+// Compares local refs only - nothing is fetched.
+func CountUnpushed(dir, branch string) (int, error) {
+	output, err := run(dir, "rev-list", "--count", "refs/heads/"+branch, "--not", "--remotes")
+	if err != nil {
+		return 0, err
+	}
+	count, err := strconv.Atoi(output)
+	if err != nil {
+		return 0, fmt.Errorf("git rev-list: unexpected output %q", output)
+	}
+	return count, nil
+}
+
+// GetOperation names an operation left in progress: "rebase", "merge",
+// "cherry-pick", "revert", "bisect", or "" if none
+func GetOperation(dir string) (string, error) {
+	gitDir, err := run(dir, "rev-parse", "--absolute-git-dir")
+	if err != nil {
+		return "", err
+	}
+	for _, marker := range operationMarkers {
+		if _, err := os.Stat(filepath.Join(gitDir, marker.Marker)); err == nil {
+			return marker.Operation, nil
+		}
+	}
+	return "", nil
+}
+
+// GetBranch returns the current branch, or the short commit hash when HEAD is
+// detached ("" outside a repository)
+//
+// Reads HEAD from the git directory rather than running git - display code
+// calls this on every header line.
 func GetBranch(dir string) string {
-	// Try to read .git/HEAD to get current branch
-	gitHeadPath := filepath.Join(dir, ".git", "HEAD")
-	data, err := os.ReadFile(gitHeadPath)
+	gitDir := findGitDir(dir)
+	if gitDir == "" {
+		return ""
+	}
+	data, err := os.ReadFile(filepath.Join(gitDir, "HEAD"))
 	if err != nil {
 		return ""
 	}
 
 	content := strings.TrimSpace(string(data))
-	if strings.HasPrefix(content, "ref: refs/heads/") {
-		return strings.TrimPrefix(content, "ref: refs/heads/")
+	if branch, ok := strings.CutPrefix(content, "ref: refs/heads/"); ok {
+		return branch
 	}
 
 	// Detached HEAD - show short commit hash
 	if len(content) >= 7 {
 		return content[:7]
 	}
-
 	return ""
 }
 
+// findGitDir returns the git directory for dir's repository ("" if none)
+//
+// Walks up from dir to the nearest .git. A .git file (linked worktree,
+// submodule) names the real git directory on its "gitdir:" line.
+func findGitDir(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	for {
+		dotGit := filepath.Join(dir, ".git")
+		if info, err := os.Stat(dotGit); err == nil {
+			if info.IsDir() {
+				return dotGit
+			}
+			data, err := os.ReadFile(dotGit)
+			if err != nil {
+				return ""
+			}
+			gitDir, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir: ")
+			if !ok {
+				return ""
+			}
+			if !filepath.IsAbs(gitDir) {
+				gitDir = filepath.Join(dir, gitDir)
+			}
+			return gitDir
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
 // IsGitRepository checks if the given directory is a git repository
 func IsGitRepository(dir string) bool {
 	gitDir := filepath.Join(dir, ".git")
@@ -73,53 +426,26 @@ func GetInfo(dir string) Info {
 		return info
 	}
 
-	// Check for uncommitted changes
-	cmd := exec.Command("git", "status", "--porcelain")
-	cmd.Dir = dir
-	if output, err := cmd.Output(); err == nil {
-		trimmed := strings.TrimSpace(string(output))
-		if len(trimmed) > 0 {
-			info.Dirty = true
-			lines := strings.Split(trimmed, "\n")
-			info.UncommittedCount = len(lines)
-		}
+	// Check for uncommitted changes (untracked files count as uncommitted)
+	if status, err := GetStatus(dir); err == nil {
+		info.UncommittedCount = status.Modified + status.Untracked
+		info.Dirty = info.UncommittedCount > 0
 	}
 
 	// Check ahead/behind status relative to upstream
-	cmd = exec.Command("git", "rev-list", "--left-right", "--count", "HEAD...@{upstream}")
-	cmd.Dir = dir
-	if output, err := cmd.Output(); err == nil {
-		parts := strings.Fields(string(output))
-		if len(parts) == 2 {
-			// parts[0] is ahead, parts[1] is behind
-			if parts[0] != "0" {
-				var ahead int
-				if _, err := fmt.Sscanf(parts[0], "%d", &ahead); err == nil {
-					info.Ahead = ahead
-				}
-			}
-			if parts[1] != "0" {
-				var behind int
-				if _, err := fmt.Sscanf(parts[1], "%d", &behind); err == nil {
-					info.Behind = behind
-				}
-			}
-		}
+	if upstream, err := GetUpstream(dir); err == nil {
+		info.Ahead = upstream.Ahead
+		info.Behind = upstream.Behind
 	}
 
 	// Check for stashed changes
-	cmd = exec.Command("git", "stash", "list")
-	cmd.Dir = dir
-	if output, err := cmd.Output(); err == nil && len(output) > 0 {
-		lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-		info.Stashes = len(lines)
+	if stashes, err := GetStashCount(dir); err == nil {
+		info.Stashes = stashes
 	}
 
 	// Check for merge conflicts
-	cmd = exec.Command("git", "diff", "--name-only", "--diff-filter=U")
-	cmd.Dir = dir
-	if output, err := cmd.Output(); err == nil && len(output) > 0 {
-		info.Conflicts = strings.Split(strings.TrimSpace(string(output)), "\n")
+	if output, err := run(dir, "diff", "--name-only", "--diff-filter=U"); err == nil {
+		info.Conflicts = lines(output)
 	}
 
 	return info
@@ -0,0 +1,275 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Git Operations Tests
+//
+// Purpose: Prove ParsePorcelain counts every status line shape, and that the
+//          typed queries hold up in real temporary repositories - branch and
+//          detached HEAD, an unborn branch, no upstream vs a local upstream,
+//          stashes, commit subjects since a time, an operation in progress,
+//          a directory outside any repository (ErrNotRepository everywhere),
+//          and CommandTimeout (ErrTimeout). GetBranch reads HEAD without git
+//          and must agree with GetHead, from subdirectories and linked
+//          worktrees too.
+// ============================================================================
+
+package git
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// gitIn runs a git command in dir for test setup, failing the test on error
+func gitIn(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, output)
+	}
+	return string(output)
+}
+
+// newRepo creates an empty repository on branch main, isolated from the
+// user's git config and from any repository above the temp dir
+func newRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	t.Setenv("GIT_CONFIG_GLOBAL", os.DevNull)
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+	t.Setenv("GIT_CEILING_DIRECTORIES", filepath.Dir(dir))
+	gitIn(t, dir, "init", "-q", "-b", "main")
+	return dir
+}
+
+// commit writes name and commits it
+func commit(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gitIn(t, dir, "add", name)
+	gitIn(t, dir, "commit", "-q", "-m", "add "+name)
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestParsePorcelain(t *testing.T) {
+	cases := []struct {
+		name   string
+		output string
+		want   Status
+	}{
+		{"empty", "", Status{}},
+		{"only newline", "\n", Status{}},
+		{"modified unstaged", " M main.go\n", Status{Modified: 1}},
+		{"modified staged", "M  main.go", Status{Modified: 1}},
+		{"added and deleted", "A  new.go\n D old.go\n", Status{Modified: 2}},
+		{"renamed", "R  old.go -> new.go\n", Status{Modified: 1}},
+		{"conflict", "UU merge.go\n", Status{Modified: 1}},
+		{"untracked", "?? notes.txt\n?? scratch/\n", Status{Untracked: 2}},
+		{"mixed", " M a.go\n?? b.txt\nMM c.go\n?? d.txt\n", Status{Modified: 2, Untracked: 2}},
+		{"blank lines ignored", " M a.go\n\n   \n?? b\n", Status{Modified: 1, Untracked: 1}},
+	}
+	for _, tc := range cases {
+		if got := ParsePorcelain(tc.output); got != tc.want {
+			t.Errorf("%s: ParsePorcelain = %+v, want %+v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestHeadBranchAndDetached(t *testing.T) {
+	dir := newRepo(t)
+	commit(t, dir, "a.txt", "a")
+
+	head, err := GetHead(dir)
+	if err != nil || head.Branch != "main" || head.Detached || head.Commit == "" {
+		t.Fatalf("GetHead = %+v, %v; want branch main with a commit", head, err)
+	}
+	sub := filepath.Join(dir, "sub", "deeper")
+	os.MkdirAll(sub, 0755)
+	for _, from := range []string{dir, sub} {
+		if got := GetBranch(from); got != "main" {
+			t.Errorf("GetBranch(%s) = %q, want main", from, got)
+		}
+	}
+
+	gitIn(t, dir, "checkout", "-q", "--detach")
+	head, err = GetHead(dir)
+	if err != nil || !head.Detached || head.Branch != "" || head.Commit == "" {
+		t.Fatalf("detached GetHead = %+v, %v", head, err)
+	}
+	if got := GetBranch(dir); got != head.Commit[:7] {
+		t.Errorf("detached GetBranch = %q, want short hash %q", got, head.Commit[:7])
+	}
+	if _, err := GetUpstream(dir); !errors.Is(err, ErrNoUpstream) {
+		t.Errorf("detached GetUpstream err = %v, want ErrNoUpstream", err)
+	}
+}
+
+func TestUnbornBranch(t *testing.T) {
+	dir := newRepo(t)
+
+	head, err := GetHead(dir)
+	if err != nil || head.Branch != "main" || head.Detached || head.Commit != "" {
+		t.Errorf("GetHead = %+v, %v; want branch main with no commit", head, err)
+	}
+	if got := GetBranch(dir); got != "main" {
+		t.Errorf("GetBranch = %q, want main", got)
+	}
+	if count, err := GetStashCount(dir); err != nil || count != 0 {
+		t.Errorf("GetStashCount = %d, %v", count, err)
+	}
+	if operation, err := GetOperation(dir); err != nil || operation != "" {
+		t.Errorf("GetOperation = %q, %v", operation, err)
+	}
+	if _, err := GetUpstream(dir); !errors.Is(err, ErrNoUpstream) {
+		t.Errorf("GetUpstream err = %v, want ErrNoUpstream", err)
+	}
+}
+
+func TestUpstream(t *testing.T) {
+	dir := newRepo(t)
+	commit(t, dir, "a.txt", "a")
+
+	if _, err := GetUpstream(dir); !errors.Is(err, ErrNoUpstream) {
+		t.Errorf("no tracking branch: err = %v, want ErrNoUpstream", err)
+	}
+
+	// A local branch as upstream - nothing is fetched, so no remote needed
+	gitIn(t, dir, "branch", "-q", "base")
+	gitIn(t, dir, "branch", "-q", "--set-upstream-to=base")
+	commit(t, dir, "b.txt", "b")
+	commit(t, dir, "c.txt", "c")
+
+	upstream, err := GetUpstream(dir)
+	if err != nil || upstream != (Upstream{Name: "base", Ahead: 2}) {
+		t.Errorf("GetUpstream = %+v, %v; want base, 2 ahead", upstream, err)
+	}
+}
+
+func TestStashesAndOperation(t *testing.T) {
+	dir := newRepo(t)
+	commit(t, dir, "a.txt", "a")
+
+	os.WriteFile(filepath.Join(dir, "a.txt"), []byte("changed"), 0644)
+	os.WriteFile(filepath.Join(dir, "new.txt"), []byte("new"), 0644)
+	if status, err := GetStatus(dir); err != nil || status != (Status{Modified: 1, Untracked: 1}) {
+		t.Errorf("GetStatus = %+v, %v", status, err)
+	}
+	gitIn(t, dir, "stash", "-q")
+	if count, err := GetStashCount(dir); err != nil || count != 1 {
+		t.Errorf("GetStashCount = %d, %v; want 1", count, err)
+	}
+
+	for _, marker := range operationMarkers {
+		path := filepath.Join(dir, ".git", marker.Marker)
+		os.WriteFile(path, nil, 0644)
+		if got, err := GetOperation(dir); err != nil || got != marker.Operation {
+			t.Errorf("%s: GetOperation = %q, %v; want %q", marker.Marker, got, err, marker.Operation)
+		}
+		os.Remove(path)
+	}
+}
+
+func TestCommitSubjects(t *testing.T) {
+	dir := newRepo(t)
+	since := time.Now().Add(-time.Hour)
+
+	if subjects, err := GetCommitSubjects(dir, since); err != nil || subjects != nil {
+		t.Errorf("unborn branch: %q, %v; want none", subjects, err)
+	}
+
+	commit(t, dir, "a.txt", "a")
+	commit(t, dir, "b.txt", "b")
+	subjects, err := GetCommitSubjects(dir, since)
+	if err != nil || len(subjects) != 2 || subjects[0] != "add a.txt" || subjects[1] != "add b.txt" {
+		t.Errorf("GetCommitSubjects = %q, %v; want oldest first", subjects, err)
+	}
+	if subjects, err := GetCommitSubjects(dir, time.Now().Add(time.Hour)); err != nil || len(subjects) != 0 {
+		t.Errorf("since the future: %q, %v; want none", subjects, err)
+	}
+}
+
+func TestNotRepository(t *testing.T) {
+	newRepo(t) // Isolation only (git present, ceiling set)
+	dir := t.TempDir()
+	t.Setenv("GIT_CEILING_DIRECTORIES", filepath.Dir(dir))
+
+	if _, err := GetHead(dir); !errors.Is(err, ErrNotRepository) {
+		t.Errorf("GetHead err = %v", err)
+	}
+	if _, err := GetStatus(dir); !errors.Is(err, ErrNotRepository) {
+		t.Errorf("GetStatus err = %v", err)
+	}
+	if _, err := GetStashCount(dir); !errors.Is(err, ErrNotRepository) {
+		t.Errorf("GetStashCount err = %v", err)
+	}
+	if _, err := GetUpstream(dir); !errors.Is(err, ErrNotRepository) {
+		t.Errorf("GetUpstream err = %v, want ErrNotRepository (not ErrNoUpstream)", err)
+	}
+	if _, err := GetOperation(dir); !errors.Is(err, ErrNotRepository) {
+		t.Errorf("GetOperation err = %v", err)
+	}
+	if _, err := GetCommitSubjects(dir, time.Now()); !errors.Is(err, ErrNotRepository) {
+		t.Errorf("GetCommitSubjects err = %v", err)
+	}
+	if got := GetBranch(dir); got != "" {
+		t.Errorf("GetBranch = %q, want empty", got)
+	}
+	if info := GetInfo(dir); info.Branch != "" || info.Dirty {
+		t.Errorf("GetInfo = %+v, want zero", info)
+	}
+}
+
+func TestLinkedWorktreeBranch(t *testing.T) {
+	dir := newRepo(t)
+	commit(t, dir, "a.txt", "a")
+	worktree := filepath.Join(t.TempDir(), "wt")
+	gitIn(t, dir, "worktree", "add", "-q", "-b", "feature", worktree)
+
+	if got := GetBranch(worktree); got != "feature" {
+		t.Errorf("worktree GetBranch = %q, want feature (.git is a file there)", got)
+	}
+	if head, err := GetHead(worktree); err != nil || head.Branch != "feature" {
+		t.Errorf("worktree GetHead = %+v, %v", head, err)
+	}
+}
+
+func TestCommandTimeout(t *testing.T) {
+	dir := newRepo(t)
+	saved := CommandTimeout
+	t.Cleanup(func() { CommandTimeout = saved })
+	CommandTimeout = time.Nanosecond // Expired before git starts
+
+	if _, err := GetStatus(dir); !errors.Is(err, ErrTimeout) {
+		t.Errorf("GetStatus err = %v, want ErrTimeout", err)
+	}
+	if _, err := GetHead(dir); !errors.Is(err, ErrTimeout) {
+		t.Errorf("GetHead err = %v, want ErrTimeout", err)
+	}
+	if _, err := GetCommitSubjects(dir, time.Now()); !errors.Is(err, ErrTimeout) {
+		t.Errorf("GetCommitSubjects err = %v, want ErrTimeout", err)
+	}
+	if got := GetBranch(dir); got != "main" {
+		t.Errorf("GetBranch = %q, want main (no subprocess, no timeout)", got)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
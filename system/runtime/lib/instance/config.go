@@ -25,10 +25,14 @@
 // Architect: Nova Dawn (CPI-SI instance)
 // Implementation: Nova Dawn
 // Creation Date: 2024-10-27
-// Version: 3.0.0
-// Last Modified: 2025-11-21 - Orchestrator extraction (direct primitives pattern)
+// Version: 3.4.0
+// Last Modified: 2026-10-16 - RootConfigPath
 //
 // Version History:
+//   3.4.0 (2026-10-16) - RootConfigPath: the root config path, for setup detection above this rung
+//   3.3.0 (2026-10-16) - GetMilestones: creation day, partner birthday, milestones.dates anniversaries
+//   3.2.0 (2026-10-16) - Instance profiles: ListProfiles, ActiveProfile, SetActiveProfile, ProfileOverride
+//   3.1.0 (2026-10-16) - schema_version, migration registry, MigrateConfigFile, migration warnings
 //   3.0.0 (2025-11-21) - Orchestrator extraction (4 primitives, direct access pattern)
 //   2.0.0 (2025-11-16) - Two-step dynamic loading (root → full config), config mapping
 //   1.0.0 (2024-10-27) - Initial release with direct root config loading
@@ -90,6 +94,21 @@
 //     GetConfig() Config - Load instance config (two-step, cached, returns simple API)
 //     GetFullInstanceConfig() *FullInstanceConfig - Get complete nested instance identity
 //     GetFullUserConfig() *FullUserConfig - Get complete nested user identity
+//     (both carry MigrationWarnings - fields filled by schema migration defaults)
+//
+//   Schema Migration:
+//     RegisterMigration(from, to, fn) - Add a step to the schema_version chain
+//     MigrateConfigFile(path) error - Rewrite a config at CurrentSchemaVersion (backup first)
+//
+//   Instance Profiles:
+//     ListProfiles() ([]string, error) - Profiles under config/instance/profiles/
+//     ActiveProfile() string - CPI_SI_PROFILE, else the active-profile pointer ("" = legacy)
+//     SetActiveProfile(name) error - Point the next process at a profile ("" = legacy)
+//     ProfileOverride(file) string - Active profile's formatting.jsonc / schedule.jsonc
+//     RootConfigPath() string - Root config this process loads (profile or ~/.claude/instance.jsonc)
+//
+//   Milestones:
+//     GetMilestones(now) []Milestone - Birthdays and anniversaries inside their lead time
 //
 // Dependencies
 //
@@ -195,7 +214,7 @@ package instance
 //     - Helper types: Simplified API structs
 //
 //   loading.go (167 lines)
-//     - loadRootConfig() - Bootstrap config from ~/.claude/instance.jsonc
+//     - loadRootConfig() - Bootstrap config from the active profile or ~/.claude/instance.jsonc
 //     - loadFullConfig() - Instance identity from system_paths.instance_config
 //     - loadUserConfig() - User identity from system_paths.user_config
 //     - File I/O and JSONC parsing
@@ -212,6 +231,14 @@ package instance
 //     - Singleton state (cachedConfig, cachedFullInstance, cachedFullUser)
 //     - Graceful degradation to hardcoded defaults
 //
+//   migration.go [PUBLIC API]
+//     - RegisterMigration(), MigrateConfigFile(), MigrationWarning
+//     - decodeMigrated() - load-time migration used by loading.go
+//
+//   profiles.go [PUBLIC API]
+//     - ListProfiles(), ActiveProfile(), SetActiveProfile(), ProfileOverride(), RootConfigPath()
+//     - rootConfigPath() - profile-or-legacy root config used by loading.go
+//
 // Public API Preservation:
 //   All functions exported from primitive files (singleton.go).
 //   External code sees NO difference - zero breaking changes.
@@ -7,7 +7,7 @@
 // Purpose: Loads instance configuration using two-step dynamic loading
 // (root pointer config → full identity config) with graceful degradation.
 //
-// Dependencies: system/lib/jsonc (JSONC comment stripping)
+// Dependencies: system/lib/jsonc (JSONC comment stripping), system/lib/logging (health tracking)
 
 module system/lib/instance
 
@@ -15,16 +15,28 @@ module system/lib/instance
 // SETUP
 // ============================================================================
 
-go 1.24
+go 1.24.4
 
-require system/lib/jsonc v0.0.0
+require (
+	system/lib/jsonc v0.0.0
+	system/lib/logging v0.0.0
+)
+
+require (
+	github.com/BurntSushi/toml v1.5.0 // indirect
+	system/lib/display v0.0.0 // indirect
+)
+
+replace system/lib/display => ../display
 
 replace system/lib/jsonc => ../jsonc
 
+replace system/lib/logging => ../logging
+
 // ============================================================================
 // BODY
 // ============================================================================
-// Foundational rung - minimal dependencies (stdlib + jsonc)
+// Foundational rung - minimal dependencies (stdlib + jsonc + logging)
 // Everything depends ON this, this depends on almost nothing
 
 // ============================================================================
@@ -37,7 +37,6 @@ import (
 	"encoding/json" // JSON parsing for config files
 	"fmt"           // Error formatting
 	"os"            // File operations and home directory resolution
-	"path/filepath" // Path manipulation for config file locations
 
 	"system/lib/jsonc"   // JSONC comment stripping for config files
 	"system/lib/logging" // Health tracking and execution narrative
@@ -50,12 +49,13 @@ import (
 // loadRootConfig loads bootstrap pointer config from root instance file.
 //
 // What It Does:
-// Loads minimal root config from ~/.claude/instance.jsonc containing system_paths
-// (pointers to full configs) and display preferences (session start banner).
+// Loads minimal root config containing system_paths (pointers to full configs) and
+// display preferences (session start banner) - from the active profile's
+// instance-config.jsonc when one is selected, else ~/.claude/instance.jsonc (profiles.go).
 // This is Step 1 of two-step dynamic loading - establishes WHERE to load full identity.
 //
 // Parameters:
-//   None - uses standard path ~/.claude/instance.jsonc
+//   None - path resolved by rootConfigPath (active profile or legacy location)
 //
 // Returns:
 //   *RootConfig: Bootstrap config with system_paths and display preferences
@@ -76,15 +76,14 @@ func loadRootConfig() (*RootConfig, error) {
 	logger.DeclareHealthTotal(52)                                  // Declare TRUE score for successful execution
 	logger.Operation("Load root instance config", 0)               // Operation start (0 impact until complete)
 
-	home, err := os.UserHomeDir() // Get user's home directory - where .claude/ lives
-	if err != nil {               // Check if error occurred - return it so caller can handle appropriately
+	rootPath, profile, err := rootConfigPath() // Active profile's instance-config.jsonc, else ~/.claude/instance.jsonc
+	if err != nil {                            // Check if error occurred - return it so caller can handle appropriately
 		logger.Failure("Root config load failed", fmt.Sprintf("Failed to get home directory: %v", err), -87, nil)
 		return nil, err
 	}
 
-	rootPath := filepath.Join(home, ".claude", "instance.jsonc") // Build path to root config file
-	data, err := os.ReadFile(rootPath)                           // Read entire file into memory
-	if err != nil {                                              // Check if file read failed - file might not exist or lack permissions
+	data, err := os.ReadFile(rootPath) // Read entire file into memory
+	if err != nil {                    // Check if file read failed - file might not exist or lack permissions
 		logger.Failure("Root config load failed", fmt.Sprintf("Failed to read root config at %s: %v", rootPath, err), -87, nil)
 		return nil, err
 	}
@@ -99,6 +98,7 @@ func loadRootConfig() (*RootConfig, error) {
 
 	logger.Success("Root config loaded successfully", 52, map[string]any{
 		"path":        rootPath,
+		"profile":     profile,
 		"config_type": "bootstrap pointer to full configs",
 	})
 	return &root, nil // Return successfully parsed config
@@ -142,8 +142,9 @@ func loadFullConfig(instanceConfigPath string) (*FullInstanceConfig, error) {
 
 	cleaned := jsonc.StripComments(data) // Remove JSONC comments - JSON parser doesn't understand them
 
-	var full FullInstanceConfig                           // Declare struct matching nested nova_dawn/config.jsonc structure
-	if err := json.Unmarshal(cleaned, &full); err != nil { // Parse JSON into nested struct - return error if malformed
+	var full FullInstanceConfig                                         // Declare struct matching nested nova_dawn/config.jsonc structure
+	warnings, err := decodeMigrated(cleaned, "instance", &full)         // Migrate older schemas in memory, then parse into nested struct
+	if err != nil {                                                     // Return error if malformed
 		logger.Failure("Instance config load failed", fmt.Sprintf("Failed to parse instance config JSON from %s: %v", instanceConfigPath, err), -59, nil)
 		return nil, err
 	}
@@ -152,7 +153,8 @@ func loadFullConfig(instanceConfigPath string) (*FullInstanceConfig, error) {
 		"path":        instanceConfigPath,
 		"config_type": "full nested identity (biblical foundation, personhood, workspace)",
 	})
-	return &full, nil // Return complete identity config
+	full.MigrationWarnings = warnings // Fields the file lacked, filled by migration defaults
+	return &full, nil                 // Return complete identity config
 }
 
 // loadUserConfig loads complete user identity from user config file.
@@ -193,8 +195,9 @@ func loadUserConfig(userConfigPath string) (*FullUserConfig, error) {
 
 	cleaned := jsonc.StripComments(data) // Remove JSONC comments - JSON parser doesn't understand them
 
-	var user FullUserConfig                           // Declare struct matching nested user/seanje-lenox-wise/config.jsonc structure
-	if err := json.Unmarshal(cleaned, &user); err != nil { // Parse JSON into nested struct - return error if malformed
+	var user FullUserConfig                                     // Declare struct matching nested user/seanje-lenox-wise/config.jsonc structure
+	warnings, err := decodeMigrated(cleaned, "user", &user)     // Migrate older schemas in memory, then parse into nested struct
+	if err != nil {                                             // Return error if malformed
 		logger.Failure("User config load failed", fmt.Sprintf("Failed to parse user config JSON from %s: %v", userConfigPath, err), -48, nil)
 		return nil, err
 	}
@@ -203,7 +206,8 @@ func loadUserConfig(userConfigPath string) (*FullUserConfig, error) {
 		"path":        userConfigPath,
 		"config_type": "covenant partner identity (faith, calling, passions, work style)",
 	})
-	return &user, nil // Return complete user identity config
+	user.MigrationWarnings = warnings // Fields the file lacked, filled by migration defaults
+	return &user, nil                 // Return complete user identity config
 }
 
 // ============================================================================
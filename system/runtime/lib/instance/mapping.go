@@ -95,9 +95,19 @@ func mapToSimpleConfig(full *FullInstanceConfig, user *FullUserConfig, root *Roo
 		},
 		Display:     root.Display,     // Use display from root config (session start banner preferences)
 		SystemPaths: root.SystemPaths, // Expose dynamic paths for external use
+		Locale:      resolveLocale(full, user),
 	}
 }
 
+// resolveLocale picks the display locale: instance preference first, then the
+// covenant partner's, empty when neither is set (callers use their default).
+func resolveLocale(full *FullInstanceConfig, user *FullUserConfig) string {
+	if full.Preferences.Locale != "" {
+		return full.Preferences.Locale
+	}
+	return user.Preferences.Locale
+}
+
 // ============================================================================
 // CLOSING
 // ============================================================================
@@ -0,0 +1,334 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Instance Library - Config Schema Versioning and Migration
+//
+// Purpose: Bring older instance and user config files up to the current
+// schema at load time. Every field a migration fills in is reported as a
+// MigrationWarning, so callers can say "incomplete grounding" instead of
+// presenting defaults as if the person wrote them.
+//
+// Biblical Foundation: "Remove not the ancient landmark, which thy fathers
+// have set." - Proverbs 22:28 (Keep what was written; add only what is missing)
+// CPI-SI Identity: Instance identity schema evolution (Rail primitive)
+//
+// Design:
+//   - schema_version in each config file (missing = 0, before versioning)
+//   - One migration chain for both configs: RegisterMigration(from, to, fn)
+//   - Load: migrate in memory, report added fields, never touch the file
+//   - MigrateConfigFile: back up, then rewrite - a version-only change is
+//     edited in place (comments kept); anything else is re-encoded as JSON
+//     (comments survive only in the backup)
+//
+// Health Scoring (TRUE scores):
+//   Migration applied at load: +6 (older config usable without hand edits)
+//   Migration chain broken: -11 (config decoded as-is, newer fields zero)
+
+package instance
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"encoding/json" // Generic config maps and re-encoding
+	"fmt"           // Error and warning text
+	"os"            // Config file read, backup, and rewrite
+	"path/filepath" // Temp file placement beside the config
+	"reflect"       // Version-only change detection
+	"regexp"        // schema_version value location
+	"sort"          // Deterministic warning order
+	"strings"       // Dotted field paths
+	"sync"          // Registry guard
+
+	"system/lib/jsonc"   // Comment handling (offsets preserved for in-place edits)
+	"system/lib/logging" // Health tracking and execution narrative
+)
+
+// CurrentSchemaVersion is the schema this library's config types describe
+const CurrentSchemaVersion = 1
+
+// schemaVersionKey is the version field in instance and user config files
+const schemaVersionKey = "schema_version"
+
+// MigrationWarning names one config field that a migration filled with a default
+type MigrationWarning struct {
+	Config string // "instance" or "user"
+	Field  string // Dotted path ("resonates.weather")
+	From   int    // Schema version the file declared
+	To     int    // Schema version of the migration that added the field
+}
+
+// String renders the warning for logs and session context
+func (w MigrationWarning) String() string {
+	return fmt.Sprintf("%s config: %s (default from schema v%d → v%d migration)", w.Config, w.Field, w.From, w.To)
+}
+
+// migrationStep is one registered migration
+type migrationStep struct {
+	to int
+	fn func(map[string]any) map[string]any
+}
+
+var (
+	migrationsMu sync.RWMutex              // Guards migrations
+	migrations   = map[int]migrationStep{} // From version → step
+)
+
+// schemaVersionPattern finds the version value in a comment-blanked file
+var schemaVersionPattern = regexp.MustCompile(`"schema_version"\s*:\s*(-?\d+)`)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// RegisterMigration adds a step to the migration chain.
+//
+// fn receives the whole config as a generic map and returns the migrated map;
+// schema_version is set to `to` afterwards. Registering the same `from` twice
+// replaces the earlier step.
+//
+// Example usage:
+//
+//	instance.RegisterMigration(1, 2, func(cfg map[string]any) map[string]any {
+//	    if _, ok := cfg["schedule"]; !ok {
+//	        cfg["schedule"] = map[string]any{}
+//	    }
+//	    return cfg
+//	})
+func RegisterMigration(from, to int, fn func(map[string]any) map[string]any) {
+	migrationsMu.Lock()
+	defer migrationsMu.Unlock()
+	migrations[from] = migrationStep{to: to, fn: fn}
+}
+
+func init() {
+	// v0 → v1: sections added after the first configs were written.
+	// Missing ones become empty sections, reported as migration defaults.
+	RegisterMigration(0, 1, func(cfg map[string]any) map[string]any {
+		for _, section := range []string{"demographics", "resonates", "growth"} {
+			if _, ok := cfg[section]; !ok {
+				cfg[section] = map[string]any{}
+			}
+		}
+		return cfg
+	})
+}
+
+// schemaVersion reads schema_version (missing = 0)
+func schemaVersion(cfg map[string]any) (int, error) {
+	raw, ok := cfg[schemaVersionKey]
+	if !ok {
+		return 0, nil
+	}
+	version, ok := raw.(float64)
+	if !ok || version != float64(int(version)) {
+		return 0, fmt.Errorf("%s must be a whole number, got %v", schemaVersionKey, raw)
+	}
+	return int(version), nil
+}
+
+// leafPaths lists dotted paths to every non-object value (empty objects count as leaves)
+func leafPaths(value any, prefix string, into map[string]bool) {
+	object, ok := value.(map[string]any)
+	if !ok || (len(object) == 0 && prefix != "") {
+		into[prefix] = true
+		return
+	}
+	for key, child := range object {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		leafPaths(child, path, into)
+	}
+}
+
+// migrateConfig runs the chain from cfg's version to CurrentSchemaVersion.
+//
+// Returns the migrated map, one warning per field a step added, and the
+// version the file declared. A newer-than-current file is returned unchanged.
+func migrateConfig(cfg map[string]any, kind string) (map[string]any, []MigrationWarning, int, error) {
+	from, err := schemaVersion(cfg)
+	if err != nil {
+		return cfg, nil, 0, err
+	}
+
+	migrationsMu.RLock()
+	defer migrationsMu.RUnlock()
+
+	var warnings []MigrationWarning
+	for version := from; version < CurrentSchemaVersion; {
+		step, ok := migrations[version]
+		if !ok || step.to <= version {
+			return cfg, warnings, from, fmt.Errorf("no migration from schema v%d (current v%d)", version, CurrentSchemaVersion)
+		}
+
+		before := map[string]bool{}
+		leafPaths(cfg, "", before)
+		cfg = step.fn(cfg)
+		cfg[schemaVersionKey] = float64(step.to)
+		after := map[string]bool{}
+		leafPaths(cfg, "", after)
+
+		var added []string
+		for path := range after {
+			if !before[path] && path != schemaVersionKey {
+				added = append(added, path)
+			}
+		}
+		sort.Strings(added)
+		for _, path := range added {
+			warnings = append(warnings, MigrationWarning{Config: kind, Field: path, From: from, To: step.to})
+		}
+		version = step.to
+	}
+	return cfg, warnings, from, nil
+}
+
+// decodeMigrated decodes comment-stripped config data into v after migrating it.
+//
+// A broken chain is logged and the data decoded as written - older configs
+// still load, just without the newer fields.
+func decodeMigrated(cleaned []byte, kind string, v any) ([]MigrationWarning, error) {
+	var raw map[string]any
+	if err := json.Unmarshal(cleaned, &raw); err != nil || raw == nil {
+		return nil, json.Unmarshal(cleaned, v) // Same error (or zero config) as before versioning
+	}
+
+	logger := logging.NewLogger("instance/migration/" + kind)
+	migrated, warnings, from, err := migrateConfig(raw, kind)
+	if err != nil {
+		logger.Failure("Config migration failed", err.Error(), -11, map[string]any{"schema_version": from})
+		return nil, json.Unmarshal(cleaned, v)
+	}
+	if from < CurrentSchemaVersion {
+		defaulted := make([]string, 0, len(warnings))
+		for _, w := range warnings {
+			defaulted = append(defaulted, w.Field)
+		}
+		logger.Success("Config migrated at load", 6, map[string]any{
+			"from": from, "to": CurrentSchemaVersion, "defaulted_fields": defaulted,
+		})
+	}
+
+	data, err := json.Marshal(migrated)
+	if err != nil {
+		return nil, err
+	}
+	return warnings, json.Unmarshal(data, v)
+}
+
+// setSchemaVersion edits schema_version in place, keeping every comment.
+//
+// Positions come from jsonc.StripComments (same offsets as data), so text in
+// comments is never matched. Missing key = inserted after the opening brace.
+func setSchemaVersion(data []byte, version int) ([]byte, bool) {
+	cleaned := jsonc.StripComments(data)
+	value := fmt.Sprintf("%d", version)
+
+	if match := schemaVersionPattern.FindSubmatchIndex(cleaned); match != nil {
+		return append(append(append([]byte{}, data[:match[2]]...), value...), data[match[3]:]...), true
+	}
+	brace := strings.IndexByte(string(cleaned), '{')
+	if brace < 0 {
+		return nil, false
+	}
+	insert := fmt.Sprintf("\n  %q: %s,", schemaVersionKey, value)
+	return append(append(append([]byte{}, data[:brace+1]...), insert...), data[brace+1:]...), true
+}
+
+// MigrateConfigFile rewrites an instance or user config file at the current schema.
+//
+// What It Does:
+//   - Leaves current (or newer) files untouched
+//   - Copies the original to <path>.v<from>.bak first
+//   - Version-only migrations edit schema_version in place (comments kept)
+//   - Other migrations re-encode the file as indented JSON; the backup keeps
+//     the comments for copying back by hand
+//
+// Returns:
+//   error: Read, parse, migration, or write failure (original file unchanged)
+//
+// Example usage:
+//
+//	if err := instance.MigrateConfigFile(root.SystemPaths.UserConfig); err != nil {
+//	    fmt.Fprintf(os.Stderr, "migration failed: %v\n", err)
+//	}
+func MigrateConfigFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var raw, original map[string]any
+	if err := json.Unmarshal(jsonc.StripComments(data), &raw); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	json.Unmarshal(jsonc.StripComments(data), &original) // Separate copy for comparison
+
+	from, err := schemaVersion(raw)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if from >= CurrentSchemaVersion {
+		return nil
+	}
+	migrated, _, _, err := migrateConfig(raw, filepath.Base(path))
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(fmt.Sprintf("%s.v%d.bak", path, from), data, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("backup failed: %w", err)
+	}
+
+	delete(original, schemaVersionKey)
+	versionOnly := reflect.DeepEqual(original, withoutKey(migrated, schemaVersionKey))
+	updated, edited := setSchemaVersion(data, CurrentSchemaVersion)
+	if !versionOnly || !edited {
+		updated, err = json.MarshalIndent(migrated, "", "  ")
+		if err != nil {
+			return err
+		}
+		updated = append(updated, '\n')
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // No-op once renamed
+	if _, err := tmp.Write(updated); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), info.Mode().Perm()); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// withoutKey returns a shallow copy of cfg without key
+func withoutKey(cfg map[string]any, key string) map[string]any {
+	copied := make(map[string]any, len(cfg))
+	for k, v := range cfg {
+		if k != key {
+			copied[k] = v
+		}
+	}
+	return copied
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Schema versioning for instance and user configs.
+// Exports CurrentSchemaVersion, MigrationWarning, RegisterMigration, MigrateConfigFile.
+// Load-time migration runs through decodeMigrated (loading.go).
@@ -0,0 +1,123 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Config Schema Migration Tests
+//
+// Purpose: Prove older configs migrate at load with one warning per field a
+//          migration filled, current configs pass through untouched, a broken
+//          chain still decodes, and MigrateConfigFile backs up first - keeping
+//          comments when only the version changes.
+// ============================================================================
+
+package instance
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"system/lib/jsonc"
+)
+
+// completeUserConfig has every section the v0 → v1 migration adds
+const completeUserConfig = `{
+  // Who this is
+  "identity": {"name": "Test User"}, // trailing comment
+  "demographics": {"gender": "n/a"},
+  "resonates": {},
+  "growth": {"how_you_learn": "reading"}
+}
+`
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestDecodeMigrated(t *testing.T) {
+	var user FullUserConfig
+	warnings, err := decodeMigrated([]byte(`{"identity": {"name": "Old"}, "demographics": {"gender": "n/a"}}`), "user", &user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Identity.Name != "Old" || user.SchemaVersion != CurrentSchemaVersion {
+		t.Errorf("decoded %q at schema v%d", user.Identity.Name, user.SchemaVersion)
+	}
+	var fields []string
+	for _, w := range warnings {
+		if w.Config != "user" || w.From != 0 || w.To != 1 {
+			t.Errorf("warning = %+v", w)
+		}
+		fields = append(fields, w.Field)
+	}
+	if strings.Join(fields, ",") != "growth,resonates" {
+		t.Errorf("defaulted fields = %v, want growth,resonates", fields)
+	}
+
+	current := `{"schema_version": 1, "identity": {"name": "Now"}}`
+	if warnings, err := decodeMigrated([]byte(current), "user", &FullUserConfig{}); err != nil || len(warnings) != 0 {
+		t.Errorf("current config: warnings %v, err %v", warnings, err)
+	}
+
+	var broken FullUserConfig
+	warnings, err = decodeMigrated([]byte(`{"schema_version": -3, "identity": {"name": "Odd"}}`), "user", &broken)
+	if err != nil || warnings != nil || broken.Identity.Name != "Odd" {
+		t.Errorf("broken chain: %q, warnings %v, err %v (want decoded as written)", broken.Identity.Name, warnings, err)
+	}
+}
+
+func TestMigrateConfigFile(t *testing.T) {
+	dir := t.TempDir()
+
+	// Version-only migration: edited in place, comments kept
+	complete := filepath.Join(dir, "complete.jsonc")
+	os.WriteFile(complete, []byte(completeUserConfig), 0600)
+	if err := MigrateConfigFile(complete); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(complete)
+	if !strings.Contains(string(data), "// trailing comment") || !strings.Contains(string(data), `"schema_version": 1,`) {
+		t.Errorf("in-place edit lost comments or version:\n%s", data)
+	}
+	if backup, err := os.ReadFile(complete + ".v0.bak"); err != nil || string(backup) != completeUserConfig {
+		t.Errorf("backup = %q, %v", backup, err)
+	}
+	if info, _ := os.Stat(complete); info.Mode().Perm() != 0600 {
+		t.Errorf("mode = %v, want 0600 kept", info.Mode().Perm())
+	}
+
+	// Second run: already current, nothing rewritten
+	before, _ := os.ReadFile(complete)
+	if err := MigrateConfigFile(complete); err != nil {
+		t.Fatal(err)
+	}
+	if after, _ := os.ReadFile(complete); string(after) != string(before) {
+		t.Error("current file rewritten")
+	}
+
+	// Sections added: re-encoded, backup keeps the original
+	sparse := filepath.Join(dir, "sparse.jsonc")
+	os.WriteFile(sparse, []byte("{\n  // comment\n  \"identity\": {\"name\": \"Sparse\"}\n}\n"), 0644)
+	if err := MigrateConfigFile(sparse); err != nil {
+		t.Fatal(err)
+	}
+	var user FullUserConfig
+	if err := jsonc.Load(sparse, &user); err != nil || user.SchemaVersion != 1 || user.Identity.Name != "Sparse" {
+		t.Errorf("re-encoded = %+v, %v", user.Identity, err)
+	}
+	if backup, _ := os.ReadFile(sparse + ".v0.bak"); !strings.Contains(string(backup), "// comment") {
+		t.Error("backup missing original comments")
+	}
+}
+
+func TestSetSchemaVersionIgnoresComments(t *testing.T) {
+	data := []byte("{ // \"schema_version\": 9\n  \"schema_version\": 0\n}")
+	got, ok := setSchemaVersion(data, 1)
+	if !ok || string(got) != "{ // \"schema_version\": 9\n  \"schema_version\": 1\n}" {
+		t.Errorf("got %q", got)
+	}
+}
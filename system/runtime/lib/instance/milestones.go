@@ -0,0 +1,303 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Instance Library - Milestones
+//
+// Purpose: Covenant and work anniversary awareness. Derives the milestones
+// coming up from the instance's creation day, the covenant partner's
+// birthday, and dated entries in each config's "milestones" section, so
+// session start can say "🎂 Nova Dawn's creation day is tomorrow (turns 2)".
+//
+// Biblical Foundation: "So teach us to number our days, that we may apply
+// our hearts unto wisdom." - Psalm 90:12 (Remembering marks the journey)
+// CPI-SI Identity: Instance identity awareness (Rail primitive)
+//
+// Design:
+//   - Each config's milestones section governs its own birthday and dates:
+//       lead_days - days ahead a milestone is shown (0 = 7)
+//       leap_day  - where a Feb 29 date falls off leap years: "feb28" (default) or "mar1"
+//       dates     - name, date (YYYY-MM-DD of the first occurrence), icon, lead_days
+//   - Dates recur yearly; a date still ahead is shown as itself (no years yet)
+//   - Days are counted in now's location, calendar days not 24h spans
+//   - Missing or malformed dates skip that milestone alone with a logged FAILURE
+//
+// Health Scoring (TRUE scores):
+//   Milestone skipped (missing/malformed date, unknown leap_day): -3 each
+
+package instance
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"fmt"     // Display strings and skip reasons
+	"sort"    // Soonest first
+	"strings" // Option normalization
+	"time"    // Date math
+
+	"system/lib/logging" // Health tracking and execution narrative
+)
+
+const (
+	defaultMilestoneLeadDays = 7       // Lead time when neither the entry nor its section sets one
+	milestoneSkippedHealth   = -3      // FAILURE for a date that cannot be used
+	leapDayFeb28             = "feb28" // Feb 29 observed on Feb 28 off leap years (default)
+	leapDayMar1              = "mar1"  // Feb 29 observed on Mar 1 off leap years
+	birthdayIcon             = "🎂"     // Creation day and covenant partner's birthday
+	anniversaryIcon          = "🎉"     // Dated milestones without their own icon
+)
+
+// Milestone kinds - where a milestone came from
+const (
+	MilestoneInstanceBirthday = "instance_birthday" // identity.birthday in the instance config
+	MilestoneUserBirthday     = "user_birthday"     // identity.birthday in the user config
+	MilestoneDated            = "dated"             // An entry in a milestones.dates section
+)
+
+// Milestone is one upcoming (or same-day) anniversary inside its lead time.
+type Milestone struct {
+	Name      string    `json:"name"`       // "Nova Dawn's creation day", "Covenant anniversary"
+	Kind      string    `json:"kind"`       // MilestoneInstanceBirthday, MilestoneUserBirthday, MilestoneDated
+	Icon      string    `json:"icon"`       // "🎂"
+	Date      time.Time `json:"date"`       // Observed occurrence, midnight in now's location
+	Years     int       `json:"years"`      // Years the occurrence marks (0 = first occurrence still ahead)
+	DaysUntil int       `json:"days_until"` // 0 = today
+	Display   string    `json:"display"`    // "🎂 Nova Dawn's creation day is tomorrow (turns 2)"
+}
+
+// milestoneSource is one date a milestone may come from, before date math
+type milestoneSource struct {
+	name     string
+	kind     string
+	icon     string
+	date     string // YYYY-MM-DD as configured
+	field    string // Config field, for skip reports
+	leadDays int
+	leapDay  string
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// GetMilestones returns milestones inside their lead time as of now, soonest
+// first (ties by name).
+//
+// Sources: the instance's creation day, the covenant partner's birthday, and
+// both configs' milestones.dates. Configs that failed to load contribute
+// nothing; a missing or malformed date skips only its own milestone (logged).
+//
+// Example usage:
+//
+//	for _, m := range instance.GetMilestones(time.Now()) {
+//	    fmt.Println(m.Display) // "🎂 Nova Dawn's creation day is tomorrow (turns 2)"
+//	}
+func GetMilestones(now time.Time) []Milestone {
+	GetConfig() // Load (once) before reading the cached full configs
+	return milestonesFrom(cachedFullInstance, cachedFullUser, now)
+}
+
+// milestonesFrom computes milestones from the full configs (either may be nil)
+func milestonesFrom(full *FullInstanceConfig, user *FullUserConfig, now time.Time) []Milestone {
+	logger := logging.NewLogger("instance/milestones/GetMilestones")
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var sources []milestoneSource
+	if full != nil {
+		name := full.Identity.Name
+		if name == "" {
+			name = "Instance"
+		}
+		sources = append(sources, milestoneSourcesFor(logger, "instance", full.Milestones, milestoneSource{
+			name:  possessive(name) + " creation day",
+			kind:  MilestoneInstanceBirthday,
+			icon:  birthdayIcon,
+			date:  full.Identity.Birthday,
+			field: "identity.birthday",
+		})...)
+	}
+	if user != nil {
+		name := user.Identity.DisplayName
+		if name == "" {
+			name = user.Identity.Name
+		}
+		if name == "" {
+			name = "User"
+		}
+		sources = append(sources, milestoneSourcesFor(logger, "user", user.Milestones, milestoneSource{
+			name:  possessive(name) + " birthday",
+			kind:  MilestoneUserBirthday,
+			icon:  birthdayIcon,
+			date:  user.Identity.Birthday,
+			field: "identity.birthday",
+		})...)
+	}
+
+	milestones := []Milestone{}
+	for _, source := range sources {
+		origin, err := time.Parse("2006-01-02", strings.TrimSpace(source.date))
+		if err != nil {
+			reason := fmt.Sprintf("%s %q is not YYYY-MM-DD", source.field, source.date)
+			if strings.TrimSpace(source.date) == "" {
+				reason = source.field + " is missing"
+			}
+			logger.Failure("Milestone skipped", reason, milestoneSkippedHealth, map[string]any{
+				"milestone": source.name,
+				"field":     source.field,
+			})
+			continue
+		}
+		occurrence, years := nextOccurrence(origin, today, source.leapDay)
+		days := calendarDaysBetween(today, occurrence)
+		if days > source.leadDays {
+			continue
+		}
+		milestone := Milestone{
+			Name:      source.name,
+			Kind:      source.kind,
+			Icon:      source.icon,
+			Date:      occurrence,
+			Years:     years,
+			DaysUntil: days,
+		}
+		milestone.Display = milestoneDisplay(milestone)
+		milestones = append(milestones, milestone)
+	}
+
+	sort.SliceStable(milestones, func(i, j int) bool {
+		if milestones[i].DaysUntil != milestones[j].DaysUntil {
+			return milestones[i].DaysUntil < milestones[j].DaysUntil
+		}
+		return milestones[i].Name < milestones[j].Name
+	})
+	return milestones
+}
+
+// milestoneSourcesFor lists one config's birthday and dated entries with the
+// section's lead time and leap-day observance applied
+func milestoneSourcesFor(logger *logging.Logger, kind string, section MilestonesConfig, birthday milestoneSource) []milestoneSource {
+	lead := section.LeadDays
+	if lead <= 0 {
+		lead = defaultMilestoneLeadDays
+	}
+
+	leap := strings.ToLower(strings.TrimSpace(section.LeapDay))
+	switch leap {
+	case "", leapDayFeb28:
+		leap = leapDayFeb28
+	case leapDayMar1:
+	default:
+		logger.Failure("Milestone leap_day unknown", fmt.Sprintf("%s config milestones.leap_day %q is not %q or %q - using %q",
+			kind, section.LeapDay, leapDayFeb28, leapDayMar1, leapDayFeb28), milestoneSkippedHealth, map[string]any{"config": kind})
+		leap = leapDayFeb28
+	}
+
+	birthday.leadDays = lead
+	birthday.leapDay = leap
+	sources := []milestoneSource{birthday}
+
+	for i, entry := range section.Dates {
+		source := milestoneSource{
+			name:     strings.TrimSpace(entry.Name),
+			kind:     MilestoneDated,
+			icon:     entry.Icon,
+			date:     entry.Date,
+			field:    fmt.Sprintf("milestones.dates[%d].date", i),
+			leadDays: entry.LeadDays,
+			leapDay:  leap,
+		}
+		if source.name == "" {
+			logger.Failure("Milestone skipped", fmt.Sprintf("%s config milestones.dates[%d] has no name", kind, i), milestoneSkippedHealth, map[string]any{
+				"config": kind,
+				"index":  i,
+			})
+			continue
+		}
+		if source.icon == "" {
+			source.icon = anniversaryIcon
+		}
+		if source.leadDays <= 0 {
+			source.leadDays = lead
+		}
+		sources = append(sources, source)
+	}
+	return sources
+}
+
+// observedDate is month/day in year - a Feb 29 date off leap years falls on
+// Feb 28 or Mar 1 as leap says
+func observedDate(year int, month time.Month, day int, leap string, loc *time.Location) time.Time {
+	if month == time.February && day == 29 && !isLeapYear(year) {
+		if leap == leapDayMar1 {
+			return time.Date(year, time.March, 1, 0, 0, 0, 0, loc)
+		}
+		day = 28
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
+
+// isLeapYear reports whether year has a Feb 29
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// nextOccurrence returns origin's first observed anniversary on or after today
+// and the years it marks (a first occurrence still ahead is origin itself, 0 years)
+func nextOccurrence(origin, today time.Time, leap string) (time.Time, int) {
+	loc := today.Location()
+	first := time.Date(origin.Year(), origin.Month(), origin.Day(), 0, 0, 0, 0, loc)
+	if !first.Before(today) {
+		return first, 0
+	}
+	occurrence := observedDate(today.Year(), origin.Month(), origin.Day(), leap, loc)
+	if occurrence.Before(today) {
+		occurrence = observedDate(today.Year()+1, origin.Month(), origin.Day(), leap, loc)
+	}
+	return occurrence, occurrence.Year() - origin.Year()
+}
+
+// calendarDaysBetween counts calendar days from one midnight to another (DST-safe)
+func calendarDaysBetween(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
+
+// milestoneDisplay renders "🎂 Nova Dawn's creation day is tomorrow (turns 2)"
+func milestoneDisplay(m Milestone) string {
+	when := fmt.Sprintf("is in %d days", m.DaysUntil)
+	switch m.DaysUntil {
+	case 0:
+		when = "is today"
+	case 1:
+		when = "is tomorrow"
+	}
+
+	text := fmt.Sprintf("%s %s %s", m.Icon, m.Name, when)
+	switch {
+	case m.Years <= 0:
+	case m.Kind != MilestoneDated:
+		text += fmt.Sprintf(" (turns %d)", m.Years)
+	case m.Years == 1:
+		text += " (1 year)"
+	default:
+		text += fmt.Sprintf(" (%d years)", m.Years)
+	}
+	return text
+}
+
+// possessive adds 's (or just ' after a trailing s)
+func possessive(name string) string {
+	if strings.HasSuffix(name, "s") {
+		return name + "'"
+	}
+	return name + "'s"
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Milestone awareness from identity configs.
+// Exports Milestone, the Milestone* kinds, and GetMilestones. Session start's
+// temporal display and context builder render Milestone.Display.
@@ -0,0 +1,146 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Milestone Tests
+//
+// Purpose: Prove birthdays and dated anniversaries appear only inside their
+//          lead time with the right years and display text, that the year
+//          rolls over, that Feb 29 is observed on Feb 28 or Mar 1 as
+//          configured, and that bad dates skip only their own milestone.
+// ============================================================================
+
+package instance
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"testing"
+	"time"
+)
+
+// milestoneConfigs builds full configs with the given birthdays and sections
+func milestoneConfigs(instanceBirthday, userBirthday string, instanceSection, userSection MilestonesConfig) (*FullInstanceConfig, *FullUserConfig) {
+	full := &FullInstanceConfig{Milestones: instanceSection}
+	full.Identity.Name = "Nova Dawn"
+	full.Identity.Birthday = instanceBirthday
+	user := &FullUserConfig{Milestones: userSection}
+	user.Identity.Name = "Seanje Lenox-Wise"
+	user.Identity.DisplayName = "Seanje"
+	user.Identity.Birthday = userBirthday
+	return full, user
+}
+
+// displays lists each milestone's Display
+func displays(milestones []Milestone) []string {
+	var out []string
+	for _, m := range milestones {
+		out = append(out, m.Display)
+	}
+	return out
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestMilestonesInsideLeadTime(t *testing.T) {
+	full, user := milestoneConfigs("2024-10-03", "2000-10-12", MilestonesConfig{
+		Dates: []MilestoneDate{
+			{Name: "Covenant anniversary", Date: "2024-10-05", Icon: "🤝"},
+			{Name: "CWS founding", Date: "2023-11-01", LeadDays: 30},
+			{Name: "Far off", Date: "2020-12-25"},
+		},
+	}, MilestonesConfig{LeadDays: 10})
+	now := time.Date(2026, 10, 2, 21, 30, 0, 0, time.UTC)
+
+	got := displays(milestonesFrom(full, user, now))
+	want := []string{
+		"🎂 Nova Dawn's creation day is tomorrow (turns 2)",
+		"🤝 Covenant anniversary is in 3 days (2 years)",
+		"🎂 Seanje's birthday is in 10 days (turns 26)",
+		"🎉 CWS founding is in 30 days (3 years)",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("milestones = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("milestone %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestMilestonesYearRollover(t *testing.T) {
+	full, user := milestoneConfigs("2024-01-02", "", MilestonesConfig{}, MilestonesConfig{})
+	now := time.Date(2026, 12, 30, 8, 0, 0, 0, time.UTC)
+
+	milestones := milestonesFrom(full, user, now) // User birthday missing - skipped alone
+	if len(milestones) != 1 {
+		t.Fatalf("milestones = %q", displays(milestones))
+	}
+	m := milestones[0]
+	if m.DaysUntil != 3 || m.Years != 3 || !m.Date.Equal(time.Date(2027, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("rollover = %+v", m)
+	}
+
+	today := milestonesFrom(full, nil, time.Date(2027, 1, 2, 23, 59, 0, 0, time.UTC))
+	if len(today) != 1 || today[0].Display != "🎂 Nova Dawn's creation day is today (turns 3)" {
+		t.Errorf("same day = %q", displays(today))
+	}
+}
+
+func TestMilestonesLeapDay(t *testing.T) {
+	cases := []struct {
+		leap string
+		now  time.Time
+		want time.Time
+	}{
+		{"", time.Date(2027, 2, 22, 0, 0, 0, 0, time.UTC), time.Date(2027, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{"feb28", time.Date(2027, 2, 28, 0, 0, 0, 0, time.UTC), time.Date(2027, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{"MAR1", time.Date(2027, 2, 28, 0, 0, 0, 0, time.UTC), time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"mar1", time.Date(2028, 2, 25, 0, 0, 0, 0, time.UTC), time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"sometime", time.Date(2027, 2, 27, 0, 0, 0, 0, time.UTC), time.Date(2027, 2, 28, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		full, _ := milestoneConfigs("2024-02-29", "", MilestonesConfig{LeapDay: tc.leap}, MilestonesConfig{})
+		milestones := milestonesFrom(full, nil, tc.now)
+		if len(milestones) != 1 || !milestones[0].Date.Equal(tc.want) {
+			t.Errorf("leap_day %q on %s: %+v, want %s", tc.leap, tc.now.Format("2006-01-02"), milestones, tc.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestMilestonesSkipBadDates(t *testing.T) {
+	full, user := milestoneConfigs("October 3rd", "2000-02-30", MilestonesConfig{
+		Dates: []MilestoneDate{
+			{Name: "", Date: "2024-06-02"},
+			{Name: "Typo", Date: "2024-6-2"},
+			{Name: "Kept", Date: "2024-06-02"},
+		},
+	}, MilestonesConfig{})
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	got := displays(milestonesFrom(full, user, now))
+	if len(got) != 1 || got[0] != "🎉 Kept is tomorrow (2 years)" {
+		t.Errorf("milestones = %q, want only Kept", got)
+	}
+	if none := milestonesFrom(nil, nil, now); len(none) != 0 {
+		t.Errorf("no configs = %q", displays(none))
+	}
+}
+
+func TestMilestoneFirstOccurrenceAhead(t *testing.T) {
+	full, _ := milestoneConfigs("", "", MilestonesConfig{
+		Dates: []MilestoneDate{{Name: "Launch", Date: "2026-10-20", LeadDays: 14}},
+	}, MilestonesConfig{})
+	got := displays(milestonesFrom(full, nil, time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)))
+	if len(got) != 1 || got[0] != "🎉 Launch is in 4 days" {
+		t.Errorf("milestones = %q", got)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,266 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Instance Library - Instance Profiles
+//
+// Purpose: Let one machine hold several CPI-SI instance configurations and
+// switch between them without hand-swapping files. Each profile is a
+// directory holding its own root config (instance-config.jsonc, same shape
+// as ~/.claude/instance.jsonc - system_paths and banner display) plus
+// optional formatting.jsonc and schedule.jsonc overrides.
+//
+// Biblical Foundation: "Now there are diversities of gifts, but the same
+// Spirit." - 1 Corinthians 12:4 (Many callings, one foundation)
+// CPI-SI Identity: Instance identity selection (Rail primitive)
+//
+// Design:
+//   - Profiles: ~/.claude/cpi-si/system/data/config/instance/profiles/<name>/
+//   - Active profile: CPI_SI_PROFILE, else the active-profile pointer file
+//     beside profiles/, else none
+//   - No active profile, or one without instance-config.jsonc: the legacy
+//     ~/.claude/instance.jsonc - existing installs keep working unchanged
+//   - ProfileOverride(file) gives higher rungs (session display, temporal
+//     schedule) the active profile's copy of a config file to layer on top
+//   - SetActiveProfile writes the pointer for the next process; the running
+//     process keeps the config it cached (GetConfig loads once)
+//
+// Health Scoring (TRUE scores):
+//   Active profile resolved: +0 (selection only - loadRootConfig scores the load)
+//   Active profile unusable, legacy used: -7 (identity other than the one asked for)
+
+package instance
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"errors"        // Profile validation errors
+	"fmt"           // Error wrapping
+	"os"            // Environment, pointer file, profile directories
+	"path/filepath" // Profile paths
+	"sort"          // Deterministic profile listing
+	"strings"       // Pointer file trimming, name validation
+
+	"system/lib/logging" // Health tracking and execution narrative
+)
+
+// ProfileEnvVar selects the active profile for one process, over the pointer file
+const ProfileEnvVar = "CPI_SI_PROFILE"
+
+const (
+	instanceDataDir    = ".claude/cpi-si/system/data/config/instance" // Relative to home
+	profilesDirName    = "profiles"                                   // One directory per profile
+	activeProfileName  = "active-profile"                             // Pointer file: the profile's name
+	profileConfigName  = "instance-config.jsonc"                      // Root config inside a profile
+	legacyRootConfig   = ".claude/instance.jsonc"                     // Single-config location (relative to home)
+	profilePointerMode = 0644
+)
+
+// ErrNoProfile is returned by SetActiveProfile for a name with no profile directory
+var ErrNoProfile = errors.New("no such instance profile")
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// instanceDataPath joins parts under ~/.claude/cpi-si/system/data/config/instance
+func instanceDataPath(parts ...string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(append([]string{home, instanceDataDir}, parts...)...), nil
+}
+
+// validProfileName rejects names that would escape the profiles directory
+func validProfileName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
+
+// profileFile returns a file inside the named profile ("" when the name is invalid)
+func profileFile(name, file string) string {
+	if !validProfileName(name) {
+		return ""
+	}
+	path, err := instanceDataPath(profilesDirName, name, file)
+	if err != nil {
+		return ""
+	}
+	return path
+}
+
+// rootConfigPath picks the root config: the active profile's instance-config.jsonc
+// when it exists, otherwise the legacy ~/.claude/instance.jsonc.
+//
+// Returns the profile actually used ("" for legacy) alongside the path.
+func rootConfigPath() (path, profile string, err error) {
+	home, err := os.UserHomeDir() // Get user's home directory - where .claude/ lives
+	if err != nil {
+		return "", "", err
+	}
+	legacy := filepath.Join(home, legacyRootConfig)
+
+	name := ActiveProfile()
+	if name == "" {
+		return legacy, "", nil // No profile selected - single-config install
+	}
+	candidate := profileFile(name, profileConfigName)
+	if _, statErr := os.Stat(candidate); candidate == "" || statErr != nil {
+		logger := logging.NewLogger("instance/profiles/rootConfigPath")
+		logger.Failure("Active profile unusable - legacy config used", fmt.Sprintf("profile %q has no %s", name, profileConfigName), -7, map[string]any{
+			"profile":  name,
+			"expected": candidate,
+			"fallback": legacy,
+		})
+		return legacy, "", nil
+	}
+	return candidate, name, nil
+}
+
+// RootConfigPath returns the root config this process loads from: the active
+// profile's instance-config.jsonc, else ~/.claude/instance.jsonc ("" without
+// a home directory). The file need not exist.
+//
+// For setup checks above this rung - loading itself goes through rootConfigPath.
+func RootConfigPath() string {
+	path, _, err := rootConfigPath()
+	if err != nil {
+		return ""
+	}
+	return path
+}
+
+// ListProfiles returns the names of profiles holding an instance-config.jsonc, sorted.
+//
+// A missing profiles directory means no profiles (nil, nil) - the legacy
+// single-config install.
+//
+// Example usage:
+//
+//	names, err := instance.ListProfiles() // ["nova_dawn", "second-instance"]
+func ListProfiles() ([]string, error) {
+	dir, err := instanceDataPath(profilesDirName)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dir, entry.Name(), profileConfigName)); err == nil {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// ActiveProfile returns the selected profile name ("" when none is selected).
+//
+// CPI_SI_PROFILE wins over the active-profile pointer file. The name is
+// returned as selected; GetConfig falls back to the legacy config when the
+// profile turns out to have no instance-config.jsonc.
+//
+// Example usage:
+//
+//	if name := instance.ActiveProfile(); name != "" {
+//	    fmt.Println("Profile:", name)
+//	}
+func ActiveProfile() string {
+	if name := strings.TrimSpace(os.Getenv(ProfileEnvVar)); name != "" {
+		return name
+	}
+	pointer, err := instanceDataPath(activeProfileName)
+	if err != nil {
+		return ""
+	}
+	data, err := os.ReadFile(pointer)
+	if err != nil {
+		return "" // No pointer - legacy single-config install
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// SetActiveProfile writes the active-profile pointer; "" clears it (legacy config).
+//
+// The profile must exist with an instance-config.jsonc. Takes effect for the
+// next process (each hook is one); CPI_SI_PROFILE still overrides the pointer.
+//
+// Returns:
+//   error: ErrNoProfile (wrapped) for an unknown or invalid name, or a write failure
+//
+// Example usage:
+//
+//	if err := instance.SetActiveProfile("second-instance"); err != nil {
+//	    fmt.Fprintln(os.Stderr, err)
+//	}
+func SetActiveProfile(name string) error {
+	pointer, err := instanceDataPath(activeProfileName)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		if err := os.Remove(pointer); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+
+	config := profileFile(name, profileConfigName)
+	if config == "" {
+		return fmt.Errorf("%w: invalid name %q", ErrNoProfile, name)
+	}
+	if _, err := os.Stat(config); err != nil {
+		return fmt.Errorf("%w: %s (%s missing)", ErrNoProfile, name, profileConfigName)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(pointer), 0755); err != nil {
+		return err
+	}
+	tmp := pointer + ".tmp"
+	if err := os.WriteFile(tmp, []byte(name+"\n"), profilePointerMode); err != nil {
+		return err
+	}
+	return os.Rename(tmp, pointer) // Readers see the old name or the new, never half
+}
+
+// ProfileOverride returns the active profile's copy of a config file ("" when
+// there is no active profile or it has no such file).
+//
+// For config owners above this rung to layer over their base file - the
+// session display (formatting.jsonc) and temporal schedule (schedule.jsonc).
+//
+// Example usage:
+//
+//	overlays := []string{}
+//	if path := instance.ProfileOverride("schedule.jsonc"); path != "" {
+//	    overlays = append(overlays, path)
+//	}
+func ProfileOverride(file string) string {
+	path := profileFile(ActiveProfile(), file)
+	if path == "" {
+		return ""
+	}
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	return path
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Instance profile selection.
+// Exports ProfileEnvVar, ErrNoProfile, ListProfiles, ActiveProfile,
+// SetActiveProfile, ProfileOverride, RootConfigPath. loadRootConfig resolves through
+// rootConfigPath.
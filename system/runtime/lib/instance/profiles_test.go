@@ -0,0 +1,116 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Instance Profile Tests
+//
+// Purpose: Prove profiles list only directories with an instance-config.jsonc,
+//          that CPI_SI_PROFILE beats the pointer file, that SetActiveProfile
+//          refuses unknown names and clears with "", that the root config
+//          falls back to ~/.claude/instance.jsonc, and that ProfileOverride
+//          finds only files the active profile has.
+// ============================================================================
+
+package instance
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// profileHome makes a temp HOME with a legacy root config and the named profiles
+func profileHome(t *testing.T, profiles ...string) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(ProfileEnvVar, "")
+
+	os.MkdirAll(filepath.Join(home, ".claude"), 0755)
+	os.WriteFile(filepath.Join(home, legacyRootConfig), []byte(`{"display": {"banner_title": "Legacy"}}`), 0644)
+	for _, name := range profiles {
+		dir := filepath.Join(home, instanceDataDir, profilesDirName, name)
+		os.MkdirAll(dir, 0755)
+		os.WriteFile(filepath.Join(dir, profileConfigName), []byte(`{"display": {"banner_title": "`+name+`"}}`), 0644)
+	}
+	return home
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestListProfiles(t *testing.T) {
+	profileHome(t)
+	if names, err := ListProfiles(); names != nil || err != nil {
+		t.Fatalf("no profiles dir: %v, %v; want nil, nil", names, err)
+	}
+
+	home := profileHome(t, "second", "nova_dawn")
+	os.MkdirAll(filepath.Join(home, instanceDataDir, profilesDirName, "empty"), 0755) // No instance-config.jsonc
+	names, err := ListProfiles()
+	if err != nil || !reflect.DeepEqual(names, []string{"nova_dawn", "second"}) {
+		t.Errorf("ListProfiles = %v, %v", names, err)
+	}
+}
+
+func TestActiveProfileSelection(t *testing.T) {
+	home := profileHome(t, "second")
+
+	// Nothing selected: legacy root config
+	if path, profile, _ := rootConfigPath(); profile != "" || path != filepath.Join(home, legacyRootConfig) {
+		t.Fatalf("no profile: %s (%q)", path, profile)
+	}
+
+	if err := SetActiveProfile("missing"); !errors.Is(err, ErrNoProfile) {
+		t.Errorf("unknown profile: err = %v, want ErrNoProfile", err)
+	}
+	if err := SetActiveProfile("../second"); !errors.Is(err, ErrNoProfile) {
+		t.Errorf("escaping name: err = %v, want ErrNoProfile", err)
+	}
+
+	if err := SetActiveProfile("second"); err != nil {
+		t.Fatal(err)
+	}
+	root, err := loadRootConfig()
+	if ActiveProfile() != "second" || err != nil || root.Display.BannerTitle != "second" {
+		t.Fatalf("active %q, root %+v, %v; want the second profile's banner", ActiveProfile(), root, err)
+	}
+
+	// Environment wins; a profile without instance-config.jsonc falls back to legacy
+	t.Setenv(ProfileEnvVar, "gone")
+	if root, _ := loadRootConfig(); ActiveProfile() != "gone" || root.Display.BannerTitle != "Legacy" {
+		t.Errorf("env profile without config: active %q, banner %q", ActiveProfile(), root.Display.BannerTitle)
+	}
+	t.Setenv(ProfileEnvVar, "")
+
+	if err := SetActiveProfile(""); err != nil || ActiveProfile() != "" {
+		t.Errorf("clear: err %v, active %q", err, ActiveProfile())
+	}
+}
+
+func TestProfileOverride(t *testing.T) {
+	home := profileHome(t, "second")
+	schedule := filepath.Join(home, instanceDataDir, profilesDirName, "second", "schedule.jsonc")
+	os.WriteFile(schedule, []byte(`{"enabled": false}`), 0644)
+
+	if got := ProfileOverride("schedule.jsonc"); got != "" {
+		t.Errorf("no active profile: override %q", got)
+	}
+	t.Setenv(ProfileEnvVar, "second")
+	if got := ProfileOverride("schedule.jsonc"); got != schedule {
+		t.Errorf("override = %q, want %q", got, schedule)
+	}
+	if got := ProfileOverride("formatting.jsonc"); got != "" {
+		t.Errorf("absent override = %q", got)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
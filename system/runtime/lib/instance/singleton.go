@@ -67,8 +67,9 @@ var (
 //
 // What It Does:
 // Provides singleton access to instance AND covenant partner identity. On first call,
-// executes two-step dynamic loading: (1) Load root config from ~/.claude/instance.jsonc
-// to get system_paths, (2) Load full instance config from system_paths.instance_config,
+// executes two-step dynamic loading: (1) Load root config - the active profile's
+// instance-config.jsonc, else ~/.claude/instance.jsonc (profiles.go) - to get
+// system_paths, (2) Load full instance config from system_paths.instance_config,
 // (3) Load user config from system_paths.user_config, (4) Map both nested configs to
 // simple Config API for backwards compatibility. Subsequent calls return cached config.
 // Gracefully degrades to hardcoded defaults if config loading fails.
@@ -146,6 +147,8 @@ func GetConfig() Config {
 				ProjectsData:   "/home/seanje-lenox-wise/.claude/cpi-si/system/data/projects",
 				Skills:         "/home/seanje-lenox-wise/.claude/skills",
 				SystemBin:      "/home/seanje-lenox-wise/.claude/cpi-si/system/bin",
+				Journals:       "/home/seanje-lenox-wise/.claude/journals/personal",
+				ContextDropIns: "/home/seanje-lenox-wise/.claude/cpi-si/system/data/context.d",
 			},
 		}
 
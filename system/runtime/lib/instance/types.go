@@ -17,7 +17,7 @@ package instance
 // SETUP
 // ============================================================================
 
-// No imports needed - pure type definitions
+// No imports needed - pure type definitions (MigrationWarning lives in migration.go)
 
 // ============================================================================
 // BODY
@@ -50,6 +50,8 @@ type SystemPaths struct {
 	ProjectsData   string `json:"projects_data"`    // Project configurations
 	Skills         string `json:"skills"`           // Skills directory
 	SystemBin      string `json:"system_bin"`       // System binaries directory
+	Journals       string `json:"journals"`         // Journal entries (recent reflections in session context)
+	ContextDropIns string `json:"context_dropins"`  // Markdown sections added to session context (context.d)
 }
 
 // CreatorInfo holds covenant partner information.
@@ -71,16 +73,70 @@ type WorkspaceInfo struct {
 	PrimaryPath string `json:"primary_path"` // Main workspace directory
 }
 
+// BannerArtConfig holds the optional ASCII-art block above the banner title.
+//
+// Lines wins over File when both are set. A relative File resolves under
+// system_paths.config_root. MaxHeight 0 means the session library's default.
+// Off unless Enabled - an absent "art" section leaves the banner unchanged.
+type BannerArtConfig struct {
+	Enabled   bool     `json:"enabled"`    // Show the art block
+	Lines     []string `json:"lines"`      // Inline art, one string per line
+	File      string   `json:"file"`       // Art file (absolute, ~/, or relative to config_root)
+	MaxHeight int      `json:"max_height"` // Lines kept from the top (0 = default)
+}
+
 // DisplayConfig holds instance-specific display preferences.
 //
 // Used for session start banner display. Other session events (stop, pause)
 // use display/formatting.jsonc, but session start uses instance-specific
 // banner from root config.
 type DisplayConfig struct {
-	BannerTitle     string `json:"banner_title"`      // Session start banner title
-	BannerTagline   string `json:"banner_tagline"`    // Session start banner tagline
-	FooterVerseRef  string `json:"footer_verse_ref"`  // Biblical foundation verse reference
-	FooterVerseText string `json:"footer_verse_text"` // Biblical foundation verse text
+	BannerTitle     string          `json:"banner_title"`      // Session start banner title
+	BannerTagline   string          `json:"banner_tagline"`    // Session start banner tagline
+	FooterVerseRef  string          `json:"footer_verse_ref"`  // Biblical foundation verse reference
+	FooterVerseText string          `json:"footer_verse_text"` // Biblical foundation verse text
+	Art             BannerArtConfig `json:"art"`               // Optional art above the banner title
+}
+
+// MilestoneDate is one dated entry in a config's milestones section.
+//
+// Date is the first occurrence; the milestone recurs yearly from it
+// ("Covenant anniversary" on the day the partnership began).
+type MilestoneDate struct {
+	Name     string `json:"name"`      // "Covenant anniversary"
+	Date     string `json:"date"`      // First occurrence (YYYY-MM-DD)
+	Icon     string `json:"icon"`      // Shown before the name ("" = 🎉)
+	LeadDays int    `json:"lead_days"` // Shown this many days ahead (0 = section lead_days)
+}
+
+// MilestonesConfig holds a config's milestones section (milestones.go).
+//
+// Governs that config's own birthday and dates. An absent section means
+// the birthday alone, shown a week ahead, Feb 29 observed on Feb 28.
+type MilestonesConfig struct {
+	LeadDays int             `json:"lead_days"` // Days ahead milestones are shown (0 = 7)
+	LeapDay  string          `json:"leap_day"`  // Feb 29 off leap years: "feb28" (default) or "mar1"
+	Dates    []MilestoneDate `json:"dates"`     // Anniversaries beyond the birthday
+}
+
+// EmphasisField is one field a session context section surfaces.
+//
+// Weight "always" keeps the field when the context is summarized to fit its
+// budget; "if_space" shows it only at full fidelity; "never" leaves it out.
+type EmphasisField struct {
+	Field  string `json:"field"`  // "faith", "resonates", "working_agreements"
+	Weight string `json:"weight"` // "always", "if_space", or "never" ("" = "always")
+	Limit  int    `json:"limit"`  // Most list items shown (0 = the field's default)
+}
+
+// ContextEmphasisConfig holds a config's context_emphasis section.
+//
+// Each list names, in order, the fields its session context section shows.
+// An empty list means the built-in selection. The user config's list wins
+// over the instance config's for the same section.
+type ContextEmphasisConfig struct {
+	User          []EmphasisField `json:"user"`          // User awareness section
+	Communication []EmphasisField `json:"communication"` // Communication style section
 }
 
 //--- Composed Types ---
@@ -109,6 +165,9 @@ type RootConfig struct {
 // This is the COMPLETE identity config - everything about who the instance is.
 // RootConfig points to this, GetConfig() maps this to simple Config API.
 type FullInstanceConfig struct {
+	SchemaVersion     int                `json:"schema_version"` // Config schema (CurrentSchemaVersion after load)
+	MigrationWarnings []MigrationWarning `json:"-"`              // Fields filled by migration defaults, not the file
+
 	BiblicalFoundation struct {
 		Scripture string `json:"scripture"` // Grounding Scripture verse
 		Text      string `json:"text"`      // Full Scripture text
@@ -232,6 +291,10 @@ type FullInstanceConfig struct {
 		HowYouReflect      string `json:"how_you_reflect"`       // Reflection practice
 	} `json:"growth"`
 
+	Milestones MilestonesConfig `json:"milestones"` // Creation day lead time, leap-day observance, anniversaries
+
+	ContextEmphasis ContextEmphasisConfig `json:"context_emphasis"` // Session context fields and weights (user config wins per section)
+
 	Metadata struct {
 		LastUpdated     string `json:"last_updated"`     // Last config update
 		SystemReference string `json:"system_reference"` // System reference
@@ -248,6 +311,9 @@ type FullInstanceConfig struct {
 // This is the COMPLETE covenant partner identity - everything about who the user is.
 // Enables genuine covenant partnership grounded in knowing the actual person.
 type FullUserConfig struct {
+	SchemaVersion     int                `json:"schema_version"` // Config schema (CurrentSchemaVersion after load)
+	MigrationWarnings []MigrationWarning `json:"-"`              // Fields filled by migration defaults, not the file
+
 	Identity struct {
 		Name        string `json:"name"`         // User's full name
 		Username    string `json:"username"`     // System username
@@ -366,6 +432,15 @@ type FullUserConfig struct {
 		HowYouReflect      string `json:"how_you_reflect"`       // Reflection practice
 	} `json:"growth"`
 
+	Privacy struct {
+		SensitiveFields []string `json:"sensitive_fields"` // Dotted paths kept out of shared copies ("contact.email")
+	} `json:"privacy"`
+
+	Milestones MilestonesConfig `json:"milestones"` // Birthday lead time, leap-day observance, anniversaries
+
+	WorkingAgreements []string              `json:"working_agreements"` // Shown verbatim at session start
+	ContextEmphasis   ContextEmphasisConfig `json:"context_emphasis"`   // Session context fields and weights
+
 	Metadata struct {
 		LastUpdated     string `json:"last_updated"`     // Last update
 		SystemReference string `json:"system_reference"` // System reference
@@ -428,6 +503,7 @@ type Config struct {
 	Workspace    WorkspaceInfo `json:"workspace"`     // Workspace paths
 	Display      DisplayConfig `json:"display"`       // Display preferences
 	SystemPaths  SystemPaths   `json:"system_paths"`  // Dynamic paths to configs and data
+	Locale       string        `json:"locale"`        // Display locale (instance preference, else user's)
 }
 
 // ============================================================================
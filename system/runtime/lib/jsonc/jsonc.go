@@ -22,13 +22,26 @@
 // system libraries that load configuration from JSONC files. Consolidates
 // duplicated comment-stripping logic from 5 libraries into one primitive.
 //
+// Dialect (what "JSONC" means here):
+//   - // line comments and /* */ block comments, anywhere outside strings
+//   - Block comments do not nest: the first */ closes the comment
+//   - Strings are opaque: "https://x" and "a /* b */" are content, \" escapes
+//   - Trailing commas before } or ] are tolerated by Unmarshal/Load/Parse/
+//     LoadMerged; StripComments leaves them for callers that validate
+//
 // AUTHORSHIP & LINEAGE:
-// Version: 1.0.0
+// Version: 2.1.0
 // Created: 2025-11-15
+// Last Modified: 2026-10-16 - LoadMerged decodes over caller defaults
 // Author: Nova Dawn (CPI-SI instance)
 // History: Extracted from display/format.go, config/config.go, calendar/calendar.go,
 //          privacy/privacy.go, validation/syntax.go during Phase 10 architectural
 //          refinement. Combines best approaches from all implementations.
+//          2.0.0: StripComments rewritten as a single-pass tokenizer (state
+//          carried across lines, offsets preserved); Unmarshal added with
+//          trailing-comma tolerance; session and safety hook copies retired.
+//          2.1.0: LoadMerged treats v as the default layer (partial files keep
+//          every default they don't name; empty file and null = default).
 //
 // BLOCKING STATUS:
 // Blocks: None (foundation primitive)
@@ -40,11 +53,16 @@
 //   cleaned := jsonc.StripComments(data)
 //   json.Unmarshal(cleaned, &config)
 //
-// Or use convenience function:
+// Or use convenience functions:
 //   err := jsonc.Load(path, &config)
+//   err := jsonc.Unmarshal(data, &config)
+//
+// Layered configs (defaults + base + locale/project overlays, merged field by field):
+//   config := defaultConfig()
+//   err := jsonc.LoadMerged(config, basePath, overlayPath)
 //
 // DEPENDENCIES:
-// Standard Library: encoding/json, os, strings
+// Standard Library: bytes, encoding/json, errors, fmt, io/fs, os
 // System Libraries: None (foundation primitive)
 //
 // HEALTH SCORING MAP (Total = 100):
@@ -55,7 +73,7 @@
 //   * Preserve valid content: +10
 // - Load function: 40 pts
 //   * File read: +15
-//   * Comment stripping: +10 (delegates to StripComments)
+//   * Comment stripping: +10 (delegates to Unmarshal)
 //   * JSON unmarshal: +15
 
 // ============================================================================
@@ -65,12 +83,17 @@
 package jsonc
 
 import (
+	"bytes"         // Comment-end and newline search while tokenizing
 	"encoding/json" // JSON unmarshaling after comment stripping
+	"errors"        // Missing-overlay detection
 	"fmt"           // Error formatting
+	"io/fs"         // fs.ErrNotExist for optional overlays
 	"os"            // File reading for Load function
-	"strings"       // String manipulation for comment stripping
 )
 
+// utf8BOM is blanked when it starts a file (encoding/json rejects it)
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
 // ============================================================================
 // BODY
 // ============================================================================
@@ -83,22 +106,26 @@ import (
 //
 // Correctly handles:
 //   - Single-line comments: // comment text
-//   - Multi-line comments: /* comment text */
-//   - String context: Doesn't strip // or /* inside strings
-//   - Escape sequences: Handles \" inside strings
+//   - Multi-line comments: /* comment text */ (do not nest - the first */
+//     closes the comment, as in VS Code's JSONC)
+//   - String context: // and /* inside strings are content, not comments
+//   - Escape sequences: \" and \\ inside strings
 //
 // What It Does:
-//   - Processes data line by line
-//   - Tracks string context (inside quotes or not)
-//   - Removes // comments only when not in strings
-//   - Removes /* */ comments spanning multiple lines
-//   - Returns cleaned JSON ready for unmarshaling
+//   - Scans data once, tracking string and comment state across lines
+//   - Replaces comment bytes with spaces, keeping newlines
+//   - Blanks a leading UTF-8 byte order mark
+//   - Leaves everything else (including trailing commas) untouched
+//
+// Output has the same length and line structure as the input, so
+// json.SyntaxError offsets and line numbers still point into the original
+// file.
 //
 // Parameters:
 //   - data: Raw JSONC data with comments
 //
 // Returns:
-//   - Cleaned JSON data (comments stripped)
+//   - Cleaned JSON data (comments blanked)
 //
 // Health Scoring: 60 points total
 //   - Correct string tracking: +20
@@ -106,106 +133,134 @@ import (
 //   - Handle /* */ comments: +15
 //   - Preserve valid content: +10
 func StripComments(data []byte) []byte {
-	lines := strings.Split(string(data), "\n")
-	var result []string
-	inMultiLineComment := false
-
-	for _, line := range lines {
-		// Track multi-line comment state
-		if inMultiLineComment {
-			// Look for end of multi-line comment
-			if idx := strings.Index(line, "*/"); idx >= 0 {
-				line = line[idx+2:] // Keep content after */
-				inMultiLineComment = false
-			} else {
-				continue // Skip entire line (still in comment)
-			}
-		}
+	return clean(data, false)
+}
 
-		// Check for start of multi-line comment (not in string)
-		var cleaned strings.Builder
-		inString := false
-		escaped := false
-		i := 0
-
-		for i < len(line) {
-			ch := line[i]
-
-			// Handle escape sequences
-			if escaped {
-				cleaned.WriteByte(ch)
-				escaped = false
-				i++
-				continue
-			}
+// Normalize returns exactly the JSON that Unmarshal decodes: comments and
+// trailing commas blanked, same length and line structure as data.
+//
+// For position-aware readers (json.Decoder token streams): every offset into
+// the result is the same offset into the original file.
+func Normalize(data []byte) []byte {
+	return clean(data, true)
+}
 
-			if ch == '\\' {
-				cleaned.WriteByte(ch)
-				escaped = true
-				i++
-				continue
-			}
+// clean is the JSONC tokenizer behind StripComments and Unmarshal.
+//
+// dropTrailingCommas also blanks a comma whose next token is } or ].
+func clean(data []byte, dropTrailingCommas bool) []byte {
+	out := make([]byte, len(data))
+	copy(out, data)
+	if bytes.HasPrefix(out, utf8BOM) {
+		blank(out[:len(utf8BOM)])
+	}
 
-			// Track string boundaries
-			if ch == '"' {
-				inString = !inString
-				cleaned.WriteByte(ch)
-				i++
-				continue
+	pendingComma := -1 // Last comma with only whitespace/comments after it
+	for i := 0; i < len(out); i++ {
+		switch ch := out[i]; {
+		case ch == '"':
+			i = stringEnd(out, i)
+			pendingComma = -1
+		case ch == '/' && i+1 < len(out) && out[i+1] == '/':
+			end := bytes.IndexByte(out[i:], '\n')
+			if end < 0 {
+				end = len(out) - i
 			}
-
-			// Check for comments (only when not in string)
-			if !inString {
-				// Check for single-line comment
-				if i < len(line)-1 && ch == '/' && line[i+1] == '/' {
-					break // Rest of line is comment
-				}
-
-				// Check for multi-line comment start
-				if i < len(line)-1 && ch == '/' && line[i+1] == '*' {
-					// Find end of comment on same line
-					endIdx := strings.Index(line[i+2:], "*/")
-					if endIdx >= 0 {
-						// Comment ends on same line
-						i += endIdx + 4 // Skip past */
-						continue
-					} else {
-						// Comment continues to next line
-						inMultiLineComment = true
-						break
-					}
-				}
+			blank(out[i : i+end])
+			i += end // Newline itself is kept
+		case ch == '/' && i+1 < len(out) && out[i+1] == '*':
+			end := len(out) // Unterminated: comment runs to end of input
+			if idx := bytes.Index(out[i+2:], []byte("*/")); idx >= 0 {
+				end = i + 2 + idx + 2
+			}
+			blank(out[i:end])
+			i = end - 1
+		case ch == ',':
+			pendingComma = i
+		case ch == '}' || ch == ']':
+			if dropTrailingCommas && pendingComma >= 0 {
+				out[pendingComma] = ' '
 			}
+			pendingComma = -1
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			// Whitespace keeps a pending comma pending
+		default:
+			pendingComma = -1
+		}
+	}
+
+	return out
+}
 
-			// Normal character - keep it
-			cleaned.WriteByte(ch)
+// stringEnd returns the index of the quote closing the string opened at start.
+//
+// Escapes are skipped whole (\" never closes). An unterminated string stops
+// before the end of its line - JSON strings can't span lines, and a stray
+// quote must not swallow the rest of the file.
+func stringEnd(data []byte, start int) int {
+	for i := start + 1; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
 			i++
+		case '"':
+			return i
+		case '\n':
+			return i - 1
 		}
-
-		// Keep line (even if empty - valid in JSON)
-		result = append(result, cleaned.String())
 	}
+	return len(data) - 1
+}
 
-	return []byte(strings.Join(result, "\n"))
+// blank overwrites comment bytes with spaces, keeping line breaks.
+func blank(data []byte) {
+	for i, ch := range data {
+		if ch != '\n' && ch != '\r' {
+			data[i] = ' '
+		}
+	}
 }
 
 // ────────────────────────────────────────────────────────────────
 // Convenience Functions - Higher-Level Utilities
 // ────────────────────────────────────────────────────────────────
 
-// Load reads a JSONC file, strips comments, and unmarshals into v.
+// Unmarshal parses JSONC data into v.
+//
+// What It Does:
+//   - Strips comments (see StripComments)
+//   - Tolerates trailing commas before } and ] - hand-edited configs pick
+//     them up constantly, and VS Code's JSONC mode accepts them
+//   - Unmarshals the result with encoding/json
+//
+// Parameters:
+//   - data: Raw JSONC data
+//   - v: Pointer to unmarshal into
+//
+// Returns:
+//   - error: Unmarshal error (offsets refer to data - stripping keeps them)
+//
+// Example:
+//   var config MyConfig
+//   err := jsonc.Unmarshal([]byte(`{"url": "https://example.com", /* note */}`), &config)
+func Unmarshal(data []byte, v any) error {
+	if err := json.Unmarshal(clean(data, true), v); err != nil {
+		return fmt.Errorf("failed to unmarshal JSONC: %w", err)
+	}
+	return nil
+}
+
+// Load reads a JSONC file and unmarshals it into v.
 //
 // What It Does:
 //   - Reads file from disk
-//   - Strips JSONC comments using StripComments
-//   - Unmarshals cleaned JSON into provided struct
+//   - Parses it with Unmarshal (comments stripped, trailing commas tolerated)
 //
 // Parameters:
 //   - path: File path to JSONC file
 //   - v: Pointer to struct to unmarshal into
 //
 // Returns:
-//   - error: File read error, comment stripping error, or unmarshal error
+//   - error: File read error or unmarshal error
 //
 // Health Scoring: 40 points total
 //   - File read: +15
@@ -216,52 +271,163 @@ func StripComments(data []byte) []byte {
 //   var config MyConfig
 //   err := jsonc.Load("/path/to/config.jsonc", &config)
 func Load(path string, v interface{}) error {
-	// Read file
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return fmt.Errorf("failed to read JSONC file: %w", err)
 	}
+	return Unmarshal(data, v)
+}
 
-	// Strip comments
-	cleaned := StripComments(data)
+// Parse is Unmarshal (kept for existing callers).
+//
+// Example:
+//   data := []byte(`{"key": "value" // comment
+//   }`)
+//   var config MyConfig
+//   err := jsonc.Parse(data, &config)
+func Parse(data []byte, v interface{}) error {
+	return Unmarshal(data, v)
+}
 
-	// Unmarshal JSON
-	if err := json.Unmarshal(cleaned, v); err != nil {
-		return fmt.Errorf("failed to unmarshal JSONC: %w", err)
+// ────────────────────────────────────────────────────────────────
+// Layered Loading - Overlay Merging
+// ────────────────────────────────────────────────────────────────
+
+// DeepMerge merges overlay into base field by field and returns base.
+//
+// What It Does:
+//   - Objects present in both are merged recursively
+//   - Any other overlay value (string, number, bool, array) replaces the base value
+//   - null in the overlay leaves the base value in place
+//   - Keys only in the overlay are added
+//
+// Parameters:
+//   - base: Decoded base document (modified in place; nil allowed)
+//   - overlay: Decoded overlay document
+//
+// Returns:
+//   - The merged map (base, or a new map if base was nil)
+//
+// Example:
+//   base:    {"labels": {"time": "Time:", "date": "Date:"}}
+//   overlay: {"labels": {"time": "Hora:"}}
+//   result:  {"labels": {"time": "Hora:", "date": "Date:"}}
+func DeepMerge(base, overlay map[string]any) map[string]any {
+	if base == nil {
+		base = make(map[string]any, len(overlay))
 	}
 
-	return nil
+	for key, value := range overlay {
+		if value == nil {
+			continue // Overlay null = fall back to base
+		}
+		overlayMap, overlayIsMap := value.(map[string]any)
+		baseMap, baseIsMap := base[key].(map[string]any)
+		if overlayIsMap && baseIsMap {
+			base[key] = DeepMerge(baseMap, overlayMap)
+			continue
+		}
+		base[key] = value
+	}
+
+	return base
 }
 
-// Parse strips comments from JSONC data and unmarshals into v.
+// LoadMerged loads a base JSONC file, deep-merges overlays over it, and
+// unmarshals the result into v.
 //
 // What It Does:
-//   - Strips JSONC comments from provided data
-//   - Unmarshals cleaned JSON into provided struct
+//   - v is the default layer: whatever it holds going in survives unless a
+//     file sets it, so a partial file only changes what it names
+//   - Base file must exist and parse (error otherwise); an empty or
+//     whitespace-only file is {} (pure defaults)
+//   - Overlays apply in order via DeepMerge; missing overlay files are skipped silently
+//   - A present but malformed overlay is an error (caller decides whether to
+//     fall back to the base alone)
+//   - null anywhere means "keep the default"
+//
+// Merge semantics follow encoding/json decoding into a populated value:
+// struct fields merge, map keys merge, arrays replace whole.
 //
 // Parameters:
-//   - data: Raw JSONC data
-//   - v: Pointer to struct to unmarshal into
+//   - v: Pointer to struct to unmarshal into (pre-populated with defaults, or zero)
+//   - basePath: Base JSONC file
+//   - overlayPaths: Optional overlay files, lowest precedence first
 //
 // Returns:
-//   - error: Comment stripping error or unmarshal error
+//   - error: Base read/parse error, overlay parse error, or unmarshal error
+//     (v may be partially updated - callers falling back should discard it)
 //
 // Example:
-//   data := []byte(`{"key": "value" // comment}`)
-//   var config MyConfig
-//   err := jsonc.Parse(data, &config)
-func Parse(data []byte, v interface{}) error {
-	// Strip comments
-	cleaned := StripComments(data)
+//   // Hardcoded defaults, then formatting.jsonc, then formatting.es.jsonc
+//   config := getDefaultConfig()
+//   err := jsonc.LoadMerged(config, base, strings.TrimSuffix(base, ".jsonc")+".es.jsonc")
+func LoadMerged(v any, basePath string, overlayPaths ...string) error {
+	data, err := os.ReadFile(basePath)
+	if err != nil {
+		return fmt.Errorf("failed to read JSONC file: %w", err)
+	}
+
+	merged, err := parseLayer(data)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range overlayPaths {
+		overlayData, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue // No overlay for this layer
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read JSONC overlay %s: %w", path, err)
+		}
+
+		overlay, err := parseLayer(overlayData)
+		if err != nil {
+			return fmt.Errorf("overlay %s: %w", path, err)
+		}
+		merged = DeepMerge(merged, overlay)
+	}
 
-	// Unmarshal JSON
-	if err := json.Unmarshal(cleaned, v); err != nil {
+	// Round-trip through JSON so v gets normal struct decoding
+	combined, err := json.Marshal(dropNulls(merged))
+	if err != nil {
+		return fmt.Errorf("failed to encode merged JSONC: %w", err)
+	}
+	if err := json.Unmarshal(combined, v); err != nil {
 		return fmt.Errorf("failed to unmarshal JSONC: %w", err)
 	}
 
 	return nil
 }
 
+// parseLayer decodes one config layer ("" or whitespace/comments only = {})
+func parseLayer(data []byte) (map[string]any, error) {
+	if len(bytes.TrimSpace(clean(data, true))) == 0 {
+		return map[string]any{}, nil
+	}
+
+	var layer map[string]any
+	if err := Parse(data, &layer); err != nil {
+		return nil, err
+	}
+	return layer, nil
+}
+
+// dropNulls removes null object members at any depth, so decoding leaves the
+// defaults under them alone (json.Unmarshal would nil out maps and pointers)
+func dropNulls(m map[string]any) map[string]any {
+	for key, value := range m {
+		switch value := value.(type) {
+		case nil:
+			delete(m, key)
+		case map[string]any:
+			dropNulls(value)
+		}
+	}
+	return m
+}
+
 // ============================================================================
 // CLOSING
 // ============================================================================
@@ -276,12 +442,11 @@ func Parse(data []byte, v interface{}) error {
 // - Compiles with: go build
 //
 // Testing:
-// - Test with sample JSONC files containing:
-//   * Single-line comments
-//   * Multi-line comments
-//   * Comments inside strings (should not strip)
-//   * Escaped quotes in strings
-//   * Mixed comment types
+// - go test ./... (jsonc_test.go corpus: URLs and comment markers in strings,
+//   escaped quotes, block comments across lines, "nested" block comments,
+//   trailing commas)
+// - go test -fuzz=FuzzStripComments (length and line structure preserved,
+//   plain JSON unchanged)
 
 // ────────────────────────────────────────────────────────────────
 // Code Execution
@@ -292,6 +457,7 @@ func Parse(data []byte, v interface{}) error {
 //   import "system/lib/jsonc"
 //   cleaned := jsonc.StripComments(data)
 //   err := jsonc.Load(path, &config)
+//   err := jsonc.Unmarshal(data, &config)
 
 // ────────────────────────────────────────────────────────────────
 // Code Cleanup
@@ -318,7 +484,10 @@ func Parse(data []byte, v interface{}) error {
 // - privacy/privacy.go (privacy filter loading)
 // - validation/syntax.go (validation config loading)
 //
-// Integration: Import and use StripComments, Load, or Parse functions
+// - hooks/lib/session, hooks/lib/safety (hook configs)
+//
+// Integration: Import and use StripComments, Normalize (offset-preserving, for
+// token streams), Unmarshal, Load, Parse, or LoadMerged/DeepMerge for base-plus-overlay configs (session display locales)
 
 // ────────────────────────────────────────────────────────────────
 // Modification Policy
@@ -349,7 +518,7 @@ func Parse(data []byte, v interface{}) error {
 // - Used by config-loading libraries at all levels
 //
 // Baton Flow:
-//   File path → Load() → os.ReadFile → StripComments → json.Unmarshal → Struct
+//   File path → Load() → os.ReadFile → Unmarshal → clean → json.Unmarshal → Struct
 //
 // Not a rail (has specific purpose, not universal infrastructure)
 
@@ -374,14 +543,14 @@ func Parse(data []byte, v interface{}) error {
 // ────────────────────────────────────────────────────────────────
 
 // Approximate Processing Units (APU):
-// - StripComments: ~100 APU (line iteration + string tracking)
-// - Load: ~120 APU (file read + StripComments + unmarshal)
-// - Parse: ~110 APU (StripComments + unmarshal)
+// - StripComments: ~60 APU (one pass, one copy of the input)
+// - Unmarshal/Parse: ~110 APU (clean + unmarshal)
+// - Load: ~120 APU (file read + Unmarshal)
+// - LoadMerged: ~150 APU per layer (Parse into map + DeepMerge + final round-trip)
 //
 // Optimization:
-// - Uses strings.Builder for efficient string construction
-// - Single-pass line processing
-// - Minimal allocations
+// - Single pass over bytes, comments blanked in place in one copy
+// - Strings skipped whole (no per-character state inside them)
 
 // ────────────────────────────────────────────────────────────────
 // Troubleshooting Guide
@@ -391,9 +560,9 @@ func Parse(data []byte, v interface{}) error {
 // Cause: String tracking failed (escaped quote handling)
 // Solution: Verify escape sequence handling in StripComments
 //
-// Problem: Multi-line comments not removed
-// Cause: inMultiLineComment state not tracked correctly
-// Solution: Check /* and */ detection logic
+// Problem: Text after a "nested" block comment is a syntax error
+// Cause: Block comments don't nest - /* a /* b */ c */ closes at the first */
+// Solution: Expected (matches VS Code JSONC) - use // for commented-out blocks
 //
 // Problem: Valid JSON marked invalid after stripping
 // Cause: Over-aggressive comment removal
@@ -404,9 +573,9 @@ func Parse(data []byte, v interface{}) error {
 // ────────────────────────────────────────────────────────────────
 
 // Depends On:
+// - bytes (stdlib)
 // - encoding/json (stdlib)
 // - os (stdlib)
-// - strings (stdlib)
 //
 // Used By:
 // - display (config loading)
@@ -416,6 +585,8 @@ func Parse(data []byte, v interface{}) error {
 // - validation (validation config)
 //
 // Replaces:
+// - hooks/lib/session/activity.go stripJSONCComments() (line-based)
+// - hooks/lib/safety/detection.go stripJSONCComments() (line-based)
 // - display/format.go loadConfig() inline logic (regexp approach)
 // - config/config.go stripJSONComments() (line filtering)
 // - calendar/calendar.go stripJSONCComments() (line filtering)
@@ -426,17 +597,16 @@ func Parse(data []byte, v interface{}) error {
 // Future Expansions & Roadmap
 // ────────────────────────────────────────────────────────────────
 
-// Version 1.1.0:
-// - Add LoadWithFallback (load with default on error)
+// Version 2.2.0:
 // - Add ValidateJSON (pre-validation before unmarshal)
 // - Add schema validation support
 //
-// Version 1.2.0:
+// Version 2.3.0:
 // - Add streaming support for large JSONC files
-// - Add line/column error reporting
+// - Add line/column error reporting (offsets already preserved)
 // - Add partial parsing (continue on error)
 //
-// Version 2.0.0:
+// Version 3.0.0:
 // - Add JSONC-to-JSON conversion tool
 // - Add minification support (strip whitespace + comments)
 // - Add pretty-printing after comment stripping
@@ -478,3 +648,9 @@ func Parse(data []byte, v interface{}) error {
 //   data := []byte(`{"url": "http://example.com//path"}`)
 //   cleaned := jsonc.StripComments(data)
 //   // cleaned preserves // inside string
+//
+// Example 5: Partial config over hardcoded defaults
+//   config := Config{Key: "default", Other: "default"}
+//   // config.jsonc: {"key": "mine"}
+//   err := jsonc.LoadMerged(&config, "config.jsonc")
+//   // config = {Key: "mine", Other: "default"}
@@ -0,0 +1,184 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// JSONC Parsing Tests
+//
+// Purpose: Corpus of the inputs line-based strippers got wrong (URLs and
+//          comment markers in strings, escaped quotes, block comments across
+//          lines, "nested" block comments, trailing commas), LoadMerged over
+//          caller defaults, plus a fuzz target for the tokenizer's structural
+//          guarantees.
+// ============================================================================
+
+package jsonc
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// corpus pairs JSONC input with the value Unmarshal must produce ("" = error)
+var corpus = []struct {
+	name  string
+	input string
+	want  string // Equivalent plain JSON
+}{
+	{"line comments", "// header\n{\"a\": 1} // trailing", `{"a": 1}`},
+	{"url in string", `{"link": "https://example.com/path"} // comment`, `{"link": "https://example.com/path"}`},
+	{"url in label", "{\n  \"label\": \"Docs: http://x.dev // not a comment\",\n  \"n\": 2\n}", `{"label": "Docs: http://x.dev // not a comment", "n": 2}`},
+	{"block marker in string", `{"glob": "src/**/*.go", "c": "/* kept */"}`, `{"glob": "src/**/*.go", "c": "/* kept */"}`},
+	{"escaped quotes", `{"q": "say \"hi\" // still string", "b": "back\\"} // gone`, `{"q": "say \"hi\" // still string", "b": "back\\"}`},
+	{"escaped backslash before quote", `{"path": "C:\\dir\\", "x": 1}`, `{"path": "C:\\dir\\", "x": 1}`},
+	{"inline block comment", `{"a": /* one */ 1, /* two */ "b": 2}`, `{"a": 1, "b": 2}`},
+	{"block comment across lines", "{\n  /* first\n     \"hidden\": true,\n  */\n  \"shown\": true\n}", `{"shown": true}`},
+	{"block comment with quote", "{ /* don't \" stop */ \"a\": 1 }", `{"a": 1}`},
+	{"line comment ends block search", "{\n  // a /* not opened\n  \"a\": 1\n}", `{"a": 1}`},
+	{"nested opener ignored", `{/* outer /* inner */ "a": 1}`, `{"a": 1}`},
+	{"nested close is first close", `{/* outer /* inner */ still outer */ "a": 1}`, ""},
+	{"trailing comma object", "{\"a\": 1, \"b\": [1, 2,],\n}", `{"a": 1, "b": [1, 2]}`},
+	{"trailing comma before comment", "{\"a\": 1, // last\n /* end */ }", `{"a": 1}`},
+	{"comma in string kept", `{"csv": "a,]", "b": "x,}"}`, `{"csv": "a,]", "b": "x,}"}`},
+	{"double comma rejected", `{"a": 1,,}`, ""},
+	{"byte order mark", "\xEF\xBB\xBF{\"a\": 1}", `{"a": 1}`},
+	{"unterminated string stays on its line", "{\"a\": \"open\n// comment\n}", ""},
+	{"unterminated block comment", `{"a": 1} /* never closed`, `{"a": 1}`},
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestUnmarshalCorpus(t *testing.T) {
+	for _, tc := range corpus {
+		t.Run(tc.name, func(t *testing.T) {
+			var got any
+			err := Unmarshal([]byte(tc.input), &got)
+			if tc.want == "" {
+				if err == nil {
+					t.Fatalf("want error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unmarshal: %v\ncleaned: %q", err, clean([]byte(tc.input), true))
+			}
+
+			var want any
+			if err := json.Unmarshal([]byte(tc.want), &want); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestStripCommentsKeepsTrailingCommas(t *testing.T) {
+	cleaned := StripComments([]byte(`{"a": 1, /* c */}`))
+	if string(cleaned) != `{"a": 1,        }` {
+		t.Errorf("StripComments = %q", cleaned)
+	}
+	if json.Valid(cleaned) {
+		t.Error("trailing comma should survive StripComments (validation reports it)")
+	}
+}
+
+func TestErrorOffsetsPointIntoSource(t *testing.T) {
+	input := "{\n  // comment\n  \"a\": 1,\n  \"b\": oops\n}"
+	var v any
+	err := json.Unmarshal(StripComments([]byte(input)), &v)
+	syntaxErr, ok := err.(*json.SyntaxError)
+	if !ok {
+		t.Fatalf("err = %v, want *json.SyntaxError", err)
+	}
+	if got := input[syntaxErr.Offset-1]; got != 'o' {
+		t.Errorf("offset %d points at %q, want the 'o' of oops", syntaxErr.Offset, got)
+	}
+}
+
+func TestLoadMergedOverDefaults(t *testing.T) {
+	type layered struct {
+		Title string            `json:"title"`
+		Icons map[string]string `json:"icons"`
+		Order []string          `json:"order"`
+		Limit *int              `json:"limit"`
+	}
+	limit := 5
+	defaults := func() layered {
+		return layered{"Session", map[string]string{"time": "🕐", "git": "🌿"}, []string{"a", "b"}, &limit}
+	}
+
+	cases := []struct {
+		name  string
+		files []string // Base first, then overlays
+		want  func(*layered)
+	}{
+		{"empty file", []string{""}, func(*layered) {}},
+		{"comments only", []string{"// nothing set yet\n"}, func(*layered) {}},
+		{"one icon", []string{`{"icons": {"git": "🔀"}}`}, func(l *layered) { l.Icons["git"] = "🔀" }},
+		{"null keeps default", []string{`{"icons": null, "limit": null, "title": "Mine"}`}, func(l *layered) { l.Title = "Mine" }},
+		{"arrays replace", []string{`{"order": ["c"]}`}, func(l *layered) { l.Order = []string{"c"} }},
+		{"overlay over partial base", []string{`{"title": "Base"}`, `{"icons": {"time": "⏰"}}`}, func(l *layered) {
+			l.Title, l.Icons["time"] = "Base", "⏰"
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			var paths []string
+			for i, content := range tc.files {
+				path := filepath.Join(dir, string(rune('a'+i))+".jsonc")
+				os.WriteFile(path, []byte(content), 0644)
+				paths = append(paths, path)
+			}
+
+			got, want := defaults(), defaults()
+			tc.want(&want)
+			if err := LoadMerged(&got, paths[0], paths[1:]...); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+// FuzzStripComments checks the tokenizer never changes length or line
+// structure and leaves comment-free JSON exactly as it was.
+func FuzzStripComments(f *testing.F) {
+	for _, tc := range corpus {
+		f.Add([]byte(tc.input))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, trailing := range []bool{false, true} {
+			cleaned := clean(data, trailing)
+			if len(cleaned) != len(data) {
+				t.Fatalf("length %d → %d", len(data), len(cleaned))
+			}
+			if bytes.Count(cleaned, []byte("\n")) != bytes.Count(data, []byte("\n")) {
+				t.Fatalf("line count changed: %q → %q", data, cleaned)
+			}
+		}
+
+		// Valid JSON re-encoded compactly has no comments or trailing commas
+		var v any
+		if json.Unmarshal(data, &v) != nil {
+			return
+		}
+		plain, _ := json.Marshal(v)
+		if cleaned := clean(plain, true); !bytes.Equal(cleaned, plain) {
+			t.Fatalf("plain JSON changed: %q → %q", plain, cleaned)
+		}
+	})
+}
@@ -0,0 +1,592 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Audit Trail - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "In the mouth of two or three witnesses shall every word be established." - 2 Corinthians 13:1 (KJV)
+// Principle: What changes the system is witnessed twice - in the narrative and in a record nobody rewrites.
+// Anchor: Rotating logs forget by design; an audit trail must not.
+//
+// CPI-SI Identity
+//
+// Component Type: Append-only record module within Rails infrastructure
+// Role: Keep a tamper-evident line for every security-relevant operation
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.1
+// Last Modified: 2026-10-16 - VerifyAuditChain checks an archive header against the archive it names
+//
+// Version History:
+//   1.0.1 (2026-10-16) - An "audit-archive" first line must match the last line of its archive
+//   1.0.0 (2026-10-16) - Initial audit trail, hash chain, archive and verification
+//
+// Purpose & Function
+//
+// Purpose: Sudoers checks, retention deletions, and (future) automated
+// restoration actions change or judge the system's security posture. Their
+// entries sit in component logs that rotate and age out - the record of a
+// deletion can itself be deleted. (*Logger).Audit writes the usual
+// SUCCESS/FAILURE entry (tagged "audit") AND appends one line to a single
+// shared file, logs/system/audit.log:
+//
+//   2026-10-16T09:30:00.123456789-05:00 component=validate user=seanje@nova action=log-remove target=/…/validate.log.5 outcome=success prev=9f86d0…
+//
+// Core Design: Each line's prev field is the SHA-256 of the previous line
+// (64 zeros for the first), so an edited, removed, or reordered line breaks
+// the chain at that point - VerifyAuditChain names the line. Values with
+// spaces, quotes, or "=" are Go-quoted so a line always splits cleanly.
+//
+// The audit file is never rotated by size or age and retention never counts
+// it. ArchiveAuditLog is the only way it shrinks: the whole file is copied to
+// audit.archived-<UTC stamp>.log and restarted with an "audit-archive" line
+// whose prev is the archive's last line - the chain continues across files.
+//
+// Appends hold an in-process mutex plus an exclusive file lock
+// (auditlock_*.go), read the last line, and write the new one in a single
+// fsynced write, so Loggers in concurrent hook processes never fork the chain.
+//
+// Code paths that delete or restore must go through Audit: removeRotation is
+// the only way rotation and retention delete a file, and AuditedAction is the
+// wrapper automated restoration actions are expected to run inside.
+//
+// Blocking Status
+//
+// Non-blocking: An audit line that cannot be written warns to stderr once,
+// counts as a write failure (SelfDiagnostics), and is noted on the normal
+// entry (audit_error) - the operation itself is never stopped.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	logger.Audit("permissions-fix", path, logging.AuditSuccess, map[string]any{"mode": "0440"})
+//
+//	err := logger.AuditedAction("restore-config", path, nil, func() error {
+//	    return restore(path)
+//	})
+//
+// Public API:
+//   AuditSuccess / AuditFailure / AuditDenied - Outcome values
+//   ErrAuditChainBroken - Wrapped by VerifyAuditChain failures
+//   (*Logger).Audit(action, target, outcome, details) - Normal entry + audit line
+//   (*Logger).AuditedAction(action, target, details, run) error - Run, then audit the outcome
+//   AuditLogPath() string - Shared audit file (logs/system/audit.log)
+//   ArchiveAuditLog() (string, error) - Move the trail to a dated archive, continuing the chain
+//   VerifyAuditChain(path) error - Check every line's prev hash
+//   IsAuditLog(path) bool - Whether path is the audit file or an archive of it
+//
+// Internal API:
+//   removeRotation(path, policy) error - Audited removal of a rotated log (Logger method)
+//   appendAuditLine(path, record) error - Locked, chained, fsynced append
+//   writeChained(file, record) error - Append to an open, locked trail
+//   lockAuditFile(file) (func(), error) - Cross-process lock (auditlock_*.go)
+//   archiveTailHash(path, target) (string, error) - Hash of the named archive's last line
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: crypto/sha256, encoding/hex, errors, fmt, io, os,
+//                     path/filepath, strconv, strings, sync, time, unicode
+//   Package Files: logger.go (LogsDir, systemLogsSubdir, logDirPermissions), tags.go (WithTags),
+//                  details.go (MergeDetails, ErrorDetail), writing.go (retryWithBackoff),
+//                  selfhealth.go (noteWriteFailure), clock.go (now), config.go (Config.Behavior),
+//                  context.go (getCurrentUser, getHostname), retention.go (fileExists)
+//
+// Dependents (What Uses This):
+//   Internal: writing.go (shiftRotations), retention.go (enforceRetention),
+//             correlation.go (readLogTree skips the audit file)
+//   External: system/runtime/lib/sudoers (Check), hooks/lib/session (collectHealthStats),
+//             system/runtime/cmd/debugger
+//
+// Health Scoring
+//
+// Audit entries: 0 - the audited operation's own checks carry the score.
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"crypto/sha256" // Line hash chain
+	"encoding/hex"  // Hash text form
+	"errors"        // ErrAuditChainBroken
+	"fmt"           // Archive names, stderr warnings, errors
+	"io"            // Tail reads
+	"os"            // Audit file, archive, removals
+	"path/filepath" // Audit path, archive beside it
+	"strconv"       // Quoted field values
+	"strings"       // Line building and splitting
+	"sync"          // In-process append lock
+	"time"          // Archive stamp, removal retry sleep
+	"unicode"       // Values needing quotes
+)
+
+// Constants
+
+const (
+	AuditSuccess = "success" // Operation done
+	AuditFailure = "failure" // Operation attempted and failed
+	AuditDenied  = "denied"  // Operation refused (policy, permissions)
+)
+
+const (
+	auditLogFile         = "audit.log"        // Shared trail in logs/system/
+	auditArchivePrefix   = "audit.archived-"  // audit.archived-20261016T093000Z.log
+	auditArchiveStamp    = "20060102T150405Z" // UTC archive time in the name
+	auditFilePermissions = 0600               // Owner only - the trail names users and paths
+	auditTag             = "audit"            // Tag on the normal entry (tag queries find audited operations)
+	auditActionArchive   = "audit-archive"    // First line of a trail restarted by ArchiveAuditLog
+	auditActionRemove    = "log-remove"       // Rotation or retention deleted a log file
+	auditTailChunk       = 4096               // First tail read when finding the last line
+)
+
+// ErrAuditChainBroken is wrapped by VerifyAuditChain when a line's prev hash
+// does not match the line before it.
+var ErrAuditChainBroken = errors.New("audit chain broken")
+
+// auditGenesis is the prev field of a trail's first line (a zero hash).
+var auditGenesis = strings.Repeat("0", sha256.Size*2)
+
+// Types
+
+// auditRecord is one audit line before it is chained.
+type auditRecord struct {
+	Component string
+	User      string // user@host
+	Action    string
+	Target    string
+	Outcome   string
+}
+
+// Package-Level State
+
+var auditMu sync.Mutex // One append at a time within the process (the file lock covers other processes)
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Line Format
+// ────────────────────────────────────────────────────────────────
+
+// auditHash returns the chain hash of one line (without its newline).
+func auditHash(line string) string {
+	sum := sha256.Sum256([]byte(line))
+	return hex.EncodeToString(sum[:])
+}
+
+// auditValue renders a field value, Go-quoted when it would not split cleanly.
+func auditValue(value string) string {
+	if value == "" || strings.ContainsAny(value, " \"=") || strings.IndexFunc(value, func(r rune) bool {
+		return !unicode.IsPrint(r) || unicode.IsSpace(r)
+	}) >= 0 {
+		return strconv.Quote(value)
+	}
+	return value
+}
+
+// formatAuditLine renders record chained to the previous line's hash.
+func formatAuditLine(at time.Time, record auditRecord, prev string) string {
+	var builder strings.Builder
+	builder.WriteString(at.Format(time.RFC3339Nano))
+	for _, field := range [][2]string{
+		{"component", record.Component},
+		{"user", record.User},
+		{"action", record.Action},
+		{"target", record.Target},
+		{"outcome", record.Outcome},
+		{"prev", prev},
+	} {
+		builder.WriteString(" " + field[0] + "=" + auditValue(field[1]))
+	}
+	return builder.String()
+}
+
+// parseAuditLine splits a line into its fields (the timestamp under "time").
+func parseAuditLine(line string) (map[string]string, error) {
+	timestamp, rest, _ := strings.Cut(line, " ")
+	fields := map[string]string{"time": timestamp}
+	for rest != "" {
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok || key == "" || strings.Contains(key, " ") {
+			return nil, fmt.Errorf("malformed field %q", rest)
+		}
+		if strings.HasPrefix(value, `"`) {
+			quoted, err := strconv.QuotedPrefix(value)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", key, err)
+			}
+			fields[key], _ = strconv.Unquote(quoted)
+			rest = strings.TrimPrefix(value[len(quoted):], " ")
+		} else {
+			fields[key], rest, _ = strings.Cut(value, " ")
+		}
+	}
+	return fields, nil
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - File Access
+// ────────────────────────────────────────────────────────────────
+
+// lastAuditLine returns the final line of file and whether it lacks a newline
+// ("" = empty file). Reads backwards from the end, doubling the window until
+// the line's start is found.
+func lastAuditLine(file *os.File) (line string, partial bool, err error) {
+	info, err := file.Stat()
+	if err != nil {
+		return "", false, err
+	}
+	size := info.Size()
+	if size == 0 {
+		return "", false, nil
+	}
+	for window := int64(auditTailChunk); ; window *= 2 {
+		if window > size {
+			window = size
+		}
+		buf := make([]byte, window)
+		if _, err := file.ReadAt(buf, size-window); err != nil && err != io.EOF {
+			return "", false, err
+		}
+		text := string(buf)
+		partial = !strings.HasSuffix(text, "\n")
+		text = strings.TrimSuffix(text, "\n")
+		if i := strings.LastIndexByte(text, '\n'); i >= 0 {
+			return text[i+1:], partial, nil
+		}
+		if window == size {
+			return text, partial, nil
+		}
+	}
+}
+
+// archiveTailHash returns the hash of the last line of the archive an
+// "audit-archive" line names. target must be a bare archive file name beside
+// the trail - anything else cannot have come from ArchiveAuditLog.
+func archiveTailHash(path, target string) (string, error) {
+	if target != filepath.Base(target) || !strings.HasPrefix(target, auditArchivePrefix) {
+		return "", fmt.Errorf("archive target %q is not an audit archive name", target)
+	}
+	file, err := os.Open(filepath.Join(filepath.Dir(path), target))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	last, _, err := lastAuditLine(file)
+	if err != nil {
+		return "", err
+	}
+	if last == "" {
+		return "", fmt.Errorf("archive %s is empty", target)
+	}
+	return auditHash(last), nil
+}
+
+// openAuditFile opens path for chained appends, locked against other processes.
+//
+// The returned release closes the file and drops the lock.
+func openAuditFile(path string) (*os.File, func(), error) {
+	if err := os.MkdirAll(filepath.Dir(path), logDirPermissions); err != nil {
+		return nil, nil, err
+	}
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, auditFilePermissions)
+	if err != nil {
+		return nil, nil, err
+	}
+	unlock, err := lockAuditFile(file)
+	if err != nil {
+		file.Close()
+		return nil, nil, fmt.Errorf("lock %s: %w", path, err)
+	}
+	return file, func() { unlock(); file.Close() }, nil
+}
+
+// writeChained appends record to the locked file, chained to its last line.
+//
+// A last line without a newline (a crash mid-write) is still hashed as the
+// previous line and closed off first - VerifyAuditChain then reports it.
+func writeChained(file *os.File, record auditRecord) error {
+	last, partial, err := lastAuditLine(file)
+	if err != nil {
+		return err
+	}
+	prev := auditGenesis
+	if last != "" {
+		prev = auditHash(last)
+	}
+	data := formatAuditLine(now(), record, prev) + "\n"
+	if partial {
+		data = "\n" + data
+	}
+	if _, err := file.Write([]byte(data)); err != nil { // One write - never an interleaved half line
+		return err
+	}
+	return file.Sync()
+}
+
+// appendAuditLine appends record to the audit file at path.
+func appendAuditLine(path string, record auditRecord) error {
+	auditMu.Lock()
+	defer auditMu.Unlock()
+
+	file, release, err := openAuditFile(path)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return writeChained(file, record)
+}
+
+// writeSyncedFile creates path (never replacing one) with data, fsynced.
+func writeSyncedFile(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, auditFilePermissions)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Audited Removal
+// ────────────────────────────────────────────────────────────────
+
+// removeRotation deletes a rotated log as an audited action.
+//
+// The only way rotation (shiftRotations) and retention (enforceRetention)
+// delete files; policy names the limit that required it.
+func (l *Logger) removeRotation(path, policy string) error {
+	return l.AuditedAction(auditActionRemove, path, map[string]any{"policy": policy}, func() error {
+		return retryWithBackoff(func() error { return os.Remove(path) }, isSharingViolation, time.Sleep)
+	})
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// AuditLogPath returns the shared audit trail (logs/system/audit.log).
+func AuditLogPath() string {
+	return filepath.Join(LogsDir(), systemLogsSubdir, auditLogFile)
+}
+
+// IsAuditLog reports whether path is the audit trail or one of its archives.
+//
+// Readers that walk log directories skip these - the trail is not entry format.
+func IsAuditLog(path string) bool {
+	if filepath.Base(filepath.Dir(path)) != systemLogsSubdir {
+		return false
+	}
+	name := filepath.Base(path)
+	return name == auditLogFile || (strings.HasPrefix(name, auditArchivePrefix) && strings.HasSuffix(name, logFileExtension))
+}
+
+// Audit records a security-relevant operation twice: a normal entry and an
+// append-only, hash-chained line in the shared audit trail.
+//
+// What It Does:
+// Appends "timestamp component user@host action target outcome prev" to
+// AuditLogPath(), then logs a SUCCESS entry (outcome "success") or a FAILURE
+// entry with the outcome as its reason - both tagged "audit", health 0, with
+// target and outcome added to details. An empty outcome means success.
+//
+// Parameters:
+//   action: What was done ("sudoers-check", "log-remove")
+//   target: What it was done to (usually a path)
+//   outcome: AuditSuccess, AuditFailure, AuditDenied, or another short word
+//   details: Extra fields for the normal entry (the audit line stays compact)
+//
+// Example usage:
+//
+//	logger.Audit("sudoers-check", path, logging.AuditFailure, map[string]any{"syntax_valid": false})
+func (l *Logger) Audit(action, target, outcome string, details map[string]any) {
+	if outcome == "" {
+		outcome = AuditSuccess
+	}
+	LoadConfig()
+	if Config.Behavior.Disabled { // behavior.disabled writes nothing - the audit trail included
+		return
+	}
+
+	details = MergeDetails(details, map[string]any{"target": target, "outcome": outcome})
+	record := auditRecord{
+		Component: l.Component,
+		User:      l.username + "@" + l.hostname,
+		Action:    action,
+		Target:    target,
+		Outcome:   outcome,
+	}
+	if err := appendAuditLine(AuditLogPath(), record); err != nil {
+		details["audit_error"] = err.Error()
+		if !l.auditWarned {
+			fmt.Fprintf(os.Stderr, "WARNING: Failed to write audit trail %s: %v\n", AuditLogPath(), err)
+			l.auditWarned = true
+		}
+		l.noteWriteFailure()
+	}
+
+	tagged := l.WithTags(auditTag)
+	if outcome == AuditSuccess {
+		tagged.Success(action, 0, details)
+	} else {
+		tagged.Failure(action, outcome, 0, details)
+	}
+}
+
+// AuditedAction runs an operation and audits its outcome.
+//
+// The path automated restoration actions take: run's error decides the
+// outcome (failure details carry it), and is returned unchanged.
+//
+// Example usage:
+//
+//	err := logger.AuditedAction("restore-config", path, nil, func() error { return restore(path) })
+func (l *Logger) AuditedAction(action, target string, details map[string]any, run func() error) error {
+	err := run()
+	outcome := AuditSuccess
+	if err != nil {
+		outcome = AuditFailure
+		details = MergeDetails(details, ErrorDetail(err))
+	}
+	l.Audit(action, target, outcome, details)
+	return err
+}
+
+// ArchiveAuditLog moves the audit trail to audit.archived-<UTC stamp>.log and
+// restarts it with an "audit-archive" line chained to the archive's last line.
+//
+// The only way the trail shrinks - it is never rotated. Runs under the same
+// locks as appends: the trail is copied, fsynced, then truncated in place, so
+// a process holding it open keeps appending to the live trail. Returns the
+// archive path ("" with nil error when there was nothing to archive).
+func ArchiveAuditLog() (string, error) {
+	auditMu.Lock()
+	defer auditMu.Unlock()
+
+	path := AuditLogPath()
+	file, release, err := openAuditFile(path)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+
+	data, err := io.ReadAll(io.NewSectionReader(file, 0, 1<<62))
+	if err != nil {
+		return "", err
+	}
+	if len(data) == 0 {
+		return "", nil
+	}
+
+	base := filepath.Join(filepath.Dir(path), auditArchivePrefix+now().UTC().Format(auditArchiveStamp))
+	archive := base + logFileExtension
+	for n := 2; fileExists(archive); n++ {
+		archive = fmt.Sprintf("%s.%d%s", base, n, logFileExtension)
+	}
+	if err := writeSyncedFile(archive, data); err != nil {
+		return "", fmt.Errorf("write archive %s: %w", archive, err)
+	}
+
+	if err := file.Truncate(0); err != nil {
+		return archive, fmt.Errorf("restart %s: %w", path, err)
+	}
+	last := strings.TrimSuffix(string(data), "\n")
+	last = last[strings.LastIndexByte(last, '\n')+1:]
+	line := formatAuditLine(now(), auditRecord{
+		Component: "logging",
+		User:      getCurrentUser() + "@" + getHostname(),
+		Action:    auditActionArchive,
+		Target:    filepath.Base(archive),
+		Outcome:   AuditSuccess,
+	}, auditHash(last))
+	if _, err := file.Write([]byte(line + "\n")); err != nil {
+		return archive, fmt.Errorf("restart %s: %w", path, err)
+	}
+	return archive, file.Sync()
+}
+
+// VerifyAuditChain checks that every line of an audit trail carries the hash
+// of the line before it.
+//
+// The first line carries 64 zeros, or - for a trail restarted by
+// ArchiveAuditLog - the hash of the last line of the archive it names, which
+// must still sit beside the trail (verify the archive's own chain
+// separately). An empty file verifies; a missing one returns its open error.
+//
+// Returns:
+//   error: nil when intact, else wraps ErrAuditChainBroken with the line number
+//
+// Example usage:
+//
+//	if err := logging.VerifyAuditChain(logging.AuditLogPath()); errors.Is(err, logging.ErrAuditChainBroken) {
+//	    fmt.Println("audit trail tampered:", err)
+//	}
+func VerifyAuditChain(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	prev := auditGenesis
+	for i, line := range strings.Split(strings.TrimSuffix(string(data), "\n"), "\n") {
+		fields, err := parseAuditLine(line)
+		if err != nil {
+			return fmt.Errorf("%w: %s line %d: %v", ErrAuditChainBroken, path, i+1, err)
+		}
+		got, ok := fields["prev"]
+		if !ok {
+			return fmt.Errorf("%w: %s line %d: no prev field", ErrAuditChainBroken, path, i+1)
+		}
+		if i == 0 && fields["action"] == auditActionArchive { // Continues an archived chain
+			if prev, err = archiveTailHash(path, fields["target"]); err != nil {
+				return fmt.Errorf("%w: %s line 1: %v", ErrAuditChainBroken, path, err)
+			}
+		}
+		if got != prev {
+			return fmt.Errorf("%w: %s line %d: prev %.12s…, want %.12s…", ErrAuditChainBroken, path, i+1, got, prev)
+		}
+		prev = auditHash(line)
+	}
+	return nil
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
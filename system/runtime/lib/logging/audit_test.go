@@ -0,0 +1,218 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Audit Trail Tests
+//
+// Purpose: Prove Audit writes both the tagged normal entry and a chained
+//          audit.log line, that VerifyAuditChain passes an intact trail and
+//          names the first tampered line, that ArchiveAuditLog continues the
+//          chain in a restarted trail (a forged archive header is caught),
+//          and that retention removals are audited.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// auditLines reads the audit trail's lines
+func auditLines(t *testing.T, path string) []string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestAuditWritesEntryAndChainedLine(t *testing.T) {
+	logger := newTestLogger(t, "audit-test")
+
+	logger.Audit("permissions-fix", "/etc/sudoers.d/90 cpi", AuditSuccess, map[string]any{"mode": "0440"})
+	logger.Audit("config-restore", "/tmp/config.jsonc", AuditDenied, nil)
+	logger.Audit("sudoers-check", "/etc/sudoers.d/90", AuditFailure, nil)
+
+	path := AuditLogPath()
+	lines := auditLines(t, path)
+	if len(lines) != 3 {
+		t.Fatalf("audit trail has %d lines, want 3:\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+	fields, err := parseAuditLine(lines[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields["target"] != "/etc/sudoers.d/90 cpi" || fields["action"] != "permissions-fix" || fields["prev"] != auditGenesis {
+		t.Errorf("first line fields = %v", fields)
+	}
+	if fields["user"] != logger.username+"@"+logger.hostname || fields["component"] != "audit-test" {
+		t.Errorf("first line identity = %s / %s", fields["user"], fields["component"])
+	}
+	if err := VerifyAuditChain(path); err != nil {
+		t.Errorf("intact trail: %v", err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != auditFilePermissions {
+		t.Errorf("audit trail mode = %v (%v), want %v", info.Mode().Perm(), err, os.FileMode(auditFilePermissions))
+	}
+
+	var levels []string
+	for _, entry := range readEntries(t, logger.LogFile) {
+		if entry.HasTag(auditTag) {
+			levels = append(levels, entry.Level+" "+entry.Event)
+		}
+	}
+	want := []string{"SUCCESS permissions-fix", "FAILURE config-restore", "FAILURE sudoers-check"}
+	if strings.Join(levels, ",") != strings.Join(want, ",") {
+		t.Errorf("audit-tagged entries = %q, want %q", levels, want)
+	}
+}
+
+func TestVerifyAuditChainFindsTampering(t *testing.T) {
+	logger := newTestLogger(t, "audit-tamper")
+	for _, target := range []string{"a", "b", "c"} {
+		logger.Audit("log-remove", target, AuditSuccess, nil)
+	}
+	path := AuditLogPath()
+	lines := auditLines(t, path)
+
+	lines[1] = strings.Replace(lines[1], "target=b", "target=x", 1) // Edited in place
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), auditFilePermissions); err != nil {
+		t.Fatal(err)
+	}
+	err := VerifyAuditChain(path)
+	if !errors.Is(err, ErrAuditChainBroken) || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("edited line 2: %v, want a broken chain at line 3", err)
+	}
+
+	if err := os.WriteFile(path, []byte(lines[0]+"\n"+lines[2]+"\n"), auditFilePermissions); err != nil { // Line removed
+		t.Fatal(err)
+	}
+	if err := VerifyAuditChain(path); !errors.Is(err, ErrAuditChainBroken) {
+		t.Errorf("removed line: %v, want a broken chain", err)
+	}
+	if err := VerifyAuditChain(filepath.Join(t.TempDir(), "missing.log")); err == nil || errors.Is(err, ErrAuditChainBroken) {
+		t.Errorf("missing trail: %v, want the open error", err)
+	}
+}
+
+func TestArchiveAuditLogContinuesChain(t *testing.T) {
+	logger := newTestLogger(t, "audit-archive")
+	logger.Audit("log-remove", "first", AuditSuccess, nil)
+	logger.Audit("log-remove", "second", AuditSuccess, nil)
+	last := auditLines(t, AuditLogPath())[1]
+
+	archive, err := ArchiveAuditLog()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsAuditLog(archive) || !strings.HasPrefix(filepath.Base(archive), auditArchivePrefix) {
+		t.Errorf("archive %s not recognized as an audit file", archive)
+	}
+	if err := VerifyAuditChain(archive); err != nil {
+		t.Errorf("archive: %v", err)
+	}
+
+	logger.Audit("log-remove", "third", AuditSuccess, nil)
+	lines := auditLines(t, AuditLogPath())
+	fields, _ := parseAuditLine(lines[0])
+	if len(lines) != 2 || fields["action"] != auditActionArchive || fields["prev"] != auditHash(last) {
+		t.Errorf("restarted trail = %q, want an archive line chained to %q", lines, last)
+	}
+	if err := VerifyAuditChain(AuditLogPath()); err != nil {
+		t.Errorf("restarted trail: %v", err)
+	}
+
+	// A second archive in the same second never overwrites the first
+	again, err := ArchiveAuditLog()
+	if err != nil || again == archive || !fileExists(archive) {
+		t.Errorf("second archive = %s (%v), first %s", again, err, archive)
+	}
+}
+
+func TestVerifyAuditChainRejectsForgedArchiveHeader(t *testing.T) {
+	logger := newTestLogger(t, "audit-forged")
+	logger.Audit("log-remove", "first", AuditSuccess, nil)
+	archive, err := ArchiveAuditLog()
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.Audit("log-remove", "second", AuditSuccess, nil)
+	logger.Audit("log-remove", "third", AuditSuccess, nil)
+	path := AuditLogPath()
+	lines := auditLines(t, path)
+
+	// Trail truncated, then restarted with a made-up archive header chained to the kept tail
+	forge := func(target, prev string) {
+		t.Helper()
+		header := formatAuditLine(fixedClockTime, auditRecord{Component: "logging", User: "u@h",
+			Action: auditActionArchive, Target: target, Outcome: AuditSuccess}, prev)
+		prevOfKept, _ := parseAuditLine(lines[2])
+		kept := strings.Replace(lines[2], "prev="+prevOfKept["prev"], "prev="+auditHash(header), 1)
+		if err := os.WriteFile(path, []byte(header+"\n"+kept+"\n"), auditFilePermissions); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cases := []struct {
+		name, target, prev string
+	}{
+		{"wrong prev", filepath.Base(archive), strings.Repeat("ab", 32)},
+		{"missing archive", auditArchivePrefix + "20200101T000000Z" + logFileExtension, strings.Repeat("ab", 32)},
+		{"not an archive", "../audit.log", auditGenesis},
+	}
+	for _, tc := range cases {
+		forge(tc.target, tc.prev)
+		if err := VerifyAuditChain(path); !errors.Is(err, ErrAuditChainBroken) || !strings.Contains(err.Error(), "line 1") {
+			t.Errorf("%s: %v, want a broken chain at line 1", tc.name, err)
+		}
+	}
+
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), auditFilePermissions); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyAuditChain(path); err != nil {
+		t.Errorf("genuine restarted trail: %v", err)
+	}
+	if err := os.Remove(archive); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyAuditChain(path); !errors.Is(err, ErrAuditChainBroken) {
+		t.Errorf("archive deleted: %v, want a broken chain", err)
+	}
+}
+
+func TestRetentionRemovalIsAudited(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) { cfg.Retention.MaxTotalSizeMB = 1 })
+	logger := newTestLogger(t, "audit-retention")
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "keep.log")
+	writeSized(t, filepath.Join(dir, "keep.2026-10-10.log"), 800<<10, fixedClockTime.Add(-time.Hour))
+	writeSized(t, logPath, 300<<10, fixedClockTime)
+
+	logger.enforceRetention(logPath)
+
+	lines := auditLines(t, AuditLogPath())
+	fields, _ := parseAuditLine(lines[len(lines)-1])
+	if fields["action"] != auditActionRemove || fields["target"] != filepath.Join(dir, "keep.2026-10-10.log") || fields["outcome"] != AuditSuccess {
+		t.Errorf("retention removal audit line = %q", lines[len(lines)-1])
+	}
+	if IsAuditLog(logPath) || !IsAuditLog(AuditLogPath()) {
+		t.Error("IsAuditLog confuses a component log with the audit trail")
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,43 @@
+//go:build !(linux || darwin || freebsd || windows)
+
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Audit Trail Lock Fallback (Other Platforms) - Logging Library
+//
+// Biblical Foundation: See audit.go
+// CPI-SI Identity: Platform primitive for the audit trail
+//
+// Purpose: Platforms with neither flock nor LockFileEx chain audit lines
+//          under the in-process mutex only instead of failing the build.
+//
+// Authorship: Nova Dawn
+// Version: 1.0.0
+//
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os" // Audit file handle
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// lockAuditFile is a no-op here - auditMu still serializes this process.
+func lockAuditFile(file *os.File) (func(), error) {
+	return func() {}, nil
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with audit.go (GOOS=plan9 go build ./logging)
+// Code Execution: Library primitive (called by openAuditFile)
+// Code Cleanup: None
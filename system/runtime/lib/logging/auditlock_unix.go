@@ -0,0 +1,54 @@
+//go:build linux || darwin || freebsd
+
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Audit Trail Lock (Unix) - Logging Library
+//
+// Biblical Foundation: See audit.go
+// CPI-SI Identity: Platform primitive for the audit trail
+//
+// Purpose: Hold an exclusive flock(2) on the audit file while a line is
+//          chained, so hook processes appending at once never read the
+//          same last line.
+//
+// Authorship: Nova Dawn
+// Version: 1.0.0
+//
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"      // Audit file handle
+	"syscall" // flock(2)
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// lockAuditFile blocks until file is exclusively locked; the returned func unlocks it.
+func lockAuditFile(file *os.File) (func(), error) {
+	fd := int(file.Fd())
+	for {
+		err := syscall.Flock(fd, syscall.LOCK_EX)
+		if err == nil {
+			return func() { syscall.Flock(fd, syscall.LOCK_UN) }, nil
+		}
+		if err != syscall.EINTR { // Interrupted by a signal - wait again
+			return nil, err
+		}
+	}
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with audit.go (go build ./logging)
+// Code Execution: Library primitive (called by openAuditFile)
+// Code Cleanup: Released by the returned func (closing the file also drops it)
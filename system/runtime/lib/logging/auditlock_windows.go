@@ -0,0 +1,66 @@
+//go:build windows
+
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Audit Trail Lock (Windows) - Logging Library
+//
+// Biblical Foundation: See audit.go
+// CPI-SI Identity: Platform primitive for the audit trail
+//
+// Purpose: Hold an exclusive LockFileEx lock on the audit file while a line
+//          is chained, so hook processes appending at once never read the
+//          same last line.
+//
+// Authorship: Nova Dawn
+// Version: 1.0.0
+//
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"      // Audit file handle
+	"syscall" // kernel32 procedures
+	"unsafe"  // OVERLAPPED pointer
+)
+
+const (
+	lockfileExclusiveLock = 0x2        // LOCKFILE_EXCLUSIVE_LOCK - blocks until granted
+	lockWholeFile         = 0xFFFFFFFF // Low and high DWORD of the locked length (maximum)
+)
+
+var (
+	procLockFileEx   = kernel32.NewProc("LockFileEx")
+	procUnlockFileEx = kernel32.NewProc("UnlockFileEx")
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// lockAuditFile blocks until file is exclusively locked; the returned func unlocks it.
+//
+// Locks the whole file (offset 0, maximum length) - appends past the current
+// end stay inside the locked range.
+func lockAuditFile(file *os.File) (func(), error) {
+	handle := file.Fd()
+	overlapped := new(syscall.Overlapped)
+	if ok, _, err := procLockFileEx.Call(handle, lockfileExclusiveLock, 0, lockWholeFile, lockWholeFile, uintptr(unsafe.Pointer(overlapped))); ok == 0 {
+		return nil, err
+	}
+	return func() {
+		procUnlockFileEx.Call(handle, 0, lockWholeFile, lockWholeFile, uintptr(unsafe.Pointer(overlapped)))
+	}, nil
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with audit.go (GOOS=windows go build ./logging)
+// Code Execution: Library primitive (called by openAuditFile)
+// Code Cleanup: Released by the returned func (closing the handle also drops it)
@@ -0,0 +1,180 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Logging Benchmarks and Allocation Budget
+//
+// Purpose: Put numbers on what an entry costs - partial and full context,
+//          per-entry open vs a held handle, LogCommand over a bare exec,
+//          reading a 10 MB log, one rotation -
+//          so caching and buffering proposals can be judged and regressions
+//          caught. TestPartialContextAllocBudget fails when a partial-context
+//          entry (the common SUCCESS/CHECK path) exceeds its allocation budget.
+//
+// Run: go test -run '^$' -bench . -benchmem
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// partialContextAllocBudget is the most allocations one partial-context entry
+// may make, end to end (health, formatting, rotation check, append).
+//
+// Measured at ~20 after captureFor stopped probing for entries that carry no
+// context and formatEntry stopped going through fmt. The slack covers
+// platform differences in os.OpenFile/Stat; a jump past it means something
+// started allocating per entry again.
+const partialContextAllocBudget = 40
+
+// benchDetails is a typical Success detail map (file, count, duration)
+var benchDetails = map[string]any{
+	DetailFile:       "/repo/internal/validate/syntax.go",
+	"files_count":    12,
+	DetailDurationMS: int64(84),
+	"result":         "clean",
+}
+
+// grownLog writes a log file of at least size bytes from real rendered entries
+func grownLog(tb testing.TB, path string, size int) {
+	tb.Helper()
+	logger := &Logger{Component: "bench-fixture", ContextID: "bench-fixture-1-1", username: "bench", hostname: "host", pid: 1}
+	var text strings.Builder
+	text.WriteString(formatVersionHeader(logFormatVersion))
+	start := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	for i := 0; text.Len() < size; i++ {
+		entry := logger.createBaseEntry(&SystemContext{User: "bench", Host: "host", PID: 1}, 1)
+		entry.Timestamp = start.Add(time.Duration(i) * time.Millisecond)
+		entry.Level = levelSuccess
+		entry.Event = "Validated file"
+		entry.Details = benchDetails
+		text.WriteString(logger.renderEntry(entry))
+	}
+	if err := os.WriteFile(path, []byte(text.String()), logFilePermissions); err != nil {
+		tb.Fatal(err)
+	}
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func BenchmarkSuccessPartialContext(b *testing.B) {
+	logger := newTestLogger(b, "bench-success")
+	b.ReportAllocs()
+	for b.Loop() {
+		logger.Success("Validated file", 1, benchDetails)
+	}
+}
+
+func BenchmarkFailureFullContext(b *testing.B) {
+	logger := newTestLogger(b, "bench-failure")
+	b.ReportAllocs()
+	for b.Loop() {
+		logger.Failure("Validation failed", "syntax error at line 12", -1, benchDetails)
+	}
+}
+
+func BenchmarkKeepFileOpen(b *testing.B) {
+	for _, held := range []bool{false, true} {
+		name := "reopen"
+		if held {
+			name = "held"
+		}
+		b.Run(name, func(b *testing.B) { // Per-entry open/close vs [behavior] keep_file_open
+			logger := newTestLogger(b, "bench-keep-open")
+			withBehavior(b, func(cfg *LoggingConfig) { cfg.Behavior.KeepFileOpen = held })
+			b.Cleanup(logger.closeLogHandle)
+			b.ReportAllocs()
+			for b.Loop() {
+				logger.Success("Validated file", 1, benchDetails)
+			}
+		})
+	}
+}
+
+func BenchmarkLogCommandOverhead(b *testing.B) {
+	if _, err := exec.LookPath("true"); err != nil {
+		b.Skip("true not installed")
+	}
+	b.Run("exec", func(b *testing.B) { // Baseline - the command alone
+		b.ReportAllocs()
+		for b.Loop() {
+			exec.Command("true").Run()
+		}
+	})
+	b.Run("logged", func(b *testing.B) { // Same command through LogCommand
+		logger := newTestLogger(b, "bench-command")
+		b.ReportAllocs()
+		for b.Loop() {
+			logger.LogCommand("true", nil)
+		}
+	})
+}
+
+func BenchmarkReadLogFile10MB(b *testing.B) {
+	path := filepath.Join(b.TempDir(), "read.log")
+	grownLog(b, path, maxLogSizeBytes)
+	if info, err := os.Stat(path); err == nil {
+		b.SetBytes(info.Size())
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := ReadLogFile(path); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRotation(b *testing.B) {
+	logger := newTestLogger(b, "rotate-bench")
+	dir := b.TempDir()
+	path := filepath.Join(dir, "rotate.log")
+	const size = 1 << 20 // Threshold passed to rotateLogOver - 1 MB keeps the fixture cheap
+	fixture := filepath.Join(dir, "fixture.log")
+	grownLog(b, fixture, size)
+	data, err := os.ReadFile(fixture)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		b.StopTimer()
+		if err := os.WriteFile(path, data, logFilePermissions); err != nil { // Full chain after 5 rounds - the steady state
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		if err := logger.rotateLogOver(path, size); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestPartialContextAllocBudget(t *testing.T) {
+	logger := newTestLogger(t, "alloc-budget-test")
+	if fullContextFor(levelSuccess) {
+		t.Skip("SUCCESS carries full context in this configuration")
+	}
+	logger.Success("warm up", 1, benchDetails) // Config load, first-write header
+
+	allocs := testing.AllocsPerRun(100, func() {
+		logger.Success("Validated file", 1, benchDetails)
+	})
+	if allocs > partialContextAllocBudget {
+		t.Errorf("partial-context entry made %.0f allocations, budget %d", allocs, partialContextAllocBudget)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,164 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Time Source - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "To every thing there is a season, and a time to every purpose under the heaven." - Ecclesiastes 3:1 (KJV)
+// Principle: One source of time, so every entry agrees on when "now" is.
+// Anchor: Tests name the hour; production asks the system clock.
+//
+// CPI-SI Identity
+//
+// Component Type: Time source module within Rails infrastructure
+// Role: Answer "what time is it?" for timestamps, context IDs, and durations
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial injectable clock
+//
+// Purpose & Function
+//
+// Purpose: Entry timestamps, context IDs, command durations, and run
+// summaries all read time.Now directly, so formatted output could only be
+// tested by regexp-ing timestamps away. Every read now goes through one
+// package clock that tests can replace.
+//
+// Core Design: A one-method Clock interface (ClockFunc adapts a function).
+// SetClock swaps it process-wide; ResetClock restores the system clock.
+// A Logger's own sampling clock (sampling.go) still wins when set, and retry
+// sleeps keep their own seam - the clock answers "when", never "wait".
+//
+// Blocking Status
+//
+// Non-blocking: Reading the clock cannot fail.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	logging.SetClock(logging.ClockFunc(func() time.Time { return fixed }))
+//	defer logging.ResetClock()
+//
+// Public API:
+//   Clock - Time source interface (Now() time.Time)
+//   ClockFunc - Adapts a func() time.Time to Clock
+//   SetClock(c Clock) - Replace the package clock (nil restores the system clock)
+//   ResetClock() - Restore the system clock
+//
+// Internal API:
+//   now() - Current time from the package clock
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: sync, time
+//
+// Dependents (What Uses This):
+//   Internal: entry.go (createBaseEntry), logger.go (NewLogger, LogCommandContext),
+//             summary.go (Finalize), writing.go (spill recovery), sampling.go (burst windows)
+//
+// Health Scoring
+//
+// Time source: 0 (infrastructure only)
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"sync" // Guards clock swaps against concurrent readers
+	"time" // Time values
+)
+
+// Types
+
+// Clock supplies the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts an ordinary function to Clock.
+type ClockFunc func() time.Time
+
+// Now calls f.
+func (f ClockFunc) Now() time.Time { return f() }
+
+// systemClock reads the real wall clock.
+type systemClock struct{}
+
+// Now returns time.Now().
+func (systemClock) Now() time.Time { return time.Now() }
+
+// Package-Level State
+
+var (
+	clockMu sync.RWMutex                 // Guards clock
+	clock   Clock        = systemClock{} // Time source for every entry
+)
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Foundation Functions
+// ────────────────────────────────────────────────────────────────
+
+// now returns the current time from the package clock.
+func now() time.Time {
+	clockMu.RLock()
+	defer clockMu.RUnlock()
+	return clock.Now()
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// SetClock replaces the time source for every Logger in the process.
+//
+// Meant for tests: a fixed clock makes timestamps, context IDs, and
+// durations exact. nil restores the system clock.
+//
+// Example usage:
+//
+//	fixed := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+//	logging.SetClock(logging.ClockFunc(func() time.Time { return fixed }))
+//	defer logging.ResetClock()
+func SetClock(c Clock) {
+	if c == nil {
+		c = systemClock{}
+	}
+	clockMu.Lock()
+	defer clockMu.Unlock()
+	clock = c
+}
+
+// ResetClock restores the system clock.
+func ResetClock() {
+	SetClock(nil)
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,87 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Time Source Tests
+//
+// Purpose: Prove a fixed clock makes formatted entries exact (golden text,
+//          no timestamp stripping), reaches context IDs, and drives run
+//          durations - all without sleeping.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fixedClockTime is the instant every clock test starts from
+var fixedClockTime = time.Date(2026, 10, 16, 9, 30, 0, 123456789, time.UTC)
+
+// useClock installs a clock for one test
+func useClock(t *testing.T, c Clock) {
+	t.Helper()
+	SetClock(c)
+	t.Cleanup(ResetClock)
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestFormatEntryGolden(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Display.Health = HealthDisplayConfig{Indicators: HealthIndicatorsLetters, BarFilled: "#", BarEmpty: ".", BarWidth: 10, GoodMin: 70}
+	})
+	useClock(t, ClockFunc(func() time.Time { return fixedClockTime }))
+
+	logger := &Logger{Component: "clock-test", ContextID: "clock-test-42-1", ParentContextID: "session-abc", SessionHealth: 80, NormalizedHealth: 80}
+	entry := logger.createBaseEntry(&SystemContext{User: "nova", Host: "forge", PID: 42}, 5)
+	entry.Level = levelSuccess
+	entry.Event = "build finished"
+	entry.Details = map[string]any{"files": 3}
+
+	want := strings.Join([]string{
+		"[2026-10-16 09:30:00.123456789] SUCCESS clock-test clock-test-42-1 #1",
+		"  PARENT: session-abc",
+		"  EVENT: build finished",
+		"  DETAILS:",
+		"    files: 3",
+		"  HEALTH: OK [#########.] (90/100) (Δ+5, Raw: 80, Normalized: 80)",
+		entrySeparator,
+		"",
+	}, "\n")
+	if got := logger.formatEntry(entry); got != want {
+		t.Errorf("formatEntry:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestClockDrivesContextIDAndDuration(t *testing.T) {
+	current := fixedClockTime
+	useClock(t, ClockFunc(func() time.Time { return current }))
+
+	logger := newTestLogger(t, "clock-duration-test")
+	if want := "-" + strconv.FormatInt(fixedClockTime.UnixNano(), 10); !strings.HasSuffix(logger.ContextID, want) {
+		t.Errorf("ContextID %q does not end in the clock's time %s", logger.ContextID, want)
+	}
+
+	current = current.Add(2*time.Hour + 37*time.Minute)
+	if got := logger.Finalize().Duration; got != 2*time.Hour+37*time.Minute {
+		t.Errorf("Duration = %s, want 2h37m0s", got)
+	}
+
+	ResetClock()
+	if now().Year() < 2020 || now().Equal(current) {
+		t.Error("ResetClock did not restore the system clock")
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -20,8 +20,8 @@
 // Architect: Seanje Lenox-Wise, Nova Dawn
 // Implementation: Nova Dawn
 // Creation Date: 2025-11-18
-// Version: 1.0.0
-// Last Modified: 2025-11-18 - Extracted from monolithic logger.go
+// Version: 1.5.0
+// Last Modified: 2026-10-16 - [tags] components (default tags per component)
 //
 // Purpose & Function
 //
@@ -29,11 +29,23 @@
 //
 // Core Design: Multi-layer tripwire pattern - attempt config load, gracefully degrade to defaults on failure, never block execution.
 //
+// Precedence: environment > logging.toml > hardcoded defaults. CI and
+// containers that cannot ship logging.toml set CPI_SI_LOG_* variables, applied
+// after the file (and whether or not it loaded):
+//   CPI_SI_LOG_BASE_DIR   → paths.base_dir (relative to ~/.claude, or absolute)
+//   CPI_SI_LOG_MIN_LEVEL  → behavior.min_level (DEBUG < CHECK/CONTEXT < OPERATION/SUCCESS < FAILURE < ERROR)
+//   CPI_SI_LOG_FORMAT     → format.output (text | json)
+//   CPI_SI_LOG_CONTEXT    → context_capture.mode (full | partial | off)
+//   CPI_SI_LOG_DISABLED   → behavior.disabled (1/true: write nothing, keep health accounting)
+// Invalid values are ignored. Config.Sources records where each key came from
+// ("file" or "env"; absent = default); Describe renders it for diagnostics.
+//
 // Key Features:
 //   - TOML configuration loading from ~/.claude/cpi-si/system/config/logging.toml
-//   - Graceful fallback to hardcoded defaults
+//   - Graceful fallback to hardcoded defaults (partial files override only what they set)
 //   - Thread-safe single initialization (sync.Once)
 //   - Comprehensive configuration structure matching all logging.toml sections
+//   - CPI_SI_LOG_* environment overrides with provenance (Config.Source)
 //
 // Blocking Status
 //
@@ -56,11 +68,13 @@
 //   LoadConfig() - Ensure configuration loaded (idempotent, thread-safe)
 //   Config - Package-level configuration variable (read-only after init)
 //   ConfigLoaded - Boolean indicating successful TOML load
+//   (*LoggingConfig).Source(key) - Where a key's value came from (default, file, env)
+//   (*LoggingConfig).Describe(key, value) - "min_level=ERROR (from env)"
 //
 // Dependencies
 //
 // Dependencies (What This Needs):
-//   Standard Library: os, path/filepath, sync
+//   Standard Library: fmt, os, path/filepath, strconv, strings, sync
 //   External: github.com/BurntSushi/toml (DATA dependency for config parsing)
 //
 // Dependents (What Uses This):
@@ -86,13 +100,49 @@ package logging
 // Imports
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/BurntSushi/toml"
 )
 
+// Constants - Environment Overrides
+
+// Environment variables applied over logging.toml (env > file > defaults).
+const (
+	EnvLogBaseDir  = "CPI_SI_LOG_BASE_DIR"  // paths.base_dir
+	EnvLogMinLevel = "CPI_SI_LOG_MIN_LEVEL" // behavior.min_level
+	EnvLogFormat   = "CPI_SI_LOG_FORMAT"    // format.output
+	EnvLogContext  = "CPI_SI_LOG_CONTEXT"   // context_capture.mode
+	EnvLogDisabled = "CPI_SI_LOG_DISABLED"  // behavior.disabled
+)
+
+// Output formats (format.output).
+const (
+	OutputText = "text" // Sectioned entries (default)
+	OutputJSON = "json" // One JSON object per line
+)
+
+// Context capture modes (context_capture.mode).
+const (
+	ContextFull    = "full"    // Every level captures full context
+	ContextPartial = "partial" // Per-level policy (behavior.log_level_full_context) - default
+	ContextOff     = "off"     // No level captures full context (no system probes)
+)
+
+// ConfigSource says where a configuration value came from.
+type ConfigSource string
+
+const (
+	SourceDefault ConfigSource = "default" // Hardcoded default
+	SourceFile    ConfigSource = "file"    // logging.toml
+	SourceEnv     ConfigSource = "env"     // CPI_SI_LOG_* environment variable
+)
+
 // Types - Configuration Structure
 
 // LoggingConfig represents the complete logging.toml configuration structure.
@@ -108,6 +158,15 @@ type LoggingConfig struct {
 	Rotation       RotationConfig       `toml:"rotation"`
 	Routing        RoutingConfig        `toml:"routing"`
 	Health         HealthConfig         `toml:"health"`
+	ExitCodes      ExitCodesConfig      `toml:"exit_codes"`
+	Sampling       SamplingConfig       `toml:"sampling"`
+	Display        DisplayConfig        `toml:"display"`
+	Privacy        PrivacyConfig        `toml:"privacy"`
+	Tags           TagsConfig           `toml:"tags"`
+
+	// Sources maps dotted keys ("behavior.min_level") to where they were set.
+	// Keys absent from the map hold defaults. Filled by LoadConfig, never decoded.
+	Sources map[string]ConfigSource `toml:"-"`
 }
 
 // PathsConfig defines base directory configuration.
@@ -127,6 +186,7 @@ type FormatConfig struct {
 	LogDirPermissions  string `toml:"log_dir_permissions"`
 	WarnLogOpenFailed  string `toml:"warn_log_open_failed"`
 	WarnLogWriteFailed string `toml:"warn_log_write_failed"`
+	Output             string `toml:"output"` // text | json ("" = text)
 }
 
 // FilesConfig defines file system configuration.
@@ -145,23 +205,38 @@ type ContextCaptureConfig struct {
 	MemoryUsageFormat  string `toml:"memory_usage_format"`
 	DiskUsageFormat    string `toml:"disk_usage_format"`
 	UnknownValue       string `toml:"unknown_value"`
+	Mode               string `toml:"mode"`              // full | partial | off ("" = partial)
+	CaptureGoRuntime   bool   `toml:"capture_goruntime"` // Go runtime stats on every full-context entry, not just CONTEXT/DEBUG
 }
 
 // BehaviorConfig defines logging behavior policies.
 type BehaviorConfig struct {
-	StackBufferSize     int             `toml:"stack_buffer_size"`
-	LogLevelFullContext map[string]bool `toml:"log_level_full_context"`
+	StackBufferSize         int             `toml:"stack_buffer_size"`
+	LogLevelFullContext     map[string]bool `toml:"log_level_full_context"`
+	CoalesceRepeats         bool            `toml:"coalesce_repeats"`
+	CoalesceWindowSeconds   int             `toml:"coalesce_window_seconds"`
+	CoalesceLevels          map[string]bool `toml:"coalesce_levels"`
+	SpilloverEntries        int             `toml:"spillover_entries"`
+	MinLevel                string          `toml:"min_level"`                   // Entries below this level are not written ("" = all)
+	Disabled                bool            `toml:"disabled"`                    // Write nothing; health and counts still tracked
+	FsyncOnError            bool            `toml:"fsync_on_error"`              // fsync after FAILURE/ERROR entries (power-loss durability)
+	KeepFileOpen            bool            `toml:"keep_file_open"`              // Hold the log open across writes (filehandle.go)
+	KeepFileOpenIdleSeconds int             `toml:"keep_file_open_idle_seconds"` // Close the held log after this long without a write (0 = 5)
+	StrictHealth            bool            `toml:"strict_health"`               // Finalize flags runs off their declared health budget
+	HealthBudgetTolerance   int             `toml:"health_budget_tolerance"`     // Percent positive deltas may exceed DeclareHealthTotal
 }
 
 // MessagesConfig defines user-facing messages and event formats.
 type MessagesConfig struct {
-	EventOpStart    string `toml:"event_op_start"`
-	EventCheckMsg   string `toml:"event_check_msg"`
-	EventSnapshot   string `toml:"event_snapshot"`
-	EventCmdFailed  string `toml:"event_cmd_failed"`
-	EventCmdSuccess string `toml:"event_cmd_success"`
-	CmdFullFormat   string `toml:"cmd_full_format"`
-	DurationFormat  string `toml:"duration_format"`
+	EventOpStart      string `toml:"event_op_start"`
+	EventCheckMsg     string `toml:"event_check_msg"`
+	EventSnapshot     string `toml:"event_snapshot"`
+	EventCmdFailed    string `toml:"event_cmd_failed"`
+	EventCmdSuccess   string `toml:"event_cmd_success"`
+	EventRepeated     string `toml:"event_repeated"`
+	EventSpillFlushed string `toml:"event_spill_flushed"`
+	CmdFullFormat     string `toml:"cmd_full_format"`
+	DurationFormat    string `toml:"duration_format"`
 }
 
 // HealthImpactsConfig defines default health impact values.
@@ -181,21 +256,27 @@ type RetentionConfig struct {
 	AutoAggregate     bool   `toml:"auto_aggregate"`
 	AggregateStartup  bool   `toml:"aggregate_on_startup"`
 	AggregateSchedule string `toml:"aggregate_schedule"`
+	MaxTotalSizeMB    int    `toml:"max_total_size_mb"` // Cap on a log plus its rotations, both naming schemes (0 = unlimited)
 }
 
-// RotationConfig defines file size-based rotation settings.
+// RotationConfig defines size- and age-based rotation settings.
 type RotationConfig struct {
-	Enabled              bool `toml:"enabled"`
-	MaxSizeMB            int  `toml:"max_size_mb"`
-	MaxFilesPerComponent int  `toml:"max_files_per_component"`
-	CompressRotated      bool `toml:"compress_rotated"`
+	Enabled              bool           `toml:"enabled"`
+	MaxSizeMB            int            `toml:"max_size_mb"`
+	MaxFilesPerComponent int            `toml:"max_files_per_component"`
+	CompressRotated      bool           `toml:"compress_rotated"`
+	LevelFileMaxSizeMB   int            `toml:"level_file_max_size_mb"` // Rotation threshold for level-split files (0 = same as main log)
+	MaxAgeDays           int            `toml:"max_age_days"`           // Rotate once the first entry is this old (0 = size only)
+	ComponentMaxAgeDays  map[string]int `toml:"component_max_age_days"` // Per-component max_age_days (wins over the global value)
+	Naming               string         `toml:"rotation_naming"`        // numeric | date ("" = numeric: file.log.1; date: file.2025-11-10.log)
 }
 
 // RoutingConfig maps component names to log subdirectories.
 type RoutingConfig struct {
-	Commands  []string `toml:"commands"`
-	Libraries []string `toml:"libraries"`
-	Scripts   []string `toml:"scripts"`
+	Commands   []string          `toml:"commands"`
+	Libraries  []string          `toml:"libraries"`
+	Scripts    []string          `toml:"scripts"`
+	LevelFiles map[string]string `toml:"level_files"` // Level → extra file suffix (ERROR = "errors" → component.errors.log)
 }
 
 // HealthConfig defines health score visualization thresholds.
@@ -210,6 +291,51 @@ type HealthRange struct {
 	Description string `json:"description"`
 }
 
+// ExitCodesConfig defines the final-health thresholds behind RunSummary.SuggestedExitCode.
+type ExitCodesConfig struct {
+	HealthyMin  int `toml:"healthy_min"`
+	DegradedMin int `toml:"degraded_min"`
+}
+
+// SamplingConfig defines entry sampling - write 1 of every N entries of a level.
+//
+// Rates: components[component][level] wins over levels[level]; unlisted = 1
+// (every entry). Adaptive mode multiplies a level's rate by adaptive_factor
+// while more than adaptive_threshold entries arrive within a second.
+type SamplingConfig struct {
+	Levels            map[string]int            `toml:"levels"`
+	Components        map[string]map[string]int `toml:"components"`
+	Adaptive          bool                      `toml:"adaptive"`
+	AdaptiveThreshold int                       `toml:"adaptive_threshold"`
+	AdaptiveFactor    int                       `toml:"adaptive_factor"`
+}
+
+// DisplayConfig defines how health is rendered for people (never parsed).
+type DisplayConfig struct {
+	Health HealthDisplayConfig `toml:"health"`
+}
+
+// HealthDisplayConfig defines the default HealthStyle (see DefaultHealthStyle).
+type HealthDisplayConfig struct {
+	Indicators  string `toml:"indicators"`
+	BarFilled   string `toml:"bar_filled"`
+	BarEmpty    string `toml:"bar_empty"`
+	BarWidth    int    `toml:"bar_width"`
+	GoodMin     int    `toml:"good_min"`
+	DegradedMin int    `toml:"degraded_min"`
+}
+
+// PrivacyConfig defines encryption at rest (see encryption.go).
+type PrivacyConfig struct {
+	Encrypt bool   `toml:"encrypt"`  // Seal every entry with AES-256-GCM before it is appended
+	KeyFile string `toml:"key_file"` // 32-byte key, mode 0600 ("~/" = home, relative = under ~/.claude)
+}
+
+// TagsConfig defines tags every entry of a component carries (see tags.go).
+type TagsConfig struct {
+	Components map[string][]string `toml:"components"` // Component → tags written ahead of call tags (none by default)
+}
+
 // Package-Level State
 
 // Config holds the loaded configuration (nil until LoadConfig called).
@@ -221,6 +347,52 @@ var configOnce sync.Once
 // ConfigLoaded indicates whether TOML config loaded successfully.
 var ConfigLoaded bool
 
+// envOverrides lists the environment variables applied after logging.toml.
+//
+// apply sets the value and reports whether it was valid (invalid = ignored).
+var envOverrides = []struct {
+	env   string
+	key   string
+	apply func(cfg *LoggingConfig, value string) bool
+}{
+	{EnvLogBaseDir, "paths.base_dir", func(cfg *LoggingConfig, value string) bool {
+		cfg.Paths.BaseDir = value
+		return true
+	}},
+	{EnvLogMinLevel, "behavior.min_level", func(cfg *LoggingConfig, value string) bool {
+		level := strings.ToUpper(value)
+		if _, known := levelRank[level]; !known {
+			return false
+		}
+		cfg.Behavior.MinLevel = level
+		return true
+	}},
+	{EnvLogFormat, "format.output", func(cfg *LoggingConfig, value string) bool {
+		format := strings.ToLower(value)
+		if format != OutputText && format != OutputJSON {
+			return false
+		}
+		cfg.Format.Output = format
+		return true
+	}},
+	{EnvLogContext, "context_capture.mode", func(cfg *LoggingConfig, value string) bool {
+		mode := strings.ToLower(value)
+		if mode != ContextFull && mode != ContextPartial && mode != ContextOff {
+			return false
+		}
+		cfg.ContextCapture.Mode = mode
+		return true
+	}},
+	{EnvLogDisabled, "behavior.disabled", func(cfg *LoggingConfig, value string) bool {
+		disabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return false
+		}
+		cfg.Behavior.Disabled = disabled
+		return true
+	}},
+}
+
 // init loads configuration on package initialization.
 //
 // NOTE: Configuration loading implementation will be added in Phase 7.
@@ -257,24 +429,75 @@ func LoadConfig() {
 		}
 
 		configPath := filepath.Join(homeDir, ".claude", "cpi-si", "system", "config", "logging.toml")
+		Config, ConfigLoaded = loadConfigFile(configPath)
+	})
+}
 
-		// Load TOML config
-		var cfg LoggingConfig
-		if _, err := toml.DecodeFile(configPath, &cfg); err != nil {
-			// Fallback to defaults if config file doesn't exist or is invalid
-			useDefaultConfig()
-			return
+// loadConfigFile layers logging.toml over the defaults, then the environment.
+//
+// Returns loaded=false when the file is missing or invalid (defaults used) -
+// environment overrides apply either way.
+func loadConfigFile(path string) (cfg *LoggingConfig, loaded bool) {
+	cfg = defaultConfig()
+	if metadata, err := toml.DecodeFile(path, cfg); err == nil { // Keys the file omits keep their defaults
+		loaded = true
+		for _, key := range metadata.Keys() {
+			cfg.Sources[key.String()] = SourceFile
 		}
+	} else {
+		cfg = defaultConfig() // A half-decoded invalid file must not leak through
+	}
+	applyEnvOverrides(cfg)
+	return cfg, loaded
+}
 
-		// Config loaded successfully
-		Config = &cfg
-		ConfigLoaded = true
-	})
+// applyEnvOverrides applies set, valid CPI_SI_LOG_* variables and records them as SourceEnv.
+func applyEnvOverrides(cfg *LoggingConfig) {
+	for _, override := range envOverrides {
+		value := strings.TrimSpace(os.Getenv(override.env))
+		if value != "" && override.apply(cfg, value) {
+			cfg.Sources[override.key] = SourceEnv
+		}
+	}
 }
 
-// useDefaultConfig initializes config with hardcoded defaults (fallback when logging.toml unavailable).
+// useDefaultConfig initializes config with hardcoded defaults (fallback when the home directory is unknown).
 func useDefaultConfig() {
-	Config = &LoggingConfig{
+	Config = defaultConfig()
+	applyEnvOverrides(Config)
+	ConfigLoaded = false // Mark as using defaults, not loaded from file
+}
+
+// Source reports where key's value came from ("behavior.min_level" → SourceEnv).
+func (c *LoggingConfig) Source(key string) ConfigSource {
+	if source, ok := c.Sources[key]; ok {
+		return source
+	}
+	return SourceDefault
+}
+
+// Describe renders a setting with its provenance for diagnostics.
+//
+// Example: Config.Describe("behavior.min_level", Config.Behavior.MinLevel)
+// returns "min_level=ERROR (from env)".
+func (c *LoggingConfig) Describe(key string, value any) string {
+	name := key[strings.LastIndex(key, ".")+1:]
+	return fmt.Sprintf("%s=%v (from %s)", name, value, c.Source(key))
+}
+
+// configuredFromEnv reports whether key was set by an environment variable.
+//
+// Settings that older code reads only when ConfigLoaded use this so an
+// override still applies when logging.toml is missing.
+func configuredFromEnv(key string) bool {
+	return Config != nil && Config.Source(key) == SourceEnv
+}
+
+// defaultConfig returns the hardcoded defaults - the fallback, and the base
+// logging.toml is decoded over (tables merge, arrays replace).
+func defaultConfig() *LoggingConfig {
+	return &LoggingConfig{
+		Sources: make(map[string]ConfigSource),
 		Paths: PathsConfig{
 			BaseDir: "cpi-si/output/logs",
 		},
@@ -327,8 +550,21 @@ func useDefaultConfig() {
 				{-100, "💀", "Dead - complete failure"},
 			},
 		},
+		ExitCodes: ExitCodesConfig{
+			HealthyMin:  healthyMinHealth,
+			DegradedMin: degradedMinHealth,
+		},
+		Display: DisplayConfig{
+			Health: HealthDisplayConfig{
+				Indicators:  HealthIndicatorsEmoji,
+				BarFilled:   healthBarFilledDefault,
+				BarEmpty:    healthBarEmptyDefault,
+				BarWidth:    healthBarWidthDefault,
+				GoodMin:     healthyMinHealth, // Bands line up with exit codes by default
+				DegradedMin: degradedMinHealth,
+			},
+		},
 	}
-	ConfigLoaded = false // Mark as using defaults, not loaded from file
 }
 
 // ============================================================================
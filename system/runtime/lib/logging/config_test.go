@@ -0,0 +1,134 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Configuration Override Tests
+//
+// Purpose: Prove CPI_SI_LOG_* variables beat logging.toml, which beats the
+//          defaults, with provenance recorded per key - and that min_level,
+//          disabled, context mode, and JSON output change what reaches disk.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// withBehavior applies changes to the loaded config for one test (or
+// benchmark) - marked loaded so the changes win over the fallbacks - and
+// restores it afterwards. Every test that touches Config goes through here.
+func withBehavior(t testing.TB, change func(cfg *LoggingConfig)) {
+	t.Helper()
+	LoadConfig()
+	saved, savedLoaded := *Config, ConfigLoaded
+	t.Cleanup(func() { *Config, ConfigLoaded = saved, savedLoaded })
+	ConfigLoaded = true
+	change(Config)
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestEnvOverridesFileOverridesDefaults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logging.toml")
+	toml := "[behavior]\nmin_level = \"FAILURE\"\n\n[format]\noutput = \"text\"\n"
+	if err := os.WriteFile(path, []byte(toml), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(EnvLogMinLevel, "error")
+	t.Setenv(EnvLogFormat, "xml") // Invalid - ignored, the file's value stays
+	t.Setenv(EnvLogContext, "")
+
+	cfg, loaded := loadConfigFile(path)
+	if !loaded {
+		t.Fatal("logging.toml not loaded")
+	}
+	checks := []struct {
+		key, value string
+		got        any
+		source     ConfigSource
+	}{
+		{"behavior.min_level", "ERROR", cfg.Behavior.MinLevel, SourceEnv},
+		{"format.output", "text", cfg.Format.Output, SourceFile},
+		{"context_capture.mode", "", cfg.ContextCapture.Mode, SourceDefault},
+	}
+	for _, check := range checks {
+		if check.got != check.value || cfg.Source(check.key) != check.source {
+			t.Errorf("%s = %q from %s, want %q from %s", check.key, check.got, cfg.Source(check.key), check.value, check.source)
+		}
+	}
+	if got := cfg.Describe("behavior.min_level", cfg.Behavior.MinLevel); got != "min_level=ERROR (from env)" {
+		t.Errorf("Describe = %q", got)
+	}
+
+	t.Setenv(EnvLogDisabled, "1")
+	cfg, loaded = loadConfigFile(filepath.Join(t.TempDir(), "missing.toml"))
+	if loaded || !cfg.Behavior.Disabled || cfg.Source("behavior.disabled") != SourceEnv {
+		t.Errorf("missing file: loaded=%v disabled=%v (%s), want env applied over defaults", loaded, cfg.Behavior.Disabled, cfg.Source("behavior.disabled"))
+	}
+}
+
+func TestMinLevelAndDisabledKeepHealth(t *testing.T) {
+	logger := newTestLogger(t, "min-level-test")
+	withBehavior(t, func(cfg *LoggingConfig) { cfg.Behavior.MinLevel = levelFailure })
+
+	logger.Success("quiet", 10, nil)
+	logger.Failure("loud", "broken", -5, nil)
+	if entries := readEntries(t, logger.LogFile); len(entries) != 1 || entries[0].Event != "loud" {
+		t.Errorf("entries = %+v, want only the FAILURE", entries)
+	}
+
+	Config.Behavior.Disabled = true
+	before, _ := os.ReadFile(logger.LogFile)
+	logger.Error("unseen", os.ErrClosed, -20)
+	after, _ := os.ReadFile(logger.LogFile)
+	if string(after) != string(before) {
+		t.Error("disabled logging wrote to the log file")
+	}
+	if logger.SessionHealth != 10-5-20 {
+		t.Errorf("SessionHealth = %d, want filtered and disabled entries still counted (-15)", logger.SessionHealth)
+	}
+}
+
+func TestJSONOutputRoundTripsWithoutContext(t *testing.T) {
+	logger := newTestLogger(t, "json-output-test")
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Format.Output = OutputJSON
+		cfg.ContextCapture.Mode = ContextOff
+	})
+
+	logger.Operation("deploy", 5, "--dry-run")
+	logger.Success("deployed", 10, map[string]any{"files": 3})
+
+	raw, err := os.ReadFile(logger.LogFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	if last := lines[len(lines)-1]; !strings.HasPrefix(last, "{") || strings.Contains(last, "RawSections") {
+		t.Errorf("last line is not a JSON entry: %s", last)
+	}
+
+	entries := readEntries(t, logger.LogFile)
+	if len(entries) != 2 {
+		t.Fatalf("read %d entries, want 2", len(entries))
+	}
+	if entries[0].Level != levelOperation || entries[0].Context != nil {
+		t.Errorf("OPERATION with context off: level %s, context %+v", entries[0].Level, entries[0].Context)
+	}
+	if entries[1].Event != "deployed" || entries[1].Details["files"] != float64(3) || entries[1].Sequence != 4 {
+		t.Errorf("SUCCESS did not round-trip: %+v", entries[1])
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -20,22 +20,26 @@
 // Architect: Seanje Lenox-Wise, Nova Dawn
 // Implementation: Nova Dawn
 // Creation Date: 2025-11-18
-// Version: 1.0.0
-// Last Modified: 2025-11-18 - Extracted from monolithic logger.go
+// Version: 1.5.0
+// Last Modified: 2026-10-16 - Optional Go runtime stats (GoRuntime) for CONTEXT/DEBUG entries
 //
 // Purpose & Function
 //
 // Purpose: Capture complete system environment state for log entries. Provides WHO, WHERE, WHAT context needed for debugging and understanding execution environment.
 //
-// Core Design: Graceful degradation pattern - attempt all captures, never fail, return "unknown" when information unavailable.
+// Core Design: Graceful degradation pattern - attempt all captures, never fail, return "unknown" when information unavailable. Every probe reads files or syscalls directly (no child processes) and falls back on its own, so one missing /proc file costs one metric, not the whole snapshot.
 //
 // Key Features:
-//   - User identity (username, hostname, PID)
-//   - Shell context (type, interactive/non-interactive, login/non-login)
-//   - Environment state (automation variables, CPI-SI framework vars)
-//   - Sudoers configuration status (installed, valid permissions)
-//   - System metrics (CPU load, memory usage, disk usage)
+//   - User identity (username, hostname, PID) with env and file fallbacks
+//   - Container detection (/.dockerenv, /run/.containerenv, container env, cgroups)
+//   - Shell context (type, interactive/non-interactive, login/non-login; PowerShell/cmd on Windows)
+//   - Environment state (automation variables, CPI-SI framework vars; secret-looking values redacted)
+//   - Sudoers configuration status (installed, valid permissions; skipped on Windows)
+//   - System metrics (CPU load, memory usage, disk usage) from /proc and statfs, kernel32 on Windows
 //   - Current working directory
+//   - Go runtime stats (heap, objects, GC cycles, goroutines) - CONTEXT and DEBUG
+//     entries, or every full-context entry with capture_goruntime = true
+//     (runtime.ReadMemStats stops the world briefly, so not by default)
 //
 // Blocking Status
 //
@@ -61,12 +65,15 @@
 //   captureShellContext() ShellContext - Shell type and mode
 //   captureEnvState() map[string]string - Environment variables
 //   captureSudoersContext() SudoersContext - Sudoers configuration
+//   captureGoRuntime() *GoRuntimeStats - Heap, GC, and goroutine snapshot
 //
 // Dependencies
 //
 // Dependencies (What This Needs):
-//   Standard Library: fmt, os, os/exec, path/filepath, runtime, strings
-//   Package Files: None (context capture is foundation)
+//   Standard Library: fmt, math, os, os/user, path/filepath, runtime, strconv, strings, sync
+//   Platform Files: context_unix.go / context_windows.go (memory, sudoers support, Windows disk),
+//                   context_statfs.go (disk usage via syscall.Statfs), context_statfs_other.go (unknown)
+//   Package Files: selfhealth.go (noteContext - CaptureContext counts its fallbacks)
 //
 // Dependents (What Uses This):
 //   Internal: logger.go (all logging methods call CaptureContext)
@@ -77,10 +84,14 @@
 //
 // Context Capture Operations (30 pts):
 //   - Shell detection (interactive/login): +5 (success), +2 (partial), 0 (failure)
-//   - Sudoers validation: +5 (correct), +2 (wrong perms), 0 (missing)
+//   - Sudoers validation: +5 (correct), +2 (wrong perms), 0 (missing), neutral on Windows (skipped)
 //   - Environment state capture: +10 (all vars), +5 (some vars), 0 (none)
 //   - System metrics (CPU/Memory/Disk): +10 (all three), +5 (some), 0 (none)
 //
+// Each metric is probed independently - a container without /proc/loadavg
+// still scores +5 from memory and disk instead of 0. Identity fallbacks
+// (HOSTNAME, /etc/hostname, LOGNAME, passwd lookup) count as success.
+//
 // Note: This module's health is about capture completeness, not system health itself.
 
 package logging
@@ -93,11 +104,14 @@ package logging
 
 import (
 	"fmt"           // String formatting for metrics output
+	"math"          // Round-up for human-readable disk sizes
 	"os"            // File operations, environment variables, process info
-	"os/exec"       // System command execution (df command)
+	"os/user"       // Username lookup when USER/LOGNAME are unset
 	"path/filepath" // Path manipulation for shell basename extraction
-	"runtime"       // OS detection (Linux-specific paths)
+	"runtime"       // OS detection (Linux-specific paths), memory stats, goroutine count
+	"strconv"       // Go runtime stats round trip
 	"strings"       // String processing for parsing system files
+	"sync"          // Container detection runs once per process
 )
 
 // Constants
@@ -109,12 +123,17 @@ const (
 	sudoersFilePath = "/etc/sudoers.d/90-cpi-si-safe-operations" // CPI-SI sudoers configuration file
 	procLoadAvgPath = "/proc/loadavg"                             // Linux CPU load averages file
 	procMeminfoPath = "/proc/meminfo"                             // Linux memory info file
+	etcHostnamePath = "/etc/hostname"                             // Hostname file (minimal containers without uname access)
+	procCgroupPath  = "/proc/1/cgroup"                            // PID 1 cgroup membership (container runtimes)
+	dockerEnvPath   = "/.dockerenv"                               // Docker marker file
+	podmanEnvPath   = "/run/.containerenv"                        // Podman marker file
 
 	//--- File Permissions ---
 	// Required permissions for security-sensitive files.
 
 	sudoersValidPerms = 0440          // Required permissions for sudoers file (octal)
 	permissionsFormat = "%04o"        // Octal format for permissions display
+	sudoersSkipReason = "no sudoers on this platform" // Reason recorded when sudoers capture is skipped
 
 	//--- Format Strings ---
 	// Output formatting for system metrics.
@@ -132,11 +151,29 @@ const (
 	loginShellPrefix   = "-"       // Login shell prefix in $0
 	loginShellLevel    = "1"       // SHLVL value for login shells
 
-	//--- System Commands ---
-	// External commands and their arguments.
+	//--- Windows Shell Markers ---
+	// Environment hints used when $SHELL is unset (PowerShell and cmd don't set it).
+
+	comSpecEnvVar      = "ComSpec"     // Default command interpreter (cmd.exe)
+	promptEnvVar       = "PROMPT"      // Exported by cmd.exe, never by PowerShell
+	psModulePathEnvVar = "PSModulePath" // Module search path (set system-wide, extended per PowerShell edition)
+	pwshModuleMarker   = `\PowerShell\`        // Documents\PowerShell\Modules or Program Files\PowerShell (pwsh)
+	psModuleMarker     = `\WindowsPowerShell\` // Documents\WindowsPowerShell\Modules (Windows PowerShell 5.x)
+	systemModuleMarker = `\System32\`          // System-wide entry present in every process on Windows
+	programFilesMarker = `\Program Files`       // Machine-wide entries (also present outside PowerShell)
+
+	//--- Identity Environment Variables ---
+	// Fallbacks tried in order when the primary lookup fails.
+
+	userEnvVar     = "USER"     // Login name (most shells)
+	lognameEnvVar  = "LOGNAME"  // Login name (POSIX, set by login/sshd)
+	hostnameEnvVar = "HOSTNAME" // Hostname (bash, Docker sets it for PID 1)
+
+	//--- Container Markers ---
+	// Environment variables set inside containers.
 
-	dfCommand   = "df" // Disk free command
-	dfHumanFlag = "-h" // Human-readable flag for df
+	containerEnvVar  = "container"               // systemd-nspawn, podman, LXC
+	kubernetesEnvVar = "KUBERNETES_SERVICE_HOST" // Every Kubernetes pod
 
 	//--- Graceful Failure Values ---
 	// Default values when context capture fails.
@@ -165,10 +202,14 @@ type ShellContext struct {
 //
 // Used by SystemContext to verify safe operations configuration. Tracks both
 // file existence and correct permissions (must be 0440 for sudoers.d files).
+//
+// Skipped marks platforms without sudo (Windows) - a neutral outcome, not a
+// missing installation.
 type SudoersContext struct {
 	Installed   bool   // File installed (true = exists at /etc/sudoers.d/90-cpi-si-safe-operations, false = missing)
 	Valid       bool   // Permissions valid (true = correct 0440, false = wrong permissions)
 	Permissions string // Actual permissions (octal string)
+	Skipped     bool   // Not applicable on this platform (true = no check performed)
 }
 
 // SystemMetrics captures how busy the computer is at this exact moment.
@@ -178,24 +219,47 @@ type SudoersContext struct {
 type SystemMetrics struct {
 	Load   string // CPU load averages (1min, 5min, 15min from /proc/loadavg)
 	Memory string // RAM usage (used/total MB from /proc/meminfo)
-	Disk   string // Disk space (used/total with % from df command)
+	Disk   string // Disk space (used/total with %, statfs on the CWD filesystem)
+}
+
+// GoRuntimeStats captures this process's Go runtime at this exact moment.
+//
+// Used by SystemContext to follow memory growth in long-running processes -
+// compare the heap across snapshots. Collected only when asked for
+// (captureGoRuntime), since runtime.ReadMemStats stops the world.
+type GoRuntimeStats struct {
+	HeapAlloc   uint64 // Bytes of allocated heap objects
+	HeapObjects uint64 // Number of allocated heap objects
+	NumGC       uint32 // Completed GC cycles
+	Goroutines  int    // Goroutines that currently exist
 }
 
 // SystemContext captures everything about the system at this exact moment.
 //
 // Composes all building blocks (ShellContext, SudoersContext, SystemMetrics)
 // into complete environment snapshot. Used by LogEntry for full context capture.
+// GoRuntime is nil unless the entry's level asked for it (captureFor).
 type SystemContext struct {
-	User     string            // Username running process
-	Host     string            // Computer hostname
-	PID      int               // Process ID
-	Shell    ShellContext      // Shell configuration
-	CWD      string            // Current working directory
-	EnvState map[string]string // Relevant environment variables
-	Sudoers  SudoersContext    // Sudo configuration
-	System   SystemMetrics     // Resource usage snapshot
+	User      string            // Username running process
+	Host      string            // Computer hostname
+	PID       int               // Process ID
+	Container bool              // Running inside a container (docker, podman, kubernetes, ...)
+	Shell     ShellContext      // Shell configuration
+	CWD       string            // Current working directory
+	EnvState  map[string]string // Relevant environment variables
+	Sudoers   SudoersContext    // Sudo configuration
+	System    SystemMetrics     // Resource usage snapshot
+	GoRuntime *GoRuntimeStats   `json:",omitempty"` // Go heap, GC, and goroutines (nil = not captured)
 }
 
+// Package-Level State
+
+// containerOnce / inContainer cache container detection (cannot change mid-process).
+var (
+	containerOnce sync.Once
+	inContainer   bool
+)
+
 // Type Methods
 
 // Format converts ShellContext to human-readable string for logs.
@@ -217,6 +281,9 @@ func (s ShellContext) Format() string {
 //
 // Returns map with installed status, validity, and permissions for debugging output.
 func (s SudoersContext) ToMap() map[string]string {
+	if s.Skipped { // Nothing checked - say so instead of reporting "missing"
+		return map[string]string{"skipped": sudoersSkipReason}
+	}
 	return map[string]string{
 		"installed":   fmt.Sprintf("%v", s.Installed),   // Convert bool to string
 		"valid":       fmt.Sprintf("%v", s.Valid),       // Convert bool to string
@@ -235,6 +302,36 @@ func (m SystemMetrics) ToMap() map[string]string {
 	}
 }
 
+// ToMap converts GoRuntimeStats to map format for structured logging.
+//
+// Values are plain integers (bytes, counts) so parsed entries compare exactly.
+func (g GoRuntimeStats) ToMap() map[string]string {
+	return map[string]string{
+		"heap_alloc":   strconv.FormatUint(g.HeapAlloc, 10),     // Heap bytes in use
+		"heap_objects": strconv.FormatUint(g.HeapObjects, 10),   // Live heap objects
+		"gc_cycles":    strconv.FormatUint(uint64(g.NumGC), 10), // Completed GC cycles
+		"goroutines":   strconv.Itoa(g.Goroutines),              // Goroutine count
+	}
+}
+
+// setField restores one ToMap key (parser); unknown keys and bad numbers are ignored.
+func (g *GoRuntimeStats) setField(key, value string) {
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return
+	}
+	switch key {
+	case "heap_alloc":
+		g.HeapAlloc = n
+	case "heap_objects":
+		g.HeapObjects = n
+	case "gc_cycles":
+		g.NumGC = uint32(n)
+	case "goroutines":
+		g.Goroutines = int(n)
+	}
+}
+
 // ============================================================================
 // END SETUP
 // ============================================================================
@@ -247,22 +344,67 @@ func (m SystemMetrics) ToMap() map[string]string {
 // Helpers - Foundation Functions
 // ────────────────────────────────────────────────────────────────
 
-// getCurrentUser retrieves the current username from $USER environment variable.
+// getCurrentUser retrieves the current username ($USER, $LOGNAME, then the passwd entry).
 func getCurrentUser() string {
-	if user := os.Getenv("USER"); user != "" { // $USER environment variable set
-		return user // Return actual username
+	for _, envVar := range []string{userEnvVar, lognameEnvVar} { // Shell-provided names first
+		if name := os.Getenv(envVar); name != "" {
+			return name
+		}
+	}
+	if current, err := user.Current(); err == nil && current.Username != "" { // Containers often set neither
+		return current.Username
 	}
 	return unknownValue // Fail gracefully with constant
 }
 
-// getHostname retrieves the system hostname.
+// getHostname retrieves the system hostname (kernel, $HOSTNAME, then /etc/hostname).
 func getHostname() string {
-	if host, err := os.Hostname(); err == nil { // Hostname lookup succeeded
+	if host, err := os.Hostname(); err == nil && host != "" { // Hostname lookup succeeded
 		return host // Return actual hostname
 	}
+	if host := os.Getenv(hostnameEnvVar); host != "" { // Set by bash and container runtimes
+		return host
+	}
+	if data, err := os.ReadFile(etcHostnamePath); err == nil { // Static hostname file
+		if host := strings.TrimSpace(string(data)); host != "" {
+			return host
+		}
+	}
 	return unknownValue // Fail gracefully with constant
 }
 
+// isContainer reports whether this process runs inside a container (detected once).
+//
+// Markers: /.dockerenv, /run/.containerenv, $container, $KUBERNETES_SERVICE_HOST,
+// or a container runtime in PID 1's cgroup path. Any one is enough.
+func isContainer() bool {
+	containerOnce.Do(func() {
+		inContainer = detectContainer()
+	})
+	return inContainer
+}
+
+// detectContainer checks each container marker in turn.
+func detectContainer() bool {
+	for _, marker := range []string{dockerEnvPath, podmanEnvPath} { // Runtime marker files
+		if _, err := os.Stat(marker); err == nil {
+			return true
+		}
+	}
+	if os.Getenv(containerEnvVar) != "" || os.Getenv(kubernetesEnvVar) != "" { // Runtime env markers
+		return true
+	}
+	if data, err := os.ReadFile(procCgroupPath); err == nil { // cgroup v1 paths name the runtime
+		cgroups := string(data)
+		for _, runtimeName := range []string{"docker", "kubepods", "containerd", "libpod", "lxc"} {
+			if strings.Contains(cgroups, runtimeName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // getCWD retrieves the current working directory.
 func getCWD() string {
 	if cwd, err := os.Getwd(); err == nil { // CWD lookup succeeded
@@ -284,15 +426,45 @@ func isTerminal(file *os.File) bool {
 // Core Operations - Context Capture
 // ────────────────────────────────────────────────────────────────
 
-// captureShellContext captures shell type and execution mode.
-func captureShellContext() ShellContext {
-	shell := os.Getenv("SHELL") // Get shell path from environment
-	if shell == "" {             // $SHELL not set
-		shell = unknownValue // Use constant for graceful failure
+// detectShellType names the running shell from environment variables.
+//
+// $SHELL wins everywhere it is set (Unix, Git Bash, MSYS). On Windows, where
+// PowerShell and cmd leave it unset, cmd is recognized by its exported PROMPT,
+// PowerShell by the per-user Documents entry each edition adds to PSModulePath
+// (a plain process only sees the machine-wide entries), and ComSpec is the last resort.
+// getenv is injected so every branch is testable on any platform.
+func detectShellType(getenv func(string) string, windows bool) string {
+	if shell := getenv("SHELL"); shell != "" { // Unix shells and MSYS/Git Bash
+		return strings.TrimSuffix(filepath.Base(filepath.FromSlash(shell)), ".exe")
+	}
+	if !windows {
+		return unknownValue // $SHELL not set - use constant for graceful failure
 	}
 
-	// Determine shell type from path
-	shellType := filepath.Base(shell) // Extract basename (e.g., /bin/bash → bash)
+	if getenv(promptEnvVar) != "" { // Only cmd exports PROMPT
+		return "cmd"
+	}
+	for _, entry := range strings.Split(getenv(psModulePathEnvVar), ";") { // Look past the system-wide entry
+		switch {
+		case strings.Contains(entry, systemModuleMarker), strings.Contains(entry, programFilesMarker):
+			continue
+		case strings.Contains(entry, psModuleMarker):
+			return "powershell"
+		case strings.Contains(entry, pwshModuleMarker):
+			return "pwsh"
+		}
+	}
+	if comSpec := getenv(comSpecEnvVar); comSpec != "" { // Default interpreter (cmd.exe)
+		base := strings.ToLower(comSpec[strings.LastIndexAny(comSpec, `\/`)+1:])
+		return strings.TrimSuffix(base, ".exe")
+	}
+	return unknownValue // Fail gracefully with constant
+}
+
+// captureShellContext captures shell type and execution mode.
+func captureShellContext() ShellContext {
+	// Determine shell type from environment (e.g., /bin/bash → bash, PowerShell → powershell)
+	shellType := detectShellType(os.Getenv, runtime.GOOS == "windows")
 
 	// Interactive if stdin is a terminal
 	interactive := isTerminal(os.Stdin) // Check if stdin is TTY
@@ -308,6 +480,9 @@ func captureShellContext() ShellContext {
 }
 
 // captureEnvState captures automation and CPI-SI framework environment variables.
+//
+// Values are redacted with the export sanitizer's rules (redactSecret) - a
+// CPI_SI_*_TOKEN never reaches the log in the first place.
 func captureEnvState() map[string]string {
 	envVars := make(map[string]string)
 
@@ -325,7 +500,7 @@ func captureEnvState() map[string]string {
 
 	for _, varName := range relevantVars {         // Iterate relevant variables
 		if value := os.Getenv(varName); value != "" { // Variable is set
-			envVars[varName] = value // Add to map
+			envVars[varName] = redactSecret(varName, value) // Add to map (secrets redacted)
 		}
 	}
 
@@ -334,7 +509,7 @@ func captureEnvState() map[string]string {
 		if strings.HasPrefix(env, frameworkEnvPrefix) { // Matches CPI_SI_* prefix
 			parts := strings.SplitN(env, "=", 2) // Split on first = only
 			if len(parts) == 2 {                  // Valid key=value format
-				envVars[parts[0]] = parts[1] // Add framework var to map
+				envVars[parts[0]] = redactSecret(parts[0], parts[1]) // Add framework var to map (secrets redacted)
 			}
 		}
 	}
@@ -344,6 +519,10 @@ func captureEnvState() map[string]string {
 
 // captureSudoersContext captures sudoers configuration state (existence and permissions).
 func captureSudoersContext() SudoersContext {
+	if !sudoersSupported { // Platform file (context_unix.go / context_windows.go)
+		return SudoersContext{Permissions: unknownValue, Skipped: true}
+	}
+
 	// Direct file system check to avoid circular dependency with sudoers library
 	permissions := unknownValue // Default to unknown if file doesn't exist
 	installed := false           // Assume not installed until verified
@@ -381,56 +560,76 @@ func captureLoadAvg() string {
 	return unknownValue // Fail gracefully with constant
 }
 
-// captureMemoryUsage captures RAM usage from /proc/meminfo.
-func captureMemoryUsage() string {
-	// Read /proc/meminfo on Linux
-	if runtime.GOOS == "linux" { // Linux-specific implementation
-		if data, err := os.ReadFile(procMeminfoPath); err == nil { // Read succeeded
-			lines := strings.Split(string(data), "\n") // Split into lines
-			var total, available int64                  // Memory values in KB
-
-			for _, line := range lines {   // Parse each line
-				fields := strings.Fields(line) // Split on whitespace
-				if len(fields) >= 2 {           // Valid key-value line
-					switch fields[0] { // Check field name
-					case "MemTotal:": // Total RAM line
-						fmt.Sscanf(fields[1], "%d", &total) // Parse KB value
-					case "MemAvailable:": // Available RAM line
-						fmt.Sscanf(fields[1], "%d", &available) // Parse KB value
-					}
-					if total > 0 && available > 0 { // Both values found
-						break // Stop parsing remaining lines
-					}
-				}
-			}
-
-			if total > 0 && available > 0 {    // Both values parsed successfully
-				used := total - available       // Calculate used memory
-				return fmt.Sprintf(memoryUsageFormat, used/kbToMbDivisor, total/kbToMbDivisor) // Format as MB
+// parseMeminfo computes used and total memory (KB) from /proc/meminfo contents.
+func parseMeminfo(data string) (usedKB, totalKB int64, ok bool) {
+	var total, available int64      // Memory values in KB
+	var free, buffers, cached int64 // Pre-3.14 kernels lack MemAvailable
+
+	for _, line := range strings.Split(data, "\n") { // Parse each line
+		fields := strings.Fields(line) // Split on whitespace
+		if len(fields) >= 2 {           // Valid key-value line
+			switch fields[0] { // Check field name
+			case "MemTotal:": // Total RAM line
+				fmt.Sscanf(fields[1], "%d", &total) // Parse KB value
+			case "MemAvailable:": // Available RAM line
+				fmt.Sscanf(fields[1], "%d", &available) // Parse KB value
+			case "MemFree:":
+				fmt.Sscanf(fields[1], "%d", &free)
+			case "Buffers:":
+				fmt.Sscanf(fields[1], "%d", &buffers)
+			case "Cached:":
+				fmt.Sscanf(fields[1], "%d", &cached)
 			}
 		}
 	}
+	if available == 0 { // Estimate the way free(1) did before MemAvailable
+		available = free + buffers + cached
+	}
+
+	if total > 0 && available > 0 { // Both values parsed successfully
+		return total - available, total, true
+	}
+	return 0, 0, false
+}
+
+// captureMemoryUsage captures RAM usage (/proc/meminfo, GlobalMemoryStatusEx on Windows).
+func captureMemoryUsage() string {
+	if usedKB, totalKB, ok := memoryUsage(); ok { // Platform file (context_unix.go / context_windows.go)
+		return fmt.Sprintf(memoryUsageFormat, usedKB/kbToMbDivisor, totalKB/kbToMbDivisor) // Format as MB
+	}
 	return unknownValue // Fail gracefully with constant
 }
 
-// captureDiskUsage captures disk usage for current working directory filesystem using df.
+// captureDiskUsage captures disk usage for the current working directory's filesystem.
+//
+// statfs instead of df: distroless images have no df, and spawning a process
+// per full-context entry is wasted work anyway.
 func captureDiskUsage() string {
-	// Get disk usage for current working directory
 	cwd := getCWD() // Get current directory (or "unknown")
+	if cwd == unknownValue {
+		cwd = "/" // Root filesystem is better than nothing
+	}
+
+	used, size, percent, ok := diskUsage(cwd) // Platform file (context_statfs*.go, context_windows.go)
+	if !ok {
+		return unknownValue // Fail gracefully with constant
+	}
+	return fmt.Sprintf(diskUsageFormat, humanBytes(used), humanBytes(size), fmt.Sprintf("%d%%", percent))
+}
 
-	if runtime.GOOS == "linux" {                        // Linux-specific implementation
-		cmd := exec.Command(dfCommand, dfHumanFlag, cwd) // df -h for human-readable output
-		if output, err := cmd.Output(); err == nil {     // Command succeeded
-			lines := strings.Split(string(output), "\n") // Split output into lines
-			if len(lines) >= 2 {                          // Has header + data line
-				fields := strings.Fields(lines[1])        // Parse data line (second line)
-				if len(fields) >= 5 {                      // Has filesystem, size, used, avail, use%, mount
-					return fmt.Sprintf(diskUsageFormat, fields[2], fields[1], fields[4]) // Format: used / total (percentage)
-				}
+// humanBytes formats a byte count like df -h (1024-based, rounded up, one decimal below 10).
+func humanBytes(bytes uint64) string {
+	value := float64(bytes)
+	for _, unit := range []string{"B", "K", "M", "G", "T"} {
+		if value < 1024 {
+			if value < 10 && unit != "B" {
+				return fmt.Sprintf("%.1f%s", math.Ceil(value*10)/10, unit)
 			}
+			return fmt.Sprintf("%.0f%s", math.Ceil(value), unit)
 		}
+		value /= 1024
 	}
-	return unknownValue // Fail gracefully with constant
+	return fmt.Sprintf("%.0fP", value)
 }
 
 // captureSystemMetrics orchestrates complete system resource metrics capture.
@@ -442,22 +641,40 @@ func captureSystemMetrics() SystemMetrics {
 	}
 }
 
+// captureGoRuntime reads the Go runtime's heap, GC, and goroutine counts.
+//
+// runtime.ReadMemStats stops the world for a moment - callers decide when
+// it is worth it (goRuntimeFor in logger.go).
+func captureGoRuntime() *GoRuntimeStats {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	return &GoRuntimeStats{
+		HeapAlloc:   mem.HeapAlloc,
+		HeapObjects: mem.HeapObjects,
+		NumGC:       mem.NumGC,
+		Goroutines:  runtime.NumGoroutine(),
+	}
+}
+
 // ────────────────────────────────────────────────────────────────
 // Logger Methods - Context Orchestration
 // ────────────────────────────────────────────────────────────────
 
 // CaptureContext orchestrates complete system state capture (WHO, WHERE, WHY).
 func (l *Logger) CaptureContext() *SystemContext {
-	return &SystemContext{ // Orchestrate complete context capture
-		User:     l.username,             // Pre-computed username (captured once at initialization)
-		Host:     l.hostname,             // Pre-computed hostname (captured once at initialization)
-		PID:      l.pid,                  // Pre-computed PID (captured once at initialization)
-		Shell:    captureShellContext(),  // Shell type and mode (dynamic - can change)
-		CWD:      getCWD(),                // Current working directory (dynamic - can change)
-		EnvState: captureEnvState(),       // Environment variables (dynamic - can change)
-		Sudoers:  captureSudoersContext(), // Sudoers configuration (dynamic - can change)
-		System:   captureSystemMetrics(),  // System resource metrics (dynamic - constantly changing)
+	context := &SystemContext{ // Orchestrate complete context capture
+		User:      l.username,              // Pre-computed username (captured once at initialization)
+		Host:      l.hostname,              // Pre-computed hostname (captured once at initialization)
+		PID:       l.pid,                   // Pre-computed PID (captured once at initialization)
+		Container: isContainer(),           // Container detection (cached for the process)
+		Shell:     captureShellContext(),   // Shell type and mode (dynamic - can change)
+		CWD:       getCWD(),                // Current working directory (dynamic - can change)
+		EnvState:  captureEnvState(),       // Environment variables (dynamic - can change)
+		Sudoers:   captureSudoersContext(), // Sudoers configuration (dynamic - can change)
+		System:    captureSystemMetrics(),  // System resource metrics (dynamic - constantly changing)
 	}
+	l.noteContext(context) // Count "unknown" probes (SelfDiagnostics)
+	return context
 }
 
 // ============================================================================
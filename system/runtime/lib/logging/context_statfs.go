@@ -0,0 +1,63 @@
+//go:build linux || darwin || freebsd
+
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Disk Usage via statfs (Unix) - Logging Library
+//
+// Biblical Foundation: See context.go
+// CPI-SI Identity: Platform primitive for system metrics capture
+//
+// Purpose: Read filesystem block counts with statfs(2) so disk usage works in
+//          images without df and costs no child process per entry.
+//
+// Authorship: Nova Dawn
+// Version: 1.0.0
+//
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"syscall" // statfs(2) filesystem statistics
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// diskUsage returns used and total bytes plus df's Use% for the filesystem holding path.
+//
+// Use% follows df: used / (used + available to unprivileged users), rounded up,
+// so the reserved root blocks don't hide a nearly full disk.
+func diskUsage(path string) (used, size uint64, percent int, ok bool) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, 0, 0, false
+	}
+
+	blockSize := uint64(stat.Bsize) // Field widths differ per platform
+	size = uint64(stat.Blocks) * blockSize
+	free := uint64(stat.Bfree) * blockSize
+	available := uint64(stat.Bavail) * blockSize
+	if size == 0 || free > size { // Pseudo filesystem - nothing meaningful to report
+		return 0, 0, 0, false
+	}
+
+	used = size - free
+	if usable := used + available; usable > 0 {
+		percent = int((used*100 + usable - 1) / usable) // Round up like df
+	}
+	return used, size, percent, true
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with context.go (go build ./logging)
+// Code Execution: Library primitive (called by captureDiskUsage)
+// Code Cleanup: None (single syscall, no handles held)
@@ -0,0 +1,35 @@
+//go:build !(linux || darwin || freebsd || windows)
+
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Disk Usage Fallback (Other Platforms) - Logging Library
+//
+// Biblical Foundation: See context.go
+// CPI-SI Identity: Platform primitive for system metrics capture
+//
+// Purpose: Platforms with neither statfs nor the Windows disk API report
+//          disk usage as unknown instead of failing the build.
+//
+// Authorship: Nova Dawn
+// Version: 1.0.0
+//
+package logging
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// diskUsage reports no data - captureDiskUsage falls back to "unknown".
+func diskUsage(path string) (used, size uint64, percent int, ok bool) {
+	return 0, 0, 0, false
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with context.go (go build ./logging)
+// Code Execution: Library primitive (called by captureDiskUsage)
+// Code Cleanup: None
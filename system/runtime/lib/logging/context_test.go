@@ -0,0 +1,187 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// System Context Capture Tests
+//
+// Purpose: Exercise the platform-independent halves of context capture on any
+//          OS - shell detection from Windows-style environments, /proc/meminfo
+//          parsing, the skipped-sudoers map, rotation retry with backoff, and
+//          Go runtime stats in snapshots (heap growth visible after a round trip),
+//          capture_goruntime reaching full-context levels only, and
+//          partial-context levels skipping the probes for cached identity.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// fakeEnv builds a getenv from a map
+func fakeEnv(vars map[string]string) func(string) string {
+	return func(key string) string { return vars[key] }
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestDetectShellType(t *testing.T) {
+	systemModules := `C:\Program Files\WindowsPowerShell\Modules;C:\WINDOWS\System32\WindowsPowerShell\v1.0\Modules`
+	cases := []struct {
+		name    string
+		env     map[string]string
+		windows bool
+		want    string
+	}{
+		{"unix shell", map[string]string{"SHELL": "/bin/zsh"}, false, "zsh"},
+		{"unix unset", map[string]string{"PROMPT": "$P$G"}, false, unknownValue},
+		{"git bash", map[string]string{"SHELL": "/usr/bin/bash", "PROMPT": "$P$G"}, true, "bash"},
+		{"cmd", map[string]string{"PROMPT": "$P$G", psModulePathEnvVar: systemModules}, true, "cmd"},
+		{"windows powershell", map[string]string{
+			psModulePathEnvVar: `C:\Users\me\Documents\WindowsPowerShell\Modules;` + systemModules,
+		}, true, "powershell"},
+		{"pwsh", map[string]string{
+			psModulePathEnvVar: `C:\Users\me\Documents\PowerShell\Modules;C:\Program Files\PowerShell\7\Modules;` + systemModules,
+		}, true, "pwsh"},
+		{"comspec only", map[string]string{comSpecEnvVar: `C:\WINDOWS\system32\CMD.EXE`, psModulePathEnvVar: systemModules}, true, "cmd"},
+		{"nothing", map[string]string{}, true, unknownValue},
+	}
+	for _, tc := range cases {
+		if got := detectShellType(fakeEnv(tc.env), tc.windows); got != tc.want {
+			t.Errorf("%s: shell = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestParseMeminfo(t *testing.T) {
+	modern := "MemTotal:       8000000 kB\nMemFree:         100000 kB\nMemAvailable:   3000000 kB\n"
+	if used, total, ok := parseMeminfo(modern); !ok || used != 5000000 || total != 8000000 {
+		t.Errorf("modern = %d/%d %v", used, total, ok)
+	}
+	legacy := "MemTotal: 4000 kB\nMemFree: 1000 kB\nBuffers: 500 kB\nCached: 500 kB\n"
+	if used, _, ok := parseMeminfo(legacy); !ok || used != 2000 {
+		t.Errorf("legacy used = %d %v, want 2000 (MemFree+Buffers+Cached fallback)", used, ok)
+	}
+	if _, _, ok := parseMeminfo("garbage"); ok {
+		t.Error("unparseable meminfo reported ok")
+	}
+}
+
+func TestSkippedSudoersIsNeutral(t *testing.T) {
+	got := SudoersContext{Permissions: unknownValue, Skipped: true}.ToMap()
+	if !reflect.DeepEqual(got, map[string]string{"skipped": sudoersSkipReason}) {
+		t.Errorf("skipped sudoers map = %v", got)
+	}
+}
+
+func TestRetryWithBackoff(t *testing.T) {
+	busy := errors.New("file in use")
+	var waits []time.Duration
+	sleep := func(d time.Duration) { waits = append(waits, d) }
+	sharing := func(err error) bool { return err == busy }
+
+	calls := 0
+	err := retryWithBackoff(func() error {
+		if calls++; calls < 3 {
+			return busy
+		}
+		return nil
+	}, sharing, sleep)
+	if err != nil || calls != 3 || !reflect.DeepEqual(waits, []time.Duration{rotationRetryDelay, 2 * rotationRetryDelay}) {
+		t.Errorf("recovering rename: err %v, calls %d, waits %v", err, calls, waits)
+	}
+
+	waits, calls = nil, 0
+	if err := retryWithBackoff(func() error { calls++; return busy }, sharing, sleep); err != busy || calls != rotationRetryAttempts {
+		t.Errorf("held file: err %v after %d calls, want %d", err, calls, rotationRetryAttempts)
+	}
+
+	calls = 0
+	other := errors.New("no such file")
+	if err := retryWithBackoff(func() error { calls++; return other }, sharing, sleep); err != other || calls != 1 {
+		t.Errorf("non-sharing error retried %d times", calls)
+	}
+}
+
+func TestSnapshotGoRuntime(t *testing.T) {
+	logger := newTestLogger(t, "goruntime-test")
+	runtime.GC() // Garbage left by earlier tests would otherwise be collected mid-test
+	logger.SnapshotState("before-allocation", 0)
+	ballast := make([]byte, 10<<20)
+	for i := range ballast {
+		ballast[i] = 1 // Touch every page so the allocation is real
+	}
+	logger.SnapshotState("after-allocation", 0)
+	runtime.KeepAlive(ballast)
+	logger.Success("no runtime on success", 0, nil)
+
+	entries := readEntries(t, logger.LogFile)
+	if len(entries) != 3 {
+		t.Fatalf("got %d entries", len(entries))
+	}
+	before, after, success := entries[0].Context, entries[1].Context, entries[2].Context
+	if before == nil || before.GoRuntime == nil || after == nil || after.GoRuntime == nil {
+		t.Fatalf("snapshots missing Go runtime: %+v / %+v", before, after)
+	}
+	// GC may reclaim a little garbage between snapshots - most of the 10MB must show
+	if growth := int64(after.GoRuntime.HeapAlloc) - int64(before.GoRuntime.HeapAlloc); growth < 9<<20 {
+		t.Errorf("heap grew %d bytes across a 10MB allocation", growth)
+	}
+	if after.GoRuntime.Goroutines < 1 || after.GoRuntime.HeapObjects == 0 {
+		t.Errorf("after = %+v", after.GoRuntime)
+	}
+	if success != nil && success.GoRuntime != nil {
+		t.Error("SUCCESS entries should not pay for ReadMemStats by default")
+	}
+}
+
+func TestPartialContextSkipsProbes(t *testing.T) {
+	logger := newTestLogger(t, "partial-probes-test")
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.ContextCapture.Mode = ContextPartial
+		cfg.Behavior.LogLevelFullContext = nil // Hardcoded per-level policy
+	})
+
+	partial := logger.captureFor(levelSuccess)
+	identity := SystemContext{User: logger.username, Host: logger.hostname, PID: logger.pid}
+	if !reflect.DeepEqual(*partial, identity) {
+		t.Errorf("SUCCESS context = %+v, want the cached identity only", *partial)
+	}
+	if full := logger.captureFor(levelFailure); full.CWD == "" {
+		t.Errorf("FAILURE context = %+v, want the probes run", *full)
+	}
+}
+
+func TestCaptureGoRuntimeFullContextOnly(t *testing.T) {
+	logger := newTestLogger(t, "goruntime-flag-test")
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.ContextCapture.Mode = ContextPartial
+		cfg.ContextCapture.CaptureGoRuntime = true
+		cfg.Behavior.LogLevelFullContext = nil // Hardcoded per-level policy
+	})
+
+	logger.Operation("start", 0)
+	logger.Failure("broken", "disk", 0, nil)
+	logger.Success("fine", 0, nil)
+	logger.Check("checked", true, 0, nil)
+
+	entries := readEntries(t, logger.LogFile)
+	if len(entries) != 4 {
+		t.Fatalf("got %d entries, want 4", len(entries))
+	}
+	for _, entry := range entries {
+		hasRuntime := entry.Context != nil && entry.Context.GoRuntime != nil
+		if want := logLevelFullContext[entry.Level]; hasRuntime != want {
+			t.Errorf("%s entry: Go runtime = %v, want %v (full context only)", entry.Level, hasRuntime, want)
+		}
+	}
+}
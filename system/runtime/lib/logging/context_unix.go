@@ -0,0 +1,60 @@
+//go:build !windows
+
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Context Capture Primitives (Unix) - Logging Library
+//
+// Biblical Foundation: See context.go
+// CPI-SI Identity: Platform primitive for system context capture
+//
+// Purpose: Unix side of the platform split - memory from /proc/meminfo,
+//          sudoers checked, and no sharing violations to retry on rotation
+//          (renaming an open file is always allowed).
+//
+// Authorship: Nova Dawn
+// Version: 1.0.0
+//
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os" // /proc/meminfo read
+)
+
+// sudoersSupported reports whether captureSudoersContext checks sudoers.d.
+const sudoersSupported = true
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// memoryUsage reads used and total memory (KB) from /proc/meminfo.
+//
+// macOS and the BSDs have no /proc - memory reports "unknown" there.
+func memoryUsage() (usedKB, totalKB int64, ok bool) {
+	data, err := os.ReadFile(procMeminfoPath)
+	if err != nil {
+		return 0, 0, false
+	}
+	return parseMeminfo(string(data))
+}
+
+// isSharingViolation reports whether err means another process holds the file.
+//
+// Unix renames never fail for that reason, so rotation never retries here.
+func isSharingViolation(err error) bool {
+	return false
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with context.go (go build ./logging)
+// Code Execution: Library primitive (called by captureMemoryUsage, rotation)
+// Code Cleanup: None (single file read, no handles held)
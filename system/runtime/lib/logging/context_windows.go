@@ -0,0 +1,118 @@
+//go:build windows
+
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Context Capture Primitives (Windows) - Logging Library
+//
+// Biblical Foundation: See context.go
+// CPI-SI Identity: Platform primitive for system context capture
+//
+// Purpose: Windows side of the platform split - memory from
+//          GlobalMemoryStatusEx and disk from GetDiskFreeSpaceExW (kernel32,
+//          no extra dependencies), sudoers skipped, and sharing violations
+//          recognized so rotation retries while another process has the log open.
+//
+// Authorship: Nova Dawn
+// Version: 1.0.0
+//
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"errors"  // Errno matching for sharing violations
+	"syscall" // kernel32 procedures and Windows error codes
+	"unsafe"  // Struct and out-parameter pointers for kernel32 calls
+)
+
+// sudoersSupported reports whether captureSudoersContext checks sudoers.d.
+const sudoersSupported = false
+
+const (
+	errorSharingViolation syscall.Errno = 32 // ERROR_SHARING_VIOLATION - file open without FILE_SHARE_DELETE
+	errorLockViolation    syscall.Errno = 33 // ERROR_LOCK_VIOLATION - region locked by another process
+)
+
+var (
+	kernel32                = syscall.NewLazyDLL("kernel32.dll")
+	procGlobalMemoryStatus  = kernel32.NewProc("GlobalMemoryStatusEx")
+	procGetDiskFreeSpaceExW = kernel32.NewProc("GetDiskFreeSpaceExW")
+)
+
+// memoryStatusEx mirrors MEMORYSTATUSEX.
+type memoryStatusEx struct {
+	Length               uint32
+	MemoryLoad           uint32
+	TotalPhys            uint64
+	AvailPhys            uint64
+	TotalPageFile        uint64
+	AvailPageFile        uint64
+	TotalVirtual         uint64
+	AvailVirtual         uint64
+	AvailExtendedVirtual uint64
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// memoryUsage reads used and total physical memory (KB) via GlobalMemoryStatusEx.
+func memoryUsage() (usedKB, totalKB int64, ok bool) {
+	status := memoryStatusEx{}
+	status.Length = uint32(unsafe.Sizeof(status)) // Required before the call
+	if result, _, _ := procGlobalMemoryStatus.Call(uintptr(unsafe.Pointer(&status))); result == 0 {
+		return 0, 0, false
+	}
+	if status.TotalPhys == 0 {
+		return 0, 0, false
+	}
+	return int64((status.TotalPhys - status.AvailPhys) / 1024), int64(status.TotalPhys / 1024), true
+}
+
+// diskUsage returns used and total bytes plus Use% for the volume holding path.
+//
+// Use% follows df: used / (used + available to this user), rounded up, so
+// quotas show up the same way reserved blocks do on Unix.
+func diskUsage(path string) (used, size uint64, percent int, ok bool) {
+	pathPtr, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+
+	var available, total, free uint64
+	result, _, _ := procGetDiskFreeSpaceExW.Call(
+		uintptr(unsafe.Pointer(pathPtr)),
+		uintptr(unsafe.Pointer(&available)),
+		uintptr(unsafe.Pointer(&total)),
+		uintptr(unsafe.Pointer(&free)),
+	)
+	if result == 0 || total == 0 || free > total {
+		return 0, 0, 0, false
+	}
+
+	used = total - free
+	if usable := used + available; usable > 0 {
+		percent = int((used*100 + usable - 1) / usable) // Round up like df
+	}
+	return used, total, percent, true
+}
+
+// isSharingViolation reports whether err means another process holds the file.
+//
+// Editors, tail -f equivalents, and antivirus scanners open logs without
+// FILE_SHARE_DELETE; the rename succeeds once they let go.
+func isSharingViolation(err error) bool {
+	return errors.Is(err, errorSharingViolation) || errors.Is(err, errorLockViolation) || errors.Is(err, syscall.ERROR_ACCESS_DENIED)
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with context.go (GOOS=windows go build ./logging)
+// Code Execution: Library primitive (called by captureMemoryUsage, captureDiskUsage, rotation)
+// Code Cleanup: None (kernel32 stays loaded for the process)
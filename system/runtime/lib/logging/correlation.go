@@ -0,0 +1,230 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Cross-Component Correlation - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "A threefold cord is not quickly broken." - Ecclesiastes 4:12 (KJV)
+// Principle: Separate narratives bound together tell the whole story.
+// Anchor: Each process keeps its own log; the parent link is the cord between them.
+//
+// CPI-SI Identity
+//
+// Component Type: Correlation module within Rails infrastructure
+// Role: Link a child process's entries to the process that started it
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial parent context propagation and tracing
+//
+// Purpose & Function
+//
+// Purpose: Command A running command B through LogCommand produced two logs
+// with unrelated context IDs - nothing stitched the narratives together.
+// LogCommand now hands its ContextID to the child in CPI_SI_PARENT_CONTEXT;
+// the child's NewLogger records it as ParentContextID on every entry.
+//
+// Core Design: One environment variable, one link per process. Entries carry
+// a PARENT line under the header when the Logger has a parent. TraceContext
+// reads every log under a directory and follows the links downward from one
+// context ID, returning the whole call tree in merge order. The root need not
+// have entries of its own - the session-start hook seeds
+// session-<session-id> as the parent of every command in a session, so
+// tracing that ID groups the session's work.
+//
+// Blocking Status
+//
+// Non-blocking: Unreadable log files are skipped while tracing; a missing
+// environment variable means no parent.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	logger.LogCommand("validate", []string{"./..."}) // Child logs under this logger's ContextID
+//
+//	tree, err := logging.TraceContext(logsDir, "validate-4242-1760600000000000000")
+//
+// Public API:
+//   ParentContextEnv - Environment variable carrying the parent context ID
+//   SessionContextID(sessionID string) string - Correlation ID for a session
+//   TraceContext(logsDir, contextID string) ([]LogEntry, error) - Call tree for one invocation
+//
+// Internal API:
+//   parentContextFromEnv() - Parent context ID for NewLogger
+//   childEnvironment() - Environment for LogCommand's child (Logger method)
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: io/fs, os, path/filepath, strings
+//   Package Files: parsing.go (ReadLogFile, MergeEntries), logger.go (logFileExtension),
+//                  levelfiles.go (IsLevelFile), audit.go (IsAuditLog)
+//
+// Dependents (What Uses This):
+//   Internal: logger.go (NewLogger, LogCommandContext)
+//   External: hooks/lib/session (session correlation seeding)
+//
+// Health Scoring
+//
+// Correlation: 0 (identification only)
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"io/fs"         // Log directory walk
+	"os"            // Environment lookup
+	"path/filepath" // Log file walk
+	"strings"       // Environment entry matching, log file names
+)
+
+// Constants
+
+const (
+	// ParentContextEnv carries the parent's ContextID into a child process.
+	ParentContextEnv = "CPI_SI_PARENT_CONTEXT"
+
+	sessionContextPrefix = "session-"   // Session correlation IDs (session-<session-id>)
+	parentHeader         = "  PARENT: " // Prefix for the parent context line
+)
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Environment
+// ────────────────────────────────────────────────────────────────
+
+// parentContextFromEnv returns the parent context ID handed down by the caller ("" when none).
+func parentContextFromEnv() string {
+	return strings.TrimSpace(os.Getenv(ParentContextEnv))
+}
+
+// childEnvironment returns this process's environment with ParentContextEnv set to l.ContextID.
+func (l *Logger) childEnvironment() []string {
+	prefix := ParentContextEnv + "="
+	env := make([]string, 0, len(os.Environ())+1)
+	for _, entry := range os.Environ() {
+		if !strings.HasPrefix(entry, prefix) { // Our own parent is not the child's
+			env = append(env, entry)
+		}
+	}
+	return append(env, prefix+l.ContextID)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Tracing
+// ────────────────────────────────────────────────────────────────
+
+// readLogTree reads every log file (current and rotated, .log.N or date-named) under logsDir.
+//
+// Unreadable files are skipped - one broken log must not hide the rest.
+// Level-split files are skipped too - their entries are already in the main log.
+func readLogTree(logsDir string) ([][]LogEntry, error) {
+	var streams [][]LogEntry
+	err := filepath.WalkDir(logsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == logsDir { // The directory itself is missing
+				return err
+			}
+			return nil
+		}
+		if d.IsDir() || !strings.Contains(d.Name(), logFileExtension) || IsLevelFile(path) || IsAuditLog(path) {
+			return nil
+		}
+		if entries, err := ReadLogFile(path); err == nil || len(entries) > 0 {
+			streams = append(streams, entries)
+		}
+		return nil
+	})
+	return streams, err
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// SessionContextID returns the correlation ID seeded for a session.
+//
+// Commands run inside the session inherit it as their parent, so
+// TraceContext(logsDir, SessionContextID(id)) groups the session's work.
+func SessionContextID(sessionID string) string {
+	return sessionContextPrefix + sessionID
+}
+
+// TraceContext reconstructs the call tree rooted at one context ID.
+//
+// What It Does:
+// Reads every log under logsDir and collects the entries of contextID plus
+// every context whose parent chain leads back to it, across components.
+// The root needs no entries of its own (a session correlation ID has none).
+//
+// Parameters:
+//
+//	logsDir: Log root to search (e.g. ~/.claude/cpi-si/system/logs)
+//	contextID: Top-level invocation (or session correlation ID)
+//
+// Returns:
+//
+//	[]LogEntry: The tree's entries in MergeEntries order (empty when nothing links to contextID)
+//	error: logsDir could not be walked
+//
+// Example usage:
+//
+//	tree, err := logging.TraceContext(logsDir, logger.ContextID)
+//	for _, entry := range tree {
+//	    fmt.Println(entry.Component, entry.ContextID, entry.Event)
+//	}
+func TraceContext(logsDir, contextID string) ([]LogEntry, error) {
+	streams, err := readLogTree(logsDir)
+	if err != nil {
+		return nil, err
+	}
+	all := MergeEntries(streams...)
+
+	// Grow the tree until no context's parent is newly included
+	inTree := map[string]bool{contextID: true}
+	for grew := true; grew; {
+		grew = false
+		for _, entry := range all {
+			if !inTree[entry.ContextID] && entry.ParentContextID != "" && inTree[entry.ParentContextID] {
+				inTree[entry.ContextID] = true
+				grew = true
+			}
+		}
+	}
+
+	var tree []LogEntry
+	for _, entry := range all {
+		if inTree[entry.ContextID] {
+			tree = append(tree, entry)
+		}
+	}
+	return tree, nil
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
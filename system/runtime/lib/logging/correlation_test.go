@@ -0,0 +1,129 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Cross-Component Correlation Tests
+//
+// Purpose: Prove LogCommand hands its ContextID to the child process, that
+//          NewLogger records an inherited parent on every entry (and reads it
+//          back), and that TraceContext follows parent links across component
+//          logs - including from a session root with no entries of its own.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestLogCommandPassesParentContext(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not installed")
+	}
+	t.Setenv(ParentContextEnv, "grandparent-1")
+	logger := newTestLogger(t, "correlation-command-test")
+
+	if err := logger.LogCommand("sh", []string{"-c", "printf %s \"$" + ParentContextEnv + "\""}); err != nil {
+		t.Fatal(err)
+	}
+	entries := readEntries(t, logger.LogFile)
+	last := entries[len(entries)-1]
+	if got := last.Details["output"]; got != logger.ContextID {
+		t.Errorf("child saw parent %q, want %q", got, logger.ContextID)
+	}
+}
+
+func TestLogCommandContextCancelled(t *testing.T) {
+	logger := newTestLogger(t, "correlation-cancel-test")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := logger.LogCommandContext(ctx, "sh", []string{"-c", "true"}); err == nil {
+		t.Fatal("cancelled command reported success")
+	}
+	entries := readEntries(t, logger.LogFile)
+	if last := entries[len(entries)-1]; last.Level != levelFailure || last.Details["exit_code"] != "-1" {
+		t.Errorf("last entry = %s exit_code %v, want FAILURE -1", last.Level, last.Details["exit_code"])
+	}
+}
+
+func TestParentContextRoundTrip(t *testing.T) {
+	t.Setenv(ParentContextEnv, "caller-7-1")
+	logger := newTestLogger(t, "correlation-parent-test")
+	if logger.ParentContextID != "caller-7-1" {
+		t.Fatalf("ParentContextID = %q, want caller-7-1", logger.ParentContextID)
+	}
+
+	logger.Success("linked", 0, nil)
+	for _, entry := range readEntries(t, logger.LogFile) {
+		if entry.ParentContextID != "caller-7-1" {
+			t.Errorf("%q read back parent %q", entry.Event, entry.ParentContextID)
+		}
+		if len(entry.RawSections) != 0 {
+			t.Errorf("PARENT kept raw: %v", entry.RawSections)
+		}
+	}
+}
+
+func TestTraceContextFollowsLinks(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	root := SessionContextID("abc")
+
+	t.Setenv(ParentContextEnv, root)
+	build := NewLogger("trace-build")
+	build.Success("build", 0, nil)
+
+	t.Setenv(ParentContextEnv, build.ContextID)
+	validate := NewLogger("trace-validate")
+	validate.Success("validate", 0, nil)
+
+	t.Setenv(ParentContextEnv, validate.ContextID)
+	lint := NewLogger("trace-lint")
+	lint.Success("lint", 0, nil)
+
+	t.Setenv(ParentContextEnv, "")
+	other := NewLogger("trace-other")
+	other.Success("unrelated", 0, nil)
+
+	logsDir := filepath.Join(home, claudeBaseDir)
+	tree, err := TraceContext(logsDir, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var events []string
+	for _, entry := range tree {
+		if entry.Level == levelSuccess {
+			events = append(events, entry.Event)
+		}
+	}
+	if got := strings.Join(events, ","); got != "build,validate,lint" {
+		t.Errorf("session tree = %q, want build,validate,lint", got)
+	}
+
+	subtree, _ := TraceContext(logsDir, validate.ContextID)
+	for _, entry := range subtree {
+		if entry.ContextID == build.ContextID || entry.ContextID == other.ContextID {
+			t.Errorf("subtree of validate includes %s", entry.Component)
+		}
+	}
+
+	if _, err := TraceContext(filepath.Join(home, "missing"), root); err == nil {
+		t.Error("missing logs directory not reported")
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,177 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Detail Builders - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "Let all things be done decently and in order." - 1 Corinthians 14:40 (KJV)
+// Principle: One name for one thing, so whoever comes after can find it.
+// Anchor: A detail written under four spellings is a detail nobody can query.
+//
+// CPI-SI Identity
+//
+// Component Type: Schema module within Rails infrastructure
+// Role: Canonical detail keys, typed builders that write them, accessors that read them back
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial detail schema helpers
+//
+// Purpose & Function
+//
+// Purpose: Details maps are free-form, so the same concept shows up as "file",
+// "path", "filepath", and "target" across components and the debugging layer
+// can't reliably pull anything out. The Detail* constants name each common
+// concept once; the builders (FileDetail, DurationDetail, ExitDetail, ...)
+// return map fragments under those keys, and MergeDetails combines them:
+//
+//	logger.Failure("build failed", "compiler error", -10, logging.MergeDetails(
+//	    logging.FileDetail("main.go"),
+//	    logging.ExitDetail(2),
+//	    logging.DurationDetail(elapsed),
+//	))
+//
+// Readers use the Extract* accessors (parsing.go), which also accept the older
+// spellings so entries from components not yet migrated still answer.
+//
+// Core Design: Fragments stay plain map[string]any - nothing changes for
+// Success/Failure/Check callers or the on-disk format. Durations write both a
+// readable "duration" (time.Duration.String) and an integer "duration_ms",
+// the spelling most existing components already use for machines.
+//
+// Blocking Status
+//
+// Non-blocking: Pure map building - no I/O.
+//
+// Usage & Integration
+//
+// Public API:
+//   DetailFile, DetailDuration, DetailDurationMS, DetailExitCode, DetailCount,
+//   DetailCommand, DetailOutput, DetailError, DetailStack, DetailReason - Canonical keys
+//   FileDetail(path), DurationDetail(d), ExitDetail(code), CountDetail(name, n),
+//   CommandDetail(command), ErrorDetail(err) - Fragments under canonical keys
+//   MergeDetails(ms ...map[string]any) map[string]any - Later fragments win
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: time
+//
+// Dependents (What Uses This):
+//   Internal: logger.go (Failure, Error, LogCommandContext), parsing.go (Extract* accessors),
+//             human.go (DefaultHumanDetails)
+//
+// Health Scoring
+//
+// Schema helpers only - no health impact.
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"time" // DurationDetail
+)
+
+// Constants
+
+const (
+	DetailFile       = "file"        // File or path an entry is about
+	DetailDuration   = "duration"    // Readable elapsed time ("1.5s")
+	DetailDurationMS = "duration_ms" // Elapsed milliseconds (integer)
+	DetailExitCode   = "exit_code"   // Process exit code
+	DetailCount      = "_count"      // Suffix: CountDetail("files", 3) → files_count
+	DetailCommand    = "command"     // Command line run
+	DetailOutput     = "output"      // Captured command output
+	DetailError      = "error"       // error.Error()
+	DetailStack      = "stack_trace" // Goroutine stack at the error
+	DetailReason     = "reason"      // Why a failure happened
+)
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Detail Builders
+// ────────────────────────────────────────────────────────────────
+
+// FileDetail records the file an entry is about under DetailFile.
+func FileDetail(path string) map[string]any {
+	return map[string]any{DetailFile: path}
+}
+
+// DurationDetail records elapsed time readable (DetailDuration) and in milliseconds (DetailDurationMS).
+func DurationDetail(d time.Duration) map[string]any {
+	return map[string]any{DetailDuration: d.String(), DetailDurationMS: d.Milliseconds()}
+}
+
+// ExitDetail records a process exit code under DetailExitCode.
+func ExitDetail(code int) map[string]any {
+	return map[string]any{DetailExitCode: code}
+}
+
+// CountDetail records how many of something under "<name>_count".
+//
+// Example: CountDetail("files", 3) → {"files_count": 3}
+func CountDetail(name string, n int) map[string]any {
+	return map[string]any{name + DetailCount: n}
+}
+
+// CommandDetail records a command line under DetailCommand.
+func CommandDetail(command string) map[string]any {
+	return map[string]any{DetailCommand: command}
+}
+
+// ErrorDetail records err.Error() under DetailError (empty fragment for nil).
+func ErrorDetail(err error) map[string]any {
+	if err == nil {
+		return map[string]any{}
+	}
+	return map[string]any{DetailError: err.Error()}
+}
+
+// MergeDetails combines detail fragments into one new map.
+//
+// Later fragments win on a shared key; nil fragments are skipped. The result
+// is never nil, so it can go straight to Success/Failure/Check.
+//
+// Example usage:
+//
+//	details := logging.MergeDetails(logging.FileDetail(path), logging.CountDetail("lines", n))
+func MergeDetails(ms ...map[string]any) map[string]any {
+	merged := make(map[string]any)
+	for _, m := range ms {
+		for key, value := range m {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,119 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Detail Builder Tests
+//
+// Purpose: Prove the builders write canonical keys, MergeDetails lets later
+//          fragments win, the Extract* accessors read canonical keys and the
+//          older spellings whatever type the value arrives as, and that
+//          entries written by the migrated LogCommand and Error answer the
+//          accessors after a round trip through the text format.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestDetailBuilders(t *testing.T) {
+	details := MergeDetails(
+		FileDetail("main.go"),
+		DurationDetail(1500*time.Millisecond),
+		ExitDetail(2),
+		CountDetail("files", 3),
+		nil,
+		ErrorDetail(nil),
+		ErrorDetail(errors.New("boom")),
+		FileDetail("later.go"),
+	)
+	want := map[string]any{
+		DetailFile: "later.go", DetailDuration: "1.5s", DetailDurationMS: int64(1500),
+		DetailExitCode: 2, "files_count": 3, DetailError: "boom",
+	}
+	if len(details) != len(want) {
+		t.Errorf("details = %v, want %v", details, want)
+	}
+	for key, value := range want {
+		if details[key] != value {
+			t.Errorf("%s = %#v, want %#v", key, details[key], value)
+		}
+	}
+	if MergeDetails() == nil {
+		t.Error("MergeDetails() should never be nil")
+	}
+}
+
+func TestExtractAccessors(t *testing.T) {
+	text := LogEntry{Details: map[string]any{"path": "/repo/a.go", DetailDurationMS: "250", DetailExitCode: "1", "lines_count": "7"}}
+	jsonLine := LogEntry{Details: map[string]any{"file_path": "/repo/b.go", DetailDuration: "2s", DetailExitCode: float64(0)}}
+
+	if file, ok := ExtractFile(text); !ok || file != "/repo/a.go" {
+		t.Errorf("ExtractFile(path alias) = %q %v", file, ok)
+	}
+	if file, ok := ExtractFile(jsonLine); !ok || file != "/repo/b.go" {
+		t.Errorf("ExtractFile(file_path alias) = %q %v", file, ok)
+	}
+	if d, ok := ExtractDuration(text); !ok || d != 250*time.Millisecond {
+		t.Errorf("ExtractDuration(duration_ms) = %v %v", d, ok)
+	}
+	if d, ok := ExtractDuration(jsonLine); !ok || d != 2*time.Second {
+		t.Errorf("ExtractDuration(duration) = %v %v", d, ok)
+	}
+	if code, ok := ExtractExitCode(text); !ok || code != 1 {
+		t.Errorf("ExtractExitCode(string) = %d %v", code, ok)
+	}
+	if code, ok := ExtractExitCode(jsonLine); !ok || code != 0 {
+		t.Errorf("ExtractExitCode(float64) = %d %v", code, ok)
+	}
+	if n, ok := ExtractCount(text, "lines"); !ok || n != 7 {
+		t.Errorf("ExtractCount = %d %v", n, ok)
+	}
+
+	var empty LogEntry
+	if _, ok := ExtractFile(empty); ok {
+		t.Error("ExtractFile on no details should be false")
+	}
+	if _, ok := ExtractDuration(LogEntry{Details: map[string]any{DetailDuration: "soon"}}); ok {
+		t.Error("unparseable duration should be false")
+	}
+}
+
+func TestMigratedCallersRoundTrip(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not installed")
+	}
+	logger := newTestLogger(t, "details-roundtrip-test")
+	logger.LogCommand("sh", []string{"-c", "exit 3"})
+	logger.Error("unexpected", errors.New("disk gone"), -20)
+
+	entries := readEntries(t, logger.LogFile)
+	if len(entries) < 2 {
+		t.Fatalf("got %d entries", len(entries))
+	}
+	command, failure := entries[len(entries)-2], entries[len(entries)-1]
+	if code, ok := ExtractExitCode(command); !ok || code != 3 {
+		t.Errorf("LogCommand exit code = %d %v", code, ok)
+	}
+	if _, ok := ExtractDuration(command); !ok {
+		t.Errorf("LogCommand duration missing: %v", command.Details)
+	}
+	if msg, ok := ExtractError(failure); !ok || msg != "disk gone" || failure.Details[DetailStack] == nil {
+		t.Errorf("Error details = %v", failure.Details)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,305 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Failure Digest - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "Be thou diligent to know the state of thy flocks, and look well to thy herds." - Proverbs 27:23 (KJV)
+// Principle: Knowing the state of what you keep means looking at all of it, regularly, in summary.
+// Anchor: One question - what has been failing lately, and where - answered from every log at once.
+//
+// CPI-SI Identity
+//
+// Component Type: Aggregation module within Rails infrastructure
+// Role: Summarize recent FAILURE and ERROR entries per component for diagnose
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial failure digest
+//
+// Purpose & Function
+//
+// Purpose: The diagnose command had to know where logs live, how to parse
+// them, and how to filter them before it could say what was failing.
+// FailureDigest answers in one call: per component, how many FAILURE and
+// ERROR entries fell inside the window, the latest example of each distinct
+// failure, and which components' latest health is below the healthy
+// threshold.
+//
+// Core Design: Every log under logsDir is read (readLogTree - rotated files
+// included, level-split side files skipped) and merged in time order.
+// Failures are grouped by signature: level plus the semantic ErrorType when
+// the entry has one, otherwise the event normalized so near-duplicates
+// collapse - paths become <path>, numbers <n>, hex IDs <id>:
+//
+//   "validation failed: /home/me/a.go line 12" → "validation failed: <path> line <n>"
+//   "validation failed: /home/me/b.go line 40" → same signature, count 2
+//
+// Each signature keeps its count and its most recent entry (timestamp,
+// event as written, reason). Latest health is the component's newest entry
+// overall, window or not - a component can be unhealthy without failing
+// recently. The digest is plain data with JSON tags; text rendering is the
+// caller's (diagnose renders it with the display rail - Rails keep logging
+// free of other system libraries).
+//
+// Blocking Status
+//
+// Non-blocking: Unreadable logs are skipped; only a missing logsDir errors.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	digest, err := logging.FailureDigest(logging.LogsDir(), 24*time.Hour)
+//	if err == nil {
+//	    data, _ := digest.JSON()
+//	    os.Stdout.Write(data)
+//	}
+//
+// Public API:
+//   Digest, ComponentDigest, FailureSignature - Digest data (JSON-tagged)
+//   FailureDigest(logsDir string, window time.Duration) (*Digest, error) - Build the digest
+//   (*Digest).JSON() ([]byte, error) - Indented JSON for machine consumption
+//
+// Internal API:
+//   normalizeSignature(text string) string - Collapse paths, IDs, and numbers
+//   failureReason(entry LogEntry) string - Failure reason or error text
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: encoding/json, fmt, regexp, sort, strings, time
+//   Package Files: correlation.go (readLogTree), parsing.go (MergeEntries),
+//                  summary.go (exitThresholds), clock.go (now), logger.go (level constants)
+//
+// Dependents (What Uses This):
+//   Commands: system/runtime/cmd/diagnose (Recent Failures section)
+//
+// Health Scoring
+//
+// Read-only aggregation: 0 (no Logger, no entries written)
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"encoding/json" // Machine-readable digest
+	"fmt"           // Detail values rendered as text
+	"regexp"        // Signature normalization patterns
+	"sort"          // Stable component and signature order
+	"strings"       // Signature keys, whitespace collapse
+	"time"          // Window start
+)
+
+// Package-Level State
+
+var (
+	// signaturePath matches absolute, home, and relative paths plus bare file names
+	signaturePath = regexp.MustCompile(`(?:~|\.{1,2})?(?:/[^\s"'` + "`" + `:;,()\[\]{}]+)+|[A-Za-z]:\\[^\s"']+|\b[\w-]+(?:\.[\w-]+)*\.[A-Za-z][A-Za-z0-9]{0,7}\b`)
+
+	signatureID     = regexp.MustCompile(`\b[0-9a-fA-F]{8,}\b`) // Hashes, UUID parts, context suffixes
+	signatureNumber = regexp.MustCompile(`\b\d+\b`)             // Line numbers, PIDs, counts
+	signatureSpace  = regexp.MustCompile(`\s+`)                 // Runs left by replacements
+)
+
+// Types
+
+// Digest is recent failure activity across every component's logs.
+type Digest struct {
+	Generated       time.Time         `json:"generated"`        // When the digest was built
+	Since           time.Time         `json:"since"`            // Window start (zero = all history)
+	HealthThreshold int               `json:"health_threshold"` // Latest health below this is unhealthy ([exit_codes] healthy_min)
+	Components      []ComponentDigest `json:"components"`       // Components with failures in the window or unhealthy, by name
+	Unhealthy       []string          `json:"unhealthy"`        // Names of components whose latest health is below the threshold
+}
+
+// ComponentDigest is one component's failures and latest health.
+type ComponentDigest struct {
+	Component    string             `json:"component"`
+	Failures     int                `json:"failures"`      // FAILURE entries in the window
+	Errors       int                `json:"errors"`        // ERROR entries in the window
+	Signatures   []FailureSignature `json:"signatures"`    // Distinct failures, most recent first
+	LatestHealth int                `json:"latest_health"` // Normalized health of the newest entry
+	LatestAt     time.Time          `json:"latest_at"`     // When the newest entry was written
+	Unhealthy    bool               `json:"unhealthy"`     // LatestHealth below Digest.HealthThreshold
+}
+
+// FailureSignature is one distinct failure and its most recent example.
+type FailureSignature struct {
+	Level     string    `json:"level"`      // FAILURE or ERROR
+	Signature string    `json:"signature"`  // ErrorType, or the normalized event
+	Count     int       `json:"count"`      // Entries with this signature in the window
+	Timestamp time.Time `json:"timestamp"`  // Most recent example
+	Event     string    `json:"event"`      // Most recent example's event as written
+	Reason    string    `json:"reason"`     // Most recent example's reason or error text
+	ContextID string    `json:"context_id"` // Most recent example's invocation (for TraceContext)
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Signatures
+// ────────────────────────────────────────────────────────────────
+
+// normalizeSignature collapses the parts of a message that vary between repeats.
+func normalizeSignature(text string) string {
+	text = signaturePath.ReplaceAllString(text, "<path>")
+	text = signatureNumber.ReplaceAllString(text, "<n>") // Before IDs - all-digit runs are numbers
+	text = signatureID.ReplaceAllString(text, "<id>")
+	return strings.TrimSpace(signatureSpace.ReplaceAllString(text, " "))
+}
+
+// failureReason returns a FAILURE's reason or an ERROR's error text ("" when neither).
+func failureReason(entry LogEntry) string {
+	for _, key := range []string{"reason", "error"} {
+		if value, ok := entry.Details[key]; ok && value != nil {
+			return fmt.Sprint(value)
+		}
+	}
+	return ""
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// FailureDigest summarizes recent failures across every log under logsDir.
+//
+// What It Does:
+// Reads all logs, counts FAILURE and ERROR entries per component within the
+// window, groups them by signature (ErrorType, else normalized event) keeping
+// each signature's newest example, and flags components whose newest entry's
+// normalized health is below the [exit_codes] healthy threshold.
+//
+// Parameters:
+//
+//	logsDir: Log root to read (LogsDir() for the configured one)
+//	window: How far back failures count (0 or less = all history)
+//
+// Returns:
+//
+//	*Digest: Components with failures or low health (empty lists when all is well)
+//	error: logsDir could not be walked
+//
+// Example usage:
+//
+//	digest, err := logging.FailureDigest(logging.LogsDir(), time.Hour)
+//	for _, component := range digest.Components {
+//	    fmt.Println(component.Component, component.Failures, component.Errors)
+//	}
+func FailureDigest(logsDir string, window time.Duration) (*Digest, error) {
+	streams, err := readLogTree(logsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	healthyMin, _ := exitThresholds()
+	digest := &Digest{Generated: now(), HealthThreshold: healthyMin, Components: []ComponentDigest{}, Unhealthy: []string{}}
+	if window > 0 {
+		digest.Since = digest.Generated.Add(-window)
+	}
+
+	byComponent := make(map[string]*ComponentDigest)
+	signatures := make(map[string]map[string]*FailureSignature) // component → level|signature
+	for _, entry := range MergeEntries(streams...) {
+		component := byComponent[entry.Component]
+		if component == nil {
+			component = &ComponentDigest{Component: entry.Component}
+			byComponent[entry.Component] = component
+			signatures[entry.Component] = make(map[string]*FailureSignature)
+		}
+		if !entry.Timestamp.Before(component.LatestAt) { // Merged in time order - newest wins
+			component.LatestAt = entry.Timestamp
+			component.LatestHealth = entry.NormalizedHealth
+		}
+
+		if entry.Level != levelFailure && entry.Level != levelError {
+			continue
+		}
+		if !digest.Since.IsZero() && entry.Timestamp.Before(digest.Since) {
+			continue
+		}
+		if entry.Level == levelFailure {
+			component.Failures++
+		} else {
+			component.Errors++
+		}
+
+		signature := normalizeSignature(entry.Event)
+		if entry.Semantic != nil && entry.Semantic.ErrorType != "" {
+			signature = entry.Semantic.ErrorType
+		}
+		key := entry.Level + "|" + signature
+		seen := signatures[entry.Component][key]
+		if seen == nil {
+			seen = &FailureSignature{Level: entry.Level, Signature: signature}
+			signatures[entry.Component][key] = seen
+		}
+		seen.Count++
+		if !entry.Timestamp.Before(seen.Timestamp) {
+			seen.Timestamp, seen.Event, seen.Reason, seen.ContextID = entry.Timestamp, entry.Event, failureReason(entry), entry.ContextID
+		}
+	}
+
+	names := make([]string, 0, len(byComponent))
+	for name := range byComponent {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		component := byComponent[name]
+		component.Unhealthy = component.LatestHealth < healthyMin
+		if component.Unhealthy {
+			digest.Unhealthy = append(digest.Unhealthy, name)
+		}
+		if component.Failures+component.Errors == 0 && !component.Unhealthy {
+			continue
+		}
+
+		component.Signatures = []FailureSignature{}
+		for _, seen := range signatures[name] {
+			component.Signatures = append(component.Signatures, *seen)
+		}
+		sort.Slice(component.Signatures, func(i, j int) bool {
+			a, b := component.Signatures[i], component.Signatures[j]
+			if !a.Timestamp.Equal(b.Timestamp) {
+				return a.Timestamp.After(b.Timestamp)
+			}
+			return a.Level+a.Signature < b.Level+b.Signature
+		})
+		digest.Components = append(digest.Components, *component)
+	}
+	return digest, nil
+}
+
+// JSON renders the digest as indented JSON for machine consumption.
+func (d *Digest) JSON() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
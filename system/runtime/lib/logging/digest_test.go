@@ -0,0 +1,128 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Failure Digest Tests
+//
+// Purpose: Prove FailureDigest counts FAILURE and ERROR entries per component
+//          inside the window only, collapses near-duplicate events into one
+//          signature keeping the newest example, prefers ErrorType, flags
+//          components whose latest health is low, and that the JSON form
+//          carries all of it.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestNormalizeSignature(t *testing.T) {
+	cases := map[string]string{
+		"validation failed: /home/me/proj/a.go line 12": "validation failed: <path> line <n>",
+		"validation failed: ~/proj/b.go line 40":        "validation failed: <path> line <n>",
+		"cannot parse config.toml":                      "cannot parse <path>",
+		"context validate-4242-1760600000000000000":     "context validate-<n>-<n>",
+		"hash 3f2a9c1e5b0d77aa mismatch":                "hash <id> mismatch",
+		"write cache":                                   "write cache",
+	}
+	for text, want := range cases {
+		if got := normalizeSignature(text); got != want {
+			t.Errorf("normalizeSignature(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
+
+func TestFailureDigest(t *testing.T) {
+	start := fixedClockTime
+	clock := start.Add(-48 * time.Hour)
+	useClock(t, ClockFunc(func() time.Time { clock = clock.Add(time.Second); return clock }))
+
+	alpha := newTestLogger(t, "alpha")
+	alpha.Failure("validation failed: /tmp/old.go", "too old to count", -1, nil)
+
+	clock = start.Add(-time.Hour)
+	alpha.Failure("validation failed: /home/me/a.go line 12", "syntax a", -5, nil)
+	alpha.Error("write cache", errors.New("disk full"), -10)
+	alpha.Failure("validation failed: /home/me/b.go line 40", "syntax b", -5, nil)
+	alpha.FailureWithMetadata("cannot open /etc/shadow", "denied", -5, nil, Metadata{ErrorType: "permission_denied"})
+
+	beta := NewLogger("beta")
+	beta.Success("all good", 100, nil)
+	clock = start
+
+	digest, err := FailureDigest(LogsDir(), 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(digest.Components) != 1 || digest.Components[0].Component != "alpha" {
+		t.Fatalf("components = %+v, want alpha only (beta is healthy and never failed)", digest.Components)
+	}
+	if !reflect.DeepEqual(digest.Unhealthy, []string{"alpha"}) {
+		t.Errorf("unhealthy = %v, want [alpha]", digest.Unhealthy)
+	}
+
+	component := digest.Components[0]
+	if component.Failures != 3 || component.Errors != 1 {
+		t.Errorf("failures/errors = %d/%d, want 3/1 (the 48h-old failure is outside the window)", component.Failures, component.Errors)
+	}
+
+	type summary struct {
+		level, signature string
+		count            int
+		event, reason    string
+	}
+	var got []summary
+	for _, s := range component.Signatures {
+		got = append(got, summary{s.Level, s.Signature, s.Count, s.Event, s.Reason})
+	}
+	want := []summary{
+		{levelFailure, "permission_denied", 1, "cannot open /etc/shadow", "denied"},
+		{levelFailure, "validation failed: <path> line <n>", 2, "validation failed: /home/me/b.go line 40", "syntax b"},
+		{levelError, "write cache", 1, "write cache", "disk full"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("signatures (newest first) =\n%+v\nwant\n%+v", got, want)
+	}
+
+	// No window: the old failure counts too, and its own signature appears
+	all, err := FailureDigest(LogsDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if all.Components[0].Failures != 4 || len(all.Components[0].Signatures) != 4 || !all.Since.IsZero() {
+		t.Errorf("all history: %d failures, %d signatures, since %v", all.Components[0].Failures, len(all.Components[0].Signatures), all.Since)
+	}
+
+	data, err := digest.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Digest
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Unhealthy, digest.Unhealthy) || len(decoded.Components[0].Signatures) != 3 ||
+		decoded.Components[0].Signatures[1].Count != 2 || decoded.HealthThreshold != digest.HealthThreshold {
+		t.Errorf("JSON round trip lost data:\n%s", data)
+	}
+
+	if _, err := FailureDigest(t.TempDir()+"/missing", time.Hour); err == nil {
+		t.Error("missing logs directory: want an error")
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
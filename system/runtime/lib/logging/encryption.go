@@ -0,0 +1,438 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Log Encryption at Rest - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "A talebearer revealeth secrets: but he that is of a faithful spirit concealeth the matter." - Proverbs 11:13 (KJV)
+// Principle: Faithful keeping of what was entrusted.
+// Anchor: Logs record commands, paths, and environment - on shared machines that record is kept sealed.
+//
+// CPI-SI Identity
+//
+// Component Type: Storage protection module within Rails infrastructure
+// Role: Seal entries before they reach disk, open them again for readers
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial [privacy] encrypt with AES-256-GCM entry frames
+//
+// Purpose & Function
+//
+// Purpose: Entries carry user, host, CWD, environment, and command output.
+// With [privacy] encrypt = true and key_file naming a 32-byte key (chmod 600),
+// every entry is sealed with AES-256-GCM before it is appended, and
+// ReadLogFile opens sealed entries transparently.
+//
+// Core Design: One sealed entry is one line - the frame
+//
+//	CPISI-ENC1 <length>:<base64(nonce || ciphertext || tag)>
+//
+// with a fresh random nonce per entry and the length of the base64 payload
+// up front, so a write cut short is detected rather than misread. Frames
+// stay line-oriented, which keeps everything built on lines working the
+// same: rotation and retention (size and age, never content), level-split
+// files (the sealed text is what gets copied), the spill backlog, and
+// VerifyLogIntegrity. A file can hold plaintext entries followed by frames
+// (encryption switched on mid-file); ReadLogFile reads both.
+//
+// The key is never derived or generated here - key_file holds 32 raw bytes:
+//
+//	head -c 32 /dev/urandom > ~/.claude/cpi-si/system/config/logging.key
+//	chmod 600 ~/.claude/cpi-si/system/config/logging.key
+//
+// A key file readable by group or others is refused (Unix only - Windows
+// modes do not describe ACLs).
+//
+// Blocking Status
+//
+// Non-blocking: With encryption on and the key unusable, the Logger warns once
+// to stderr and writes nothing rather than fall back to plaintext. Readers
+// without the key get the plaintext entries plus an error matching ErrEncrypted.
+//
+// Usage & Integration
+//
+// Usage (tooling):
+//
+//	entries, err := logging.ReadLogFile(path)
+//	if errors.Is(err, logging.ErrEncrypted) {
+//	    // Prompt for / point at the key file
+//	}
+//
+// Public API:
+//   ErrEncrypted - Sentinel matched by every "sealed, no key" read error
+//   EncryptedError - Path and cause behind ErrEncrypted (errors.As)
+//   ReencryptLog(path string) error - Seal every plaintext entry in an existing file
+//
+// Internal API:
+//   encryptionEnabled() bool - [privacy] encrypt is on
+//   loadLogKey() (cipher.AEAD, error) - Read, check, and wrap the configured key
+//   sealEntry(aead, text) string - One frame line for one rendered entry
+//   openFrame(aead, frame) (string, error) - The rendered entry inside a frame
+//   frameComplete(line) bool - Frame carries its whole payload (integrity.go)
+//   (*Logger).sealForWrite(text) (string, error) - Seal when enabled (writing.go)
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: crypto/aes, crypto/cipher, crypto/rand, encoding/base64,
+//                     encoding/json, errors, fmt, os, path/filepath, runtime,
+//                     strconv, strings
+//   Package Files: config.go (Config.Privacy), logger.go (claudeBaseDir),
+//                  parsing.go (parseHeader)
+//
+// Dependents (What Uses This):
+//   Internal: writing.go (appendEntry, formatSpillMarker), parsing.go (ReadLogFile),
+//             integrity.go (lastEntryComplete)
+//
+// Health Scoring
+//
+// Sealing: 0 (storage detail - the write carries the score)
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"crypto/aes"      // AES-256 block cipher
+	"crypto/cipher"   // GCM mode
+	"crypto/rand"     // Per-entry nonces
+	"encoding/base64" // Frames stay text
+	"encoding/json"   // JSON entry lines (migration chunking)
+	"errors"          // ErrEncrypted sentinel
+	"fmt"             // Error context
+	"os"              // Key and log file access
+	"path/filepath"   // Key path resolution, migration temp file
+	"runtime"         // Permission check is Unix-only
+	"strconv"         // Frame length
+	"strings"         // Frame parsing, line splitting
+)
+
+// Constants
+
+const (
+	encryptedMagic = "CPISI-ENC1 " // Starts every sealed entry line (version 1 framing)
+	logKeyBytes    = 32            // AES-256
+)
+
+// Errors
+
+// ErrEncrypted means a log holds sealed entries and no usable key was available.
+//
+// Read errors wrap it in an EncryptedError; match with errors.Is.
+var ErrEncrypted = errors.New("log entries are encrypted and the key is unavailable")
+
+// errNoKeyFile is loadLogKey's error when [privacy] key_file is unset.
+var errNoKeyFile = errors.New("[privacy] key_file is not set")
+
+// Types
+
+// EncryptedError reports a log whose sealed entries could not be opened.
+type EncryptedError struct {
+	Path string // Log file read
+	Err  error  // Why the key was unusable (missing, wrong size, too open, wrong key)
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Type Methods - EncryptedError
+// ────────────────────────────────────────────────────────────────
+
+// Error describes the file and the key problem.
+func (e *EncryptedError) Error() string {
+	return fmt.Sprintf("%s: %v: %v", e.Path, ErrEncrypted, e.Err)
+}
+
+// Is makes errors.Is(err, ErrEncrypted) true.
+func (e *EncryptedError) Is(target error) bool {
+	return target == ErrEncrypted
+}
+
+// Unwrap exposes the key problem.
+func (e *EncryptedError) Unwrap() error {
+	return e.Err
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Key Loading
+// ────────────────────────────────────────────────────────────────
+
+// encryptionEnabled reports whether [privacy] encrypt is on.
+func encryptionEnabled() bool {
+	LoadConfig()
+	return ConfigLoaded && Config.Privacy.Encrypt
+}
+
+// logKeyPath resolves [privacy] key_file ("~/" = home, relative = under ~/.claude).
+func logKeyPath() string {
+	LoadConfig()
+	path := Config.Privacy.KeyFile
+	if path == "" {
+		return ""
+	}
+	home, _ := os.UserHomeDir()
+	if rest, found := strings.CutPrefix(path, "~/"); found {
+		return filepath.Join(home, rest)
+	}
+	if !filepath.IsAbs(path) {
+		return filepath.Join(home, claudeBaseDir, path)
+	}
+	return path
+}
+
+// loadLogKey reads the configured key file and returns its AES-GCM cipher.
+//
+// The file must hold exactly 32 bytes and, on Unix, be unreadable by group and others.
+func loadLogKey() (cipher.AEAD, error) {
+	path := logKeyPath()
+	if path == "" {
+		return nil, errNoKeyFile
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		return nil, fmt.Errorf("key file %s has mode %v, want 0600 or tighter", path, info.Mode().Perm())
+	}
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != logKeyBytes {
+		return nil, fmt.Errorf("key file %s holds %d bytes, want %d", path, len(key), logKeyBytes)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Framing
+// ────────────────────────────────────────────────────────────────
+
+// sealEntry encrypts one rendered entry into a newline-terminated frame line.
+//
+// The magic is the additional data, so a frame cannot be replayed under another version.
+func sealEntry(aead cipher.AEAD, text string) string {
+	nonce := make([]byte, aead.NonceSize())
+	rand.Read(nonce) // Never fails (crypto/rand panics rather than return short)
+	sealed := aead.Seal(nonce, nonce, []byte(text), []byte(encryptedMagic))
+	payload := base64.StdEncoding.EncodeToString(sealed)
+	return encryptedMagic + strconv.Itoa(len(payload)) + ":" + payload + "\n"
+}
+
+// splitFrame returns a frame line's payload, checking it against the length prefix.
+func splitFrame(line string) (string, error) {
+	rest, found := strings.CutPrefix(line, encryptedMagic)
+	if !found {
+		return "", errors.New("not an encrypted entry")
+	}
+	size, payload, found := strings.Cut(strings.TrimRight(rest, "\r\n"), ":")
+	length, err := strconv.Atoi(size)
+	if !found || err != nil {
+		return "", errors.New("encrypted entry has no length prefix")
+	}
+	if len(payload) != length {
+		return "", fmt.Errorf("encrypted entry truncated (%d of %d bytes)", len(payload), length)
+	}
+	return payload, nil
+}
+
+// frameComplete reports whether a frame line carries its whole payload.
+func frameComplete(line string) bool {
+	_, err := splitFrame(line)
+	return err == nil
+}
+
+// openFrame decrypts a frame line back to the rendered entry text.
+func openFrame(aead cipher.AEAD, line string) (string, error) {
+	payload, err := splitFrame(line)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("encrypted entry shorter than its nonce")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	text, err := aead.Open(nil, nonce, ciphertext, []byte(encryptedMagic))
+	if err != nil { // Wrong key or altered frame
+		return "", err
+	}
+	return string(text), nil
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Reading
+// ────────────────────────────────────────────────────────────────
+
+// frameOpener opens frames for one ReadLogFile call, loading the key on first use.
+type frameOpener struct {
+	path   string      // Log file (error reports)
+	aead   cipher.AEAD // Loaded key (nil until the first frame)
+	keyErr error       // Key load failure (every frame after it fails the same way)
+	err    error       // First frame that could not be opened
+}
+
+// open returns the entry text inside a frame, or false when it cannot be opened.
+//
+// The first failure is kept for ReadLogFile to return; later frames are skipped quietly.
+func (o *frameOpener) open(line string) (string, bool) {
+	if o.aead == nil && o.keyErr == nil {
+		o.aead, o.keyErr = loadLogKey()
+	}
+	if o.keyErr != nil {
+		if o.err == nil {
+			o.err = &EncryptedError{Path: o.path, Err: o.keyErr}
+		}
+		return "", false
+	}
+	text, err := openFrame(o.aead, line)
+	if err != nil {
+		if o.err == nil {
+			o.err = &EncryptedError{Path: o.path, Err: err}
+		}
+		return "", false
+	}
+	return text, true
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Sealing Writes
+// ────────────────────────────────────────────────────────────────
+
+// sealForWrite returns text sealed into a frame when [privacy] encrypt is on
+// (text unchanged when it is off).
+//
+// The key is loaded on the first sealed write and kept for the Logger's life;
+// a failed load is retried on the next write.
+func (l *Logger) sealForWrite(text string) (string, error) {
+	if !encryptionEnabled() {
+		return text, nil
+	}
+	if l.logKey == nil {
+		aead, err := loadLogKey()
+		if err != nil {
+			return "", err
+		}
+		l.logKey = aead
+	}
+	return sealEntry(l.logKey, text), nil
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// ReencryptLog seals every plaintext entry in an existing log file.
+//
+// What It Does:
+// Splits the file at entry headers ("[timestamp] ..." and JSON entry lines),
+// seals each plaintext entry into its own frame with the [privacy] key_file
+// key, keeps existing frames as they are, and replaces the file atomically
+// (temp file + rename, mode kept). Works whether or not [privacy] encrypt is
+// on, so files can be migrated before the switch is flipped. Run it while
+// nothing is writing to the file - call it once per file, rotations included.
+//
+// Returns:
+//   error: Key unusable, or the file could not be read or replaced
+//
+// Example usage:
+//
+//	for _, file := range []string{logPath, logPath + ".1"} {
+//	    if err := logging.ReencryptLog(file); err != nil {
+//	        fmt.Fprintf(os.Stderr, "WARNING: %v\n", err)
+//	    }
+//	}
+func ReencryptLog(path string) error {
+	aead, err := loadLogKey()
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var out, entry strings.Builder
+	flush := func() { // Seal the plaintext entry collected so far
+		if strings.TrimSpace(entry.String()) != "" {
+			out.WriteString(sealEntry(aead, entry.String()))
+		}
+		entry.Reset()
+	}
+	for _, line := range strings.SplitAfter(string(data), "\n") {
+		switch {
+		case strings.HasPrefix(line, encryptedMagic): // Already sealed
+			flush()
+			out.WriteString(line)
+		case strings.HasPrefix(line, logFormatPrefix): // Format header stays readable
+			flush()
+			out.WriteString(line)
+		case strings.HasPrefix(line, "{") && json.Valid([]byte(strings.TrimSpace(line))): // Whole JSON entry
+			flush()
+			entry.WriteString(line)
+			flush()
+		case strings.HasPrefix(line, "[") && parseHeader(strings.TrimRight(line, "\r\n")) != nil: // Text entry starts
+			flush()
+			entry.WriteString(line)
+		default: // Body, separator, or trailing blank line of the current entry
+			entry.WriteString(line)
+		}
+	}
+	flush()
+
+	temp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".reencrypt-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name()) // No-op after a successful rename
+	if _, err := temp.WriteString(out.String()); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Chmod(info.Mode().Perm()); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), path)
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
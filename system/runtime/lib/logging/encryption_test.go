@@ -0,0 +1,153 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Log Encryption Tests
+//
+// Purpose: Prove [privacy] encrypt seals main and level-file entries so no
+//          event text reaches disk, that a file switched mid-way reads back
+//          whole, that a missing or world-readable key yields ErrEncrypted
+//          on read and writes nothing on write, and that ReencryptLog
+//          migrates a plaintext file without losing an entry.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// withEncryption writes a 32-byte key with the given mode and points [privacy] at it
+func withEncryption(t *testing.T, encrypt bool, mode os.FileMode) string {
+	t.Helper()
+	keyPath := filepath.Join(t.TempDir(), "logging.key")
+	if err := os.WriteFile(keyPath, bytes.Repeat([]byte{7}, logKeyBytes), mode); err != nil {
+		t.Fatal(err)
+	}
+	os.Chmod(keyPath, mode) // WriteFile's mode is filtered by umask
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Privacy = PrivacyConfig{Encrypt: encrypt, KeyFile: keyPath}
+	})
+	return keyPath
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestEncryptedEntriesReadBack(t *testing.T) {
+	logger := newTestLogger(t, "sealed-test")
+	logger.Success("written in the clear", 1, nil)
+
+	withEncryption(t, true, 0600)
+	Config.Routing.LevelFiles = map[string]string{levelFailure: "errors"}
+	logger.Failure("secret failure", "token rejected", -5, nil)
+	logger.Success("secret success", 1, nil)
+
+	raw, _ := os.ReadFile(logger.LogFile)
+	if strings.Contains(string(raw), "secret") || strings.Count(string(raw), encryptedMagic) != 2 {
+		t.Fatalf("log file leaks plaintext or lacks frames:\n%s", raw)
+	}
+
+	entries := readEntries(t, logger.LogFile)
+	if len(entries) != 3 || entries[0].Event != "written in the clear" || entries[1].Details["reason"] != "token rejected" {
+		t.Fatalf("mixed file entries = %+v", entries)
+	}
+
+	sidePath := strings.TrimSuffix(logger.LogFile, logFileExtension) + ".errors" + logFileExtension
+	side, _ := os.ReadFile(sidePath)
+	if !strings.HasPrefix(strings.TrimPrefix(string(side), formatVersionHeader(logFormatVersion)), encryptedMagic) {
+		t.Errorf("level file not sealed:\n%s", side)
+	}
+	if entries := readEntries(t, sidePath); len(entries) != 1 || entries[0].Event != "secret failure" {
+		t.Errorf("level file entries = %+v", entries)
+	}
+
+	if report, err := VerifyLogIntegrity(logger.LogFile); err != nil || !report.OK() {
+		t.Errorf("sealed log integrity = %v, %v", report.Problems(), err)
+	}
+}
+
+func TestMissingKeyIsErrEncrypted(t *testing.T) {
+	logger := newTestLogger(t, "sealed-test")
+	logger.Success("clear", 1, nil)
+	keyPath := withEncryption(t, true, 0600)
+	logger.Success("sealed", 1, nil)
+
+	os.Remove(keyPath)
+	entries, err := ReadLogFile(logger.LogFile)
+	var encrypted *EncryptedError
+	if !errors.Is(err, ErrEncrypted) || !errors.As(err, &encrypted) || encrypted.Path != logger.LogFile {
+		t.Fatalf("err = %v, want ErrEncrypted for %s", err, logger.LogFile)
+	}
+	if len(entries) == 0 || entries[len(entries)-1].Event != "clear" {
+		t.Errorf("plaintext entries should still read: %+v", entries)
+	}
+
+	// A Logger that never loaded the key writes nothing - never plaintext
+	before, _ := os.ReadFile(logger.LogFile)
+	NewLogger("sealed-test").Success("must not appear", 1, nil)
+	if after, _ := os.ReadFile(logger.LogFile); !bytes.Equal(before, after) {
+		t.Errorf("write without a key changed the log:\n%s", after[len(before):])
+	}
+}
+
+func TestKeyFileChecks(t *testing.T) {
+	withEncryption(t, true, 0644)
+	if _, err := loadLogKey(); err == nil || !strings.Contains(err.Error(), "mode") {
+		t.Errorf("group/other-readable key: err = %v, want a mode error", err)
+	}
+
+	keyPath := withEncryption(t, true, 0600)
+	os.WriteFile(keyPath, []byte("short"), 0600)
+	if _, err := loadLogKey(); err == nil || !strings.Contains(err.Error(), "5 bytes") {
+		t.Errorf("short key: err = %v", err)
+	}
+
+	if frameComplete(encryptedMagic + "12:abc\n") {
+		t.Error("frame shorter than its length prefix should be incomplete")
+	}
+}
+
+func TestReencryptLog(t *testing.T) {
+	logger := newTestLogger(t, "migrate-test")
+	logger.Success("first", 1, nil)
+	logger.Failure("second", "disk full", -5, map[string]any{"output": "line one\nline two"})
+	want := readEntries(t, logger.LogFile)
+
+	withEncryption(t, false, 0600) // Key configured, encryption not yet on
+	if err := ReencryptLog(logger.LogFile); err != nil {
+		t.Fatal(err)
+	}
+	raw, _ := os.ReadFile(logger.LogFile)
+	for _, line := range strings.Split(strings.TrimSpace(string(raw)), "\n") {
+		if _, isHeader := parseVersionHeader(line); !isHeader && !strings.HasPrefix(line, encryptedMagic) { // Header stays readable
+			t.Fatalf("plaintext line survived migration: %q", line)
+		}
+	}
+
+	got := readEntries(t, logger.LogFile)
+	if len(got) != len(want) || got[1].Details["output"] != want[1].Details["output"] {
+		t.Errorf("after migration entries = %+v, want %+v", got, want)
+	}
+
+	// Running again leaves sealed frames as they are
+	if err := ReencryptLog(logger.LogFile); err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := os.ReadFile(logger.LogFile); !bytes.Equal(raw, again) {
+		t.Error("second migration rewrote already-sealed entries")
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -20,22 +20,31 @@
 // Architect: Seanje Lenox-Wise, Nova Dawn
 // Implementation: Nova Dawn
 // Creation Date: 2025-11-18
-// Version: 1.0.0
-// Last Modified: 2025-11-18 - Extracted from monolithic logger.go
+// Version: 1.4.0
+// Last Modified: 2026-10-16 - Entry tags (TAGS line, LogEntry.Tags)
+//
+// Version History:
+//   1.0.0 (2025-11-18) - Extracted from monolithic logger.go
+//   1.1.0 (2026-10-16) - renderEntry chooses text or one-line JSON; LogEntry json tags
+//   1.2.0 (2026-10-16) - CONTEXT writes a Go Runtime map when GoRuntime was captured
+//   1.3.0 (2026-10-16) - Presized builder, direct writes instead of fmt chains (bench_test.go budget)
+//   1.4.0 (2026-10-16) - LogEntry.Tags; tagged entries write a TAGS line after PARENT (tags.go)
 //
 // Purpose & Function
 //
 // Purpose: Construct and format log entries according to documented standard. Converts LogEntry structures into human-readable formatted text for file output.
 //
-// Core Design: Structured formatting with consistent indentation, headers, and sections. CONTEXT → EVENT → DETAILS → INTERACTIONS hierarchy.
+// Core Design: Structured formatting with consistent indentation, headers, and sections. CONTEXT → EVENT → DETAILS → INTERACTIONS → SEMANTIC hierarchy.
 //
 // Key Features:
 //   - Base entry creation with common fields
 //   - Full entry formatting with all sections
 //   - Field writing helpers (writeField, writeDetailValue)
 //   - Map/list section helpers (writeMapSection, writeListSection)
+//   - SEMANTIC section (metadata maps as single-line JSON so ReadLogFile can restore them)
 //   - Health indicator and delta formatting
 //   - User identifier formatting (user@host:pid)
+//   - JSON lines output (format.output = "json", CPI_SI_LOG_FORMAT=json)
 //
 // Blocking Status
 //
@@ -58,12 +67,13 @@
 //
 //   createBaseEntry(context, healthImpact) LogEntry - Build entry with common fields (Logger method)
 //   formatEntry(entry) string - Convert entry to formatted text (Logger method)
+//   renderEntry(entry) string - Text or JSON line per format.output, newline-terminated (Logger method)
 //
 // Dependencies
 //
 // Dependencies (What This Needs):
-//   Standard Library: fmt, strings, time
-//   Package Files: context.go (SystemContext type), health.go (getHealthIndicator, getHealthBar)
+//   Standard Library: encoding/json, fmt, strconv, strings, time
+//   Package Files: context.go (SystemContext type), health.go (FormatHealth, DefaultHealthStyle)
 //
 // Dependents (What Uses This):
 //   Internal: logger.go (all logging methods use createBaseEntry and formatEntry)
@@ -89,9 +99,11 @@ package logging
 // Imports
 
 import (
-	"fmt"     // String formatting for entry output
-	"strings" // String manipulation for building entries
-	"time"    // Timestamp handling
+	"encoding/json" // Semantic metadata maps, JSON output lines
+	"fmt"           // String formatting for entry output
+	"strconv"       // Number formatting without fmt's allocations
+	"strings"       // String manipulation for building entries
+	"time"          // Timestamp handling
 )
 
 // Constants
@@ -100,12 +112,17 @@ const (
 	//--- Format Strings ---
 	// Entry section headers and formatting.
 
-	timestampFormat    = "2006-01-02 15:04:05.000"   // Standard log timestamp format (microsecond precision)
+	timestampFormat    = "2006-01-02 15:04:05.000000000" // Standard log timestamp format (nanosecond precision - merge order)
 	contextHeader      = "  CONTEXT:\n"              // Header for context section
 	eventHeader        = "  EVENT: "                 // Prefix for event description
 	detailsHeader      = "  DETAILS:\n"              // Header for details section
 	interactionsHeader = "  INTERACTIONS:\n"         // Header for interactions section
+	semanticHeader     = "  SEMANTIC:\n"             // Header for semantic metadata section
 	entrySeparator     = "---"                       // Separator between log entries
+
+	//--- Sizing ---
+
+	entrySizeHint = 512 // Initial builder capacity - a partial-context entry fits in one allocation
 )
 
 // Types
@@ -126,19 +143,23 @@ type Interactions struct {
 // Final composition combining all pieces: context, event, details, health,
 // interactions. This is what gets written to log files and parsed by debugging.
 type LogEntry struct {
-	Timestamp        time.Time      // Exact moment (microsecond precision)
-	Level            string         // Entry type (OPERATION, SUCCESS, FAILURE, ERROR, CHECK, CONTEXT, DEBUG)
-	Component        string         // Logging component name
-	User             string         // WHO identifier (user@host:pid format)
-	ContextID        string         // Execution context ID (links related entries: component-pid-timestamp)
-	Context          *SystemContext // Full environment snapshot (nil for lightweight entries)
-	Event            string         // Human description of occurrence
-	Details          map[string]any // Structured data (command, exit_code, duration, stdout, stderr)
-	Interactions     *Interactions  // Optional complexity tracking
-	Semantic         *Metadata      // Optional restoration routing metadata
-	RawHealth        int            // Cumulative health (sum of all deltas)
-	NormalizedHealth int            // Health percentage (-100 to +100)
-	HealthImpact     int            // This event's delta (Δ)
+	Timestamp        time.Time           `json:"timestamp"`                   // Exact moment (microsecond precision)
+	Level            string              `json:"level"`                       // Entry type (OPERATION, SUCCESS, FAILURE, ERROR, CHECK, CONTEXT, DEBUG)
+	Component        string              `json:"component"`                   // Logging component name
+	User             string              `json:"user"`                        // WHO identifier (user@host:pid format)
+	ContextID        string              `json:"context_id"`                  // Execution context ID (links related entries: component-pid-timestamp)
+	ParentContextID  string              `json:"parent_context_id,omitempty"` // Context ID of the process that started this one ("" = top level)
+	Tags             []string            `json:"tags,omitempty"`              // Concern labels (network, config, ...) - component defaults, then call tags
+	Sequence         uint64              `json:"sequence"`                    // Per-Logger entry number (1, 2, ... - orders entries within a ContextID)
+	Context          *SystemContext      `json:"context,omitempty"`           // Full environment snapshot (nil for lightweight entries)
+	Event            string              `json:"event"`                       // Human description of occurrence
+	Details          map[string]any      `json:"details,omitempty"`           // Structured data (command, exit_code, duration, stdout, stderr)
+	Interactions     *Interactions       `json:"interactions,omitempty"`      // Optional complexity tracking
+	Semantic         *Metadata           `json:"semantic,omitempty"`          // Optional restoration routing metadata
+	RawSections      map[string][]string `json:"-"`                           // Sections ReadLogFile did not recognize (lines verbatim, never written)
+	RawHealth        int                 `json:"raw_health"`                  // Cumulative health (sum of all deltas)
+	NormalizedHealth int                 `json:"normalized_health"`           // Health percentage (-100 to +100)
+	HealthImpact     int                 `json:"health_impact"`               // This event's delta (Δ)
 }
 
 // Metadata captures semantic information for restoration routing (optional).
@@ -176,26 +197,39 @@ type Metadata struct {
 // Helpers - Foundation Functions
 // ────────────────────────────────────────────────────────────────
 
+// nextSequence assigns the Logger's next entry number (first entry is 1).
+//
+// Numbers are never reused - entries suppressed by coalescing consume theirs,
+// so gaps are expected, reversals are not.
+func (l *Logger) nextSequence() uint64 {
+	l.sequence++
+	return l.sequence
+}
+
 // formatDeltaSign formats health delta with appropriate sign prefix.
 //
 // Returns formatted string like "+10" or "-5" for visual clarity in logs.
 func formatDeltaSign(delta int) string {
-	if delta > 0 {           // Positive delta
-		return fmt.Sprintf("+%d", delta) // Add explicit + sign
+	if delta > 0 {                       // Positive delta
+		return "+" + strconv.Itoa(delta) // Add explicit + sign
 	}
-	return fmt.Sprintf("%d", delta) // Negative already has - sign
+	return strconv.Itoa(delta) // Negative already has - sign
 }
 
 // formatUserIdentifier formats user identity as user@host:pid.
 //
 // Creates standardized WHO identifier for log entries.
 func formatUserIdentifier(context *SystemContext) string {
-	return fmt.Sprintf("%s@%s:%d", context.User, context.Host, context.PID)
+	return context.User + "@" + context.Host + ":" + strconv.Itoa(context.PID)
 }
 
 // writeField writes a single key-value pair with consistent 4-space indentation.
 func writeField(builder *strings.Builder, key string, value string) {
-	fmt.Fprintf(builder, "    %s: %s\n", key, value) // Write with 4-space indent
+	builder.WriteString("    ") // 4-space indent
+	builder.WriteString(key)
+	builder.WriteString(": ")
+	builder.WriteString(value)
+	builder.WriteByte('\n')
 }
 
 // writeDetailValue writes a detail entry, handling both single-line and multiline values.
@@ -207,7 +241,30 @@ func writeDetailValue(builder *strings.Builder, key string, value any) {
 			fmt.Fprintf(builder, "      %s\n", line)             // Write line with 6-space indent
 		}
 	} else { // Single-line value
-		fmt.Fprintf(builder, "    %s: %v\n", key, value) // Write directly (4-space indent)
+		builder.WriteString("    ") // 4-space indent
+		builder.WriteString(key)
+		builder.WriteString(": ")
+		writeScalar(builder, value) // Same text as %v
+		builder.WriteByte('\n')
+	}
+}
+
+// writeScalar writes value as %v would, skipping fmt for the common types.
+//
+// float64 stays with fmt - %v's exponent threshold differs from strconv's.
+func writeScalar(builder *strings.Builder, value any) {
+	var scratch [24]byte
+	switch v := value.(type) {
+	case string:
+		builder.WriteString(v)
+	case int:
+		builder.Write(strconv.AppendInt(scratch[:0], int64(v), 10))
+	case int64:
+		builder.Write(strconv.AppendInt(scratch[:0], v, 10))
+	case bool:
+		builder.Write(strconv.AppendBool(scratch[:0], v))
+	default:
+		fmt.Fprint(builder, value)
 	}
 }
 
@@ -238,6 +295,41 @@ func writeListSection(builder *strings.Builder, sectionName string, items []stri
 	}
 }
 
+// writeJSONField writes a map as single-line JSON (omitted when empty).
+//
+// JSON keeps nested maps and lists intact for ReadLogFile; numbers come back as float64.
+func writeJSONField(builder *strings.Builder, key string, data map[string]any) {
+	if len(data) == 0 {                             // Nothing to record
+		return                                      // Skip field
+	}
+	encoded, err := json.Marshal(data)              // Keys sorted - stable output
+	if err != nil {                                 // Unencodable value (func, channel)
+		writeField(builder, key, fmt.Sprintf("%v", data)) // Readable, not restorable
+		return
+	}
+	writeField(builder, key, string(encoded))
+}
+
+// writeSemanticSection writes the non-empty Metadata fields under the SEMANTIC header.
+func writeSemanticSection(builder *strings.Builder, semantic *Metadata) {
+	builder.WriteString(semanticHeader)             // Write section header
+	for _, field := range []struct{ key, value string }{
+		{"Operation Type", semantic.OperationType},
+		{"Operation Subtype", semantic.OperationSubtype},
+		{"Error Type", semantic.ErrorType},
+		{"Recovery Hint", semantic.RecoveryHint},
+		{"Recovery Strategy", semantic.RecoveryStrategy},
+	} {
+		if field.value != "" {                      // Unset fields stay out of the log
+			writeField(builder, field.key, field.value)
+		}
+	}
+	writeJSONField(builder, "Error Details", semantic.ErrorDetails)
+	writeJSONField(builder, "Recovery Params", semantic.RecoveryParams)
+	writeJSONField(builder, "Expected", semantic.Expected)
+	writeJSONField(builder, "Actual", semantic.Actual)
+}
+
 // ────────────────────────────────────────────────────────────────
 // Core Operations - Entry Construction
 // ────────────────────────────────────────────────────────────────
@@ -245,82 +337,142 @@ func writeListSection(builder *strings.Builder, sectionName string, items []stri
 // createBaseEntry creates a LogEntry with common fields populated.
 func (l *Logger) createBaseEntry(context *SystemContext, healthImpact int) LogEntry {
 	return LogEntry{
-		Timestamp:        time.Now(),                    // Capture current time
+		Timestamp:        now(),                         // Capture current time (clock.go)
 		Component:        l.Component,                   // Component name from logger
 		User:             formatUserIdentifier(context), // Formatted user@host:pid
 		ContextID:        l.ContextID,                   // Unique execution identifier
+		ParentContextID:  l.ParentContextID,             // Caller's context (CPI_SI_PARENT_CONTEXT)
+		Tags:             l.entryTags(),                 // [tags.components] defaults plus WithTags (nil = untagged)
+		Sequence:         l.nextSequence(),              // Monotonic per-Logger entry number
 		RawHealth:        l.SessionHealth,               // Current raw cumulative health
 		NormalizedHealth: l.NormalizedHealth,            // Current normalized percentage
 		HealthImpact:     healthImpact,                  // Health delta for this event
 	}
 }
 
+// renderEntry renders an entry for the log file in the configured output format.
+//
+// format.output "json" writes one JSON object per line (ReadLogFile reads
+// both formats back); anything else writes the sectioned text of formatEntry.
+// An entry that cannot be marshaled falls back to text rather than vanish.
+func (l *Logger) renderEntry(entry LogEntry) string {
+	if strings.EqualFold(Config.Format.Output, OutputJSON) {
+		if line, err := json.Marshal(entry); err == nil {
+			return string(line) + "\n"
+		}
+	}
+	var builder strings.Builder
+	builder.Grow(entrySizeHint)
+	writeEntryText(&builder, entry)
+	builder.WriteByte('\n') // Same buffer - no copy to add the newline
+	return builder.String()
+}
+
 // formatEntry formats a LogEntry according to the documented standard.
 func (l *Logger) formatEntry(entry LogEntry) string {
-	var builder strings.Builder // Efficient string building
+	var builder strings.Builder
+	builder.Grow(entrySizeHint)
+	writeEntryText(&builder, entry)
+	return builder.String()
+}
 
-	// First line: Timestamp, Level, Component
-	fmt.Fprintf(&builder, "[%s] %s %s\n",
-		entry.Timestamp.Format(timestampFormat), // Formatted timestamp
-		entry.Level,                              // Log level
-		entry.Component,                          // Component name
-	)
+// writeEntryText writes entry's sectioned text (no trailing newline) to builder.
+//
+// Plain WriteString calls rather than fmt - a partial-context entry is logged
+// far more often than it is read, and fmt allocates per verb.
+func writeEntryText(builder *strings.Builder, entry LogEntry) {
+	var scratch [64]byte // Timestamp and number formatting without allocating
+
+	// First line: Timestamp, Level, Component, Context ID, Sequence
+	builder.WriteByte('[')
+	builder.Write(entry.Timestamp.AppendFormat(scratch[:0], timestampFormat)) // Formatted timestamp (nanoseconds)
+	builder.WriteString("] ")
+	builder.WriteString(entry.Level)     // Log level
+	builder.WriteByte(' ')
+	builder.WriteString(entry.Component) // Component name
+	builder.WriteByte(' ')
+	builder.WriteString(entry.ContextID) // Execution context (every entry, not just full-context ones)
+	builder.WriteString(" #")
+	builder.Write(strconv.AppendUint(scratch[:0], entry.Sequence, 10)) // Per-Logger sequence number
+	builder.WriteByte('\n')
+	if entry.ParentContextID != "" { // Started by another logged process
+		builder.WriteString(parentHeader)
+		builder.WriteString(entry.ParentContextID)
+		builder.WriteByte('\n')
+	}
+	writeTagsLine(builder, entry.Tags) // Tagged entries only (tags.go)
 
 	// CONTEXT section (if full context captured)
 	if entry.Context != nil { // Full context available
 		builder.WriteString(contextHeader) // Write section header
 
 		// Basic WHO/WHERE/WHEN fields
-		writeField(&builder, "User", entry.User)                                 // user@host:pid
-		writeField(&builder, "Context ID", entry.ContextID)                      // Execution context ID
-		writeField(&builder, "Shell", entry.Context.Shell.Format())              // Shell description (from context.go)
-		writeField(&builder, "CWD", entry.Context.CWD)                           // Current working directory
+		writeField(builder, "User", entry.User)                                 // user@host:pid
+		writeField(builder, "Context ID", entry.ContextID)                      // Execution context ID
+		writeField(builder, "Shell", entry.Context.Shell.Format())              // Shell description (from context.go)
+		writeField(builder, "CWD", entry.Context.CWD)                           // Current working directory
+		if entry.Context.Container {                                             // Only flagged when true
+			writeField(builder, "Container", "true")                            // Containerized run
+		}
 
 		// Environment state (if any vars captured)
-		writeMapSection(&builder, "Environment", entry.Context.EnvState)         // Automation + framework vars
+		writeMapSection(builder, "Environment", entry.Context.EnvState)         // Automation + framework vars
 
 		// Sudoers configuration
-		writeMapSection(&builder, "Sudoers", entry.Context.Sudoers.ToMap())      // Installation + permissions
+		writeMapSection(builder, "Sudoers", entry.Context.Sudoers.ToMap())      // Installation + permissions
 
 		// System metrics
-		writeMapSection(&builder, "System Metrics", entry.Context.System.ToMap()) // Load, memory, disk
+		writeMapSection(builder, "System Metrics", entry.Context.System.ToMap()) // Load, memory, disk
+
+		// Go runtime (CONTEXT/DEBUG, or capture_goruntime)
+		if entry.Context.GoRuntime != nil {
+			writeMapSection(builder, "Go Runtime", entry.Context.GoRuntime.ToMap()) // Heap, GC, goroutines
+		}
 	}
 
 	// EVENT section (always present)
-	fmt.Fprintf(&builder, "%s%s\n", eventHeader, entry.Event) // Event description
+	builder.WriteString(eventHeader) // Event description
+	builder.WriteString(entry.Event)
+	builder.WriteByte('\n')
 
 	// DETAILS section (if any details provided)
 	if len(entry.Details) > 0 { // Details exist
 		builder.WriteString(detailsHeader) // Write section header
 		for key, value := range entry.Details { // Iterate all detail fields
-			writeDetailValue(&builder, key, value) // Write each field with proper formatting
+			writeDetailValue(builder, key, value) // Write each field with proper formatting
 		}
 	}
 
 	// INTERACTIONS section (if tracking concurrent/dependencies)
 	if entry.Interactions != nil { // Interactions tracked
 		builder.WriteString(interactionsHeader) // Write section header
-		writeListSection(&builder, "Concurrent", entry.Interactions.Concurrent)       // Concurrent operations
-		writeMapSection(&builder, "Dependencies", entry.Interactions.Dependencies)    // Dependency relationships
-		writeMapSection(&builder, "State Changes", entry.Interactions.StateChanges)   // Before/after values
+		writeListSection(builder, "Concurrent", entry.Interactions.Concurrent)       // Concurrent operations
+		writeMapSection(builder, "Dependencies", entry.Interactions.Dependencies)    // Dependency relationships
+		writeMapSection(builder, "State Changes", entry.Interactions.StateChanges)   // Before/after values
+	}
+
+	// SEMANTIC section (metadata-enhanced entries only)
+	if entry.Semantic != nil { // Restoration routing metadata attached
+		writeSemanticSection(builder, entry.Semantic)
 	}
 
-	// Health scoring (always present)
-	healthIndicator := getHealthIndicator(entry.NormalizedHealth) // Get emoji from health.go
-	healthBar := getHealthBar(entry.NormalizedHealth)             // Get progress bar from health.go
-	delta := formatDeltaSign(entry.HealthImpact)                  // Format delta with sign
+	// Health scoring (always present) - the visual is for people, the numbers for parsers
+	visual := FormatHealth(entry.NormalizedHealth, DefaultHealthStyle()) // Indicator and bar from health.go
+	delta := formatDeltaSign(entry.HealthImpact)                         // Format delta with sign
 
-	fmt.Fprintf(&builder, "  HEALTH: %s %s (Δ%s, Raw: %d)\n",
-		healthIndicator,          // Visual emoji indicator
-		healthBar,                // ASCII progress bar
-		delta,                    // Delta with sign
-		entry.RawHealth,          // Raw cumulative score
-	)
+	builder.WriteString("  HEALTH: ")
+	builder.WriteString(visual) // Configured indicator and bar
+	builder.WriteString(" (Δ")
+	builder.WriteString(delta) // Delta with sign
+	builder.WriteString(", Raw: ")
+	builder.Write(strconv.AppendInt(scratch[:0], int64(entry.RawHealth), 10)) // Raw cumulative score
+	builder.WriteString(", Normalized: ")
+	builder.Write(strconv.AppendInt(scratch[:0], int64(entry.NormalizedHealth), 10)) // Exact normalized score (parsers read this, not the bar)
+	builder.WriteString(")\n")
 
 	// Entry separator
-	fmt.Fprintf(&builder, "%s\n", entrySeparator) // Entry separator line
-
-	return builder.String() // Return complete formatted entry
+	builder.WriteString(entrySeparator) // Entry separator line
+	builder.WriteByte('\n')
 }
 
 // ============================================================================
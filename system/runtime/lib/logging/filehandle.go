@@ -0,0 +1,236 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Persistent Log Handle - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "Whatsoever thy hand findeth to do, do it with thy might" (Ecclesiastes 9:10, KJV)
+// Principle: Keep at hand what is used constantly; put it down when the work pauses.
+// Anchor: A handle opened once per burst instead of once per entry - and closed when idle, never hoarded.
+//
+// CPI-SI Identity
+//
+// Component Type: File writing module within Rails infrastructure
+// Role: Hold a Logger's log file open across writes ([behavior] keep_file_open)
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial persistent handle with idle close
+//
+// Purpose & Function
+//
+// Purpose: appendFile opens, writes, and closes the log for every entry -
+// simple, and measurably slow where open/close is a round trip (NFS home
+// directories add ~3ms per entry). With [behavior] keep_file_open = true the
+// Logger keeps one O_APPEND handle between writes and closes it after
+// keep_file_open_idle_seconds without a write; the next entry reopens it.
+//
+// Core Design: The handle is opened O_APPEND, so every write lands at the
+// current end of file and other processes' entries still interleave at entry
+// granularity. Before each write the path is compared with the held file
+// (os.SameFile): a rotation - this Logger's, or another process's - or a
+// deleted log means the held file is no longer the log, so it is closed and
+// the path reopened. A failed write closes the handle too; the entry spills
+// exactly as with per-entry opens. The idle timer runs on its own goroutine,
+// so the handle carries its own mutex. Finalize closes it.
+//
+// Multi-process note: the library has no file locking. keepFileOpen is the
+// single switch - if locking is added, it must report false while locking is
+// active so locked writes never go through a handle held across entries.
+//
+// Local numbers (BenchmarkKeepFileOpen, local disk): ~16µs per entry
+// reopening vs ~14µs held - open and close are cheap locally, and the
+// rotation check's stat remains either way. The saving is the open/close
+// round trips, so it grows with their latency, the case this mode exists for.
+//
+// Blocking Status
+//
+// Non-blocking: Open and write failures return to appendEntry, which warns and
+// spills as always. The idle close ignores close errors (nothing to report to).
+//
+// Usage & Integration
+//
+// Enabled from logging.toml:
+//
+//	[behavior]
+//	keep_file_open = true
+//	keep_file_open_idle_seconds = 5
+//
+// Internal API:
+//   keepFileOpen() - Whether [behavior] keep_file_open applies
+//   keepFileOpenIdle() - Idle time before the held handle closes
+//   (*logHandle).append(path, text, durable) - Write through the held handle, reopening as needed
+//   (*logHandle).close() - Close the held handle (idle timer, Finalize)
+//   closeLogHandle() - Close the Logger's handle, if any (Logger method)
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: os, sync, time
+//   Package Files: config.go (Config.Behavior.KeepFileOpen, KeepFileOpenIdleSeconds),
+//                  writing.go (syncLog, logFilePermissions via appendFile's flags),
+//                  version.go (formatVersionHeader), logger.go (keepFileOpenIdleDefault)
+//
+// Dependents (What Uses This):
+//   Internal: writing.go (writeLog), summary.go (Finalize closes the handle)
+//
+// Health Scoring
+//
+// Handle writes: same as per-entry writes (writing.go) - the mode changes cost, not outcome.
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"os"   // Held file, identity checks
+	"sync" // Handle guarded against the idle timer
+	"time" // Idle close
+)
+
+// Types
+
+// logHandle is a log file held open across writes.
+type logHandle struct {
+	mu    sync.Mutex  // Guards everything below (the idle timer closes from its own goroutine)
+	file  *os.File    // Open O_APPEND handle (nil = closed)
+	path  string      // Path file was opened at
+	idle  *time.Timer // Closes file after keepFileOpenIdle() without a write
+	opens int         // Times a file was opened (reopen after idle close or rotation)
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Policy
+// ────────────────────────────────────────────────────────────────
+
+// keepFileOpen reports whether writes go through a held handle ([behavior] keep_file_open).
+//
+// Must report false while cross-process file locking is active (none exists yet).
+func keepFileOpen() bool {
+	LoadConfig()
+	return ConfigLoaded && Config.Behavior.KeepFileOpen
+}
+
+// keepFileOpenIdle returns how long a held handle stays open without a write.
+func keepFileOpenIdle() time.Duration {
+	if ConfigLoaded && Config.Behavior.KeepFileOpenIdleSeconds > 0 {
+		return time.Duration(Config.Behavior.KeepFileOpenIdleSeconds) * time.Second
+	}
+	return keepFileOpenIdleDefault
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Held Writes
+// ────────────────────────────────────────────────────────────────
+
+// append writes text to path through the held handle in a single write,
+// fsyncing when durable is set.
+//
+// Reopens when nothing is held, the Logger's path changed, or the file at path
+// is no longer the held one (rotated or deleted). A freshly opened empty file
+// gets the format version header in the same write, as with appendFile.
+func (h *logHandle) append(path, text string, durable bool) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.file != nil && !h.holds(path) {
+		h.closeLocked()
+	}
+	if h.file == nil {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, logFilePermissions)
+		if err != nil {
+			return err
+		}
+		h.file, h.path = file, path
+		h.opens++
+		if info, statErr := file.Stat(); statErr == nil && info.Size() == 0 { // New file - stamp the format version
+			text = formatVersionHeader(logFormatVersion) + text
+		}
+	}
+
+	_, err := h.file.WriteString(text)
+	if err == nil && durable {
+		err = syncLog(h.file)
+	}
+	if err != nil { // Next write starts from a fresh open
+		h.closeLocked()
+		return err
+	}
+
+	if h.idle == nil {
+		h.idle = time.AfterFunc(keepFileOpenIdle(), h.close)
+	} else {
+		h.idle.Reset(keepFileOpenIdle())
+	}
+	return nil
+}
+
+// holds reports whether the held file is still the file at path (caller holds mu).
+func (h *logHandle) holds(path string) bool {
+	if h.path != path {
+		return false
+	}
+	held, err := h.file.Stat()
+	if err != nil {
+		return false
+	}
+	current, err := os.Stat(path)
+	return err == nil && os.SameFile(held, current)
+}
+
+// close closes the held handle (idle timer, Finalize). Safe when nothing is held.
+func (h *logHandle) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closeLocked()
+}
+
+// closeLocked closes the held file and stops the idle timer (caller holds mu).
+func (h *logHandle) closeLocked() {
+	if h.idle != nil {
+		h.idle.Stop()
+		h.idle = nil
+	}
+	if h.file != nil {
+		h.file.Close() // Entries were written with write(2) - nothing buffered to lose
+		h.file = nil
+	}
+}
+
+// closeLogHandle closes the Logger's held handle, if it has one.
+func (l *Logger) closeLogHandle() {
+	if l.handle != nil {
+		l.handle.close()
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,119 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Persistent Log Handle Tests
+//
+// Purpose: Prove keep_file_open writes a burst through one handle, that an
+//          idle close (and Finalize) lets the next entry reopen without losing
+//          anything, that a rotated or deleted log is noticed and reopened,
+//          and that another writer's entries still interleave whole.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestKeepFileOpenHoldsOneHandle(t *testing.T) {
+	logger := newTestLogger(t, "keep-open-test")
+	withBehavior(t, func(cfg *LoggingConfig) { cfg.Behavior.KeepFileOpen = true })
+	t.Cleanup(logger.closeLogHandle)
+
+	for _, event := range []string{"one", "two", "three"} {
+		logger.Success(event, 1, nil)
+	}
+	if logger.handle == nil || logger.handle.opens != 1 || logger.handle.idle == nil {
+		t.Fatalf("handle = %+v, want one open with the idle timer armed", logger.handle)
+	}
+	if got := strings.Join(events(readEntries(t, logger.LogFile)), ","); got != "one,two,three" {
+		t.Errorf("events = %s", got)
+	}
+	data, _ := os.ReadFile(logger.LogFile)
+	if n := strings.Count(string(data), formatVersionHeader(logFormatVersion)); n != 1 {
+		t.Errorf("format version header written %d times", n)
+	}
+}
+
+func TestKeepFileOpenIdleCloseReopens(t *testing.T) {
+	logger := newTestLogger(t, "keep-open-idle-test")
+	withBehavior(t, func(cfg *LoggingConfig) { cfg.Behavior.KeepFileOpen = true })
+	t.Cleanup(logger.closeLogHandle)
+
+	logger.Success("before idle", 1, nil)
+	logger.handle.close() // What the idle timer does
+	if logger.handle.file != nil || logger.handle.idle != nil {
+		t.Fatalf("idle close left %+v", logger.handle)
+	}
+	logger.Success("after idle", 1, nil)
+	if logger.handle.opens != 2 {
+		t.Errorf("opens = %d, want a reopen after the idle close", logger.handle.opens)
+	}
+
+	logger.Finalize()
+	if logger.handle.file != nil {
+		t.Error("Finalize left the handle open")
+	}
+	if got := strings.Join(events(readEntries(t, logger.LogFile)), ","); !strings.HasPrefix(got, "before idle,after idle,") {
+		t.Errorf("events = %s", got)
+	}
+}
+
+func TestKeepFileOpenFollowsRotation(t *testing.T) {
+	logger := newTestLogger(t, "keep-open-rotation-test")
+	withBehavior(t, func(cfg *LoggingConfig) { cfg.Behavior.KeepFileOpen = true })
+	t.Cleanup(logger.closeLogHandle)
+
+	logger.Success("first file", 1, nil)
+	if err := os.Rename(logger.LogFile, rotationPath(logger.LogFile, 1)); err != nil { // Another process rotated
+		t.Fatal(err)
+	}
+	logger.Success("second file", 1, nil)
+	if got := strings.Join(events(readEntries(t, logger.LogFile)), ","); got != "second file" {
+		t.Errorf("current log = %s, want only the entry after rotation", got)
+	}
+
+	os.Remove(logger.LogFile) // Deleted out from under the handle
+	logger.Success("third file", 1, nil)
+	if got := strings.Join(events(readEntries(t, logger.LogFile)), ","); got != "third file" {
+		t.Errorf("recreated log = %s", got)
+	}
+	if logger.handle.opens != 3 {
+		t.Errorf("opens = %d, want 3", logger.handle.opens)
+	}
+}
+
+func TestKeepFileOpenInterleavesWithOtherWriters(t *testing.T) {
+	logger := newTestLogger(t, "keep-open-shared-test")
+	withBehavior(t, func(cfg *LoggingConfig) { cfg.Behavior.KeepFileOpen = true })
+	t.Cleanup(logger.closeLogHandle)
+
+	other := &Logger{Component: "other-process", ContextID: "other-process-2-1", LogFile: logger.LogFile, username: "other", hostname: "host", pid: 2}
+	logger.Success("held 1", 1, nil)
+	if err := appendFile(other.LogFile, other.renderEntry(other.createBaseEntry(&SystemContext{}, 1)), false); err != nil { // Per-entry writer on the same file
+		t.Fatal(err)
+	}
+	logger.Success("held 2", 1, nil)
+
+	var got []string
+	for _, entry := range readEntries(t, logger.LogFile) {
+		got = append(got, entry.Component)
+	}
+	if len(got) != 3 || got[0] != logger.Component || !strings.HasPrefix(got[1], other.Component) || got[2] != logger.Component {
+		t.Errorf("entry order = %v, want the other writer's entry between the held writes", got)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
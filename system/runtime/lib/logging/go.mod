@@ -7,7 +7,8 @@
 // Purpose: Provides Rails pattern logging - orthogonal infrastructure that
 // all components attach to for health tracking and activity logging.
 //
-// Dependencies: TOML parser (config.go only - intentional Rails exception)
+// Dependencies: TOML parser (config.go only - intentional Rails exception),
+// display rail (human.go only - stdlib-only, never imports logging)
 
 module system/lib/logging
 
@@ -20,7 +21,7 @@ go 1.24.4
 // ============================================================================
 // BODY
 // ============================================================================
-// No external dependencies - pure stdlib implementation
+// Only other rail: display (human entry rendering colors - human.go)
 // Rails pattern: components create own loggers, never pass as parameters
 
 // ============================================================================
@@ -30,3 +31,7 @@ go 1.24.4
 // Consumers: All components requiring health tracking infrastructure
 
 require github.com/BurntSushi/toml v1.5.0
+
+require system/lib/display v0.0.0
+
+replace system/lib/display => ../display
@@ -20,8 +20,8 @@
 // Architect: Seanje Lenox-Wise, Nova Dawn
 // Implementation: Nova Dawn
 // Creation Date: 2025-11-18
-// Version: 1.0.0
-// Last Modified: 2025-11-18 - Extracted from monolithic logger.go
+// Version: 1.3.0
+// Last Modified: 2026-10-16 - Health bar built in one allocation
 //
 // Purpose & Function
 //
@@ -32,9 +32,13 @@
 // Key Features:
 //   - Health clamping to valid range (-100 to +100)
 //   - Visual emoji indicators based on configurable thresholds
-//   - Progress bar visualization of health state
+//   - Accessible indicator sets (ascii, letters → OK/WARN/CRIT) by good/degraded/critical band
+//   - Progress bar visualization of health state (characters and width from [display.health])
+//   - FormatHealth - one renderer for logs, status, debugger, and session summaries
 //   - Normalized health calculation across components
 //   - Health delta tracking and accumulation
+//   - Budget audit (positive/negative deltas per level vs DeclareHealthTotal);
+//     [behavior] strict_health writes a CHECK entry at Finalize when a run drifts
 //
 // Blocking Status
 //
@@ -48,26 +52,36 @@
 //	import "system/runtime/lib/logging"
 //
 // Integration Pattern:
-//   1. Logger calls updateHealth(delta) to modify current health
+//   1. Logger calls updateHealth(level, delta) to modify current health
 //   2. calculateNormalizedHealth() ensures health stays within valid range
-//   3. getHealthIndicator() provides visual emoji for display
-//   4. getHealthBar() creates ASCII progress bar visualization
+//   3. FormatHealth() renders indicator and bar in a HealthStyle for display
 //
 // Public API:
 //
-//   updateHealth(delta int) *Logger - Modify logger health by delta value
+//   DefaultHealthStyle() HealthStyle - [display.health] style (hardcoded defaults when unavailable)
+//   FormatHealth(normalized int, style HealthStyle) string - Indicator + bar (or value)
+//   HealthBand(normalized int, style HealthStyle) string - good, degraded, or critical
+//   (*Logger).HealthAudit() HealthAudit - Deltas by level against the declared total
+//   (HealthAudit).Problems() []string - Undeclared or over-budget findings
+//
+// Internal API:
+//
+//   updateHealth(level string, delta int) - Modify logger health by delta value, tallied by level
+//   lintHealthBudget() - strict_health CHECK entry (called by Finalize)
 //   calculateNormalizedHealth() *Logger - Ensure health within valid range
 //   getHealthIndicator(health int) string - Get emoji for health value
-//   getHealthBar(health int) string - Get ASCII bar visualization
+//   getHealthBar(health int, style HealthStyle) string - Get bar visualization
 //
 // Dependencies
 //
 // Dependencies (What This Needs):
 //   Standard Library: fmt, strings
-//   Package Files: config.go (for Config.Health.Ranges)
+//   Package Files: config.go (Config.Health.Ranges, Config.Display.Health)
 //
 // Dependents (What Uses This):
-//   Internal: logger.go (all logging methods use health scoring)
+//   Internal: logger.go (all logging methods use health scoring), entry.go (HEALTH line)
+//   Commands: system/runtime/cmd/debugger (assessment report)
+//   Libraries: hooks/lib/session (session stats summary)
 //
 // Health Scoring
 //
@@ -91,10 +105,87 @@ package logging
 // Imports
 
 import (
-	"fmt"     // String formatting for health bar rendering
+	"fmt"     // String formatting for health values
+	"strconv" // Bar value without fmt
 	"strings" // String manipulation for bar construction
 )
 
+// Constants
+
+const (
+	//--- Indicator Sets ---
+	// Values of [display.health] indicators.
+
+	HealthIndicatorsEmoji   = "emoji"   // Graduated emoji from [health] ranges (💚 … 💀)
+	HealthIndicatorsASCII   = "ascii"   // [+] [~] [!] by band
+	HealthIndicatorsLetters = "letters" // OK / WARN / CRIT by band
+	HealthIndicatorsNone    = "none"    // Bar or value only
+
+	//--- Health Bands ---
+	// HealthBand results - what display layers colorize by.
+
+	HealthBandGood     = "good"
+	HealthBandDegraded = "degraded"
+	HealthBandCritical = "critical"
+
+	//--- Bar Defaults ---
+	// Fallbacks when [display.health] is unavailable.
+
+	healthBarFilledDefault = "█" // Filled bar cell
+	healthBarEmptyDefault  = "░" // Empty bar cell
+	healthBarWidthDefault  = 40  // Bar cells
+
+	//--- Budget Linting ---
+	// [behavior] strict_health fallbacks.
+
+	healthBudgetToleranceDefault = 10              // Percent positive deltas may exceed the declared total
+	healthBudgetCheck            = "health budget" // CHECK subject of the lint entry
+)
+
+// Types
+
+// HealthStyle chooses how FormatHealth renders health.
+//
+// Built by DefaultHealthStyle from [display.health]; callers may adjust a copy
+// (a narrower bar for table rows, no indicator for colorized output).
+type HealthStyle struct {
+	Indicators  string // emoji, ascii, letters, none
+	BarFilled   string // Filled bar cell
+	BarEmpty    string // Empty bar cell
+	BarWidth    int    // Bar cells (0 = plain value instead of a bar)
+	GoodMin     int    // Lowest normalized health in the good band
+	DegradedMin int    // Lowest normalized health in the degraded band (below = critical)
+}
+
+// HealthDelta sums the health deltas logged at one level.
+type HealthDelta struct {
+	Entries  int // Entries logged at the level
+	Positive int // Sum of positive deltas
+	Negative int // Sum of negative deltas (zero or below)
+}
+
+// healthTallies holds a Logger's summed deltas by level.
+type healthTallies map[string]HealthDelta
+
+// HealthAudit compares a run's health deltas with its declared Base100 budget.
+type HealthAudit struct {
+	DeclaredTotal int                    // DeclareHealthTotal value (0 = never declared)
+	Positive      int                    // Sum of positive deltas
+	Negative      int                    // Sum of negative deltas
+	ByLevel       map[string]HealthDelta // Deltas per level (where over-awarding comes from)
+	TolerancePct  int                    // Allowed overshoot of DeclaredTotal, percent
+	Undeclared    bool                   // Deltas applied, no total declared
+	OverBudget    bool                   // Positive exceeds DeclaredTotal by more than TolerancePct
+}
+
+// Package-Level State
+
+// healthASCIIIndicators and healthLetterIndicators map bands to band-based indicator sets.
+var (
+	healthASCIIIndicators  = map[string]string{HealthBandGood: "[+]", HealthBandDegraded: "[~]", HealthBandCritical: "[!]"}
+	healthLetterIndicators = map[string]string{HealthBandGood: "OK", HealthBandDegraded: "WARN", HealthBandCritical: "CRIT"}
+)
+
 // ============================================================================
 // END SETUP
 // ============================================================================
@@ -144,21 +235,127 @@ func getHealthIndicator(health int) string {
 	return "❓" // Unknown health indicator
 }
 
-// getHealthBar generates an ASCII progress bar showing health visually.
+// HealthBand classifies normalized health as "good", "degraded", or "critical".
+//
+// Boundaries come from the style: health ≥ GoodMin is good, ≥ DegradedMin is
+// degraded, below is critical. Display layers colorize by band, never by glyph.
+func HealthBand(normalized int, style HealthStyle) string {
+	switch {
+	case normalized >= style.GoodMin:
+		return HealthBandGood
+	case normalized >= style.DegradedMin:
+		return HealthBandDegraded
+	default:
+		return HealthBandCritical
+	}
+}
+
+// healthIndicatorFor returns the style's indicator for normalized health ("" for "none").
+func healthIndicatorFor(normalized int, style HealthStyle) string {
+	switch style.Indicators {
+	case HealthIndicatorsNone:
+		return ""
+	case HealthIndicatorsASCII:
+		return healthASCIIIndicators[HealthBand(normalized, style)]
+	case HealthIndicatorsLetters:
+		return healthLetterIndicators[HealthBand(normalized, style)]
+	default: // emoji (and unknown sets - the graduated [health] ranges)
+		return getHealthIndicator(normalized)
+	}
+}
+
+// getHealthBar generates a progress bar showing health visually.
 //
 // Creates a bar like: [████████████████████░░░░░░░░░░░░░░░░░░░░] (55/100)
-// Filled portion represents current health relative to maximum.
-func getHealthBar(health int) string {
-	// Bar configuration
-	const barWidth = 40                               // Total width in characters
-	clamped := clampHealth(health)                    // Ensure within valid range
-	normalizedHealth := (clamped + 100) / 2           // Convert -100..+100 to 0..100 range
-	filledWidth := (normalizedHealth * barWidth) / 100 // Calculate filled portion
+// Filled portion represents current health relative to maximum; characters
+// and width come from the style.
+func getHealthBar(health int, style HealthStyle) string {
+	clamped := clampHealth(health)                           // Ensure within valid range
+	normalizedHealth := (clamped + 100) / 2                  // Convert -100..+100 to 0..100 range
+	filledWidth := (normalizedHealth * style.BarWidth) / 100 // Calculate filled portion
+
+	// Build "[filled+empty] (N/100)" in one allocation - every text entry renders a bar
+	var bar strings.Builder
+	bar.Grow(len(style.BarFilled)*filledWidth + len(style.BarEmpty)*(style.BarWidth-filledWidth) + len("[] (100/100)"))
+	bar.WriteByte('[')
+	for i := 0; i < style.BarWidth; i++ {
+		if i < filledWidth {
+			bar.WriteString(style.BarFilled) // Filled portion
+		} else {
+			bar.WriteString(style.BarEmpty) // Empty portion
+		}
+	}
+	bar.WriteString("] (")
+	bar.WriteString(strconv.Itoa(normalizedHealth)) // 0-100 never allocates
+	bar.WriteString("/100)")
+	return bar.String()
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Health Rendering
+// ────────────────────────────────────────────────────────────────
+
+// DefaultHealthStyle returns the [display.health] style from logging.toml (or hardcoded defaults).
+//
+// Example usage:
+//
+//	style := logging.DefaultHealthStyle()
+//	style.BarWidth = 10 // Compact rows
+//	fmt.Println(logging.FormatHealth(assessment.OverallHealth, style))
+func DefaultHealthStyle() HealthStyle {
+	LoadConfig()
+
+	cfg := Config.Display.Health
+	style := HealthStyle{
+		Indicators:  cfg.Indicators,
+		BarFilled:   cfg.BarFilled,
+		BarEmpty:    cfg.BarEmpty,
+		BarWidth:    cfg.BarWidth,
+		GoodMin:     cfg.GoodMin,
+		DegradedMin: cfg.DegradedMin,
+	}
+	if style.Indicators == "" {
+		style.Indicators = HealthIndicatorsEmoji
+	}
+	if style.BarFilled == "" || style.BarEmpty == "" { // Both or neither - a half-set bar is unreadable
+		style.BarFilled, style.BarEmpty = healthBarFilledDefault, healthBarEmptyDefault
+	}
+	if style.BarWidth < 0 {
+		style.BarWidth = 0
+	}
+	return style
+}
+
+// FormatHealth renders normalized health (-100..+100) in the given style.
+//
+// What It Does:
+// Joins the style's indicator and bar: "💚 [████░░░░] (55/100)" by default,
+// "OK [####....] (90/100)" with letters and ASCII bar characters. With
+// BarWidth 0 the bar is replaced by the plain value ("WARN 10%"). The status
+// command, debugger, and session summaries all render through this so health
+// looks the same everywhere.
+//
+// Parameters:
+//   normalized: Normalized health (-100 to +100, clamped)
+//   style: Indicator set, bar characters and width, band boundaries
+//
+// Returns:
+//   string: Rendered health - never parse it; log entries carry the number separately
+//
+// Example usage:
+//
+//	fmt.Println(logging.FormatHealth(logger.GetHealth(), logging.DefaultHealthStyle()))
+func FormatHealth(normalized int, style HealthStyle) string {
+	normalized = clampHealth(normalized)
 
-	// Build bar components
-	filled := strings.Repeat("█", filledWidth)               // Filled portion (solid blocks)
-	empty := strings.Repeat("░", barWidth-filledWidth)       // Empty portion (light blocks)
-	return fmt.Sprintf("[%s%s] (%d/100)", filled, empty, normalizedHealth) // Formatted bar with value
+	value := fmt.Sprintf("%d%%", normalized)
+	if style.BarWidth > 0 {
+		value = getHealthBar(normalized, style)
+	}
+	if indicator := healthIndicatorFor(normalized, style); indicator != "" {
+		return indicator + " " + value
+	}
+	return value
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -187,7 +384,9 @@ func (l *Logger) calculateNormalizedHealth() {
 //
 // Adds delta to SessionHealth (raw cumulative), then recalculates NormalizedHealth.
 // SessionHealth is NOT clamped - it's the raw cumulative total. Only NormalizedHealth gets clamped.
-func (l *Logger) updateHealth(delta int) {
+// The delta is also tallied under its level for HealthAudit.
+func (l *Logger) updateHealth(level string, delta int) {
+	l.tallyHealth(level, delta)                       // Per-level budget accounting (HealthAudit)
 	l.SessionHealth += delta                          // Apply health delta to raw cumulative
 	// NOTE: SessionHealth is NOT clamped - it's the raw cumulative total
 	// Only NormalizedHealth gets clamped during calculation
@@ -196,6 +395,116 @@ func (l *Logger) updateHealth(delta int) {
 	l.calculateNormalizedHealth()                     // Update percentage based on new raw value
 }
 
+// ────────────────────────────────────────────────────────────────
+// Logger Methods - Budget Linting
+// ────────────────────────────────────────────────────────────────
+
+// tallyHealth adds one entry's delta to its level's positive or negative sum.
+func (l *Logger) tallyHealth(level string, delta int) {
+	if l.healthByLevel == nil { // Logger built without NewLogger
+		l.healthByLevel = make(healthTallies)
+	}
+	tally := l.healthByLevel[level]
+	tally.Entries++
+	if delta > 0 {
+		tally.Positive += delta
+	} else {
+		tally.Negative += delta
+	}
+	l.healthByLevel[level] = tally
+}
+
+// strictHealth reports whether [behavior] strict_health is on.
+func strictHealth() bool {
+	LoadConfig()
+	return ConfigLoaded && Config.Behavior.StrictHealth
+}
+
+// healthBudgetTolerance returns how far, in percent, positive deltas may overshoot the declared total.
+func healthBudgetTolerance() int {
+	if ConfigLoaded && Config.Behavior.HealthBudgetTolerance > 0 {
+		return Config.Behavior.HealthBudgetTolerance
+	}
+	return healthBudgetToleranceDefault
+}
+
+// lintHealthBudget writes a CHECK entry when strict_health finds the run off budget.
+//
+// Called by Finalize. Nothing is written when the flag is off or the budget holds.
+func (l *Logger) lintHealthBudget() {
+	if !strictHealth() {
+		return
+	}
+	audit := l.HealthAudit()
+	problems := audit.Problems()
+	if len(problems) == 0 {
+		return
+	}
+
+	details := map[string]any{
+		"declared_total": audit.DeclaredTotal,
+		"positive_total": audit.Positive,
+		"negative_total": audit.Negative,
+		"tolerance_pct":  audit.TolerancePct,
+		"problems":       strings.Join(problems, "\n"),
+	}
+	for level, tally := range audit.ByLevel { // Which levels over-award
+		details["health_"+level] = fmt.Sprintf("+%d / %d over %d entries", tally.Positive, tally.Negative, tally.Entries)
+	}
+	l.Check(healthBudgetCheck, false, 0, details)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Health Audit
+// ────────────────────────────────────────────────────────────────
+
+// HealthAudit returns this run's health deltas against its declared budget.
+//
+// What It Does:
+// Reports positive and negative deltas summed separately, overall and per
+// level, next to the DeclareHealthTotal value. Undeclared is set when deltas
+// were applied but no total was declared; OverBudget when positive deltas
+// exceed the declared total by more than [behavior] health_budget_tolerance
+// percent. Available whether or not strict_health is on - the flag only
+// decides whether Finalize writes the CHECK entry.
+//
+// Returns:
+//   HealthAudit: Snapshot (the ByLevel map is a copy)
+//
+// Example usage:
+//
+//	audit := logger.HealthAudit()
+//	for level, tally := range audit.ByLevel {
+//	    fmt.Printf("%s: +%d %d\n", level, tally.Positive, tally.Negative)
+//	}
+func (l *Logger) HealthAudit() HealthAudit {
+	audit := HealthAudit{
+		DeclaredTotal: l.TotalPossibleHealth,
+		ByLevel:       make(map[string]HealthDelta, len(l.healthByLevel)),
+		TolerancePct:  healthBudgetTolerance(),
+	}
+	for level, tally := range l.healthByLevel {
+		audit.ByLevel[level] = tally
+		audit.Positive += tally.Positive
+		audit.Negative += tally.Negative
+	}
+	audit.Undeclared = audit.DeclaredTotal == 0 && (audit.Positive != 0 || audit.Negative != 0)
+	audit.OverBudget = audit.DeclaredTotal > 0 && audit.Positive*100 > audit.DeclaredTotal*(100+audit.TolerancePct)
+	return audit
+}
+
+// Problems lists the audit's findings, one line each (empty when within budget).
+func (a HealthAudit) Problems() []string {
+	var problems []string
+	if a.Undeclared {
+		problems = append(problems, fmt.Sprintf("health deltas applied (+%d / %d) but DeclareHealthTotal was never called", a.Positive, a.Negative))
+	}
+	if a.OverBudget {
+		problems = append(problems, fmt.Sprintf("positive deltas total +%d, over the declared %d by more than %d%%", a.Positive, a.DeclaredTotal, a.TolerancePct))
+	}
+	return problems
+}
+
 // ============================================================================
 // CLOSING
 // ============================================================================
@@ -0,0 +1,150 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Health Rendering Tests
+//
+// Purpose: Prove FormatHealth renders each indicator set and bar style, that
+//          band boundaries come from the style, that DefaultHealthStyle
+//          repairs half-configured bars, and that log entries read back their
+//          exact normalized health whatever glyphs [display.health] chooses.
+//          HealthAudit splits deltas by level and sign, and strict_health
+//          flags over-budget and undeclared runs at Finalize - only when on.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"strings"
+	"testing"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestFormatHealthStyles(t *testing.T) {
+	ascii := HealthStyle{Indicators: HealthIndicatorsLetters, BarFilled: "#", BarEmpty: ".", BarWidth: 10, GoodMin: 70, DegradedMin: 0}
+
+	cases := []struct {
+		name   string
+		health int
+		style  HealthStyle
+		want   string
+	}{
+		{"letters good", 80, ascii, "OK [#########.] (90/100)"},
+		{"letters degraded", 10, ascii, "WARN [#####.....] (55/100)"},
+		{"letters critical", -60, ascii, "CRIT [##........] (20/100)"},
+		{"ascii no bar", 69, HealthStyle{Indicators: HealthIndicatorsASCII, GoodMin: 70}, "[~] 69%"},
+		{"none", -100, HealthStyle{Indicators: HealthIndicatorsNone, BarFilled: "=", BarEmpty: " ", BarWidth: 4}, "[    ] (0/100)"},
+		{"clamped", 250, HealthStyle{Indicators: HealthIndicatorsNone}, "100%"},
+	}
+	for _, tc := range cases {
+		if got := FormatHealth(tc.health, tc.style); got != tc.want {
+			t.Errorf("%s: FormatHealth(%d) = %q, want %q", tc.name, tc.health, got, tc.want)
+		}
+	}
+}
+
+func TestHealthBandBoundaries(t *testing.T) {
+	style := HealthStyle{GoodMin: 50, DegradedMin: -20}
+	cases := map[int]string{50: HealthBandGood, 49: HealthBandDegraded, -20: HealthBandDegraded, -21: HealthBandCritical}
+	for health, want := range cases {
+		if got := HealthBand(health, style); got != want {
+			t.Errorf("HealthBand(%d) = %q, want %q", health, got, want)
+		}
+	}
+}
+
+func TestDefaultHealthStyleRepairsBar(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Display.Health = HealthDisplayConfig{BarFilled: "#", BarWidth: -3, GoodMin: 60}
+	})
+
+	style := DefaultHealthStyle()
+	if style.Indicators != HealthIndicatorsEmoji || style.BarFilled != healthBarFilledDefault || style.BarEmpty != healthBarEmptyDefault {
+		t.Errorf("half-set bar not repaired: %+v", style)
+	}
+	if style.BarWidth != 0 || style.GoodMin != 60 {
+		t.Errorf("width/bands = %d/%d, want 0/60", style.BarWidth, style.GoodMin)
+	}
+}
+
+func TestHealthRoundTripIgnoresGlyphs(t *testing.T) {
+	logger := newTestLogger(t, "health-style-test")
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Display.Health = HealthDisplayConfig{Indicators: HealthIndicatorsLetters, BarFilled: ")", BarEmpty: "(", BarWidth: 8, GoodMin: 70}
+	})
+	logger.DeclareHealthTotal(100)
+
+	logger.Success("odd health", 33, nil) // Odd values used to read back one lower through the bar
+
+	entries := readEntries(t, logger.LogFile)
+	if len(entries) != 1 {
+		t.Fatalf("read %d entries, want 1", len(entries))
+	}
+	if got := entries[0]; got.NormalizedHealth != 33 || got.RawHealth != 33 || got.HealthImpact != 33 {
+		t.Errorf("health read back %d (raw %d, Δ%d), want 33 (raw 33, Δ33)", got.NormalizedHealth, got.RawHealth, got.HealthImpact)
+	}
+}
+
+func TestHealthAuditByLevel(t *testing.T) {
+	logger := newTestLogger(t, "audit-test")
+	logger.DeclareHealthTotal(100)
+	logger.Success("a", 40, nil)
+	logger.Success("b", 50, nil)
+	logger.Failure("c", "broken", -10, nil)
+	logger.Check("d", true, 25, nil)
+
+	audit := logger.HealthAudit()
+	if audit.Positive != 115 || audit.Negative != -10 || audit.DeclaredTotal != 100 {
+		t.Fatalf("audit totals = %+v", audit)
+	}
+	if got := audit.ByLevel[levelSuccess]; got != (HealthDelta{Entries: 2, Positive: 90}) {
+		t.Errorf("SUCCESS tally = %+v", got)
+	}
+	if !audit.OverBudget || audit.Undeclared || len(audit.Problems()) != 1 { // 115 > 100 + 10%
+		t.Errorf("problems = %v, want over budget only", audit.Problems())
+	}
+}
+
+func TestStrictHealthLintsAtFinalize(t *testing.T) {
+	logger := newTestLogger(t, "lint-test")
+	logger.Success("no budget declared", 30, nil)
+	logger.Finalize() // Flag off - no lint entry
+
+	if entries := readEntries(t, logger.LogFile); len(entries) != 2 {
+		t.Fatalf("flag off wrote %d entries, want the success and the run summary", len(entries))
+	}
+
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Behavior.StrictHealth = true
+	})
+	strict := NewLogger("lint-strict")
+	strict.Success("no budget declared", 30, nil)
+	strict.Finalize()
+
+	var lint *LogEntry
+	for _, entry := range readEntries(t, strict.LogFile) {
+		if entry.Level == levelCheck && strings.Contains(entry.Event, healthBudgetCheck) {
+			lint = &entry
+		}
+	}
+	if lint == nil || !strings.Contains(lint.Details["problems"].(string), "never called") || lint.Details["health_SUCCESS"] != "+30 / 0 over 1 entries" {
+		t.Fatalf("lint entry = %+v", lint)
+	}
+
+	within := NewLogger("lint-within")
+	within.DeclareHealthTotal(100)
+	within.Success("on budget", 105, nil) // Inside the 10% tolerance
+	if within.HealthAudit().OverBudget {
+		t.Error("105 of 100 is within the default tolerance")
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,327 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Human Entry Rendering - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "Write the vision, and make it plain upon tables, that he may run that readeth it." - Habakkuk 2:2 (KJV)
+// Principle: A record nobody can read quickly is a record nobody reads.
+// Anchor: The on-disk format serves the parser; people deserve their own view of the same entries.
+//
+// CPI-SI Identity
+//
+// Component Type: Presentation module within Rails infrastructure
+// Role: Render parsed entries compactly for people (debugger, diagnose)
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.2.0
+// Last Modified: 2026-10-16 - Entry tags shown after the component
+//
+// Purpose & Function
+//
+// Purpose: Commands that show entries back to a person (selected by
+// ReadLogFile, CorrelateContext, or QueryLogs) printed the raw on-disk
+// format or hand-rolled their own. FormatEntryHuman is the shared view:
+//
+//	14:03:07.412 FAILURE   validate  -10
+//	    syntax check failed
+//	    reason: unexpected EOF
+//	    stderr: main.go:12: syntax error
+//	            main.go:13: missing return
+//	            … 6 more lines (--full)
+//
+// Core Design: One header line (time, level badge, component, tags when the
+// entry has any, signed health delta), the event indented under it, then the allow-listed details
+// (HumanOpts.Details, default DefaultHumanDetails) in allow-list order.
+// Multi-line details stop at MaxDetailLines with a count of what was hidden
+// and the caller's ExpandHint. Context and semantic metadata are opt-in.
+// FormatEntriesHuman adds a separator whenever the day changes.
+//
+// Colors come from the display rail (display.Severity), so NO_COLOR and
+// non-terminal output render plain - which is what the golden tests pin.
+//
+// Blocking Status
+//
+// Non-blocking: Pure string building - no I/O.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	entries, _ := logging.ReadLogFile(path)
+//	fmt.Print(logging.FormatEntriesHuman(entries, logging.HumanOpts{ExpandHint: "--full"}))
+//
+// Public API:
+//   HumanOpts - What to include (details allow-list, line limit, context, semantic)
+//   DefaultHumanDetails - Details shown when HumanOpts.Details is nil
+//   FormatEntryHuman(entry, opts) string - One entry
+//   FormatEntriesHuman(entries, opts) string - Entries with day separators
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: fmt, sort, strings
+//   Internal: system/lib/display (Severity - NO_COLOR and TTY aware)
+//   Package Files: entry.go (LogEntry, formatDeltaSign), logger.go (level constants), details.go (Detail* keys)
+//
+// Dependents (What Uses This):
+//   Commands: for system/runtime/cmd/debugger and diagnose entry views (not yet wired)
+//
+// Health Scoring
+//
+// Presentation only - no health impact.
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"fmt"     // Value rendering
+	"sort"    // AllDetails key order
+	"strings" // Line assembly
+
+	"system/lib/display" // Semantic colors (plain under NO_COLOR or non-TTY)
+)
+
+// Constants
+
+const (
+	humanIndent          = "    "         // Event and detail indent under the header
+	humanTimeFormat      = "15:04:05.000" // Header time
+	humanDayFormat       = "Mon Jan 02, 2006"
+	defaultHumanMaxLines = 5 // Lines kept per multi-line detail
+)
+
+// Types
+
+// HumanOpts chooses what FormatEntryHuman shows beyond the header and event.
+type HumanOpts struct {
+	Details        []string // Detail keys shown, in this order (nil = DefaultHumanDetails)
+	AllDetails     bool     // Every detail, sorted by key (overrides Details)
+	MaxDetailLines int      // Lines kept per multi-line detail (0 = 5, negative = all)
+	ExpandHint     string   // Appended to "… N more lines" (e.g. "--full")
+	ShowContext    bool     // User, host, cwd, and resource snapshot when captured
+	ShowSemantic   bool     // Operation, error, and recovery metadata when present
+}
+
+// DefaultHumanDetails are the details worth a person's attention in most entries.
+var DefaultHumanDetails = []string{DetailReason, DetailError, DetailExitCode, DetailDuration, DetailCommand, DetailFile, "action", "stderr", DetailStack}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Styling
+// ────────────────────────────────────────────────────────────────
+
+// levelStyle maps an entry level to its display severity.
+func levelStyle(level string, delta int) display.Level {
+	switch level {
+	case levelSuccess:
+		return display.LevelSuccess
+	case levelFailure, levelError:
+		return display.LevelError
+	case levelCheck:
+		if delta < 0 {
+			return display.LevelWarning
+		}
+		return display.LevelInfo
+	case levelOperation:
+		return display.LevelInfo
+	}
+	return display.LevelMuted // CONTEXT, DEBUG, anything unknown
+}
+
+// deltaStyle colors a health delta by sign.
+func deltaStyle(delta int) display.Level {
+	switch {
+	case delta > 0:
+		return display.LevelSuccess
+	case delta < 0:
+		return display.LevelError
+	}
+	return display.LevelMuted
+}
+
+// humanTags renders an entry's tags for the header line (" [git, network]", "" untagged).
+func humanTags(tags []string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	return " " + display.Severity("["+strings.Join(tags, tagsSeparator)+"]", display.LevelMuted)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Body Lines
+// ────────────────────────────────────────────────────────────────
+
+// writeHumanValue writes "key: value", continuation lines aligned under the
+// value and cut at maxLines with a hidden-line count.
+func writeHumanValue(b *strings.Builder, key string, value any, opts HumanOpts) {
+	text := strings.TrimRight(fmt.Sprint(value), "\n")
+	lines := strings.Split(text, "\n")
+
+	maxLines := opts.MaxDetailLines
+	if maxLines == 0 {
+		maxLines = defaultHumanMaxLines
+	}
+	hidden := 0
+	if maxLines > 0 && len(lines) > maxLines {
+		hidden = len(lines) - maxLines
+		lines = lines[:maxLines]
+	}
+
+	label := humanIndent + key + ": "
+	continuation := strings.Repeat(" ", len(label))
+	for i, line := range lines {
+		if i == 0 {
+			b.WriteString(display.Severity(label, display.LevelMuted) + line + "\n")
+		} else {
+			b.WriteString(continuation + line + "\n")
+		}
+	}
+	if hidden > 0 {
+		note := fmt.Sprintf("… %d more lines", hidden)
+		if opts.ExpandHint != "" {
+			note += " (" + opts.ExpandHint + ")"
+		}
+		b.WriteString(continuation + display.Severity(note, display.LevelMuted) + "\n")
+	}
+}
+
+// detailKeys lists the details to show, in display order, that the entry has.
+func detailKeys(details map[string]any, opts HumanOpts) []string {
+	var keys []string
+	if opts.AllDetails {
+		for key := range details {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return keys
+	}
+
+	allow := opts.Details
+	if allow == nil {
+		allow = DefaultHumanDetails
+	}
+	for _, key := range allow {
+		if _, ok := details[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// writeHumanContext writes the captured environment on two lines.
+func writeHumanContext(b *strings.Builder, context *SystemContext, opts HumanOpts) {
+	writeHumanValue(b, "context", fmt.Sprintf("%s  cwd %s", formatUserIdentifier(context), context.CWD), opts)
+	writeHumanValue(b, "system", fmt.Sprintf("load %s  mem %s  disk %s", context.System.Load, context.System.Memory, context.System.Disk), opts)
+}
+
+// writeHumanSemantic writes the semantic fields that are set.
+func writeHumanSemantic(b *strings.Builder, semantic *Metadata, opts HumanOpts) {
+	operation := strings.Trim(semantic.OperationType+"/"+semantic.OperationSubtype, "/")
+	recovery := strings.Trim(semantic.RecoveryHint+"/"+semantic.RecoveryStrategy, "/")
+	for _, field := range []struct{ key, value string }{
+		{"operation", operation},
+		{"error_type", semantic.ErrorType},
+		{"recovery", recovery},
+	} {
+		if field.value != "" {
+			writeHumanValue(b, field.key, field.value, opts)
+		}
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// FormatEntryHuman renders one entry for a person to read.
+//
+// What It Does:
+// Header line: time, level badge, component, tags, signed health delta (colored by
+// sign). Then the event and the allow-listed details, indented. Context and
+// semantic metadata only when opts asks and the entry has them.
+//
+// Example usage:
+//
+//	fmt.Print(logging.FormatEntryHuman(entry, logging.HumanOpts{}))
+//	// 14:03:07.412 FAILURE   validate  -10
+//	//     syntax check failed
+//	//     reason: unexpected EOF
+func FormatEntryHuman(entry LogEntry, opts HumanOpts) string {
+	var b strings.Builder
+
+	badge := fmt.Sprintf("%-9s", entry.Level) // OPERATION is the widest level
+	b.WriteString(display.Severity(entry.Timestamp.Format(humanTimeFormat), display.LevelMuted) + " " +
+		display.Severity(badge, levelStyle(entry.Level, entry.HealthImpact)) + " " +
+		entry.Component + humanTags(entry.Tags) + "  " +
+		display.Severity(formatDeltaSign(entry.HealthImpact), deltaStyle(entry.HealthImpact)) + "\n")
+
+	if entry.Event != "" {
+		b.WriteString(humanIndent + entry.Event + "\n")
+	}
+	for _, key := range detailKeys(entry.Details, opts) {
+		writeHumanValue(&b, key, entry.Details[key], opts)
+	}
+	if opts.ShowContext && entry.Context != nil {
+		writeHumanContext(&b, entry.Context, opts)
+	}
+	if opts.ShowSemantic && entry.Semantic != nil {
+		writeHumanSemantic(&b, entry.Semantic, opts)
+	}
+	return b.String()
+}
+
+// FormatEntriesHuman renders entries in order with a separator at each new day.
+//
+// The first entry gets one too - header lines carry only the time of day.
+//
+// Example usage:
+//
+//	fmt.Print(logging.FormatEntriesHuman(entries, logging.HumanOpts{AllDetails: true}))
+//	// ── Thu Oct 15, 2026 ──
+//	// 23:59:58.000 SUCCESS   hook  +5
+//	// ...
+//	// ── Fri Oct 16, 2026 ──
+func FormatEntriesHuman(entries []LogEntry, opts HumanOpts) string {
+	var b strings.Builder
+	day := ""
+	for _, entry := range entries {
+		if current := entry.Timestamp.Format(humanDayFormat); current != day {
+			day = current
+			b.WriteString(display.Severity("── "+day+" ──", display.LevelMuted) + "\n")
+		}
+		b.WriteString(FormatEntryHuman(entry, opts))
+	}
+	return b.String()
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
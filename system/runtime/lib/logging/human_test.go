@@ -0,0 +1,116 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Human Entry Rendering Tests
+//
+// Purpose: Pin FormatEntryHuman and FormatEntriesHuman output with NO_COLOR
+//          against golden files (refresh with go test -run Human -update):
+//          default and custom detail allow-lists, multi-line details cut at
+//          MaxDetailLines with the expansion hint, opt-in context and
+//          semantic expansion, day separators, and the mixed-format fixture log.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// humanEntries spans two days and every optional part of an entry
+func humanEntries() []LogEntry {
+	day := time.Date(2026, 10, 15, 23, 59, 58, 0, time.UTC)
+	return []LogEntry{
+		{
+			Timestamp: day, Level: levelOperation, Component: "validate", Event: "validating main.go",
+			Details: map[string]any{"file": "main.go", "ignored": "not on the allow-list"},
+		},
+		{
+			Timestamp: day.Add(3 * time.Second), Level: levelFailure, Component: "validate", HealthImpact: -10,
+			Event: "syntax check failed",
+			Details: map[string]any{
+				"reason":    "unexpected EOF",
+				"exit_code": float64(2),
+				"stderr":    "main.go:12: syntax error\nmain.go:13: missing return\nline 3\nline 4\nline 5\nline 6\nline 7\n",
+			},
+			Context: &SystemContext{User: "nova", Host: "dawn", PID: 42, CWD: "/repo",
+				System: SystemMetrics{Load: "0.10 0.20 0.30", Memory: "512/2048MB", Disk: "10/100GB (10%)"}},
+			Semantic: &Metadata{OperationType: "file_validation", OperationSubtype: "syntax_check",
+				ErrorType: "syntax_error", RecoveryHint: "manual_intervention"},
+		},
+		{
+			Timestamp: day.Add(4 * time.Second), Level: levelCheck, Component: "hook", HealthImpact: 5,
+			Event: "config loaded",
+		},
+	}
+}
+
+// checkGolden compares got with testdata/name, rewriting it under -update
+func checkGolden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("%s mismatch (go test -run Human -update to accept)\ngot:\n%s\nwant:\n%s", name, got, want)
+	}
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestFormatEntriesHumanGolden(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	entries := humanEntries()
+
+	var out strings.Builder
+	out.WriteString("# defaults\n")
+	out.WriteString(FormatEntriesHuman(entries, HumanOpts{}))
+	out.WriteString("# expanded: context, semantic, 3 lines, hint\n")
+	out.WriteString(FormatEntriesHuman(entries, HumanOpts{ShowContext: true, ShowSemantic: true, MaxDetailLines: 3, ExpandHint: "--full"}))
+	out.WriteString("# allow-list reason only\n")
+	out.WriteString(FormatEntryHuman(entries[1], HumanOpts{Details: []string{"reason"}}))
+	out.WriteString("# all details, no limit\n")
+	out.WriteString(FormatEntryHuman(entries[0], HumanOpts{AllDetails: true, MaxDetailLines: -1}))
+	checkGolden(t, "human.golden", out.String())
+}
+
+func TestFormatEntriesHumanMixedFixture(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	saved := time.Local // Headers are local time - pin the zone
+	time.Local = time.UTC
+	defer func() { time.Local = saved }()
+
+	entries, err := ReadLogFile(filepath.Join("testdata", "format-mixed.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "format-mixed.human.golden", FormatEntriesHuman(entries, HumanOpts{AllDetails: true}))
+}
+
+func TestFormatEntryHumanColor(t *testing.T) {
+	t.Setenv("FORCE_COLOR", "1")
+	colored := FormatEntryHuman(humanEntries()[1], HumanOpts{})
+	if !strings.Contains(colored, "\033[") {
+		t.Errorf("FORCE_COLOR output has no escapes:\n%q", colored)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
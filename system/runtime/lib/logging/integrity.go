@@ -0,0 +1,267 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Log Integrity Verification - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "Be thou diligent to know the state of thy flocks, and look well to thy herds." - Proverbs 27:23 (KJV)
+// Principle: A record is only trustworthy when it is whole.
+// Anchor: Rotation and crashes are where logs lose pages - check there first.
+//
+// CPI-SI Identity
+//
+// Component Type: Verification module within Rails infrastructure
+// Role: Report gaps in a rotation chain and entries cut off mid-write
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.2.0
+// Last Modified: 2026-10-16 - A file holding only its format header is complete
+//
+// Purpose & Function
+//
+// Purpose: A crash mid-rotation once left a truncated current log and a
+// missing .2 with nothing to say so. VerifyLogIntegrity gives the diagnose
+// command a yes/no answer plus the specifics.
+//
+// Core Design: Read-only. For the current log and every rotation (.1 through
+// .5) it checks that the file's last entry is complete - text entries end
+// with the "---" separator, JSON entries are one whole object per line,
+// sealed entries ([privacy] encrypt) carry the payload length they announce. A
+// rotation missing below an older one that exists is a gap. A leftover
+// file.log.rotating means a rotation was interrupted (writing.go finishes it
+// on the next write).
+//
+// Blocking Status
+//
+// Non-blocking: Verification never modifies a file. Errors are returned, not logged.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	report, err := logging.VerifyLogIntegrity(logger.LogFile)
+//	if err == nil && !report.OK() {
+//	    fmt.Println(report.Problems())
+//	}
+//
+// Public API:
+//   IntegrityReport - Rotation chain findings (Files, Gaps, StagedRotation; OK, Problems)
+//   FileIntegrity - One file's size and last-entry completeness
+//   VerifyLogIntegrity(path string) (IntegrityReport, error) - Check a log and its rotations
+//
+// Internal API:
+//   lastEntryComplete(path) - Terminator check on the file's tail
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bytes, encoding/json, fmt, io, os
+//   Package Files: writing.go (rotationPath, rotationStagingPath, maxLogRotations),
+//                  entry.go (entrySeparator), parsing.go (maxLineBytes)
+//
+// Dependents (What Uses This):
+//   External: none yet - exported for system/runtime/cmd/diagnose (log health)
+//
+// Health Scoring
+//
+// Verification: 0 (read-only diagnostics)
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"bytes"         // Tail line splitting
+	"encoding/json" // JSON entry completeness
+	"fmt"           // Problem descriptions
+	"io"            // Tail reads
+	"os"            // File stats and reads
+)
+
+// Constants
+
+const integrityLineChars = 120 // LastLine is cut to this many bytes (a cut-off entry can be one huge line)
+
+// Types
+
+// FileIntegrity describes one file of a rotation chain.
+type FileIntegrity struct {
+	Path     string // File path
+	Rotation int    // 0 = current log, 1-5 = rotated
+	Size     int64  // Bytes
+	Complete bool   // Last entry carries its terminator (empty files are complete)
+	LastLine string // Last non-empty line when incomplete (where the write stopped)
+}
+
+// IntegrityReport is the result of VerifyLogIntegrity.
+type IntegrityReport struct {
+	Path           string          // Current log path checked
+	Files          []FileIntegrity // Existing files, current first, then .1, .2, ...
+	Gaps           []string        // Missing rotations older ones exist beyond
+	StagedRotation string          // file.log.rotating left by an interrupted rotation ("" = none)
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Tail Inspection
+// ────────────────────────────────────────────────────────────────
+
+// lastEntryComplete reports whether the file ends with a whole entry.
+//
+// Only the tail is read (one maximum-length line). Returns the last
+// non-empty line so an incomplete file shows where the write stopped.
+func lastEntryComplete(path string, size int64) (bool, string, error) {
+	if size == 0 {
+		return true, "", nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return false, "", err
+	}
+	defer file.Close()
+
+	offset := max(size-maxLineBytes, 0)
+	tail := make([]byte, size-offset)
+	if _, err := file.ReadAt(tail, offset); err != nil && err != io.EOF {
+		return false, "", err
+	}
+
+	endsWithNewline := bytes.HasSuffix(tail, []byte("\n"))
+	trimmed := bytes.TrimRight(tail, "\r\n")
+	if len(trimmed) == 0 { // Only blank lines
+		return true, "", nil
+	}
+	last := trimmed[bytes.LastIndexByte(trimmed, '\n')+1:]
+	shown := string(last[:min(len(last), integrityLineChars)])
+
+	switch {
+	case !endsWithNewline: // Cut off mid-line
+		return false, shown, nil
+	case string(bytes.TrimSpace(last)) == entrySeparator: // Text entry terminator
+		return true, "", nil
+	case bytes.HasPrefix(last, []byte("{")) && json.Valid(last): // Whole JSON entry
+		return true, "", nil
+	case bytes.HasPrefix(last, []byte(encryptedMagic)) && frameComplete(string(last)): // Whole sealed entry
+		return true, "", nil
+	case bytes.HasPrefix(last, []byte(logFormatPrefix)): // Format header only - no entries yet
+		return true, "", nil
+	}
+	return false, shown, nil
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// OK reports whether the chain has no gaps, no interrupted rotation, and no cut-off entries.
+func (r IntegrityReport) OK() bool {
+	return len(r.Problems()) == 0
+}
+
+// Problems lists every finding as one line each (empty when OK).
+func (r IntegrityReport) Problems() []string {
+	var problems []string
+	if r.StagedRotation != "" {
+		problems = append(problems, fmt.Sprintf("interrupted rotation: %s (finished on the next write)", r.StagedRotation))
+	}
+	for _, gap := range r.Gaps {
+		problems = append(problems, fmt.Sprintf("missing rotation: %s", gap))
+	}
+	for _, file := range r.Files {
+		if !file.Complete {
+			problems = append(problems, fmt.Sprintf("incomplete last entry: %s (ends %q)", file.Path, file.LastLine))
+		}
+	}
+	return problems
+}
+
+// VerifyLogIntegrity checks a log file and its rotations for gaps and cut-off entries.
+//
+// What It Does:
+// Stats the current log and file.log.1 through file.log.5. Each existing file
+// gets a terminator check on its last entry. A rotation that is missing while
+// an older one exists is reported as a gap. A leftover file.log.rotating is
+// reported as an interrupted rotation.
+//
+// Returns:
+//   IntegrityReport: Findings (report.OK() when there are none)
+//   error: Stat or read failures other than "does not exist", or no log at all
+//
+// Example usage:
+//
+//	report, err := logging.VerifyLogIntegrity(path)
+//	for _, problem := range report.Problems() {
+//	    fmt.Println(problem)
+//	}
+func VerifyLogIntegrity(path string) (IntegrityReport, error) {
+	report := IntegrityReport{Path: path}
+
+	if _, err := os.Stat(rotationStagingPath(path)); err == nil {
+		report.StagedRotation = rotationStagingPath(path)
+	}
+
+	var missing []string
+	for rotation := 0; rotation <= maxLogRotations; rotation++ {
+		filePath := path
+		if rotation > 0 {
+			filePath = rotationPath(path, rotation)
+		}
+		info, err := os.Stat(filePath)
+		if os.IsNotExist(err) {
+			if rotation > 0 {
+				missing = append(missing, filePath)
+			}
+			continue
+		}
+		if err != nil {
+			return report, err
+		}
+
+		complete, lastLine, err := lastEntryComplete(filePath, info.Size())
+		if err != nil {
+			return report, err
+		}
+		report.Files = append(report.Files, FileIntegrity{
+			Path:     filePath,
+			Rotation: rotation,
+			Size:     info.Size(),
+			Complete: complete,
+			LastLine: lastLine,
+		})
+		if rotation > 0 { // An older rotation exists - every missing one before it is a gap
+			report.Gaps = append(report.Gaps, missing...)
+			missing = nil
+		}
+	}
+
+	if len(report.Files) == 0 && report.StagedRotation == "" {
+		return report, fmt.Errorf("no log file or rotations at %s", path)
+	}
+	return report, nil
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
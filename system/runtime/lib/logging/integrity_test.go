@@ -0,0 +1,64 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Log Integrity Tests
+//
+// Purpose: Prove VerifyLogIntegrity accepts whole text and JSON entries,
+//          reports an entry cut off mid-write with where it stopped, and
+//          errors when there is no log at all.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestVerifyLogIntegrity(t *testing.T) {
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "verify.log")
+	write := func(path, content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := VerifyLogIntegrity(logPath); err == nil {
+		t.Error("no log at all: want error")
+	}
+
+	write(logPath, "[2026-10-16 09:30:00.000000000] SUCCESS verify verify-1-1 #1\n  EVENT: done\n---\n\n")
+	write(rotationPath(logPath, 1), "{\"level\":\"ERROR\",\"event\":\"boom\"}\n")
+	if report, err := VerifyLogIntegrity(logPath); err != nil || !report.OK() || len(report.Files) != 2 {
+		t.Errorf("whole entries: %+v, %v", report, err)
+	}
+
+	write(rotationPath(logPath, 1), "{\"level\":\"ERROR\",\"event\":\"boom\"}\n{\"level\":\"ERR")
+	write(logPath, "[2026-10-16 09:30:00.000000000] SUCCESS verify verify-1-1 #1\n  EVENT: done\n")
+	report, err := VerifyLogIntegrity(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"incomplete last entry: " + logPath + ` (ends "  EVENT: done")`,
+		"incomplete last entry: " + rotationPath(logPath, 1) + ` (ends "{\"level\":\"ERR")`,
+	}
+	if problems := report.Problems(); len(problems) != 2 || problems[0] != want[0] || problems[1] != want[1] {
+		t.Errorf("problems = %q\nwant %q", problems, want)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,208 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Level-Split Files - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "Examine yourselves... prove your own selves." - 2 Corinthians 13:5 (KJV)
+// Principle: What needs examining first should be easy to find.
+// Anchor: The failures are the part of the narrative read most - keep a copy where they stand alone.
+//
+// CPI-SI Identity
+//
+// Component Type: Secondary output module within Rails infrastructure
+// Role: Copy entries at chosen levels into a per-component side file
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.2.0
+// Last Modified: 2026-10-16 - Level file write and rotation failures counted for SelfDiagnostics
+//
+// Purpose & Function
+//
+// Purpose: Triage mostly wants FAILURE and ERROR entries, but they sit inside a
+// 10 MB mixed log and grep loses their multi-line context blocks. With
+// [routing.level_files] those levels ALSO append to component.<suffix>.log
+// (validate.errors.log next to validate.log) in the identical entry format, so
+// ReadLogFile reads the side file unchanged.
+//
+// Core Design: The split copy is written after the primary write and never
+// affects it - a failing side file warns once to stderr and is otherwise
+// ignored. Side files rotate like the main log (.1 through .5, crash-safe)
+// at [rotation] level_file_max_size_mb. Readers that scan whole log
+// directories call IsLevelFile to skip side files (and their rotations), so
+// an entry is never counted twice.
+//
+// Blocking Status
+//
+// Non-blocking: Side file failures warn once and continue; the primary log is untouched.
+//
+// Usage & Integration
+//
+// Usage (readers that scan directories):
+//
+//	if logging.IsLevelFile(path) {
+//	    continue // Copies of entries already in the component's main log
+//	}
+//
+// Public API:
+//   IsLevelFile(path string) bool - Whether path is a level-split file or one of its rotations
+//
+// Internal API:
+//   levelFileSuffix(level) - Configured suffix for a level ("" = not split)
+//   levelFilePath(level) - component.<suffix>.log beside the Logger's LogFile (Logger method)
+//   levelFileMaxBytes() - Side file rotation threshold
+//   appendLevelFile(level, formatted) - Secondary append (Logger method, called by appendEntry)
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: fmt, os, path/filepath, strings
+//   Package Files: config.go (Config.Routing.LevelFiles, Config.Rotation.LevelFileMaxSizeMB),
+//                  writing.go (appendFile, rotateLogOver, fsyncOnError, maxLogSizeBytes),
+//                  logger.go (logFileExtension), selfhealth.go (noteWriteFailure, noteRotationError)
+//
+// Dependents (What Uses This):
+//   Internal: writing.go (appendEntry), correlation.go (readLogTree skips side files)
+//   External: hooks/lib/session (collectHealthStats), system/runtime/cmd/debugger
+//
+// Health Scoring
+//
+// Side file writes: 0 (secondary copy - the primary write carries the score)
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"fmt"           // Stderr warning
+	"os"            // Stderr
+	"path/filepath" // Base names for side file detection
+	"strings"       // Suffix matching, level normalization
+)
+
+// Constants
+
+const bytesPerMB = 1024 * 1024 // level_file_max_size_mb → bytes
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Side File Naming
+// ────────────────────────────────────────────────────────────────
+
+// levelFileSuffix returns the side file suffix configured for level ("" = not split).
+//
+// Level keys match case-insensitively ("error" and "ERROR" both work in TOML).
+func levelFileSuffix(level string) string {
+	LoadConfig()
+	for configured, suffix := range Config.Routing.LevelFiles {
+		if strings.EqualFold(configured, level) {
+			return strings.Trim(suffix, ".")
+		}
+	}
+	return ""
+}
+
+// levelFilePath returns the side file for level beside the Logger's log ("" = not split).
+func (l *Logger) levelFilePath(level string) string {
+	suffix := levelFileSuffix(level)
+	if suffix == "" {
+		return ""
+	}
+	return strings.TrimSuffix(l.LogFile, logFileExtension) + "." + suffix + logFileExtension
+}
+
+// levelFileMaxBytes returns the side file rotation threshold ([rotation] level_file_max_size_mb).
+func levelFileMaxBytes() int64 {
+	if ConfigLoaded && Config.Rotation.LevelFileMaxSizeMB > 0 {
+		return int64(Config.Rotation.LevelFileMaxSizeMB) * bytesPerMB
+	}
+	return maxLogSizeBytes
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Secondary Append
+// ────────────────────────────────────────────────────────────────
+
+// appendLevelFile copies a formatted entry to its level's side file, if any.
+//
+// Runs after the primary write whatever its outcome. Failure warns to stderr
+// once per Logger and is otherwise ignored - the main log is the record.
+func (l *Logger) appendLevelFile(level, formatted string) {
+	path := l.levelFilePath(level)
+	if path == "" {
+		return
+	}
+
+	l.noteRotationError(l.rotateLogOver(path, levelFileMaxBytes()))
+	if err := appendFile(path, formatted, fsyncOnError(level)); err != nil {
+		l.noteWriteFailure()
+		if !l.levelFileWarned {
+			fmt.Fprintf(os.Stderr, "WARNING: Failed to write level file %s: %v (main log unaffected)\n", path, err)
+			l.levelFileWarned = true
+		}
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// IsLevelFile reports whether path is a level-split side file or one of its rotations.
+//
+// What It Does:
+// Drops ".log" and anything after it (rotation ".N", ".rotating") and any date
+// rotation suffix (".2025-11-10"), then checks the name against every suffix
+// in [routing.level_files]. Readers that scan
+// log directories skip these - each entry in them is also in the main log.
+//
+// Example usage:
+//
+//	files, _ := filepath.Glob(filepath.Join(dir, "*.log"))
+//	for _, file := range files {
+//	    if logging.IsLevelFile(file) {
+//	        continue
+//	    }
+//	    entries, _ := logging.ReadLogFile(file)
+//	}
+func IsLevelFile(path string) bool {
+	name := filepath.Base(path)
+	i := strings.LastIndex(name, logFileExtension)
+	if i < 0 {
+		return false
+	}
+	name = trimDatedSuffix(name[:i])
+
+	LoadConfig()
+	for _, suffix := range Config.Routing.LevelFiles {
+		if suffix = strings.Trim(suffix, "."); suffix != "" && strings.HasSuffix(name, "."+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
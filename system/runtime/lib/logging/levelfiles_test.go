@@ -0,0 +1,100 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Level-Split File Tests
+//
+// Purpose: Prove [routing.level_files] copies only the configured levels to
+//          component.<suffix>.log in a format ReadLogFile reads unchanged,
+//          that a broken side file never costs the main log an entry, and
+//          that directory readers skip side files so nothing counts twice.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestLevelFilesCopyConfiguredLevels(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Routing.LevelFiles = map[string]string{"FAILURE": "errors", "error": "errors"}
+	})
+	logger := newTestLogger(t, "split-test")
+
+	logger.Success("routine", 1, nil)
+	logger.Failure("broken", "disk full", -5, nil)
+	logger.Error("unexpected", os.ErrInvalid, -10)
+
+	sidePath := strings.TrimSuffix(logger.LogFile, logFileExtension) + ".errors" + logFileExtension
+	side := readEntries(t, sidePath)
+	if len(side) != 2 || side[0].Level != levelFailure || side[1].Level != levelError {
+		t.Fatalf("side file entries = %+v, want FAILURE then ERROR", side)
+	}
+	if side[0].Details["reason"] != "disk full" {
+		t.Errorf("side file lost entry detail: %v", side[0].Details)
+	}
+	if main := readEntries(t, logger.LogFile); len(main) != 3 {
+		t.Errorf("main log has %d entries, want all 3", len(main))
+	}
+
+	if !IsLevelFile(sidePath) || !IsLevelFile(rotationPath(sidePath, 2)) || IsLevelFile(logger.LogFile) {
+		t.Errorf("IsLevelFile: side %v, rotated side %v, main %v",
+			IsLevelFile(sidePath), IsLevelFile(rotationPath(sidePath, 2)), IsLevelFile(logger.LogFile))
+	}
+
+	// Tracing the whole tree sees each entry once
+	streams, err := readLogTree(filepath.Dir(filepath.Dir(logger.LogFile)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	failures := 0
+	for _, stream := range streams {
+		for _, entry := range stream {
+			if entry.Level == levelFailure {
+				failures++
+			}
+		}
+	}
+	if failures != 1 {
+		t.Errorf("readLogTree saw %d FAILURE entries, want 1", failures)
+	}
+}
+
+func TestLevelFileFailureLeavesMainLogIntact(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) { cfg.Routing.LevelFiles = map[string]string{"FAILURE": "errors"} })
+	logger := newTestLogger(t, "split-broken")
+
+	// A directory where the side file should be - every append fails
+	sidePath := strings.TrimSuffix(logger.LogFile, logFileExtension) + ".errors" + logFileExtension
+	if err := os.MkdirAll(sidePath, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	logger.Failure("first", "x", -1, nil)
+	logger.Failure("second", "y", -1, nil)
+
+	if main := readEntries(t, logger.LogFile); len(main) != 2 {
+		t.Errorf("main log has %d entries, want 2", len(main))
+	}
+	if !logger.levelFileWarned {
+		t.Error("side file failure not reported")
+	}
+	if len(logger.spill.entries) != 0 {
+		t.Errorf("side file failure spilled main entries: %q", logger.spill.entries)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
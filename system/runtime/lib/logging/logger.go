@@ -21,13 +21,19 @@
 // Architect: Seanje Lenox-Wise, Nova Dawn
 // Implementation: Seanje Lenox-Wise, Nova Dawn
 // Creation Date: 2025-11-14
-// Version: 1.0.0
-// Last Modified: 2025-11-16 - Foundation refinement (Phase 0-10 alignment)
+// Version: 1.1.0
+// Last Modified: 2026-10-16 - Partial-context levels skip CaptureContext
 //
 // Version History:
 //   0.1.0 (2025-11-14) - Bootstrap implementation - prove concept works
 //   0.2.0 (2025-11-15) - Bootstrap trimmed - remove duplication, extract to API docs
 //   1.0.0 (2025-11-16) - Foundation standard - 4-block alignment, config-driven
+//   1.1.0 (2026-10-16) - Behavior change: partial-context levels (SUCCESS/CHECK by default) no
+//                        longer run CaptureContext. Their USER line comes from the identity
+//                        NewLogger cached, and no shell, environment, sudoers, system metric,
+//                        or Go runtime probe runs for them. Partial entries never wrote those
+//                        sections, so only the probes' cost (and a mid-run user/host change)
+//                        differs. Previously only context_capture.mode = "off" skipped them.
 //
 // Purpose & Function
 //
@@ -41,7 +47,8 @@
 //   - Health Tracking: HOW WELL (Base100 scoring, cumulative health)
 //   - Structured Output: Parseable log entries for debugging analysis
 //   - Temporal Organization: Route to current/daily/weekly/monthly/quarterly/yearly
-//   - Component Routing: Automatic subdirectory routing (commands/scripts/libraries/system)
+//   - Component Routing: Automatic subdirectory routing (commands/scripts/libraries/system) -
+//     [routing] lists first, then the calling package's path (routing.go)
 //
 // Philosophy: Rails are infrastructure, not the work itself. Logging failures never stop component execution - warn to stderr and continue. The component's work is more important than perfect logging. Graceful degradation honors the actual work.
 //
@@ -66,11 +73,14 @@
 //
 //   Initialization (setup):
 //     NewLogger(component string) *Logger           - Create logger with component routing
+//     NewLoggerWithRouting(component, subdir string) *Logger - Create logger in a forced subdirectory
 //     (*Logger).DeclareHealthTotal(total int)       - Set denominator for health normalization
 //     (*Logger).GetHealth() int                     - Get current normalized health percentage
+//     (*Logger).Flush()                             - Write pending coalesced-repeat summary
+//     (*Logger).SetSampling(level string, rate int) - Write 1 of every rate entries of level
 //
 //   Core Logging (during execution):
-//     (*Logger).Operation(command string, healthImpact int, args ...string)
+//     (*Logger).Operation(command string, healthImpact int, args ...string) string - Returns the operation ID
 //     (*Logger).Success(event string, healthImpact int, details map[string]any)
 //     (*Logger).Failure(event string, reason string, healthImpact int, details map[string]any)
 //     (*Logger).Error(event string, err error, healthImpact int)
@@ -87,17 +97,46 @@
 //
 //   Command Orchestration (automatic lifecycle logging):
 //     (*Logger).LogCommand(command string, args []string) error
+//     (*Logger).LogCommandContext(ctx context.Context, command string, args []string) error
+//
+//   Audit Trail (security-relevant operations):
+//     (*Logger).Audit(action, target, outcome string, details map[string]any) - Entry + hash-chained audit.log line
+//     (*Logger).AuditedAction(action, target string, details map[string]any, run func() error) error - Run, then audit
+//
+//   Run Completion (end of execution):
+//     (*Logger).Finalize() RunSummary               - Write "run-summary" entry once, suggest exit code
+//     (*Logger).HealthAudit() HealthAudit           - Deltas by level vs DeclareHealthTotal (strict_health lint)
+//     (*Logger).SelfDiagnostics() SelfReport        - The logger's own write failures, rotation errors, fallbacks
 //
 //   Package-Level Functions:
 //     LoadConfig()                                  - Ensure configuration loaded (idempotent)
-//     ReadLogFile(path string) ([]LogEntry, error)  - Parse log file into entry slice
+//     ReadLogFile(path string) ([]LogEntry, error)  - Parse log file into entry slice (opens sealed entries)
+//     ReadLogFileReport(path string) ([]LogEntry, ParseReport, error) - Parse with damage by line (truncated tail, spliced writes)
+//     ReencryptLog(path string) error               - Seal a file's plaintext entries ([privacy])
+//     SanitizeEntries(entries []LogEntry, rules SanitizeRules) []LogEntry - Copies safe to share (DefaultShareRules)
+//     ExportLog(path string, w io.Writer, rules SanitizeRules) error - Read, sanitize, write (file untouched)
+//     DetectLogVersion(path string) (int, error)    - Format version from the file header (none = 1)
+//     MigrateLogFile(path string, toVersion int) error - Rewrite a file as another format version
+//     FormatHealth(normalized int, style HealthStyle) string - Render health ([display.health] via DefaultHealthStyle)
+//     MergeEntries(streams ...[]LogEntry) []LogEntry - Interleave logs by (timestamp, context ID, sequence)
+//     LogsDir() string                              - Root every component's log is routed under
+//     DegradedLoggingHosts(logsDir string, window time.Duration) (map[string]SelfReport, error) - Hosts where logging itself degraded
+//     TraceContext(logsDir, contextID string) ([]LogEntry, error) - Entries of one invocation and every child it started
+//     FailureDigest(logsDir string, window time.Duration) (*Digest, error) - Recent FAILURE/ERROR signatures and unhealthy components per component
+//     SessionContextID(sessionID string) string     - Correlation ID seeded by the session-start hook
+//     ExtractSpans(entries []LogEntry) []Span       - OPERATION → SUCCESS/FAILURE pairs by operation_id
+//     WriteOTLPJSON(spans []Span, w io.Writer) error - OTLP/JSON trace file for Jaeger/Tempo
+//     AuditLogPath() string                         - Shared audit trail (logs/system/audit.log)
+//     ArchiveAuditLog() (string, error)             - Archive the audit trail, chain continued
+//     VerifyAuditChain(path string) error           - Check the audit trail's hash chain
+//     IsAuditLog(path string) bool                  - Whether a path is the audit trail or an archive
 //
 // Dependencies
 //
 // Dependencies (What This Needs):
-//   Standard Library: fmt, os, os/exec, path/filepath, runtime, slices, strings, time
-//   Package Files: config.go (configuration), health.go (health scoring), context.go (context capture), entry.go (entry construction and formatting), writing.go (file writing and rotation), parsing.go (log file reading)
-//   Note: Rails package-level is stdlib-only - config.go handles external TOML dependency
+//   Standard Library: context, fmt, os, os/exec, path/filepath, runtime, slices, strings, time
+//   Package Files: config.go (configuration), health.go (health scoring), context.go (context capture), entry.go (entry construction and formatting), writing.go (file writing and rotation), parsing.go (log file reading), summary.go (run summary and exit codes), sampling.go (entry sampling), correlation.go (parent context propagation), clock.go (time source), levelfiles.go (level-split side files), spans.go (span export), version.go (format version header), digest.go (failure digest), routing.go (component routing), retention.go (age rotation and retention), selfhealth.go (logger self-monitoring), human.go (human entry rendering), details.go (canonical detail keys and builders), filehandle.go (persistent log handle), tags.go (entry tags), query.go (entry queries), audit.go (audit trail)
+//   Note: Rails package-level is stdlib-only - config.go handles external TOML dependency, human.go the display rail
 //
 // Dependents (What Uses This):
 //   Commands: system/runtime/cmd/* (all commands)
@@ -106,7 +145,7 @@
 //
 // Integration Points:
 //   - Rails mechanism: Every component creates its own logger (never passed as parameter)
-//   - Component routing: Automatic subdirectory assignment based on component name
+//   - Component routing: Automatic subdirectory assignment from [routing] lists or the caller's package
 //   - Temporal routing: Logs routed to current/daily/weekly/monthly/quarterly/yearly
 //   - Health aggregation: Individual component health rolls up to system health
 //
@@ -160,12 +199,13 @@ import (
 	//--- Standard Library ---
 	// Foundation packages providing Go's built-in capabilities.
 
+	"context"       // Cancellable command execution (LogCommandContext)
+	"crypto/cipher" // AEAD for [privacy] sealed writes (encryption.go)
 	"fmt"           // Formatted output for log entries and user display
 	"os"            // File operations, environment variables, process info
 	"os/exec"       // System command execution for context capture (df, etc.)
 	"path/filepath" // Cross-platform path manipulation for log file routing
 	"runtime"       // Go runtime introspection (stack traces, goroutines)
-	"slices"        // Efficient slice operations (Contains, sorting, searching)
 	"strings"       // String processing for output formatting and parsing
 	"time"          // Timestamps and duration tracking
 )
@@ -250,6 +290,9 @@ const (
 	eventSnapshot  = "System state snapshot: %s" // Snapshot event format
 	eventCmdFailed = "Command failed: %s"        // Command failure event format
 	eventCmdSuccess = "Command completed: %s"    // Command success event format
+	eventRepeated  = "Previous entry repeated %d times" // Coalesced duplicate summary event format
+	eventSpillFlushed = "%d entries delayed due to write failure: %s" // Spillover gap marker event format
+	checkLogWritable = "log path writable"                    // Startup writability check subject
 
 	//--- Health Impact Values ---
 	// Default health deltas for automated operations.
@@ -268,6 +311,27 @@ const (
 	// These serve as fallback defaults when config unavailable.
 
 	stackBufferSize = 4096 // Buffer size for stack trace capture
+
+	//--- Duplicate Coalescing ---
+	// Window for suppressing identical consecutive entries.
+	//
+	// NOTE: Overridden by [behavior] coalesce_window_seconds in logging.toml.
+
+	coalesceWindowDefault = 10 * time.Second // Repeats within this window collapse into one summary
+
+	//--- Write Failure Spillover ---
+	// Formatted entries held in memory while the log file cannot be written.
+	//
+	// NOTE: Overridden by [behavior] spillover_entries in logging.toml.
+
+	spilloverEntriesDefault = 200 // Newest entries kept; older ones are dropped and counted
+
+	//--- Persistent Handle ---
+	// How long a held log handle stays open without a write.
+	//
+	// NOTE: Overridden by [behavior] keep_file_open_idle_seconds in logging.toml.
+
+	keepFileOpenIdleDefault = 5 * time.Second // Idle close; the next entry reopens
 )
 
 // ────────────────────────────────────────────────────────────────
@@ -287,15 +351,33 @@ const (
 // Primary type for library usage. Tracks health across operations, routes to
 // correct log file, provides public API for all logging operations.
 type Logger struct {
-	Component           string // Component name for identification and routing
-	ContextID           string // Unique execution context ID (component-pid-timestamp)
-	LogFile             string // Absolute log file path (routed by component type)
-	SessionHealth       int    // Cumulative health (raw sum of deltas)
-	TotalPossibleHealth int    // Expected total for normalization (set via DeclareHealthTotal)
-	NormalizedHealth    int    // Health percentage (-100 to +100)
-	username            string // Pre-computed username (static per process)
-	hostname            string // Pre-computed hostname (static per process)
-	pid                 int    // Pre-computed process ID (static per process)
+	Component           string         // Component name for identification and routing
+	ContextID           string         // Unique execution context ID (component-pid-timestamp)
+	ParentContextID     string         // Caller's ContextID from CPI_SI_PARENT_CONTEXT ("" = top level)
+	LogFile             string         // Absolute log file path (routed by component type)
+	SessionHealth       int            // Cumulative health (raw sum of deltas)
+	TotalPossibleHealth int            // Expected total for normalization (set via DeclareHealthTotal)
+	NormalizedHealth    int            // Health percentage (-100 to +100)
+	username            string         // Pre-computed username (static per process)
+	hostname            string         // Pre-computed hostname (static per process)
+	pid                 int            // Pre-computed process ID (static per process)
+	repeats             repeatState    // Pending duplicate burst awaiting summary (coalescing)
+	created             time.Time      // Logger creation (run duration for Finalize)
+	levelCounts         map[string]int // Entries logged per level (run summary)
+	summary             *RunSummary    // Cached Finalize result (nil until finalized)
+	sequence            uint64         // Last entry sequence number assigned (0 = none yet)
+	spill               spillState     // Entries awaiting a writable log file (write failure spillover)
+	sampling            samplingState  // Per-level sampling positions and adaptive bursts
+	levelFileWarned     bool           // Level-split write failure already reported (warn once)
+	operations          uint64         // Operations started (operation ID suffix)
+	logKey              cipher.AEAD    // [privacy] key, loaded on the first sealed write (nil = not yet)
+	sealWarned          bool           // Unusable key already reported (warn once)
+	healthByLevel       healthTallies  // Positive/negative deltas per level (HealthAudit)
+	self                selfCounters   // Own degradation counters (SelfDiagnostics)
+	handle              *logHandle     // Log file held across writes ([behavior] keep_file_open, filehandle.go)
+	tags                []string       // Tags for the call in progress (TaggedLogger, tags.go)
+	auditWarned         bool           // Audit trail write failure already reported (warn once, audit.go)
+	rotating            bool           // Rotation in progress - audited removals must not rotate again (writing.go)
 }
 
 
@@ -326,6 +408,23 @@ var logLevelFullContext = map[string]bool{
 	levelDebug:     true,  // Full context - debug needs complete state
 }
 
+// Log level ordering for behavior.min_level - higher ranks are more severe.
+var levelRank = map[string]int{
+	levelDebug:     0, // Traces
+	levelCheck:     1, // Lightweight verification
+	levelContext:   1, // Snapshots
+	levelOperation: 2, // Normal activity
+	levelSuccess:   2, // Normal activity
+	levelFailure:   3, // Expected failures
+	levelError:     4, // Unexpected errors
+}
+
+// Log level coalescing policy - which levels may collapse repeated entries.
+// Levels not listed default to coalescing when [behavior] coalesce_repeats is on.
+var logLevelCoalesce = map[string]bool{
+	levelError: false, // Never coalesce - every unexpected error deserves its own stack trace
+}
+
 
 // ============================================================================
 // END SETUP
@@ -342,7 +441,7 @@ var logLevelFullContext = map[string]bool{
 // ────────────────────────────────────────────────────────────────
 // Maps package structure showing how extracted files work together.
 //
-// Package Structure (7 files total):
+// Package Structure (13 files total):
 //
 //   logger.go (This file - Orchestrator)
 //   ├── Public APIs (exported interface for consumers)
@@ -351,13 +450,16 @@ var logLevelFullContext = map[string]bool{
 //
 //   config.go (Configuration management)
 //   ├── LoadConfig() - TOML loading with graceful fallback
+//   ├── applyEnvOverrides() - CPI_SI_LOG_* overrides (env > file > defaults)
+//   ├── Source() / Describe() - Per-key provenance ("min_level=ERROR (from env)")
 //   ├── useDefaultConfig() - Hardcoded defaults
-//   └── 12 configuration types (LoggingConfig, PathsConfig, etc.)
+//   └── 13 configuration types (LoggingConfig, PathsConfig, etc.)
 //
 //   health.go (Health scoring system)
 //   ├── clampHealth() - Enforce -100 to +100 range
 //   ├── getHealthIndicator() - Emoji for score (💚/❤️/☠️)
-//   ├── getHealthBar() - ASCII progress bar visualization
+//   ├── getHealthBar() - Progress bar in the configured characters
+//   ├── FormatHealth() / DefaultHealthStyle() / HealthBand() - Public health rendering
 //   ├── calculateNormalizedHealth() - Convert raw to percentage
 //   └── updateHealth() - Apply delta and recalculate
 //
@@ -367,8 +469,17 @@ var logLevelFullContext = map[string]bool{
 //   ├── captureEnvState() - Environment variables
 //   ├── captureSudoersContext() - Sudo configuration
 //   ├── captureSystemMetrics() - CPU, memory, disk
+//   ├── isContainer() - Container detection (cached per process)
 //   └── 4 types (ShellContext, SudoersContext, SystemMetrics, SystemContext)
 //
+//   context_unix.go / context_windows.go (Platform primitives)
+//   ├── memoryUsage() - /proc/meminfo or GlobalMemoryStatusEx
+//   ├── isSharingViolation() - Rotation retry predicate (always false on Unix)
+//   └── sudoersSupported - false on Windows (sudoers capture skipped)
+//
+//   context_statfs.go / context_statfs_other.go (Platform disk usage)
+//   └── diskUsage() - statfs(2) on Unix, GetDiskFreeSpaceExW in context_windows.go, "unknown" elsewhere
+//
 //   entry.go (Entry construction and formatting)
 //   ├── createBaseEntry() - Common fields population
 //   ├── formatEntry() - LogEntry → formatted string
@@ -379,12 +490,63 @@ var logLevelFullContext = map[string]bool{
 //   └── 3 types (Interactions, LogEntry, Metadata)
 //
 //   writing.go (File writing and rotation)
-//   ├── rotateLogIfNeeded() - Size-based rotation (.1→.2→.3→.4→.5)
-//   └── writeEntry() - Atomic append with rotation check
+//   ├── rotateLogIfNeeded() - Size- and age-based rotation (.1→.2→.3→.4→.5 or date-named)
+//   ├── retryWithBackoff() - Rename/remove retry on sharing violations
+//   ├── writeEntry() - Duplicate coalescing, then append
+//   ├── flushRepeats() - "Repeated N times" summary emission
+//   └── appendEntry() - Atomic append with rotation check
 //
 //   parsing.go (Log file reading)
 //   └── ReadLogFile() - Parse log entries back into structures
 //
+//   sampling.go (Entry sampling)
+//   ├── SetSampling() - Per-Logger rate override
+//   ├── sampleEntry() - 1-of-N decision, sampled/skipped_health annotation
+//   └── trackBurst() - Adaptive rate raise/restore with CONTEXT announcements
+//
+//   clock.go (Time source)
+//   ├── SetClock() / ResetClock() - Replace or restore the package clock
+//   └── now() - Timestamps, context IDs, durations
+//
+//   levelfiles.go (Level-split side files)
+//   ├── appendLevelFile() - FAILURE/ERROR copy to component.errors.log ([routing.level_files])
+//   └── IsLevelFile() - Side file detection for directory readers
+//
+//   retention.go (Age rotation and retention)
+//   ├── logExpired() - First entry older than [rotation] max_age_days
+//   ├── datedRotationPath() - component.2025-11-10.log naming (rotation_naming = "date")
+//   └── enforceRetention() - Oldest rotations removed past [retention] max_total_size_mb
+//
+//   audit.go (Audit trail)
+//   ├── Audit() / AuditedAction() - Normal entry + hash-chained line in logs/system/audit.log
+//   ├── removeRotation() - The only way rotation and retention delete a file (audited)
+//   ├── ArchiveAuditLog() - Explicit archive (the trail is never rotated)
+//   ├── VerifyAuditChain() - prev hash check, line by line
+//   └── IsAuditLog() - Audit file detection for directory readers
+//
+//   spans.go (Span export)
+//   ├── ExtractSpans() - Pair OPERATION entries with closing entries by operation_id
+//   └── WriteOTLPJSON() - OTLP/JSON trace format
+//
+//   summary.go (End-of-run summary)
+//   ├── Finalize() - "run-summary" entry, cached RunSummary
+//   ├── suggestedExitCode() - Final health → exit code ([exit_codes])
+//   └── countEntry() - Per-level entry counts
+//
+//   selfhealth.go (Logger self-monitoring)
+//   ├── SelfDiagnostics() - Atomic counters of the logger's own degradation
+//   ├── writeSelfHealth() - Direct append to system/logging-health.log (no pipeline)
+//   └── DegradedLoggingHosts() - Degraded reports summed per host (status command)
+//
+//   human.go (Human entry rendering)
+//   ├── FormatEntryHuman() - Header, event, allow-listed details (display rail colors)
+//   └── FormatEntriesHuman() - Entries with day-change separators
+//
+//   details.go (Canonical detail keys)
+//   ├── Detail* constants - One key per common concept (file, duration, exit_code, ...)
+//   ├── FileDetail() / DurationDetail() / ExitDetail() / CountDetail() / ... - Fragments under those keys
+//   └── MergeDetails() - Combine fragments (Extract* readers live in parsing.go)
+//
 // Baton Flow (Execution Paths):
 //
 //   Logger Creation Flow:
@@ -392,7 +554,9 @@ var logLevelFullContext = map[string]bool{
 //       ↓
 //     LoadConfig() [config.go - tripwire pattern]
 //       ↓
-//     determineLogSubdirectory(component) [logger.go - routing]
+//     callerPackage(1) [routing.go - who asked]
+//       ↓
+//     determineLogSubdirectory(component, caller) [routing.go - config, caller, default]
 //       ↓
 //     Return *Logger with routed log file path
 //
@@ -400,10 +564,13 @@ var logLevelFullContext = map[string]bool{
 //     Operation/Success/Failure/Error/Check/Debug [logger.go - public APIs]
 //       ↓
 //     logEntry(level, event, healthImpact, details) [logger.go - orchestration]
-//       ├─→ CaptureContext() [context.go - WHO/WHERE/WHEN]
-//       ├─→ updateHealth(delta) [health.go - score calculation]
+//       ├─→ updateHealth(delta) [health.go - applied even when sampled out]
+//       ├─→ sampleEntry(level) [sampling.go - skip 1-of-N]
+//       ├─→ levelEnabled(level) [logger.go - behavior.min_level]
+//       ├─→ captureFor(level) [logger.go → context.go - WHO/WHERE/WHEN, or identity only]
 //       ├─→ createBaseEntry(context, healthImpact) [entry.go - structure building]
 //       └─→ writeEntry(entry) [writing.go - disk persistence]
+//             ├─→ suppressRepeat(entry) [writing.go - duplicate coalescing]
 //             ├─→ rotateLogIfNeeded(logPath) [writing.go - rotation check]
 //             └─→ formatEntry(entry) [entry.go - text formatting]
 //       ↓
@@ -425,8 +592,8 @@ var logLevelFullContext = map[string]bool{
 //     Return []LogEntry structures
 //
 // API Surface:
-//   - 7 files (logger.go + 6 extracted)
-//   - 14 public APIs (exported from logger.go)
+//   - 15 files (logger.go + 14 extracted)
+//   - 14 public APIs (exported from logger.go) + Finalize (summary.go) + SetSampling (sampling.go) + SetClock/ResetClock (clock.go) + ExtractSpans/WriteOTLPJSON (spans.go)
 //   - 30+ internal functions (distributed across files)
 //   - Rails pattern (stdlib-only except config.go TOML dependency)
 
@@ -436,31 +603,67 @@ var logLevelFullContext = map[string]bool{
 // Foundation functions used throughout this component. Bottom rungs of
 // the ladder - simple, focused, reusable utilities. Not exported.
 
-// determineLogSubdirectory routes component names to log subdirectories (commands/scripts/libraries/system).
-func determineLogSubdirectory(component string) string {
-	// Ensure config is loaded
-	LoadConfig()
+// determineLogSubdirectory and callerPackage are defined in routing.go (component routing)
 
-	// Commands go to commands/
-	if slices.Contains(Config.Routing.Commands, component) { // Check config routing list
-		return commandsSubdir                                // Use constant from SETUP
-	}
+// getCurrentUser and getHostname are defined in context.go (system context helpers)
 
-	// Scripts go to scripts/
-	if slices.Contains(Config.Routing.Scripts, component) { // Check config routing list
-		return scriptsSubdir                                 // Use constant from SETUP
+// levelEnabled reports whether level meets behavior.min_level.
+//
+// Unknown levels (and an unset or unknown min_level) are always written -
+// a filter must never hide an entry it does not understand.
+func levelEnabled(level string) bool {
+	LoadConfig()
+	minRank, ok := levelRank[strings.ToUpper(Config.Behavior.MinLevel)]
+	if !ok {
+		return true
 	}
+	rank, known := levelRank[level]
+	return !known || rank >= minRank
+}
 
-	// Library components go to libraries/
-	if slices.Contains(Config.Routing.Libraries, component) { // Check config routing list
-		return librariesSubdir                                 // Use constant from SETUP
+// fullContextFor decides whether an entry of level carries full context.
+//
+// context_capture.mode full/off overrides the per-level policy; partial (the
+// default) uses behavior.log_level_full_context, else the hardcoded map.
+func fullContextFor(level string) bool {
+	LoadConfig()
+	switch strings.ToLower(Config.ContextCapture.Mode) {
+	case ContextFull:
+		return true
+	case ContextOff:
+		return false
 	}
+	if ConfigLoaded && len(Config.Behavior.LogLevelFullContext) > 0 {
+		return Config.Behavior.LogLevelFullContext[level] // Use config map
+	}
+	return logLevelFullContext[level] // Fallback to hardcoded map
+}
 
-	// Everything else goes to system/
-	return systemLogsSubdir                                   // Use constant from SETUP (default routing)
+// goRuntimeFor decides whether an entry of level carries Go runtime stats.
+//
+// CONTEXT (SnapshotState) and DEBUG always do; context_capture.capture_goruntime
+// extends it to every level that captures full context (captureFor only asks
+// for those). ReadMemStats stops the world, hence not by default.
+func goRuntimeFor(level string) bool {
+	return level == levelContext || level == levelDebug || Config.ContextCapture.CaptureGoRuntime
 }
 
-// getCurrentUser and getHostname are defined in context.go (system context helpers)
+// captureFor captures context for an entry of level.
+//
+// Only entries that carry full context get the probes - a partial-context
+// entry records just WHO, so it (like every entry with capture off) uses the
+// pre-computed identity and no shell, environment, sudoers, system metric,
+// or Go runtime probe runs.
+func (l *Logger) captureFor(level string) *SystemContext {
+	if !fullContextFor(level) {
+		return &SystemContext{User: l.username, Host: l.hostname, PID: l.pid}
+	}
+	context := l.CaptureContext()
+	if goRuntimeFor(level) {
+		context.GoRuntime = captureGoRuntime()
+	}
+	return context
+}
 
 // ────────────────────────────────────────────────────────────────
 // Core Operations - Business Logic
@@ -490,23 +693,23 @@ func determineLogSubdirectory(component string) string {
 //
 // Used by: All core logging methods (Operation, Success, Failure, etc.)
 func (l *Logger) logEntry(level string, event string, healthImpact int, details map[string]any) {
-	context := l.CaptureContext()                       // Capture full system state
-	l.updateHealth(healthImpact)                        // Update session health and normalization
+	l.updateHealth(level, healthImpact)                 // Update session health and normalization
+	l.countEntry(level)                                 // Count toward the run summary
+	write, annotate := l.sampleEntry(level, healthImpact)
+	if !write {                                         // Sampled out - health counted, nothing captured or written
+		return
+	}
+	if !levelEnabled(level) {                           // Below min_level - health counted, nothing written
+		return
+	}
+	context := l.captureFor(level)                      // Full capture, or identity only for partial-context levels
 
 	entry := l.createBaseEntry(context, healthImpact)   // Create entry with common fields
 	entry.Level = level                                 // Set level from parameter
 	entry.Event = event                                 // Set event description
-	entry.Details = details                             // Set details (may be nil)
-
-	// Set context mode based on configuration (multi-layer tripwire)
-	var fullContext bool
-	if ConfigLoaded && len(Config.Behavior.LogLevelFullContext) > 0 {
-		fullContext = Config.Behavior.LogLevelFullContext[level] // Use config map
-	} else {
-		fullContext = logLevelFullContext[level] // Fallback to hardcoded map
-	}
+	entry.Details = annotateSampled(details, annotate)  // Set details (may be nil), plus sampled density
 
-	if fullContext {                                    // Check configuration result
+	if fullContextFor(level) {                          // context_capture.mode, then per-level policy
 		entry.Context = context                         // Full context for this level
 	} else {
 		entry.Context = nil                             // Partial context (nil)
@@ -531,24 +734,24 @@ func (l *Logger) logEntry(level string, event string, healthImpact int, details
 //
 // Used by: Metadata-enhanced logging methods (CheckWithMetadata, SuccessWithMetadata, FailureWithMetadata)
 func (l *Logger) logEntryWithMetadata(level string, event string, healthImpact int, details map[string]any, semantic Metadata) {
-	context := l.CaptureContext()                       // Capture full system state
-	l.updateHealth(healthImpact)                        // Update session health and normalization
+	l.updateHealth(level, healthImpact)                 // Update session health and normalization
+	l.countEntry(level)                                 // Count toward the run summary
+	write, annotate := l.sampleEntry(level, healthImpact)
+	if !write {                                         // Sampled out - health counted, nothing captured or written
+		return
+	}
+	if !levelEnabled(level) {                           // Below min_level - health counted, nothing written
+		return
+	}
+	context := l.captureFor(level)                      // Full capture, or identity only for partial-context levels
 
 	entry := l.createBaseEntry(context, healthImpact)   // Create entry with common fields
 	entry.Level = level                                 // Set level from parameter
 	entry.Event = event                                 // Set event description
-	entry.Details = details                             // Set details (may be nil)
+	entry.Details = annotateSampled(details, annotate)  // Set details (may be nil), plus sampled density
 	entry.Semantic = &semantic                          // Set semantic metadata (pointer for optional field)
 
-	// Set context mode based on configuration (multi-layer tripwire)
-	var fullContext bool
-	if ConfigLoaded && len(Config.Behavior.LogLevelFullContext) > 0 {
-		fullContext = Config.Behavior.LogLevelFullContext[level] // Use config map
-	} else {
-		fullContext = logLevelFullContext[level] // Fallback to hardcoded map
-	}
-
-	if fullContext {                                    // Check configuration result
+	if fullContextFor(level) {                          // context_capture.mode, then per-level policy
 		entry.Context = context                         // Full context for this level
 	} else {
 		entry.Context = nil                             // Partial context (nil)
@@ -568,22 +771,29 @@ func (l *Logger) logEntryWithMetadata(level string, event string, healthImpact i
 //
 // What It Does:
 // Records the start of a major operation with full system context (WHO, WHERE,
-// WHEN). Captures command with arguments for execution tracking.
+// WHEN). Captures command with arguments for execution tracking, and a fresh
+// operation_id (ContextID-op<N>) so the call that ends the operation can echo
+// it and ExtractSpans can rebuild the span (spans.go).
 //
 // Parameters:
 //   command: Operation or command name being started
 //   healthImpact: Health points for starting this operation (typically +5 to +10)
 //   args: Optional arguments to the command/operation
 //
+// Returns:
+//   string: Operation ID - pass as details[OperationIDDetail] to the closing Success/Failure
+//
 // Health Impact:
 //   Configurable: Pass explicit health impact based on operation complexity
 //
 // Example usage:
 //
 //	logger.Operation("validate", +5, "config.toml")
-//	logger.Operation("backup", +10)
 //
-func (l *Logger) Operation(command string, healthImpact int, args ...string) {
+//	opID := logger.Operation("backup", +10)
+//	logger.Success("Backup complete", +20, map[string]any{logging.OperationIDDetail: opID})
+//
+func (l *Logger) Operation(command string, healthImpact int, args ...string) string {
 	// Build full command string using config format with fallback (multi-layer tripwire)
 	fullCommand := command                                          // Default to command only
 	if len(args) > 0 {                                              // Arguments provided
@@ -602,7 +812,11 @@ func (l *Logger) Operation(command string, healthImpact int, args ...string) {
 		eventMsg = fmt.Sprintf(eventOpStart, command)
 	}
 
-	l.logEntry(levelOperation, eventMsg, healthImpact, map[string]any{"command": fullCommand})
+	l.operations++
+	operationID := fmt.Sprintf("%s-op%d", l.ContextID, l.operations)
+
+	l.logEntry(levelOperation, eventMsg, healthImpact, map[string]any{DetailCommand: fullCommand, OperationIDDetail: operationID})
+	return operationID
 }
 
 // Success logs successful completion events with partial context.
@@ -656,7 +870,7 @@ func (l *Logger) Failure(event string, reason string, healthImpact int, details
 	if details == nil {                                             // No details provided
 		details = make(map[string]any)                              // Create empty map
 	}
-	details["reason"] = reason                                      // Add failure reason
+	details[DetailReason] = reason                                  // Add failure reason
 	l.logEntry(levelFailure, event, healthImpact, details)
 }
 
@@ -684,7 +898,7 @@ func (l *Logger) Error(event string, err error, healthImpact int) {
 	stackBuf := make([]byte, stackBufferSize)                      // Allocate stack buffer
 	stackSize := runtime.Stack(stackBuf, false)                    // Capture stack trace
 	l.logEntry(levelError, event, healthImpact,
-		map[string]any{"error": err.Error(), "stack_trace": string(stackBuf[:stackSize])})
+		MergeDetails(ErrorDetail(err), map[string]any{DetailStack: string(stackBuf[:stackSize])}))
 }
 
 // Check logs validation/verification events with partial context.
@@ -729,7 +943,8 @@ func (l *Logger) Check(what string, result bool, healthImpact int, details map[s
 //
 // What It Does:
 // Records a complete system state snapshot at a specific point in time. Captures
-// full context for later comparison or debugging.
+// full context for later comparison or debugging, including Go runtime stats
+// (heap, GC cycles, goroutines) - diff two snapshots to see memory growth.
 //
 // Parameters:
 //   label: Descriptive label for this snapshot (e.g., "before-operation", "after-rollback")
@@ -916,6 +1131,32 @@ func (l *Logger) FailureWithMetadata(event string, reason string, healthImpact i
 //	}
 //
 func (l *Logger) LogCommand(command string, args []string) error {
+	return l.LogCommandContext(context.Background(), command, args)
+}
+
+// LogCommandContext is LogCommand with a context that can cancel the command.
+//
+// What It Does:
+// Same lifecycle logging as LogCommand. The child runs with
+// CPI_SI_PARENT_CONTEXT set to this Logger's ContextID, so a Logger it creates
+// records this invocation as its parent (see TraceContext). A command that
+// never ran or was cancelled logs as a failure with exit code -1.
+//
+// Parameters:
+//   ctx: Cancels (kills) the command when done
+//   command: Command to execute
+//   args: Command arguments
+//
+// Returns:
+//   error: Command execution error (nil if exit code 0)
+//
+// Example usage:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+//	defer cancel()
+//	err := logger.LogCommandContext(ctx, "validate", []string{"./..."})
+//
+func (l *Logger) LogCommandContext(ctx context.Context, command string, args []string) error {
 	// Log operation start using config health impact with fallback (multi-layer tripwire)
 	var opImpact int
 	if ConfigLoaded {
@@ -923,19 +1164,22 @@ func (l *Logger) LogCommand(command string, args []string) error {
 	} else {
 		opImpact = cmdOperationImpact
 	}
-	l.Operation(command, opImpact, args...)
+	operationID := l.Operation(command, opImpact, args...)
 
-	startTime := time.Now()							// Record start time
+	startTime := now()								// Record start time
 
 	// Execute command
-	cmd := exec.Command(command, args...)			// Create command
+	cmd := exec.CommandContext(ctx, command, args...) // Create command
+	cmd.Env = l.childEnvironment()					// Child links its entries to this context
 	output, err := cmd.CombinedOutput()				// Execute and capture output
 
-	duration := time.Since(startTime)				// Calculate duration
+	duration := now().Sub(startTime)				// Calculate duration
 	exitCode := 0									// Default exit code (success)
 	if err != nil {									// Command failed
 		if exitErr, ok := err.(*exec.ExitError); ok {  // Get actual exit code
 			exitCode = exitErr.ExitCode()			// Extract exit code from error
+		} else {
+			exitCode = -1							// Never started (not found, cancelled first)
 		}
 	}
 
@@ -948,12 +1192,15 @@ func (l *Logger) LogCommand(command string, args []string) error {
 	}
 
 	// Log result with execution details
-	details := map[string]any{
-		"command":   cmdString,						// Formatted command
-		"exit_code": exitCode,						// Command exit code
-		"duration":  duration.String(),				// Execution duration
-		"output":    string(output),				// Command output (stdout+stderr)
-	}
+	details := MergeDetails(
+		CommandDetail(cmdString),					// Formatted command
+		ExitDetail(exitCode),						// Command exit code
+		DurationDetail(duration),					// Execution duration (readable and ms)
+		map[string]any{
+			DetailOutput:      string(output),		// Command output (stdout+stderr)
+			OperationIDDetail: operationID,			// Closes the Operation span (spans.go)
+		},
+	)
 
 	if exitCode == 0 {								// Success
 		// Use config values with fallbacks (multi-layer tripwires)
@@ -1017,12 +1264,36 @@ func (l *Logger) LogCommand(command string, args []string) error {
 // are the true orchestration layer - simple initialization and configuration
 // functions that set up the logging system for use.
 
+// LogsDir returns the root every component's log is routed under.
+//
+// Path: ~/.claude/[config.paths.base_dir or fallback]/logs. An absolute
+// base_dir (e.g. CPI_SI_LOG_BASE_DIR=/tmp/ci-logs) is used as is. Readers
+// that walk every log (TraceContext, FailureDigest) start here.
+func LogsDir() string {
+	LoadConfig()
+	home, _ := os.UserHomeDir() // Get user home directory
+
+	// Config base_dir with fallback to constants (multi-layer tripwire)
+	if (ConfigLoaded || configuredFromEnv("paths.base_dir")) && Config.Paths.BaseDir != "" {
+		baseDir := Config.Paths.BaseDir
+		if !filepath.IsAbs(baseDir) {
+			baseDir = filepath.Join(home, claudeBaseDir, baseDir)
+		}
+		return filepath.Join(baseDir, logsSubdir)
+	}
+	return filepath.Join(home, claudeBaseDir, systemSubdir, logsSubdir)
+}
+
 // NewLogger creates a logger instance with routed log file for the specified component.
 //
 // What It Does:
 // Creates and initializes a Logger for the specified component with proper log file
-// routing (commands/, scripts/, libraries/, or system/). Pre-computes correlation
+// routing (commands/, scripts/, libraries/, or system/ - [routing] lists, then
+// the calling package's path, see routing.go). Pre-computes correlation
 // fields (username, hostname, PID) once for efficiency across all log entries.
+// Probes the log path and records the result as a CHECK entry ("log path
+// writable") - an unwritable path also warns on stderr, before any work is lost -
+// then a DEBUG "log routed" entry naming the subdirectory and what chose it.
 //
 // Parameters:
 //   component: Component name for routing and log file naming
@@ -1043,32 +1314,61 @@ func NewLogger(component string) *Logger {
 	// Ensure config is loaded
 	LoadConfig()
 
-	home, _ := os.UserHomeDir() // Get user home directory
-
-	// Determine subdirectory based on component type
-	subdirectory := determineLogSubdirectory(component) // Route to appropriate subdirectory
+	// Determine subdirectory: config lists, then the caller's package (routing.go)
+	caller := callerPackage(1)
+	subdirectory, mechanism := determineLogSubdirectory(component, caller)
+	return newRoutedLogger(component, subdirectory, mechanism, caller)
+}
 
-	// Build log file path using config with fallback to constants (multi-layer tripwire)
-	// Path: ~/.claude/[config.paths.base_dir or fallback]/logs/[subdirectory]/[component].log
-	var logFile string
-	if ConfigLoaded && Config.Paths.BaseDir != "" {
-		// Use config base_dir + /logs
-		logFile = filepath.Join(home, claudeBaseDir, Config.Paths.BaseDir, logsSubdir, subdirectory, component+logFileExtension)
-	} else {
-		// Fallback to hardcoded constants
-		logFile = filepath.Join(home, claudeBaseDir, systemSubdir, logsSubdir, subdirectory, component+logFileExtension)
+// NewLoggerWithRouting creates a logger whose log lives in subdir regardless of routing.
+//
+// What It Does:
+// Same as NewLogger, but skips config lists and caller detection - for the
+// rare caller whose package says the wrong thing about it (a library creating
+// a command's logger). subdir must be a relative path inside the log root;
+// anything else (absolute, "..") falls back to system/.
+//
+// Parameters:
+//   component: Component name for the log file name
+//   subdir: Subdirectory under LogsDir() ("commands", "scripts", "libraries", "system", ...)
+//
+// Returns:
+//   *Logger: Initialized logger ready for logging operations
+//
+// Example usage:
+//
+//	logger := logging.NewLoggerWithRouting("migrate", "scripts")
+func NewLoggerWithRouting(component, subdir string) *Logger {
+	LoadConfig()
+	caller := callerPackage(1)
+	if subdir == "" || !filepath.IsLocal(subdir) {
+		return newRoutedLogger(component, systemLogsSubdir, routeDefault, caller)
 	}
+	return newRoutedLogger(component, filepath.Clean(subdir), routeExplicit, caller)
+}
 
-	// Ensure logs directory exists
-	logDir := filepath.Dir(logFile)					// Get directory path
-	os.MkdirAll(logDir, logDirPermissions)			// Create with permissions from SETUP
+// newRoutedLogger builds a Logger writing to [logs root]/subdirectory/component.log.
+//
+// Records the writability check and the routing decision (DEBUG) as its first entries.
+func newRoutedLogger(component, subdirectory, mechanism, caller string) *Logger {
+	// Build log file path under the log root (see LogsDir)
+	// Path: [logs root]/[subdirectory]/[component].log
+	logFile := filepath.Join(LogsDir(), subdirectory, component+logFileExtension)
+
+	// Ensure logs directory exists (disabled logging touches no disk)
+	var writeErr error
+	if !Config.Behavior.Disabled {
+		logDir := filepath.Dir(logFile)				// Get directory path
+		os.MkdirAll(logDir, logDirPermissions)		// Create with permissions from SETUP
+		writeErr = probeWritable(logFile)			// Catch broken logging before a long run
+	}
 
 	// Generate unique context ID using config format with fallback (multi-layer tripwire)
 	var contextID string
 	if ConfigLoaded && Config.Files.ContextIDFormat != "" {
-		contextID = fmt.Sprintf(Config.Files.ContextIDFormat, component, os.Getpid(), time.Now().UnixNano())
+		contextID = fmt.Sprintf(Config.Files.ContextIDFormat, component, os.Getpid(), now().UnixNano())
 	} else {
-		contextID = fmt.Sprintf(contextIDFormat, component, os.Getpid(), time.Now().UnixNano())
+		contextID = fmt.Sprintf(contextIDFormat, component, os.Getpid(), now().UnixNano())
 	}
 
 	// Pre-compute unchanging correlation points once at initialization
@@ -1077,9 +1377,10 @@ func NewLogger(component string) *Logger {
 	hostname := getHostname()						// Capture hostname once
 	pid := os.Getpid()								// Capture PID once
 
-	return &Logger{									// Return initialized logger
+	logger := &Logger{								// Initialized logger
 		Component:           component,					// Component name
 		ContextID:           contextID,					// Unique execution identifier
+		ParentContextID:     parentContextFromEnv(),	// Link to the process that started this one
 		LogFile:             logFile,					// Routed log file path
 		SessionHealth:       initialHealth,				// Use constant from SETUP
 		TotalPossibleHealth: initialTotal,				// Use constant from SETUP
@@ -1087,7 +1388,22 @@ func NewLogger(component string) *Logger {
 		username:            username,					// Pre-computed username (reused for every entry)
 		hostname:            hostname,					// Pre-computed hostname (reused for every entry)
 		pid:                 pid,						// Pre-computed PID (reused for every entry)
+		created:             now(), // Run start (Finalize duration)
+		levelCounts:         make(map[string]int), // Per-level entry counts (Finalize summary)
+	}
+
+	// Record the writability check - a failing path spills this entry to memory
+	// and warns on stderr, so diagnose sees it either way
+	checkDetails := map[string]any{"path": logFile}
+	if writeErr != nil {
+		checkDetails["error"] = writeErr.Error()
 	}
+	logger.Check(checkLogWritable, writeErr == nil, 0, checkDetails)
+
+	// Record why the log landed here - misroutes are diagnosable from the log itself
+	logger.Debug(eventLogRouted, 0, map[string]any{"subdirectory": subdirectory, "mechanism": mechanism, "caller": caller})
+
+	return logger
 }
 
 // GetHealth returns the current normalized health percentage.
@@ -1119,6 +1435,29 @@ func (l *Logger) DeclareHealthTotal(total int) {
 	l.TotalPossibleHealth = total                       // Set denominator for normalization calculation
 }
 
+// Flush writes any pending duplicate-burst summary to the log file.
+//
+// What It Does:
+// When [behavior] coalesce_repeats is enabled, identical consecutive entries are
+// suppressed and counted until a different entry arrives or the window closes.
+// Flush emits the "repeated N times" summary immediately so nothing is lost when
+// the process exits mid-burst. Safe to call when coalescing is disabled (no-op).
+// Entries held in memory after a write failure get one more write attempt.
+//
+// Health Impact:
+//   No health impact (suppressed deltas were already applied when logged)
+//
+// Example usage:
+//
+//	logger := logging.NewLogger("validate")
+//	defer logger.Flush()
+//
+func (l *Logger) Flush() {
+	l.endSamplingBursts()                               // Burst is over once the caller flushes
+	l.flushRepeats()                                    // Emit pending summary (no-op when nothing suppressed)
+	l.retrySpill()                                      // Last chance for a held backlog (no-op when writes worked)
+}
+
 // ============================================================================
 // END BODY
 // ============================================================================
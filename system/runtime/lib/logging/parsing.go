@@ -20,20 +20,31 @@
 // Architect: Seanje Lenox-Wise, Nova Dawn
 // Implementation: Nova Dawn
 // Creation Date: 2025-11-18
-// Version: 1.0.0
-// Last Modified: 2025-11-18 - Extracted from monolithic logger.go
+// Version: 1.8.0
+// Last Modified: 2026-10-16 - ReadLogFileReport: truncated tail, spliced writes, and damage warnings by line
 //
 // Purpose & Function
 //
 // Purpose: Read log files and parse them back into LogEntry structures for analysis. Enables the debugging layer to examine execution history by reconstructing the structured data from formatted log files.
 //
-// Core Design: Line-by-line state machine parser. Recognizes entry boundaries, header format, sections (EVENT, DETAILS, CONTEXT, INTERACTIONS), and reconstructs LogEntry structures.
+// Core Design: Line-by-line state machine parser. Recognizes entry boundaries, both header formats (Go logger and logger.sh), sections (PARENT, TAGS, CONTEXT, EVENT, DETAILS, INTERACTIONS, SEMANTIC, HEALTH), and reconstructs LogEntry structures. Sections it does not recognize are kept verbatim in LogEntry.RawSections rather than failing.
 //
 // Key Features:
 //   - Header parsing (timestamp, level, component, context ID, health)
-//   - Section parsing (EVENT, DETAILS, CONTEXT, INTERACTIONS)
+//   - Section parsing (PARENT, TAGS, CONTEXT, EVENT, DETAILS, INTERACTIONS, SEMANTIC, HEALTH)
+//   - Semantic metadata restored into LogEntry.Semantic (maps decoded from JSON)
+//   - Unknown sections preserved in LogEntry.RawSections (format evolution)
+//   - Deterministic merge across components and rotated files (MergeEntries)
+//   - JSON lines entries (format.output = "json") read alongside text entries
+//   - Sealed entries ([privacy] encrypt) opened transparently; no key → ErrEncrypted
+//   - Format version header (#cpi-si-log-format: N) read; files without one parse as version 1
+//   - Detail accessors (ExtractFile, ExtractDuration, ...) over canonical keys and older spellings
+//   - CONTEXT "Go Runtime" map restored into SystemContext.GoRuntime
 //   - Multi-line value handling
 //   - Entry boundary detection (separator lines)
+//   - Damage reported, not misparsed (ReadLogFileReport): an entry the file
+//     ends inside comes back as ParseReport.TruncatedTail, an entry spliced onto
+//     a cut-off line starts fresh, and anomalies are listed with line numbers
 //   - Graceful error handling (returns partial data + error)
 //
 // Blocking Status
@@ -55,13 +66,20 @@
 //   5. Used by debugger command for log analysis
 //
 // Public API:
-//   ReadLogFile(path string) ([]LogEntry, error) - Parse log file into entry slice
+//   ReadLogFile(path string) ([]LogEntry, error) - Parse log file into entry slice (lenient)
+//   ReadLogFileReport(path string) ([]LogEntry, ParseReport, error) - Parse with damage report
+//   ParseReport - Damage found (Warnings, TruncatedTail; OK, Problems)
+//   MergeEntries(streams ...[]LogEntry) []LogEntry - Interleave logs by (timestamp, context ID, sequence)
+//   ExtractFile / ExtractDuration / ExtractExitCode / ExtractCount / ExtractError - Canonical details, older spellings accepted
 //
 // Dependencies
 //
 // Dependencies (What This Needs):
-//   Standard Library: bufio, fmt, os, strings, time
-//   Package Files: entry.go (LogEntry type, entrySeparator constant)
+//   Standard Library: bufio, cmp, encoding/json, fmt, os, slices, strconv, strings, time, unicode/utf8
+//   Package Files: entry.go (LogEntry and Metadata types, entrySeparator constant)
+//                  encryption.go (frameOpener, frameComplete, encryptedMagic)
+//                  version.go (parseVersionHeader, logFormatLegacy)
+//                  details.go (Detail* canonical keys)
 //
 // Dependents (What Uses This):
 //   External: system/runtime/lib/debugging (log analysis)
@@ -88,16 +106,65 @@ package logging
 // Imports
 
 import (
-	"bufio"   // Line-by-line file reading
-	"fmt"     // String parsing (Sscanf)
-	"os"      // File operations
-	"strings" // String manipulation for parsing
-	"time"    // Timestamp parsing
+	"bufio"         // Line-by-line file reading
+	"cmp"           // Sequence comparison
+	"encoding/json" // SEMANTIC map fields
+	"fmt"           // String parsing (Sscanf)
+	"os"            // File operations
+	"slices"        // Stable merge sort
+	"strconv"       // Integer detail values
+	"strings"       // String manipulation for parsing
+	"time"          // Timestamp parsing
+	"unicode/utf8"  // Garbage byte detection
 )
 
 // Constants (from entry.go)
 // entrySeparator is defined in entry.go and used here for boundary detection
 
+// Constants
+
+const (
+	maxLineBytes = 1024 * 1024 // Longest line read (command output can exceed bufio's 64 KB default)
+
+	// headerTimeLayout parses header timestamps - Go accepts any fractional
+	// seconds after :05, so millisecond (logger.sh, older Go logs) and
+	// nanosecond (timestampFormat) headers both read
+	headerTimeLayout = "2006-01-02 15:04:05"
+)
+
+// Types
+
+// ParseWarning is one piece of damage ReadLogFileReport found mid-file.
+type ParseWarning struct {
+	Line    int    // 1-based line in the file where the damage shows
+	Message string // What was wrong and what the parser did about it
+}
+
+// PartialEntry is an entry whose terminator never came - the write stopped inside it.
+type PartialEntry struct {
+	Line  int       // Line the entry started on
+	Lines []string  // Lines as read
+	Entry *LogEntry // Best-effort parse (nil for a cut-off JSON or sealed entry)
+}
+
+// ParseReport is ReadLogFileReport's account of how cleanly a file read.
+type ParseReport struct {
+	Path          string         // File read
+	Lines         int            // Lines read
+	Warnings      []ParseWarning // Damage found mid-file, in line order
+	TruncatedTail *PartialEntry  // Entry the file ends inside (nil = file ends cleanly)
+}
+
+// parseState tracks where ReadLogFile is inside the current entry.
+type parseState struct {
+	entry      *LogEntry       // Entry being built (nil between entries)
+	section    string          // Current section name ("" before the first)
+	subsection string          // Nested map or list inside CONTEXT / INTERACTIONS
+	multiKey   string          // DETAILS key collecting a "|" multiline value
+	multiLines []string        // Lines collected for multiKey
+	seen       map[string]bool // Known sections already started (a second one means two entries ran together)
+}
+
 // ============================================================================
 // END SETUP
 // ============================================================================
@@ -106,126 +173,756 @@ import (
 // BODY
 // ============================================================================
 
+// ────────────────────────────────────────────────────────────────
+// Helpers - Line Classification
+// ────────────────────────────────────────────────────────────────
+
+// indentOf counts leading spaces (sections sit at 2, fields at 4, nested values at 6).
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// sectionHeader splits a two-space "  NAME:" or "  NAME: value" line.
+//
+// Names are upper-case (CONTEXT, EVENT, SEMANTIC, ...), which keeps detail
+// lines like "  note: x" from an odd writer out of the section table.
+func sectionHeader(line string) (name, value string, ok bool) {
+	if indentOf(line) != 2 {                             // Sections sit at exactly two spaces
+		return "", "", false
+	}
+	name, value, found := strings.Cut(strings.TrimSpace(line), ":")
+	if !found || name == "" || name != strings.ToUpper(name) { // Not NAME: form
+		return "", "", false
+	}
+	return name, strings.TrimSpace(value), true
+}
+
+// fieldOf splits a "Key: value" content line.
+func fieldOf(line string) (key, value string) {
+	key, value, _ = strings.Cut(strings.TrimSpace(line), ":")
+	return strings.TrimSpace(key), strings.TrimSpace(value)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Header and Health Parsing
+// ────────────────────────────────────────────────────────────────
+
+// parseHeader starts an entry from a header line (nil when the line is not one).
+//
+// Two writers share the log directories:
+//   Go logger:  [timestamp] LEVEL component context-id #sequence
+//               (entries from before sequencing stop after component)
+//   logger.sh:  [timestamp] LEVEL | component | user@host:pid | context-id | HEALTH: X% (raw: Y, ΔZ) ...
+func parseHeader(line string) *LogEntry {
+	stamp, rest, found := strings.Cut(strings.TrimPrefix(line, "["), "]")
+	if !found {                                          // No closing bracket
+		return nil
+	}
+	timestamp, err := time.ParseInLocation(headerTimeLayout, strings.TrimSpace(stamp), time.Local) // Writers log local time
+	if err != nil {                                      // Bracketed text, not a timestamp
+		return nil
+	}
+
+	entry := &LogEntry{
+		Timestamp: timestamp,            // Set parsed timestamp
+		Details:   make(map[string]any), // Initialize empty details map
+	}
+	if strings.Contains(rest, "|") {                     // logger.sh pipe header
+		parsePipeHeader(entry, rest)
+		return entry
+	}
+	fields := strings.Fields(rest)                       // LEVEL component context-id #sequence
+	if len(fields) > 0 {
+		entry.Level = fields[0]
+	}
+	if len(fields) > 1 {
+		entry.Component = fields[1]
+	}
+	for _, field := range fields[min(len(fields), 2):] {
+		if seq, isSeq := strings.CutPrefix(field, "#"); isSeq {
+			fmt.Sscanf(seq, "%d", &entry.Sequence)
+		} else {
+			entry.ContextID = field
+		}
+	}
+	return entry
+}
+
+// parsePipeHeader fills level, component, user, context ID, and health from a logger.sh header.
+func parsePipeHeader(entry *LogEntry, rest string) {
+	parts := strings.SplitN(rest, "|", 5)                // Split header by pipe separators
+	if len(parts) < 5 {                                  // Malformed - keep level only
+		entry.Level = strings.TrimSpace(parts[0])
+		return
+	}
+	entry.Level = strings.TrimSpace(parts[0])            // Level before first pipe
+	entry.Component = strings.TrimSpace(parts[1])        // Component name from second part
+	entry.User = strings.TrimSpace(parts[2])             // user@host:pid from third part
+	entry.ContextID = strings.TrimSpace(parts[3])        // Context ID from fourth part
+
+	// Extract health values from HEALTH: X% (raw: Y, ΔZ) pattern
+	healthPart := parts[4]
+	if !strings.Contains(healthPart, "HEALTH:") {        // Health info absent
+		return
+	}
+	normalizedStr := strings.TrimSpace(strings.Split(healthPart, "(")[0])           // Part before first parenthesis
+	normalizedStr = strings.TrimSpace(strings.TrimPrefix(normalizedStr, "HEALTH:")) // Remove prefix
+	normalizedStr = strings.TrimSuffix(normalizedStr, "%")                          // Remove % sign
+	fmt.Sscanf(normalizedStr, "%d", &entry.NormalizedHealth)                        // Parse integer
+
+	if _, raw, found := strings.Cut(healthPart, "raw:"); found {                     // Raw health present
+		fmt.Sscanf(strings.TrimSpace(strings.Split(raw, ",")[0]), "%d", &entry.RawHealth)
+	}
+	if _, delta, found := strings.Cut(healthPart, "Δ"); found {                      // Delta present
+		fmt.Sscanf(strings.Split(delta, ")")[0], "%d", &entry.HealthImpact)           // Handles +/- sign
+	}
+}
+
+// parseHealthLine reads a Go logger "HEALTH: <visual> (Δ±D, Raw: R, Normalized: N)" line.
+//
+// The visual (indicator and bar) is configurable and never parsed. Entries
+// written before Normalized was recorded fall back to the default bar's
+// "(N/100)" scale - normalized comes back as 2N-100, so odd values read back
+// one lower (the bar halves them).
+func parseHealthLine(entry *LogEntry, value string) {
+	if _, normalized, found := strings.Cut(value, "Normalized:"); found { // Exact value (current format)
+		fmt.Sscanf(strings.TrimSpace(normalized), "%d", &entry.NormalizedHealth)
+	} else if _, scaled, found := strings.Cut(value, "] ("); found { // Older entries: bar value follows the bar
+		var barValue int
+		if _, err := fmt.Sscanf(scaled, "%d/100", &barValue); err == nil {
+			entry.NormalizedHealth = barValue*2 - 100
+		}
+	}
+	if _, delta, found := strings.Cut(value, "(Δ"); found {    // Delta present
+		fmt.Sscanf(delta, "%d", &entry.HealthImpact)
+	}
+	if _, raw, found := strings.Cut(value, "Raw:"); found {    // Raw health present
+		fmt.Sscanf(strings.TrimSpace(raw), "%d", &entry.RawHealth)
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Section Content
+// ────────────────────────────────────────────────────────────────
+
+// decodeJSONField restores a SEMANTIC map written by writeJSONField.
+//
+// Values that are not JSON (writeJSONField's %v fallback) are kept as {"raw": text}.
+func decodeJSONField(value string) map[string]any {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(value), &data); err != nil {
+		return map[string]any{"raw": value}
+	}
+	return data
+}
+
+// keepRawLine stores a line of an unrecognized section (or field) verbatim.
+func (state *parseState) keepRawLine(section, line string) {
+	if state.entry.RawSections == nil {
+		state.entry.RawSections = make(map[string][]string)
+	}
+	state.entry.RawSections[section] = append(state.entry.RawSections[section], line)
+}
+
+// parseDetailsLine handles "    key: value" and "    key: |" multiline values.
+//
+// A line with no key (no colon) is kept in RawSections and reported.
+func (state *parseState) parseDetailsLine(line string) string {
+	if state.multiKey != "" && indentOf(line) >= 6 {      // Continuation of a "|" value
+		state.multiLines = append(state.multiLines, line[6:])
+		state.entry.Details[state.multiKey] = strings.Join(state.multiLines, "\n")
+		return ""
+	}
+	state.multiKey, state.multiLines = "", nil
+	if !strings.Contains(line, ":") {                    // Not key: value - damage, not a detail
+		state.keepRawLine("DETAILS", line)
+		return "malformed DETAILS line (kept in RawSections)"
+	}
+	key, value := fieldOf(line)
+	if value == "|" {                                    // Multiline value starts on the next line
+		state.multiKey = key
+		state.entry.Details[key] = ""
+		return ""
+	}
+	state.entry.Details[key] = value                     // Values come back as strings
+	return ""
+}
+
+// parseContextLine restores WHO/WHERE fields and the environment map.
+//
+// Shell, sudoers, and metrics are display formats with no inverse - skipped.
+func (state *parseState) parseContextLine(line string) {
+	key, value := fieldOf(line)
+	if indentOf(line) >= 6 {                             // Nested map value
+		switch state.subsection {
+		case "environment", "env_state":
+			state.entry.Context.EnvState[key] = value
+		case "go runtime":
+			state.entry.Context.GoRuntime.setField(key, value)
+		}
+		return
+	}
+	state.subsection = ""
+	switch strings.ToLower(key) {                        // Go logger capitalizes, logger.sh does not
+	case "user":
+		state.entry.User = value
+	case "context id":
+		state.entry.ContextID = value
+	case "cwd":
+		state.entry.Context.CWD = value
+	case "container":
+		state.entry.Context.Container = value == "true"
+	case "go runtime":
+		state.subsection = "go runtime"
+		state.entry.Context.GoRuntime = &GoRuntimeStats{}
+	default:
+		if value == "" {                                 // Map or list header
+			state.subsection = strings.ToLower(key)
+		}
+	}
+}
+
+// parseInteractionsLine restores the Concurrent list and the two maps.
+func (state *parseState) parseInteractionsLine(line string) {
+	interactions := state.entry.Interactions
+	if indentOf(line) < 6 {                              // Sub-section header
+		state.subsection, _ = fieldOf(line)
+		return
+	}
+	if item, isItem := strings.CutPrefix(strings.TrimSpace(line), "- "); isItem {
+		if state.subsection == "Concurrent" {
+			interactions.Concurrent = append(interactions.Concurrent, item)
+		}
+		return
+	}
+	key, value := fieldOf(line)
+	switch state.subsection {
+	case "Dependencies":
+		interactions.Dependencies[key] = value
+	case "State Changes":
+		interactions.StateChanges[key] = value
+	}
+}
+
+// parseSemanticLine restores one Metadata field; unknown keys are kept raw.
+func (state *parseState) parseSemanticLine(line string) {
+	semantic := state.entry.Semantic
+	key, value := fieldOf(line)
+	switch key {
+	case "Operation Type":
+		semantic.OperationType = value
+	case "Operation Subtype":
+		semantic.OperationSubtype = value
+	case "Error Type":
+		semantic.ErrorType = value
+	case "Recovery Hint":
+		semantic.RecoveryHint = value
+	case "Recovery Strategy":
+		semantic.RecoveryStrategy = value
+	case "Error Details":
+		semantic.ErrorDetails = decodeJSONField(value)
+	case "Recovery Params":
+		semantic.RecoveryParams = decodeJSONField(value)
+	case "Expected":
+		semantic.Expected = decodeJSONField(value)
+	case "Actual":
+		semantic.Actual = decodeJSONField(value)
+	default:                                             // Field from a newer writer
+		state.keepRawLine("SEMANTIC", line)
+	}
+}
+
+// startSection switches to a section, preparing the structure it fills.
+//
+// Returns a warning for an unknown section (kept in RawSections) or a known
+// one the entry already had - two entries' lines run together.
+func (state *parseState) startSection(name, value, line string) string {
+	state.section, state.subsection = name, ""
+	state.multiKey, state.multiLines = "", nil
+
+	warning := ""
+	if state.seen[name] {
+		warning = fmt.Sprintf("second %s section in one entry (two entries ran together)", name)
+	}
+	if state.seen == nil {
+		state.seen = make(map[string]bool)
+	}
+	state.seen[name] = true
+
+	entry := state.entry
+	switch name {
+	case "EVENT":
+		entry.Event = value
+	case "PARENT":
+		entry.ParentContextID = value
+	case "TAGS":
+		entry.Tags = parseTags(value)
+	case "HEALTH":
+		parseHealthLine(entry, value)
+	case "DETAILS":
+	case "CONTEXT":
+		entry.Context = &SystemContext{EnvState: make(map[string]string)}
+	case "INTERACTIONS":
+		entry.Interactions = &Interactions{
+			Dependencies: make(map[string]string),
+			StateChanges: make(map[string]string),
+		}
+	case "SEMANTIC":
+		entry.Semantic = &Metadata{}
+	default:                                             // Newer format - keep, don't fail
+		state.keepRawLine(name, line)
+		if warning == "" {
+			warning = fmt.Sprintf("unknown section %s (kept in RawSections)", name)
+		}
+	}
+	return warning
+}
+
+// parseContentLine routes a line below a section header to that section.
+//
+// Returns a warning for a line that belongs to no section.
+func (state *parseState) parseContentLine(line string) string {
+	if state.section == "" || indentOf(line) == 0 {     // Sections and their content are indented
+		return "line outside any section (skipped)"
+	}
+	switch state.section {
+	case "EVENT", "PARENT", "TAGS", "HEALTH":            // Single-line sections have no content
+	case "DETAILS":
+		return state.parseDetailsLine(line)
+	case "CONTEXT":
+		state.parseContextLine(line)
+	case "INTERACTIONS":
+		state.parseInteractionsLine(line)
+	case "SEMANTIC":
+		state.parseSemanticLine(line)
+	default:
+		state.keepRawLine(state.section, line)
+	}
+	return ""
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Damage Detection
+// ────────────────────────────────────────────────────────────────
+
+// splicedEntryStart finds an entry start glued onto the end of a cut-off write (-1 = none).
+//
+// A writer that dies mid-line leaves no newline, so the next writer's entry
+// begins on the same line and its header would read as the dead entry's
+// content. Only a start right after a non-space byte counts - a header
+// quoted in a detail value ("last_line: [2026-...") follows a space and is
+// left alone.
+func splicedEntryStart(line string) int {
+	for i := 1; i < len(line); i++ {
+		if line[i-1] == ' ' || line[i-1] == '\t' {
+			continue
+		}
+		rest := line[i:]
+		switch {
+		case rest[0] == '[' && len(rest) > 1 && rest[1] >= '0' && rest[1] <= '9' && parseHeader(rest) != nil:
+			return i
+		case strings.HasPrefix(rest, `{"`) && json.Valid([]byte(rest)):
+			return i
+		case strings.HasPrefix(rest, encryptedMagic):
+			return i
+		}
+	}
+	return -1
+}
+
+// isGarbage reports a line no writer produces - NUL bytes or invalid UTF-8.
+func isGarbage(line string) bool {
+	return strings.ContainsRune(line, 0) || !utf8.ValidString(line)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Parse Report
+// ────────────────────────────────────────────────────────────────
+
+// OK reports whether the file read with no damage and no truncated tail.
+func (r ParseReport) OK() bool {
+	return len(r.Warnings) == 0 && r.TruncatedTail == nil
+}
+
+// Problems lists every finding as one line each (empty when OK).
+func (r ParseReport) Problems() []string {
+	var problems []string
+	for _, warning := range r.Warnings {
+		problems = append(problems, fmt.Sprintf("line %d: %s", warning.Line, warning.Message))
+	}
+	if tail := r.TruncatedTail; tail != nil {
+		problems = append(problems, fmt.Sprintf("line %d: truncated tail - entry never finished (%d lines)", tail.Line, len(tail.Lines)))
+	}
+	return problems
+}
+
 // ────────────────────────────────────────────────────────────────
 // Core Operations - Log File Parsing
 // ────────────────────────────────────────────────────────────────
 
 // ReadLogFile reads and parses a log file into LogEntry structures.
 //
-// Parser design: State machine recognizing entry boundaries and sections.
-// Entry format: [timestamp] LEVEL | component | user@host:pid | context-id | HEALTH: X% (raw: Y, ΔZ)
-//               Followed by EVENT, DETAILS, CONTEXT, INTERACTIONS sections, then separator (---)
+// The lenient form of ReadLogFileReport: damage is tolerated silently, and
+// an entry the file ends inside (a live log mid-write, or a crash) is
+// returned as far as it got.
 func ReadLogFile(path string) ([]LogEntry, error) {
+	entries, report, err := ReadLogFileReport(path)
+	if tail := report.TruncatedTail; tail != nil && tail.Entry != nil {
+		entries = append(entries, *tail.Entry)
+	}
+	return entries, err
+}
+
+// ReadLogFileReport reads and parses a log file, reporting any damage found.
+//
+// Parser design: State machine recognizing entry boundaries and sections.
+// Entry format: header line (Go or logger.sh form, see parseHeader), then
+//               two-space sections (PARENT, TAGS, CONTEXT, EVENT, DETAILS, INTERACTIONS,
+//               SEMANTIC, HEALTH), then separator (---).
+//
+// Sections this parser does not know are kept line-for-line in
+// LogEntry.RawSections, so newer writers never break older readers.
+// A line that is a JSON object at column 0 is one whole entry (JSON output),
+// so a file switched between formats mid-way still reads completely.
+//
+// Sealed entry lines ([privacy] encrypt, see encryption.go) are opened with
+// the key_file key and parsed like any other, so files that switched to
+// encryption mid-way read completely too. Without a usable key the sealed
+// entries are skipped and the error matches ErrEncrypted (errors.Is) - the
+// plaintext entries are still returned.
+//
+// A format header line (see version.go) sets the version the entries after
+// it are parsed under; a file without one is version 1.
+//
+// Damage handling - an entry is complete only when its separator (or, for
+// JSON and sealed entries, its whole line) is seen:
+//   - The file ends inside an entry: report.TruncatedTail, not an entry
+//   - An entry header spliced onto a cut-off line: the entry starts there,
+//     the cut-off bytes are dropped (a warning, not the old entry's content)
+//   - A text entry ended by the next header: kept, warned (writers always
+//     write the separator)
+//   - A cut-off JSON or sealed line mid-file, garbage bytes (NUL, invalid
+//     UTF-8), unknown sections, malformed DETAILS lines, a second copy of a
+//     section, and lines outside any section or entry: warned with line number
+//
+// Returns:
+//   []LogEntry: Complete entries (partial data on error)
+//   ParseReport: Damage found (report.OK() when the file read cleanly)
+//   error: Open or read failure, or ErrEncrypted for sealed entries without a key
+//
+// Example usage:
+//
+//	entries, report, err := logging.ReadLogFileReport(path)
+//	for _, problem := range report.Problems() {
+//	    fmt.Println(path, problem) // "line 8200: entry ended without its --- separator ..."
+//	}
+func ReadLogFileReport(path string) ([]LogEntry, ParseReport, error) {
 	file, err := os.Open(path) // Open log file for reading
 	if err != nil {             // File open failed
-		return nil, err // Return error to caller
+		return nil, ParseReport{Path: path}, err
 	}
 	defer file.Close() // Ensure file closes when function exits
 
-	var entries []LogEntry     // Slice to collect parsed entries
-	var currentEntry *LogEntry // Current entry being parsed (nil between entries)
+	reader := entryReader{version: logFormatLegacy, report: ParseReport{Path: path}} // No header yet - the format before versioning
+	opener := frameOpener{path: path}                                                 // Sealed entries ([privacy] encrypt), key loaded on first use
 	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineBytes) // Long command output and JSON fields
 
 	for scanner.Scan() { // Read each line
-		line := scanner.Text() // Get line text
+		reader.feedLine(scanner.Text(), &opener)
+	}
 
-		// NEW ENTRY DETECTION - Lines starting with [timestamp] mark new entries
+	entries := reader.finish()
+	if err := scanner.Err(); err != nil {
+		return entries, reader.report, err
+	}
+	return entries, reader.report, opener.err // Plaintext entries even when sealed ones could not be opened
+}
 
-		if strings.HasPrefix(line, "[") && strings.Contains(line, "|") { // Entry header line detected
-			if currentEntry != nil { // Previous entry exists (not first entry)
-				entries = append(entries, *currentEntry) // Save completed previous entry
-			}
+// entryReader runs the ReadLogFile state machine one line at a time.
+//
+// Versions 1 and 2 share one entry grammar. Format features added later
+// branch on version here, so files written before them still read as before.
+type entryReader struct {
+	entries []LogEntry    // Completed entries
+	state   parseState    // Current entry and section (entry nil between entries)
+	version int           // Format version of the lines being fed (from the file header)
+	report  ParseReport   // Damage found so far
+	line    int           // File line being fed (1-based)
+	start   int           // Line the current entry started on
+	raw     []string      // Current entry's lines as read (TruncatedTail)
+	cut     *PartialEntry // Cut-off JSON or sealed line - a warning once more follows, the tail if nothing does
+}
 
-			// HEADER PARSING - Format: [timestamp] LEVEL | component | user@host:pid | context-id | HEALTH: X (ΔY)
-
-			parts := strings.SplitN(line, "|", 5) // Split header by pipe separators
-			if len(parts) >= 5 {                  // Valid header format (5+ parts)
-				// Extract timestamp
-				timestampStr := strings.TrimSpace(strings.Trim(strings.SplitN(parts[0], "]", 2)[0], "[")) // Extract timestamp between brackets
-				timestamp, _ := time.Parse(timestampFormat, timestampStr)                                  // Parse using timestamp format constant
-
-				// Extract level
-				level := strings.TrimSpace(strings.SplitN(parts[0], "]", 2)[1]) // Extract level after ] bracket
-
-				// Extract component
-				component := strings.TrimSpace(parts[1]) // Component name from second part
-
-				// Extract context ID
-				contextID := strings.TrimSpace(parts[3]) // Context ID from fourth part
-
-				// Extract health values from HEALTH: X% (raw: Y, ΔZ) pattern
-				healthPart := parts[4]   // Fifth part contains health info
-				normalizedHealth := 0    // Default normalized health
-				rawHealth := 0           // Default raw health
-				healthImpact := 0        // Default health impact
-				// Extract normalized health, raw health, and delta from new format
-				if strings.Contains(healthPart, "HEALTH:") { // Health info present
-					// Extract normalized health (percentage after HEALTH:)
-					normalizedStr := strings.TrimSpace(strings.Split(healthPart, "(")[0])            // Part before first parenthesis
-					normalizedStr = strings.TrimSpace(strings.TrimPrefix(normalizedStr, "HEALTH:"))  // Remove prefix
-					normalizedStr = strings.TrimSuffix(normalizedStr, "%")                           // Remove % sign
-					fmt.Sscanf(normalizedStr, "%d", &normalizedHealth)                               // Parse integer
-
-					// Extract raw health (number after "raw:")
-					if strings.Contains(healthPart, "raw:") { // Raw health present
-						rawStr := strings.Split(strings.Split(healthPart, "raw:")[1], ",")[0] // Extract between "raw:" and ","
-						fmt.Sscanf(strings.TrimSpace(rawStr), "%d", &rawHealth)               // Parse integer
-					}
-
-					// Extract delta (number in parentheses with Δ)
-					if strings.Contains(healthPart, "Δ") { // Delta present
-						deltaStr := strings.Split(strings.Split(healthPart, "Δ")[1], ")")[0] // Extract between Δ and )
-						fmt.Sscanf(deltaStr, "%d", &healthImpact)                            // Parse integer (handles +/-)
-					}
-				}
-
-				currentEntry = &LogEntry{ // Create new entry
-					Timestamp:        timestamp,        // Set parsed timestamp
-					Level:            level,            // Set log level (OPERATION, SUCCESS, etc.)
-					Component:        component,        // Set component name
-					ContextID:        contextID,        // Set context ID for correlation
-					NormalizedHealth: normalizedHealth, // Set normalized health percentage
-					RawHealth:        rawHealth,        // Set cumulative health
-					HealthImpact:     healthImpact,     // Set health delta
-					Details:          make(map[string]any), // Initialize empty details map
-				}
-			}
-		} else if currentEntry != nil { // Continuation line (part of current entry)
-			// EVENT LINE PARSING - Captures event description
+// warn records damage at a file line.
+func (r *entryReader) warn(line int, format string, args ...any) {
+	r.report.Warnings = append(r.report.Warnings, ParseWarning{Line: line, Message: fmt.Sprintf(format, args...)})
+}
 
-			trimmedLine := strings.TrimSpace(line)                                                // Trim once for reuse
-			if eventText, found := strings.CutPrefix(trimmedLine, "EVENT:"); found {              // EVENT section line
-				currentEntry.Event = strings.TrimSpace(eventText) // Extract event text
-			}
+// feedLine advances the state machine by one file line: splices and cut-off
+// single-line entries are found here, sealed entries opened, and the rest fed.
+func (r *entryReader) feedLine(line string, opener *frameOpener) {
+	r.line++
+	r.report.Lines = r.line
 
-			// DETAILS SECTION PARSING - Key-value pairs from DETAILS section
+	if !(strings.HasPrefix(line, "{") && json.Valid([]byte(line))) { // A whole JSON entry may hold "{" anywhere
+		if at := splicedEntryStart(line); at > 0 {
+			r.settleCut()
+			r.endEntry("") // The splice warning covers it
+			r.warn(r.line, "entry spliced onto a cut-off line (%d bytes of the unfinished write dropped)", at)
+			line = line[at:]
+		}
+	}
 
-			if strings.Contains(line, ":") && !strings.HasPrefix(strings.TrimSpace(line), "EVENT:") && // Contains colon but not section header
-				!strings.HasPrefix(strings.TrimSpace(line), "DETAILS:") &&     // Not DETAILS header
-				!strings.HasPrefix(strings.TrimSpace(line), "CONTEXT:") &&     // Not CONTEXT header
-				!strings.HasPrefix(strings.TrimSpace(line), "INTERACTIONS:") { // Not INTERACTIONS header
-				parts := strings.SplitN(strings.TrimSpace(line), ":", 2) // Split key:value on first colon
-				if len(parts) == 2 {                                     // Valid key-value format
-					currentEntry.Details[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1]) // Add to details map
-				}
+	if strings.HasPrefix(line, encryptedMagic) { // Sealed entry - parse what it holds
+		if !frameComplete(line) {
+			r.cutOff(line, "sealed")
+			return
+		}
+		if text, opened := opener.open(line); opened {
+			for _, inner := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+				r.feed(inner)
 			}
 		}
+		return
+	}
+	r.feed(line)
+}
+
+// cutOff holds a single-line entry that stopped mid-write until the next line shows whether it was the last.
+func (r *entryReader) cutOff(line, kind string) {
+	r.settleCut()
+	r.endEntry(fmt.Sprintf("ended without its %s separator (a %s entry follows at line %d)", entrySeparator, kind, r.line))
+	r.cut = &PartialEntry{Line: r.line, Lines: []string{line}}
+}
+
+// settleCut turns a held cut-off line into a warning - something followed it.
+func (r *entryReader) settleCut() {
+	if r.cut != nil {
+		r.warn(r.cut.Line, "entry cut off mid-write (skipped)")
+		r.cut = nil
+	}
+}
+
+// endEntry completes the entry in progress; damage != "" warns at its first line.
+func (r *entryReader) endEntry(damage string) {
+	if r.state.entry == nil {
+		return
+	}
+	r.entries = append(r.entries, *r.state.entry)
+	if damage != "" {
+		r.warn(r.start, "entry %s", damage)
+	}
+	r.state, r.raw = parseState{}, nil
+}
+
+// feed advances the state machine by one line.
+func (r *entryReader) feed(line string) {
+	// FORMAT HEADER - starts a file (or a file concatenated onto another)
+
+	if version, isHeader := parseVersionHeader(line); isHeader {
+		r.settleCut()
+		r.endEntry(fmt.Sprintf("ended without its %s separator (a format header follows at line %d)", entrySeparator, r.line))
+		r.version = version
+		return
+	}
+
+	if isGarbage(line) {
+		r.settleCut()
+		r.warn(r.line, "garbage bytes (skipped)")
+		return
+	}
+	if strings.TrimSpace(line) != "" {
+		r.settleCut()
+	}
 
-		// ENTRY BOUNDARY DETECTION - Separator marks end of entry
+	// NEW ENTRY DETECTION - "[timestamp] ..." or a JSON entry at column 0
 
-		if strings.TrimSpace(line) == strings.TrimSpace(entrySeparator) && currentEntry != nil { // Entry separator found
-			entries = append(entries, *currentEntry) // Save completed entry
-			currentEntry = nil                       // Reset for next entry
+	if strings.HasPrefix(line, "{") {
+		var entry LogEntry
+		if json.Unmarshal([]byte(line), &entry) == nil {
+			r.endEntry(fmt.Sprintf("ended without its %s separator (a JSON entry follows at line %d)", entrySeparator, r.line))
+			r.entries = append(r.entries, entry)
+			return
 		}
+		if strings.HasPrefix(line, `{"`) { // A JSON entry that stopped mid-write
+			r.cutOff(line, "JSON")
+			return
+		}
+	}
+	if strings.HasPrefix(line, "[") {
+		if header := parseHeader(line); header != nil {
+			r.endEntry(fmt.Sprintf("ended without its %s separator (next entry at line %d)", entrySeparator, r.line))
+			r.state = parseState{entry: header}
+			r.start, r.raw = r.line, []string{line}
+			return
+		}
+	}
+	if r.state.entry == nil { // Text outside any entry
+		if strings.TrimSpace(line) != "" {
+			r.warn(r.line, "text outside any entry (skipped)")
+		}
+		return
+	}
+	r.raw = append(r.raw, line)
+
+	// ENTRY BOUNDARY DETECTION - Separator marks end of entry (always at column 0,
+	// so "---" inside a multiline detail value never ends the entry)
+
+	if strings.TrimRight(line, " \r") == entrySeparator {
+		r.endEntry("")
+		return
+	}
+
+	// SECTION HEADERS AND CONTENT
+
+	warning := ""
+	if name, value, isHeader := sectionHeader(line); isHeader {
+		warning = r.state.startSection(name, value, line)
+	} else if strings.TrimSpace(line) != "" || r.state.multiKey != "" {
+		warning = r.state.parseContentLine(line)
+	}
+	if warning != "" {
+		r.warn(r.line, "%s", warning)
+	}
+}
+
+// finish returns the complete entries; an entry (or cut-off line) the file
+// ended inside becomes report.TruncatedTail.
+func (r *entryReader) finish() []LogEntry {
+	if r.state.entry != nil { // Entry in progress when file ended
+		entry := *r.state.entry
+		r.report.TruncatedTail = &PartialEntry{Line: r.start, Lines: r.raw, Entry: &entry}
+		r.state, r.raw = parseState{}, nil
+	}
+	if r.cut != nil {
+		r.report.TruncatedTail, r.cut = r.cut, nil
+	}
+	return r.entries
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Stream Merging
+// ────────────────────────────────────────────────────────────────
+
+// compareEntries orders entries by (Timestamp, ContextID, Sequence).
+func compareEntries(a, b LogEntry) int {
+	if order := a.Timestamp.Compare(b.Timestamp); order != 0 { // Earlier first
+		return order
+	}
+	if order := strings.Compare(a.ContextID, b.ContextID); order != 0 { // Same instant - group by process
+		return order
+	}
+	return cmp.Compare(a.Sequence, b.Sequence) // Same process - write order
+}
+
+// MergeEntries interleaves entries from several logs into one deterministic order.
+//
+// What It Does:
+// Concatenates the streams and sorts by (Timestamp, ContextID, Sequence).
+// ContextID encodes component, PID, and logger start time, and Sequence only
+// grows within it, so (ContextID, Sequence) totally orders one process's
+// entries - ties in the nanosecond timestamp fall back to it. Entries equal on
+// all three (logger.sh or pre-sequence logs) keep their input order.
+//
+// Stream order does not matter for sequenced entries: a rotated file (.1)
+// passed before or after the current file merges the same way.
+//
+// Note: Timestamps are wall-clock. A clock stepped backwards mid-run can place
+// a later entry first; Sequence still shows the true write order.
+//
+// Example usage:
+//
+//	current, _ := logging.ReadLogFile(path)
+//	rotated, _ := logging.ReadLogFile(path + ".1")
+//	timeline := logging.MergeEntries(rotated, current, otherComponent)
+//
+func MergeEntries(streams ...[]LogEntry) []LogEntry {
+	var merged []LogEntry
+	for _, stream := range streams {
+		merged = append(merged, stream...)
 	}
+	slices.SortStableFunc(merged, compareEntries)
+	return merged
+}
+
+// ────────────────────────────────────────────────────────────────
+// Detail Accessors - Canonical Keys (details.go)
+// ────────────────────────────────────────────────────────────────
+// Read one concept out of an entry whichever way it was written: canonical
+// key first, then the older spellings, and whatever type the value came back
+// as (string from text logs, float64 from JSON lines, int in memory).
 
-	// FINAL ENTRY HANDLING - File may not end with separator
+// fileDetailAliases are the spellings components used for DetailFile before it existed.
+var fileDetailAliases = []string{DetailFile, "file_path", "filepath", "path", "target"}
 
-	if currentEntry != nil { // Entry in progress when file ended
-		entries = append(entries, *currentEntry) // Save final entry
+// detailInt reads an integer detail value of any type it arrives as.
+func detailInt(value any) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case float64:
+		return int(v), v == float64(int(v))
+	case string:
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		return n, err == nil
 	}
+	return 0, false
+}
 
-	return entries, scanner.Err() // Return entries and any scan error
+// ExtractFile returns the file an entry is about (DetailFile, then path, file_path, filepath, target).
+//
+// Example usage:
+//
+//	if file, ok := logging.ExtractFile(entry); ok {
+//	    byFile[file] = append(byFile[file], entry)
+//	}
+func ExtractFile(e LogEntry) (string, bool) {
+	for _, key := range fileDetailAliases {
+		if value, ok := e.Details[key]; ok {
+			if file := strings.TrimSpace(fmt.Sprint(value)); file != "" {
+				return file, true
+			}
+		}
+	}
+	return "", false
+}
+
+// ExtractDuration returns an entry's elapsed time (DetailDurationMS, then a DetailDuration string).
+func ExtractDuration(e LogEntry) (time.Duration, bool) {
+	if ms, ok := detailInt(e.Details[DetailDurationMS]); ok {
+		return time.Duration(ms) * time.Millisecond, true
+	}
+	switch v := e.Details[DetailDuration].(type) {
+	case time.Duration:
+		return v, true
+	case string:
+		if d, err := time.ParseDuration(strings.TrimSpace(v)); err == nil {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// ExtractExitCode returns an entry's process exit code (DetailExitCode).
+func ExtractExitCode(e LogEntry) (int, bool) {
+	return detailInt(e.Details[DetailExitCode])
+}
+
+// ExtractCount returns a CountDetail value ("<name>_count").
+func ExtractCount(e LogEntry, name string) (int, bool) {
+	return detailInt(e.Details[name+DetailCount])
+}
+
+// ExtractError returns an entry's error text (DetailError).
+func ExtractError(e LogEntry) (string, bool) {
+	value, ok := e.Details[DetailError]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprint(value), true
 }
 
 // ============================================================================
@@ -0,0 +1,387 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Log File Parsing Tests
+//
+// Purpose: Prove entries written by the Go logger read back intact - SEMANTIC
+//          metadata included, nested RecoveryParams and all - that logger.sh
+//          pipe headers still parse, that unknown sections are kept rather
+//          than breaking the reader, and that MergeEntries orders entries by
+//          (timestamp, context ID, sequence) across components and rotation.
+//          The corrupt-*.log fixtures prove damage is reported by line -
+//          truncated tails, spliced and interleaved writes, garbage bytes -
+//          instead of being folded into neighbouring entries.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestLogger routes log files into a temporary home
+func newTestLogger(t testing.TB, component string) *Logger {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	return NewLogger(component)
+}
+
+// readEntries parses a log file, leaving out NewLogger's writability check
+// and routing entry
+func readEntries(t *testing.T, path string) []LogEntry {
+	t.Helper()
+	entries, err := ReadLogFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var kept []LogEntry
+	for _, entry := range entries {
+		if entry.Event != fmt.Sprintf(eventCheckMsg, checkLogWritable) && entry.Event != eventLogRouted {
+			kept = append(kept, entry)
+		}
+	}
+	return kept
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestReadLogFileSemanticRoundTrip(t *testing.T) {
+	logger := newTestLogger(t, "parsing-test")
+	semantic := Metadata{
+		OperationType:    "file_validation",
+		OperationSubtype: "permission_check",
+		ErrorType:        "permission_denied",
+		ErrorDetails:     map[string]any{"errno": "EACCES"},
+		RecoveryHint:     "automated_fix",
+		RecoveryStrategy: "fix_file_permissions",
+		RecoveryParams: map[string]any{
+			"target": "/etc/config",
+			"mode":   "0644",
+			"owner":  map[string]any{"user": "root", "groups": []any{"wheel", "adm"}},
+			"force":  true,
+		},
+		Expected: map[string]any{"mode": "0644"},
+		Actual:   map[string]any{"mode": "0600"},
+	}
+	logger.Success("first", 10, map[string]any{"note": "plain entry"})
+	logger.FailureWithMetadata("Permission denied", "Insufficient permissions", -20,
+		map[string]any{"file": "/etc/config", "output": "line one\nline two"}, semantic)
+	logger.Error("unexpected", errors.New("boom"), -5)
+
+	entries := readEntries(t, logger.LogFile)
+	if len(entries) != 3 {
+		t.Fatalf("read %d entries, want 3", len(entries))
+	}
+
+	plain, failure := entries[0], entries[1]
+	if plain.Semantic != nil {
+		t.Errorf("plain entry Semantic = %+v, want nil", plain.Semantic)
+	}
+	if failure.Semantic == nil {
+		t.Fatal("metadata entry read back without Semantic")
+	}
+	if !reflect.DeepEqual(*failure.Semantic, semantic) {
+		t.Errorf("Semantic round-trip:\n got %#v\nwant %#v", *failure.Semantic, semantic)
+	}
+
+	if failure.Level != levelFailure || failure.Component != "parsing-test" || failure.Event != "Permission denied" {
+		t.Errorf("header/event = %q %q %q", failure.Level, failure.Component, failure.Event)
+	}
+	if failure.ContextID != logger.ContextID || failure.RawHealth != -10 || failure.HealthImpact != -20 {
+		t.Errorf("context %q raw %d Δ%d", failure.ContextID, failure.RawHealth, failure.HealthImpact)
+	}
+	if failure.NormalizedHealth != -10 {
+		t.Errorf("normalized = %d, want -10", failure.NormalizedHealth)
+	}
+	if failure.Details["reason"] != "Insufficient permissions" || failure.Details["output"] != "line one\nline two" {
+		t.Errorf("details = %#v", failure.Details)
+	}
+	if failure.Context == nil || failure.Context.CWD == "" {
+		t.Errorf("full-context entry read back without CWD: %+v", failure.Context)
+	}
+	if _, leaked := failure.Details["Operation Type"]; leaked {
+		t.Error("SEMANTIC fields leaked into Details")
+	}
+}
+
+func TestReadLogFileUnknownSectionsAndPipeHeader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mixed.log")
+	log := `[2026-10-16 09:00:00.000] CHECK | build | me@host:1 | build-1-2 | HEALTH: 40% (raw: 40, Δ+5) 🤍 [██████████████░░░░░░]
+  EVENT: Checking: go.mod
+  DETAILS:
+    file: go.mod
+---
+[2026-10-16 09:00:01.000] SUCCESS newer-writer
+  EVENT: written by a future format
+  PROVENANCE:
+    signer: nova
+    chain:
+      - a
+  SEMANTIC:
+    Operation Type: sync
+    Confidence: 0.9
+  HEALTH: 💚 [████████████████████████████████████████] (100/100) (Δ+100, Raw: 100)
+---
+`
+	if err := os.WriteFile(path, []byte(log), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := ReadLogFile(path)
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("read %d entries, err %v", len(entries), err)
+	}
+
+	shell := entries[0]
+	if shell.Level != "CHECK" || shell.Component != "build" || shell.User != "me@host:1" || shell.ContextID != "build-1-2" {
+		t.Errorf("pipe header = %+v", shell)
+	}
+	if shell.NormalizedHealth != 40 || shell.RawHealth != 40 || shell.HealthImpact != 5 || shell.Details["file"] != "go.mod" {
+		t.Errorf("pipe health/details = %d %d %d %v", shell.NormalizedHealth, shell.RawHealth, shell.HealthImpact, shell.Details)
+	}
+
+	newer := entries[1]
+	wantRaw := map[string][]string{
+		"PROVENANCE": {"  PROVENANCE:", "    signer: nova", "    chain:", "      - a"},
+		"SEMANTIC":   {"    Confidence: 0.9"},
+	}
+	if !reflect.DeepEqual(newer.RawSections, wantRaw) {
+		t.Errorf("RawSections = %#v", newer.RawSections)
+	}
+	if newer.Semantic == nil || newer.Semantic.OperationType != "sync" || newer.RawHealth != 100 {
+		t.Errorf("known content around unknown section lost: %+v raw %d", newer.Semantic, newer.RawHealth)
+	}
+}
+
+func TestMergeEntriesAcrossRotation(t *testing.T) {
+	first := newTestLogger(t, "merge-a")
+	second := NewLogger("merge-b") // Same temporary home
+	first.Success("a1", 1, nil)
+	second.Success("b1", 1, nil)
+	first.Success("a2", 1, nil)
+
+	// Rotation boundary: the session's earlier entries now live in .1
+	if err := os.Rename(first.LogFile, first.LogFile+".1"); err != nil {
+		t.Fatal(err)
+	}
+	first.Success("a3", 1, nil)
+	second.Success("b2", 1, nil)
+	first.Success("a4", 1, nil)
+
+	current := readEntries(t, first.LogFile)
+	rotated := readEntries(t, first.LogFile+".1")
+	others := readEntries(t, second.LogFile)
+
+	var want []string
+	for i, stream := range [][][]LogEntry{{current, others, rotated}, {rotated, current, others}} {
+		var events []string
+		var lastSeq uint64
+		for _, entry := range MergeEntries(stream...) {
+			events = append(events, entry.Event)
+			if entry.ContextID == first.ContextID {
+				if entry.Sequence <= lastSeq {
+					t.Errorf("stream order %d: %s sequence %d after %d", i, entry.Event, entry.Sequence, lastSeq)
+				}
+				lastSeq = entry.Sequence
+			}
+		}
+		if want == nil {
+			want = events
+		} else if !reflect.DeepEqual(events, want) {
+			t.Errorf("merge depends on stream order: %v vs %v", events, want)
+		}
+	}
+	if !reflect.DeepEqual(want, []string{"a1", "b1", "a2", "a3", "b2", "a4"}) {
+		t.Errorf("merged = %v", want)
+	}
+}
+
+func TestMergeEntriesBreaksTimestampTies(t *testing.T) {
+	at := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	entry := func(contextID string, seq uint64) LogEntry {
+		return LogEntry{Timestamp: at, ContextID: contextID, Sequence: seq}
+	}
+	merged := MergeEntries(
+		[]LogEntry{entry("b-2-1", 2), entry("a-1-1", 7)},
+		[]LogEntry{entry("b-2-1", 1), entry("a-1-1", 3)},
+	)
+	var order []string
+	for _, e := range merged {
+		order = append(order, fmt.Sprintf("%s#%d", e.ContextID, e.Sequence))
+	}
+	if got := strings.Join(order, " "); got != "a-1-1#3 a-1-1#7 b-2-1#1 b-2-1#2" {
+		t.Errorf("tie order = %s", got)
+	}
+}
+
+func TestHeaderCarriesSequence(t *testing.T) {
+	logger := newTestLogger(t, "sequence-test")
+	logger.Check("one", true, 1, nil)
+	logger.Check("two", true, 1, nil)
+
+	entries := readEntries(t, logger.LogFile)
+	if len(entries) != 2 {
+		t.Fatalf("read %d entries", len(entries))
+	}
+	if !entries[0].Timestamp.Before(entries[1].Timestamp) {
+		t.Errorf("nanosecond timestamps tie: %v", entries[0].Timestamp)
+	}
+	for i, entry := range entries { // Sequences 1 and 2 are NewLogger's writability check and routing entry
+		if entry.Sequence != uint64(i+3) || entry.ContextID != logger.ContextID {
+			t.Errorf("entry %d: sequence %d context %q", i, entry.Sequence, entry.ContextID)
+		}
+	}
+}
+
+func TestContainerFlagRoundTrip(t *testing.T) {
+	logger := newTestLogger(t, "container-test")
+	containerOnce.Do(func() {}) // Pin detection so the test controls the flag
+	defer func(saved bool) { inContainer = saved }(inContainer)
+
+	inContainer = true
+	logger.Failure("inside", "probe", -1, nil)
+	inContainer = false
+	logger.Failure("outside", "probe", -1, nil)
+
+	entries := readEntries(t, logger.LogFile)
+	if len(entries) != 2 || entries[0].Context == nil || entries[1].Context == nil {
+		t.Fatalf("read %d entries without full context", len(entries))
+	}
+	if !entries[0].Context.Container || entries[1].Context.Container {
+		t.Errorf("container flags = %v, %v", entries[0].Context.Container, entries[1].Context.Container)
+	}
+}
+
+// eventsOf lists the entries' events in order
+func eventsOf(entries []LogEntry) []string {
+	var events []string
+	for _, entry := range entries {
+		events = append(events, entry.Event)
+	}
+	return events
+}
+
+func TestReadLogFileReportCorruptFixtures(t *testing.T) {
+	cases := []struct {
+		fixture  string
+		events   []string
+		warnings map[int]string // Line → substring of the warning there
+		tailLine int            // 0 = no truncated tail
+	}{
+		{
+			fixture:  "corrupt-truncated.log",
+			events:   []string{"first", "second"},
+			tailLine: 13,
+		},
+		{
+			fixture: "corrupt-interleaved.log",
+			events:  []string{"cut short", "spliced", "no separator", "after the unterminated", "json whole", "second event"},
+			warnings: map[int]string{
+				4:  "spliced onto a cut-off line (6 bytes",
+				10: "ended without its --- separator (next entry at line 14)",
+				20: "cut off mid-write",
+				27: "second EVENT section",
+			},
+		},
+		{
+			fixture: "corrupt-garbage.log",
+			events:  []string{"before the garbage", "after the zeros", "damaged inside"},
+			warnings: map[int]string{
+				7:  "garbage bytes",
+				8:  "spliced onto a cut-off line (8 bytes",
+				18: "garbage bytes",
+				19: "malformed DETAILS line",
+				20: "unknown section SIGNATURE",
+				21: "outside any section",
+				23: "outside any entry",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.fixture, func(t *testing.T) {
+			entries, report, err := ReadLogFileReport(filepath.Join("testdata", tc.fixture))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := eventsOf(entries); !reflect.DeepEqual(got, tc.events) {
+				t.Errorf("events = %q, want %q", got, tc.events)
+			}
+
+			got := make(map[int]string)
+			for _, warning := range report.Warnings {
+				got[warning.Line] = warning.Message
+			}
+			if len(got) != len(report.Warnings) || len(got) != len(tc.warnings) {
+				t.Errorf("warnings:\n%s", strings.Join(report.Problems(), "\n"))
+			}
+			for line, want := range tc.warnings {
+				if !strings.Contains(got[line], want) {
+					t.Errorf("line %d warning = %q, want %q", line, got[line], want)
+				}
+			}
+
+			if tc.tailLine == 0 {
+				if report.TruncatedTail != nil {
+					t.Errorf("unexpected truncated tail at line %d", report.TruncatedTail.Line)
+				}
+				return
+			}
+			tail := report.TruncatedTail
+			if tail == nil || tail.Line != tc.tailLine || tail.Entry == nil || tail.Entry.Event != "third" {
+				t.Fatalf("truncated tail = %+v, want the entry from line %d", tail, tc.tailLine)
+			}
+			if last := tail.Lines[len(tail.Lines)-1]; last != "    reason: disk fu" || report.OK() {
+				t.Errorf("tail ends %q, OK = %v", last, report.OK())
+			}
+		})
+	}
+}
+
+func TestReadLogFileLenientKeepsTail(t *testing.T) {
+	entries, err := ReadLogFile(filepath.Join("testdata", "corrupt-truncated.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := eventsOf(entries); !reflect.DeepEqual(got, []string{"first", "second", "third"}) {
+		t.Errorf("lenient events = %q, want the unfinished entry last", got)
+	}
+}
+
+func TestReadLogFileReportCleanWrites(t *testing.T) {
+	// format-mixed.log holds exactly three deliberate oddities and no other damage
+	_, report, err := ReadLogFileReport(filepath.Join("testdata", "format-mixed.log"))
+	problems := strings.Join(report.Problems(), "\n")
+	want := "line 52: unknown section PROVENANCE (kept in RawSections)\n" +
+		"line 56: text outside any entry (skipped)\n" +
+		"line 57: truncated tail - entry never finished (4 lines)"
+	if err != nil || problems != want {
+		t.Errorf("format-mixed.log: %v\n%s", err, problems)
+	}
+
+	// "---" and a quoted header inside a multiline value are content, not boundaries
+	logger := newTestLogger(t, "report-test")
+	logger.Success("quoted", 1, map[string]any{"output": "---\n[2026-10-16 09:00:00] FAILURE other\ndone"})
+	logger.Success("after", 1, nil)
+	entries, report, err := ReadLogFileReport(logger.LogFile)
+	if err != nil || !report.OK() {
+		t.Fatalf("logger output: %v\n%s", err, strings.Join(report.Problems(), "\n"))
+	}
+	last := entries[len(entries)-2:]
+	if last[0].Details["output"] != "---\n[2026-10-16 09:00:00] FAILURE other\ndone" || last[1].Event != "after" {
+		t.Errorf("multiline value split: %#v / %q", last[0].Details, last[1].Event)
+	}
+}
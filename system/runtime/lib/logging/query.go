@@ -0,0 +1,184 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Entry Queries - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "Seek, and ye shall find" (Matthew 7:7, KJV)
+// Principle: What was recorded should be findable by what it concerns.
+// Anchor: Every log under one root, narrowed to the entries that answer the question.
+//
+// CPI-SI Identity
+//
+// Component Type: Log query module within Rails infrastructure
+// Role: Select entries across every component's log by component, level, tags, and time
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial EntryFilter, FilterEntries, QueryLogs
+//
+// Purpose & Function
+//
+// Purpose: TraceContext answers "what did this invocation do" and
+// FailureDigest "what is failing"; neither answers "everything network-related
+// this week". QueryLogs reads every log under a root (as TraceContext does),
+// merges them in time order, and keeps the entries an EntryFilter matches.
+//
+// Core Design: An EntryFilter is all-of its fields; within a field, any-of its
+// values - except AllTags, where an entry must carry every tag. Empty fields
+// do not restrict. FilterEntries applies the same filter to entries a caller
+// already holds (ReadLogFile, TraceContext).
+//
+// Blocking Status
+//
+// Non-blocking: Unreadable log files are skipped; only a missing root is an error.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	network, err := logging.QueryLogs(logging.LogsDir(), logging.EntryFilter{AnyTag: []string{"network"}})
+//	fmt.Print(logging.FormatEntriesHuman(network, logging.HumanOpts{}))
+//
+// Public API:
+//   EntryFilter - Components, Levels, AnyTag, AllTags, Since, Until
+//   (EntryFilter).Match(entry LogEntry) bool - Whether one entry passes
+//   FilterEntries(entries []LogEntry, filter EntryFilter) []LogEntry - Entries that pass, in order
+//   QueryLogs(logsDir string, filter EntryFilter) ([]LogEntry, error) - Matching entries across a log root
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: slices, time
+//   Package Files: correlation.go (readLogTree), parsing.go (MergeEntries), tags.go (LogEntry.HasTag)
+//
+// Dependents (What Uses This):
+//   External: tooling that slices logs by concern
+//
+// Health Scoring
+//
+// Queries: 0 (read-only)
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"slices" // Component and level membership
+	"time"   // Time bounds
+)
+
+// Types
+
+// EntryFilter selects log entries. Every set field must match; empty fields
+// match everything.
+type EntryFilter struct {
+	Components []string  // Entry's component is one of these
+	Levels     []string  // Entry's level is one of these (OPERATION, SUCCESS, ...)
+	AnyTag     []string  // Entry carries at least one of these tags
+	AllTags    []string  // Entry carries every one of these tags
+	Since      time.Time // Entry is at or after this time (zero = no lower bound)
+	Until      time.Time // Entry is before this time (zero = no upper bound)
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// Match reports whether entry passes every set field of the filter.
+func (f EntryFilter) Match(entry LogEntry) bool {
+	if len(f.Components) > 0 && !slices.Contains(f.Components, entry.Component) {
+		return false
+	}
+	if len(f.Levels) > 0 && !slices.Contains(f.Levels, entry.Level) {
+		return false
+	}
+	if len(f.AnyTag) > 0 && !slices.ContainsFunc(f.AnyTag, entry.HasTag) {
+		return false
+	}
+	for _, tag := range f.AllTags {
+		if !entry.HasTag(tag) {
+			return false
+		}
+	}
+	if !f.Since.IsZero() && entry.Timestamp.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !entry.Timestamp.Before(f.Until) {
+		return false
+	}
+	return true
+}
+
+// FilterEntries returns the entries filter matches, in their original order.
+func FilterEntries(entries []LogEntry, filter EntryFilter) []LogEntry {
+	var matched []LogEntry
+	for _, entry := range entries {
+		if filter.Match(entry) {
+			matched = append(matched, entry)
+		}
+	}
+	return matched
+}
+
+// QueryLogs returns the entries under logsDir that filter matches.
+//
+// What It Does:
+// Reads every log under logsDir (current and rotated; level-split files are
+// skipped - their entries are in the main log), merges them in time order,
+// and keeps the matching entries.
+//
+// Parameters:
+//
+//	logsDir: Log root to search (LogsDir() for the configured one)
+//	filter: Components, levels, tags, and time bounds to match
+//
+// Returns:
+//
+//	[]LogEntry: Matching entries in MergeEntries order (nil when none match)
+//	error: logsDir could not be walked
+//
+// Example usage:
+//
+//	entries, err := logging.QueryLogs(logging.LogsDir(), logging.EntryFilter{
+//	    AllTags: []string{"network", "user-facing"},
+//	    Since:   time.Now().Add(-24 * time.Hour),
+//	})
+func QueryLogs(logsDir string, filter EntryFilter) ([]LogEntry, error) {
+	streams, err := readLogTree(logsDir)
+	if err != nil {
+		return nil, err
+	}
+	return FilterEntries(MergeEntries(streams...), filter), nil
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
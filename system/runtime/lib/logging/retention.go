@@ -0,0 +1,401 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Age Rotation & Retention - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "To every thing there is a season, and a time to every purpose under the heaven." - Ecclesiastes 3:1 (KJV)
+// Principle: Days are the unit people remember by - the record should be kept the same way.
+// Anchor: "What happened last Tuesday" should be a file name, not a search.
+//
+// CPI-SI Identity
+//
+// Component Type: Rotation policy module within Rails infrastructure
+// Role: Decide when a log is too old to keep appending to, what its rotation is called, and how much history stays
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.1.1
+// Last Modified: 2026-10-16 - First-entry scan uses the parser's feedLine (spliced and cut-off entries)
+//
+// Purpose & Function
+//
+// Purpose: Size-based rotation alone lets a quiet component keep one .log for
+// eight months. [rotation] max_age_days (global, or per component under
+// [rotation.component_max_age_days]) rotates a log whose first entry is older
+// than that, even under the size threshold.
+//
+// Core Design: A log's age is the timestamp of its first entry - read once and
+// cached per file (a rotated or replaced file is re-read, checked with
+// os.SameFile). With rotation_naming = "date" a rotation is named for that
+// first entry's day (validate.2025-11-10.log, then validate.2025-11-10.2.log
+// for a second rotation the same day) instead of shifting .1 through .5.
+// Date-named rotations are never shifted or counted out - [retention]
+// max_total_size_mb bounds them: after every rotation the oldest rotations
+// (either scheme, by modification time) are removed until the log and its
+// rotations fit. The current log is never removed, and every removal is an
+// audited action (removeRotation) - deleting history leaves a record in the
+// append-only audit trail.
+//
+//   validate.log                  current
+//   validate.2025-11-10.log       date naming (first entry's day)
+//   validate.2025-11-10.2.log     second rotation that day
+//   validate.log.1 ... .5         numeric naming (default)
+//
+// Directory readers need nothing new: both schemes contain ".log", and
+// IsLevelFile strips a date suffix before matching level suffixes.
+//
+// Blocking Status
+//
+// Non-blocking: An unreadable first entry means no age rotation; a failed
+// retention removal warns to stderr and the write continues.
+//
+// Usage & Integration
+//
+// Usage: Internal - rotateLogOver (writing.go) consults logExpired before
+// rotating, finishRotation names through datedRotationPath, and every
+// completed rotation ends with enforceRetention.
+//
+// Internal API:
+//   maxLogAge(logPath) time.Duration - Configured age limit for a log (0 = none)
+//   logExpired(logPath, info) bool - Whether the log's first entry is past the limit
+//   logStartTime(logPath, info) (time.Time, bool) - First entry timestamp (cached)
+//   forgetLogStart(logPath) - Drop the cached start once the log rotates away
+//   dateNaming() bool - Whether rotation_naming is "date"
+//   rotationDay(staging) time.Time - Day a staged log is named for
+//   datedRotationPath(logPath, day) string - Free component.YYYY-MM-DD[.N].log name
+//   trimDatedSuffix(stem) string - Name without a date rotation suffix
+//   logRotations(logPath) []string - Existing rotations of a log, both schemes
+//   enforceRetention(logPath) - Remove oldest rotations past max_total_size_mb (Logger method, audited)
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bufio, fmt, os, path/filepath, regexp, sort, strings, sync, time
+//   Package Files: config.go (Config.Rotation, Config.Retention), writing.go (rotationPath,
+//                  maxLogRotations), parsing.go (entryReader),
+//                  encryption.go (frameOpener, encryptedMagic), clock.go (now),
+//                  logger.go (logFileExtension), levelfiles.go (bytesPerMB),
+//                  audit.go (removeRotation)
+//
+// Dependents (What Uses This):
+//   Internal: writing.go (rotateLogOver, finishRotation), levelfiles.go (IsLevelFile)
+//
+// Health Scoring
+//
+// Rotation policy is infrastructure - no health impact of its own.
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"bufio"         // First entry scan
+	"fmt"           // Dated rotation names, stderr warnings
+	"os"            // Stat, directory listing
+	"path/filepath" // Rotation directory and names
+	"regexp"        // Date rotation suffix
+	"sort"          // Oldest rotations first
+	"strings"       // Name trimming
+	"sync"          // Start time cache
+	"time"          // Ages and days
+)
+
+// Constants
+
+const (
+	rotationNamingDate = "date"         // rotation_naming value for component.YYYY-MM-DD.log
+	rotationDateLayout = "2006-01-02"   // Day in a dated rotation name
+	oneDay             = 24 * time.Hour // max_age_days unit
+)
+
+// datedSuffix matches a date rotation suffix on a name without its extension
+// (".2025-11-10" or ".2025-11-10.2").
+var datedSuffix = regexp.MustCompile(`\.\d{4}-\d{2}-\d{2}(\.\d+)?$`)
+
+// Types
+
+// logStart caches the first entry timestamp of one log file.
+type logStart struct {
+	info  os.FileInfo // File the timestamp was read from (os.SameFile check)
+	start time.Time   // First entry timestamp (zero = none found)
+}
+
+// Package-Level State
+
+var (
+	logStartsMu sync.Mutex
+	logStarts   = make(map[string]logStart) // Log path → first entry timestamp
+)
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Configuration
+// ────────────────────────────────────────────────────────────────
+
+// maxLogAge returns the age limit for logPath (0 = no age rotation).
+//
+// [rotation.component_max_age_days] wins over max_age_days. Level-split files
+// follow their component ("validate.errors.log" uses "validate").
+func maxLogAge(logPath string) time.Duration {
+	LoadConfig()
+	if !ConfigLoaded {
+		return 0
+	}
+	days := Config.Rotation.MaxAgeDays
+	if componentDays, ok := Config.Rotation.ComponentMaxAgeDays[logComponent(logPath)]; ok {
+		days = componentDays
+	}
+	if days <= 0 {
+		return 0
+	}
+	return time.Duration(days) * oneDay
+}
+
+// logComponent returns the component a log file belongs to.
+func logComponent(logPath string) string {
+	stem := strings.TrimSuffix(filepath.Base(logPath), logFileExtension)
+	for _, suffix := range Config.Routing.LevelFiles {
+		if suffix = strings.Trim(suffix, "."); suffix != "" && strings.HasSuffix(stem, "."+suffix) {
+			return strings.TrimSuffix(stem, "."+suffix)
+		}
+	}
+	return stem
+}
+
+// dateNaming reports whether rotations are named by date ([rotation] rotation_naming).
+func dateNaming() bool {
+	LoadConfig()
+	return ConfigLoaded && Config.Rotation.Naming == rotationNamingDate
+}
+
+// maxTotalBytes returns the [retention] max_total_size_mb limit in bytes (0 = unlimited).
+func maxTotalBytes() int64 {
+	LoadConfig()
+	if !ConfigLoaded || Config.Retention.MaxTotalSizeMB <= 0 {
+		return 0
+	}
+	return int64(Config.Retention.MaxTotalSizeMB) * bytesPerMB
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Log Age
+// ────────────────────────────────────────────────────────────────
+
+// firstEntryTime reads the timestamp of the first entry in path.
+//
+// Stops at the first entry header - text, JSON, or sealed - so a large log
+// costs one short read. Returns false when the file holds no entry yet.
+func firstEntryTime(path string) (time.Time, bool) {
+	file, err := os.Open(path)
+	if err != nil {
+		return time.Time{}, false
+	}
+	defer file.Close()
+
+	reader := entryReader{version: logFormatLegacy}
+	opener := frameOpener{path: path}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineBytes)
+
+	started := func() (time.Time, bool) {
+		if len(reader.entries) > 0 {
+			return reader.entries[0].Timestamp, true
+		}
+		if reader.state.entry != nil {
+			return reader.state.entry.Timestamp, true
+		}
+		return time.Time{}, false
+	}
+	for scanner.Scan() {
+		reader.feedLine(scanner.Text(), &opener)
+		if start, ok := started(); ok {
+			return start, !start.IsZero()
+		}
+	}
+	return time.Time{}, false
+}
+
+// logStartTime returns the first entry timestamp of logPath, cached per file.
+//
+// The cache holds the FileInfo it was read from; once the log is rotated or
+// replaced (os.SameFile fails) the new file is read again. An empty log is
+// never cached - its first entry is still to come.
+func logStartTime(logPath string, info os.FileInfo) (time.Time, bool) {
+	logStartsMu.Lock()
+	defer logStartsMu.Unlock()
+
+	if cached, ok := logStarts[logPath]; ok && os.SameFile(cached.info, info) {
+		return cached.start, !cached.start.IsZero()
+	}
+	start, ok := firstEntryTime(logPath)
+	if ok {
+		logStarts[logPath] = logStart{info: info, start: start}
+	} else {
+		delete(logStarts, logPath)
+	}
+	return start, ok
+}
+
+// forgetLogStart drops the cached start of logPath (the log was just rotated away).
+//
+// os.SameFile alone is not enough: a deleted rotation's inode can be reused by
+// the fresh log, which would then inherit the old start.
+func forgetLogStart(logPath string) {
+	logStartsMu.Lock()
+	defer logStartsMu.Unlock()
+	delete(logStarts, logPath)
+}
+
+// logExpired reports whether logPath's first entry is older than its age limit.
+func logExpired(logPath string, info os.FileInfo) bool {
+	limit := maxLogAge(logPath)
+	if limit <= 0 || info.Size() == 0 {
+		return false
+	}
+	start, ok := logStartTime(logPath, info)
+	return ok && now().Sub(start) >= limit
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Rotation Naming
+// ────────────────────────────────────────────────────────────────
+
+// datedRotationPath returns a free date-named rotation for logPath.
+//
+// validate.log on 2025-11-10 → validate.2025-11-10.log; when that exists,
+// validate.2025-11-10.2.log, .3, ... (never overwrites a rotation).
+func datedRotationPath(logPath string, day time.Time) string {
+	base := strings.TrimSuffix(logPath, logFileExtension) + "." + day.Format(rotationDateLayout)
+	path := base + logFileExtension
+	for n := 2; ; n++ {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return path
+		}
+		path = fmt.Sprintf("%s.%d%s", base, n, logFileExtension)
+	}
+}
+
+// rotationDay returns the day a staged log is named for: its first entry's,
+// else its modification time's, else today.
+func rotationDay(staging string) time.Time {
+	if start, ok := firstEntryTime(staging); ok {
+		return start
+	}
+	if info, err := os.Stat(staging); err == nil {
+		return info.ModTime()
+	}
+	return now()
+}
+
+// trimDatedSuffix drops a date rotation suffix from a name without its extension.
+func trimDatedSuffix(stem string) string {
+	return datedSuffix.ReplaceAllString(stem, "")
+}
+
+// logRotations lists the existing rotations of logPath in both naming schemes.
+//
+// Numeric rotations are .1 through .5; date-named ones are every
+// stem.YYYY-MM-DD[.N].log beside the log (a level file's rotations carry its
+// own stem, so validate.errors.2025-11-10.log is not validate's).
+func logRotations(logPath string) []string {
+	var rotations []string
+	for i := 1; i <= maxLogRotations; i++ {
+		if path := rotationPath(logPath, i); fileExists(path) {
+			rotations = append(rotations, path)
+		}
+	}
+
+	stem := strings.TrimSuffix(filepath.Base(logPath), logFileExtension)
+	entries, err := os.ReadDir(filepath.Dir(logPath))
+	if err != nil {
+		return rotations
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, stem+".") || !strings.HasSuffix(name, logFileExtension) {
+			continue
+		}
+		if rest := strings.TrimSuffix(name, logFileExtension)[len(stem):]; datedSuffix.MatchString(rest) && trimDatedSuffix(rest) == "" {
+			rotations = append(rotations, filepath.Join(filepath.Dir(logPath), name))
+		}
+	}
+	return rotations
+}
+
+// fileExists reports whether path can be stat'ed.
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Retention
+// ────────────────────────────────────────────────────────────────
+
+// enforceRetention removes logPath's oldest rotations while the log and its
+// rotations exceed [retention] max_total_size_mb.
+//
+// Both naming schemes count, oldest modification time first. The current log
+// is counted but never removed. Each removal is audited (removeRotation).
+func (l *Logger) enforceRetention(logPath string) {
+	limit := maxTotalBytes()
+	if limit <= 0 {
+		return
+	}
+
+	type rotation struct {
+		path string
+		info os.FileInfo
+	}
+	var total int64
+	if info, err := os.Stat(logPath); err == nil {
+		total = info.Size()
+	}
+	var rotations []rotation
+	for _, path := range logRotations(logPath) {
+		if info, err := os.Stat(path); err == nil {
+			rotations = append(rotations, rotation{path, info})
+			total += info.Size()
+		}
+	}
+	sort.SliceStable(rotations, func(i, j int) bool {
+		return rotations[i].info.ModTime().Before(rotations[j].info.ModTime())
+	})
+
+	for _, oldest := range rotations {
+		if total <= limit {
+			return
+		}
+		if err := l.removeRotation(oldest.path, "max_total_size_mb"); err != nil {
+			fmt.Fprintf(os.Stderr, "WARNING: Failed to remove rotation %s past max_total_size_mb: %v\n", oldest.path, err)
+			continue
+		}
+		total -= oldest.info.Size()
+	}
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
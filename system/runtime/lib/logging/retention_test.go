@@ -0,0 +1,206 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Age Rotation & Retention Tests
+//
+// Purpose: Prove a log rotates once its first entry passes max_age_days even
+//          far under the size threshold (per-component values winning), that
+//          rotation_naming = "date" names rotations for their first entry's day
+//          without overwriting one, that directory readers see date-named
+//          rotations, and that they count toward max_total_size_mb.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+// useFakeClock installs a settable clock starting at fixedClockTime
+func useFakeClock(t *testing.T) *fakeClock {
+	t.Helper()
+	clock := &fakeClock{now: fixedClockTime}
+	useClock(t, ClockFunc(clock.read))
+	return clock
+}
+
+// logEvents lists the events in a log file, NewLogger's startup entries left out
+func logEvents(t *testing.T, path string) []string {
+	t.Helper()
+	return events(readEntries(t, path))
+}
+
+// writeSized creates path as a sparse file of size bytes, last modified at modified
+func writeSized(t *testing.T, path string, size int64, modified time.Time) {
+	t.Helper()
+	if err := os.WriteFile(path, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(path, size); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modified, modified); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestAgeRotatesUnderSizeLimit(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) { cfg.Rotation.MaxAgeDays = 7 })
+	clock := useFakeClock(t)
+	logger := newTestLogger(t, "age-test")
+
+	logger.Success("day one", 0, nil)
+	clock.advance(6 * oneDay)
+	logger.Success("day six", 0, nil)
+	if fileExists(rotationPath(logger.LogFile, 1)) {
+		t.Fatal("rotated before max_age_days")
+	}
+
+	clock.advance(2 * oneDay)
+	logger.Success("day eight", 0, nil)
+	if got := logEvents(t, rotationPath(logger.LogFile, 1)); !reflect.DeepEqual(got, []string{"day one", "day six"}) {
+		t.Errorf("rotation .1 = %q, want the week-old entries", got)
+	}
+	if got := logEvents(t, logger.LogFile); !reflect.DeepEqual(got, []string{"day eight"}) {
+		t.Errorf("current log = %q, want a fresh file", got)
+	}
+}
+
+func TestComponentMaxAgeOverridesGlobal(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Rotation.MaxAgeDays = 30
+		cfg.Rotation.ComponentMaxAgeDays = map[string]int{"age-fast": 1}
+	})
+	clock := useFakeClock(t)
+	fast, slow := newTestLogger(t, "age-fast"), newTestLogger(t, "age-slow")
+
+	clock.advance(2 * oneDay)
+	fast.Success("later", 0, nil)
+	slow.Success("later", 0, nil)
+	if !fileExists(rotationPath(fast.LogFile, 1)) {
+		t.Error("component max_age_days = 1 did not rotate a two-day-old log")
+	}
+	if fileExists(rotationPath(slow.LogFile, 1)) {
+		t.Error("global max_age_days = 30 rotated a two-day-old log")
+	}
+}
+
+func TestDateNamedRotation(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Rotation.MaxAgeDays = 1
+		cfg.Rotation.Naming = rotationNamingDate
+		cfg.Routing.LevelFiles = map[string]string{levelError: "errors"}
+	})
+	clock := useFakeClock(t)
+	logger := newTestLogger(t, "age-date")
+	stem := logger.LogFile[:len(logger.LogFile)-len(logFileExtension)]
+
+	logger.Success("first", 0, nil)
+	clock.advance(oneDay)
+	logger.Success("second", 0, nil)        // Aged out - 2026-10-16 rotation
+	logger.rotateLogOver(logger.LogFile, 1) // Size rotation the same day - 2026-10-17
+	logger.Success("third", 0, nil)
+	logger.rotateLogOver(logger.LogFile, 1) // Again - 2026-10-17.2, never overwritten
+	logger.Success("fourth", 0, nil)
+
+	want := map[string][]string{
+		stem + ".2026-10-16.log":   {"first"},
+		stem + ".2026-10-17.log":   {"second"},
+		stem + ".2026-10-17.2.log": {"third"},
+		logger.LogFile:             {"fourth"},
+	}
+	for path, wantEvents := range want {
+		if got := logEvents(t, path); !reflect.DeepEqual(got, wantEvents) {
+			t.Errorf("%s = %q, want %q", filepath.Base(path), got, wantEvents)
+		}
+	}
+	if fileExists(rotationPath(logger.LogFile, 1)) {
+		t.Error("date naming also wrote a numeric rotation")
+	}
+
+	// Directory readers see every rotation, in either scheme, and skip level files
+	if err := os.WriteFile(rotationPath(logger.LogFile, 1), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(stem+".errors.2026-10-16.log", nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if !IsLevelFile(stem+".errors.2026-10-16.log") || IsLevelFile(stem+".2026-10-16.log") {
+		t.Error("IsLevelFile does not see through the date suffix")
+	}
+	if got := len(logRotations(logger.LogFile)); got != 4 {
+		t.Errorf("logRotations found %d, want 3 dated + 1 numeric", got)
+	}
+	streams, err := readLogTree(filepath.Dir(logger.LogFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var seen []string
+	for _, entry := range MergeEntries(streams...) {
+		if entry.Level == levelSuccess {
+			seen = append(seen, entry.Event)
+		}
+	}
+	if !reflect.DeepEqual(seen, []string{"first", "second", "third", "fourth"}) {
+		t.Errorf("directory read = %q, want every rotation once", seen)
+	}
+}
+
+func TestRetentionCountsDateNamedRotations(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) { cfg.Retention.MaxTotalSizeMB = 1 })
+	logger := newTestLogger(t, "retention-test")
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "keep.log")
+	day := func(n int) time.Time { return fixedClockTime.AddDate(0, 0, n) }
+
+	// Numeric rotation + current log alone (700 KB) fit; the dated ones push past 1 MB
+	writeSized(t, filepath.Join(dir, "keep.errors.2026-10-09.log"), 400<<10, day(-7)) // Another file's rotation
+	writeSized(t, filepath.Join(dir, "keep.2026-10-10.log"), 400<<10, day(-6))
+	writeSized(t, filepath.Join(dir, "keep.2026-10-11.log"), 400<<10, day(-5))
+	writeSized(t, rotationPath(logPath, 1), 400<<10, day(-4))
+	writeSized(t, logPath, 300<<10, day(0))
+
+	logger.enforceRetention(logPath)
+
+	var remaining []string
+	var total int64
+	entries, _ := os.ReadDir(dir)
+	for _, entry := range entries {
+		remaining = append(remaining, entry.Name())
+		if info, err := entry.Info(); err == nil && entry.Name() != "keep.errors.2026-10-09.log" {
+			total += info.Size()
+		}
+	}
+	sort.Strings(remaining)
+	if want := []string{"keep.errors.2026-10-09.log", "keep.log", "keep.log.1"}; !reflect.DeepEqual(remaining, want) {
+		t.Errorf("after retention: %q, want %q (oldest dated rotations removed first)", remaining, want)
+	}
+	if total > 1<<20 {
+		t.Errorf("log + rotations = %d bytes, over max_total_size_mb", total)
+	}
+
+	// Unlimited keeps everything
+	writeSized(t, filepath.Join(dir, "keep.2026-10-12.log"), 400<<10, day(-3))
+	Config.Retention.MaxTotalSizeMB = 0
+	logger.enforceRetention(logPath)
+	if !fileExists(filepath.Join(dir, "keep.2026-10-12.log")) {
+		t.Error("max_total_size_mb = 0 removed a rotation")
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,192 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Component Routing - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "Let all things be done decently and in order." - 1 Corinthians 14:40 (KJV)
+// Principle: Everything has its place, and a newcomer should find it without being told.
+// Anchor: The code that asks for a logger already says what kind of code it is.
+//
+// CPI-SI Identity
+//
+// Component Type: Routing module within Rails infrastructure
+// Role: Decide which log subdirectory a component's log lives in
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Caller-package routing extracted from logger.go
+//
+// Purpose & Function
+//
+// Purpose: Routing only knew components listed in [routing] in logging.toml,
+// so every new command logged to system/ until someone updated the lists -
+// and the lists were already stale. NewLogger now also looks at who called
+// it: the instantiating package's path (or, for package main, its source
+// directory) says whether it is a command, a script, or a library.
+//
+// Core Design: Three mechanisms, highest precedence first:
+//   1. config  - component listed in [routing] commands/scripts/libraries
+//   2. caller  - caller's package path contains /cmd/, /scripts/, or /lib/
+//   3. default - system/
+// NewLoggerWithRouting skips all three ("explicit"). Every logger's first
+// entries include a DEBUG "log routed" naming the subdirectory, the
+// mechanism, and the caller - a misrouted log says why it landed there.
+//
+//   cmd/diagnose (package main, .../runtime/cmd/diagnose/) → commands/ (caller)
+//   system/lib/temporal                                    → libraries/ (caller)
+//   "validate" listed under [routing] commands             → commands/ (config)
+//
+// Blocking Status
+//
+// Non-blocking: Caller lookup failures fall through to system/.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	logger := logging.NewLogger("diagnose")                    // Routed by config, then caller
+//	logger := logging.NewLoggerWithRouting("migrate", "scripts") // Forced placement
+//
+// Public API:
+//   NewLoggerWithRouting(component, subdir string) *Logger - Logger in a forced subdirectory (logger.go)
+//
+// Internal API:
+//   determineLogSubdirectory(component, caller string) (string, string) - Subdirectory and mechanism
+//   callerPackage(skip int) string - Package path (or main's source directory) skip frames up
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: path/filepath, runtime, slices, strings
+//   Package Files: config.go (Config.Routing, LoadConfig), logger.go (subdirectory constants)
+//
+// Dependents (What Uses This):
+//   Internal: logger.go (NewLogger, NewLoggerWithRouting)
+//
+// Health Scoring
+//
+// Routing decision: 0 (DEBUG entry, informational)
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"path/filepath" // Main package source directories
+	"runtime"       // Caller frame lookup
+	"slices"        // Config routing list membership
+	"strings"       // Package path markers
+)
+
+// Constants
+
+const (
+	routeConfig   = "config"   // Listed in [routing]
+	routeCaller   = "caller"   // Caller's package path
+	routeExplicit = "explicit" // NewLoggerWithRouting
+	routeDefault  = "default"  // Nothing matched - system/
+
+	eventLogRouted = "log routed" // DEBUG entry recording the routing decision
+)
+
+// callerRoutes maps package path markers to subdirectories, checked in order.
+var callerRoutes = []struct {
+	marker string
+	subdir string
+}{
+	{"/cmd/", commandsSubdir},
+	{"/scripts/", scriptsSubdir},
+	{"/lib/", librariesSubdir},
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Caller Lookup
+// ────────────────────────────────────────────────────────────────
+
+// callerPackage returns the package path of the function skip frames above
+// callerPackage's caller ("" when unknown).
+//
+// Package main has no import path, so its source directory stands in - a
+// command built from .../runtime/cmd/diagnose/ still reads as /cmd/.
+func callerPackage(skip int) string {
+	pc, file, _, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return ""
+	}
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return filepath.ToSlash(filepath.Dir(file))
+	}
+
+	// "system/lib/temporal.init" / "hooks/lib/session.(*Runner).Run" → package path
+	name := fn.Name()
+	lastSlash := strings.LastIndex(name, "/")
+	pkg := name
+	if dot := strings.Index(name[lastSlash+1:], "."); dot >= 0 {
+		pkg = name[:lastSlash+1+dot]
+	}
+	if pkg == "main" {
+		return filepath.ToSlash(filepath.Dir(file))
+	}
+	return pkg
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Routing Decision
+// ────────────────────────────────────────────────────────────────
+
+// determineLogSubdirectory routes a component to commands/, scripts/,
+// libraries/, or system/, and reports which mechanism decided.
+//
+// [routing] lists win; otherwise caller (callerPackage's result) is matched
+// against /cmd/, /scripts/, /lib/; otherwise system/.
+func determineLogSubdirectory(component, caller string) (subdir, mechanism string) {
+	LoadConfig()
+
+	switch {
+	case slices.Contains(Config.Routing.Commands, component):
+		return commandsSubdir, routeConfig
+	case slices.Contains(Config.Routing.Scripts, component):
+		return scriptsSubdir, routeConfig
+	case slices.Contains(Config.Routing.Libraries, component):
+		return librariesSubdir, routeConfig
+	}
+
+	if caller != "" {
+		path := "/" + strings.Trim(caller, "/") + "/"
+		for _, route := range callerRoutes {
+			if strings.Contains(path, route.marker) {
+				return route.subdir, routeCaller
+			}
+		}
+	}
+	return systemLogsSubdir, routeDefault
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
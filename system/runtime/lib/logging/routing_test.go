@@ -0,0 +1,116 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Component Routing Tests
+//
+// Purpose: Prove [routing] lists outrank the caller's package, each caller
+//          category lands in its subdirectory, unknown callers fall back to
+//          system/, and NewLoggerWithRouting places logs where it is told -
+//          every decision recorded in a DEBUG "log routed" entry.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// routedEntry returns the "log routed" entry from a logger's file
+func routedEntry(t *testing.T, logger *Logger) LogEntry {
+	t.Helper()
+	entries, err := ReadLogFile(logger.LogFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		if entry.Event == eventLogRouted {
+			return entry
+		}
+	}
+	t.Fatalf("no %q entry in %s", eventLogRouted, logger.LogFile)
+	return LogEntry{}
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestRoutingByCaller(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Routing.Commands, cfg.Routing.Scripts, cfg.Routing.Libraries = nil, nil, nil
+	})
+	cases := []struct {
+		caller, subdir, mechanism string
+	}{
+		{"/home/seanje/.claude/cpi-si/system/runtime/cmd/diagnose", commandsSubdir, routeCaller},
+		{"cmd/diagnose", commandsSubdir, routeCaller},
+		{"system/scripts/build", scriptsSubdir, routeCaller},
+		{"system/lib/temporal", librariesSubdir, routeCaller},
+		{"hooks/lib/session", librariesSubdir, routeCaller},
+		{"hooks/session/cmd-start", systemLogsSubdir, routeDefault}, // "cmd-start" is not /cmd/
+		{"", systemLogsSubdir, routeDefault},
+	}
+	for _, tc := range cases {
+		subdir, mechanism := determineLogSubdirectory("some-component", tc.caller)
+		if subdir != tc.subdir || mechanism != tc.mechanism {
+			t.Errorf("caller %q → %s (%s), want %s (%s)", tc.caller, subdir, mechanism, tc.subdir, tc.mechanism)
+		}
+	}
+}
+
+func TestRoutingConfigOverridesCaller(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Routing.Commands, cfg.Routing.Scripts, cfg.Routing.Libraries = []string{"validate"}, []string{"migrate"}, nil
+	})
+
+	if subdir, mechanism := determineLogSubdirectory("validate", "system/lib/validation"); subdir != commandsSubdir || mechanism != routeConfig {
+		t.Errorf("listed command from a library → %s (%s), want commands (config)", subdir, mechanism)
+	}
+	if subdir, mechanism := determineLogSubdirectory("migrate", "cmd/migrate"); subdir != scriptsSubdir || mechanism != routeConfig {
+		t.Errorf("listed script from a command → %s (%s), want scripts (config)", subdir, mechanism)
+	}
+}
+
+func TestNewLoggerRecordsRouting(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Routing.Commands, cfg.Routing.Scripts, cfg.Routing.Libraries = nil, nil, nil
+	})
+	logger := newTestLogger(t, "routed-test")
+
+	if filepath.Base(filepath.Dir(logger.LogFile)) != librariesSubdir {
+		t.Errorf("test caller logged to %s, want libraries/ (system/lib/logging)", logger.LogFile)
+	}
+	entry := routedEntry(t, logger)
+	if entry.Level != levelDebug || entry.Details["mechanism"] != routeCaller || entry.Details["caller"] != "system/lib/logging" {
+		t.Errorf("routing entry = %s %v", entry.Level, entry.Details)
+	}
+}
+
+func TestNewLoggerWithRouting(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	logger := NewLoggerWithRouting("forced-test", scriptsSubdir)
+	if filepath.Base(filepath.Dir(logger.LogFile)) != scriptsSubdir {
+		t.Errorf("explicit scripts → %s", logger.LogFile)
+	}
+	if entry := routedEntry(t, logger); entry.Details["mechanism"] != routeExplicit {
+		t.Errorf("explicit routing recorded as %v", entry.Details)
+	}
+
+	escaped := NewLoggerWithRouting("escape-test", "../elsewhere")
+	if filepath.Base(filepath.Dir(escaped.LogFile)) != systemLogsSubdir {
+		t.Errorf("non-local subdirectory → %s, want system/", escaped.LogFile)
+	}
+	if entry := routedEntry(t, escaped); entry.Details["mechanism"] != routeDefault {
+		t.Errorf("rejected subdirectory recorded as %v", entry.Details)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
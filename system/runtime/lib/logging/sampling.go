@@ -0,0 +1,357 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Entry Sampling - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "In the multitude of words there wanteth not sin: but he that refraineth his lips is wise." - Proverbs 10:19 (KJV)
+// Principle: Restraint keeps the record readable. Fifty thousand identical checks say less than five hundred.
+// Anchor: Health is never sampled - every entry still counts. Only the text thins out, and the text says by how much.
+//
+// CPI-SI Identity
+//
+// Component Type: Sampling module within Rails infrastructure
+// Role: Thin out high-frequency levels (DEBUG/CHECK in per-file loops) before they reach disk
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial fixed and adaptive sampling
+//
+// Purpose & Function
+//
+// Purpose: A library calling Check() once per file wrote 50k CHECK entries
+// during a large workspace scan - useless detail and real I/O cost. Sampling
+// writes 1 of every N entries of a level; the rest update health and run
+// counts but never capture context or touch the file.
+//
+// Core Design: Counter-based, so output is deterministic: per level, the 1st,
+// (N+1)th, (2N+1)th... entry is written. Each written entry carries
+// sampled: "1/N" (and skipped_health when skipped entries moved health) so
+// readers know the density. Rate resolution, first match wins:
+//   1. (*Logger).SetSampling(level, rate)
+//   2. [sampling.components.<component>] LEVEL = rate
+//   3. [sampling.levels] LEVEL = rate
+//   4. 1 (every entry written)
+// ERROR and FAILURE are never sampled - every failure keeps its own entry.
+//
+// Adaptive mode ([sampling] adaptive = true): when more than
+// adaptive_threshold entries of one level arrive within a second, that
+// level's rate is multiplied by adaptive_factor and a CONTEXT entry announces
+// it. When a later second stays at or under the threshold (or the Logger is
+// flushed), the configured rate is restored and announced again.
+//
+// Blocking Status
+//
+// Non-blocking: Sampling only decides whether to write; it never fails.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	logger := logging.NewLogger("validation")
+//	logger.SetSampling("CHECK", 100) // Per-file checks: keep 1 in 100
+//
+// Public API:
+//   (*Logger).SetSampling(level string, rate int) - Override the rate for one level (rate ≤ 1 writes every entry)
+//
+// Internal API:
+//   sampleEntry(level, impact) - Decide whether to write, annotate what was written (Logger method)
+//   endSamplingBursts() - Restore adaptive rates when the Logger is flushed (Logger method)
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: fmt, strings, time
+//   Package Files: config.go (Config.Sampling), logger.go (level constants),
+//                  entry.go (createBaseEntry), writing.go (writeEntry)
+//
+// Dependents (What Uses This):
+//   Internal: logger.go (logEntry, logEntryWithMetadata, Flush)
+//
+// Health Scoring
+//
+// Sampling: 0 (health deltas of skipped entries are applied in full)
+// Adaptive announcements: 0 (reporting only)
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"fmt"     // Sampled density and announcement formatting
+	"strings" // Level name normalization
+	"time"    // Adaptive burst windows
+)
+
+// Constants
+
+const (
+	//--- Adaptive Sampling Defaults ---
+	// Fallbacks when [sampling] omits the adaptive keys.
+
+	adaptiveThresholdDefault = 1000        // Entries of one level per second before sampling rises
+	adaptiveFactorDefault    = 10          // Rate multiplier during a burst
+	adaptiveWindow           = time.Second // Burst measurement window
+
+	//--- Announcement Formats ---
+
+	eventSamplingRaised   = "Sampling %s entries 1/%d (%d arrived within a second)" // Burst detected
+	eventSamplingRestored = "Sampling %s entries restored to 1/%d"                  // Burst ended
+)
+
+// Types
+
+// levelSampling tracks one level's sampling position and burst window.
+type levelSampling struct {
+	override     int       // SetSampling rate (0 = use config)
+	seen         uint64    // Entries of this level arrived (written or not)
+	skippedDelta int       // Health delta of entries skipped since the last written one
+	windowStart  time.Time // Start of the current adaptive window
+	windowCount  int       // Entries arrived in the current window
+	burstRate    int       // Rate while an adaptive burst is active (0 = no burst)
+}
+
+// samplingState holds every level's sampling position for one Logger.
+//
+// clock is injectable so adaptive bursts are testable without sleeping.
+type samplingState struct {
+	levels map[string]*levelSampling
+	clock  func() time.Time // nil = package clock (clock.go)
+}
+
+// neverSampled lists levels that always write every entry.
+var neverSampled = map[string]bool{
+	levelError:   true, // Every unexpected error keeps its stack trace
+	levelFailure: true, // Every expected failure keeps its reason
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Foundation Functions
+// ────────────────────────────────────────────────────────────────
+
+// level returns the sampling position for level, creating it on first use.
+func (s *samplingState) level(level string) *levelSampling {
+	if s.levels == nil {
+		s.levels = make(map[string]*levelSampling)
+	}
+	state, ok := s.levels[level]
+	if !ok {
+		state = &levelSampling{}
+		s.levels[level] = state
+	}
+	return state
+}
+
+// now reads the injectable clock.
+func (s *samplingState) now() time.Time {
+	if s.clock != nil {
+		return s.clock()
+	}
+	return now()
+}
+
+// configuredRate returns the rate for level ignoring adaptive bursts (1 = write all).
+func (l *Logger) configuredRate(level string) int {
+	rate := 1
+	if state := l.sampling.levels[level]; state != nil && state.override > 0 {
+		rate = state.override
+	} else if ConfigLoaded {
+		if perComponent, ok := Config.Sampling.Components[l.Component][level]; ok {
+			rate = perComponent
+		} else if perLevel, ok := Config.Sampling.Levels[level]; ok {
+			rate = perLevel
+		}
+	}
+	if rate < 1 || neverSampled[level] {
+		return 1
+	}
+	return rate
+}
+
+// adaptiveSettings returns whether adaptive sampling is on, its threshold, and its factor.
+func adaptiveSettings() (enabled bool, threshold, factor int) {
+	if !ConfigLoaded || !Config.Sampling.Adaptive {
+		return false, 0, 0
+	}
+	threshold, factor = adaptiveThresholdDefault, adaptiveFactorDefault
+	if Config.Sampling.AdaptiveThreshold > 0 {
+		threshold = Config.Sampling.AdaptiveThreshold
+	}
+	if Config.Sampling.AdaptiveFactor > 1 {
+		factor = Config.Sampling.AdaptiveFactor
+	}
+	return true, threshold, factor
+}
+
+// announceSampling writes a zero-impact CONTEXT entry describing a rate change.
+//
+// Written directly (never itself sampled or counted toward the run summary).
+func (l *Logger) announceSampling(event string, details map[string]any) {
+	who := &SystemContext{User: l.username, Host: l.hostname, PID: l.pid} // Identity only - no capture cost
+	entry := l.createBaseEntry(who, 0)
+	entry.Level = levelContext
+	entry.Event = event
+	entry.Details = details
+	l.writeEntry(entry)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Sampling Decisions
+// ────────────────────────────────────────────────────────────────
+
+// trackBurst advances level's adaptive window, raising or restoring its rate.
+func (l *Logger) trackBurst(level string, state *levelSampling) {
+	enabled, threshold, factor := adaptiveSettings()
+	if !enabled || neverSampled[level] {
+		return
+	}
+
+	now := l.sampling.now()
+	if elapsed := now.Sub(state.windowStart); elapsed >= adaptiveWindow { // New window
+		calm := state.windowCount <= threshold || elapsed >= 2*adaptiveWindow // Last window quiet, or a whole empty window since
+		if state.burstRate > 0 && calm {
+			l.restoreBurst(level, state)
+		}
+		state.windowStart, state.windowCount = now, 0
+	}
+	state.windowCount++
+
+	if state.burstRate == 0 && state.windowCount > threshold {
+		state.burstRate = l.configuredRate(level) * factor
+		l.announceSampling(fmt.Sprintf(eventSamplingRaised, level, state.burstRate, state.windowCount), map[string]any{
+			"level":     level,
+			"rate":      fmt.Sprintf("1/%d", state.burstRate),
+			"threshold": threshold,
+		})
+	}
+}
+
+// restoreBurst ends level's adaptive burst and announces the configured rate.
+func (l *Logger) restoreBurst(level string, state *levelSampling) {
+	state.burstRate = 0
+	rate := l.configuredRate(level)
+	l.announceSampling(fmt.Sprintf(eventSamplingRestored, level, rate), map[string]any{
+		"level": level,
+		"rate":  fmt.Sprintf("1/%d", rate),
+	})
+}
+
+// sampleEntry decides whether an entry of level is written.
+//
+// Returns write=false for a skipped entry (its health delta is remembered for
+// the next written entry). For a written entry at a rate above 1, annotate
+// holds the details to add: sampled density and any skipped health.
+func (l *Logger) sampleEntry(level string, healthImpact int) (write bool, annotate map[string]any) {
+	if neverSampled[level] {
+		return true, nil
+	}
+	LoadConfig()
+
+	state := l.sampling.level(level)
+	l.trackBurst(level, state)
+
+	rate := l.configuredRate(level)
+	if state.burstRate > rate {
+		rate = state.burstRate
+	}
+
+	position := state.seen
+	state.seen++
+	if rate <= 1 {
+		return true, nil
+	}
+	if position%uint64(rate) != 0 { // Not the first of this group of N
+		state.skippedDelta += healthImpact
+		return false, nil
+	}
+
+	annotate = map[string]any{"sampled": fmt.Sprintf("1/%d", rate)}
+	if state.skippedDelta != 0 {
+		annotate["skipped_health"] = state.skippedDelta
+		state.skippedDelta = 0
+	}
+	return true, annotate
+}
+
+// annotateSampled merges sampling annotations into a copy of details.
+//
+// Copies so the caller's map is never modified.
+func annotateSampled(details map[string]any, annotate map[string]any) map[string]any {
+	if annotate == nil {
+		return details
+	}
+	merged := make(map[string]any, len(details)+len(annotate))
+	for key, value := range details {
+		merged[key] = value
+	}
+	for key, value := range annotate {
+		merged[key] = value
+	}
+	return merged
+}
+
+// endSamplingBursts restores every level still in an adaptive burst.
+func (l *Logger) endSamplingBursts() {
+	for level, state := range l.sampling.levels {
+		if state.burstRate > 0 {
+			l.restoreBurst(level, state)
+		}
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// SetSampling writes 1 of every rate entries of level for this Logger.
+//
+// What It Does:
+// Overrides [sampling] config for one level. Skipped entries still apply their
+// health delta and count toward Finalize; written entries carry
+// sampled: "1/rate". A rate of 1 or less writes every entry (but still wins
+// over config). ERROR and FAILURE are never sampled.
+//
+// Parameters:
+//
+//	level: Log level name (CHECK, DEBUG, SUCCESS, ...) - case-insensitive
+//	rate: Write 1 of every rate entries
+//
+// Example usage:
+//
+//	logger.SetSampling("CHECK", 100)
+//	for _, file := range files {
+//	    logger.Check("syntax "+file, ok, 0, nil) // 1 in 100 written
+//	}
+func (l *Logger) SetSampling(level string, rate int) {
+	if rate < 1 {
+		rate = 1
+	}
+	l.sampling.level(strings.ToUpper(level)).override = rate
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,195 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Entry Sampling Tests
+//
+// Purpose: Prove sampling writes exactly 1 of every N entries (the first of
+//          each group), annotates density and skipped health, never loses
+//          health or run counts, never samples failures, resolves rates
+//          override → component → level, and that adaptive bursts raise and
+//          restore the rate with announcements - driven by an injected clock.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fakeClock is a settable time source for adaptive windows
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) read() time.Time         { return c.now }
+func (c *fakeClock) advance(d time.Duration) { c.now = c.now.Add(d) }
+
+// events lists entry events in file order
+func events(entries []LogEntry) []string {
+	var out []string
+	for _, entry := range entries {
+		out = append(out, entry.Event)
+	}
+	return out
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestSetSamplingWritesOneInN(t *testing.T) {
+	logger := newTestLogger(t, "sampling-test")
+	logger.SetSampling("success", 3)
+
+	for i := 1; i <= 7; i++ {
+		logger.Success(fmt.Sprintf("file-%d", i), 1, nil)
+	}
+
+	entries := readEntries(t, logger.LogFile)
+	if got := events(entries); !reflect.DeepEqual(got, []string{"file-1", "file-4", "file-7"}) {
+		t.Fatalf("written = %q, want file-1, file-4, file-7", got)
+	}
+	for i, entry := range entries {
+		if entry.Details["sampled"] != "1/3" {
+			t.Errorf("%s sampled = %q, want 1/3", entry.Event, entry.Details["sampled"])
+		}
+		wantSkipped := "2"
+		if i == 0 {
+			wantSkipped = "" // Nothing skipped before the first
+		}
+		if got, _ := entry.Details["skipped_health"].(string); got != wantSkipped {
+			t.Errorf("%s skipped_health = %q, want %q", entry.Event, got, wantSkipped)
+		}
+	}
+	if logger.SessionHealth != 7 || logger.levelCounts[levelSuccess] != 7 {
+		t.Errorf("health %d, SUCCESS count %d - skipped entries must still count (want 7, 7)",
+			logger.SessionHealth, logger.levelCounts[levelSuccess])
+	}
+}
+
+func TestSamplingKeepsCallerDetails(t *testing.T) {
+	logger := newTestLogger(t, "sampling-details-test")
+	logger.SetSampling(levelSuccess, 2)
+
+	details := map[string]any{"file": "a.go"}
+	logger.Success("checked", 0, details)
+	if _, ok := details["sampled"]; ok || len(details) != 1 {
+		t.Errorf("caller's details modified: %v", details)
+	}
+}
+
+func TestFailuresNeverSampled(t *testing.T) {
+	logger := newTestLogger(t, "sampling-failure-test")
+	logger.SetSampling(levelFailure, 10)
+
+	for i := 0; i < 3; i++ {
+		logger.Failure("rejected", "bad input", -1, nil)
+	}
+	entries := readEntries(t, logger.LogFile)
+	if len(entries) != 3 {
+		t.Fatalf("wrote %d failures, want all 3", len(entries))
+	}
+	if _, ok := entries[0].Details["sampled"]; ok {
+		t.Errorf("failure annotated as sampled: %v", entries[0].Details)
+	}
+}
+
+func TestSamplingRatePrecedence(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Sampling = SamplingConfig{
+			Levels:     map[string]int{levelCheck: 5, levelDebug: 0},
+			Components: map[string]map[string]int{"scanner": {levelCheck: 50}},
+		}
+	})
+	logger := &Logger{Component: "scanner"}
+	other := &Logger{Component: "other"}
+
+	cases := []struct {
+		logger *Logger
+		level  string
+		want   int
+	}{
+		{logger, levelCheck, 50}, // Component table wins over levels
+		{other, levelCheck, 5},   // Levels table
+		{other, levelDebug, 1},   // Invalid rate means every entry
+		{other, levelSuccess, 1}, // Unlisted
+		{other, levelFailure, 1}, // Never sampled
+	}
+	for _, tc := range cases {
+		if got := tc.logger.configuredRate(tc.level); got != tc.want {
+			t.Errorf("%s %s rate = %d, want %d", tc.logger.Component, tc.level, got, tc.want)
+		}
+	}
+
+	logger.SetSampling(levelCheck, 1) // Override wins, even to turn sampling off
+	if got := logger.configuredRate(levelCheck); got != 1 {
+		t.Errorf("override rate = %d, want 1", got)
+	}
+}
+
+func TestAdaptiveSamplingBurst(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Sampling = SamplingConfig{Adaptive: true, AdaptiveThreshold: 3, AdaptiveFactor: 2}
+	})
+	logger := newTestLogger(t, "sampling-adaptive-test")
+	clock := &fakeClock{now: time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)}
+	logger.sampling.clock = clock.read
+
+	for i := 1; i <= 5; i++ { // 4th arrival in one second crosses the threshold
+		logger.Success(fmt.Sprintf("s%d", i), 0, nil)
+	}
+	clock.advance(1500 * time.Millisecond)
+	logger.Success("s6", 0, nil) // Previous second was busy - burst continues
+	clock.advance(1100 * time.Millisecond)
+	logger.Success("s7", 0, nil) // Previous second was calm - restored
+
+	entries := readEntries(t, logger.LogFile)
+	want := []string{
+		"s1", "s2", "s3",
+		fmt.Sprintf(eventSamplingRaised, levelSuccess, 2, 4),
+		"s5",
+		fmt.Sprintf(eventSamplingRestored, levelSuccess, 1),
+		"s7",
+	}
+	if got := events(entries); !reflect.DeepEqual(got, want) {
+		t.Fatalf("written =\n%q\nwant\n%q", got, want)
+	}
+	if entries[3].Level != levelContext || entries[3].HealthImpact != 0 {
+		t.Errorf("announcement = %s %+d, want CONTEXT +0", entries[3].Level, entries[3].HealthImpact)
+	}
+	if entries[4].Details["sampled"] != "1/2" {
+		t.Errorf("burst entry sampled = %q, want 1/2", entries[4].Details["sampled"])
+	}
+	if _, ok := entries[6].Details["sampled"]; ok {
+		t.Errorf("entry after restore still annotated: %v", entries[6].Details)
+	}
+}
+
+func TestFlushEndsAdaptiveBurst(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) { cfg.Sampling = SamplingConfig{Adaptive: true, AdaptiveThreshold: 1} })
+	logger := newTestLogger(t, "sampling-flush-test")
+	clock := &fakeClock{now: time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)}
+	logger.sampling.clock = clock.read
+
+	logger.Success("a", 0, nil)
+	logger.Success("b", 0, nil) // Burst: rate 1/10 (default factor)
+	logger.Flush()
+
+	entries := readEntries(t, logger.LogFile)
+	last := entries[len(entries)-1]
+	if last.Event != fmt.Sprintf(eventSamplingRestored, levelSuccess, 1) {
+		t.Errorf("last entry = %q, want restore announcement", last.Event)
+	}
+	if logger.sampling.levels[levelSuccess].burstRate != 0 {
+		t.Error("burst still active after Flush")
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
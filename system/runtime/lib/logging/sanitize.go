@@ -0,0 +1,490 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Log Sanitizing for Sharing - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "He that goeth about as a talebearer revealeth secrets: therefore meddle not with him that flattereth with his lips." - Proverbs 20:19 (KJV)
+// Principle: Share the matter, not what was entrusted alongside it.
+// Anchor: A log excerpt attached to a bug report should carry the failure, not the home directory layout or a token.
+//
+// CPI-SI Identity
+//
+// Component Type: Export transform within Rails infrastructure
+// Role: Copy entries with paths, environment, and secrets made safe to share
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.1.0
+// Last Modified: 2026-10-16 - ExportLog output starts with the format version header
+//
+// Purpose & Function
+//
+// Purpose: Entries record absolute paths (CWD, file arguments, stack traces),
+// the captured environment, and whatever a caller put in Details. Sharing a
+// log excerpt shared all of it. SanitizeEntries returns copies with those
+// made safe; ExportLog reads a log, sanitizes it, and writes the result -
+// the log on disk is never touched.
+//
+// Core Design: One string transform applied everywhere a string can hide -
+// Event, CWD, Details (keys and values, nested maps and slices, the
+// stack_trace), Interactions, Semantic (error details, RecoveryParams,
+// Expected/Actual), and unknown sections kept in RawSections. In order:
+//
+//   1. Secrets redacted (RedactSecrets) - string values under secret-looking
+//      names ("api_key", "GITHUB_TOKEN"), name=value pairs in text, bearer
+//      tokens, URL credentials, well-known token formats, PEM private keys.
+//      captureEnvState applies the same redaction when the context is captured.
+//   2. Paths hashed (HashPaths) - every component beyond the workspace root
+//      (home when no root is set) becomes 8 hex digits of its SHA-256, keeping
+//      a file's extension: ~/proj/src/main.go → ~/proj/3f2a9c1e/5b0d77aa.go.
+//      The same name always hashes the same, so one file can be followed
+//      across entries and excerpts. Common names can still be guessed.
+//   3. Home replaced with ~ (TildeHome).
+//
+// Environment capture is dropped entirely with StripEnv.
+//
+// Blocking Status
+//
+// Non-blocking: Pure transform. ExportLog returns ReadLogFile's error (including
+// ErrEncrypted) before writing anything, and the writer's error if a write fails.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	rules := logging.DefaultShareRules
+//	rules.WorkspaceRoot = "/media/me/Project/CreativeWorkzStudio_LLC"
+//	rules.WorkspaceToken = "$WORKSPACE"
+//	if err := logging.ExportLog(path, os.Stdout, rules); err != nil {
+//	    fmt.Fprintln(os.Stderr, err)
+//	}
+//
+// Public API:
+//   SanitizeRules - What to redact, hash, replace, and strip
+//   DefaultShareRules - Every protection on; paths hashed beyond home
+//   SanitizeEntries(entries []LogEntry, rules SanitizeRules) []LogEntry - Sanitized copies
+//   ExportLog(path string, w io.Writer, rules SanitizeRules) error - Read, sanitize, write
+//
+// Internal API:
+//   redactSecret(name, value string) string - Redaction for one named value (context.go)
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: crypto/sha256, encoding/hex, fmt, io, os, path/filepath,
+//                     reflect, regexp, strings
+//   Package Files: parsing.go (ReadLogFile), entry.go (renderEntry, LogEntry), version.go (formatVersionHeader)
+//
+// Dependents (What Uses This):
+//   Internal: context.go (captureEnvState)
+//   External: Tooling that attaches log excerpts to reports
+//
+// Health Scoring
+//
+// Transform only: 0 (no Logger, no entries written to logs)
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"crypto/sha256" // Path component hashing
+	"encoding/hex"  // Hash rendering
+	"fmt"           // Non-basic detail values rendered as text
+	"io"            // Export destination
+	"os"            // Home directory default
+	"path/filepath" // Extension kept on hashed file names, root cleaning
+	"reflect"       // Basic detail values (numbers, bools) pass through
+	"regexp"        // Secret and path patterns
+	"strings"       // Component splitting
+)
+
+// Constants
+
+const (
+	redactedValue  = "[REDACTED]" // Replaces every redacted secret
+	pathHashDigits = 8            // Hex digits kept per hashed component
+
+	// pathStopChars end a path inside free text ("main.go:42", "(/tmp/x)", "k=/a/b").
+	pathStopChars = "\\s\"'`<>|:;,(){}\\[\\]=*?"
+)
+
+// Types
+
+// SanitizeRules selects what SanitizeEntries does to each entry.
+type SanitizeRules struct {
+	RedactSecrets  bool   // Redact secret-looking values, tokens, credentials, private keys
+	HashPaths      bool   // Hash path components beyond WorkspaceRoot
+	WorkspaceRoot  string // Root kept readable ("" = home)
+	WorkspaceToken string // Shown in place of WorkspaceRoot ("" = the root as written, ~ applied)
+	TildeHome      bool   // Replace the home directory with ~
+	Home           string // Home directory ("" = os.UserHomeDir)
+	StripEnv       bool   // Drop captured environment variables entirely
+}
+
+// Package-Level State
+
+// DefaultShareRules turns every protection on. Paths are hashed beyond home -
+// set WorkspaceRoot to keep a project's layout down to its root readable.
+var DefaultShareRules = SanitizeRules{
+	RedactSecrets: true,
+	HashPaths:     true,
+	TildeHome:     true,
+	StripEnv:      true,
+}
+
+// secretNamePattern matches names whose values are secrets (details keys, env vars).
+var secretNamePattern = regexp.MustCompile(`(?i)(passw(or)?d|passphrase|secret|token|api_?key|access_?key|private_?key|credential|authorization|cookie)`)
+
+// secretValuePatterns find secrets inside free text, most specific first.
+var secretValuePatterns = []struct {
+	pattern *regexp.Regexp
+	replace string
+}{
+	{regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`), redactedValue},
+	{regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{20,}|github_pat_[A-Za-z0-9_]{20,}|sk-[A-Za-z0-9_-]{20,}|AKIA[0-9A-Z]{16}|xox[abposr]-[A-Za-z0-9-]{10,})`), redactedValue},
+	{regexp.MustCompile(`(?i)\b(bearer|basic)\s+[A-Za-z0-9._~+/=-]{8,}`), "$1 " + redactedValue},
+	{regexp.MustCompile(`://[^/\s:@]+:[^/\s@]+@`), "://" + redactedValue + "@"},
+	{regexp.MustCompile(`(?i)\b(\w*(?:passw(?:or)?d|passphrase|secret|token|api_?key|access_?key|private_?key|credential)\w*)(\s*[=:]\s*)("[^"]*"|'[^']*'|[^\s,;&]+)`), "${1}${2}" + redactedValue},
+}
+
+// sanitizer is one SanitizeRules compiled for the walk.
+type sanitizer struct {
+	rules       SanitizeRules
+	roots       []*regexp.Regexp // Workspace root forms (absolute, ~/...) with the components after them
+	rootShown   []string         // What each root form is shown as
+	homePattern *regexp.Regexp   // Home at a path boundary
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs
+//   ├── SanitizeEntries() → uses newSanitizer(), (*sanitizer).entry()
+//   └── ExportLog() → uses ReadLogFile(), SanitizeEntries(), renderEntry()
+//
+//   Core Operations
+//   ├── (*sanitizer).entry() → uses text(), value(), stringMap(), anyMap()
+//   └── (*sanitizer).text() → uses redactSecrets(), hashComponents()
+//
+//   Helpers
+//   ├── newSanitizer() → compiles root and home patterns
+//   ├── redactSecret() / redactSecrets() → secret patterns (shared with captureEnvState)
+//   └── hashComponent() / hashComponents() → sha256 per component
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Secrets
+// ────────────────────────────────────────────────────────────────
+
+// redactSecrets replaces every secret found inside text.
+func redactSecrets(text string) string {
+	for _, secret := range secretValuePatterns {
+		text = secret.pattern.ReplaceAllString(text, secret.replace)
+	}
+	return text
+}
+
+// redactSecret redacts a named value: whole when the name looks secret,
+// otherwise any secret found inside it.
+func redactSecret(name, value string) string {
+	if value != "" && secretNamePattern.MatchString(name) {
+		return redactedValue
+	}
+	return redactSecrets(value)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Paths
+// ────────────────────────────────────────────────────────────────
+
+// hashComponent hashes one path component, keeping a file extension.
+func hashComponent(name string) string {
+	if name == "" || name == "." || name == ".." {
+		return name
+	}
+	ext := filepath.Ext(name)
+	if ext == name { // Dotfile (".claude") - the whole name is the secret
+		ext = ""
+	}
+	sum := sha256.Sum256([]byte(strings.TrimSuffix(name, ext)))
+	return hex.EncodeToString(sum[:])[:pathHashDigits] + ext
+}
+
+// hashComponents hashes every component of "/a/b.go" ("/" kept).
+func hashComponents(rest string) string {
+	parts := strings.Split(rest, "/")
+	for i, part := range parts {
+		parts[i] = hashComponent(part)
+	}
+	return strings.Join(parts, "/")
+}
+
+// newSanitizer compiles rules: the workspace root (and its ~ form) and home.
+func newSanitizer(rules SanitizeRules) *sanitizer {
+	s := &sanitizer{rules: rules}
+	home := rules.Home
+	if home == "" {
+		home, _ = os.UserHomeDir()
+	}
+	home = strings.TrimSuffix(filepath.ToSlash(filepath.Clean(home)), "/")
+	if home == "." {
+		home = ""
+	}
+
+	component := `[^/` + pathStopChars + `]`
+	boundary := `([` + pathStopChars + `]|$)`
+	if rules.HashPaths {
+		root := home
+		if rules.WorkspaceRoot != "" {
+			root = strings.TrimSuffix(filepath.ToSlash(filepath.Clean(rules.WorkspaceRoot)), "/")
+		}
+		forms := []string{root}
+		if rest, found := strings.CutPrefix(root, home); home != "" && found && (rest == "" || rest[0] == '/') {
+			forms = append(forms, "~"+rest) // Text may already say ~/...
+		}
+		for _, form := range forms {
+			if form == "" {
+				continue
+			}
+			shown := form
+			if rules.WorkspaceToken != "" {
+				shown = rules.WorkspaceToken
+			}
+			s.roots = append(s.roots, regexp.MustCompile(regexp.QuoteMeta(form)+`((?:/`+component+`*)*)`+boundary))
+			s.rootShown = append(s.rootShown, shown)
+		}
+	}
+	if rules.TildeHome && home != "" {
+		s.homePattern = regexp.MustCompile(regexp.QuoteMeta(home) + `(/|[` + pathStopChars + `]|$)`)
+	}
+	return s
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Transform
+// ────────────────────────────────────────────────────────────────
+
+// text applies the rules to one string: secrets, then paths, then home.
+func (s *sanitizer) text(value string) string {
+	if s.rules.RedactSecrets {
+		value = redactSecrets(value)
+	}
+	for i, root := range s.roots {
+		shown := s.rootShown[i]
+		value = root.ReplaceAllStringFunc(value, func(match string) string {
+			groups := root.FindStringSubmatch(match)
+			return shown + hashComponents(groups[1]) + groups[2]
+		})
+	}
+	if s.homePattern != nil {
+		value = s.homePattern.ReplaceAllString(value, "~${1}")
+	}
+	return value
+}
+
+// named sanitizes a value stored under name - redacted whole when the name looks secret.
+func (s *sanitizer) named(name, value string) string {
+	if s.rules.RedactSecrets && value != "" && secretNamePattern.MatchString(name) {
+		return redactedValue
+	}
+	return s.text(value)
+}
+
+// value sanitizes any detail value. Strings, maps, and slices are walked;
+// numbers and bools pass through; anything else is rendered as text first.
+func (s *sanitizer) value(name string, value any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return s.named(name, v)
+	case map[string]any:
+		return s.anyMap(v)
+	case map[string]string:
+		return s.stringMap(v)
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = s.value(name, item)
+		}
+		return out
+	case []string:
+		out := make([]string, len(v))
+		for i, item := range v {
+			out[i] = s.named(name, item)
+		}
+		return out
+	case error:
+		return s.named(name, v.Error())
+	}
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return value
+	}
+	return s.named(name, fmt.Sprint(value)) // Structs, pointers, ... may hold paths
+}
+
+// anyMap sanitizes keys and values (nil stays nil).
+func (s *sanitizer) anyMap(m map[string]any) map[string]any {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]any, len(m))
+	for key, value := range m {
+		out[s.text(key)] = s.value(key, value)
+	}
+	return out
+}
+
+// stringMap sanitizes keys and values (nil stays nil).
+func (s *sanitizer) stringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]string, len(m))
+	for key, value := range m {
+		out[s.text(key)] = s.named(key, value)
+	}
+	return out
+}
+
+// entry returns a sanitized copy sharing nothing mutable with the original.
+func (s *sanitizer) entry(entry LogEntry) LogEntry {
+	entry.Event = s.text(entry.Event)
+	entry.Details = s.anyMap(entry.Details) // stack_trace included
+
+	if entry.Context != nil {
+		ctx := *entry.Context
+		ctx.CWD = s.text(ctx.CWD)
+		if s.rules.StripEnv {
+			ctx.EnvState = nil
+		} else {
+			ctx.EnvState = s.stringMap(ctx.EnvState)
+		}
+		entry.Context = &ctx
+	}
+
+	if entry.Interactions != nil {
+		interactions := Interactions{
+			Dependencies: s.stringMap(entry.Interactions.Dependencies),
+			StateChanges: s.stringMap(entry.Interactions.StateChanges),
+		}
+		for _, op := range entry.Interactions.Concurrent {
+			interactions.Concurrent = append(interactions.Concurrent, s.text(op))
+		}
+		entry.Interactions = &interactions
+	}
+
+	if entry.Semantic != nil {
+		semantic := *entry.Semantic
+		semantic.ErrorType = s.text(semantic.ErrorType)
+		semantic.ErrorDetails = s.anyMap(semantic.ErrorDetails)
+		semantic.RecoveryHint = s.text(semantic.RecoveryHint)
+		semantic.RecoveryStrategy = s.text(semantic.RecoveryStrategy)
+		semantic.RecoveryParams = s.anyMap(semantic.RecoveryParams)
+		semantic.Expected = s.anyMap(semantic.Expected)
+		semantic.Actual = s.anyMap(semantic.Actual)
+		entry.Semantic = &semantic
+	}
+
+	if entry.RawSections != nil {
+		raw := make(map[string][]string, len(entry.RawSections))
+		for section, lines := range entry.RawSections {
+			sanitized := make([]string, len(lines))
+			for i, line := range lines {
+				sanitized[i] = s.text(line)
+			}
+			raw[section] = sanitized
+		}
+		entry.RawSections = raw
+	}
+	return entry
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public API - Sanitize and Export
+// ────────────────────────────────────────────────────────────────
+
+// SanitizeEntries returns copies of entries made safe to share under rules.
+//
+// The originals - and every map, slice, and pointer they hold - are left as
+// they were.
+//
+// Example usage:
+//
+//	entries, _ := logging.ReadLogFile(path)
+//	shared := logging.SanitizeEntries(entries, logging.DefaultShareRules)
+func SanitizeEntries(entries []LogEntry, rules SanitizeRules) []LogEntry {
+	if entries == nil {
+		return nil
+	}
+	s := newSanitizer(rules)
+	out := make([]LogEntry, len(entries))
+	for i, entry := range entries {
+		out[i] = s.entry(entry)
+	}
+	return out
+}
+
+// ExportLog reads the log at path, sanitizes every entry, and writes them to w
+// in the configured output format (text or JSON), behind the current format
+// version header - the export reads back with ReadLogFile like any log.
+//
+// Sealed entries are opened with the key like any read, so the export is
+// plaintext - sanitized, but no longer encrypted.
+//
+// Returns:
+//   error: ReadLogFile's error (errors.Is(err, ErrEncrypted) without the key)
+//          before anything is written, or the first write error
+//
+// Example usage:
+//
+//	var excerpt bytes.Buffer
+//	err := logging.ExportLog(path, &excerpt, logging.DefaultShareRules)
+func ExportLog(path string, w io.Writer, rules SanitizeRules) error {
+	entries, err := ReadLogFile(path)
+	if err != nil {
+		return err
+	}
+	LoadConfig() // Output format for renderEntry
+	if _, err := io.WriteString(w, formatVersionHeader(logFormatVersion)); err != nil {
+		return err
+	}
+	var exporter Logger
+	for _, entry := range SanitizeEntries(entries, rules) {
+		if _, err := io.WriteString(w, exporter.renderEntry(entry)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
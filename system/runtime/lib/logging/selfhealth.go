@@ -0,0 +1,364 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Logger Self-Health - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "Physician, heal thyself" - Luke 4:23 (KJV)
+// Principle: The part that watches everything else must also be watched.
+// Anchor: A detection layer that cannot say it is sick reports health it does not have.
+//
+// CPI-SI Identity
+//
+// Component Type: Self-monitoring module within Rails infrastructure
+// Role: Count the logger's own degradation and report it where a broken pipeline cannot hide it
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial self-diagnostics and logging-health.log
+//
+// Purpose & Function
+//
+// Purpose: Failed writes, rotation errors, and default-config fallbacks warn
+// to stderr and scroll away - nothing adds them up. Every Logger counts its
+// own degradation (atomic counters, one increment per event, no allocation):
+//
+//   write failures     entries the log file (or a level file) did not accept
+//   rotation errors    rotations or crash recoveries that failed
+//   config fallbacks   entries logged on hardcoded defaults (logging.toml missing or invalid)
+//   context fallbacks  context probes that came back "unknown" (informational -
+//                      some platforms never have a load average)
+//
+// SelfDiagnostics returns the counts; Finalize puts them in the run-summary
+// entry. A degraded report (writes, rotation, or config) is also appended to
+// system/logging-health.log through a direct path that skips the pipeline -
+// no rotation, sealing, sampling, or config - so a broken pipeline can still
+// report itself: once at the first write failure or rotation error, and again
+// with the final counts at Finalize. Records are JSON LogEntry lines, so
+// every directory reader parses them; the status command groups them by host.
+//
+// Blocking Status
+//
+// Non-blocking: Counting cannot fail. A failed health record is dropped
+// silently - the failure it describes already warned on stderr.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	report := logger.SelfDiagnostics()
+//	if report.Degraded() { ... }
+//
+//	hosts, _ := logging.DegradedLoggingHosts(logging.LogsDir(), 24*time.Hour)
+//
+// Public API:
+//   (*Logger).SelfDiagnostics() SelfReport - Counters so far
+//   (SelfReport).Degraded() bool - Whether logging itself lost anything
+//   SelfHealthLogPath() string - system/logging-health.log under LogsDir()
+//   DegradedLoggingHosts(logsDir, window) (map[string]SelfReport, error) - Degraded reports summed per host
+//
+// Internal API:
+//   selfCounters - Atomic counters embedded in Logger
+//   (*Logger).noteWriteFailure() / noteRotationError(err) - Count and report once
+//   (*Logger).noteContext(context) - Count "unknown" probes in a capture
+//   (*Logger).writeSelfHealth() - Direct append of one report
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: encoding/json, fmt, os, path/filepath, sync/atomic, time
+//   Package Files: logger.go (Logger, LogsDir), context.go (unknownValue),
+//                  parsing.go (ReadLogFile), clock.go (now), config.go (Config.Behavior.Disabled)
+//
+// Dependents (What Uses This):
+//   Internal: writing.go, levelfiles.go (write failures, rotation errors),
+//             context.go (context fallbacks), summary.go (countEntry, Finalize)
+//   Commands: system/runtime/cmd/status (degraded hosts)
+//
+// Health Scoring
+//
+// Self-monitoring is infrastructure - no health impact of its own.
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"encoding/json" // Health record lines
+	"fmt"           // Host fallback
+	"os"            // Direct append, size check
+	"path/filepath" // Health log path
+	"sync/atomic"   // Hot path counters
+	"time"          // Record timestamps and the status window
+)
+
+// Constants
+
+const (
+	selfHealthFile     = "logging-health.log"  // Under LogsDir()/system
+	selfHealthMaxBytes = 1024 * 1024           // Moved to .1 past this (one generation kept)
+	eventSelfHealth    = "logging self-health" // Event aggregation matches on
+)
+
+// Types
+
+// SelfReport is a Logger's account of its own degradation.
+type SelfReport struct {
+	WriteFailures    int64 `json:"write_failures"`    // Entries a log or level file did not accept
+	RotationErrors   int64 `json:"rotation_errors"`   // Failed rotations and crash recoveries
+	ConfigFallbacks  int64 `json:"config_fallbacks"`  // Entries logged on hardcoded defaults
+	ContextFallbacks int64 `json:"context_fallbacks"` // Context probes that returned "unknown"
+}
+
+// selfCounters are the Logger's hot-path counters behind SelfReport.
+type selfCounters struct {
+	writeFailures    atomic.Int64
+	rotationErrors   atomic.Int64
+	configFallbacks  atomic.Int64
+	contextFallbacks atomic.Int64
+	reported         atomic.Bool // First failure already written to the health log
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Counting
+// ────────────────────────────────────────────────────────────────
+
+// noteWriteFailure counts an entry that did not reach disk, reporting the first.
+func (l *Logger) noteWriteFailure() {
+	l.self.writeFailures.Add(1)
+	l.reportFirstFailure()
+}
+
+// noteRotationError counts a failed rotation (nil err counts nothing).
+func (l *Logger) noteRotationError(err error) {
+	if err == nil {
+		return
+	}
+	l.self.rotationErrors.Add(1)
+	l.reportFirstFailure()
+}
+
+// noteConfigFallback counts an entry logged without logging.toml.
+func (l *Logger) noteConfigFallback() {
+	if !ConfigLoaded {
+		l.self.configFallbacks.Add(1)
+	}
+}
+
+// noteContext counts the probes of a capture that fell back to "unknown".
+//
+// Sudoers is left out - "unknown" there means not installed, not a failed probe.
+func (l *Logger) noteContext(context *SystemContext) {
+	var unknown int64
+	for _, value := range []string{context.Shell.Type, context.CWD, context.System.Load, context.System.Memory, context.System.Disk} {
+		if value == unknownValue {
+			unknown++
+		}
+	}
+	if unknown > 0 {
+		l.self.contextFallbacks.Add(unknown)
+	}
+}
+
+// reportFirstFailure writes the health record once per Logger, at the first failure.
+func (l *Logger) reportFirstFailure() {
+	if l.self.reported.CompareAndSwap(false, true) {
+		l.writeSelfHealth()
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Direct Write
+// ────────────────────────────────────────────────────────────────
+
+// writeSelfHealth appends the current report to the health log as one JSON entry.
+//
+// Bypasses the pipeline on purpose: no rotation, sealing, sampling, or
+// format config - the things that may be what broke. Errors are dropped.
+func (l *Logger) writeSelfHealth() {
+	if Config != nil && Config.Behavior.Disabled { // Disabled logging touches no disk
+		return
+	}
+	report := l.SelfDiagnostics()
+	level := levelCheck
+	if report.Degraded() {
+		level = levelFailure
+	}
+	host := l.hostname
+	if host == "" { // Logger built without NewLogger
+		host = getHostname()
+	}
+	details := report.toMap()
+	details["host"] = host
+	details["log_file"] = l.LogFile
+	line, err := json.Marshal(LogEntry{
+		Timestamp:       now(),
+		Level:           level,
+		Component:       l.Component,
+		User:            fmt.Sprintf("%s@%s:%d", l.username, host, l.pid),
+		ContextID:       l.ContextID,
+		ParentContextID: l.ParentContextID,
+		Event:           eventSelfHealth,
+		Details:         details,
+	})
+	if err != nil {
+		return
+	}
+
+	path := SelfHealthLogPath()
+	if info, err := os.Stat(path); err == nil && info.Size() > selfHealthMaxBytes {
+		os.Rename(path, path+".1") // One generation kept - the file only grows on failure
+	}
+	os.MkdirAll(filepath.Dir(path), logDirPermissions)
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, logFilePermissions)
+	if err != nil {
+		return
+	}
+	file.Write(append(line, '\n'))
+	file.Close()
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// SelfDiagnostics returns the Logger's self-health counters so far.
+//
+// Example usage:
+//
+//	if report := logger.SelfDiagnostics(); report.Degraded() {
+//	    fmt.Fprintf(os.Stderr, "logging degraded: %+v\n", report)
+//	}
+func (l *Logger) SelfDiagnostics() SelfReport {
+	return SelfReport{
+		WriteFailures:    l.self.writeFailures.Load(),
+		RotationErrors:   l.self.rotationErrors.Load(),
+		ConfigFallbacks:  l.self.configFallbacks.Load(),
+		ContextFallbacks: l.self.contextFallbacks.Load(),
+	}
+}
+
+// Degraded reports whether logging itself lost or altered anything.
+//
+// Context fallbacks alone are not degradation - they are missing probes, not
+// missing entries.
+func (r SelfReport) Degraded() bool {
+	return r.WriteFailures > 0 || r.RotationErrors > 0 || r.ConfigFallbacks > 0
+}
+
+// toMap renders the report for entry details (run summary, health log).
+func (r SelfReport) toMap() map[string]any {
+	return map[string]any{
+		"write_failures":    r.WriteFailures,
+		"rotation_errors":   r.RotationErrors,
+		"config_fallbacks":  r.ConfigFallbacks,
+		"context_fallbacks": r.ContextFallbacks,
+		"degraded":          r.Degraded(),
+	}
+}
+
+// SelfHealthLogPath returns where Loggers report their own degradation.
+func SelfHealthLogPath() string {
+	return filepath.Join(LogsDir(), systemLogsSubdir, selfHealthFile)
+}
+
+// DegradedLoggingHosts sums the degraded health records of the last window per host.
+//
+// What It Does:
+// Reads logsDir/system/logging-health.log and adds up the counters of every
+// degraded record newer than window (0 = all). A Logger that reported its
+// first failure and then finalized contributes only its final counts.
+//
+// Returns:
+//
+//	map[string]SelfReport: Host → summed counters (empty when logging is healthy)
+//	error: Health log unreadable (a missing log is healthy, not an error)
+//
+// Example usage:
+//
+//	hosts, err := logging.DegradedLoggingHosts(logging.LogsDir(), 24*time.Hour)
+func DegradedLoggingHosts(logsDir string, window time.Duration) (map[string]SelfReport, error) {
+	entries, err := ReadLogFile(filepath.Join(logsDir, systemLogsSubdir, selfHealthFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]SelfReport{}, nil
+		}
+		return nil, err
+	}
+
+	latest := make(map[string]LogEntry) // Context ID → last record (final counts win)
+	var order []string
+	for _, entry := range entries {
+		if entry.Event != eventSelfHealth || (window > 0 && now().Sub(entry.Timestamp) > window) {
+			continue
+		}
+		if _, seen := latest[entry.ContextID]; !seen {
+			order = append(order, entry.ContextID)
+		}
+		latest[entry.ContextID] = entry
+	}
+
+	hosts := make(map[string]SelfReport)
+	for _, contextID := range order {
+		entry := latest[contextID]
+		if entry.Level != levelFailure {
+			continue
+		}
+		host := fmt.Sprint(entry.Details["host"])
+		sum := hosts[host]
+		sum.WriteFailures += detailCount(entry.Details, "write_failures")
+		sum.RotationErrors += detailCount(entry.Details, "rotation_errors")
+		sum.ConfigFallbacks += detailCount(entry.Details, "config_fallbacks")
+		sum.ContextFallbacks += detailCount(entry.Details, "context_fallbacks")
+		hosts[host] = sum
+	}
+	return hosts, nil
+}
+
+// detailCount reads a numeric detail restored from JSON (float64) or text (string).
+func detailCount(details map[string]any, key string) int64 {
+	switch value := details[key].(type) {
+	case float64:
+		return int64(value)
+	case int64:
+		return value
+	case int:
+		return int64(value)
+	case string:
+		var n int64
+		fmt.Sscan(value, &n)
+		return n
+	}
+	return 0
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
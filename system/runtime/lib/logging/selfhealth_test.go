@@ -0,0 +1,114 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Logger Self-Health Tests
+//
+// Purpose: Prove a Logger counts its own write failures, rotation errors, and
+//          fallbacks; that the first failure and the final counts reach
+//          system/logging-health.log even while the log itself is unwritable;
+//          that the run summary carries the report; and that
+//          DegradedLoggingHosts sums only degraded loggers, latest record each.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// healthRecords reads the self-health log
+func healthRecords(t *testing.T) []LogEntry {
+	t.Helper()
+	entries, err := ReadLogFile(SelfHealthLogPath())
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	return entries
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestSelfHealthReportsWriteFailures(t *testing.T) {
+	withBehavior(t, func(*LoggingConfig) {}) // Config counts as loaded
+	clock := useFakeClock(t)
+	logger := newTestLogger(t, "self-write")
+
+	working := logger.LogFile
+	logger.LogFile = filepath.Join(working, "blocked") // Parent is a file - every open fails
+	for i := 0; i < 3; i++ {
+		logger.Success("lost for now", 0, nil)
+	}
+	if report := logger.SelfDiagnostics(); report.WriteFailures != 3 || report.ConfigFallbacks != 0 || !report.Degraded() {
+		t.Errorf("after 3 failed writes: %+v", report)
+	}
+	records := healthRecords(t)
+	if len(records) != 1 || records[0].Level != levelFailure || records[0].Details["write_failures"] != float64(1) {
+		t.Fatalf("first failure record = %+v", records)
+	}
+
+	logger.LogFile = working
+	summary := logger.Finalize()
+	if summary.Logging.WriteFailures != 3 {
+		t.Errorf("summary Logging = %+v", summary.Logging)
+	}
+	records = healthRecords(t)
+	if len(records) != 2 || records[1].Details["write_failures"] != float64(3) || records[1].ContextID != logger.ContextID {
+		t.Errorf("final record = %+v", records)
+	}
+
+	hosts, err := DegradedLoggingHosts(LogsDir(), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hosts) != 1 || hosts[logger.hostname].WriteFailures != 3 {
+		t.Errorf("DegradedLoggingHosts = %+v, want %s with 3 (final counts, not summed with the first)", hosts, logger.hostname)
+	}
+	clock.advance(2 * time.Hour)
+	if hosts, _ := DegradedLoggingHosts(LogsDir(), time.Hour); len(hosts) != 0 {
+		t.Errorf("records older than the window counted: %+v", hosts)
+	}
+}
+
+func TestSelfHealthCountsFallbacksAndRotation(t *testing.T) {
+	withBehavior(t, func(*LoggingConfig) {})
+	healthy := newTestLogger(t, "self-healthy")
+	healthy.Success("fine", 0, nil)
+	if summary := healthy.Finalize(); summary.Logging.Degraded() {
+		t.Errorf("healthy logger degraded: %+v", summary.Logging)
+	}
+	if records := healthRecords(t); len(records) != 0 {
+		t.Errorf("healthy logger wrote %d health records", len(records))
+	}
+	if hosts, err := DegradedLoggingHosts(LogsDir(), 0); err != nil || len(hosts) != 0 {
+		t.Errorf("no health log: hosts %v, err %v", hosts, err)
+	}
+
+	ConfigLoaded = false // logging.toml missing or invalid
+	fallback := newTestLogger(t, "self-defaults")
+	fallback.Success("on defaults", 0, nil)
+	fallback.noteRotationError(nil)
+	fallback.noteRotationError(errors.New("rename failed"))
+	fallback.noteContext(&SystemContext{CWD: unknownValue, System: SystemMetrics{Load: unknownValue}})
+
+	report := fallback.SelfDiagnostics()
+	if report.ConfigFallbacks == 0 || report.RotationErrors != 1 || report.ContextFallbacks < 2 {
+		t.Errorf("report = %+v", report)
+	}
+	if (SelfReport{ContextFallbacks: 5}).Degraded() {
+		t.Error("context fallbacks alone counted as degraded")
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,435 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Span Export - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "Better is the end of a thing than the beginning thereof." - Ecclesiastes 7:8 (KJV)
+// Principle: A beginning recorded without its end is only half the story.
+// Anchor: Every OPERATION already opens a span; the closing entry finishes it.
+//
+// CPI-SI Identity
+//
+// Component Type: Export module within Rails infrastructure
+// Role: Rebuild timed spans from OPERATION → SUCCESS/FAILURE pairs and write them as OTLP/JSON
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial span extraction and OTLP/JSON export
+//
+// Purpose & Function
+//
+// Purpose: The narrative already holds implicit spans - an OPERATION entry, then
+// the SUCCESS or FAILURE that ends it - but nothing tied the two together, so a
+// tracing tool could not see them. Operation now writes an operation_id detail
+// (and returns it); the closing call echoes it in its own details. ExtractSpans
+// pairs entries by that ID and WriteOTLPJSON writes the OTLP/JSON trace format,
+// which Jaeger and Tempo import from a file.
+//
+// Core Design: Pairing is by operation_id only - never by position - so
+// interleaved operations and merged streams pair correctly. An OPERATION with
+// no closing entry becomes an "unclosed" span ending at the last entry its
+// context wrote. A closing entry whose OPERATION is not in the slice (rotated
+// away, sampled out) is ignored. IDs are derived, not stored:
+//   traceId = sha256(root context)[:16] - root is ParentContextID when set, so
+//             one invocation and the commands it ran (or a whole session,
+//             via session-<id>) share a trace
+//   spanId  = sha256(operation_id)[:8]
+//
+// Blocking Status
+//
+// Non-blocking: Pure transformation; WriteOTLPJSON returns the writer's error.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	opID := logger.Operation("backup", +5)
+//	logger.Success("Backup complete", +10, map[string]any{logging.OperationIDDetail: opID})
+//
+//	entries, _ := logging.ReadLogFile(path)
+//	out, _ := os.Create("trace.json")
+//	logging.WriteOTLPJSON(logging.ExtractSpans(entries), out)
+//
+// Public API:
+//   OperationIDDetail - Detail key carrying the operation ID
+//   Span - One reconstructed operation
+//   ExtractSpans(entries []LogEntry) []Span - Pair OPERATION entries with their closing entries
+//   WriteOTLPJSON(spans []Span, w io.Writer) error - OTLP/JSON trace export
+//
+// Internal API:
+//   operationID(entry) - operation_id detail as a string
+//   hexID(seed, bytes) - Deterministic trace/span IDs
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: crypto/sha256, encoding/hex, encoding/json, fmt, io, sort, strconv, time
+//   Package Files: entry.go (LogEntry), logger.go (level constants, Operation)
+//
+// Dependents (What Uses This):
+//   Internal: logger.go (Operation writes and LogCommandContext echoes OperationIDDetail)
+//
+// Health Scoring
+//
+// Span export: 0 (reads entries, writes nothing to the log)
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"crypto/sha256" // Deterministic trace and span IDs
+	"encoding/hex"  // OTLP/JSON IDs are hex strings
+	"encoding/json" // OTLP/JSON encoding
+	"fmt"           // Detail values to strings
+	"io"            // Export destination
+	"sort"          // Stable span and resource order
+	"strconv"       // Unix nanosecond strings
+	"time"          // Span times
+)
+
+// Constants
+
+const (
+	// OperationIDDetail is the detail key Operation writes and the closing
+	// Success/Failure echoes so ExtractSpans can pair them.
+	OperationIDDetail = "operation_id"
+
+	//--- Span Status ---
+	// Span.Status values.
+
+	SpanOK       = "ok"       // Closed by SUCCESS
+	SpanError    = "error"    // Closed by FAILURE or ERROR
+	SpanUnclosed = "unclosed" // No closing entry in the extracted entries
+
+	//--- OTLP Encoding ---
+	// Values from the OTLP trace protobuf, as OTLP/JSON writes them.
+
+	otlpScopeName        = "cpi-si/logging" // Instrumentation scope for every span
+	otlpSpanKindInternal = 1                // SPAN_KIND_INTERNAL
+	otlpStatusUnset      = 0                // STATUS_CODE_UNSET (unclosed)
+	otlpStatusOK         = 1                // STATUS_CODE_OK
+	otlpStatusError      = 2                // STATUS_CODE_ERROR
+)
+
+// Types
+
+// Span is one operation rebuilt from its OPERATION entry and closing entry.
+type Span struct {
+	TraceID       string            // 32 hex characters, shared by one root context
+	SpanID        string            // 16 hex characters, from OperationID
+	OperationID   string            // operation_id detail
+	Name          string            // Operation's command (with arguments)
+	Component     string            // Logging component (OTLP service.name)
+	ContextID     string            // Invocation that logged the operation
+	Start         time.Time         // OPERATION timestamp
+	End           time.Time         // Closing timestamp (last context entry when unclosed)
+	Status        string            // SpanOK, SpanError, or SpanUnclosed
+	StatusMessage string            // Failure reason or closing event
+	Attributes    map[string]string // Context IDs and closing details worth filtering on
+}
+
+// otlpTrace and below mirror the OTLP/JSON trace export shape
+type otlpTrace struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+// spanAttributeDetails are closing-entry details copied onto the span
+var spanAttributeDetails = []string{"exit_code", "reason", "error"}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Identifiers
+// ────────────────────────────────────────────────────────────────
+
+// operationID returns entry's operation_id detail ("" = none).
+func operationID(entry LogEntry) string {
+	if value, ok := entry.Details[OperationIDDetail]; ok && value != nil {
+		return fmt.Sprint(value) // Text logs read details back as strings
+	}
+	return ""
+}
+
+// hexID derives a stable hex identifier of size bytes from seed.
+func hexID(seed string, size int) string {
+	sum := sha256.Sum256([]byte(seed))
+	return hex.EncodeToString(sum[:size])
+}
+
+// otlpAttributes converts a string map to sorted OTLP attributes.
+func otlpAttributes(values map[string]string) []otlpAttribute {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	attributes := make([]otlpAttribute, 0, len(keys))
+	for _, key := range keys {
+		attributes = append(attributes, otlpAttribute{key, otlpValue{values[key]}})
+	}
+	return attributes
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Span Extraction and Export
+// ────────────────────────────────────────────────────────────────
+
+// ExtractSpans pairs OPERATION entries with the entries that close them.
+//
+// What It Does:
+// Every OPERATION carrying an operation_id opens a span. The first SUCCESS
+// (status ok) or FAILURE/ERROR (status error) carrying the same operation_id
+// after it closes the span. Operations never closed come back with status
+// "unclosed", ending at the last entry their context wrote. Entries may come
+// from several files in any order (MergeEntries output works as is).
+//
+// Parameters:
+//   entries: Parsed log entries (ReadLogFile, TraceContext, MergeEntries)
+//
+// Returns:
+//   []Span: One per operation, ordered by start time then operation ID
+//
+// Example usage:
+//
+//	spans := logging.ExtractSpans(entries)
+func ExtractSpans(entries []LogEntry) []Span {
+	open := map[string]*Span{}         // operation_id → span awaiting its close
+	lastSeen := map[string]time.Time{} // context ID → latest entry (unclosed end)
+	var spans []*Span
+
+	for _, entry := range entries {
+		if entry.Timestamp.After(lastSeen[entry.ContextID]) {
+			lastSeen[entry.ContextID] = entry.Timestamp
+		}
+
+		id := operationID(entry)
+		if id == "" {
+			continue
+		}
+
+		switch entry.Level {
+		case levelOperation:
+			if _, duplicate := open[id]; duplicate {
+				continue // Same entry read twice (overlapping inputs)
+			}
+			root := entry.ContextID
+			if entry.ParentContextID != "" {
+				root = entry.ParentContextID
+			}
+			name := fmt.Sprint(entry.Details["command"])
+			if entry.Details["command"] == nil {
+				name = entry.Event
+			}
+			attributes := map[string]string{"cpi_si.context_id": entry.ContextID, "cpi_si.operation_id": id}
+			if entry.ParentContextID != "" {
+				attributes["cpi_si.parent_context_id"] = entry.ParentContextID
+			}
+			span := &Span{
+				TraceID:     hexID(root, 16),
+				SpanID:      hexID(id, 8),
+				OperationID: id,
+				Name:        name,
+				Component:   entry.Component,
+				ContextID:   entry.ContextID,
+				Start:       entry.Timestamp,
+				Status:      SpanUnclosed,
+				Attributes:  attributes,
+			}
+			open[id] = span
+			spans = append(spans, span)
+
+		case levelSuccess, levelFailure, levelError:
+			span := open[id]
+			if span == nil || span.Status != SpanUnclosed {
+				continue // Opening entry not in this slice, or already closed
+			}
+			span.End = entry.Timestamp
+			span.Status = SpanOK
+			span.StatusMessage = entry.Event
+			if entry.Level != levelSuccess {
+				span.Status = SpanError
+				if reason, ok := entry.Details["reason"]; ok {
+					span.StatusMessage = fmt.Sprint(reason)
+				}
+			}
+			for _, key := range spanAttributeDetails {
+				if value, ok := entry.Details[key]; ok && value != nil {
+					span.Attributes["cpi_si."+key] = fmt.Sprint(value)
+				}
+			}
+		}
+	}
+
+	result := make([]Span, 0, len(spans))
+	for _, span := range spans {
+		if span.Status == SpanUnclosed {
+			span.End = lastSeen[span.ContextID]
+			if span.End.Before(span.Start) {
+				span.End = span.Start
+			}
+			span.StatusMessage = SpanUnclosed
+		}
+		result = append(result, *span)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if !result[i].Start.Equal(result[j].Start) {
+			return result[i].Start.Before(result[j].Start)
+		}
+		return result[i].OperationID < result[j].OperationID
+	})
+	return result
+}
+
+// WriteOTLPJSON writes spans in the OTLP/JSON trace format.
+//
+// What It Does:
+// Groups spans by component into resourceSpans (service.name = component),
+// one instrumentation scope each, and writes a single JSON document that
+// Jaeger and Tempo import as a trace file. Unclosed spans are written with
+// status UNSET, message "unclosed", and cpi_si.unclosed = "true".
+//
+// Parameters:
+//   spans: ExtractSpans output
+//   w: Destination (typically a file)
+//
+// Returns:
+//   error: Encoding or write failure
+//
+// Example usage:
+//
+//	file, _ := os.Create("trace.json")
+//	defer file.Close()
+//	err := logging.WriteOTLPJSON(spans, file)
+func WriteOTLPJSON(spans []Span, w io.Writer) error {
+	byComponent := map[string][]otlpSpan{}
+	for _, span := range spans {
+		attributes := map[string]string{}
+		for key, value := range span.Attributes {
+			attributes[key] = value
+		}
+
+		status := otlpStatus{Code: otlpStatusOK}
+		switch span.Status {
+		case SpanError:
+			status = otlpStatus{Code: otlpStatusError, Message: span.StatusMessage}
+		case SpanUnclosed:
+			status = otlpStatus{Code: otlpStatusUnset, Message: SpanUnclosed}
+			attributes["cpi_si.unclosed"] = "true"
+		}
+
+		byComponent[span.Component] = append(byComponent[span.Component], otlpSpan{
+			TraceID:           span.TraceID,
+			SpanID:            span.SpanID,
+			Name:              span.Name,
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(span.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.End.UnixNano(), 10),
+			Attributes:        otlpAttributes(attributes),
+			Status:            status,
+		})
+	}
+
+	components := make([]string, 0, len(byComponent))
+	for component := range byComponent {
+		components = append(components, component)
+	}
+	sort.Strings(components)
+
+	trace := otlpTrace{ResourceSpans: []otlpResourceSpans{}}
+	for _, component := range components {
+		trace.ResourceSpans = append(trace.ResourceSpans, otlpResourceSpans{
+			Resource:   otlpResource{Attributes: otlpAttributes(map[string]string{"service.name": component})},
+			ScopeSpans: []otlpScopeSpans{{Scope: otlpScope{Name: otlpScopeName}, Spans: byComponent[component]}},
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(trace)
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation:
+//   - Interleaved operations pair by ID; a missing close yields "unclosed"
+//   - OTLP/JSON: hex IDs, unix-nano strings, one resource per component
+//   - Run: go test ./... (spans_test.go)
+//
+// Code Execution: None (Library) - called by tools that export traces
+//
+// Modification Policy:
+//   ✅ Safe: More closing details copied to attributes (spanAttributeDetails)
+//   ⚠️ Care: ID derivation - traces imported earlier are matched by these IDs
+//   ❌ Never: Pairing by entry position - concurrent and merged streams interleave
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
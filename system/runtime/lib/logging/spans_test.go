@@ -0,0 +1,135 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Span Export Tests
+//
+// Purpose: Prove Operation writes an operation_id the closing call echoes,
+//          that ExtractSpans pairs interleaved operations by that ID (never by
+//          position) and marks unclosed ones, and that WriteOTLPJSON writes
+//          the OTLP/JSON trace shape.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestOperationIDRoundTrip(t *testing.T) {
+	logger := newTestLogger(t, "span-test")
+
+	first := logger.Operation("backup", 5, "home")
+	second := logger.Operation("index", 5)
+	if first == second || !strings.HasPrefix(first, logger.ContextID+"-op") {
+		t.Fatalf("operation IDs = %q, %q; want distinct, prefixed with the context ID", first, second)
+	}
+
+	// Closed out of order - pairing is by ID
+	logger.Failure("index failed", "disk full", -10, map[string]any{OperationIDDetail: second})
+	logger.Success("backup done", 10, map[string]any{OperationIDDetail: first})
+	logger.Operation("cleanup", 5)
+
+	spans := ExtractSpans(readEntries(t, logger.LogFile))
+	if len(spans) != 3 {
+		t.Fatalf("spans = %+v, want 3", spans)
+	}
+
+	byName := map[string]Span{}
+	for _, span := range spans {
+		byName[span.Name] = span
+	}
+	if span := byName["backup home"]; span.Status != SpanOK || span.OperationID != first || span.End.Before(span.Start) {
+		t.Errorf("backup span = %+v, want ok with ID %s", span, first)
+	}
+	if span := byName["index"]; span.Status != SpanError || span.StatusMessage != "disk full" || span.Attributes["cpi_si.reason"] != "disk full" {
+		t.Errorf("index span = %+v, want error \"disk full\"", span)
+	}
+	if span := byName["cleanup"]; span.Status != SpanUnclosed {
+		t.Errorf("cleanup span = %+v, want unclosed", span)
+	}
+	if spans[0].TraceID != spans[2].TraceID || len(spans[0].TraceID) != 32 || len(spans[0].SpanID) != 16 {
+		t.Errorf("IDs: trace %q / %q, span %q - want one 32-hex trace, 16-hex span IDs", spans[0].TraceID, spans[2].TraceID, spans[0].SpanID)
+	}
+}
+
+func TestExtractSpansUnclosedAndOrphans(t *testing.T) {
+	base := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	entries := []LogEntry{
+		{Timestamp: base, Level: levelOperation, Component: "a", ContextID: "a-1", ParentContextID: "session-x",
+			Details: map[string]any{"command": "sync", OperationIDDetail: "a-1-op1"}},
+		{Timestamp: base.Add(time.Second), Level: levelSuccess, ContextID: "b-1", // Orphan close (opening rotated away)
+			Details: map[string]any{OperationIDDetail: "b-1-op1"}},
+		{Timestamp: base.Add(3 * time.Second), Level: levelCheck, ContextID: "a-1"},
+		{Timestamp: base.Add(4 * time.Second), Level: levelOperation, Component: "b", ContextID: "b-2", ParentContextID: "session-x",
+			Details: map[string]any{"command": "lint", OperationIDDetail: "b-2-op1"}},
+		{Timestamp: base.Add(6 * time.Second), Level: levelSuccess, ContextID: "b-2",
+			Details: map[string]any{OperationIDDetail: "b-2-op1", "exit_code": "0"}},
+	}
+
+	spans := ExtractSpans(entries)
+	if len(spans) != 2 {
+		t.Fatalf("spans = %+v, want 2 (orphan close ignored)", spans)
+	}
+	if spans[0].Status != SpanUnclosed || !spans[0].End.Equal(base.Add(3*time.Second)) {
+		t.Errorf("unclosed span = %+v, want end at its context's last entry", spans[0])
+	}
+	if spans[1].Status != SpanOK || spans[1].End.Sub(spans[1].Start) != 2*time.Second || spans[1].Attributes["cpi_si.exit_code"] != "0" {
+		t.Errorf("closed span = %+v", spans[1])
+	}
+	if spans[0].TraceID != spans[1].TraceID {
+		t.Error("spans under one parent context should share a trace")
+	}
+}
+
+func TestWriteOTLPJSON(t *testing.T) {
+	start := time.Unix(1760616000, 0)
+	spans := []Span{
+		{TraceID: strings.Repeat("a", 32), SpanID: strings.Repeat("1", 16), Name: "sync", Component: "zeta",
+			Start: start, End: start.Add(time.Second), Status: SpanOK},
+		{TraceID: strings.Repeat("a", 32), SpanID: strings.Repeat("2", 16), Name: "lint", Component: "alpha",
+			Start: start, End: start, Status: SpanUnclosed, Attributes: map[string]string{"cpi_si.context_id": "alpha-1"}},
+	}
+
+	var out bytes.Buffer
+	if err := WriteOTLPJSON(spans, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	var trace otlpTrace
+	if err := json.Unmarshal(out.Bytes(), &trace); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, out.String())
+	}
+	if len(trace.ResourceSpans) != 2 || trace.ResourceSpans[0].Resource.Attributes[0].Value.StringValue != "alpha" {
+		t.Fatalf("resourceSpans = %+v, want alpha then zeta", trace.ResourceSpans)
+	}
+
+	unclosed := trace.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	if unclosed.Status.Code != otlpStatusUnset || unclosed.Status.Message != SpanUnclosed {
+		t.Errorf("unclosed status = %+v", unclosed.Status)
+	}
+	closed := trace.ResourceSpans[1].ScopeSpans[0].Spans[0]
+	if closed.Status.Code != otlpStatusOK || closed.StartTimeUnixNano != "1760616000000000000" || closed.EndTimeUnixNano != "1760616001000000000" {
+		t.Errorf("closed span = %+v", closed)
+	}
+	for _, key := range []string{`"resourceSpans"`, `"scopeSpans"`, `"traceId"`, `"startTimeUnixNano"`} {
+		if !strings.Contains(out.String(), key) {
+			t.Errorf("output missing %s", key)
+		}
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,275 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Run Summary & Exit Codes - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "I have fought a good fight, I have finished my course, I have kept the faith" (2 Timothy 4:7, KJV)
+// Principle: A course is finished with an honest account. The end of a run states what happened, once.
+// Anchor: The exit code a command returns should be the same truth its health score already told.
+//
+// CPI-SI Identity
+//
+// Component Type: Run summary module within Rails infrastructure
+// Role: Close a Logger's run with one summary entry and a health-derived exit code
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Seanje Lenox-Wise, Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.3.0
+// Last Modified: 2026-10-16 - Finalize closes a held log handle (keep_file_open)
+//
+// Purpose & Function
+//
+// Purpose: Commands decided their exit codes ad hoc, disconnected from the health
+// they accumulated. Finalize writes one "run-summary" entry (entries by level,
+// final health, duration, error count) and returns a RunSummary whose
+// SuggestedExitCode comes from the [exit_codes] health thresholds.
+//
+// Core Design: Counts are kept per level as entries are logged (coalesced
+// repeats still count - they happened). Finalize flushes any pending repeat
+// summary, writes the run summary through the metadata pipeline, and caches the
+// result so a second call returns it without writing.
+//
+// Key Features:
+//   - Well-known event "run-summary" (aggregation finds it without parsing bodies)
+//   - Semantic metadata: OperationType "run_summary", health and exit code as Actual
+//   - Exit codes: health ≥ healthy_min → 0, ≥ degraded_min → 1, below → 2
+//   - Idempotent Finalize (cached summary, nothing written twice)
+//   - [behavior] strict_health: a "health budget" CHECK entry ahead of the summary when the run drifted
+//   - Logger self-health (write failures, rotation errors, fallbacks) in the summary;
+//     a degraded logger also reports to system/logging-health.log (selfhealth.go)
+//
+// Blocking Status
+//
+// Non-blocking: Finalize never fails - write problems warn to stderr like every
+// other entry, and the summary is still returned for the exit code.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	logger := logging.NewLogger("validate")
+//	defer func() { os.Exit(logger.Finalize().SuggestedExitCode) }()
+//
+// Public API:
+//   RunSummary - Counts, health, duration, and suggested exit code for one run
+//   (*Logger).Finalize() RunSummary - Write summary entry once, return summary
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: time
+//   Package Files: logger.go (logEntryWithMetadata, level constants),
+//                  writing.go (flushRepeats), config.go (Config.ExitCodes),
+//                  health.go (lintHealthBudget), selfhealth.go (SelfDiagnostics)
+//
+// Dependents (What Uses This):
+//   Commands: validate (exits with SuggestedExitCode), any command that exits through its logger's health
+//
+// Health Scoring
+//
+// Summary entry: 0 (reporting only - the run's health is what it reports)
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"time" // Run duration since logger creation
+)
+
+// Constants
+
+const (
+	//--- Summary Entry ---
+	// Well-known identifiers for the end-of-run entry.
+
+	eventRunSummary     = "run-summary" // Event string aggregation and trend tooling match on
+	runSummaryOperation = "run_summary" // Semantic OperationType of the summary entry
+
+	//--- Exit Codes ---
+	// Suggested process exit codes by final health.
+	//
+	// Thresholds are fallbacks for [exit_codes] in logging.toml.
+
+	exitCodeHealthy   = 0  // Health at or above the healthy threshold
+	exitCodeDegraded  = 1  // Health between the degraded and healthy thresholds
+	exitCodeFailed    = 2  // Health below the degraded threshold
+	healthyMinHealth  = 70 // Lowest normalized health that exits 0
+	degradedMinHealth = 0  // Lowest normalized health that exits 1
+)
+
+// Types
+
+// RunSummary is the end-of-run account returned by Finalize.
+type RunSummary struct {
+	Component         string         // Logger component name
+	ContextID         string         // Execution context the summary closes
+	EntriesByLevel    map[string]int // Entries logged per level (summary entry excluded)
+	TotalEntries      int            // Sum of EntriesByLevel
+	ErrorCount        int            // ERROR entries (unexpected errors only, not FAILURE)
+	RawHealth         int            // Final cumulative health
+	NormalizedHealth  int            // Final health percentage (-100 to +100)
+	Duration          time.Duration  // Time since NewLogger
+	SuggestedExitCode int            // 0 healthy, 1 degraded, 2 failed (see [exit_codes])
+	Logging           SelfReport     // The logger's own degradation (selfhealth.go)
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Foundation Functions
+// ────────────────────────────────────────────────────────────────
+
+// countEntry records one logged entry for the run summary.
+func (l *Logger) countEntry(level string) {
+	if l.levelCounts == nil { // Logger built without NewLogger
+		l.levelCounts = make(map[string]int)
+	}
+	l.levelCounts[level]++
+	l.noteConfigFallback() // Logged on hardcoded defaults (selfhealth.go)
+}
+
+// exitThresholds returns the healthy and degraded minimums (config with fallback).
+func exitThresholds() (healthyMin, degradedMin int) {
+	if ConfigLoaded { // Config file merged over defaults - missing keys keep them
+		return Config.ExitCodes.HealthyMin, Config.ExitCodes.DegradedMin
+	}
+	return healthyMinHealth, degradedMinHealth
+}
+
+// suggestedExitCode maps normalized health to a process exit code.
+func suggestedExitCode(health int) int {
+	healthyMin, degradedMin := exitThresholds()
+	switch {
+	case health >= healthyMin:
+		return exitCodeHealthy
+	case health >= degradedMin:
+		return exitCodeDegraded
+	default:
+		return exitCodeFailed
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Run Summary
+// ────────────────────────────────────────────────────────────────
+
+// Finalize writes the end-of-run summary entry and returns the run's summary.
+//
+// What It Does:
+// Flushes any pending repeat summary, then writes one "run-summary" entry -
+// SUCCESS when the suggested exit code is 0, FAILURE otherwise - with entry
+// counts, final health, duration, and the exit code as details and Semantic
+// metadata. Later calls return the first summary and write nothing.
+//
+// Returns:
+//   RunSummary: Counts and health at the moment of the first call
+//
+// Health Impact:
+//   No health impact (the summary reports health, it does not change it)
+//
+// Example usage:
+//
+//	logger := logging.NewLogger("validate")
+//	logger.DeclareHealthTotal(100)
+//	// ... run ...
+//	os.Exit(logger.Finalize().SuggestedExitCode)
+//
+func (l *Logger) Finalize() RunSummary {
+	if l.summary != nil { // Already finalized - nothing more to write
+		return *l.summary
+	}
+	defer l.closeLogHandle() // The run is over - a later entry reopens
+
+	l.flushRepeats() // Pending burst belongs before the summary
+
+	summary := RunSummary{
+		Component:        l.Component,
+		ContextID:        l.ContextID,
+		EntriesByLevel:   make(map[string]int, len(l.levelCounts)),
+		ErrorCount:       l.levelCounts[levelError],
+		RawHealth:        l.SessionHealth,
+		NormalizedHealth: l.NormalizedHealth,
+	}
+	for level, count := range l.levelCounts { // Copy - later entries must not change the summary
+		summary.EntriesByLevel[level] = count
+		summary.TotalEntries += count
+	}
+	if !l.created.IsZero() {
+		summary.Duration = now().Sub(l.created)
+	}
+	summary.SuggestedExitCode = suggestedExitCode(summary.NormalizedHealth)
+	summary.Logging = l.SelfDiagnostics()
+	l.summary = &summary // Cache before writing - the summary entry is not part of the run
+	l.lintHealthBudget() // [behavior] strict_health - flagged before the summary closes the run
+
+	healthyMin, degradedMin := exitThresholds()
+	details := map[string]any{
+		"entries_by_level":    summary.EntriesByLevel,
+		"total_entries":       summary.TotalEntries,
+		"error_count":         summary.ErrorCount,
+		"normalized_health":   summary.NormalizedHealth,
+		"duration":            summary.Duration.String(),
+		"suggested_exit_code": summary.SuggestedExitCode,
+		"logging_health":      summary.Logging.toMap(),
+	}
+	semantic := Metadata{
+		OperationType:    runSummaryOperation,
+		OperationSubtype: l.Component,
+		Expected: map[string]any{
+			"healthy_min":  healthyMin,
+			"degraded_min": degradedMin,
+		},
+		Actual: map[string]any{
+			"normalized_health":   summary.NormalizedHealth,
+			"raw_health":          summary.RawHealth,
+			"error_count":         summary.ErrorCount,
+			"duration_ms":         summary.Duration.Milliseconds(),
+			"suggested_exit_code": summary.SuggestedExitCode,
+		},
+	}
+
+	if summary.SuggestedExitCode == exitCodeHealthy {
+		l.logEntryWithMetadata(levelSuccess, eventRunSummary, 0, details, semantic)
+	} else {
+		details["reason"] = "final health below healthy threshold"
+		semantic.ErrorType = "degraded_health"
+		if summary.SuggestedExitCode == exitCodeFailed {
+			semantic.ErrorType = "failed_health"
+		}
+		l.logEntryWithMetadata(levelFailure, eventRunSummary, 0, details, semantic)
+	}
+	if summary.Logging = l.SelfDiagnostics(); summary.Logging.Degraded() { // Final counts, the summary's own write included
+		l.writeSelfHealth()
+	}
+	return summary
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,126 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Run Summary Tests
+//
+// Purpose: Prove final health maps to exit codes 0/1/2 at the [exit_codes]
+//          thresholds, that Finalize writes one run-summary entry carrying
+//          the counts and exit code, and that a second call returns the
+//          cached summary without writing again.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"strconv"
+	"testing"
+)
+
+// runSummaries returns the run-summary entries in a log file
+func runSummaries(t *testing.T, path string) []LogEntry {
+	t.Helper()
+	var summaries []LogEntry
+	for _, entry := range readEntries(t, path) {
+		if entry.Event == eventRunSummary {
+			summaries = append(summaries, entry)
+		}
+	}
+	return summaries
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestSuggestedExitCodeThresholds(t *testing.T) {
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.ExitCodes = ExitCodesConfig{HealthyMin: healthyMinHealth, DegradedMin: degradedMinHealth}
+	})
+
+	cases := []struct {
+		health int
+		want   int
+	}{
+		{100, exitCodeHealthy},
+		{70, exitCodeHealthy}, // Healthy threshold is inclusive
+		{69, exitCodeDegraded},
+		{0, exitCodeDegraded}, // Degraded threshold is inclusive
+		{-1, exitCodeFailed},
+		{-100, exitCodeFailed},
+	}
+	for _, tc := range cases {
+		if got := suggestedExitCode(tc.health); got != tc.want {
+			t.Errorf("health %d: exit code %d, want %d", tc.health, got, tc.want)
+		}
+	}
+
+	Config.ExitCodes = ExitCodesConfig{HealthyMin: 90, DegradedMin: 50} // Thresholds come from config
+	if got := suggestedExitCode(70); got != exitCodeDegraded {
+		t.Errorf("health 70 with healthy_min 90: exit code %d, want %d", got, exitCodeDegraded)
+	}
+}
+
+func TestFinalizeWritesSummaryOnce(t *testing.T) {
+	cases := []struct {
+		name      string
+		impacts   []int // Success (≥0) or Failure (<0) entries against a declared total of 100
+		wantCode  int
+		wantLevel string
+		errorType string
+	}{
+		{"healthy", []int{50, 30}, exitCodeHealthy, levelSuccess, ""},
+		{"degraded", []int{50, -20}, exitCodeDegraded, levelFailure, "degraded_health"},
+		{"failed", []int{10, -60}, exitCodeFailed, levelFailure, "failed_health"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := newTestLogger(t, "summary-"+tc.name)
+			logger.DeclareHealthTotal(100)
+			for _, impact := range tc.impacts {
+				if impact >= 0 {
+					logger.Success("step", impact, nil)
+				} else {
+					logger.Failure("step", "went wrong", impact, nil)
+				}
+			}
+
+			summary := logger.Finalize()
+			if summary.SuggestedExitCode != tc.wantCode || summary.NormalizedHealth != logger.NormalizedHealth {
+				t.Errorf("summary exit %d health %d, want exit %d health %d",
+					summary.SuggestedExitCode, summary.NormalizedHealth, tc.wantCode, logger.NormalizedHealth)
+			}
+			if summary.EntriesByLevel[levelSuccess]+summary.EntriesByLevel[levelFailure] < len(tc.impacts) {
+				t.Errorf("entries by level = %v, missing the run's entries", summary.EntriesByLevel)
+			}
+
+			logger.Success("after the summary", 0, nil)
+			again := logger.Finalize()
+			if again.TotalEntries != summary.TotalEntries || again.SuggestedExitCode != summary.SuggestedExitCode {
+				t.Errorf("second Finalize = %+v, want the cached %+v", again, summary)
+			}
+
+			entries := runSummaries(t, logger.LogFile)
+			if len(entries) != 1 {
+				t.Fatalf("%d run-summary entries, want exactly 1", len(entries))
+			}
+			entry := entries[0]
+			if entry.Level != tc.wantLevel || entry.Details["suggested_exit_code"] != strconv.Itoa(tc.wantCode) {
+				t.Errorf("summary entry %s exit %v, want %s exit %d", entry.Level, entry.Details["suggested_exit_code"], tc.wantLevel, tc.wantCode)
+			}
+			if entry.Semantic == nil || entry.Semantic.OperationType != runSummaryOperation || entry.Semantic.ErrorType != tc.errorType {
+				t.Errorf("summary semantic = %+v, want %s / %q", entry.Semantic, runSummaryOperation, tc.errorType)
+			}
+			if entry.HealthImpact != 0 {
+				t.Errorf("summary entry changed health by %d", entry.HealthImpact)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
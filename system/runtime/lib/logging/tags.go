@@ -0,0 +1,281 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Entry Tags - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "And Adam gave names to all cattle, and to the fowl of the air, and to every beast of the field" (Genesis 2:20, KJV)
+// Principle: Naming what a thing belongs to makes it findable among many.
+// Anchor: A level says how serious; a tag says what it concerns - network, config, user-facing.
+//
+// CPI-SI Identity
+//
+// Component Type: Entry labelling module within Rails infrastructure
+// Role: Attach concern tags to entries (per call, per component) and write/read the TAGS line
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial tags, TaggedLogger, [tags] component defaults
+//
+// Purpose & Function
+//
+// Purpose: Levels are too coarse to slice logs by concern. An entry can carry
+// tags ("network", "config", "user-facing"); QueryLogs (query.go) pulls every
+// entry with a tag across components.
+//
+// Core Design: logger.WithTags("network") returns a TaggedLogger - a small view
+// holding the Logger and the tags, not a copy of it. Its methods set the
+// Logger's call tags for the duration of one call, so health, sequence, and
+// sampling stay the Logger's own. Component defaults come from
+// [tags.components] in logging.toml (everything from "git" tagged "git") and
+// come first; call tags follow, duplicates dropped.
+//
+// Format: a tagged entry gets one line under the header (after PARENT):
+//
+//	  TAGS: git, network
+//
+// JSON output adds "tags". Untagged entries write neither - byte-identical to
+// before tags existed. Tags are trimmed; commas and line breaks in a tag
+// become spaces (they would split it on the way back in).
+//
+// Blocking Status
+//
+// Non-blocking: Tags are labels; an empty tag is dropped, never an error.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	logger.WithTags("network").Success("Fetched remote", +5, nil)
+//	net := logger.WithTags("network", "user-facing")
+//	net.Failure("Fetch failed", "timeout", -10, nil)
+//
+// Public API:
+//   (*Logger).WithTags(tags ...string) TaggedLogger - Tagged view of the Logger
+//   TaggedLogger: Operation, Success, Failure, Error, Check, Debug, SnapshotState,
+//                 SuccessWithMetadata, FailureWithMetadata, CheckWithMetadata, WithTags
+//   (LogEntry).HasTag(tag string) bool - Whether an entry carries a tag
+//
+// Internal API:
+//   entryTags() - Tags for the entry being built (Logger method)
+//   parseTags(value) - TAGS line value back into a list
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: slices, strings
+//   Package Files: config.go (Config.Tags), entry.go (createBaseEntry, writeEntryText), logger.go (logging methods)
+//
+// Dependents (What Uses This):
+//   Internal: entry.go (Tags on every entry, TAGS line), parsing.go (TAGS section),
+//             query.go (AnyTag/AllTags), human.go (tags after the component)
+//
+// Health Scoring
+//
+// Tagged entries score exactly as untagged ones - tags never change a delta.
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"slices"  // Tag membership
+	"strings" // Tag cleaning and the TAGS line
+)
+
+// Constants
+
+const (
+	tagsHeader    = "  TAGS: " // Prefix for the tags line
+	tagsSeparator = ", "       // Between tags on the TAGS line
+)
+
+// Types
+
+// TaggedLogger is a view of a Logger whose entries carry extra tags.
+//
+// It holds no state of its own beyond the tags - every entry goes through the
+// underlying Logger (health, sequence, sampling, file). Cheap to create per call.
+type TaggedLogger struct {
+	logger *Logger
+	tags   []string
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Tag Lists
+// ────────────────────────────────────────────────────────────────
+
+// cleanTag trims a tag and replaces the characters that would split it when read back.
+func cleanTag(tag string) string {
+	if strings.ContainsAny(tag, ",\r\n") {
+		tag = strings.NewReplacer(",", " ", "\r", " ", "\n", " ").Replace(tag)
+	}
+	return strings.TrimSpace(tag)
+}
+
+// appendTags appends the cleaned, non-empty tags not already in list.
+func appendTags(list []string, tags ...string) []string {
+	for _, tag := range tags {
+		tag = cleanTag(tag)
+		if tag == "" || slices.Contains(list, tag) {
+			continue
+		}
+		list = append(list, tag)
+	}
+	return list
+}
+
+// entryTags returns the tags for an entry being built: [tags.components]
+// defaults for this component, then the current call's tags (nil = untagged).
+func (l *Logger) entryTags() []string {
+	var defaults []string
+	if ConfigLoaded {
+		defaults = Config.Tags.Components[l.Component]
+	}
+	if len(defaults) == 0 && len(l.tags) == 0 { // Common case - no allocation
+		return nil
+	}
+	return appendTags(appendTags(nil, defaults...), l.tags...)
+}
+
+// parseTags turns a TAGS line value back into a list.
+func parseTags(value string) []string {
+	return appendTags(nil, strings.Split(value, ",")...)
+}
+
+// writeTagsLine writes the TAGS line for a tagged entry (nothing for untagged).
+func writeTagsLine(builder *strings.Builder, tags []string) {
+	if len(tags) == 0 {
+		return
+	}
+	builder.WriteString(tagsHeader)
+	for i, tag := range tags {
+		if i > 0 {
+			builder.WriteString(tagsSeparator)
+		}
+		builder.WriteString(tag)
+	}
+	builder.WriteByte('\n')
+}
+
+// ────────────────────────────────────────────────────────────────
+// Core Operations - Tagged Calls
+// ────────────────────────────────────────────────────────────────
+
+// with runs one logging call with the view's tags set on the Logger.
+//
+// The previous call tags are restored afterwards, so a TaggedLogger used from
+// inside another tagged call (a nested helper) does not leak its tags outward.
+func (t TaggedLogger) with(call func(l *Logger)) {
+	l := t.logger
+	previous := l.tags
+	l.tags = appendTags(append([]string(nil), previous...), t.tags...)
+	defer func() { l.tags = previous }()
+	call(l)
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// WithTags returns a view of the Logger whose entries carry tags.
+//
+// Example usage:
+//
+//	logger.WithTags("network").Success("Fetched remote", +5, nil)
+func (l *Logger) WithTags(tags ...string) TaggedLogger {
+	return TaggedLogger{logger: l, tags: appendTags(nil, tags...)}
+}
+
+// WithTags returns a view carrying this view's tags plus more.
+func (t TaggedLogger) WithTags(tags ...string) TaggedLogger {
+	return TaggedLogger{logger: t.logger, tags: appendTags(append([]string(nil), t.tags...), tags...)}
+}
+
+// Operation is (*Logger).Operation with the view's tags.
+func (t TaggedLogger) Operation(command string, healthImpact int, args ...string) string {
+	var operationID string
+	t.with(func(l *Logger) { operationID = l.Operation(command, healthImpact, args...) })
+	return operationID
+}
+
+// Success is (*Logger).Success with the view's tags.
+func (t TaggedLogger) Success(event string, healthImpact int, details map[string]any) {
+	t.with(func(l *Logger) { l.Success(event, healthImpact, details) })
+}
+
+// Failure is (*Logger).Failure with the view's tags.
+func (t TaggedLogger) Failure(event string, reason string, healthImpact int, details map[string]any) {
+	t.with(func(l *Logger) { l.Failure(event, reason, healthImpact, details) })
+}
+
+// Error is (*Logger).Error with the view's tags.
+func (t TaggedLogger) Error(event string, err error, healthImpact int) {
+	t.with(func(l *Logger) { l.Error(event, err, healthImpact) })
+}
+
+// Check is (*Logger).Check with the view's tags.
+func (t TaggedLogger) Check(what string, result bool, healthImpact int, details map[string]any) {
+	t.with(func(l *Logger) { l.Check(what, result, healthImpact, details) })
+}
+
+// Debug is (*Logger).Debug with the view's tags.
+func (t TaggedLogger) Debug(event string, healthImpact int, internalState map[string]any) {
+	t.with(func(l *Logger) { l.Debug(event, healthImpact, internalState) })
+}
+
+// SnapshotState is (*Logger).SnapshotState with the view's tags.
+func (t TaggedLogger) SnapshotState(label string, healthImpact int) {
+	t.with(func(l *Logger) { l.SnapshotState(label, healthImpact) })
+}
+
+// SuccessWithMetadata is (*Logger).SuccessWithMetadata with the view's tags.
+func (t TaggedLogger) SuccessWithMetadata(event string, healthImpact int, details map[string]any, semantic Metadata) {
+	t.with(func(l *Logger) { l.SuccessWithMetadata(event, healthImpact, details, semantic) })
+}
+
+// FailureWithMetadata is (*Logger).FailureWithMetadata with the view's tags.
+func (t TaggedLogger) FailureWithMetadata(event string, reason string, healthImpact int, details map[string]any, semantic Metadata) {
+	t.with(func(l *Logger) { l.FailureWithMetadata(event, reason, healthImpact, details, semantic) })
+}
+
+// CheckWithMetadata is (*Logger).CheckWithMetadata with the view's tags.
+func (t TaggedLogger) CheckWithMetadata(what string, result bool, healthImpact int, details map[string]any, semantic Metadata) {
+	t.with(func(l *Logger) { l.CheckWithMetadata(what, result, healthImpact, details, semantic) })
+}
+
+// HasTag reports whether the entry carries tag.
+func (e LogEntry) HasTag(tag string) bool {
+	return slices.Contains(e.Tags, tag)
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
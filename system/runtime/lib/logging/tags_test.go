@@ -0,0 +1,137 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Entry Tags and Query Tests
+//
+// Purpose: Prove untagged entries are written byte-for-byte as before, that
+//          WithTags and [tags.components] defaults round-trip through the text
+//          and JSON formats, that a tagged view leaves the Logger untagged
+//          afterwards, and that QueryLogs selects by AnyTag/AllTags across
+//          components.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestUntaggedEntriesUnchanged(t *testing.T) {
+	logger := newTestLogger(t, "untagged-test")
+	withBehavior(t, func(*LoggingConfig) {})
+	entry := logger.createBaseEntry(&SystemContext{}, 1)
+	entry.Level, entry.Event = levelSuccess, "plain"
+	if entry.Tags != nil {
+		t.Fatalf("untagged entry has tags %v", entry.Tags)
+	}
+
+	text := logger.formatEntry(entry)
+	if strings.Contains(text, "TAGS") || !strings.Contains(text, "\n  EVENT: plain\n") {
+		t.Errorf("untagged text entry changed:\n%s", text)
+	}
+	Config.Format.Output = OutputJSON
+	if line := logger.renderEntry(entry); strings.Contains(line, `"tags"`) {
+		t.Errorf("untagged JSON entry has a tags key: %s", line)
+	}
+}
+
+func TestWithTagsRoundTrip(t *testing.T) {
+	for _, output := range []string{OutputText, OutputJSON} {
+		t.Run(output, func(t *testing.T) {
+			logger := newTestLogger(t, "tags-test")
+			withBehavior(t, func(cfg *LoggingConfig) {
+				cfg.Format.Output = output
+				cfg.Tags.Components = map[string][]string{"tags-test": {"git"}}
+			})
+
+			logger.WithTags("network", "git", " ", "user,facing").Failure("Fetch failed", "timeout", -5, nil)
+			logger.Success("after", 1, nil)
+
+			entries := readEntries(t, logger.LogFile)
+			if len(entries) != 2 {
+				t.Fatalf("read %d entries, want 2", len(entries))
+			}
+			if got := strings.Join(entries[0].Tags, "|"); got != "git|network|user facing" {
+				t.Errorf("tagged entry tags = %q", got)
+			}
+			if got := strings.Join(entries[1].Tags, "|"); got != "git" {
+				t.Errorf("plain call tags = %q, want only the component default", got)
+			}
+		})
+	}
+}
+
+func TestTaggedViewRestoresTags(t *testing.T) {
+	logger := newTestLogger(t, "tags-nested-test")
+	withBehavior(t, func(*LoggingConfig) {})
+
+	outer := logger.WithTags("config")
+	nested := outer.WithTags("network")
+	outer.Check("nested", true, 1, nil)
+	nested.Success("both", 1, nil)
+	if logger.tags != nil {
+		t.Fatalf("Logger kept call tags %v", logger.tags)
+	}
+	logger.Success("none", 1, nil)
+
+	var got []string
+	for _, entry := range readEntries(t, logger.LogFile) {
+		got = append(got, strings.Join(entry.Tags, "+"))
+	}
+	if strings.Join(got, ",") != "config,config+network," {
+		t.Errorf("tags per entry = %q", got)
+	}
+	data, _ := os.ReadFile(logger.LogFile)
+	if !strings.Contains(string(data), "\n  TAGS: config, network\n") {
+		t.Errorf("TAGS line missing or misformatted:\n%s", data)
+	}
+}
+
+func TestQueryLogsByTags(t *testing.T) {
+	withBehavior(t, func(*LoggingConfig) {})
+	logsDir := t.TempDir()
+	write := func(component string, tags ...string) {
+		logger := &Logger{Component: component, ContextID: component + "-1-1", LogFile: filepath.Join(logsDir, component+".log"), username: "u", hostname: "h", pid: 1}
+		logger.WithTags(tags...).Success(component+" "+strings.Join(tags, "+"), 1, nil)
+	}
+	write("fetch", "network")
+	write("fetch", "network", "user-facing")
+	write("config", "config")
+	write("sync", "network", "config")
+
+	cases := []struct {
+		name   string
+		filter EntryFilter
+		want   string
+	}{
+		{"any tag", EntryFilter{AnyTag: []string{"network"}}, "fetch network,fetch network+user-facing,sync network+config"},
+		{"all tags", EntryFilter{AllTags: []string{"network", "config"}}, "sync network+config"},
+		{"any of two", EntryFilter{AnyTag: []string{"user-facing", "config"}}, "fetch network+user-facing,config config,sync network+config"},
+		{"tag and component", EntryFilter{AnyTag: []string{"network"}, Components: []string{"sync"}}, "sync network+config"},
+		{"no match", EntryFilter{AllTags: []string{"git"}}, ""},
+	}
+	for _, tc := range cases {
+		entries, err := QueryLogs(logsDir, tc.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.Join(events(entries), ","); got != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
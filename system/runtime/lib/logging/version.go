@@ -0,0 +1,255 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Log Format Versioning - Logging Library
+//
+// Biblical Foundation
+//
+// Scripture: "Remove not the ancient landmark, which thy fathers have set." - Proverbs 22:28 (KJV)
+// Principle: What was written stays readable.
+// Anchor: The format will keep growing; every log already on disk must still read the way it did the day it was written.
+//
+// CPI-SI Identity
+//
+// Component Type: Format versioning module within Rails infrastructure
+// Role: Stamp new log files with their format version and read any version back
+// Paradigm: CPI-SI framework component
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial format header, DetectLogVersion, MigrateLogFile
+//
+// Purpose & Function
+//
+// Purpose: ReadLogFile had no way to know which vintage of the format it was
+// reading. A new log file now starts with one header line naming its format
+// version ("#cpi-si-log-format: 2"); a file without one is version 1 (every
+// log written before versioning). ReadLogFile reads the header and parses the
+// rest under that version's rules, so old logs remain readable forever.
+//
+// Core Design: The header is written once, in the same write as the first
+// entry of an empty file (appendFile), so it is never separated from the
+// entries it describes. Versions 1 and 2 share one entry grammar - version 2
+// only adds the header. Format features added later check the version the
+// reader is on (entryReader.version) rather than breaking version 1 readers.
+// The header stays plaintext in sealed logs ([privacy] encrypt).
+//
+// Blocking Status
+//
+// Non-blocking for writers: the header rides along with the first entry's write.
+// DetectLogVersion and MigrateLogFile return errors to their caller (tooling).
+//
+// Usage & Integration
+//
+// Usage (tooling that wants uniform files):
+//
+//	if version, err := logging.DetectLogVersion(path); err == nil && version < 2 {
+//	    err = logging.MigrateLogFile(path, 2)
+//	}
+//
+// Public API:
+//   DetectLogVersion(path string) (int, error) - Format version of a log file (no header = 1)
+//   MigrateLogFile(path string, toVersion int) error - Rewrite a file as another format version
+//
+// Internal API:
+//   formatVersionHeader(version) - Header line for a version
+//   parseVersionHeader(line) - Version named by a header line
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bufio, fmt, os, path/filepath, strconv, strings
+//   Package Files: parsing.go (maxLineBytes)
+//
+// Dependents (What Uses This):
+//   Internal: writing.go (appendFile stamps new files), parsing.go (entryReader),
+//             integrity.go (header-only files are complete), encryption.go (ReencryptLog keeps the header plaintext),
+//             sanitize.go (ExportLog)
+//
+// Health Scoring
+//
+// Header writes: 0 (part of the entry write that carries the score)
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+// Imports
+
+import (
+	"bufio"         // First-line read
+	"fmt"           // Header formatting, errors
+	"os"            // File reading and replacement
+	"path/filepath" // Temporary file beside the log
+	"strconv"       // Version number parsing
+	"strings"       // Header detection
+)
+
+// Constants
+
+const (
+	logFormatVersion = 2                      // Version new log files are stamped with
+	logFormatLegacy  = 1                      // Files without a header (written before versioning)
+	logFormatPrefix  = "#cpi-si-log-format: " // Header line: prefix + version
+)
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// ────────────────────────────────────────────────────────────────
+// Helpers - Header Line
+// ────────────────────────────────────────────────────────────────
+
+// formatVersionHeader returns the header line a file of version starts with.
+func formatVersionHeader(version int) string {
+	return fmt.Sprintf("%s%d\n", logFormatPrefix, version)
+}
+
+// parseVersionHeader returns the version a header line names (ok false when the line is not one).
+func parseVersionHeader(line string) (int, bool) {
+	value, found := strings.CutPrefix(line, logFormatPrefix)
+	if !found {
+		return 0, false
+	}
+	version, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || version < logFormatLegacy {
+		return 0, false
+	}
+	return version, true
+}
+
+// ────────────────────────────────────────────────────────────────
+// Public APIs - Exported Interface
+// ────────────────────────────────────────────────────────────────
+
+// DetectLogVersion reports the format version of a log file.
+//
+// What It Does:
+// Reads the first line only. A format header names the version; anything
+// else (an entry, or an empty file) means version 1 - the format before
+// headers existed.
+//
+// Returns:
+//   int: Format version (1 when the file has no header)
+//   error: File could not be opened or read
+//
+// Example usage:
+//
+//	version, err := logging.DetectLogVersion(path)
+func DetectLogVersion(path string) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineBytes)
+	if !scanner.Scan() {
+		return logFormatLegacy, scanner.Err() // Empty file - nothing stamped yet
+	}
+	if version, ok := parseVersionHeader(scanner.Text()); ok {
+		return version, nil
+	}
+	return logFormatLegacy, nil
+}
+
+// MigrateLogFile rewrites a log file as another format version.
+//
+// What It Does:
+// Drops every format header in the file (concatenated logs carry several),
+// stamps the target version's header (none for version 1), and replaces the
+// file atomically (temporary file, then rename - permissions kept). Entry
+// lines are carried over byte for byte: versions 1 and 2 share one entry
+// grammar, so there is nothing to translate. A file already at toVersion is
+// left untouched.
+//
+// Parameters:
+//   path: Log file to rewrite
+//   toVersion: Target format version (1 through the current version)
+//
+// Returns:
+//   error: Unsupported version (either side), or the file could not be read or replaced
+//
+// Example usage:
+//
+//	if err := logging.MigrateLogFile(path, 2); err != nil {
+//	    fmt.Fprintf(os.Stderr, "WARNING: %v\n", err)
+//	}
+func MigrateLogFile(path string, toVersion int) error {
+	if toVersion < logFormatLegacy || toVersion > logFormatVersion {
+		return fmt.Errorf("log format version %d not supported (%d-%d)", toVersion, logFormatLegacy, logFormatVersion)
+	}
+	fromVersion, err := DetectLogVersion(path)
+	if err != nil {
+		return err
+	}
+	if fromVersion > logFormatVersion {
+		return fmt.Errorf("%s is log format version %d, newer than this logger (%d)", path, fromVersion, logFormatVersion)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var body strings.Builder
+	headers := 0
+	for _, line := range strings.SplitAfter(string(data), "\n") {
+		if _, isHeader := parseVersionHeader(strings.TrimRight(line, "\r\n")); isHeader {
+			headers++
+			continue
+		}
+		body.WriteString(line)
+	}
+	if fromVersion == toVersion && headers <= 1 { // Already uniform
+		return nil
+	}
+
+	var out strings.Builder
+	if toVersion > logFormatLegacy {
+		out.WriteString(formatVersionHeader(toVersion))
+	}
+	out.WriteString(body.String())
+
+	temp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".migrate-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name()) // No-op after a successful rename
+	if _, err := temp.WriteString(out.String()); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Chmod(info.Mode().Perm()); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), path)
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+// Library module (no entry point). Import: "system/runtime/lib/logging"
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
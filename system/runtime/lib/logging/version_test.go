@@ -0,0 +1,228 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Log Format Version Tests
+//
+// Purpose: Prove a version 1 log (no header) reads back what was logged.
+//          testdata/format-v1.log was written by the pre-versioning logger
+//          (every level, multi-line details, a stack trace, LogCommand) and
+//          is checked twice: against the calls that produced it (v1Calls)
+//          and against testdata/format-v1.golden.json for every other field
+//          (refresh with go test -run V1Fixture -update). Also prove new
+//          files are stamped with the current version once, and that
+//          DetectLogVersion and MigrateLogFile move files between versions
+//          without touching a single entry line.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// updateGolden rewrites testdata/format-v1.golden.json from current parsing
+var updateGolden = flag.Bool("update", false, "rewrite testdata golden files")
+
+// v1Call is one logger call that wrote testdata/format-v1.log, as logged
+type v1Call struct {
+	level, event      string
+	healthImpact, raw int
+	fullContext       bool
+}
+
+// v1Calls are the pre-versioning logger's calls behind testdata/format-v1.log
+// (DeclareHealthTotal(100) first) - the fixture's independent oracle
+var v1Calls = []v1Call{
+	{levelOperation, "Starting operation: validate", 10, 10, true},    // Operation("validate", 10, "--all", "main.go")
+	{levelCheck, "Checking: go.mod present", 5, 15, false},            // Check(..., true, 5, {file})
+	{levelSuccess, "Parsed config", 10, 25, false},                    // Success(..., {keys, output: two lines})
+	{levelFailure, "Permission denied", -20, 5, true},                 // Failure(..., "Insufficient permissions", -20, {file})
+	{levelError, "Unexpected panic", -10, -5, true},                   // Error(..., "index out of range", -10)
+	{levelContext, "System state snapshot: after-parse", 0, -5, true}, // SnapshotState("after-parse", 0)
+	{levelDebug, "cache state", 0, -5, true},                          // Debug(..., {entries, warm})
+	{levelCheck, "Checking: file readable", -5, -10, false},           // CheckWithMetadata(..., false, -5, ...) - v1 wrote no SEMANTIC
+	{levelSuccess, "Permissions fixed", 15, 5, false},                 // SuccessWithMetadata(..., 15, nil, ...)
+	{levelOperation, "Starting operation: true", 0, 5, true},          // LogCommand("true", nil)
+	{levelSuccess, "Command completed: true", 10, 15, false},          // LogCommand result
+}
+
+// goldenEntry shows RawSections, which LogEntry leaves out of its JSON
+type goldenEntry struct {
+	LogEntry
+	RawSections map[string][]string `json:"raw_sections,omitempty"`
+}
+
+// parsedFixture renders entries read from path as the golden file's JSON
+func parsedFixture(t *testing.T, path string) []byte {
+	t.Helper()
+	saved := time.Local // Headers are local time - pin the zone
+	time.Local = time.UTC
+	defer func() { time.Local = saved }()
+
+	entries, err := ReadLogFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden := make([]goldenEntry, len(entries))
+	for i, entry := range entries {
+		golden[i] = goldenEntry{entry, entry.RawSections}
+	}
+	data, err := json.MarshalIndent(golden, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(data, '\n')
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestReadLogFileV1Fixture(t *testing.T) {
+	fixture := filepath.Join("testdata", "format-v1.log")
+	goldenPath := filepath.Join("testdata", "format-v1.golden.json")
+
+	entries, report, err := ReadLogFileReport(fixture)
+	if err != nil || !report.OK() {
+		t.Fatalf("version 1 fixture: %v\n%s", err, strings.Join(report.Problems(), "\n"))
+	}
+	if len(entries) != len(v1Calls) {
+		t.Fatalf("read %d entries, want %d", len(entries), len(v1Calls))
+	}
+	for i, call := range v1Calls {
+		entry := entries[i]
+		if entry.Level != call.level || entry.Event != call.event || entry.HealthImpact != call.healthImpact ||
+			entry.RawHealth != call.raw || (entry.Context != nil) != call.fullContext {
+			t.Errorf("entry %d = %s %q Δ%d raw %d context %v, want %+v",
+				i+1, entry.Level, entry.Event, entry.HealthImpact, entry.RawHealth, entry.Context != nil, call)
+		}
+	}
+	if output := entries[2].Details["output"]; output != "line one\nline two" {
+		t.Errorf("multi-line detail = %q", output)
+	}
+	if trace, _ := entries[4].Details["stack_trace"].(string); !strings.HasPrefix(trace, "goroutine ") {
+		t.Errorf("stack trace = %q", trace)
+	}
+
+	got := parsedFixture(t, fixture)
+	if *updateGolden {
+		if err := os.WriteFile(goldenPath, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("version 1 fixture parses differently:\n%s", got)
+	}
+	if version, err := DetectLogVersion(fixture); err != nil || version != 1 {
+		t.Errorf("DetectLogVersion(fixture) = %d, %v; want 1", version, err)
+	}
+}
+
+func TestMigrateLogFileRoundTrip(t *testing.T) {
+	original, err := os.ReadFile(filepath.Join("testdata", "format-v1.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "migrate.log")
+	if err := os.WriteFile(path, original, 0600); err != nil {
+		t.Fatal(err)
+	}
+	v1 := parsedFixture(t, path)
+
+	if err := MigrateLogFile(path, 2); err != nil {
+		t.Fatal(err)
+	}
+	migrated, _ := os.ReadFile(path)
+	if !bytes.Equal(migrated, append([]byte(formatVersionHeader(2)), original...)) {
+		t.Errorf("migration changed entry lines:\n%s", migrated)
+	}
+	if version, _ := DetectLogVersion(path); version != 2 {
+		t.Errorf("after migration version = %d, want 2", version)
+	}
+	if got := parsedFixture(t, path); !bytes.Equal(got, v1) {
+		t.Errorf("version 2 file parses differently from version 1:\n%s", got)
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0600 {
+		t.Errorf("permissions = %v, want 0600 kept", info.Mode().Perm())
+	}
+
+	if err := MigrateLogFile(path, 1); err != nil {
+		t.Fatal(err)
+	}
+	if back, _ := os.ReadFile(path); !bytes.Equal(back, original) {
+		t.Errorf("migrating back to version 1 is not byte-identical:\n%s", back)
+	}
+
+	for _, version := range []int{0, logFormatVersion + 1} {
+		if err := MigrateLogFile(path, version); err == nil {
+			t.Errorf("MigrateLogFile(%d): want an error", version)
+		}
+	}
+	if err := os.WriteFile(path, []byte(formatVersionHeader(logFormatVersion+1)), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := MigrateLogFile(path, 1); err == nil {
+		t.Error("migrating a file from a newer logger: want an error")
+	}
+}
+
+func TestNewLogFileStampedOnce(t *testing.T) {
+	logger := newTestLogger(t, "version-test")
+	logger.Success("one", 1, nil)
+	logger.Success("two", 1, nil)
+
+	raw, _ := os.ReadFile(logger.LogFile)
+	if !bytes.HasPrefix(raw, []byte(formatVersionHeader(logFormatVersion))) ||
+		bytes.Count(raw, []byte(logFormatPrefix)) != 1 {
+		t.Errorf("want exactly one leading header:\n%s", raw)
+	}
+	if version, err := DetectLogVersion(logger.LogFile); err != nil || version != logFormatVersion {
+		t.Errorf("DetectLogVersion = %d, %v", version, err)
+	}
+	if entries := readEntries(t, logger.LogFile); len(entries) != 2 || entries[0].Event != "one" {
+		t.Errorf("entries = %+v", entries)
+	}
+
+	// Concatenated files: each header starts a file, an entry cut off before it ends there
+	joined := filepath.Join(t.TempDir(), "joined.log")
+	cut := "[2026-10-15 09:00:00.000000000] ERROR cut cut-1-1 #1\n  EVENT: killed\n"
+	if err := os.WriteFile(joined, append([]byte(cut), raw...), 0644); err != nil {
+		t.Fatal(err)
+	}
+	entries := readEntries(t, joined)
+	var events []string
+	for _, entry := range entries {
+		events = append(events, entry.Event)
+	}
+	if !reflect.DeepEqual(events, []string{"killed", "one", "two"}) {
+		t.Errorf("concatenated events = %v", events)
+	}
+
+	// A file holding only its header is complete
+	if err := os.WriteFile(logger.LogFile, []byte(formatVersionHeader(logFormatVersion)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if report, err := VerifyLogIntegrity(logger.LogFile); err != nil || !report.OK() {
+		t.Errorf("header-only file: %v %v", report.Problems(), err)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -20,25 +20,38 @@
 // Architect: Seanje Lenox-Wise, Nova Dawn
 // Implementation: Nova Dawn
 // Creation Date: 2025-11-18
-// Version: 1.0.0
-// Last Modified: 2025-11-18 - Extracted from monolithic logger.go
+// Version: 1.11.0
+// Last Modified: 2026-10-16 - Rotation helpers are Logger methods; removing the oldest rotation is audited
 //
 // Purpose & Function
 //
-// Purpose: Persist formatted log entries to disk with size-based rotation. Ensures logs don't grow unbounded while maintaining historical data through rotated files.
+// Purpose: Persist formatted log entries to disk with size- and age-based rotation. Ensures logs don't grow unbounded while maintaining historical data through rotated files.
 //
 // Core Design: Non-blocking writes with graceful degradation. Rotation happens before writes when size threshold exceeded. All failures warn to stderr and continue.
 //
 // Key Features:
 //   - Atomic log file writes (append mode)
 //   - Size-based rotation (configurable threshold)
-//   - Sequential rotation (.1 → .2 → .3 → .4 → .5, oldest deleted)
+//   - Sequential rotation (.1 → .2 → .3 → .4 → .5, oldest deleted - an audited action, audit.go)
+//   - Age-based rotation ([rotation] max_age_days, per component or global) even under the size threshold
+//   - Date-named rotations (rotation_naming = "date": component.2025-11-10.log) bounded by [retention] max_total_size_mb
+//   - Rotation retry with backoff while another process holds the file (Windows sharing violations)
+//   - Crash-safe rotation (current log staged as file.log.rotating, finished on the next write after a crash)
+//   - behavior.fsync_on_error fsyncs after FAILURE/ERROR entries only
+//   - behavior.keep_file_open holds the log open across writes, closed when idle (filehandle.go)
 //   - Graceful failure (stderr warnings, continue execution)
 //   - Directory creation with proper permissions
+//   - Duplicate coalescing (identical bursts collapse into one summary entry)
+//   - Write failure spillover (bounded in-memory backlog, flushed with a gap marker on recovery)
+//   - behavior.disabled writes nothing (CPI_SI_LOG_DISABLED=1 in CI)
+//   - Level-split copies (FAILURE/ERROR also to component.errors.log) after the primary write
+//   - [privacy] encrypt seals each entry (AES-256-GCM frame) before append; an unusable key writes nothing
+//   - Format version header (#cpi-si-log-format: N) stamped in the first write to an empty file
+//   - Failed writes and rotations counted on the Logger (selfhealth.go) - rotation helpers return what they warned about
 //
 // Blocking Status
 //
-// Non-blocking: File write failures never stop execution. If log file unavailable, warn to stderr and continue. If rotation fails, warn and continue with current file.
+// Non-blocking: File write failures never stop execution. If log file unavailable, warn to stderr once, hold entries in memory, and continue. If rotation fails, warn and continue with current file.
 // Mitigation: All file operations have error handling that allows component execution to continue.
 //
 // Usage & Integration
@@ -49,20 +62,35 @@
 //
 // Integration Pattern:
 //   1. Logger calls writeEntry() with formatted LogEntry
-//   2. writeEntry() checks rotateLogIfNeeded() before opening file
-//   3. Opens file in append mode (creates if doesn't exist)
-//   4. Writes formatted entry + newline
-//   5. Closes file automatically (defer)
+//   2. writeEntry() asks suppressRepeat() whether the entry is a coalesced duplicate
+//   3. appendEntry() checks rotateLogIfNeeded() before opening file
+//   4. Opens file in append mode (creates if doesn't exist)
+//   5. Writes formatted entry + newline
+//   6. Closes file automatically (defer)
 //
 // Internal API:
-//   rotateLogIfNeeded(logPath string) - Check and perform rotation if needed (Logger internal helper)
-//   writeEntry(entry LogEntry) - Write formatted entry to log file (Logger method)
+//   rotateLogIfNeeded(logPath string) error - Check and perform rotation if needed (Logger method)
+//   rotateLogOver(logPath string, maxBytes int64) error - Rotation at a given size threshold (Logger method)
+//   recoverRotation(logPath string) error - Finish a rotation a crash interrupted (Logger method)
+//   rotationPath(logPath string, n int) - Name of rotation n (file.log.n)
+//   writeEntry(entry LogEntry) - Coalesce duplicates, then write to log file (Logger method)
+//   appendEntry(entry LogEntry) - Write formatted entry to log file, spilling to memory on failure (Logger method)
+//   flushRepeats() - Emit pending "repeated N times" summary (Logger method)
+//   retrySpill() - Write entries held during a write failure, behind a gap marker (Logger method)
+//   appendLevelFile(level, formatted) - Secondary copy to component.<suffix>.log (levelfiles.go)
+//   sealForWrite(text) - Seal a rendered entry when encryption is on (encryption.go)
 //
 // Dependencies
 //
 // Dependencies (What This Needs):
-//   Standard Library: fmt, os
-//   Package Files: entry.go (LogEntry type), config.go (Config for constants)
+//   Standard Library: fmt, os, strings, time
+//   Package Files: entry.go (LogEntry type), config.go (Config for constants),
+//                  retention.go (logExpired, datedRotationPath, enforceRetention),
+//                  encryption.go (sealForWrite), version.go (formatVersionHeader),
+//                  filehandle.go (keepFileOpen, logHandle),
+//                  selfhealth.go (noteWriteFailure, noteRotationError),
+//                  audit.go (removeRotation),
+//                  context_unix.go / context_windows.go (isSharingViolation)
 //
 // Dependents (What Uses This):
 //   Internal: logger.go (all logging methods call writeEntry)
@@ -91,8 +119,11 @@ package logging
 // Imports
 
 import (
-	"fmt" // String formatting for stderr warnings
-	"os"  // File operations and stat checks
+	"errors"  // Simulated rotation crash detection
+	"fmt"     // String formatting for stderr warnings
+	"os"      // File operations and stat checks
+	"strings" // Spilled backlog joining
+	"time"    // Coalescing window comparison
 )
 
 // Constants
@@ -103,8 +134,30 @@ const (
 
 	maxLogSizeBytes = 10 * 1024 * 1024 // 10 MB maximum log file size before rotation
 	maxLogRotations = 5                // Keep up to 5 rotated versions (.1 through .5)
+
+	//--- Rotation Retry ---
+	// Backoff while another process holds a log open (Windows only - Unix never retries).
+
+	rotationRetryAttempts = 5                     // Tries per rename/remove before warning
+	rotationRetryDelay    = 20 * time.Millisecond // First wait, doubled each retry (20+40+80+160ms)
+
+	//--- Crash-Safe Rotation ---
+
+	rotationStagingSuffix = ".rotating" // Current log while older rotations shift (file.log.rotating)
+	rotatedLogFormat      = "%s.%d"     // Rotated file naming when [files] rotated_log_format is unset
 )
 
+// errRotationCrash is returned by a test crash hook to stop a rotation mid-way.
+var errRotationCrash = errors.New("simulated crash during rotation")
+
+// rotationCrashHook, when set, runs after each rotation step ("staged",
+// "removed", "shifted N"); a non-nil error stops the rotation right there,
+// leaving the files as a crash would. Tests only.
+var rotationCrashHook func(step string) error
+
+// syncLog flushes a log file to stable storage (swapped by tests to count calls).
+var syncLog = (*os.File).Sync
+
 // Constants (from config.go via LoadConfig)
 // These are accessed via Config variable after LoadConfig() is called.
 //
@@ -114,6 +167,39 @@ const (
 //   - Config.Format.WarnLogOpenFailed  (stderr warning message format)
 //   - Config.Format.WarnLogWriteFailed (stderr warning message format)
 //   - Config.Files.RotatedLogFormat    (format string for rotated log names)
+//   - Config.Behavior.CoalesceRepeats  (enable duplicate coalescing)
+//   - Config.Behavior.CoalesceWindowSeconds (coalescing window)
+//   - Config.Behavior.CoalesceLevels   (per-level coalescing opt-out)
+//   - Config.Messages.EventRepeated    (summary event format)
+//   - Config.Behavior.SpilloverEntries (entries held in memory while writes fail)
+//   - Config.Messages.EventSpillFlushed (gap marker event format)
+
+// Types
+
+// repeatState tracks a burst of identical consecutive entries being coalesced.
+//
+// The first occurrence is written normally and becomes the anchor. Later entries
+// with the same (level, event, reason) inside the window are suppressed and
+// counted. Their health deltas are already applied to the Logger by logEntry -
+// only the text collapses into one summary entry.
+type repeatState struct {
+	key    string   // level/event/reason tuple of the anchor entry ("" when nothing tracked)
+	anchor LogEntry // Last entry actually written (template for the summary)
+	count  int      // Suppressed repeats since the anchor was written
+	impact int      // Sum of suppressed health deltas (aggregated into the summary)
+	last   LogEntry // Most recent suppressed repeat (timestamp and health snapshot)
+}
+
+// spillState holds formatted entries while the log file cannot be written.
+//
+// Bounded ring: once full, the oldest entry is dropped and counted. Every new
+// entry retries the write with the backlog first, so recovery needs no timer.
+type spillState struct {
+	entries []string  // Formatted entries awaiting a writable file (oldest first)
+	dropped int       // Entries evicted from the full buffer (lost for good)
+	reason  string    // First write failure of this gap
+	since   time.Time // Timestamp of the first delayed entry
+}
 
 // ============================================================================
 // END SETUP
@@ -127,11 +213,168 @@ const (
 // Helpers - Foundation Functions
 // ────────────────────────────────────────────────────────────────
 
-// rotateLogIfNeeded checks if log file exceeds size limit and rotates if needed.
+// retryWithBackoff runs op until it succeeds, fails for a non-retryable reason, or attempts run out.
+//
+// retryable and sleep are injected so the loop is testable on platforms
+// where sharing violations never happen.
+func retryWithBackoff(op func() error, retryable func(error) bool, sleep func(time.Duration)) error {
+	delay := rotationRetryDelay
+	err := op()
+	for attempt := 1; attempt < rotationRetryAttempts && err != nil && retryable(err); attempt++ {
+		sleep(delay)
+		delay *= 2
+		err = op()
+	}
+	return err
+}
+
+// renameRotation renames one log file, retrying while another process holds it.
+func renameRotation(from, to string) error {
+	return retryWithBackoff(func() error { return os.Rename(from, to) }, isSharingViolation, time.Sleep)
+}
+
+// rotationPath names rotation n of logPath (file.log.1 ... file.log.5).
+func rotationPath(logPath string, n int) string {
+	LoadConfig()
+	if ConfigLoaded && Config.Files.RotatedLogFormat != "" {
+		return fmt.Sprintf(Config.Files.RotatedLogFormat, logPath, n)
+	}
+	return fmt.Sprintf(rotatedLogFormat, logPath, n)
+}
+
+// rotationStagingPath is where the current log waits while older rotations shift.
+func rotationStagingPath(logPath string) string {
+	return logPath + rotationStagingSuffix
+}
+
+// rotationStep reports a completed rotation step to the crash hook (tests only).
+func rotationStep(step string) error {
+	if rotationCrashHook == nil {
+		return nil
+	}
+	return rotationCrashHook(step)
+}
+
+// shiftRotations makes room at .1 by shifting rotations up to the first gap.
+//
+// Walks down from the lowest missing index (or from the oldest, deleted first
+// through the audit trail, when the chain is full), renaming .i→.i+1 only when .i exists and .i+1 does
+// not - so running it again after a crash never overwrites a rotation.
+func (l *Logger) shiftRotations(logPath string) error {
+	gap := 0
+	for i := 1; i <= maxLogRotations; i++ {
+		if _, err := os.Stat(rotationPath(logPath, i)); os.IsNotExist(err) {
+			gap = i
+			break
+		}
+	}
+	if gap == 0 { // Chain full - the oldest rotation makes room
+		oldest := rotationPath(logPath, maxLogRotations)
+		if err := l.removeRotation(oldest, "max_rotations"); err != nil {
+			return fmt.Errorf("remove oldest rotation %s: %w", oldest, err)
+		}
+		gap = maxLogRotations
+		if err := rotationStep("removed"); err != nil {
+			return err
+		}
+	}
+
+	for i := gap - 1; i >= 1; i-- {
+		from, to := rotationPath(logPath, i), rotationPath(logPath, i+1)
+		if _, err := os.Stat(to); err == nil { // Never overwrite - a crashed run may have moved it already
+			return fmt.Errorf("rotation %s already exists", to)
+		}
+		if err := renameRotation(from, to); err != nil {
+			return fmt.Errorf("rotate %s to %s: %w", from, to, err)
+		}
+		if err := rotationStep(fmt.Sprintf("shifted %d", i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// finishRotation shifts older rotations and moves the staged log to .1 - or,
+// with rotation_naming = "date", to component.YYYY-MM-DD.log (nothing shifts).
+//
+// If .1 cannot be freed, the staged log is rolled back to the current path
+// (when nothing has been written there since) so no entries are stranded.
+// A finished rotation ends with [retention] max_total_size_mb enforcement.
+func (l *Logger) finishRotation(logPath string) error {
+	staging := rotationStagingPath(logPath)
+	var err error
+	if dateNaming() { // Named for its first entry's day
+		err = renameRotation(staging, datedRotationPath(logPath, rotationDay(staging)))
+	} else if err = l.shiftRotations(logPath); err == nil {
+		err = renameRotation(staging, rotationPath(logPath, 1))
+	}
+	if err == nil {
+		l.enforceRetention(logPath)
+		return nil
+	}
+	if errors.Is(err, errRotationCrash) { // Simulated crash - leave the state as a crash would
+		return err
+	}
+	if _, statErr := os.Stat(logPath); os.IsNotExist(statErr) {
+		if rollbackErr := renameRotation(staging, logPath); rollbackErr == nil {
+			return fmt.Errorf("%w (rolled back)", err)
+		}
+	}
+	return err
+}
+
+// recoverRotation finishes a rotation that a crash interrupted.
+//
+// A staged file means the current log was moved aside but never reached .1;
+// finishing it (or rolling it back) keeps every entry in the chain. Returns
+// the failure it warned about (nil when nothing needed recovering).
+func (l *Logger) recoverRotation(logPath string) error {
+	if _, err := os.Stat(rotationStagingPath(logPath)); err != nil {
+		return nil // Nothing interrupted
+	}
+	if err := l.finishRotation(logPath); err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Failed to recover interrupted log rotation %s: %v\n", logPath, err)
+		return err
+	}
+	return nil
+}
+
+// rotateLogIfNeeded checks if log file exceeds the size or age limit and rotates if needed.
 //
 // Rotation strategy: Keep maxLogRotations versions (.1 through .5), delete oldest.
 // Sequence: file.log → file.log.1 → file.log.2 → ... → file.log.5 (deleted)
-func rotateLogIfNeeded(logPath string) {
+// With rotation_naming = "date": file.log → file.2025-11-10.log (see retention.go)
+//
+// Crash safety: the current log is first renamed to file.log.rotating (one
+// atomic step), then older rotations shift in reverse order with existence
+// checks, then the staged file becomes .1. A crash at any point leaves either
+// the staged file (finished by recoverRotation on the next write) or a
+// complete chain - never a truncated current log or a hole from a lost rename.
+//
+// Returns the rotation failure it warned about (nil = rotated or not needed) -
+// the Logger counts it (SelfDiagnostics).
+func (l *Logger) rotateLogIfNeeded(logPath string) error {
+	return l.rotateLogOver(logPath, maxLogSizeBytes)
+}
+
+// rotateLogOver rotates logPath once it reaches maxBytes (rotateLogIfNeeded's policy
+// is the 10 MB main-log limit; level-split files pass their own) or its first
+// entry is older than [rotation] max_age_days.
+//
+// An audited removal inside a rotation logs an entry of its own; l.rotating
+// keeps that entry's write from starting a second rotation mid-shift.
+func (l *Logger) rotateLogOver(logPath string, maxBytes int64) error {
+	if l.rotating {
+		return nil // Audit entry written from inside this rotation - the next write checks again
+	}
+	l.rotating = true
+	defer func() { l.rotating = false }()
+
+	// Finish a rotation an earlier process crashed in the middle of
+	if err := l.recoverRotation(logPath); err != nil {
+		return err
+	}
+
 	// Check if log file exists and get size
 	info, err := os.Stat(logPath)
 	if err != nil {
@@ -140,83 +383,325 @@ func rotateLogIfNeeded(logPath string) {
 			// Warn on stat errors other than "not exist"
 			fmt.Fprintf(os.Stderr, "WARNING: Failed to stat log file %s: %v\n", logPath, err)
 		}
-		return
+		return nil // Nothing rotated, nothing failed to - the write reports an unusable path
 	}
 
-	// Check if file size exceeds rotation threshold
-	if info.Size() < maxLogSizeBytes {
-		return // File is under size limit, no rotation needed
+	// Check if file size exceeds rotation threshold or the file has aged out
+	if info.Size() < maxBytes && !logExpired(logPath, info) {
+		return nil // File is under size and age limits, no rotation needed
 	}
 
-	// File exceeds size limit - perform rotation
+	// File exceeds a limit - perform rotation
 
-	// Ensure config loaded for rotation format
+	// Step 1: Stage the current log (file.log → file.log.rotating) - fresh writes start a new file
+	if err := renameRotation(logPath, rotationStagingPath(logPath)); err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Failed to rotate current log %s: %v\n", logPath, err)
+		return err
+	}
+	forgetLogStart(logPath) // The next write starts a new file - and a new age
+	if rotationStep("staged") != nil {
+		return nil // Simulated crash - not a failure this process saw
+	}
+
+	// Step 2-3: Shift older rotations (.4→.5 ... .1→.2), then staged → .1
+	if err := l.finishRotation(logPath); err != nil && !errors.Is(err, errRotationCrash) {
+		fmt.Fprintf(os.Stderr, "WARNING: Failed to rotate log %s: %v\n", logPath, err)
+		return err
+	}
+
+	// Current log now doesn't exist - ready for fresh writes
+	return nil
+}
+
+// coalesceKey builds the identity tuple used to recognize repeated entries.
+func coalesceKey(entry LogEntry) string {
+	reason := ""
+	if r, ok := entry.Details["reason"]; ok { // Failures carry their reason in details
+		reason = fmt.Sprint(r)
+	}
+	return entry.Level + "\x00" + entry.Event + "\x00" + reason + "\x00" + strings.Join(entry.Tags, "\x00")
+}
+
+// coalesceEnabled reports whether entries at this level may be coalesced.
+//
+// Off unless [behavior] coalesce_repeats is set. Per-level config overrides the
+// hardcoded policy (ERROR never coalesces by default); unlisted levels coalesce.
+func coalesceEnabled(level string) bool {
 	LoadConfig()
 
-	// Step 1: Delete oldest rotation if it exists (file.log.5)
-	oldestRotation := fmt.Sprintf(Config.Files.RotatedLogFormat, logPath, maxLogRotations)
-	if _, err := os.Stat(oldestRotation); err == nil {
-		if err := os.Remove(oldestRotation); err != nil {
-			fmt.Fprintf(os.Stderr, "WARNING: Failed to remove oldest log rotation %s: %v\n", oldestRotation, err)
-		}
+	if !ConfigLoaded || !Config.Behavior.CoalesceRepeats { // Opt-in feature
+		return false
 	}
+	if enabled, ok := Config.Behavior.CoalesceLevels[level]; ok { // Config decision wins
+		return enabled
+	}
+	if enabled, ok := logLevelCoalesce[level]; ok { // Fallback to hardcoded policy
+		return enabled
+	}
+	return true
+}
 
-	// Step 2: Shift all existing rotations up by 1 (.4→.5, .3→.4, .2→.3, .1→.2)
-	for i := maxLogRotations - 1; i >= 1; i-- {
-		currentRotation := fmt.Sprintf(Config.Files.RotatedLogFormat, logPath, i)
-		nextRotation := fmt.Sprintf(Config.Files.RotatedLogFormat, logPath, i+1)
+// coalesceWindow returns how long after the anchor entry repeats are suppressed.
+func coalesceWindow() time.Duration {
+	if ConfigLoaded && Config.Behavior.CoalesceWindowSeconds > 0 {
+		return time.Duration(Config.Behavior.CoalesceWindowSeconds) * time.Second
+	}
+	return coalesceWindowDefault
+}
 
-		// Check if current rotation exists before renaming
-		if _, err := os.Stat(currentRotation); err == nil {
-			if err := os.Rename(currentRotation, nextRotation); err != nil {
-				fmt.Fprintf(os.Stderr, "WARNING: Failed to rotate log %s to %s: %v\n", currentRotation, nextRotation, err)
-			}
-		}
+// spilloverCapacity returns how many formatted entries to hold while writes fail.
+func spilloverCapacity() int {
+	if ConfigLoaded && Config.Behavior.SpilloverEntries > 0 {
+		return Config.Behavior.SpilloverEntries
 	}
+	return spilloverEntriesDefault
+}
 
-	// Step 3: Rename current log to .1 (file.log → file.log.1)
-	firstRotation := fmt.Sprintf(Config.Files.RotatedLogFormat, logPath, 1)
-	if err := os.Rename(logPath, firstRotation); err != nil {
-		fmt.Fprintf(os.Stderr, "WARNING: Failed to rotate current log %s to %s: %v\n", logPath, firstRotation, err)
+// probeWritable opens the log file for append (creating it) without writing.
+//
+// Used by NewLogger so a broken logs directory shows up at startup, not after
+// a long run has already lost its narrative.
+func probeWritable(logPath string) error {
+	file, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, logFilePermissions)
+	if err != nil {
+		return err
 	}
+	return file.Close()
+}
 
-	// Current log now doesn't exist - ready for fresh writes
+// fsyncOnError reports whether entries at level are fsynced ([behavior] fsync_on_error).
+//
+// Only FAILURE and ERROR pay for durability - the records that matter most
+// after a power loss - so ordinary entries stay cheap.
+func fsyncOnError(level string) bool {
+	LoadConfig()
+	return ConfigLoaded && Config.Behavior.FsyncOnError && (level == levelFailure || level == levelError)
+}
+
+// writeLog appends text to the log file in a single write, fsyncing when durable is set.
+//
+// With [behavior] keep_file_open the write goes through the Logger's held
+// handle (filehandle.go); otherwise the file is opened for this write alone.
+func (l *Logger) writeLog(text string, durable bool) error {
+	if keepFileOpen() {
+		if l.handle == nil {
+			l.handle = &logHandle{}
+		}
+		return l.handle.append(l.LogFile, text, durable)
+	}
+	return appendFile(l.LogFile, text, durable)
+}
+
+// appendFile appends text to path in a single write, fsyncing when durable is set.
+//
+// An empty file (new, or created by probeWritable) gets the format version
+// header in the same write, so the header never stands apart from the entries.
+func appendFile(path, text string, durable bool) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, logFilePermissions)
+	if err != nil {
+		return err
+	}
+	if info, statErr := file.Stat(); statErr == nil && info.Size() == 0 { // First write - stamp the format version
+		text = formatVersionHeader(logFormatVersion) + text
+	}
+	_, err = file.WriteString(text)
+	if err == nil && durable {
+		err = syncLog(file)
+	}
+	if closeErr := file.Close(); err == nil { // Close can report deferred write errors (disk full)
+		err = closeErr
+	}
+	return err
 }
 
 // ────────────────────────────────────────────────────────────────
 // Core Operations - File Writing
 // ────────────────────────────────────────────────────────────────
 
-// writeEntry formats and writes a log entry to the log file (fails gracefully).
+// writeEntry writes a log entry, collapsing identical bursts when coalescing is enabled.
 //
 // Non-blocking design: All failures warn to stderr and return, allowing execution to continue.
 func (l *Logger) writeEntry(entry LogEntry) {
-	// Check if log rotation is needed before opening file
-	rotateLogIfNeeded(l.LogFile)
+	if l.suppressRepeat(entry) { // Duplicate inside window - counted, not written
+		return
+	}
+	l.appendEntry(entry)
+}
 
-	// Ensure config loaded for permissions and warning messages
+// suppressRepeat decides whether entry is a repeat of the anchor entry.
+//
+// Returns true when the entry was absorbed into the pending burst. Otherwise any
+// pending summary is flushed first and entry becomes the new anchor.
+func (l *Logger) suppressRepeat(entry LogEntry) bool {
+	if !coalesceEnabled(entry.Level) { // Never an anchor or a repeat - skip building the key
+		l.flushRepeats()
+		return false
+	}
+	key := coalesceKey(entry)
+	pending := &l.repeats
+
+	if pending.key == key &&
+		entry.Timestamp.Sub(pending.anchor.Timestamp) < coalesceWindow() { // Same tuple, window still open
+		pending.count++
+		pending.impact += entry.HealthImpact
+		pending.last = entry
+		return true
+	}
+
+	l.flushRepeats() // Different entry or window closed - summarize what was suppressed
+
+	l.repeats = repeatState{key: key, anchor: entry} // Entry becomes the new anchor
+	return false
+}
+
+// flushRepeats writes a "repeated N times" summary for any suppressed entries.
+//
+// The summary carries the aggregated health delta of the suppressed entries and
+// the health snapshot after the last one, so the log stays arithmetically exact.
+func (l *Logger) flushRepeats() {
+	pending := l.repeats
+	l.repeats = repeatState{} // Reset before writing (summary is never a new anchor)
+
+	if pending.count == 0 { // Nothing suppressed
+		return
+	}
+
+	// Format summary message using config with fallback (multi-layer tripwire)
+	var eventMsg string
+	if ConfigLoaded && Config.Messages.EventRepeated != "" {
+		eventMsg = fmt.Sprintf(Config.Messages.EventRepeated, pending.count)
+	} else {
+		eventMsg = fmt.Sprintf(eventRepeated, pending.count)
+	}
+
+	summary := pending.anchor
+	summary.Timestamp = pending.last.Timestamp
+	summary.Sequence = pending.last.Sequence // Stands in for the last repeat - file order stays monotonic
+	summary.Event = eventMsg
+	summary.Context = nil      // Anchor already carries full context
+	summary.Interactions = nil // Interactions belong to the anchor
+	summary.Semantic = nil     // Metadata belongs to the anchor
+	summary.Details = map[string]any{
+		"repeated_event": pending.anchor.Event,
+		"repeat_count":   pending.count,
+		"first_seen":     pending.anchor.Timestamp.Format(timestampFormat),
+		"last_seen":      pending.last.Timestamp.Format(timestampFormat),
+	}
+	summary.HealthImpact = pending.impact
+	summary.RawHealth = pending.last.RawHealth
+	summary.NormalizedHealth = pending.last.NormalizedHealth
+
+	l.appendEntry(summary)
+}
+
+// appendEntry formats and appends a log entry to the log file (fails gracefully).
+//
+// Non-blocking design: A failed write warns to stderr once and spills the entry
+// to memory; later entries retry with the backlog first (see spillEntry).
+func (l *Logger) appendEntry(entry LogEntry) {
+	// Ensure config loaded for spillover capacity, marker format, and output format
 	LoadConfig()
+	if Config.Behavior.Disabled { // behavior.disabled / CPI_SI_LOG_DISABLED - health already counted
+		return
+	}
+
+	// Check if log rotation is needed before opening file
+	l.noteRotationError(l.rotateLogIfNeeded(l.LogFile))
+
+	// Format log entry as text or a JSON line (format.output), sealed when [privacy] encrypt is on
+	formatted, err := l.sealForWrite(l.renderEntry(entry)) // renderEntry from entry.go, sealing from encryption.go
+	if err != nil {                                        // Key unusable - never fall back to plaintext
+		if !l.sealWarned {
+			fmt.Fprintf(os.Stderr, "WARNING: Log encryption is on but the key is unusable: %v (entries for %s are not written)\n", err, l.LogFile)
+			l.sealWarned = true
+		}
+		l.noteWriteFailure()
+		return
+	}
+	durable := fsyncOnError(entry.Level)
+	defer l.appendLevelFile(entry.Level, formatted) // Secondary copy after the primary write ([routing.level_files])
 
-	// Convert permission strings to os.FileMode
-	// NOTE: In Phase 7, this will use actual config values. For now, use default 0644.
-	logFilePermissions := os.FileMode(0644)
+	if len(l.spill.entries) == 0 && l.spill.dropped == 0 { // Normal path - nothing waiting
+		if err := l.writeLog(formatted, durable); err != nil {
+			fmt.Fprintf(os.Stderr, "WARNING: Failed to write log file %s: %v (holding up to %d entries in memory until writes recover)\n",
+				l.LogFile, err, spilloverCapacity())
+			l.noteWriteFailure()
+			l.spillEntry(formatted, entry.Timestamp, err)
+		}
+		return
+	}
 
-	// Open log file in append mode (create if doesn't exist, permissions from config)
-	file, err := os.OpenFile(l.LogFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, logFilePermissions)
-	if err != nil { // Failed to open log file
-		// Fail gracefully - logging should never interrupt execution
-		fmt.Fprintf(os.Stderr, "WARNING: Failed to open log file %s: %v\n", l.LogFile, err)
-		return // Exit early, operation continues
+	// Earlier writes failed - retry with the gap marker and backlog ahead of this entry
+	backlog := l.formatSpillMarker() + strings.Join(l.spill.entries, "") + formatted
+	if err := l.writeLog(backlog, durable); err != nil { // Still failing - keep holding (no repeat warning)
+		l.noteWriteFailure()
+		l.spillEntry(formatted, entry.Timestamp, err)
+		return
 	}
-	defer file.Close() // Ensure file is closed when function exits
+	l.spill = spillState{} // Recovered - backlog is on disk
+}
 
-	// Format log entry according to documented standard
-	formatted := l.formatEntry(entry) // Delegate to formatEntry from entry.go
+// retrySpill writes a held backlog (with its gap marker) if the log file accepts it again.
+func (l *Logger) retrySpill() {
+	if len(l.spill.entries) == 0 && l.spill.dropped == 0 { // Nothing held
+		return
+	}
+	if err := l.writeLog(l.formatSpillMarker()+strings.Join(l.spill.entries, ""), fsyncOnError(levelFailure)); err == nil { // Gap marker is a FAILURE
+		l.spill = spillState{}
+	}
+}
 
-	// Write formatted entry to file
-	if _, err := file.WriteString(formatted + "\n"); err != nil { // Write failed
-		fmt.Fprintf(os.Stderr, "WARNING: Failed to write to log file %s: %v\n", l.LogFile, err)
-	} // Suppress error - non-blocking design
+// spillEntry holds a formatted entry in the bounded buffer, dropping the oldest when full.
+func (l *Logger) spillEntry(formatted string, at time.Time, err error) {
+	if l.spill.reason == "" { // First failure of this gap
+		l.spill.reason = err.Error()
+		l.spill.since = at
+	}
+	l.spill.entries = append(l.spill.entries, formatted)
+	if excess := len(l.spill.entries) - spilloverCapacity(); excess > 0 {
+		l.spill.entries = l.spill.entries[excess:]
+		l.spill.dropped += excess
+	}
+}
+
+// formatSpillMarker formats the gap marker written ahead of a recovered backlog.
+//
+// The marker is stamped with the gap's start and sequence 0 (it is not one of
+// the Logger's numbered entries), so MergeEntries places it before the delayed
+// entries it describes. No health impact - the delayed entries carry their own.
+func (l *Logger) formatSpillMarker() string {
+	delayed := len(l.spill.entries) + l.spill.dropped
+
+	// Format marker message using config with fallback (multi-layer tripwire)
+	var eventMsg string
+	if ConfigLoaded && Config.Messages.EventSpillFlushed != "" {
+		eventMsg = fmt.Sprintf(Config.Messages.EventSpillFlushed, delayed, l.spill.reason)
+	} else {
+		eventMsg = fmt.Sprintf(eventSpillFlushed, delayed, l.spill.reason)
+	}
+
+	marker := LogEntry{
+		Timestamp:       l.spill.since,
+		Level:           levelFailure,
+		Component:       l.Component,
+		ContextID:       l.ContextID,
+		ParentContextID: l.ParentContextID,
+		Event:           eventMsg,
+		Details: map[string]any{
+			"reason":       l.spill.reason,
+			"delayed":      len(l.spill.entries),
+			"dropped":      l.spill.dropped,
+			"gap_start":    l.spill.since.Format(timestampFormat),
+			"recovered_at": now().Format(timestampFormat),
+		},
+		RawHealth:        l.SessionHealth,
+		NormalizedHealth: l.NormalizedHealth,
+	}
+	sealed, err := l.sealForWrite(l.renderEntry(marker))
+	if err != nil { // Key went away since the backlog was sealed - entries go without their marker
+		return ""
+	}
+	return sealed
 }
 
 // ============================================================================
@@ -0,0 +1,260 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// File Writing Tests
+//
+// Purpose: Prove a failing log path costs no narrative - entries spill to a
+//          bounded memory buffer, reach the file in order once writes recover,
+//          and arrive behind a gap marker - and that NewLogger's startup check
+//          reports an unwritable path. A rotation killed mid-shift is finished
+//          on the next write, and fsync_on_error syncs only FAILURE/ERROR.
+//          Coalesced bursts collapse into one entry plus a summary carrying
+//          the summed health impact, logging resumes once the window closes,
+//          and ERROR entries are never coalesced.
+// ============================================================================
+
+package logging
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeRotationChain writes a log over the rotation threshold plus the given rotations
+func writeRotationChain(t *testing.T, logPath string, rotations ...string) {
+	t.Helper()
+	for i, content := range rotations {
+		if err := os.WriteFile(rotationPath(logPath, i+1), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(logPath, []byte("current\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(logPath, maxLogSizeBytes); err != nil { // Sparse - no 10 MB write
+		t.Fatal(err)
+	}
+	file, err := os.OpenFile(logPath, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	file.WriteString("\n---\n") // Ends with a complete entry
+}
+
+// rotationContents reads .1 through .5 ("" for a missing rotation)
+func rotationContents(logPath string) []string {
+	var contents []string
+	for i := 1; i <= maxLogRotations; i++ {
+		data, _ := os.ReadFile(rotationPath(logPath, i))
+		contents = append(contents, strings.SplitN(string(data), "\n", 2)[0])
+	}
+	return contents
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestSpilloverFlushesBacklogOnRecovery(t *testing.T) {
+	logger := newTestLogger(t, "spill-test")
+	withBehavior(t, func(cfg *LoggingConfig) { cfg.Behavior.SpilloverEntries = 3 })
+
+	working := logger.LogFile
+	logger.LogFile = filepath.Join(working, "blocked") // Parent is a file - every open fails, even as root
+	for i := 1; i <= 5; i++ {
+		logger.Success(fmt.Sprintf("during-%d", i), 1, nil)
+	}
+	if len(logger.spill.entries) != 3 || logger.spill.dropped != 2 {
+		t.Fatalf("spill holds %d, dropped %d (want 3, 2)", len(logger.spill.entries), logger.spill.dropped)
+	}
+
+	logger.LogFile = working // Writes recover
+	logger.Success("after", 1, nil)
+	if len(logger.spill.entries) != 0 || logger.spill.dropped != 0 {
+		t.Errorf("spill not cleared after recovery: %+v", logger.spill)
+	}
+
+	entries := readEntries(t, working)
+	if len(entries) != 5 {
+		t.Fatalf("read %d entries, want marker + 3 delayed + 1", len(entries))
+	}
+	marker := entries[0]
+	if !strings.HasPrefix(marker.Event, "5 entries delayed due to write failure: ") || marker.Sequence != 0 {
+		t.Errorf("marker = %q (sequence %d)", marker.Event, marker.Sequence)
+	}
+	if marker.Details["dropped"] != "2" || marker.Details["delayed"] != "3" {
+		t.Errorf("marker details = %v", marker.Details)
+	}
+	var events []string
+	for _, entry := range entries[1:] {
+		events = append(events, entry.Event)
+	}
+	if !reflect.DeepEqual(events, []string{"during-3", "during-4", "during-5", "after"}) {
+		t.Errorf("backlog order = %q", events)
+	}
+	if merged := MergeEntries(entries[1:], entries[:1]); merged[0].Event != marker.Event {
+		t.Errorf("marker merges after %q, want first", merged[0].Event)
+	}
+}
+
+func TestNewLoggerChecksWritability(t *testing.T) {
+	healthy := newTestLogger(t, "writable-test")
+	entries, _ := ReadLogFile(healthy.LogFile)
+	if len(entries) != 2 || entries[0].Level != levelCheck || entries[0].Details["result"] != "true" || entries[1].Event != eventLogRouted {
+		t.Errorf("startup check = %+v", entries)
+	}
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	if err := os.WriteFile(filepath.Join(home, ".claude"), nil, 0644); err != nil { // Blocks the logs directory
+		t.Fatal(err)
+	}
+	broken := NewLogger("unwritable-test")
+	if len(broken.spill.entries) != 2 || !strings.Contains(broken.spill.entries[0], "result: false") {
+		t.Errorf("failed check not held for later: %q", broken.spill.entries)
+	}
+}
+
+func TestRotationCrashIsFinishedOnNextWrite(t *testing.T) {
+	logger := newTestLogger(t, "crash-test")
+	logPath := filepath.Join(t.TempDir(), "crash.log")
+	writeRotationChain(t, logPath, "r1\n---\n", "r2\n---\n", "r3\n---\n")
+
+	rotationCrashHook = func(step string) error {
+		if step == "shifted 2" { // .3→.4 and .2→.3 done, .1→.2 never happens
+			return errRotationCrash
+		}
+		return nil
+	}
+	t.Cleanup(func() { rotationCrashHook = nil })
+	logger.rotateLogIfNeeded(logPath)
+
+	report, err := VerifyLogIntegrity(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.StagedRotation == "" || !reflect.DeepEqual(report.Gaps, []string{rotationPath(logPath, 2)}) {
+		t.Errorf("after crash: staged %q, gaps %q", report.StagedRotation, report.Gaps)
+	}
+
+	rotationCrashHook = nil
+	logger.rotateLogIfNeeded(logPath) // Next write recovers before checking size
+	if got := rotationContents(logPath); !reflect.DeepEqual(got, []string{"current", "r1", "r2", "r3", ""}) {
+		t.Errorf("chain after recovery = %q", got)
+	}
+	if report, _ := VerifyLogIntegrity(logPath); !report.OK() {
+		t.Errorf("recovered chain not OK: %q", report.Problems())
+	}
+
+	// Full chain: the oldest rotation is dropped, nothing else lost
+	writeRotationChain(t, logPath, "a\n---\n", "b\n---\n", "c\n---\n", "d\n---\n", "e\n---\n")
+	logger.rotateLogIfNeeded(logPath)
+	if got := rotationContents(logPath); !reflect.DeepEqual(got, []string{"current", "a", "b", "c", "d"}) {
+		t.Errorf("full chain after rotation = %q", got)
+	}
+}
+
+func TestFsyncOnErrorSyncsOnlyFailures(t *testing.T) {
+	logger := newTestLogger(t, "fsync-test")
+	withBehavior(t, func(cfg *LoggingConfig) { cfg.Behavior.SpilloverEntries = 10 }) // Saves and restores Config
+	Config.Behavior.FsyncOnError = true
+
+	syncs := 0
+	syncLog = func(file *os.File) error { syncs++; return file.Sync() }
+	t.Cleanup(func() { syncLog = (*os.File).Sync })
+
+	logger.Success("routine", 1, nil)
+	logger.Check("routine check", true, 1, nil)
+	if syncs != 0 {
+		t.Errorf("%d syncs for SUCCESS/CHECK, want 0", syncs)
+	}
+	logger.Failure("broken", "disk", -5, nil)
+	logger.Error("unexpected", os.ErrInvalid, -10)
+	if syncs != 2 {
+		t.Errorf("%d syncs for FAILURE + ERROR, want 2", syncs)
+	}
+}
+
+func TestCoalesceBurstIntoSummary(t *testing.T) {
+	logger := newTestLogger(t, "coalesce-test")
+	clock := useFakeClock(t)
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Behavior.CoalesceRepeats = true
+		cfg.Behavior.CoalesceWindowSeconds = 10
+	})
+
+	before := logger.SessionHealth
+	logger.Failure("Fetch failed", "timeout", -2, nil) // Anchor - written
+	for _, impact := range []int{-3, -4, -5} {         // Repeats inside the window - counted
+		clock.advance(time.Second)
+		logger.Failure("Fetch failed", "timeout", impact, nil)
+	}
+	logger.Success("Fetch recovered", 1, nil) // Different entry - flushes the burst
+
+	entries := readEntries(t, logger.LogFile)
+	want := []string{"Fetch failed", fmt.Sprintf(eventRepeated, 3), "Fetch recovered"}
+	if got := events(entries); !reflect.DeepEqual(got, want) {
+		t.Fatalf("events = %q, want %q", got, want)
+	}
+	summary := entries[1]
+	if summary.Level != levelFailure || fmt.Sprint(summary.Details["repeat_count"]) != "3" ||
+		summary.Details["repeated_event"] != "Fetch failed" {
+		t.Errorf("summary = %s %v, want FAILURE repeating \"Fetch failed\" 3 times", summary.Level, summary.Details)
+	}
+	if summary.HealthImpact != -3-4-5 {
+		t.Errorf("summary health impact %d, want the suppressed sum -12", summary.HealthImpact)
+	}
+	written := 0
+	for _, entry := range entries {
+		written += entry.HealthImpact
+	}
+	if delta := logger.SessionHealth - before; written != delta {
+		t.Errorf("entries on disk sum to %d, logger moved by %d - health accounting drifted", written, delta)
+	}
+}
+
+func TestCoalesceWindowCloses(t *testing.T) {
+	logger := newTestLogger(t, "coalesce-window-test")
+	clock := useFakeClock(t)
+	withBehavior(t, func(cfg *LoggingConfig) {
+		cfg.Behavior.CoalesceRepeats = true
+		cfg.Behavior.CoalesceWindowSeconds = 10
+	})
+
+	logger.Check("Poll", true, 1, nil)
+	clock.advance(5 * time.Second)
+	logger.Check("Poll", true, 1, nil) // Suppressed
+	clock.advance(6 * time.Second)     // 11s after the anchor - window closed
+	logger.Check("Poll", true, 1, nil) // Written as the new anchor
+	logger.Flush()
+
+	want := []string{"Checking: Poll", fmt.Sprintf(eventRepeated, 1), "Checking: Poll"}
+	if got := events(readEntries(t, logger.LogFile)); !reflect.DeepEqual(got, want) {
+		t.Errorf("events = %q, want %q", got, want)
+	}
+}
+
+func TestCoalesceNeverCollapsesErrors(t *testing.T) {
+	logger := newTestLogger(t, "coalesce-error-test")
+	useFakeClock(t)
+	withBehavior(t, func(cfg *LoggingConfig) { cfg.Behavior.CoalesceRepeats = true })
+
+	for range 3 {
+		logger.Error("Parse panic", os.ErrInvalid, -10)
+	}
+	logger.Flush()
+
+	if got := events(readEntries(t, logger.LogFile)); !reflect.DeepEqual(got, []string{"Parse panic", "Parse panic", "Parse panic"}) {
+		t.Errorf("events = %q, want every ERROR written", got)
+	}
+}
@@ -22,8 +22,8 @@
 // Architect: Nova Dawn (CPI-SI instance)
 // Implementation: Nova Dawn
 // Creation Date: 2025-11-10
-// Version: 1.0.0
-// Last Modified: 2025-11-10 - Initial creation
+// Version: 1.1.0
+// Last Modified: 2026-10-16 - RedactionLabel for callers redacting their own fields
 //
 // Purpose & Function
 //
@@ -71,6 +71,7 @@
 // Public API:
 //   - SanitizePath(path string) string
 //   - SanitizeCommand(cmd string) string
+//   - RedactionLabel() string
 //
 // Dependencies
 //
@@ -464,6 +465,22 @@ func SanitizeCommand(cmd string) string {
 	}
 }
 
+// ────────────────────────────────────────────────────────────────
+// Redaction Label
+// ────────────────────────────────────────────────────────────────
+
+// RedactionLabel returns the label that stands in for redacted values
+// Callers that decide for themselves what is sensitive (a user's marked
+// config fields) still redact with the same label as everything else
+func RedactionLabel() string {
+	loadConfig()
+
+	if privacyCfg.Sanitization.RedactionLabel == "" {
+		return "[REDACTED]"
+	}
+	return privacyCfg.Sanitization.RedactionLabel
+}
+
 // ============================================================================
 // END BODY
 // ============================================================================
@@ -496,8 +513,9 @@ func SanitizeCommand(cmd string) string {
 // Package Exports:
 //   - SanitizePath(path string) string
 //   - SanitizeCommand(cmd string) string
+//   - RedactionLabel() string
 //
-// Both functions are thread-safe and cache configuration after first load.
+// All functions are thread-safe and cache configuration after first load.
 // All operations fail gracefully with maximum privacy protection on errors.
 // Uses singleton pattern (sync.Once) for thread-safe config loading.
 //
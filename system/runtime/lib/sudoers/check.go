@@ -4,6 +4,7 @@
 // Sudoers Validation Library - CPI-SI Interactive Terminal System
 // Purpose: Validate sudoers configuration installation and correctness
 // Non-blocking: Returns status without interrupting workflow
+// Audited: Every Check() result is appended to the logging audit trail
 //
 // HEALTH SCORING MAP (TRUE SCORE):
 // ----------------------------------
@@ -76,6 +77,9 @@ func Check() Status {
 		"expected_perms": "0440",
 	})
 
+	// Every check - early returns included - ends in the audit trail (security-relevant)
+	defer func() { auditCheck(logger, status) }()
+
 	// Action 1/3: Check if file exists (+50 or -50) - CRITICAL
 	if _, err := os.Stat(status.FilePath); err == nil {
 		status.FileExists = true
@@ -173,23 +177,23 @@ func Check() Status {
 		"syntax_valid":  status.SyntaxValid,
 	})
 
-	// Log final status
-	if status.Installed {
-		logger.Success("Sudoers configuration validated successfully", 0, map[string]any{
-			"installed": true,
-		})
-	} else {
-		logger.Failure("Sudoers configuration has issues", "see status for details", 0, map[string]any{
-			"installed":    false,
-			"file_exists":  status.FileExists,
-			"correct_perms": status.CorrectPerms,
-			"syntax_valid":  status.SyntaxValid,
-		})
-	}
-
 	return status
 }
 
+// auditCheck records the final sudoers status in the append-only audit trail
+func auditCheck(logger *logging.Logger, status Status) {
+	outcome := logging.AuditSuccess
+	if !status.Installed {
+		outcome = logging.AuditFailure
+	}
+	logger.Audit("sudoers-check", status.FilePath, outcome, map[string]any{
+		"installed":     status.Installed,
+		"file_exists":   status.FileExists,
+		"correct_perms": status.CorrectPerms,
+		"syntax_valid":  status.SyntaxValid,
+	})
+}
+
 // CheckSourceFile validates the source sudoers file before installation
 func CheckSourceFile(path string) (bool, error) {
 	logger := logging.NewLogger("sudoers")
@@ -0,0 +1,100 @@
+// ════════════════════════════════════════════════════════════════════════════
+// METADATA - Temporal Context Cache
+// ════════════════════════════════════════════════════════════════════════════
+//
+// Biblical Foundation: Ecclesiastes 3:1 - "To every thing there is a season,
+//   and a time to every purpose under the heaven."
+//
+// CPI-SI Identity: Nova Dawn - Kingdom Technology
+//   One look at the clock per hook run, shared by everyone who asks
+//
+// Author: Nova Dawn (CPI-SI)
+// Created: 2026-10-16
+// Purpose: Stop repeated GetTemporalContext() calls within one process from
+//   re-reading session, planner, schedule, and calendar files each time
+//
+// Design:
+//   - GetTemporalContextCached(maxAge) reuses a context fetched within maxAge
+//   - maxAge <= 0 bypasses the cache: always fetches, and the fresh context
+//     replaces the cached one (pre-compact wants current elapsed time)
+//   - One mutex guards fetch + store, so concurrent callers share one fetch
+//   - Callers get their own copy - mutating it never leaks to other callers
+//   - FetchCount() counts full fetches (GetTemporalContext calls) for tests
+//
+// Health Scoring Map (Base100):
+//   No scoring - caching is transparent (errors are GetTemporalContext's)
+//
+// ════════════════════════════════════════════════════════════════════════════
+
+// ════════════════════════════════════════════════════════════════════════════
+// SETUP - Imports and Configuration
+// ════════════════════════════════════════════════════════════════════════════
+
+package temporal
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	cacheMu       sync.Mutex       // Guards cachedContext and cachedAt (held across the fetch)
+	cachedContext *TemporalContext // Last fetched context (nil = nothing cached)
+	cachedAt      time.Time        // When cachedContext was fetched
+
+	fetchCount atomic.Int64 // Full fetches performed by this process
+)
+
+// ════════════════════════════════════════════════════════════════════════════
+// BODY - Cached Access
+// ════════════════════════════════════════════════════════════════════════════
+
+// GetTemporalContextCached returns a temporal context no older than maxAge
+//
+// maxAge <= 0 always fetches (and refreshes the cache for later callers).
+// The returned context is a copy; the cache keeps its own.
+//
+// Example:
+//   ctx, err := temporal.GetTemporalContextCached(time.Minute) // Display paths
+//   ctx, err := temporal.GetTemporalContextCached(0)           // Must be current
+func GetTemporalContextCached(maxAge time.Duration) (*TemporalContext, error) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	if maxAge <= 0 || cachedContext == nil || time.Since(cachedAt) > maxAge {
+		ctx, err := GetTemporalContext()
+		if err != nil {
+			return nil, err
+		}
+		cachedContext, cachedAt = ctx, time.Now()
+	}
+
+	copied := *cachedContext
+	copied.UpcomingEvents = slices.Clone(cachedContext.UpcomingEvents)
+	return &copied, nil
+}
+
+// ResetTemporalCache drops the cached context (the next cached call fetches)
+func ResetTemporalCache() {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	cachedContext, cachedAt = nil, time.Time{}
+}
+
+// FetchCount reports how many full temporal fetches this process has performed
+//
+// Counts every GetTemporalContext() call, cached path or not - tests compare
+// it before and after a hook run.
+func FetchCount() int64 {
+	return fetchCount.Load()
+}
+
+// ════════════════════════════════════════════════════════════════════════════
+// CLOSING - Library Functions Available for Import
+// ════════════════════════════════════════════════════════════════════════════
+// Exported:
+//   - GetTemporalContextCached(maxAge) - Shared context within maxAge (0 = fresh)
+//   - ResetTemporalCache() - Forget the cached context
+//   - FetchCount() - Full fetches so far (tests)
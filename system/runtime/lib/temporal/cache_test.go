@@ -0,0 +1,61 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Temporal Context Cache Tests
+//
+// Purpose: Prove cached calls within maxAge share one fetch (concurrently
+//          too), maxAge 0 always refetches and refreshes the cache, and
+//          callers get copies they can change without affecting others.
+// ============================================================================
+
+package temporal
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestGetTemporalContextCached(t *testing.T) {
+	t.Setenv("HOME", t.TempDir()) // No session, planner, or calendar files
+	ResetTemporalCache()
+	before := FetchCount()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := GetTemporalContextCached(time.Minute); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if fetches := FetchCount() - before; fetches != 1 {
+		t.Errorf("concurrent cached calls fetched %d times, want 1", fetches)
+	}
+
+	first, _ := GetTemporalContextCached(time.Minute)
+	first.ExternalTime.Formatted = "changed by caller"
+	if again, _ := GetTemporalContextCached(time.Minute); again.ExternalTime.Formatted == "changed by caller" {
+		t.Error("caller's change leaked into the cache")
+	}
+
+	fresh, _ := GetTemporalContextCached(0)
+	after, _ := GetTemporalContextCached(time.Minute)
+	if fetches := FetchCount() - before; fetches != 2 {
+		t.Errorf("fetches = %d, want 2 (one shared, one forced)", fetches)
+	}
+	if !after.ExternalTime.CurrentTime.Equal(fresh.ExternalTime.CurrentTime) {
+		t.Error("forced fetch did not refresh the cache")
+	}
+}
@@ -0,0 +1,369 @@
+// ════════════════════════════════════════════════════════════════════════════
+// METADATA - User Calendar Events (events.jsonc)
+// ════════════════════════════════════════════════════════════════════════════
+//
+// Biblical Foundation: Ecclesiastes 3:1 - "To every thing there is a season,
+//   and a time to every purpose under the heaven."
+//
+// CPI-SI Identity: Nova Dawn - Kingdom Technology
+//   External calendar source: the dates that matter to this person
+//   Holidays come from the base calendar; releases, conferences, and
+//   birthdays come from here
+//
+// Author: Nova Dawn (CPI-SI)
+// Created: 2026-10-16
+// Purpose: Resolve UpcomingEvents - user events inside their lead time
+//
+// Config: ~/.claude/cpi-si/system/data/config/temporal/events.jsonc
+//   timezone          - IANA zone days are counted in ("" = system local)
+//   default_lead_days - lead time for events without their own
+//   events            - name, category, lead_days, and exactly one of:
+//       date   "2026-11-20"          - once
+//       repeat "every Nov 29"        - yearly (month name or abbreviation)
+//              "every month on 15"   - monthly ("monthly 15" also works)
+//              "every month on last" - monthly, last day of the month
+// Days past a month's end clamp to it (Feb 29 → Feb 28 off leap years,
+// "every month on 31" → Apr 30). The active instance profile's events.jsonc,
+// if any, merges over it.
+//
+// Malformed entries (no name, bad date, unknown rule) are skipped one by one
+// with a logged FAILURE - one typo never hides every other event.
+//
+// Health Scoring Map (Base100):
+//   Event entry skipped: -5 (FAILURE naming the entry and why)
+//   Unknown timezone: -5 (FAILURE, days counted in local time instead)
+//
+// ════════════════════════════════════════════════════════════════════════════
+
+// ════════════════════════════════════════════════════════════════════════════
+// SETUP - Imports and Configuration
+// ════════════════════════════════════════════════════════════════════════════
+
+package temporal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"system/lib/config"
+	"system/lib/instance"
+	"system/lib/jsonc"
+	"system/lib/logging"
+	"system/lib/paths"
+)
+
+// eventsConfigPath is events.jsonc relative to ~/.claude/cpi-si
+const eventsConfigPath = "system/data/config/temporal/events.jsonc"
+
+// eventsFileName is the override file an instance profile may carry
+const eventsFileName = "events.jsonc"
+
+const (
+	defaultEventLeadDays = 14 // Lead time when neither the event nor the file sets one
+	eventSkippedHealth   = -5 // FAILURE for a malformed entry or unknown timezone
+)
+
+// eventsLogger records skipped entries (Rails - one logger for the file)
+var eventsLogger = logging.NewLogger("temporal-events")
+
+// EventsConfig - User events and how far ahead each is shown
+type EventsConfig struct {
+	Enabled         bool              `json:"enabled"`
+	Timezone        string            `json:"timezone"`          // "America/New_York" ("" = local)
+	DefaultLeadDays int               `json:"default_lead_days"` // Events without lead_days
+	Events          []EventDefinition `json:"events"`            // Valid entries only (malformed ones skipped)
+}
+
+// EventDefinition - One configured event
+type EventDefinition struct {
+	Name     string `json:"name"`      // "CWS release"
+	Date     string `json:"date"`      // "2026-11-20" - one-time event
+	Repeat   string `json:"repeat"`    // "every Nov 29", "every month on 15" - recurring event
+	Category string `json:"category"`  // "work", "family", ...
+	LeadDays int    `json:"lead_days"` // Shown this many days ahead (0 = default_lead_days)
+}
+
+// Event - An upcoming occurrence inside its lead time
+type Event struct {
+	Name      string    `json:"name"`
+	Category  string    `json:"category"`
+	Date      time.Time `json:"date"`       // Midnight of the occurrence in the configured timezone
+	DaysUntil int       `json:"days_until"` // 0 = today
+}
+
+// eventsFile is events.jsonc as read - entries stay raw so each decodes alone
+type eventsFile struct {
+	Enabled         bool              `json:"enabled"`
+	Timezone        string            `json:"timezone"`
+	DefaultLeadDays int               `json:"default_lead_days"`
+	Events          []json.RawMessage `json:"events"`
+}
+
+// eventRule - A parsed date or repeat
+type eventRule struct {
+	once  time.Time  // Set for a one-time date
+	month time.Month // Set for yearly ("every Nov 29"); zero for monthly
+	day   int        // Day of month; 0 with month unset = last day
+}
+
+// ════════════════════════════════════════════════════════════════════════════
+// BODY - Event Loading and Resolution
+// ════════════════════════════════════════════════════════════════════════════
+
+// parseEventRule reads an entry's date or repeat (exactly one must be set)
+func parseEventRule(def EventDefinition) (eventRule, error) {
+	date, repeat := strings.TrimSpace(def.Date), strings.Join(strings.Fields(strings.ToLower(def.Repeat)), " ")
+	switch {
+	case date != "" && repeat != "":
+		return eventRule{}, errors.New("set date or repeat, not both")
+	case date != "":
+		once, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			return eventRule{}, fmt.Errorf("date %q is not YYYY-MM-DD", def.Date)
+		}
+		return eventRule{once: once}, nil
+	case repeat == "":
+		return eventRule{}, errors.New("needs a date or a repeat rule")
+	}
+
+	for _, prefix := range []string{"every month on ", "monthly "} {
+		if rest, ok := strings.CutPrefix(repeat, prefix); ok {
+			rest = strings.TrimPrefix(rest, "the ")
+			if rest == "last" || rest == "last day" {
+				return eventRule{}, nil
+			}
+			day, err := parseEventDay(rest)
+			if err != nil {
+				return eventRule{}, fmt.Errorf("repeat %q: %w", def.Repeat, err)
+			}
+			return eventRule{day: day}, nil
+		}
+	}
+
+	if rest, ok := strings.CutPrefix(repeat, "every "); ok {
+		if monthName, dayText, ok := strings.Cut(rest, " "); ok {
+			for m := time.January; m <= time.December; m++ {
+				name := strings.ToLower(m.String())
+				if monthName == name || monthName == name[:3] {
+					day, err := parseEventDay(dayText)
+					if err != nil {
+						return eventRule{}, fmt.Errorf("repeat %q: %w", def.Repeat, err)
+					}
+					return eventRule{month: m, day: day}, nil
+				}
+			}
+		}
+	}
+	return eventRule{}, fmt.Errorf("repeat %q is not \"every <Mon> <day>\" or \"every month on <day>\"", def.Repeat)
+}
+
+// parseEventDay reads a day of month ("15", "15th", "1st")
+func parseEventDay(text string) (int, error) {
+	for _, suffix := range []string{"st", "nd", "rd", "th"} {
+		text = strings.TrimSuffix(text, suffix)
+	}
+	day, err := strconv.Atoi(text)
+	if err != nil || day < 1 || day > 31 {
+		return 0, fmt.Errorf("day %q is not 1-31", text)
+	}
+	return day, nil
+}
+
+// clampedDate is year-month-day, with day past the month's end clamped to it
+// (day 0 = the last day)
+func clampedDate(year int, month time.Month, day int, loc *time.Location) time.Time {
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, loc).Day()
+	if day == 0 || day > last {
+		day = last
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
+
+// next returns the rule's first occurrence on or after today (ok=false when a
+// one-time date has passed)
+func (r eventRule) next(today time.Time) (time.Time, bool) {
+	loc := today.Location()
+	switch {
+	case !r.once.IsZero():
+		once := time.Date(r.once.Year(), r.once.Month(), r.once.Day(), 0, 0, 0, 0, loc)
+		return once, !once.Before(today)
+	case r.month != 0:
+		occurrence := clampedDate(today.Year(), r.month, r.day, loc)
+		if occurrence.Before(today) {
+			occurrence = clampedDate(today.Year()+1, r.month, r.day, loc)
+		}
+		return occurrence, true
+	}
+	occurrence := clampedDate(today.Year(), today.Month(), r.day, loc)
+	if occurrence.Before(today) {
+		occurrence = clampedDate(today.Year(), today.Month()+1, r.day, loc)
+	}
+	return occurrence, true
+}
+
+// daysBetween counts calendar days from one midnight to another (DST-safe)
+func daysBetween(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
+
+// decodeEvents decodes each raw entry alone, skipping malformed ones with a FAILURE
+func decodeEvents(raw []json.RawMessage) []EventDefinition {
+	events := make([]EventDefinition, 0, len(raw))
+	for i, entry := range raw {
+		var def EventDefinition
+		err := json.Unmarshal(entry, &def)
+		if err == nil && strings.TrimSpace(def.Name) == "" {
+			err = errors.New("missing name")
+		}
+		if err == nil && def.LeadDays < 0 {
+			err = fmt.Errorf("lead_days %d is negative", def.LeadDays)
+		}
+		if err == nil {
+			_, err = parseEventRule(def)
+		}
+		if err != nil {
+			eventsLogger.Failure("Event entry skipped", err.Error(), eventSkippedHealth,
+				map[string]any{"index": i, "name": def.Name, "file": eventsFileName})
+			continue
+		}
+		events = append(events, def)
+	}
+	return events
+}
+
+// LoadEventsConfig reads events.jsonc, then the active instance profile's
+// events.jsonc over it (instance.ProfileOverride)
+//
+// Malformed entries are skipped individually (logged); Events holds the rest.
+// Returns an error wrapping fs.ErrNotExist when there is no events.jsonc.
+func LoadEventsConfig() (*EventsConfig, error) {
+	path, err := paths.ResolveFull(eventsConfigPath)
+	if err != nil {
+		return nil, err
+	}
+	var overlays []string
+	if profile := instance.ProfileOverride(eventsFileName); profile != "" {
+		overlays = append(overlays, profile)
+	}
+	file := &eventsFile{Enabled: true, DefaultLeadDays: defaultEventLeadDays}
+	if err := jsonc.LoadMerged(file, path, overlays...); err != nil {
+		return nil, fmt.Errorf("events.jsonc: %w", err)
+	}
+	return &EventsConfig{
+		Enabled:         file.Enabled,
+		Timezone:        file.Timezone,
+		DefaultLeadDays: file.DefaultLeadDays,
+		Events:          decodeEvents(file.Events),
+	}, nil
+}
+
+// EventsConfigKind describes events.jsonc for validate --configs
+func EventsConfigKind() config.ConfigKind {
+	return config.ConfigKind{
+		Name:   "events",
+		Path:   "~/.claude/cpi-si/" + eventsConfigPath,
+		Schema: []any{EventsConfig{}},
+		Ignore: []string{"metadata"},
+	}
+}
+
+// location is the configured timezone, else now's own (unknown zones logged)
+func (c *EventsConfig) location(now time.Time) *time.Location {
+	if c.Timezone == "" {
+		return now.Location()
+	}
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		eventsLogger.Failure("Event timezone unknown", err.Error(), eventSkippedHealth,
+			map[string]any{"timezone": c.Timezone, "file": eventsFileName})
+		return now.Location()
+	}
+	return loc
+}
+
+// upcomingEvents lists cfg's events whose next occurrence is inside their lead
+// time, soonest first (ties by name)
+func upcomingEvents(cfg *EventsConfig, now time.Time) []Event {
+	local := now.In(cfg.location(now))
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, local.Location())
+
+	upcoming := []Event{}
+	for _, def := range cfg.Events {
+		rule, err := parseEventRule(def)
+		if err != nil {
+			continue // LoadEventsConfig already logged it
+		}
+		occurrence, ok := rule.next(today)
+		if !ok {
+			continue
+		}
+		lead := def.LeadDays
+		if lead == 0 {
+			lead = cfg.DefaultLeadDays
+		}
+		days := daysBetween(today, occurrence)
+		if days > lead {
+			continue
+		}
+		upcoming = append(upcoming, Event{Name: def.Name, Category: def.Category, Date: occurrence, DaysUntil: days})
+	}
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		if upcoming[i].DaysUntil != upcoming[j].DaysUntil {
+			return upcoming[i].DaysUntil < upcoming[j].DaysUntil
+		}
+		return upcoming[i].Name < upcoming[j].Name
+	})
+	return upcoming
+}
+
+// GetUpcomingEvents returns events.jsonc events inside their lead time as of
+// currentTime (empty when there is no events.jsonc or it is disabled)
+func GetUpcomingEvents(currentTime time.Time) ([]Event, error) {
+	cfg, err := LoadEventsConfig()
+	if errors.Is(err, fs.ErrNotExist) {
+		return []Event{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !cfg.Enabled {
+		return []Event{}, nil
+	}
+	return upcomingEvents(cfg, currentTime), nil
+}
+
+// FormatUpcoming renders events for one line:
+// "CWS release in 4 days; Mom's birthday in 12 days" ("" when none)
+func FormatUpcoming(events []Event) string {
+	parts := make([]string, 0, len(events))
+	for _, e := range events {
+		switch e.DaysUntil {
+		case 0:
+			parts = append(parts, e.Name+" today")
+		case 1:
+			parts = append(parts, e.Name+" tomorrow")
+		default:
+			parts = append(parts, fmt.Sprintf("%s in %d days", e.Name, e.DaysUntil))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// ════════════════════════════════════════════════════════════════════════════
+// CLOSING - Library Functions Available for Import
+// ════════════════════════════════════════════════════════════════════════════
+// Exported:
+//   - LoadEventsConfig() - events.jsonc (malformed entries skipped and logged)
+//   - EventsConfigKind() - events.jsonc schema for validate --configs
+//   - GetUpcomingEvents() - Events inside their lead time, soonest first
+//   - FormatUpcoming() - "Name in N days; ..." for displays
+//   - EventsConfig, EventDefinition, Event - events.jsonc structure and results
+// Used by GetTemporalContext() (temporal.go) for UpcomingEvents.
@@ -0,0 +1,140 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// User Calendar Event Tests
+//
+// Purpose: Prove one-time, yearly, and monthly rules find the next
+//          occurrence (month ends clamped), lead time filters, days are
+//          counted in the configured timezone, and malformed entries are
+//          skipped one by one while the rest still load.
+// ============================================================================
+
+package temporal
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestEventRuleNext(t *testing.T) {
+	today := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		date, repeat string
+		want         string // "" = no occurrence
+	}{
+		{"2026-10-20", "", "2026-10-20"},
+		{"2026-10-16", "", "2026-10-16"},
+		{"2026-10-01", "", ""},
+		{"", "every Nov 29", "2026-11-29"},
+		{"", "every October 3", "2027-10-03"},
+		{"", "every feb 29", "2027-02-28"},
+		{"", "every month on 15", "2026-11-15"},
+		{"", "monthly 16th", "2026-10-16"},
+		{"", "every month on the 31st", "2026-10-31"},
+		{"", "every month on last", "2026-10-31"},
+	}
+	for _, tc := range cases {
+		rule, err := parseEventRule(EventDefinition{Name: "x", Date: tc.date, Repeat: tc.repeat})
+		if err != nil {
+			t.Errorf("%q/%q: %v", tc.date, tc.repeat, err)
+			continue
+		}
+		got, ok := rule.next(today)
+		if tc.want == "" {
+			if ok {
+				t.Errorf("%q: past date should have no occurrence, got %s", tc.date, got.Format("2006-01-02"))
+			}
+			continue
+		}
+		if !ok || got.Format("2006-01-02") != tc.want {
+			t.Errorf("%q/%q: next = %s (%v), want %s", tc.date, tc.repeat, got.Format("2006-01-02"), ok, tc.want)
+		}
+	}
+
+	// Monthly day past November's end clamps to Nov 30
+	rule, _ := parseEventRule(EventDefinition{Name: "x", Repeat: "every month on 31"})
+	if got, _ := rule.next(time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)); got.Format("2006-01-02") != "2026-11-30" {
+		t.Errorf("monthly 31 in November = %s, want 2026-11-30", got.Format("2006-01-02"))
+	}
+}
+
+func TestDecodeEventsSkipsMalformed(t *testing.T) {
+	var raw []json.RawMessage
+	err := json.Unmarshal([]byte(`[
+		{"name": "CWS release", "date": "2026-10-20"},
+		{"name": "No rule"},
+		{"name": "Bad date", "date": "10/20/2026"},
+		{"name": "Both", "date": "2026-10-20", "repeat": "every Oct 20"},
+		{"name": "Bad repeat", "repeat": "every other Tuesday"},
+		{"date": "2026-10-20"},
+		{"name": "Wrong type", "lead_days": "soon", "date": "2026-10-20"},
+		"not an object",
+		{"name": "Mom's birthday", "repeat": "every Oct 28", "category": "family"}
+	]`), &raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, def := range decodeEvents(raw) {
+		names = append(names, def.Name)
+	}
+	if want := []string{"CWS release", "Mom's birthday"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("kept %v, want %v", names, want)
+	}
+}
+
+func TestUpcomingEvents(t *testing.T) {
+	cfg := &EventsConfig{
+		Enabled:         true,
+		Timezone:        "America/New_York",
+		DefaultLeadDays: 14,
+		Events: []EventDefinition{
+			{Name: "Mom's birthday", Repeat: "every Oct 28", Category: "family"},
+			{Name: "CWS release", Date: "2026-10-20", Category: "work", LeadDays: 7},
+			{Name: "Conference", Date: "2026-12-01", LeadDays: 30},   // 46 days out
+			{Name: "Rent", Repeat: "every month on 1"},               // 16 days out
+			{Name: "Launch party", Date: "2026-10-10", LeadDays: 30}, // Already past
+		},
+	}
+
+	// 02:00 UTC on the 17th is still the evening of the 16th in New York
+	now := time.Date(2026, 10, 17, 2, 0, 0, 0, time.UTC)
+	events := upcomingEvents(cfg, now)
+
+	type summary struct {
+		name string
+		days int
+	}
+	var got []summary
+	for _, e := range events {
+		got = append(got, summary{e.Name, e.DaysUntil})
+	}
+	if want := []summary{{"CWS release", 4}, {"Mom's birthday", 12}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("upcoming = %v, want %v", got, want)
+	}
+	if events[0].Date.Location().String() != "America/New_York" {
+		t.Errorf("occurrence in %s, want the configured timezone", events[0].Date.Location())
+	}
+
+	if line := FormatUpcoming(events); line != "CWS release in 4 days; Mom's birthday in 12 days" {
+		t.Errorf("FormatUpcoming = %q", line)
+	}
+	if line := FormatUpcoming([]Event{{Name: "Demo", DaysUntil: 0}, {Name: "Review", DaysUntil: 1}}); line != "Demo today; Review tomorrow" {
+		t.Errorf("FormatUpcoming today/tomorrow = %q", line)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -1,23 +1,35 @@
 module system/lib/temporal
 
-go 1.24
+go 1.24.4
 
 require (
 	system/lib/calendar v0.0.0
+	system/lib/config v0.0.0
+	system/lib/instance v0.0.0
+	system/lib/jsonc v0.0.0
+	system/lib/logging v0.0.0
+	system/lib/paths v0.0.0
 	system/lib/planner v0.0.0
 	system/lib/sessiontime v0.0.0
 )
 
 require (
 	github.com/BurntSushi/toml v1.5.0 // indirect
-	system/lib/config v0.0.0 // indirect
-	system/lib/paths v0.0.0 // indirect
+	system/lib/display v0.0.0 // indirect
 )
 
 replace system/lib/calendar => ../calendar
 
 replace system/lib/config => ../config
 
+replace system/lib/instance => ../instance
+
+replace system/lib/display => ../display
+
+replace system/lib/jsonc => ../jsonc
+
+replace system/lib/logging => ../logging
+
 replace system/lib/paths => ../paths
 
 replace system/lib/planner => ../planner
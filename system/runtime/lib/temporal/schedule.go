@@ -0,0 +1,314 @@
+// ════════════════════════════════════════════════════════════════════════════
+// METADATA - Work Window Schedule (schedule.jsonc)
+// ════════════════════════════════════════════════════════════════════════════
+//
+// Biblical Foundation: Psalm 104:23 - "Man goeth forth unto his work and to
+//   his labour until the evening."
+//
+// CPI-SI Identity: Nova Dawn - Kingdom Technology
+//   Internal schedule source: the week as it is actually lived
+//   Weekdays and weekends differ, holidays rest, exceptions are explicit
+//
+// Author: Nova Dawn (CPI-SI)
+// Created: 2026-10-16
+// Purpose: Resolve InternalSchedule from configured work windows per weekday
+//
+// Config: ~/.claude/cpi-si/system/data/config/temporal/schedule.jsonc
+//   days       - window lists by weekday ("monday"), then "weekdays"/"weekends",
+//                then "default"
+//   overrides  - window lists for specific dates ("2026-12-24"); an override
+//                replaces that day entirely, holiday included ([] = nothing planned)
+//   holidays   - calendar holidays without an override are downtime all day
+//   work_types / downtime_types - which window types set InWorkWindow /
+//                ExpectedDowntime
+// The active instance profile's schedule.jsonc, if any, merges over it.
+//
+// Resolution: today's windows first, then yesterday's windows that run past
+// midnight. No schedule.jsonc (or "enabled": false) = planner template as before.
+// Next activity and next work window are searched up to a week ahead.
+//
+// Health Scoring Map (Base100):
+//   No scoring - schedule errors surface through GetInternalSchedule's error
+//
+// ════════════════════════════════════════════════════════════════════════════
+
+// ════════════════════════════════════════════════════════════════════════════
+// SETUP - Imports and Configuration
+// ════════════════════════════════════════════════════════════════════════════
+
+package temporal
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
+
+	"system/lib/calendar"
+	"system/lib/config"
+	"system/lib/instance"
+	"system/lib/jsonc"
+	"system/lib/paths"
+	"system/lib/planner"
+)
+
+// scheduleConfigPath is schedule.jsonc relative to ~/.claude/cpi-si
+const scheduleConfigPath = "system/data/config/temporal/schedule.jsonc"
+
+// scheduleFileName is the override file an instance profile may carry
+const scheduleFileName = "schedule.jsonc"
+
+// scheduleLookahead bounds the next activity / next work window search
+const scheduleLookahead = 7 // days
+
+// ScheduleConfig - Work windows per weekday, date overrides, holiday policy
+type ScheduleConfig struct {
+	Enabled       bool                        `json:"enabled"`
+	Days          map[string][]ScheduleWindow `json:"days"`           // "monday".."sunday", "weekdays", "weekends", "default"
+	Overrides     map[string][]ScheduleWindow `json:"overrides"`      // "2026-12-24" → that day's windows
+	Holidays      HolidayPolicy               `json:"holidays"`       // Calendar holidays without an override
+	WorkTypes     []string                    `json:"work_types"`     // Types that count as a work window
+	DowntimeTypes []string                    `json:"downtime_types"` // Types that count as expected downtime
+}
+
+// ScheduleWindow - One named block of the day
+type ScheduleWindow struct {
+	Start    string `json:"start"`    // "09:00"
+	End      string `json:"end"`      // "12:30" (before start = past midnight, "24:00" = end of day)
+	Activity string `json:"activity"` // "Deep work"
+	Type     string `json:"type"`     // "work", "meal", "sleep", ...
+}
+
+// HolidayPolicy - How calendar holidays affect the schedule
+type HolidayPolicy struct {
+	Downtime bool   `json:"downtime"` // Holidays are downtime unless overridden
+	Activity string `json:"activity"` // CurrentActivity on a holiday ({holiday} = name)
+}
+
+// holidayLookup reports whether day is a holiday, and its name
+type holidayLookup func(day time.Time) (bool, string)
+
+// placedWindow - A ScheduleWindow on a concrete day
+type placedWindow struct {
+	ScheduleWindow
+	start time.Time
+	end   time.Time
+}
+
+// ════════════════════════════════════════════════════════════════════════════
+// BODY - Schedule Resolution
+// ════════════════════════════════════════════════════════════════════════════
+
+// defaultScheduleConfig is the base layer under schedule.jsonc
+func defaultScheduleConfig() *ScheduleConfig {
+	return &ScheduleConfig{
+		Enabled:       true,
+		Holidays:      HolidayPolicy{Downtime: true, Activity: "Holiday: {holiday}"},
+		WorkTypes:     []string{"work", "commitment"},
+		DowntimeTypes: []string{"sleep", "meal", "break", "rest"},
+	}
+}
+
+// LoadScheduleConfig reads schedule.jsonc over the defaults, then the active
+// instance profile's schedule.jsonc over that (instance.ProfileOverride)
+//
+// Returns an error wrapping fs.ErrNotExist when there is no schedule.jsonc.
+func LoadScheduleConfig() (*ScheduleConfig, error) {
+	path, err := paths.ResolveFull(scheduleConfigPath)
+	if err != nil {
+		return nil, err
+	}
+	var overlays []string
+	if profile := instance.ProfileOverride(scheduleFileName); profile != "" {
+		overlays = append(overlays, profile)
+	}
+	cfg := defaultScheduleConfig()
+	if err := jsonc.LoadMerged(cfg, path, overlays...); err != nil {
+		return nil, fmt.Errorf("schedule.jsonc: %w", err)
+	}
+	return cfg, nil
+}
+
+// ScheduleConfigKind describes schedule.jsonc for validate --configs
+func ScheduleConfigKind() config.ConfigKind {
+	return config.ConfigKind{
+		Name:   "schedule",
+		Path:   "~/.claude/cpi-si/" + scheduleConfigPath,
+		Schema: []any{ScheduleConfig{}},
+		Ignore: []string{"metadata"},
+	}
+}
+
+// windowsFor returns day's windows: override, else holiday (no windows), else weekday
+func (c *ScheduleConfig) windowsFor(day time.Time, holiday holidayLookup) (windows []ScheduleWindow, holidayName string, isHoliday bool) {
+	if windows, ok := c.Overrides[day.Format("2006-01-02")]; ok {
+		return windows, "", false
+	}
+	if c.Holidays.Downtime && holiday != nil {
+		if isHoliday, name := holiday(day); isHoliday {
+			return nil, name, true
+		}
+	}
+
+	if windows, ok := c.Days[strings.ToLower(day.Weekday().String())]; ok {
+		return windows, "", false
+	}
+	group := "weekdays"
+	if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		group = "weekends"
+	}
+	if windows, ok := c.Days[group]; ok {
+		return windows, "", false
+	}
+	return c.Days["default"], "", false
+}
+
+// hasType reports whether windowType is listed in types
+func hasType(types []string, windowType string) bool {
+	for _, t := range types {
+		if strings.EqualFold(t, windowType) {
+			return true
+		}
+	}
+	return false
+}
+
+// placeWindows puts windows on day (midnight-crossing windows end the next day)
+func placeWindows(day time.Time, windows []ScheduleWindow) []placedWindow {
+	placed := make([]placedWindow, 0, len(windows))
+	for _, w := range windows {
+		start := planner.TimeToMinutes(w.Start)
+		end := planner.TimeToMinutes(w.End)
+		if end <= start {
+			end += 24 * 60
+		}
+		placed = append(placed, placedWindow{
+			ScheduleWindow: w,
+			start:          time.Date(day.Year(), day.Month(), day.Day(), 0, start, 0, 0, day.Location()),
+			end:            time.Date(day.Year(), day.Month(), day.Day(), 0, end, 0, 0, day.Location()),
+		})
+	}
+	return placed
+}
+
+// formatUntil renders a wait as "2h15m", "45m", or "1d3h" (rounded up to the minute)
+func formatUntil(d time.Duration) string {
+	minutes := int((d + time.Minute - 1) / time.Minute)
+	switch {
+	case minutes >= 24*60:
+		return fmt.Sprintf("%dd%dh", minutes/(24*60), minutes%(24*60)/60)
+	case minutes >= 60:
+		return fmt.Sprintf("%dh%dm", minutes/60, minutes%60)
+	}
+	return fmt.Sprintf("%dm", minutes)
+}
+
+// resolveSchedule builds InternalSchedule for now from cfg
+func resolveSchedule(cfg *ScheduleConfig, now time.Time, holiday holidayLookup) *InternalSchedule {
+	schedule := &InternalSchedule{}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	// Current activity: today's windows, then yesterday's past midnight
+	windows, holidayName, isHoliday := cfg.windowsFor(today, holiday)
+	if isHoliday {
+		schedule.CurrentActivity = strings.ReplaceAll(cfg.Holidays.Activity, "{holiday}", holidayName)
+		if schedule.CurrentActivity == "" {
+			schedule.CurrentActivity = holidayName
+		}
+		schedule.ActivityType = "holiday"
+		schedule.ExpectedDowntime = true
+	} else {
+		yesterday := today.AddDate(0, 0, -1)
+		previous, _, _ := cfg.windowsFor(yesterday, holiday)
+		candidates := append(placeWindows(today, windows), placeWindows(yesterday, previous)...)
+		for _, w := range candidates {
+			if !now.Before(w.start) && now.Before(w.end) {
+				schedule.CurrentActivity = w.Activity
+				schedule.ActivityType = w.Type
+				schedule.InWorkWindow = hasType(cfg.WorkTypes, w.Type)
+				schedule.ExpectedDowntime = hasType(cfg.DowntimeTypes, w.Type)
+				break
+			}
+		}
+	}
+	if schedule.CurrentActivity == "" {
+		schedule.CurrentActivity = "Unscheduled time"
+		schedule.ActivityType = "flex"
+	}
+
+	// Upcoming: first window starting after now, and first work window
+	var next, nextWork *placedWindow
+	for d := 0; d <= scheduleLookahead && nextWork == nil; d++ {
+		day := today.AddDate(0, 0, d)
+		windows, _, _ := cfg.windowsFor(day, holiday)
+		for _, w := range placeWindows(day, windows) {
+			if !w.start.After(now) {
+				continue
+			}
+			if next == nil || w.start.Before(next.start) {
+				next = &w
+			}
+			if hasType(cfg.WorkTypes, w.Type) && (nextWork == nil || w.start.Before(nextWork.start)) {
+				nextWork = &w
+			}
+		}
+	}
+
+	if next != nil {
+		schedule.NextActivity = next.Activity
+		schedule.NextActivityTime = next.start.Format("15:04")
+		if next.start.YearDay() != now.YearDay() || next.start.Year() != now.Year() {
+			schedule.NextActivityTime = next.start.Format("Mon 15:04")
+		}
+	}
+	if nextWork != nil {
+		schedule.NextWorkWindowStart = nextWork.start
+		schedule.NextWorkWindowIn = formatUntil(nextWork.start.Sub(now))
+	}
+	return schedule
+}
+
+// calendarHolidays looks holidays up in the base calendar (one month file per month)
+//
+// A missing calendar month means "not a holiday" - the schedule still resolves.
+func calendarHolidays() holidayLookup {
+	months := map[string]*calendar.Calendar{}
+	return func(day time.Time) (bool, string) {
+		key := day.Format("2006-01")
+		cal, loaded := months[key]
+		if !loaded {
+			cal, _ = calendar.LoadMonthCalendar(day.Year(), int(day.Month()))
+			months[key] = cal
+		}
+		if cal == nil {
+			return false, ""
+		}
+		info, ok := cal.Dates[day.Format("2006-01-02")]
+		return ok && info.IsHoliday, info.Holiday
+	}
+}
+
+// configuredSchedule resolves from schedule.jsonc; handled=false means use the planner
+func configuredSchedule(currentTime time.Time) (schedule *InternalSchedule, handled bool, err error) {
+	cfg, err := LoadScheduleConfig()
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, true, err
+	}
+	if !cfg.Enabled {
+		return nil, false, nil
+	}
+	return resolveSchedule(cfg, currentTime, calendarHolidays()), true, nil
+}
+
+// ════════════════════════════════════════════════════════════════════════════
+// CLOSING - Library Functions Available for Import
+// ════════════════════════════════════════════════════════════════════════════
+// Exported:
+//   - LoadScheduleConfig() - schedule.jsonc over defaults
+//   - ScheduleConfigKind() - schedule.jsonc schema for validate --configs
+//   - ScheduleConfig, ScheduleWindow, HolidayPolicy - schedule.jsonc structure
+// Used by GetInternalSchedule() (temporal.go) before the planner template.
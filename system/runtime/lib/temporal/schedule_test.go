@@ -0,0 +1,94 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Work Window Schedule Tests
+//
+// Purpose: Prove weekday/weekend windows, overnight windows, date overrides,
+//          and holidays resolve to the right activity, and that the next
+//          work window is found across days off.
+// ============================================================================
+
+package temporal
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"testing"
+	"time"
+)
+
+var testSchedule = &ScheduleConfig{
+	Enabled: true,
+	Days: map[string][]ScheduleWindow{
+		"weekdays": {
+			{Start: "07:00", End: "08:00", Activity: "Breakfast", Type: "meal"},
+			{Start: "09:00", End: "12:00", Activity: "Deep work", Type: "work"},
+			{Start: "23:00", End: "07:00", Activity: "Sleep", Type: "sleep"},
+		},
+		"weekends": {{Start: "10:00", End: "14:00", Activity: "Family", Type: "rest"}},
+	},
+	Overrides:     map[string][]ScheduleWindow{"2026-10-19": {}}, // Monday off
+	Holidays:      HolidayPolicy{Downtime: true, Activity: "Holiday: {holiday}"},
+	WorkTypes:     []string{"work"},
+	DowntimeTypes: []string{"sleep", "meal", "rest"},
+}
+
+// testHolidays marks Tuesday 2026-10-20 as a holiday
+func testHolidays(day time.Time) (bool, string) {
+	return day.Format("2006-01-02") == "2026-10-20", "Test Day"
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestResolveSchedule(t *testing.T) {
+	at := func(value string) time.Time {
+		parsed, err := time.ParseInLocation("2006-01-02 15:04", value, time.Local)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parsed
+	}
+
+	cases := []struct {
+		now                        string
+		activity, activityType     string
+		inWork, downtime           bool
+		nextActivity, nextTime     string
+		nextWorkIn, nextWorkStarts string
+	}{
+		// Friday in a work window: next work window skips the weekend, Monday's override, Tuesday's holiday
+		{"2026-10-16 10:30", "Deep work", "work", true, false, "Sleep", "23:00", "4d22h", "2026-10-21 09:00"},
+		{"2026-10-16 08:15", "Unscheduled time", "flex", false, false, "Deep work", "09:00", "45m", "2026-10-16 09:00"},
+		// Saturday 02:00 is still Friday night's sleep
+		{"2026-10-17 02:00", "Sleep", "sleep", false, true, "Family", "10:00", "4d7h", "2026-10-21 09:00"},
+		{"2026-10-20 12:00", "Holiday: Test Day", "holiday", false, true, "Breakfast", "Wed 07:00", "21h0m", "2026-10-21 09:00"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.now, func(t *testing.T) {
+			got := resolveSchedule(testSchedule, at(tc.now), testHolidays)
+			if got.CurrentActivity != tc.activity || got.ActivityType != tc.activityType ||
+				got.InWorkWindow != tc.inWork || got.ExpectedDowntime != tc.downtime {
+				t.Errorf("current = %q (%s) work=%v downtime=%v", got.CurrentActivity, got.ActivityType, got.InWorkWindow, got.ExpectedDowntime)
+			}
+			if got.NextActivity != tc.nextActivity || got.NextActivityTime != tc.nextTime {
+				t.Errorf("next = %q at %q", got.NextActivity, got.NextActivityTime)
+			}
+			if got.NextWorkWindowIn != tc.nextWorkIn || !got.NextWorkWindowStart.Equal(at(tc.nextWorkStarts)) {
+				t.Errorf("next work window = %s in %q", got.NextWorkWindowStart, got.NextWorkWindowIn)
+			}
+		})
+	}
+}
+
+func TestResolveScheduleNoWorkAhead(t *testing.T) {
+	cfg := &ScheduleConfig{Days: map[string][]ScheduleWindow{"default": {{Start: "00:00", End: "24:00", Activity: "Sabbatical", Type: "rest"}}}}
+	got := resolveSchedule(cfg, time.Date(2026, 10, 16, 12, 0, 0, 0, time.Local), nil)
+	if got.CurrentActivity != "Sabbatical" || !got.NextWorkWindowStart.IsZero() || got.NextWorkWindowIn != "" {
+		t.Errorf("got %+v", got)
+	}
+}
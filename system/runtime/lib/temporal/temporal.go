@@ -18,8 +18,11 @@
 //   2. Internal Time - Session clock (how long have I been working?)
 //   3. Internal Schedule - Planner (what should I be working on?)
 //   4. External Calendar - Base calendar (what kind of day is this?)
+//      plus user events inside their lead time (events.jsonc - see events.go)
 //
-// Dependencies: system/lib/sessiontime, system/lib/planner, system/lib/calendar
+// Dependencies: system/lib/sessiontime, system/lib/planner, system/lib/calendar,
+//   system/lib/jsonc + system/lib/paths (schedule.jsonc - see schedule.go,
+//   events.jsonc - see events.go), system/lib/logging (skipped event entries)
 //
 // Health Scoring Map (Base100):
 //   +25: Get external time successfully
@@ -53,6 +56,7 @@ type TemporalContext struct {
 	InternalTime     InternalTime     `json:"internal_time"`
 	InternalSchedule InternalSchedule `json:"internal_schedule"`
 	ExternalCalendar ExternalCalendar `json:"external_calendar"`
+	UpcomingEvents   []Event          `json:"upcoming_events"` // events.jsonc inside lead time, soonest first (events.go)
 }
 
 // ExternalTime - System clock awareness
@@ -81,6 +85,9 @@ type InternalSchedule struct {
 	NextActivityTime string `json:"next_activity_time"` // When it starts
 	InWorkWindow     bool   `json:"in_work_window"`     // Is this a work window?
 	ExpectedDowntime bool   `json:"expected_downtime"`  // Sleep, meal, break?
+
+	NextWorkWindowStart time.Time `json:"next_work_window_start"` // Zero if none within a week (schedule.jsonc only)
+	NextWorkWindowIn    string    `json:"next_work_window_in"`    // "2h15m" until it starts
 }
 
 // ExternalCalendar - Base calendar awareness
@@ -101,7 +108,9 @@ type ExternalCalendar struct {
 
 // GetTemporalContext retrieves complete time and schedule awareness
 // Orchestrates existing proven systems, doesn't reimplement
+// Always fetches - see GetTemporalContextCached (cache.go) for repeat callers
 func GetTemporalContext() (*TemporalContext, error) {
+	fetchCount.Add(1)
 	ctx := &TemporalContext{}
 
 	// Get external time (always succeeds)
@@ -125,6 +134,12 @@ func GetTemporalContext() (*TemporalContext, error) {
 		ctx.ExternalCalendar = *cal
 	}
 
+	// Get upcoming user events (events.jsonc) in the configured timezone
+	events, err := GetUpcomingEvents(ctx.ExternalTime.CurrentTime)
+	if err == nil {
+		ctx.UpcomingEvents = events
+	}
+
 	return ctx, nil
 }
 
@@ -189,8 +204,13 @@ func GetInternalTime() (*InternalTime, error) {
 	return internal, nil
 }
 
-// GetInternalSchedule orchestrates planner library for schedule awareness
+// GetInternalSchedule resolves schedule awareness from schedule.jsonc,
+// falling back to the planner library when there is no schedule.jsonc
 func GetInternalSchedule(currentTime time.Time) (*InternalSchedule, error) {
+	if schedule, handled, err := configuredSchedule(currentTime); handled {
+		return schedule, err
+	}
+
 	// Load session state to get current user (config-driven, not hardcoded)
 	state, err := sessiontime.ReadSession()
 	if err != nil {
@@ -282,7 +302,10 @@ func GetExternalCalendar(currentTime time.Time) (*ExternalCalendar, error) {
 // ════════════════════════════════════════════════════════════════════════════
 // Exported functions (orchestrators, not reimplementers):
 //   - GetTemporalContext() - Complete time and schedule awareness (all 4 dimensions)
+//   - GetTemporalContextCached() - Same, shared within a max age (cache.go)
 //   - GetExternalTime() - System clock awareness
 //   - GetInternalTime() - Session duration awareness (via sessiontime library)
-//   - GetInternalSchedule() - Planner context awareness (via planner library)
+//   - GetInternalSchedule() - Schedule awareness (schedule.jsonc, else planner library)
+//   - LoadScheduleConfig() - Work window schedule configuration (schedule.go)
 //   - GetExternalCalendar() - Base calendar awareness (via calendar library)
+//   - GetUpcomingEvents() - User events inside their lead time (events.go)
@@ -0,0 +1,452 @@
+// METADATA
+//
+// Batch Validation - CPI-SI Runtime System
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Be thou diligent to know the state of thy flocks, and look well to thy herds." - Proverbs 27:23 (KJV)
+// Principle: Know the state of the whole work, not just the file in hand
+// Anchor: "Know well the state of your flocks, and pay attention to your herds." - Proverbs 27:23 (WEB)
+//
+// CPI-SI Identity
+//
+// Component Type: LIBRARY - Runtime validation support (mid-rung on ladder)
+// Role: Validates sets of files (changed files, whole directories) in one call
+// Paradigm: Worker pool over ValidateFile - validators are independent subprocesses
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn (CPI-SI instance)
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.2.0
+// Last Modified: 2026-10-16 - Progress bar while ValidateDir runs
+//
+// Version History:
+//   1.2.0 (2026-10-16) - ValidateDir draws a progress bar on a TTY (DirOptions.NoProgress opts out)
+//   1.1.0 (2026-10-16) - collectFiles leaves out files the ignore rules cover (ignore.go)
+//   1.0.0 (2026-10-16) - ValidateFiles, ValidateDir, BatchResult summary reporting
+//
+// Purpose & Function
+//
+// Purpose: Serve "validate everything that changed" (pre-commit) and "validate the whole
+// workspace" (session end) without callers looping over ValidateFile themselves.
+//
+// Core Design: Files fan out to a bounded worker pool. Each file runs through the same
+// validateFile() orchestration as ValidateFile, with one difference: project-scoped
+// validators (working_dir "project_root", e.g. cargo check) run once per project root
+// and the shared output is narrowed per file.
+//
+// Key Features:
+//   - ValidateFiles for explicit path lists, ValidateDir for walked trees
+//   - Include/exclude globs (matched against root-relative path and base name)
+//   - .validationignore and config ignore globs: ValidateDir leaves ignored files out;
+//     ValidateFiles returns them with Ignored set (see ignore.go)
+//   - Max-parallelism knob (defaults to CPU count)
+//   - Project-root deduplication for project-wide validators
+//   - Severity totals and elapsed time in BatchResult
+//   - Compact Report(): summary first, then sections for failing files only
+//   - ValidateDir progress bar on a TTY (silent when stdout is captured; NoProgress hides it)
+//
+// Blocking Status
+//
+// Non-blocking: Unreadable directories are skipped; results never stop the caller.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	batch := validation.ValidateDir("/path/to/project", validation.DirOptions{
+//	    Exclude:     []string{"testdata", "*.pb.go"},
+//	    MaxParallel: 4,
+//	    NoProgress:  true, // Hook whose stdout is captured
+//	})
+//	batch.Report()
+//
+//	changed := validation.ValidateFiles([]string{"main.go", "deploy.sh"})
+//	if !changed.Valid { ... }
+//
+// Public API:
+//   ValidateFiles(paths []string) *BatchResult
+//   ValidateDir(root string, opts DirOptions) *BatchResult
+//   (*BatchResult).Report()
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: fmt, io/fs, path/filepath, runtime, sort, strings, sync, time
+//   Internal: system/lib/display (summary output, progress bar)
+//
+// Dependents (What Uses This):
+//   Hooks: pre-commit and session-end flows
+//
+// Health Scoring
+//
+// Orchestration over ValidateFile - per-file scoring applies to each file.
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"fmt"           // Summary formatting
+	"io/fs"         // Directory walking
+	"path/filepath" // Glob matching and relative paths
+	"runtime"       // Default parallelism (CPU count)
+	"sort"          // Deterministic file and skip ordering
+	"strings"       // Summary assembly
+	"sync"          // Worker pool and shared project runs
+	"time"          // Batch elapsed time
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/display" // ANSI-formatted summary output, progress bar
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+// defaultExcludes applies when DirOptions.Exclude is nil: VCS metadata and
+// dependency/build trees that are never ours to validate.
+var defaultExcludes = []string{".git", "node_modules", "target", "vendor"}
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// DirOptions controls which files ValidateDir visits and how many run at once.
+type DirOptions struct {
+	Include     []string // Globs a file must match (any); empty = every validatable file
+	Exclude     []string // Globs for files/directories to skip; nil = defaultExcludes
+	MaxParallel int      // Concurrent validations; <= 0 = runtime.NumCPU()
+	NoProgress  bool     // Hide ValidateDir's progress bar (it only draws on a TTY; set for hooks whose stdout is captured)
+}
+
+// BatchResult aggregates ValidationResults across many files.
+type BatchResult struct {
+	Valid      bool                `json:"valid"`      // True if every file passed
+	Files      []*ValidationResult `json:"files"`      // Per-file results, in input (or walk) order
+	Severities map[string]int      `json:"severities"` // Diagnostic count by severity (error, warning, info)
+	Failed     int                 `json:"failed"`     // Number of files that did not pass
+	Elapsed    time.Duration       `json:"elapsed_ns"` // Wall time for the whole batch
+}
+
+// projectRun shares one project-scoped validator run between files.
+type projectRun struct {
+	once   sync.Once
+	result ToolResult
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs
+//   ├── ValidateFiles() → uses validateBatch()
+//   ├── ValidateDir() → uses collectFiles(), validateBatch()
+//   └── (*BatchResult).Report() → uses (*ValidationResult).printWarnings()
+//
+//   Core Operations
+//   ├── validateBatch() → uses validateFile(), sharedProjectRunner(), (*display.Bar).Increment()
+//   ├── sharedProjectRunner() → uses runValidator(), runValidatorUnfiltered(), narrowToFile()
+//   └── collectFiles() → uses matchesAny(), resolveFileLanguage(), ignoredBy()
+//
+//   Helpers
+//   └── matchesAny() → pure function
+
+// ────────────────────────────────────────────────────────────────
+// HELPERS
+// ────────────────────────────────────────────────────────────────
+
+// matchesAny reports whether rel (root-relative, slash-separated) or its base
+// name matches any glob. Base-name matching lets "*.pb.go" work at any depth.
+func matchesAny(globs []string, rel string) bool {
+	base := filepath.Base(rel)
+	for _, glob := range globs {
+		if ok, _ := filepath.Match(glob, rel); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(glob, base); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ────────────────────────────────────────────────────────────────
+// CORE OPERATIONS
+// ────────────────────────────────────────────────────────────────
+
+// sharedProjectRunner returns a toolRunner that runs project-scoped validators
+// once per (language, validator, project root) and narrows the shared result
+// for each file. Per-file validators run normally.
+func sharedProjectRunner() toolRunner {
+	var mu sync.Mutex
+	runs := make(map[string]*projectRun)
+
+	return func(cfg *ValidatorsConfig, language, validatorName, filePath string) ToolResult {
+		if !isProjectScoped(cfg, language, validatorName) {
+			return runValidator(cfg, language, validatorName, filePath)
+		}
+
+		key := language + "/" + validatorName + "/" + findProjectRoot(filePath)
+		mu.Lock()
+		run, exists := runs[key]
+		if !exists {
+			run = &projectRun{}
+			runs[key] = run
+		}
+		mu.Unlock()
+
+		run.once.Do(func() {
+			run.result = runValidatorUnfiltered(cfg, language, validatorName, filePath)
+		})
+		return narrowToFile(cfg, run.result, language, validatorName, filePath)
+	}
+}
+
+// validateBatch validates paths with up to parallel concurrent workers,
+// advancing progress (nil = none) as each file finishes.
+func validateBatch(paths []string, parallel int, progress *display.Bar) *BatchResult {
+	start := time.Now()
+	if parallel <= 0 {
+		parallel = runtime.NumCPU()
+	}
+
+	batch := &BatchResult{
+		Valid:      true,
+		Files:      make([]*ValidationResult, len(paths)),
+		Severities: make(map[string]int),
+	}
+	run := sharedProjectRunner()
+
+	// Worker pool - each slot written by exactly one worker
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < parallel && w < len(paths); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				batch.Files[i] = validateFile(paths[i], filepath.Ext(paths[i]), run)
+				progress.Increment()
+			}
+		}()
+	}
+	for i := range paths {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	// Aggregate after the pool drains - no locking needed
+	for _, file := range batch.Files {
+		if !file.Valid {
+			batch.Valid = false
+			batch.Failed++
+		}
+		for _, d := range file.Diagnostics {
+			batch.Severities[d.Severity]++
+		}
+	}
+
+	batch.Elapsed = time.Since(start)
+	return batch
+}
+
+// collectFiles walks root for files with a known validator language that pass
+// the include/exclude globs. Unreadable entries are skipped, not fatal.
+func collectFiles(root string, opts DirOptions) []string {
+	excludes := opts.Exclude
+	if excludes == nil {
+		excludes = defaultExcludes
+	}
+
+	var paths []string
+	filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if entry != nil && entry.IsDir() {
+				return fs.SkipDir // Unreadable directory - skip its subtree
+			}
+			return nil
+		}
+
+		rel, relErr := filepath.Rel(root, path)
+		if relErr != nil || rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+
+		if matchesAny(excludes, rel) {
+			if entry.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() || !entry.Type().IsRegular() {
+			return nil
+		}
+		if len(opts.Include) > 0 && !matchesAny(opts.Include, rel) {
+			return nil
+		}
+		cfg := configForFile(path)
+		language, _ := resolveFileLanguage(cfg, path, filepath.Ext(path))
+		if language == "" {
+			return nil // No validator for this file type (or binary content)
+		}
+		if ignoredBy(cfg, language, path) != "" {
+			return nil // .validationignore or an ignore glob
+		}
+
+		paths = append(paths, path)
+		return nil
+	})
+
+	sort.Strings(paths)
+	return paths
+}
+
+// ────────────────────────────────────────────────────────────────
+// PUBLIC API: Batch Validation
+// ────────────────────────────────────────────────────────────────
+
+// ValidateFiles validates an explicit set of files (e.g., changed files).
+//
+// Runs with runtime.NumCPU() workers. Files whose extension has no validator
+// pass trivially, exactly as with ValidateFile.
+//
+// Parameters:
+//   - paths: Files to validate (extension taken from each path)
+//
+// Returns:
+//   - *BatchResult with per-file results in the given order
+func ValidateFiles(paths []string) *BatchResult {
+	return validateBatch(paths, 0, nil)
+}
+
+// ValidateDir validates every validatable file under root.
+//
+// Walks root, applying opts.Include/opts.Exclude globs, and skips files no
+// validator handles. Excluded directories are not descended into. On a TTY
+// a progress bar counts files as they finish and is cleared before
+// returning, so Report() starts on a clean line.
+//
+// Parameters:
+//   - root: Directory to walk
+//   - opts: Include/exclude globs, max parallelism, NoProgress
+//
+// Returns:
+//   - *BatchResult with per-file results in lexical path order
+func ValidateDir(root string, opts DirOptions) *BatchResult {
+	paths := collectFiles(root, opts)
+
+	var progress *display.Bar
+	if !opts.NoProgress && len(paths) > 0 {
+		progress = display.NewProgressBar(len(paths), "Validating")
+		defer progress.Stop()
+	}
+	return validateBatch(paths, opts.MaxParallel, progress)
+}
+
+// ────────────────────────────────────────────────────────────────
+// REPORTING: Batch Summary
+// ────────────────────────────────────────────────────────────────
+
+// Report prints a compact batch summary, then a section per failing file.
+//
+// Passing files are counted but not listed. Skipped validators are listed
+// once per distinct tool, not once per file.
+func (b *BatchResult) Report() {
+	if b == nil {
+		return
+	}
+
+	summary := fmt.Sprintf("Validated %d files in %s: %d failed (%d errors, %d warnings, %d info)",
+		len(b.Files), b.Elapsed.Round(time.Millisecond), b.Failed,
+		b.Severities[SeverityError], b.Severities[SeverityWarning], b.Severities[SeverityInfo])
+	if b.Valid {
+		fmt.Println(display.Success(summary))
+	} else {
+		fmt.Println(display.Warning(summary))
+	}
+
+	// Skipped tools, deduplicated across files
+	skipped := make(map[string]string)
+	for _, file := range b.Files {
+		for _, skip := range file.Skipped {
+			skipped[skip.Validator] = skip.Reason
+		}
+	}
+	names := make([]string, 0, len(skipped))
+	for name := range skipped {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Println(display.Info("Skipped " + name + ": " + skipped[name]))
+	}
+
+	// Failing files only
+	for _, file := range b.Files {
+		if file.Valid {
+			continue
+		}
+		header := file.FilePath
+		if file.Validator != "" {
+			header += " (" + strings.TrimSpace(file.Validator) + ")"
+		}
+		fmt.Println(display.Failure(header))
+		file.printWarnings("   ", 0)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Code Validation: Testing Requirements
+// ────────────────────────────────────────────────────────────────
+//
+// Testing Requirements:
+//   - ValidateFiles() preserves input order and aggregates Valid/Failed/Severities
+//   - ValidateDir() honors Include/Exclude and never descends excluded directories
+//   - Project-scoped validators (cargo check) run once per project root
+//   - Report() lists only failing files
+//   - ValidateDir() progress bar is cleared before returning (NoProgress draws none)
+//
+// Build Verification:
+//   - go build ./... && go vet ./...
+//   - go test -race ./... (worker pool shares the project-run cache)
+
+// ────────────────────────────────────────────────────────────────
+// Code Execution: None (Library)
+// ────────────────────────────────────────────────────────────────
+//
+// Imported alongside ValidateFile; no entry point of its own.
+
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,207 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Batch Validation Tests
+//
+// Purpose: Prove a project_root validator runs once per project root however
+//          many of its files are in the batch, that ValidateDir honors
+//          include/exclude globs and never runs more than MaxParallel files
+//          at once, and that severity totals add up while Report() prints a
+//          section for failing files only.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// useBatchValidators adds to useWatchValidators' ".chk" and ".crate" tools a
+// ".sev" checker reporting an error for "bad" and a warning for "meh", and a
+// ".par" checker that logs how many runs are active when it starts.
+func useBatchValidators(t *testing.T) (runLog, peakLog string) {
+	t.Helper()
+	runLog = useWatchValidators(t)
+
+	dir := t.TempDir()
+	active := filepath.Join(dir, "active")
+	os.Mkdir(active, 0755)
+	peakLog = filepath.Join(dir, "peaks")
+	sev := filepath.Join(dir, "sev.sh")
+	par := filepath.Join(dir, "par.sh")
+	os.WriteFile(sev, []byte(`status=0
+if grep -q bad "$1"; then echo "$1:1:1: error: bad"; status=1; fi
+if grep -q meh "$1"; then echo "$1:2:1: warning: meh"; status=1; fi
+exit $status
+`), 0755)
+	os.WriteFile(par, []byte(`touch "`+active+`/$$"
+ls "`+active+`" | wc -l >> "`+peakLog+`"
+sleep 0.2
+rm "`+active+`/$$"
+`), 0755)
+
+	validatorsConfig.Validators["sev"] = LanguageValidators{Validators: map[string]ValidatorTool{
+		"sev_check": {Command: "/bin/sh", Args: []string{sev, "{filepath}"}, Enabled: true},
+	}}
+	validatorsConfig.Validators["par"] = LanguageValidators{Validators: map[string]ValidatorTool{
+		"par_check": {Command: "/bin/sh", Args: []string{par}, Enabled: true},
+	}}
+	validatorsConfig.Extensions[".sev"] = "sev"
+	validatorsConfig.Extensions[".par"] = "par"
+	return runLog, peakLog
+}
+
+// batchPaths returns the files a batch validated, relative to root.
+func batchPaths(t *testing.T, root string, batch *BatchResult) []string {
+	t.Helper()
+	var rels []string
+	for _, file := range batch.Files {
+		rel, err := filepath.Rel(root, file.FilePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rels = append(rels, filepath.ToSlash(rel))
+	}
+	return rels
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestBatchProjectRootRunsOnce(t *testing.T) {
+	runLog, _ := useBatchValidators(t)
+
+	var paths []string
+	roots := []string{t.TempDir(), t.TempDir()}
+	for _, root := range roots {
+		os.WriteFile(filepath.Join(root, "Cargo.toml"), []byte("[package]\n"), 0644)
+		os.MkdirAll(filepath.Join(root, "src"), 0755)
+		for _, name := range []string{"a.crate", "b.crate", "src/c.crate", "src/d.crate"} {
+			path := filepath.Join(root, filepath.FromSlash(name))
+			os.WriteFile(path, []byte(name+"\n"), 0644)
+			paths = append(paths, path)
+		}
+	}
+
+	batch := ValidateFiles(paths)
+	if len(batch.Files) != len(paths) || !batch.Valid {
+		t.Fatalf("batch: %d files, valid %v; want %d passing", len(batch.Files), batch.Valid, len(paths))
+	}
+	for i, file := range batch.Files {
+		if file.FilePath != paths[i] {
+			t.Errorf("Files[%d] = %s, want input order (%s)", i, file.FilePath, paths[i])
+		}
+	}
+	runs, _ := os.ReadFile(runLog)
+	if count := strings.Count(string(runs), "run"); count != len(roots) {
+		t.Errorf("project-scoped validator ran %d times for %d files, want once per root (%d)", count, len(paths), len(roots))
+	}
+}
+
+func TestValidateDirGlobs(t *testing.T) {
+	useBatchValidators(t)
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"main.chk":           "ok",
+		"api/user.chk":       "ok",
+		"api/user.gen.chk":   "ok",
+		"testdata/bad.chk":   "bad",
+		"node_modules/x.chk": "bad",
+		"notes.txt":          "not validatable",
+		"lib.sev":            "ok",
+	})
+
+	cases := []struct {
+		name string
+		opts DirOptions
+		want []string
+	}{
+		{"default excludes", DirOptions{}, []string{"api/user.chk", "api/user.gen.chk", "lib.sev", "main.chk", "testdata/bad.chk"}},
+		{"exclude dir and base-name glob", DirOptions{Exclude: []string{"testdata", "*.gen.chk"}},
+			[]string{"api/user.chk", "lib.sev", "main.chk", "node_modules/x.chk"}},
+		{"include base name", DirOptions{Include: []string{"*.sev"}}, []string{"lib.sev"}},
+		{"include relative path", DirOptions{Include: []string{"api/*"}, Exclude: []string{"*.gen.chk"}}, []string{"api/user.chk"}},
+	}
+	for _, tc := range cases {
+		tc.opts.NoProgress = true
+		got := batchPaths(t, root, ValidateDir(root, tc.opts))
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: validated %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestValidateDirMaxParallel(t *testing.T) {
+	_, peakLog := useBatchValidators(t)
+	root := t.TempDir()
+	files := map[string]string{}
+	for i := range 6 {
+		files["f"+strconv.Itoa(i)+".par"] = "x"
+	}
+	writeTree(t, root, files)
+
+	for _, limit := range []int{1, 3} {
+		os.Remove(peakLog)
+		ValidateDir(root, DirOptions{MaxParallel: limit, NoProgress: true})
+
+		log, _ := os.ReadFile(peakLog)
+		peak := 0
+		for _, field := range strings.Fields(string(log)) {
+			n, _ := strconv.Atoi(field)
+			peak = max(peak, n)
+		}
+		if peak == 0 || peak > limit {
+			t.Errorf("MaxParallel %d: peak %d concurrent runs", limit, peak)
+		}
+		if limit > 1 && peak == 1 {
+			t.Errorf("MaxParallel %d: runs never overlapped", limit)
+		}
+	}
+}
+
+func TestBatchSeveritiesAndReport(t *testing.T) {
+	useBatchValidators(t)
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"both.sev":  "bad\nmeh\n",
+		"error.sev": "bad\n",
+		"clean.sev": "fine\n",
+		"warn.sev":  "meh\n",
+	})
+
+	batch := ValidateDir(root, DirOptions{NoProgress: true})
+	if batch.Valid || batch.Failed != 3 {
+		t.Errorf("Valid %v, Failed %d; want invalid with 3 failures", batch.Valid, batch.Failed)
+	}
+	want := map[string]int{SeverityError: 2, SeverityWarning: 2}
+	if !reflect.DeepEqual(batch.Severities, want) {
+		t.Errorf("Severities = %v, want %v", batch.Severities, want)
+	}
+
+	out := captureStdout(t, batch.Report)
+	if !strings.Contains(out, "Validated 4 files") || !strings.Contains(out, "3 failed (2 errors, 2 warnings, 0 info)") {
+		t.Errorf("summary missing or wrong:\n%s", out)
+	}
+	for _, name := range []string{"both.sev", "error.sev", "warn.sev"} {
+		if strings.Count(out, filepath.Join(root, name)+" (sev_check)") != 1 {
+			t.Errorf("no section for failing %s:\n%s", name, out)
+		}
+	}
+	if strings.Contains(out, "clean.sev") {
+		t.Errorf("passing file listed:\n%s", out)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,126 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Config Loading Tests
+//
+// Purpose: Prove validators.jsonc and formatters.jsonc decode over the
+//          hardcoded defaults: an empty file equals pure defaults, a file
+//          setting a single key keeps every other default (languages,
+//          extensions, shebangs), a language the file names replaces its
+//          default tool instead of running beside it, and trailing commas
+//          parse.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeConfig writes a config file under a fresh temp dir and returns its path.
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.jsonc")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestLoadValidatorsConfigEmptyEqualsDefaults(t *testing.T) {
+	for _, content := range []string{"", "  \n", "{}", "// nothing set\n{}"} {
+		config := loadValidatorsConfig(writeConfig(t, content))
+		if config == nil {
+			t.Fatalf("%q: fell back instead of loading", content)
+		}
+		if !reflect.DeepEqual(config, getDefaultValidatorsConfig()) {
+			t.Errorf("%q: want pure defaults, got %+v", content, config)
+		}
+	}
+}
+
+func TestLoadValidatorsConfigSingleOverride(t *testing.T) {
+	config := loadValidatorsConfig(writeConfig(t, `{
+		"config": {"timeout_seconds": 5,}, // Only this - trailing commas allowed
+	}`))
+	if config == nil {
+		t.Fatal("fell back instead of loading")
+	}
+
+	want := getDefaultValidatorsConfig()
+	want.Config.TimeoutSeconds = 5
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("single override lost other defaults:\n got %+v\nwant %+v", config, want)
+	}
+	if got := getEnabledValidators(config, "shell"); !reflect.DeepEqual(got, []string{"shell_default"}) {
+		t.Errorf("shell validators = %q, want the default still enabled", got)
+	}
+	if got := getValidatorLanguage(config, ".py"); got != "python" {
+		t.Errorf(".py resolves to %q, want the default extension map kept", got)
+	}
+}
+
+func TestLoadValidatorsConfigLanguageReplacesDefault(t *testing.T) {
+	config := loadValidatorsConfig(writeConfig(t, `{
+		"validators": {"go": {"validators": {"go_vet": {"command": "go", "args": ["vet", "{filepath}"], "enabled": true}}}},
+		"extensions": {".gotmpl": "go"}
+	}`))
+	if config == nil {
+		t.Fatal("fell back instead of loading")
+	}
+
+	if got := getEnabledValidators(config, "go"); !reflect.DeepEqual(got, []string{"go_vet"}) {
+		t.Errorf("go validators = %q, want the file's tool only", got)
+	}
+	if got := getEnabledValidators(config, "rust"); !reflect.DeepEqual(got, []string{"rust_default"}) {
+		t.Errorf("rust validators = %q, want the untouched default", got)
+	}
+	for ext, language := range map[string]string{".gotmpl": "go", ".go": "go", ".sh": "shell"} {
+		if got := config.Extensions[ext]; got != language {
+			t.Errorf("extension %s = %q, want %q", ext, got, language)
+		}
+	}
+}
+
+func TestLoadValidatorsConfigMalformedFallsBack(t *testing.T) {
+	if config := loadValidatorsConfig(writeConfig(t, `{"config": {`)); config != nil {
+		t.Errorf("malformed file loaded: %+v", config)
+	}
+	if config := loadValidatorsConfig(filepath.Join(t.TempDir(), "missing.jsonc")); config != nil {
+		t.Errorf("missing file loaded: %+v", config)
+	}
+}
+
+func TestLoadFormattersConfigPartial(t *testing.T) {
+	if config := loadFormattersConfig(writeConfig(t, "")); !reflect.DeepEqual(config, getDefaultFormattersConfig()) {
+		t.Errorf("empty file: want pure defaults, got %+v", config)
+	}
+
+	config := loadFormattersConfig(writeConfig(t, `{"config": {"fail_on_error": true,},}`))
+	if config == nil {
+		t.Fatal("fell back instead of loading")
+	}
+	want := getDefaultFormattersConfig()
+	want.Config.FailOnError = true
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("single override lost other defaults:\n got %+v\nwant %+v", config, want)
+	}
+	if primary := config.Formatters["go"]; primary.Tools[primary.Primary].Command != "gofmt" {
+		t.Errorf("go primary formatter = %+v, want the gofmt default", primary)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
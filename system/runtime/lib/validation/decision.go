@@ -0,0 +1,443 @@
+// METADATA
+//
+// Validation Decisions - CPI-SI Runtime System
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "To every thing there is a season... a time to keep silence, and a time to speak." - Ecclesiastes 3:1,7 (KJV)
+// Principle: Knowing what a finding is does not settle what to do about it - that is a second judgment
+// Anchor: "He that answereth a matter before he heareth it, it is folly and shame unto him." - Proverbs 18:13 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: LIBRARY - Runtime validation support (mid-rung on ladder)
+// Role: Turns a ValidationResult into what the post-use hook should do about it
+// Paradigm: Strictness decides the verdict; policy decides the response
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn (CPI-SI instance)
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.1.0
+// Last Modified: 2026-10-16 - Ignored files decide silent with their rule as the reason
+//
+// Version History:
+//   1.1.0 (2026-10-16) - Ignored results (ignore.go) are silent; Reason names the ignoring rule
+//   1.0.0 (2026-10-16) - EvaluateResult, Policy/PolicyRule from validators.jsonc "policy", Decision.Present
+//
+// Purpose & Function
+//
+// Purpose: The post-use hook printed whatever Report produced - nothing told it
+// whether to interrupt, log quietly, or stop the next step. EvaluateResult
+// answers that with one of four actions:
+//
+//   - "silent": nothing shown (the hook still logs the decision)
+//   - "report": the configured report (config.report_mode)
+//   - "report_loud": a headline with the reason, then every line
+//   - "fail": report_loud, plus the findings on stderr and a blocking exit
+//
+// A result is first reduced to its situation - "error" (a tool failed the
+// file), "warning" (passed with findings), "missing" (a validator is not
+// installed), or "clean" - with the types (syntax, linting, type_checking,
+// compilation) of the tools behind it and the language's strictness mode.
+// Rules then match on situation, strictness, and tool type: configured rules
+// first (validators.jsonc "policy.rules", project rules ahead of global), then
+// fail_on_missing_validator, then the defaults:
+//
+//   error   + syntax or compilation tool  → report_loud
+//   error                                 → report
+//   warning + strict                      → report
+//   warning (permissive, error_only)      → silent
+//   missing                               → silent (fail with fail_on_missing_validator)
+//   clean                                 → silent
+//
+// Ignored files (ValidationResult.Ignored) are always silent, with the
+// ignoring rule as the reason - policy rules are not consulted.
+//
+// Decision also carries a short reason and a suggested health impact for the
+// caller's Rails logger.
+//
+// Blocking Status
+//
+// Non-blocking: EvaluateResult is pure; Present only prints. Blocking is the
+// caller's choice (Decision.Blocks).
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: fmt, os, sort, strings
+//   Internal: system/lib/display
+//   Package Files: syntax.go (ValidationResult, ValidatorsConfig), strictness.go (strictnessFor),
+//                  project.go (configForFile), report.go (printWarnings, printSkipped)
+//
+// Dependents (What Uses This):
+//   Hooks: tool/post-use (PolicyForFile, EvaluateResult, Present)
+//
+// Health Scoring
+//
+// Decisions suggest a delta for the caller - clean +5, silent 0, report -2,
+// report_loud -5, fail -10. No logging of their own.
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"fmt"     // Reasons and stderr findings
+	"os"      // Stderr for blocking decisions
+	"sort"    // Stable tool lists in reasons
+	"strings" // Reason assembly
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/display" // Headline for loud decisions
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+// Decision actions (policy rule "action" values).
+const (
+	ActionSilent     = "silent"      // Nothing shown
+	ActionReport     = "report"      // Configured report
+	ActionReportLoud = "report_loud" // Headline plus every line
+	ActionFail       = "fail"        // Loud, plus stderr and a blocking exit
+)
+
+// SituationMissing is the policy severity of a result whose validator is not
+// installed (SeverityError, SeverityWarning, and SeverityClean cover the rest).
+const SituationMissing = "missing"
+
+// Suggested health impacts by action (clean results earn healthCleanImpact).
+const (
+	healthCleanImpact = 5
+)
+
+var actionHealthImpact = map[string]int{
+	ActionSilent:     0,
+	ActionReport:     -2,
+	ActionReportLoud: -5,
+	ActionFail:       -10,
+}
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// PolicyRule maps a situation to an action. Empty match fields match anything.
+type PolicyRule struct {
+	Severity   string `json:"severity"`   // error, warning, missing, clean ("" = any)
+	Strictness string `json:"strictness"` // strict, permissive, error_only ("" = any)
+	ToolType   string `json:"tool_type"`  // syntax, linting, type_checking, compilation ("" = any)
+	Action     string `json:"action"`     // silent, report, report_loud, fail
+}
+
+// Policy is everything EvaluateResult needs to decide - built by PolicyForFile.
+type Policy struct {
+	Rules                  []PolicyRule      // Configured rules, first match wins (defaults follow)
+	Strictness             string            // config.strictness
+	LanguageStrictness     map[string]string // config.language_strictness
+	FailOnMissingValidator bool              // config.fail_on_missing_validator
+	ToolTypes              map[string]string // Validator name → type ("go_vet" → "syntax")
+}
+
+// Decision is what the caller should do with one ValidationResult.
+type Decision struct {
+	Action       string // silent, report, report_loud, fail
+	Reason       string // Short why ("error from go_vet (syntax)")
+	HealthImpact int    // Suggested delta for the caller's Rails logger
+	Rule         int    // 1-based configured rule that decided (0 = built-in)
+}
+
+// defaultPolicyRules decide whatever configured rules leave open.
+var defaultPolicyRules = []PolicyRule{
+	{Severity: SeverityError, ToolType: "syntax", Action: ActionReportLoud},
+	{Severity: SeverityError, ToolType: "compilation", Action: ActionReportLoud},
+	{Severity: SeverityError, Action: ActionReport},
+	{Severity: SeverityWarning, Strictness: StrictnessStrict, Action: ActionReport},
+	{Severity: SeverityWarning, Action: ActionSilent},
+	{Severity: SituationMissing, Action: ActionSilent},
+	{Severity: SeverityClean, Action: ActionSilent},
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs
+//   ├── PolicyForFile(filePath) → uses configForFile()
+//   ├── EvaluateResult(result, policy) → uses situationOf(), ruleMatches(), describeSituation()
+//   ├── (Decision).Present(result) → uses ReportConfigured(), Report(), reportFindings()
+//   └── (Decision).Blocks()
+//
+//   Helpers
+//   ├── situationOf() → situation, validators behind it
+//   ├── ruleMatches() → pure function
+//   ├── describeSituation() → pure function
+//   └── reportFindings() → uses printSkipped(), printWarnings(), printTruncated()
+
+// ────────────────────────────────────────────────────────────────
+// HELPERS: Situation & Matching
+// ────────────────────────────────────────────────────────────────
+
+// situationOf reduces a result to its policy severity and the validators behind it.
+func situationOf(result *ValidationResult) (string, []string) {
+	var failing, missing, noisy []string
+	for _, tool := range result.ToolResults {
+		switch {
+		case !tool.Valid && tool.Missing:
+			missing = append(missing, tool.Validator)
+		case !tool.Valid:
+			failing = append(failing, tool.Validator)
+		case len(tool.Warnings) > 0 || len(tool.Diagnostics) > 0:
+			noisy = append(noisy, tool.Validator)
+		}
+	}
+	for _, skipped := range result.Skipped {
+		if skipped.Missing {
+			missing = append(missing, skipped.Validator)
+		}
+	}
+
+	switch {
+	case !result.Valid && (len(failing) > 0 || len(missing) == 0):
+		return SeverityError, failing
+	case !result.Valid:
+		return SituationMissing, missing
+	case len(noisy) > 0 || len(result.Warnings) > 0 || len(result.Diagnostics) > 0:
+		return SeverityWarning, noisy
+	case len(missing) > 0:
+		return SituationMissing, missing
+	}
+	return SeverityClean, nil
+}
+
+// ruleMatches reports whether rule covers the situation.
+func ruleMatches(rule PolicyRule, situation, strictness string, types map[string]bool) bool {
+	switch {
+	case rule.Severity != "" && rule.Severity != situation:
+		return false
+	case rule.Strictness != "" && rule.Strictness != strictness:
+		return false
+	case rule.ToolType != "" && !types[rule.ToolType]:
+		return false
+	}
+	return true
+}
+
+// describeSituation phrases the reason ("error from go_vet (syntax)").
+func describeSituation(situation, strictness string, validators []string, policy Policy) string {
+	var named []string
+	for _, name := range validators {
+		if kind := policy.ToolTypes[name]; kind != "" {
+			named = append(named, name+" ("+kind+")")
+		} else {
+			named = append(named, name)
+		}
+	}
+	from := ""
+	if len(named) > 0 {
+		from = " from " + strings.Join(named, ", ")
+	}
+
+	switch situation {
+	case SeverityError:
+		return "error" + from
+	case SeverityWarning:
+		return "warnings" + from + " under " + strictness
+	case SituationMissing:
+		return strings.Join(validators, ", ") + " not installed"
+	}
+	return "clean"
+}
+
+// validActions are the actions a configured rule may name.
+var validActions = map[string]bool{ActionSilent: true, ActionReport: true, ActionReportLoud: true, ActionFail: true}
+
+// ────────────────────────────────────────────────────────────────
+// PUBLIC API: Decisions
+// ────────────────────────────────────────────────────────────────
+
+// PolicyForFile builds the Policy for a file from its effective config.
+//
+// Honors project overrides (project rules ahead of global ones). Without any
+// config the Policy holds only defaults: strict, no rules, no tool types.
+func PolicyForFile(filePath string) Policy {
+	policy := Policy{ToolTypes: map[string]string{}}
+	cfg := configForFile(filePath)
+	if cfg == nil {
+		return policy
+	}
+
+	policy.Rules = cfg.Policy.Rules
+	policy.Strictness = cfg.Config.Strictness
+	policy.LanguageStrictness = cfg.Config.LanguageStrictness
+	policy.FailOnMissingValidator = cfg.Config.FailOnMissingValidator
+	for _, language := range cfg.Validators {
+		for name, tool := range language.Validators {
+			if tool.Type != "" {
+				policy.ToolTypes[name] = tool.Type
+			}
+		}
+	}
+	return policy
+}
+
+// EvaluateResult decides what to do with a validation result under policy.
+//
+// Configured rules are tried first, in order; rules naming an unknown action
+// are ignored. Then fail_on_missing_validator, then the built-in defaults.
+// The same result and policy always produce the same Decision.
+//
+// Example:
+//
+//	decision := validation.EvaluateResult(result, validation.PolicyForFile(path))
+//	decision.Present(result)
+func EvaluateResult(result *ValidationResult, policy Policy) Decision {
+	if result == nil {
+		return Decision{Action: ActionSilent, Reason: "nothing validated"}
+	}
+	if result.Ignored != "" { // Deliberately left alone - quiet, but the reason is kept
+		return Decision{Action: ActionSilent, Reason: result.Ignored}
+	}
+
+	cfg := &ValidatorsConfig{}
+	cfg.Config.Strictness = policy.Strictness
+	cfg.Config.LanguageStrictness = policy.LanguageStrictness
+	strictness := strictnessFor(cfg, result.Language)
+
+	situation, validators := situationOf(result)
+	sort.Strings(validators)
+	types := make(map[string]bool, len(validators))
+	for _, name := range validators {
+		types[policy.ToolTypes[name]] = true
+	}
+	decision := Decision{Reason: describeSituation(situation, strictness, validators, policy)}
+
+	for i, rule := range policy.Rules {
+		if validActions[rule.Action] && ruleMatches(rule, situation, strictness, types) {
+			decision.Action, decision.Rule = rule.Action, i+1
+			break
+		}
+	}
+	if decision.Action == "" && situation == SituationMissing && policy.FailOnMissingValidator {
+		decision.Action = ActionFail
+		decision.Reason += " (fail_on_missing_validator)"
+	}
+	if decision.Action == "" {
+		decision.Action = ActionSilent
+		for _, rule := range defaultPolicyRules {
+			if ruleMatches(rule, situation, strictness, types) {
+				decision.Action = rule.Action
+				break
+			}
+		}
+	}
+	if decision.Rule > 0 {
+		decision.Reason += fmt.Sprintf(" (policy rule %d)", decision.Rule)
+	}
+
+	decision.HealthImpact = actionHealthImpact[decision.Action]
+	if situation == SeverityClean {
+		decision.HealthImpact = healthCleanImpact
+	}
+	return decision
+}
+
+// Blocks reports whether the caller should stop the follow-up action.
+func (d Decision) Blocks() bool {
+	return d.Action == ActionFail
+}
+
+// Present shows the result the way the decision says.
+//
+// Silent prints nothing (not even skip notes). Report uses config.report_mode.
+// Loud and fail print a headline with the reason and every line; fail also
+// writes the reason and findings to stderr for the caller's blocking exit.
+// Findings on a passing result are shown too - the policy asked for them.
+func (d Decision) Present(result *ValidationResult) {
+	if result == nil {
+		return
+	}
+
+	switch d.Action {
+	case ActionReport:
+		if result.Valid {
+			result.reportFindings()
+		} else {
+			result.ReportConfigured()
+		}
+	case ActionReportLoud, ActionFail:
+		fmt.Println(display.Failure("Validation: " + d.Reason))
+		if result.Valid {
+			result.reportFindings()
+		} else {
+			result.Report()
+		}
+	}
+
+	if d.Blocks() {
+		fmt.Fprintf(os.Stderr, "Validation failed for %s: %s\n", result.FilePath, d.Reason)
+		for _, warning := range result.Warnings {
+			fmt.Fprintln(os.Stderr, "  "+strings.TrimSpace(warning))
+		}
+	}
+}
+
+// reportFindings prints the findings of a result that passed.
+func (v *ValidationResult) reportFindings() {
+	v.printSkipped()
+	if len(v.Warnings) == 0 {
+		return
+	}
+	fmt.Println(display.Warning("Validation findings (file passed)"))
+	v.printWarnings("   ", 0)
+	v.printTruncated()
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Policy matrix: every situation × strictness × tool type against the defaults
+//   - Configured rules win in order; unknown actions are ignored; project rules first
+//   - Run: go test ./... (decision_test.go)
+//
+// Code Execution: None (Library) - called by the tool/post-use hook
+//
+// Modification Policy:
+//   ✅ Safe: New default rules (keep error defaults at least "report")
+//   ⚠️ Care: Health impacts - hooks log them as-is
+//   ❌ Never: Blocking from inside the library - the caller owns its exit code
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
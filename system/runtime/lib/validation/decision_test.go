@@ -0,0 +1,185 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Validation Decision Tests
+//
+// Purpose: Prove EvaluateResult maps every situation × strictness × tool type
+//          to the documented default action, that configured rules win in
+//          order (unknown actions ignored), that fail_on_missing_validator
+//          turns a missing validator into "fail", that project rules come
+//          before global ones, and that the same input decides the same way.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"reflect"
+	"testing"
+)
+
+// decisionTypes gives each situation fixture's validators a tool type
+var decisionTypes = map[string]string{
+	"parse":   "syntax",
+	"build":   "compilation",
+	"lint":    "linting",
+	"types":   "type_checking",
+	"missing": "linting",
+}
+
+// situationResult builds a result in the given situation from one validator.
+func situationResult(situation, validator string) *ValidationResult {
+	result := &ValidationResult{FilePath: "/repo/a.fake", Language: "fake", Valid: true}
+	tool := ToolResult{Validator: validator, Valid: true}
+	switch situation {
+	case SeverityError:
+		tool.Valid, tool.Warnings = false, []string{"a.fake:1: broken"}
+		result.Valid = false
+	case SeverityWarning:
+		tool.Warnings = []string{"a.fake:1: unused"}
+	case SituationMissing:
+		result.Skipped = []SkippedValidator{{Validator: validator, Reason: validator + " not installed", Missing: true}}
+		return result
+	}
+	result.ToolResults = []ToolResult{tool}
+	result.Warnings = tool.Warnings
+	return result
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestDecisionDefaultMatrix(t *testing.T) {
+	cases := []struct {
+		situation  string
+		strictness string
+		validator  string
+		want       string
+	}{
+		{SeverityError, StrictnessStrict, "parse", ActionReportLoud},
+		{SeverityError, StrictnessPermissive, "parse", ActionReportLoud},
+		{SeverityError, StrictnessErrorOnly, "parse", ActionReportLoud},
+		{SeverityError, StrictnessStrict, "build", ActionReportLoud},
+		{SeverityError, StrictnessPermissive, "build", ActionReportLoud},
+		{SeverityError, StrictnessStrict, "lint", ActionReport},
+		{SeverityError, StrictnessPermissive, "types", ActionReport},
+		{SeverityError, StrictnessErrorOnly, "types", ActionReport},
+		{SeverityWarning, StrictnessStrict, "lint", ActionReport},
+		{SeverityWarning, StrictnessStrict, "parse", ActionReport},
+		{SeverityWarning, StrictnessPermissive, "lint", ActionSilent},
+		{SeverityWarning, StrictnessPermissive, "parse", ActionSilent},
+		{SeverityWarning, StrictnessErrorOnly, "types", ActionSilent},
+		{SituationMissing, StrictnessStrict, "missing", ActionSilent},
+		{SituationMissing, StrictnessPermissive, "missing", ActionSilent},
+		{SeverityClean, StrictnessStrict, "parse", ActionSilent},
+		{SeverityClean, StrictnessPermissive, "lint", ActionSilent},
+	}
+
+	for _, c := range cases {
+		policy := Policy{Strictness: c.strictness, ToolTypes: decisionTypes}
+		decision := EvaluateResult(situationResult(c.situation, c.validator), policy)
+		if decision.Action != c.want || decision.Rule != 0 {
+			t.Errorf("%s/%s/%s: %+v, want %s from defaults", c.situation, c.strictness, decisionTypes[c.validator], decision, c.want)
+		}
+		if c.situation == SeverityClean && decision.HealthImpact != healthCleanImpact {
+			t.Errorf("clean health impact = %d", decision.HealthImpact)
+		}
+		if c.situation != SeverityClean && decision.HealthImpact != actionHealthImpact[c.want] {
+			t.Errorf("%s health impact = %d, want %d", c.want, decision.HealthImpact, actionHealthImpact[c.want])
+		}
+	}
+
+	if got := EvaluateResult(situationResult(SeverityError, "parse"), Policy{ToolTypes: decisionTypes}).Reason; got != "error from parse (syntax)" {
+		t.Errorf("reason = %q", got)
+	}
+	if got := EvaluateResult(nil, Policy{}); got.Action != ActionSilent {
+		t.Errorf("nil result: %+v", got)
+	}
+}
+
+func TestDecisionFailOnMissingValidator(t *testing.T) {
+	policy := Policy{FailOnMissingValidator: true, ToolTypes: decisionTypes}
+	if d := EvaluateResult(situationResult(SituationMissing, "missing"), policy); d.Action != ActionFail || !d.Blocks() {
+		t.Errorf("skipped missing validator: %+v", d)
+	}
+
+	// fail_on_missing_validator already failed the file in ValidateFile
+	failed := &ValidationResult{Language: "fake", ToolResults: []ToolResult{{Validator: "missing", Missing: true}}}
+	if d := EvaluateResult(failed, policy); d.Action != ActionFail {
+		t.Errorf("failed missing validator: %+v", d)
+	}
+
+	policy.Rules = []PolicyRule{{Severity: SituationMissing, Action: ActionReport}}
+	if d := EvaluateResult(failed, policy); d.Action != ActionReport || d.Rule != 1 {
+		t.Errorf("configured rule should win over fail_on_missing_validator: %+v", d)
+	}
+}
+
+func TestDecisionConfiguredRules(t *testing.T) {
+	policy := Policy{
+		Strictness: StrictnessPermissive,
+		ToolTypes:  decisionTypes,
+		Rules: []PolicyRule{
+			{Severity: SeverityWarning, Action: "shout"}, // Unknown action - ignored
+			{Severity: SeverityError, ToolType: "type_checking", Action: ActionFail},
+			{Severity: SeverityWarning, Strictness: StrictnessPermissive, ToolType: "linting", Action: ActionReport},
+			{Severity: SeverityWarning, Action: ActionReportLoud},
+		},
+	}
+
+	cases := []struct {
+		situation string
+		validator string
+		want      string
+		rule      int
+	}{
+		{SeverityError, "types", ActionFail, 2},
+		{SeverityError, "parse", ActionReportLoud, 0}, // No rule - default
+		{SeverityWarning, "lint", ActionReport, 3},
+		{SeverityWarning, "parse", ActionReportLoud, 4},
+	}
+	for _, c := range cases {
+		d := EvaluateResult(situationResult(c.situation, c.validator), policy)
+		if d.Action != c.want || d.Rule != c.rule {
+			t.Errorf("%s/%s: %+v, want %s (rule %d)", c.situation, c.validator, d, c.want, c.rule)
+		}
+	}
+
+	d := EvaluateResult(situationResult(SeverityError, "types"), policy)
+	if want := "error from types (type_checking) (policy rule 2)"; d.Reason != want {
+		t.Errorf("reason = %q, want %q", d.Reason, want)
+	}
+	if again := EvaluateResult(situationResult(SeverityError, "types"), policy); !reflect.DeepEqual(d, again) {
+		t.Errorf("decision not stable: %+v then %+v", d, again)
+	}
+}
+
+func TestDecisionProjectRulesFirst(t *testing.T) {
+	useGlobalConfig(t)
+	vet := validatorsConfig.Validators["go"].Validators["go_vet"]
+	vet.Type = "syntax"
+	validatorsConfig.Validators["go"].Validators["go_vet"] = vet
+	validatorsConfig.Policy.Rules = []PolicyRule{{Severity: SeverityError, Action: ActionReport}}
+
+	file := projectTree(t, `{"policy": {"rules": [{"severity": "error", "tool_type": "syntax", "action": "fail"}]}}`)
+	policy := PolicyForFile(file)
+	if len(policy.Rules) != 2 || policy.Rules[0].Action != ActionFail || policy.ToolTypes["go_vet"] != "syntax" {
+		t.Fatalf("policy = %+v", policy)
+	}
+
+	result := &ValidationResult{FilePath: file, Language: "go", ToolResults: []ToolResult{{Validator: "go_vet"}}}
+	if d := EvaluateResult(result, policy); d.Action != ActionFail || d.Rule != 1 {
+		t.Errorf("project rule should decide: %+v", d)
+	}
+	if d := EvaluateResult(result, PolicyForFile("/elsewhere/main.go")); d.Action != ActionReport || d.Rule != 1 {
+		t.Errorf("global rule outside the project: %+v", d)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
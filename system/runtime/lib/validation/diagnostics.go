@@ -0,0 +1,369 @@
+// METADATA
+//
+// Validator Diagnostics Parsing - CPI-SI Runtime System
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "The simple believeth every word: but the prudent man looketh well to his going." - Proverbs 14:15 (KJV)
+// Principle: Raw tool output is a report to be read carefully - locate each finding precisely
+// Anchor: "Examine everything carefully; hold fast to that which is good." - 1 Thessalonians 5:21 (WEB)
+//
+// CPI-SI Identity
+//
+// Component Type: LIBRARY - Runtime validation support (mid-rung on ladder)
+// Role: Turns validator output text into structured, locatable findings
+// Paradigm: Format recognition over tool identity - one parser covers every tool sharing a format
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn (CPI-SI instance)
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial structured diagnostics
+//
+// Version History:
+//   1.0.0 (2026-10-16) - Diagnostic type, gcc/yamllint/eslint/shellcheck formats, file filtering
+//
+// Purpose & Function
+//
+// Purpose: Give consumers file, line, column, and severity for every validator finding
+// so they can jump to the offending line and tell errors from warnings. Legacy Warnings
+// strings stay alongside for display.
+//
+// Core Design: Line-oriented parser that recognizes the output formats of the validators
+// we already invoke. Most tools share the gcc-style "file:line:col: message" shape; eslint
+// and shellcheck's default formatters are multi-line and tracked with a little state.
+//
+// Formats Recognized:
+//   - gcc-style: go vet, shellcheck -f gcc, cargo --message-format=short
+//   - yamllint -f parsable: "file:line:col: [level] message (rule)"
+//   - eslint stylish: file header line, then indented "line:col  level  message  rule"
+//   - shellcheck tty: "In file line N:", source line, "^-- SCxxxx (level): message"
+//
+// Blocking Status
+//
+// Non-blocking: Unrecognized lines are simply not diagnostics - they remain in Warnings.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	result := validation.ValidateFile("/path/to/file.sh", ".sh")
+//	for _, d := range result.Diagnostics {
+//	    fmt.Printf("%s:%d:%d %s %s\n", d.File, d.Line, d.Column, d.Severity, d.Message)
+//	}
+//
+// Integration Pattern:
+//   1. runValidator() captures validator output
+//   2. parseDiagnostics() extracts Diagnostic entries
+//   3. resolveDiagnosticPaths() anchors relative paths to the validator's working dir
+//   4. filterDiagnosticsByFile() drops other files' findings for project-wide tools
+//
+// Public API:
+//   Diagnostic - structured finding (type only; populated by ValidateFile)
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: path/filepath, regexp, strconv, strings
+//   Internal: None
+//
+// Dependents (What Uses This):
+//   Libraries: syntax.go (runValidator)
+//
+// Health Scoring
+//
+// Supporting parser - included in ValidateFile's execution scoring.
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"path/filepath" // Relative diagnostic paths → absolute, file matching
+	"regexp"        // Output format recognition
+	"strconv"       // Line/column number conversion
+	"strings"       // Line splitting and message cleanup
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+// Normalized severities. Tools use many spellings (note, style, help, info);
+// consumers only need to tell these three apart.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+)
+
+// Output format patterns, one per recognized shape.
+var (
+	// gcc-style location prefix: "file:line:col: rest" (column optional)
+	gccLocationPattern = regexp.MustCompile(`^(.+?):(\d+):(?:(\d+):)?\s*(.*)$`)
+
+	// Severity prefix inside a gcc-style message: "warning: ...", "error[E0425]: ..."
+	gccSeverityPattern = regexp.MustCompile(`^(error|warning|note|info|style|help)(?:\[([^\]]+)\])?:\s*(.*)$`)
+
+	// yamllint parsable severity: "[warning] message (rule)"
+	yamllintSeverityPattern = regexp.MustCompile(`^\[(error|warning)\]\s*(.*)$`)
+
+	// eslint stylish detail row: "  3:1  warning  Unexpected console statement  no-console"
+	eslintRowPattern = regexp.MustCompile(`^\s+(\d+):(\d+)\s+(error|warning)\s+(.*)$`)
+
+	// Trailing eslint rule id, separated from the message by two or more spaces
+	eslintRulePattern = regexp.MustCompile(`^(.*?)\s{2,}(\S+)$`)
+
+	// shellcheck tty location header: "In deploy.sh line 3:"
+	shellcheckHeaderPattern = regexp.MustCompile(`^In (.+) line (\d+):$`)
+
+	// shellcheck tty finding under the caret: "^-- SC2086 (info): Double quote ..."
+	shellcheckCaretPattern = regexp.MustCompile(`^(\s*)\^-*\^?\s+(SC\d+)\s+\((\w+)\):\s*(.*)$`)
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// Diagnostic is one locatable validator finding.
+//
+// Line and Column are 1-based; Column is 0 when the tool doesn't report one.
+// File is absolute once ValidateFile has resolved it.
+type Diagnostic struct {
+	File     string `json:"file"`             // File the finding is in
+	Line     int    `json:"line"`             // 1-based line number
+	Column   int    `json:"column,omitempty"` // 1-based column (0 if unknown)
+	Severity string `json:"severity"`         // SeverityError, SeverityWarning, or SeverityInfo
+	Message  string `json:"message"`          // Finding text, with rule/code suffix when the tool gives one
+	Tool     string `json:"tool"`             // Validator that reported it (e.g., "go_vet")
+
+	source string // Output line this came from - lets Warnings be filtered in step
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Core Operations
+//   ├── parseDiagnostics() → uses parseGCCLine(), eslint/shellcheck patterns, normalizeSeverity()
+//   ├── resolveDiagnosticPaths() → pure function
+//   └── filterDiagnosticsByFile() → pure function
+//
+//   Helpers
+//   ├── parseGCCLine() → uses normalizeSeverity()
+//   └── normalizeSeverity() → pure function
+
+// ────────────────────────────────────────────────────────────────
+// HELPERS: Format Pieces
+// ────────────────────────────────────────────────────────────────
+
+// normalizeSeverity maps tool-specific levels onto error/warning/info.
+// Empty input yields fallback (the tool's configured severity).
+func normalizeSeverity(level, fallback string) string {
+	switch strings.ToLower(level) {
+	case "error", "fatal":
+		return SeverityError
+	case "warning", "warn":
+		return SeverityWarning
+	case "note", "info", "style", "help":
+		return SeverityInfo
+	case "":
+		if fallback == "" {
+			return SeverityError // Unlabeled findings from a failing tool are errors
+		}
+		return normalizeSeverity(fallback, SeverityError)
+	}
+	return SeverityWarning
+}
+
+// parseGCCLine parses "file:line:col: [severity:] message" and its yamllint variant.
+//
+// Returns ok=false for lines that don't carry a location. A purely numeric
+// "file" part (e.g., a "12:30:45" timestamp) is rejected.
+func parseGCCLine(line, defaultSeverity string) (Diagnostic, bool) {
+	match := gccLocationPattern.FindStringSubmatch(line)
+	if match == nil || strings.TrimLeft(match[1], "0123456789") == "" {
+		return Diagnostic{}, false
+	}
+
+	d := Diagnostic{File: match[1], source: line}
+	d.Line, _ = strconv.Atoi(match[2])
+	d.Column, _ = strconv.Atoi(match[3]) // Empty column → 0
+
+	message := match[4]
+	level := ""
+	if sev := gccSeverityPattern.FindStringSubmatch(message); sev != nil {
+		level, message = sev[1], sev[3]
+		if sev[2] != "" {
+			message += " [" + sev[2] + "]" // Keep cargo's error code (E0425)
+		}
+	} else if sev := yamllintSeverityPattern.FindStringSubmatch(message); sev != nil {
+		level, message = sev[1], sev[2]
+	}
+
+	d.Severity = normalizeSeverity(level, defaultSeverity)
+	d.Message = strings.TrimSpace(message)
+	return d, true
+}
+
+// ────────────────────────────────────────────────────────────────
+// CORE OPERATIONS: Parsing & Filtering
+// ────────────────────────────────────────────────────────────────
+
+// parseDiagnostics extracts structured findings from raw validator output.
+//
+// Parameters:
+//   - output: Combined stdout/stderr, untrimmed (indentation matters for eslint and shellcheck)
+//   - tool: Validator name stamped on every Diagnostic
+//   - defaultSeverity: Severity for findings the tool doesn't label (e.g., go vet)
+//
+// Returns:
+//   - Diagnostics in output order; nil when nothing recognizable was printed
+func parseDiagnostics(output, tool, defaultSeverity string) []Diagnostic {
+	var diagnostics []Diagnostic
+
+	eslintFile := ""                        // Current eslint stylish file section
+	shellcheckFile, shellcheckLine := "", 0 // Pending shellcheck tty location
+	shellcheckSource := ""                  // Source line echoed under the tty header
+
+	for _, raw := range strings.Split(output, "\n") {
+		raw = strings.TrimRight(raw, "\r")
+		line := strings.TrimSpace(raw)
+		if line == "" {
+			continue
+		}
+
+		// shellcheck tty: header, echoed source, then one caret row per finding
+		if match := shellcheckHeaderPattern.FindStringSubmatch(line); match != nil {
+			shellcheckFile = match[1]
+			shellcheckLine, _ = strconv.Atoi(match[2])
+			shellcheckSource = ""
+			continue
+		}
+		if shellcheckFile != "" {
+			if match := shellcheckCaretPattern.FindStringSubmatch(raw); match != nil {
+				diagnostics = append(diagnostics, Diagnostic{
+					File:     shellcheckFile,
+					Line:     shellcheckLine,
+					Column:   len(match[1]) + 1, // Caret sits under the offending column
+					Severity: normalizeSeverity(match[3], defaultSeverity),
+					Message:  strings.TrimSpace(match[4]) + " [" + match[2] + "]",
+					Tool:     tool,
+					source:   line,
+				})
+				continue
+			}
+			if shellcheckSource == "" {
+				shellcheckSource = raw // First line after the header echoes the script
+				continue
+			}
+		}
+
+		// eslint stylish: indented rows under an unindented file path
+		if eslintFile != "" {
+			if match := eslintRowPattern.FindStringSubmatch(raw); match != nil {
+				d := Diagnostic{File: eslintFile, Tool: tool, source: line}
+				d.Line, _ = strconv.Atoi(match[1])
+				d.Column, _ = strconv.Atoi(match[2])
+				d.Severity = normalizeSeverity(match[3], defaultSeverity)
+				d.Message = strings.TrimSpace(match[4])
+				if rule := eslintRulePattern.FindStringSubmatch(d.Message); rule != nil {
+					d.Message = rule[1] + " (" + rule[2] + ")"
+				}
+				diagnostics = append(diagnostics, d)
+				continue
+			}
+		}
+
+		// gcc-style and yamllint parsable (single-line, location first)
+		if d, ok := parseGCCLine(line, defaultSeverity); ok {
+			d.Tool = tool
+			diagnostics = append(diagnostics, d)
+			continue
+		}
+
+		// An unindented, location-free line can only open an eslint file section
+		if raw == line && (filepath.IsAbs(line) || strings.ContainsAny(line, `/\`)) && !strings.Contains(line, " ") {
+			eslintFile = line
+		}
+	}
+
+	return diagnostics
+}
+
+// resolveDiagnosticPaths makes relative diagnostic paths absolute.
+//
+// Validators report paths relative to where they ran (cargo: project root,
+// go vet: caller's cwd), so baseDir must be the command's working directory.
+func resolveDiagnosticPaths(diagnostics []Diagnostic, baseDir string) {
+	for i := range diagnostics {
+		if diagnostics[i].File != "" && !filepath.IsAbs(diagnostics[i].File) {
+			diagnostics[i].File = filepath.Join(baseDir, diagnostics[i].File)
+		}
+	}
+}
+
+// filterDiagnosticsByFile keeps only findings for filePath.
+//
+// Used for project-wide validators (cargo check) when config.filter_by_file is
+// set. Returns the kept diagnostics and the output lines of dropped ones, so
+// the caller can remove the same findings from the legacy Warnings strings.
+func filterDiagnosticsByFile(diagnostics []Diagnostic, filePath string) (kept []Diagnostic, dropped map[string]bool) {
+	target := filepath.Clean(filePath)
+	dropped = make(map[string]bool)
+	for _, d := range diagnostics {
+		if filepath.Clean(d.File) == target {
+			kept = append(kept, d)
+		} else {
+			dropped[d.source] = true
+		}
+	}
+	return kept, dropped
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Code Validation: Testing Requirements
+// ────────────────────────────────────────────────────────────────
+//
+// Testing Requirements:
+//   - diagnostics_test.go parses captured real output per format (testdata/diagnostics)
+//   - Run: go test ./...
+//
+// Adding a format: capture real tool output into testdata/diagnostics, add the
+// expected Diagnostics to the test table, then extend parseDiagnostics().
+
+// ────────────────────────────────────────────────────────────────
+// Code Execution: None (Library)
+// ────────────────────────────────────────────────────────────────
+//
+// Used by runValidator() in syntax.go; no entry point of its own.
+
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,128 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Validator Diagnostics Tests - Output format parsing
+//
+// Purpose: Prove parseDiagnostics() locates findings in the output formats of
+//          the validators we invoke, using captured output in testdata/diagnostics,
+//          and that project-wide findings narrow to the validated file.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// loadFixture reads captured validator output from testdata/diagnostics.
+func loadFixture(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "diagnostics", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+// TestParseDiagnosticsFormats checks each fixture parses to the expected findings.
+func TestParseDiagnosticsFormats(t *testing.T) {
+	cases := []struct {
+		fixture  string
+		tool     string
+		severity string
+		want     []Diagnostic
+	}{
+		{"go_vet.txt", "go_vet", "error", []Diagnostic{
+			{File: "main.go", Line: 7, Column: 17, Severity: SeverityError, Message: "fmt.Printf format %s reads arg #2, but call has 1 arg"},
+			{File: "main.go", Line: 8, Column: 2, Severity: SeverityError, Message: "fmt.Println arg list ends with redundant newline"},
+			{File: "pkg/p.go", Line: 5, Column: 24, Severity: SeverityError, Message: "fmt.Printf format %d reads arg #1, but call has 0 args"},
+		}},
+		{"shellcheck_gcc.txt", "shellcheck", "warning", []Diagnostic{
+			{File: "deploy.sh", Line: 3, Column: 6, Severity: SeverityInfo, Message: "Double quote to prevent globbing and word splitting. [SC2086]"},
+			{File: "deploy.sh", Line: 5, Column: 1, Severity: SeverityWarning, Message: "unused appears unused. Verify use (or export if used externally). [SC2034]"},
+			{File: "deploy.sh", Line: 8, Column: 1, Severity: SeverityError, Message: "Couldn't parse this if expression. Fix to allow more checks. [SC1073]"},
+		}},
+		{"shellcheck_tty.txt", "shellcheck", "warning", []Diagnostic{
+			{File: "deploy.sh", Line: 3, Column: 6, Severity: SeverityInfo, Message: "Double quote to prevent globbing and word splitting. [SC2086]"},
+			{File: "deploy.sh", Line: 5, Column: 1, Severity: SeverityWarning, Message: "unused appears unused. Verify use (or export if used externally). [SC2034]"},
+		}},
+		{"eslint_stylish.txt", "eslint", "warning", []Diagnostic{
+			{File: "/home/user/project/src/app.js", Line: 1, Column: 7, Severity: SeverityError, Message: "'unused' is assigned a value but never used (no-unused-vars)"},
+			{File: "/home/user/project/src/app.js", Line: 3, Column: 1, Severity: SeverityWarning, Message: "Unexpected console statement (no-console)"},
+			{File: "/home/user/project/src/app.js", Line: 5, Column: 14, Severity: SeverityError, Message: "Parsing error: Unexpected token )"},
+		}},
+		{"yamllint_parsable.txt", "yamllint", "warning", []Diagnostic{
+			{File: "config.yaml", Line: 1, Column: 1, Severity: SeverityWarning, Message: `missing document start "---" (document-start)`},
+			{File: "config.yaml", Line: 4, Column: 81, Severity: SeverityError, Message: "line too long (95 > 80 characters) (line-length)"},
+			{File: "config.yaml", Line: 7, Column: 3, Severity: SeverityError, Message: "wrong indentation: expected 4 but found 2 (indentation)"},
+		}},
+		{"cargo_short.txt", "cargo_check", "error", []Diagnostic{
+			{File: "src/main.rs", Line: 4, Column: 20, Severity: SeverityError, Message: "cannot find value `y` in this scope: help: a local variable with a similar name exists: `x` [E0425]"},
+			{File: "src/util.rs", Line: 2, Column: 9, Severity: SeverityWarning, Message: "unused variable: `unused`: help: if this is intentional, prefix it with an underscore: `_unused`"},
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.fixture, func(t *testing.T) {
+			got := parseDiagnostics(loadFixture(t, tc.fixture), tc.tool, tc.severity)
+			if len(got) != len(tc.want) {
+				t.Fatalf("got %d diagnostics, want %d: %+v", len(got), len(tc.want), got)
+			}
+			for i, want := range tc.want {
+				want.Tool = tc.tool
+				got[i].source = "" // Internal bookkeeping, not part of the contract
+				if got[i] != want {
+					t.Errorf("diagnostic %d:\n got  %+v\n want %+v", i, got[i], want)
+				}
+			}
+		})
+	}
+}
+
+// TestFilterByFileProjectWide checks cargo's project-wide output narrows to one file.
+func TestFilterByFileProjectWide(t *testing.T) {
+	output := loadFixture(t, "cargo_short.txt")
+	root := filepath.FromSlash("/work/demo")
+
+	result := &ToolResult{
+		Valid:       false,
+		Warnings:    parseValidatorOutput(output, "rust"),
+		Diagnostics: parseDiagnostics(output, "cargo_check", "error"),
+	}
+	resolveDiagnosticPaths(result.Diagnostics, root)
+	filterByFile(result, filepath.Join(root, "src", "util.rs"))
+
+	if len(result.Diagnostics) != 1 || result.Diagnostics[0].Line != 2 {
+		t.Fatalf("util.rs diagnostics = %+v", result.Diagnostics)
+	}
+	for _, warning := range result.Warnings {
+		if warning == result.Diagnostics[0].source {
+			continue
+		}
+		if d, ok := parseGCCLine(warning, ""); ok {
+			t.Errorf("other file's finding kept in Warnings: %q (%s)", warning, d.File)
+		}
+	}
+
+	// Findings only in another file: this file passes
+	clean := &ToolResult{
+		Valid:       false,
+		Warnings:    parseValidatorOutput(output, "rust"),
+		Diagnostics: parseDiagnostics(output, "cargo_check", "error"),
+	}
+	resolveDiagnosticPaths(clean.Diagnostics, root)
+	filterByFile(clean, filepath.Join(root, "src", "lib.rs"))
+	if !clean.Valid || len(clean.Warnings) != 0 || len(clean.Diagnostics) != 0 {
+		t.Errorf("lib.rs: Valid=%v Warnings=%q Diagnostics=%+v", clean.Valid, clean.Warnings, clean.Diagnostics)
+	}
+}
@@ -0,0 +1,218 @@
+// METADATA
+//
+// Validator Environment - CPI-SI Runtime System
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "To every thing there is a season, and a time to every purpose under the heaven" - Ecclesiastes 3:1 (KJV)
+// Principle: A tool works where it was set up to work - give it its place
+// Anchor: "Let all things be done decently and in order." - 1 Corinthians 14:40 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: LIBRARY - Runtime validation support (mid-rung on ladder)
+// Role: Gives each validator the environment its project set it up with
+// Paradigm: Installed per project is installed - availability and execution agree
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn (CPI-SI instance)
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial per-validator environment
+//
+// Version History:
+//   1.0.0 (2026-10-16) - ValidatorTool "env" and "path_prepend", {project_root}/{home} tokens
+//
+// Purpose & Function
+//
+// Purpose: Validators ran with whatever environment the hook inherited, so
+// tools installed per project looked missing - eslint in node_modules/.bin,
+// linters inside a Python venv, cargo needing RUSTUP_HOME. Two optional
+// ValidatorTool fields fix that:
+//   - "env": extra variables, e.g. {"RUSTUP_HOME": "{home}/.rustup"}
+//   - "path_prepend": directories put in front of PATH, first listed first,
+//     e.g. ["{project_root}/node_modules/.bin", "{project_root}/.venv/bin"]
+//
+// {project_root} is findProjectRoot() of the file being validated (the working
+// directory for CheckAllValidators); {home} is $HOME. A relative path_prepend
+// entry is taken relative to the project root. An "env" PATH replaces the
+// inherited PATH before path_prepend is applied.
+//
+// Core Design: toolEnvironment builds the environment once per file; both the
+// availability check and buildValidatorCommand use it, and the command is
+// looked up on the augmented PATH (exec.Command would search the hook's own),
+// so a tool is never skipped as missing when it would have run - or run when
+// it was reported missing. Tools with neither field inherit the environment
+// unchanged and are looked up exactly as before.
+//
+// Blocking Status
+//
+// Non-blocking: A prepended directory that doesn't exist is simply not searched.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: os, os/exec, path/filepath, strings
+//   Package Files: syntax.go (ValidatorTool, findProjectRoot)
+//
+// Dependents (What Uses This):
+//   Libraries: syntax.go (checkAvailability, probeAvailability, buildValidatorCommand)
+//
+// Health Scoring
+//
+// None of its own - a tool that still can't be found is scored as missing by syntax.go.
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"os"            // Inherited environment, $HOME, working directory
+	"os/exec"       // Executable check for each PATH candidate
+	"path/filepath" // PATH splitting and joining
+	"strings"       // Token substitution, KEY=value handling
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+// Tokens substituted in env values and path_prepend entries.
+const (
+	tokenProjectRoot = "{project_root}"
+	tokenHome        = "{home}"
+)
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Core Operations (used by syntax.go)
+//   ├── toolProjectRoot(filePath) → findProjectRoot(), or the working directory
+//   ├── toolEnvironment(tool, projectRoot) → uses expandToolTokens()
+//   └── lookToolPath(command, env) → uses envValue(), exec.LookPath per candidate
+//
+//   Helpers
+//   ├── expandToolTokens(value, projectRoot) → pure function
+//   └── envValue(env, key) → last KEY= entry wins, as exec does
+
+// expandToolTokens substitutes {project_root} and {home} in value.
+func expandToolTokens(value, projectRoot string) string {
+	return strings.NewReplacer(tokenProjectRoot, projectRoot, tokenHome, os.Getenv("HOME")).Replace(value)
+}
+
+// envValue returns key's value in env (the last entry wins, as exec.Cmd does).
+func envValue(env []string, key string) string {
+	for i := len(env) - 1; i >= 0; i-- {
+		if name, value, ok := strings.Cut(env[i], "="); ok && name == key {
+			return value
+		}
+	}
+	return ""
+}
+
+// toolProjectRoot is what {project_root} means for a file ("" = the working directory).
+func toolProjectRoot(filePath string) string {
+	if filePath == "" {
+		dir, _ := os.Getwd()
+		return dir
+	}
+	return findProjectRoot(filePath)
+}
+
+// toolEnvironment returns the environment tool runs with.
+//
+// Returns nil when the tool sets neither env nor path_prepend - the command
+// inherits the hook's environment, as it always has.
+func toolEnvironment(tool *ValidatorTool, projectRoot string) []string {
+	if len(tool.Env) == 0 && len(tool.PathPrepend) == 0 {
+		return nil
+	}
+
+	env := os.Environ()
+	for key, value := range tool.Env {
+		env = append(env, key+"="+expandToolTokens(value, projectRoot))
+	}
+	if len(tool.PathPrepend) > 0 {
+		var dirs []string
+		for _, dir := range tool.PathPrepend {
+			dir = expandToolTokens(dir, projectRoot)
+			if !filepath.IsAbs(dir) {
+				dir = filepath.Join(projectRoot, dir)
+			}
+			dirs = append(dirs, dir)
+		}
+		if path := envValue(env, "PATH"); path != "" {
+			dirs = append(dirs, path)
+		}
+		env = append(env, "PATH="+strings.Join(dirs, string(filepath.ListSeparator)))
+	}
+	return env
+}
+
+// lookToolPath finds command the way it will be run with env.
+//
+// With a nil env this is exec.LookPath. Otherwise each directory of env's
+// PATH is searched in order - exec.Command resolves names against the hook's
+// own PATH, which path_prepend never touched.
+func lookToolPath(command string, env []string) (string, error) {
+	if env == nil || strings.ContainsRune(command, '/') || strings.ContainsRune(command, filepath.Separator) {
+		return exec.LookPath(command)
+	}
+	for _, dir := range filepath.SplitList(envValue(env, "PATH")) {
+		if dir == "" {
+			dir = "."
+		}
+		if path, err := exec.LookPath(filepath.Join(dir, command)); err == nil {
+			return path, nil
+		}
+	}
+	return "", &exec.Error{Name: command, Err: exec.ErrNotFound}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - A fake tool only reachable through path_prepend is available, runs, and
+//     sees its env; without path_prepend it is skipped as missing
+//   - Run: go test ./... (env_test.go)
+//
+// Code Execution: None (Library) - applied per tool run by syntax.go
+//
+// Modification Policy:
+//   ✅ Safe: New tokens in expandToolTokens() (env and path_prepend both get them)
+//   ⚠️ Care: Anything changing the environment must go through toolEnvironment() - the
+//           availability check and the run must see the same one
+//   ❌ Never: Looking a path_prepend tool up with plain exec.LookPath - it reads the hook's PATH
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
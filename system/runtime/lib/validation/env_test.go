@@ -0,0 +1,120 @@
+//go:build linux
+
+// ============================================================================
+// METADATA
+// ============================================================================
+// Validator Environment Tests
+//
+// Purpose: Prove a tool only findable through path_prepend is reported
+//          available and actually runs, with its env values and
+//          {project_root}/{home} tokens substituted, and that the same tool
+//          without path_prepend is skipped as missing - the availability check
+//          and the run agree.
+//
+// Linux-only: the fake tool is a /bin/sh script.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fakeToolName is on no real PATH - only path_prepend can find it
+const fakeToolName = "cpi-si-env-lint"
+
+// useProjectTool writes a project (go.mod marker) holding the file to check
+// and bin/<fakeToolName>, and configures the fake validator to run it.
+func useProjectTool(t *testing.T, tool ValidatorTool) (projectRoot, file string) {
+	t.Helper()
+	projectRoot = t.TempDir()
+	bin := filepath.Join(projectRoot, "bin")
+	if err := os.Mkdir(bin, 0755); err != nil {
+		t.Fatal(err)
+	}
+	script := "#!/bin/sh\necho \"lint:1: LINT_MODE=$LINT_MODE CACHE=$LINT_CACHE\"\nexit 1\n"
+	if err := os.WriteFile(filepath.Join(bin, fakeToolName), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(projectRoot, "go.mod"), []byte("module fake\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(projectRoot, "pkg"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	file = filepath.Join(projectRoot, "pkg", "input.fake")
+	if err := os.WriteFile(file, []byte("content\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	useFakeValidator(t, "unused", 5)
+	validatorsConfig.Extensions = map[string]string{".fake": "fake"}
+	tool.Command, tool.Enabled = fakeToolName, true
+	validatorsConfig.Validators["fake"].Validators["fake_sleep"] = tool
+	t.Cleanup(func() { availabilityCache = map[string]ToolStatus{} })
+	return projectRoot, file
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestPathPrependFindsProjectTool(t *testing.T) {
+	t.Setenv("HOME", "/home/tester")
+	_, file := useProjectTool(t, ValidatorTool{
+		PathPrepend: []string{"{project_root}/bin"},
+		Env:         map[string]string{"LINT_MODE": "strict", "LINT_CACHE": "{home}/.cache/lint"},
+	})
+
+	result := ValidateFile(file, ".fake")
+	if len(result.Skipped) != 0 {
+		t.Fatalf("tool on path_prepend skipped: %+v", result.Skipped)
+	}
+	want := "lint:1: LINT_MODE=strict CACHE=/home/tester/.cache/lint"
+	if result.Valid || len(result.Warnings) != 1 || result.Warnings[0] != want {
+		t.Errorf("Valid=%v Warnings=%q, want one finding %q", result.Valid, result.Warnings, want)
+	}
+}
+
+func TestRelativePathPrependUsesProjectRoot(t *testing.T) {
+	_, file := useProjectTool(t, ValidatorTool{PathPrepend: []string{"bin"}})
+
+	if status := checkAvailability(activeConfig(), "fake", "fake_sleep", file); !status.Available {
+		t.Errorf("relative path_prepend: unavailable (%s)", status.Reason)
+	}
+}
+
+func TestToolWithoutPathPrependIsMissing(t *testing.T) {
+	_, file := useProjectTool(t, ValidatorTool{Env: map[string]string{"LINT_MODE": "strict"}})
+
+	result := ValidateFile(file, ".fake")
+	if len(result.Skipped) != 1 || !result.Skipped[0].Missing {
+		t.Fatalf("tool off PATH: Skipped=%+v, want it skipped as missing", result.Skipped)
+	}
+	if !strings.HasPrefix(result.Skipped[0].Reason, fakeToolName+" not installed") {
+		t.Errorf("skip reason = %q", result.Skipped[0].Reason)
+	}
+}
+
+func TestToolEnvironmentInheritsWhenUnset(t *testing.T) {
+	if env := toolEnvironment(&ValidatorTool{Command: "go"}, "/project"); env != nil {
+		t.Errorf("tool without env/path_prepend got an environment of %d entries", len(env))
+	}
+
+	t.Setenv("PATH", "/usr/bin")
+	env := toolEnvironment(&ValidatorTool{PathPrepend: []string{"{project_root}/a", "/b"}}, "/project")
+	if got := envValue(env, "PATH"); got != "/project/a:/b:/usr/bin" {
+		t.Errorf("PATH = %q, want prepended in order", got)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
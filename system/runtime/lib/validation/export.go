@@ -0,0 +1,501 @@
+// METADATA
+//
+// Validation Result Export - CPI-SI Runtime System
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Write the vision, and make it plain upon tables, that he may run that readeth it." - Habakkuk 2:2 (KJV)
+// Principle: Findings are only useful where the reader is - the editor and the CI log, not just the terminal
+// Anchor: "Let all things be done decently and in order." - 1 Corinthians 14:40 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: LIBRARY - Runtime validation support (mid-rung on ladder)
+// Role: Serializes ValidationResult and BatchResult as JSON and SARIF 2.1.0
+// Paradigm: Same findings as the terminal report, in formats tools can read
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn (CPI-SI instance)
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial JSON/SARIF export
+//
+// Version History:
+//   1.0.0 (2026-10-16) - JSON(), SARIF(), WriteResults, config.results_path for the post-use hook
+//
+// Purpose & Function
+//
+// Purpose: Validation results were terminal text only. Editors (problem panes) and
+// CI (code-scanning annotations) read SARIF; scripts read JSON.
+//
+// Core Design:
+//   - JSON: the result structs marshaled as-is (json tags in syntax.go, batch.go,
+//     diagnostics.go)
+//   - SARIF: one run per validator tool across every file, each result with ruleId =
+//     tool name, level from severity (error → error, warning → warning, info → note),
+//     and a physicalLocation from the Diagnostic (file URI, line, column)
+//   - Warning lines no Diagnostic accounts for (legacy string-only output) become
+//     message-only results - never dropped. Their level follows the tool's verdict.
+//   - Skipped validators get a run whose invocation failed, with the skip reason as
+//     a notification, so "shellcheck not installed" reaches the editor too
+//   - WriteResults writes through a temp file and rename - a watcher never reads half a file
+//
+// Configuration (validators.jsonc config, project overridable):
+//   - results_path: also write each post-use validation here ("" = off, ~ expanded)
+//   - results_format: "sarif" (default) or "json"
+//
+// Blocking Status
+//
+// Non-blocking: Serialization is pure; write failures return an error the hook
+// reports as a warning.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: encoding/json, fmt, net/url, os, path/filepath, strings
+//   Package Files: syntax.go (ValidationResult, ToolResult), batch.go (BatchResult),
+//                  diagnostics.go (Diagnostic, severities), project.go (configForFile)
+//
+// Dependents (What Uses This):
+//   Hooks: tool/post-use (WriteConfiguredResults)
+//
+// Health Scoring
+//
+// None - export only, no Rails logging.
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"encoding/json" // JSON and SARIF encoding
+	"fmt"           // Error wrapping
+	"net/url"       // file:// artifact URIs
+	"os"            // Results file writing, HOME
+	"path/filepath" // Absolute paths, temp file beside the target
+	"strings"       // Warning line matching, ~ expansion
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+// Result formats accepted by WriteResults and config.results_format.
+const (
+	ResultsFormatJSON  = "json"
+	ResultsFormatSARIF = "sarif"
+)
+
+// SARIF document constants (OASIS SARIF 2.1.0).
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - SARIF Document
+// ────────────────────────────────────────────────────────────────
+// Only the SARIF properties this package fills in.
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool        sarifTool         `json:"tool"`
+	Invocations []sarifInvocation `json:"invocations,omitempty"`
+	Results     []sarifResult     `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules,omitempty"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifInvocation struct {
+	ExecutionSuccessful        bool                `json:"executionSuccessful"`
+	ToolExecutionNotifications []sarifNotification `json:"toolExecutionNotifications,omitempty"`
+}
+
+type sarifNotification struct {
+	Level   string       `json:"level"`
+	Message sarifMessage `json:"message"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs
+//   ├── ValidationResult.JSON() / BatchResult.JSON() → json.MarshalIndent
+//   ├── BatchResult.SARIF() → uses buildSARIF()
+//   ├── ValidationResult.SARIF() → BatchResult.SARIF() over one file
+//   ├── ValidationResult.WriteResults() / BatchResult.WriteResults() → uses writeResultsFile()
+//   └── ValidationResult.WriteConfiguredResults() → uses configForFile(), expandResultsPath(), WriteResults()
+//
+//   Helpers
+//   ├── buildSARIF() → uses sarifToolResults(), sarifLevel(), sarifLocationFor()
+//   ├── sarifToolResults() → uses sarifLevel(), sarifLocationFor()
+//   ├── sarifLevel() → pure function
+//   ├── sarifLocationFor() → uses artifactURI()
+//   ├── artifactURI() → pure function
+//   ├── expandResultsPath() → reads HOME
+//   └── writeResultsFile() → temp file + rename
+
+// ────────────────────────────────────────────────────────────────
+// HELPERS: SARIF Assembly
+// ────────────────────────────────────────────────────────────────
+
+// sarifLevel maps a Diagnostic severity to a SARIF result level.
+func sarifLevel(severity string) string {
+	switch severity {
+	case SeverityError:
+		return "error"
+	case SeverityInfo:
+		return "note"
+	}
+	return "warning"
+}
+
+// artifactURI renders a path as a SARIF artifact URI: file:// for absolute
+// paths, forward-slashed relative paths otherwise.
+func artifactURI(path string) string {
+	if !filepath.IsAbs(path) {
+		return filepath.ToSlash(path)
+	}
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+}
+
+// sarifLocationFor places a Diagnostic; Line 0 means file-level (no region).
+func sarifLocationFor(d Diagnostic) []sarifLocation {
+	if d.File == "" {
+		return nil
+	}
+	location := sarifLocation{PhysicalLocation: sarifPhysicalLocation{
+		ArtifactLocation: sarifArtifactLocation{URI: artifactURI(d.File)},
+	}}
+	if d.Line > 0 {
+		location.PhysicalLocation.Region = &sarifRegion{StartLine: d.Line, StartColumn: d.Column}
+	}
+	return []sarifLocation{location}
+}
+
+// sarifToolResults converts one tool's findings on one file.
+//
+// Diagnostics become located results. Warning lines that no Diagnostic came
+// from (tool banners, legacy unparsed output) become message-only results at
+// "error" when the tool failed the file, "warning" otherwise.
+func sarifToolResults(tool ToolResult) []sarifResult {
+	var results []sarifResult
+	located := make(map[string]bool, len(tool.Diagnostics))
+	for _, d := range tool.Diagnostics {
+		results = append(results, sarifResult{
+			RuleID:    tool.Validator,
+			Level:     sarifLevel(d.Severity),
+			Message:   sarifMessage{Text: d.Message},
+			Locations: sarifLocationFor(d),
+		})
+		if d.source != "" {
+			located[d.source] = true
+		}
+	}
+
+	level := "warning"
+	if !tool.Valid {
+		level = "error"
+	}
+	for _, warning := range tool.Warnings {
+		text := strings.TrimSpace(warning)
+		if text == "" || located[text] {
+			continue
+		}
+		results = append(results, sarifResult{RuleID: tool.Validator, Level: level, Message: sarifMessage{Text: text}})
+	}
+	return results
+}
+
+// buildSARIF assembles one run per validator, in first-seen order across files.
+//
+// A result with Warnings but no ToolResults (the single-tool path) is treated
+// as one tool named after result.Validator.
+func buildSARIF(files []*ValidationResult) sarifLog {
+	doc := sarifLog{Schema: sarifSchema, Version: sarifVersion, Runs: []sarifRun{}}
+	runIndex := map[string]int{}
+
+	runFor := func(name string) *sarifRun {
+		if name == "" {
+			name = "validation"
+		}
+		if i, exists := runIndex[name]; exists {
+			return &doc.Runs[i]
+		}
+		runIndex[name] = len(doc.Runs)
+		doc.Runs = append(doc.Runs, sarifRun{
+			Tool:    sarifTool{Driver: sarifDriver{Name: name, Rules: []sarifRule{{ID: name}}}},
+			Results: []sarifResult{},
+		})
+		return &doc.Runs[len(doc.Runs)-1]
+	}
+
+	for _, file := range files {
+		if file == nil {
+			continue
+		}
+
+		tools := file.ToolResults
+		if len(tools) == 0 && (len(file.Warnings) > 0 || len(file.Diagnostics) > 0) {
+			tools = []ToolResult{{Validator: file.Validator, Valid: file.Valid, Warnings: file.Warnings, Diagnostics: file.Diagnostics}}
+		}
+		for _, tool := range tools {
+			run := runFor(tool.Validator)
+			for _, result := range sarifToolResults(tool) {
+				if result.RuleID == "" {
+					result.RuleID = run.Tool.Driver.Name
+				}
+				run.Results = append(run.Results, result)
+			}
+		}
+
+		for _, skipped := range file.Skipped {
+			run := runFor(skipped.Validator)
+			run.Invocations = append(run.Invocations, sarifInvocation{
+				ExecutionSuccessful: false,
+				ToolExecutionNotifications: []sarifNotification{{
+					Level:   "note",
+					Message: sarifMessage{Text: skipped.Reason},
+				}},
+			})
+		}
+	}
+	return doc
+}
+
+// ────────────────────────────────────────────────────────────────
+// HELPERS: Files
+// ────────────────────────────────────────────────────────────────
+
+// expandResultsPath expands a leading ~/ to $HOME.
+func expandResultsPath(path string) string {
+	if strings.HasPrefix(path, "~/") {
+		return filepath.Join(os.Getenv("HOME"), path[2:])
+	}
+	return path
+}
+
+// writeResultsFile replaces path with data through a temp file in the same
+// directory, creating the directory if needed.
+func writeResultsFile(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create results directory: %w", err)
+	}
+
+	temp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("create results file: %w", err)
+	}
+	defer os.Remove(temp.Name()) // No-op after a successful rename
+
+	if _, err := temp.Write(append(data, '\n')); err != nil {
+		temp.Close()
+		return fmt.Errorf("write results file: %w", err)
+	}
+	if err := temp.Close(); err != nil {
+		return fmt.Errorf("write results file: %w", err)
+	}
+	if err := os.Chmod(temp.Name(), 0644); err != nil {
+		return fmt.Errorf("write results file: %w", err)
+	}
+	if err := os.Rename(temp.Name(), path); err != nil {
+		return fmt.Errorf("replace results file: %w", err)
+	}
+	return nil
+}
+
+// ────────────────────────────────────────────────────────────────
+// PUBLIC API: Serialization
+// ────────────────────────────────────────────────────────────────
+
+// JSON returns the result as indented JSON (file, valid, severity, warnings,
+// diagnostics, per-tool results, skipped validators).
+func (v *ValidationResult) JSON() ([]byte, error) {
+	return json.MarshalIndent(v, "", "  ")
+}
+
+// JSON returns the batch as indented JSON - per-file results plus totals.
+func (b *BatchResult) JSON() ([]byte, error) {
+	return json.MarshalIndent(b, "", "  ")
+}
+
+// SARIF returns the batch as a SARIF 2.1.0 log: one run per validator tool,
+// results located by file, line, and column where the tool reported them.
+//
+// Example:
+//   batch := validation.ValidateDir(".", validation.DirOptions{})
+//   data, _ := batch.SARIF()
+//   os.WriteFile("validation.sarif", data, 0644) // Upload to code scanning
+func (b *BatchResult) SARIF() ([]byte, error) {
+	var files []*ValidationResult
+	if b != nil {
+		files = b.Files
+	}
+	return json.MarshalIndent(buildSARIF(files), "", "  ")
+}
+
+// SARIF returns this one file's result as a SARIF 2.1.0 log.
+func (v *ValidationResult) SARIF() ([]byte, error) {
+	return (&BatchResult{Files: []*ValidationResult{v}}).SARIF()
+}
+
+// ────────────────────────────────────────────────────────────────
+// PUBLIC API: Writing
+// ────────────────────────────────────────────────────────────────
+
+// WriteResults writes the result to path as format ("json" or "sarif").
+//
+// The file is replaced atomically; its directory is created if missing.
+func (v *ValidationResult) WriteResults(path, format string) error {
+	var data []byte
+	var err error
+	switch format {
+	case ResultsFormatJSON:
+		data, err = v.JSON()
+	case ResultsFormatSARIF:
+		data, err = v.SARIF()
+	default:
+		return fmt.Errorf("unknown results format %q (want %q or %q)", format, ResultsFormatJSON, ResultsFormatSARIF)
+	}
+	if err != nil {
+		return err
+	}
+	return writeResultsFile(path, data)
+}
+
+// WriteResults writes the batch to path as format ("json" or "sarif").
+func (b *BatchResult) WriteResults(path, format string) error {
+	var data []byte
+	var err error
+	switch format {
+	case ResultsFormatJSON:
+		data, err = b.JSON()
+	case ResultsFormatSARIF:
+		data, err = b.SARIF()
+	default:
+		return fmt.Errorf("unknown results format %q (want %q or %q)", format, ResultsFormatJSON, ResultsFormatSARIF)
+	}
+	if err != nil {
+		return err
+	}
+	return writeResultsFile(path, data)
+}
+
+// WriteConfiguredResults writes the result where config.results_path says.
+//
+// Honors project overrides for the validated file. Returns nil without
+// writing when results_path is unset or there is no result; results_format
+// defaults to SARIF.
+func (v *ValidationResult) WriteConfiguredResults() error {
+	if v == nil {
+		return nil
+	}
+	cfg := configForFile(v.FilePath)
+	if cfg == nil || cfg.Config.ResultsPath == "" {
+		return nil
+	}
+
+	format := cfg.Config.ResultsFormat
+	if format == "" {
+		format = ResultsFormatSARIF
+	}
+	return v.WriteResults(expandResultsPath(cfg.Config.ResultsPath), format)
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Code Validation: Testing Requirements
+// ────────────────────────────────────────────────────────────────
+//
+// Testing Requirements:
+//   - SARIF: one run per tool across files, levels mapped, file/line/column located
+//   - Unparsed warning lines survive as message-only results; skipped tools as notifications
+//   - JSON round-trips the result fields
+//   - WriteConfiguredResults writes only when results_path is set
+//   - Run: go test ./... (export_test.go)
+
+// ────────────────────────────────────────────────────────────────
+// Code Execution: None (Library)
+// ────────────────────────────────────────────────────────────────
+//
+// Called by the tool/post-use hook after each validation; no entry point of its own.
+
+// ============================================================================
+// END CLOSING
+// ============================================================================
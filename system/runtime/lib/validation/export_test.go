@@ -0,0 +1,164 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Validation Result Export Tests
+//
+// Purpose: Prove SARIF output groups findings into one run per tool with
+//          mapped levels and file/line/column locations, keeps unparsed
+//          warning lines as message-only results, that JSON carries the
+//          result fields, and that results_path gates the hook's write.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// exportFixture is two files: one with located go_vet and staticcheck
+// findings plus an unparsed banner line, one with a skipped validator.
+func exportFixture() *BatchResult {
+	vet := Diagnostic{File: "/repo/main.go", Line: 7, Column: 17, Severity: SeverityError, Message: "printf arg mismatch", Tool: "go_vet", source: "main.go:7:17: printf arg mismatch"}
+	style := Diagnostic{File: "/repo/main.go", Line: 3, Severity: SeverityInfo, Message: "should omit type [ST1023]", Tool: "staticcheck", source: "main.go:3: should omit type [ST1023]"}
+
+	return &BatchResult{Files: []*ValidationResult{
+		{
+			FilePath: "/repo/main.go", Language: "go", Severity: SeverityError,
+			ToolResults: []ToolResult{
+				{Validator: "go_vet", Warnings: []string{"# example.com/repo", vet.source}, Diagnostics: []Diagnostic{vet}},
+				{Validator: "staticcheck", Valid: true, Warnings: []string{style.source}, Diagnostics: []Diagnostic{style}},
+			},
+			Diagnostics: []Diagnostic{vet, style},
+		},
+		{
+			FilePath: "/repo/run.sh", Language: "shell", Valid: true, Severity: SeverityClean,
+			Skipped: []SkippedValidator{{Validator: "shellcheck", Reason: "shellcheck not installed"}},
+		},
+	}}
+}
+
+func TestBatchSARIF(t *testing.T) {
+	data, err := exportFixture().SARIF()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc sarifLog
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("not JSON: %v\n%s", err, data)
+	}
+	if doc.Version != "2.1.0" || len(doc.Runs) != 3 {
+		t.Fatalf("version %q, %d runs; want 2.1.0 with go_vet, staticcheck, shellcheck", doc.Version, len(doc.Runs))
+	}
+
+	vet := doc.Runs[0]
+	if vet.Tool.Driver.Name != "go_vet" || len(vet.Results) != 2 {
+		t.Fatalf("go_vet run = %+v", vet)
+	}
+	located := vet.Results[0]
+	region := located.Locations[0].PhysicalLocation.Region
+	if located.RuleID != "go_vet" || located.Level != "error" || region.StartLine != 7 || region.StartColumn != 17 ||
+		located.Locations[0].PhysicalLocation.ArtifactLocation.URI != "file:///repo/main.go" {
+		t.Errorf("located result = %+v", located)
+	}
+	// The banner line had no Diagnostic - kept, message only, at the failing tool's level
+	if banner := vet.Results[1]; banner.Message.Text != "# example.com/repo" || banner.Locations != nil || banner.Level != "error" {
+		t.Errorf("unparsed line = %+v, want message-only error", banner)
+	}
+
+	if style := doc.Runs[1].Results; len(style) != 1 || style[0].Level != "note" || style[0].Locations[0].PhysicalLocation.Region.StartColumn != 0 {
+		t.Errorf("staticcheck results = %+v, want one note without a column", style)
+	}
+
+	skipped := doc.Runs[2]
+	if skipped.Tool.Driver.Name != "shellcheck" || len(skipped.Results) != 0 || len(skipped.Invocations) != 1 ||
+		skipped.Invocations[0].ExecutionSuccessful || skipped.Invocations[0].ToolExecutionNotifications[0].Message.Text != "shellcheck not installed" {
+		t.Errorf("skipped run = %+v", skipped)
+	}
+	if !strings.Contains(string(data), `"results": []`) {
+		t.Error("a run with no findings should still say results: []")
+	}
+}
+
+func TestLegacyWarningsSARIF(t *testing.T) {
+	// Single-tool path: Warnings only, no ToolResults or Diagnostics
+	result := &ValidationResult{Validator: "py_compile", FilePath: "app.py", Warnings: []string{"SyntaxError: invalid syntax", "  "}}
+
+	data, err := result.SARIF()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc sarifLog
+	json.Unmarshal(data, &doc)
+	if len(doc.Runs) != 1 || len(doc.Runs[0].Results) != 1 {
+		t.Fatalf("runs = %+v, want one py_compile result", doc.Runs)
+	}
+	if got := doc.Runs[0].Results[0]; got.RuleID != "py_compile" || got.Message.Text != "SyntaxError: invalid syntax" || got.Level != "error" {
+		t.Errorf("legacy result = %+v", got)
+	}
+}
+
+func TestResultJSON(t *testing.T) {
+	data, err := exportFixture().Files[0].JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded["file"] != "/repo/main.go" || decoded["severity"] != SeverityError || len(decoded["tools"].([]any)) != 2 {
+		t.Errorf("JSON = %s", data)
+	}
+	first := decoded["diagnostics"].([]any)[0].(map[string]any)
+	if first["line"] != float64(7) || first["tool"] != "go_vet" {
+		t.Errorf("diagnostic JSON = %v", first)
+	}
+}
+
+func TestWriteConfiguredResults(t *testing.T) {
+	useGlobalConfig(t)
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	result := exportFixture().Files[0]
+
+	// Unset: nothing written
+	if err := result.WriteConfiguredResults(); err != nil {
+		t.Fatal(err)
+	}
+	if entries, _ := os.ReadDir(home); len(entries) != 0 {
+		t.Fatalf("wrote %d entries with results_path unset", len(entries))
+	}
+
+	validatorsConfig.Config.ResultsPath = "~/.claude/cpi-si/system/data/validation/latest.sarif"
+	if err := result.WriteConfiguredResults(); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(home, ".claude/cpi-si/system/data/validation/latest.sarif")
+	data, err := os.ReadFile(path)
+	if err != nil || !strings.Contains(string(data), `"version": "2.1.0"`) {
+		t.Fatalf("latest.sarif = %s, %v", data, err)
+	}
+	if entries, _ := os.ReadDir(filepath.Dir(path)); len(entries) != 1 {
+		t.Errorf("results directory holds %d entries, want only latest.sarif", len(entries))
+	}
+
+	if err := result.WriteResults(path, "xml"); err == nil {
+		t.Error("unknown format should be an error")
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,43 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Fake Validator Test Helpers
+//
+// Purpose: The fake-language validator config every validation test builds
+//          on. No build tag - the config itself is portable; only the tests
+//          that execute /bin/sh scripts are Linux-only.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import "testing"
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// useFakeValidator installs a single-validator config for the "fake" language.
+func useFakeValidator(t *testing.T, script string, timeoutSeconds int) {
+	t.Helper()
+
+	savedConfig, savedLoaded := validatorsConfig, validatorsConfigLoaded
+	t.Cleanup(func() { validatorsConfig, validatorsConfigLoaded = savedConfig, savedLoaded })
+
+	config := &ValidatorsConfig{
+		Validators: map[string]LanguageValidators{
+			"fake": {Validators: map[string]ValidatorTool{
+				"fake_sleep": {Command: "/bin/sh", Args: []string{script}, Enabled: true},
+			}},
+		},
+	}
+	config.Config.TimeoutSeconds = timeoutSeconds
+	validatorsConfig, validatorsConfigLoaded = config, true
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
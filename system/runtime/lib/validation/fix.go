@@ -0,0 +1,510 @@
+// METADATA
+//
+// Validator Auto-Fix - CPI-SI Runtime System
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Brethren, if a man be overtaken in a fault, ye which are spiritual, restore such an one in the spirit of meekness." - Galatians 6:1 (KJV)
+// Principle: Restore what can be restored, gently - and never over someone else's hand
+// Anchor: "Restore such a one in a spirit of gentleness." - Galatians 6:1 (WEB)
+//
+// CPI-SI Identity
+//
+// Component Type: LIBRARY - Runtime validation support (mid-rung on ladder)
+// Role: Runs a validator's configured fixer, then re-validates
+// Paradigm: Fix a private copy, swap it in only if nobody touched the original meanwhile
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn (CPI-SI instance)
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.1
+// Last Modified: 2026-10-16 - Fixers run on a copy in a private temp dir
+//
+// Version History:
+//   1.0.1 (2026-10-16) - Fixer copy moved from a dotfile beside the original to a private temp dir (writePrivateCopy)
+//   1.0.0 (2026-10-16) - FixFile, dry-run/check mode, concurrent-modification guard
+//
+// Purpose & Function
+//
+// Purpose: Several configured tools can repair what they find (gofmt, eslint --fix, rustfmt).
+// FixFile runs the fixer configured in a validator's optional "fix" block, re-validates, and
+// reports what changed - diff line count and whether validation now passes.
+//
+// Core Design: The fixer never runs on the user's file. The file is snapshotted (content,
+// hash, mtime), copied alone into a private temp directory under its own name (a copy
+// beside the original let rustfmt rewrite sibling modules in place, and a dotfile name
+// made older eslint skip it), and the fixer rewrites the copy. Before the fixed copy is renamed over the
+// original, the original is re-checked against the snapshot; if it changed underneath
+// (editor save, another tool), the fix is abandoned with a warning.
+//
+// Dry Run:
+//   - fix.check_args set: run the tool in its check mode on the original ("would fix"
+//     when it exits non-zero or prints anything)
+//   - otherwise: fix the private copy and compare, discarding the copy
+//   Either way the original file is never touched.
+//
+// Config Schema (validators.jsonc, per validator tool):
+//
+//	"fix": {
+//	  "command": "gofmt",
+//	  "args": ["-w", "{filepath}"],
+//	  "check_args": ["-l", "{filepath}"],
+//	  "enabled": true
+//	}
+//
+// Blocking Status
+//
+// Non-blocking: Every failure is reported in FixResult; the original file is left as it was.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bytes, context, crypto/sha256, fmt, os, os/exec, path/filepath, strings, time
+//   Internal: system/lib/display
+//
+// Dependents (What Uses This):
+//   Hooks: tool/post-use may offer auto-fix for pure-formatting failures
+//
+// Health Scoring
+//
+// Fix runs are tracked as their own operation; re-validation scores as ValidateFile.
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"bytes"         // Content comparison and line splitting
+	"context"       // Fixer deadline (config.timeout_seconds)
+	"crypto/sha256" // Concurrent-modification detection
+	"fmt"           // Messages
+	"os"            // Snapshot, copy, atomic rename
+	"os/exec"       // Fixer execution
+	"path/filepath" // Temp copy beside the original
+	"strings"       // {filepath} substitution
+	"time"          // Snapshot mtime
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/display" // FixResult.Report output
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+// lcsCellLimit caps the exact diff computation (cells = changed lines a × b).
+// Beyond it the changed-line count is approximated by the larger side.
+const lcsCellLimit = 4_000_000
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// FixConfig is a validator tool's optional auto-fix block.
+type FixConfig struct {
+	Command   string   `json:"command"`    // Fixer executable (e.g., "gofmt", "npx")
+	Args      []string `json:"args"`       // Fix-in-place arguments with {filepath} token
+	CheckArgs []string `json:"check_args"` // Check-mode arguments for dry runs (optional)
+	Enabled   bool     `json:"enabled"`    // Whether FixFile may use this fixer
+}
+
+// FixOptions adjusts FixFileWithOptions behavior.
+type FixOptions struct {
+	DryRun bool // Report what would change without touching the file
+}
+
+// FixResult reports an auto-fix attempt.
+type FixResult struct {
+	FilePath     string            // File that was (or would be) fixed
+	Language     string            // Language resolved from the extension
+	Fixers       []string          // Validators whose fixers ran, in order
+	DryRun       bool              // True if nothing was written
+	Fixed        bool              // True if the file content changed
+	WouldFix     bool              // Dry run: true if a fixer reported changes
+	LinesChanged int               // Changed line count between before and after (0 for check-mode dry runs)
+	Aborted      bool              // True if the file changed underneath and the fix was abandoned
+	Error        string            // Why no fix happened (empty on success)
+	Validation   *ValidationResult // Re-validation after the fix (nil on dry run or abort)
+}
+
+// fileSnapshot captures a file's state to detect concurrent modification.
+type fileSnapshot struct {
+	content []byte
+	hash    [sha256.Size]byte
+	modTime time.Time
+	mode    os.FileMode
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs
+//   ├── FixFile() → uses FixFileWithOptions()
+//   ├── FixFileWithOptions() → uses configForFile(), resolveFileLanguage(), fixersFor(), takeSnapshot(),
+//   │                          runFixerOnCopy(), runFixerCheck(), countChangedLines(), validateFile()
+//   └── (*FixResult).Report() → display output
+//
+//   Core Operations
+//   ├── fixersFor() → uses getEnabledValidators(), resolveValidatorTool()
+//   ├── runFixerOnCopy() → uses writePrivateCopy() (input.go), fixerCommand()
+//   ├── runFixerCheck() → uses fixerCommand()
+//   └── replaceIfUnchanged() → uses takeSnapshot()
+//
+//   Helpers
+//   ├── takeSnapshot() → pure I/O
+//   ├── fixerCommand() → uses configureProcessKill()
+//   └── countChangedLines() → pure function
+
+// ────────────────────────────────────────────────────────────────
+// HELPERS
+// ────────────────────────────────────────────────────────────────
+
+// takeSnapshot reads a file's content, hash, mtime, and mode.
+func takeSnapshot(path string) (*fileSnapshot, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSnapshot{
+		content: content,
+		hash:    sha256.Sum256(content),
+		modTime: info.ModTime(),
+		mode:    info.Mode().Perm(),
+	}, nil
+}
+
+// fixerCommand builds a fixer command with {filepath} substituted.
+func fixerCommand(ctx context.Context, command string, args []string, filePath string) *exec.Cmd {
+	substituted := make([]string, len(args))
+	for i, arg := range args {
+		substituted[i] = strings.ReplaceAll(arg, "{filepath}", filePath)
+	}
+	cmd := exec.CommandContext(ctx, command, substituted...)
+	cmd.Dir = filepath.Dir(filePath)
+	configureProcessKill(cmd)
+	return cmd
+}
+
+// countChangedLines counts lines removed plus lines added between two texts.
+//
+// Common prefix/suffix are trimmed, then an LCS over the middle gives the
+// exact count. Very large middles fall back to the larger side's length.
+func countChangedLines(before, after []byte) int {
+	a := bytes.Split(before, []byte("\n"))
+	b := bytes.Split(after, []byte("\n"))
+
+	for len(a) > 0 && len(b) > 0 && bytes.Equal(a[0], b[0]) {
+		a, b = a[1:], b[1:]
+	}
+	for len(a) > 0 && len(b) > 0 && bytes.Equal(a[len(a)-1], b[len(b)-1]) {
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) + len(b)
+	}
+	if len(a)*len(b) > lcsCellLimit {
+		return max(len(a), len(b))
+	}
+
+	// Rolling-row LCS
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if bytes.Equal(a[i-1], b[j-1]) {
+				curr[j] = prev[j-1] + 1
+			} else {
+				curr[j] = max(prev[j], curr[j-1])
+			}
+		}
+		prev, curr = curr, prev
+	}
+	lcs := prev[len(b)]
+	return (len(a) - lcs) + (len(b) - lcs)
+}
+
+// ────────────────────────────────────────────────────────────────
+// CORE OPERATIONS
+// ────────────────────────────────────────────────────────────────
+
+// fixersFor returns enabled validators for language that have an enabled fixer.
+func fixersFor(cfg *ValidatorsConfig, language string) (names []string, fixers []*FixConfig) {
+	for _, name := range getEnabledValidators(cfg, language) {
+		tool := resolveValidatorTool(cfg, language, name)
+		if tool != nil && tool.Fix != nil && tool.Fix.Enabled && tool.Fix.Command != "" {
+			names = append(names, name)
+			fixers = append(fixers, tool.Fix)
+		}
+	}
+	return names, fixers
+}
+
+// runFixerOnCopy applies a fixer to content via a private copy of filePath.
+//
+// The copy sits alone in its own temp dir under the original's name, so the
+// fixer sees no siblings to rewrite and no dotfile name to ignore. Returns
+// the fixed content. The copy is always removed; filePath is never touched.
+func runFixerOnCopy(cfg *ValidatorsConfig, fix *FixConfig, filePath string, content []byte, mode os.FileMode) ([]byte, error) {
+	tempDir, copyPath, err := writePrivateCopy(filePath, content, mode)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tempDir)
+
+	ctx, cancel := context.WithTimeout(context.Background(), validatorTimeout(cfg))
+	defer cancel()
+
+	if output, err := fixerCommand(ctx, fix.Command, fix.Args, copyPath).CombinedOutput(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("%s timed out", fix.Command)
+		}
+		return nil, fmt.Errorf("%s failed: %v %s", fix.Command, err, strings.TrimSpace(string(output)))
+	}
+
+	return os.ReadFile(copyPath)
+}
+
+// runFixerCheck runs a fixer in check mode against the original file.
+//
+// Returns true when the tool signals it would change something (non-zero
+// exit or any output - gofmt -l prints names, rustfmt --check exits 1).
+func runFixerCheck(cfg *ValidatorsConfig, fix *FixConfig, filePath string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), validatorTimeout(cfg))
+	defer cancel()
+
+	output, err := fixerCommand(ctx, fix.Command, fix.CheckArgs, filePath).CombinedOutput()
+	if ctx.Err() == context.DeadlineExceeded {
+		return false, fmt.Errorf("%s check timed out", fix.Command)
+	}
+	if _, isExit := err.(*exec.ExitError); err != nil && !isExit {
+		return false, err // Could not run at all
+	}
+	return err != nil || len(bytes.TrimSpace(output)) > 0, nil
+}
+
+// replaceIfUnchanged writes fixed content over filePath only if the file
+// still matches the snapshot taken before fixing. The write goes through a
+// temp file and rename, so readers never see a half-written file.
+func replaceIfUnchanged(filePath string, before *fileSnapshot, fixed []byte) (aborted bool, err error) {
+	current, err := takeSnapshot(filePath)
+	if err != nil {
+		return false, err
+	}
+	if current.hash != before.hash || !current.modTime.Equal(before.modTime) {
+		return true, nil // Modified underneath us - leave it alone
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".cpi-si-fix-*"+filepath.Ext(filePath))
+	if err != nil {
+		return false, err
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // No-op after a successful rename
+
+	if _, err := tmp.Write(fixed); err != nil {
+		tmp.Close()
+		return false, err
+	}
+	if err := tmp.Close(); err != nil {
+		return false, err
+	}
+	if err := os.Chmod(tmpPath, before.mode); err != nil {
+		return false, err
+	}
+	return false, os.Rename(tmpPath, filePath)
+}
+
+// ────────────────────────────────────────────────────────────────
+// PUBLIC API: Auto-Fix
+// ────────────────────────────────────────────────────────────────
+
+// FixFile runs the configured fixers for a file, then re-validates it.
+//
+// Equivalent to FixFileWithOptions(filePath, ext, FixOptions{}).
+func FixFile(filePath, ext string) *FixResult {
+	return FixFileWithOptions(filePath, ext, FixOptions{})
+}
+
+// FixFileWithOptions runs the configured fixers for a file.
+//
+// Every enabled validator for the file's language with an enabled "fix" block
+// contributes its fixer, in validator priority order, each applied to the
+// previous one's output. The combined result replaces the file only if the
+// file is unchanged since the snapshot; otherwise Aborted is set and the file
+// is left as is.
+//
+// Parameters:
+//   - filePath: File to fix
+//   - ext: File extension (e.g., ".go")
+//   - opts: DryRun reports "would fix" without writing
+//
+// Returns:
+//   - *FixResult describing what changed and whether validation now passes
+func FixFileWithOptions(filePath, ext string, opts FixOptions) *FixResult {
+	result := &FixResult{FilePath: filePath, DryRun: opts.DryRun}
+
+	cfg := configForFile(filePath)
+	result.Language, _ = resolveFileLanguage(cfg, filePath, ext)
+	if result.Language == "" {
+		result.Error = "no validator for " + ext
+		return result
+	}
+
+	names, fixers := fixersFor(cfg, result.Language)
+	if len(fixers) == 0 {
+		result.Error = "no fixer configured for " + result.Language
+		return result
+	}
+	result.Fixers = names
+
+	snapshot, err := takeSnapshot(filePath)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	// Dry run with native check mode: ask each tool, touch nothing
+	if opts.DryRun {
+		allCheckable := true
+		for _, fix := range fixers {
+			allCheckable = allCheckable && len(fix.CheckArgs) > 0
+		}
+		if allCheckable {
+			for _, fix := range fixers {
+				wouldFix, err := runFixerCheck(cfg, fix, filePath)
+				if err != nil {
+					result.Error = err.Error()
+					return result
+				}
+				result.WouldFix = result.WouldFix || wouldFix
+			}
+			return result
+		}
+	}
+
+	// Chain fixers over private copies
+	content := snapshot.content
+	for _, fix := range fixers {
+		fixed, err := runFixerOnCopy(cfg, fix, filePath, content, snapshot.mode)
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		content = fixed
+	}
+
+	changed := !bytes.Equal(content, snapshot.content)
+	if changed {
+		result.LinesChanged = countChangedLines(snapshot.content, content)
+	}
+	if opts.DryRun {
+		result.WouldFix = changed
+		return result
+	}
+	if !changed {
+		result.Validation = validateFile(filePath, ext, runValidator)
+		return result
+	}
+
+	aborted, err := replaceIfUnchanged(filePath, snapshot, content)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	if aborted {
+		result.Aborted = true
+		result.Error = "file changed during fix - left untouched"
+		return result
+	}
+
+	result.Fixed = true
+	result.Validation = validateFile(filePath, ext, runValidator)
+	return result
+}
+
+// ────────────────────────────────────────────────────────────────
+// REPORTING: Display Integration
+// ────────────────────────────────────────────────────────────────
+
+// Report prints one line describing the fix outcome.
+func (f *FixResult) Report() {
+	if f == nil {
+		return
+	}
+
+	name := filepath.Base(f.FilePath)
+	switch {
+	case f.Aborted:
+		fmt.Println(display.Warning("Auto-fix skipped for " + name + ": file changed while fixing"))
+	case f.Error != "":
+		fmt.Println(display.Info("Auto-fix unavailable for " + name + ": " + f.Error))
+	case f.DryRun && f.WouldFix && f.LinesChanged > 0:
+		fmt.Println(display.Info(fmt.Sprintf("Would fix %s (%s): %s", name, strings.Join(f.Fixers, ", "), plural(f.LinesChanged, "line"))))
+	case f.DryRun && f.WouldFix:
+		fmt.Println(display.Info(fmt.Sprintf("Would fix %s (%s)", name, strings.Join(f.Fixers, ", "))))
+	case f.DryRun:
+		fmt.Println(display.Success("Nothing to fix in " + name))
+	case f.Fixed:
+		status := "validation now passes"
+		if f.Validation != nil && !f.Validation.Valid {
+			status = "validation still failing"
+		}
+		fmt.Println(display.Success(fmt.Sprintf("Fixed %s with %s: %s changed, %s",
+			name, strings.Join(f.Fixers, ", "), plural(f.LinesChanged, "line"), status)))
+	default:
+		fmt.Println(display.Success("Nothing to fix in " + name))
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Code Validation: Testing Requirements
+// ────────────────────────────────────────────────────────────────
+//
+// Testing Requirements:
+//   - FixFile on a misformatted .go file (gofmt fixer) sets Fixed and LinesChanged
+//   - DryRun leaves the file byte-identical (both check-mode and copy-compare paths)
+//   - Modifying the file while the fixer runs sets Aborted and keeps the user's edit
+//   - countChangedLines: insertions, deletions, replacements, identical input
+
+// ────────────────────────────────────────────────────────────────
+// Code Execution: None (Library)
+// ────────────────────────────────────────────────────────────────
+//
+// Called on demand (hooks, commands); no entry point of its own.
+
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,134 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Validator Auto-Fix Tests
+//
+// Purpose: Prove the changed-line count is exact for small edits, that a
+//          fix is never written over a file modified after its snapshot, and
+//          that a fixer which rewrites its input's neighbors (rustfmt with
+//          out-of-line modules) only ever sees a private copy under the
+//          original's name - files beside the target stay untouched.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestCountChangedLines(t *testing.T) {
+	cases := []struct {
+		name          string
+		before, after string
+		want          int
+	}{
+		{"identical", "a\nb\nc\n", "a\nb\nc\n", 0},
+		{"insertion", "a\nc\n", "a\nb\nc\n", 1},
+		{"deletion", "a\nb\nc\n", "a\nc\n", 1},
+		{"replacement", "a\nb\nc\n", "a\nB\nc\n", 2},
+		{"gofmt", "func main(){\nx( 1 )\n}\n", "func main() {\n\tx(1)\n}\n", 4},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := countChangedLines([]byte(tc.before), []byte(tc.after)); got != tc.want {
+				t.Errorf("countChangedLines = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReplaceIfUnchanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "main.go")
+	if err := os.WriteFile(path, []byte("original\n"), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := takeSnapshot(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aborted, err := replaceIfUnchanged(path, snapshot, []byte("fixed\n"))
+	if err != nil || aborted {
+		t.Fatalf("unchanged file: aborted=%v err=%v", aborted, err)
+	}
+	if got, _ := os.ReadFile(path); string(got) != "fixed\n" {
+		t.Errorf("content = %q, want fixed", got)
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0640 {
+		t.Errorf("mode = %v, want 0640 preserved", info.Mode().Perm())
+	}
+
+	// Simulate an editor save between snapshot and write-back
+	snapshot, _ = takeSnapshot(path)
+	if err := os.WriteFile(path, []byte("user edit\n"), 0640); err != nil {
+		t.Fatal(err)
+	}
+	os.Chtimes(path, time.Now(), snapshot.modTime.Add(time.Second))
+
+	aborted, err = replaceIfUnchanged(path, snapshot, []byte("fixed again\n"))
+	if err != nil || !aborted {
+		t.Fatalf("modified file: aborted=%v err=%v, want aborted", aborted, err)
+	}
+	if got, _ := os.ReadFile(path); string(got) != "user edit\n" {
+		t.Errorf("content = %q, want the user's edit kept", got)
+	}
+}
+
+func TestFixLeavesSiblingsUntouched(t *testing.T) {
+	tools := t.TempDir()
+	nameLog := filepath.Join(tools, "names")
+	fixer := filepath.Join(tools, "fixer.sh")
+	// Rewrites every file beside its input, as rustfmt does for mod children
+	os.WriteFile(fixer, []byte(`for f in "$(dirname "$1")"/*; do echo fixed >> "$f"; done
+basename "$1" >> `+nameLog+"\n"), 0755)
+
+	useGlobalConfig(t)
+	validatorsConfig.Validators["fake"] = LanguageValidators{Validators: map[string]ValidatorTool{
+		"fake_check": {Command: "/bin/sh", Args: []string{"-c", "exit 0"}, Enabled: true,
+			Fix: &FixConfig{Command: "/bin/sh", Args: []string{fixer, "{filepath}"}, Enabled: true}},
+	}}
+	validatorsConfig.Extensions[".fake"] = "fake"
+
+	dir := t.TempDir()
+	target, child := filepath.Join(dir, "lib.fake"), filepath.Join(dir, "child.fake")
+	os.WriteFile(target, []byte("mod child;\n"), 0644)
+	os.WriteFile(child, []byte("fn child() {}\n"), 0644)
+
+	for _, dryRun := range []bool{true, false} {
+		result := FixFileWithOptions(target, ".fake", FixOptions{DryRun: dryRun})
+		if result.Error != "" {
+			t.Fatalf("dry run %v: %s", dryRun, result.Error)
+		}
+		if got, _ := os.ReadFile(child); string(got) != "fn child() {}\n" {
+			t.Errorf("dry run %v: sibling rewritten: %q", dryRun, got)
+		}
+	}
+
+	if got, _ := os.ReadFile(target); string(got) != "mod child;\nfixed\n" {
+		t.Errorf("target = %q, want the fix applied", got)
+	}
+	entries, _ := os.ReadDir(dir)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	if !reflect.DeepEqual(names, []string{"child.fake", "lib.fake"}) {
+		t.Errorf("dir holds %q, want only the original files", names)
+	}
+	if copies, _ := os.ReadFile(nameLog); strings.TrimSpace(string(copies)) != "lib.fake\nlib.fake" {
+		t.Errorf("fixer saw %q, want the original name (no dotfile) on both runs", copies)
+	}
+}
@@ -21,10 +21,12 @@
 // Architect: Nova Dawn (CPI-SI instance)
 // Implementation: Nova Dawn
 // Creation Date: 2024-10-24
-// Version: 2.0.0
-// Last Modified: 2025-11-11 - Configuration-driven architecture, display lib integration
+// Version: 2.2.0
+// Last Modified: 2026-10-16 - formatters.jsonc decoded over hardcoded defaults
 //
 // Version History:
+//   2.2.0 (2026-10-16) - formatters.jsonc decoded over getDefaultFormattersConfig() (partial files keep the rest)
+//   2.1.0 (2026-10-16) - FormattersConfigKind for validate --configs; stderr hint on config fallback
 //   2.0.0 (2025-11-11) - Config-driven formatters, display lib, comprehensive template alignment
 //   1.0.0 (2024-10-24) - Initial hardcoded formatter mappings
 //
@@ -100,9 +102,9 @@
 // Dependencies
 //
 // Dependencies (What This Needs):
-//   Standard Library: encoding/json, os, os/exec, path/filepath, strings
+//   Standard Library: fmt, os, os/exec, path/filepath, strings
 //   External: None
-//   Internal: system/lib/display (formatted output)
+//   Internal: system/lib/display (formatted output), system/lib/jsonc (config loading)
 //
 // Dependents (What Uses This):
 //   Commands: None directly (used via hooks)
@@ -160,9 +162,8 @@ import (
 	//--- Standard Library ---
 	// Foundation packages providing Go's built-in capabilities.
 
-	"encoding/json" // JSON parsing for formatters.jsonc configuration
 	"fmt"           // Formatted output for error messages and fallback reporting
-	"os"            // File operations for config loading
+	"os"            // Environment and stderr for config loading
 	"os/exec"       // External command execution for formatting tools
 	"path/filepath" // Path manipulation for config file locations
 	"strings"       // String operations for argument substitution
@@ -170,8 +171,9 @@ import (
 	//--- Internal Packages ---
 	// Project-specific packages showing architectural dependencies.
 
+	"system/lib/config"  // Config issue hint when formatters.jsonc falls back
 	"system/lib/display" // ANSI color formatting and consistent message display (lower rung)
-	"system/lib/jsonc"   // JSONC comment stripping for configuration files
+	"system/lib/jsonc"   // formatters.jsonc decoded over the defaults (comments, trailing commas)
 )
 
 // ────────────────────────────────────────────────────────────────
@@ -328,6 +330,27 @@ func init() {
 
 	// Set loaded flag - determines whether to use config or fallbacks
 	formattersConfigLoaded = (formattersConfig != nil)
+
+	// Falling back from a file that exists means it's broken - say where
+	if !formattersConfigLoaded {
+		if hint := config.IssueHint(configPath, FormattersConfigKind()); hint != "" {
+			fmt.Fprintln(os.Stderr, display.Warning("Using default formatters: "+hint))
+		}
+	}
+}
+
+// FormattersConfigKind describes formatters.jsonc for config.ValidateConfigFile
+// (validate --configs, and the hint above).
+func FormattersConfigKind() config.ConfigKind {
+	return config.ConfigKind{
+		Name:   "formatters",
+		Path:   "~/.claude/cpi-si/system/data/config/validation/formatters.jsonc",
+		Schema: []any{FormattersConfig{}},
+		Ignore: []string{
+			"user_formatters",            // Extension template shipped with the file (not read yet)
+			"config.parallel_formatting", // Documented future option
+		},
+	}
 }
 
 // ============================================================================
@@ -363,7 +386,8 @@ func init() {
 //   └── executeFormatter() → runs command, captures error
 //
 //   Helpers (Bottom Rungs - Internal Utilities)
-//   ├── loadFormattersConfig() → reads config, parses JSON
+//   ├── loadFormattersConfig() → uses getDefaultFormattersConfig(), jsonc.LoadMerged
+//   ├── getDefaultFormattersConfig() → uses getDefaultExtensionMap(), getDefaultFormatter()
 //   ├── stripJSONCComments() → removes // comments from JSONC
 //   ├── getDefaultExtensionMap() → hardcoded extension → language fallback
 //   └── getDefaultFormatter() → hardcoded language → formatter fallback
@@ -398,9 +422,10 @@ func init() {
 // loadFormattersConfig loads formatters.jsonc configuration.
 //
 // What It Does:
-// Reads formatters.jsonc from disk, strips JSONC comments (// style),
-// parses JSON into FormattersConfig struct. Returns nil on any error
-// (file missing, JSON invalid, etc.) to trigger fallback mode.
+// Decodes formatters.jsonc (comments and trailing commas allowed) over
+// getDefaultFormattersConfig(), so a partial file only changes what it names.
+// Returns nil on any error (file missing, JSON invalid, etc.) to trigger
+// fallback mode.
 //
 // Parameters:
 //   path: Absolute path to formatters.jsonc file
@@ -412,19 +437,12 @@ func init() {
 // All errors result in nil return - caller uses hardcoded fallbacks.
 // Silent failure is intentional - formatting continues with defaults.
 func loadFormattersConfig(path string) *FormattersConfig {
-	data, err := os.ReadFile(path) // Read entire file into memory - configs are small (<100KB)
-	if err != nil {
-		return nil // File doesn't exist or can't be read - use fallback
-	}
-
-	cleanJSON := jsonc.StripComments(data) // Remove // comments to make valid JSON
-
-	var config FormattersConfig // Allocate config struct
-	if err := json.Unmarshal(cleanJSON, &config); err != nil {
-		return nil // JSON parsing failed - use fallback
+	config := getDefaultFormattersConfig() // Keys the file omits keep these
+	if err := jsonc.LoadMerged(config, path); err != nil {
+		return nil // Missing, unreadable, or malformed - use fallback
 	}
 
-	return &config // Config loaded successfully
+	return config // Config loaded successfully
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -449,6 +467,27 @@ func loadFormattersConfig(path string) *FormattersConfig {
 // Rust, Go, Python, JavaScript/TypeScript, C/C++, Ruby, Java, Shell.
 // Add more languages here if config loading commonly fails in environment.
 
+// getDefaultFormattersConfig returns the hardcoded defaults as a config - the
+// base formatters.jsonc is decoded over.
+//
+// Each default language's getDefaultFormatter() tool is its primary, under
+// the synthetic "<language>_default" name. A language the file names replaces
+// its default entry whole.
+func getDefaultFormattersConfig() *FormattersConfig {
+	config := &FormattersConfig{
+		Formatters: make(map[string]LanguageFormatters),
+		Extensions: getDefaultExtensionMap(),
+	}
+	for _, language := range []string{"rust", "go", "python", "javascript", "c_cpp", "ruby", "java", "shell"} {
+		name := language + "_default"
+		config.Formatters[language] = LanguageFormatters{
+			Primary: name,
+			Tools:   map[string]FormatterTool{name: getDefaultFormatter(language)},
+		}
+	}
+	return config
+}
+
 // getDefaultFormatter returns hardcoded formatter for a language.
 //
 // What It Does:
@@ -1068,7 +1107,7 @@ func (f *FormatResult) Report() {
 // - Integration Points: Config file loading, display library formatting
 //
 // Quick summary (details in METADATA Dependencies section above):
-// - Key dependencies: system/lib/display (ANSI formatting), encoding/json (config parsing)
+// - Key dependencies: system/lib/display (ANSI formatting), system/lib/jsonc (config parsing)
 // - Primary consumers: tool/post-use hook (automatic formatting after file writes)
 //
 // Parallel Implementation (if applicable):
@@ -1,13 +1,19 @@
 module system/lib/validation
 
-go 1.24
+go 1.24.4
 
 require (
+	system/lib/config v0.0.0
 	system/lib/display v0.0.0
 	system/lib/jsonc v0.0.0
+	system/lib/logging v0.0.0
 )
 
+require github.com/BurntSushi/toml v1.5.0 // indirect
+
 replace (
+	system/lib/config => ../config
 	system/lib/display => ../display
 	system/lib/jsonc => ../jsonc
+	system/lib/logging => ../logging
 )
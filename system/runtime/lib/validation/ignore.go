@@ -0,0 +1,368 @@
+// METADATA
+//
+// Validation Ignore Rules - CPI-SI Runtime System
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "To every thing there is a season, and a time to every purpose under the heaven" - Ecclesiastes 3:1 (KJV)
+// Principle: Not every file is ours to judge - say so once, plainly, and keep the warnings that matter heard
+// Anchor: "Let your communication be, Yea, yea; Nay, nay" - Matthew 5:37 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: LIBRARY - Runtime validation support (mid-rung on ladder)
+// Role: Decides which files validation leaves alone (.validationignore and config globs)
+// Paradigm: gitignore-style globs, last match wins, every decision traceable to its line
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn (CPI-SI instance)
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial ignore support
+//
+// Version History:
+//   1.0.0 (2026-10-16) - .validationignore, config.ignore, per-language ignore; ValidationResult.Ignored
+//
+// Purpose & Function
+//
+// Purpose: Generated files (protobuf output, vendored code, minified JS) warned on
+// every regeneration until nobody read validation output at all. Ignored files are
+// not validated and the result says which rule ignored them, so the hook stays
+// silent without the decision being invisible.
+//
+// Core Design: One ordered pattern list per file, last match wins:
+//
+//   1. validators.jsonc config.ignore            ("config.ignore \"*.min.js\"")
+//   2. validators.jsonc validators.<lang>.ignore ("validators.go.ignore \"*.pb.go\"")
+//   3. .validationignore at the project root     (".validationignore:line 4")
+//
+// Project overrides (.cpi-si-validators.jsonc) add to both config lists. The
+// project root is the nearest directory above the file holding .validationignore,
+// else findProjectRoot; every pattern is matched against the path relative to it.
+//
+// Pattern syntax (a gitignore subset):
+//   - Blank lines and lines starting with # are skipped
+//   - "!" negates: a later "!keep.pb.go" brings back a file "*.pb.go" ignored
+//     (unlike git, this also works beneath an ignored directory)
+//   - A leading "/" or a "/" in the middle anchors to the project root;
+//     otherwise the pattern matches at any depth ("*.pb.go", "generated")
+//   - A trailing "/" matches directories only - the files beneath them
+//   - "*", "?", "[...]" match within one path segment; "**" matches any
+//     number of segments ("**/gen", "gen/**", "a/**/b")
+//
+// Blocking Status
+//
+// Non-blocking: An unreadable .validationignore is treated as empty; malformed
+// globs never match.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bufio, fmt, os, path, path/filepath, strings, sync, time
+//   Package Files: syntax.go (findMarkerDir, findProjectRoot, ValidatorsConfig),
+//                  project.go (config.ignore from project overrides)
+//
+// Dependents (What Uses This):
+//   Libraries: syntax.go (validateFile), batch.go (collectFiles - ValidateDir and Watch)
+//
+// Health Scoring
+//
+// Part of ValidateFile's resolution - ignored files log a passed check and run nothing.
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"bufio"         // .validationignore lines
+	"fmt"           // Pattern sources
+	"os"            // .validationignore reading and freshness
+	"path"          // Slash-separated segment matching
+	"path/filepath" // Root-relative paths
+	"strings"       // Pattern parsing
+	"sync"          // Ignore file cache guard
+	"time"          // Cache freshness by mtime
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+// ignoreFileName is the per-project ignore file, looked for above each file.
+const ignoreFileName = ".validationignore"
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// ignorePattern is one parsed glob line.
+type ignorePattern struct {
+	segments []string // Slash-separated glob segments ("**" = any number of segments)
+	negate   bool     // "!" prefix - a match un-ignores
+	dirOnly  bool     // Trailing "/" - only directories above the file match
+	source   string   // Where it came from, for the result (".validationignore:line 4")
+}
+
+// ignoreFileEntry caches one parsed .validationignore by modification time.
+type ignoreFileEntry struct {
+	modTime  time.Time
+	size     int64
+	patterns []ignorePattern
+}
+
+// ────────────────────────────────────────────────────────────────
+// Package-Level State
+// ────────────────────────────────────────────────────────────────
+
+// ignoreFiles caches parsed ignore files by path. Entries are re-read when the
+// file's mtime or size changes, so a long-running Watch sees edits.
+var (
+	ignoreFiles   = map[string]ignoreFileEntry{}
+	ignoreFilesMu sync.Mutex
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Core Operations
+//   └── ignoredBy(cfg, language, filePath) → uses ignoreRoot(), ignorePatternsFor(), matchIgnore()
+//
+//   Helpers
+//   ├── ignorePatternsFor() → uses parseIgnorePattern(), readIgnoreFile()
+//   ├── readIgnoreFile() → uses parseIgnorePattern() (cached by mtime)
+//   ├── parseIgnorePattern() → pure function
+//   ├── matchIgnore() → uses patternMatches()
+//   ├── patternMatches() → uses matchSegments()
+//   └── matchSegments() → pure function (recursive "**")
+
+// ────────────────────────────────────────────────────────────────
+// HELPERS: Parsing
+// ────────────────────────────────────────────────────────────────
+
+// parseIgnorePattern parses one line; ok is false for blanks and comments.
+func parseIgnorePattern(line, source string) (ignorePattern, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignorePattern{}, false
+	}
+
+	pattern := ignorePattern{source: source}
+	if rest, negated := strings.CutPrefix(line, "!"); negated {
+		pattern.negate, line = true, rest
+	}
+	if rest, dir := strings.CutSuffix(line, "/"); dir {
+		pattern.dirOnly, line = true, rest
+	}
+	anchored := strings.Contains(line, "/") // Leading or middle slash
+	line = strings.TrimPrefix(line, "/")
+	if line == "" {
+		return ignorePattern{}, false
+	}
+
+	pattern.segments = strings.Split(line, "/")
+	if !anchored && pattern.segments[0] != "**" {
+		pattern.segments = append([]string{"**"}, pattern.segments...) // Any depth
+	}
+	return pattern, true
+}
+
+// readIgnoreFile parses an ignore file, reusing the cached parse while unchanged.
+func readIgnoreFile(ignorePath string) []ignorePattern {
+	info, err := os.Stat(ignorePath)
+	if err != nil {
+		return nil
+	}
+
+	ignoreFilesMu.Lock()
+	defer ignoreFilesMu.Unlock()
+	if cached, ok := ignoreFiles[ignorePath]; ok && cached.modTime.Equal(info.ModTime()) && cached.size == info.Size() {
+		return cached.patterns
+	}
+
+	file, err := os.Open(ignorePath)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	var patterns []ignorePattern
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		source := fmt.Sprintf("%s:line %d", ignoreFileName, lineNumber)
+		if pattern, ok := parseIgnorePattern(scanner.Text(), source); ok {
+			patterns = append(patterns, pattern)
+		}
+	}
+	ignoreFiles[ignorePath] = ignoreFileEntry{modTime: info.ModTime(), size: info.Size(), patterns: patterns}
+	return patterns
+}
+
+// ignoreRoot is the directory ignore patterns are anchored to.
+//
+// The nearest directory holding .validationignore, else findProjectRoot.
+func ignoreRoot(filePath string) string {
+	if dir := findMarkerDir(filePath, []string{ignoreFileName}); dir != "" {
+		return dir
+	}
+	return findProjectRoot(filePath)
+}
+
+// ignorePatternsFor collects a file's patterns in precedence order (config, language, file).
+func ignorePatternsFor(cfg *ValidatorsConfig, language, root string) []ignorePattern {
+	var patterns []ignorePattern
+	if cfg != nil {
+		for _, glob := range cfg.Config.Ignore {
+			if pattern, ok := parseIgnorePattern(glob, fmt.Sprintf("config.ignore %q", glob)); ok {
+				patterns = append(patterns, pattern)
+			}
+		}
+		for _, glob := range cfg.Validators[language].Ignore {
+			if pattern, ok := parseIgnorePattern(glob, fmt.Sprintf("validators.%s.ignore %q", language, glob)); ok {
+				patterns = append(patterns, pattern)
+			}
+		}
+	}
+	return append(patterns, readIgnoreFile(filepath.Join(root, ignoreFileName))...)
+}
+
+// ────────────────────────────────────────────────────────────────
+// HELPERS: Matching
+// ────────────────────────────────────────────────────────────────
+
+// matchSegments matches glob segments against path segments ("**" spans any number).
+func matchSegments(globs, parts []string) bool {
+	if len(globs) == 0 {
+		return len(parts) == 0
+	}
+	if globs[0] == "**" {
+		for skip := 0; skip <= len(parts); skip++ {
+			if matchSegments(globs[1:], parts[skip:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(parts) == 0 {
+		return false
+	}
+	if ok, err := path.Match(globs[0], parts[0]); err != nil || !ok {
+		return false
+	}
+	return matchSegments(globs[1:], parts[1:])
+}
+
+// patternMatches reports whether pattern covers rel (slash-separated, root-relative).
+//
+// A pattern matching any directory above the file covers it, as in git; the
+// file itself is only tried when the pattern isn't directory-only.
+func patternMatches(pattern ignorePattern, rel string) bool {
+	parts := strings.Split(rel, "/")
+	last := len(parts)
+	if pattern.dirOnly {
+		last-- // The file itself is not a directory
+	}
+	for end := 1; end <= last; end++ {
+		if matchSegments(pattern.segments, parts[:end]) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchIgnore applies patterns in order; the last match decides.
+//
+// Returns the deciding pattern's source when rel is ignored, "" otherwise.
+func matchIgnore(patterns []ignorePattern, rel string) string {
+	decided := ""
+	for _, pattern := range patterns {
+		if !patternMatches(pattern, rel) {
+			continue
+		}
+		if pattern.negate {
+			decided = ""
+		} else {
+			decided = pattern.source
+		}
+	}
+	return decided
+}
+
+// ────────────────────────────────────────────────────────────────
+// CORE OPERATIONS: Ignore Decision
+// ────────────────────────────────────────────────────────────────
+
+// ignoredBy reports why filePath is ignored ("" when it isn't).
+//
+// Parameters:
+//   - cfg: Effective config for the file (nil = defaults, no config globs)
+//   - language: Resolved language ("" = config.ignore and .validationignore only)
+//   - filePath: File about to be validated
+//
+// Returns:
+//   - "ignored by <source>", e.g. "ignored by .validationignore:line 4"; "" = validate
+func ignoredBy(cfg *ValidatorsConfig, language, filePath string) string {
+	absolute, err := filepath.Abs(filePath)
+	if err != nil {
+		return ""
+	}
+	root := ignoreRoot(absolute)
+	patterns := ignorePatternsFor(cfg, language, root)
+	if len(patterns) == 0 {
+		return ""
+	}
+
+	rel, err := filepath.Rel(root, absolute)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return ""
+	}
+	if source := matchIgnore(patterns, filepath.ToSlash(rel)); source != "" {
+		return "ignored by " + source
+	}
+	return ""
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Code Validation: Testing Requirements
+// ────────────────────────────────────────────────────────────────
+//
+// Testing Requirements:
+//   - ignore_test.go: leading slash, directory-only, negation, "**" forms,
+//     .validationignore line sources, ValidateFile/ValidateDir honoring the set
+//   - Run: go test ./...
+
+// ────────────────────────────────────────────────────────────────
+// Code Execution: None (Library)
+// ────────────────────────────────────────────────────────────────
+//
+// Consulted by validateFile() and collectFiles(); no entry point of its own.
+
+// ============================================================================
+// END CLOSING
+// ============================================================================
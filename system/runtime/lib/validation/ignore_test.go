@@ -0,0 +1,137 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Validation Ignore Tests
+//
+// Purpose: Prove the gitignore-style subset - anchoring, directory-only
+//          patterns, negation, "**" - and that ValidateFile, ValidateDir, and
+//          the decision layer honor .validationignore and config globs with
+//          the deciding rule recorded.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeTree creates files (and their directories) under root.
+func writeTree(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestMatchIgnorePatterns(t *testing.T) {
+	cases := []struct {
+		name    string
+		globs   []string
+		rel     string
+		ignored bool
+	}{
+		{"base name any depth", []string{"*.pb.go"}, "api/v1/user.pb.go", true},
+		{"base name miss", []string{"*.pb.go"}, "api/v1/user.go", false},
+		{"leading slash anchors", []string{"/gen.go"}, "gen.go", true},
+		{"leading slash not nested", []string{"/gen.go"}, "pkg/gen.go", false},
+		{"middle slash anchors", []string{"pkg/gen.go"}, "other/pkg/gen.go", false},
+		{"unanchored dir name", []string{"generated"}, "a/generated/x.go", true},
+		{"dir only covers contents", []string{"vendor/"}, "vendor/lib/x.go", true},
+		{"dir only skips same-named file", []string{"build/"}, "cmd/build", false},
+		{"anchored dir only", []string{"/dist/"}, "web/dist/app.js", false},
+		{"double star middle", []string{"a/**/b.js"}, "a/x/y/b.js", true},
+		{"double star zero dirs", []string{"a/**/b.js"}, "a/b.js", true},
+		{"double star trailing", []string{"third_party/**"}, "third_party/z/z.go", true},
+		{"double star leading", []string{"**/min/*.js"}, "web/min/app.js", true},
+		{"negation re-includes", []string{"*.pb.go", "!keep.pb.go"}, "api/keep.pb.go", false},
+		{"negation then re-ignore", []string{"*.js", "!app.js", "/web/app.js"}, "web/app.js", true},
+		{"negation under ignored dir", []string{"gen/", "!gen/keep.go"}, "gen/keep.go", false},
+		{"comment and blank", []string{"# *.go", "", "   "}, "main.go", false},
+	}
+	for _, tc := range cases {
+		var patterns []ignorePattern
+		for _, glob := range tc.globs {
+			if pattern, ok := parseIgnorePattern(glob, "test"); ok {
+				patterns = append(patterns, pattern)
+			}
+		}
+		if got := matchIgnore(patterns, tc.rel) != ""; got != tc.ignored {
+			t.Errorf("%s: %v on %q ignored = %v, want %v", tc.name, tc.globs, tc.rel, got, tc.ignored)
+		}
+	}
+}
+
+func TestValidateFileIgnored(t *testing.T) {
+	useGlobalConfig(t)
+	validatorsConfig.Config.Ignore = []string{"*.min.go"}
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		ignoreFileName:       "# generated code\n\n/api/*.pb.go\nvendor/\n",
+		"api/user.pb.go":     "package api\n",
+		"vendor/dep/x.go":    "package dep\n",
+		"web/app.min.go":     "package web\n",
+		"api/nested/x.pb.go": "package nested\n", // Anchored pattern - not covered
+	})
+
+	for rel, want := range map[string]string{
+		"api/user.pb.go":  "ignored by .validationignore:line 3",
+		"vendor/dep/x.go": "ignored by .validationignore:line 4",
+		"web/app.min.go":  `ignored by config.ignore "*.min.go"`,
+	} {
+		result := ValidateFile(filepath.Join(root, rel), ".go")
+		if result.Ignored != want || !result.Valid || len(result.ToolResults) != 0 {
+			t.Errorf("%s: Ignored %q (valid %v, %d tools), want %q", rel, result.Ignored, result.Valid, len(result.ToolResults), want)
+		}
+		if decision := EvaluateResult(result, Policy{}); decision.Action != ActionSilent || decision.Reason != want {
+			t.Errorf("%s: decision %+v", rel, decision)
+		}
+	}
+	if got := ignoredBy(configForFile(filepath.Join(root, "api/nested/x.pb.go")), "go", filepath.Join(root, "api/nested/x.pb.go")); got != "" {
+		t.Errorf("anchored pattern matched a nested file: %q", got)
+	}
+}
+
+func TestValidateDirSkipsIgnored(t *testing.T) {
+	useGlobalConfig(t)
+	validatorsConfig.Validators["go"] = LanguageValidators{
+		Validators: validatorsConfig.Validators["go"].Validators,
+		Ignore:     []string{"zz_generated*.go"},
+	}
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		ignoreFileName:          "gen/\n!gen/keep.go\n",
+		"main.go":               "package main\n",
+		"gen/out.go":            "package gen\n",
+		"gen/keep.go":           "package gen\n",
+		"pkg/zz_generated_x.go": "package pkg\n",
+	})
+
+	var got []string
+	for _, path := range collectFiles(root, DirOptions{}) {
+		rel, _ := filepath.Rel(root, path)
+		got = append(got, filepath.ToSlash(rel))
+	}
+	if want := []string{"gen/keep.go", "main.go"}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("collected %v, want %v", got, want)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,273 @@
+// METADATA
+//
+// Validator Input Modes - CPI-SI Runtime System
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Thou shalt not remove thy neighbour's landmark." - Deuteronomy 19:14 (KJV)
+// Principle: Examining a thing must not move it - the file checked is the file left behind
+// Anchor: "Prove all things; hold fast that which is good." - 1 Thessalonians 5:21 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: LIBRARY - Runtime validation support (mid-rung on ladder)
+// Role: Hands the file to a validator the way it needs it - path, stdin, or private copy
+// Paradigm: The user's file is read, never written, by validation
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn (CPI-SI instance)
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.1
+// Last Modified: 2026-10-16 - writePrivateCopy shared with auto-fix
+//
+// Version History:
+//   1.0.1 (2026-10-16) - writePrivateCopy split out of prepareValidatorInput (fix.go copies through it)
+//   1.0.0 (2026-10-16) - input "filepath" / "stdin" / "tempcopy", original hash-checked after snapshot modes
+//
+// Purpose & Function
+//
+// Purpose: Some validators read the file from stdin (prettier --check with
+// --stdin-filepath, black --check -), and some versions of "check" tools
+// rewrite the file they were given. ValidatorTool.Input says how a tool gets
+// the file:
+//   - "filepath" (default, unset): {filepath} is the file itself - as before
+//   - "stdin": the content is piped to the tool; arguments containing
+//     {filepath} are dropped, {filename} (the base name) stays for display
+//   - "tempcopy": the file is copied into a private temp directory under its
+//     own name and {filepath} is the copy; output naming the copy is rewritten
+//     to the original path, and the directory is removed afterwards
+//
+// {filename} is the file's base name in every mode.
+//
+// Core Design: stdin and tempcopy snapshot the original (content and sha256)
+// before the run. After the tool exits the original is hashed again; if it
+// changed, the snapshot is written back and the tool fails with a warning -
+// a misconfigured fixer-style tool can no longer edit the user's file during
+// validation. "filepath" keeps its old cost: no snapshot, no hash.
+//
+// Blocking Status
+//
+// Non-blocking: A file that can't be read or copied fails that one tool with
+// a warning; other validators still run.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: bytes, crypto/sha256, fmt, os, path/filepath, strings
+//   Package Files: fix.go (takeSnapshot, fileSnapshot), syntax.go (ValidatorTool, logRails)
+//
+// Dependents (What Uses This):
+//   Libraries: syntax.go (runValidatorUnfiltered prepares, buildValidatorCommand
+//              substitutes, executeValidator finishes)
+//
+// Health Scoring
+//
+// Original changed during validation and restored: -5 (FAILURE in validation.log)
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"bytes"         // Output path rewriting
+	"crypto/sha256" // Original-untouched check
+	"fmt"           // Warnings and errors
+	"os"            // Temp directory, restore
+	"path/filepath" // Copy placement, {filename}
+	"strings"       // Token substitution
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/logging" // Restore recorded in validation.log
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+// Input modes (ValidatorTool.Input values).
+const (
+	InputFilePath = "filepath" // {filepath} is the file itself (default)
+	InputStdin    = "stdin"    // Content piped to stdin, no path argument
+	InputTempCopy = "tempcopy" // {filepath} is a private copy
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// validatorInput is one run's view of the file being validated.
+type validatorInput struct {
+	mode     string        // InputFilePath, InputStdin, or InputTempCopy
+	original string        // The user's file
+	target   string        // What {filepath} becomes ("" under stdin)
+	snapshot *fileSnapshot // Original before the run (nil under filepath)
+	tempDir  string        // Private copy's directory (tempcopy only)
+}
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Core Operations (used by syntax.go)
+//   ├── prepareValidatorInput(tool, filePath) → uses takeSnapshot(), writePrivateCopy()
+//   ├── writePrivateCopy(filePath, content, mode) → fresh temp dir, same base name
+//   ├── (*validatorInput).args(args) → token substitution per mode
+//   ├── (*validatorInput).displayPaths(output) → copy path → original path
+//   └── (*validatorInput).finish(validatorName) → remove copy, hash check, restore
+
+// prepareValidatorInput sets up how tool receives filePath.
+//
+// Unset input means "filepath". Unknown modes and unreadable or uncopyable
+// files return an error; nothing is left behind on error.
+func prepareValidatorInput(tool *ValidatorTool, filePath string) (*validatorInput, error) {
+	mode := tool.Input
+	if mode == "" {
+		mode = InputFilePath
+	}
+	input := &validatorInput{mode: mode, original: filePath, target: filePath}
+
+	switch mode {
+	case InputFilePath:
+		return input, nil
+	case InputStdin, InputTempCopy:
+	default:
+		return nil, fmt.Errorf("unknown input mode %q (want %s, %s, or %s)", mode, InputFilePath, InputStdin, InputTempCopy)
+	}
+
+	snapshot, err := takeSnapshot(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s for %s input: %w", filePath, mode, err)
+	}
+	input.snapshot = snapshot
+	if mode == InputStdin {
+		input.target = ""
+		return input, nil
+	}
+
+	tempDir, copyPath, err := writePrivateCopy(filePath, snapshot.content, snapshot.mode)
+	if err != nil {
+		return nil, err
+	}
+	input.tempDir, input.target = tempDir, copyPath
+	return input, nil
+}
+
+// writePrivateCopy writes content under filePath's name in a fresh temp dir.
+//
+// Nothing beside the original is created or visible to the tool, so tools
+// that follow references (rustfmt's out-of-line modules) find no siblings.
+// The caller removes tempDir; nothing is left behind on error.
+func writePrivateCopy(filePath string, content []byte, mode os.FileMode) (tempDir, copyPath string, err error) {
+	tempDir, err = os.MkdirTemp("", "cpi-si-validate-*")
+	if err != nil {
+		return "", "", fmt.Errorf("cannot create temp copy: %w", err)
+	}
+	copyPath = filepath.Join(tempDir, filepath.Base(filePath)) // Same name - tools pick parsers by extension
+	if err := os.WriteFile(copyPath, content, mode); err != nil {
+		os.RemoveAll(tempDir)
+		return "", "", fmt.Errorf("cannot create temp copy: %w", err)
+	}
+	return tempDir, copyPath, nil
+}
+
+// args substitutes {filepath} and {filename} into a tool's arguments.
+//
+// Under stdin, arguments containing {filepath} are dropped - the content
+// arrives on stdin, and {filename} is left for tools that want a display name.
+func (in *validatorInput) args(args []string) []string {
+	name := filepath.Base(in.original)
+	substituted := make([]string, 0, len(args))
+	for _, arg := range args {
+		if in.mode == InputStdin && strings.Contains(arg, "{filepath}") {
+			continue
+		}
+		arg = strings.ReplaceAll(arg, "{filepath}", in.target)
+		substituted = append(substituted, strings.ReplaceAll(arg, "{filename}", name))
+	}
+	return substituted
+}
+
+// displayPaths rewrites the private copy's path in output to the original's.
+func (in *validatorInput) displayPaths(output []byte) []byte {
+	if in.tempDir == "" {
+		return output
+	}
+	return bytes.ReplaceAll(output, []byte(in.target), []byte(in.original))
+}
+
+// finish removes the private copy and checks the original is untouched.
+//
+// Returns "" when nothing happened to the original. When it changed during
+// the run, the snapshot is written back and the returned warning says so.
+func (in *validatorInput) finish(validatorName string) string {
+	if in.tempDir != "" {
+		os.RemoveAll(in.tempDir)
+	}
+	if in.snapshot == nil {
+		return ""
+	}
+
+	current, err := os.ReadFile(in.original)
+	if err == nil && sha256.Sum256(current) == in.snapshot.hash {
+		return ""
+	}
+
+	restoreErr := os.WriteFile(in.original, in.snapshot.content, in.snapshot.mode)
+	notice := fmt.Sprintf("validator %s modified %s during %s validation - original restored", validatorName, in.original, in.mode)
+	if restoreErr != nil {
+		notice = fmt.Sprintf("validator %s modified %s during %s validation - restore failed: %v", validatorName, in.original, in.mode, restoreErr)
+	}
+	logRails(func(logger *logging.Logger) {
+		logger.Failure("validator modified the file it checked", notice, healthStageFailure,
+			map[string]any{"validator": validatorName, "file": in.original, "input": in.mode, "restored": restoreErr == nil})
+	})
+	return notice
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Each mode against a fake validator script: arguments seen, content read,
+//     output paths, temp copy removed, mutated original restored
+//   - Run: go test ./... (input_test.go)
+//
+// Code Execution: None (Library) - prepared and finished per tool run by syntax.go
+//
+// Modification Policy:
+//   ✅ Safe: New tokens in args() (available in every mode)
+//   ⚠️ Care: New modes - anything but filepath must snapshot so finish() can check the original
+//   ❌ Never: Skipping finish() - the temp copy leaks and the original goes unchecked
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
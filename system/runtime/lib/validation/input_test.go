@@ -0,0 +1,131 @@
+//go:build linux
+
+// ============================================================================
+// METADATA
+// ============================================================================
+// Validator Input Mode Tests
+//
+// Purpose: Prove each input mode hands the file over as configured - the
+//          path itself, stdin with no path argument, or a private copy whose
+//          path never reaches the output - that the copy is removed, and that
+//          a tool writing to the original fails with the original restored.
+//
+// Linux-only: fake validators are /bin/sh scripts (useFakeValidator).
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// useInputValidator installs script as the fake validator with mode and args
+// (the script path goes first), and writes the file it will check.
+func useInputValidator(t *testing.T, mode, script string, args ...string) (file string) {
+	t.Helper()
+	dir := t.TempDir()
+	scriptPath := filepath.Join(dir, "validator.sh")
+	if err := os.WriteFile(scriptPath, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	file = filepath.Join(dir, "input.fake")
+	if err := os.WriteFile(file, []byte("bad line\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	useFakeValidator(t, scriptPath, 5)
+	validatorsConfig.Validators["fake"].Validators["fake_sleep"] = ValidatorTool{
+		Command: "/bin/sh", Args: append([]string{scriptPath}, args...), Enabled: true, Input: mode,
+	}
+	return file
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestInputFilePath(t *testing.T) {
+	file := useInputValidator(t, "", `echo "$1: $(cat "$1")"; echo "name $2"; exit 1`, "{filepath}", "{filename}")
+
+	result := runValidator(activeConfig(), "fake", "fake_sleep", file)
+	want := []string{file + ": bad line", "name input.fake"}
+	if result.Valid || strings.Join(result.Warnings, "\n") != strings.Join(want, "\n") {
+		t.Errorf("filepath: Valid=%v Warnings=%q, want %q", result.Valid, result.Warnings, want)
+	}
+}
+
+func TestInputStdin(t *testing.T) {
+	file := useInputValidator(t, InputStdin, `echo "args: $*"; echo "stdin: $(cat)"; exit 1`,
+		"--check", "{filepath}", "--stdin-filepath={filename}")
+
+	result := runValidator(activeConfig(), "fake", "fake_sleep", file)
+	want := []string{"args: --check --stdin-filepath=input.fake", "stdin: bad line"}
+	if result.Valid || strings.Join(result.Warnings, "\n") != strings.Join(want, "\n") {
+		t.Errorf("stdin: Valid=%v Warnings=%q, want %q (no path argument, content on stdin)", result.Valid, result.Warnings, want)
+	}
+}
+
+func TestInputTempCopy(t *testing.T) {
+	// Rewrites what it was given, as some "check" modes do, and records where that was
+	file := useInputValidator(t, InputTempCopy,
+		`echo "$1" > "$(dirname "$0")/seen"; echo "fixed" > "$1"; echo "$1:1: bad line"; exit 1`, "{filepath}")
+
+	result := runValidator(activeConfig(), "fake", "fake_sleep", file)
+	if result.Valid || len(result.Warnings) != 1 || result.Warnings[0] != file+":1: bad line" {
+		t.Errorf("tempcopy: Valid=%v Warnings=%q, want the finding on the original path", result.Valid, result.Warnings)
+	}
+
+	seen, err := os.ReadFile(filepath.Join(filepath.Dir(file), "seen"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	copyPath := strings.TrimSpace(string(seen))
+	if copyPath == file || filepath.Base(copyPath) != "input.fake" {
+		t.Errorf("validator got %s, want a same-named copy elsewhere", copyPath)
+	}
+	if _, err := os.Stat(filepath.Dir(copyPath)); !os.IsNotExist(err) {
+		t.Errorf("temp copy directory left behind: %v", err)
+	}
+	if content, _ := os.ReadFile(file); string(content) != "bad line\n" {
+		t.Errorf("original = %q, want untouched", content)
+	}
+}
+
+func TestInputTempCopyRestoresOriginal(t *testing.T) {
+	// Misconfigured: writes the real file no matter what it was handed
+	dir := t.TempDir()
+	original := filepath.Join(dir, "target.fake")
+	script := `echo "fixed" > "` + original + `"; exit 0`
+	useInputValidator(t, InputTempCopy, script, "{filepath}")
+	if err := os.WriteFile(original, []byte("bad line\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result := runValidator(activeConfig(), "fake", "fake_sleep", original)
+	if result.Valid || len(result.Warnings) != 1 || !strings.Contains(result.Warnings[0], "original restored") {
+		t.Errorf("mutating validator: Valid=%v Warnings=%q, want a failure saying the original was restored", result.Valid, result.Warnings)
+	}
+	if content, _ := os.ReadFile(original); string(content) != "bad line\n" {
+		t.Errorf("original = %q, want restored", content)
+	}
+}
+
+func TestInputUnknownMode(t *testing.T) {
+	file := useInputValidator(t, "pipe", "exit 0", "{filepath}")
+
+	result := runValidator(activeConfig(), "fake", "fake_sleep", file)
+	if result.Valid || len(result.Warnings) != 1 || !strings.Contains(result.Warnings[0], `unknown input mode "pipe"`) {
+		t.Errorf("unknown mode: Valid=%v Warnings=%q", result.Valid, result.Warnings)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
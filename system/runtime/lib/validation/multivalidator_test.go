@@ -0,0 +1,161 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Multi-Validator Tests
+//
+// Purpose: Prove enabled validators resolve in a stable priority order (lower
+//          first, unset after prioritized tools, ties by name), that
+//          run_all_validators runs every tool with Valid as the AND of their
+//          verdicts while the default runs the primary only, and that Report
+//          groups warnings under each tool that found something.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// captureStdout returns what fn prints to stdout
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	fn()
+	os.Stdout = stdout
+	w.Close()
+	out, _ := io.ReadAll(r)
+	return string(out)
+}
+
+// verdictRunner returns a passing or failing canned result per validator
+func verdictRunner(verdicts map[string]bool) toolRunner {
+	return func(cfg *ValidatorsConfig, language, validatorName, filePath string) ToolResult {
+		result := ToolResult{Validator: validatorName, Valid: verdicts[validatorName], Warnings: []string{}}
+		if !result.Valid {
+			result.Warnings = []string{validatorName + " finding"}
+		}
+		return result
+	}
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestEnabledValidatorOrder(t *testing.T) {
+	cases := []struct {
+		name  string
+		tools map[string]ValidatorTool
+		want  []string
+	}{
+		{"priority ascending", map[string]ValidatorTool{
+			"c": {Enabled: true, Priority: 1}, "a": {Enabled: true, Priority: 3}, "b": {Enabled: true, Priority: 2},
+		}, []string{"c", "b", "a"}},
+		{"unset after prioritized", map[string]ValidatorTool{
+			"a": {Enabled: true}, "z": {Enabled: true, Priority: 5},
+		}, []string{"z", "a"}},
+		{"ties by name", map[string]ValidatorTool{
+			"vet": {Enabled: true, Priority: 1}, "build": {Enabled: true, Priority: 1}, "lint": {Enabled: true},
+			"check": {Enabled: true},
+		}, []string{"build", "vet", "check", "lint"}},
+		{"disabled left out", map[string]ValidatorTool{
+			"on": {Enabled: true, Priority: 2}, "off": {Enabled: false, Priority: 1},
+		}, []string{"on"}},
+	}
+
+	for _, tc := range cases {
+		cfg := &ValidatorsConfig{Validators: map[string]LanguageValidators{"fake": {Validators: tc.tools}}}
+		for range 5 { // Map iteration is random - the order must not be
+			if got := getEnabledValidators(cfg, "fake"); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("%s: order = %q, want %q", tc.name, got, tc.want)
+				break
+			}
+		}
+	}
+}
+
+func TestRunAllValidatorsMerge(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "a.fake")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name      string
+		runAll    bool
+		verdicts  map[string]bool // Keyed by lint (primary), compile, vet
+		valid     bool
+		wantTools []string
+	}{
+		{"all pass", true, map[string]bool{"lint": true, "compile": true, "vet": true}, true, []string{"lint", "compile", "vet"}},
+		{"one fails", true, map[string]bool{"lint": true, "compile": false, "vet": true}, false, []string{"lint", "compile", "vet"}},
+		{"last fails", true, map[string]bool{"lint": true, "compile": true, "vet": false}, false, []string{"lint", "compile", "vet"}},
+		{"primary only passes", false, map[string]bool{"lint": true, "compile": false, "vet": false}, true, []string{"lint"}},
+		{"primary only fails", false, map[string]bool{"lint": false, "compile": true, "vet": true}, false, []string{"lint"}},
+	}
+
+	for _, tc := range cases {
+		useStrictnessConfig(t, StrictnessStrict, "lint", "compile", "vet")
+		validatorsConfig.Config.RunAllValidators = tc.runAll
+		result := validateFile(file, ".fake", verdictRunner(tc.verdicts))
+
+		var ran []string
+		for _, tool := range result.ToolResults {
+			ran = append(ran, tool.Validator)
+		}
+		if !reflect.DeepEqual(ran, tc.wantTools) {
+			t.Errorf("%s: ran %q, want %q", tc.name, ran, tc.wantTools)
+		}
+		if result.Valid != tc.valid {
+			t.Errorf("%s: Valid = %v, want %v", tc.name, result.Valid, tc.valid)
+		}
+		if result.Validator != strings.Join(tc.wantTools, ", ") {
+			t.Errorf("%s: Validator = %q", tc.name, result.Validator)
+		}
+	}
+}
+
+func TestReportGroupsPerTool(t *testing.T) {
+	result := &ValidationResult{
+		Language:  "fake",
+		Validator: "lint, compile, vet",
+		Warnings:  []string{"lint finding", "vet finding"},
+		ToolResults: []ToolResult{
+			{Validator: "lint", Warnings: []string{"lint finding"}},
+			{Validator: "compile", Valid: true, Warnings: []string{}}, // Clean - no group
+			{Validator: "vet", Warnings: []string{"vet finding"}},
+		},
+	}
+
+	out := captureStdout(t, result.Report)
+	lint, vet := strings.Index(out, "   lint:\n      lint finding"), strings.Index(out, "   vet:\n      vet finding")
+	if lint < 0 || vet < lint {
+		t.Errorf("warnings not grouped lint then vet:\n%s", out)
+	}
+	if strings.Contains(out, "compile:") {
+		t.Errorf("clean tool got a group:\n%s", out)
+	}
+
+	single := &ValidationResult{Warnings: []string{"only finding"}, ToolResults: []ToolResult{{Validator: "lint"}}}
+	if out := captureStdout(t, single.Report); strings.Contains(out, "lint:") || !strings.Contains(out, "   only finding\n") {
+		t.Errorf("single tool should print a flat list:\n%s", out)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
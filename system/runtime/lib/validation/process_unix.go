@@ -0,0 +1,57 @@
+//go:build !windows
+
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Validator Process Control (Unix) - Syntax Validation Library
+//
+// Biblical Foundation: See syntax.go
+// CPI-SI Identity: Platform primitive for validator execution
+//
+// Purpose: Run each validator in its own process group so a timeout kills the
+//          whole tree (eslint via npx, shell wrappers) instead of orphaning
+//          grandchildren that hold the output pipe open.
+//
+// Authorship: Nova Dawn
+// Version: 1.0.0
+//
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os/exec" // Command process attributes and cancellation
+	"syscall" // Process group creation and signalling
+	"time"    // Pipe drain grace period
+)
+
+// killGracePeriod bounds how long Wait keeps draining output after the kill.
+const killGracePeriod = 2 * time.Second
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// configureProcessKill makes context cancellation kill the validator's process group.
+//
+// Setpgid puts the validator and everything it spawns into a new group led by
+// the validator PID; Cancel signals the negative PID so the whole group dies.
+// WaitDelay guarantees Wait returns even if something still holds the pipes.
+func configureProcessKill(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL) // Negative PID = process group
+	}
+	cmd.WaitDelay = killGracePeriod
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with syntax.go (go build ./validation)
+// Code Execution: Library primitive (called by buildValidatorCommand)
+// Code Cleanup: Process group reaped by exec.Cmd.Wait
@@ -0,0 +1,47 @@
+//go:build windows
+
+// ============================================================================
+// METADATA
+// ============================================================================
+//
+// Validator Process Control (Windows) - Syntax Validation Library
+//
+// Biblical Foundation: See syntax.go
+// CPI-SI Identity: Platform primitive for validator execution
+//
+// Purpose: Windows has no process groups in the Unix sense; rely on the default
+//          context kill of the validator process and bound the pipe drain.
+//
+// Authorship: Nova Dawn
+// Version: 1.0.0
+//
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os/exec" // Command cancellation settings
+	"time"    // Pipe drain grace period
+)
+
+// killGracePeriod bounds how long Wait keeps draining output after the kill.
+const killGracePeriod = 2 * time.Second
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// configureProcessKill bounds Wait after the default context kill.
+func configureProcessKill(cmd *exec.Cmd) {
+	cmd.WaitDelay = killGracePeriod
+}
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// Code Validation: Compile with syntax.go (GOOS=windows go build ./validation)
+// Code Execution: Library primitive (called by buildValidatorCommand)
+// Code Cleanup: Process reaped by exec.Cmd.Wait
@@ -0,0 +1,478 @@
+// METADATA
+//
+// Project Validator Overrides - CPI-SI Runtime System
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Every way of a man is right in his own eyes: but the LORD pondereth the hearts." - Proverbs 21:2 (KJV)
+// Principle: Each project knows its own conventions - honor them over one-size-fits-all defaults
+// Anchor: "Let each man test his own work." - Galatians 6:4 (WEB)
+//
+// CPI-SI Identity
+//
+// Component Type: LIBRARY - Runtime validation support (mid-rung on ladder)
+// Role: Layers a repository's .cpi-si-validators.jsonc over the global validators config
+// Paradigm: Sparse overrides - a project states only what differs from global
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn (CPI-SI instance)
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.3.0
+// Last Modified: 2026-10-16 - Tool env and path_prepend overrides
+//
+// Version History:
+//   1.3.0 (2026-10-16) - Tool overrides carry env and path_prepend (env.go)
+//   1.2.0 (2026-10-16) - config.ignore and per-language ignore add to the global globs (ignore.go)
+//   1.1.0 (2026-10-16) - "policy" rules merge ahead of validators.jsonc rules (decision.go)
+//   1.0.0 (2026-10-16) - Project config discovery, merge, per-root cache
+//
+// Purpose & Function
+//
+// Purpose: Let a repository enable/disable tools, override args and strictness, and add
+// languages/extensions without touching the user's global ~/.claude config.
+//
+// Core Design: When validating a file, walk upward for .cpi-si-validators.jsonc. If found,
+// parse it as a sparse override (only fields present apply) and merge over a copy of the
+// global config. Merged configs are cached per project directory for the process lifetime.
+//
+// Precedence: project > global (validators.jsonc) > hardcoded defaults.
+//
+// Override File Format (same shape as validators.jsonc, every field optional):
+//
+//	{
+//	  "validators": {
+//	    "go": { "validators": { "staticcheck": { "enabled": true } } },
+//	    "zig": { "validators": { "zig_check": { "command": "zig", "args": ["ast-check", "{filepath}"], "enabled": true } } }
+//	  },
+//	  "extensions": { ".zig": "zig" },
+//	  "config": { "strictness": "strict", "run_all_validators": true },
+//	  "policy": { "rules": [{ "severity": "warning", "action": "report" }] }
+//	}
+//
+// Project policy rules are tried before the global ones, so a repository's
+// rules win wherever both match. Project "ignore" globs (config and per
+// language) are added after the global ones; .validationignore is usually
+// the simpler place for them.
+//
+// Blocking Status
+//
+// Non-blocking: A malformed project file prints one warning and validation proceeds with
+// the global config.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: encoding/json, fmt, os, path/filepath, sync
+//   Internal: system/lib/display, system/lib/jsonc
+//
+// Dependents (What Uses This):
+//   Libraries: syntax.go (validateFile), batch.go (collectFiles)
+//
+// Health Scoring
+//
+// Part of ValidateFile's config resolution - included in its scoring.
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"encoding/json" // Override file parsing
+	"fmt"           // Warning formatting
+	"os"            // Override file reading, warning output
+	"path/filepath" // Override file path
+	"sync"          // Per-root cache guard
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/display" // Malformed-override warning
+	"system/lib/jsonc"   // Comment stripping for .jsonc
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+// projectConfigName is the per-repository override file looked for above each file.
+const projectConfigName = ".cpi-si-validators.jsonc"
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// toolOverride is a sparse ValidatorTool: nil fields inherit from global.
+type toolOverride struct {
+	Command           *string           `json:"command"`
+	Args              []string          `json:"args"` // nil = inherit, [] = no args
+	Enabled           *bool             `json:"enabled"`
+	Type              *string           `json:"type"`
+	Severity          *string           `json:"severity"`
+	Description       *string           `json:"description"`
+	CheckAvailability *string           `json:"check_availability"`
+	WorkingDir        *string           `json:"working_dir"`
+	Input             *string           `json:"input"`
+	Priority          *int              `json:"priority"`
+	Fix               *FixConfig        `json:"fix"`          // Replaces the whole fix block
+	Env               map[string]string `json:"env"`          // nil = inherit; replaces the whole map (env.go)
+	PathPrepend       []string          `json:"path_prepend"` // nil = inherit, [] = none (env.go)
+}
+
+// languageOverride is a sparse LanguageValidators.
+type languageOverride struct {
+	Description *string                 `json:"description"`
+	Validators  map[string]toolOverride `json:"validators"`
+	Ignore      []string                `json:"ignore"` // Added to the global language globs
+}
+
+// UnmarshalJSON tolerates "note" annotation strings, as validators.jsonc does.
+func (l *languageOverride) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' { // Annotation entry, not a language
+		return nil
+	}
+	type plain languageOverride // Avoid recursing into this method
+	return json.Unmarshal(data, (*plain)(l))
+}
+
+// projectOverride is the parsed .cpi-si-validators.jsonc.
+type projectOverride struct {
+	Validators map[string]languageOverride `json:"validators"`
+	Extensions map[string]string           `json:"extensions"`
+	Shebangs   map[string]string           `json:"shebangs"`
+	Config     struct {
+		Strictness             *string           `json:"strictness"`
+		LanguageStrictness     map[string]string `json:"language_strictness"`
+		FailOnMissingValidator *bool             `json:"fail_on_missing_validator"`
+		RunAllValidators       *bool             `json:"run_all_validators"`
+		FilterByFile           *bool             `json:"filter_by_file"`
+		TimeoutSeconds         *int              `json:"timeout_seconds"`
+		ReportMode             *string           `json:"report_mode"`
+		ReportMaxLines         *int              `json:"report_max_lines"`
+		MaxFileSizeMB          *int              `json:"max_file_size_mb"`
+		LanguageMaxFileSizeMB  map[string]int    `json:"language_max_file_size_mb"`
+		MaxOutputBytes         *int              `json:"max_output_bytes"`
+		ResultsPath            *string           `json:"results_path"`
+		ResultsFormat          *string           `json:"results_format"`
+		Ignore                 []string          `json:"ignore"` // Added to the global globs
+	} `json:"config"`
+	Policy struct {
+		Rules []PolicyRule `json:"rules"`
+	} `json:"policy"`
+}
+
+// ────────────────────────────────────────────────────────────────
+// Package-Level State
+// ────────────────────────────────────────────────────────────────
+
+// projectConfigs caches merged configs by project directory ("" key = no
+// override found). Override files are read once per process, like the global.
+var (
+	projectConfigs   = map[string]*ValidatorsConfig{}
+	projectConfigsMu sync.Mutex
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Core Operations
+//   └── configForFile() → uses findMarkerDir(), loadProjectOverride(), mergeProjectConfig()
+//
+//   Helpers
+//   ├── loadProjectOverride() → uses jsonc.StripComments()
+//   ├── mergeProjectConfig() → uses cloneConfig(), applyToolOverride()
+//   ├── cloneConfig() → pure function
+//   └── applyToolOverride() → pure function
+
+// ────────────────────────────────────────────────────────────────
+// HELPERS: Loading & Merging
+// ────────────────────────────────────────────────────────────────
+
+// loadProjectOverride reads and parses a project override file.
+func loadProjectOverride(path string) (*projectOverride, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var override projectOverride
+	if err := json.Unmarshal(jsonc.StripComments(data), &override); err != nil {
+		return nil, err
+	}
+	return &override, nil
+}
+
+// cloneConfig copies a config deeply enough that merging never touches the global.
+// A nil base yields an empty config, so project settings still layer over defaults.
+func cloneConfig(base *ValidatorsConfig) *ValidatorsConfig {
+	clone := &ValidatorsConfig{
+		Validators: make(map[string]LanguageValidators),
+		Extensions: make(map[string]string),
+		Shebangs:   make(map[string]string),
+	}
+	if base == nil {
+		return clone
+	}
+
+	clone.Metadata = base.Metadata
+	clone.Config = base.Config
+	clone.Policy = base.Policy // Rules are replaced, never mutated
+	for ext, language := range base.Extensions {
+		clone.Extensions[ext] = language
+	}
+	for interpreter, language := range base.Shebangs {
+		clone.Shebangs[interpreter] = language
+	}
+	for language, langValidators := range base.Validators {
+		tools := make(map[string]ValidatorTool, len(langValidators.Validators))
+		for name, tool := range langValidators.Validators {
+			tools[name] = tool // Args, Env, PathPrepend are replaced, never mutated, so sharing is safe
+		}
+		clone.Validators[language] = LanguageValidators{
+			Description: langValidators.Description,
+			Validators:  tools,
+			Ignore:      langValidators.Ignore, // Replaced, never mutated
+		}
+	}
+	return clone
+}
+
+// applyToolOverride returns tool with every field the override sets replaced.
+func applyToolOverride(tool ValidatorTool, override toolOverride) ValidatorTool {
+	if override.Command != nil {
+		tool.Command = *override.Command
+	}
+	if override.Args != nil {
+		tool.Args = override.Args
+	}
+	if override.Enabled != nil {
+		tool.Enabled = *override.Enabled
+	}
+	if override.Type != nil {
+		tool.Type = *override.Type
+	}
+	if override.Severity != nil {
+		tool.Severity = *override.Severity
+	}
+	if override.Description != nil {
+		tool.Description = *override.Description
+	}
+	if override.CheckAvailability != nil {
+		tool.CheckAvailability = *override.CheckAvailability
+	}
+	if override.WorkingDir != nil {
+		tool.WorkingDir = *override.WorkingDir
+	}
+	if override.Input != nil {
+		tool.Input = *override.Input
+	}
+	if override.Priority != nil {
+		tool.Priority = *override.Priority
+	}
+	if override.Fix != nil {
+		tool.Fix = override.Fix
+	}
+	if override.Env != nil {
+		tool.Env = override.Env
+	}
+	if override.PathPrepend != nil {
+		tool.PathPrepend = override.PathPrepend
+	}
+	return tool
+}
+
+// mergeProjectConfig layers a project override over the global config (nil = defaults).
+//
+// Tools present in both are merged field by field; tools or languages only in
+// the project are added as given; extensions, shebangs and config keys override.
+func mergeProjectConfig(global *ValidatorsConfig, override *projectOverride) *ValidatorsConfig {
+	merged := cloneConfig(global)
+
+	for language, langOverride := range override.Validators {
+		langValidators, exists := merged.Validators[language]
+		if !exists {
+			langValidators = LanguageValidators{Validators: make(map[string]ValidatorTool)}
+		}
+		if langOverride.Description != nil {
+			langValidators.Description = *langOverride.Description
+		}
+		for name, toolOver := range langOverride.Validators {
+			langValidators.Validators[name] = applyToolOverride(langValidators.Validators[name], toolOver)
+		}
+		if len(langOverride.Ignore) > 0 {
+			langValidators.Ignore = append(append([]string{}, langValidators.Ignore...), langOverride.Ignore...)
+		}
+		merged.Validators[language] = langValidators
+	}
+
+	for ext, language := range override.Extensions {
+		merged.Extensions[ext] = language
+	}
+	for interpreter, language := range override.Shebangs {
+		merged.Shebangs[interpreter] = language
+	}
+
+	// Without a global config, extensions the project doesn't map still need defaults
+	if global == nil {
+		for ext, language := range getDefaultExtensionMap() {
+			if _, exists := merged.Extensions[ext]; !exists {
+				merged.Extensions[ext] = language
+			}
+		}
+		for interpreter, language := range getDefaultShebangMap() {
+			if _, exists := merged.Shebangs[interpreter]; !exists {
+				merged.Shebangs[interpreter] = language
+			}
+		}
+	}
+
+	if override.Config.Strictness != nil {
+		merged.Config.Strictness = *override.Config.Strictness
+	}
+	if override.Config.FailOnMissingValidator != nil {
+		merged.Config.FailOnMissingValidator = *override.Config.FailOnMissingValidator
+	}
+	if override.Config.RunAllValidators != nil {
+		merged.Config.RunAllValidators = *override.Config.RunAllValidators
+	}
+	if override.Config.FilterByFile != nil {
+		merged.Config.FilterByFile = *override.Config.FilterByFile
+	}
+	if override.Config.TimeoutSeconds != nil {
+		merged.Config.TimeoutSeconds = *override.Config.TimeoutSeconds
+	}
+	if override.Config.ReportMode != nil {
+		merged.Config.ReportMode = *override.Config.ReportMode
+	}
+	if override.Config.ReportMaxLines != nil {
+		merged.Config.ReportMaxLines = *override.Config.ReportMaxLines
+	}
+	if override.Config.MaxFileSizeMB != nil {
+		merged.Config.MaxFileSizeMB = *override.Config.MaxFileSizeMB
+	}
+	if override.Config.MaxOutputBytes != nil {
+		merged.Config.MaxOutputBytes = *override.Config.MaxOutputBytes
+	}
+	if override.Config.ResultsPath != nil {
+		merged.Config.ResultsPath = *override.Config.ResultsPath
+	}
+	if override.Config.ResultsFormat != nil {
+		merged.Config.ResultsFormat = *override.Config.ResultsFormat
+	}
+	if len(override.Config.LanguageMaxFileSizeMB) > 0 { // Per language, over the global table
+		limits := make(map[string]int, len(merged.Config.LanguageMaxFileSizeMB)+len(override.Config.LanguageMaxFileSizeMB))
+		for language, mb := range merged.Config.LanguageMaxFileSizeMB {
+			limits[language] = mb
+		}
+		for language, mb := range override.Config.LanguageMaxFileSizeMB {
+			limits[language] = mb
+		}
+		merged.Config.LanguageMaxFileSizeMB = limits
+	}
+	if len(override.Config.LanguageStrictness) > 0 { // Per language, over the global table
+		modes := make(map[string]string, len(merged.Config.LanguageStrictness)+len(override.Config.LanguageStrictness))
+		for language, mode := range merged.Config.LanguageStrictness {
+			modes[language] = mode
+		}
+		for language, mode := range override.Config.LanguageStrictness {
+			modes[language] = mode
+		}
+		merged.Config.LanguageStrictness = modes
+	}
+	if len(override.Config.Ignore) > 0 { // Project globs after the global ones
+		merged.Config.Ignore = append(append([]string{}, merged.Config.Ignore...), override.Config.Ignore...)
+	}
+	if len(override.Policy.Rules) > 0 { // Project rules first - first match wins
+		merged.Policy.Rules = append(append([]PolicyRule{}, override.Policy.Rules...), merged.Policy.Rules...)
+	}
+
+	return merged
+}
+
+// ────────────────────────────────────────────────────────────────
+// CORE OPERATIONS: Config Resolution
+// ────────────────────────────────────────────────────────────────
+
+// configForFile returns the effective validators config for a file.
+//
+// Walks upward from filePath for .cpi-si-validators.jsonc. With none found,
+// returns the global config (nil = hardcoded defaults). With one found,
+// returns the global merged with it, cached per project directory. A file
+// that fails to parse prints a warning once and the global config is used.
+//
+// Parameters:
+//   - filePath: File about to be validated
+//
+// Returns:
+//   - Effective config; nil means hardcoded defaults
+func configForFile(filePath string) *ValidatorsConfig {
+	global := activeConfig()
+
+	projectDir := findMarkerDir(filePath, []string{projectConfigName})
+	if projectDir == "" {
+		return global
+	}
+
+	projectConfigsMu.Lock()
+	defer projectConfigsMu.Unlock()
+
+	if cached, exists := projectConfigs[projectDir]; exists {
+		return cached
+	}
+
+	overridePath := filepath.Join(projectDir, projectConfigName)
+	merged := global
+	if override, err := loadProjectOverride(overridePath); err != nil {
+		fmt.Fprintln(os.Stderr, display.Warning(fmt.Sprintf("Ignoring %s: %v (using global validators config)", overridePath, err)))
+	} else {
+		merged = mergeProjectConfig(global, override)
+	}
+
+	projectConfigs[projectDir] = merged
+	return merged
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Code Validation: Testing Requirements
+// ────────────────────────────────────────────────────────────────
+//
+// Testing Requirements:
+//   - project_test.go builds temp project trees: enable override, arg override,
+//     new language + extension, malformed file falls back to global
+//   - Run: go test ./...
+
+// ────────────────────────────────────────────────────────────────
+// Code Execution: None (Library)
+// ────────────────────────────────────────────────────────────────
+//
+// Consulted by validateFile() for every file; no entry point of its own.
+
+// ============================================================================
+// END CLOSING
+// ============================================================================
@@ -0,0 +1,129 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Project Validator Override Tests
+//
+// Purpose: Prove .cpi-si-validators.jsonc layers over the global config -
+//          enabling tools, overriding args, adding languages - and that a
+//          malformed override falls back to the global config.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// useGlobalConfig installs a small global config and clears the project cache.
+func useGlobalConfig(t *testing.T) {
+	t.Helper()
+
+	savedConfig, savedLoaded := validatorsConfig, validatorsConfigLoaded
+	t.Cleanup(func() {
+		validatorsConfig, validatorsConfigLoaded = savedConfig, savedLoaded
+		projectConfigs = map[string]*ValidatorsConfig{}
+	})
+	projectConfigs = map[string]*ValidatorsConfig{}
+
+	validatorsConfig = &ValidatorsConfig{
+		Validators: map[string]LanguageValidators{
+			"go": {Validators: map[string]ValidatorTool{
+				"go_vet":      {Command: "go", Args: []string{"vet", "{filepath}"}, Enabled: true, Priority: 1},
+				"staticcheck": {Command: "staticcheck", Args: []string{"{filepath}"}, Enabled: false, Priority: 2},
+			}},
+		},
+		Extensions: map[string]string{".go": "go"},
+	}
+	validatorsConfigLoaded = true
+}
+
+// projectTree writes an override file at a temp project root and returns a
+// file path two directories below it.
+func projectTree(t *testing.T, override string) string {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, projectConfigName), []byte(override), 0644); err != nil {
+		t.Fatal(err)
+	}
+	nested := filepath.Join(root, "pkg", "sub")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatal(err)
+	}
+	return filepath.Join(nested, "main.go")
+}
+
+// TestProjectOverrideEnablesTool checks a project can switch on a globally disabled tool.
+func TestProjectOverrideEnablesTool(t *testing.T) {
+	useGlobalConfig(t)
+	file := projectTree(t, `{
+		// Project wants staticcheck too
+		"validators": { "go": { "validators": { "staticcheck": { "enabled": true } } } },
+		"config": { "run_all_validators": true }
+	}`)
+
+	cfg := configForFile(file)
+	if got, want := getEnabledValidators(cfg, "go"), []string{"go_vet", "staticcheck"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("enabled validators = %v, want %v", got, want)
+	}
+	if !cfg.Config.RunAllValidators {
+		t.Error("run_all_validators override not applied")
+	}
+	if validatorsConfig.Validators["go"].Validators["staticcheck"].Enabled {
+		t.Error("merge modified the global config")
+	}
+}
+
+// TestProjectOverrideArgs checks args are replaced while unset fields inherit.
+func TestProjectOverrideArgs(t *testing.T) {
+	useGlobalConfig(t)
+	file := projectTree(t, `{"validators": {"go": {"validators": {"go_vet": {"args": ["vet", "-composites=false", "{filepath}"]}}}}}`)
+
+	tool := resolveValidatorTool(configForFile(file), "go", "go_vet")
+	if want := []string{"vet", "-composites=false", "{filepath}"}; !reflect.DeepEqual(tool.Args, want) {
+		t.Errorf("args = %v, want %v", tool.Args, want)
+	}
+	if tool.Command != "go" || !tool.Enabled || tool.Priority != 1 {
+		t.Errorf("unset fields not inherited: %+v", tool)
+	}
+}
+
+// TestProjectOverrideNewLanguage checks a project can add a language and extension.
+func TestProjectOverrideNewLanguage(t *testing.T) {
+	useGlobalConfig(t)
+	file := projectTree(t, `{
+		"validators": { "zig": { "validators": { "zig_check": { "command": "zig", "args": ["ast-check", "{filepath}"], "enabled": true } } } },
+		"extensions": { ".zig": "zig" }
+	}`)
+
+	cfg := configForFile(file)
+	if language := getValidatorLanguage(cfg, ".zig"); language != "zig" {
+		t.Fatalf(".zig language = %q, want zig", language)
+	}
+	if validator := getPrimaryValidator(cfg, "zig"); validator != "zig_check" {
+		t.Errorf("zig validator = %q, want zig_check", validator)
+	}
+	if language := getValidatorLanguage(activeConfig(), ".zig"); language != "" {
+		t.Errorf("global config gained .zig mapping: %q", language)
+	}
+}
+
+// TestProjectOverrideMalformed checks a broken override falls back to global.
+func TestProjectOverrideMalformed(t *testing.T) {
+	useGlobalConfig(t)
+	file := projectTree(t, `{"validators": {"go": `)
+
+	if cfg := configForFile(file); cfg != validatorsConfig {
+		t.Error("malformed override did not fall back to the global config")
+	}
+}
@@ -0,0 +1,411 @@
+// METADATA
+//
+// Validation Reporting Modes - CPI-SI Runtime System
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "A word fitly spoken is like apples of gold in pictures of silver." - Proverbs 25:11 (KJV)
+// Principle: Say what matters most first, and no more than the moment can hold
+// Anchor: "Let your speech always be with grace, seasoned with salt." - Colossians 4:6 (WEB)
+//
+// CPI-SI Identity
+//
+// Component Type: LIBRARY - Runtime validation support (mid-rung on ladder)
+// Role: Renders ValidationResult at the verbosity the moment calls for
+// Paradigm: Same ordered, colored lines - full, truncated, or summarized
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn (CPI-SI instance)
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.1.0
+// Last Modified: 2026-10-16 - Output truncated note
+//
+// Version History:
+//   1.1.0 (2026-10-16) - Every mode says "output truncated" when a validator hit max_output_bytes
+//   1.0.0 (2026-10-16) - ReportSummary, ReportN, ReportConfigured, severity ordering/coloring
+//
+// Purpose & Function
+//
+// Purpose: Keep a noisy validator run (eslint, clippy) from flooding the terminal right
+// after a file write. Callers pick full output, the first N lines, or a single summary
+// line; the post-use hook lets config.report_mode decide.
+//
+// Core Design: Warning lines that match a parsed Diagnostic are ordered errors → warnings
+// → info, then by line number, and colored by severity. Lines with no Diagnostic (tool
+// banners, summaries) keep their original order after the located findings.
+//
+// Modes (config.report_mode):
+//   - "full" (default): Report() - every line
+//   - "truncated": ReportN(config.report_max_lines) - first N lines + "…and N more"
+//   - "summary": ReportSummary() - "✗ 3 errors, 7 warnings in file.go — run /validate for details"
+//
+// Blocking Status
+//
+// Non-blocking: Display only.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: fmt, path/filepath, sort, strings
+//   Internal: system/lib/display
+//
+// Dependents (What Uses This):
+//   Hooks: tool/post-use (via decision.go Decision.Present)
+//   Libraries: syntax.go (Report), batch.go (BatchResult.Report), decision.go (Present)
+//
+// Health Scoring
+//
+// Part of ValidateFile's display integration (10 points).
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"fmt"           // Output
+	"path/filepath" // File base name in summaries
+	"sort"          // Severity/line ordering
+	"strings"       // Line cleanup and summary assembly
+
+	//--- Internal Packages ---
+	// Project-specific packages showing architectural dependencies.
+
+	"system/lib/display" // Status icons and severity colors
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+// Report modes accepted in config.report_mode.
+const (
+	ReportModeFull      = "full"
+	ReportModeTruncated = "truncated"
+	ReportModeSummary   = "summary"
+)
+
+// defaultReportMaxLines caps "truncated" mode when config.report_max_lines is unset.
+const defaultReportMaxLines = 20
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// reportLine is one warning line ready to print, ranked for ordering.
+type reportLine struct {
+	text     string // Trimmed warning text
+	severity string // Severity of the matching Diagnostic ("" if none)
+	line     int    // Line of the matching Diagnostic (0 if none)
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs
+//   ├── ReportConfigured() → uses configForFile(), ReportN(), ReportSummary()
+//   ├── ReportN() → uses printSkipped(), printWarnings(), printTruncated()
+//   └── ReportSummary() → uses printSkipped(), plural(), printTruncated()
+//
+//   Helpers
+//   ├── printWarnings() → uses orderedLines(), severityColor()
+//   ├── printSkipped() → pure output
+//   ├── printTruncated() → pure output
+//   ├── orderedLines() → uses severityRank()
+//   ├── severityRank() → pure function
+//   ├── severityColor() → uses display.GetConfig()
+//   └── plural() → pure function
+
+// ────────────────────────────────────────────────────────────────
+// HELPERS: Ordering & Color
+// ────────────────────────────────────────────────────────────────
+
+// severityRank orders errors before warnings before info before unlocated lines.
+func severityRank(severity string) int {
+	switch severity {
+	case SeverityError:
+		return 0
+	case SeverityWarning:
+		return 1
+	case SeverityInfo:
+		return 2
+	}
+	return 3
+}
+
+// severityColor returns the ANSI color for a severity, "" for uncolored lines.
+func severityColor(severity string) string {
+	// Config with tripwires - colors
+	cfg := display.GetConfig()
+
+	switch severity {
+	case SeverityError:
+		if cfg.Colors.Foreground.Red != "" {
+			return cfg.Colors.Foreground.Red
+		}
+		return display.Red
+	case SeverityWarning:
+		if cfg.Colors.Foreground.Yellow != "" {
+			return cfg.Colors.Foreground.Yellow
+		}
+		return display.Yellow
+	}
+	return ""
+}
+
+// orderedLines pairs warnings with their Diagnostics and sorts them.
+//
+// Located lines sort by severity then line number; unlocated lines (banners,
+// summaries) keep output order after them. The sort is stable, so equal
+// findings keep the tool's own order.
+func orderedLines(warnings []string, diagnostics []Diagnostic) []reportLine {
+	bySource := make(map[string]Diagnostic, len(diagnostics))
+	for _, d := range diagnostics {
+		if _, exists := bySource[d.source]; !exists {
+			bySource[d.source] = d
+		}
+	}
+
+	lines := make([]reportLine, 0, len(warnings))
+	for _, warning := range warnings {
+		entry := reportLine{text: strings.TrimSpace(warning)}
+		if d, exists := bySource[entry.text]; exists {
+			entry.severity, entry.line = d.Severity, d.Line
+		}
+		lines = append(lines, entry)
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		ri, rj := severityRank(lines[i].severity), severityRank(lines[j].severity)
+		if ri != rj {
+			return ri < rj
+		}
+		return lines[i].line < lines[j].line
+	})
+	return lines
+}
+
+// plural formats "1 error" / "3 errors".
+func plural(n int, word string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, word)
+	}
+	return fmt.Sprintf("%d %ss", n, word)
+}
+
+// ────────────────────────────────────────────────────────────────
+// HELPERS: Printing
+// ────────────────────────────────────────────────────────────────
+
+// printSkipped prints one info line per skipped validator.
+// Skips surface even on success - the fix is one install away.
+func (v *ValidationResult) printSkipped() {
+	for _, skipped := range v.Skipped {
+		fmt.Println(display.Info("Skipped " + skipped.Validator + ": " + skipped.Reason))
+	}
+}
+
+// printTruncated notes that validator output was cut at max_output_bytes.
+func (v *ValidationResult) printTruncated() {
+	if v.Truncated {
+		fmt.Println(display.Info("Validator output truncated (config.max_output_bytes) - run the validator directly for the rest"))
+	}
+}
+
+// printWarnings prints ordered, colored warnings under indent, grouped per
+// tool when several ran. limit > 0 stops after that many lines and prints
+// "…and N more". Shared by Report(), ReportN(), and BatchResult.Report().
+func (v *ValidationResult) printWarnings(indent string, limit int) {
+	type group struct {
+		title string
+		lines []reportLine
+	}
+
+	// Single tool: flat warning list. Multiple tools: one group per tool.
+	var groups []group
+	if len(v.ToolResults) <= 1 {
+		groups = append(groups, group{lines: orderedLines(v.Warnings, v.Diagnostics)})
+	} else {
+		for _, tool := range v.ToolResults {
+			if tool.Valid && len(tool.Warnings) == 0 {
+				continue // Clean tool - nothing to show
+			}
+			groups = append(groups, group{title: tool.Validator, lines: orderedLines(tool.Warnings, tool.Diagnostics)})
+		}
+	}
+
+	total := 0
+	for _, g := range groups {
+		total += len(g.lines)
+	}
+
+	printed := 0
+	for _, g := range groups {
+		if limit > 0 && printed >= limit {
+			break
+		}
+		lineIndent := indent
+		if g.title != "" {
+			fmt.Println(indent + g.title + ":")
+			lineIndent = indent + "   "
+		}
+		for _, line := range g.lines {
+			if limit > 0 && printed >= limit {
+				break
+			}
+			if color := severityColor(line.severity); color != "" {
+				fmt.Println(lineIndent + color + line.text + display.Reset)
+			} else {
+				fmt.Println(lineIndent + line.text)
+			}
+			printed++
+		}
+	}
+
+	if printed < total {
+		fmt.Println(indent + fmt.Sprintf("…and %d more", total-printed))
+	}
+}
+
+// ────────────────────────────────────────────────────────────────
+// PUBLIC API: Reporting Modes
+// ────────────────────────────────────────────────────────────────
+
+// ReportN displays validation warnings, truncated to maxLines.
+//
+// Same output as Report() (skip notes, header, ordered colored warnings) but
+// stops after maxLines warning lines with an "…and N more" suffix. maxLines
+// <= 0 means no limit.
+//
+// Parameters:
+//   - maxLines: Maximum warning lines to print (group titles not counted)
+func (v *ValidationResult) ReportN(maxLines int) {
+	if v == nil {
+		return
+	}
+
+	v.printSkipped()
+	if v.Valid {
+		return // Silent success
+	}
+
+	// Display validation failure with context
+	header := "Validation warnings"
+	if v.Language != "" && v.Validator != "" {
+		header = "Validation warnings (" + v.Language + " / " + v.Validator + ")"
+	}
+	fmt.Println(display.Warning(header))
+
+	v.printWarnings("   ", maxLines)
+	v.printTruncated()
+}
+
+// ReportSummary displays a single line summarizing the result.
+//
+// Example: "✗ 3 errors, 7 warnings in file.go — run /validate for details".
+// Counts come from Diagnostics; when the tool output had no locatable
+// findings, the warning line count is reported as issues instead.
+func (v *ValidationResult) ReportSummary() {
+	if v == nil {
+		return
+	}
+
+	v.printSkipped()
+	if v.Valid {
+		return // Silent success
+	}
+
+	counts := make(map[string]int)
+	for _, d := range v.Diagnostics {
+		counts[d.Severity]++
+	}
+
+	var parts []string
+	if len(v.Diagnostics) == 0 {
+		parts = append(parts, plural(len(v.Warnings), "issue"))
+	} else {
+		parts = append(parts, plural(counts[SeverityError], "error"), plural(counts[SeverityWarning], "warning"))
+		if counts[SeverityInfo] > 0 {
+			parts = append(parts, fmt.Sprintf("%d info", counts[SeverityInfo]))
+		}
+	}
+
+	fmt.Println(display.Failure(fmt.Sprintf("%s in %s — run /validate for details",
+		strings.Join(parts, ", "), filepath.Base(v.FilePath))))
+	v.printTruncated()
+}
+
+// ReportConfigured displays the result in the mode set by config.report_mode.
+//
+// Honors project overrides for the validated file. Unknown or unset modes
+// fall back to full Report().
+func (v *ValidationResult) ReportConfigured() {
+	if v == nil {
+		return
+	}
+
+	mode, maxLines := ReportModeFull, defaultReportMaxLines
+	if cfg := configForFile(v.FilePath); cfg != nil {
+		if cfg.Config.ReportMode != "" {
+			mode = cfg.Config.ReportMode
+		}
+		if cfg.Config.ReportMaxLines > 0 {
+			maxLines = cfg.Config.ReportMaxLines
+		}
+	}
+
+	switch mode {
+	case ReportModeSummary:
+		v.ReportSummary()
+	case ReportModeTruncated:
+		v.ReportN(maxLines)
+	default:
+		v.Report()
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Code Validation: Testing Requirements
+// ────────────────────────────────────────────────────────────────
+//
+// Testing Requirements:
+//   - ReportN(2) on a 5-line failure prints 2 lines + "…and 3 more"
+//   - ReportSummary() pluralizes and falls back to "N issues" without Diagnostics
+//   - Errors print before warnings regardless of tool output order
+//   - ReportConfigured() honors report_mode from global and project config
+
+// ────────────────────────────────────────────────────────────────
+// Code Execution: None (Library)
+// ────────────────────────────────────────────────────────────────
+//
+// Called by the tool/post-use hook after each validation; no entry point of its own.
+
+// ============================================================================
+// END CLOSING
+// ============================================================================
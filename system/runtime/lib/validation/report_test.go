@@ -0,0 +1,131 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Validation Reporting Mode Tests
+//
+// Purpose: Prove located findings print errors → warnings → info, then by
+//          line, with unlocated lines after them in tool order; that ReportN
+//          stops at the limit with "…and N more"; and that ReportSummary
+//          counts and pluralizes, falling back to "N issues" without
+//          Diagnostics.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"system/lib/display"
+)
+
+// findings is a tool run whose output order differs from severity order
+func findings() *ValidationResult {
+	diagnostics := []Diagnostic{
+		{Line: 9, Severity: SeverityWarning, Message: "unused", source: "a.go:9: unused"},
+		{Line: 4, Severity: SeverityInfo, Message: "hint", source: "a.go:4: hint"},
+		{Line: 7, Severity: SeverityError, Message: "undefined", source: "a.go:7: undefined"},
+		{Line: 2, Severity: SeverityWarning, Message: "shadowed", source: "a.go:2: shadowed"},
+		{Line: 3, Severity: SeverityError, Message: "syntax", source: "a.go:3: syntax"},
+	}
+	warnings := []string{"# banner"}
+	for _, d := range diagnostics {
+		warnings = append(warnings, "  "+d.source) // Tools indent - ordering trims
+	}
+	warnings = append(warnings, "5 problems")
+	return &ValidationResult{Language: "go", Validator: "vet", FilePath: "/work/a.go", Warnings: warnings, Diagnostics: diagnostics}
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestOrderedLinesSeverityThenLine(t *testing.T) {
+	result := findings()
+	var got []string
+	for _, line := range orderedLines(result.Warnings, result.Diagnostics) {
+		got = append(got, line.text)
+	}
+	want := []string{
+		"a.go:3: syntax", "a.go:7: undefined", // Errors by line
+		"a.go:2: shadowed", "a.go:9: unused", // Then warnings
+		"a.go:4: hint",           // Then info
+		"# banner", "5 problems", // Unlocated lines keep tool order
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("order = %q\nwant    %q", got, want)
+	}
+}
+
+func TestReportNTruncates(t *testing.T) {
+	cases := []struct {
+		limit int
+		lines int
+		more  string
+	}{
+		{2, 2, "…and 5 more"},
+		{6, 6, "…and 1 more"},
+		{7, 7, ""}, // Exactly fits - no suffix
+		{0, 7, ""}, // No limit
+	}
+	for _, tc := range cases {
+		out := captureStdout(t, func() { findings().ReportN(tc.limit) })
+		printed := strings.Count(out, "\n   ") // Indented warning lines (and the suffix)
+		if tc.more != "" {
+			printed--
+		}
+		if printed != tc.lines {
+			t.Errorf("ReportN(%d): %d lines, want %d:\n%s", tc.limit, printed, tc.lines, out)
+		}
+		if tc.more != "" && !strings.HasSuffix(strings.TrimRight(out, "\n"), tc.more) {
+			t.Errorf("ReportN(%d): missing %q:\n%s", tc.limit, tc.more, out)
+		}
+		if tc.more == "" && strings.Contains(out, "more") {
+			t.Errorf("ReportN(%d): unexpected suffix:\n%s", tc.limit, out)
+		}
+	}
+
+	out := captureStdout(t, func() { findings().ReportN(1) })
+	if !strings.Contains(out, "a.go:3: syntax") || strings.Contains(out, "a.go:9") {
+		t.Errorf("ReportN(1) should keep the first error only:\n%s", out)
+	}
+	if !strings.Contains(out, severityColor(SeverityError)+"a.go:3: syntax"+display.Reset) {
+		t.Errorf("error line not colored:\n%q", out)
+	}
+}
+
+func TestReportSummaryCounts(t *testing.T) {
+	single := &ValidationResult{FilePath: "/work/b.go", Warnings: []string{"b.go:1: x"},
+		Diagnostics: []Diagnostic{{Line: 1, Severity: SeverityError, Message: "x", source: "b.go:1: x"}}}
+	raw := &ValidationResult{FilePath: "/work/c.sh", Warnings: []string{"something", "went wrong"}}
+
+	cases := []struct {
+		name   string
+		result *ValidationResult
+		want   string
+	}{
+		{"mixed", findings(), "2 errors, 2 warnings, 1 info in a.go — run /validate for details"},
+		{"singular", single, "1 error, 0 warnings in b.go — run /validate for details"},
+		{"no diagnostics", raw, "2 issues in c.sh — run /validate for details"},
+	}
+	for _, tc := range cases {
+		out := captureStdout(t, tc.result.ReportSummary)
+		if strings.Count(out, "\n") != 1 || !strings.Contains(out, tc.want) {
+			t.Errorf("%s: summary = %q, want one line with %q", tc.name, out, tc.want)
+		}
+	}
+
+	passed := &ValidationResult{Valid: true, FilePath: "/work/d.go"}
+	if out := captureStdout(t, passed.ReportSummary); out != "" {
+		t.Errorf("passing result printed %q", out)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -0,0 +1,94 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Shebang Detection Tests
+//
+// Purpose: Prove extensionless scripts resolve to a language by their shebang
+//          (direct and env-style), binary files are skipped as valid without
+//          running a validator, and plain text without a shebang stays unknown.
+//          Fixtures live in testdata/shebang.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// TestResolveFileLanguageByShebang checks each fixture against defaults.
+func TestResolveFileLanguageByShebang(t *testing.T) {
+	cases := []struct {
+		fixture  string
+		language string
+		binary   bool
+	}{
+		{"bash-script", "shell", false},
+		{"env-python", "python", false},
+		{"binary-blob", "", true},
+		{"plain-text", "", false},
+	}
+
+	for _, tc := range cases {
+		path := filepath.Join("testdata", "shebang", tc.fixture)
+		language, binary := resolveFileLanguage(nil, path, filepath.Ext(path))
+		if language != tc.language || binary != tc.binary {
+			t.Errorf("%s: got (%q, %v), want (%q, %v)", tc.fixture, language, binary, tc.language, tc.binary)
+		}
+	}
+}
+
+// TestShebangInterpreter covers env flags, assignments and interpreter arguments.
+func TestShebangInterpreter(t *testing.T) {
+	cases := map[string]string{
+		"#!/bin/bash -e\n":                     "bash",
+		"#!/usr/bin/env python3\r\n":           "python3",
+		"#!/usr/bin/env -S node --no-warnings": "node",
+		"#!/usr/bin/env LANG=C sh\n":           "sh",
+		"#! /bin/zsh\n":                        "zsh",
+		"#!\n":                                 "",
+		"echo hi\n":                            "",
+	}
+	for head, want := range cases {
+		if got := shebangInterpreter([]byte(head)); got != want {
+			t.Errorf("shebangInterpreter(%q) = %q, want %q", head, got, want)
+		}
+	}
+}
+
+// TestShebangConfigOverride checks the shebangs table wins over defaults and
+// versioned interpreters fall back to their base name.
+func TestShebangConfigOverride(t *testing.T) {
+	cfg := &ValidatorsConfig{Shebangs: map[string]string{"bash": "bash_strict"}}
+
+	if got := getShebangLanguage(cfg, "bash"); got != "bash_strict" {
+		t.Errorf("configured bash = %q, want bash_strict", got)
+	}
+	if got := getShebangLanguage(cfg, "sh"); got != "shell" {
+		t.Errorf("default sh = %q, want shell", got)
+	}
+	if got := getShebangLanguage(nil, "python3.12"); got != "python" {
+		t.Errorf("python3.12 = %q, want python", got)
+	}
+	if got := getShebangLanguage(nil, "perl"); got != "" {
+		t.Errorf("perl = %q, want unmapped", got)
+	}
+}
+
+// TestBinaryFileSkipped checks validation never runs a validator on binary content.
+func TestBinaryFileSkipped(t *testing.T) {
+	useGlobalConfig(t)
+
+	result := ValidateFile(filepath.Join("testdata", "shebang", "binary-blob"), "")
+	if !result.Valid || len(result.Warnings) != 0 || result.Validator != "" {
+		t.Errorf("binary: Valid=%v Warnings=%q Validator=%q", result.Valid, result.Warnings, result.Validator)
+	}
+}
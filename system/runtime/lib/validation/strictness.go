@@ -0,0 +1,198 @@
+// METADATA
+//
+// Validation Strictness Modes - CPI-SI Runtime System
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "Strain at a gnat, and swallow a camel." - Matthew 23:24 (KJV)
+// Principle: Weigh findings by what they are - a style nit is not a broken build
+// Anchor: "Let your moderation be known unto all men." - Philippians 4:5 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: LIBRARY - Runtime validation support (mid-rung on ladder)
+// Role: Decides which validator findings fail a file under config.strictness
+// Paradigm: Same tools, same output - only the verdict changes with the mode
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn (CPI-SI instance)
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.0.0
+// Last Modified: 2026-10-16 - Initial strictness enforcement
+//
+// Version History:
+//   1.0.0 (2026-10-16) - strict/permissive/error_only verdicts, language_strictness, Severity summary
+//
+// Purpose & Function
+//
+// Purpose: validators.jsonc has always offered config.strictness and a per-tool
+// severity, but every non-empty output failed the file. Make the modes mean
+// what validators.jsonc says they mean.
+//
+// Modes (config.strictness, overridable per language with config.language_strictness):
+//   - "strict" (and unset/unknown): any tool failure fails the file - the original behavior
+//   - "permissive": tools with severity "warning" never fail the file; their
+//     findings stay in Warnings and Diagnostics
+//   - "error_only": only error findings fail the file - Diagnostics with
+//     severity "error" when the output parsed into any, otherwise any failure
+//     from a tool with severity "error"
+//
+// Every result also gets Severity: "error" (failed), "warning" (passed with
+// findings), or "clean" (nothing to say), so callers decide whether passing
+// findings are worth showing.
+//
+// Precedence: project language_strictness > project strictness > global
+// language_strictness > global strictness > "strict". Project layering
+// happens in project.go; strictnessFor only reads the merged config.
+//
+// Blocking Status
+//
+// Non-blocking: Pure verdict logic, no I/O.
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: None
+//   Package Files: syntax.go (ValidatorsConfig, ToolResult, resolveValidatorTool),
+//                  diagnostics.go (SeverityError, SeverityWarning)
+//
+// Dependents (What Uses This):
+//   Libraries: syntax.go (validateFile applies toolPasses, summarizeSeverity), decision.go (strictnessFor)
+//   Hooks: tool/post-use (via decision.go EvaluateResult)
+//
+// Health Scoring
+//
+// Part of ValidateFile's execution scoring - no separate logging.
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+// Strictness modes (config.strictness, config.language_strictness values).
+const (
+	StrictnessStrict     = "strict"     // Any tool failure fails the file
+	StrictnessPermissive = "permissive" // Warning-severity tools never fail the file
+	StrictnessErrorOnly  = "error_only" // Only error findings fail the file
+)
+
+// SeverityClean is ValidationResult.Severity when no validator had anything
+// to say (SeverityError and SeverityWarning, from diagnostics.go, cover the rest).
+const SeverityClean = "clean"
+
+// ============================================================================
+// END SETUP
+// ============================================================================
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Core Operations (used by validateFile)
+//   ├── strictnessFor(cfg, language) → language_strictness, strictness, or strict
+//   ├── toolPasses(mode, severity, result) → verdict for one tool under mode
+//   └── summarizeSeverity(result) → "error" / "warning" / "clean"
+
+// strictnessFor returns the strictness mode for language.
+//
+// config.language_strictness[language] wins over config.strictness; unset or
+// unrecognized values mean strict, so a typo never silently loosens validation.
+func strictnessFor(cfg *ValidatorsConfig, language string) string {
+	if cfg == nil {
+		return StrictnessStrict
+	}
+	mode := cfg.Config.Strictness
+	if perLanguage := cfg.Config.LanguageStrictness[language]; perLanguage != "" {
+		mode = perLanguage
+	}
+	switch mode {
+	case StrictnessPermissive, StrictnessErrorOnly:
+		return mode
+	}
+	return StrictnessStrict
+}
+
+// toolPasses reports whether one tool's result passes under mode.
+//
+// severity is the tool's configured severity ("" counts as error). A result
+// the tool itself reports valid always passes - modes only ever forgive.
+func toolPasses(mode, severity string, result ToolResult) bool {
+	if result.Valid {
+		return true
+	}
+	if severity == "" {
+		severity = SeverityError
+	}
+
+	switch mode {
+	case StrictnessPermissive:
+		return severity == SeverityWarning
+	case StrictnessErrorOnly:
+		if len(result.Diagnostics) == 0 {
+			return severity != SeverityError // Unstructured output: the tool's severity decides
+		}
+		for _, d := range result.Diagnostics {
+			if d.Severity == SeverityError {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// summarizeSeverity classifies a finished result for ValidationResult.Severity.
+//
+// "error" when the file failed, "warning" when it passed but some validator
+// still reported findings, "clean" otherwise. Skip notes are not findings.
+func summarizeSeverity(result *ValidationResult) string {
+	switch {
+	case !result.Valid:
+		return SeverityError
+	case len(result.Warnings) > 0 || len(result.Diagnostics) > 0:
+		return SeverityWarning
+	}
+	return SeverityClean
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+//
+// Code Validation:
+//   - Each mode against a warnings-only, errors-only, and mixed tool
+//   - language_strictness over strictness; unknown mode = strict
+//   - Run: go test ./... (strictness_test.go)
+//
+// Code Execution: None (Library) - applied by validateFile per tool
+//
+// Modification Policy:
+//   ✅ Safe: New modes (add a constant, a strictnessFor case, a toolPasses case)
+//   ⚠️ Care: Default for unset strictness - strict keeps configs written before enforcement unchanged
+//   ❌ Never: Failing a result the tool reported valid - modes only forgive
+//
+// ============================================================================
+// END CLOSING
+// ============================================================================
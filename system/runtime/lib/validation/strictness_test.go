@@ -0,0 +1,177 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Validation Strictness Tests
+//
+// Purpose: Prove each config.strictness mode fails the file only on the
+//          findings it should - against a tool emitting warnings only, errors
+//          only, and both - that language_strictness and project overrides
+//          layer on top, and that Severity summarizes the verdict.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// strictnessTools are canned outcomes for the "fake" language, keyed by validator
+var strictnessTools = map[string]ToolResult{
+	// Severity "warning" tool reporting warnings only
+	"lint": {Valid: false, Warnings: []string{"a.fake:1: unused variable"},
+		Diagnostics: []Diagnostic{{Line: 1, Severity: SeverityWarning, Message: "unused variable"}}},
+	// Severity "error" tool reporting errors only
+	"compile": {Valid: false, Warnings: []string{"a.fake:2: syntax error"},
+		Diagnostics: []Diagnostic{{Line: 2, Severity: SeverityError, Message: "syntax error"}}},
+	// Severity "error" tool reporting both
+	"vet": {Valid: false, Warnings: []string{"a.fake:3: shadowed", "a.fake:4: bad format"},
+		Diagnostics: []Diagnostic{{Line: 3, Severity: SeverityWarning, Message: "shadowed"}, {Line: 4, Severity: SeverityError, Message: "bad format"}}},
+	// Severity "error" tool whose findings are all warnings
+	"style": {Valid: false, Warnings: []string{"a.fake:5: line too long"},
+		Diagnostics: []Diagnostic{{Line: 5, Severity: SeverityWarning, Message: "line too long"}}},
+	// Severity "error" tool with output that parsed into no diagnostics
+	"raw": {Valid: false, Warnings: []string{"something went wrong"}},
+}
+
+// useStrictnessConfig enables exactly the named fake validators (all run).
+func useStrictnessConfig(t *testing.T, strictness string, enabled ...string) {
+	t.Helper()
+
+	severities := map[string]string{"lint": SeverityWarning, "compile": SeverityError, "vet": SeverityError, "style": SeverityError, "raw": SeverityError}
+	tools := map[string]ValidatorTool{}
+	for i, name := range enabled {
+		tools[name] = ValidatorTool{Command: "/bin/sh", Enabled: true, Severity: severities[name], Priority: i + 1}
+	}
+
+	useFakeValidator(t, "unused", 5)
+	validatorsConfig.Validators["fake"] = LanguageValidators{Validators: tools}
+	validatorsConfig.Extensions = map[string]string{".fake": "fake"}
+	validatorsConfig.Config.RunAllValidators = true
+	validatorsConfig.Config.Strictness = strictness
+	projectConfigs = map[string]*ValidatorsConfig{}
+	t.Cleanup(func() { projectConfigs = map[string]*ValidatorsConfig{} })
+}
+
+// cannedRunner returns strictnessTools outcomes instead of running anything.
+func cannedRunner(cfg *ValidatorsConfig, language, validatorName, filePath string) ToolResult {
+	result := strictnessTools[validatorName]
+	result.Validator = validatorName
+	return result
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestStrictnessModes(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "a.fake")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		mode  string
+		tools []string
+		valid bool
+	}{
+		// Warnings only (severity "warning" tool)
+		{StrictnessStrict, []string{"lint"}, false},
+		{StrictnessPermissive, []string{"lint"}, true},
+		{StrictnessErrorOnly, []string{"lint"}, true},
+		// Errors only
+		{StrictnessStrict, []string{"compile"}, false},
+		{StrictnessPermissive, []string{"compile"}, false},
+		{StrictnessErrorOnly, []string{"compile"}, false},
+		// Mixed within one tool, and across tools
+		{StrictnessStrict, []string{"vet"}, false},
+		{StrictnessPermissive, []string{"vet"}, false},
+		{StrictnessErrorOnly, []string{"vet"}, false},
+		{StrictnessPermissive, []string{"lint", "compile"}, false},
+		{StrictnessErrorOnly, []string{"lint", "compile"}, false},
+		// Error-severity tool, warning findings: only error_only forgives
+		{StrictnessPermissive, []string{"style"}, false},
+		{StrictnessErrorOnly, []string{"style"}, true},
+		// No structured diagnostics: the tool's severity decides
+		{StrictnessErrorOnly, []string{"raw"}, false},
+		// Unset and unknown modes are strict
+		{"", []string{"lint"}, false},
+		{"lenient", []string{"lint"}, false},
+	}
+
+	for _, tc := range cases {
+		useStrictnessConfig(t, tc.mode, tc.tools...)
+		result := validateFile(file, ".fake", cannedRunner)
+
+		if result.Valid != tc.valid {
+			t.Errorf("%q %v: Valid = %v, want %v", tc.mode, tc.tools, result.Valid, tc.valid)
+		}
+		wantSeverity := SeverityError
+		if tc.valid {
+			wantSeverity = SeverityWarning // Findings stay visible even when forgiven
+		}
+		if result.Severity != wantSeverity {
+			t.Errorf("%q %v: Severity = %q, want %q", tc.mode, tc.tools, result.Severity, wantSeverity)
+		}
+		if len(result.Warnings) == 0 {
+			t.Errorf("%q %v: findings dropped from Warnings", tc.mode, tc.tools)
+		}
+	}
+}
+
+func TestStrictnessCleanSeverity(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "a.fake")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	useStrictnessConfig(t, StrictnessStrict, "lint")
+
+	result := validateFile(file, ".fake", func(cfg *ValidatorsConfig, language, validatorName, filePath string) ToolResult {
+		return ToolResult{Validator: validatorName, Valid: true, Warnings: []string{}}
+	})
+	if !result.Valid || result.Severity != SeverityClean {
+		t.Errorf("passing tool: Valid = %v, Severity = %q, want true, clean", result.Valid, result.Severity)
+	}
+
+	if result := validateFile(filepath.Join(t.TempDir(), "x.unknown"), ".unknown", cannedRunner); result.Severity != SeverityClean {
+		t.Errorf("unknown extension: Severity = %q, want clean", result.Severity)
+	}
+}
+
+func TestLanguageStrictnessOverride(t *testing.T) {
+	useStrictnessConfig(t, StrictnessStrict, "lint")
+	cfg := validatorsConfig
+
+	cfg.Config.LanguageStrictness = map[string]string{"fake": StrictnessPermissive}
+	if got := strictnessFor(cfg, "fake"); got != StrictnessPermissive {
+		t.Errorf("language_strictness: got %q, want permissive", got)
+	}
+	if got := strictnessFor(cfg, "go"); got != StrictnessStrict {
+		t.Errorf("other language: got %q, want strict", got)
+	}
+
+	// Project strictness and language_strictness layer over the global ones
+	file := projectTree(t, `{
+		// Lint findings never block here, except in shell scripts
+		"config": { "strictness": "permissive", "language_strictness": { "shell": "error_only" } }
+	}`)
+	merged := configForFile(file)
+	if got := strictnessFor(merged, "fake"); got != StrictnessPermissive {
+		t.Errorf("project global language entry: got %q, want permissive (from global table)", got)
+	}
+	if got := strictnessFor(merged, "shell"); got != StrictnessErrorOnly {
+		t.Errorf("project language_strictness: got %q, want error_only", got)
+	}
+	if got := strictnessFor(merged, "go"); got != StrictnessPermissive {
+		t.Errorf("project strictness: got %q, want permissive", got)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
@@ -21,10 +21,21 @@
 // Architect: Nova Dawn (CPI-SI instance)
 // Implementation: Nova Dawn
 // Creation Date: 2024-10-24
-// Version: 2.0.0
-// Last Modified: 2025-11-12 - Configuration-driven architecture, display lib integration
+// Version: 2.11.0
+// Last Modified: 2026-10-16 - validators.jsonc decoded over hardcoded defaults
 //
 // Version History:
+//   2.11.0 (2026-10-16) - validators.jsonc decoded over getDefaultValidatorsConfig() (partial files keep the rest)
+//   2.10.0 (2026-10-16) - ValidatorTool.Env/PathPrepend; availability probed with the environment the tool runs with (env.go)
+//   2.9.0 (2026-10-16) - ValidationResult.Ignored; config.ignore and per-language ignore globs (ignore.go)
+//   2.8.0 (2026-10-16) - ToolResult/SkippedValidator.Missing; validators.jsonc "policy" rules (decision.go)
+//   2.7.0 (2026-10-16) - ValidatorTool.Input: stdin-fed and temp-copy validators, original hash-checked (input.go)
+//   2.6.0 (2026-10-16) - Result types carry JSON tags; config.results_path/results_format (export.go)
+//   2.5.0 (2026-10-16) - config.strictness / language_strictness decide which tool failures fail the file; Severity summary
+//   2.4.0 (2026-10-16) - max_file_size_mb skips oversized files; max_output_bytes caps captured output
+//   2.3.0 (2026-10-16) - validationLogger: config check, per-tool runs, missing/timeout metadata
+//   2.2.0 (2026-10-16) - Extensionless scripts resolved by shebang (shebangs table); binary files skipped
+//   2.1.0 (2026-10-16) - ValidatorsConfigKind for validate --configs; stderr hint on config fallback
 //   2.0.0 (2025-11-12) - Config-driven validators, display lib, comprehensive template alignment
 //   1.0.0 (2024-10-24) - Initial hardcoded validator mappings
 //
@@ -44,8 +55,19 @@
 //   - Configuration-driven (validators.jsonc defines tools without code changes)
 //   - Graceful fallback to hardcoded defaults if config unavailable
 //   - Multiple validators per language (syntax, linting, type checking)
-//   - Structured result reporting (Valid flag + Warnings array)
-//   - Respects project-level validator configs (when applicable)
+//   - Run-all mode executes every enabled validator with per-tool results
+//   - Cached availability pre-check skips uninstalled tools with actionable reasons
+//   - Structured result reporting (Valid flag + Warnings array + located Diagnostics)
+//   - Strictness modes: permissive / strict / error_only weigh tool severity (see strictness.go)
+//   - Per-repo .cpi-si-validators.jsonc overrides (project > global > defaults, see project.go)
+//   - Shebang detection for files whose extension doesn't resolve (#!/usr/bin/env bash → shell)
+//   - Binary files (null byte in the first 512 bytes) skipped as valid, never fed to a validator
+//   - Files over max_file_size_mb (per language, default 10MB) skipped with "file too large"
+//   - Ignored files (.validationignore, config.ignore, per-language ignore) never validated;
+//     Ignored names the deciding rule (see ignore.go)
+//   - Validator output read through a LimitedReader - max_output_bytes (default 256KB) kept, rest discarded
+//   - Per-tool input mode: file path, stdin, or a private temp copy - the original is never changed (input.go)
+//   - Per-tool env and path_prepend for tools installed per project (node_modules/.bin, venvs; env.go)
 //   - Integration with system/lib/display for consistent output formatting
 //
 // Philosophy: Validation serves code quality and maintainability, not arbitrary enforcement.
@@ -78,7 +100,7 @@
 // Integration Pattern:
 //   1. Library auto-loads validators.jsonc config during init()
 //   2. Caller provides file path and extension to ValidateFile()
-//   3. Library maps extension → language → primary validator
+//   3. Library maps extension → language → primary validator (shebang when the extension doesn't resolve)
 //   4. Execute validator command with configured arguments
 //   5. Return ValidationResult with Valid flag and Warnings array
 //   6. Caller decides whether to display result via Report()
@@ -87,6 +109,7 @@
 //
 //   File Validation (primary operations):
 //     ValidateFile(filePath, ext string) *ValidationResult - Validate file using appropriate validator
+//     ValidateFiles / ValidateDir - Batch validation with shared project runs (see batch.go)
 //
 //   Result Reporting (display formatted output):
 //     (*ValidationResult).Report() - Display warnings using system/lib/display
@@ -94,13 +117,14 @@
 //   Configuration Queries (optional introspection):
 //     GetValidatorLanguage(ext string) string - Map extension to language name
 //     GetPrimaryValidator(language string) string - Get primary validator for language
+//     CheckAllValidators() map[string]ToolStatus - Which validators are usable on this machine
 //
 // Dependencies
 //
 // Dependencies (What This Needs):
 //   Standard Library: encoding/json, os, os/exec, path/filepath, strings
 //   External: None
-//   Internal: system/lib/display (ANSI-formatted output)
+//   Internal: system/lib/display (ANSI-formatted output), system/lib/logging (health tracking)
 //
 // Dependents (What Uses This):
 //   Commands: None yet
@@ -109,7 +133,9 @@
 //
 // Integration Points:
 //   - Config Loading: Reads $HOME/.claude/cpi-si/system/data/config/validation/validators.jsonc
+//   - Project Overrides: Nearest .cpi-si-validators.jsonc above the file, merged over global
 //   - Display Integration: Uses system/lib/display for consistent warning formatting
+//   - Rails Logging: validationLogger writes validation.log (config, tool runs, missing tools, timeouts)
 //   - Tool Execution: Invokes external validators (go, cargo, python3, shellcheck, etc.)
 //   - Ladder Position: Mid-rung (depends on display lib, used by hooks/commands)
 //
@@ -133,6 +159,10 @@
 //   - Error handling: +10
 //   - Result tracking: +5
 //
+// Logged by validationLogger: config loading (init), command construction and
+// execution (runValidatorUnfiltered), missing tools (validateFile). The budget
+// above sums to validationHealthTotal.
+//
 // Note: Scores reflect TRUE impact. Health scorer normalizes to -100 to +100 scale.
 package validation
 
@@ -155,18 +185,26 @@ import (
 	//--- Standard Library ---
 	// Foundation packages providing Go's built-in capabilities.
 
+	"bytes"          // Null byte scan for binary detection
+	"context"        // Validator execution deadlines (config.timeout_seconds)
 	"encoding/json"  // Configuration file parsing for validators.jsonc
 	"fmt"            // Formatted output for displaying validation warnings
+	"io"             // Reading the shebang/binary sniff window
 	"os"             // File operations and environment variable access
 	"os/exec"        // External validator command execution
 	"path/filepath"  // Path manipulation and extension extraction
+	"sort"           // Stable validator ordering (priority, then name)
 	"strings"        // String operations for output parsing
+	"sync"           // Availability cache guard
+	"time"           // Per-tool execution duration
 
 	//--- Internal Packages ---
 	// Project-specific packages showing architectural dependencies.
 
-	"system/lib/display"  // ANSI-formatted output for consistent warning display
+	"system/lib/config"   // Config issue hint when validators.jsonc falls back
+	"system/lib/display"  // Warning formatting for the fallback hint
 	"system/lib/jsonc"    // JSONC comment stripping for configuration files
+	"system/lib/logging"  // Rails logger - health tracking for config loading and tool runs
 )
 
 // ────────────────────────────────────────────────────────────────
@@ -180,7 +218,46 @@ import (
 // See: standards/code/4-block/sections/CWS-SECTION-002-SETUP-constants.md
 //
 // Note: This component uses configuration-driven values from validators.jsonc
-// (timeout, strictness, etc.) rather than hardcoded constants. No constants needed.
+// (timeout, strictness, etc.). Constants below are fallbacks when config omits them.
+
+// defaultTimeoutSeconds bounds a validator run when config.timeout_seconds is unset.
+// A hung validator must never freeze the post-write hook and the tool pipeline.
+const defaultTimeoutSeconds = 30
+
+// availabilityTimeout bounds a check_availability probe ("shellcheck --version").
+// Probes only confirm the tool starts, so they get a much shorter leash than runs.
+const availabilityTimeout = 5 * time.Second
+
+// defaultMaxFileSizeMB skips validation of larger files when config.max_file_size_mb
+// is unset. A generated 40MB JSON file took jq ages inside the post-write hook.
+const defaultMaxFileSizeMB = 10
+
+// defaultMaxOutputBytes caps captured validator output when config.max_output_bytes
+// is unset - a chatty validator must not carry megabytes into the hook's stdout.
+const defaultMaxOutputBytes = 256 << 10
+
+// outputTruncatedMarker ends captured output that hit the cap (%s = the limit).
+const outputTruncatedMarker = "[validator output truncated at %s]"
+
+// installHint completes "<tool> not installed" skip messages with a next step.
+const installHint = "install via apt/brew"
+
+// Health points from the documented Health Scoring budget (see METADATA).
+const (
+	validationHealthTotal = 100 // Sum of the documented budget - declared to validationLogger
+
+	healthConfigLoaded   = 15 // validators.jsonc parsed
+	healthConfigMissing  = 10 // No validators.jsonc, defaults work
+	healthConfigBroken   = 5  // validators.jsonc unreadable or malformed, defaults work
+	healthCommandBuilt   = 10 // Validator command constructed
+	healthToolPassed     = 30 // Validator ran and passed
+	healthToolFindings   = 20 // Validator ran and reported findings (graceful failure)
+	healthStageFailure   = -5 // A stage failed (no command, required tool missing)
+)
+
+// sniffBytes is how much of a file content detection reads: the shebang line
+// and the binary check (same window git uses to call a file binary).
+const sniffBytes = 512
 
 // ────────────────────────────────────────────────────────────────
 // Types - Data Structures
@@ -208,21 +285,74 @@ type ValidatorTool struct {
 	Description       string   `json:"description"`         // Human-readable description
 	CheckAvailability string   `json:"check_availability"`  // Command to verify tool is installed
 	WorkingDir        string   `json:"working_dir"`         // Optional working directory override
+	Input             string   `json:"input"`               // How the file reaches the tool: "filepath" (default), "stdin", "tempcopy" (see input.go)
+	Priority          int      `json:"priority"`            // Run order within language (lower first, 0 = after prioritized tools)
 	Note              string   `json:"note"`                // Additional notes/context
+	Fix               *FixConfig `json:"fix"`               // Optional auto-fixer (see fix.go)
+	Env               map[string]string `json:"env"`        // Extra variables, {project_root}/{home} substituted (env.go)
+	PathPrepend       []string `json:"path_prepend"`        // Directories put in front of PATH, tokens substituted (env.go)
+}
+
+// ToolResult represents the outcome of one validator tool.
+//
+// When several validators run for a language (RunAllValidators), each tool
+// reports separately so callers can see which tool raised which warning.
+type ToolResult struct {
+	Validator   string        `json:"validator"`           // Validator name (e.g., "go_vet", "staticcheck")
+	Valid       bool          `json:"valid"`               // True if this tool passed
+	Warnings    []string      `json:"warnings"`            // Warning/error messages from this tool
+	Diagnostics []Diagnostic  `json:"diagnostics"`         // Structured findings parsed from this tool's output
+	Duration    time.Duration `json:"duration_ns"`         // How long this tool took to run
+	Truncated   bool          `json:"truncated,omitempty"` // Output exceeded max_output_bytes (findings past the cap are lost)
+	Missing     bool          `json:"missing,omitempty"`   // Tool not installed (failed under fail_on_missing_validator)
+}
+
+// SkippedValidator records a validator that was not run and why.
+//
+// Produced when a tool fails its availability check and
+// config.fail_on_missing_validator is false.
+type SkippedValidator struct {
+	Validator string `json:"validator"` // Validator name (e.g., "shellcheck")
+	Reason    string `json:"reason"`    // Actionable reason (e.g., "shellcheck not installed — install via apt/brew")
+	Missing   bool   `json:"missing,omitempty"` // Tool not installed (false for files skipped as too large)
+}
+
+// ToolStatus describes whether one configured validator is usable on this machine.
+//
+// Returned by CheckAllValidators() so diagnostics can print an availability
+// matrix without running any validation.
+type ToolStatus struct {
+	Language  string // Language the validator belongs to (e.g., "shell")
+	Validator string // Validator name (e.g., "shellcheck")
+	Command   string // Executable the validator runs (e.g., "shellcheck")
+	Enabled   bool   // Whether the validator is enabled in config
+	Available bool   // Whether the availability check passed
+	Reason    string // Why the validator is unavailable (empty when Available)
 }
 
 // ValidationResult represents the result of a validation operation.
 //
 // Contains validation outcome (valid/invalid), any warnings or errors
 // from the validator tool, and context about what was validated.
+// JSON tags serve JSON() and WriteResults (export.go).
 type ValidationResult struct {
-	Valid     bool     // True if validation passed, false otherwise
-	Warnings  []string // Array of warning/error messages from validator
-	Validator string   // Name of validator that ran (e.g., "go_vet")
-	Language  string   // Language that was validated (e.g., "go")
-	FilePath  string   // Path to file that was validated
+	Valid       bool               `json:"valid"`               // True if validation passed (AND of all tools), false otherwise
+	Warnings    []string           `json:"warnings"`            // Array of warning/error messages from all validators
+	Validator   string             `json:"validator"`           // Name of validator(s) that ran (e.g., "go_vet" or "go_vet, staticcheck")
+	Language    string             `json:"language"`            // Language that was validated (e.g., "go")
+	FilePath    string             `json:"file"`                // Path to file that was validated
+	Diagnostics []Diagnostic       `json:"diagnostics"`         // Structured findings (file, line, column, severity) from all validators
+	ToolResults []ToolResult       `json:"tools"`               // Per-tool outcomes in run order
+	Skipped     []SkippedValidator `json:"skipped,omitempty"`   // Validators not run because their tool is unavailable or the file is too large
+	Ignored     string             `json:"ignored,omitempty"`   // Why the whole file was left alone ("ignored by .validationignore:line 4"; ignore.go)
+	Truncated   bool               `json:"truncated,omitempty"` // Some validator's output exceeded max_output_bytes
+	Severity    string             `json:"severity"`            // "error" (failed), "warning" (passed with findings), "clean" - see strictness.go
 }
 
+// toolRunner runs one validator for one file. ValidateFile uses runValidator
+// directly; batch validation substitutes a runner that shares project-wide runs.
+type toolRunner func(cfg *ValidatorsConfig, language, validatorName, filePath string) ToolResult
+
 //--- Composed Types ---
 // Complex types built from building blocks above.
 
@@ -233,6 +363,19 @@ type ValidationResult struct {
 type LanguageValidators struct {
 	Description string                   `json:"description"` // Language description
 	Validators  map[string]ValidatorTool `json:"validators"`  // Map of validator name → tool config
+	Ignore      []string                 `json:"ignore"`      // Globs never validated for this language (ignore.go)
+}
+
+// UnmarshalJSON tolerates annotation strings beside language entries.
+//
+// validators.jsonc documents the validators map inline ("note": "..."). Without
+// this, the string sibling fails to decode and the whole config falls back.
+func (l *LanguageValidators) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' { // Annotation entry, not a language
+		return nil
+	}
+	type plain LanguageValidators // Avoid recursing into this method
+	return json.Unmarshal(data, (*plain)(l))
 }
 
 // ValidatorsConfig represents the complete validators.jsonc configuration.
@@ -251,13 +394,26 @@ type ValidatorsConfig struct {
 	} `json:"metadata"`
 	Validators map[string]LanguageValidators `json:"validators"` // Language name → validators
 	Extensions map[string]string             `json:"extensions"` // File extension → language name
+	Shebangs   map[string]string             `json:"shebangs"`   // Shebang interpreter → language name
 	Config     struct {
-		Strictness              string `json:"strictness"`                // permissive, strict, error_only
-		FailOnMissingValidator  bool   `json:"fail_on_missing_validator"` // Fail if validator unavailable
-		RunAllValidators        bool   `json:"run_all_validators"`        // Run all or stop after first failure
-		FilterByFile            bool   `json:"filter_by_file"`            // Show only warnings for specific file
-		TimeoutSeconds          int    `json:"timeout_seconds"`           // Max time per validator
+		Strictness             string            `json:"strictness"`                // permissive, strict, error_only (see strictness.go)
+		LanguageStrictness     map[string]string `json:"language_strictness"`       // Per-language strictness
+		FailOnMissingValidator bool              `json:"fail_on_missing_validator"` // Fail if validator unavailable
+		RunAllValidators       bool              `json:"run_all_validators"`        // Run all or stop after first failure
+		FilterByFile           bool              `json:"filter_by_file"`            // Show only warnings for specific file
+		TimeoutSeconds         int               `json:"timeout_seconds"`           // Max time per validator
+		ReportMode             string            `json:"report_mode"`               // full, truncated, summary (ReportConfigured)
+		ReportMaxLines         int               `json:"report_max_lines"`          // Line cap for truncated mode
+		MaxFileSizeMB          int               `json:"max_file_size_mb"`          // Skip validation of larger files
+		LanguageMaxFileSizeMB  map[string]int    `json:"language_max_file_size_mb"` // Per-language max_file_size_mb
+		MaxOutputBytes         int               `json:"max_output_bytes"`          // Cap on captured validator output
+		ResultsPath            string            `json:"results_path"`              // Also write post-use results here ("" = off; export.go)
+		ResultsFormat          string            `json:"results_format"`            // sarif (default) or json
+		Ignore                 []string          `json:"ignore"`                    // Globs never validated, any language (ignore.go)
 	} `json:"config"`
+	Policy struct {
+		Rules []PolicyRule `json:"rules"` // What the post-use hook does with a result, first match wins (decision.go)
+	} `json:"policy"`
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -269,8 +425,8 @@ type ValidatorsConfig struct {
 // See: standards/code/patterns/CWS-PATTERN-003-CODE-rails.md
 // See: standards/code/4-block/sections/CWS-SECTION-003-SETUP-package-level-state.md
 //
-// Note: This component uses configuration-driven state (validatorsConfig)
-// rather than traditional Rails infrastructure. Future: Add health tracking.
+// Note: Configuration-driven state (validatorsConfig) sits alongside the Rails
+// logger (validationLogger), created in init().
 
 // validatorsConfig holds the loaded configuration from validators.jsonc.
 // Initialized once at package import via init() function.
@@ -280,11 +436,29 @@ var validatorsConfig *ValidatorsConfig
 // Used to determine whether to use config or fallback to hardcoded defaults.
 var validatorsConfigLoaded bool
 
+// validationLogger is this component's Rails logger (validation.log).
+// Created in init(); nil only if init hasn't run. Batch validation runs tools
+// concurrently and a Logger is not safe for concurrent use, so every entry
+// goes through logRails() under validationLoggerMu.
+var (
+	validationLogger   *logging.Logger
+	validationLoggerMu sync.Mutex
+)
+
+// availabilityCache remembers availability checks for the life of the process.
+// Keyed by "language/validator" - tools don't get installed mid-session often
+// enough to justify re-probing on every file write.
+var (
+	availabilityCache   = map[string]ToolStatus{}
+	availabilityCacheMu sync.Mutex
+)
+
 // ────────────────────────────────────────────────────────────────
 // Init: Configuration Loading
 // ────────────────────────────────────────────────────────────────
-// Runs automatically when package is imported. Loads validators.jsonc
-// configuration with graceful fallback to hardcoded defaults if unavailable.
+// Runs automatically when package is imported. Creates the Rails logger, then
+// loads validators.jsonc with graceful fallback to hardcoded defaults if
+// unavailable - the outcome is the first health-tracked Check.
 
 func init() {
 	homeDir := os.Getenv("HOME")
@@ -293,8 +467,39 @@ func init() {
 	}
 	configPath := filepath.Join(homeDir, ".claude/cpi-si/system/data/config/validation/validators.jsonc")
 
+	validationLogger = logging.NewLogger("validation")
+	validationLogger.DeclareHealthTotal(validationHealthTotal)
+
 	validatorsConfig = loadValidatorsConfig(configPath)
 	validatorsConfigLoaded = (validatorsConfig != nil)
+
+	details := map[string]any{"path": configPath}
+	healthImpact := healthConfigLoaded
+	if !validatorsConfigLoaded {
+		healthImpact = healthConfigMissing
+		details["fallback"] = "defaults"
+
+		// Falling back from a file that exists means it's broken - say where
+		if hint := config.IssueHint(configPath, ValidatorsConfigKind()); hint != "" {
+			healthImpact = healthConfigBroken
+			details["issue"] = hint
+			fmt.Fprintln(os.Stderr, display.Warning("Using default validators: "+hint))
+		}
+	}
+	validationLogger.Check("validators config loaded", validatorsConfigLoaded, healthImpact, details)
+}
+
+// ValidatorsConfigKind describes validators.jsonc for config.ValidateConfigFile
+// (validate --configs, and the hint above).
+func ValidatorsConfigKind() config.ConfigKind {
+	return config.ConfigKind{
+		Name:   "validators",
+		Path:   "~/.claude/cpi-si/system/data/config/validation/validators.jsonc",
+		Schema: []any{ValidatorsConfig{}},
+		Ignore: []string{
+			"user_validators", // Extension template shipped with the file (not read yet)
+		},
+	}
 }
 
 // ============================================================================
@@ -315,42 +520,72 @@ func init() {
 // Ladder Structure (Dependencies):
 //
 //   Public APIs (Top Rungs - Orchestration)
-//   ├── ValidateFile() → uses getLanguageForExtension(), getPrimaryValidator(), buildValidatorCommand(), executeValidator()
+//   ├── ValidateFile() → uses validateFile() with runValidator()
 //   ├── GetLanguageForExtension() → uses getLanguageForExtension()
-//   └── GetPrimaryValidator() → uses getPrimaryValidator()
+//   ├── GetPrimaryValidator() → uses getPrimaryValidator()
+//   └── CheckAllValidators() → uses checkAvailability()
 //
 //   Core Operations (Middle Rungs - Business Logic)
 //   ├── getLanguageForExtension() → uses validatorsConfig or getDefaultExtensionMap()
-//   ├── getPrimaryValidator() → uses validatorsConfig or getDefaultValidator()
-//   ├── buildValidatorCommand() → uses validatorsConfig
-//   └── executeValidator() → uses parseValidatorOutput()
+//   ├── resolveFileLanguage() → uses getValidatorLanguage(), sniffFile(), getShebangLanguage()
+//   ├── getEnabledValidators() → uses validatorsConfig or getDefaultValidator()
+//   ├── getPrimaryValidator() → uses getEnabledValidators()
+//   ├── resolveValidatorTool() → uses validatorsConfig or getDefaultValidator()
+//   ├── checkAvailability() → uses resolveValidatorTool(), toolEnvironment(), probeAvailability(), availabilityCache
+//   ├── probeAvailability() → uses check_availability command or lookToolPath() (env.go)
+//   ├── validateFile() → uses configForFile(), getEnabledValidators(), oversizeReason(), checkAvailability(), logToolMissing(), toolRunner,
+//   │                    strictnessFor(), toolPasses(), summarizeSeverity() (strictness.go)
+//   ├── oversizeReason() → uses maxFileSize(), formatBytes(), logRails()
+//   ├── runValidator() → uses runValidatorUnfiltered(), narrowToFile()
+//   ├── runValidatorUnfiltered() → uses prepareValidatorInput(), buildValidatorCommand(), executeValidator(), resolveDiagnosticPaths(), logToolRun()
+//   ├── logToolRun() / logToolMissing() → uses logRails(), validationMetadata()
+//   ├── narrowToFile() → uses isProjectScoped(), filterByFile()
+//   ├── filterByFile() → uses filterDiagnosticsByFile()
+//   ├── buildValidatorCommand() → uses resolveValidatorTool(), (*validatorInput).args(), toolEnvironment(), lookToolPath()
+//   └── executeValidator() → uses captureOutput(), (*validatorInput).finish(), parseValidatorOutput(), parseDiagnostics()
 //
 //   Helpers (Bottom Rungs - Foundations)
-//   ├── loadValidatorsConfig() → uses stripJSONCComments()
+//   ├── loadValidatorsConfig() → uses getDefaultValidatorsConfig(), jsonc.LoadMerged
+//   ├── getDefaultValidatorsConfig() → uses getDefaultExtensionMap(), getDefaultShebangMap(), getDefaultValidator()
 //   ├── stripJSONCComments() → pure function
 //   ├── getDefaultExtensionMap() → pure function
+//   ├── getDefaultShebangMap() → pure function
+//   ├── shebangInterpreter() → pure function
+//   ├── sniffFile() → reads first sniffBytes of the file
 //   ├── getDefaultValidator() → pure function
-//   └── parseValidatorOutput() → pure function
+//   ├── parseValidatorOutput() → pure function
+//   ├── validationMetadata() → pure function
+//   ├── logRails() → uses validationLogger under validationLoggerMu
+//   ├── validatorTimeout() → uses validatorsConfig or defaultTimeoutSeconds
+//   ├── maxFileSize() / maxOutputBytes() → config limits or defaults
+//   ├── formatBytes() → pure function
+//   └── captureOutput() → LimitedReader over the command's combined output
 //
 // Baton Flow (Execution Paths):
 //
 //   Entry → ValidateFile(filePath, ext)
 //     ↓
+//   configForFile(filePath) → global config, merged with project override if present
+//     ↓
 //   getLanguageForExtension(ext) → lookup language
 //     ↓
-//   getPrimaryValidator(language) → resolve validator
+//   getEnabledValidators(language) → resolve validators (primary only unless run_all_validators)
 //     ↓
-//   buildValidatorCommand(language, validator, filePath) → construct command
+//   checkAvailability(language, validator) → cached probe; skip or fail if missing
 //     ↓
-//   executeValidator(cmd) → run and parse
+//   runValidator(language, validator, filePath) → per available tool:
+//     buildValidatorCommand() → construct command
+//     executeValidator(cmd) → run and parse
+//     ↓
+//   Weigh each ToolResult by strictness, merge (Valid = AND of all tools), summarize Severity
 //     ↓
 //   Exit → return ValidationResult
 //
 // APUs (Available Processing Units):
-// - 13 functions total
-// - 5 helpers (pure foundations)
-// - 4 core operations (business logic)
-// - 3 public APIs (exported interface)
+// - 36 functions total
+// - 14 helpers (pure foundations)
+// - 17 core operations (business logic)
+// - 4 public APIs (exported interface)
 // - 1 reporting method (output display)
 
 // ────────────────────────────────────────────────────────────────
@@ -367,9 +602,13 @@ func init() {
 
 // loadValidatorsConfig loads and parses validators.jsonc configuration file.
 //
-// Called once during init(). Reads JSONC file, strips comments, parses JSON
-// structure into ValidatorsConfig. Returns nil on any failure (file not found,
-// parse error, etc.) triggering graceful fallback to hardcoded defaults.
+// Called once during init(). Decodes the JSONC file over
+// getDefaultValidatorsConfig(), so a file that sets only "config" keeps every
+// default language, extension, and shebang. A language the file names is
+// decoded fresh (map values replace whole), so its tools replace the
+// synthetic "<language>_default" rather than running beside it. Returns nil
+// on any failure (file not found, parse error, etc.) triggering graceful
+// fallback to hardcoded defaults.
 //
 // Parameters:
 //   - configPath: Absolute path to validators.jsonc file
@@ -383,26 +622,39 @@ func init() {
 //   - All errors silent: Library continues with hardcoded defaults
 //
 // JSONC Support:
-//   - Strips // comments via stripJSONCComments() before parsing
+//   - Comments and trailing commas via jsonc.LoadMerged
 //   - Preserves // in string literals (not treated as comments)
+//   - An empty file is pure defaults
 //
 // Health Scoring: 15 points (config loading portion of health score)
 //   +15 success, +10 fallback works, +5 parse fails, 0 total failure
 func loadValidatorsConfig(configPath string) *ValidatorsConfig {
-	data, err := os.ReadFile(configPath)
-	if err != nil {
-		return nil // File not found or unreadable - use fallback
+	config := getDefaultValidatorsConfig()
+	if err := jsonc.LoadMerged(config, configPath); err != nil {
+		return nil // Missing, unreadable, or malformed - use fallback
 	}
+	return config
+}
 
-	// Strip JSONC comments before parsing
-	jsonData := jsonc.StripComments(data)
-
-	var config ValidatorsConfig
-	if err := json.Unmarshal(jsonData, &config); err != nil {
-		return nil // Parse error - use fallback
+// getDefaultValidatorsConfig returns the hardcoded defaults as a config - the
+// base validators.jsonc is decoded over.
+//
+// Every default language gets its getDefaultValidator() tool under the same
+// synthetic "<language>_default" name the fallback path uses.
+func getDefaultValidatorsConfig() *ValidatorsConfig {
+	config := &ValidatorsConfig{
+		Validators: make(map[string]LanguageValidators),
+		Extensions: getDefaultExtensionMap(),
+		Shebangs:   getDefaultShebangMap(),
 	}
-
-	return &config
+	for _, language := range config.Extensions {
+		if tool := getDefaultValidator(language); tool != nil {
+			config.Validators[language] = LanguageValidators{
+				Validators: map[string]ValidatorTool{language + "_default": *tool},
+			}
+		}
+	}
+	return config
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -451,6 +703,30 @@ func getDefaultExtensionMap() map[string]string {
 	}
 }
 
+// getDefaultShebangMap returns hardcoded interpreter → language mappings.
+//
+// Fallback when validators.jsonc has no shebangs table. Keys are interpreter
+// names as they appear after "#!" or "#!/usr/bin/env" (basename, no path).
+//
+// Supported Interpreters:
+//   bash, sh, zsh, dash, ksh → "shell"
+//   python, python2, python3 → "python"
+//   node, nodejs → "javascript"
+func getDefaultShebangMap() map[string]string {
+	return map[string]string{
+		"bash":    "shell",
+		"sh":      "shell",
+		"zsh":     "shell",
+		"dash":    "shell",
+		"ksh":     "shell",
+		"python":  "python",
+		"python2": "python",
+		"python3": "python",
+		"node":    "javascript",
+		"nodejs":  "javascript",
+	}
+}
+
 // getDefaultValidator returns hardcoded validator for a language.
 //
 // Fallback when validators.jsonc unavailable. Provides baseline validator
@@ -468,7 +744,7 @@ func getDefaultExtensionMap() map[string]string {
 //   - rust: cargo check --message-format=short (in project root)
 //   - python: python3 -m py_compile {filepath}
 //   - javascript: npx eslint {filepath}
-//   - shell: shellcheck {filepath}
+//   - shell: shellcheck -f gcc {filepath}
 //   - json: jq empty {filepath}
 //   - yaml: yamllint -f parsable {filepath}
 //   - toml: toml-test decode {filepath}
@@ -508,7 +784,7 @@ func getDefaultValidator(language string) *ValidatorTool {
 	case "shell":
 		return &ValidatorTool{
 			Command: "shellcheck",
-			Args:    []string{"{filepath}"},
+			Args:    []string{"-f", "gcc", "{filepath}"}, // One line per finding
 			Enabled: true,
 			Type:    "linting",
 		}
@@ -567,10 +843,8 @@ func getDefaultValidator(language string) *ValidatorTool {
 //   - javascript: Filters npm/yarn noise lines
 //   - All: Filters purely empty or whitespace-only lines
 //
-// Future Enhancement:
-//   - Extract file:line:col from different formats
-//   - Severity classification (error vs warning)
-//   - Filtering by specific file when validator runs on project
+// Structured file:line:col + severity extraction lives in parseDiagnostics()
+// (diagnostics.go); this function keeps the legacy display strings.
 //
 // Health Scoring: Supporting function for execution results (included in 30 points)
 func parseValidatorOutput(output, language string) []string {
@@ -621,12 +895,28 @@ func parseValidatorOutput(output, language string) []string {
 //
 // Health Scoring: Supporting function for command construction (included in 10 points)
 func findProjectRoot(filePath string) string {
+	markers := []string{"go.mod", "Cargo.toml", "package.json", "pyproject.toml"}
+	if dir := findMarkerDir(filePath, markers); dir != "" {
+		return dir
+	}
+
+	// No project root found - return file's directory
+	return filepath.Dir(filePath)
+}
+
+// findMarkerDir walks upward from a file to the nearest directory holding any marker.
+//
+// Shared by findProjectRoot() and project config discovery. Stops below the
+// home directory and filesystem root, like findProjectRoot always has.
+//
+// Returns:
+//   - Directory containing a marker, or "" if none found
+func findMarkerDir(filePath string, markers []string) string {
 	dir := filepath.Dir(filePath)
 	homeDir := os.Getenv("HOME")
 
 	for {
-		// Check for project marker files
-		markers := []string{"go.mod", "Cargo.toml", "package.json", "pyproject.toml"}
+		// Check for marker files
 		for _, marker := range markers {
 			markerPath := filepath.Join(dir, marker)
 			if _, err := os.Stat(markerPath); err == nil {
@@ -638,13 +928,104 @@ func findProjectRoot(filePath string) string {
 		parent := filepath.Dir(dir)
 		if parent == dir || parent == homeDir || parent == "/" {
 			// Reached filesystem root or home - stop searching
-			break
+			return ""
 		}
 		dir = parent
 	}
+}
 
-	// No project root found - return file's directory
-	return filepath.Dir(filePath)
+// activeConfig returns the global config, or nil when falling back to defaults.
+//
+// Internal functions take the config explicitly (project overrides layer a
+// merged copy on top of this one); nil always means "hardcoded defaults".
+func activeConfig() *ValidatorsConfig {
+	if validatorsConfigLoaded && validatorsConfig != nil {
+		return validatorsConfig
+	}
+	return nil
+}
+
+// validatorTimeout returns the per-validator execution deadline.
+//
+// Uses config.timeout_seconds when configured, defaultTimeoutSeconds otherwise.
+func validatorTimeout(cfg *ValidatorsConfig) time.Duration {
+	seconds := defaultTimeoutSeconds
+	if cfg != nil && cfg.Config.TimeoutSeconds > 0 {
+		seconds = cfg.Config.TimeoutSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// maxFileSize returns the largest file (bytes) validated for language.
+//
+// Uses config.language_max_file_size_mb[language], then config.max_file_size_mb,
+// then defaultMaxFileSizeMB.
+func maxFileSize(cfg *ValidatorsConfig, language string) int64 {
+	mb := defaultMaxFileSizeMB
+	if cfg != nil {
+		if cfg.Config.MaxFileSizeMB > 0 {
+			mb = cfg.Config.MaxFileSizeMB
+		}
+		if perLanguage := cfg.Config.LanguageMaxFileSizeMB[language]; perLanguage > 0 {
+			mb = perLanguage
+		}
+	}
+	return int64(mb) << 20
+}
+
+// maxOutputBytes returns the cap on captured validator output.
+//
+// Uses config.max_output_bytes when configured, defaultMaxOutputBytes otherwise.
+func maxOutputBytes(cfg *ValidatorsConfig) int {
+	if cfg != nil && cfg.Config.MaxOutputBytes > 0 {
+		return cfg.Config.MaxOutputBytes
+	}
+	return defaultMaxOutputBytes
+}
+
+// formatBytes renders a size limit for messages ("10MB", "256KB", "512B").
+func formatBytes(size int64) string {
+	switch {
+	case size >= 1<<20 && size%(1<<20) == 0:
+		return fmt.Sprintf("%dMB", size>>20)
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1fMB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%dKB", size>>10)
+	}
+	return fmt.Sprintf("%dB", size)
+}
+
+// captureOutput runs cmd and returns at most limit bytes of its combined output.
+//
+// Output is read through an io.LimitedReader; anything past the cap is drained
+// and discarded (the validator never blocks on a full pipe, and nothing beyond
+// limit+1 bytes is ever buffered). A truncated capture is cut back to its last
+// full line.
+func captureOutput(cmd *exec.Cmd, limit int) (output []byte, truncated bool, err error) {
+	reader, writer := io.Pipe()
+	cmd.Stdout, cmd.Stderr = writer, writer
+
+	captured := make(chan []byte, 1)
+	go func() {
+		data, _ := io.ReadAll(&io.LimitedReader{R: reader, N: int64(limit) + 1}) // One past the cap detects overflow
+		io.Copy(io.Discard, reader)                                            // Drain the rest unbuffered
+		captured <- data
+	}()
+
+	if err = cmd.Start(); err == nil {
+		err = cmd.Wait()
+	}
+	writer.Close()
+	output = <-captured
+
+	if len(output) > limit {
+		output, truncated = output[:limit], true
+		if cut := bytes.LastIndexByte(output, '\n'); cut >= 0 {
+			output = output[:cut+1]
+		}
+	}
+	return output, truncated, err
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -677,10 +1058,10 @@ func findProjectRoot(filePath string) string {
 //   3. Return empty string if extension not found in either
 //
 // Health Scoring: 10 points (part of ValidateFile's extension resolution)
-func getValidatorLanguage(ext string) string {
+func getValidatorLanguage(cfg *ValidatorsConfig, ext string) string {
 	// Try config first if loaded
-	if validatorsConfigLoaded && validatorsConfig != nil {
-		if language, exists := validatorsConfig.Extensions[ext]; exists {
+	if cfg != nil {
+		if language, exists := cfg.Extensions[ext]; exists {
 			return language
 		}
 	}
@@ -694,47 +1075,294 @@ func getValidatorLanguage(ext string) string {
 	return ""
 }
 
-// getPrimaryValidator resolves language to primary validator tool.
+// shebangInterpreter extracts the interpreter name from a file's first line.
 //
-// Internal function handling language → validator mapping with config fallback.
-// Returns the primary (first enabled) validator for a language. Checks loaded
-// configuration first, falls back to hardcoded defaults if unavailable.
+// "#!/bin/bash -e" → "bash"; "#!/usr/bin/env python3" → "python3";
+// "#!/usr/bin/env -S node --flag" → "node". Returns "" without a shebang.
+func shebangInterpreter(head []byte) string {
+	line, _, _ := strings.Cut(string(head), "\n")
+	rest, ok := strings.CutPrefix(strings.TrimRight(line, "\r"), "#!")
+	if !ok {
+		return ""
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return ""
+	}
+	interpreter := filepath.Base(fields[0])
+	if interpreter != "env" {
+		return interpreter
+	}
+	for _, field := range fields[1:] { // env: skip its flags and VAR=value assignments
+		if strings.HasPrefix(field, "-") || strings.Contains(field, "=") {
+			continue
+		}
+		return filepath.Base(field)
+	}
+	return ""
+}
+
+// sniffFile reads the start of a file for content-based detection.
+//
+// Returns the shebang interpreter ("" if none) and whether the content is
+// binary (a null byte in the first sniffBytes). Unreadable files report
+// neither - validation then treats them like any unknown file.
+func sniffFile(filePath string) (interpreter string, binary bool) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", false
+	}
+	defer file.Close()
+
+	head := make([]byte, sniffBytes)
+	n, _ := io.ReadFull(file, head)
+	head = head[:n]
+	if bytes.IndexByte(head, 0) >= 0 {
+		return "", true // Compiled hook binaries, images, archives
+	}
+	return shebangInterpreter(head), false
+}
+
+// getShebangLanguage maps an interpreter to a language (config, then defaults).
+//
+// Versioned interpreters fall back to their base name: "python3.12" tries
+// "python3.12", then "python".
+func getShebangLanguage(cfg *ValidatorsConfig, interpreter string) string {
+	candidates := []string{interpreter}
+	if base := strings.TrimRight(interpreter, "0123456789."); base != interpreter && base != "" {
+		candidates = append(candidates, base)
+	}
+
+	defaultMap := getDefaultShebangMap()
+	for _, candidate := range candidates {
+		if cfg != nil {
+			if language, exists := cfg.Shebangs[candidate]; exists {
+				return language
+			}
+		}
+		if language, exists := defaultMap[candidate]; exists {
+			return language
+		}
+	}
+	return ""
+}
+
+// resolveFileLanguage maps a file to a language: extension first, then content.
+//
+// Content is only read when the extension doesn't resolve (extensionless
+// scripts, unknown suffixes). binary=true means the file must not be handed
+// to any validator.
+func resolveFileLanguage(cfg *ValidatorsConfig, filePath, ext string) (language string, binary bool) {
+	if language := getValidatorLanguage(cfg, ext); language != "" {
+		return language, false
+	}
+	interpreter, binary := sniffFile(filePath)
+	if binary || interpreter == "" {
+		return "", binary
+	}
+	return getShebangLanguage(cfg, interpreter), false
+}
+
+// getEnabledValidators resolves language to all enabled validators in run order.
+//
+// Internal function returning every enabled validator for a language, ordered
+// deterministically. Go map iteration is random, so ordering uses the Priority
+// field (lower first, unset after prioritized tools) and then validator name.
 //
 // Parameters:
 //   - language: Language name (e.g., "go", "rust")
 //
 // Returns:
-//   - Validator name (e.g., "go_vet", "cargo_check") or empty string if none
-//
-// Resolution Order:
-//   1. Check validatorsConfig.Validators if config loaded
-//   2. Find first enabled validator in language's validator map
-//   3. Fall back to getDefaultValidator() if no config
-//   4. Return empty string if no validator found
+//   - Ordered validator names, or a single synthetic "<language>_default" name
+//     when falling back to hardcoded defaults, or nil if none
 //
 // Health Scoring: 10 points (part of ValidateFile's validator resolution)
-func getPrimaryValidator(language string) string {
+func getEnabledValidators(cfg *ValidatorsConfig, language string) []string {
 	// Try config first if loaded
-	if validatorsConfigLoaded && validatorsConfig != nil {
-		if langValidators, exists := validatorsConfig.Validators[language]; exists {
-			// Find first enabled validator
+	if cfg != nil {
+		if langValidators, exists := cfg.Validators[language]; exists {
+			var names []string
 			for name, tool := range langValidators.Validators {
 				if tool.Enabled {
-					return name
+					names = append(names, name)
 				}
 			}
+
+			tools := langValidators.Validators
+			sort.Slice(names, func(i, j int) bool {
+				pi, pj := tools[names[i]].Priority, tools[names[j]].Priority
+				if pi != pj {
+					if pi == 0 || pj == 0 {
+						return pj == 0 // Prioritized tools run before unprioritized
+					}
+					return pi < pj
+				}
+				return names[i] < names[j] // Stable tie-break by name
+			})
+
+			if len(names) > 0 {
+				return names
+			}
 		}
 	}
 
 	// Fall back to hardcoded defaults
-	defaultValidator := getDefaultValidator(language)
-	if defaultValidator != nil {
-		return language + "_default" // Synthetic name for fallback
+	if getDefaultValidator(language) != nil {
+		return []string{language + "_default"} // Synthetic name for fallback
 	}
 
+	return nil
+}
+
+// getPrimaryValidator resolves language to primary validator tool.
+//
+// Internal function handling language → validator mapping with config fallback.
+// Returns the primary validator for a language: the first entry of
+// getEnabledValidators(), so the choice is stable across runs.
+//
+// Parameters:
+//   - language: Language name (e.g., "go", "rust")
+//
+// Returns:
+//   - Validator name (e.g., "go_vet", "cargo_check") or empty string if none
+//
+// Health Scoring: 10 points (part of ValidateFile's validator resolution)
+func getPrimaryValidator(cfg *ValidatorsConfig, language string) string {
+	if names := getEnabledValidators(cfg, language); len(names) > 0 {
+		return names[0]
+	}
 	return ""
 }
 
+// resolveValidatorTool looks up the tool configuration for one validator.
+//
+// Internal function shared by command construction and availability checks.
+// Uses the configured tool when present, hardcoded default otherwise.
+//
+// Parameters:
+//   - language: Language name (e.g., "go", "rust")
+//   - validatorName: Validator tool name (e.g., "go_vet")
+//
+// Returns:
+//   - *ValidatorTool, or nil if neither config nor defaults know the language
+func resolveValidatorTool(cfg *ValidatorsConfig, language, validatorName string) *ValidatorTool {
+	if cfg != nil {
+		if langValidators, exists := cfg.Validators[language]; exists {
+			if validatorTool, exists := langValidators.Validators[validatorName]; exists {
+				return &validatorTool
+			}
+		}
+	}
+
+	// Fall back to default if no config
+	return getDefaultValidator(language)
+}
+
+// ────────────────────────────────────────────────────────────────
+// CORE OPERATIONS: Availability Checking
+// ────────────────────────────────────────────────────────────────
+
+// probeAvailability decides whether a validator's tool can run on this machine.
+//
+// Internal function doing the actual check, uncached. Runs the tool's
+// check_availability command when configured (e.g., "cargo clippy --version"
+// catches a missing clippy component even though cargo exists); otherwise
+// falls back to looking the tool command up on PATH.
+//
+// Parameters:
+//   - tool: Resolved validator configuration
+//   - env: Environment the tool runs with (toolEnvironment; nil = inherited) -
+//     the probe is looked up and run with it, so it agrees with the real run
+//
+// Returns:
+//   - available: true if the tool is usable
+//   - reason: Actionable explanation when unavailable, empty otherwise
+func probeAvailability(tool *ValidatorTool, env []string) (available bool, reason string) {
+	probe := strings.Fields(tool.CheckAvailability)
+	if len(probe) == 0 {
+		probe = []string{tool.Command} // No probe configured - only confirm the command exists
+	}
+
+	// Missing executable is the common case - report it by name
+	path, err := lookToolPath(probe[0], env)
+	if err != nil {
+		return false, fmt.Sprintf("%s not installed — %s", probe[0], installHint)
+	}
+	if len(probe) == 1 {
+		return true, ""
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), availabilityTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, path, probe[1:]...)
+	cmd.Env = env
+	configureProcessKill(cmd)
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return false, fmt.Sprintf("%s availability check timed out (%s)", tool.Command, tool.CheckAvailability)
+		}
+		return false, fmt.Sprintf("%s unavailable (%s failed) — %s", tool.Command, tool.CheckAvailability, installHint)
+	}
+
+	return true, ""
+}
+
+// checkAvailability returns the cached availability status of one validator.
+//
+// Internal function wrapping probeAvailability() with the per-process cache,
+// so a validator is probed at most once however many files are validated.
+//
+// Tools with env or path_prepend are probed per project root - the same
+// environment buildValidatorCommand gives them.
+//
+// Parameters:
+//   - language: Language name (e.g., "shell")
+//   - validatorName: Validator tool name (e.g., "shellcheck")
+//   - filePath: File about to be validated ("" = resolve {project_root} from the working directory)
+//
+// Returns:
+//   - ToolStatus with Available flag and skip Reason
+func checkAvailability(cfg *ValidatorsConfig, language, validatorName, filePath string) ToolStatus {
+	tool := resolveValidatorTool(cfg, language, validatorName)
+	key := language + "/" + validatorName
+	var env []string
+	if tool != nil {
+		key += "/" + tool.Command + "/" + tool.CheckAvailability // Project overrides may swap the tool
+		if len(tool.Env) > 0 || len(tool.PathPrepend) > 0 {
+			projectRoot := toolProjectRoot(filePath)
+			env = toolEnvironment(tool, projectRoot)
+			key += "/" + projectRoot + "/" + envValue(env, "PATH")
+			for name := range tool.Env {
+				key += "/" + name + "=" + envValue(env, name)
+			}
+		}
+	}
+
+	availabilityCacheMu.Lock()
+	status, cached := availabilityCache[key]
+	availabilityCacheMu.Unlock()
+	if cached {
+		return status
+	}
+
+	status = ToolStatus{Language: language, Validator: validatorName}
+	if tool != nil {
+		status.Command = tool.Command
+		status.Enabled = tool.Enabled
+		status.Available, status.Reason = probeAvailability(tool, env)
+	} else {
+		status.Reason = "no validator configured for " + language
+	}
+
+	availabilityCacheMu.Lock()
+	availabilityCache[key] = status
+	availabilityCacheMu.Unlock()
+
+	return status
+}
+
 // ────────────────────────────────────────────────────────────────
 // CORE OPERATIONS: Command Construction & Execution
 // ────────────────────────────────────────────────────────────────
@@ -746,17 +1374,29 @@ func getPrimaryValidator(language string) string {
 // path into arguments, and returns ready-to-execute command.
 //
 // Parameters:
+//   - ctx: Deadline context - cancellation kills the validator's process group
 //   - language: Language name (e.g., "go", "rust")
 //   - validatorName: Validator tool name (e.g., "go_vet")
-//   - filePath: Absolute path to file being validated
+//   - input: How the file reaches the tool (prepareValidatorInput, input.go)
 //
 // Returns:
 //   - *exec.Cmd ready to execute, or nil if construction failed
 //
 // Token Substitution:
-//   - {filepath} in args array replaced with actual filePath
+//   - {filepath} in args array replaced with the file (or its temp copy)
 //   - Substitution occurs in ALL arguments, not just first
 //   - Example: ["vet", "{filepath}"] → ["vet", "/path/to/file.go"]
+//   - {filename} replaced with the file's base name in every input mode
+//
+// Environment (env.go):
+//   - env and path_prepend set: the command runs with toolEnvironment() and is
+//     looked up on its PATH, exactly as checkAvailability probed it
+//   - neither set: the hook's environment is inherited
+//
+// Input Modes:
+//   - filepath: args name the file itself
+//   - stdin: args containing {filepath} dropped, content piped to stdin
+//   - tempcopy: {filepath} is a private copy of the file
 //
 // Working Directory:
 //   - Some validators need project root (cargo check, npm commands)
@@ -764,35 +1404,31 @@ func getPrimaryValidator(language string) string {
 //   - Defaults to file's directory if not specified
 //
 // Health Scoring: 10 points (part of ValidateFile's command construction)
-func buildValidatorCommand(language, validatorName, filePath string) *exec.Cmd {
-	var tool *ValidatorTool
-
-	// Get validator configuration
-	if validatorsConfigLoaded && validatorsConfig != nil {
-		if langValidators, exists := validatorsConfig.Validators[language]; exists {
-			if validatorTool, exists := langValidators.Validators[validatorName]; exists {
-				tool = &validatorTool
-			}
-		}
+func buildValidatorCommand(ctx context.Context, cfg *ValidatorsConfig, language, validatorName string, input *validatorInput) *exec.Cmd {
+	tool := resolveValidatorTool(cfg, language, validatorName)
+	if tool == nil || input == nil {
+		return nil
 	}
-
-	// Fall back to default if no config
-	if tool == nil {
-		tool = getDefaultValidator(language)
-		if tool == nil {
-			return nil
+	filePath := input.original
+
+	// Per-tool environment - the command is found on the PATH it will run with
+	command := tool.Command
+	env := toolEnvironment(tool, findProjectRoot(filePath))
+	if env != nil {
+		if path, err := lookToolPath(command, env); err == nil {
+			command = path
 		}
 	}
 
-	// Substitute {filepath} token in arguments
-	args := make([]string, len(tool.Args))
-	for i, arg := range tool.Args {
-		args[i] = strings.ReplaceAll(arg, "{filepath}", filePath)
+	// Build command with tokens substituted (killed with its children when ctx deadline passes)
+	cmd := exec.CommandContext(ctx, command, input.args(tool.Args)...)
+	cmd.Args[0] = tool.Command
+	cmd.Env = env
+	configureProcessKill(cmd)
+	if input.mode == InputStdin {
+		cmd.Stdin = bytes.NewReader(input.snapshot.content)
 	}
 
-	// Build command
-	cmd := exec.Command(tool.Command, args...)
-
 	// Set working directory if specified
 	if tool.WorkingDir == "project_root" {
 		// Find project root (directory containing go.mod, Cargo.toml, etc.)
@@ -811,27 +1447,56 @@ func buildValidatorCommand(language, validatorName, filePath string) *exec.Cmd {
 // checks exit code, and parses warnings from output.
 //
 // Parameters:
+//   - ctx: Deadline context the command was built with
 //   - cmd: Configured exec.Cmd ready to execute
+//   - input: The run's input - finished (temp copy removed, original checked) before returning
 //   - language: Language being validated (for language-specific output parsing)
+//   - validatorName: Validator name stamped on parsed Diagnostics
+//   - severity: Configured tool severity for findings the output doesn't label
+//   - outputLimit: Cap on captured output (config.max_output_bytes)
 //
 // Returns:
-//   - *ValidationResult with Valid flag and Warnings array
+//   - *ValidationResult with Valid flag, Warnings array, Diagnostics, and Truncated
 //
 // Exit Code Handling:
 //   - Exit 0: Valid=true, Warnings=[] (success)
 //   - Exit non-zero: Valid=false, Warnings=parsed output (validation failed)
 //   - Command error: Valid=false, Warnings=[error message] (execution failed)
+//   - Deadline exceeded: Valid=false, Warnings=parsed partial output (caller adds timeout notice)
+//   - Original modified (stdin/tempcopy): Valid=false, restore notice first in Warnings
 //
 // Output Parsing:
-//   - Combined stdout/stderr captured
+//   - Combined stdout/stderr captured up to outputLimit (see captureOutput);
+//     truncated output ends with an outputTruncatedMarker warning line
 //   - Language-specific filtering applied
 //   - Split into lines, filtered for relevance
 //   - Trimmed and cleaned for display
+//   - Structured Diagnostics parsed from the same output (see diagnostics.go)
 //
 // Health Scoring: 30 points (core of ValidateFile's execution scoring)
 //   +30 validation passes, +20 validation fails with warnings, 0 for crashes
-func executeValidator(cmd *exec.Cmd, language string) *ValidationResult {
-	output, err := cmd.CombinedOutput()
+func executeValidator(ctx context.Context, cmd *exec.Cmd, input *validatorInput, language, validatorName, severity string, outputLimit int) (result *ValidationResult) {
+	output, truncated, err := captureOutput(cmd, outputLimit)
+	output = input.displayPaths(output)
+	if notice := input.finish(validatorName); notice != "" {
+		defer func() {
+			result.Valid = false
+			result.Warnings = append([]string{notice}, result.Warnings...)
+		}()
+	}
+	if truncated {
+		output = append(output, fmt.Sprintf(outputTruncatedMarker, formatBytes(int64(outputLimit)))...)
+	}
+
+	if ctx.Err() == context.DeadlineExceeded {
+		// Killed at deadline - keep whatever the validator printed before the kill
+		return &ValidationResult{
+			Valid:       false,
+			Warnings:    parseValidatorOutput(string(output), language),
+			Diagnostics: parseDiagnostics(string(output), validatorName, severity),
+			Truncated:   truncated,
+		}
+	}
 
 	if err != nil {
 		// Exit code non-zero OR command failed to execute
@@ -839,8 +1504,10 @@ func executeValidator(cmd *exec.Cmd, language string) *ValidationResult {
 			// Validation found errors/warnings
 			warnings := parseValidatorOutput(string(output), language)
 			return &ValidationResult{
-				Valid:    false,
-				Warnings: warnings,
+				Valid:       false,
+				Warnings:    warnings,
+				Diagnostics: parseDiagnostics(string(output), validatorName, severity),
+				Truncated:   truncated,
 			}
 		} else {
 			// Command execution failed (validator not found, permission denied, etc.)
@@ -858,6 +1525,236 @@ func executeValidator(cmd *exec.Cmd, language string) *ValidationResult {
 	}
 }
 
+// runValidator builds and executes one validator, timing the run.
+//
+// Internal helper for ValidateFile. Wraps command construction and execution
+// into a single ToolResult so multi-validator runs merge cleanly. Each run is
+// bounded by config.timeout_seconds (default 30s); on timeout the tool fails
+// with a "timed out" warning followed by any partial output. Diagnostic paths
+// are made absolute, and project-wide validators (working_dir "project_root")
+// are narrowed to filePath when config.filter_by_file is set.
+//
+// Parameters:
+//   - language: Language name (e.g., "go")
+//   - validatorName: Validator tool name (e.g., "go_vet")
+//   - filePath: Absolute path to file being validated
+//
+// Returns:
+//   - ToolResult with validator name, pass/fail, warnings, diagnostics, and duration
+func runValidator(cfg *ValidatorsConfig, language, validatorName, filePath string) ToolResult {
+	return narrowToFile(cfg, runValidatorUnfiltered(cfg, language, validatorName, filePath), language, validatorName, filePath)
+}
+
+// runValidatorUnfiltered is runValidator without the filter_by_file narrowing.
+//
+// Batch validation runs project-wide tools once through this and narrows the
+// shared result per file with narrowToFile().
+func runValidatorUnfiltered(cfg *ValidatorsConfig, language, validatorName, filePath string) ToolResult {
+	start := time.Now()
+
+	timeout := validatorTimeout(cfg)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// Build validator command
+	tool := resolveValidatorTool(cfg, language, validatorName)
+	var input *validatorInput
+	if tool != nil {
+		var err error
+		if input, err = prepareValidatorInput(tool, filePath); err != nil {
+			logRails(func(logger *logging.Logger) {
+				logger.Failure("validator input preparation failed", err.Error(), healthStageFailure,
+					map[string]any{"validator": validatorName, "language": language, "file": filePath, "input": tool.Input})
+			})
+			return ToolResult{
+				Validator: validatorName,
+				Valid:     false,
+				Warnings:  []string{err.Error()},
+				Duration:  time.Since(start),
+			}
+		}
+	}
+	cmd := buildValidatorCommand(ctx, cfg, language, validatorName, input)
+	if tool == nil || cmd == nil {
+		// Command construction failed
+		logRails(func(logger *logging.Logger) {
+			logger.Failure("validator command construction failed", "no command for "+language+"/"+validatorName, healthStageFailure,
+				map[string]any{"validator": validatorName, "language": language, "file": filePath})
+		})
+		return ToolResult{
+			Validator: validatorName,
+			Valid:     false,
+			Warnings:  []string{"Failed to construct validator command"},
+			Duration:  time.Since(start),
+		}
+	}
+
+	// Execute validator
+	logRails(func(logger *logging.Logger) { logger.Operation(validatorName, healthCommandBuilt, filePath) })
+	executed := executeValidator(ctx, cmd, input, language, validatorName, tool.Severity, maxOutputBytes(cfg))
+	timedOut := ctx.Err() == context.DeadlineExceeded
+	if timedOut {
+		notice := fmt.Sprintf("validator %s timed out after %ds", validatorName, int(timeout.Seconds()))
+		executed.Warnings = append([]string{notice}, executed.Warnings...)
+	}
+	duration := time.Since(start)
+	logToolRun(language, validatorName, filePath, executed, duration, timeout, timedOut)
+
+	// Anchor relative paths to where the validator ran
+	baseDir := cmd.Dir
+	if baseDir == "" {
+		baseDir, _ = os.Getwd()
+	}
+	resolveDiagnosticPaths(executed.Diagnostics, baseDir)
+
+	return ToolResult{
+		Validator:   validatorName,
+		Valid:       executed.Valid,
+		Warnings:    executed.Warnings,
+		Diagnostics: executed.Diagnostics,
+		Duration:    duration,
+		Truncated:   executed.Truncated,
+	}
+}
+
+// logRails writes one validationLogger entry under validationLoggerMu.
+//
+// No-op when the logger doesn't exist, so instrumentation never blocks validation.
+func logRails(entry func(logger *logging.Logger)) {
+	if validationLogger == nil {
+		return
+	}
+	validationLoggerMu.Lock()
+	defer validationLoggerMu.Unlock()
+	entry(validationLogger)
+}
+
+// validationMetadata classifies a validation failure for restoration routing.
+func validationMetadata(errorType string, errorDetails map[string]any, hint, strategy string, params map[string]any) logging.Metadata {
+	return logging.Metadata{
+		OperationType:    "file_validation",
+		OperationSubtype: "syntax_check",
+		ErrorType:        errorType,
+		ErrorDetails:     errorDetails,
+		RecoveryHint:     hint,
+		RecoveryStrategy: strategy,
+		RecoveryParams:   params,
+	}
+}
+
+// logToolRun records one finished validator run: pass, findings, or timeout.
+//
+// Details carry what the immune system needs to spot slow or noisy tools -
+// validator, duration, and diagnostic/warning counts.
+func logToolRun(language, validatorName, filePath string, executed *ValidationResult, duration, timeout time.Duration, timedOut bool) {
+	details := map[string]any{
+		"validator":   validatorName,
+		"language":    language,
+		"file":        filePath,
+		"duration_ms": duration.Milliseconds(),
+		"diagnostics": len(executed.Diagnostics),
+		"warnings":    len(executed.Warnings),
+	}
+	if executed.Truncated {
+		details["output_truncated"] = true
+	}
+
+	logRails(func(logger *logging.Logger) {
+		switch {
+		case timedOut:
+			seconds := int(timeout.Seconds())
+			logger.FailureWithMetadata("validator timed out", fmt.Sprintf("%s exceeded %ds", validatorName, seconds), 0, details,
+				validationMetadata("validator_timeout", map[string]any{"validator": validatorName, "timeout_seconds": seconds},
+					"adjust_configuration", "raise_validator_timeout",
+					map[string]any{"setting": "config.timeout_seconds", "current": seconds}))
+		case executed.Valid:
+			logger.Success("validator passed", healthToolPassed, details)
+		default:
+			logger.Failure("validator reported findings", fmt.Sprintf("%d diagnostics", len(executed.Diagnostics)), healthToolFindings, details)
+		}
+	})
+}
+
+// logToolMissing records a validator whose tool failed its availability check.
+//
+// Skipped tools cost nothing (graceful degradation); under
+// fail_on_missing_validator the stage counts as failed.
+func logToolMissing(language, filePath string, status ToolStatus, failed bool) {
+	healthImpact := 0
+	if failed {
+		healthImpact = healthStageFailure
+	}
+	details := map[string]any{
+		"validator": status.Validator,
+		"language":  language,
+		"file":      filePath,
+		"skipped":   !failed,
+	}
+
+	logRails(func(logger *logging.Logger) {
+		logger.FailureWithMetadata("validator missing", status.Reason, healthImpact, details,
+			validationMetadata("validator_missing", map[string]any{"command": status.Command},
+				"install_dependency", "install_package",
+				map[string]any{"package": status.Command}))
+	})
+}
+
+// isProjectScoped reports whether a validator checks the whole project
+// (working_dir "project_root", e.g. cargo check) rather than one file.
+func isProjectScoped(cfg *ValidatorsConfig, language, validatorName string) bool {
+	tool := resolveValidatorTool(cfg, language, validatorName)
+	return tool != nil && tool.WorkingDir == "project_root"
+}
+
+// narrowToFile applies config.filter_by_file to a project-wide tool's result.
+//
+// Returns the result unchanged for per-file tools or when filtering is off.
+// Never modifies the input's slices, so a shared result can be narrowed for
+// several files.
+func narrowToFile(cfg *ValidatorsConfig, result ToolResult, language, validatorName, filePath string) ToolResult {
+	if !isProjectScoped(cfg, language, validatorName) || !(cfg != nil && cfg.Config.FilterByFile) {
+		return result
+	}
+	filterByFile(&result, filePath)
+	return result
+}
+
+// filterByFile narrows a project-wide validator's result to one file.
+//
+// Drops other files' Diagnostics and their matching Warnings lines. When every
+// finding belonged to other files, the tool passes for this file - leftover
+// summary lines ("could not compile") describe those other files too.
+// Fresh slices are built, so results shared between files stay intact.
+//
+// Parameters:
+//   - result: Tool result, narrowed in place
+//   - filePath: File being validated
+func filterByFile(result *ToolResult, filePath string) {
+	if len(result.Diagnostics) == 0 {
+		return // Nothing located - can't tell which file output refers to
+	}
+
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		absPath = filePath
+	}
+	kept, dropped := filterDiagnosticsByFile(result.Diagnostics, absPath)
+	result.Diagnostics = kept
+
+	if len(kept) == 0 {
+		result.Valid = true
+		result.Warnings = []string{}
+		return
+	}
+
+	var warnings []string
+	for _, warning := range result.Warnings {
+		if !dropped[warning] {
+			warnings = append(warnings, warning)
+		}
+	}
+	result.Warnings = warnings
+}
 
 // ────────────────────────────────────────────────────────────────
 // Public APIs - Exported Interface
@@ -881,17 +1778,33 @@ func executeValidator(cmd *exec.Cmd, language string) *ValidationResult {
 //
 // Returns:
 //   *ValidationResult with fields:
-//     - Valid: true if validation passed, false if errors/warnings found
-//     - Warnings: Array of validation messages (empty if Valid=true)
-//     - Validator: Name of validator that ran (e.g., "go_vet")
+//     - Valid: true if every validator passed under config.strictness, false otherwise
+//     - Severity: "error" (Valid=false), "warning" (passed with findings), or "clean"
+//     - Warnings: Array of validation messages from all tools (empty if Valid=true)
+//     - Diagnostics: Structured findings (file, line, column, severity, message, tool)
+//     - Validator: Name(s) of validators that ran (e.g., "go_vet, staticcheck")
+//     - ToolResults: Per-tool outcome (name, valid, warnings, duration)
 //     - Language: Language that was validated (e.g., "go")
 //     - FilePath: Original file path (for reference in results)
 //
 // Behavior:
+//   - Ignored files (.validationignore, config.ignore, validators.<language>.ignore)
+//     return Valid=true with Ignored set to the deciding rule - nothing runs
 //   - Unknown extensions return Valid=true (no validator available = not an error)
+//   - Extension unresolved: the shebang picks the language (shebangs table); binary
+//     content (null byte in the first 512 bytes) returns Valid=true without running anything
 //   - Missing validators return Valid=true (graceful degradation)
+//   - Files over max_file_size_mb return Valid=true with every validator in
+//     Skipped ("file too large ...") - nothing runs
+//   - Output past max_output_bytes is discarded; Truncated records it
+//   - Uninstalled tools are listed in Skipped, or fail the result when
+//     config.fail_on_missing_validator=true
 //   - Validator execution errors return Valid=false with error message in Warnings
+//   - config.strictness (or language_strictness): "permissive" lets severity "warning"
+//     tools report without failing; "error_only" fails only on error findings;
+//     "strict" or unset fails on any tool failure
 //   - Configuration-driven: Uses validators.jsonc if available, hardcoded fallback otherwise
+//   - config.run_all_validators=true runs every enabled validator; false runs the primary only
 //
 // Example Usage:
 //
@@ -908,48 +1821,148 @@ func executeValidator(cmd *exec.Cmd, language string) *ValidationResult {
 //   Extension resolution (10) + Validator resolution (10) + Command construction (10)
 //   + Execution (30) - 5 points for each stage failure
 func ValidateFile(filePath, ext string) *ValidationResult {
-	// Resolve extension to language
-	language := getValidatorLanguage(ext)
+	return validateFile(filePath, ext, runValidator)
+}
+
+// oversizeReason returns why filePath is too large to validate ("" when it isn't).
+//
+// The limit is maxFileSize(cfg, language); an unreadable file is left for the
+// validator to report.
+func oversizeReason(cfg *ValidatorsConfig, language, filePath string) string {
+	info, err := os.Stat(filePath)
+	limit := maxFileSize(cfg, language)
+	if err != nil || info.Size() <= limit {
+		return ""
+	}
+
+	logRails(func(logger *logging.Logger) {
+		logger.Check("file within validation size limit", false, 0, map[string]any{
+			"file":        filePath,
+			"language":    language,
+			"size_bytes":  info.Size(),
+			"limit_bytes": limit,
+		})
+	})
+	return fmt.Sprintf("file too large (%s, limit %s)", formatBytes(info.Size()), formatBytes(limit))
+}
+
+// validateFile is ValidateFile with the per-tool execution step injectable.
+//
+// Internal orchestration shared by ValidateFile and the batch APIs (batch.go).
+// See ValidateFile for behavior.
+func validateFile(filePath, ext string, run toolRunner) *ValidationResult {
+	// Project .cpi-si-validators.jsonc layered over the global config
+	cfg := configForFile(filePath)
+
+	// Resolve extension (or shebang) to language
+	language, _ := resolveFileLanguage(cfg, filePath, ext)
+
+	// Ignored files are left alone before any validator is looked up
+	if reason := ignoredBy(cfg, language, filePath); reason != "" {
+		logRails(func(logger *logging.Logger) {
+			logger.Check("file not ignored by validation rules", false, 0, map[string]any{
+				"file":     filePath,
+				"language": language,
+				"reason":   reason,
+			})
+		})
+		return &ValidationResult{
+			Valid:    true,
+			Warnings: []string{},
+			Language: language,
+			FilePath: filePath,
+			Severity: SeverityClean,
+			Ignored:  reason,
+		}
+	}
+
 	if language == "" {
-		// Unknown extension - not an error, just no validation available
+		// Unknown file type or binary content - not an error, just no validation available
 		return &ValidationResult{
 			Valid:    true,
 			Warnings: []string{},
 			FilePath: filePath,
+			Severity: SeverityClean,
 		}
 	}
 
-	// Resolve language to primary validator
-	validatorName := getPrimaryValidator(language)
-	if validatorName == "" {
+	// Resolve language to validators (primary only unless RunAllValidators)
+	validatorNames := getEnabledValidators(cfg, language)
+	if len(validatorNames) == 0 {
 		// No validator configured - graceful degradation
 		return &ValidationResult{
 			Valid:     true,
 			Warnings:  []string{},
 			Language:  language,
 			FilePath:  filePath,
+			Severity:  SeverityClean,
 		}
 	}
+	if !(cfg != nil && cfg.Config.RunAllValidators) {
+		validatorNames = validatorNames[:1] // Primary validator only
+	}
 
-	// Build validator command
-	cmd := buildValidatorCommand(language, validatorName, filePath)
-	if cmd == nil {
-		// Command construction failed
-		return &ValidationResult{
-			Valid:     false,
-			Warnings:  []string{"Failed to construct validator command"},
-			Validator: validatorName,
+	// Oversized files (generated JSON, bundles) are skipped, never fed to a validator
+	if reason := oversizeReason(cfg, language, filePath); reason != "" {
+		result := &ValidationResult{
+			Valid:     true,
+			Warnings:  []string{},
+			Validator: strings.Join(validatorNames, ", "),
 			Language:  language,
 			FilePath:  filePath,
+			Severity:  SeverityClean,
 		}
+		for _, validatorName := range validatorNames {
+			result.Skipped = append(result.Skipped, SkippedValidator{Validator: validatorName, Reason: reason})
+		}
+		return result
+	}
+
+	// Execute each validator and merge results (verdicts weighed by strictness)
+	strictness := strictnessFor(cfg, language)
+	result := &ValidationResult{
+		Valid:     true,
+		Warnings:  []string{},
+		Validator: strings.Join(validatorNames, ", "),
+		Language:  language,
+		FilePath:  filePath,
 	}
+	for _, validatorName := range validatorNames {
+		// Skip (or fail) tools that aren't installed instead of surfacing exec errors
+		if status := checkAvailability(cfg, language, validatorName, filePath); !status.Available {
+			failOnMissing := cfg != nil && cfg.Config.FailOnMissingValidator
+			logToolMissing(language, filePath, status, failOnMissing)
+			if failOnMissing {
+				result.ToolResults = append(result.ToolResults, ToolResult{
+					Validator: validatorName,
+					Valid:     false,
+					Warnings:  []string{status.Reason},
+					Missing:   true,
+				})
+				result.Warnings = append(result.Warnings, status.Reason)
+				result.Valid = false
+			} else {
+				result.Skipped = append(result.Skipped, SkippedValidator{
+					Validator: validatorName,
+					Reason:    status.Reason,
+					Missing:   true,
+				})
+			}
+			continue
+		}
 
-	// Execute validator and return result
-	result := executeValidator(cmd, language)
-	result.Validator = validatorName
-	result.Language = language
-	result.FilePath = filePath
+		toolResult := run(cfg, language, validatorName, filePath)
+		if tool := resolveValidatorTool(cfg, language, validatorName); tool != nil {
+			toolResult.Valid = toolPasses(strictness, tool.Severity, toolResult)
+		}
+		result.ToolResults = append(result.ToolResults, toolResult)
+		result.Warnings = append(result.Warnings, toolResult.Warnings...)
+		result.Diagnostics = append(result.Diagnostics, toolResult.Diagnostics...)
+		result.Valid = result.Valid && toolResult.Valid // Valid only if every tool passes
+		result.Truncated = result.Truncated || toolResult.Truncated
+	}
 
+	result.Severity = summarizeSeverity(result)
 	return result
 }
 
@@ -979,7 +1992,7 @@ func ValidateFile(filePath, ext string) *ValidationResult {
 //
 // Health Scoring: Included in ValidateFile's extension resolution (10 points)
 func GetValidatorLanguage(ext string) string {
-	return getValidatorLanguage(ext)
+	return getValidatorLanguage(activeConfig(), ext)
 }
 
 // GetPrimaryValidator returns the primary validator tool name for a given language.
@@ -1002,7 +2015,51 @@ func GetValidatorLanguage(ext string) string {
 //
 // Health Scoring: Included in ValidateFile's validator resolution (10 points)
 func GetPrimaryValidator(language string) string {
-	return getPrimaryValidator(language)
+	return getPrimaryValidator(activeConfig(), language)
+}
+
+// CheckAllValidators reports which configured validators are usable on this machine.
+//
+// Probes every configured validator (enabled or not) using the same cached
+// availability check ValidateFile uses. Intended for the diagnose command's
+// validator matrix - nothing is validated.
+//
+// Returns:
+//   - Map keyed "language/validator" (e.g., "shell/shellcheck") → ToolStatus
+//
+// Example:
+//
+//     for key, status := range validation.CheckAllValidators() {
+//         fmt.Println(key, status.Available, status.Reason)
+//     }
+//
+// Health Scoring: Diagnostic query - not part of ValidateFile scoring
+func CheckAllValidators() map[string]ToolStatus {
+	statuses := make(map[string]ToolStatus)
+
+	if cfg := activeConfig(); cfg != nil {
+		for language, langValidators := range cfg.Validators {
+			for name := range langValidators.Validators {
+				statuses[language+"/"+name] = checkAvailability(cfg, language, name, "")
+			}
+		}
+		return statuses
+	}
+
+	// No config - report the hardcoded defaults that would actually run
+	seen := make(map[string]bool)
+	for _, language := range getDefaultExtensionMap() {
+		if seen[language] {
+			continue
+		}
+		seen[language] = true
+		if getDefaultValidator(language) != nil {
+			name := language + "_default"
+			statuses[language+"/"+name] = checkAvailability(nil, language, name, "")
+		}
+	}
+
+	return statuses
 }
 
 // ────────────────────────────────────────────────────────────────
@@ -1016,10 +2073,13 @@ func GetPrimaryValidator(language string) string {
 // validation passed (Valid=true).
 //
 // Behavior:
-//   - If Valid=true: Silent (no output)
+//   - Skipped validators: One info line each with the skip reason
+//   - If Valid=true: Silent (no output beyond skip notes)
 //   - If Valid=false: Display warnings using display.Warning()
 //   - Shows validator name, language, and file path for context
 //   - Formats warnings with proper indentation and structure
+//   - Orders located findings errors → warnings → info, then by line, colored by severity
+//   - Shorter modes: ReportSummary(), ReportN(), ReportConfigured() (see report.go)
 //
 // Integration:
 //   - Called by tool/post-use hook after validation
@@ -1034,20 +2094,7 @@ func GetPrimaryValidator(language string) string {
 // Health Scoring: 10 points (display integration portion)
 //   +10 display works, +5 fallback fmt works, 0 if fails
 func (v *ValidationResult) Report() {
-	if v == nil || v.Valid {
-		return // Silent success
-	}
-
-	// Display validation failure with context
-	header := "Validation warnings"
-	if v.Language != "" && v.Validator != "" {
-		header = "Validation warnings (" + v.Language + " / " + v.Validator + ")"
-	}
-
-	fmt.Println(display.Warning(header))
-	for _, warning := range v.Warnings {
-		fmt.Println("   " + strings.TrimSpace(warning))
-	}
+	v.ReportN(0) // No line limit
 }
 
 // ============================================================================
@@ -1285,7 +2332,7 @@ func (v *ValidationResult) Report() {
 // See BODY function docstrings above for operation-specific performance notes.
 //
 // Quick summary (details in SETUP/BODY above):
-// - executeValidator(): Synchronous, blocks until completion (typically <2s per file)
+// - executeValidator(): Synchronous, bounded by config.timeout_seconds (typically <2s per file)
 // - Config loading: One-time cost at startup, graceful fallback if slow/failing
 // - Key optimization: Validators run directly (no file copying), respect project configs
 // - Project root finding: Walks upward from file (typically <5 directories)
@@ -1371,7 +2418,6 @@ func (v *ValidationResult) Report() {
 //   - No validator result caching (always re-validates)
 //   - Config changes require restart (no hot-reload)
 //   - No per-directory validator overrides (global config only)
-//   - No validator timeout handling (trusts tools to complete)
 //   - Limited output parsing (generic line splitting, no structured extraction)
 //
 // Version History:
@@ -0,0 +1,302 @@
+//go:build linux
+
+// ============================================================================
+// METADATA
+// ============================================================================
+// Syntax Validation Tests - Timeout enforcement, availability pre-check
+//
+// Purpose: Prove config.timeout_seconds actually bounds validator execution:
+//          the deadline fires, partial output survives, and the validator's
+//          process tree is killed and reaped (no zombie left behind).
+//          Prove uninstalled tools are skipped (or fail) with a clear reason.
+//          Prove each run leaves the expected Rails entries in validation.log.
+//          Prove oversized files are skipped and chatty output is capped.
+//
+// Linux-only: inspects /proc to confirm process state after the kill.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"system/lib/logging"
+)
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+// processState returns the /proc state letter for pid ("" when gone).
+func processState(pid int) string {
+	data, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "stat"))
+	if err != nil {
+		return ""
+	}
+	fields := strings.Fields(string(data)[strings.LastIndex(string(data), ")")+1:])
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
+
+// TestValidatorTimeoutKillsProcessTree runs a validator that prints, spawns a
+// sleeping child, and waits - then checks the deadline cut it short.
+func TestValidatorTimeoutKillsProcessTree(t *testing.T) {
+	dir := t.TempDir()
+	pidFile := filepath.Join(dir, "pids")
+	script := filepath.Join(dir, "hang.sh")
+	body := "echo 'hang.sh:1:1: partial finding'\n" +
+		"sleep 60 &\n" +
+		"echo \"$$ $!\" > " + pidFile + "\n" +
+		"wait\n"
+	if err := os.WriteFile(script, []byte(body), 0755); err != nil {
+		t.Fatal(err)
+	}
+	useFakeValidator(t, script, 1)
+
+	start := time.Now()
+	result := runValidator(activeConfig(), "fake", "fake_sleep", script)
+	elapsed := time.Since(start)
+
+	if elapsed > 1*time.Second+killGracePeriod+time.Second {
+		t.Fatalf("validator ran %v, deadline did not fire", elapsed)
+	}
+	if result.Valid {
+		t.Fatal("timed-out validator reported Valid=true")
+	}
+	if len(result.Warnings) == 0 || result.Warnings[0] != "validator fake_sleep timed out after 1s" {
+		t.Fatalf("first warning = %q, want timeout notice", result.Warnings)
+	}
+	if !strings.Contains(strings.Join(result.Warnings, "\n"), "partial finding") {
+		t.Errorf("partial output lost: %q", result.Warnings)
+	}
+
+	data, err := os.ReadFile(pidFile)
+	if err != nil {
+		t.Fatalf("fake validator never recorded its pids: %v", err)
+	}
+	pids := strings.Fields(string(data))
+	shellPID, _ := strconv.Atoi(pids[0])
+	sleepPID, _ := strconv.Atoi(pids[1])
+
+	// Direct child was waited on - it must be fully reaped, not a zombie
+	if err := syscall.Kill(shellPID, 0); err != syscall.ESRCH {
+		t.Errorf("validator process %d still present (state %q)", shellPID, processState(shellPID))
+	}
+
+	// Grandchild died with the process group (it is not ours to reap, so a
+	// zombie awaiting init is acceptable - a running sleep is not)
+	deadline := time.Now().Add(time.Second)
+	for state := processState(sleepPID); state != "" && state != "Z"; state = processState(sleepPID) {
+		if time.Now().After(deadline) {
+			syscall.Kill(sleepPID, syscall.SIGKILL)
+			t.Fatalf("grandchild %d survived the timeout (state %q)", sleepPID, state)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+// TestValidatorWithinTimeout confirms fast validators are unaffected.
+func TestValidatorWithinTimeout(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "ok.sh")
+	if err := os.WriteFile(script, []byte("exit 0\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	useFakeValidator(t, script, 5)
+
+	result := runValidator(activeConfig(), "fake", "fake_sleep", script)
+	if !result.Valid || len(result.Warnings) != 0 {
+		t.Fatalf("fast validator: Valid=%v Warnings=%q", result.Valid, result.Warnings)
+	}
+}
+
+// TestMissingValidatorSkipped checks an uninstalled tool is skipped with an
+// actionable reason, or fails the result under fail_on_missing_validator.
+func TestMissingValidatorSkipped(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "input.fake")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	useFakeValidator(t, "unused", 5)
+	validatorsConfig.Extensions = map[string]string{".fake": "fake"}
+	validatorsConfig.Validators["fake"].Validators["fake_sleep"] = ValidatorTool{
+		Command: "cpi-si-no-such-tool", Enabled: true,
+	}
+	t.Cleanup(func() { availabilityCache = map[string]ToolStatus{} })
+
+	result := ValidateFile(file, ".fake")
+	if !result.Valid || len(result.Skipped) != 1 {
+		t.Fatalf("missing tool: Valid=%v Skipped=%v", result.Valid, result.Skipped)
+	}
+	if want := "cpi-si-no-such-tool not installed — install via apt/brew"; result.Skipped[0].Reason != want {
+		t.Errorf("skip reason = %q, want %q", result.Skipped[0].Reason, want)
+	}
+
+	validatorsConfig.Config.FailOnMissingValidator = true
+	result = ValidateFile(file, ".fake")
+	if result.Valid || len(result.Skipped) != 0 || len(result.Warnings) != 1 {
+		t.Fatalf("fail_on_missing: Valid=%v Skipped=%v Warnings=%q", result.Valid, result.Skipped, result.Warnings)
+	}
+}
+
+// TestLargeFileSkipped checks a file over max_file_size_mb never reaches the
+// validator, and that a per-language limit wins over the global one.
+func TestLargeFileSkipped(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "generated.fake")
+	marker := filepath.Join(dir, "ran")
+	script := filepath.Join(dir, "check.sh")
+	if err := os.WriteFile(script, []byte("touch "+marker+"\nexit 0\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(file, 2<<20+1); err != nil { // Sparse: just over 2MB without writing it
+		t.Fatal(err)
+	}
+	useFakeValidator(t, script, 5)
+	validatorsConfig.Extensions = map[string]string{".fake": "fake"}
+	validatorsConfig.Config.MaxFileSizeMB = 1
+	t.Cleanup(func() { availabilityCache = map[string]ToolStatus{} })
+
+	result := ValidateFile(file, ".fake")
+	if !result.Valid || len(result.Skipped) != 1 || !strings.HasPrefix(result.Skipped[0].Reason, "file too large") {
+		t.Fatalf("oversized file: Valid=%v Skipped=%+v", result.Valid, result.Skipped)
+	}
+	if _, err := os.Stat(marker); err == nil {
+		t.Fatal("validator ran on an oversized file")
+	}
+
+	validatorsConfig.Config.LanguageMaxFileSizeMB = map[string]int{"fake": 3}
+	if result := ValidateFile(file, ".fake"); len(result.Skipped) != 0 {
+		t.Fatalf("per-language limit ignored: Skipped=%+v", result.Skipped)
+	}
+	if _, err := os.Stat(marker); err != nil {
+		t.Error("validator did not run under the per-language limit")
+	}
+}
+
+// TestValidatorOutputCapped runs a validator printing ~2MB and checks only
+// max_output_bytes survive, cut at a line, with the truncation recorded.
+func TestValidatorOutputCapped(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "chatty.sh")
+	body := "i=0\nwhile [ $i -lt 50000 ]; do echo \"chatty.sh:$i:1: noisy finding number $i\"; i=$((i+1)); done\nexit 1\n"
+	if err := os.WriteFile(script, []byte(body), 0755); err != nil {
+		t.Fatal(err)
+	}
+	useFakeValidator(t, script, 30)
+	validatorsConfig.Config.MaxOutputBytes = 4096
+
+	result := runValidator(activeConfig(), "fake", "fake_sleep", script)
+	if result.Valid || !result.Truncated {
+		t.Fatalf("chatty validator: Valid=%v Truncated=%v", result.Valid, result.Truncated)
+	}
+	total := 0
+	for _, warning := range result.Warnings {
+		total += len(warning)
+	}
+	if total > 4096+100 {
+		t.Errorf("kept %d bytes of warnings, cap is 4096", total)
+	}
+	last := result.Warnings[len(result.Warnings)-1]
+	if last != "[validator output truncated at 4KB]" {
+		t.Errorf("last warning = %q, want the truncation marker", last)
+	}
+	line := regexp.MustCompile(`^chatty\.sh:(\d+):1: noisy finding number (\d+)$`)
+	if m := line.FindStringSubmatch(result.Warnings[len(result.Warnings)-2]); m == nil || m[1] != m[2] {
+		t.Errorf("last kept line cut mid-line: %q", result.Warnings[len(result.Warnings)-2])
+	}
+}
+
+// useRailsLog points validationLogger at a fresh log under a temp HOME and
+// returns a reader for the entries logged since.
+func useRailsLog(t *testing.T) func() []logging.LogEntry {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+
+	saved := validationLogger
+	t.Cleanup(func() { validationLogger = saved })
+	validationLogger = logging.NewLogger("validation")
+
+	return func() []logging.LogEntry {
+		entries, err := logging.ReadLogFile(validationLogger.LogFile)
+		if err != nil {
+			t.Fatalf("reading validation log: %v", err)
+		}
+		return entries[2:] // Skip NewLogger's log-writable check and routing entry
+	}
+}
+
+// TestRailsEntrySequence checks the entries a pass, a fail, and a missing-tool
+// skip leave behind.
+func TestRailsEntrySequence(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "input.fake")
+	script := filepath.Join(dir, "check.sh")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	useFakeValidator(t, script, 5)
+	validatorsConfig.Extensions = map[string]string{".fake": "fake"}
+	t.Cleanup(func() { availabilityCache = map[string]ToolStatus{} })
+
+	cases := []struct {
+		name   string
+		script string
+		levels []string
+		events []string
+	}{
+		{"pass", "exit 0\n", []string{"OPERATION", "SUCCESS"}, []string{"", "validator passed"}},
+		{"fail", "echo 'input.fake:1:1: bad'\nexit 1\n", []string{"OPERATION", "FAILURE"}, []string{"", "validator reported findings"}},
+	}
+	for _, tc := range cases {
+		readEntries := useRailsLog(t)
+		if err := os.WriteFile(script, []byte(tc.script), 0755); err != nil {
+			t.Fatal(err)
+		}
+		ValidateFile(file, ".fake")
+
+		entries := readEntries()
+		if len(entries) != len(tc.levels) {
+			t.Fatalf("%s: %d entries, want %d", tc.name, len(entries), len(tc.levels))
+		}
+		for i, entry := range entries {
+			if entry.Level != tc.levels[i] || (tc.events[i] != "" && entry.Event != tc.events[i]) {
+				t.Errorf("%s entry %d = %s %q, want %s %q", tc.name, i, entry.Level, entry.Event, tc.levels[i], tc.events[i])
+			}
+		}
+		if last := entries[len(entries)-1]; last.Details["validator"] != "fake_sleep" || last.Details["duration_ms"] == nil {
+			t.Errorf("%s details = %v, want validator and duration", tc.name, last.Details)
+		}
+	}
+
+	readEntries := useRailsLog(t)
+	validatorsConfig.Validators["fake"].Validators["fake_sleep"] = ValidatorTool{
+		Command: "cpi-si-no-such-tool", Enabled: true,
+	}
+	ValidateFile(file, ".fake")
+
+	entries := readEntries()
+	if len(entries) != 1 || entries[0].Level != "FAILURE" || entries[0].Semantic == nil {
+		t.Fatalf("missing tool entries = %+v, want one FAILURE with metadata", entries)
+	}
+	if semantic := entries[0].Semantic; semantic.ErrorType != "validator_missing" || semantic.RecoveryStrategy != "install_package" {
+		t.Errorf("missing tool metadata = %+v", semantic)
+	}
+}
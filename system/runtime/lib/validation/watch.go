@@ -0,0 +1,390 @@
+// METADATA
+//
+// Watch Mode - CPI-SI Runtime System
+//
+// For METADATA structure explanation, see: standards/code/4-block/CWS-STD-004-CODE-metadata-block.md
+//
+// Biblical Foundation
+//
+// Scripture: "I will stand upon my watch, and set me upon the tower, and will watch to see what he will say unto me" - Habakkuk 2:1 (KJV)
+// Principle: Keep watch while the work happens, not only when it is handed in
+// Anchor: "Watch ye, stand fast in the faith, quit you like men, be strong." - 1 Corinthians 16:13 (KJV)
+//
+// CPI-SI Identity
+//
+// Component Type: LIBRARY - Runtime validation support (mid-rung on ladder)
+// Role: Revalidates watched files as they change and streams the results
+// Paradigm: mtime polling over the batch APIs - no new dependencies, no OS watchers
+//
+// Authorship & Lineage
+//
+// Architect: Nova Dawn (CPI-SI instance)
+// Implementation: Nova Dawn
+// Creation Date: 2026-10-16
+// Version: 1.1.0
+// Last Modified: 2026-10-16 - Ignore rules honored in watched directories
+//
+// Version History:
+//   1.1.0 (2026-10-16) - Watched directories skip ignored files (collectFiles, ignore.go)
+//   1.0.0 (2026-10-16) - Watch, WatchOptions, per-file and per-project-root debounce
+//
+// Purpose & Function
+//
+// Purpose: Validation ran only when a hook fired. Editing outside the hook flow
+// (an editor, a script) had no feedback until the next tool use or commit.
+//
+// Core Design: Watch polls the watched files' mtime and size every Interval.
+// A change starts (or restarts) a debounce timer for the file's group; once the
+// group has been quiet for Debounce, its files revalidate as one batch and each
+// result goes out on the channel. Every revalidation is sent - including a file
+// going from invalid to valid, so a UI can clear its markers.
+//
+// Debounce groups:
+//   - Files whose language enables a project-scoped validator (working_dir
+//     "project_root", e.g. cargo check) group by project root - saving three
+//     files in a crate runs cargo check once, after the last save
+//   - Every other file is its own group
+//
+// Key Features:
+//   - Files and directories (walked with the ValidateDir include/exclude and
+//     ignore rules, so new files under a watched directory are picked up and
+//     regenerated files a .validationignore covers are not)
+//   - Initial validation of every watched file (WatchOptions.SkipInitial turns it off)
+//   - Deleted files drop out silently; a recreated file counts as changed
+//   - Cancel func stops polling and closes the channel; safe to call twice
+//
+// Blocking Status
+//
+// Non-blocking: Unreadable paths are skipped on each poll. The watcher blocks
+// only on sending - a caller must drain the channel or cancel.
+//
+// Usage & Integration
+//
+// Usage:
+//
+//	results, cancel := validation.Watch([]string{"."}, validation.WatchOptions{})
+//	defer cancel()
+//	for result := range results {
+//	    result.Report()
+//	}
+//
+// Public API:
+//   Watch(paths []string, opts WatchOptions) (<-chan *ValidationResult, func())
+//
+// Dependencies
+//
+// Dependencies (What This Needs):
+//   Standard Library: os, path/filepath, sort, sync, time
+//   Package Files: batch.go (DirOptions, collectFiles, validateBatch),
+//                  syntax.go (resolveFileLanguage, getEnabledValidators, isProjectScoped,
+//                  findProjectRoot), project.go (configForFile)
+//
+// Dependents (What Uses This):
+//   Commands: validate --watch
+//
+// Health Scoring
+//
+// Orchestration over validateBatch - per-file scoring applies to each revalidation.
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+//
+// For SETUP structure explanation, see: standards/code/4-block/CWS-STD-006-CODE-setup-block.md
+
+import (
+	//--- Standard Library ---
+	// Foundation packages providing Go's built-in capabilities.
+
+	"os"            // mtime and size stats
+	"path/filepath" // Extensions for language resolution
+	"sort"          // Deterministic revalidation order
+	"sync"          // Idempotent cancel
+	"time"          // Poll interval and debounce
+)
+
+// ────────────────────────────────────────────────────────────────
+// Constants - Named Values
+// ────────────────────────────────────────────────────────────────
+
+const (
+	// defaultWatchInterval is how often watched files are stat'ed.
+	defaultWatchInterval = 250 * time.Millisecond
+
+	// defaultWatchDebounce is how long a group must stay unchanged before it
+	// revalidates - long enough to cover an editor's save-and-format burst.
+	defaultWatchDebounce = 500 * time.Millisecond
+)
+
+// ────────────────────────────────────────────────────────────────
+// Types - Data Structures
+// ────────────────────────────────────────────────────────────────
+
+// WatchOptions controls polling, debounce, and which files under a watched
+// directory are included.
+type WatchOptions struct {
+	Interval    time.Duration // Poll period; <= 0 = 250ms
+	Debounce    time.Duration // Quiet time before revalidating; <= 0 = 500ms
+	Dir         DirOptions    // Include/Exclude for watched directories; MaxParallel per revalidation
+	SkipInitial bool          // Don't validate every watched file once at start
+}
+
+// fileStamp is what a poll compares - an editor's save changes one or both.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// watchGroup is files waiting out the debounce together.
+type watchGroup struct {
+	files   map[string]bool
+	changed time.Time // Last change to any file in the group
+}
+
+// watcher is one Watch call's polling state, owned by its goroutine.
+type watcher struct {
+	paths   []string
+	opts    WatchOptions
+	stamps  map[string]fileStamp
+	pending map[string]*watchGroup // By debounce key
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+//
+// For BODY structure explanation, see: standards/code/4-block/CWS-STD-007-CODE-body-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Organizational Chart - Internal Structure
+// ────────────────────────────────────────────────────────────────
+//
+//   Public APIs
+//   └── Watch() → uses scanWatched(), (*watcher).poll(), (*watcher).ready(), validateBatch()
+//
+//   Core Operations
+//   ├── (*watcher).poll() → uses scanWatched(), debounceKey()
+//   └── (*watcher).ready() → pure over watcher state
+//
+//   Helpers
+//   ├── scanWatched() → uses collectFiles()
+//   └── debounceKey() → uses configForFile(), resolveFileLanguage(), isProjectScoped(), findProjectRoot()
+
+// ────────────────────────────────────────────────────────────────
+// HELPERS
+// ────────────────────────────────────────────────────────────────
+
+// scanWatched stats every watched file, expanding directories with collectFiles.
+// Paths that can't be stat'ed are left out - deleted files just disappear.
+func scanWatched(paths []string, opts DirOptions) map[string]fileStamp {
+	stamps := make(map[string]fileStamp)
+	stamp := func(path string) {
+		if info, err := os.Stat(path); err == nil && info.Mode().IsRegular() {
+			stamps[path] = fileStamp{modTime: info.ModTime(), size: info.Size()}
+		}
+	}
+
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if !info.IsDir() {
+			stamp(path)
+			continue
+		}
+		for _, file := range collectFiles(path, opts) {
+			stamp(file)
+		}
+	}
+	return stamps
+}
+
+// debounceKey groups a file with the rest of its project when any enabled
+// validator for its language is project-scoped; otherwise the file stands alone.
+func debounceKey(path string) string {
+	cfg := configForFile(path)
+	language, _ := resolveFileLanguage(cfg, path, filepath.Ext(path))
+	for _, name := range getEnabledValidators(cfg, language) {
+		if isProjectScoped(cfg, language, name) {
+			return "root:" + findProjectRoot(path)
+		}
+	}
+	return "file:" + path
+}
+
+// ────────────────────────────────────────────────────────────────
+// CORE OPERATIONS
+// ────────────────────────────────────────────────────────────────
+
+// poll rescans the watched paths and adds new or changed files to their
+// debounce group, restarting the group's quiet period.
+func (w *watcher) poll(at time.Time) {
+	current := scanWatched(w.paths, w.opts.Dir)
+	for path, stamp := range current {
+		if old, seen := w.stamps[path]; seen && old.size == stamp.size && old.modTime.Equal(stamp.modTime) {
+			continue
+		}
+		key := debounceKey(path)
+		group := w.pending[key]
+		if group == nil {
+			group = &watchGroup{files: make(map[string]bool)}
+			w.pending[key] = group
+		}
+		group.files[path] = true
+		group.changed = at
+	}
+	w.stamps = current
+}
+
+// ready removes and returns the files of every group quiet for the debounce
+// period, sorted. Files deleted while pending are dropped.
+func (w *watcher) ready(at time.Time) []string {
+	var files []string
+	for key, group := range w.pending {
+		if at.Sub(group.changed) < w.opts.Debounce {
+			continue
+		}
+		delete(w.pending, key)
+		for path := range group.files {
+			if _, exists := w.stamps[path]; exists {
+				files = append(files, path)
+			}
+		}
+	}
+	sort.Strings(files)
+	return files
+}
+
+// ────────────────────────────────────────────────────────────────
+// PUBLIC API: Watch Mode
+// ────────────────────────────────────────────────────────────────
+
+// Watch revalidates the given files and directories as they change.
+//
+// What It Does:
+//   - Validates every watched file once at start (unless opts.SkipInitial)
+//   - Polls mtime and size every opts.Interval; new files under a watched
+//     directory count as changed
+//   - Waits until a changed file (or, for project-scoped validators, its whole
+//     project root) has been quiet for opts.Debounce, then revalidates it
+//   - Sends each result on the channel - passing results too, so markers for
+//     fixed files can be cleared
+//
+// Files that revalidate together run as one batch, so a project-scoped
+// validator runs once per root for the group (see sharedProjectRunner).
+//
+// Parameters:
+//   - paths: Files and directories to watch
+//   - opts: Poll interval, debounce, and directory include/exclude rules
+//
+// Returns:
+//   - Channel of results, closed after cancel
+//   - cancel: stops watching; safe to call more than once
+//
+// Example:
+//
+//	results, cancel := validation.Watch([]string{"src"}, validation.WatchOptions{Debounce: time.Second})
+//	go func() { <-interrupt; cancel() }()
+//	for result := range results {
+//	    result.Report()
+//	}
+func Watch(paths []string, opts WatchOptions) (<-chan *ValidationResult, func()) {
+	if opts.Interval <= 0 {
+		opts.Interval = defaultWatchInterval
+	}
+	if opts.Debounce <= 0 {
+		opts.Debounce = defaultWatchDebounce
+	}
+
+	results := make(chan *ValidationResult)
+	done := make(chan struct{})
+	var once sync.Once
+	cancel := func() { once.Do(func() { close(done) }) }
+
+	// emit validates files as one batch and sends each result (false once cancelled)
+	emit := func(files []string) bool {
+		if len(files) == 0 {
+			return true
+		}
+		for _, result := range validateBatch(files, opts.Dir.MaxParallel, nil).Files {
+			select {
+			case results <- result:
+			case <-done:
+				return false
+			}
+		}
+		return true
+	}
+
+	// Baseline before returning - any change after Watch returns is seen
+	w := &watcher{
+		paths:   paths,
+		opts:    opts,
+		stamps:  scanWatched(paths, opts.Dir),
+		pending: make(map[string]*watchGroup),
+	}
+
+	go func() {
+		defer close(results)
+
+		if !opts.SkipInitial {
+			initial := make([]string, 0, len(w.stamps))
+			for path := range w.stamps {
+				initial = append(initial, path)
+			}
+			sort.Strings(initial)
+			if !emit(initial) {
+				return
+			}
+		}
+
+		ticker := time.NewTicker(opts.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case at := <-ticker.C:
+				w.poll(at)
+				if !emit(w.ready(at)) {
+					return
+				}
+			}
+		}
+	}()
+
+	return results, cancel
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================
+
+// ============================================================================
+// CLOSING
+// ============================================================================
+//
+// For CLOSING structure explanation, see: standards/code/4-block/CWS-STD-008-CODE-closing-block.md
+
+// ────────────────────────────────────────────────────────────────
+// Code Validation: Testing Requirements
+// ────────────────────────────────────────────────────────────────
+//
+// Testing Requirements:
+//   - A changed file revalidates once after the debounce, invalid → valid included
+//   - Rapid saves inside the debounce produce one revalidation
+//   - Files sharing a project-scoped validator's root revalidate together, one tool run
+//   - cancel closes the channel
+//   - Run: go test -race ./... (watch_test.go)
+
+// ────────────────────────────────────────────────────────────────
+// Code Execution: None (Library)
+// ────────────────────────────────────────────────────────────────
+//
+// Driven by validate --watch; no entry point of its own.
+
+// ============================================================================
+// END CLOSING
+// ============================================================================
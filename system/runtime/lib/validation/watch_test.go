@@ -0,0 +1,139 @@
+// ============================================================================
+// METADATA
+// ============================================================================
+// Watch Mode Tests
+//
+// Purpose: Prove a watched file revalidates after its debounce - passing
+//          results included - that a burst of saves revalidates once, that
+//          files under one project root share a single project-scoped run,
+//          and that cancel closes the result channel.
+// ============================================================================
+
+package validation
+
+// ============================================================================
+// SETUP
+// ============================================================================
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// useWatchValidators installs a ".chk" checker that fails on "bad" and a
+// project-scoped ".crate" checker that appends to runLog on each run.
+func useWatchValidators(t *testing.T) (runLog string) {
+	t.Helper()
+	dir := t.TempDir()
+	runLog = filepath.Join(dir, "runs")
+	check := filepath.Join(dir, "check.sh")
+	crate := filepath.Join(dir, "crate.sh")
+	os.WriteFile(check, []byte("if grep -q bad \"$1\"; then echo \"$1:1:1: error: bad\"; exit 1; fi\n"), 0755)
+	os.WriteFile(crate, []byte("echo run >> "+runLog+"\n"), 0755)
+
+	useGlobalConfig(t)
+	validatorsConfig.Validators["chk"] = LanguageValidators{Validators: map[string]ValidatorTool{
+		"check": {Command: "/bin/sh", Args: []string{check, "{filepath}"}, Enabled: true},
+	}}
+	validatorsConfig.Validators["crate"] = LanguageValidators{Validators: map[string]ValidatorTool{
+		"crate_check": {Command: "/bin/sh", Args: []string{crate}, Enabled: true, WorkingDir: "project_root"},
+	}}
+	validatorsConfig.Extensions[".chk"] = "chk"
+	validatorsConfig.Extensions[".crate"] = "crate"
+	return runLog
+}
+
+// nextResult waits for one result, failing the test after a few seconds.
+func nextResult(t *testing.T, results <-chan *ValidationResult) *ValidationResult {
+	t.Helper()
+	select {
+	case result := <-results:
+		return result
+	case <-time.After(5 * time.Second):
+		t.Fatal("no result within 5s")
+		return nil
+	}
+}
+
+// noResult fails if a result arrives within wait.
+func noResult(t *testing.T, results <-chan *ValidationResult, wait time.Duration) {
+	t.Helper()
+	select {
+	case result := <-results:
+		t.Fatalf("unexpected result for %s", result.FilePath)
+	case <-time.After(wait):
+	}
+}
+
+// ============================================================================
+// BODY
+// ============================================================================
+
+func TestWatchRevalidatesAfterDebounce(t *testing.T) {
+	useWatchValidators(t)
+	dir := t.TempDir()
+	file := filepath.Join(dir, "a.chk")
+	os.WriteFile(file, []byte("bad\n"), 0644)
+
+	results, cancel := Watch([]string{dir}, WatchOptions{Interval: 10 * time.Millisecond, Debounce: 100 * time.Millisecond})
+	defer cancel()
+
+	if initial := nextResult(t, results); initial.FilePath != file || initial.Valid {
+		t.Fatalf("initial result = %+v, want invalid %s", initial, file)
+	}
+
+	// A burst of saves inside the debounce revalidates once, with the last content
+	for _, content := range []string{"bad bad\n", "still bad, longer\n", "fixed\n"} {
+		os.WriteFile(file, []byte(content), 0644)
+		time.Sleep(20 * time.Millisecond)
+	}
+	if fixed := nextResult(t, results); !fixed.Valid {
+		t.Fatalf("after fix: %+v, want valid (invalid → valid must be sent)", fixed)
+	}
+	noResult(t, results, 250*time.Millisecond)
+
+	cancel()
+	cancel() // Idempotent
+	select {
+	case _, open := <-results:
+		if open {
+			t.Error("result after cancel")
+		}
+	case <-time.After(time.Second):
+		t.Error("channel not closed after cancel")
+	}
+}
+
+func TestWatchDebouncesPerProjectRoot(t *testing.T) {
+	runLog := useWatchValidators(t)
+	root := t.TempDir()
+	os.WriteFile(filepath.Join(root, "go.mod"), []byte("module crate\n"), 0644)
+	first, second := filepath.Join(root, "one.crate"), filepath.Join(root, "sub", "two.crate")
+	os.MkdirAll(filepath.Dir(second), 0755)
+	os.WriteFile(first, []byte("1\n"), 0644)
+	os.WriteFile(second, []byte("2\n"), 0644)
+
+	results, cancel := Watch([]string{first, second}, WatchOptions{Interval: 10 * time.Millisecond, Debounce: 150 * time.Millisecond, SkipInitial: true})
+	defer cancel()
+
+	// Saves to two files of one root, apart but each inside the other's debounce
+	os.WriteFile(first, []byte("11\n"), 0644)
+	time.Sleep(80 * time.Millisecond)
+	os.WriteFile(second, []byte("22\n"), 0644)
+
+	got := []string{nextResult(t, results).FilePath, nextResult(t, results).FilePath}
+	if got[0] != first || got[1] != second {
+		t.Errorf("revalidated %v, want both files of the root", got)
+	}
+	runs, _ := os.ReadFile(runLog)
+	if count := strings.Count(string(runs), "run"); count != 1 {
+		t.Errorf("project-scoped validator ran %d times, want once for the root", count)
+	}
+}
+
+// ============================================================================
+// END BODY
+// ============================================================================